@@ -19,10 +19,12 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb-tools/pkg/check"
 	router "github.com/pingcap/tidb-tools/pkg/table-router"
 
 	"github.com/pingcap/dm/dm/config"
 	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
 	"github.com/pingcap/dm/pkg/conn"
 
 	tc "github.com/pingcap/check"
@@ -48,6 +50,9 @@ func ignoreExcept(itemMap map[string]struct{}) []string {
 		config.TableSchemaChecking,
 		config.ShardTableSchemaChecking,
 		config.ShardAutoIncrementIDChecking,
+		config.TargetPrivilegeChecking,
+		config.TargetVersionChecking,
+		config.MaxAllowedPacketChecking,
 	}
 	ignoreCheckingItems := make([]string, 0, len(items)-len(itemMap))
 	for _, i := range items {
@@ -222,6 +227,135 @@ func (s *testCheckerSuite) TestBinlogRowImageChecking(c *tc.C) {
 	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.IsNil)
 }
 
+func (s *testCheckerSuite) TestPrimaryKeyChecking(c *tc.C) {
+	var (
+		schema = "db_1"
+		tb1    = "t_1"
+		tb2    = "t_2"
+	)
+	cfgs := []*config.SubTaskConfig{
+		{
+			IgnoreCheckingItems: ignoreExcept(map[string]struct{}{config.BinlogRowImageChecking: {}}),
+			SyncerConfig:        config.SyncerConfig{MinimalRowImage: true},
+		},
+	}
+
+	mock := conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"DATABASE"}).AddRow(schema))
+	mock.ExpectQuery("SHOW FULL TABLES").WillReturnRows(sqlmock.NewRows([]string{"Tables_in_" + schema, "Table_type"}).AddRow(tb1, "BASE TABLE").AddRow(tb2, "BASE TABLE"))
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'binlog_row_image'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+		AddRow("binlog_row_image", "MINIMAL"))
+	mock.ExpectQuery("SELECT TABLE_NAME FROM information_schema.TABLE_CONSTRAINTS").WithArgs(schema).WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME"}).AddRow(tb1))
+	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.ErrorMatches, "(.|\n)*binlog_row_image is MINIMAL, but tables `db_1`.`t_2` have no primary key(.|\n)*")
+
+	mock = conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"DATABASE"}).AddRow(schema))
+	mock.ExpectQuery("SHOW FULL TABLES").WillReturnRows(sqlmock.NewRows([]string{"Tables_in_" + schema, "Table_type"}).AddRow(tb1, "BASE TABLE").AddRow(tb2, "BASE TABLE"))
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'binlog_row_image'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+		AddRow("binlog_row_image", "NOBLOB"))
+	mock.ExpectQuery("SELECT TABLE_NAME FROM information_schema.TABLE_CONSTRAINTS").WithArgs(schema).WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME"}).AddRow(tb1).AddRow(tb2))
+	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.IsNil)
+}
+
+func (s *testCheckerSuite) TestTargetPrivilegeChecking(c *tc.C) {
+	var (
+		schema = "db_1"
+		tb1    = "t_1"
+	)
+	cfgs := []*config.SubTaskConfig{
+		{
+			IgnoreCheckingItems: ignoreExcept(map[string]struct{}{config.TargetPrivilegeChecking: {}}),
+			MetaSchema:          "dm_meta",
+		},
+	}
+
+	mock := conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"DATABASE"}).AddRow(schema))
+	mock.ExpectQuery("SHOW FULL TABLES").WillReturnRows(sqlmock.NewRows([]string{"Tables_in_" + schema, "Table_type"}).AddRow(tb1, "BASE TABLE"))
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT CREATE,INSERT ON *.* TO 'haha'@'%'").
+		AddRow("GRANT ALTER,DROP ON `db\\_%`.* TO 'haha'@'%'"))
+	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.ErrorMatches, "(.|\n)*lack of ALTER,DROP privilege on schema `dm_meta`(.|\n)*")
+
+	mock = conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"DATABASE"}).AddRow(schema))
+	mock.ExpectQuery("SHOW FULL TABLES").WillReturnRows(sqlmock.NewRows([]string{"Tables_in_" + schema, "Table_type"}).AddRow(tb1, "BASE TABLE"))
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT CREATE,INSERT ON *.* TO 'haha'@'%'").
+		AddRow("GRANT ALL PRIVILEGES ON `dm_meta`.* TO 'haha'@'%'").
+		AddRow("GRANT ALTER,DROP ON `db\\_%`.* TO 'haha'@'%'"))
+	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.IsNil)
+
+	// the privileges may be granted by the roles.
+	result := &check.Result{}
+	verifyTargetPrivileges(result, []string{"GRANT USAGE ON *.* TO 'haha'@'%'", "GRANT 'r1'@'%' TO 'haha'@'%'"}, []string{schema})
+	c.Assert(result.State, tc.Equals, check.StateWarning)
+	c.Assert(result.Errors, tc.HasLen, 1)
+	c.Assert(result.Errors[0].ShortErr, tc.Equals, "lack of CREATE,ALTER,DROP,INSERT privilege on schema `db_1`")
+}
+
+func (s *testCheckerSuite) TestTargetVersionChecking(c *tc.C) {
+	cfgs := []*config.SubTaskConfig{
+		{
+			IgnoreCheckingItems: ignoreExcept(map[string]struct{}{config.TargetVersionChecking: {}}),
+		},
+	}
+
+	mock := conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'version'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+		AddRow("version", "5.7.25-TiDB-v5.2.1"))
+	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.IsNil)
+
+	mock = conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'version'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+		AddRow("version", "5.5.26-log"))
+	c.Assert(CheckSyncConfig(context.Background(), cfgs, common.DefaultErrorCnt, common.DefaultWarnCnt), tc.ErrorMatches, "(.|\n)*version required at least .* but got 5.5.26(.|\n)*")
+
+	cases := []struct {
+		version string
+		state   check.State
+	}{
+		{"5.7.25-TiDB-v4.0.0", check.StateSuccess},
+		{"5.7.25-TiDB-v3.0.20", check.StateWarning},
+		{"8.0.22", check.StateWarning},
+		{"10.5.8-MariaDB", check.StateWarning},
+		{"10.0.0-MariaDB", check.StateFailure},
+		{"invalid", check.StateFailure},
+	}
+	for _, cs := range cases {
+		result := &check.Result{State: check.StateFailure}
+		checkTargetVersion(result, cs.version)
+		c.Assert(result.State, tc.Equals, cs.state, tc.Commentf("version %s", cs.version))
+	}
+}
+
+func (s *testCheckerSuite) TestMaxAllowedPacketChecking(c *tc.C) {
+	cfgs := []*config.SubTaskConfig{
+		{
+			IgnoreCheckingItems: ignoreExcept(map[string]struct{}{config.MaxAllowedPacketChecking: {}}),
+		},
+	}
+
+	// the source and target share the mock DB.
+	mock := conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'max_allowed_packet'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+		AddRow("max_allowed_packet", "67108864"))
+	mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'max_allowed_packet'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+		AddRow("max_allowed_packet", "4194304"))
+	checkingItems := config.FilterCheckingItems(cfgs[0].IgnoreCheckingItems)
+	checker := NewChecker(cfgs, checkingItems, common.DefaultErrorCnt, common.DefaultWarnCnt)
+	c.Assert(checker.Init(context.Background()), tc.IsNil)
+	defer checker.Close()
+	pr := make(chan pb.ProcessResult, 1)
+	checker.Process(context.Background(), pr)
+	r := <-pr
+	c.Assert(r.Errors, tc.HasLen, 0)
+	results := checker.result.detail
+	c.Assert(results.Summary.Warning, tc.Equals, int64(1))
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Errors[0].ShortErr, tc.Equals, "max_allowed_packet 4194304 of target DB is less than 67108864 of source DB")
+}
+
 func (s *testCheckerSuite) TestTableSchemaChecking(c *tc.C) {
 	var (
 		schema = "db_1"
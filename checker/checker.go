@@ -120,6 +120,9 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 	_, checkingShardID := c.checkingItems[config.ShardAutoIncrementIDChecking]
 	_, checkingShard := c.checkingItems[config.ShardTableSchemaChecking]
 	_, checkSchema := c.checkingItems[config.TableSchemaChecking]
+	_, checkTargetPrivilege := c.checkingItems[config.TargetPrivilegeChecking]
+	// the routed schemas to check the privileges of the target DB.
+	targetSchemas := make(map[string]struct{})
 
 	for _, instance := range c.instances {
 		bw, err := filter.New(instance.cfg.CaseSensitive, instance.cfg.BAList)
@@ -174,7 +177,11 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 		if _, ok := c.checkingItems[config.BinlogFormatChecking]; ok {
 			c.checkList = append(c.checkList, check.NewMySQLBinlogFormatChecker(instance.sourceDB.DB, instance.sourceDBinfo))
 		}
-		if _, ok := c.checkingItems[config.BinlogRowImageChecking]; ok {
+		// the columns not logged are filled by looking up from the upstream or not replicated, so FULL row image is not
+		// required, but the tables should have a primary key to find the rows.
+		_, checkRowImage := c.checkingItems[config.BinlogRowImageChecking]
+		checkPK := checkRowImage && (instance.cfg.SyncerConfig.FillMissingColumns || instance.cfg.SyncerConfig.MinimalRowImage)
+		if checkRowImage && !checkPK {
 			c.checkList = append(c.checkList, check.NewMySQLBinlogRowImageChecker(instance.sourceDB.DB, instance.sourceDBinfo))
 		}
 		if _, ok := c.checkingItems[config.DumpPrivilegeChecking]; ok {
@@ -184,7 +191,14 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 			c.checkList = append(c.checkList, check.NewSourceReplicationPrivilegeChecker(instance.sourceDB.DB, instance.sourceDBinfo))
 		}
 
-		if !checkingShard && !checkSchema {
+		if _, ok := c.checkingItems[config.MaxAllowedPacketChecking]; ok {
+			c.checkList = append(c.checkList, newMaxAllowedPacketChecker(instance.sourceDB.DB, instance.sourceDBinfo, instance.targetDB.DB))
+		}
+
+		if checkTargetPrivilege && instance.cfg.MetaSchema != "" {
+			targetSchemas[instance.cfg.MetaSchema] = struct{}{}
+		}
+		if !checkingShard && !checkSchema && !checkPK && !checkTargetPrivilege {
 			continue
 		}
 
@@ -201,6 +215,13 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 		checkTables := make(map[string][]string)
 		for name, tables := range mapping {
 			for _, table := range tables {
+				if checkTargetPrivilege {
+					targetSchema, _, err2 := r.Route(table.Schema, table.Name)
+					if err2 != nil {
+						return terror.ErrGenTableRouter.Delegate(err2)
+					}
+					targetSchemas[targetSchema] = struct{}{}
+				}
 				checkTables[table.Schema] = append(checkTables[table.Schema], table.Name)
 				if _, ok := sharding[name]; !ok {
 					sharding[name] = make(map[string]map[string][]string)
@@ -221,6 +242,20 @@ func (c *Checker) Init(ctx context.Context) (err error) {
 		if checkSchema {
 			c.checkList = append(c.checkList, check.NewTablesChecker(instance.sourceDB.DB, instance.sourceDBinfo, checkTables))
 		}
+		if checkPK {
+			c.checkList = append(c.checkList, newPrimaryKeyChecker(instance.sourceDB.DB, instance.sourceDBinfo, checkTables))
+		}
+	}
+
+	// all subtasks of the task share the same target DB.
+	if len(c.instances) > 0 {
+		target := c.instances[0]
+		if _, ok := c.checkingItems[config.TargetVersionChecking]; ok {
+			c.checkList = append(c.checkList, newTargetVersionChecker(target.targetDB.DB, target.targetDBInfo))
+		}
+		if checkTargetPrivilege {
+			c.checkList = append(c.checkList, newTargetPrivilegeChecker(target.targetDB.DB, target.targetDBInfo, sortedSchemas(targetSchemas)))
+		}
 	}
 
 	if checkingShard {
@@ -0,0 +1,111 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb-tools/pkg/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+)
+
+// primaryKeyChecker checks whether the tables to replicate have a primary key when the upstream doesn't log the full
+// row images, the rows are found by the primary key logged in the before image.
+type primaryKeyChecker struct {
+	db     *sql.DB
+	dbinfo *dbutil.DBConfig
+	tables map[string][]string // schema => []table
+}
+
+// newPrimaryKeyChecker returns a checker which checks the primary key of the tables for MINIMAL or NOBLOB row image.
+func newPrimaryKeyChecker(db *sql.DB, dbinfo *dbutil.DBConfig, tables map[string][]string) check.Checker {
+	return &primaryKeyChecker{db: db, dbinfo: dbinfo, tables: tables}
+}
+
+// Check implements the Checker interface.
+func (c *primaryKeyChecker) Check(ctx context.Context) *check.Result {
+	result := &check.Result{
+		Name:  c.Name(),
+		Desc:  "check whether the tables have a primary key if binlog_row_image is not FULL",
+		State: check.StateFailure,
+		Extra: fmt.Sprintf("address of db instance - %s:%d", c.dbinfo.Host, c.dbinfo.Port),
+	}
+
+	// the full row images are always used before MySQL 5.6.2, the variable doesn't exist.
+	rowImage, err := dbutil.ShowBinlogRowImage(ctx, c.db)
+	if err != nil && errors.Cause(err) != sql.ErrNoRows {
+		result.Errors = append(result.Errors, check.NewError("%v", err))
+		return result
+	}
+	if rowImage == "" || strings.EqualFold(rowImage, "FULL") {
+		result.State = check.StateSuccess
+		return result
+	}
+
+	schemas := make([]string, 0, len(c.tables))
+	for schema := range c.tables {
+		schemas = append(schemas, schema)
+	}
+	sort.Strings(schemas)
+
+	var noPK []string
+	for _, schema := range schemas {
+		withPK, err2 := tablesWithPrimaryKey(ctx, c.db, schema)
+		if err2 != nil {
+			result.Errors = append(result.Errors, check.NewError("%v", err2))
+			return result
+		}
+		for _, table := range c.tables[schema] {
+			if _, ok := withPK[table]; !ok {
+				noPK = append(noPK, dbutil.TableName(schema, table))
+			}
+		}
+	}
+	if len(noPK) > 0 {
+		result.Errors = append(result.Errors, check.NewError("binlog_row_image is %s, but tables %s have no primary key", rowImage, strings.Join(noPK, ",")))
+		result.Instruction = "please add a primary key to the tables, or execute 'set global binlog_row_image = FULL;'"
+		return result
+	}
+	result.State = check.StateSuccess
+	return result
+}
+
+// Name implements the Checker interface.
+func (c *primaryKeyChecker) Name() string {
+	return "table_primary_key"
+}
+
+// tablesWithPrimaryKey returns the tables which have a primary key in the schema.
+func tablesWithPrimaryKey(ctx context.Context, db *sql.DB, schema string) (map[string]struct{}, error) {
+	rows, err := db.QueryContext(ctx, "SELECT TABLE_NAME FROM information_schema.TABLE_CONSTRAINTS WHERE TABLE_SCHEMA = ? AND CONSTRAINT_TYPE = 'PRIMARY KEY'", schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables := make(map[string]struct{})
+	for rows.Next() {
+		var table string
+		if err = rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables[table] = struct{}{}
+	}
+	return tables, rows.Err()
+}
@@ -0,0 +1,331 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/pingcap/tidb-tools/pkg/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/stringutil"
+
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+var (
+	// targetPrivileges are the privileges needed on the target schemas to create, change and write the tables.
+	targetPrivileges = []mysql.PrivilegeType{mysql.CreatePriv, mysql.AlterPriv, mysql.DropPriv, mysql.InsertPriv}
+	// minTargetTiDBVersion is the minimal TiDB version tested with DM as the target.
+	minTargetTiDBVersion = semver.New("4.0.0")
+)
+
+// targetPrivilegeChecker checks whether the user of the target DB has the privileges to create, change and write the
+// tables in the target schemas, which are the routed schemas and the meta schema.
+type targetPrivilegeChecker struct {
+	db      *sql.DB
+	dbinfo  *dbutil.DBConfig
+	schemas []string
+}
+
+// newTargetPrivilegeChecker returns a checker which checks the privileges of the target DB on the schemas.
+func newTargetPrivilegeChecker(db *sql.DB, dbinfo *dbutil.DBConfig, schemas []string) check.Checker {
+	return &targetPrivilegeChecker{db: db, dbinfo: dbinfo, schemas: schemas}
+}
+
+// Check implements the Checker interface.
+func (c *targetPrivilegeChecker) Check(ctx context.Context) *check.Result {
+	result := &check.Result{
+		Name:  c.Name(),
+		Desc:  "check privileges of target DB on the routed schemas",
+		State: check.StateFailure,
+		Extra: fmt.Sprintf("address of db instance - %s:%d", c.dbinfo.Host, c.dbinfo.Port),
+	}
+
+	grants, err := dbutil.ShowGrants(ctx, c.db, "", "")
+	if err != nil {
+		result.Errors = append(result.Errors, check.NewError("%v", err))
+		return result
+	}
+	verifyTargetPrivileges(result, grants, c.schemas)
+	return result
+}
+
+// Name implements the Checker interface.
+func (c *targetPrivilegeChecker) Name() string {
+	return "target_db_privilege"
+}
+
+// schemaGrant is the privileges granted on the schemas matching the pattern of a database level GRANT.
+type schemaGrant struct {
+	patWeights []rune
+	patTypes   []byte
+	privs      map[mysql.PrivilegeType]struct{}
+}
+
+// verifyTargetPrivileges checks whether the grants contain the target privileges on every schema, the privileges can
+// be granted globally, or on the database level with the wildcards. the state is warning rather than failure if the
+// lacked privileges may be granted by the roles.
+func verifyTargetPrivileges(result *check.Result, grants []string, schemas []string) {
+	var (
+		user         string
+		hasRole      bool
+		globalPrivs  = make(map[mysql.PrivilegeType]struct{})
+		schemaGrants []schemaGrant
+	)
+	for _, grant := range grants {
+		node, err := parser.New().ParseOneStmt(grant, "", "")
+		if err != nil {
+			result.Errors = append(result.Errors, check.NewError("fail to parse grant %s: %v", grant, err))
+			return
+		}
+		switch stmt := node.(type) {
+		case *ast.GrantRoleStmt:
+			hasRole = true
+			continue
+		case *ast.GrantProxyStmt:
+			continue
+		case *ast.GrantStmt:
+			if user == "" && len(stmt.Users) > 0 {
+				user = stmt.Users[0].User.Username
+			}
+			privs := make(map[mysql.PrivilegeType]struct{}, len(stmt.Privs))
+			for _, priv := range stmt.Privs {
+				if priv.Priv == mysql.AllPriv {
+					for _, p := range targetPrivileges {
+						privs[p] = struct{}{}
+					}
+				} else if len(priv.Cols) == 0 {
+					privs[priv.Priv] = struct{}{}
+				}
+			}
+			switch stmt.Level.Level {
+			case ast.GrantLevelGlobal:
+				for p := range privs {
+					globalPrivs[p] = struct{}{}
+				}
+			case ast.GrantLevelDB:
+				patWeights, patTypes := stringutil.CompilePattern(strings.ToLower(stmt.Level.DBName), '\\')
+				schemaGrants = append(schemaGrants, schemaGrant{patWeights: patWeights, patTypes: patTypes, privs: privs})
+			}
+		default:
+			result.Errors = append(result.Errors, check.NewError("%s is not grant statement", grant))
+			return
+		}
+	}
+
+	var lacks []string
+	for _, schema := range schemas {
+		var lackPrivs []string
+		for _, p := range targetPrivileges {
+			if _, ok := globalPrivs[p]; ok {
+				continue
+			}
+			granted := false
+			for _, g := range schemaGrants {
+				if _, ok := g.privs[p]; ok && stringutil.DoMatch(strings.ToLower(schema), g.patWeights, g.patTypes) {
+					granted = true
+					break
+				}
+			}
+			if !granted {
+				lackPrivs = append(lackPrivs, strings.ToUpper(mysql.Priv2Str[p]))
+			}
+		}
+		if len(lackPrivs) == 0 {
+			continue
+		}
+		privileges := strings.Join(lackPrivs, ",")
+		result.Errors = append(result.Errors, check.NewError("lack of %s privilege on schema %s", privileges, dbutil.ColumnName(schema)))
+		lacks = append(lacks, fmt.Sprintf("GRANT %s ON %s.* TO '%s'@'%s';", privileges, dbutil.ColumnName(schema), user, "%"))
+	}
+	if len(lacks) == 0 {
+		result.State = check.StateSuccess
+		return
+	}
+	result.Instruction = strings.Join(lacks, " ")
+	if hasRole {
+		for _, err := range result.Errors {
+			err.Severity = check.StateWarning
+		}
+		result.State = check.StateWarning
+		result.Instruction = "the privileges granted by the roles are not checked, please make sure they're granted by the default roles, or " + result.Instruction
+	}
+}
+
+// targetVersionChecker checks whether the version of the target DB is compatible. the target TiDB lower than
+// minTargetTiDBVersion, or MySQL/MariaDB as the target is not fully tested, and MySQL/MariaDB is required at least
+// the minimal version supported as the source.
+type targetVersionChecker struct {
+	db     *sql.DB
+	dbinfo *dbutil.DBConfig
+}
+
+// newTargetVersionChecker returns a checker which checks the version of the target DB.
+func newTargetVersionChecker(db *sql.DB, dbinfo *dbutil.DBConfig) check.Checker {
+	return &targetVersionChecker{db: db, dbinfo: dbinfo}
+}
+
+// Check implements the Checker interface.
+func (c *targetVersionChecker) Check(ctx context.Context) *check.Result {
+	result := &check.Result{
+		Name:  c.Name(),
+		Desc:  "check whether the version of target DB is compatible",
+		State: check.StateFailure,
+		Extra: fmt.Sprintf("address of db instance - %s:%d", c.dbinfo.Host, c.dbinfo.Port),
+	}
+
+	value, err := dbutil.ShowVersion(ctx, c.db)
+	if err != nil {
+		result.Errors = append(result.Errors, check.NewError("%v", err))
+		return result
+	}
+	checkTargetVersion(result, value)
+	return result
+}
+
+// Name implements the Checker interface.
+func (c *targetVersionChecker) Name() string {
+	return "target_db_version"
+}
+
+// checkTargetVersion checks the version string of the target DB.
+func checkTargetVersion(result *check.Result, value string) {
+	if strings.Contains(strings.ToLower(value), "tidb") {
+		version, err := utils.ExtractTiDBVersion(value)
+		if err != nil {
+			result.Errors = append(result.Errors, check.NewError("%v", err))
+			return
+		}
+		if version.LessThan(*minTargetTiDBVersion) {
+			result.State = check.StateWarning
+			result.Errors = append(result.Errors, &check.Error{
+				Severity: check.StateWarning,
+				ShortErr: fmt.Sprintf("TiDB version lower than v%s is not fully tested, but got v%s", minTargetTiDBVersion, version),
+			})
+			result.Instruction = "please upgrade the target TiDB"
+			return
+		}
+		result.State = check.StateSuccess
+		return
+	}
+
+	flavor, needVersion := "mysql", check.SupportedVersion["mysql"]
+	if check.IsMariaDB(value) {
+		flavor, needVersion = "mariadb", check.SupportedVersion["mariadb"]
+	}
+	version, err := toMySQLVersion(value)
+	if err != nil {
+		result.Errors = append(result.Errors, check.NewError("%v", err))
+		return
+	}
+	if !version.Ge(needVersion.Min) {
+		result.Errors = append(result.Errors, check.NewError("version required at least %v but got %v", needVersion.Min, version))
+		result.Instruction = "please upgrade the target DB"
+		return
+	}
+	result.State = check.StateWarning
+	result.Errors = append(result.Errors, &check.Error{
+		Severity: check.StateWarning,
+		ShortErr: fmt.Sprintf("%s %v as the target is not fully tested", flavor, version),
+	})
+}
+
+// toMySQLVersion parses the version of MySQL/MariaDB like `5.7.25-log` or `10.5.8-MariaDB`.
+func toMySQLVersion(value string) (check.MySQLVersion, error) {
+	version := check.MySQLVersion{}
+	parts := strings.Split(strings.SplitN(value, "-", 2)[0], ".")
+	if len(parts) != 3 {
+		return version, fmt.Errorf("invalid MySQL version %s", value)
+	}
+	for i, part := range parts {
+		v, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return version, fmt.Errorf("invalid MySQL version %s", value)
+		}
+		version[i] = uint(v)
+	}
+	return version, nil
+}
+
+// maxAllowedPacketChecker checks whether max_allowed_packet of the target DB is not less than the source DB, otherwise
+// the large rows or statements replicated from the source may fail in the target.
+type maxAllowedPacketChecker struct {
+	sourceDB     *sql.DB
+	sourceDBinfo *dbutil.DBConfig
+	targetDB     *sql.DB
+}
+
+// newMaxAllowedPacketChecker returns a checker which compares max_allowed_packet of the source and target DB.
+func newMaxAllowedPacketChecker(sourceDB *sql.DB, sourceDBinfo *dbutil.DBConfig, targetDB *sql.DB) check.Checker {
+	return &maxAllowedPacketChecker{sourceDB: sourceDB, sourceDBinfo: sourceDBinfo, targetDB: targetDB}
+}
+
+// Check implements the Checker interface.
+func (c *maxAllowedPacketChecker) Check(ctx context.Context) *check.Result {
+	result := &check.Result{
+		Name:  c.Name(),
+		Desc:  "check whether max_allowed_packet of target DB is not less than source DB",
+		State: check.StateFailure,
+		Extra: fmt.Sprintf("address of db instance - %s:%d", c.sourceDBinfo.Host, c.sourceDBinfo.Port),
+	}
+
+	var sizes [2]uint64
+	for i, db := range []*sql.DB{c.sourceDB, c.targetDB} {
+		value, err := utils.GetGlobalVariable(ctx, db, "max_allowed_packet")
+		if err != nil {
+			result.Errors = append(result.Errors, check.NewError("%v", err))
+			return result
+		}
+		sizes[i], err = strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			result.Errors = append(result.Errors, check.NewError("invalid max_allowed_packet %s", value))
+			return result
+		}
+	}
+	if sizes[1] < sizes[0] {
+		result.State = check.StateWarning
+		result.Errors = append(result.Errors, &check.Error{
+			Severity: check.StateWarning,
+			ShortErr: fmt.Sprintf("max_allowed_packet %d of target DB is less than %d of source DB", sizes[1], sizes[0]),
+		})
+		result.Instruction = fmt.Sprintf("please execute 'set global max_allowed_packet = %d;' in target DB", sizes[0])
+		return result
+	}
+	result.State = check.StateSuccess
+	return result
+}
+
+// Name implements the Checker interface.
+func (c *maxAllowedPacketChecker) Name() string {
+	return "max_allowed_packet"
+}
+
+// sortedSchemas returns the schemas of the set in order.
+func sortedSchemas(set map[string]struct{}) []string {
+	schemas := make([]string, 0, len(set))
+	for schema := range set {
+		schemas = append(schemas, schema)
+	}
+	sort.Strings(schemas)
+	return schemas
+}
@@ -41,13 +41,14 @@ func main() {
 	}
 	http.Handle("/", http.StripPrefix("/", http.FileServer(statikFS)))
 
-	portal := portal.NewHandler(cfg.TaskFilePath, cfg.Timeout)
+	portal := portal.NewHandler(cfg.TaskFilePath, cfg.Timeout, cfg.MasterAddr)
 
 	http.HandleFunc("/check", portal.Check)
 	http.HandleFunc("/schema", portal.GetSchemaInfo)
 	http.HandleFunc("/generate_config", portal.GenerateConfig)
 	http.HandleFunc("/analyze_config_file", portal.AnalyzeConfig)
 	http.HandleFunc("/download", portal.Download)
+	http.HandleFunc("/task_progress", portal.TaskProgress)
 
 	err = http.ListenAndServe(fmt.Sprintf(":%d", cfg.Port), nil)
 	if err != nil {
@@ -0,0 +1,100 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/pingcap/errors"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// relayConfig is the command line config of dm-relay.
+type relayConfig struct {
+	*flag.FlagSet `json:"-"`
+
+	printVersion      bool
+	printSampleConfig bool
+
+	ConfigFile string
+	RelayDir   string
+	StatusAddr string
+
+	LogLevel  string
+	LogFile   string
+	LogFormat string
+}
+
+func newRelayConfig() *relayConfig {
+	cfg := &relayConfig{}
+	cfg.FlagSet = flag.NewFlagSet("dm-relay", flag.ContinueOnError)
+	fs := cfg.FlagSet
+
+	fs.BoolVar(&cfg.printVersion, "V", false, "prints version and exit")
+	fs.BoolVar(&cfg.printSampleConfig, "print-sample-config", false, "print sample source config file")
+	fs.StringVar(&cfg.ConfigFile, "config", "", "path to source config file")
+	fs.StringVar(&cfg.RelayDir, "relay-dir", "", "directory to store relay log files, overwrites `relay-dir` in the source config file")
+	fs.StringVar(&cfg.StatusAddr, "status-addr", "", "status address to serve metrics, empty means disabled")
+	fs.StringVar(&cfg.LogLevel, "L", "info", "log level: debug, info, warn, error, fatal")
+	fs.StringVar(&cfg.LogFile, "log-file", "", "log file path")
+	fs.StringVar(&cfg.LogFormat, "log-format", "text", `the format of the log, "text" or "json"`)
+	return cfg
+}
+
+func (c *relayConfig) parse(args []string) error {
+	if err := c.FlagSet.Parse(args); err != nil {
+		return errors.Trace(err)
+	}
+	if c.printVersion {
+		fmt.Println(utils.GetRawInfo())
+		return flag.ErrHelp
+	}
+	if c.printSampleConfig {
+		fmt.Println(config.SampleConfigFile)
+		return flag.ErrHelp
+	}
+	if len(c.FlagSet.Args()) != 0 {
+		return errors.Errorf("'%s' is an invalid flag", c.FlagSet.Arg(0))
+	}
+	if c.ConfigFile == "" {
+		return errors.New("`config` should be set to the path of source config file")
+	}
+	return nil
+}
+
+// loadSourceConfig loads the source config from file, and adjusts it by the upstream database like adding a source.
+func (c *relayConfig) loadSourceConfig(ctx context.Context) (*config.SourceConfig, error) {
+	cfg, err := config.LoadFromFile(c.ConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	if c.RelayDir != "" {
+		cfg.RelayDir = c.RelayDir
+	}
+
+	fromDB, err := conn.DefaultDBProvider.Apply(*cfg.GenerateDBConfig())
+	if err != nil {
+		return nil, err
+	}
+	defer fromDB.Close()
+	if err = cfg.Adjust(ctx, fromDB.DB); err != nil {
+		return nil, err
+	}
+	return cfg, cfg.Verify()
+}
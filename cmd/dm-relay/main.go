@@ -0,0 +1,142 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/pingcap/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/streamer"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/relay"
+	"github.com/pingcap/dm/relay/purger"
+)
+
+// relayOperator makes the relay unit a purger.RelayOperator.
+type relayOperator struct {
+	relay.Process
+}
+
+// EarliestActiveRelayLog implements purger.RelayOperator.EarliestActiveRelayLog.
+func (o relayOperator) EarliestActiveRelayLog() *streamer.RelayLogInfo {
+	return o.ActiveRelayLog()
+}
+
+func main() {
+	// 1. init conf
+	cfg := newRelayConfig()
+	err := cfg.parse(os.Args[1:])
+	switch errors.Cause(err) {
+	case nil:
+	case flag.ErrHelp:
+		os.Exit(0)
+	default:
+		common.PrintLinesf("parse cmd flags err: %s", terror.Message(err))
+		os.Exit(2)
+	}
+
+	// 2. init logger
+	err = log.InitLogger(&log.Config{
+		File:   cfg.LogFile,
+		Format: cfg.LogFormat,
+		Level:  strings.ToLower(cfg.LogLevel),
+	})
+	if err != nil {
+		common.PrintLinesf("init logger error %s", terror.Message(err))
+		os.Exit(2)
+	}
+
+	// 3. print process version information
+	utils.PrintInfo("dm-relay", func() {
+		log.L().Info("", zap.String("config file", cfg.ConfigFile), zap.String("relay dir", cfg.RelayDir), zap.String("status addr", cfg.StatusAddr))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
+	go func() {
+		sig := <-sc
+		log.L().Info("got signal to exit", zap.Stringer("signal", sig))
+		cancel()
+	}()
+
+	// 4. load the source config
+	sourceCfg, err := cfg.loadSourceConfig(ctx)
+	if err != nil {
+		common.PrintLinesf("load source config error %s", terror.Message(err))
+		os.Exit(2)
+	}
+	// do not log the whole source config to protect the password.
+	log.L().Info("loaded source config", zap.String("source", sourceCfg.SourceID), zap.String("relay dir", sourceCfg.RelayDir),
+		zap.String("flavor", sourceCfg.Flavor), zap.Uint32("server id", sourceCfg.ServerID), zap.Bool("enable gtid", sourceCfg.EnableGTID))
+
+	// 5. serve the metrics
+	if cfg.StatusAddr != "" {
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
+		registry.MustRegister(prometheus.NewGoCollector())
+		relay.RegisterMetrics(registry)
+		prometheus.DefaultGatherer = registry
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		httpS := &http.Server{Addr: cfg.StatusAddr, Handler: mux}
+		go func() {
+			if err2 := httpS.ListenAndServe(); err2 != nil && err2 != http.ErrServerClosed {
+				log.L().Error("status server returned", log.ShortError(err2))
+			}
+		}()
+		defer httpS.Close()
+	}
+
+	// 6. start the relay and the purger
+	r := relay.NewRelay(relay.FromSourceCfg(sourceCfg))
+	if err = r.Init(ctx); err != nil {
+		common.PrintLinesf("init relay error %s", terror.Message(err))
+		os.Exit(2)
+	}
+	p := purger.NewPurger(sourceCfg.Purge, sourceCfg.RelayDir, []purger.RelayOperator{relayOperator{r}, streamer.GetReaderHub()}, nil)
+	p.Start()
+
+	pResult := r.Process(ctx)
+
+	// 7. close the relay and the purger
+	p.Close()
+	r.Close()
+	if len(pResult.Errors) > 0 {
+		fmt.Printf("run relay error %v", pResult.Errors)
+		os.Exit(2)
+	}
+	log.L().Info("dm-relay exit")
+
+	// 8. flush log
+	if syncErr := log.L().Sync(); syncErr != nil {
+		fmt.Fprintln(os.Stderr, "sync log failed", syncErr)
+		os.Exit(1)
+	}
+}
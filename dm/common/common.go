@@ -22,16 +22,26 @@ import (
 	"github.com/pingcap/dm/pkg/terror"
 )
 
+// OperatorMetadataKey is the key of the gRPC metadata reporting the operator of a request, like the OS user of dmctl.
+const OperatorMetadataKey = "dm-operator"
+
 var (
 	useOfClosedErrMsg = "use of closed network connection"
 	// ClusterVersionKey is used to store the version of the cluster.
 	ClusterVersionKey = "/dm-cluster/version"
+	// KeySchemaVersionKey is used to store the version of the etcd key schema, see pkg/upgrade for the migrations.
+	KeySchemaVersionKey = "/dm-cluster/key-schema-version"
+	// ClusterUpgradeKey is used to store the progress of the rolling upgrade of the cluster, it exists during the upgrade.
+	ClusterUpgradeKey = "/dm-cluster/upgrade"
 	// WorkerRegisterKeyAdapter is used to encode and decode register key.
 	// k/v: Encode(worker-name) -> the information of the DM-worker node.
 	WorkerRegisterKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-worker/r/")
 	// WorkerKeepAliveKeyAdapter is used to encode and decode keepalive key.
 	// k/v: Encode(worker-name) -> time.
 	WorkerKeepAliveKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-worker/a/")
+	// WorkerLoadKeyAdapter is used to store the load and labels reported by DM-worker along with the keepalive.
+	// k/v: Encode(worker-name) -> the load of the DM-worker node.
+	WorkerLoadKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-worker/load/")
 	// LoadTaskKeyAdapter is used to store the worker which in load stage for the source of the subtask.
 	// k/v: Encode(task, source-id) -> worker-name.
 	LoadTaskKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/load-task/")
@@ -48,6 +58,9 @@ var (
 	// UpstreamRelayWorkerKeyAdapter is used to store the upstream which this worker needs to pull relay log
 	// k/v: Encode(worker-name) -> source-id.
 	UpstreamRelayWorkerKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/relay-worker/")
+	// UpstreamDisabledKeyAdapter is used to store the sources which are disabled by user.
+	// k/v: Encode(source-id) -> the information of the disabled source.
+	UpstreamDisabledKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/upstream/disabled/")
 	// UpstreamSubTaskKeyAdapter is used to store SubTask which are subscribing data from MySQL source.
 	// k/v: Encode(source-id, task-name) -> SubTaskConfig.
 	UpstreamSubTaskKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/upstream/subtask/")
@@ -57,6 +70,18 @@ var (
 	// StageSubTaskKeyAdapter is used to store the running stage of the subtask.
 	// k/v: Encode(source-id, task-name) -> the running stage of the subtask.
 	StageSubTaskKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/stage/subtask/")
+	// TaskLockKeyAdapter is used to store the locks of tasks held by operators.
+	// k/v: Encode(task-name) -> the lock of the task.
+	TaskLockKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/task-lock/")
+	// ProjectKeyAdapter is used to store the migration projects grouping the tasks.
+	// k/v: Encode(project-name) -> the project.
+	ProjectKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/project/")
+	// OperationAuditKeyAdapter is used to store the audit records of the mutating operations on DM-master.
+	// k/v: Encode(record-id) -> the record, the IDs are ordered by the time of the operations.
+	OperationAuditKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/operation-audit/")
+	// ProgressHistoryKeyAdapter is used to store the progress of the subtasks recorded periodically by DM-master.
+	// k/v: Encode(task-name, record-id) -> the progress of the subtasks, the IDs are ordered by the time of the records.
+	ProgressHistoryKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/progress-history/")
 
 	// ShardDDLPessimismInfoKeyAdapter is used to store shard DDL info in pessimistic model.
 	// k/v: Encode(task-name, source-id) -> shard DDL info.
@@ -93,12 +118,13 @@ var (
 func keyAdapterKeysLen(s KeyAdapter) int {
 	switch s {
 	case WorkerRegisterKeyAdapter, UpstreamConfigKeyAdapter, UpstreamBoundWorkerKeyAdapter,
-		WorkerKeepAliveKeyAdapter, StageRelayKeyAdapter,
-		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter:
+		WorkerKeepAliveKeyAdapter, WorkerLoadKeyAdapter, StageRelayKeyAdapter,
+		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter, UpstreamDisabledKeyAdapter, TaskLockKeyAdapter,
+		ProjectKeyAdapter, OperationAuditKeyAdapter:
 		return 1
 	case UpstreamSubTaskKeyAdapter, StageSubTaskKeyAdapter,
 		ShardDDLPessimismInfoKeyAdapter, ShardDDLPessimismOperationKeyAdapter,
-		ShardDDLOptimismSourceTablesKeyAdapter, LoadTaskKeyAdapter:
+		ShardDDLOptimismSourceTablesKeyAdapter, LoadTaskKeyAdapter, ProgressHistoryKeyAdapter:
 		return 2
 	case ShardDDLOptimismInitSchemaKeyAdapter:
 		return 3
@@ -0,0 +1,82 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+
+	"github.com/pingcap/tidb/parser/charset"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// CharsetConvertRule converts a charset of upstream to another one in downstream, it's used to migrate the legacy
+// upstreams whose charsets are rejected or mis-collated by the downstream.
+// the charsets and collations of the databases, tables and columns in DDLs are converted, and the string values of
+// the row changes are decoded into UTF-8 if `transcode` is set. the dumped data are always encoded in UTF-8.
+type CharsetConvertRule struct {
+	From string `yaml:"from" toml:"from" json:"from"`
+	To   string `yaml:"to" toml:"to" json:"to"`
+	// Collation replaces the collations of `from`, the default collation of `to` is used if it's not specified.
+	Collation string `yaml:"collation" toml:"collation" json:"collation"`
+	// Transcode decodes the string values of the `from` columns into UTF-8 in row changes. don't set it if the values
+	// are already encoded in UTF-8 though the columns are declared as `from`, which is common in legacy applications.
+	Transcode bool `yaml:"transcode" toml:"transcode" json:"transcode"`
+}
+
+// Adjust lowercases the charsets and collation of the rule and verifies it, idx is the index of the rule in task config.
+func (r *CharsetConvertRule) Adjust(idx int) error {
+	r.From, r.To, r.Collation = strings.ToLower(r.From), strings.ToLower(r.To), strings.ToLower(r.Collation)
+	switch {
+	case r.From == "" || r.To == "":
+		return terror.ErrConfigInvalidCharsetConvert.Generate(idx, "from and to should not be empty")
+	case r.From == charset.CharsetBin || r.To == charset.CharsetBin:
+		return terror.ErrConfigInvalidCharsetConvert.Generate(idx, "binary can't be converted")
+	case !isKnownCharset(r.From):
+		return terror.ErrConfigInvalidCharsetConvert.Generate(idx, "unknown charset "+r.From)
+	case !isKnownCharset(r.To):
+		return terror.ErrConfigInvalidCharsetConvert.Generate(idx, "unknown charset "+r.To)
+	case r.From == r.To && r.Collation == "":
+		return terror.ErrConfigInvalidCharsetConvert.Generate(idx, "from and to are the same charset without collation")
+	}
+	if r.Collation != "" {
+		collation, err := charset.GetCollationByName(r.Collation)
+		if err != nil {
+			return terror.ErrConfigInvalidCharsetConvert.Generate(idx, err.Error())
+		}
+		if collation.CharsetName != r.To {
+			return terror.ErrConfigInvalidCharsetConvert.Generate(idx, "collation "+r.Collation+" doesn't belong to "+r.To)
+		}
+	}
+	if r.Transcode {
+		if enc, name := charset.Lookup(r.From); enc == nil || name == "utf-8" {
+			return terror.ErrConfigInvalidCharsetConvert.Generate(idx, "transcoding from "+r.From+" is not supported")
+		}
+	}
+	return nil
+}
+
+// CharsetConvertFunc returns the function converting a charset by the rules, it returns the charset and collation to
+// convert to, and false if the charset is not converted.
+func CharsetConvertFunc(rules []*CharsetConvertRule) func(cs string) (string, string, bool) {
+	return func(cs string) (string, string, bool) {
+		cs = strings.ToLower(cs)
+		for _, rule := range rules {
+			if rule.From == cs {
+				return rule.To, rule.Collation, true
+			}
+		}
+		return "", "", false
+	}
+}
@@ -61,6 +61,9 @@ type CheckerConfig struct {
 	CheckEnable     bool     `yaml:"check-enable" toml:"check-enable" json:"check-enable"`
 	BackoffRollback Duration `yaml:"backoff-rollback" toml:"backoff-rollback" json:"backoff-rollback"`
 	BackoffMax      Duration `yaml:"backoff-max" toml:"backoff-max" json:"backoff-max"`
+	// the max number of auto resumes of a subtask in an hour, the subtask enters crash loop and is not auto resumed
+	// until resumed manually if it's exhausted. 0 means no limit.
+	ResumeBudget int `yaml:"resume-budget,omitempty" toml:"resume-budget,omitempty" json:"resume-budget,omitempty"`
 	// unexpose config
 	CheckInterval Duration `yaml:"check-interval" toml:"check-interval" json:"-"`
 	BackoffMin    Duration `yaml:"backoff-min" toml:"backoff-min" json:"-"`
@@ -34,6 +34,9 @@ const (
 	TableSchemaChecking          = "table_schema"
 	ShardTableSchemaChecking     = "schema_of_shard_tables"
 	ShardAutoIncrementIDChecking = "auto_increment_ID"
+	TargetPrivilegeChecking      = "target_privilege"
+	TargetVersionChecking        = "target_version"
+	MaxAllowedPacketChecking     = "max_allowed_packet"
 )
 
 // AllCheckingItems contains all checking items.
@@ -49,6 +52,9 @@ var AllCheckingItems = map[string]string{
 	TableSchemaChecking:          "table schema compatibility checking item",
 	ShardTableSchemaChecking:     "consistent schema of shard tables checking item",
 	ShardAutoIncrementIDChecking: "conflict auto increment ID of shard tables checking item",
+	TargetPrivilegeChecking:      "privileges of target DB on the routed schemas checking item",
+	TargetVersionChecking:        "TiDB/MySQL version of target DB checking item",
+	MaxAllowedPacketChecking:     "max_allowed_packet of source and target DB checking item",
 }
 
 // MaxSourceIDLength is the max length for dm-worker source id.
@@ -0,0 +1,73 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// types of ColumnTransformation.
+const (
+	// ColumnTransformHash replaces the value with the hex encoded HMAC-SHA256 of the value keyed by `value`.
+	ColumnTransformHash = "hash"
+	// ColumnTransformNull replaces the value with NULL.
+	ColumnTransformNull = "null"
+	// ColumnTransformConstant replaces the value with `value`.
+	ColumnTransformConstant = "constant"
+	// ColumnTransformMask replaces the digits and letters of the value with pseudo-random digits and letters seeded by
+	// the HMAC-SHA256 of the value keyed by `value`, other characters and the format of the value are kept.
+	ColumnTransformMask = "mask"
+)
+
+// ColumnTransformation represents a rule that transforms the values of some columns in row changes before they are
+// applied to downstream, it's used to replicate sensitive data into analytics clusters.
+// the transformations are deterministic, so the transformed values can still be used to join tables and identify rows.
+// NULL values are never transformed, and the expression filters are evaluated on the original values.
+type ColumnTransformation struct {
+	Schema  string   `yaml:"schema" toml:"schema" json:"schema"`
+	Table   string   `yaml:"table" toml:"table" json:"table"`
+	Columns []string `yaml:"columns" toml:"columns" json:"columns"`
+	Type    string   `yaml:"type" toml:"type" json:"type"`
+	// Value is the constant of `constant`, or the salt of `hash` and `mask`.
+	Value string `yaml:"value" toml:"value" json:"value"`
+	// KeepPrefix and KeepSuffix are the numbers of characters not masked at the beginning and the end of the value,
+	// only used in `mask`.
+	KeepPrefix int `yaml:"keep-prefix" toml:"keep-prefix" json:"keep-prefix"`
+	KeepSuffix int `yaml:"keep-suffix" toml:"keep-suffix" json:"keep-suffix"`
+}
+
+// Verify does verification on the column transformation.
+func (t *ColumnTransformation) Verify(name string) error {
+	if t.Schema == "" || t.Table == "" {
+		return terror.ErrConfigInvalidColumnTransformation.Generate(name, "schema and table should not be empty")
+	}
+	if len(t.Columns) == 0 {
+		return terror.ErrConfigInvalidColumnTransformation.Generate(name, "columns should not be empty")
+	}
+	if dupes := checkDuplicateString(t.Columns); len(dupes) > 0 {
+		return terror.ErrConfigInvalidColumnTransformation.Generate(name, "duplicate columns "+dupes[0])
+	}
+	switch t.Type {
+	case ColumnTransformHash, ColumnTransformNull, ColumnTransformConstant, ColumnTransformMask:
+	default:
+		return terror.ErrConfigInvalidColumnTransformation.Generate(name, "type "+t.Type+" not supported, should be one of hash, null, constant and mask")
+	}
+	if t.KeepPrefix < 0 || t.KeepSuffix < 0 {
+		return terror.ErrConfigInvalidColumnTransformation.Generate(name, "keep-prefix and keep-suffix should not be negative")
+	}
+	if (t.KeepPrefix > 0 || t.KeepSuffix > 0) && t.Type != ColumnTransformMask {
+		return terror.ErrConfigInvalidColumnTransformation.Generate(name, "keep-prefix and keep-suffix are only used in mask")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// the name of a TiDB resource group, it's case-insensitive.
+var resourceGroupNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]{1,32}$`)
+
+// DownstreamLabelConfig labels the downstream sessions of a task, so the downstream admins can attribute and throttle
+// the traffic of DM, like by the TiDB resource control.
+type DownstreamLabelConfig struct {
+	// prefix `/* dm-task:<task-name> */` to the statements executed in the downstream
+	Comment bool `yaml:"comment" toml:"comment" json:"comment"`
+	// bind the downstream sessions to the TiDB resource group by `SET RESOURCE GROUP`, TiDB v7.1.0 or later is required
+	ResourceGroup string `yaml:"resource-group" toml:"resource-group" json:"resource-group"`
+}
+
+// SessionLabel is the label applied to every connection of a DB, it's generated from DownstreamLabelConfig.
+type SessionLabel struct {
+	// the comment prefixed to the statements, with a trailing space
+	Comment       string
+	ResourceGroup string
+}
+
+// verify verifies the downstream label config.
+func (c *DownstreamLabelConfig) verify() error {
+	if c.ResourceGroup != "" && !resourceGroupNameRegexp.MatchString(c.ResourceGroup) {
+		return terror.ErrConfigInvalidDownstreamLabel.Generate(fmt.Sprintf("`resource-group` %s should only contain letters, digits and underscores, and at most 32 characters", c.ResourceGroup))
+	}
+	return nil
+}
+
+// sessionLabel returns the label of the downstream sessions of task, nil if nothing is labeled.
+func (c *DownstreamLabelConfig) sessionLabel(task string) *SessionLabel {
+	if c == nil || (!c.Comment && c.ResourceGroup == "") {
+		return nil
+	}
+	label := &SessionLabel{ResourceGroup: c.ResourceGroup}
+	if c.Comment {
+		// the task name can't close the comment.
+		label.Comment = fmt.Sprintf("/* dm-task:%s */ ", strings.ReplaceAll(task, "*/", "*\\/"))
+	}
+	return label
+}
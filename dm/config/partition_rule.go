@@ -0,0 +1,54 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// modes of PartitionRule.
+const (
+	// PartitionModeCheck checks every row change has a partition in the downstream table before writing it.
+	PartitionModeCheck = "check"
+	// PartitionModeHint checks as PartitionModeCheck, and writes the row change to its partitions by the
+	// `PARTITION (p...)` hint.
+	PartitionModeHint = "hint"
+)
+
+// PartitionRule declares a downstream partitioned table, the partitions of its row changes are located by DM according
+// to the partition definitions of the downstream table, so the rows destined for a non-existent partition are found
+// before writing with precise errors.
+// only the tables partitioned by RANGE, RANGE COLUMNS, LIST or LIST COLUMNS on a single integer column are supported.
+type PartitionRule struct {
+	// TargetSchema and TargetTable are the names of the downstream table after routing.
+	TargetSchema string `yaml:"target-schema" toml:"target-schema" json:"target-schema"`
+	TargetTable  string `yaml:"target-table" toml:"target-table" json:"target-table"`
+	// Mode is one of `check` and `hint`, default is `check`.
+	Mode string `yaml:"mode" toml:"mode" json:"mode"`
+}
+
+// Adjust sets the default values of the partition rule and verifies it, idx is the index of the rule in task config.
+func (r *PartitionRule) Adjust(idx int) error {
+	if r.TargetSchema == "" || r.TargetTable == "" {
+		return terror.ErrConfigInvalidPartitionRule.Generate(idx, "target-schema and target-table should not be empty")
+	}
+	switch r.Mode {
+	case "":
+		r.Mode = PartitionModeCheck
+	case PartitionModeCheck, PartitionModeHint:
+	default:
+		return terror.ErrConfigInvalidPartitionRule.Generate(idx, "mode "+r.Mode+" not supported, should be one of check and hint")
+	}
+	return nil
+}
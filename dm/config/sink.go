@@ -0,0 +1,95 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// sink types.
+const (
+	SinkTypeKafka = "kafka"
+)
+
+// sink message protocols.
+const (
+	SinkProtocolCanalJSON = "canal-json"
+	SinkProtocolMaxwell   = "maxwell"
+	// SinkProtocolAvro encodes the messages in the Confluent wire format with the schemas in a schema registry.
+	SinkProtocolAvro = "avro"
+)
+
+const (
+	// DefaultSinkTopic routes row changes of every target table to its own topic.
+	DefaultSinkTopic           = "{schema}_{table}"
+	defaultSinkMaxMessageBytes = 1024 * 1024
+)
+
+// SinkConfig is the configuration of a message queue sink.
+// When it's set, row changes and DDLs are emitted to the sink instead of being executed in the target database,
+// and the target database is only used to save the checkpoints.
+type SinkConfig struct {
+	Type     string `yaml:"type" toml:"type" json:"type"`
+	Protocol string `yaml:"protocol" toml:"protocol" json:"protocol"`
+
+	// Kafka brokers, like ["127.0.0.1:9092"]
+	Brokers []string `yaml:"brokers" toml:"brokers" json:"brokers"`
+	// topic name for every target table, `{schema}` and `{table}` are replaced by the target schema/table name
+	Topic           string `yaml:"topic" toml:"topic" json:"topic"`
+	KafkaVersion    string `yaml:"kafka-version" toml:"kafka-version" json:"kafka-version"`
+	MaxMessageBytes int    `yaml:"max-message-bytes" toml:"max-message-bytes" json:"max-message-bytes"`
+	Compression     string `yaml:"compression" toml:"compression" json:"compression"`
+	// the URL of the Confluent schema registry, like "http://127.0.0.1:8081", it's required by avro
+	SchemaRegistry string `yaml:"schema-registry" toml:"schema-registry" json:"schema-registry"`
+}
+
+// adjust adjusts and verifies the sink config.
+func (c *SinkConfig) adjust(taskMode string) error {
+	if c.Type == "" {
+		c.Type = SinkTypeKafka
+	}
+	if c.Type != SinkTypeKafka {
+		return terror.ErrConfigSinkTypeNotSupport.Generate(c.Type)
+	}
+
+	if c.Protocol == "" {
+		c.Protocol = SinkProtocolCanalJSON
+	}
+	switch c.Protocol {
+	case SinkProtocolCanalJSON, SinkProtocolMaxwell:
+	case SinkProtocolAvro:
+		if c.SchemaRegistry == "" {
+			return terror.ErrConfigInvalidSink.Generate("`schema-registry` should not be empty for avro")
+		}
+	default:
+		return terror.ErrConfigSinkProtocolNotSupport.Generate(c.Protocol)
+	}
+
+	if len(c.Brokers) == 0 {
+		return terror.ErrConfigInvalidSink.Generate("`brokers` should not be empty")
+	}
+	// the full data can only be loaded into the target database.
+	if taskMode != ModeIncrement {
+		return terror.ErrConfigInvalidSink.Generate(fmt.Sprintf("task-mode %s is not supported, only `%s` is supported", taskMode, ModeIncrement))
+	}
+	if c.Topic == "" {
+		c.Topic = DefaultSinkTopic
+	}
+	if c.MaxMessageBytes <= 0 {
+		c.MaxMessageBytes = defaultSinkMaxMessageBytes
+	}
+	return nil
+}
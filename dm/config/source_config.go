@@ -19,8 +19,10 @@ import (
 	"database/sql"
 	_ "embed"
 	"encoding/json"
+	"fmt"
 	"math"
 	"math/rand"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -50,6 +52,7 @@ var getAllServerIDFunc = utils.GetAllServerID
 // SampleConfigFile is sample config file of source.
 // The embed source.yaml is a copy of dm/master/source.yaml, because embed
 // can only match regular files in the current directory and subdirectories.
+//
 //go:embed source.yaml
 var SampleConfigFile string
 
@@ -58,6 +61,144 @@ type PurgeConfig struct {
 	Interval    int64 `yaml:"interval" toml:"interval" json:"interval"`             // check whether need to purge at this @Interval (seconds)
 	Expires     int64 `yaml:"expires" toml:"expires" json:"expires"`                // if file's modified time is older than @Expires (hours), then it can be purged
 	RemainSpace int64 `yaml:"remain-space" toml:"remain-space" json:"remain-space"` // if remain space in @RelayBaseDir less than @RemainSpace (GB), then it can be purged
+
+	// if disk usage (percent) of @RelayBaseDir reaches @HighWatermark, purge relay log files until it drops to @LowWatermark
+	HighWatermark int64 `yaml:"high-watermark,omitempty" toml:"high-watermark" json:"high-watermark"`
+	LowWatermark  int64 `yaml:"low-watermark,omitempty" toml:"low-watermark" json:"low-watermark"`
+	// max bytes of relay log files purged by watermarks in one check, 0 means no limit
+	TargetFreeBytes int64 `yaml:"target-free-bytes,omitempty" toml:"target-free-bytes" json:"target-free-bytes"`
+}
+
+// Verify verifies the purge config.
+func (c *PurgeConfig) Verify() error {
+	if c.HighWatermark < 0 || c.HighWatermark > 100 || c.LowWatermark < 0 || c.LowWatermark > 100 {
+		return terror.ErrConfigInvalidPurge.Generate("`high-watermark` and `low-watermark` should be in [0, 100]")
+	}
+	if c.LowWatermark > 0 && c.HighWatermark == 0 {
+		return terror.ErrConfigInvalidPurge.Generate("`low-watermark` is set without `high-watermark`")
+	}
+	if c.HighWatermark > 0 && c.LowWatermark >= c.HighWatermark {
+		return terror.ErrConfigInvalidPurge.Generate("`low-watermark` should be less than `high-watermark`")
+	}
+	if c.TargetFreeBytes < 0 {
+		return terror.ErrConfigInvalidPurge.Generate("`target-free-bytes` should not be negative")
+	}
+	return nil
+}
+
+// RelayArchiveConfig is the configuration for archiving relay log events to a remote endpoint.
+type RelayArchiveConfig struct {
+	// HTTP(S) endpoint receiving the events, every event is POSTed as the body of a request, empty means disabled
+	URL string `yaml:"url,omitempty" toml:"url" json:"url"`
+	// max events waiting for archiving in memory, events beyond it are archived later from the relay log files
+	QueueSize int `yaml:"queue-size,omitempty" toml:"queue-size" json:"queue-size"`
+	// timeout of every archive request
+	Timeout Duration `yaml:"timeout,omitempty" toml:"timeout" json:"timeout"`
+}
+
+// Verify verifies the relay archive config.
+func (c *RelayArchiveConfig) Verify() error {
+	if c.URL == "" {
+		if c.QueueSize != 0 || c.Timeout.Duration != 0 {
+			return terror.ErrConfigInvalidRelayArchive.Generate("`url` should be set")
+		}
+		return nil
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return terror.ErrConfigInvalidRelayArchive.Generate("`url` should be a valid HTTP or HTTPS URL")
+	}
+	if c.QueueSize < 0 {
+		return terror.ErrConfigInvalidRelayArchive.Generate("`queue-size` should not be negative")
+	}
+	if c.Timeout.Duration < 0 {
+		return terror.ErrConfigInvalidRelayArchive.Generate("`timeout` should not be negative")
+	}
+	return nil
+}
+
+// RelayFileConfig is the configuration for rotating relay log files locally, independent of the upstream binlog files.
+// the events after rotating are written into segments of the relay log file named after the upstream binlog file.
+type RelayFileConfig struct {
+	// rotate to a new segment after a transaction if the current one reaches it (MB), 0 means disabled
+	MaxSize int64 `yaml:"max-size,omitempty" toml:"max-size" json:"max-size"`
+	// rotate to a new segment after a transaction if the current one is opened for it, 0 means disabled
+	MaxAge Duration `yaml:"max-age,omitempty" toml:"max-age" json:"max-age"`
+}
+
+// Verify verifies the relay file config.
+func (c *RelayFileConfig) Verify() error {
+	if c.MaxSize < 0 {
+		return terror.ErrConfigInvalidRelayFile.Generate("`max-size` should not be negative")
+	}
+	if c.MaxAge.Duration < 0 {
+		return terror.ErrConfigInvalidRelayFile.Generate("`max-age` should not be negative")
+	}
+	return nil
+}
+
+// RelayDiskCheckConfig is the configuration for checking the health of the disk of relay dir in the background,
+// the relay is paused with an error when the disk is unhealthy.
+type RelayDiskCheckConfig struct {
+	// check the disk at it, 0 means disabled
+	Interval Duration `yaml:"interval,omitempty" toml:"interval" json:"interval"`
+	// the disk is unhealthy if the space usage (percent) reaches it, 0 means not checked
+	MaxUsage int64 `yaml:"max-usage,omitempty" toml:"max-usage" json:"max-usage"`
+	// the disk is unhealthy if the inode usage (percent) reaches it, 0 means not checked
+	MaxInodeUsage int64 `yaml:"max-inode-usage,omitempty" toml:"max-inode-usage" json:"max-inode-usage"`
+	// the disk is unhealthy if writing and syncing a probe file takes longer than it, 0 means not checked.
+	// the disk is always unhealthy if the probe file can't be written, like the disk is remounted as read-only
+	MaxWriteLatency Duration `yaml:"max-write-latency,omitempty" toml:"max-write-latency" json:"max-write-latency"`
+}
+
+// Verify verifies the relay disk check config.
+func (c *RelayDiskCheckConfig) Verify() error {
+	if c.Interval.Duration < 0 {
+		return terror.ErrConfigInvalidRelayDiskCheck.Generate("`interval` should not be negative")
+	}
+	if c.MaxUsage < 0 || c.MaxUsage > 100 || c.MaxInodeUsage < 0 || c.MaxInodeUsage > 100 {
+		return terror.ErrConfigInvalidRelayDiskCheck.Generate("`max-usage` and `max-inode-usage` should be in [0, 100]")
+	}
+	if c.MaxWriteLatency.Duration < 0 {
+		return terror.ErrConfigInvalidRelayDiskCheck.Generate("`max-write-latency` should not be negative")
+	}
+	return nil
+}
+
+// the policies when a relay transformer fails.
+const (
+	// RelayTransformerOnErrorSkip passes the event through unchanged by the failed transformer.
+	RelayTransformerOnErrorSkip = "skip"
+	// RelayTransformerOnErrorFail stops the relay with the error.
+	RelayTransformerOnErrorFail = "fail"
+)
+
+// RelayTransformerConfig is the configuration for a transformer in the chain of transforming relay log events.
+type RelayTransformerConfig struct {
+	// name of the registered transformer, like `checksum`, `filter`, `anonymize` and `annotate`
+	Name string `yaml:"name" toml:"name" json:"name"`
+	// `skip` (default) or `fail` when the transformer fails
+	OnError string `yaml:"on-error,omitempty" toml:"on-error" json:"on-error"`
+	// params of the transformer
+	Params map[string]string `yaml:"params,omitempty" toml:"params" json:"params"`
+}
+
+// verifyRelayTransformers verifies the relay transformers config and sets the default values.
+func verifyRelayTransformers(cfgs []RelayTransformerConfig) error {
+	for i := range cfgs {
+		if cfgs[i].Name == "" {
+			return terror.ErrConfigInvalidRelayTransformer.Generate("`name` should be set")
+		}
+		switch cfgs[i].OnError {
+		case "":
+			cfgs[i].OnError = RelayTransformerOnErrorSkip
+		case RelayTransformerOnErrorSkip, RelayTransformerOnErrorFail:
+		default:
+			return terror.ErrConfigInvalidRelayTransformer.Generate(fmt.Sprintf("`on-error` %s of %s should be `%s` or `%s`",
+				cfgs[i].OnError, cfgs[i].Name, RelayTransformerOnErrorSkip, RelayTransformerOnErrorFail))
+		}
+	}
+	return nil
 }
 
 // SourceConfig is the configuration for source.
@@ -82,6 +223,24 @@ type SourceConfig struct {
 	// config items for purger
 	Purge PurgeConfig `yaml:"purge" toml:"purge" json:"purge"`
 
+	// config items for archiving relay log events
+	RelayArchive RelayArchiveConfig `yaml:"relay-archive,omitempty" toml:"relay-archive" json:"relay-archive"`
+
+	// config items for rotating relay log files locally
+	RelayFile RelayFileConfig `yaml:"relay-file,omitempty" toml:"relay-file" json:"relay-file"`
+
+	// for checking the health of the disk of relay dir
+	RelayDiskCheck RelayDiskCheckConfig `yaml:"relay-disk-check,omitempty" toml:"relay-disk-check" json:"relay-disk-check"`
+
+	// the chain of transformers for relay log events, applied in order
+	RelayTransformers []RelayTransformerConfig `yaml:"relay-transformers,omitempty" toml:"relay-transformers" json:"relay-transformers"`
+
+	// the max bandwidth (MB/s) of reading binlog from the upstream by relay and syncer, 0 means no limit
+	NetRateLimit int64 `yaml:"net-rate-limit,omitempty" toml:"net-rate-limit" json:"net-rate-limit"`
+
+	// the labels of the workers which this source is preferred to be bound to, used by the `label-affinity` scheduler policy
+	WorkerLabels map[string]string `yaml:"worker-labels,omitempty" toml:"worker-labels" json:"worker-labels"`
+
 	// config items for task status checker
 	Checker CheckerConfig `yaml:"checker" toml:"checker" json:"checker"`
 
@@ -224,8 +383,39 @@ func (c *SourceConfig) Verify() error {
 	if c.Checker.BackoffMax.Duration < c.Checker.BackoffMin.Duration {
 		return terror.ErrConfigCheckerMaxTooSmall.Generate(c.Checker.BackoffMax.Duration, c.Checker.BackoffMin.Duration)
 	}
+	if c.Checker.ResumeBudget < 0 {
+		return terror.ErrConfigInvalidResumeBudget.Generate(c.Checker.ResumeBudget)
+	}
 
-	return nil
+	if err = c.From.VerifyConnOptions(); err != nil {
+		return err
+	}
+
+	if err = c.From.Tunnel.Verify(); err != nil {
+		return err
+	}
+
+	if err = c.RelayArchive.Verify(); err != nil {
+		return err
+	}
+
+	if err = c.RelayFile.Verify(); err != nil {
+		return err
+	}
+
+	if err = c.RelayDiskCheck.Verify(); err != nil {
+		return err
+	}
+
+	if err = verifyRelayTransformers(c.RelayTransformers); err != nil {
+		return err
+	}
+
+	if c.NetRateLimit < 0 {
+		return terror.ErrConfigInvalidNetRateLimit.Generate(c.NetRateLimit)
+	}
+
+	return c.Purge.Verify()
 }
 
 // DecryptPassword returns a decrypted config replica in config.
@@ -417,8 +607,14 @@ type SourceConfigForDowngrade struct {
 	ServerID        uint32                 `yaml:"server-id"`
 	Tracer          map[string]interface{} `yaml:"tracer"`
 	// any new config item, we mark it omitempty
-	CaseSensitive bool                  `yaml:"case-sensitive,omitempty"`
-	Filters       []*bf.BinlogEventRule `yaml:"filters,omitempty"`
+	CaseSensitive     bool                     `yaml:"case-sensitive,omitempty"`
+	Filters           []*bf.BinlogEventRule    `yaml:"filters,omitempty"`
+	RelayArchive      RelayArchiveConfig       `yaml:"relay-archive,omitempty"`
+	RelayFile         RelayFileConfig          `yaml:"relay-file,omitempty"`
+	NetRateLimit      int64                    `yaml:"net-rate-limit,omitempty"`
+	WorkerLabels      map[string]string        `yaml:"worker-labels,omitempty"`
+	RelayTransformers []RelayTransformerConfig `yaml:"relay-transformers,omitempty"`
+	RelayDiskCheck    RelayDiskCheckConfig     `yaml:"relay-disk-check,omitempty"`
 }
 
 // NewSourceConfigForDowngrade creates a new base config for downgrade.
@@ -442,6 +638,13 @@ func NewSourceConfigForDowngrade(sourceCfg *SourceConfig) *SourceConfigForDowngr
 		Tracer:          sourceCfg.Tracer,
 		CaseSensitive:   sourceCfg.CaseSensitive,
 		Filters:         sourceCfg.Filters,
+		RelayArchive:    sourceCfg.RelayArchive,
+		RelayFile:       sourceCfg.RelayFile,
+		NetRateLimit:    sourceCfg.NetRateLimit,
+		WorkerLabels:    sourceCfg.WorkerLabels,
+
+		RelayTransformers: sourceCfg.RelayTransformers,
+		RelayDiskCheck:    sourceCfg.RelayDiskCheck,
 	}
 }
 
@@ -28,6 +28,7 @@ import (
 	. "github.com/pingcap/check"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
 
+	"github.com/pingcap/dm/pkg/terror"
 	"github.com/pingcap/dm/pkg/utils"
 )
 
@@ -223,6 +224,97 @@ func (t *testConfig) TestConfigVerify(c *C) {
 			},
 			"",
 		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.MaxOpenConns = 8
+				cfg.From.MaxIdleConns = 4
+				cfg.From.DialTimeout = "3s"
+				cfg.From.ReadTimeout = "1m"
+				return cfg
+			},
+			"",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.MaxOpenConns = -1
+				return cfg
+			},
+			".*invalid connection option max-open-conns of database .*: should not be negative.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.WriteTimeout = "30"
+				return cfg
+			},
+			".*invalid connection option write-timeout of database .*: 30.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.DialTimeout = "-1s"
+				return cfg
+			},
+			".*invalid connection option dial-timeout of database .*: should be positive.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.NetRateLimit = 10
+				return cfg
+			},
+			"",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.NetRateLimit = -1
+				return cfg
+			},
+			".*invalid net-rate-limit -1, it should not be negative.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.Tunnel = &TunnelConfig{Type: TunnelSSH, Host: "192.168.0.1", Port: 22, User: "dm", PrivateKey: "/home/dm/.ssh/id_rsa"}
+				return cfg
+			},
+			"",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.Tunnel = &TunnelConfig{Type: TunnelSSH, Host: "192.168.0.1", Port: 22, User: "dm"}
+				return cfg
+			},
+			".*invalid tunnel config: `password` or `private-key` should be set for SSH tunnel.*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.Tunnel = &TunnelConfig{Type: TunnelSOCKS5, Host: "192.168.0.1", Port: 1080}
+				return cfg
+			},
+			"",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.Tunnel = &TunnelConfig{Type: "http", Host: "192.168.0.1", Port: 1080}
+				return cfg
+			},
+			".*invalid tunnel config: `type` should be ssh or socks5, but got \"http\".*",
+		},
+		{
+			func() *SourceConfig {
+				cfg := newConfig()
+				cfg.From.Tunnel = &TunnelConfig{Type: TunnelSOCKS5, Host: "192.168.0.1"}
+				return cfg
+			},
+			".*invalid tunnel config: `port` 0 should be in \\[1, 65535\\].*",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -238,6 +330,95 @@ func (t *testConfig) TestConfigVerify(c *C) {
 	}
 }
 
+func (t *testConfig) TestPurgeConfigVerify(c *C) {
+	testCases := []struct {
+		cfg         PurgeConfig
+		errorFormat string
+	}{
+		{PurgeConfig{}, ""},
+		{PurgeConfig{HighWatermark: 85, LowWatermark: 70, TargetFreeBytes: 1024}, ""},
+		{PurgeConfig{HighWatermark: 85}, ""},
+		{PurgeConfig{HighWatermark: 101}, ".*`high-watermark` and `low-watermark` should be in \\[0, 100\\].*"},
+		{PurgeConfig{HighWatermark: 85, LowWatermark: -1}, ".*`high-watermark` and `low-watermark` should be in \\[0, 100\\].*"},
+		{PurgeConfig{LowWatermark: 70}, ".*`low-watermark` is set without `high-watermark`.*"},
+		{PurgeConfig{HighWatermark: 70, LowWatermark: 70}, ".*`low-watermark` should be less than `high-watermark`.*"},
+		{PurgeConfig{TargetFreeBytes: -1}, ".*`target-free-bytes` should not be negative.*"},
+	}
+
+	for _, tc := range testCases {
+		err := tc.cfg.Verify()
+		if tc.errorFormat != "" {
+			c.Assert(terror.ErrConfigInvalidPurge.Equal(err), IsTrue)
+			c.Assert(err, ErrorMatches, tc.errorFormat)
+		} else {
+			c.Assert(err, IsNil)
+		}
+	}
+}
+
+func (t *testConfig) TestRelayArchiveConfigVerify(c *C) {
+	testCases := []struct {
+		cfg         RelayArchiveConfig
+		errorFormat string
+	}{
+		{RelayArchiveConfig{}, ""},
+		{RelayArchiveConfig{URL: "http://127.0.0.1:8080/binlog", QueueSize: 1024, Timeout: Duration{time.Second}}, ""},
+		{RelayArchiveConfig{URL: "https://archiver.example.com/binlog"}, ""},
+		{RelayArchiveConfig{QueueSize: 1024}, ".*`url` should be set.*"},
+		{RelayArchiveConfig{URL: "127.0.0.1:8080"}, ".*`url` should be a valid HTTP or HTTPS URL.*"},
+		{RelayArchiveConfig{URL: "ftp://127.0.0.1/binlog"}, ".*`url` should be a valid HTTP or HTTPS URL.*"},
+		{RelayArchiveConfig{URL: "http://127.0.0.1:8080", QueueSize: -1}, ".*`queue-size` should not be negative.*"},
+		{RelayArchiveConfig{URL: "http://127.0.0.1:8080", Timeout: Duration{-time.Second}}, ".*`timeout` should not be negative.*"},
+	}
+
+	for _, tc := range testCases {
+		err := tc.cfg.Verify()
+		if tc.errorFormat != "" {
+			c.Assert(terror.ErrConfigInvalidRelayArchive.Equal(err), IsTrue)
+			c.Assert(err, ErrorMatches, tc.errorFormat)
+		} else {
+			c.Assert(err, IsNil)
+		}
+	}
+
+	// the zero value is omitted in YAML.
+	cfg := NewSourceConfig()
+	content, err := cfg.Yaml()
+	c.Assert(err, IsNil)
+	c.Assert(content, Not(Matches), "(?s).*relay-archive.*")
+}
+
+func (t *testConfig) TestRelayTransformersVerify(c *C) {
+	cfgs := []RelayTransformerConfig{
+		{Name: "checksum", OnError: RelayTransformerOnErrorFail},
+		{Name: "filter", Params: map[string]string{"event-types": "RowsQueryEvent"}},
+	}
+	c.Assert(verifyRelayTransformers(cfgs), IsNil)
+	c.Assert(cfgs[0].OnError, Equals, RelayTransformerOnErrorFail)
+	c.Assert(cfgs[1].OnError, Equals, RelayTransformerOnErrorSkip)
+
+	err := verifyRelayTransformers([]RelayTransformerConfig{{OnError: RelayTransformerOnErrorSkip}})
+	c.Assert(terror.ErrConfigInvalidRelayTransformer.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*`name` should be set.*")
+	err = verifyRelayTransformers([]RelayTransformerConfig{{Name: "checksum", OnError: "ignore"}})
+	c.Assert(terror.ErrConfigInvalidRelayTransformer.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*`on-error` ignore of checksum should be.*")
+
+	// parsed from YAML and kept in the downgrade config.
+	cfg, err := ParseYaml(`
+source-id: mysql-replica-01
+relay-transformers:
+  - name: anonymize
+    params:
+      mask: "#"
+`)
+	c.Assert(err, IsNil)
+	c.Assert(cfg.RelayTransformers, DeepEquals, []RelayTransformerConfig{
+		{Name: "anonymize", Params: map[string]string{"mask": "#"}},
+	})
+	c.Assert(NewSourceConfigForDowngrade(cfg).RelayTransformers, DeepEquals, cfg.RelayTransformers)
+}
+
 func (t *testConfig) TestSourceConfigForDowngrade(c *C) {
 	cfg, err := LoadFromFile(sourceSampleFile)
 	c.Assert(err, IsNil)
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
@@ -27,6 +28,7 @@ import (
 	"github.com/pingcap/tidb-tools/pkg/filter"
 	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	lcfg "github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/parser/charset"
 	"go.uber.org/zap"
 
 	"github.com/pingcap/dm/pkg/dumpling"
@@ -40,6 +42,8 @@ const (
 	ModeAll       = "all"
 	ModeFull      = "full"
 	ModeIncrement = "incremental"
+	// ModeSchemaOnly only dumps and creates the schemas and tables in downstream, then the task finishes.
+	ModeSchemaOnly = "schema-only"
 
 	DefaultShadowTableRules = "^_(.+)_(?:new|gho)$"
 	DefaultTrashTableRules  = "^_(.+)_(?:ghc|del|old)$"
@@ -91,14 +95,25 @@ type DBConfig struct {
 	Port     int    `toml:"port" json:"port" yaml:"port"`
 	User     string `toml:"user" json:"user" yaml:"user"`
 	Password string `toml:"password" json:"-" yaml:"password"` // omit it for privacy
-	// deprecated, mysql driver could automatically fetch this value
+	// mysql driver could automatically fetch this value from server when it's not set or 0
 	MaxAllowedPacket *int              `toml:"max-allowed-packet" json:"max-allowed-packet" yaml:"max-allowed-packet"`
 	Session          map[string]string `toml:"session" json:"session" yaml:"session"`
 
+	// connection pool and timeouts, they override the values chosen by DM for different usages if set.
+	MaxOpenConns int    `toml:"max-open-conns" json:"max-open-conns" yaml:"max-open-conns,omitempty"`
+	MaxIdleConns int    `toml:"max-idle-conns" json:"max-idle-conns" yaml:"max-idle-conns,omitempty"`
+	DialTimeout  string `toml:"dial-timeout" json:"dial-timeout" yaml:"dial-timeout,omitempty"`
+	ReadTimeout  string `toml:"read-timeout" json:"read-timeout" yaml:"read-timeout,omitempty"`
+	WriteTimeout string `toml:"write-timeout" json:"write-timeout" yaml:"write-timeout,omitempty"`
+
 	// security config
 	Security *Security `toml:"security" json:"security" yaml:"security"`
+	// connect to the database through an SSH bastion or a SOCKS5 proxy if set
+	Tunnel *TunnelConfig `toml:"tunnel" json:"tunnel" yaml:"tunnel,omitempty"`
 
 	RawDBCfg *RawDBConfig `toml:"-" json:"-" yaml:"-"`
+	// label every connection, set from `downstream-label` of the task for the downstream databases
+	SessionLabel *SessionLabel `toml:"-" json:"-" yaml:"-"`
 }
 
 func (db *DBConfig) String() string {
@@ -127,13 +142,63 @@ func (db *DBConfig) Decode(data string) error {
 
 // Adjust adjusts the config.
 func (db *DBConfig) Adjust() {
-	// force set session time zone to UTC here.
+	// set session time zone to UTC if not specified.
 	AdjustTargetDBTimeZone(db)
 	if len(db.Password) > 0 {
 		db.Password = utils.DecryptOrPlaintext(db.Password)
 	}
 }
 
+// TimeZone returns the session `time_zone` of the connections.
+func (db *DBConfig) TimeZone() string {
+	if tz, ok := db.sessionVar("time_zone"); ok {
+		return tz
+	}
+	return defaultTimeZone
+}
+
+// sessionVar gets the session variable, the name is case-insensitive.
+func (db *DBConfig) sessionVar(name string) (string, bool) {
+	for k, v := range db.Session {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// VerifyConnOptions verifies the connection pool and timeouts options.
+func (db *DBConfig) VerifyConnOptions() error {
+	if db.MaxAllowedPacket != nil && *db.MaxAllowedPacket < 0 {
+		return terror.ErrConfigInvalidDBConnOption.Generate("max-allowed-packet", db.Host, db.Port, "should not be negative")
+	}
+	if db.MaxOpenConns < 0 {
+		return terror.ErrConfigInvalidDBConnOption.Generate("max-open-conns", db.Host, db.Port, "should not be negative")
+	}
+	if db.MaxIdleConns < 0 {
+		return terror.ErrConfigInvalidDBConnOption.Generate("max-idle-conns", db.Host, db.Port, "should not be negative")
+	}
+	for _, opt := range []struct {
+		name, value string
+	}{
+		{"dial-timeout", db.DialTimeout},
+		{"read-timeout", db.ReadTimeout},
+		{"write-timeout", db.WriteTimeout},
+	} {
+		if opt.value == "" {
+			continue
+		}
+		d, err := time.ParseDuration(opt.value)
+		if err != nil {
+			return terror.ErrConfigInvalidDBConnOption.Delegate(err, opt.name, db.Host, db.Port, opt.value)
+		}
+		if d <= 0 {
+			return terror.ErrConfigInvalidDBConnOption.Generate(opt.name, db.Host, db.Port, "should be positive")
+		}
+	}
+	return nil
+}
+
 // Clone returns a deep copy of DBConfig. This function only fixes data race when adjusting Session.
 func (db *DBConfig) Clone() *DBConfig {
 	if db == nil {
@@ -155,6 +220,7 @@ func (db *DBConfig) Clone() *DBConfig {
 	}
 
 	clone.Security = db.Security.Clone()
+	clone.Tunnel = db.Tunnel.Clone()
 
 	if db.RawDBCfg != nil {
 		dbCfg := *(db.RawDBCfg)
@@ -243,20 +309,42 @@ type SubTaskConfig struct {
 	Timezone string `toml:"timezone" json:"timezone"`
 
 	Meta *Meta `toml:"meta" json:"meta"`
+	// StartTime is set by `start-task --start-time`, syncer starts from the first binlog event written at or after it
+	// instead of Meta for a fresh task. it's in RFC3339 format.
+	StartTime string `toml:"start-time" json:"start-time"`
+	// DryRun is set by `start-task --dry-run`, syncer writes the SQL statements to a file in the working directory of
+	// DM-worker instead of executing them in downstream, and the checkpoints are only kept in memory.
+	DryRun bool `toml:"dry-run" json:"dry-run"`
+	// Priority decides the order of recovering subtasks when dm-worker restarts, higher first.
+	Priority int `toml:"priority" json:"priority"`
+	// DownstreamLabel labels the sessions of To and Targets.
+	DownstreamLabel *DownstreamLabelConfig `toml:"downstream-label" json:"downstream-label"`
+	// CharsetConvert converts the charsets of upstream in DDLs and row changes.
+	CharsetConvert []*CharsetConvertRule `toml:"charset-convert" json:"charset-convert"`
 
 	// RelayDir get value from dm-worker config
 	RelayDir string `toml:"relay-dir" json:"relay-dir"`
 
+	// NetRateLimit get value from source config, the max bandwidth (MB/s) of reading binlog from the upstream directly
+	NetRateLimit int64 `toml:"net-rate-limit" json:"net-rate-limit"`
+
 	// UseRelay get value from dm-worker's relayEnabled
 	UseRelay bool            `toml:"use-relay" json:"use-relay"`
 	From     DBConfig        `toml:"from" json:"from"`
 	To       DBConfig        `toml:"to" json:"to"`
 	TiDB     TiDBExtraConfig `toml:"tidb" json:"tidb"`
+	Sink     *SinkConfig     `toml:"sink" json:"sink"`
+	Targets  []DBConfig      `toml:"targets" json:"targets"`
 
 	RouteRules         []*router.TableRule   `toml:"route-rules" json:"route-rules"`
 	FilterRules        []*bf.BinlogEventRule `toml:"filter-rules" json:"filter-rules"`
 	ColumnMappingRules []*column.Rule        `toml:"mapping-rule" json:"mapping-rule"`
 	ExprFilter         []*ExpressionFilter   `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	// ColumnTransformations are applied in order, a column is transformed by the first matched transformation.
+	ColumnTransformations []*ColumnTransformation `yaml:"column-transformations" toml:"column-transformations" json:"column-transformations"`
+	PartitionRules        []*PartitionRule        `yaml:"partition-rules" toml:"partition-rules" json:"partition-rules"`
+	// BroadcastRouteRules route the tables to additional target tables, every rule is applied independently.
+	BroadcastRouteRules []*router.TableRule `toml:"broadcast-route-rules" json:"broadcast-route-rules"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList *filter.Rules `toml:"black-white-list" json:"black-white-list"`
@@ -287,6 +375,9 @@ type SubTaskConfig struct {
 
 	// which DM worker is running the subtask, this will be injected when the real worker starts running the subtask(StartSubTask).
 	WorkerName string `toml:"-" json:"-"`
+	// the size of the downstream connection pool shared by the subtasks on the DM worker, 0 means not shared. it's
+	// injected like WorkerName.
+	DownstreamPoolSize int `toml:"-" json:"-"`
 }
 
 // NewSubTaskConfig creates a new SubTaskConfig.
@@ -425,16 +516,174 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 		// if not ends with the task name, we append the task name to the tail
 		c.LoaderConfig.Dir += dirSuffix
 	}
+	switch c.LoaderConfig.CleanupPolicy {
+	case "", CleanupKeep, CleanupDelete:
+	case CleanupArchive:
+		if c.LoaderConfig.ArchiveDir == "" {
+			return terror.ErrConfigInvalidCleanupPolicy.Generate(c.LoaderConfig.CleanupPolicy, "`archive-dir` is not specified")
+		}
+	case CleanupUpload:
+		if c.LoaderConfig.ArchiveStorage == "" {
+			return terror.ErrConfigInvalidCleanupPolicy.Generate(c.LoaderConfig.CleanupPolicy, "`archive-storage` is not specified")
+		}
+	default:
+		return terror.ErrConfigInvalidCleanupPolicy.Generate(c.LoaderConfig.CleanupPolicy, "unknown policy")
+	}
+	if err := c.adjustSchemaCharset(); err != nil {
+		return err
+	}
 
 	if c.SyncerConfig.QueueSize == 0 {
 		c.SyncerConfig.QueueSize = defaultQueueSize
 	}
+	if c.SyncerConfig.WorkerCount == 0 {
+		c.SyncerConfig.WorkerCount = defaultWorkerCount
+	}
+	if c.SyncerConfig.WorkerCount < 0 || c.SyncerConfig.QueueSize < 0 || c.SyncerConfig.MemoryQuota < 0 {
+		return terror.ErrConfigInvalidSyncerResource.Generate(c.SyncerConfig.WorkerCount, c.SyncerConfig.QueueSize, c.SyncerConfig.MemoryQuota)
+	}
+	if c.SyncerConfig.DDLTimeout < 0 {
+		return terror.ErrConfigInvalidDDLTimeout.Generate(c.SyncerConfig.DDLTimeout)
+	}
+	if c.SyncerConfig.FillMissingColumnsRateLimit < 0 {
+		return terror.ErrConfigInvalidFillRateLimit.Generate(c.SyncerConfig.FillMissingColumnsRateLimit)
+	}
+	if c.SyncerConfig.MinimalRowImage {
+		switch {
+		case c.SyncerConfig.FillMissingColumns:
+			return terror.ErrConfigMinimalRowImageConflict.Generate("`fill-missing-columns`")
+		case c.SyncerConfig.Compact:
+			return terror.ErrConfigMinimalRowImageConflict.Generate("`compact`")
+		case c.Sink != nil:
+			return terror.ErrConfigMinimalRowImageConflict.Generate("a sink")
+		case c.SyncerConfig.HookPlugin != "":
+			return terror.ErrConfigMinimalRowImageConflict.Generate("`hook-plugin`")
+		}
+	}
+	if c.SyncerConfig.ExactlyOnce {
+		switch {
+		case c.Sink != nil:
+			return terror.ErrConfigExactlyOnceConflict.Generate("a sink")
+		case len(c.Targets) > 0:
+			return terror.ErrConfigExactlyOnceConflict.Generate("`targets`")
+		case c.SyncerConfig.SlowDigestThreshold > 0:
+			return terror.ErrConfigExactlyOnceConflict.Generate("`slow-digest-threshold`")
+		case c.SyncerConfig.MemoryQuota > 0:
+			// the quota of the buffered row changes is released after they are executed, which never happens if the
+			// transaction is larger than the quota.
+			return terror.ErrConfigExactlyOnceConflict.Generate("`memory-quota`")
+		}
+		c.SyncerConfig.WorkerCount = 1
+	}
+	if c.SyncerConfig.ShardVerifyInterval < 0 {
+		return terror.ErrConfigInvalidShardVerify.Generate(fmt.Sprintf("`shard-verify-interval` %d is negative", c.SyncerConfig.ShardVerifyInterval))
+	}
+	if c.SyncerConfig.ShardConflictSampleRate < 0 || c.SyncerConfig.ShardConflictSampleRate > 100 {
+		return terror.ErrConfigInvalidShardVerify.Generate(fmt.Sprintf("`shard-conflict-sample-rate` %d is out of [0, 100]", c.SyncerConfig.ShardConflictSampleRate))
+	}
 	if c.SyncerConfig.CheckpointFlushInterval == 0 {
 		c.SyncerConfig.CheckpointFlushInterval = defaultCheckpointFlushInterval
 	}
+	if c.SyncerConfig.MaxRowSize < 0 {
+		return terror.ErrConfigInvalidMaxRowSize.Generate(c.SyncerConfig.MaxRowSize)
+	}
+	switch c.SyncerConfig.OversizedRowPolicy {
+	case "", OversizedRowFail, OversizedRowTruncate, OversizedRowSideTable:
+	default:
+		return terror.ErrConfigOversizedRowPolicyNotSupport.Generate(c.SyncerConfig.OversizedRowPolicy)
+	}
+	switch c.SyncerConfig.GeneratedColumnMismatch {
+	case "", GeneratedColumnMismatchError, GeneratedColumnMismatchSkip:
+	default:
+		return terror.ErrConfigGeneratedColumnMismatchNotSupport.Generate(c.SyncerConfig.GeneratedColumnMismatch)
+	}
+	switch c.SyncerConfig.LoadDataPolicy {
+	case "", LoadDataSkip, LoadDataError, LoadDataReconstruct:
+	default:
+		return terror.ErrConfigLoadDataPolicyNotSupport.Generate(c.SyncerConfig.LoadDataPolicy)
+	}
+	switch c.SyncerConfig.PartitionDDLPolicy {
+	case "", PartitionDDLPass, PartitionDDLDrop:
+	case PartitionDDLConvert:
+		// the converted DELETE statements can't be coordinated among the shard tables or emitted as DDL messages.
+		if c.ShardMode != "" || c.Sink != nil {
+			return terror.ErrConfigInvalidPartitionDDLPolicy.Generate(c.SyncerConfig.PartitionDDLPolicy,
+				"can't be used in shard mode or with a sink, please use `drop` instead")
+		}
+	default:
+		return terror.ErrConfigInvalidPartitionDDLPolicy.Generate(c.SyncerConfig.PartitionDDLPolicy, "not supported")
+	}
+	if c.SyncerConfig.DDLWindow != "" {
+		if _, err := ParseDDLWindow(c.SyncerConfig.DDLWindow); err != nil {
+			return err
+		}
+		switch c.SyncerConfig.DDLWindowPolicy {
+		case "":
+			c.SyncerConfig.DDLWindowPolicy = DDLWindowBuffer
+			fallthrough
+		case DDLWindowBuffer:
+			// the DMLs of the table are buffered like the quarantined tables.
+			if c.ShardMode != "" || c.Sink != nil {
+				return terror.ErrConfigInvalidDDLWindow.Generate(c.SyncerConfig.DDLWindow,
+					"`buffer` policy can't be used in shard mode or with a sink, please use `block` instead")
+			}
+		case DDLWindowBlock:
+		default:
+			return terror.ErrConfigInvalidDDLWindow.Generate(c.SyncerConfig.DDLWindow, fmt.Sprintf("unknown policy %s", c.SyncerConfig.DDLWindowPolicy))
+		}
+	}
+	if c.SyncerConfig.SyncWindow != "" {
+		if _, err := ParseSyncWindow(c.SyncerConfig.SyncWindow); err != nil {
+			return err
+		}
+	}
+	switch c.SyncerConfig.OnTableRecreate {
+	case "", TableRecreateCheck:
+	case TableRecreateRedump:
+		if c.ShardMode != "" {
+			// the downstream table is merged from the shard tables.
+			return terror.ErrConfigTableRecreateActionNotSupport.Generate(c.SyncerConfig.OnTableRecreate, "can't be used in shard mode")
+		}
+	default:
+		return terror.ErrConfigTableRecreateActionNotSupport.Generate(c.SyncerConfig.OnTableRecreate, "unknown action")
+	}
+	if c.SyncerConfig.ErrorBudget < 0 || c.SyncerConfig.ErrorBudgetWindow < 0 {
+		return terror.ErrConfigInvalidErrorBudget.Generate(c.SyncerConfig.ErrorBudget, c.SyncerConfig.ErrorBudgetWindow)
+	}
+	if c.SyncerConfig.ErrorBudget > 0 && c.SyncerConfig.ErrorBudgetWindow == 0 {
+		c.SyncerConfig.ErrorBudgetWindow = defaultErrorBudgetWindow
+	}
+	if c.Sink != nil {
+		if err := c.Sink.adjust(c.Mode); err != nil {
+			return err
+		}
+	}
+	if err := c.adjustTargets(); err != nil {
+		return err
+	}
+	if err := c.adjustDownstreamLabel(); err != nil {
+		return err
+	}
+	if c.StartTime != "" {
+		if c.Mode != ModeIncrement {
+			return terror.ErrConfigInvalidStartTime.Generate(c.StartTime, fmt.Sprintf("task-mode %s is not supported", c.Mode))
+		}
+		if _, err := ParseStartTime(c.StartTime); err != nil {
+			return err
+		}
+	}
+	if c.DryRun {
+		if err := c.VerifyDryRun(); err != nil {
+			return err
+		}
+	}
 
 	c.From.Adjust()
 	c.To.Adjust()
+	// all units migrate the timestamps in the session time zone of the target database.
+	if _, err := utils.ParseTimeZone(c.To.TimeZone()); err != nil {
+		return err
+	}
 
 	if verifyDecryptPassword {
 		_, err1 := c.DecryptPassword()
@@ -454,6 +703,9 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	if _, err := router.NewTableRouter(c.CaseSensitive, c.RouteRules); err != nil {
 		return terror.ErrConfigGenTableRouter.Delegate(err)
 	}
+	if err := c.adjustBroadcastRoutes(); err != nil {
+		return err
+	}
 	// NewMapping will fill arguments with the default values.
 	if _, err := column.NewMapping(c.CaseSensitive, c.ColumnMappingRules); err != nil {
 		return terror.ErrConfigGenColumnMapping.Delegate(err)
@@ -510,6 +762,169 @@ func (c *SubTaskConfig) Parse(arguments []string, verifyDecryptPassword bool) er
 	return c.Adjust(verifyDecryptPassword)
 }
 
+// VerifyDryRun verifies the subtask can be run by `start-task --dry-run`, the options writing downstream by
+// themselves are not supported.
+func (c *SubTaskConfig) VerifyDryRun() error {
+	switch {
+	case c.Mode != ModeIncrement:
+		return terror.ErrConfigDryRunConflict.Generate(fmt.Sprintf("task-mode %s", c.Mode))
+	case c.ShardMode != "":
+		return terror.ErrConfigDryRunConflict.Generate("`shard-mode`")
+	case c.OnlineDDL:
+		return terror.ErrConfigDryRunConflict.Generate("`online-ddl`")
+	case c.Sink != nil:
+		return terror.ErrConfigDryRunConflict.Generate("a sink")
+	case len(c.Targets) > 0:
+		return terror.ErrConfigDryRunConflict.Generate("`targets`")
+	case c.SyncerConfig.ExactlyOnce:
+		return terror.ErrConfigDryRunConflict.Generate("`exactly-once`")
+	case c.SyncerConfig.ApplySummaryInterval > 0:
+		return terror.ErrConfigDryRunConflict.Generate("`apply-summary-interval`")
+	case c.SyncerConfig.OversizedRowPolicy == OversizedRowSideTable:
+		return terror.ErrConfigDryRunConflict.Generate("`oversized-row-policy: side-table`")
+	}
+	return nil
+}
+
+// ParseStartTime parses the start time of `start-task --start-time`, it should be in the format of `2006-01-02 15:04:05`
+// in the local time zone, or in RFC3339 format.
+func ParseStartTime(startTime string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", startTime, time.Local); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return t, terror.ErrConfigInvalidStartTime.Delegate(err, startTime, "wrong format")
+	}
+	return t, nil
+}
+
+// adjustBroadcastRoutes verifies the broadcast routes, every rule generates its own table router.
+func (c *SubTaskConfig) adjustBroadcastRoutes() error {
+	if len(c.BroadcastRouteRules) == 0 {
+		return nil
+	}
+	if c.Sink != nil {
+		return terror.ErrConfigInvalidBroadcastRoute.Generate("broadcast-route-rules", "can't be used together with `sink`")
+	}
+	// the full data can only be loaded into the target tables of `routes`.
+	if c.Mode != ModeIncrement {
+		return terror.ErrConfigInvalidBroadcastRoute.Generate("broadcast-route-rules", fmt.Sprintf("task-mode %s is not supported, only `%s` is supported", c.Mode, ModeIncrement))
+	}
+	for i, rule := range c.BroadcastRouteRules {
+		if rule == nil {
+			return terror.ErrConfigInvalidBroadcastRoute.Generate(fmt.Sprintf("#%d", i), "rule should not be empty")
+		}
+		if _, err := router.NewTableRouter(c.CaseSensitive, []*router.TableRule{rule}); err != nil {
+			return terror.ErrConfigInvalidBroadcastRoute.Delegate(err, fmt.Sprintf("#%d", i), "fail to generate table router")
+		}
+	}
+	return nil
+}
+
+// adjustTargets adjusts and verifies the additional downstream databases.
+func (c *SubTaskConfig) adjustTargets() error {
+	if len(c.Targets) == 0 {
+		return nil
+	}
+	if c.Sink != nil {
+		return terror.ErrConfigInvalidTargets.Generate("`targets` can't be used together with `sink`")
+	}
+	// the full data can only be loaded into the target database.
+	if c.Mode != ModeIncrement {
+		return terror.ErrConfigInvalidTargets.Generate(fmt.Sprintf("task-mode %s is not supported, only `%s` is supported", c.Mode, ModeIncrement))
+	}
+	// the syncers of the replica targets don't coordinate the shard DDLs.
+	if c.ShardMode != "" {
+		return terror.ErrConfigInvalidTargets.Generate("`targets` can't be used together with `shard-mode`")
+	}
+
+	addrs := map[string]struct{}{
+		fmt.Sprintf("%s:%d", c.To.Host, c.To.Port): {},
+	}
+	for i := range c.Targets {
+		target := &c.Targets[i]
+		if target.Host == "" {
+			return terror.ErrConfigInvalidTargets.Generate("`host` of target should not be empty")
+		}
+		addr := fmt.Sprintf("%s:%d", target.Host, target.Port)
+		if _, ok := addrs[addr]; ok {
+			return terror.ErrConfigInvalidTargets.Generate(fmt.Sprintf("target %s is duplicated", addr))
+		}
+		addrs[addr] = struct{}{}
+		// the timestamps in binlog are formatted in the session time zone of `target-database`.
+		if tz, ok := target.sessionVar("time_zone"); !ok {
+			if target.Session == nil {
+				target.Session = make(map[string]string, 1)
+			}
+			target.Session["time_zone"] = c.To.TimeZone()
+		} else if tz != c.To.TimeZone() {
+			return terror.ErrConfigInvalidTargets.Generate(fmt.Sprintf("session time_zone %s of target %s is different from %s of target-database", tz, addr, c.To.TimeZone()))
+		}
+		target.Adjust()
+	}
+	return nil
+}
+
+// adjustDownstreamLabel verifies the downstream label and applies it to the downstream databases.
+func (c *SubTaskConfig) adjustDownstreamLabel() error {
+	if c.DownstreamLabel == nil {
+		return nil
+	}
+	if err := c.DownstreamLabel.verify(); err != nil {
+		return err
+	}
+	c.applyDownstreamLabel()
+	return nil
+}
+
+// adjustSchemaCharset verifies the charset and collation to rewrite the created schemas, the charset is derived from the
+// collation if only the collation is specified.
+func (c *SubTaskConfig) adjustSchemaCharset() error {
+	cs, co := strings.ToLower(c.LoaderConfig.SchemaCharset), strings.ToLower(c.LoaderConfig.SchemaCollation)
+	if cs == "" && co == "" {
+		return nil
+	}
+	if c.TiDB.Backend != "" {
+		return terror.ErrConfigInvalidSchemaCharset.Generate(cs, co, "it's not supported when importing by tidb-lightning")
+	}
+	if co != "" {
+		collation, err := charset.GetCollationByName(co)
+		if err != nil {
+			return terror.ErrConfigInvalidSchemaCharset.Generate(cs, co, err.Error())
+		}
+		if cs == "" {
+			cs = collation.CharsetName
+		} else if cs != collation.CharsetName {
+			return terror.ErrConfigInvalidSchemaCharset.Generate(cs, co, "the collation doesn't belong to the charset")
+		}
+	} else if !isKnownCharset(cs) {
+		return terror.ErrConfigInvalidSchemaCharset.Generate(cs, co, "unknown charset")
+	}
+	c.LoaderConfig.SchemaCharset, c.LoaderConfig.SchemaCollation = cs, co
+	return nil
+}
+
+// isKnownCharset checks whether the charset is known by MySQL, not only the ones supported by TiDB.
+func isKnownCharset(cs string) bool {
+	for _, collation := range charset.GetCollations() {
+		if collation.CharsetName == cs {
+			return true
+		}
+	}
+	return false
+}
+
+// applyDownstreamLabel sets the session label of the downstream databases, it's not encoded so it's set again after
+// decoding.
+func (c *SubTaskConfig) applyDownstreamLabel() {
+	label := c.DownstreamLabel.sessionLabel(c.Name)
+	c.To.SessionLabel = label
+	for i := range c.Targets {
+		c.Targets[i].SessionLabel = label
+	}
+}
+
 // DecryptPassword tries to decrypt db password in config.
 func (c *SubTaskConfig) DecryptPassword() (*SubTaskConfig, error) {
 	clone, err := c.Clone()
@@ -545,6 +960,7 @@ func (c *SubTaskConfig) Clone() (*SubTaskConfig, error) {
 	if err != nil {
 		return nil, terror.ErrConfigTomlTransform.Delegate(err, "decode subtask config from data")
 	}
+	clone.applyDownstreamLabel()
 
 	return clone, nil
 }
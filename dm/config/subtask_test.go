@@ -15,9 +15,12 @@ package config
 
 import (
 	"reflect"
+	"time"
 
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb-tools/pkg/filter"
+
+	"github.com/pingcap/dm/pkg/terror"
 )
 
 func (t *testConfig) TestSubTask(c *C) {
@@ -135,6 +138,241 @@ func (t *testConfig) TestSubTaskAdjustFail(c *C) {
 			},
 			"\\[.*\\], Message: online scheme rtc not supported.*",
 		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.MaxRowSize = -1
+				return cfg
+			},
+			"\\[.*\\], Message: invalid `max-row-size` -1.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.OversizedRowPolicy = "ignore"
+				return cfg
+			},
+			"\\[.*\\], Message: oversized row policy ignore not supported.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.GeneratedColumnMismatch = "ignore"
+				return cfg
+			},
+			"\\[.*\\], Message: generated column mismatch policy ignore not supported.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.LoadDataPolicy = "ignore"
+				return cfg
+			},
+			"\\[.*\\], Message: load data policy ignore not supported.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.PartitionDDLPolicy = "ignore"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid partition ddl policy ignore: not supported.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.PartitionDDLPolicy = PartitionDDLConvert
+				cfg.ShardMode = ShardOptimistic
+				return cfg
+			},
+			"\\[.*\\], Message: invalid partition ddl policy convert: can't be used in shard mode or with a sink.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.DDLWindow = "01:00"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid ddl window 01:00: should be like `01:00-05:00`.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.DDLWindow = "01:00-25:00"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid ddl window 01:00-25:00: should be like `01:00-05:00`.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.DDLWindow = "01:00-05:00"
+				cfg.DDLWindowPolicy = "skip"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid ddl window 01:00-05:00: unknown policy skip.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.DDLWindow = "01:00-05:00"
+				cfg.ShardMode = ShardOptimistic
+				return cfg
+			},
+			"\\[.*\\], Message: invalid ddl window 01:00-05:00: `buffer` policy can't be used in shard mode.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.OnTableRecreate = "ignore"
+				return cfg
+			},
+			"\\[.*\\], Message: table recreate action ignore not supported: unknown action.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.OnTableRecreate = TableRecreateRedump
+				cfg.ShardMode = ShardOptimistic
+				return cfg
+			},
+			"\\[.*\\], Message: table recreate action redump not supported: can't be used in shard mode.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.CleanupPolicy = "truncate"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid cleanup policy truncate: unknown policy.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.CleanupPolicy = CleanupArchive
+				return cfg
+			},
+			"\\[.*\\], Message: invalid cleanup policy archive: `archive-dir` is not specified.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.CleanupPolicy = CleanupUpload
+				cfg.ArchiveDir = "./archive"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid cleanup policy upload: `archive-storage` is not specified.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SchemaCharset = "utf8mb5"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid schema-charset utf8mb5 or schema-collation : unknown charset.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SchemaCharset = "utf8mb4"
+				cfg.SchemaCollation = "latin1_bin"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid schema-charset utf8mb4 or schema-collation latin1_bin: the collation doesn't belong to the charset.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.SchemaCharset = "utf8mb4"
+				cfg.TiDB.Backend = "local"
+				return cfg
+			},
+			"\\[.*\\], Message: invalid schema-charset utf8mb4 or schema-collation : it's not supported when importing by tidb-lightning.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.ErrorBudget = -1
+				return cfg
+			},
+			"\\[.*\\], Message: invalid `error-budget` -1 or `error-budget-window` 0.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.MemoryQuota = -1
+				return cfg
+			},
+			"\\[.*\\], Message: invalid `worker-count` 16, `queue-size` 1024 or `memory-quota` -1.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.DDLTimeout = -1
+				return cfg
+			},
+			"\\[.*\\], Message: invalid `ddl-timeout` -1.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.FillMissingColumns = true
+				cfg.FillMissingColumnsRateLimit = -1
+				return cfg
+			},
+			"\\[.*\\], Message: invalid `fill-missing-columns-rate-limit` -1.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.MinimalRowImage = true
+				cfg.Compact = true
+				return cfg
+			},
+			"\\[.*\\], Message: `minimal-row-image` can't be used with `compact`.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.MinimalRowImage = true
+				cfg.HookPlugin = "/path/to/hook.so"
+				return cfg
+			},
+			"\\[.*\\], Message: `minimal-row-image` can't be used with `hook-plugin`.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.ShardConflictSampleRate = 101
+				return cfg
+			},
+			"\\[.*\\], Message: invalid shard verify config: `shard-conflict-sample-rate` 101 is out of \\[0, 100\\].*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.Sink = &SinkConfig{Protocol: "protobuf"}
+				return cfg
+			},
+			"\\[.*\\], Message: sink protocol protobuf not supported.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.Sink = &SinkConfig{Protocol: SinkProtocolAvro, Brokers: []string{"127.0.0.1:9092"}}
+				return cfg
+			},
+			"\\[.*\\], Message: invalid sink config: `schema-registry` should not be empty for avro.*",
+		},
+		{
+			func() *SubTaskConfig {
+				cfg := newSubTaskConfig()
+				cfg.Mode = ModeAll
+				cfg.Sink = &SinkConfig{Brokers: []string{"127.0.0.1:9092"}}
+				return cfg
+			},
+			"\\[.*\\], Message: invalid sink config: task-mode all is not supported.*",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -144,6 +382,195 @@ func (t *testConfig) TestSubTaskAdjustFail(c *C) {
 	}
 }
 
+func (t *testConfig) TestSubTaskErrorBudget(c *C) {
+	cfg := &SubTaskConfig{
+		Name:     "test",
+		SourceID: "source-1",
+	}
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.ErrorBudgetWindow, Equals, 0)
+
+	cfg.ErrorBudget = 10
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.ErrorBudgetWindow, Equals, defaultErrorBudgetWindow)
+
+	cfg.ErrorBudgetWindow = 5
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.ErrorBudgetWindow, Equals, 5)
+}
+
+func (t *testConfig) TestSubTaskSink(c *C) {
+	cfg := &SubTaskConfig{
+		Name:     "test",
+		SourceID: "source-1",
+		Mode:     ModeIncrement,
+		Sink:     &SinkConfig{Brokers: []string{"127.0.0.1:9092"}},
+	}
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.Sink.Type, Equals, SinkTypeKafka)
+	c.Assert(cfg.Sink.Protocol, Equals, SinkProtocolCanalJSON)
+	c.Assert(cfg.Sink.Topic, Equals, DefaultSinkTopic)
+	c.Assert(cfg.Sink.MaxMessageBytes, Equals, defaultSinkMaxMessageBytes)
+
+	cfg.Sink.Brokers = nil
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*`brokers` should not be empty.*")
+}
+
+func (t *testConfig) TestSubTaskTargets(c *C) {
+	cfg := &SubTaskConfig{
+		Name:     "test",
+		SourceID: "source-1",
+		Mode:     ModeIncrement,
+		To:       DBConfig{Host: "127.0.0.1", Port: 4000},
+		Targets:  []DBConfig{{Host: "127.0.0.1", Port: 4001}},
+	}
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.Targets[0].Session["time_zone"], Equals, defaultTimeZone)
+
+	// the targets use the session time zone of target-database.
+	cfg.To.Session = map[string]string{"time_zone": "+08:00"}
+	cfg.Targets = []DBConfig{{Host: "127.0.0.1", Port: 4001}}
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.Targets[0].Session["time_zone"], Equals, "+08:00")
+	cfg.Targets[0].Session["time_zone"] = "+00:00"
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*session time_zone \\+00:00 of target 127.0.0.1:4001 is different from \\+08:00 of target-database.*")
+	cfg.Targets[0].Session["time_zone"] = "SYSTEM"
+	cfg.To.Session["time_zone"] = "SYSTEM"
+	c.Assert(terror.ErrConfigInvalidTimezone.Equal(cfg.Adjust(false)), IsTrue)
+	cfg.To.Session = nil
+	cfg.Targets = []DBConfig{{Host: "127.0.0.1", Port: 4001}}
+
+	cfg.Targets = append(cfg.Targets, DBConfig{Host: "127.0.0.1", Port: 4000})
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*invalid targets config: target 127.0.0.1:4000 is duplicated.*")
+
+	cfg.Targets = []DBConfig{{Port: 4001}}
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*`host` of target should not be empty.*")
+
+	cfg.Targets = []DBConfig{{Host: "127.0.0.1", Port: 4001}}
+	cfg.Sink = &SinkConfig{Brokers: []string{"127.0.0.1:9092"}}
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*`targets` can't be used together with `sink`.*")
+
+	cfg.Sink = nil
+	cfg.Mode = ModeAll
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*invalid targets config: task-mode all is not supported.*")
+
+	cfg.Mode = ModeIncrement
+	cfg.ShardMode = ShardOptimistic
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*`targets` can't be used together with `shard-mode`.*")
+}
+
+func (t *testConfig) TestSubTaskDownstreamLabel(c *C) {
+	cfg := &SubTaskConfig{
+		Name:     "test*/",
+		SourceID: "source-1",
+		Mode:     ModeIncrement,
+		To:       DBConfig{Host: "127.0.0.1", Port: 4000},
+		Targets:  []DBConfig{{Host: "127.0.0.1", Port: 4001}},
+	}
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.To.SessionLabel, IsNil)
+
+	cfg.DownstreamLabel = &DownstreamLabelConfig{}
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.To.SessionLabel, IsNil)
+
+	cfg.DownstreamLabel = &DownstreamLabelConfig{Comment: true, ResourceGroup: "rg_dm"}
+	c.Assert(cfg.Adjust(false), IsNil)
+	expected := &SessionLabel{Comment: "/* dm-task:test*\\/ */ ", ResourceGroup: "rg_dm"}
+	c.Assert(cfg.To.SessionLabel, DeepEquals, expected)
+	c.Assert(cfg.Targets[0].SessionLabel, DeepEquals, expected)
+	// the label is not encoded, it's set again when cloning.
+	clone, err := cfg.DecryptPassword()
+	c.Assert(err, IsNil)
+	c.Assert(clone.To.SessionLabel, DeepEquals, expected)
+
+	cfg.DownstreamLabel.ResourceGroup = "rg-dm"
+	c.Assert(terror.ErrConfigInvalidDownstreamLabel.Equal(cfg.Adjust(false)), IsTrue)
+}
+
+func (t *testConfig) TestSubTaskExactlyOnce(c *C) {
+	cfg := &SubTaskConfig{
+		Name:     "test",
+		SourceID: "source-1",
+		Mode:     ModeIncrement,
+		To:       DBConfig{Host: "127.0.0.1", Port: 4000},
+	}
+	cfg.SyncerConfig.ExactlyOnce = true
+	cfg.SyncerConfig.WorkerCount = 16
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.SyncerConfig.WorkerCount, Equals, 1)
+
+	cfg.SyncerConfig.SlowDigestThreshold = 100
+	c.Assert(terror.ErrConfigExactlyOnceConflict.Equal(cfg.Adjust(false)), IsTrue)
+	cfg.SyncerConfig.SlowDigestThreshold = 0
+	cfg.SyncerConfig.MemoryQuota = 1 << 30
+	c.Assert(terror.ErrConfigExactlyOnceConflict.Equal(cfg.Adjust(false)), IsTrue)
+	cfg.SyncerConfig.MemoryQuota = 0
+	cfg.Targets = []DBConfig{{Host: "127.0.0.1", Port: 4001}}
+	c.Assert(terror.ErrConfigExactlyOnceConflict.Equal(cfg.Adjust(false)), IsTrue)
+}
+
+func (t *testConfig) TestSubTaskStartTime(c *C) {
+	startTime, err := ParseStartTime("2021-07-01 12:00:00")
+	c.Assert(err, IsNil)
+	c.Assert(startTime.Equal(time.Date(2021, 7, 1, 12, 0, 0, 0, time.Local)), IsTrue)
+	startTime, err = ParseStartTime("2021-07-01T12:00:00+08:00")
+	c.Assert(err, IsNil)
+	c.Assert(startTime.Unix(), Equals, time.Date(2021, 7, 1, 4, 0, 0, 0, time.UTC).Unix())
+	_, err = ParseStartTime("2021/07/01 12:00:00")
+	c.Assert(terror.ErrConfigInvalidStartTime.Equal(err), IsTrue)
+
+	cfg := &SubTaskConfig{
+		Name:      "test",
+		SourceID:  "source-1",
+		Mode:      ModeIncrement,
+		StartTime: "2021-07-01T12:00:00+08:00",
+	}
+	c.Assert(cfg.Adjust(false), IsNil)
+
+	cfg.StartTime = "yesterday"
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*invalid start-time yesterday: wrong format.*")
+
+	cfg.StartTime = "2021-07-01T12:00:00+08:00"
+	cfg.Mode = ModeAll
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*task-mode all is not supported.*")
+}
+
+func (t *testConfig) TestSubTaskSchemaCharset(c *C) {
+	cfg := &SubTaskConfig{
+		Name:     "test",
+		SourceID: "source-1",
+		Mode:     ModeSchemaOnly,
+	}
+	cfg.SchemaCollation = "UTF8MB4_BIN"
+	c.Assert(cfg.Adjust(false), IsNil)
+	c.Assert(cfg.SchemaCharset, Equals, "utf8mb4")
+	c.Assert(cfg.SchemaCollation, Equals, "utf8mb4_bin")
+
+	// charsets not supported by TiDB are allowed if the downstream is MySQL.
+	cfg.SchemaCharset, cfg.SchemaCollation = "gb2312", ""
+	c.Assert(cfg.Adjust(false), IsNil)
+}
+
+func (t *testConfig) TestSubTaskDryRun(c *C) {
+	cfg := &SubTaskConfig{
+		Name:     "test",
+		SourceID: "source-1",
+		Mode:     ModeIncrement,
+		DryRun:   true,
+	}
+	c.Assert(cfg.Adjust(false), IsNil)
+
+	cfg.SyncerConfig.ExactlyOnce = true
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*`start-task --dry-run` can't be used with `exactly-once`.*")
+	cfg.SyncerConfig.ExactlyOnce = false
+	cfg.OnlineDDL = true
+	c.Assert(terror.ErrConfigDryRunConflict.Equal(cfg.Adjust(false)), IsTrue)
+	cfg.OnlineDDL = false
+	cfg.Mode = ModeAll
+	c.Assert(cfg.Adjust(false), ErrorMatches, ".*can't be used with task-mode all.*")
+}
+
 func (t *testConfig) TestSubTaskBlockAllowList(c *C) {
 	filterRules1 := &filter.Rules{
 		DoDBs: []string{"s1"},
@@ -182,7 +609,7 @@ func (t *testConfig) TestDBConfigClone(c *C) {
 	}
 
 	// When add new fields, also update this value
-	c.Assert(reflect.Indirect(reflect.ValueOf(a)).NumField(), Equals, 8)
+	c.Assert(reflect.Indirect(reflect.ValueOf(a)).NumField(), Equals, 15)
 
 	b := a.Clone()
 	c.Assert(a, DeepEquals, b)
@@ -205,4 +632,13 @@ func (t *testConfig) TestDBConfigClone(c *C) {
 	b = a.Clone()
 	c.Assert(a, DeepEquals, b)
 	c.Assert(a.Security, Not(Equals), b.Security)
+
+	a.Tunnel = &TunnelConfig{Type: TunnelSOCKS5, Host: "192.168.0.1", Port: 1080}
+	b = a.Clone()
+	c.Assert(a, DeepEquals, b)
+	c.Assert(a.Tunnel, Not(Equals), b.Tunnel)
+
+	a.SessionLabel = &SessionLabel{Comment: "/* dm-task:test */ "}
+	b = a.Clone()
+	c.Assert(a, DeepEquals, b)
 }
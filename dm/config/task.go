@@ -50,6 +50,53 @@ const (
 	tidbTxnOptimistic = "optimistic"
 )
 
+// policies for rows exceed `max-row-size`.
+const (
+	OversizedRowFail      = "fail"
+	OversizedRowTruncate  = "truncate"
+	OversizedRowSideTable = "side-table"
+)
+
+// policies for columns which are generated columns in only one of the upstream and downstream tables.
+const (
+	GeneratedColumnMismatchError = "error"
+	GeneratedColumnMismatchSkip  = "skip"
+)
+
+// actions when a table is dropped and then created with a different structure in upstream.
+const (
+	TableRecreateCheck  = "check"
+	TableRecreateRedump = "redump"
+)
+
+// policies for the LOAD DATA statements logged in statement format in upstream.
+const (
+	LoadDataSkip        = "skip"
+	LoadDataError       = "error"
+	LoadDataReconstruct = "reconstruct"
+)
+
+// policies for the partition operations of the upstream DDLs.
+const (
+	PartitionDDLPass    = "pass"
+	PartitionDDLDrop    = "drop"
+	PartitionDDLConvert = "convert"
+)
+
+// policies for the DDLs replicated out of the `ddl-window`.
+const (
+	DDLWindowBuffer = "buffer"
+	DDLWindowBlock  = "block"
+)
+
+// policies to clean up a dump file after it's imported by the loader.
+const (
+	CleanupKeep    = "keep"
+	CleanupDelete  = "delete"
+	CleanupArchive = "archive" // move to `archive-dir`
+	CleanupUpload  = "upload"  // upload to `archive-storage` and then delete
+)
+
 // default config item values.
 var (
 	// TaskConfig.
@@ -71,6 +118,7 @@ var (
 	defaultBatch                   = 100
 	defaultQueueSize               = 1024 // do not give too large default value to avoid OOM
 	defaultCheckpointFlushInterval = 30   // in seconds
+	defaultErrorBudgetWindow       = 60   // in seconds
 	// force use UTC time_zone.
 	defaultTimeZone = "+00:00"
 
@@ -91,12 +139,25 @@ type Meta struct {
 	BinLogName string `toml:"binlog-name" yaml:"binlog-name"`
 	BinLogPos  uint32 `toml:"binlog-pos" yaml:"binlog-pos"`
 	BinLogGTID string `toml:"binlog-gtid" yaml:"binlog-gtid"`
+	// MetadataFile is the path of the `metadata` file written by Dumpling on the DM-worker host, the binlog position and
+	// GTID sets in it are used instead of the above items. it's used to replicate incrementally after the full data
+	// is imported by other tools.
+	MetadataFile string `toml:"metadata-file" yaml:"metadata-file,omitempty"`
 }
 
 // Verify does verification on configs
 // NOTE: we can't decide to verify `binlog-name` or `binlog-gtid` until bound to a source (with `enable-gtid` set).
 func (m *Meta) Verify() error {
-	if m != nil && len(m.BinLogName) == 0 && len(m.BinLogGTID) == 0 {
+	if m == nil {
+		return nil
+	}
+	if len(m.MetadataFile) > 0 {
+		if len(m.BinLogName) > 0 || len(m.BinLogGTID) > 0 {
+			return terror.ErrConfigMetaFileConflict.Generate()
+		}
+		return nil
+	}
+	if len(m.BinLogName) == 0 && len(m.BinLogGTID) == 0 {
 		return terror.ErrConfigMetaInvalid.Generate()
 	}
 
@@ -112,6 +173,10 @@ type MySQLInstance struct {
 	ColumnMappingRules []string `yaml:"column-mapping-rules"`
 	RouteRules         []string `yaml:"route-rules"`
 	ExpressionFilters  []string `yaml:"expression-filters"`
+	// ColumnTransformationRules are the names of the column transformations applied to the row changes
+	ColumnTransformationRules []string `yaml:"column-transformation-rules"`
+	// BroadcastRouteRules are the names of the broadcast routes which replicate the tables to additional target tables
+	BroadcastRouteRules []string `yaml:"broadcast-route-rules"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWListName string `yaml:"black-white-list"`
@@ -207,6 +272,16 @@ type LoaderConfig struct {
 	PoolSize int    `yaml:"pool-size" toml:"pool-size" json:"pool-size"`
 	Dir      string `yaml:"dir" toml:"dir" json:"dir"`
 	SQLMode  string `yaml:"-" toml:"-" json:"-"` // wrote by dump unit
+
+	// CleanupPolicy cleans up each data file once it's imported, so the disk space is released during importing.
+	CleanupPolicy  string `yaml:"cleanup-policy" toml:"cleanup-policy" json:"cleanup-policy"`
+	ArchiveDir     string `yaml:"archive-dir" toml:"archive-dir" json:"archive-dir"`
+	ArchiveStorage string `yaml:"archive-storage" toml:"archive-storage" json:"archive-storage"` // like s3://bucket/prefix
+
+	// rewrite the charset and collation of the created databases, tables and columns, the default collation
+	// of the charset is used if only the charset is specified.
+	SchemaCharset   string `yaml:"schema-charset" toml:"schema-charset" json:"schema-charset"`
+	SchemaCollation string `yaml:"schema-collation" toml:"schema-collation" json:"schema-collation"`
 }
 
 // DefaultLoaderConfig return default loader config for task.
@@ -238,6 +313,9 @@ type SyncerConfig struct {
 	QueueSize   int    `yaml:"queue-size" toml:"queue-size" json:"queue-size"`
 	// checkpoint flush interval in seconds.
 	CheckpointFlushInterval int `yaml:"checkpoint-flush-interval" toml:"checkpoint-flush-interval" json:"checkpoint-flush-interval"`
+	// flush checkpoints in background without waiting for all DML jobs executed,
+	// the flushed checkpoints may fall behind at most two `checkpoint-flush-interval`.
+	AsyncCheckpointFlush bool `yaml:"async-checkpoint-flush" toml:"async-checkpoint-flush" json:"async-checkpoint-flush"`
 
 	// deprecated
 	MaxRetry int `yaml:"max-retry" toml:"max-retry" json:"max-retry"`
@@ -248,8 +326,92 @@ type SyncerConfig struct {
 	// deprecated
 	DisableCausality bool `yaml:"disable-detect" toml:"disable-detect" json:"disable-detect"`
 	SafeMode         bool `yaml:"safe-mode" toml:"safe-mode" json:"safe-mode"`
+	// compact the row changes of the same row before replicating them to downstream.
+	Compact bool `yaml:"compact,omitempty" toml:"compact" json:"compact"`
+	// apply each upstream transaction in one downstream transaction together with its binlog location recorded in
+	// the `<task>_dm_applied_gtid` table of the meta schema, the transactions applied before a crash but not yet
+	// checkpointed are skipped instead of applied again when the task is resumed. the transactions are applied by
+	// one DML worker, so `worker-count` is set to 1. the row changes of a transaction are buffered in memory until
+	// it ends, so the task is stopped when a transaction has more than 100000 row changes.
+	ExactlyOnce bool `yaml:"exactly-once,omitempty" toml:"exactly-once" json:"exactly-once"`
 	// deprecated, use `ansi-quotes` in top level config instead
 	EnableANSIQuotes bool `yaml:"enable-ansi-quotes" toml:"enable-ansi-quotes" json:"enable-ansi-quotes"`
+
+	// max size of a single row in bytes, 0 means no limit.
+	MaxRowSize int `yaml:"max-row-size" toml:"max-row-size" json:"max-row-size"`
+	// how to handle rows exceed `max-row-size`, one of `fail`, `truncate` and `side-table`, default is `fail`.
+	// `truncate` requires the table has a primary key or a not null unique key, `side-table` records the whole rows
+	// into the `<task>_syncer_oversized_row` table of the meta schema instead of replicating them.
+	OversizedRowPolicy string `yaml:"oversized-row-policy" toml:"oversized-row-policy" json:"oversized-row-policy"`
+
+	// interval in seconds to compare the tracked table structures with the downstream tables, 0 means disabled.
+	SchemaDriftCheckInterval int `yaml:"schema-drift-check-interval" toml:"schema-drift-check-interval" json:"schema-drift-check-interval"`
+
+	// how to handle the columns which are generated columns in only one of the upstream and downstream tables,
+	// `error` or `skip` (not replicate these columns). empty means not to compare with the downstream tables.
+	GeneratedColumnMismatch string `yaml:"generated-column-mismatch,omitempty" toml:"generated-column-mismatch" json:"generated-column-mismatch"`
+
+	// auto-pause the task if more than `error-budget` retryable errors of the downstream happen
+	// in `error-budget-window` seconds, 0 means no limit.
+	ErrorBudget       int `yaml:"error-budget,omitempty" toml:"error-budget" json:"error-budget"`
+	ErrorBudgetWindow int `yaml:"error-budget-window,omitempty" toml:"error-budget-window" json:"error-budget-window"`
+
+	// max total size in bytes of the row changes buffered in the syncer, reading binlog is blocked until
+	// the buffered row changes are executed if it's exceeded, 0 means no limit.
+	MemoryQuota int64 `yaml:"memory-quota,omitempty" toml:"memory-quota" json:"memory-quota"`
+	// isolate the tables of the statements whose average execution time in downstream exceeds
+	// `slow-digest-threshold` milliseconds into a dedicated DML queue, 0 means disabled.
+	SlowDigestThreshold int `yaml:"slow-digest-threshold,omitempty" toml:"slow-digest-threshold" json:"slow-digest-threshold"`
+	// if a DDL isn't finished in `ddl-timeout` seconds, stop waiting on the connection and track the DDL job
+	// of downstream TiDB asynchronously until it's done, 0 means waiting on the connection.
+	DDLTimeout int `yaml:"ddl-timeout,omitempty" toml:"ddl-timeout" json:"ddl-timeout"`
+	// what to do when a table is dropped and then created with a different structure in upstream, empty means
+	// nothing special. `check` re-validates the rules of the table and compares it with the downstream table,
+	// `redump` also rebuilds the downstream table if it still has the old structure.
+	OnTableRecreate string `yaml:"on-table-recreate,omitempty" toml:"on-table-recreate" json:"on-table-recreate"`
+	// interval in seconds to write the summaries of the row changes applied to each downstream table into an audit
+	// table in the meta schema, 0 means disabled.
+	ApplySummaryInterval int `yaml:"apply-summary-interval,omitempty" toml:"apply-summary-interval" json:"apply-summary-interval"`
+	// fill the columns not logged in binlog when the upstream uses `binlog_row_image` MINIMAL or NOBLOB by looking up
+	// the rows by primary key from the upstream, instead of failing the task. at most `fill-missing-columns-rate-limit`
+	// rows are looked up per second, 0 means no limit.
+	FillMissingColumns          bool `yaml:"fill-missing-columns,omitempty" toml:"fill-missing-columns" json:"fill-missing-columns"`
+	FillMissingColumnsRateLimit int  `yaml:"fill-missing-columns-rate-limit,omitempty" toml:"fill-missing-columns-rate-limit" json:"fill-missing-columns-rate-limit"`
+	// build the DMLs from the columns logged in binlog when the upstream uses `binlog_row_image` MINIMAL or NOBLOB,
+	// instead of failing the task. UPDATE and DELETE find the rows by the primary key in the before image, UPDATE only
+	// sets and INSERT only inserts the logged columns, so the tables to replicate should have a primary key.
+	MinimalRowImage bool `yaml:"minimal-row-image,omitempty" toml:"minimal-row-image" json:"minimal-row-image"`
+	// verify the shard tables merged into the same downstream table. every `shard-verify-interval` seconds the tracked
+	// schemas of the shard tables are compared, the same columns should have the same types and the primary keys should
+	// be the same, 0 means disabled. `shard-conflict-sample-rate` percent of the rows are sampled by the primary key to
+	// check whether different shard tables write different rows with the same primary key, 0 means disabled.
+	ShardVerifyInterval     int `yaml:"shard-verify-interval,omitempty" toml:"shard-verify-interval" json:"shard-verify-interval"`
+	ShardConflictSampleRate int `yaml:"shard-conflict-sample-rate,omitempty" toml:"shard-conflict-sample-rate" json:"shard-conflict-sample-rate"`
+	// what to do with the LOAD DATA statements which are logged in statement format even if `binlog_format` is MIXED,
+	// empty means `skip`. `skip` skips them with a warning, `error` pauses the task, `reconstruct` parses the loaded
+	// file from binlog and replicates the rows as INSERT (or REPLACE for `LOAD DATA ... REPLACE`).
+	LoadDataPolicy string `yaml:"load-data-policy,omitempty" toml:"load-data-policy" json:"load-data-policy"`
+	// what to do with the partitioning of the upstream tables when the downstream doesn't support the same syntax,
+	// empty means `pass`. `pass` executes the DDLs as is, `drop` removes the partitioning from CREATE TABLE and skips
+	// the partition operations of ALTER TABLE, so the rows in the dropped or truncated partitions are kept in downstream,
+	// `convert` does as `drop` but converts DROP/TRUNCATE PARTITION to DELETE by the partition definitions tracked from
+	// the upstream, only the tables partitioned by RANGE or LIST on a single integer column are supported.
+	PartitionDDLPolicy string `yaml:"partition-ddl-policy,omitempty" toml:"partition-ddl-policy" json:"partition-ddl-policy"`
+	// the maintenance window to execute the replicated DDLs in, like "01:00-05:00" in the local time of DM-worker,
+	// empty means executing the DDLs immediately. the DDLs out of the window are held until the window by
+	// `ddl-window-policy`, empty means `buffer`. `buffer` holds the DDL and buffers the following DMLs of the table,
+	// the other tables are replicated as usual, `block` pauses replicating all tables of the task at the DDL.
+	DDLWindow       string `yaml:"ddl-window,omitempty" toml:"ddl-window" json:"ddl-window"`
+	DDLWindowPolicy string `yaml:"ddl-window-policy,omitempty" toml:"ddl-window-policy" json:"ddl-window-policy"`
+	// the daily window to apply the DMLs in, like "22:00-06:00" in the local time of DM-worker, empty means applying
+	// the DMLs all the time. out of the window, replicating is paused before the next transaction or DDL, the
+	// transaction being replicated when the window ends is finished, and the start of the next window is shown as
+	// `syncWindowStart` in query-status. the relay log is still pulled if relay is enabled, otherwise the upstream
+	// binlog should be kept until the window.
+	SyncWindow string `yaml:"sync-window,omitempty" toml:"sync-window" json:"sync-window"`
+	// the path of the Go plugin on DM-worker to intercept the DDLs and row changes, the plugin exports `NewHook` to
+	// create a `hook.Hook` of package `github.com/pingcap/dm/syncer/hook`.
+	HookPlugin string `yaml:"hook-plugin,omitempty" toml:"hook-plugin" json:"hook-plugin"`
 }
 
 // DefaultSyncerConfig return default syncer config for task.
@@ -317,6 +479,11 @@ type TaskConfig struct {
 	Filters        map[string]*bf.BinlogEventRule `yaml:"filters" toml:"filters" json:"filters"`
 	ColumnMappings map[string]*column.Rule        `yaml:"column-mappings" toml:"column-mappings" json:"column-mappings"`
 	ExprFilter     map[string]*ExpressionFilter   `yaml:"expression-filter" toml:"expression-filter" json:"expression-filter"`
+	// ColumnTransformations hash or mask the values of sensitive columns, it's the successor of column-mappings.
+	ColumnTransformations map[string]*ColumnTransformation `yaml:"column-transformations" toml:"column-transformations" json:"column-transformations"`
+	// BroadcastRoutes route a table to additional target tables besides the one routed by `routes`,
+	// the target-table can be empty to keep the name of the upstream table.
+	BroadcastRoutes map[string]*router.TableRule `yaml:"broadcast-routes" toml:"broadcast-routes" json:"broadcast-routes"`
 
 	// black-white-list is deprecated, use block-allow-list instead
 	BWList map[string]*filter.Rules `yaml:"black-white-list" toml:"black-white-list" json:"black-white-list"`
@@ -335,6 +502,25 @@ type TaskConfig struct {
 
 	// extra config when target db is TiDB
 	TiDB *TiDBExtraConfig `yaml:"tidb" toml:"tidb" json:"tidb"`
+
+	// emit row changes to a message queue instead of the target database
+	Sink *SinkConfig `yaml:"sink" toml:"sink" json:"sink"`
+
+	// additional downstream databases, every one is replicated by its own syncer which reads the binlog and keeps
+	// the checkpoint in its meta schema independently, so a slow or failing one doesn't stall the others
+	Targets []*DBConfig `yaml:"targets" toml:"targets" json:"targets"`
+
+	// declare the downstream partitioned tables whose partitions are located for every row change
+	PartitionRules []*PartitionRule `yaml:"partition-rules,omitempty" toml:"partition-rules" json:"partition-rules"`
+
+	// subtasks with higher priority are recovered earlier when dm-worker restarts
+	Priority int `yaml:"priority,omitempty" toml:"priority" json:"priority"`
+
+	// label the downstream sessions of the task by statement comments or the TiDB resource group
+	DownstreamLabel *DownstreamLabelConfig `yaml:"downstream-label,omitempty" toml:"downstream-label" json:"downstream-label"`
+
+	// convert the charsets of upstream in DDLs and row changes
+	CharsetConvert []*CharsetConvertRule `yaml:"charset-convert,omitempty" toml:"charset-convert" json:"charset-convert"`
 }
 
 // NewTaskConfig creates a TaskConfig.
@@ -351,6 +537,8 @@ func NewTaskConfig() *TaskConfig {
 		Filters:                 make(map[string]*bf.BinlogEventRule),
 		ColumnMappings:          make(map[string]*column.Rule),
 		ExprFilter:              make(map[string]*ExpressionFilter),
+		ColumnTransformations:   make(map[string]*ColumnTransformation),
+		BroadcastRoutes:         make(map[string]*router.TableRule),
 		BWList:                  make(map[string]*filter.Rules),
 		BAList:                  make(map[string]*filter.Rules),
 		Mydumpers:               make(map[string]*MydumperConfig),
@@ -412,7 +600,7 @@ func (c *TaskConfig) RawDecode(data string) error {
 }
 
 // find unused items in config.
-var configRefPrefixes = []string{"RouteRules", "FilterRules", "ColumnMappingRules", "Mydumper", "Loader", "Syncer", "ExprFilter"}
+var configRefPrefixes = []string{"RouteRules", "FilterRules", "ColumnMappingRules", "Mydumper", "Loader", "Syncer", "ExprFilter", "ColumnTransformation", "BroadcastRoute"}
 
 const (
 	routeRulesIdx = iota
@@ -422,6 +610,8 @@ const (
 	loaderIdx
 	syncerIdx
 	exprFilterIdx
+	columnTransformationIdx
+	broadcastRouteIdx
 )
 
 // adjust adjusts and verifies config.
@@ -429,7 +619,7 @@ func (c *TaskConfig) adjust() error {
 	if len(c.Name) == 0 {
 		return terror.ErrConfigNeedUniqueTaskName.Generate()
 	}
-	if c.TaskMode != ModeFull && c.TaskMode != ModeIncrement && c.TaskMode != ModeAll {
+	if c.TaskMode != ModeFull && c.TaskMode != ModeIncrement && c.TaskMode != ModeAll && c.TaskMode != ModeSchemaOnly {
 		return terror.ErrConfigInvalidTaskMode.Generate()
 	}
 
@@ -455,6 +645,17 @@ func (c *TaskConfig) adjust() error {
 	if c.TargetDB == nil {
 		return terror.ErrConfigNeedTargetDB.Generate()
 	}
+	if err := c.TargetDB.VerifyConnOptions(); err != nil {
+		return err
+	}
+	for _, target := range c.Targets {
+		if target == nil {
+			return terror.ErrConfigInvalidTargets.Generate("target should not be empty")
+		}
+		if err := target.VerifyConnOptions(); err != nil {
+			return err
+		}
+	}
 
 	if len(c.MySQLInstances) == 0 {
 		return terror.ErrConfigMySQLInstsAtLeastOne.Generate()
@@ -498,6 +699,50 @@ func (c *TaskConfig) adjust() error {
 		}
 	}
 
+	for name, transformation := range c.ColumnTransformations {
+		if err := transformation.Verify(name); err != nil {
+			return err
+		}
+	}
+
+	for name, rule := range c.BroadcastRoutes {
+		if rule == nil {
+			return terror.ErrConfigInvalidBroadcastRoute.Generate(name, "rule should not be empty")
+		}
+		if err := rule.Valid(); err != nil {
+			return terror.ErrConfigInvalidBroadcastRoute.Generate(name, err.Error())
+		}
+	}
+
+	partitionTables := make(map[string]struct{}, len(c.PartitionRules))
+	for i, rule := range c.PartitionRules {
+		if rule == nil {
+			return terror.ErrConfigInvalidPartitionRule.Generate(i, "rule should not be empty")
+		}
+		if err := rule.Adjust(i); err != nil {
+			return err
+		}
+		key := rule.TargetSchema + "." + rule.TargetTable
+		if _, ok := partitionTables[key]; ok {
+			return terror.ErrConfigInvalidPartitionRule.Generate(i, "duplicate rules for table "+key)
+		}
+		partitionTables[key] = struct{}{}
+	}
+
+	convertFrom := make(map[string]struct{}, len(c.CharsetConvert))
+	for i, rule := range c.CharsetConvert {
+		if rule == nil {
+			return terror.ErrConfigInvalidCharsetConvert.Generate(i, "rule should not be empty")
+		}
+		if err := rule.Adjust(i); err != nil {
+			return err
+		}
+		if _, ok := convertFrom[rule.From]; ok {
+			return terror.ErrConfigInvalidCharsetConvert.Generate(i, "duplicate rules for charset "+rule.From)
+		}
+		convertFrom[rule.From] = struct{}{}
+	}
+
 	instanceIDs := make(map[string]int) // source-id -> instance-index
 	globalConfigReferCount := map[string]int{}
 	duplicateErrorStrings := make([]string, 0)
@@ -511,7 +756,7 @@ func (c *TaskConfig) adjust() error {
 		instanceIDs[inst.SourceID] = i
 
 		switch c.TaskMode {
-		case ModeFull, ModeAll:
+		case ModeFull, ModeAll, ModeSchemaOnly:
 			if inst.Meta != nil {
 				log.L().Warn("metadata will not be used. for Full mode, incremental sync will never occur; for All mode, the meta dumped by MyDumper will be used", zap.Int("mysql instance", i), zap.String("task mode", c.TaskMode))
 			}
@@ -575,7 +820,7 @@ func (c *TaskConfig) adjust() error {
 			inst.Mydumper.Threads = inst.MydumperThread
 		}
 
-		if (c.TaskMode == ModeFull || c.TaskMode == ModeAll) && len(inst.Mydumper.MydumperPath) == 0 {
+		if c.TaskMode != ModeIncrement && len(inst.Mydumper.MydumperPath) == 0 {
 			// only verify if set, whether is valid can only be verify when we run it
 			return terror.ErrConfigMydumperPathNotValid.Generate(i)
 		}
@@ -634,6 +879,26 @@ func (c *TaskConfig) adjust() error {
 			}
 			globalConfigReferCount[configRefPrefixes[exprFilterIdx]+name]++
 		}
+		for _, name := range inst.ColumnTransformationRules {
+			if _, ok := c.ColumnTransformations[name]; !ok {
+				return terror.ErrConfigColumnTransformationNotFound.Generate(i, name)
+			}
+			globalConfigReferCount[configRefPrefixes[columnTransformationIdx]+name]++
+		}
+		if len(inst.ColumnTransformationRules) > 0 && c.TaskMode != ModeIncrement {
+			// the dumped data are loaded as is, so the sensitive data would be leaked.
+			return terror.ErrConfigInvalidColumnTransformation.Generate(inst.ColumnTransformationRules[0], "only supported in `incremental` task-mode")
+		}
+		for _, name := range inst.BroadcastRouteRules {
+			if _, ok := c.BroadcastRoutes[name]; !ok {
+				return terror.ErrConfigBroadcastRouteNotFound.Generate(i, name)
+			}
+			globalConfigReferCount[configRefPrefixes[broadcastRouteIdx]+name]++
+		}
+		if len(inst.BroadcastRouteRules) > 0 && c.TaskMode != ModeIncrement {
+			// the dumped data are only loaded into the target tables of `routes`.
+			return terror.ErrConfigInvalidBroadcastRoute.Generate(inst.BroadcastRouteRules[0], "only supported in `incremental` task-mode")
+		}
 
 		if dupeRules := checkDuplicateString(inst.RouteRules); len(dupeRules) > 0 {
 			duplicateErrorStrings = append(duplicateErrorStrings, fmt.Sprintf("mysql-instance(%d)'s route-rules: %s", i, strings.Join(dupeRules, ", ")))
@@ -647,6 +912,12 @@ func (c *TaskConfig) adjust() error {
 		if dupeRules := checkDuplicateString(inst.ExpressionFilters); len(dupeRules) > 0 {
 			duplicateErrorStrings = append(duplicateErrorStrings, fmt.Sprintf("mysql-instance(%d)'s expression-filters: %s", i, strings.Join(dupeRules, ", ")))
 		}
+		if dupeRules := checkDuplicateString(inst.ColumnTransformationRules); len(dupeRules) > 0 {
+			duplicateErrorStrings = append(duplicateErrorStrings, fmt.Sprintf("mysql-instance(%d)'s column-transformation-rules: %s", i, strings.Join(dupeRules, ", ")))
+		}
+		if dupeRules := checkDuplicateString(inst.BroadcastRouteRules); len(dupeRules) > 0 {
+			duplicateErrorStrings = append(duplicateErrorStrings, fmt.Sprintf("mysql-instance(%d)'s broadcast-route-rules: %s", i, strings.Join(dupeRules, ", ")))
+		}
 	}
 	if len(duplicateErrorStrings) > 0 {
 		return terror.ErrConfigDuplicateCfgItem.Generate(strings.Join(duplicateErrorStrings, "\n"))
@@ -688,6 +959,16 @@ func (c *TaskConfig) adjust() error {
 			unusedConfigs = append(unusedConfigs, exprFilter)
 		}
 	}
+	for transformation := range c.ColumnTransformations {
+		if globalConfigReferCount[configRefPrefixes[columnTransformationIdx]+transformation] == 0 {
+			unusedConfigs = append(unusedConfigs, transformation)
+		}
+	}
+	for broadcastRoute := range c.BroadcastRoutes {
+		if globalConfigReferCount[configRefPrefixes[broadcastRouteIdx]+broadcastRoute] == 0 {
+			unusedConfigs = append(unusedConfigs, broadcastRoute)
+		}
+	}
 
 	if len(unusedConfigs) != 0 {
 		sort.Strings(unusedConfigs)
@@ -757,24 +1038,17 @@ func AdjustTargetDBSessionCfg(dbConfig *DBConfig, version *semver.Version) {
 			lowerMap[cfg.key] = cfg.val
 		}
 	}
-	// force set time zone to UTC
-	if tz, ok := lowerMap["time_zone"]; ok {
-		log.L().Warn("session variable 'time_zone' is overwritten with UTC timezone.",
-			zap.String("time_zone", tz))
+	// use UTC time zone if not specified
+	if _, ok := lowerMap["time_zone"]; !ok {
+		lowerMap["time_zone"] = defaultTimeZone
 	}
-	lowerMap["time_zone"] = defaultTimeZone
 	dbConfig.Session = lowerMap
 }
 
-// AdjustTargetDBTimeZone force adjust session `time_zone` to UTC.
+// AdjustTargetDBTimeZone sets session `time_zone` to UTC if it's not specified.
 func AdjustTargetDBTimeZone(config *DBConfig) {
-	for k := range config.Session {
-		if strings.ToLower(k) == "time_zone" {
-			log.L().Warn("session variable 'time_zone' is overwritten by default UTC timezone.",
-				zap.String("time_zone", config.Session[k]))
-			config.Session[k] = defaultTimeZone
-			return
-		}
+	if _, ok := config.sessionVar("time_zone"); ok {
+		return
 	}
 	if config.Session == nil {
 		config.Session = make(map[string]string, 1)
@@ -800,6 +1074,14 @@ func (c *TaskConfig) YamlForDowngrade() (string, error) {
 		return "", err
 	}
 	t.TargetDB.Password = cipher
+	for i, target := range t.Targets {
+		cipher, err = utils.Encrypt(utils.DecryptOrPlaintext(target.Password))
+		if err != nil {
+			return "", err
+		}
+		t.Targets[i] = target.Clone()
+		t.Targets[i].Password = cipher
+	}
 
 	// omit default values, so we can ignore them for later marshal
 	t.omitDefaultVals()
@@ -826,7 +1108,9 @@ type MySQLInstanceForDowngrade struct {
 	Syncer             *SyncerConfig   `yaml:"syncer"`
 	SyncerThread       int             `yaml:"syncer-thread"`
 	// new config item
-	ExpressionFilters []string `yaml:"expression-filters,omitempty"`
+	ExpressionFilters         []string `yaml:"expression-filters,omitempty"`
+	ColumnTransformationRules []string `yaml:"column-transformation-rules,omitempty"`
+	BroadcastRouteRules       []string `yaml:"broadcast-route-rules,omitempty"`
 }
 
 // NewMySQLInstancesForDowngrade creates []* MySQLInstanceForDowngrade.
@@ -851,6 +1135,9 @@ func NewMySQLInstancesForDowngrade(mysqlInstances []*MySQLInstance) []*MySQLInst
 			Syncer:             m.Syncer,
 			SyncerThread:       m.SyncerThread,
 			ExpressionFilters:  m.ExpressionFilters,
+
+			ColumnTransformationRules: m.ColumnTransformationRules,
+			BroadcastRouteRules:       m.BroadcastRouteRules,
 		}
 		mysqlInstancesForDowngrade = append(mysqlInstancesForDowngrade, newMySQLInstance)
 	}
@@ -891,6 +1178,15 @@ type TaskConfigForDowngrade struct {
 	OnlineDDL        bool                         `yaml:"online-ddl,omitempty"`
 	ShadowTableRules []string                     `yaml:"shadow-table-rules,omitempty"`
 	TrashTableRules  []string                     `yaml:"trash-table-rules,omitempty"`
+	Sink             *SinkConfig                  `yaml:"sink,omitempty"`
+	Targets          []*DBConfig                  `yaml:"targets,omitempty"`
+
+	ColumnTransformations map[string]*ColumnTransformation `yaml:"column-transformations,omitempty"`
+	PartitionRules        []*PartitionRule                 `yaml:"partition-rules,omitempty"`
+	BroadcastRoutes       map[string]*router.TableRule     `yaml:"broadcast-routes,omitempty"`
+	Priority              int                              `yaml:"priority,omitempty"`
+	DownstreamLabel       *DownstreamLabelConfig           `yaml:"downstream-label,omitempty"`
+	CharsetConvert        []*CharsetConvertRule            `yaml:"charset-convert,omitempty"`
 }
 
 // NewTaskConfigForDowngrade create new TaskConfigForDowngrade.
@@ -925,6 +1221,14 @@ func NewTaskConfigForDowngrade(taskConfig *TaskConfig) *TaskConfigForDowngrade {
 		OnlineDDL:               taskConfig.OnlineDDL,
 		ShadowTableRules:        taskConfig.ShadowTableRules,
 		TrashTableRules:         taskConfig.TrashTableRules,
+		Sink:                    taskConfig.Sink,
+		Targets:                 taskConfig.Targets,
+		ColumnTransformations:   taskConfig.ColumnTransformations,
+		PartitionRules:          taskConfig.PartitionRules,
+		BroadcastRoutes:         taskConfig.BroadcastRoutes,
+		Priority:                taskConfig.Priority,
+		DownstreamLabel:         taskConfig.DownstreamLabel,
+		CharsetConvert:          taskConfig.CharsetConvert,
 	}
 }
 
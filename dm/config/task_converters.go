@@ -85,6 +85,20 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]DBConfig) ([]*
 			cfg.ExprFilter[j] = c.ExprFilter[name]
 		}
 
+		if len(inst.ColumnTransformationRules) > 0 {
+			cfg.ColumnTransformations = make([]*ColumnTransformation, len(inst.ColumnTransformationRules))
+			for j, name := range inst.ColumnTransformationRules {
+				cfg.ColumnTransformations[j] = c.ColumnTransformations[name]
+			}
+		}
+
+		if len(inst.BroadcastRouteRules) > 0 {
+			cfg.BroadcastRouteRules = make([]*router.TableRule, len(inst.BroadcastRouteRules))
+			for j, name := range inst.BroadcastRouteRules {
+				cfg.BroadcastRouteRules[j] = c.BroadcastRoutes[name]
+			}
+		}
+
 		cfg.BAList = c.BAList[inst.BAListName]
 
 		cfg.MydumperConfig = *inst.Mydumper
@@ -92,6 +106,14 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]DBConfig) ([]*
 		cfg.SyncerConfig = *inst.Syncer
 
 		cfg.CleanDumpFile = c.CleanDumpFile
+		cfg.Sink = c.Sink
+		cfg.PartitionRules = c.PartitionRules
+		cfg.Priority = c.Priority
+		cfg.DownstreamLabel = c.DownstreamLabel
+		cfg.CharsetConvert = c.CharsetConvert
+		for _, target := range c.Targets {
+			cfg.Targets = append(cfg.Targets, *target.Clone())
+		}
 
 		if err := cfg.Adjust(true); err != nil {
 			return nil, terror.Annotatef(err, "source %s", inst.SourceID)
@@ -290,6 +312,14 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.OnlineDDL = stCfg0.OnlineDDL
 	c.OnlineDDLScheme = stCfg0.OnlineDDLScheme
 	c.CleanDumpFile = stCfg0.CleanDumpFile
+	c.Sink = stCfg0.Sink
+	c.PartitionRules = stCfg0.PartitionRules
+	c.Priority = stCfg0.Priority
+	c.DownstreamLabel = stCfg0.DownstreamLabel
+	c.CharsetConvert = stCfg0.CharsetConvert
+	for i := range stCfg0.Targets {
+		c.Targets = append(c.Targets, &stCfg0.Targets[i]) // just ref
+	}
 	c.MySQLInstances = make([]*MySQLInstance, 0, len(stCfgs))
 	c.BAList = make(map[string]*filter.Rules)
 	c.Routes = make(map[string]*router.TableRule)
@@ -299,6 +329,8 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.Loaders = make(map[string]*LoaderConfig)
 	c.Syncers = make(map[string]*SyncerConfig)
 	c.ExprFilter = make(map[string]*ExpressionFilter)
+	c.ColumnTransformations = make(map[string]*ColumnTransformation)
+	c.BroadcastRoutes = make(map[string]*router.TableRule)
 
 	baListMap := make(map[string]string, len(stCfgs))
 	routeMap := make(map[string]string, len(stCfgs))
@@ -308,8 +340,10 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	syncMap := make(map[string]string, len(stCfgs))
 	cmMap := make(map[string]string, len(stCfgs))
 	exprFilterMap := make(map[string]string, len(stCfgs))
-	var baListIdx, routeIdx, filterIdx, dumpIdx, loadIdx, syncIdx, cmIdx, efIdx int
-	var baListName, routeName, filterName, dumpName, loadName, syncName, cmName, efName string
+	ctMap := make(map[string]string, len(stCfgs))
+	brMap := make(map[string]string, len(stCfgs))
+	var baListIdx, routeIdx, filterIdx, dumpIdx, loadIdx, syncIdx, cmIdx, efIdx, ctIdx, brIdx int
+	var baListName, routeName, filterName, dumpName, loadName, syncName, cmName, efName, ctName, brName string
 
 	// NOTE:
 	// - we choose to ref global configs for instances now.
@@ -358,6 +392,20 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 			c.ColumnMappings[cmName] = rule
 		}
 
+		ctNames := make([]string, 0, len(stCfg.ColumnTransformations))
+		for _, rule := range stCfg.ColumnTransformations {
+			ctName, ctIdx = getGenerateName(rule, ctIdx, "ct", ctMap)
+			ctNames = append(ctNames, ctName)
+			c.ColumnTransformations[ctName] = rule
+		}
+
+		brNames := make([]string, 0, len(stCfg.BroadcastRouteRules))
+		for _, rule := range stCfg.BroadcastRouteRules {
+			brName, brIdx = getGenerateName(rule, brIdx, "broadcast-route", brMap)
+			brNames = append(brNames, brName)
+			c.BroadcastRoutes[brName] = rule
+		}
+
 		c.MySQLInstances = append(c.MySQLInstances, &MySQLInstance{
 			SourceID:           stCfg.SourceID,
 			Meta:               stCfg.Meta,
@@ -369,6 +417,9 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 			LoaderConfigName:   loadName,
 			SyncerConfigName:   syncName,
 			ExpressionFilters:  exprFilterNames,
+
+			ColumnTransformationRules: ctNames,
+			BroadcastRouteRules:       brNames,
 		})
 	}
 	return c
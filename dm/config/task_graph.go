@@ -0,0 +1,198 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// kinds of TaskGraphNode.
+const (
+	TaskGraphNodeSource     = "source"
+	TaskGraphNodeUpstream   = "upstream"
+	TaskGraphNodeDownstream = "downstream"
+)
+
+// TaskGraph describes how the upstream tables of a task are routed into the downstream tables.
+// upstream tables are described by the patterns of route rules, so it can be generated without
+// connecting to the upstream sources.
+type TaskGraph struct {
+	Task  string              `json:"task"`
+	Nodes []*TaskGraphNode    `json:"nodes"`
+	Edges []*TaskGraphEdge    `json:"edges"`
+	index map[string]struct{} // node IDs, used to deduplicate nodes
+}
+
+// TaskGraphNode represents a source, an upstream table pattern or a downstream table.
+type TaskGraphNode struct {
+	ID    string `json:"id"`
+	Kind  string `json:"kind"`
+	Label string `json:"label"`
+	// block-allow-list applied to the source, only for source node
+	BAList string `json:"block-allow-list,omitempty"`
+}
+
+// TaskGraphEdge represents a source owns an upstream table pattern, or an upstream table pattern
+// is routed into a downstream table.
+type TaskGraphEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Route string `json:"route,omitempty"`
+	// binlog event filters and expression filters whose patterns cover the upstream table pattern
+	Filters []string `json:"filters,omitempty"`
+}
+
+// NewTaskGraph generates the routing and merging topology of the task.
+func NewTaskGraph(c *TaskConfig) *TaskGraph {
+	g := &TaskGraph{
+		Task:  c.Name,
+		Nodes: make([]*TaskGraphNode, 0),
+		Edges: make([]*TaskGraphEdge, 0),
+		index: make(map[string]struct{}),
+	}
+
+	for _, inst := range c.MySQLInstances {
+		sourceID := "source:" + inst.SourceID
+		g.addNode(&TaskGraphNode{
+			ID:     sourceID,
+			Kind:   TaskGraphNodeSource,
+			Label:  inst.SourceID,
+			BAList: inst.BAListName,
+		})
+
+		for _, name := range inst.RouteRules {
+			rule, ok := c.Routes[name]
+			if !ok {
+				continue
+			}
+			upstream := tableLabel(rule.SchemaPattern, rule.TablePattern)
+			upstreamID := fmt.Sprintf("upstream:%s:%s", inst.SourceID, upstream)
+			if g.addNode(&TaskGraphNode{ID: upstreamID, Kind: TaskGraphNodeUpstream, Label: upstream}) {
+				g.Edges = append(g.Edges, &TaskGraphEdge{From: sourceID, To: upstreamID})
+			}
+
+			targetTable := rule.TargetTable
+			if targetTable == "" && rule.TablePattern != "" {
+				// table name is kept when target table is not specified
+				targetTable = rule.TablePattern
+			}
+			downstream := tableLabel(rule.TargetSchema, targetTable)
+			downstreamID := "downstream:" + downstream
+			g.addNode(&TaskGraphNode{ID: downstreamID, Kind: TaskGraphNodeDownstream, Label: downstream})
+			g.Edges = append(g.Edges, &TaskGraphEdge{
+				From:    upstreamID,
+				To:      downstreamID,
+				Route:   name,
+				Filters: coveredFilters(c, inst, rule.SchemaPattern, rule.TablePattern),
+			})
+		}
+	}
+	return g
+}
+
+// addNode adds the node if not exists, and returns whether it's added.
+func (g *TaskGraph) addNode(node *TaskGraphNode) bool {
+	if _, ok := g.index[node.ID]; ok {
+		return false
+	}
+	g.index[node.ID] = struct{}{}
+	g.Nodes = append(g.Nodes, node)
+	return true
+}
+
+// DOT returns the graph in Graphviz DOT language.
+func (g *TaskGraph) DOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", g.Task)
+	b.WriteString("\trankdir=LR;\n")
+	for _, node := range g.Nodes {
+		attrs := fmt.Sprintf("label=%q", node.Label)
+		switch node.Kind {
+		case TaskGraphNodeSource:
+			if node.BAList != "" {
+				attrs = fmt.Sprintf("label=%q", node.Label+"\nblock-allow-list: "+node.BAList)
+			}
+			attrs += ", shape=cylinder"
+		case TaskGraphNodeDownstream:
+			attrs += ", shape=box, style=bold"
+		default:
+			attrs += ", shape=box"
+		}
+		fmt.Fprintf(&b, "\t%q [%s];\n", node.ID, attrs)
+	}
+	for _, edge := range g.Edges {
+		labels := make([]string, 0, 2)
+		if edge.Route != "" {
+			labels = append(labels, "route: "+edge.Route)
+		}
+		if len(edge.Filters) > 0 {
+			labels = append(labels, "filters: "+strings.Join(edge.Filters, ", "))
+		}
+		if len(labels) > 0 {
+			fmt.Fprintf(&b, "\t%q -> %q [label=%q];\n", edge.From, edge.To, strings.Join(labels, "\n"))
+		} else {
+			fmt.Fprintf(&b, "\t%q -> %q;\n", edge.From, edge.To)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// tableLabel returns the quoted name of a table, or all tables in the schema if table is empty.
+func tableLabel(schema, table string) string {
+	if table == "" {
+		return fmt.Sprintf("`%s`.*", schema)
+	}
+	return fmt.Sprintf("`%s`.`%s`", schema, table)
+}
+
+// coveredFilters returns the filters of the source whose schema and table patterns cover the route patterns.
+func coveredFilters(c *TaskConfig, inst *MySQLInstance, schemaPattern, tablePattern string) []string {
+	var filters []string
+	for _, name := range inst.FilterRules {
+		rule, ok := c.Filters[name]
+		if !ok {
+			continue
+		}
+		if patternCovers(c.CaseSensitive, rule.SchemaPattern, schemaPattern) && patternCovers(c.CaseSensitive, rule.TablePattern, tablePattern) {
+			filters = append(filters, name)
+		}
+	}
+	for _, name := range inst.ExpressionFilters {
+		rule, ok := c.ExprFilter[name]
+		if !ok {
+			continue
+		}
+		if patternCovers(c.CaseSensitive, rule.Schema, schemaPattern) && patternCovers(c.CaseSensitive, rule.Table, tablePattern) {
+			filters = append(filters, name)
+		}
+	}
+	return filters
+}
+
+// patternCovers returns whether the filter pattern matches the route pattern, an empty filter pattern matches everything.
+// the route pattern is treated as a literal name, so a filter for a part of the routed tables is not reported.
+func patternCovers(caseSensitive bool, filterPattern, routePattern string) bool {
+	if filterPattern == "" {
+		return true
+	}
+	if !caseSensitive {
+		filterPattern = strings.ToLower(filterPattern)
+		routePattern = strings.ToLower(routePattern)
+	}
+	matched, err := path.Match(filterPattern, routePattern)
+	return err == nil && matched
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testConfig) TestTaskGraph(c *C) {
+	taskConfig := NewTaskConfig()
+	err := taskConfig.Decode(`---
+name: test
+task-mode: all
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+
+mysql-instances:
+  - source-id: "mysql-replica-01"
+    block-allow-list: "instance"
+    route-rules: ["route-table", "route-schema"]
+    filter-rules: ["filter-all", "filter-shard"]
+  - source-id: "mysql-replica-02"
+    route-rules: ["route-table"]
+    filter-rules: ["filter-other"]
+    expression-filters: ["even-c"]
+
+routes:
+  route-table:
+    schema-pattern: "shard_db_*"
+    table-pattern: "shard_table_*"
+    target-schema: "db"
+    target-table: "tbl"
+  route-schema:
+    schema-pattern: "shard_db_*"
+    target-schema: "db"
+
+filters:
+  filter-all:
+    schema-pattern: "shard_db_*"
+    events: ["truncate table"]
+    action: Ignore
+  filter-shard:
+    schema-pattern: "shard_db_*"
+    table-pattern: "shard_table_*"
+    events: ["delete"]
+    action: Ignore
+  filter-other:
+    schema-pattern: "other_db"
+    events: ["delete"]
+    action: Ignore
+
+expression-filter:
+  even-c:
+    schema: "shard_db_*"
+    table: "shard_table_*"
+    insert-value-expr: "c % 2 = 0"
+
+block-allow-list:
+  instance:
+    do-dbs: ["~^shard_db_.*"]
+`)
+	c.Assert(err, IsNil)
+
+	g := NewTaskGraph(taskConfig)
+	c.Assert(g.Task, Equals, "test")
+	c.Assert(g.Nodes, DeepEquals, []*TaskGraphNode{
+		{ID: "source:mysql-replica-01", Kind: TaskGraphNodeSource, Label: "mysql-replica-01", BAList: "instance"},
+		{ID: "upstream:mysql-replica-01:`shard_db_*`.`shard_table_*`", Kind: TaskGraphNodeUpstream, Label: "`shard_db_*`.`shard_table_*`"},
+		{ID: "downstream:`db`.`tbl`", Kind: TaskGraphNodeDownstream, Label: "`db`.`tbl`"},
+		{ID: "upstream:mysql-replica-01:`shard_db_*`.*", Kind: TaskGraphNodeUpstream, Label: "`shard_db_*`.*"},
+		{ID: "downstream:`db`.*", Kind: TaskGraphNodeDownstream, Label: "`db`.*"},
+		{ID: "source:mysql-replica-02", Kind: TaskGraphNodeSource, Label: "mysql-replica-02"},
+		{ID: "upstream:mysql-replica-02:`shard_db_*`.`shard_table_*`", Kind: TaskGraphNodeUpstream, Label: "`shard_db_*`.`shard_table_*`"},
+	})
+	c.Assert(g.Edges, HasLen, 6)
+	// tables of both sources are merged into the same downstream table
+	c.Assert(g.Edges[1], DeepEquals, &TaskGraphEdge{
+		From:    "upstream:mysql-replica-01:`shard_db_*`.`shard_table_*`",
+		To:      "downstream:`db`.`tbl`",
+		Route:   "route-table",
+		Filters: []string{"filter-all", "filter-shard"},
+	})
+	c.Assert(g.Edges[3].Filters, DeepEquals, []string{"filter-all"})
+	c.Assert(g.Edges[5], DeepEquals, &TaskGraphEdge{
+		From:    "upstream:mysql-replica-02:`shard_db_*`.`shard_table_*`",
+		To:      "downstream:`db`.`tbl`",
+		Route:   "route-table",
+		Filters: []string{"even-c"},
+	})
+
+	data, err := json.Marshal(g)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Matches, `\{"task":"test","nodes":\[.*\],"edges":\[.*\]\}`)
+
+	dot := g.DOT()
+	c.Assert(dot, Matches, `(?s)digraph "test" \{\n\trankdir=LR;\n.*\}\n`)
+	c.Assert(dot, Matches, "(?s).*\t\"source:mysql-replica-01\" \\[label=\"mysql-replica-01\\\\nblock-allow-list: instance\", shape=cylinder\\];\n.*")
+	c.Assert(dot, Matches, "(?s).*\t\"source:mysql-replica-02\" -> \"upstream:mysql-replica-02:`shard_db_\\*`.`shard_table_\\*`\";\n.*")
+	c.Assert(dot, Matches, "(?s).*\t\"upstream:mysql-replica-01:`shard_db_\\*`.\\*\" -> \"downstream:`db`.\\*\" \\[label=\"route: route-schema\\\\nfilters: filter-all\"\\];\n.*")
+}
@@ -868,6 +868,21 @@ func (t *testConfig) TestMetaVerify(c *C) {
 		BinLogGTID: "1-1-12,4-4-4",
 	}
 	c.Assert(m.Verify(), IsNil)
+
+	// only `metadata-file`.
+	m = &Meta{
+		MetadataFile: "/data/dump/metadata",
+	}
+	c.Assert(m.Verify(), IsNil)
+
+	// `metadata-file` with `binlog-name` or `binlog-gtid`.
+	m.BinLogName = "mysql-bin.000123"
+	c.Assert(terror.ErrConfigMetaFileConflict.Equal(m.Verify()), IsTrue)
+	m = &Meta{
+		BinLogGTID:   "1-1-12,4-4-4",
+		MetadataFile: "/data/dump/metadata",
+	}
+	c.Assert(terror.ErrConfigMetaFileConflict.Equal(m.Verify()), IsTrue)
 }
 
 func (t *testConfig) TestMySQLInstance(c *C) {
@@ -928,12 +943,26 @@ func (t *testConfig) TestAdjustTargetDBConfig(c *C) {
 			DBConfig{Session: map[string]string{"sql_mode": "", tidbTxnMode: "pessimistic", "time_zone": "+00:00"}},
 			semver.New("4.0.0-beta.2"),
 		},
+		{
+			DBConfig{Session: map[string]string{"Time_Zone": "Asia/Shanghai"}},
+			DBConfig{Session: map[string]string{tidbTxnMode: tidbTxnOptimistic, "time_zone": "Asia/Shanghai"}},
+			semver.New("5.0.0"),
+		},
 	}
 
 	for _, tc := range testCases {
 		AdjustTargetDBSessionCfg(&tc.dbConfig, tc.version)
 		c.Assert(tc.dbConfig, DeepEquals, tc.result)
 	}
+
+	// the session time zone specified by user is kept.
+	dbConfig := DBConfig{Session: map[string]string{"TIME_ZONE": "+08:00"}}
+	AdjustTargetDBTimeZone(&dbConfig)
+	c.Assert(dbConfig.Session, DeepEquals, map[string]string{"TIME_ZONE": "+08:00"})
+	c.Assert(dbConfig.TimeZone(), Equals, "+08:00")
+	dbConfig = DBConfig{}
+	AdjustTargetDBTimeZone(&dbConfig)
+	c.Assert(dbConfig.TimeZone(), Equals, defaultTimeZone)
 }
 
 func (t *testConfig) TestDefaultConfig(c *C) {
@@ -1089,3 +1118,162 @@ func cloneValues(dest, src reflect.Value) {
 		}
 	}
 }
+
+func (t *testConfig) TestColumnTransformations(c *C) {
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = ModeIncrement
+	cfg.TargetDB = &DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1", Meta: &Meta{BinLogName: "mysql-bin.000001"}})
+	cfg.ColumnTransformations["mask-phone"] = &ColumnTransformation{
+		Schema:     "db",
+		Table:      "tbl",
+		Columns:    []string{"phone"},
+		Type:       ColumnTransformMask,
+		KeepPrefix: 3,
+		KeepSuffix: 4,
+	}
+	c.Assert(terror.ErrConfigGlobalConfigsUnused.Equal(cfg.adjust()), IsTrue)
+
+	cfg.MySQLInstances[0].ColumnTransformationRules = []string{"mask-phone", "not-exist"}
+	c.Assert(terror.ErrConfigColumnTransformationNotFound.Equal(cfg.adjust()), IsTrue)
+	cfg.MySQLInstances[0].ColumnTransformationRules = []string{"mask-phone"}
+	c.Assert(cfg.adjust(), IsNil)
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]DBConfig{"source1": {}})
+	c.Assert(err, IsNil)
+	c.Assert(stCfgs[0].ColumnTransformations, DeepEquals, []*ColumnTransformation{cfg.ColumnTransformations["mask-phone"]})
+
+	// the dumped data are not transformed
+	cfg.TaskMode = ModeAll
+	c.Assert(terror.ErrConfigInvalidColumnTransformation.Equal(cfg.adjust()), IsTrue)
+	cfg.TaskMode = ModeIncrement
+
+	invalids := []*ColumnTransformation{
+		{Table: "tbl", Columns: []string{"c"}, Type: ColumnTransformNull},
+		{Schema: "db", Table: "tbl", Type: ColumnTransformNull},
+		{Schema: "db", Table: "tbl", Columns: []string{"c", "c"}, Type: ColumnTransformNull},
+		{Schema: "db", Table: "tbl", Columns: []string{"c"}, Type: "encrypt"},
+		{Schema: "db", Table: "tbl", Columns: []string{"c"}, Type: ColumnTransformHash, KeepPrefix: 1},
+		{Schema: "db", Table: "tbl", Columns: []string{"c"}, Type: ColumnTransformMask, KeepSuffix: -1},
+	}
+	for _, invalid := range invalids {
+		cfg.ColumnTransformations["mask-phone"] = invalid
+		c.Assert(terror.ErrConfigInvalidColumnTransformation.Equal(cfg.adjust()), IsTrue)
+	}
+}
+
+func (t *testConfig) TestBroadcastRoutes(c *C) {
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = ModeIncrement
+	cfg.TargetDB = &DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1", Meta: &Meta{BinLogName: "mysql-bin.000001"}})
+	cfg.BroadcastRoutes["report"] = &router.TableRule{SchemaPattern: "db", TablePattern: "tbl*", TargetSchema: "report"}
+	cfg.BroadcastRoutes["archive"] = &router.TableRule{SchemaPattern: "db", TablePattern: "tbl*", TargetSchema: "archive", TargetTable: "tbl"}
+	c.Assert(terror.ErrConfigGlobalConfigsUnused.Equal(cfg.adjust()), IsTrue)
+
+	cfg.MySQLInstances[0].BroadcastRouteRules = []string{"report", "not-exist"}
+	c.Assert(terror.ErrConfigBroadcastRouteNotFound.Equal(cfg.adjust()), IsTrue)
+	cfg.MySQLInstances[0].BroadcastRouteRules = []string{"report", "archive", "report"}
+	c.Assert(terror.ErrConfigDuplicateCfgItem.Equal(cfg.adjust()), IsTrue)
+	cfg.MySQLInstances[0].BroadcastRouteRules = []string{"report", "archive"}
+	c.Assert(cfg.adjust(), IsNil)
+
+	// rules with the same patterns are applied independently
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]DBConfig{"source1": {}})
+	c.Assert(err, IsNil)
+	c.Assert(stCfgs[0].BroadcastRouteRules, DeepEquals, []*router.TableRule{cfg.BroadcastRoutes["report"], cfg.BroadcastRoutes["archive"]})
+
+	cfg2 := SubTaskConfigsToTaskConfig(stCfgs...)
+	c.Assert(cfg2.BroadcastRoutes, HasLen, 2)
+	c.Assert(cfg2.MySQLInstances[0].BroadcastRouteRules, HasLen, 2)
+
+	stCfgs[0].Sink = &SinkConfig{}
+	c.Assert(terror.ErrConfigInvalidBroadcastRoute.Equal(stCfgs[0].adjustBroadcastRoutes()), IsTrue)
+
+	// the dumped data are only loaded into the target tables of `routes`
+	cfg.TaskMode = ModeAll
+	c.Assert(terror.ErrConfigInvalidBroadcastRoute.Equal(cfg.adjust()), IsTrue)
+	cfg.TaskMode = ModeIncrement
+
+	cfg.BroadcastRoutes["archive"] = &router.TableRule{SchemaPattern: "db", TablePattern: "tbl*"}
+	c.Assert(terror.ErrConfigInvalidBroadcastRoute.Equal(cfg.adjust()), IsTrue)
+	cfg.BroadcastRoutes["archive"] = nil
+	c.Assert(terror.ErrConfigInvalidBroadcastRoute.Equal(cfg.adjust()), IsTrue)
+}
+
+func (t *testConfig) TestPartitionRules(c *C) {
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = ModeIncrement
+	cfg.TargetDB = &DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1", Meta: &Meta{BinLogName: "mysql-bin.000001"}})
+	cfg.PartitionRules = []*PartitionRule{
+		{TargetSchema: "db", TargetTable: "t1"},
+		{TargetSchema: "db", TargetTable: "t2", Mode: PartitionModeHint},
+	}
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(cfg.PartitionRules[0].Mode, Equals, PartitionModeCheck)
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]DBConfig{"source1": {}})
+	c.Assert(err, IsNil)
+	c.Assert(stCfgs[0].PartitionRules, DeepEquals, cfg.PartitionRules)
+	c.Assert(SubTaskConfigsToTaskConfig(stCfgs...).PartitionRules, DeepEquals, cfg.PartitionRules)
+
+	invalids := [][]*PartitionRule{
+		{nil},
+		{{TargetSchema: "db"}},
+		{{TargetSchema: "db", TargetTable: "t1", Mode: "route"}},
+		{{TargetSchema: "db", TargetTable: "t1"}, {TargetSchema: "db", TargetTable: "t1", Mode: PartitionModeHint}},
+	}
+	for _, invalid := range invalids {
+		cfg.PartitionRules = invalid
+		c.Assert(terror.ErrConfigInvalidPartitionRule.Equal(cfg.adjust()), IsTrue)
+	}
+}
+
+func (t *testConfig) TestCharsetConvert(c *C) {
+	cfg := NewTaskConfig()
+	cfg.Name = "test"
+	cfg.TaskMode = ModeIncrement
+	cfg.TargetDB = &DBConfig{}
+	cfg.MySQLInstances = append(cfg.MySQLInstances, &MySQLInstance{SourceID: "source1", Meta: &Meta{BinLogName: "mysql-bin.000001"}})
+	cfg.CharsetConvert = []*CharsetConvertRule{
+		{From: "UTF8", To: "utf8mb4"},
+		{From: "latin1", To: "utf8mb4", Collation: "UTF8MB4_BIN", Transcode: true},
+		{From: "utf8mb4", To: "utf8mb4", Collation: "utf8mb4_general_ci"},
+	}
+	c.Assert(cfg.adjust(), IsNil)
+	c.Assert(cfg.CharsetConvert[0].From, Equals, "utf8")
+	c.Assert(cfg.CharsetConvert[1].Collation, Equals, "utf8mb4_bin")
+
+	stCfgs, err := TaskConfigToSubTaskConfigs(cfg, map[string]DBConfig{"source1": {}})
+	c.Assert(err, IsNil)
+	c.Assert(stCfgs[0].CharsetConvert, DeepEquals, cfg.CharsetConvert)
+	c.Assert(SubTaskConfigsToTaskConfig(stCfgs...).CharsetConvert, DeepEquals, cfg.CharsetConvert)
+
+	convert := CharsetConvertFunc(cfg.CharsetConvert)
+	cs, co, ok := convert("LATIN1")
+	c.Assert(ok, IsTrue)
+	c.Assert(cs, Equals, "utf8mb4")
+	c.Assert(co, Equals, "utf8mb4_bin")
+	_, _, ok = convert("ascii")
+	c.Assert(ok, IsFalse)
+
+	invalids := [][]*CharsetConvertRule{
+		{nil},
+		{{From: "utf8"}},
+		{{From: "binary", To: "utf8mb4"}},
+		{{From: "utf9", To: "utf8mb4"}},
+		{{From: "utf8mb4", To: "utf8mb4"}},
+		{{From: "utf8", To: "utf8mb4", Collation: "utf8_bin"}},
+		{{From: "utf8", To: "utf8mb4", Collation: "utf8mb4_xx"}},
+		{{From: "utf8", To: "utf8mb4", Transcode: true}},
+		{{From: "utf8", To: "utf8mb4"}, {From: "utf8", To: "utf8mb4", Collation: "utf8mb4_bin"}},
+	}
+	for _, invalid := range invalids {
+		cfg.CharsetConvert = invalid
+		c.Assert(terror.ErrConfigInvalidCharsetConvert.Equal(cfg.adjust()), IsTrue, Commentf("%v", invalid))
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+const timeWindowLayout = "15:04"
+
+// TimeWindow is a daily time window like the maintenance window, it ends on the next day if End <= Start.
+type TimeWindow struct {
+	Start time.Duration // offset from the midnight
+	End   time.Duration
+}
+
+// ParseDDLWindow parses the `ddl-window` config like "01:00-05:00".
+func ParseDDLWindow(s string) (TimeWindow, error) {
+	w, err := parseTimeWindow(s)
+	if err != nil {
+		return w, terror.ErrConfigInvalidDDLWindow.Generate(s, err.Error())
+	}
+	return w, nil
+}
+
+// ParseSyncWindow parses the `sync-window` config like "22:00-06:00".
+func ParseSyncWindow(s string) (TimeWindow, error) {
+	w, err := parseTimeWindow(s)
+	if err != nil {
+		return w, terror.ErrConfigInvalidSyncWindow.Generate(s, err.Error())
+	}
+	return w, nil
+}
+
+// parseTimeWindow parses a time window like "01:00-05:00", the error is the reason why it's invalid.
+func parseTimeWindow(s string) (TimeWindow, error) {
+	var w TimeWindow
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return w, errors.New("should be like `01:00-05:00`")
+	}
+	offsets := make([]time.Duration, 0, 2)
+	for _, part := range parts {
+		t, err := time.Parse(timeWindowLayout, strings.TrimSpace(part))
+		if err != nil {
+			return w, errors.New("should be like `01:00-05:00`")
+		}
+		offsets = append(offsets, time.Duration(t.Hour())*time.Hour+time.Duration(t.Minute())*time.Minute)
+	}
+	w.Start, w.End = offsets[0], offsets[1]
+	if w.Start == w.End {
+		return w, errors.New("the window is empty")
+	}
+	return w, nil
+}
+
+// Contains returns whether t is in the window.
+func (w TimeWindow) Contains(t time.Time) bool {
+	year, month, day := t.Date()
+	offset := t.Sub(time.Date(year, month, day, 0, 0, 0, 0, t.Location()))
+	if w.Start < w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	return offset >= w.Start || offset < w.End
+}
+
+// NextStart returns the start time of the window which t is in, or the next window if t is out of the window.
+func (w TimeWindow) NextStart(t time.Time) time.Time {
+	year, month, day := t.Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, t.Location()).Add(w.Start)
+	if w.Contains(t) {
+		if start.After(t) {
+			// in the window started yesterday.
+			start = start.AddDate(0, 0, -1)
+		}
+		return start
+	}
+	if !start.After(t) {
+		start = start.AddDate(0, 0, 1)
+	}
+	return start
+}
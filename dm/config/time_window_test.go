@@ -0,0 +1,52 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testConfig) TestTimeWindow(c *C) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2021, 10, day, hour, minute, 0, 0, time.Local)
+	}
+
+	w, err := ParseDDLWindow("01:00-05:30")
+	c.Assert(err, IsNil)
+	c.Assert(w, Equals, TimeWindow{Start: time.Hour, End: 5*time.Hour + 30*time.Minute})
+	c.Assert(w.Contains(at(1, 0, 59)), IsFalse)
+	c.Assert(w.Contains(at(1, 1, 0)), IsTrue)
+	c.Assert(w.Contains(at(1, 5, 29)), IsTrue)
+	c.Assert(w.Contains(at(1, 5, 30)), IsFalse)
+	c.Assert(w.NextStart(at(1, 0, 59)), Equals, at(1, 1, 0))
+	c.Assert(w.NextStart(at(1, 3, 0)), Equals, at(1, 1, 0))
+	c.Assert(w.NextStart(at(1, 12, 0)), Equals, at(2, 1, 0))
+
+	// cross the midnight.
+	w, err = ParseDDLWindow(" 23:00 - 02:00 ")
+	c.Assert(err, IsNil)
+	c.Assert(w.Contains(at(1, 22, 59)), IsFalse)
+	c.Assert(w.Contains(at(1, 23, 30)), IsTrue)
+	c.Assert(w.Contains(at(2, 1, 0)), IsTrue)
+	c.Assert(w.Contains(at(2, 2, 0)), IsFalse)
+	c.Assert(w.NextStart(at(2, 1, 0)), Equals, at(1, 23, 0))
+	c.Assert(w.NextStart(at(2, 12, 0)), Equals, at(2, 23, 0))
+
+	_, err = ParseDDLWindow("01:00-01:00")
+	c.Assert(err, ErrorMatches, ".*invalid ddl window 01:00-01:00: the window is empty.*")
+	_, err = ParseSyncWindow("22:00")
+	c.Assert(err, ErrorMatches, ".*invalid sync window 22:00: should be like `01:00-05:00`.*")
+}
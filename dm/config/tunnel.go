@@ -0,0 +1,80 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// the types of tunnel.
+const (
+	TunnelSSH    = "ssh"
+	TunnelSOCKS5 = "socks5"
+)
+
+// TunnelConfig is the configuration for connecting to the database through an SSH bastion or a SOCKS5 proxy,
+// it is used when the database is only reachable through a jump host.
+type TunnelConfig struct {
+	Type string `toml:"type" json:"type" yaml:"type"`
+	// address of the SSH server or the SOCKS5 proxy
+	Host string `toml:"host" json:"host" yaml:"host"`
+	Port int    `toml:"port" json:"port" yaml:"port"`
+	User string `toml:"user" json:"user" yaml:"user,omitempty"`
+	// password of the SSH user or the SOCKS5 user, can be encrypted by `dmctl encrypt`
+	Password string `toml:"password" json:"-" yaml:"password,omitempty"`
+	// private key file of the SSH user
+	PrivateKey string `toml:"private-key" json:"private-key" yaml:"private-key,omitempty"`
+	// known_hosts file to verify the SSH server, the host key is not verified if it's empty
+	KnownHosts string `toml:"known-hosts" json:"known-hosts" yaml:"known-hosts,omitempty"`
+}
+
+// Verify verifies the tunnel config, a nil config means no tunnel.
+func (c *TunnelConfig) Verify() error {
+	if c == nil {
+		return nil
+	}
+	switch c.Type {
+	case TunnelSSH:
+		if c.User == "" {
+			return terror.ErrConfigInvalidTunnel.Generate("`user` should be set for SSH tunnel")
+		}
+		if c.Password == "" && c.PrivateKey == "" {
+			return terror.ErrConfigInvalidTunnel.Generate("`password` or `private-key` should be set for SSH tunnel")
+		}
+	case TunnelSOCKS5:
+		if c.PrivateKey != "" || c.KnownHosts != "" {
+			return terror.ErrConfigInvalidTunnel.Generate("`private-key` and `known-hosts` are only supported by SSH tunnel")
+		}
+	default:
+		return terror.ErrConfigInvalidTunnel.Generate(fmt.Sprintf("`type` should be %s or %s, but got %q", TunnelSSH, TunnelSOCKS5, c.Type))
+	}
+	if c.Host == "" {
+		return terror.ErrConfigInvalidTunnel.Generate("`host` should be set")
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return terror.ErrConfigInvalidTunnel.Generate(fmt.Sprintf("`port` %d should be in [1, 65535]", c.Port))
+	}
+	return nil
+}
+
+// Clone returns a deep copy of the tunnel config.
+func (c *TunnelConfig) Clone() *TunnelConfig {
+	if c == nil {
+		return nil
+	}
+	clone := *c
+	return &clone
+}
@@ -36,6 +36,17 @@ const (
 	EncryptCmdName = "encrypt"
 	// DecryptCmdName is special command.
 	DecryptCmdName = "decrypt"
+	// TaskGraphCmdName is special command, which doesn't need to connect to DM-master.
+	TaskGraphCmdName = "task-graph"
+	// InitTaskCmdName is special command, which doesn't need to connect to DM-master.
+	InitTaskCmdName = "init-task"
+	// ContextCmdName is special command, which doesn't need to connect to DM-master.
+	ContextCmdName = "context"
+
+	// OutputJSON prints the responses in JSON.
+	OutputJSON = "json"
+	// OutputYAML prints the responses in YAML.
+	OutputYAML = "yaml"
 
 	// Master specifies member master type.
 	Master = "master"
@@ -53,7 +64,7 @@ const (
 	DefaultWarnCnt = 10
 )
 
-var argsNeedAdjust = [...]string{"-version", "-config", "-master-addr", "-rpc-timeout", "-ssl-ca", "-ssl-cert", "-ssl-key", "-" + EncryptCmdName, "-" + DecryptCmdName}
+var argsNeedAdjust = [...]string{"-version", "-config", "-master-addr", "-rpc-timeout", "-ssl-ca", "-ssl-cert", "-ssl-key", "-context", "-output", "-" + EncryptCmdName, "-" + DecryptCmdName}
 
 // NewConfig creates a new base config for dmctl.
 func NewConfig(fs *pflag.FlagSet) *Config {
@@ -71,6 +82,8 @@ func DefineConfigFlagSet(fs *pflag.FlagSet) {
 	fs.String("ssl-ca", "", "Path of file that contains list of trusted SSL CAs for connection.")
 	fs.String("ssl-cert", "", "Path of file that contains X509 certificate in PEM format for connection.")
 	fs.String("ssl-key", "", "Path of file that contains X509 key in PEM format for connection.")
+	fs.String("context", "", "Name of the dmctl context to use, the current context is used if not specified.")
+	fs.String("output", "", fmt.Sprintf("Output format of the responses, %s or %s, default is %s.", OutputJSON, OutputYAML, OutputJSON))
 	fs.String(EncryptCmdName, "", "Encrypts plaintext to ciphertext.")
 	fs.String(DecryptCmdName, "", "Decrypts ciphertext to plaintext.")
 	_ = fs.MarkHidden(EncryptCmdName)
@@ -115,6 +128,14 @@ func (c *Config) getConfigFromFlagSet() error {
 		return err
 	}
 	c.SSLKey, err = fs.GetString("ssl-key")
+	if err != nil {
+		return err
+	}
+	c.Context, err = fs.GetString("context")
+	if err != nil {
+		return err
+	}
+	c.Output, err = fs.GetString("output")
 	return err
 }
 
@@ -129,6 +150,10 @@ type Config struct {
 
 	ConfigFile string `json:"config-file"`
 
+	// name of the dmctl context which fills the items not specified.
+	Context string `json:"context"`
+	Output  string `toml:"output" json:"output"`
+
 	config.Security
 }
 
@@ -162,6 +187,11 @@ func (c *Config) Adjust() error {
 		return errors.Trace(err)
 	}
 
+	// fill the items not specified from the dmctl context.
+	if err = c.applyContext(); err != nil {
+		return err
+	}
+
 	// try get master Addr from env "DM_MASTER_ADDR" if this flag is empty.
 	if c.MasterAddr == "" {
 		c.MasterAddr = os.Getenv("DM_MASTER_ADDR")
@@ -203,6 +233,14 @@ func (c *Config) adjust() error {
 		return errors.Errorf("invalid time duration: %s", c.RPCTimeoutStr)
 	}
 	c.RPCTimeout = timeout
+	return validateOutput(c.Output)
+}
+
+// validateOutput checks whether the output format is supported.
+func validateOutput(output string) error {
+	if output != "" && output != OutputJSON && output != OutputYAML {
+		return errors.Errorf("invalid output format %s, only support %s and %s", output, OutputJSON, OutputYAML)
+	}
 	return nil
 }
 
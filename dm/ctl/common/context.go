@@ -0,0 +1,185 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pingcap/errors"
+
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// ContextsFileEnv is the environment variable to specify the path of the dmctl contexts file,
+// `~/.dmctl/contexts.toml` is used if it's empty.
+const ContextsFileEnv = "DMCTL_CONTEXTS"
+
+// Context is a saved connection profile of a DM cluster, so the connection flags needn't be passed on every command.
+type Context struct {
+	Name       string `toml:"name" json:"name"`
+	MasterAddr string `toml:"master-addr" json:"master-addr"`
+	RPCTimeout string `toml:"rpc-timeout,omitempty" json:"rpc-timeout,omitempty"`
+	SSLCA      string `toml:"ssl-ca,omitempty" json:"ssl-ca,omitempty"`
+	SSLCert    string `toml:"ssl-cert,omitempty" json:"ssl-cert,omitempty"`
+	SSLKey     string `toml:"ssl-key,omitempty" json:"ssl-key,omitempty"`
+	Output     string `toml:"output,omitempty" json:"output,omitempty"`
+}
+
+// Validate checks whether the items of the context are valid.
+func (c *Context) Validate() error {
+	if c.Name == "" {
+		return errors.New("context name should not be empty")
+	}
+	if c.MasterAddr != "" {
+		if err := validateAddr(c.MasterAddr); err != nil {
+			return errors.Annotatef(err, "specify master addr %s", c.MasterAddr)
+		}
+	}
+	if c.RPCTimeout != "" {
+		if timeout, err := time.ParseDuration(c.RPCTimeout); err != nil || timeout <= 0 {
+			return errors.Errorf("invalid time duration: %s", c.RPCTimeout)
+		}
+	}
+	return validateOutput(c.Output)
+}
+
+// Contexts is the content of the dmctl contexts file.
+type Contexts struct {
+	CurrentContext string     `toml:"current-context" json:"current-context"`
+	Contexts       []*Context `toml:"contexts" json:"contexts"`
+}
+
+// ContextsFile returns the path of the dmctl contexts file.
+func ContextsFile() (string, error) {
+	if path := os.Getenv(ContextsFileEnv); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.Annotatef(err, "get the path of dmctl contexts file, you can set it by environment variable '%s'", ContextsFileEnv)
+	}
+	return filepath.Join(home, ".dmctl", "contexts.toml"), nil
+}
+
+// LoadContexts loads the dmctl contexts file, no context is returned if the file doesn't exist.
+func LoadContexts(path string) (*Contexts, error) {
+	contexts := &Contexts{}
+	if !utils.IsFileExists(path) {
+		return contexts, nil
+	}
+	if _, err := toml.DecodeFile(path, contexts); err != nil {
+		return nil, errors.Annotatef(err, "load dmctl contexts file %s", path)
+	}
+	return contexts, nil
+}
+
+// Save writes the contexts into the dmctl contexts file, which is only accessible by the owner because it has the
+// paths of TLS material.
+func (c *Contexts) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return errors.Annotatef(err, "save dmctl contexts file %s", path)
+	}
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(c); err != nil {
+		return errors.Annotatef(err, "save dmctl contexts file %s", path)
+	}
+	return errors.Annotatef(utils.WriteFileAtomic(path, buf.Bytes(), 0o600), "save dmctl contexts file %s", path)
+}
+
+// Get returns the context with the name, nil if not found.
+func (c *Contexts) Get(name string) *Context {
+	for _, ctx := range c.Contexts {
+		if ctx.Name == name {
+			return ctx
+		}
+	}
+	return nil
+}
+
+// Set adds the context, or replaces the one with the same name.
+func (c *Contexts) Set(ctx *Context) {
+	for i, old := range c.Contexts {
+		if old.Name == ctx.Name {
+			c.Contexts[i] = ctx
+			return
+		}
+	}
+	c.Contexts = append(c.Contexts, ctx)
+}
+
+// Delete deletes the context with the name, and unsets the current context if it's deleted.
+// false is returned if not found.
+func (c *Contexts) Delete(name string) bool {
+	for i, ctx := range c.Contexts {
+		if ctx.Name == name {
+			c.Contexts = append(c.Contexts[:i], c.Contexts[i+1:]...)
+			if c.CurrentContext == name {
+				c.CurrentContext = ""
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// applyContext fills the items not specified by the command line flags or the config file from the context
+// specified by `--context`, or the current context.
+func (c *Config) applyContext() error {
+	path, err := ContextsFile()
+	if err != nil {
+		if c.Context == "" {
+			return nil
+		}
+		return err
+	}
+	contexts, err := LoadContexts(path)
+	if err != nil {
+		return err
+	}
+	name := c.Context
+	if name == "" {
+		name = contexts.CurrentContext
+	}
+	if name == "" {
+		return nil
+	}
+	ctx := contexts.Get(name)
+	if ctx == nil {
+		return errors.Errorf("dmctl context %s not found in %s, use `dmctl context list` to see all contexts", name, path)
+	}
+
+	if c.MasterAddr == "" {
+		c.MasterAddr = ctx.MasterAddr
+	}
+	if ctx.RPCTimeout != "" && (c.FlagSet == nil || !c.FlagSet.Changed("rpc-timeout")) {
+		c.RPCTimeoutStr = ctx.RPCTimeout
+	}
+	if c.SSLCA == "" {
+		c.SSLCA = ctx.SSLCA
+	}
+	if c.SSLCert == "" {
+		c.SSLCert = ctx.SSLCert
+	}
+	if c.SSLKey == "" {
+		c.SSLKey = ctx.SSLKey
+	}
+	if c.Output == "" {
+		c.Output = ctx.Output
+	}
+	return nil
+}
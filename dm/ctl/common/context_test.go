@@ -0,0 +1,139 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/check"
+	"github.com/spf13/pflag"
+)
+
+func TestCtlCommon(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&testContextSuite{})
+
+type testContextSuite struct{}
+
+func (t *testContextSuite) TestContexts(c *check.C) {
+	path := filepath.Join(c.MkDir(), "dmctl", "contexts.toml")
+	contexts, err := LoadContexts(path)
+	c.Assert(err, check.IsNil)
+	c.Assert(contexts.Contexts, check.HasLen, 0)
+
+	prod := &Context{Name: "prod", MasterAddr: "127.0.0.1:8261,127.0.0.2:8261", SSLCA: "ca.pem", Output: OutputYAML}
+	dev := &Context{Name: "dev", MasterAddr: "127.0.0.1:18261", RPCTimeout: "1m"}
+	c.Assert(prod.Validate(), check.IsNil)
+	c.Assert(dev.Validate(), check.IsNil)
+	c.Assert((&Context{MasterAddr: "127.0.0.1:8261"}).Validate(), check.ErrorMatches, ".*name should not be empty.*")
+	c.Assert((&Context{Name: "a", MasterAddr: "127.0.0.1"}).Validate(), check.ErrorMatches, ".*missing port.*")
+	c.Assert((&Context{Name: "a", RPCTimeout: "-1s"}).Validate(), check.ErrorMatches, "invalid time duration.*")
+	c.Assert((&Context{Name: "a", Output: "xml"}).Validate(), check.ErrorMatches, "invalid output format xml.*")
+
+	contexts.Set(prod)
+	contexts.Set(dev)
+	contexts.CurrentContext = "prod"
+	c.Assert(contexts.Save(path), check.IsNil)
+	fi, err := os.Stat(path)
+	c.Assert(err, check.IsNil)
+	c.Assert(fi.Mode().Perm(), check.Equals, os.FileMode(0o600))
+
+	contexts, err = LoadContexts(path)
+	c.Assert(err, check.IsNil)
+	c.Assert(contexts.CurrentContext, check.Equals, "prod")
+	c.Assert(contexts.Contexts, check.DeepEquals, []*Context{prod, dev})
+	c.Assert(contexts.Get("dev"), check.DeepEquals, dev)
+	c.Assert(contexts.Get("test"), check.IsNil)
+
+	// replace
+	dev2 := &Context{Name: "dev", MasterAddr: "127.0.0.1:28261"}
+	contexts.Set(dev2)
+	c.Assert(contexts.Contexts, check.DeepEquals, []*Context{prod, dev2})
+
+	c.Assert(contexts.Delete("test"), check.IsFalse)
+	c.Assert(contexts.Delete("prod"), check.IsTrue)
+	c.Assert(contexts.CurrentContext, check.Equals, "")
+	c.Assert(contexts.Contexts, check.DeepEquals, []*Context{dev2})
+}
+
+func (t *testContextSuite) TestApplyContext(c *check.C) {
+	path := filepath.Join(c.MkDir(), "contexts.toml")
+	c.Assert(os.Setenv(ContextsFileEnv, path), check.IsNil)
+	defer os.Unsetenv(ContextsFileEnv)
+
+	newConfig := func(args ...string) *Config {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		DefineConfigFlagSet(fs)
+		c.Assert(fs.Parse(args), check.IsNil)
+		cfg := NewConfig(fs)
+		c.Assert(cfg.getConfigFromFlagSet(), check.IsNil)
+		return cfg
+	}
+
+	// no contexts file
+	cfg := newConfig()
+	c.Assert(cfg.applyContext(), check.IsNil)
+	c.Assert(cfg.MasterAddr, check.Equals, "")
+
+	contexts := &Contexts{CurrentContext: "prod"}
+	contexts.Set(&Context{Name: "prod", MasterAddr: "127.0.0.1:8261", RPCTimeout: "1m", SSLCA: "ca.pem", SSLCert: "cert.pem", SSLKey: "key.pem", Output: OutputYAML})
+	contexts.Set(&Context{Name: "dev", MasterAddr: "127.0.0.1:18261"})
+	c.Assert(contexts.Save(path), check.IsNil)
+
+	// the current context
+	cfg = newConfig()
+	c.Assert(cfg.applyContext(), check.IsNil)
+	c.Assert(cfg.MasterAddr, check.Equals, "127.0.0.1:8261")
+	c.Assert(cfg.RPCTimeoutStr, check.Equals, "1m")
+	c.Assert(cfg.SSLCA, check.Equals, "ca.pem")
+	c.Assert(cfg.SSLCert, check.Equals, "cert.pem")
+	c.Assert(cfg.SSLKey, check.Equals, "key.pem")
+	c.Assert(cfg.Output, check.Equals, OutputYAML)
+
+	// the flags take precedence
+	cfg = newConfig("--master-addr", "127.0.0.2:8261", "--rpc-timeout", "30s", "--output", OutputJSON)
+	c.Assert(cfg.applyContext(), check.IsNil)
+	c.Assert(cfg.MasterAddr, check.Equals, "127.0.0.2:8261")
+	c.Assert(cfg.RPCTimeoutStr, check.Equals, "30s")
+	c.Assert(cfg.SSLCA, check.Equals, "ca.pem")
+	c.Assert(cfg.Output, check.Equals, OutputJSON)
+
+	// the specified context
+	cfg = newConfig("--context", "dev")
+	c.Assert(cfg.applyContext(), check.IsNil)
+	c.Assert(cfg.MasterAddr, check.Equals, "127.0.0.1:18261")
+	c.Assert(cfg.RPCTimeoutStr, check.Equals, defaultRPCTimeout)
+	c.Assert(cfg.SSLCA, check.Equals, "")
+
+	cfg = newConfig("--context", "test")
+	c.Assert(cfg.applyContext(), check.ErrorMatches, "dmctl context test not found.*")
+}
+
+func (t *testContextSuite) TestJSONToYAML(c *check.C) {
+	s, err := jsonToYAML(`{"result": true, "msg": "", "sources": [{"source": "mysql-replica-01", "pos": 1234567890123, "lag": 1.5}]}`)
+	c.Assert(err, check.IsNil)
+	c.Assert(s, check.Equals, `msg: ""
+result: true
+sources:
+- lag: 1.5
+  pos: 1234567890123
+  source: mysql-replica-01`)
+
+	_, err = jsonToYAML("{")
+	c.Assert(err, check.NotNil)
+}
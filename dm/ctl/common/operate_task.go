@@ -19,8 +19,8 @@ import (
 	"github.com/pingcap/dm/dm/pb"
 )
 
-// OperateTask does operation on task.
-func OperateTask(op pb.TaskOp, name string, sources []string) (*pb.OperateTaskResponse, error) {
+// OperateTask does operation on task, force means ignoring the lock of the task.
+func OperateTask(op pb.TaskOp, name string, sources []string, force bool) (*pb.OperateTaskResponse, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -32,6 +32,7 @@ func OperateTask(op pb.TaskOp, name string, sources []string) (*pb.OperateTaskRe
 			Op:      op,
 			Name:    name,
 			Sources: sources,
+			Force:   force,
 		},
 		&resp,
 	)
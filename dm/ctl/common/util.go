@@ -36,8 +36,11 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
 
+	dmcommon "github.com/pingcap/dm/dm/common"
 	"github.com/pingcap/dm/dm/config"
 	"github.com/pingcap/dm/dm/pb"
 	"github.com/pingcap/dm/pkg/log"
@@ -79,7 +82,7 @@ func (c *CtlClient) updateMasterClient() error {
 	endpoints := c.EtcdClient.Endpoints()
 	for _, endpoint := range endpoints {
 		//nolint:staticcheck
-		conn, err = grpc.Dial(utils.UnwrapScheme(endpoint), c.tls.ToGRPCDialOption(), grpc.WithBackoffMaxDelay(3*time.Second), grpc.WithBlock(), grpc.WithTimeout(3*time.Second))
+		conn, err = grpc.Dial(utils.UnwrapScheme(endpoint), c.tls.ToGRPCDialOption(), grpc.WithBackoffMaxDelay(3*time.Second), grpc.WithBlock(), grpc.WithTimeout(3*time.Second), grpc.WithUserAgent("dmctl"))
 		if err == nil {
 			c.conn = conn
 			c.MasterClient = pb.NewMasterClient(conn)
@@ -98,6 +101,10 @@ func (c *CtlClient) sendRequest(
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
+	// report the operator for the operation audit log of DM-master.
+	if operator := os.Getenv("USER"); operator != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, dmcommon.OperatorMetadataKey, operator)
+	}
 	params := []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)}
 	for _, o := range opts {
 		params = append(params, reflect.ValueOf(o))
@@ -198,7 +205,7 @@ func PrettyPrintResponse(resp proto.Message) {
 	if err != nil {
 		PrintLinesf("%v", err)
 	} else {
-		fmt.Println(s)
+		printOutput(s)
 	}
 }
 
@@ -208,8 +215,60 @@ func PrettyPrintInterface(resp interface{}) {
 	if err != nil {
 		PrintLinesf("%v", err)
 	} else {
-		fmt.Println(string(s))
+		printOutput(string(s))
+	}
+}
+
+// printOutput prints the JSON string in the output format of the global config.
+func printOutput(s string) {
+	if globalConfig.Output == OutputYAML {
+		y, err := jsonToYAML(s)
+		if err != nil {
+			PrintLinesf("%v", err)
+			return
+		}
+		s = y
+	}
+	fmt.Println(s)
+}
+
+// jsonToYAML converts a JSON string to YAML, the numbers are kept as they are in JSON.
+func jsonToYAML(s string) (string, error) {
+	var v interface{}
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return "", errors.Trace(err)
+	}
+	out, err := yaml.Marshal(convertJSONNumber(v))
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+// convertJSONNumber converts the json.Number in the value decoded from JSON to int64 or float64, which are not
+// quoted in YAML.
+func convertJSONNumber(v interface{}) interface{} {
+	switch val := v.(type) {
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		if f, err := val.Float64(); err == nil {
+			return f
+		}
+		return val.String()
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = convertJSONNumber(item)
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = convertJSONNumber(item)
+		}
 	}
+	return v
 }
 
 func marshResponseToString(resp proto.Message) (string, error) {
@@ -59,7 +59,10 @@ func NewRootCmd() *cobra.Command {
 		master.NewPauseTaskCmd(),
 		master.NewResumeTaskCmd(),
 		master.NewCheckTaskCmd(),
-		//	master.NewUpdateTaskCmd(),
+		master.NewValidateConnectivityCmd(),
+		master.NewTaskGraphCmd(),
+		master.NewInitTaskCmd(),
+		master.NewUpdateTaskCmd(),
 		master.NewQueryStatusCmd(),
 		master.NewShowDDLLocksCmd(),
 		master.NewUnlockDDLLockCmd(),
@@ -73,6 +76,7 @@ func NewRootCmd() *cobra.Command {
 		master.NewOperateSchemaCmd(),
 		master.NewGetCfgCmd(),
 		master.NewHandleErrorCmd(),
+		master.NewQuarantineTableCmd(),
 		master.NewTransferSourceCmd(),
 		master.NewStartRelayCmd(),
 		master.NewStopRelayCmd(),
@@ -80,6 +84,12 @@ func NewRootCmd() *cobra.Command {
 		master.NewShardDDLLockCmd(),
 		master.NewSourceTableSchemaCmd(),
 		master.NewConfigCmd(),
+		master.NewClusterCmd(),
+		master.NewTaskLockCmd(),
+		master.NewProjectCmd(),
+		master.NewOperationHistoryCmd(),
+		master.NewKeySchemaCmd(),
+		master.NewContextCmd(),
 		newDecryptCmd(),
 		newEncryptCmd(),
 	)
@@ -209,7 +219,12 @@ func MainStart(args []string) {
 			os.Exit(0)
 		}
 
-		if cmd.Name() == common.DecryptCmdName || cmd.Name() == common.EncryptCmdName {
+		if cmd.Name() == common.DecryptCmdName || cmd.Name() == common.EncryptCmdName || cmd.Name() == common.TaskGraphCmdName ||
+			cmd.Name() == common.InitTaskCmdName {
+			return nil
+		}
+		// the context commands only manage the local contexts file.
+		if cmd.HasParent() && cmd.Parent().Name() == common.ContextCmdName {
 			return nil
 		}
 
@@ -17,7 +17,6 @@ import (
 	"github.com/pingcap/dm/dm/ctl/common"
 	"github.com/pingcap/dm/dm/pb"
 
-	"github.com/pingcap/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -28,6 +27,9 @@ func NewBinlogCmd() *cobra.Command {
 		Short: "manage upstream binlog operations",
 	}
 	cmd.PersistentFlags().StringP("binlog-pos", "b", "", "position used to match binlog event if matched the binlog operation will be applied. The format like \"mysql-bin|000001.000003:3270\"")
+	cmd.PersistentFlags().String("binlog-gtid", "", "GTID used to match binlog event if matched the binlog operation will be applied, it can't be used together with binlog-pos. The format like \"3ccc475b-2343-11e7-be21-6c0b84d59f30:14\"")
+	cmd.PersistentFlags().String("task", "", "the task name, used instead of the task-name argument")
+	cmd.PersistentFlags().Bool("preview", false, "show the event which the skip/replace/inject operation would be applied to, and ask for confirmation before applying it")
 	cmd.AddCommand(
 		newBinlogSkipCmd(),
 		newBinlogReplaceCmd(),
@@ -38,15 +40,34 @@ func NewBinlogCmd() *cobra.Command {
 	return cmd
 }
 
+// getBinlogTaskAndArgs returns the task name from `--task` or the first argument, and the remaining arguments.
+func getBinlogTaskAndArgs(cmd *cobra.Command) (string, []string, error) {
+	task, err := cmd.Flags().GetString("task")
+	if err != nil {
+		return "", nil, err
+	}
+	args := cmd.Flags().Args()
+	if task == "" {
+		if len(args) == 0 {
+			return "", nil, nil
+		}
+		task, args = common.GetTaskNameFromArgOrFile(args[0]), args[1:]
+	}
+	return task, args, nil
+}
+
 func newBinlogSkipCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "skip <task-name>",
-		Short: "skip the current error event or a specific binlog position (binlog-pos) event",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) != 1 {
+		Short: "skip the current error event or a specific binlog position (binlog-pos) or GTID (binlog-gtid) event",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			taskName, args, err := getBinlogTaskAndArgs(cmd)
+			if err != nil {
+				return err
+			}
+			if taskName == "" || len(args) != 0 {
 				return cmd.Help()
 			}
-			taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
 			return sendHandleErrorRequest(cmd, pb.ErrorOp_Skip, taskName, nil)
 		},
 	}
@@ -56,17 +77,9 @@ func newBinlogSkipCmd() *cobra.Command {
 func newBinlogReplaceCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "replace <task-name> <replace-sql1> <replace-sql2>...",
-		Short: "replace the current error event or a specific binlog position (binlog-pos) ddl event with some ddls",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) <= 1 {
-				return cmd.Help()
-			}
-			taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
-			sqls, err := common.ExtractSQLsFromArgs(cmd.Flags().Args()[1:])
-			if err != nil {
-				return err
-			}
-			return sendHandleErrorRequest(cmd, pb.ErrorOp_Replace, taskName, sqls)
+		Short: "replace the current error event or a specific binlog position (binlog-pos) or GTID (binlog-gtid) ddl event with some ddls",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return sendBinlogSQLsRequest(cmd, pb.ErrorOp_Replace)
 		},
 	}
 	return cmd
@@ -75,27 +88,44 @@ func newBinlogReplaceCmd() *cobra.Command {
 func newBinlogRevertCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "revert <task-name>",
-		Short: "revert the current binlog operation or a specific binlog position (binlog-pos) operation",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
+		Short: "revert the current binlog operation or a specific binlog position (binlog-pos) or GTID (binlog-gtid) operation",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			taskName, _, err := getBinlogTaskAndArgs(cmd)
+			if err != nil {
+				return err
+			}
+			if taskName == "" {
 				return cmd.Help()
 			}
-			taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
 			return sendHandleErrorRequest(cmd, pb.ErrorOp_Revert, taskName, nil)
 		},
 	}
 	return cmd
 }
 
-// FIXME: implement this later.
 func newBinlogInjectCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:    "inject <task-name> <inject-sql1> <inject-sql2>...",
-		Short:  "inject the current error event or a specific binlog position (binlog-pos) ddl event with some ddls",
-		Hidden: true,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			return errors.Errorf("this function will be supported later")
+		Use:   "inject <task-name> <inject-sql1> <inject-sql2>...",
+		Short: "inject some ddls before the current error event or a specific binlog position (binlog-pos) or GTID (binlog-gtid) ddl event",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return sendBinlogSQLsRequest(cmd, pb.ErrorOp_Inject)
 		},
 	}
 	return cmd
 }
+
+// sendBinlogSQLsRequest sends the replace or inject request with the SQLs in arguments.
+func sendBinlogSQLsRequest(cmd *cobra.Command, op pb.ErrorOp) error {
+	taskName, args, err := getBinlogTaskAndArgs(cmd)
+	if err != nil {
+		return err
+	}
+	if taskName == "" || len(args) == 0 {
+		return cmd.Help()
+	}
+	sqls, err := common.ExtractSQLsFromArgs(args)
+	if err != nil {
+		return err
+	}
+	return sendHandleErrorRequest(cmd, op, taskName, sqls)
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"strings"
+
+	"github.com/pingcap/check"
+	"github.com/spf13/cobra"
+)
+
+func (t *testCtlMaster) TestGetBinlogTaskAndArgs(c *check.C) {
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := NewBinlogCmd()
+		inject, _, err := cmd.Find([]string{"inject"})
+		c.Assert(err, check.IsNil)
+		c.Assert(inject.ParseFlags(args), check.IsNil)
+		return inject
+	}
+
+	task, args, err := getBinlogTaskAndArgs(newCmd())
+	c.Assert(err, check.IsNil)
+	c.Assert(task, check.Equals, "")
+	c.Assert(args, check.HasLen, 0)
+
+	task, args, err = getBinlogTaskAndArgs(newCmd("test", "alter table tb add column a int"))
+	c.Assert(err, check.IsNil)
+	c.Assert(task, check.Equals, "test")
+	c.Assert(args, check.DeepEquals, []string{"alter table tb add column a int"})
+
+	task, args, err = getBinlogTaskAndArgs(newCmd("--task", "test", "--binlog-gtid", "3ccc475b-2343-11e7-be21-6c0b84d59f30:14", "alter table tb add column a int"))
+	c.Assert(err, check.IsNil)
+	c.Assert(task, check.Equals, "test")
+	c.Assert(args, check.DeepEquals, []string{"alter table tb add column a int"})
+}
+
+func (t *testCtlMaster) TestReadConfirmation(c *check.C) {
+	cases := []struct {
+		input     string
+		confirmed bool
+	}{
+		{"y\n", true},
+		{" YES \n", true},
+		{"yes", true},
+		{"n\n", false},
+		{"\n", false},
+		{"", false},
+		{"yeah\n", false},
+	}
+	for _, cs := range cases {
+		confirmed, err := readConfirmation(strings.NewReader(cs.input))
+		c.Assert(err, check.IsNil)
+		c.Assert(confirmed, check.Equals, cs.confirmed, check.Commentf("input %q", cs.input))
+	}
+}
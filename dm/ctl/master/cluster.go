@@ -0,0 +1,129 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/upgrade"
+)
+
+// NewClusterCmd creates a Cluster command.
+func NewClusterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster <command>",
+		Short: "backup or restore the cluster states, or upgrade the cluster",
+	}
+	cmd.AddCommand(
+		newClusterBackupCmd(),
+		newClusterRestoreCmd(),
+		newClusterUpgradeCmd(),
+	)
+	return cmd
+}
+
+func newClusterBackupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup <file>",
+		Short: "Backup the sources, tasks, relay configs and shard DDL locks of the cluster to a file",
+		RunE:  clusterBackupFunc,
+	}
+	return cmd
+}
+
+func newClusterRestoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <file>",
+		Short: "Restore the cluster states from a backup file into a fresh cluster",
+		RunE:  clusterRestoreFunc,
+	}
+	return cmd
+}
+
+// clusterBackupFunc reads the cluster states from etcd and writes them to a file.
+func clusterBackupFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	file := cmd.Flags().Arg(0)
+
+	cli := common.GlobalCtlClient.EtcdClient
+	ver, _, err := upgrade.GetVersion(cli)
+	if err != nil {
+		common.PrintLinesf("can not get cluster version from etcd")
+		return err
+	}
+	backup, err := ha.GetClusterBackup(cli)
+	if err != nil {
+		common.PrintLinesf("can not get cluster states from etcd")
+		return err
+	}
+	backup.InternalVersion = ver.InternalNo
+	backup.ReleaseVersion = ver.ReleaseVer
+
+	content, err := json.MarshalIndent(backup, "", "  ")
+	if err != nil {
+		common.PrintLinesf("fail to marshal cluster backup")
+		return err
+	}
+	// the backup contains the source configs, so it's only readable by the owner.
+	if err = os.WriteFile(file, content, 0o600); err != nil {
+		common.PrintLinesf("can not write cluster backup to file `%s`", file)
+		return err
+	}
+	common.PrintLinesf("backup %d cluster states at revision %d to file `%s` succeed", len(backup.KVs), backup.Revision, file)
+	return nil
+}
+
+// clusterRestoreFunc sends the backup file to DM-master to restore the cluster states.
+func clusterRestoreFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	content, err := common.GetFileContent(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.RestoreClusterResponse{}
+	err = common.SendRequest(
+		ctx,
+		"RestoreCluster",
+		&pb.RestoreClusterRequest{
+			Backup: string(content),
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
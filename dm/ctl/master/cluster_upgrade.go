@@ -0,0 +1,121 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
+)
+
+func newClusterUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade <start | prepare-worker | resume-worker | finish | show> [worker-name] [--version version]",
+		Short: "Coordinates a rolling upgrade of the cluster, the task operations are blocked until it's finished",
+		Long: `Coordinates a rolling upgrade of the cluster, the task operations are blocked until it's finished.
+1. start --version version: starts the upgrade window.
+2. upgrade DM-master instances one by one.
+3. for each DM-worker:
+   prepare-worker worker-name: hands over its source to a free DM-worker, or pauses the subtasks of its source,
+   then restart the DM-worker with the new binary,
+   resume-worker worker-name: resumes the paused subtasks after the DM-worker is online.
+4. finish: verifies the versions and the schema migrations of the cluster, and finishes the upgrade window.`,
+		RunE: clusterUpgradeFunc,
+	}
+	cmd.Flags().String("version", "", "the release version upgraded to, like v2.0.7")
+	return cmd
+}
+
+func convertUpgradeClusterOp(t string) pb.UpgradeClusterOp {
+	switch t {
+	case "start":
+		return pb.UpgradeClusterOp_StartUpgrade
+	case "prepare-worker":
+		return pb.UpgradeClusterOp_PrepareWorkerUpgrade
+	case "resume-worker":
+		return pb.UpgradeClusterOp_ResumeWorkerUpgrade
+	case "finish":
+		return pb.UpgradeClusterOp_FinishUpgrade
+	case "show":
+		return pb.UpgradeClusterOp_ShowUpgrade
+	default:
+		return pb.UpgradeClusterOp_InvalidUpgradeClusterOp
+	}
+}
+
+// clusterUpgradeFunc does upgrade cluster request.
+func clusterUpgradeFunc(cmd *cobra.Command, _ []string) error {
+	argLen := len(cmd.Flags().Args())
+	if argLen < 1 || argLen > 2 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	op := convertUpgradeClusterOp(cmd.Flags().Arg(0))
+	if op == pb.UpgradeClusterOp_InvalidUpgradeClusterOp {
+		common.PrintLinesf("invalid operate '%s' on cluster upgrade", cmd.Flags().Arg(0))
+		return errors.New("please check output to see error")
+	}
+	var worker string
+	switch op {
+	case pb.UpgradeClusterOp_PrepareWorkerUpgrade, pb.UpgradeClusterOp_ResumeWorkerUpgrade:
+		if argLen != 2 {
+			common.PrintLinesf("cluster upgrade %s should specify the worker", cmd.Flags().Arg(0))
+			return errors.New("please check output to see error")
+		}
+		worker = cmd.Flags().Arg(1)
+	default:
+		if argLen != 1 {
+			cmd.SetOut(os.Stdout)
+			common.PrintCmdUsage(cmd)
+			return errors.New("please check output to see error")
+		}
+	}
+
+	version, err := cmd.Flags().GetString("version")
+	if err != nil {
+		return err
+	}
+	if op == pb.UpgradeClusterOp_StartUpgrade && version == "" {
+		common.PrintLinesf("cluster upgrade start should specify the version by --version")
+		return errors.New("please check output to see error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.UpgradeClusterResponse{}
+	err = common.SendRequest(
+		ctx,
+		"UpgradeCluster",
+		&pb.UpgradeClusterRequest{
+			Op:      op,
+			Version: version,
+			Worker:  worker,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
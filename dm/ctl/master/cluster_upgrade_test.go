@@ -0,0 +1,29 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/pb"
+)
+
+func (t *testCtlMaster) TestConvertUpgradeClusterOp(c *check.C) {
+	c.Assert(convertUpgradeClusterOp("start"), check.Equals, pb.UpgradeClusterOp_StartUpgrade)
+	c.Assert(convertUpgradeClusterOp("prepare-worker"), check.Equals, pb.UpgradeClusterOp_PrepareWorkerUpgrade)
+	c.Assert(convertUpgradeClusterOp("resume-worker"), check.Equals, pb.UpgradeClusterOp_ResumeWorkerUpgrade)
+	c.Assert(convertUpgradeClusterOp("finish"), check.Equals, pb.UpgradeClusterOp_FinishUpgrade)
+	c.Assert(convertUpgradeClusterOp("show"), check.Equals, pb.UpgradeClusterOp_ShowUpgrade)
+	c.Assert(convertUpgradeClusterOp("unknown"), check.Equals, pb.UpgradeClusterOp_InvalidUpgradeClusterOp)
+}
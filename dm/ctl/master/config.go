@@ -15,7 +15,6 @@ package master
 
 import (
 	"context"
-	"encoding/json"
 	"os"
 	"path"
 	"sort"
@@ -24,6 +23,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/spf13/cobra"
 	"go.etcd.io/etcd/clientv3"
+	"gopkg.in/yaml.v2"
 
 	"github.com/pingcap/dm/dm/config"
 	"github.com/pingcap/dm/dm/ctl/common"
@@ -35,8 +35,10 @@ import (
 var (
 	taskDirname          = "tasks"
 	sourceDirname        = "sources"
-	relayWorkersFilename = "relay_workers.json"
-	yamlSuffix           = ".yaml"
+	relayWorkersFilename = "relay_workers.yaml"
+	// relay workers are exported as JSON before, it's still imported for compatibility.
+	legacyRelayWorkersFilename = "relay_workers.json"
+	yamlSuffix                 = ".yaml"
 )
 
 // NewConfigCmd creates a Config command.
@@ -50,6 +52,7 @@ func NewConfigCmd() *cobra.Command {
 		newConfigSourceCmd(),
 		newConfigMasterCmd(),
 		newConfigWorkerCmd(),
+		newConfigAllCmd(),
 		newExportCfgsCmd(),
 		newImportCfgsCmd(),
 	)
@@ -171,11 +174,29 @@ func configWorkerList(cmd *cobra.Command, args []string) error {
 	return sendGetConfigRequest(pb.CfgType_WorkerType, name, output)
 }
 
+func newConfigAllCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all",
+		Short: "show all the task, source, master and worker configs with their etcd revisions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return cmd.Help()
+			}
+			output, err := cmd.Flags().GetString("path")
+			if err != nil {
+				return err
+			}
+			return sendGetAllConfigRequest(output)
+		},
+	}
+	return cmd
+}
+
 // newExportCfgsCmd creates a exportCfg command.
 func newExportCfgsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "export",
-		Short: "Export the configurations of sources and tasks",
+		Short: "Export the configurations of sources, tasks and relay workers",
 		RunE:  exportCfgsFunc,
 	}
 	cmd.Flags().StringP("dir", "d", "", "specify the configs directory, default is `./configs`")
@@ -187,7 +208,7 @@ func newExportCfgsCmd() *cobra.Command {
 func newImportCfgsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "import",
-		Short: "Import the configurations of sources and tasks",
+		Short: "Import the configurations of sources, tasks and relay workers",
 		RunE:  importCfgsFunc,
 	}
 	cmd.Flags().StringP("dir", "d", "", "specify the configs directory, default is `./configs`")
@@ -231,7 +252,24 @@ func exportCfgsFunc(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	// write relayWorkers
-	if err = writeRelayWorkers(path.Join(filePath, relayWorkersFilename), relayWorkersSet); err != nil {
+	if err = writeRelayWorkers(filePath, relayWorkersSet); err != nil {
+		return err
+	}
+	// remove the configs of the deleted sources and tasks exported before, so the directory can be managed by VCS.
+	sources := make(map[string]struct{}, len(sourceCfgsMap))
+	for source := range sourceCfgsMap {
+		sources[source] = struct{}{}
+	}
+	if err = removeStaleCfgFiles(sourceDir, sources); err != nil {
+		return err
+	}
+	tasks := make(map[string]struct{})
+	for _, subTaskCfgs := range subTaskCfgsMap {
+		for task := range subTaskCfgs {
+			tasks[task] = struct{}{}
+		}
+	}
+	if err = removeStaleCfgFiles(taskDir, tasks); err != nil {
 		return err
 	}
 
@@ -269,9 +307,8 @@ func importCfgsFunc(cmd *cobra.Command, args []string) error {
 	if err := createTasks(ctx, taskCfgs); err != nil {
 		return err
 	}
-	if len(relayWorkers) > 0 {
-		common.PrintLinesf("The original relay workers have been exported to `%s`.", path.Join(filePath, relayWorkersFilename))
-		common.PrintLinesf("Currently DM doesn't support recover relay workers. You may need to execute `transfer-source` and `start-relay` command manually.")
+	if err := startRelayWorkers(ctx, relayWorkers); err != nil {
+		return err
 	}
 
 	common.PrintLinesf("import configs from directory `%s` succeed", filePath)
@@ -284,8 +321,12 @@ func collectDirCfgs(dir string) ([]string, error) {
 		return nil, err
 	}
 
+	// other files like README may be put in the directory managed by VCS.
 	cfgs := make([]string, 0, len(files))
 	for _, f := range files {
+		if ext := path.Ext(f.Name()); f.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
 		cfg, err2 := common.GetFileContent(path.Join(dir, f.Name()))
 		if err2 != nil {
 			return nil, err2
@@ -395,7 +436,14 @@ func writeTaskCfgs(taskDir string, subTaskCfgsMap map[string]map[string]config.S
 	return nil
 }
 
-func writeRelayWorkers(relayWorkersFile string, relayWorkersSet map[string]map[string]struct{}) error {
+func writeRelayWorkers(dir string, relayWorkersSet map[string]map[string]struct{}) error {
+	relayWorkersFile := path.Join(dir, relayWorkersFilename)
+	for _, file := range []string{relayWorkersFile, path.Join(dir, legacyRelayWorkersFilename)} {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			common.PrintLinesf("can not remove relay workers file `%s` exported before", file)
+			return err
+		}
+	}
 	if len(relayWorkersSet) == 0 {
 		return nil
 	}
@@ -411,7 +459,7 @@ func writeRelayWorkers(relayWorkersFile string, relayWorkersSet map[string]map[s
 		relayWorkers[source] = workers
 	}
 
-	content, err := json.Marshal(relayWorkers)
+	content, err := yaml.Marshal(relayWorkers)
 	if err != nil {
 		common.PrintLinesf("fail to marshal relay workers")
 		return err
@@ -425,6 +473,27 @@ func writeRelayWorkers(relayWorkersFile string, relayWorkersSet map[string]map[s
 	return nil
 }
 
+// removeStaleCfgFiles removes the config files in dir whose names are not in names.
+func removeStaleCfgFiles(dir string, names map[string]struct{}) error {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		common.PrintLinesf("can not read directory `%s`", dir)
+		return err
+	}
+	for _, f := range files {
+		name := strings.TrimSuffix(f.Name(), yamlSuffix)
+		if _, ok := names[name]; ok || f.IsDir() || name == f.Name() {
+			continue
+		}
+		file := path.Join(dir, f.Name())
+		if err = os.Remove(file); err != nil {
+			common.PrintLinesf("can not remove stale config file `%s`", file)
+			return err
+		}
+	}
+	return nil
+}
+
 func collectCfgs(dir string) (sourceCfgs []string, taskCfgs []string, relayWorkers map[string][]string, err error) {
 	var (
 		sourceDir        = path.Join(dir, sourceDirname)
@@ -432,6 +501,9 @@ func collectCfgs(dir string) (sourceCfgs []string, taskCfgs []string, relayWorke
 		relayWorkersFile = path.Join(dir, relayWorkersFilename)
 		content          []byte
 	)
+	if !utils.IsFileExists(relayWorkersFile) {
+		relayWorkersFile = path.Join(dir, legacyRelayWorkersFilename)
+	}
 	if !utils.IsDirExists(dir) {
 		return nil, nil, nil, errors.Errorf("config directory `%s` not exists", dir)
 	}
@@ -454,7 +526,8 @@ func collectCfgs(dir string) (sourceCfgs []string, taskCfgs []string, relayWorke
 			common.PrintLinesf("fail to read relay workers config `%s`", relayWorkersFile)
 			return
 		}
-		err = json.Unmarshal(content, &relayWorkers)
+		// YAML is a superset of JSON, so the legacy file can be unmarshalled too.
+		err = yaml.Unmarshal(content, &relayWorkers)
 		if err != nil {
 			common.PrintLinesf("fail to unmarshal relay workers config `%s`", relayWorkersFile)
 			return
@@ -524,3 +597,42 @@ func createTasks(ctx context.Context, taskCfgs []string) error {
 	}
 	return nil
 }
+
+// startRelayWorkers starts relay for the sources on the workers, the workers which already started relay are skipped
+// by DM-master.
+func startRelayWorkers(ctx context.Context, relayWorkers map[string][]string) error {
+	if len(relayWorkers) == 0 {
+		return nil
+	}
+	common.PrintLinesf("start enabling relay for sources")
+
+	sources := make([]string, 0, len(relayWorkers))
+	for source := range relayWorkers {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	relayResp := &pb.OperateRelayResponse{}
+	for _, source := range sources {
+		err := common.SendRequest(
+			ctx,
+			"OperateRelay",
+			&pb.OperateRelayRequest{
+				Op:     pb.RelayOpV2_StartRelayV2,
+				Source: source,
+				Worker: relayWorkers[source],
+			},
+			&relayResp,
+		)
+		if err != nil {
+			common.PrintLinesf("fail to start relay workers")
+			return err
+		}
+		if !relayResp.Result {
+			common.PrettyPrintResponse(relayResp)
+			common.PrintLinesf("fail to start relay of source `%s` on workers %v, you may need to execute `start-relay` command manually", source, relayWorkers[source])
+			return errors.Errorf("fail to start relay workers")
+		}
+	}
+	return nil
+}
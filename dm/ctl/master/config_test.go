@@ -0,0 +1,66 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"os"
+	"path"
+
+	"github.com/pingcap/check"
+)
+
+func (t *testCtlMaster) TestExportImportCfgFiles(c *check.C) {
+	dir := c.MkDir()
+	taskDir, sourceDir, err := createDirectory(dir)
+	c.Assert(err, check.IsNil)
+
+	// the files of the deleted sources are removed, other files are kept.
+	for _, name := range []string{"source1.yaml", "source2.yaml", "README.md"} {
+		c.Assert(os.WriteFile(path.Join(sourceDir, name), []byte("source"), 0o644), check.IsNil)
+	}
+	c.Assert(os.WriteFile(path.Join(taskDir, "task1.yml"), []byte("task"), 0o644), check.IsNil)
+	c.Assert(removeStaleCfgFiles(sourceDir, map[string]struct{}{"source1": {}}), check.IsNil)
+	c.Assert(removeStaleCfgFiles(taskDir, map[string]struct{}{}), check.IsNil)
+	files, err := os.ReadDir(sourceDir)
+	c.Assert(err, check.IsNil)
+	c.Assert(files, check.HasLen, 2)
+	c.Assert(files[0].Name(), check.Equals, "README.md")
+	c.Assert(files[1].Name(), check.Equals, "source1.yaml")
+
+	// the legacy relay workers file is replaced.
+	c.Assert(os.WriteFile(path.Join(dir, legacyRelayWorkersFilename), []byte(`{"source1":["worker1"]}`), 0o644), check.IsNil)
+	sourceCfgs, taskCfgs, relayWorkers, err := collectCfgs(dir)
+	c.Assert(err, check.IsNil)
+	c.Assert(sourceCfgs, check.DeepEquals, []string{"source"})
+	c.Assert(taskCfgs, check.DeepEquals, []string{"task"})
+	c.Assert(relayWorkers, check.DeepEquals, map[string][]string{"source1": {"worker1"}})
+
+	c.Assert(writeRelayWorkers(dir, map[string]map[string]struct{}{
+		"source1": {"worker2": {}, "worker1": {}},
+	}), check.IsNil)
+	_, err = os.Stat(path.Join(dir, legacyRelayWorkersFilename))
+	c.Assert(os.IsNotExist(err), check.IsTrue)
+	content, err := os.ReadFile(path.Join(dir, relayWorkersFilename))
+	c.Assert(err, check.IsNil)
+	c.Assert(string(content), check.Equals, "source1:\n- worker1\n- worker2\n")
+	_, _, relayWorkers, err = collectCfgs(dir)
+	c.Assert(err, check.IsNil)
+	c.Assert(relayWorkers, check.DeepEquals, map[string][]string{"source1": {"worker1", "worker2"}})
+
+	// no relay workers
+	c.Assert(writeRelayWorkers(dir, nil), check.IsNil)
+	_, _, relayWorkers, err = collectCfgs(dir)
+	c.Assert(err, check.IsNil)
+	c.Assert(relayWorkers, check.HasLen, 0)
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+)
+
+// NewContextCmd creates a Context command.
+func NewContextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   common.ContextCmdName + " <command>",
+		Short: "Manages the saved connection profiles of DM clusters",
+		Long: fmt.Sprintf(`Manages the saved connection profiles (contexts) of DM clusters.
+The items not specified by the command line flags or the config file are filled from the context specified by --context,
+or the current context. Contexts are stored in ~/.dmctl/contexts.toml, or the file specified by environment variable '%s'.`,
+			common.ContextsFileEnv),
+	}
+	cmd.AddCommand(
+		newContextListCmd(),
+		newContextUseCmd(),
+		newContextSetCmd(),
+		newContextDeleteCmd(),
+	)
+	return cmd
+}
+
+func newContextListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists all contexts and the current context",
+		RunE:  contextListFunc,
+	}
+	return cmd
+}
+
+func newContextUseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use <name>",
+		Short: "Sets the current context",
+		RunE:  contextUseFunc,
+	}
+	return cmd
+}
+
+func newContextSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <name> [--master-addr addr] [--rpc-timeout timeout] [--ssl-ca ca] [--ssl-cert cert] [--ssl-key key] [--output json|yaml]",
+		Short: "Creates a context, or updates the specified items of a context",
+		RunE:  contextSetFunc,
+	}
+	cmd.Flags().String("master-addr", "", "master API server addresses of the cluster")
+	cmd.Flags().String("rpc-timeout", "", "RPC timeout")
+	cmd.Flags().String("ssl-ca", "", "path of file that contains list of trusted SSL CAs for connection")
+	cmd.Flags().String("ssl-cert", "", "path of file that contains X509 certificate in PEM format for connection")
+	cmd.Flags().String("ssl-key", "", "path of file that contains X509 key in PEM format for connection")
+	cmd.Flags().String("output", "", "output format of the responses, json or yaml")
+	return cmd
+}
+
+func newContextDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Deletes a context",
+		RunE:  contextDeleteFunc,
+	}
+	return cmd
+}
+
+// loadContexts loads the dmctl contexts file and returns its path.
+func loadContexts() (*common.Contexts, string, error) {
+	path, err := common.ContextsFile()
+	if err != nil {
+		return nil, "", err
+	}
+	contexts, err := common.LoadContexts(path)
+	return contexts, path, err
+}
+
+func contextListFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 0 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	contexts, _, err := loadContexts()
+	if err != nil {
+		return err
+	}
+	common.PrettyPrintInterface(contexts)
+	return nil
+}
+
+func contextUseFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	name := cmd.Flags().Arg(0)
+	contexts, path, err := loadContexts()
+	if err != nil {
+		return err
+	}
+	if contexts.Get(name) == nil {
+		return fmt.Errorf("dmctl context %s not found in %s", name, path)
+	}
+	contexts.CurrentContext = name
+	if err = contexts.Save(path); err != nil {
+		return err
+	}
+	common.PrintLinesf("switched to context `%s`, it takes effect on the next dmctl command", name)
+	return nil
+}
+
+func contextSetFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	name := cmd.Flags().Arg(0)
+	contexts, path, err := loadContexts()
+	if err != nil {
+		return err
+	}
+
+	ctx := &common.Context{Name: name}
+	if old := contexts.Get(name); old != nil {
+		*ctx = *old
+	}
+	for flag, item := range map[string]*string{
+		"master-addr": &ctx.MasterAddr,
+		"rpc-timeout": &ctx.RPCTimeout,
+		"ssl-ca":      &ctx.SSLCA,
+		"ssl-cert":    &ctx.SSLCert,
+		"ssl-key":     &ctx.SSLKey,
+		"output":      &ctx.Output,
+	} {
+		if !cmd.Flags().Changed(flag) {
+			continue
+		}
+		if *item, err = cmd.Flags().GetString(flag); err != nil {
+			return err
+		}
+	}
+	if err = ctx.Validate(); err != nil {
+		return err
+	}
+
+	contexts.Set(ctx)
+	// the first context becomes the current one.
+	if contexts.CurrentContext == "" {
+		contexts.CurrentContext = name
+	}
+	if err = contexts.Save(path); err != nil {
+		return err
+	}
+	common.PrintLinesf("context `%s` is saved to %s", name, path)
+	return nil
+}
+
+func contextDeleteFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	name := cmd.Flags().Arg(0)
+	contexts, path, err := loadContexts()
+	if err != nil {
+		return err
+	}
+	if !contexts.Delete(name) {
+		return fmt.Errorf("dmctl context %s not found in %s", name, path)
+	}
+	if err = contexts.Save(path); err != nil {
+		return err
+	}
+	common.PrintLinesf("context `%s` is deleted", name)
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/spf13/cobra"
 
 	"github.com/pingcap/dm/dm/ctl/common"
@@ -114,3 +115,41 @@ func sendGetConfigRequest(tp pb.CfgType, name, output string) error {
 	common.PrettyPrintResponse(resp)
 	return nil
 }
+
+// sendGetAllConfigRequest gets all the configs with their etcd revisions, the whole response is written to the output
+// file as a snapshot if output is not empty.
+func sendGetAllConfigRequest(output string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), common.GlobalConfig().RPCTimeout)
+	defer cancel()
+
+	resp := &pb.GetCfgResponse{}
+	err := common.SendRequest(
+		ctx,
+		"GetCfg",
+		&pb.GetCfgRequest{All: true},
+		&resp,
+	)
+	if err != nil {
+		common.PrintLinesf("can not get all configs")
+		return err
+	}
+
+	if resp.Result && len(output) != 0 {
+		mar := jsonpb.Marshaler{EmitDefaults: true, Indent: "    "}
+		snapshot, err2 := mar.MarshalToString(resp)
+		if err2 != nil {
+			return err2
+		}
+		if err2 = os.WriteFile(output, []byte(snapshot), 0o644); err2 != nil {
+			common.PrintLinesf("can not write configs to file %s", output)
+			return err2
+		}
+		resp = &pb.GetCfgResponse{
+			Result:   true,
+			Msg:      fmt.Sprintf("write configs to file %s succeed", output),
+			Revision: resp.Revision,
+		}
+	}
+	common.PrettyPrintResponse(resp)
+	return nil
+}
@@ -14,9 +14,13 @@
 package master
 
 import (
+	"bufio"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -98,6 +102,18 @@ func sendHandleErrorRequest(cmd *cobra.Command, op pb.ErrorOp, taskName string,
 			return err
 		}
 	}
+	var binlogGTID string
+	// only `binlog` commands support matching by GTID.
+	if cmd.Flags().Lookup("binlog-gtid") != nil {
+		binlogGTID, err = cmd.Flags().GetString("binlog-gtid")
+		if err != nil {
+			return err
+		}
+		if len(binlogGTID) != 0 && len(binlogPos) != 0 {
+			common.PrintLinesf("binlog-pos and binlog-gtid can't be specified at the same time")
+			return errors.New("please check output to see error")
+		}
+	}
 
 	sources, err := common.GetSourceArgs(cmd)
 	if err != nil {
@@ -107,20 +123,50 @@ func sendHandleErrorRequest(cmd *cobra.Command, op pb.ErrorOp, taskName string,
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	resp := &pb.HandleErrorResponse{}
-	err = common.SendRequest(
-		ctx,
-		"HandleError",
-		&pb.HandleErrorRequest{
-			Op:        op,
-			Task:      taskName,
-			BinlogPos: binlogPos,
-			Sqls:      sqls,
-			Sources:   sources,
-		},
-		&resp,
-	)
+	req := &pb.HandleErrorRequest{
+		Op:         op,
+		Task:       taskName,
+		BinlogPos:  binlogPos,
+		Sqls:       sqls,
+		Sources:    sources,
+		BinlogGTID: binlogGTID,
+	}
+
+	// only `binlog` commands support previewing, and revert has no event to preview.
+	var preview bool
+	if cmd.Flags().Lookup("preview") != nil && op != pb.ErrorOp_Revert {
+		preview, err = cmd.Flags().GetBool("preview")
+		if err != nil {
+			return err
+		}
+	}
+	if preview {
+		previewReq := *req
+		previewReq.Op = pb.ErrorOp_Preview
+		previewReq.Sqls = nil
+		previewResp := &pb.HandleErrorResponse{}
+		err = common.SendRequest(ctx, "HandleError", &previewReq, &previewResp)
+		if err != nil {
+			return err
+		}
+		common.PrettyPrintResponse(previewResp)
+		if !previewResp.Result {
+			return errors.New("fail to preview the event, please check output to see error")
+		}
 
+		fmt.Printf("Do you want to %s the event(s) above? [y/N]: ", strings.ToLower(op.String()))
+		confirmed, err2 := readConfirmation(os.Stdin)
+		if err2 != nil {
+			return err2
+		}
+		if !confirmed {
+			common.PrintLinesf("the %s operation is canceled", strings.ToLower(op.String()))
+			return nil
+		}
+	}
+
+	resp := &pb.HandleErrorResponse{}
+	err = common.SendRequest(ctx, "HandleError", req, &resp)
 	if err != nil {
 		return err
 	}
@@ -128,3 +174,16 @@ func sendHandleErrorRequest(cmd *cobra.Command, op pb.ErrorOp, taskName string,
 	common.PrettyPrintResponse(resp)
 	return nil
 }
+
+// readConfirmation reads a line from the reader and returns whether it's `y` or `yes`, case-insensitively.
+func readConfirmation(r io.Reader) (bool, error) {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,373 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// NewInitTaskCmd creates a InitTask command.
+func NewInitTaskCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   common.InitTaskCmdName + " [config-file]",
+		Short: "Generates a task configuration file by asking for the sources, downstream, shard mode and filters",
+		RunE:  initTaskFunc,
+	}
+	return cmd
+}
+
+// initTaskFunc asks for the task settings and writes the task configuration to the file or stdout, it doesn't need to
+// connect to DM-master.
+func initTaskFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) > 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	var (
+		file = cmd.Flags().Arg(0)
+		// the questions are written to stderr when the configuration is written to stdout.
+		promptOut io.Writer = os.Stdout
+	)
+	if file == "" {
+		promptOut = os.Stderr
+	} else if _, err := os.Stat(file); err == nil {
+		return fmt.Errorf("file %s already exists", file)
+	}
+
+	skeleton, err := askTaskSkeleton(newPrompter(os.Stdin, promptOut))
+	if err != nil {
+		return err
+	}
+	content, err := skeleton.render()
+	if err != nil {
+		return err
+	}
+	if file == "" {
+		fmt.Print(content)
+		return nil
+	}
+	if err = os.WriteFile(file, []byte(content), 0o600); err != nil {
+		return err
+	}
+	common.PrintLinesf("the task configuration is written to %s, check it by `check-task %s` before starting the task", file, file)
+	return nil
+}
+
+// prompter asks questions and reads the answers line by line.
+type prompter struct {
+	r *bufio.Reader
+	w io.Writer
+}
+
+func newPrompter(r io.Reader, w io.Writer) *prompter {
+	return &prompter{r: bufio.NewReader(r), w: w}
+}
+
+// ask asks the question until the answer is valid, the default value is used if the answer is empty.
+func (p *prompter) ask(question, defaultValue string, validate func(string) error) (string, error) {
+	for {
+		if defaultValue != "" {
+			fmt.Fprintf(p.w, "%s [%s]: ", question, defaultValue)
+		} else {
+			fmt.Fprintf(p.w, "%s: ", question)
+		}
+		line, err := p.r.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			if err == io.EOF {
+				return "", errors.New("the input is closed before all questions are answered")
+			}
+			return "", err
+		}
+		answer := strings.TrimSpace(line)
+		if answer == "" {
+			answer = defaultValue
+		}
+		if validate == nil {
+			return answer, nil
+		}
+		if err = validate(answer); err != nil {
+			fmt.Fprintf(p.w, "  %s\n", err)
+			continue
+		}
+		return answer, nil
+	}
+}
+
+// choose asks for one of the options.
+func (p *prompter) choose(question string, options []string, defaultValue string) (string, error) {
+	return p.ask(fmt.Sprintf("%s (%s)", question, strings.Join(options, "/")), defaultValue, func(answer string) error {
+		for _, option := range options {
+			if answer == option {
+				return nil
+			}
+		}
+		return fmt.Errorf("please choose one of %s", strings.Join(options, ", "))
+	})
+}
+
+// confirm asks a yes or no question.
+func (p *prompter) confirm(question string, defaultValue bool) (bool, error) {
+	value := "n"
+	if defaultValue {
+		value = "y"
+	}
+	answer, err := p.choose(question, []string{"y", "n"}, value)
+	return answer == "y", err
+}
+
+// askList asks for a comma separated list.
+func (p *prompter) askList(question, defaultValue string) ([]string, error) {
+	answer, err := p.ask(question+" (comma separated)", defaultValue, func(answer string) error {
+		if len(splitList(answer)) == 0 {
+			return errors.New("please input at least one item")
+		}
+		return nil
+	})
+	return splitList(answer), err
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func notEmpty(answer string) error {
+	if answer == "" {
+		return errors.New("please input a value")
+	}
+	return nil
+}
+
+func isPort(answer string) error {
+	port, err := strconv.Atoi(answer)
+	if err != nil || port <= 0 || port > 65535 {
+		return errors.New("please input a port in [1, 65535]")
+	}
+	return nil
+}
+
+// skeletonSource is a source of the task skeleton.
+type skeletonSource struct {
+	SourceID   string
+	BinlogName string
+	BinlogPos  string
+	BinlogGTID string
+}
+
+// taskSkeleton is the answers to generate the task configuration.
+type taskSkeleton struct {
+	Name           string
+	Mode           string
+	ShardMode      string
+	Sources        []skeletonSource
+	TargetHost     string
+	TargetPort     string
+	TargetUser     string
+	TargetPassword string
+	DoDBs          []string
+	// route the sharded tables to one table, only for the shard mode
+	SchemaPattern string
+	TablePattern  string
+	TargetSchema  string
+	TargetTable   string
+	IgnoreDrop    bool
+	OnlineDDL     bool
+}
+
+// askTaskSkeleton asks for the settings of a task.
+func askTaskSkeleton(p *prompter) (*taskSkeleton, error) {
+	var (
+		s   = &taskSkeleton{}
+		err error
+	)
+	if s.Name, err = p.ask("task name", "", notEmpty); err != nil {
+		return nil, err
+	}
+	if s.Mode, err = p.choose("task mode, `all` migrates the full data and then replicates the binlog",
+		[]string{config.ModeAll, config.ModeFull, config.ModeIncrement, config.ModeSchemaOnly}, config.ModeAll); err != nil {
+		return nil, err
+	}
+
+	sourceIDs, err := p.askList("source IDs created by `operate-source create`", "")
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range sourceIDs {
+		source := skeletonSource{SourceID: id}
+		if s.Mode == config.ModeIncrement {
+			if source.BinlogName, err = p.ask(fmt.Sprintf("binlog file of %s to start replicating from", id), "mysql-bin.000001", notEmpty); err != nil {
+				return nil, err
+			}
+			if source.BinlogPos, err = p.ask(fmt.Sprintf("binlog position of %s to start replicating from", id), "4", notEmpty); err != nil {
+				return nil, err
+			}
+			if source.BinlogGTID, err = p.ask(fmt.Sprintf("binlog GTID set of %s to start replicating from, only used when GTID is enabled for the source", id), "", nil); err != nil {
+				return nil, err
+			}
+		}
+		s.Sources = append(s.Sources, source)
+	}
+
+	if s.TargetHost, err = p.ask("downstream host", "127.0.0.1", notEmpty); err != nil {
+		return nil, err
+	}
+	if s.TargetPort, err = p.ask("downstream port", "4000", isPort); err != nil {
+		return nil, err
+	}
+	if s.TargetUser, err = p.ask("downstream user", "root", notEmpty); err != nil {
+		return nil, err
+	}
+	password, err := p.ask("downstream password, it's encrypted in the configuration", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if password != "" {
+		if s.TargetPassword, err = utils.Encrypt(password); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.DoDBs, err = p.askList("upstream databases to migrate, regular expressions start with `~`", ""); err != nil {
+		return nil, err
+	}
+
+	shardMode := "no"
+	if len(s.Sources) > 1 {
+		shardMode = config.ShardPessimistic
+	}
+	if shardMode, err = p.choose("shard mode to merge the sharded tables into one downstream table, `no` if not merging",
+		[]string{"no", config.ShardPessimistic, config.ShardOptimistic}, shardMode); err != nil {
+		return nil, err
+	}
+	if shardMode != "no" {
+		s.ShardMode = shardMode
+		if s.SchemaPattern, err = p.ask("pattern of the upstream sharded databases, like `shard_db_*`", "", notEmpty); err != nil {
+			return nil, err
+		}
+		if s.TablePattern, err = p.ask("pattern of the upstream sharded tables, like `shard_table_*`", "", notEmpty); err != nil {
+			return nil, err
+		}
+		if s.TargetSchema, err = p.ask("downstream database to merge into", "", notEmpty); err != nil {
+			return nil, err
+		}
+		if s.TargetTable, err = p.ask("downstream table to merge into", "", notEmpty); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.IgnoreDrop, err = p.confirm("ignore the DROP DATABASE, DROP TABLE and TRUNCATE TABLE statements", s.ShardMode != ""); err != nil {
+		return nil, err
+	}
+	if s.OnlineDDL, err = p.confirm("replicate the DDLs executed by gh-ost or pt-osc", false); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+var taskSkeletonTemplate = template.Must(template.New("task").Funcs(template.FuncMap{"quote": strconv.Quote}).Parse(`# generated by ` + "`dmctl init-task`" + `, see ` + "`task_advanced.yaml`" + ` for all the options.
+---
+name: {{quote .Name}}  # the unique name of the task
+task-mode: {{quote .Mode}}  # full: migrate the full data only, incremental: replicate the binlog only, all: both
+{{- if .ShardMode}}
+shard-mode: {{quote .ShardMode}}  # how to coordinate the DDLs of the sharded tables, pessimistic or optimistic
+{{- end}}
+meta-schema: "dm_meta"  # the downstream database to save the checkpoints
+online-ddl: {{.OnlineDDL}}  # whether to replicate the DDLs executed by gh-ost or pt-osc
+
+target-database:
+  host: {{quote .TargetHost}}
+  port: {{.TargetPort}}
+  user: {{quote .TargetUser}}
+  password: {{quote .TargetPassword}}  # encrypted by ` + "`dmctl encrypt`" + `, plaintext is also supported
+
+mysql-instances:
+{{- range .Sources}}
+  - source-id: {{quote .SourceID}}
+    block-allow-list: "instance"
+{{- if $.ShardMode}}
+    route-rules: ["sharding-route-schema", "sharding-route-table"]
+{{- end}}
+{{- if $.IgnoreDrop}}
+    filter-rules: ["ignore-drop"]
+{{- end}}
+{{- if .BinlogName}}
+    meta:  # where to start replicating from if there is no checkpoint
+      binlog-name: {{quote .BinlogName}}
+      binlog-pos: {{.BinlogPos}}
+{{- if .BinlogGTID}}
+      binlog-gtid: {{quote .BinlogGTID}}
+{{- end}}
+{{- end}}
+{{- end}}
+
+block-allow-list:
+  instance:
+    do-dbs: [{{range $i, $db := .DoDBs}}{{if $i}}, {{end}}{{quote $db}}{{end}}]
+    ignore-dbs: ["mysql", "sys", "information_schema", "performance_schema"]
+{{- if .ShardMode}}
+
+routes:
+  sharding-route-schema:  # the DDLs on the databases are routed too
+    schema-pattern: {{quote .SchemaPattern}}
+    target-schema: {{quote .TargetSchema}}
+  sharding-route-table:
+    schema-pattern: {{quote .SchemaPattern}}
+    table-pattern: {{quote .TablePattern}}
+    target-schema: {{quote .TargetSchema}}
+    target-table: {{quote .TargetTable}}
+{{- end}}
+{{- if .IgnoreDrop}}
+
+filters:
+  ignore-drop:
+    schema-pattern: "*"
+    events: ["drop database", "drop table", "truncate table"]
+    action: Ignore
+{{- end}}
+`))
+
+// render renders the task configuration, it's verified to be decoded successfully.
+func (s *taskSkeleton) render() (string, error) {
+	var buf bytes.Buffer
+	if err := taskSkeletonTemplate.Execute(&buf, s); err != nil {
+		return "", err
+	}
+	content := buf.String()
+	cfg := config.NewTaskConfig()
+	if err := cfg.Decode(content); err != nil {
+		return "", err
+	}
+	return content, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+func askSkeleton(c *check.C, answers ...string) (*taskSkeleton, string) {
+	var out bytes.Buffer
+	s, err := askTaskSkeleton(newPrompter(strings.NewReader(strings.Join(answers, "\n")+"\n"), &out))
+	c.Assert(err, check.IsNil)
+	return s, out.String()
+}
+
+func (t *testCtlMaster) TestInitTaskSharding(c *check.C) {
+	s, out := askSkeleton(c,
+		"test",
+		"", // task-mode, default all
+		"mysql-01, mysql-02",
+		"",               // host
+		"abc",            // invalid port
+		"3306",           // port
+		"",               // user
+		"123456",         // password
+		"~^shard_db_.*$", // do-dbs
+		"",               // shard-mode, default pessimistic for multiple sources
+		"shard_db_*",
+		"shard_table_*",
+		"db",
+		"tb",
+		"", // ignore drop, default y for the shard mode
+		"n",
+	)
+	c.Assert(out, check.Matches, "(?s).*please input a port in \\[1, 65535\\].*")
+	c.Assert(s.Mode, check.Equals, config.ModeAll)
+	c.Assert(s.ShardMode, check.Equals, config.ShardPessimistic)
+	c.Assert(s.IgnoreDrop, check.IsTrue)
+	password, err := utils.Decrypt(s.TargetPassword)
+	c.Assert(err, check.IsNil)
+	c.Assert(password, check.Equals, "123456")
+
+	content, err := s.render()
+	c.Assert(err, check.IsNil)
+	cfg := config.NewTaskConfig()
+	c.Assert(cfg.Decode(content), check.IsNil)
+	c.Assert(cfg.Name, check.Equals, "test")
+	c.Assert(cfg.ShardMode, check.Equals, config.ShardPessimistic)
+	c.Assert(cfg.TargetDB.Port, check.Equals, 3306)
+	c.Assert(cfg.MySQLInstances, check.HasLen, 2)
+	c.Assert(cfg.MySQLInstances[1].SourceID, check.Equals, "mysql-02")
+	c.Assert(cfg.MySQLInstances[1].RouteRules, check.DeepEquals, []string{"sharding-route-schema", "sharding-route-table"})
+	c.Assert(cfg.MySQLInstances[1].FilterRules, check.DeepEquals, []string{"ignore-drop"})
+	c.Assert(cfg.Routes["sharding-route-table"].TargetTable, check.Equals, "tb")
+	c.Assert(cfg.BAList["instance"].DoDBs, check.DeepEquals, []string{"~^shard_db_.*$"})
+}
+
+func (t *testCtlMaster) TestInitTaskIncremental(c *check.C) {
+	s, _ := askSkeleton(c,
+		"test",
+		"unknown", // invalid task-mode
+		config.ModeIncrement,
+		"mysql-01",
+		"mysql-bin.000003",
+		"1234",
+		"",
+		"", "", "", "",
+		"db1,db2",
+		"", // shard-mode, default no for a single source
+		"", "",
+	)
+	c.Assert(s.ShardMode, check.Equals, "")
+	c.Assert(s.IgnoreDrop, check.IsFalse)
+	c.Assert(s.TargetPassword, check.Equals, "")
+
+	content, err := s.render()
+	c.Assert(err, check.IsNil)
+	cfg := config.NewTaskConfig()
+	c.Assert(cfg.Decode(content), check.IsNil)
+	c.Assert(cfg.TaskMode, check.Equals, config.ModeIncrement)
+	c.Assert(cfg.MySQLInstances[0].Meta.BinLogName, check.Equals, "mysql-bin.000003")
+	c.Assert(cfg.MySQLInstances[0].Meta.BinLogPos, check.Equals, uint32(1234))
+	c.Assert(cfg.Routes, check.HasLen, 0)
+	c.Assert(cfg.Filters, check.HasLen, 0)
+	c.Assert(cfg.BAList["instance"].DoDBs, check.DeepEquals, []string{"db1", "db2"})
+
+	// the input is closed before all questions are answered.
+	_, err = askTaskSkeleton(newPrompter(strings.NewReader("test\n"), &bytes.Buffer{}))
+	c.Assert(err, check.ErrorMatches, "the input is closed .*")
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
+)
+
+// NewKeySchemaCmd creates a KeySchema command.
+func NewKeySchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-schema [--dry-run] [--rollback-to version]",
+		Short: "Upgrades the etcd key schema of the cluster to the latest version, or rolls it back before downgrading DM-master",
+		RunE:  keySchemaFunc,
+	}
+	cmd.Flags().Bool("dry-run", false, "only show the versions and the keys to change without changing them")
+	cmd.Flags().Uint64("rollback-to", 0, "roll back the key schema to this version")
+	return cmd
+}
+
+// keySchemaFunc does migrate key schema request.
+func keySchemaFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 0 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+	version, err := cmd.Flags().GetUint64("rollback-to")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.MigrateKeySchemaResponse{}
+	err = common.SendRequest(
+		ctx,
+		"MigrateKeySchema",
+		&pb.MigrateKeySchemaRequest{
+			Rollback: cmd.Flags().Changed("rollback-to"),
+			Version:  version,
+			DryRun:   dryRun,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
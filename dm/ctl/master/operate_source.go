@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -32,8 +33,8 @@ import (
 // NewOperateSourceCmd creates a OperateSource command.
 func NewOperateSourceCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "operate-source <operate-type> [config-file ...] [--print-sample-config]",
-		Short: "`create`/`update`/`stop`/`show` upstream MySQL/MariaDB source",
+		Use:   "operate-source <operate-type> [config-file|config-dir ...] [--print-sample-config]",
+		Short: "`create`/`update`/`stop`/`show`/`disable`/`enable` upstream MySQL/MariaDB source",
 		RunE:  operateSourceFunc,
 	}
 	cmd.Flags().BoolP("print-sample-config", "p", false, "print sample config file of source")
@@ -50,11 +51,20 @@ func convertCmdType(t string) pb.SourceOp {
 		return pb.SourceOp_StopSource
 	case "show":
 		return pb.SourceOp_ShowSource
+	case "disable":
+		return pb.SourceOp_DisableSource
+	case "enable":
+		return pb.SourceOp_EnableSource
 	default:
 		return pb.SourceOp_InvalidSourceOp
 	}
 }
 
+// acceptSourceID returns whether the operation accepts source IDs besides config files.
+func acceptSourceID(op pb.SourceOp) bool {
+	return op == pb.SourceOp_StopSource || op == pb.SourceOp_DisableSource || op == pb.SourceOp_EnableSource
+}
+
 // operateMysqlFunc does migrate relay request.
 func operateSourceFunc(cmd *cobra.Command, _ []string) error {
 	printSampleConfig, err := cmd.Flags().GetBool("print-sample-config")
@@ -81,48 +91,52 @@ func operateSourceFunc(cmd *cobra.Command, _ []string) error {
 		return errors.New("please check output to see error")
 	}
 	if op != pb.SourceOp_ShowSource && len(cmd.Flags().Args()) == 1 {
-		common.PrintLinesf("operate-source create/update/stop should specify config-file(s)")
+		common.PrintLinesf("operate-source create/update/stop/disable/enable should specify config-file(s)")
 		return errors.New("please check output to see error")
 	}
 
-	contents := make([]string, 0, len(cmd.Flags().Args())-1)
-	sourceID := make([]string, 0, len(cmd.Flags().Args())-1)
-	sources, err := common.GetSourceArgs(cmd)
+	sourceID, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+	args, err := expandSourceConfigArgs(cmd.Flags().Args()[1:])
 	if err != nil {
 		return err
 	}
-	sourceID = append(sourceID, sources...)
 
-	for i := 1; i < len(cmd.Flags().Args()); i++ {
-		arg := cmd.Flags().Arg(i)
-		var content []byte
-		content, err = common.GetFileContent(arg)
-		if err != nil {
-			if op == pb.SourceOp_StopSource {
-				sourceID = append(sourceID, arg)
-				continue
-			}
-			return err
+	contents := make([]string, 0, len(args))
+	// the results of the config files failed to load, so users can fix them all at once.
+	failed := make([]*pb.CommonWorkerResponse, 0)
+	for _, arg := range args {
+		content, err2 := common.GetFileContent(arg)
+		if err2 != nil && acceptSourceID(op) {
+			sourceID = append(sourceID, arg)
+			continue
 		}
-		// If source is configured with tls certificate related content
-		// the contents of the certificate need to be read and transferred to the dm-master
-		cfg, yamlErr := config.ParseYaml(string(content))
-		if yamlErr != nil {
-			return yamlErr
+		if err2 == nil {
+			content, err2 = loadSourceConfigContent(content)
 		}
-		if cfg.From.Security != nil {
-			loadErr := cfg.From.Security.LoadTLSContent()
-			if loadErr != nil {
-				log.L().Warn("load tls content failed", zap.Error(terror.ErrCtlLoadTLSCfg.Generate(loadErr)))
+		if err2 != nil {
+			if acceptSourceID(op) {
+				return err2
 			}
-			yamlStr, yamlErr := cfg.Yaml()
-			if yamlErr != nil {
-				return yamlErr
-			}
-			content = []byte(yamlStr)
+			failed = append(failed, &pb.CommonWorkerResponse{
+				Result: false,
+				Msg:    fmt.Sprintf("%s: %s", arg, err2.Error()),
+			})
+			continue
 		}
 		contents = append(contents, string(content))
 	}
+	if len(failed) > 0 {
+		// don't operate any source if some config files are invalid.
+		common.PrettyPrintResponse(&pb.OperateSourceResponse{
+			Result:  false,
+			Msg:     fmt.Sprintf("%d of %d source config files are invalid, no source is operated", len(failed), len(args)),
+			Sources: failed,
+		})
+		return nil
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -146,3 +160,55 @@ func operateSourceFunc(cmd *cobra.Command, _ []string) error {
 	common.PrettyPrintResponse(resp)
 	return nil
 }
+
+// expandSourceConfigArgs replaces the directories in args with the source config files (*.yaml and *.yml) in them,
+// the files in a directory are sorted by name. the args not exist are kept, they may be source IDs.
+func expandSourceConfigArgs(args []string) ([]string, error) {
+	ret := make([]string, 0, len(args))
+	for _, arg := range args {
+		fi, err := os.Stat(arg)
+		if err != nil || !fi.IsDir() {
+			ret = append(ret, arg)
+			continue
+		}
+		entries, err := os.ReadDir(arg)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, entry := range entries {
+			ext := filepath.Ext(entry.Name())
+			if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+				continue
+			}
+			ret = append(ret, filepath.Join(arg, entry.Name()))
+			found = true
+		}
+		if !found {
+			return nil, fmt.Errorf("no source config file (*.yaml or *.yml) found in directory %s", arg)
+		}
+	}
+	return ret, nil
+}
+
+// loadSourceConfigContent checks the content of a source config file.
+// If source is configured with tls certificate related content
+// the contents of the certificate need to be read and transferred to the dm-master.
+func loadSourceConfigContent(content []byte) ([]byte, error) {
+	cfg, err := config.ParseYaml(string(content))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.From.Security != nil {
+		loadErr := cfg.From.Security.LoadTLSContent()
+		if loadErr != nil {
+			log.L().Warn("load tls content failed", zap.Error(terror.ErrCtlLoadTLSCfg.Generate(loadErr)))
+		}
+		yamlStr, err := cfg.Yaml()
+		if err != nil {
+			return nil, err
+		}
+		return []byte(yamlStr), nil
+	}
+	return content, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/pb"
+)
+
+func (t *testCtlMaster) TestExpandSourceConfigArgs(c *check.C) {
+	dir := c.MkDir()
+	for _, name := range []string{"b.yaml", "a.yml", "c.toml"} {
+		c.Assert(os.WriteFile(filepath.Join(dir, name), nil, 0o644), check.IsNil)
+	}
+	c.Assert(os.Mkdir(filepath.Join(dir, "d.yaml"), 0o755), check.IsNil)
+	file := filepath.Join(c.MkDir(), "source.yaml")
+	c.Assert(os.WriteFile(file, nil, 0o644), check.IsNil)
+
+	// directories are expanded, files and source IDs are kept.
+	args, err := expandSourceConfigArgs([]string{file, dir, "mysql-replica-01"})
+	c.Assert(err, check.IsNil)
+	c.Assert(args, check.DeepEquals, []string{
+		file,
+		filepath.Join(dir, "a.yml"),
+		filepath.Join(dir, "b.yaml"),
+		"mysql-replica-01",
+	})
+
+	// empty directory
+	_, err = expandSourceConfigArgs([]string{c.MkDir()})
+	c.Assert(err, check.ErrorMatches, "no source config file .* found in directory .*")
+}
+
+func (t *testCtlMaster) TestLoadSourceConfigContent(c *check.C) {
+	content := []byte("source-id: mysql-replica-01\nfrom:\n  host: 127.0.0.1\n")
+	ret, err := loadSourceConfigContent(content)
+	c.Assert(err, check.IsNil)
+	c.Assert(ret, check.DeepEquals, content)
+
+	_, err = loadSourceConfigContent([]byte("invalid-item: 1"))
+	c.Assert(err, check.NotNil)
+}
+
+func (t *testCtlMaster) TestConvertCmdType(c *check.C) {
+	c.Assert(convertCmdType("create"), check.Equals, pb.SourceOp_StartSource)
+	c.Assert(convertCmdType("disable"), check.Equals, pb.SourceOp_DisableSource)
+	c.Assert(convertCmdType("enable"), check.Equals, pb.SourceOp_EnableSource)
+	c.Assert(convertCmdType("unknown"), check.Equals, pb.SourceOp_InvalidSourceOp)
+	c.Assert(acceptSourceID(pb.SourceOp_DisableSource), check.IsTrue)
+	c.Assert(acceptSourceID(pb.SourceOp_StartSource), check.IsFalse)
+}
@@ -30,6 +30,7 @@ import (
 const (
 	batchSizeFlag    = "batch-size"
 	defaultBatchSize = 5
+	forceFlag        = "force"
 )
 
 type batchTaskResult struct {
@@ -63,8 +64,12 @@ func operateTaskFunc(taskOp pb.TaskOp, cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	force, err := cmd.Flags().GetBool(forceFlag)
+	if err != nil {
+		return err
+	}
 
-	resp, err := common.OperateTask(taskOp, name, sources)
+	resp, err := common.OperateTask(taskOp, name, sources, force)
 	if err != nil {
 		common.PrintLinesf("can not %s task %s", strings.ToLower(taskOp.String()), name)
 		return err
@@ -77,10 +82,11 @@ func operateTaskFunc(taskOp pb.TaskOp, cmd *cobra.Command) error {
 func addOperateSourceTaskFlags(cmd *cobra.Command) {
 	// control workload to dm-cluster for sources with large number of tasks.
 	cmd.Flags().Int(batchSizeFlag, defaultBatchSize, "batch size when operating all (sub)tasks bound to a source")
+	cmd.Flags().Bool(forceFlag, false, "operate the task even if it is locked by others")
 }
 
 func operateSourceTaskFunc(taskOp pb.TaskOp, cmd *cobra.Command) error {
-	source, batchSize, err := parseOperateSourceTaskParams(cmd)
+	source, batchSize, force, err := parseOperateSourceTaskParams(cmd)
 	if err != nil {
 		cmd.SetOut(os.Stdout)
 		common.PrintCmdUsage(cmd)
@@ -103,13 +109,13 @@ func operateSourceTaskFunc(taskOp pb.TaskOp, cmd *cobra.Command) error {
 		return nil
 	}
 
-	result := batchOperateTask(taskOp, batchSize, sources, resp.Sources[0].SubTaskStatus)
+	result := batchOperateTask(taskOp, batchSize, sources, force, resp.Sources[0].SubTaskStatus)
 	common.PrettyPrintInterface(result)
 
 	return nil
 }
 
-func batchOperateTask(taskOp pb.TaskOp, batchSize int, sources []string, subTaskStatus []*pb.SubTaskStatus) *batchTaskResult {
+func batchOperateTask(taskOp pb.TaskOp, batchSize int, sources []string, force bool, subTaskStatus []*pb.SubTaskStatus) *batchTaskResult {
 	result := batchTaskResult{Result: true, Tasks: []*operateTaskResult{}}
 
 	if len(subTaskStatus) < batchSize {
@@ -133,7 +139,7 @@ func batchOperateTask(taskOp pb.TaskOp, batchSize int, sources []string, subTask
 
 			for name := range workCh {
 				taskResult := operateTaskResult{Task: name, Op: taskOp.String()}
-				taskOpResp, err := common.OperateTask(taskOp, name, sources)
+				taskOpResp, err := common.OperateTask(taskOp, name, sources, force)
 				if err != nil {
 					taskResult.Result = false
 					taskResult.Msg = err.Error()
@@ -163,22 +169,26 @@ func batchOperateTask(taskOp pb.TaskOp, batchSize int, sources []string, subTask
 	return &result
 }
 
-func parseOperateSourceTaskParams(cmd *cobra.Command) (string, int, error) {
+func parseOperateSourceTaskParams(cmd *cobra.Command) (string, int, bool, error) {
 	sources, err := common.GetSourceArgs(cmd)
 	if err != nil {
-		return "", 0, err
+		return "", 0, false, err
 	}
 	if len(sources) == 0 {
 		common.PrintLinesf(`must give one source-name when task-name/task-conf is not specified`)
-		return "", 0, errors.New("missing source")
+		return "", 0, false, errors.New("missing source")
 	} else if len(sources) > 1 {
 		common.PrintLinesf(`can give only one source-name when task-name/task-conf is not specified`)
-		return "", 0, errors.New("too many source")
+		return "", 0, false, errors.New("too many source")
 	}
 	batchSize, err := cmd.Flags().GetInt(batchSizeFlag)
 	if err != nil {
 		common.PrintLinesf("error in parse `--" + batchSizeFlag + "`")
-		return "", 0, err
+		return "", 0, false, err
+	}
+	force, err := cmd.Flags().GetBool(forceFlag)
+	if err != nil {
+		return "", 0, false, err
 	}
-	return sources[0], batchSize, nil
+	return sources[0], batchSize, force, nil
 }
@@ -22,31 +22,31 @@ func (t *testCtlMaster) TestParseBatchTaskParameters(c *check.C) {
 	{
 		cmd := prepareTestCmd()
 		_ = cmd.ParseFlags([]string{"task-name"})
-		_, _, err := parseOperateSourceTaskParams(cmd)
+		_, _, _, err := parseOperateSourceTaskParams(cmd)
 		c.Assert(err, check.Not(check.IsNil))
 	}
 	{
 		cmd := prepareTestCmd()
-		_, _, err := parseOperateSourceTaskParams(cmd)
+		_, _, _, err := parseOperateSourceTaskParams(cmd)
 		c.Assert(err, check.Not(check.IsNil))
 	}
 	{
 		cmd := prepareTestCmd()
 		_ = cmd.ParseFlags([]string{"-s", "source-name", "-s", "source-name2"})
-		_, _, err := parseOperateSourceTaskParams(cmd)
+		_, _, _, err := parseOperateSourceTaskParams(cmd)
 		c.Assert(err, check.Not(check.IsNil))
 	}
 	{
 		cmd := prepareTestCmd()
 		_ = cmd.ParseFlags([]string{"-s", "source-name"})
-		source, _, err := parseOperateSourceTaskParams(cmd)
+		source, _, _, err := parseOperateSourceTaskParams(cmd)
 		c.Assert(source, check.Equals, "source-name")
 		c.Assert(err, check.IsNil)
 	}
 	{
 		cmd := prepareTestCmd()
 		_ = cmd.ParseFlags([]string{"-s", "source-name"})
-		source, batchSize, err := parseOperateSourceTaskParams(cmd)
+		source, batchSize, _, err := parseOperateSourceTaskParams(cmd)
 		c.Assert(source, check.Equals, "source-name")
 		c.Assert(batchSize, check.Equals, defaultBatchSize)
 		c.Assert(err, check.IsNil)
@@ -54,11 +54,19 @@ func (t *testCtlMaster) TestParseBatchTaskParameters(c *check.C) {
 	{
 		cmd := prepareTestCmd()
 		_ = cmd.ParseFlags([]string{"-s", "source-name", "--batch-size", "2"})
-		source, batchSize, err := parseOperateSourceTaskParams(cmd)
+		source, batchSize, _, err := parseOperateSourceTaskParams(cmd)
 		c.Assert(source, check.Equals, "source-name")
 		c.Assert(batchSize, check.Equals, 2)
 		c.Assert(err, check.IsNil)
 	}
+	{
+		cmd := prepareTestCmd()
+		_ = cmd.ParseFlags([]string{"-s", "source-name", "--force"})
+		source, _, force, err := parseOperateSourceTaskParams(cmd)
+		c.Assert(source, check.Equals, "source-name")
+		c.Assert(force, check.IsTrue)
+		c.Assert(err, check.IsNil)
+	}
 }
 
 func prepareTestCmd() *cobra.Command {
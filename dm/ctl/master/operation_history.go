@@ -0,0 +1,81 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
+)
+
+// NewOperationHistoryCmd creates an OperationHistory command.
+func NewOperationHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operation-history [--limit n] [--method method] [--operator operator]",
+		Short: "Shows the audit records of the mutating operations like start-task and purge-relay, newer records come first",
+		RunE:  operationHistoryFunc,
+	}
+	cmd.Flags().Int64("limit", 20, "max number of records to show, all records if not positive")
+	cmd.Flags().String("method", "", "only show the records of this RPC method, like StartTask")
+	cmd.Flags().String("operator", "", "only show the records of this operator")
+	return cmd
+}
+
+// operationHistoryFunc does operation history request.
+func operationHistoryFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 0 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	limit, err := cmd.Flags().GetInt64("limit")
+	if err != nil {
+		return err
+	}
+	method, err := cmd.Flags().GetString("method")
+	if err != nil {
+		return err
+	}
+	operator, err := cmd.Flags().GetString("operator")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.OperationHistoryResponse{}
+	err = common.SendRequest(
+		ctx,
+		"OperationHistory",
+		&pb.OperationHistoryRequest{
+			Limit:    limit,
+			Method:   method,
+			Operator: operator,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
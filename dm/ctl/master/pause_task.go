@@ -22,7 +22,7 @@ import (
 // NewPauseTaskCmd creates a PauseTask command.
 func NewPauseTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   `pause-task [-s source ...] [task-name | task-file]`,
+		Use:   `pause-task [-s source ...] [--force] [task-name | task-file]`,
 		Short: "Pauses a specified running task or all (sub)tasks bound to a source",
 		RunE:  pauseTaskFunc,
 	}
@@ -0,0 +1,107 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
+)
+
+// NewProjectCmd creates a Project command.
+func NewProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project <create | delete | status> [project-name] [task-name | task-file ...]",
+		Short: "`create`/`delete` a migration project grouping the tasks of one logical migration, or show its combined `status`",
+		RunE:  projectFunc,
+	}
+	return cmd
+}
+
+func convertProjectOp(t string) pb.ProjectOp {
+	switch t {
+	case "create":
+		return pb.ProjectOp_CreateProject
+	case "delete":
+		return pb.ProjectOp_DeleteProject
+	case "status":
+		return pb.ProjectOp_ShowProject
+	default:
+		return pb.ProjectOp_InvalidProjectOp
+	}
+}
+
+// projectFunc does operate project request.
+func projectFunc(cmd *cobra.Command, _ []string) error {
+	argLen := len(cmd.Flags().Args())
+	if argLen < 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	op := convertProjectOp(cmd.Flags().Arg(0))
+	switch {
+	case op == pb.ProjectOp_InvalidProjectOp:
+		common.PrintLinesf("invalid operate '%s' on project", cmd.Flags().Arg(0))
+		return errors.New("please check output to see error")
+	case op == pb.ProjectOp_CreateProject && argLen < 3:
+		common.PrintLinesf("project create should specify the project and its tasks")
+		return errors.New("please check output to see error")
+	case op == pb.ProjectOp_DeleteProject && argLen != 2:
+		common.PrintLinesf("project delete should specify the project")
+		return errors.New("please check output to see error")
+	case op == pb.ProjectOp_ShowProject && argLen > 2:
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	var (
+		name  string
+		tasks []string
+	)
+	if argLen > 1 {
+		name = cmd.Flags().Arg(1)
+		for _, arg := range cmd.Flags().Args()[2:] {
+			tasks = append(tasks, common.GetTaskNameFromArgOrFile(arg))
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.OperateProjectResponse{}
+	err := common.SendRequest(
+		ctx,
+		"OperateProject",
+		&pb.OperateProjectRequest{
+			Op:    op,
+			Name:  name,
+			Tasks: tasks,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
@@ -0,0 +1,27 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/pb"
+)
+
+func (t *testCtlMaster) TestConvertProjectOp(c *check.C) {
+	c.Assert(convertProjectOp("create"), check.Equals, pb.ProjectOp_CreateProject)
+	c.Assert(convertProjectOp("delete"), check.Equals, pb.ProjectOp_DeleteProject)
+	c.Assert(convertProjectOp("status"), check.Equals, pb.ProjectOp_ShowProject)
+	c.Assert(convertProjectOp("unknown"), check.Equals, pb.ProjectOp_InvalidProjectOp)
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
+)
+
+// NewQuarantineTableCmd creates a QuarantineTable command.
+func NewQuarantineTableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quarantine-table <add | release | repair | show> <task-name | task-file> [-s source ...] [-d database] [-t table]",
+		Short: "`add`/`release`/`repair`/`show` the quarantined downstream tables, whose row changes are buffered without pausing the task",
+		RunE:  quarantineTableFunc,
+	}
+	cmd.Flags().StringP("database", "d", "", "database name of the downstream table")
+	cmd.Flags().StringP("table", "t", "", "name of the downstream table")
+	return cmd
+}
+
+func convertQuarantineOp(t string) pb.QuarantineOp {
+	switch t {
+	case "add":
+		return pb.QuarantineOp_AddQuarantine
+	case "release":
+		return pb.QuarantineOp_ReleaseQuarantine
+	case "repair":
+		return pb.QuarantineOp_RepairQuarantine
+	case "show":
+		return pb.QuarantineOp_ShowQuarantine
+	default:
+		return pb.QuarantineOp_InvalidQuarantineOp
+	}
+}
+
+// quarantineTableFunc does quarantine table request.
+func quarantineTableFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 2 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	op := convertQuarantineOp(cmd.Flags().Arg(0))
+	if op == pb.QuarantineOp_InvalidQuarantineOp {
+		common.PrintLinesf("invalid operate '%s' on quarantine", cmd.Flags().Arg(0))
+		return errors.New("please check output to see error")
+	}
+	taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(1))
+
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+	database, err := cmd.Flags().GetString("database")
+	if err != nil {
+		return err
+	}
+	table, err := cmd.Flags().GetString("table")
+	if err != nil {
+		return err
+	}
+	if op != pb.QuarantineOp_ShowQuarantine && (database == "" || table == "") {
+		common.PrintLinesf("must specify 'database' and 'table' of the downstream table")
+		return errors.New("please check output to see error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.QuarantineTableResponse{}
+	err = common.SendRequest(
+		ctx,
+		"QuarantineTable",
+		&pb.QuarantineTableRequest{
+			Op:       op,
+			Task:     taskName,
+			Sources:  sources,
+			Database: database,
+			Table:    table,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+	common.PrettyPrintResponse(resp)
+	return nil
+}
@@ -42,11 +42,12 @@ type taskInfo struct {
 // NewQueryStatusCmd creates a QueryStatus command.
 func NewQueryStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "query-status [-s source ...] [task-name | task-file] [--more]",
+		Use:   "query-status [-s source ...] [task-name | task-file] [--more] [--since duration]",
 		Short: "Queries task status",
 		RunE:  queryStatusFunc,
 	}
 	cmd.Flags().BoolP("more", "", false, "whether to print the detailed task information")
+	cmd.Flags().String("since", "", "compare the progress with the history of a time window, like `1h`")
 	return cmd
 }
 
@@ -63,6 +64,11 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
+	since, err := cmd.Flags().GetString("since")
+	if err != nil {
+		common.PrintLinesf("error in parse `--since`")
+		return err
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), common.GlobalConfig().RPCTimeout)
 	defer cancel()
@@ -74,6 +80,7 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 		&pb.QueryStatusListRequest{
 			Name:    taskName,
 			Sources: sources,
+			Since:   since,
 		},
 		&resp,
 	)
@@ -89,7 +96,7 @@ func queryStatusFunc(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	if resp.Result && taskName == "" && len(sources) == 0 && !more {
+	if resp.Result && taskName == "" && len(sources) == 0 && !more && since == "" {
 		result, hasFalseResult := wrapTaskResult(resp)
 		if !hasFalseResult { // if any result is false, we still print the full status.
 			common.PrettyPrintInterface(result)
@@ -14,23 +14,74 @@
 package master
 
 import (
+	"context"
+	"errors"
+	"os"
+
 	"github.com/spf13/cobra"
 
+	"github.com/pingcap/dm/dm/ctl/common"
 	"github.com/pingcap/dm/dm/pb"
 )
 
 // NewResumeTaskCmd creates a ResumeTask command.
 func NewResumeTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "resume-task [-s source ...] [task-name | task-file]",
+		Use:   "resume-task [-s source ...] [--reset-error-budget] [--force] [task-name | task-file]",
 		Short: "Resumes a specified paused task or all (sub)tasks bound to a source",
 		RunE:  resumeTaskFunc,
 	}
 	addOperateSourceTaskFlags(cmd)
+	cmd.Flags().Bool("reset-error-budget", false, "reset the error budget of the downstream before resuming the task")
 	return cmd
 }
 
 // resumeTaskFunc does resume task request.
 func resumeTaskFunc(cmd *cobra.Command, _ []string) (err error) {
-	return operateTaskFunc(pb.TaskOp_Resume, cmd)
+	resetErrorBudget, err := cmd.Flags().GetBool("reset-error-budget")
+	if err != nil {
+		return err
+	}
+	if !resetErrorBudget {
+		return operateTaskFunc(pb.TaskOp_Resume, cmd)
+	}
+
+	// only support resetting the error budget of a specified task.
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	name := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+	sources, err := common.GetSourceArgs(cmd)
+	if err != nil {
+		return err
+	}
+	force, err := cmd.Flags().GetBool(forceFlag)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp := &pb.OperateTaskResponse{}
+	err = common.SendRequest(
+		ctx,
+		"OperateTask",
+		&pb.OperateTaskRequest{
+			Op:               pb.TaskOp_Resume,
+			Name:             name,
+			Sources:          sources,
+			ResetErrorBudget: true,
+			Force:            force,
+		},
+		&resp,
+	)
+	if err != nil {
+		common.PrintLinesf("can not resume task %s", name)
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
 }
@@ -32,11 +32,14 @@ import (
 // NewStartTaskCmd creates a StartTask command.
 func NewStartTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "start-task [-s source ...] [--remove-meta] <config-file>",
+		Use:   "start-task [-s source ...] [--remove-meta] [--allow-overlap] [--start-time time] [--dry-run] <config-file>",
 		Short: "Starts a task as defined in the configuration file",
 		RunE:  startTaskFunc,
 	}
 	cmd.Flags().BoolP("remove-meta", "", false, "whether to remove task's meta data")
+	cmd.Flags().BoolP("allow-overlap", "", false, "whether to start the task even if it writes to the same downstream tables as other tasks")
+	cmd.Flags().StringP("start-time", "", "", "start incremental replication from the first binlog event at or after this time, in the format of \"2006-01-02 15:04:05\" or RFC3339")
+	cmd.Flags().BoolP("dry-run", "", false, "write the SQL statements of incremental replication to files on DM-workers instead of executing them in downstream")
 	return cmd
 }
 
@@ -77,6 +80,24 @@ func startTaskFunc(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	allowOverlap, err := cmd.Flags().GetBool("allow-overlap")
+	if err != nil {
+		common.PrintLinesf("error in parse `--allow-overlap`")
+		return err
+	}
+
+	startTime, err := cmd.Flags().GetString("start-time")
+	if err != nil {
+		common.PrintLinesf("error in parse `--start-time`")
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		common.PrintLinesf("error in parse `--dry-run`")
+		return err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -86,9 +107,12 @@ func startTaskFunc(cmd *cobra.Command, _ []string) error {
 		ctx,
 		"StartTask",
 		&pb.StartTaskRequest{
-			Task:       string(content),
-			Sources:    sources,
-			RemoveMeta: removeMeta,
+			Task:         string(content),
+			Sources:      sources,
+			RemoveMeta:   removeMeta,
+			AllowOverlap: allowOverlap,
+			StartTime:    startTime,
+			DryRun:       dryRun,
 		},
 		&resp,
 	)
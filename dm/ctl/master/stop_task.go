@@ -22,7 +22,7 @@ import (
 // NewStopTaskCmd creates a StopTask command.
 func NewStopTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "stop-task [-s source ...] [task-name | task-file]",
+		Use:   "stop-task [-s source ...] [--force] [task-name | task-file]",
 		Short: "Stops a specified task or all (sub)tasks bound to a source",
 		RunE:  stopTaskFunc,
 	}
@@ -0,0 +1,86 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/ctl/common"
+)
+
+const (
+	taskGraphFormatDOT  = "dot"
+	taskGraphFormatJSON = "json"
+)
+
+// NewTaskGraphCmd creates a TaskGraph command.
+func NewTaskGraphCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   common.TaskGraphCmdName + " <config-file> [--format dot|json]",
+		Short: "Shows how upstream tables are routed into downstream tables in the task configuration file",
+		RunE:  taskGraphFunc,
+	}
+	cmd.Flags().String("format", taskGraphFormatDOT, "output format, dot or json")
+	return cmd
+}
+
+// taskGraphFunc generates the routing topology of the task, it doesn't need to connect to DM-master.
+func taskGraphFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return err
+	}
+	content, err := common.GetFileContent(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	output, err := genTaskGraph(string(content), format)
+	if err != nil {
+		return err
+	}
+	fmt.Print(output)
+	return nil
+}
+
+func genTaskGraph(content, format string) (string, error) {
+	if format != taskGraphFormatDOT && format != taskGraphFormatJSON {
+		return "", fmt.Errorf("invalid format %s, only support dot and json", format)
+	}
+
+	cfg := config.NewTaskConfig()
+	if err := cfg.Decode(content); err != nil {
+		return "", err
+	}
+	g := config.NewTaskGraph(cfg)
+	if format == taskGraphFormatDOT {
+		return g.DOT(), nil
+	}
+	data, err := json.MarshalIndent(g, "", "    ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pingcap/check"
+)
+
+const taskGraphTestConfig = `---
+name: test
+task-mode: all
+target-database:
+  host: "127.0.0.1"
+  port: 4000
+  user: "root"
+  password: ""
+
+mysql-instances:
+  - source-id: "mysql-replica-01"
+    route-rules: ["route-table"]
+
+routes:
+  route-table:
+    schema-pattern: "shard_db_*"
+    table-pattern: "shard_table_*"
+    target-schema: "db"
+    target-table: "tbl"
+`
+
+func (t *testCtlMaster) TestTaskGraph(c *check.C) {
+	output, err := genTaskGraph(taskGraphTestConfig, taskGraphFormatDOT)
+	c.Assert(err, check.IsNil)
+	c.Assert(output, check.Matches, "(?s)digraph .*`db`.`tbl`.*")
+
+	output, err = genTaskGraph(taskGraphTestConfig, taskGraphFormatJSON)
+	c.Assert(err, check.IsNil)
+	c.Assert(output, check.Matches, "(?s)\\{.*mysql-replica-01.*\\}\n")
+
+	_, err = genTaskGraph(taskGraphTestConfig, "svg")
+	c.Assert(err, check.ErrorMatches, "invalid format svg, only support dot and json")
+	_, err = genTaskGraph("invalid: [", taskGraphFormatDOT)
+	c.Assert(err, check.NotNil)
+
+	// the config file is read from a temporary directory, nothing is written into the working directory.
+	file := filepath.Join(c.MkDir(), "task.yaml")
+	c.Assert(os.WriteFile(file, []byte(taskGraphTestConfig), 0o644), check.IsNil)
+	cmd := NewTaskGraphCmd()
+	cmd.SetArgs([]string{file, "--format", taskGraphFormatJSON})
+	c.Assert(cmd.Execute(), check.IsNil)
+}
@@ -0,0 +1,117 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
+)
+
+// NewTaskLockCmd creates a TaskLock command.
+func NewTaskLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "task-lock <lock | unlock | show> [task-name | task-file] [--holder holder] [--reason reason] [--duration duration] [--force]",
+		Short: "`lock`/`unlock`/`show` the lock of a task, which guards the task from being paused/resumed/stopped by others",
+		RunE:  taskLockFunc,
+	}
+	cmd.Flags().String("holder", os.Getenv("USER"), "the holder of the lock")
+	cmd.Flags().String("reason", "", "the reason for locking the task")
+	cmd.Flags().Duration("duration", time.Hour, "the duration of the lock, the lock expires automatically after it")
+	cmd.Flags().Bool(forceFlag, false, "lock or unlock the task even if it is locked by others")
+	return cmd
+}
+
+func convertTaskLockOp(t string) pb.TaskLockOp {
+	switch t {
+	case "lock":
+		return pb.TaskLockOp_LockTask
+	case "unlock":
+		return pb.TaskLockOp_UnlockTask
+	case "show":
+		return pb.TaskLockOp_ShowTaskLock
+	default:
+		return pb.TaskLockOp_InvalidTaskLockOp
+	}
+}
+
+// taskLockFunc does operate task lock request.
+func taskLockFunc(cmd *cobra.Command, _ []string) error {
+	argLen := len(cmd.Flags().Args())
+	if argLen < 1 || argLen > 2 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	op := convertTaskLockOp(cmd.Flags().Arg(0))
+	if op == pb.TaskLockOp_InvalidTaskLockOp {
+		common.PrintLinesf("invalid operate '%s' on task lock", cmd.Flags().Arg(0))
+		return errors.New("please check output to see error")
+	}
+	var task string
+	if argLen == 2 {
+		task = common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(1))
+	} else if op != pb.TaskLockOp_ShowTaskLock {
+		common.PrintLinesf("task-lock lock/unlock should specify the task")
+		return errors.New("please check output to see error")
+	}
+
+	holder, err := cmd.Flags().GetString("holder")
+	if err != nil {
+		return err
+	}
+	reason, err := cmd.Flags().GetString("reason")
+	if err != nil {
+		return err
+	}
+	duration, err := cmd.Flags().GetDuration("duration")
+	if err != nil {
+		return err
+	}
+	force, err := cmd.Flags().GetBool(forceFlag)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.OperateTaskLockResponse{}
+	err = common.SendRequest(
+		ctx,
+		"OperateTaskLock",
+		&pb.OperateTaskLockRequest{
+			Op:       op,
+			Task:     task,
+			Holder:   holder,
+			Reason:   reason,
+			Duration: int64(duration / time.Second),
+			Force:    force,
+		},
+		&resp,
+	)
+	if err != nil {
+		return err
+	}
+
+	common.PrettyPrintResponse(resp)
+	return nil
+}
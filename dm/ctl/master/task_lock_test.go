@@ -0,0 +1,27 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/pb"
+)
+
+func (t *testCtlMaster) TestConvertTaskLockOp(c *check.C) {
+	c.Assert(convertTaskLockOp("lock"), check.Equals, pb.TaskLockOp_LockTask)
+	c.Assert(convertTaskLockOp("unlock"), check.Equals, pb.TaskLockOp_UnlockTask)
+	c.Assert(convertTaskLockOp("show"), check.Equals, pb.TaskLockOp_ShowTaskLock)
+	c.Assert(convertTaskLockOp("unknown"), check.Equals, pb.TaskLockOp_InvalidTaskLockOp)
+}
@@ -27,10 +27,11 @@ import (
 // NewTransferSourceCmd creates a TransferSource command.
 func NewTransferSourceCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "transfer-source <source-id> <worker-id>",
+		Use:   "transfer-source [--handover] <source-id> <worker-id>",
 		Short: "Transfers a upstream MySQL/MariaDB source to a free worker",
 		RunE:  transferSourceFunc,
 	}
+	cmd.Flags().Bool("handover", false, "pause the running subtasks and move the relay of the source to the new worker, then resume the subtasks there")
 	return cmd
 }
 
@@ -43,7 +44,10 @@ func transferSourceFunc(cmd *cobra.Command, _ []string) error {
 
 	sourceID := cmd.Flags().Arg(0)
 	workerID := cmd.Flags().Arg(1)
-	var err error
+	handover, err := cmd.Flags().GetBool("handover")
+	if err != nil {
+		return err
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -53,8 +57,9 @@ func transferSourceFunc(cmd *cobra.Command, _ []string) error {
 		ctx,
 		"TransferSource",
 		&pb.TransferSourceRequest{
-			Source: sourceID,
-			Worker: workerID,
+			Source:   sourceID,
+			Worker:   workerID,
+			Handover: handover,
 		},
 		&resp,
 	)
@@ -0,0 +1,68 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
+)
+
+// NewValidateConnectivityCmd creates a ValidateConnectivity command.
+func NewValidateConnectivityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-connectivity <task-name | config-file>",
+		Short: "Validates the connectivity and privileges to all upstream and downstream databases of a task from the DM-workers",
+		RunE:  validateConnectivityFunc,
+	}
+	return cmd
+}
+
+func validateConnectivityFunc(cmd *cobra.Command, _ []string) error {
+	if len(cmd.Flags().Args()) != 1 {
+		cmd.SetOut(os.Stdout)
+		common.PrintCmdUsage(cmd)
+		return errors.New("please check output to see error")
+	}
+
+	req := &pb.ValidateTaskConnectivityRequest{}
+	arg := cmd.Flags().Arg(0)
+	// a running task is specified by its name, like `GetTaskNameFromArgOrFile`.
+	if strings.HasSuffix(arg, ".yaml") || strings.HasSuffix(arg, ".yml") {
+		content, err := common.GetFileContent(arg)
+		if err != nil {
+			return err
+		}
+		req.Task = string(content)
+	} else {
+		req.Name = arg
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resp := &pb.ValidateTaskConnectivityResponse{}
+	err := common.SendRequest(ctx, "ValidateConnectivity", req, &resp)
+	if err != nil {
+		return err
+	}
+	common.PrettyPrintResponse(resp)
+	return nil
+}
@@ -76,7 +76,10 @@ func (s *Server) bootstrapBeforeSchedulerStart(ctx context.Context) error {
 		return nil
 	}
 
-	return upgrade.TryUpgradeBeforeSchedulerStart(ctx, s.etcdClient)
+	if err := upgrade.TryUpgradeBeforeSchedulerStart(ctx, s.etcdClient); err != nil {
+		return err
+	}
+	return upgrade.TryMigrateKeySchema(ctx, s.etcdClient)
 }
 
 // importFromV10x tries to import/upgrade the cluster from v1.0.x.
@@ -0,0 +1,167 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// client types of DM-master, they are distinguished by the user agent of the gRPC requests.
+const (
+	ClientTypeDMCtl   = "dmctl"
+	ClientTypeHTTP    = "http"
+	ClientTypeDefault = "default"
+	// requests forwarded by other DM-masters are never limited, they have been limited by the forwarding DM-master.
+	clientTypeDMMaster = "dm-master"
+
+	userAgentDMCtl    = "dmctl"
+	userAgentHTTP     = "dm-master-http"
+	userAgentDMMaster = "dm-master"
+
+	// limiters not used for this duration are removed.
+	clientLimiterIdleTimeout = 10 * time.Minute
+)
+
+// ClientRateLimit is the rate limit of the rate-limited RPCs for every client of a client type.
+type ClientRateLimit struct {
+	Rate  float64 `toml:"rate" json:"rate"`   // allowed requests per second
+	Burst int     `toml:"burst" json:"burst"` // max permits bursts
+}
+
+// clientType returns the client type of the request by its user agent.
+func clientType(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ClientTypeDefault
+	}
+	for _, ua := range md.Get("user-agent") {
+		// gRPC appends its own user agent like "dmctl grpc-go/1.26.0"
+		switch strings.Fields(ua + " ")[0] {
+		case userAgentDMCtl:
+			return ClientTypeDMCtl
+		case userAgentHTTP:
+			return ClientTypeHTTP
+		case userAgentDMMaster:
+			return clientTypeDMMaster
+		}
+	}
+	return ClientTypeDefault
+}
+
+// clientHost returns the host of the client, or empty string if unknown.
+func clientHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+type clientLimiterItem struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// clientLimiter limits the rate of the rate-limited RPCs for every client, a client is identified by its type and host.
+type clientLimiter struct {
+	mu       sync.Mutex
+	limits   map[string]ClientRateLimit // client type -> limit
+	methods  map[string]struct{}
+	limiters map[string]*clientLimiterItem // client type + host -> limiter
+	lastGC   time.Time
+}
+
+func newClientLimiter(limits map[string]ClientRateLimit, methods []string) *clientLimiter {
+	l := &clientLimiter{
+		limits:   limits,
+		methods:  make(map[string]struct{}, len(methods)),
+		limiters: make(map[string]*clientLimiterItem),
+		lastGC:   time.Now(),
+	}
+	for _, method := range methods {
+		l.methods[method] = struct{}{}
+	}
+	return l
+}
+
+// allow checks whether the request of the method is allowed, returns a throttling error with the retry-after duration
+// if not allowed.
+func (l *clientLimiter) allow(ctx context.Context, method string) error {
+	if l == nil || len(l.limits) == 0 {
+		return nil
+	}
+	if _, ok := l.methods[method]; !ok {
+		return nil
+	}
+	typ := clientType(ctx)
+	limit, ok := l.limits[typ]
+	if !ok {
+		return nil
+	}
+	host := clientHost(ctx)
+
+	l.mu.Lock()
+	now := time.Now()
+	l.gc(now)
+	key := typ + "/" + host
+	item, ok := l.limiters[key]
+	if !ok {
+		item = &clientLimiterItem{limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)}
+		l.limiters[key] = item
+	}
+	item.lastSeen = now
+	r := item.limiter.ReserveN(now, 1)
+	delay := r.DelayFrom(now)
+	if delay > 0 {
+		r.CancelAt(now)
+	}
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	retryAfter := delay.Round(time.Millisecond)
+	// the hint for gRPC clients, ignore the error because the header may have been sent.
+	_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.FormatFloat(retryAfter.Seconds(), 'f', -1, 64)))
+	return terror.ErrMasterRPCThrottled.Generate(method, typ, host, retryAfter)
+}
+
+// gc removes the idle limiters, it should be called with l.mu held.
+func (l *clientLimiter) gc(now time.Time) {
+	if now.Sub(l.lastGC) < clientLimiterIdleTimeout {
+		return
+	}
+	for key, item := range l.limiters {
+		if now.Sub(item.lastSeen) >= clientLimiterIdleTimeout {
+			delete(l.limiters, key)
+		}
+	}
+	l.lastGC = now
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"net"
+
+	"github.com/pingcap/check"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+var _ = check.Suite(&testClientLimiterSuite{})
+
+type testClientLimiterSuite struct{}
+
+func clientContext(userAgent, addr string) context.Context {
+	ctx := context.Background()
+	if userAgent != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("user-agent", userAgent))
+	}
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(ctx, &peer.Peer{Addr: tcpAddr})
+}
+
+func (t *testClientLimiterSuite) TestClientType(c *check.C) {
+	c.Assert(clientType(context.Background()), check.Equals, ClientTypeDefault)
+	c.Assert(clientType(clientContext("dmctl grpc-go/1.26.0", "127.0.0.1:1234")), check.Equals, ClientTypeDMCtl)
+	c.Assert(clientType(clientContext("dm-master-http grpc-go/1.26.0", "127.0.0.1:1234")), check.Equals, ClientTypeHTTP)
+	c.Assert(clientType(clientContext("dm-master grpc-go/1.26.0", "127.0.0.1:1234")), check.Equals, clientTypeDMMaster)
+	c.Assert(clientType(clientContext("grpc-go/1.26.0", "127.0.0.1:1234")), check.Equals, ClientTypeDefault)
+	c.Assert(clientHost(clientContext("", "127.0.0.1:1234")), check.Equals, "127.0.0.1")
+}
+
+func (t *testClientLimiterSuite) TestClientLimiter(c *check.C) {
+	var nilLimiter *clientLimiter
+	c.Assert(nilLimiter.allow(context.Background(), "QueryStatus"), check.IsNil)
+
+	l := newClientLimiter(map[string]ClientRateLimit{
+		ClientTypeDMCtl:   {Rate: 0.001, Burst: 2},
+		ClientTypeDefault: {Rate: 0.001, Burst: 1},
+	}, defaultRateLimitedRPCs)
+
+	ctl1 := clientContext("dmctl grpc-go/1.26.0", "127.0.0.1:1234")
+	// burst is allowed, and then throttled
+	c.Assert(l.allow(ctl1, "QueryStatus"), check.IsNil)
+	c.Assert(l.allow(ctl1, "QueryStatus"), check.IsNil)
+	err := l.allow(ctl1, "QueryStatus")
+	c.Assert(terror.ErrMasterRPCThrottled.Equal(err), check.IsTrue)
+	c.Assert(err, check.ErrorMatches, ".*request QueryStatus from dmctl client 127.0.0.1 is throttled.*")
+	// not rate-limited RPCs
+	c.Assert(l.allow(ctl1, "StartTask"), check.IsNil)
+
+	// clients on other hosts have their own quotas
+	ctl2 := clientContext("dmctl grpc-go/1.26.0", "127.0.0.2:1234")
+	c.Assert(l.allow(ctl2, "QueryStatus"), check.IsNil)
+
+	other := clientContext("grpc-go/1.26.0", "127.0.0.1:1234")
+	c.Assert(l.allow(other, "QueryStatus"), check.IsNil)
+	c.Assert(terror.ErrMasterRPCThrottled.Equal(l.allow(other, "QueryStatus")), check.IsTrue)
+
+	// no limit for HTTP clients and forwarded requests
+	http := clientContext("dm-master-http grpc-go/1.26.0", "127.0.0.1:1234")
+	master := clientContext("dm-master grpc-go/1.26.0", "127.0.0.1:1234")
+	for i := 0; i < 5; i++ {
+		c.Assert(l.allow(http, "QueryStatus"), check.IsNil)
+		c.Assert(l.allow(master, "QueryStatus"), check.IsNil)
+	}
+}
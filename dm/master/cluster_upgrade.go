@@ -0,0 +1,363 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/master/scheduler"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/upgrade"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// UpgradeCluster implements MasterServer.UpgradeCluster.
+func (s *Server) UpgradeCluster(ctx context.Context, req *pb.UpgradeClusterRequest) (*pb.UpgradeClusterResponse, error) {
+	var (
+		resp2 *pb.UpgradeClusterResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.UpgradeClusterResponse{}
+	s.clusterUpgradeMu.Lock()
+	defer s.clusterUpgradeMu.Unlock()
+
+	var (
+		u   ha.ClusterUpgrade
+		ok  bool
+		err error
+	)
+	switch req.Op {
+	case pb.UpgradeClusterOp_StartUpgrade:
+		u, err = s.startClusterUpgrade(req.Version)
+		ok = err == nil
+	case pb.UpgradeClusterOp_PrepareWorkerUpgrade:
+		u, err = s.prepareWorkerUpgrade(req.Worker)
+		ok = err == nil
+	case pb.UpgradeClusterOp_ResumeWorkerUpgrade:
+		u, err = s.resumeWorkerUpgrade(req.Worker)
+		ok = err == nil
+	case pb.UpgradeClusterOp_FinishUpgrade:
+		u, ok, err = s.finishClusterUpgrade(ctx)
+	case pb.UpgradeClusterOp_ShowUpgrade:
+		u, ok, _, err = ha.GetClusterUpgrade(s.etcdClient)
+	default:
+		err = terror.ErrMasterInvalidOperateOp.Generate(req.Op.String(), "cluster upgrade")
+	}
+	if ok {
+		resp.Upgrade = clusterUpgradeToPB(u)
+	}
+	if err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	resp.Result = true
+	return resp, nil
+}
+
+// startClusterUpgrade starts the upgrade window, starting the window to the same version again does nothing.
+func (s *Server) startClusterUpgrade(version string) (ha.ClusterUpgrade, error) {
+	if version == "" {
+		return ha.ClusterUpgrade{}, terror.ErrMasterWorkerArgsExtractor.Generatef("must specify the version to upgrade to")
+	}
+	u, ok, _, err := ha.GetClusterUpgrade(s.etcdClient)
+	if err != nil {
+		return u, err
+	}
+	if ok {
+		if u.TargetVersion != version {
+			return u, clusterUpgradingError(u)
+		}
+		return u, nil
+	}
+
+	ver, _, err := upgrade.GetVersion(s.etcdClient)
+	if err != nil {
+		return u, err
+	}
+	u = ha.NewClusterUpgrade(version, ver.ReleaseVer, time.Now())
+	if _, err = ha.PutClusterUpgrade(s.etcdClient, u); err != nil {
+		return u, err
+	}
+	log.L().Info("cluster upgrade started", zap.Stringer("upgrade", u))
+	return u, nil
+}
+
+// prepareWorkerUpgrade makes the DM-worker ready to be restarted with the new binary. the running subtasks of its
+// source are paused, and the source is handed over to a free DM-worker if any, then the subtasks are resumed there.
+// otherwise, the subtasks keep paused until the DM-worker is resumed.
+func (s *Server) prepareWorkerUpgrade(worker string) (ha.ClusterUpgrade, error) {
+	u, err := s.getClusterUpgrade()
+	if err != nil {
+		return u, err
+	}
+	if worker == "" {
+		return u, terror.ErrMasterWorkerArgsExtractor.Generatef("must specify the worker to prepare")
+	}
+	w := s.scheduler.GetWorkerByName(worker)
+	if w == nil {
+		return u, terror.ErrSchedulerWorkerNotExist.Generate(worker)
+	}
+	wu, ok := u.Workers[worker]
+	if ok && wu.Stage == ha.WorkerUpgradePrepared {
+		if wu.Source == "" || wu.HandoverTo != "" {
+			return u, nil
+		}
+		// the subtasks are paused but not handed over, try again in case a DM-worker becomes free.
+	} else {
+		wu = &ha.WorkerUpgrade{Worker: worker, Stage: ha.WorkerUpgradePrepared}
+		u.Workers[worker] = wu
+	}
+	if w.Stage() != scheduler.WorkerBound {
+		// no source to hand over, the DM-worker can be restarted directly.
+		_, err = ha.PutClusterUpgrade(s.etcdClient, u)
+		return u, err
+	}
+
+	wu.Source = w.Bound().Source
+	for task, subtasks := range s.scheduler.GetSubTaskCfgs() {
+		if _, ok := subtasks[wu.Source]; !ok {
+			continue
+		}
+		if s.scheduler.GetExpectSubTaskStage(task, wu.Source).Expect != pb.Stage_Running {
+			continue
+		}
+		if err = s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Paused, task, wu.Source); err != nil {
+			break
+		}
+		wu.PausedTasks = append(wu.PausedTasks, task)
+	}
+	sort.Strings(wu.PausedTasks)
+	// record the paused subtasks first, so they can be resumed later even if failed halfway.
+	_, err2 := ha.PutClusterUpgrade(s.etcdClient, u)
+	if err != nil {
+		return u, err
+	}
+	if err2 != nil {
+		return u, err2
+	}
+
+	for _, target := range s.handoverCandidates(u, worker) {
+		if err2 = s.scheduler.TransferSource(wu.Source, target); err2 != nil {
+			log.L().Warn("fail to hand over the source for upgrade", zap.String("source", wu.Source),
+				zap.String("from", worker), zap.String("to", target), log.ShortError(err2))
+			continue
+		}
+		wu.HandoverTo = target
+		if err = s.resumePausedTasks(wu.Source, wu.PausedTasks); err != nil {
+			break
+		}
+		wu.PausedTasks = nil
+		break
+	}
+	if _, err2 = ha.PutClusterUpgrade(s.etcdClient, u); err2 != nil {
+		return u, err2
+	}
+	log.L().Info("DM-worker prepared for upgrade", zap.String("worker", worker), zap.String("source", wu.Source),
+		zap.String("handover to", wu.HandoverTo), zap.Strings("paused tasks", wu.PausedTasks), log.ShortError(err))
+	return u, err
+}
+
+// handoverCandidates returns the free DM-workers the source can be handed over to, the DM-workers prepared but not
+// resumed are excluded because they're being restarted.
+func (s *Server) handoverCandidates(u ha.ClusterUpgrade, worker string) []string {
+	workers, err := s.scheduler.GetAllWorkers()
+	if err != nil {
+		return nil
+	}
+	candidates := make([]string, 0, len(workers))
+	for _, w := range workers {
+		name := w.BaseInfo().Name
+		if name == worker || w.Stage() != scheduler.WorkerFree {
+			continue
+		}
+		if wu, ok := u.Workers[name]; ok && wu.Stage == ha.WorkerUpgradePrepared {
+			continue
+		}
+		candidates = append(candidates, name)
+	}
+	sort.Strings(candidates)
+	return candidates
+}
+
+// resumeWorkerUpgrade resumes the subtasks paused when preparing the DM-worker, after it's restarted and online.
+func (s *Server) resumeWorkerUpgrade(worker string) (ha.ClusterUpgrade, error) {
+	u, err := s.getClusterUpgrade()
+	if err != nil {
+		return u, err
+	}
+	wu, ok := u.Workers[worker]
+	if !ok {
+		return u, terror.ErrMasterWorkerArgsExtractor.Generatef("worker %s is not prepared for upgrade", worker)
+	}
+	if wu.Stage == ha.WorkerUpgradeResumed {
+		return u, nil
+	}
+	if w := s.scheduler.GetWorkerByName(worker); w == nil || w.Stage() == scheduler.WorkerOffline {
+		return u, terror.ErrMasterClusterUpgradeVerifyFail.Generate(fmt.Sprintf("DM-worker %s is offline, please start it with the new binary first", worker))
+	}
+
+	if err = s.resumePausedTasks(wu.Source, wu.PausedTasks); err != nil {
+		return u, err
+	}
+	wu.Stage = ha.WorkerUpgradeResumed
+	wu.PausedTasks = nil
+	if _, err = ha.PutClusterUpgrade(s.etcdClient, u); err != nil {
+		return u, err
+	}
+	log.L().Info("DM-worker resumed after upgrade", zap.String("worker", worker), zap.String("source", wu.Source))
+	return u, nil
+}
+
+// resumePausedTasks resumes the paused subtasks of the source, the stopped ones are skipped.
+func (s *Server) resumePausedTasks(source string, tasks []string) error {
+	for _, task := range tasks {
+		if _, ok := s.scheduler.GetSubTaskCfgsByTask(task)[source]; !ok {
+			continue
+		}
+		if err := s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Running, task, source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finishClusterUpgrade verifies the cluster is upgraded and finishes the upgrade window, the window is kept if the
+// verification fails. the second return value indicates whether the window is kept.
+func (s *Server) finishClusterUpgrade(ctx context.Context) (ha.ClusterUpgrade, bool, error) {
+	u, err := s.getClusterUpgrade()
+	if err != nil {
+		return u, false, err
+	}
+	if err = s.verifyClusterUpgrade(ctx, u); err != nil {
+		return u, true, err
+	}
+	if _, err = ha.DeleteClusterUpgrade(s.etcdClient); err != nil {
+		return u, true, err
+	}
+	log.L().Info("cluster upgrade finished", zap.Stringer("upgrade", u))
+	return u, false, nil
+}
+
+// verifyClusterUpgrade checks the DM-master leader is of the target version, the version and schema migrations of
+// the cluster are done, and all DM-workers are online and resumed.
+func (s *Server) verifyClusterUpgrade(ctx context.Context, u ha.ClusterUpgrade) error {
+	var problems []string
+	if utils.ReleaseVersion != u.TargetVersion {
+		problems = append(problems, fmt.Sprintf("DM-master leader is of release version %s", utils.ReleaseVersion))
+	}
+
+	ver, _, err := upgrade.GetVersion(s.etcdClient)
+	if err != nil {
+		return err
+	}
+	if ver.Compare(upgrade.CurrentVersion) != 0 {
+		problems = append(problems, fmt.Sprintf("cluster version %s is not migrated to %s", ver, upgrade.CurrentVersion))
+	}
+	keySchemaVer, _, err := upgrade.GetKeySchemaVersion(s.etcdClient)
+	if err != nil {
+		return err
+	}
+	if latest := upgrade.LatestKeySchemaVersion(); keySchemaVer != latest {
+		problems = append(problems, fmt.Sprintf("etcd key schema version %d is not migrated to %d", keySchemaVer, latest))
+	}
+	missing, err := upgrade.CheckCheckpointSchema(ctx, s.scheduler.GetSubTaskCfgs())
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("fail to check the checkpoint tables: %v", err))
+	} else if len(missing) > 0 {
+		problems = append(problems, fmt.Sprintf("checkpoint tables %s are not migrated", strings.Join(missing, ", ")))
+	}
+
+	workers, err := s.scheduler.GetAllWorkers()
+	if err != nil {
+		return err
+	}
+	for _, w := range workers {
+		name := w.BaseInfo().Name
+		if w.Stage() == scheduler.WorkerOffline {
+			problems = append(problems, fmt.Sprintf("DM-worker %s is offline", name))
+		} else if wu, ok := u.Workers[name]; ok && wu.Stage != ha.WorkerUpgradeResumed {
+			problems = append(problems, fmt.Sprintf("DM-worker %s is not resumed", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return terror.ErrMasterClusterUpgradeVerifyFail.Generate(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// getClusterUpgrade returns the upgrade window, or an error if the cluster is not being upgraded.
+func (s *Server) getClusterUpgrade() (ha.ClusterUpgrade, error) {
+	u, ok, _, err := ha.GetClusterUpgrade(s.etcdClient)
+	if err != nil {
+		return u, err
+	}
+	if !ok {
+		return u, terror.ErrMasterClusterNotUpgrading.Generate()
+	}
+	return u, nil
+}
+
+// checkClusterUpgrade returns an error if the cluster is being upgraded, the task operations are blocked until the
+// upgrade is finished.
+func (s *Server) checkClusterUpgrade() error {
+	u, ok, _, err := ha.GetClusterUpgrade(s.etcdClient)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return clusterUpgradingError(u)
+	}
+	return nil
+}
+
+func clusterUpgradingError(u ha.ClusterUpgrade) error {
+	return terror.ErrMasterClusterUpgrading.Generate(u.TargetVersion, u.StartTime.Format(time.RFC3339))
+}
+
+func clusterUpgradeToPB(u ha.ClusterUpgrade) *pb.ClusterUpgrade {
+	ret := &pb.ClusterUpgrade{
+		TargetVersion: u.TargetVersion,
+		FromVersion:   u.FromVersion,
+		StartTime:     u.StartTime.Format(time.RFC3339),
+	}
+	for _, wu := range u.Workers {
+		ret.Workers = append(ret.Workers, &pb.WorkerUpgrade{
+			Worker:      wu.Worker,
+			Stage:       wu.Stage,
+			Source:      wu.Source,
+			HandoverTo:  wu.HandoverTo,
+			PausedTasks: wu.PausedTasks,
+		})
+	}
+	sort.Slice(ret.Workers, func(i, j int) bool {
+		return ret.Workers[i].Worker < ret.Workers[j].Worker
+	})
+	return ret
+}
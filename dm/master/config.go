@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"math"
 	"net"
 	"net/url"
 	"os"
@@ -30,6 +31,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/master/scheduler"
 	"github.com/pingcap/dm/pkg/log"
 	"github.com/pingcap/dm/pkg/terror"
 	"github.com/pingcap/dm/pkg/utils"
@@ -46,8 +48,14 @@ const (
 	defaultMaxTxnOps               = 2048
 	defaultQuotaBackendBytes       = 2 * 1024 * 1024 * 1024 // 2GB
 	quotaBackendBytesLowerBound    = 500 * 1024 * 1024      // 500MB
+	defaultOperationAuditMaxRecord = 1000
+	defaultProgressHistoryInterval = "1m"
+	defaultProgressHistoryRetain   = "24h"
 )
 
+// defaultRateLimitedRPCs are the RPCs limited by `client-rate-limits` by default, they fan out requests to DM-workers.
+var defaultRateLimitedRPCs = []string{"QueryStatus"}
+
 // SampleConfigFile is sample config file of dm-master.
 //go:embed dm-master.toml
 var SampleConfigFile string
@@ -85,6 +93,8 @@ func NewConfig() *Config {
 	fs.StringVar(&cfg.SSLKey, "ssl-key", "", "path of file that contains X509 key in PEM format for connection")
 	fs.Var(&cfg.CertAllowedCN, "cert-allowed-cn", "the trusted common name that allowed to visit")
 
+	fs.StringVar(&cfg.Policy, "policy", scheduler.PolicyLeastLoaded, `the policy to choose a free DM-worker for a source, "least-loaded", "round-robin" or "label-affinity"`)
+
 	fs.StringVar(&cfg.V1SourcesPath, "v1-sources-path", "", "directory path used to store source config files when upgrading from v1.0.x")
 
 	return cfg
@@ -104,6 +114,30 @@ type Config struct {
 	RPCRateLimit  float64       `toml:"rpc-rate-limit" json:"rpc-rate-limit"`
 	RPCRateBurst  int           `toml:"rpc-rate-burst" json:"rpc-rate-burst"`
 
+	// ClientRateLimits limits the rate of RateLimitedRPCs for every client, keyed by the client type.
+	ClientRateLimits map[string]ClientRateLimit `toml:"client-rate-limits" json:"client-rate-limits"`
+	RateLimitedRPCs  []string                   `toml:"rate-limited-rpcs" json:"rate-limited-rpcs"`
+
+	// FollowerReadMaxStalenessStr enables the followers to serve the read-only RPCs if not empty.
+	FollowerReadMaxStalenessStr string        `toml:"follower-read-max-staleness" json:"follower-read-max-staleness"`
+	FollowerReadMaxStaleness    time.Duration `toml:"-" json:"-"`
+
+	// OperationAuditMaxRecords is the max number of the records kept in the operation audit log.
+	OperationAuditMaxRecords int `toml:"operation-audit-max-records" json:"operation-audit-max-records"`
+
+	// ProgressHistoryIntervalStr is the interval to record the progress of the tasks, "0s" means disabled.
+	ProgressHistoryIntervalStr string        `toml:"progress-history-interval" json:"progress-history-interval"`
+	ProgressHistoryInterval    time.Duration `toml:"-" json:"-"`
+	// ProgressHistoryRetainStr is how long the progress records are kept.
+	ProgressHistoryRetainStr string        `toml:"progress-history-retain" json:"progress-history-retain"`
+	ProgressHistoryRetain    time.Duration `toml:"-" json:"-"`
+
+	// Policy is the scheduler policy to choose a free DM-worker for a source.
+	Policy string `toml:"policy" json:"policy"`
+
+	// SourceDiscovery creates and removes the sources by the upstream endpoints in a service registry if not nil.
+	SourceDiscovery *SourceDiscoveryConfig `toml:"source-discovery" json:"source-discovery"`
+
 	MasterAddr    string `toml:"master-addr" json:"master-addr"`
 	AdvertiseAddr string `toml:"advertise-addr" json:"advertise-addr"`
 
@@ -195,6 +229,28 @@ func (c *Config) Parse(arguments []string) error {
 	return c.adjust()
 }
 
+// adjustClientRateLimits adjusts and verifies the client rate limits.
+func (c *Config) adjustClientRateLimits() error {
+	for typ, limit := range c.ClientRateLimits {
+		switch typ {
+		case ClientTypeDMCtl, ClientTypeHTTP, ClientTypeDefault:
+		default:
+			return terror.ErrMasterConfigInvalidClientRateLimit.Generate(fmt.Sprintf("unknown client type %s, should be one of %s, %s and %s", typ, ClientTypeDMCtl, ClientTypeHTTP, ClientTypeDefault))
+		}
+		if limit.Rate <= 0 {
+			return terror.ErrMasterConfigInvalidClientRateLimit.Generate(fmt.Sprintf("rate of client type %s should be positive", typ))
+		}
+		if limit.Burst <= 0 {
+			limit.Burst = int(math.Ceil(limit.Rate))
+			c.ClientRateLimits[typ] = limit
+		}
+	}
+	if len(c.RateLimitedRPCs) == 0 {
+		c.RateLimitedRPCs = defaultRateLimitedRPCs
+	}
+	return nil
+}
+
 // configFromFile loads config from file.
 func (c *Config) configFromFile(path string) error {
 	metaData, err := toml.DecodeFile(path, c)
@@ -247,6 +303,17 @@ func (c *Config) adjust() error {
 	}
 	c.RPCTimeout = timeout
 
+	if c.FollowerReadMaxStalenessStr != "" {
+		staleness, err2 := time.ParseDuration(c.FollowerReadMaxStalenessStr)
+		if err2 != nil {
+			return terror.ErrMasterConfigTimeoutParse.Delegate(err2)
+		}
+		if staleness <= 0 {
+			return terror.ErrMasterConfigTimeoutParse.Generatef("follower-read-max-staleness should be positive, but got %s", c.FollowerReadMaxStalenessStr)
+		}
+		c.FollowerReadMaxStaleness = staleness
+	}
+
 	// for backward compatibility
 	if c.RPCRateLimit <= 0 {
 		log.L().Warn("invalid rpc-rate-limit, default value used", zap.Float64("specified rpc-rate-limit", c.RPCRateLimit), zap.Float64("default rpc-rate-limit", DefaultRate))
@@ -257,6 +324,50 @@ func (c *Config) adjust() error {
 		c.RPCRateBurst = DefaultBurst
 	}
 
+	if err = c.adjustClientRateLimits(); err != nil {
+		return err
+	}
+
+	if c.OperationAuditMaxRecords <= 0 {
+		c.OperationAuditMaxRecords = defaultOperationAuditMaxRecord
+	}
+
+	if c.ProgressHistoryIntervalStr == "" {
+		c.ProgressHistoryIntervalStr = defaultProgressHistoryInterval
+	}
+	interval, err := time.ParseDuration(c.ProgressHistoryIntervalStr)
+	if err != nil {
+		return terror.ErrMasterConfigTimeoutParse.Delegate(err)
+	}
+	if interval < 0 {
+		return terror.ErrMasterConfigTimeoutParse.Generatef("progress-history-interval should not be negative, but got %s", c.ProgressHistoryIntervalStr)
+	}
+	c.ProgressHistoryInterval = interval
+	if c.ProgressHistoryRetainStr == "" {
+		c.ProgressHistoryRetainStr = defaultProgressHistoryRetain
+	}
+	retain, err := time.ParseDuration(c.ProgressHistoryRetainStr)
+	if err != nil {
+		return terror.ErrMasterConfigTimeoutParse.Delegate(err)
+	}
+	if retain <= 0 {
+		return terror.ErrMasterConfigTimeoutParse.Generatef("progress-history-retain should be positive, but got %s", c.ProgressHistoryRetainStr)
+	}
+	c.ProgressHistoryRetain = retain
+
+	if c.Policy == "" {
+		c.Policy = scheduler.PolicyLeastLoaded
+	}
+	if !scheduler.IsValidPolicy(c.Policy) {
+		return terror.ErrMasterConfigInvalidSchedulerPolicy.Generate(c.Policy)
+	}
+
+	if c.SourceDiscovery != nil {
+		if err = c.SourceDiscovery.adjust(); err != nil {
+			return err
+		}
+	}
+
 	if c.Name == "" {
 		var hostname string
 		hostname, err = os.Hostname()
@@ -20,11 +20,13 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	capturer "github.com/kami-zh/go-capturer"
 	"github.com/pingcap/check"
 	"go.etcd.io/etcd/embed"
 
+	"github.com/pingcap/dm/dm/master/scheduler"
 	"github.com/pingcap/dm/pkg/log"
 	"github.com/pingcap/dm/pkg/terror"
 )
@@ -298,3 +300,71 @@ func (t *testConfigSuite) TestAdjustAddr(c *check.C) {
 	c.Assert(cfg.adjust(), check.IsNil)
 	c.Assert(cfg.AdvertiseAddr, check.Equals, cfg.MasterAddr)
 }
+
+func (t *testConfigSuite) TestAdjustClientRateLimits(c *check.C) {
+	cfg := NewConfig()
+	c.Assert(cfg.configFromFile(defaultConfigFile), check.IsNil)
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.ClientRateLimits, check.HasLen, 0)
+	c.Assert(cfg.RateLimitedRPCs, check.DeepEquals, defaultRateLimitedRPCs)
+
+	// burst defaults to the rate
+	cfg.ClientRateLimits = map[string]ClientRateLimit{ClientTypeDMCtl: {Rate: 1.5}}
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.ClientRateLimits[ClientTypeDMCtl], check.Equals, ClientRateLimit{Rate: 1.5, Burst: 2})
+
+	cfg.ClientRateLimits = map[string]ClientRateLimit{ClientTypeHTTP: {Rate: 0, Burst: 1}}
+	c.Assert(terror.ErrMasterConfigInvalidClientRateLimit.Equal(cfg.adjust()), check.IsTrue)
+	cfg.ClientRateLimits = map[string]ClientRateLimit{"dm-worker": {Rate: 1, Burst: 1}}
+	c.Assert(terror.ErrMasterConfigInvalidClientRateLimit.Equal(cfg.adjust()), check.IsTrue)
+}
+
+func (t *testConfigSuite) TestAdjustFollowerReadMaxStaleness(c *check.C) {
+	cfg := NewConfig()
+	c.Assert(cfg.configFromFile(defaultConfigFile), check.IsNil)
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.FollowerReadMaxStaleness, check.Equals, time.Duration(0))
+
+	cfg.FollowerReadMaxStalenessStr = "5s"
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.FollowerReadMaxStaleness, check.Equals, 5*time.Second)
+
+	cfg.FollowerReadMaxStalenessStr = "-1s"
+	c.Assert(terror.ErrMasterConfigTimeoutParse.Equal(cfg.adjust()), check.IsTrue)
+	cfg.FollowerReadMaxStalenessStr = "5"
+	c.Assert(terror.ErrMasterConfigTimeoutParse.Equal(cfg.adjust()), check.IsTrue)
+}
+
+func (t *testConfigSuite) TestAdjustProgressHistory(c *check.C) {
+	cfg := NewConfig()
+	c.Assert(cfg.configFromFile(defaultConfigFile), check.IsNil)
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.ProgressHistoryInterval, check.Equals, time.Minute)
+	c.Assert(cfg.ProgressHistoryRetain, check.Equals, 24*time.Hour)
+
+	cfg.ProgressHistoryIntervalStr = "0s"
+	cfg.ProgressHistoryRetainStr = "1h"
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.ProgressHistoryInterval, check.Equals, time.Duration(0))
+	c.Assert(cfg.ProgressHistoryRetain, check.Equals, time.Hour)
+
+	cfg.ProgressHistoryIntervalStr = "-1s"
+	c.Assert(terror.ErrMasterConfigTimeoutParse.Equal(cfg.adjust()), check.IsTrue)
+	cfg.ProgressHistoryIntervalStr = "1m"
+	cfg.ProgressHistoryRetainStr = "0s"
+	c.Assert(terror.ErrMasterConfigTimeoutParse.Equal(cfg.adjust()), check.IsTrue)
+}
+
+func (t *testConfigSuite) TestAdjustPolicy(c *check.C) {
+	cfg := NewConfig()
+	c.Assert(cfg.configFromFile(defaultConfigFile), check.IsNil)
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.Policy, check.Equals, scheduler.PolicyLeastLoaded)
+
+	cfg = NewConfig()
+	c.Assert(cfg.Parse([]string{"--config", defaultConfigFile, "--policy", scheduler.PolicyLabelAffinity}), check.IsNil)
+	c.Assert(cfg.Policy, check.Equals, scheduler.PolicyLabelAffinity)
+
+	cfg.Policy = "random"
+	c.Assert(terror.ErrMasterConfigInvalidSchedulerPolicy.Equal(cfg.adjust()), check.IsTrue)
+}
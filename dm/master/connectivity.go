@@ -0,0 +1,126 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/master/workerrpc"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// ValidateConnectivity implements MasterServer.ValidateConnectivity.
+func (s *Server) ValidateConnectivity(ctx context.Context, req *pb.ValidateTaskConnectivityRequest) (*pb.ValidateTaskConnectivityResponse, error) {
+	var (
+		resp2 *pb.ValidateTaskConnectivityResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.ValidateTaskConnectivityResponse{}
+	stCfgs, err := s.connectivitySubTaskCfgs(req)
+	if err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+
+	sourceRespCh := make(chan *pb.ValidateConnectivityResponse, len(stCfgs))
+	var wg sync.WaitGroup
+	for _, stCfg := range stCfgs {
+		wg.Add(1)
+		go func(stCfg *config.SubTaskConfig) {
+			defer wg.Done()
+			sourceRespCh <- s.validateSourceConnectivity(ctx, stCfg)
+		}(stCfg)
+	}
+	wg.Wait()
+	close(sourceRespCh)
+
+	resp.Result = true
+	for sourceResp := range sourceRespCh {
+		resp.Sources = append(resp.Sources, sourceResp)
+		if !sourceResp.Result {
+			resp.Result = false
+		}
+	}
+	sort.Slice(resp.Sources, func(i, j int) bool {
+		return resp.Sources[i].Source < resp.Sources[j].Source
+	})
+	return resp, nil
+}
+
+// connectivitySubTaskCfgs returns the subtask configs of the running task or the task config file in req.
+// the task config file is not checked like `check-task`, which connects to the databases from DM-master.
+func (s *Server) connectivitySubTaskCfgs(req *pb.ValidateTaskConnectivityRequest) ([]*config.SubTaskConfig, error) {
+	if req.Name != "" {
+		subCfgs := s.scheduler.GetSubTaskCfgsByTask(req.Name)
+		if len(subCfgs) == 0 {
+			return nil, terror.ErrSchedulerTaskNotExist.Generate(req.Name)
+		}
+		stCfgs := make([]*config.SubTaskConfig, 0, len(subCfgs))
+		for _, subCfg := range subCfgs {
+			stCfgs = append(stCfgs, subCfg)
+		}
+		return stCfgs, nil
+	}
+
+	if req.Task == "" {
+		return nil, terror.ErrMasterWorkerArgsExtractor.Generatef("must specify a running task or a task config file")
+	}
+	cfg := config.NewTaskConfig()
+	if err := cfg.Decode(req.Task); err != nil {
+		return nil, terror.WithClass(err, terror.ClassDMMaster)
+	}
+	stCfgs, err := config.TaskConfigToSubTaskConfigs(cfg, s.getSourceConfigs(cfg.MySQLInstances))
+	if err != nil {
+		return nil, terror.WithClass(err, terror.ClassDMMaster)
+	}
+	return stCfgs, nil
+}
+
+// validateSourceConnectivity asks the DM-worker bound to the source of stCfg to validate the connectivity.
+func (s *Server) validateSourceConnectivity(ctx context.Context, stCfg *config.SubTaskConfig) *pb.ValidateConnectivityResponse {
+	source := stCfg.SourceID
+	errorResp := func(msg, worker string) *pb.ValidateConnectivityResponse {
+		return &pb.ValidateConnectivityResponse{Msg: msg, Source: source, Worker: worker}
+	}
+
+	worker := s.scheduler.GetWorkerBySource(source)
+	if worker == nil {
+		return errorResp(fmt.Sprintf("source %s relevant worker-client not found", source), "")
+	}
+	cfgStr, err := stCfg.Toml()
+	if err != nil {
+		return errorResp(err.Error(), worker.BaseInfo().Name)
+	}
+	req := &workerrpc.Request{
+		Type:                 workerrpc.CmdValidateConnectivity,
+		ValidateConnectivity: &pb.ValidateConnectivityRequest{SubTaskCfg: cfgStr},
+	}
+	resp, err := worker.SendRequest(ctx, req, s.cfg.RPCTimeout)
+	if err != nil {
+		return errorResp(err.Error(), worker.BaseInfo().Name)
+	}
+	resp.ValidateConnectivity.Source = source
+	return resp.ValidateConnectivity
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+)
+
+// etcdBackupPageSize is the max count of key-value pairs in a response of the snapshot.
+var etcdBackupPageSize int64 = 1000
+
+// BackupEtcd implements MasterServer.BackupEtcd.
+// it's served by any DM-master without forwarding to the leader, because the reads of the embedded etcd are
+// linearizable. the errors are sent in the last response of the stream like other RPCs.
+func (s *Server) BackupEtcd(req *pb.BackupEtcdRequest, stream pb.Master_BackupEtcdServer) error {
+	ctx := stream.Context()
+	log.L().Info("", zap.Any("payload", req), zap.String("request", "BackupEtcd"))
+	if err := s.clientLimiter.allow(ctx, "BackupEtcd"); err != nil {
+		return err
+	}
+
+	rev := req.Revision
+	if rev == 0 {
+		var err error
+		rev, err = ha.GetEtcdSnapshot(ctx, s.etcdClient, etcdBackupPageSize, req.DmOnly, func(kvs []ha.EtcdKV, snapshotRev int64, last bool) error {
+			return stream.Send(&pb.BackupEtcdResponse{
+				Result:       true,
+				Revision:     snapshotRev,
+				Snapshot:     true,
+				SnapshotDone: last,
+				Kvs:          etcdKVsToPB(kvs),
+			})
+		})
+		if err != nil {
+			return sendBackupEtcdError(stream, err)
+		}
+		log.L().Info("etcd snapshot is sent", zap.Int64("revision", rev), zap.Bool("dm only", req.DmOnly))
+	}
+	if !req.Watch {
+		return nil
+	}
+
+	err := ha.WatchEtcdChanges(ctx, s.etcdClient, rev, req.DmOnly, func(kvs []ha.EtcdKV, changeRev int64) error {
+		return stream.Send(&pb.BackupEtcdResponse{
+			Result:   true,
+			Revision: changeRev,
+			Kvs:      etcdKVsToPB(kvs),
+		})
+	})
+	if err != nil {
+		return sendBackupEtcdError(stream, err)
+	}
+	return nil
+}
+
+// sendBackupEtcdError sends err to the client, the stream may be already broken so the error of sending is ignored.
+func sendBackupEtcdError(stream pb.Master_BackupEtcdServer, err error) error {
+	log.L().Warn("fail to back up etcd", log.ShortError(err))
+	_ = stream.Send(&pb.BackupEtcdResponse{Msg: err.Error()})
+	return nil
+}
+
+func etcdKVsToPB(kvs []ha.EtcdKV) []*pb.EtcdKeyValue {
+	res := make([]*pb.EtcdKeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		res = append(res, &pb.EtcdKeyValue{
+			Key:         kv.Key,
+			Value:       kv.Value,
+			Deleted:     kv.Deleted,
+			ModRevision: kv.ModRevision,
+		})
+	}
+	return res
+}
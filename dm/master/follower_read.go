@@ -0,0 +1,458 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/master/scheduler"
+	"github.com/pingcap/dm/dm/master/workerrpc"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// minFollowerReadSyncInterval is the min interval for the followers to refresh their read-only caches.
+const minFollowerReadSyncInterval = 100 * time.Millisecond
+
+// followerReadRPCs are the read-only RPCs which can be served by the followers.
+var followerReadRPCs = map[string]struct{}{
+	"QueryStatus": {},
+	"GetCfg":      {},
+	"ListMember":  {},
+}
+
+// followerCache caches the cluster states in etcd for a follower to serve the read-only RPCs.
+// it's only valid after a successful refresh, and the staleness is bounded by the leader election.
+type followerCache struct {
+	mu sync.RWMutex
+
+	valid bool
+
+	sourceCfgs   map[string]*config.SourceConfig            // source ID -> source config
+	subTaskCfgs  map[string]map[string]config.SubTaskConfig // task name -> source ID -> subtask config
+	workers      map[string]ha.WorkerInfo                   // worker name -> worker info
+	keepAlive    map[string]ha.WorkerEvent                  // worker name -> keepalive event
+	bounds       map[string]ha.SourceBound                  // worker name -> source bound
+	relayWorkers map[string]map[string]struct{}             // source ID -> set(workers)
+
+	// worker name -> gRPC client, kept across refreshes and closed when the worker is gone.
+	clients     map[string]workerrpc.Client
+	clientAddrs map[string]string // worker name -> address of the client
+}
+
+func newFollowerCache() *followerCache {
+	return &followerCache{
+		clients:     make(map[string]workerrpc.Client),
+		clientAddrs: make(map[string]string),
+	}
+}
+
+// refresh reloads the cluster states from etcd.
+func (c *followerCache) refresh(cli *clientv3.Client) error {
+	sourceCfgs, _, err := ha.GetSourceCfg(cli, "", 0)
+	if err != nil {
+		return err
+	}
+	subTaskCfgs, _, err := ha.GetAllSubTaskCfg(cli)
+	if err != nil {
+		return err
+	}
+	workers, _, err := ha.GetAllWorkerInfo(cli)
+	if err != nil {
+		return err
+	}
+	keepAlive, _, err := ha.GetKeepAliveWorkers(cli)
+	if err != nil {
+		return err
+	}
+	bounds, _, err := ha.GetSourceBound(cli, "")
+	if err != nil {
+		return err
+	}
+	relayWorkers, _, err := ha.GetAllRelayConfig(cli)
+	if err != nil {
+		return err
+	}
+
+	taskCfgs := make(map[string]map[string]config.SubTaskConfig)
+	for source, tasks := range subTaskCfgs {
+		for task, cfg := range tasks {
+			if _, ok := taskCfgs[task]; !ok {
+				taskCfgs[task] = make(map[string]config.SubTaskConfig)
+			}
+			taskCfgs[task][source] = cfg
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sourceCfgs = sourceCfgs
+	c.subTaskCfgs = taskCfgs
+	c.workers = workers
+	c.keepAlive = keepAlive
+	c.bounds = bounds
+	c.relayWorkers = relayWorkers
+	c.valid = true
+
+	for name, cli := range c.clients {
+		if info, ok := workers[name]; !ok || info.Addr != c.clientAddrs[name] {
+			cli.Close()
+			delete(c.clients, name)
+			delete(c.clientAddrs, name)
+		}
+	}
+	return nil
+}
+
+// reset invalidates the cache and releases the worker clients.
+func (c *followerCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.valid = false
+	for name, cli := range c.clients {
+		cli.Close()
+		delete(c.clients, name)
+		delete(c.clientAddrs, name)
+	}
+}
+
+func (c *followerCache) isValid() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.valid
+}
+
+// workerStage returns the stage of the worker like the scheduler.
+func (c *followerCache) workerStage(name string) scheduler.WorkerStage {
+	if _, ok := c.keepAlive[name]; !ok {
+		return scheduler.WorkerOffline
+	}
+	if _, ok := c.bounds[name]; ok {
+		return scheduler.WorkerBound
+	}
+	return scheduler.WorkerFree
+}
+
+// boundWorker returns the name of the worker bound to the source, or empty string if not found.
+func (c *followerCache) boundWorker(source string) string {
+	for name, bound := range c.bounds {
+		if bound.Source == source {
+			return name
+		}
+	}
+	return ""
+}
+
+// getClient returns the gRPC client of the worker, the caller should hold the write lock.
+func (c *followerCache) getClient(name string, securityCfg config.Security) (workerrpc.Client, error) {
+	if cli, ok := c.clients[name]; ok {
+		return cli, nil
+	}
+	info, ok := c.workers[name]
+	if !ok {
+		return nil, terror.ErrMasterWorkerArgsExtractor.Generatef("worker %s not found", name)
+	}
+	cli, err := workerrpc.NewGRPCClient(info.Addr, securityCfg)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[name] = cli
+	c.clientAddrs[name] = info.Addr
+	return cli, nil
+}
+
+// followerReadLoop refreshes the read-only cache periodically when the current member is a follower.
+func (s *Server) followerReadLoop(ctx context.Context) {
+	interval := s.cfg.FollowerReadMaxStaleness / 2
+	if interval < minFollowerReadSyncInterval {
+		interval = minFollowerReadSyncInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer s.followerCache.reset()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if leader := s.leader.Load(); leader == oneselfLeader || leader == oneselfStartingLeader {
+			if s.followerCache.isValid() {
+				s.followerCache.reset()
+			}
+			continue
+		}
+
+		// the cache is refreshed after the leader is synced, so it's not staler than the election.
+		ctx2, cancel := context.WithTimeout(ctx, interval)
+		_, _, err := s.election.SyncLeader(ctx2)
+		cancel()
+		if err != nil {
+			log.L().Warn("fail to sync the leader for follower read", log.ShortError(err))
+			continue
+		}
+		if err = s.followerCache.refresh(s.etcdClient); err != nil {
+			log.L().Warn("fail to refresh the cache for follower read", log.ShortError(err))
+			s.followerCache.reset()
+		}
+	}
+}
+
+// canFollowerRead returns whether the request can be served by the current member as a follower.
+func (s *Server) canFollowerRead(methodName string, req interface{}) bool {
+	if s.followerCache == nil || s.cfg.FollowerReadMaxStaleness <= 0 {
+		return false
+	}
+	if _, ok := followerReadRPCs[methodName]; !ok {
+		return false
+	}
+	if cfgReq, ok := req.(*pb.GetCfgRequest); ok && (cfgReq.All || cfgReq.Type != pb.CfgType_TaskType) {
+		return false
+	}
+	if statusReq, ok := req.(*pb.QueryStatusListRequest); ok && statusReq.Since != "" {
+		// the progress history is not cached.
+		return false
+	}
+	if leader := s.leader.Load(); leader == oneselfLeader || leader == oneselfStartingLeader {
+		return false
+	}
+	staleness := s.election.Staleness()
+	if staleness < 0 || staleness > s.cfg.FollowerReadMaxStaleness {
+		return false
+	}
+	return s.followerCache.isValid()
+}
+
+// followerRead serves the read-only request from the cache of the follower.
+func (s *Server) followerRead(ctx context.Context, req interface{}) (interface{}, error) {
+	switch r := req.(type) {
+	case *pb.QueryStatusListRequest:
+		return s.followerQueryStatus(ctx, r), nil
+	case *pb.GetCfgRequest:
+		return s.followerGetTaskCfg(r), nil
+	case *pb.ListMemberRequest:
+		return s.followerListMember(ctx, r), nil
+	default:
+		return nil, terror.ErrMasterRequestIsNotForwardToLeader
+	}
+}
+
+func (s *Server) followerQueryStatus(ctx context.Context, req *pb.QueryStatusListRequest) *pb.QueryStatusListResponse {
+	c := s.followerCache
+	c.mu.Lock()
+	var sources []string
+	switch {
+	case len(req.GetSources()) > 0:
+		sources = req.GetSources()
+		var invalidSource []string
+		for _, source := range sources {
+			if _, ok := c.sourceCfgs[source]; !ok {
+				invalidSource = append(invalidSource, source)
+			}
+		}
+		if len(invalidSource) > 0 {
+			c.mu.Unlock()
+			return &pb.QueryStatusListResponse{
+				Result: false,
+				Msg:    terror.ErrMasterWorkerArgsExtractor.Generatef("sources %s haven't been added", invalidSource).Error(),
+			}
+		}
+	case len(req.GetName()) > 0:
+		for source := range c.subTaskCfgs[req.GetName()] {
+			sources = append(sources, source)
+		}
+		if len(sources) == 0 {
+			c.mu.Unlock()
+			return &pb.QueryStatusListResponse{
+				Result: false,
+				Msg:    terror.ErrMasterWorkerArgsExtractor.Generatef("task %s has no source or not exist", req.GetName()).Error(),
+			}
+		}
+	default:
+		for _, bound := range c.bounds {
+			sources = append(sources, bound.Source)
+		}
+	}
+	sort.Strings(sources)
+
+	type target struct {
+		source string
+		worker string
+		cli    workerrpc.Client
+		err    error
+	}
+	// if user specified sources, query relay workers instead of task workers
+	queryRelayWorker := len(req.GetSources()) > 0
+	targets := make([]target, 0, len(sources))
+	for _, source := range sources {
+		workerNames := make([]string, 0, 1)
+		if queryRelayWorker {
+			for name := range c.relayWorkers[source] {
+				workerNames = append(workerNames, name)
+			}
+			sort.Strings(workerNames)
+		}
+		if name := c.boundWorker(source); name != "" {
+			if _, ok := c.relayWorkers[source][name]; !ok || !queryRelayWorker {
+				workerNames = append(workerNames, name)
+			}
+		}
+		if len(workerNames) == 0 {
+			targets = append(targets, target{source: source, err: terror.ErrMasterWorkerArgsExtractor.Generatef("%s relevant worker-client not found", source)})
+			continue
+		}
+		for _, name := range workerNames {
+			cli, err := c.getClient(name, s.cfg.Security)
+			targets = append(targets, target{source: source, worker: name, cli: cli, err: err})
+		}
+	}
+	c.mu.Unlock()
+
+	workerReq := &workerrpc.Request{
+		Type:        workerrpc.CmdQueryStatus,
+		QueryStatus: &pb.QueryStatusRequest{Name: req.Name},
+	}
+	workerResps := make([]*pb.QueryStatusResponse, len(targets))
+	var wg sync.WaitGroup
+	for i := range targets {
+		t := targets[i]
+		if t.err != nil {
+			log.L().Error("response error", zap.Error(t.err))
+			workerResps[i] = &pb.QueryStatusResponse{
+				Result:       false,
+				Msg:          t.err.Error(),
+				SourceStatus: &pb.SourceStatus{Source: t.source, Worker: t.worker},
+			}
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := t.cli.SendRequest(ctx, workerReq, s.cfg.RPCTimeout)
+			if err != nil {
+				workerResps[i] = &pb.QueryStatusResponse{
+					Result:       false,
+					Msg:          err.Error(),
+					SourceStatus: &pb.SourceStatus{},
+				}
+			} else {
+				workerResps[i] = resp.QueryStatus
+			}
+			workerResps[i].SourceStatus.Source = t.source
+		}(i)
+	}
+	wg.Wait()
+
+	// NOTE: unsynced status of shard DDL is not filled because the shard DDL locks are only kept by the leader.
+	return &pb.QueryStatusListResponse{
+		Result:  true,
+		Sources: workerResps,
+		Locks:   s.getTaskLocksForStatus(req.Name),
+	}
+}
+
+func (s *Server) followerGetTaskCfg(req *pb.GetCfgRequest) *pb.GetCfgResponse {
+	resp := &pb.GetCfgResponse{}
+	s.followerCache.mu.RLock()
+	subCfgMap := s.followerCache.subTaskCfgs[req.Name]
+	subCfgList := make([]*config.SubTaskConfig, 0, len(subCfgMap))
+	for _, subCfg := range subCfgMap {
+		subCfg := subCfg
+		subCfgList = append(subCfgList, &subCfg)
+	}
+	s.followerCache.mu.RUnlock()
+	if len(subCfgList) == 0 {
+		resp.Msg = "task not found"
+		return resp
+	}
+	resp.Result = true
+	resp.Cfg = taskCfgString(subCfgList)
+	return resp
+}
+
+func (s *Server) followerListMember(ctx context.Context, req *pb.ListMemberRequest) *pb.ListMemberResponse {
+	if !req.Leader && !req.Master && !req.Worker {
+		req.Leader = true
+		req.Master = true
+		req.Worker = true
+	}
+
+	resp := &pb.ListMemberResponse{}
+	members := make([]*pb.Members, 0)
+
+	if req.Leader {
+		members = append(members, &pb.Members{
+			Member: s.listMemberLeader(ctx, req.Names),
+		})
+	}
+
+	if req.Master {
+		res, err := s.listMemberMaster(ctx, req.Names)
+		if err != nil {
+			resp.Msg = err.Error()
+			return resp
+		}
+		members = append(members, &pb.Members{
+			Member: res,
+		})
+	}
+
+	if req.Worker {
+		set := make(map[string]bool)
+		for _, name := range req.Names {
+			set[name] = true
+		}
+
+		s.followerCache.mu.RLock()
+		workers := make([]*pb.WorkerInfo, 0, len(s.followerCache.workers))
+		for name, info := range s.followerCache.workers {
+			if len(set) > 0 && !set[name] {
+				continue
+			}
+			workers = append(workers, &pb.WorkerInfo{
+				Name:   name,
+				Addr:   info.Addr,
+				Stage:  string(s.followerCache.workerStage(name)),
+				Source: s.followerCache.bounds[name].Source,
+			})
+		}
+		s.followerCache.mu.RUnlock()
+		sort.Slice(workers, func(i, j int) bool {
+			return workers[i].Name < workers[j].Name
+		})
+
+		members = append(members, &pb.Members{
+			Member: &pb.Members_Worker{
+				Worker: &pb.ListWorkerMember{
+					Workers: workers,
+				},
+			},
+		})
+	}
+
+	resp.Result = true
+	resp.Members = members
+	return resp
+}
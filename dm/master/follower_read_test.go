@@ -0,0 +1,107 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/master/scheduler"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+)
+
+func (t *testMaster) TestFollowerRead(c *check.C) {
+	server := testDefaultMasterServer(c)
+	server.leader.Store("dm-master-leader")
+	server.etcdClient = t.etcdTestCli
+	sources, workers := defaultWorkerSource()
+
+	// not enabled
+	c.Assert(server.canFollowerRead("QueryStatus", &pb.QueryStatusListRequest{}), check.IsFalse)
+
+	taskCfg := config.NewTaskConfig()
+	c.Assert(taskCfg.Decode(taskConfig), check.IsNil)
+	dbCfgs := make(map[string]config.DBConfig, len(sources))
+	for _, source := range sources {
+		sourceCfg, err := config.LoadFromFile("./source.yaml")
+		c.Assert(err, check.IsNil)
+		sourceCfg.SourceID = source
+		_, err = ha.PutSourceCfg(t.etcdTestCli, sourceCfg)
+		c.Assert(err, check.IsNil)
+		dbCfgs[source] = sourceCfg.From
+	}
+	stCfgs, err := config.TaskConfigToSubTaskConfigs(taskCfg, dbCfgs)
+	c.Assert(err, check.IsNil)
+	stCfgs2 := make([]config.SubTaskConfig, 0, len(stCfgs))
+	for _, stCfg := range stCfgs {
+		stCfgs2 = append(stCfgs2, *stCfg)
+	}
+	_, err = ha.PutSubTaskCfgStage(t.etcdTestCli, stCfgs2, nil)
+	c.Assert(err, check.IsNil)
+	for i, addr := range workers {
+		_, err = ha.PutWorkerInfo(t.etcdTestCli, ha.NewWorkerInfo(sources[i]+"-worker", addr))
+		c.Assert(err, check.IsNil)
+	}
+	_, err = ha.PutSourceBound(t.etcdTestCli, ha.NewSourceBound(sources[0], sources[0]+"-worker"))
+	c.Assert(err, check.IsNil)
+
+	c.Assert(server.followerCache.isValid(), check.IsFalse)
+	c.Assert(server.followerCache.refresh(t.etcdTestCli), check.IsNil)
+	c.Assert(server.followerCache.isValid(), check.IsTrue)
+
+	// get the task config
+	cfgResp := server.followerGetTaskCfg(&pb.GetCfgRequest{Type: pb.CfgType_TaskType, Name: taskCfg.Name})
+	c.Assert(cfgResp.Result, check.IsTrue)
+	c.Assert(strings.Contains(cfgResp.Cfg, "source-id: mysql-replica-01"), check.IsTrue, check.Commentf(cfgResp.Cfg))
+	cfgResp = server.followerGetTaskCfg(&pb.GetCfgRequest{Type: pb.CfgType_TaskType, Name: "not-exist"})
+	c.Assert(cfgResp.Result, check.IsFalse)
+	c.Assert(cfgResp.Msg, check.Equals, "task not found")
+
+	// list the workers
+	memberResp := server.followerListMember(context.Background(), &pb.ListMemberRequest{Worker: true})
+	c.Assert(memberResp.Result, check.IsTrue)
+	c.Assert(memberResp.Members, check.HasLen, 1)
+	workerInfos := memberResp.Members[0].GetWorker().Workers
+	c.Assert(workerInfos, check.HasLen, 2)
+	c.Assert(workerInfos[0].Name, check.Equals, sources[0]+"-worker")
+	c.Assert(workerInfos[0].Stage, check.Equals, string(scheduler.WorkerOffline))
+	c.Assert(workerInfos[0].Source, check.Equals, sources[0])
+	c.Assert(workerInfos[1].Addr, check.Equals, workers[1])
+	c.Assert(workerInfos[1].Source, check.Equals, "")
+
+	// query status of a not added source
+	statusResp := server.followerQueryStatus(context.Background(), &pb.QueryStatusListRequest{Sources: []string{"not-exist"}})
+	c.Assert(statusResp.Result, check.IsFalse)
+	c.Assert(statusResp.Msg, check.Matches, ".*sources \\[not-exist\\] haven't been added.*")
+
+	// query status of a source without a bound worker
+	statusResp = server.followerQueryStatus(context.Background(), &pb.QueryStatusListRequest{Sources: []string{sources[1]}})
+	c.Assert(statusResp.Result, check.IsTrue)
+	c.Assert(statusResp.Sources, check.HasLen, 1)
+	c.Assert(statusResp.Sources[0].Result, check.IsFalse)
+	c.Assert(statusResp.Sources[0].SourceStatus.Source, check.Equals, sources[1])
+
+	// the leader never serves the follower read
+	server.cfg.FollowerReadMaxStaleness = time.Second
+	server.leader.Store(oneselfLeader)
+	c.Assert(server.canFollowerRead("QueryStatus", &pb.QueryStatusListRequest{}), check.IsFalse)
+
+	server.followerCache.reset()
+	c.Assert(server.followerCache.isValid(), check.IsFalse)
+}
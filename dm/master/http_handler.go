@@ -47,7 +47,7 @@ func getStatusHandle() http.Handler {
 // getHTTPAPIHandler returns a HTTP handler to handle DM-master APIs.
 func getHTTPAPIHandler(ctx context.Context, addr string, securityOpt grpc.DialOption) (http.Handler, error) {
 	// dial the real API server in non-blocking mode, it may not started yet.
-	opts := []grpc.DialOption{securityOpt}
+	opts := []grpc.DialOption{securityOpt, grpc.WithUserAgent(userAgentHTTP)}
 	// NOTE: should we need to replace `host` in `addr` to `127.0.0.1`?
 	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
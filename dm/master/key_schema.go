@@ -0,0 +1,63 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/upgrade"
+)
+
+// MigrateKeySchema implements MasterServer.MigrateKeySchema.
+func (s *Server) MigrateKeySchema(ctx context.Context, req *pb.MigrateKeySchemaRequest) (*pb.MigrateKeySchemaResponse, error) {
+	var (
+		resp2 *pb.MigrateKeySchemaResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.MigrateKeySchemaResponse{LatestVersion: upgrade.LatestKeySchemaVersion()}
+	to := resp.LatestVersion
+	if req.Rollback {
+		to = req.Version
+	}
+	plan, err := upgrade.MigrateKeySchema(ctx, s.etcdClient, to, req.DryRun)
+	if err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+
+	resp.Result = true
+	resp.FromVersion = plan.From
+	resp.ToVersion = plan.To
+	for _, c := range plan.Changes {
+		resp.Changes = append(resp.Changes, &pb.KeySchemaChange{
+			Version:     c.Version,
+			Description: c.Description,
+			OldKey:      c.OldKey,
+			NewKey:      c.NewKey,
+		})
+	}
+	if !req.DryRun && plan.To < plan.From {
+		// the key schema is upgraded again when a DM-master of the current version becomes the leader.
+		resp.Msg = fmt.Sprintf("the key schema is rolled back to version %d, please downgrade the DM-master cluster before restarting any DM-master", plan.To)
+	}
+	return resp, nil
+}
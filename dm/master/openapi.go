@@ -22,6 +22,8 @@ import (
 	"github.com/deepmap/oapi-codegen/pkg/middleware"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
 	"go.uber.org/zap"
 
 	"github.com/pingcap/dm/checker"
@@ -30,6 +32,7 @@ import (
 	"github.com/pingcap/dm/dm/master/scheduler"
 	"github.com/pingcap/dm/dm/pb"
 	"github.com/pingcap/dm/openapi"
+	"github.com/pingcap/dm/pkg/conn"
 	"github.com/pingcap/dm/pkg/log"
 	"github.com/pingcap/dm/pkg/terror"
 )
@@ -211,12 +214,47 @@ func (s *Server) DMAPIResumeRelay(ctx echo.Context, sourceName string) error {
 
 // DMAPIGetSourceSchemaList get source schema list url is: (GET /api/v1/sources/{source-name}/schemas).
 func (s *Server) DMAPIGetSourceSchemaList(ctx echo.Context, sourceName string) error {
-	return nil
+	baseDB, err := s.openSourceDB(sourceName)
+	if err != nil {
+		return err
+	}
+	defer baseDB.Close()
+	schemaList, err := dbutil.GetSchemas(ctx.Request().Context(), baseDB.DB)
+	if err != nil {
+		return terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	}
+	resp := make(openapi.SchemaNameList, 0, len(schemaList))
+	for _, schema := range schemaList {
+		if !filter.IsSystemSchema(schema) {
+			resp = append(resp, schema)
+		}
+	}
+	return ctx.JSON(http.StatusOK, resp)
 }
 
 // DMAPIGetSourceTableList get source table list url is: (GET /api/v1/sources/{source-name}/schemas/{schema-name}).
 func (s *Server) DMAPIGetSourceTableList(ctx echo.Context, sourceName string, schemaName string) error {
-	return nil
+	baseDB, err := s.openSourceDB(sourceName)
+	if err != nil {
+		return err
+	}
+	defer baseDB.Close()
+	tableList, err := dbutil.GetTables(ctx.Request().Context(), baseDB.DB, schemaName)
+	if err != nil {
+		return terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	}
+	return ctx.JSON(http.StatusOK, openapi.TableNameList(tableList))
+}
+
+// openSourceDB opens the upstream database with the credentials in the source config,
+// so the callers (like dm-portal) don't need to hold the credentials of every upstream.
+func (s *Server) openSourceDB(sourceName string) (*conn.BaseDB, error) {
+	sourceCfg := s.scheduler.GetSourceCfgByID(sourceName)
+	if sourceCfg == nil {
+		return nil, terror.ErrSchedulerSourceCfgNotExist.Generate(sourceName)
+	}
+	dbCfg := sourceCfg.GenerateDBConfig()
+	return conn.DefaultDBProvider.Apply(*dbCfg)
 }
 
 // DMAPIGetSourceStatus url is: (GET /api/v1/sources/{source-id}/status).
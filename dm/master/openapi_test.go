@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/deepmap/oapi-codegen/pkg/testutil"
 	"github.com/golang/mock/gomock"
 	"github.com/pingcap/check"
@@ -37,6 +38,7 @@ import (
 	"github.com/pingcap/dm/dm/pbmock"
 	"github.com/pingcap/dm/openapi"
 	"github.com/pingcap/dm/openapi/fixtures"
+	"github.com/pingcap/dm/pkg/conn"
 	"github.com/pingcap/dm/pkg/ha"
 	"github.com/pingcap/dm/pkg/terror"
 	"github.com/pingcap/dm/pkg/utils"
@@ -208,6 +210,57 @@ func (t *openAPISuite) TestSourceAPI(c *check.C) {
 	cancel()
 }
 
+func (t *openAPISuite) TestSourceSchemaAPI(c *check.C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := setupServer(ctx, c)
+	defer func() {
+		cancel()
+		s.Close()
+		conn.DefaultDBProvider = &conn.DefaultDBProviderImpl{}
+	}()
+
+	baseURL := "/api/v1/sources"
+	// source not exist
+	result := testutil.NewRequest().Get(fmt.Sprintf("%s/%s/schemas", baseURL, source1Name)).Go(t.testT, s.echo)
+	c.Assert(result.Code(), check.Equals, http.StatusBadRequest)
+	var errResp openapi.ErrorWithMessage
+	c.Assert(result.UnmarshalBodyToObject(&errResp), check.IsNil)
+	c.Assert(errResp.ErrorCode, check.Equals, int(terror.ErrSchedulerSourceCfgNotExist.Code()))
+
+	source1 := openapi.Source{
+		SourceName: source1Name,
+		EnableGtid: false,
+		Host:       "127.0.0.1",
+		Password:   "123456",
+		Port:       3306,
+		User:       "root",
+	}
+	result = testutil.NewRequest().Post(baseURL).WithJsonBody(source1).Go(t.testT, s.echo)
+	c.Assert(result.Code(), check.Equals, http.StatusCreated)
+
+	// list schemas, system schemas are filtered out
+	mock := conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW DATABASES").WillReturnRows(sqlmock.NewRows([]string{"Database"}).
+		AddRow("information_schema").AddRow("mysql").AddRow("db1").AddRow("db2"))
+	result = testutil.NewRequest().Get(fmt.Sprintf("%s/%s/schemas", baseURL, source1Name)).Go(t.testT, s.echo)
+	c.Assert(result.Code(), check.Equals, http.StatusOK)
+	var schemaList openapi.SchemaNameList
+	c.Assert(result.UnmarshalBodyToObject(&schemaList), check.IsNil)
+	c.Assert(schemaList, check.DeepEquals, openapi.SchemaNameList{"db1", "db2"})
+	c.Assert(mock.ExpectationsWereMet(), check.IsNil)
+
+	// list tables
+	mock = conn.InitMockDB(c)
+	mock.ExpectQuery("SHOW FULL TABLES IN `db1` WHERE Table_Type != 'VIEW';").WillReturnRows(
+		sqlmock.NewRows([]string{"Tables_in_db1", "Table_type"}).AddRow("t1", "BASE TABLE").AddRow("t2", "BASE TABLE"))
+	result = testutil.NewRequest().Get(fmt.Sprintf("%s/%s/schemas/db1", baseURL, source1Name)).Go(t.testT, s.echo)
+	c.Assert(result.Code(), check.Equals, http.StatusOK)
+	var tableList openapi.TableNameList
+	c.Assert(result.UnmarshalBodyToObject(&tableList), check.IsNil)
+	c.Assert(tableList, check.DeepEquals, openapi.TableNameList{"t1", "t2"})
+	c.Assert(mock.ExpectationsWereMet(), check.IsNil)
+}
+
 func (t *openAPISuite) TestRelayAPI(c *check.C) {
 	ctx, cancel := context.WithCancel(context.Background())
 	s := setupServer(ctx, c)
@@ -0,0 +1,278 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	dmcommon "github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// operator returns the operator of the request reported by the client, or empty string if unknown.
+func operator(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ops := md.Get(dmcommon.OperatorMetadataKey); len(ops) > 0 {
+		return ops[0]
+	}
+	return ""
+}
+
+// isReadOnlyRequest returns whether the request of an audited RPC doesn't mutate anything, like showing the locks.
+func isReadOnlyRequest(req interface{}) bool {
+	switch r := req.(type) {
+	case *pb.OperateSourceRequest:
+		return r.Op == pb.SourceOp_ShowSource
+	case *pb.OperateSchemaRequest:
+		return r.Op == pb.SchemaOp_GetSchema
+	case *pb.OperateTaskLockRequest:
+		return r.Op == pb.TaskLockOp_ShowTaskLock
+	case *pb.QuarantineTableRequest:
+		return r.Op == pb.QuarantineOp_ShowQuarantine
+	case *pb.OperateProjectRequest:
+		return r.Op == pb.ProjectOp_ShowProject
+	case *pb.HandleErrorRequest:
+		return r.Op == pb.ErrorOp_Preview
+	case *pb.MigrateKeySchemaRequest:
+		return r.DryRun
+	case *pb.UpgradeClusterRequest:
+		return r.Op == pb.UpgradeClusterOp_ShowUpgrade
+	}
+	return false
+}
+
+// auditOperation records the mutating request of the method and its outcome into the operation audit log.
+// the requests forwarded by other DM-masters are recorded by the forwarding DM-master which knows the client,
+// and failing to record only logs the error, the operation itself is not affected.
+func (s *Server) auditOperation(ctx context.Context, method string, req interface{}, resp interface{}, err error) {
+	typ := clientType(ctx)
+	if typ == clientTypeDMMaster || s.etcdClient == nil || isReadOnlyRequest(req) {
+		return
+	}
+
+	var (
+		result bool
+		msg    string
+	)
+	if err != nil {
+		msg = err.Error()
+	} else if r, ok := resp.(interface {
+		GetResult() bool
+		GetMsg() string
+	}); ok {
+		result, msg = r.GetResult(), r.GetMsg()
+	}
+	args := utils.HidePassword(fmt.Sprintf("%v", req))
+	r := ha.NewOperationRecord(time.Now(), method, operator(ctx), typ, clientHost(ctx), s.cfg.Name, args, result, msg)
+	if _, err2 := ha.PutOperationRecord(s.etcdClient, r); err2 != nil {
+		log.L().Error("fail to record the operation", zap.String("request", method), zap.String("operator", r.Operator), log.ShortError(err2))
+		return
+	}
+	if _, err2 := ha.TrimOperationRecords(s.etcdClient, s.cfg.OperationAuditMaxRecords); err2 != nil {
+		log.L().Warn("fail to trim the operation records", log.ShortError(err2))
+	}
+}
+
+// OperationHistory implements MasterServer.OperationHistory.
+func (s *Server) OperationHistory(ctx context.Context, req *pb.OperationHistoryRequest) (*pb.OperationHistoryResponse, error) {
+	var (
+		resp2 *pb.OperationHistoryResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.OperationHistoryResponse{}
+	// the records are filtered after loaded, they are bounded by `operation-audit-max-records`.
+	records, _, err := ha.GetOperationRecords(s.etcdClient, 0)
+	if err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	for _, r := range records {
+		if (req.Method != "" && r.Method != req.Method) || (req.Operator != "" && r.Operator != req.Operator) {
+			continue
+		}
+		resp.Records = append(resp.Records, &pb.OperationRecord{
+			Time:       r.Time.Format(time.RFC3339),
+			Method:     r.Method,
+			Operator:   r.Operator,
+			ClientType: r.ClientType,
+			ClientHost: r.ClientHost,
+			Master:     r.Master,
+			Args:       r.Args,
+			Result:     r.Result,
+			Msg:        r.Msg,
+		})
+		if req.Limit > 0 && int64(len(resp.Records)) >= req.Limit {
+			break
+		}
+	}
+	resp.Result = true
+	return resp, nil
+}
+
+// auditedServer wraps the Server to record the mutating RPCs from the clients into the operation audit log,
+// it's registered as the gRPC service so the requests from dmctl and the HTTP API are audited.
+type auditedServer struct {
+	*Server
+}
+
+// StartTask implements MasterServer.StartTask.
+func (s auditedServer) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.StartTaskResponse, error) {
+	resp, err := s.Server.StartTask(ctx, req)
+	s.auditOperation(ctx, "StartTask", req, resp, err)
+	return resp, err
+}
+
+// OperateTask implements MasterServer.OperateTask.
+func (s auditedServer) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*pb.OperateTaskResponse, error) {
+	resp, err := s.Server.OperateTask(ctx, req)
+	s.auditOperation(ctx, "OperateTask", req, resp, err)
+	return resp, err
+}
+
+// UpdateTask implements MasterServer.UpdateTask.
+func (s auditedServer) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
+	resp, err := s.Server.UpdateTask(ctx, req)
+	s.auditOperation(ctx, "UpdateTask", req, resp, err)
+	return resp, err
+}
+
+// UnlockDDLLock implements MasterServer.UnlockDDLLock.
+func (s auditedServer) UnlockDDLLock(ctx context.Context, req *pb.UnlockDDLLockRequest) (*pb.UnlockDDLLockResponse, error) {
+	resp, err := s.Server.UnlockDDLLock(ctx, req)
+	s.auditOperation(ctx, "UnlockDDLLock", req, resp, err)
+	return resp, err
+}
+
+// OperateWorkerRelayTask implements MasterServer.OperateWorkerRelayTask.
+func (s auditedServer) OperateWorkerRelayTask(ctx context.Context, req *pb.OperateWorkerRelayRequest) (*pb.OperateWorkerRelayResponse, error) {
+	resp, err := s.Server.OperateWorkerRelayTask(ctx, req)
+	s.auditOperation(ctx, "OperateWorkerRelayTask", req, resp, err)
+	return resp, err
+}
+
+// PurgeWorkerRelay implements MasterServer.PurgeWorkerRelay.
+func (s auditedServer) PurgeWorkerRelay(ctx context.Context, req *pb.PurgeWorkerRelayRequest) (*pb.PurgeWorkerRelayResponse, error) {
+	resp, err := s.Server.PurgeWorkerRelay(ctx, req)
+	s.auditOperation(ctx, "PurgeWorkerRelay", req, resp, err)
+	return resp, err
+}
+
+// OperateSource implements MasterServer.OperateSource.
+func (s auditedServer) OperateSource(ctx context.Context, req *pb.OperateSourceRequest) (*pb.OperateSourceResponse, error) {
+	resp, err := s.Server.OperateSource(ctx, req)
+	s.auditOperation(ctx, "OperateSource", req, resp, err)
+	return resp, err
+}
+
+// OfflineMember implements MasterServer.OfflineMember.
+func (s auditedServer) OfflineMember(ctx context.Context, req *pb.OfflineMemberRequest) (*pb.OfflineMemberResponse, error) {
+	resp, err := s.Server.OfflineMember(ctx, req)
+	s.auditOperation(ctx, "OfflineMember", req, resp, err)
+	return resp, err
+}
+
+// OperateLeader implements MasterServer.OperateLeader.
+func (s auditedServer) OperateLeader(ctx context.Context, req *pb.OperateLeaderRequest) (*pb.OperateLeaderResponse, error) {
+	resp, err := s.Server.OperateLeader(ctx, req)
+	s.auditOperation(ctx, "OperateLeader", req, resp, err)
+	return resp, err
+}
+
+// OperateSchema implements MasterServer.OperateSchema.
+func (s auditedServer) OperateSchema(ctx context.Context, req *pb.OperateSchemaRequest) (*pb.OperateSchemaResponse, error) {
+	resp, err := s.Server.OperateSchema(ctx, req)
+	s.auditOperation(ctx, "OperateSchema", req, resp, err)
+	return resp, err
+}
+
+// HandleError implements MasterServer.HandleError.
+func (s auditedServer) HandleError(ctx context.Context, req *pb.HandleErrorRequest) (*pb.HandleErrorResponse, error) {
+	resp, err := s.Server.HandleError(ctx, req)
+	s.auditOperation(ctx, "HandleError", req, resp, err)
+	return resp, err
+}
+
+// TransferSource implements MasterServer.TransferSource.
+func (s auditedServer) TransferSource(ctx context.Context, req *pb.TransferSourceRequest) (*pb.TransferSourceResponse, error) {
+	resp, err := s.Server.TransferSource(ctx, req)
+	s.auditOperation(ctx, "TransferSource", req, resp, err)
+	return resp, err
+}
+
+// OperateRelay implements MasterServer.OperateRelay.
+func (s auditedServer) OperateRelay(ctx context.Context, req *pb.OperateRelayRequest) (*pb.OperateRelayResponse, error) {
+	resp, err := s.Server.OperateRelay(ctx, req)
+	s.auditOperation(ctx, "OperateRelay", req, resp, err)
+	return resp, err
+}
+
+// RestoreCluster implements MasterServer.RestoreCluster.
+func (s auditedServer) RestoreCluster(ctx context.Context, req *pb.RestoreClusterRequest) (*pb.RestoreClusterResponse, error) {
+	resp, err := s.Server.RestoreCluster(ctx, req)
+	// the backup may be large, only record the size of it.
+	s.auditOperation(ctx, "RestoreCluster", fmt.Sprintf("backup of %d bytes", len(req.Backup)), resp, err)
+	return resp, err
+}
+
+// OperateTaskLock implements MasterServer.OperateTaskLock.
+func (s auditedServer) OperateTaskLock(ctx context.Context, req *pb.OperateTaskLockRequest) (*pb.OperateTaskLockResponse, error) {
+	resp, err := s.Server.OperateTaskLock(ctx, req)
+	s.auditOperation(ctx, "OperateTaskLock", req, resp, err)
+	return resp, err
+}
+
+// QuarantineTable implements MasterServer.QuarantineTable.
+func (s auditedServer) QuarantineTable(ctx context.Context, req *pb.QuarantineTableRequest) (*pb.QuarantineTableResponse, error) {
+	resp, err := s.Server.QuarantineTable(ctx, req)
+	s.auditOperation(ctx, "QuarantineTable", req, resp, err)
+	return resp, err
+}
+
+// OperateProject implements MasterServer.OperateProject.
+func (s auditedServer) OperateProject(ctx context.Context, req *pb.OperateProjectRequest) (*pb.OperateProjectResponse, error) {
+	resp, err := s.Server.OperateProject(ctx, req)
+	s.auditOperation(ctx, "OperateProject", req, resp, err)
+	return resp, err
+}
+
+// MigrateKeySchema implements MasterServer.MigrateKeySchema.
+func (s auditedServer) MigrateKeySchema(ctx context.Context, req *pb.MigrateKeySchemaRequest) (*pb.MigrateKeySchemaResponse, error) {
+	resp, err := s.Server.MigrateKeySchema(ctx, req)
+	s.auditOperation(ctx, "MigrateKeySchema", req, resp, err)
+	return resp, err
+}
+
+// UpgradeCluster implements MasterServer.UpgradeCluster.
+func (s auditedServer) UpgradeCluster(ctx context.Context, req *pb.UpgradeClusterRequest) (*pb.UpgradeClusterResponse, error) {
+	resp, err := s.Server.UpgradeCluster(ctx, req)
+	s.auditOperation(ctx, "UpgradeCluster", req, resp, err)
+	return resp, err
+}
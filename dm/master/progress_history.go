@@ -0,0 +1,186 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+)
+
+// progressHistoryLoop records the progress of all tasks periodically when the current member is the leader.
+func (s *Server) progressHistoryLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.ProgressHistoryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if s.leader.Load() != oneselfLeader {
+			continue
+		}
+		s.recordProgress(ctx)
+	}
+}
+
+// recordProgress records the progress of all tasks into etcd and deletes the records older than
+// `progress-history-retain`, failing to record only logs the error.
+func (s *Server) recordProgress(ctx context.Context) {
+	ctx2, cancel := context.WithTimeout(ctx, s.cfg.RPCTimeout)
+	resps := s.getStatusFromWorkers(ctx2, s.scheduler.BoundSources(), "", false)
+	cancel()
+
+	now := time.Now()
+	if records := progressRecords(now, resps); len(records) > 0 {
+		if _, err := ha.PutProgressRecords(s.etcdClient, records...); err != nil {
+			log.L().Error("fail to record the progress of tasks", log.ShortError(err))
+		}
+	}
+	if _, err := ha.DeleteProgressRecordsBefore(s.etcdClient, now.Add(-s.cfg.ProgressHistoryRetain)); err != nil {
+		log.L().Warn("fail to delete the old progress records", log.ShortError(err))
+	}
+}
+
+// progressRecords groups the progress of the subtasks in the status responses by the task.
+func progressRecords(t time.Time, resps []*pb.QueryStatusResponse) []ha.ProgressRecord {
+	var (
+		tasks    []string
+		subTasks = make(map[string][]ha.SubTaskProgress)
+	)
+	for _, resp := range resps {
+		if !resp.Result || resp.SourceStatus == nil {
+			continue
+		}
+		for _, st := range resp.SubTaskStatus {
+			if st == nil || st.Name == "" {
+				continue
+			}
+			if _, ok := subTasks[st.Name]; !ok {
+				tasks = append(tasks, st.Name)
+			}
+			subTasks[st.Name] = append(subTasks[st.Name], subTaskProgress(resp.SourceStatus.Source, st))
+		}
+	}
+
+	records := make([]ha.ProgressRecord, 0, len(tasks))
+	for _, task := range tasks {
+		records = append(records, ha.NewProgressRecord(t, task, subTasks[task]))
+	}
+	return records
+}
+
+func subTaskProgress(source string, st *pb.SubTaskStatus) ha.SubTaskProgress {
+	p := ha.SubTaskProgress{
+		Source: source,
+		Stage:  st.Stage,
+		Unit:   st.Unit,
+	}
+	switch status := st.Status.(type) {
+	case *pb.SubTaskStatus_Sync:
+		p.Location = status.Sync.SyncerBinlog
+		p.GTID = status.Sync.SyncerBinlogGtid
+		p.Rows = status.Sync.TotalEvents
+	case *pb.SubTaskStatus_Load:
+		p.Location = status.Load.Progress
+		p.Rows = status.Load.FinishedRows
+	}
+	if st.Result != nil {
+		for _, e := range st.Result.Errors {
+			p.Errors = append(p.Errors, e.Message)
+		}
+	}
+	return p
+}
+
+// compareProgress compares the progress records of each subtask, the records should be ordered by time for every task.
+// only the subtasks of the sources are compared if `sources` is not empty.
+func compareProgress(records []ha.ProgressRecord, sources []string) []*pb.SubTaskProgressHistory {
+	sourceSet := make(map[string]struct{}, len(sources))
+	for _, source := range sources {
+		sourceSet[source] = struct{}{}
+	}
+
+	var (
+		histories []*pb.SubTaskProgressHistory
+		index     = make(map[string]int)
+		prevs     = make(map[string]ha.SubTaskProgress)
+		errs      = make(map[string]map[string]struct{})
+	)
+	for _, r := range records {
+		for _, p := range r.SubTasks {
+			if _, ok := sourceSet[p.Source]; len(sourceSet) > 0 && !ok {
+				continue
+			}
+			key := r.Task + "/" + p.Source
+			cur := &pb.SubTaskProgress{
+				Time:     r.Time.Format(time.RFC3339),
+				Stage:    p.Stage,
+				Unit:     p.Unit,
+				Location: p.Location,
+				Gtid:     p.GTID,
+				Rows:     p.Rows,
+				Errors:   p.Errors,
+			}
+
+			i, ok := index[key]
+			if !ok {
+				i = len(histories)
+				index[key] = i
+				histories = append(histories, &pb.SubTaskProgressHistory{Task: r.Task, Source: p.Source, First: cur})
+				errs[key] = make(map[string]struct{})
+			} else {
+				prev := prevs[key]
+				if p.Unit != prev.Unit || p.Location != prev.Location || p.GTID != prev.GTID {
+					histories[i].Moved = true
+				}
+				delta := p.Rows - prev.Rows
+				if p.Unit != prev.Unit || delta < 0 {
+					// the counter is reset when the subtask is restarted or switched to the next unit.
+					delta = p.Rows
+				}
+				histories[i].RowsApplied += delta
+			}
+			prevs[key] = p
+
+			h := histories[i]
+			h.Last = cur
+			h.Samples++
+			for _, e := range p.Errors {
+				if _, ok2 := errs[key][e]; !ok2 {
+					errs[key][e] = struct{}{}
+					h.Errors = append(h.Errors, e)
+				}
+			}
+		}
+	}
+	return histories
+}
+
+// progressHistory compares the current progress of the task with the progress records since the time.
+func (s *Server) progressHistory(task string, sources []string, since time.Time, current []*pb.QueryStatusResponse) ([]*pb.SubTaskProgressHistory, error) {
+	records, _, err := ha.GetProgressRecords(s.etcdClient, task, since)
+	if err != nil {
+		return nil, err
+	}
+	// the records of every task are ordered by time, so the current progress is appended as the latest ones.
+	records = append(records, progressRecords(time.Now(), current)...)
+	return compareProgress(records, sources), nil
+}
@@ -0,0 +1,287 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// phases of a migration project, in the order of migrating.
+const (
+	projectPhasePending  = "Pending"
+	projectPhaseDump     = "Dump"
+	projectPhaseLoad     = "Load"
+	projectPhaseSync     = "Sync"
+	projectPhaseFinished = "Finished"
+)
+
+var projectPhaseOrder = map[string]int{
+	projectPhaseDump:     0,
+	projectPhaseLoad:     1,
+	projectPhaseSync:     2,
+	projectPhaseFinished: 3,
+}
+
+// OperateProject implements MasterServer.OperateProject.
+func (s *Server) OperateProject(ctx context.Context, req *pb.OperateProjectRequest) (*pb.OperateProjectResponse, error) {
+	var (
+		resp2 *pb.OperateProjectResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.OperateProjectResponse{}
+	var err error
+	switch req.Op {
+	case pb.ProjectOp_CreateProject:
+		err = s.createProject(req)
+	case pb.ProjectOp_DeleteProject:
+		err = s.deleteProject(req.Name)
+	case pb.ProjectOp_ShowProject:
+		resp.Projects, err = s.getProjectStatus(ctx, req.Name)
+	default:
+		err = terror.ErrMasterInvalidOperateOp.Generate(req.Op.String(), "project")
+	}
+	if err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	if req.Op == pb.ProjectOp_CreateProject {
+		resp.Projects, _ = s.getProjectStatus(ctx, req.Name)
+	}
+	resp.Result = true
+	return resp, nil
+}
+
+// createProject creates a project grouping the tasks, the tasks may be not started yet.
+func (s *Server) createProject(req *pb.OperateProjectRequest) error {
+	switch {
+	case req.Name == "":
+		return terror.ErrMasterWorkerArgsExtractor.Generatef("must specify the name of the project")
+	case len(req.Tasks) == 0:
+		return terror.ErrMasterWorkerArgsExtractor.Generatef("must specify the tasks of the project")
+	}
+	seen := make(map[string]struct{}, len(req.Tasks))
+	for _, task := range req.Tasks {
+		if _, ok := seen[task]; ok {
+			return terror.ErrMasterWorkerArgsExtractor.Generatef("task %s is specified more than once", task)
+		}
+		seen[task] = struct{}{}
+	}
+
+	p := ha.NewProject(req.Name, req.Tasks)
+	ok, _, err := ha.PutProjectIfNotExist(s.etcdClient, p)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return terror.ErrMasterProjectExists.Generate(req.Name)
+	}
+	log.L().Info("project created", zap.Stringer("project", p))
+	return nil
+}
+
+// deleteProject deletes the project, the tasks of it are not affected.
+func (s *Server) deleteProject(name string) error {
+	if name == "" {
+		return terror.ErrMasterWorkerArgsExtractor.Generatef("must specify the name of the project")
+	}
+	ok, _, err := ha.DeleteProject(s.etcdClient, name)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return terror.ErrMasterProjectNotFound.Generate(name)
+	}
+	log.L().Info("project deleted", zap.String("project", name))
+	return nil
+}
+
+// getProjectStatus returns the status of the project, or of all projects if name is empty.
+func (s *Server) getProjectStatus(ctx context.Context, name string) ([]*pb.ProjectStatus, error) {
+	var projects map[string]ha.Project
+	if name != "" {
+		p, ok, _, err := ha.GetProject(s.etcdClient, name)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, terror.ErrMasterProjectNotFound.Generate(name)
+		}
+		projects = map[string]ha.Project{name: p}
+	} else {
+		var err error
+		projects, _, err = ha.GetAllProject(s.etcdClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ret := make([]*pb.ProjectStatus, 0, len(projects))
+	for _, p := range projects {
+		statuses := make(map[string][]*pb.QueryStatusResponse, len(p.Tasks))
+		for _, task := range p.Tasks {
+			if sources := s.getTaskResources(task); len(sources) > 0 {
+				statuses[task] = s.getStatusFromWorkers(ctx, sources, task, false)
+			}
+		}
+		ret = append(ret, combineProjectStatus(p, statuses))
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret, nil
+}
+
+// combineProjectStatus combines the status of the tasks in the project into a single view, k/v of statuses:
+// task-name -> the status responses of the sources, tasks without status are not started.
+//   - phase: the earliest phase of the unfinished subtasks.
+//   - progress: the average progress of the full data migration of the subtasks.
+//   - estimated remaining seconds: of the slowest subtask in full data migration.
+//   - cutover ready: all subtasks are finished or syncing and have caught up with upstream.
+func combineProjectStatus(p ha.Project, statuses map[string][]*pb.QueryStatusResponse) *pb.ProjectStatus {
+	var (
+		status = &pb.ProjectStatus{
+			Name:  p.Name,
+			Tasks: p.Tasks,
+			Phase: projectPhaseFinished,
+		}
+		pending       bool
+		totalProgress float64
+	)
+	setPhase := func(phase string) {
+		if projectPhaseOrder[phase] < projectPhaseOrder[status.Phase] {
+			status.Phase = phase
+		}
+	}
+	block := func(format string, args ...interface{}) {
+		status.Blockers = append(status.Blockers, fmt.Sprintf(format, args...))
+	}
+
+	for _, task := range p.Tasks {
+		resps, ok := statuses[task]
+		if !ok {
+			pending = true
+			block("task %s is not started", task)
+			continue
+		}
+		sort.Slice(resps, func(i, j int) bool {
+			return resps[i].SourceStatus.GetSource() < resps[j].SourceStatus.GetSource()
+		})
+		for _, resp := range resps {
+			source := resp.SourceStatus.GetSource()
+			if !resp.Result {
+				block("fail to query status of task %s on source %s: %s", task, source, resp.Msg)
+				continue
+			}
+			for _, st := range resp.SubTaskStatus {
+				if st.Name != task {
+					continue
+				}
+				sub := &pb.ProjectSubTaskStatus{
+					Task:                      task,
+					Source:                    source,
+					Unit:                      st.Unit,
+					Stage:                     st.Stage,
+					Progress:                  "0.00 %",
+					EstimatedRemainingSeconds: -1,
+				}
+				var (
+					progress float64
+					phase    string
+				)
+				switch st.Unit {
+				case pb.UnitType_Check, pb.UnitType_Dump:
+					phase = projectPhaseDump
+				case pb.UnitType_Load:
+					phase = projectPhaseLoad
+					if load := st.GetLoad(); load != nil {
+						if load.TotalBytes > 0 {
+							progress = float64(load.FinishedBytes) / float64(load.TotalBytes)
+						}
+						sub.Progress = load.Progress
+						sub.EstimatedRemainingSeconds = load.EstimatedRemainingSeconds
+					}
+				default:
+					phase = projectPhaseSync
+					progress = 1
+					sub.Progress = "100.00 %"
+					sub.EstimatedRemainingSeconds = 0
+					if sync := st.GetSync(); sync != nil {
+						sub.SecondsBehindMaster = sync.SecondsBehindMaster
+						sub.Synced = sync.Synced
+					}
+				}
+				if st.Stage == pb.Stage_Finished {
+					phase = projectPhaseFinished
+					progress = 1
+					sub.Progress = "100.00 %"
+					sub.EstimatedRemainingSeconds = 0
+				}
+				setPhase(phase)
+				totalProgress += progress
+
+				switch {
+				case st.Stage == pb.Stage_Finished:
+				case st.Stage != pb.Stage_Running:
+					block("task %s on source %s is %s in %s unit", task, source, st.Stage, st.Unit)
+				case phase != projectPhaseSync:
+					block("task %s on source %s is in %s unit", task, source, st.Unit)
+				case len(st.GetSync().GetBlockingDDLs()) > 0 || len(st.GetSync().GetUnresolvedGroups()) > 0:
+					block("task %s on source %s has unresolved shard DDL", task, source)
+				case !sub.Synced:
+					block("task %s on source %s has not caught up with upstream, %d seconds behind master", task, source, sub.SecondsBehindMaster)
+				}
+				status.Subtasks = append(status.Subtasks, sub)
+			}
+		}
+	}
+
+	if len(status.Subtasks) > 0 {
+		status.Progress = fmt.Sprintf("%.2f %%", totalProgress/float64(len(status.Subtasks))*100)
+	} else {
+		status.Progress = "0.00 %"
+	}
+	if len(status.Subtasks) == 0 || (pending && status.Phase == projectPhaseFinished) {
+		status.Phase = projectPhasePending
+	}
+	if pending {
+		status.EstimatedRemainingSeconds = -1
+	} else {
+		for _, sub := range status.Subtasks {
+			if sub.EstimatedRemainingSeconds < 0 {
+				status.EstimatedRemainingSeconds = -1
+				break
+			}
+			if sub.EstimatedRemainingSeconds > status.EstimatedRemainingSeconds {
+				status.EstimatedRemainingSeconds = sub.EstimatedRemainingSeconds
+			}
+		}
+	}
+	status.CutoverReady = len(status.Blockers) == 0
+	return status
+}
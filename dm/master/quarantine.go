@@ -0,0 +1,117 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/dm/dm/master/workerrpc"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// quarantineReleaseTimeout is the timeout to release a quarantined table, all the buffered events are written to
+// downstream which may take much longer than `rpc-timeout`.
+const quarantineReleaseTimeout = 30 * time.Minute
+
+// QuarantineTable implements MasterServer.QuarantineTable.
+func (s *Server) QuarantineTable(ctx context.Context, req *pb.QuarantineTableRequest) (*pb.QuarantineTableResponse, error) {
+	var (
+		resp2 *pb.QuarantineTableResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.QuarantineTableResponse{}
+	switch req.Op {
+	case pb.QuarantineOp_AddQuarantine, pb.QuarantineOp_ReleaseQuarantine, pb.QuarantineOp_RepairQuarantine:
+		if req.Database == "" || req.Table == "" {
+			resp.Msg = "must specify the database and table of the downstream table"
+			return resp, nil
+		}
+	case pb.QuarantineOp_ShowQuarantine:
+	default:
+		resp.Msg = terror.ErrMasterInvalidOperateOp.Generate(req.Op.String(), "quarantine").Error()
+		return resp, nil
+	}
+
+	sources := req.Sources
+	if len(sources) == 0 {
+		sources = s.getTaskResources(req.Task)
+		if len(sources) == 0 {
+			resp.Msg = fmt.Sprintf("task %s has no source or not exist, please check the task name and status", req.Task)
+			return resp, nil
+		}
+	}
+
+	timeout := s.cfg.RPCTimeout
+	if req.Op == pb.QuarantineOp_ReleaseQuarantine && timeout < quarantineReleaseTimeout {
+		timeout = quarantineReleaseTimeout
+	}
+	workerRespCh := make(chan *pb.CommonWorkerResponse, len(sources))
+	var wg sync.WaitGroup
+	for _, source := range sources {
+		wg.Add(1)
+		go func(source string) {
+			defer wg.Done()
+			worker := s.scheduler.GetWorkerBySource(source)
+			if worker == nil {
+				workerRespCh <- errorCommonWorkerResponse(fmt.Sprintf("source %s relevant worker-client not found", source), source, "")
+				return
+			}
+			workerReq := workerrpc.Request{
+				Type: workerrpc.CmdQuarantineTable,
+				QuarantineTable: &pb.QuarantineWorkerTableRequest{
+					Op:       req.Op,
+					Task:     req.Task,
+					Source:   source,
+					Database: req.Database,
+					Table:    req.Table,
+				},
+			}
+
+			var workerResp *pb.CommonWorkerResponse
+			resp, err := worker.SendRequest(ctx, &workerReq, timeout)
+			if err != nil {
+				workerResp = errorCommonWorkerResponse(err.Error(), source, worker.BaseInfo().Name)
+			} else {
+				workerResp = resp.QuarantineTable
+			}
+			workerResp.Source = source
+			workerRespCh <- workerResp
+		}(source)
+	}
+	wg.Wait()
+
+	workerRespMap := make(map[string]*pb.CommonWorkerResponse, len(sources))
+	for len(workerRespCh) > 0 {
+		workerResp := <-workerRespCh
+		workerRespMap[workerResp.Source] = workerResp
+	}
+
+	sort.Strings(sources)
+	resp.Sources = make([]*pb.CommonWorkerResponse, 0, len(sources))
+	for _, source := range sources {
+		resp.Sources = append(resp.Sources, workerRespMap[source])
+	}
+	resp.Result = true
+	return resp, nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"sort"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// DisableSource disables a source temporarily, the running relay and subtasks of the source are paused,
+// but the source config, the bound relationship and the checkpoints are kept.
+// the relay and subtasks of a disabled source can't be resumed, and no new subtasks can be added to it,
+// until the source is enabled by `EnableSource`.
+func (s *Scheduler) DisableSource(source string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		return terror.ErrSchedulerNotStarted.Generate()
+	}
+
+	// 1. check the source.
+	if _, ok := s.sourceCfgs[source]; !ok {
+		return terror.ErrSchedulerSourceCfgNotExist.Generate(source)
+	}
+	if _, ok := s.disabledSources[source]; ok {
+		return terror.ErrSchedulerSourceDisabled.Generate(source)
+	}
+
+	// 2. pause the running relay and subtasks, and record them to resume after enabled.
+	ds := ha.NewDisabledSource(source)
+	var relayStages []ha.Stage
+	if stage, ok := s.expectRelayStages[source]; ok && stage.Expect == pb.Stage_Running {
+		ds.RelayRunning = true
+		relayStages = append(relayStages, ha.NewRelayStage(pb.Stage_Paused, source))
+	}
+
+	subTaskStages, release, err := s.collectSubTaskStages(source, pb.Stage_Running, pb.Stage_Paused, nil)
+	defer release()
+	if err != nil {
+		return err
+	}
+	for _, stage := range subTaskStages {
+		ds.RunningTasks = append(ds.RunningTasks, stage.Task)
+	}
+
+	// 3. put the disabled source and stages into etcd.
+	if _, err = ha.PutDisabledSourceStage(s.etcdCli, ds, relayStages, subTaskStages); err != nil {
+		return err
+	}
+
+	// 4. update the disabled source and stages in the scheduler.
+	s.disabledSources[source] = ds
+	s.updateStagesForSource(relayStages, subTaskStages)
+	s.logger.Info("source disabled", zap.Stringer("disabled source", ds))
+	return nil
+}
+
+// EnableSource enables a source disabled by `DisableSource`,
+// the relay and subtasks running before disabled are resumed.
+func (s *Scheduler) EnableSource(source string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		return terror.ErrSchedulerNotStarted.Generate()
+	}
+
+	// 1. check the source.
+	ds, ok := s.disabledSources[source]
+	if !ok {
+		return terror.ErrSchedulerSourceNotDisabled.Generate(source)
+	}
+
+	// 2. resume the relay and subtasks running before disabled, the stopped subtasks are ignored.
+	var relayStages []ha.Stage
+	if _, ok = s.expectRelayStages[source]; ok && ds.RelayRunning {
+		relayStages = append(relayStages, ha.NewRelayStage(pb.Stage_Running, source))
+	}
+	runningTasks := make(map[string]struct{}, len(ds.RunningTasks))
+	for _, task := range ds.RunningTasks {
+		runningTasks[task] = struct{}{}
+	}
+	subTaskStages, release, err := s.collectSubTaskStages(source, pb.Stage_Paused, pb.Stage_Running, runningTasks)
+	defer release()
+	if err != nil {
+		return err
+	}
+
+	// 3. delete the disabled source and put stages into etcd.
+	if _, err = ha.DeleteDisabledSourceStage(s.etcdCli, source, relayStages, subTaskStages); err != nil {
+		return err
+	}
+
+	// 4. update the disabled source and stages in the scheduler.
+	delete(s.disabledSources, source)
+	s.updateStagesForSource(relayStages, subTaskStages)
+	s.logger.Info("source enabled", zap.Stringer("disabled source", ds))
+	return nil
+}
+
+// IsSourceDisabled returns whether the source is disabled.
+func (s *Scheduler) IsSourceDisabled(source string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.disabledSources[source]
+	return ok
+}
+
+// checkSourcesNotDisabled returns an error if any of the sources is disabled.
+func (s *Scheduler) checkSourcesNotDisabled(sources ...string) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, source := range sources {
+		if _, ok := s.disabledSources[source]; ok {
+			return terror.ErrSchedulerSourceDisabled.Generate(source)
+		}
+	}
+	return nil
+}
+
+// collectSubTaskStages acquires the latches of the subtasks of the source in `from` stage,
+// and returns their new stages in `to` stage. if tasks is not nil, only the subtasks in it are collected.
+// the returned release function should always be called to release the latches.
+func (s *Scheduler) collectSubTaskStages(source string, from, to pb.Stage, tasks map[string]struct{}) ([]ha.Stage, ReleaseFunc, error) {
+	var (
+		stages   []ha.Stage
+		releases []ReleaseFunc
+		err      error
+	)
+	release := func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+
+	s.expectSubTaskStages.Range(func(k, v interface{}) bool {
+		task := k.(string)
+		if tasks != nil {
+			if _, ok := tasks[task]; !ok {
+				return true
+			}
+		}
+		r, err2 := s.subtaskLatch.tryAcquire(task)
+		if err2 != nil {
+			err = terror.ErrSchedulerLatchInUse.Generate("OperateSource", task)
+			return false
+		}
+		releases = append(releases, r)
+		if stage, ok := v.(map[string]ha.Stage)[source]; ok && stage.Expect == from {
+			stages = append(stages, ha.NewSubTaskStage(to, source, task))
+		}
+		return true
+	})
+	sort.Slice(stages, func(i, j int) bool {
+		return stages[i].Task < stages[j].Task
+	})
+	return stages, release, err
+}
+
+// updateStagesForSource updates the expectant relay and subtask stages in the scheduler,
+// the latches of the subtasks should be held by the caller.
+func (s *Scheduler) updateStagesForSource(relayStages, subTaskStages []ha.Stage) {
+	for _, stage := range relayStages {
+		s.expectRelayStages[stage.Source] = stage
+	}
+	for _, stage := range subTaskStages {
+		if v, ok := s.expectSubTaskStages.Load(stage.Task); ok {
+			v.(map[string]ha.Stage)[stage.Source] = stage
+		}
+	}
+}
+
+// recoverDisabledSources recovers the disabled sources from etcd.
+func (s *Scheduler) recoverDisabledSources(cli *clientv3.Client) error {
+	dss, _, err := ha.GetAllDisabledSource(cli)
+	if err != nil {
+		return err
+	}
+	for source, ds := range dss {
+		s.disabledSources[source] = ds
+	}
+	return nil
+}
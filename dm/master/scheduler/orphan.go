@@ -0,0 +1,162 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/master/workerrpc"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+)
+
+var (
+	// orphanCheckInterval is the interval to check the orphan subtasks in DM-workers.
+	orphanCheckInterval = time.Minute
+	// orphanCheckRPCTimeout is the timeout of the requests sent to DM-workers when checking the orphan subtasks.
+	orphanCheckRPCTimeout = 30 * time.Second
+)
+
+// checkOrphanSubTasks checks the orphan subtasks in DM-workers periodically.
+// a DM-worker may keep running a source or some subtasks which no longer exist in etcd after partial failures,
+// e.g. it failed to handle the deletion of the subtask or source bound. these subtasks may write the downstream
+// concurrently with the subtasks scheduled to other DM-workers, so they are stopped.
+func (s *Scheduler) checkOrphanSubTasks(ctx context.Context) {
+	ticker := time.NewTicker(orphanCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.cleanOrphanSubTasks(ctx)
+		}
+	}
+}
+
+// cleanOrphanSubTasks checks the source and subtasks running in all online DM-workers, and asks the DM-worker to stop
+// them if they are not consistent with the scheduler. DM-worker checks them with etcd again before stopping, so a
+// subtask started or a source bound after the check is not stopped.
+func (s *Scheduler) cleanOrphanSubTasks(ctx context.Context) {
+	s.mu.RLock()
+	workers := make([]*Worker, 0, len(s.workers))
+	for _, w := range s.workers {
+		if w.Stage() != WorkerOffline {
+			workers = append(workers, w)
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, w := range workers {
+		if ctx.Err() != nil {
+			return
+		}
+		name := w.BaseInfo().Name
+		resp, err := w.SendRequest(ctx, &workerrpc.Request{
+			Type:        workerrpc.CmdQueryStatus,
+			QueryStatus: &pb.QueryStatusRequest{},
+		}, orphanCheckRPCTimeout)
+		if err != nil {
+			s.logger.Warn("fail to query status when checking orphan subtasks", zap.String("worker", name), zap.Error(err))
+			continue
+		}
+		if resp.QueryStatus.SourceStatus == nil || resp.QueryStatus.SourceStatus.Source == "" {
+			continue
+		}
+		source := resp.QueryStatus.SourceStatus.Source
+		subTasks := make([]string, 0, len(resp.QueryStatus.SubTaskStatus))
+		for _, st := range resp.QueryStatus.SubTaskStatus {
+			subTasks = append(subTasks, st.Name)
+		}
+
+		staleBound, orphans := s.findOrphanSubTasks(name, source, subTasks)
+		if !staleBound && len(orphans) == 0 {
+			continue
+		}
+		s.logger.Warn("found orphan subtasks in worker, try to stop them", zap.String("worker", name),
+			zap.String("source", source), zap.Bool("stale bound", staleBound), zap.Strings("subtasks", orphans))
+
+		resp, err = w.SendRequest(ctx, &workerrpc.Request{
+			Type:                workerrpc.CmdCleanOrphanSubTasks,
+			CleanOrphanSubTasks: &pb.CleanOrphanSubTasksRequest{},
+		}, orphanCheckRPCTimeout)
+		if err != nil {
+			s.logger.Error("fail to clean orphan subtasks", zap.String("worker", name), zap.Error(err))
+			continue
+		}
+		cleanResp := resp.CleanOrphanSubTasks
+		if !cleanResp.Result {
+			s.logger.Error("fail to clean orphan subtasks", zap.String("worker", name), zap.String("msg", cleanResp.Msg))
+			continue
+		}
+		s.logger.Info("orphan subtasks cleaned", zap.String("worker", name),
+			zap.String("stale source", cleanResp.Source), zap.Strings("stopped subtasks", cleanResp.SubTasks))
+	}
+}
+
+// findOrphanSubTasks returns whether the source handled by the worker is not bound to it, and the subtasks which
+// don't exist in the scheduler.
+// a worker can handle a source which is not bound to it only for relay, so all its subtasks are orphans.
+func (s *Scheduler) findOrphanSubTasks(worker, source string, subTasks []string) (bool, []string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if w, ok := s.bounds[source]; !ok || w.BaseInfo().Name != worker {
+		_, isRelayWorker := s.relayWorkers[source][worker]
+		if len(subTasks) == 0 && isRelayWorker {
+			return false, nil
+		}
+		orphans := append([]string{}, subTasks...)
+		sort.Strings(orphans)
+		return true, orphans
+	}
+
+	var orphans []string
+	for _, task := range subTasks {
+		if s.isOrphanSubTask(task, source) {
+			orphans = append(orphans, task)
+		}
+	}
+	sort.Strings(orphans)
+	return false, orphans
+}
+
+// isOrphanSubTask returns whether the subtask has no config or expect stage in the scheduler. the subtask being
+// operated concurrently is not treated as an orphan, it will be checked next time.
+func (s *Scheduler) isOrphanSubTask(task, source string) bool {
+	release, err := s.subtaskLatch.tryAcquire(task)
+	if err != nil {
+		return false
+	}
+	defer release()
+
+	v, ok := s.subTaskCfgs.Load(task)
+	if !ok {
+		return true
+	}
+	if _, ok = v.(map[string]config.SubTaskConfig)[source]; !ok {
+		return true
+	}
+	v, ok = s.expectSubTaskStages.Load(task)
+	if !ok {
+		return true
+	}
+	_, ok = v.(map[string]ha.Stage)[source]
+	return !ok
+}
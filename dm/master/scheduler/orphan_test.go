@@ -0,0 +1,105 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/master/workerrpc"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/dm/pbmock"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+)
+
+func (t *testScheduler) TestCleanOrphanSubTasks(c *C) {
+	var (
+		logger  = log.L()
+		s       = NewScheduler(&logger, config.Security{})
+		ctrl    = gomock.NewController(c)
+		source1 = "mysql-replica-1"
+		source2 = "mysql-replica-2"
+		source3 = "mysql-replica-3"
+		task1   = "task-1"
+		task2   = "task-2"
+		task3   = "task-3"
+	)
+	defer ctrl.Finish()
+
+	// task1 is running normally, task2 has no expect stage, task3 doesn't exist.
+	s.subTaskCfgs.Store(task1, map[string]config.SubTaskConfig{source1: {}})
+	s.expectSubTaskStages.Store(task1, map[string]ha.Stage{source1: ha.NewSubTaskStage(pb.Stage_Running, source1, task1)})
+	s.subTaskCfgs.Store(task2, map[string]config.SubTaskConfig{source1: {}})
+
+	addWorker := func(name string) *pbmock.MockWorkerClient {
+		w, err := NewWorker(ha.NewWorkerInfo(name, "127.0.0.1:8262"), config.Security{})
+		c.Assert(err, IsNil)
+		w.ToFree()
+		s.workers[name] = w
+		cli := pbmock.NewMockWorkerClient(ctrl)
+		wrap, err := workerrpc.NewGRPCClientWrap(nil, cli)
+		c.Assert(err, IsNil)
+		s.SetWorkerClientForTest(name, wrap)
+		return cli
+	}
+	expectStatus := func(cli *pbmock.MockWorkerClient, source string, tasks ...string) {
+		resp := &pb.QueryStatusResponse{Result: true, SourceStatus: &pb.SourceStatus{Source: source}}
+		for _, task := range tasks {
+			resp.SubTaskStatus = append(resp.SubTaskStatus, &pb.SubTaskStatus{Name: task})
+		}
+		cli.EXPECT().QueryStatus(gomock.Any(), gomock.Any()).Return(resp, nil)
+	}
+	expectClean := func(cli *pbmock.MockWorkerClient, source string, tasks ...string) {
+		cli.EXPECT().CleanOrphanSubTasks(gomock.Any(), gomock.Any()).Return(
+			&pb.CleanOrphanSubTasksResponse{Result: true, Source: source, SubTasks: tasks}, nil)
+	}
+
+	// worker1 is bound to source1 and running orphan subtasks.
+	cli1 := addWorker("dm-worker-1")
+	c.Assert(s.workers["dm-worker-1"].ToBound(ha.NewSourceBound(source1, "dm-worker-1")), IsNil)
+	s.bounds[source1] = s.workers["dm-worker-1"]
+	expectStatus(cli1, source1, task1, task2, task3)
+	expectClean(cli1, "", task2, task3)
+	// worker2 is free but still handling source2.
+	cli2 := addWorker("dm-worker-2")
+	expectStatus(cli2, source2, task1)
+	expectClean(cli2, source2, task1)
+	// worker3 is only pulling relay log for source3.
+	cli3 := addWorker("dm-worker-3")
+	s.relayWorkers[source3] = map[string]struct{}{"dm-worker-3": {}}
+	expectStatus(cli3, source3)
+	// worker4 is offline.
+	addWorker("dm-worker-4")
+	s.workers["dm-worker-4"].ToOffline()
+
+	s.cleanOrphanSubTasks(context.Background())
+
+	staleBound, orphans := s.findOrphanSubTasks("dm-worker-1", source1, []string{task3, task1, task2})
+	c.Assert(staleBound, IsFalse)
+	c.Assert(orphans, DeepEquals, []string{task2, task3})
+	staleBound, orphans = s.findOrphanSubTasks("dm-worker-3", source3, []string{task1})
+	c.Assert(staleBound, IsTrue)
+	c.Assert(orphans, DeepEquals, []string{task1})
+
+	// the subtask being operated is not treated as an orphan.
+	release, err := s.subtaskLatch.tryAcquire(task3)
+	c.Assert(err, IsNil)
+	_, orphans = s.findOrphanSubTasks("dm-worker-1", source1, []string{task3})
+	c.Assert(orphans, HasLen, 0)
+	release()
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/ha"
+)
+
+// the policies to choose a Free worker for an unbound source.
+const (
+	// PolicyLeastLoaded chooses the worker with the least bound sources and resource usage.
+	PolicyLeastLoaded = "least-loaded"
+	// PolicyRoundRobin chooses the workers in turn ordered by the name.
+	PolicyRoundRobin = "round-robin"
+	// PolicyLabelAffinity chooses the least loaded worker among the workers whose labels match the
+	// `worker-labels` of the source, and falls back to all workers if no one matches.
+	PolicyLabelAffinity = "label-affinity"
+)
+
+// IsValidPolicy returns whether the policy is supported.
+func IsValidPolicy(policy string) bool {
+	switch policy {
+	case PolicyLeastLoaded, PolicyRoundRobin, PolicyLabelAffinity:
+		return true
+	}
+	return false
+}
+
+// SetPolicy sets the policy to choose a Free worker for an unbound source, it should be called before `Start`.
+func (s *Scheduler) SetPolicy(policy string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policy = policy
+}
+
+// pickFreeWorker picks a Free worker for the source by the policy, returns nil if no Free worker.
+// the load of the workers reported along with the keepalive are read from etcd.
+func (s *Scheduler) pickFreeWorker(source string) *Worker {
+	frees := make([]*Worker, 0, len(s.workers))
+	for _, w := range s.workers {
+		if w.Stage() == WorkerFree {
+			frees = append(frees, w)
+		}
+	}
+	if len(frees) == 0 {
+		return nil
+	}
+	sort.Slice(frees, func(i, j int) bool {
+		return frees[i].BaseInfo().Name < frees[j].BaseInfo().Name
+	})
+
+	if s.policy == PolicyRoundRobin {
+		picked := frees[0]
+		for _, w := range frees {
+			if w.BaseInfo().Name > s.lastPickedWorker {
+				picked = w
+				break
+			}
+		}
+		s.lastPickedWorker = picked.BaseInfo().Name
+		return picked
+	}
+
+	var loads map[string]ha.WorkerLoad
+	if s.etcdCli != nil {
+		var err error
+		loads, _, err = ha.GetAllWorkerLoads(s.etcdCli)
+		if err != nil {
+			// still pick a worker without the load, binding the source is more important.
+			s.logger.Warn("fail to get the load of workers", zap.Error(err))
+		}
+	}
+
+	if s.policy == PolicyLabelAffinity {
+		if cfg, ok := s.sourceCfgs[source]; ok && len(cfg.WorkerLabels) > 0 {
+			matched := make([]*Worker, 0, len(frees))
+			for _, w := range frees {
+				if labelsMatch(loads[w.BaseInfo().Name].Labels, cfg.WorkerLabels) {
+					matched = append(matched, w)
+				}
+			}
+			if len(matched) > 0 {
+				frees = matched
+			} else {
+				s.logger.Warn("no free worker matches the labels of source, choose from all free workers",
+					zap.String("source", source), zap.Any("labels", cfg.WorkerLabels))
+			}
+		}
+	}
+
+	// workers without the load reported are treated as idle.
+	sort.SliceStable(frees, func(i, j int) bool {
+		li, lj := loads[frees[i].BaseInfo().Name], loads[frees[j].BaseInfo().Name]
+		if li.BoundSources != lj.BoundSources {
+			return li.BoundSources < lj.BoundSources
+		}
+		return loadScore(li) < loadScore(lj)
+	})
+	return frees[0]
+}
+
+// loadScore scores the resource usage of the worker, the lower the better.
+func loadScore(load ha.WorkerLoad) float64 {
+	return (load.CPUUsage + load.MemoryUsage + load.DiskUsage) / 3
+}
+
+// labelsMatch returns whether all `expected` labels are in `labels`.
+func labelsMatch(labels, expected map[string]string) bool {
+	for k, v := range expected {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+)
+
+func (t *testScheduler) TestPickFreeWorker(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		logger      = log.L()
+		s           = NewScheduler(&logger, config.Security{})
+		sourceID1   = "mysql-replica-1"
+		workerName1 = "dm-worker-1"
+		workerName2 = "dm-worker-2"
+		workerName3 = "dm-worker-3"
+	)
+	c.Assert(IsValidPolicy(PolicyLeastLoaded), IsTrue)
+	c.Assert(IsValidPolicy("random"), IsFalse)
+
+	s.etcdCli = etcdTestCli
+	s.sourceCfgs[sourceID1] = &config.SourceConfig{SourceID: sourceID1}
+	for _, name := range []string{workerName1, workerName2, workerName3} {
+		w := &Worker{baseInfo: ha.WorkerInfo{Name: name}}
+		w.ToFree()
+		s.workers[name] = w
+	}
+	c.Assert(s.policy, Equals, PolicyLeastLoaded)
+
+	// no load reported, choose by the name.
+	c.Assert(s.pickFreeWorker(sourceID1).BaseInfo().Name, Equals, workerName1)
+
+	putLoad := func(load ha.WorkerLoad) {
+		data, err := json.Marshal(load)
+		c.Assert(err, IsNil)
+		_, err = etcdTestCli.Put(context.Background(), common.WorkerLoadKeyAdapter.Encode(load.Name), string(data))
+		c.Assert(err, IsNil)
+	}
+	putLoad(ha.WorkerLoad{Name: workerName1, CPUUsage: 90, MemoryUsage: 80, DiskUsage: 70})
+	putLoad(ha.WorkerLoad{Name: workerName2, CPUUsage: 10, MemoryUsage: 20, DiskUsage: 30, BoundSources: 1})
+	putLoad(ha.WorkerLoad{Name: workerName3, CPUUsage: 50, MemoryUsage: 50, DiskUsage: 50, Labels: map[string]string{"zone": "z1"}})
+
+	// least-loaded, fewer bound sources come first.
+	c.Assert(s.pickFreeWorker(sourceID1).BaseInfo().Name, Equals, workerName3)
+	s.workers[workerName3].ToOffline()
+	c.Assert(s.pickFreeWorker(sourceID1).BaseInfo().Name, Equals, workerName1)
+	s.workers[workerName3].ToFree()
+
+	// label-affinity.
+	s.SetPolicy(PolicyLabelAffinity)
+	s.sourceCfgs[sourceID1].WorkerLabels = map[string]string{"zone": "z1"}
+	c.Assert(s.pickFreeWorker(sourceID1).BaseInfo().Name, Equals, workerName3)
+	// fall back to all workers if no one matches.
+	s.sourceCfgs[sourceID1].WorkerLabels = map[string]string{"zone": "z2"}
+	c.Assert(s.pickFreeWorker(sourceID1).BaseInfo().Name, Equals, workerName3)
+	s.workers[workerName3].ToOffline()
+	c.Assert(s.pickFreeWorker(sourceID1).BaseInfo().Name, Equals, workerName1)
+	s.workers[workerName3].ToFree()
+
+	// round-robin.
+	s.SetPolicy(PolicyRoundRobin)
+	c.Assert(s.pickFreeWorker(sourceID1).BaseInfo().Name, Equals, workerName1)
+	c.Assert(s.pickFreeWorker(sourceID1).BaseInfo().Name, Equals, workerName2)
+	s.workers[workerName3].ToOffline()
+	c.Assert(s.pickFreeWorker(sourceID1).BaseInfo().Name, Equals, workerName1)
+	s.workers[workerName1].ToOffline()
+	s.workers[workerName2].ToOffline()
+	c.Assert(s.pickFreeWorker(sourceID1), IsNil)
+}
@@ -149,10 +149,24 @@ type Scheduler struct {
 	// - stop-relay
 	relayWorkers map[string]map[string]struct{}
 
+	// sources disabled by user, source ID -> disabled source.
+	// add:
+	// - disable source by user request (calling `DisableSource`).
+	// - recover from etcd (calling `recoverDisabledSources`).
+	// delete:
+	// - enable source by user request (calling `EnableSource`).
+	// - remove source by user request (calling `RemoveSourceCfg`).
+	disabledSources map[string]ha.DisabledSource
+
 	// workers in load stage
 	// task -> source -> worker
 	loadTasks map[string]map[string]string
 
+	// the policy to choose a Free worker for an unbound source, see `pickFreeWorker`.
+	policy string
+	// the last worker chosen by the `round-robin` policy.
+	lastPickedWorker string
+
 	securityCfg config.Security
 }
 
@@ -168,7 +182,9 @@ func NewScheduler(pLogger *log.Logger, securityCfg config.Security) *Scheduler {
 		lastBound:         make(map[string]ha.SourceBound),
 		expectRelayStages: make(map[string]ha.Stage),
 		relayWorkers:      make(map[string]map[string]struct{}),
+		disabledSources:   make(map[string]ha.DisabledSource),
 		loadTasks:         make(map[string]map[string]string),
+		policy:            PolicyLeastLoaded,
 		securityCfg:       securityCfg,
 	}
 }
@@ -206,6 +222,10 @@ func (s *Scheduler) Start(pCtx context.Context, etcdCli *clientv3.Client) (err e
 	if err != nil {
 		return err
 	}
+	err = s.recoverDisabledSources(etcdCli)
+	if err != nil {
+		return err
+	}
 
 	var loadTaskRev int64
 	loadTaskRev, err = s.recoverLoadTasks(etcdCli, false)
@@ -252,6 +272,12 @@ func (s *Scheduler) Start(pCtx context.Context, etcdCli *clientv3.Client) (err e
 		s.observeLoadTask(ctx, etcdCli, rev1)
 	}(loadTaskRev)
 
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.checkOrphanSubTasks(ctx)
+	}()
+
 	s.started = true // started now
 	s.cancel = cancel
 	s.logger.Info("the scheduler has started")
@@ -427,6 +453,7 @@ func (s *Scheduler) RemoveSourceCfg(source string) error {
 	// 5. delete the config and expectant stage in the scheduler
 	delete(s.sourceCfgs, source)
 	delete(s.expectRelayStages, source)
+	delete(s.disabledSources, source)
 
 	// 6. unbound for the source.
 	s.updateStatusForUnbound(source)
@@ -771,6 +798,9 @@ func (s *Scheduler) AddSubTasks(latched bool, cfgs ...config.SubTaskConfig) erro
 		if _, ok := existSourcesM[cfg.SourceID]; ok {
 			continue
 		}
+		if _, ok := s.disabledSources[cfg.SourceID]; ok {
+			return terror.ErrSchedulerSourceDisabled.Generate(cfg.SourceID)
+		}
 		newCfgs = append(newCfgs, cfg)
 		newStages = append(newStages, ha.NewSubTaskStage(pb.Stage_Running, cfg.SourceID, cfg.Name))
 		if _, ok := s.bounds[cfg.SourceID]; !ok {
@@ -804,6 +834,59 @@ func (s *Scheduler) AddSubTasks(latched bool, cfgs ...config.SubTaskConfig) erro
 	return nil
 }
 
+// UpdateSubTaskCfgs updates the configs of one or more existing subtasks for one task in one etcd txn,
+// the expectant stages are not changed.
+// setting `latched` to true means caller has acquired latch.
+func (s *Scheduler) UpdateSubTaskCfgs(latched bool, cfgs ...config.SubTaskConfig) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.started {
+		return terror.ErrSchedulerNotStarted.Generate()
+	}
+	if len(cfgs) == 0 {
+		return nil
+	}
+
+	taskNamesM := make(map[string]struct{}, 1)
+	for _, cfg := range cfgs {
+		taskNamesM[cfg.Name] = struct{}{}
+	}
+	taskNames := strMapToSlice(taskNamesM)
+	if len(taskNames) > 1 {
+		// only subtasks from one task supported now.
+		return terror.ErrSchedulerMultiTask.Generate(taskNames)
+	}
+	task := taskNames[0]
+
+	if !latched {
+		release, err := s.subtaskLatch.tryAcquire(task)
+		if err != nil {
+			return terror.ErrSchedulerLatchInUse.Generate("UpdateSubTaskCfgs", task)
+		}
+		defer release()
+	}
+
+	v, ok := s.subTaskCfgs.Load(task)
+	if !ok {
+		return terror.ErrSchedulerSubTaskOpTaskNotExist.Generate(task)
+	}
+	cfgM := v.(map[string]config.SubTaskConfig)
+	for _, cfg := range cfgs {
+		if _, ok = cfgM[cfg.SourceID]; !ok {
+			return terror.ErrSchedulerSubTaskOpSourceNotExist.Generate([]string{cfg.SourceID})
+		}
+	}
+
+	if _, err := ha.PutSubTaskCfgStage(s.etcdCli, cfgs, []ha.Stage{}); err != nil {
+		return err
+	}
+	for _, cfg := range cfgs {
+		cfgM[cfg.SourceID] = cfg
+	}
+	return nil
+}
+
 // RemoveSubTasks removes the information of one or more subtasks for one task.
 func (s *Scheduler) RemoveSubTasks(task string, sources ...string) error {
 	if !s.started {
@@ -1070,6 +1153,9 @@ func (s *Scheduler) StartRelay(source string, workers []string) error {
 	if _, ok := s.sourceCfgs[source]; !ok {
 		return terror.ErrSchedulerSourceCfgNotExist.Generate(source)
 	}
+	if _, ok := s.disabledSources[source]; ok {
+		return terror.ErrSchedulerSourceDisabled.Generate(source)
+	}
 	startedWorkers := s.relayWorkers[source]
 	if startedWorkers == nil {
 		startedWorkers = map[string]struct{}{}
@@ -1279,6 +1365,9 @@ func (s *Scheduler) UpdateExpectRelayStage(newStage pb.Stage, sources ...string)
 			notExistSourcesM[source] = struct{}{}
 			continue
 		}
+		if _, ok := s.disabledSources[source]; ok && newStage == pb.Stage_Running {
+			return terror.ErrSchedulerSourceDisabled.Generate(source)
+		}
 
 		if currStage, ok := s.expectRelayStages[source]; ok {
 			currStagesM[currStage.Expect.String()] = struct{}{}
@@ -1333,6 +1422,16 @@ func (s *Scheduler) GetExpectRelayStage(source string) ha.Stage {
 // because some user may want to update `{Running, Paused, ...}` to `{Running, Running, ...}`.
 // so, this should be also supported in DM-worker.
 func (s *Scheduler) UpdateExpectSubTaskStage(newStage pb.Stage, task string, sources ...string) error {
+	return s.updateExpectSubTaskStage(newStage, false, task, sources...)
+}
+
+// ResumeSubTaskWithResetErrorBudget updates the expect subtask stage to `Running`,
+// and let DM-worker reset the error budget of the subtask before resuming it.
+func (s *Scheduler) ResumeSubTaskWithResetErrorBudget(task string, sources ...string) error {
+	return s.updateExpectSubTaskStage(pb.Stage_Running, true, task, sources...)
+}
+
+func (s *Scheduler) updateExpectSubTaskStage(newStage pb.Stage, resetErrorBudget bool, task string, sources ...string) error {
 	if !s.started {
 		return terror.ErrSchedulerNotStarted.Generate()
 	}
@@ -1354,6 +1453,12 @@ func (s *Scheduler) UpdateExpectSubTaskStage(newStage pb.Stage, task string, sou
 	}
 	defer release()
 
+	if newStage == pb.Stage_Running {
+		if err = s.checkSourcesNotDisabled(sources...); err != nil {
+			return err
+		}
+	}
+
 	// 2. check the task exists.
 	v, ok := s.expectSubTaskStages.Load(task)
 	if !ok {
@@ -1372,7 +1477,9 @@ func (s *Scheduler) UpdateExpectSubTaskStage(newStage pb.Stage, task string, sou
 		} else {
 			currStagesM[currStage.Expect.String()] = struct{}{}
 		}
-		stages = append(stages, ha.NewSubTaskStage(newStage, source, task))
+		stage := ha.NewSubTaskStage(newStage, source, task)
+		stage.ResetErrorBudget = resetErrorBudget
+		stages = append(stages, stage)
 	}
 	notExistSources := strMapToSlice(notExistSourcesM)
 	currStages := strMapToSlice(currStagesM)
@@ -1392,7 +1499,9 @@ func (s *Scheduler) UpdateExpectSubTaskStage(newStage pb.Stage, task string, sou
 	}
 
 	// 4. update the stages in the scheduler.
+	// the error budget only needs to be reset once, so don't keep it in the scheduler.
 	for _, stage := range stages {
+		stage.ResetErrorBudget = false
 		stagesM[stage.Source] = stage
 	}
 
@@ -1479,6 +1588,7 @@ func (s *Scheduler) recoverSubTasks(cli *clientv3.Client) error {
 		for task, stage := range stageM {
 			v, _ := s.expectSubTaskStages.LoadOrStore(task, map[string]ha.Stage{})
 			m := v.(map[string]ha.Stage)
+			stage.ResetErrorBudget = false
 			m[source] = stage
 		}
 	}
@@ -1898,7 +2008,7 @@ func (s *Scheduler) tryBoundForWorker(w *Worker) (bounded bool, err error) {
 	return true, nil
 }
 
-// tryBoundForSource tries to bound a source to a random Free worker.
+// tryBoundForSource tries to bound a source to a Free worker.
 // returns (true, nil) after bounded.
 // caller should update the s.unbounds.
 // caller should make sure this source has source config.
@@ -1970,16 +2080,14 @@ func (s *Scheduler) tryBoundForSource(source string) (bool, error) {
 		}
 	}
 
-	// and then a random Free worker.
+	// and then a Free worker chosen by the policy.
 	if worker == nil {
-		for _, w := range s.workers {
-			if w.Stage() == WorkerFree {
-				worker = w
-				s.logger.Info("found free worker when source bound",
-					zap.String("worker", w.BaseInfo().Name),
-					zap.String("source", source))
-				break
-			}
+		worker = s.pickFreeWorker(source)
+		if worker != nil {
+			s.logger.Info("found free worker when source bound",
+				zap.String("worker", worker.BaseInfo().Name),
+				zap.String("source", source),
+				zap.String("policy", s.policy))
 		}
 	}
 
@@ -2082,6 +2190,7 @@ func (s *Scheduler) reset() {
 	s.unbounds = make(map[string]struct{})
 	s.expectRelayStages = make(map[string]ha.Stage)
 	s.expectSubTaskStages = sync.Map{}
+	s.disabledSources = make(map[string]ha.DisabledSource)
 	s.loadTasks = make(map[string]map[string]string)
 }
 
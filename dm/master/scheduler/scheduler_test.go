@@ -277,6 +277,35 @@ func (t *testScheduler) testSchedulerProgress(c *C, restart int) {
 	t.subTaskStageMatch(c, s, taskName1, sourceID1, pb.Stage_Paused)
 	c.Assert(s.UpdateExpectSubTaskStage(pb.Stage_Running, taskName1, sourceID1), IsNil)
 	t.subTaskStageMatch(c, s, taskName1, sourceID1, pb.Stage_Running)
+	// pause/resume task1 with resetting the error budget, the flag is only put into etcd.
+	c.Assert(s.UpdateExpectSubTaskStage(pb.Stage_Paused, taskName1, sourceID1), IsNil)
+	c.Assert(s.ResumeSubTaskWithResetErrorBudget(taskName1, sourceID1), IsNil)
+	c.Assert(s.GetExpectSubTaskStage(taskName1, sourceID1).ResetErrorBudget, IsFalse)
+	eStageM, _, err := ha.GetSubTaskStage(etcdTestCli, sourceID1, taskName1)
+	c.Assert(err, IsNil)
+	c.Assert(eStageM[taskName1].Expect, Equals, pb.Stage_Running)
+	c.Assert(eStageM[taskName1].ResetErrorBudget, IsTrue)
+	c.Assert(s.UpdateExpectSubTaskStage(pb.Stage_Running, taskName1, sourceID1), IsNil)
+	t.subTaskStageMatch(c, s, taskName1, sourceID1, pb.Stage_Running)
+	// disable the source, the relay and task1 are paused and can't be resumed.
+	c.Assert(terror.ErrSchedulerSourceNotDisabled.Equal(s.EnableSource(sourceID1)), IsTrue)
+	c.Assert(terror.ErrSchedulerSourceCfgNotExist.Equal(s.DisableSource(sourceID2)), IsTrue)
+	c.Assert(s.DisableSource(sourceID1), IsNil)
+	c.Assert(s.IsSourceDisabled(sourceID1), IsTrue)
+	c.Assert(terror.ErrSchedulerSourceDisabled.Equal(s.DisableSource(sourceID1)), IsTrue)
+	t.relayStageMatch(c, s, sourceID1, pb.Stage_Paused)
+	t.subTaskStageMatch(c, s, taskName1, sourceID1, pb.Stage_Paused)
+	c.Assert(terror.ErrSchedulerSourceDisabled.Equal(s.UpdateExpectRelayStage(pb.Stage_Running, sourceID1)), IsTrue)
+	c.Assert(terror.ErrSchedulerSourceDisabled.Equal(s.UpdateExpectSubTaskStage(pb.Stage_Running, taskName1, sourceID1)), IsTrue)
+	rebuildScheduler(ctx)
+	c.Assert(s.IsSourceDisabled(sourceID1), IsTrue)
+	// enable the source, the relay and task1 are resumed.
+	c.Assert(s.EnableSource(sourceID1), IsNil)
+	c.Assert(s.IsSourceDisabled(sourceID1), IsFalse)
+	t.relayStageMatch(c, s, sourceID1, pb.Stage_Running)
+	t.subTaskStageMatch(c, s, taskName1, sourceID1, pb.Stage_Running)
+	rebuildScheduler(ctx)
+	c.Assert(s.IsSourceDisabled(sourceID1), IsFalse)
 	// update subtask stage without source or task take no effect now (and return without error).
 	c.Assert(s.UpdateExpectSubTaskStage(pb.Stage_Paused, "", sourceID1), IsNil)
 	c.Assert(s.UpdateExpectSubTaskStage(pb.Stage_Paused, taskName1), IsNil)
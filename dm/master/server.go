@@ -15,6 +15,7 @@ package master
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -35,6 +36,7 @@ import (
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/pingcap/dm/checker"
 	dmcommon "github.com/pingcap/dm/dm/common"
@@ -51,8 +53,10 @@ import (
 	"github.com/pingcap/dm/pkg/cputil"
 	"github.com/pingcap/dm/pkg/election"
 	"github.com/pingcap/dm/pkg/etcdutil"
+	"github.com/pingcap/dm/pkg/ha"
 	"github.com/pingcap/dm/pkg/log"
 	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/upgrade"
 	"github.com/pingcap/dm/pkg/utils"
 )
 
@@ -112,6 +116,14 @@ type Server struct {
 
 	// agent pool
 	ap *AgentPool
+	// rate limiter of the requests from clients
+	clientLimiter *clientLimiter
+	// cache of the cluster states to serve the read-only requests as a follower
+	followerCache *followerCache
+	// serializes the operations on the task locks
+	taskLockMu sync.Mutex
+	// serializes the operations on the cluster upgrade window
+	clusterUpgradeMu sync.Mutex
 
 	// WaitGroup for background functions.
 	bgFunWg sync.WaitGroup
@@ -128,9 +140,15 @@ func NewServer(cfg *Config) *Server {
 		cfg:       cfg,
 		scheduler: scheduler.NewScheduler(&logger, cfg.Security),
 		ap:        NewAgentPool(&RateLimitConfig{rate: cfg.RPCRateLimit, burst: cfg.RPCRateBurst}),
+
+		clientLimiter: newClientLimiter(cfg.ClientRateLimits, cfg.RateLimitedRPCs),
+		followerCache: newFollowerCache(),
 	}
 	server.pessimist = shardddl.NewPessimist(&logger, server.getTaskResources)
 	server.optimist = shardddl.NewOptimist(&logger)
+	if cfg.Policy != "" {
+		server.scheduler.SetPolicy(cfg.Policy)
+	}
 	server.closed.Store(true)
 	setUseTLS(&cfg.Security)
 
@@ -196,7 +214,11 @@ func (s *Server) Start(ctx context.Context) (err error) {
 	}
 
 	// gRPC API server
-	gRPCSvr := func(gs *grpc.Server) { pb.RegisterMasterServer(gs, s) }
+	// NOTE: the embed etcd has registered the gRPC health service (grpc.health.v1), so we only register reflection here.
+	gRPCSvr := func(gs *grpc.Server) {
+		pb.RegisterMasterServer(gs, auditedServer{s})
+		reflection.Register(gs)
+	}
 
 	// start embed etcd server, gRPC API server and HTTP (API, status and debug) server.
 	s.etcd, err = startEtcd(etcdCfg, gRPCSvr, userHandles, etcdStartTimeout)
@@ -232,6 +254,30 @@ func (s *Server) Start(ctx context.Context) (err error) {
 		s.electionNotify(ctx)
 	}()
 
+	if s.cfg.FollowerReadMaxStaleness > 0 {
+		s.bgFunWg.Add(1)
+		go func() {
+			defer s.bgFunWg.Done()
+			s.followerReadLoop(ctx)
+		}()
+	}
+
+	if s.cfg.ProgressHistoryInterval > 0 {
+		s.bgFunWg.Add(1)
+		go func() {
+			defer s.bgFunWg.Done()
+			s.progressHistoryLoop(ctx)
+		}()
+	}
+
+	if s.cfg.SourceDiscovery != nil {
+		s.bgFunWg.Add(1)
+		go func() {
+			defer s.bgFunWg.Done()
+			s.sourceDiscoveryLoop(ctx)
+		}()
+	}
+
 	runBackgroundOnce.Do(func() {
 		s.bgFunWg.Add(1)
 		go func() {
@@ -423,6 +469,10 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 	}
 
 	resp := &pb.StartTaskResponse{}
+	if err := s.checkClusterUpgrade(); err != nil {
+		resp.Msg = err.Error()
+		return resp, nil
+	}
 	cfg, stCfgs, err := s.generateSubTask(ctx, req.Task, ctlcommon.DefaultErrorCnt, ctlcommon.DefaultWarnCnt)
 	if err != nil {
 		resp.Msg = err.Error()
@@ -430,6 +480,20 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 		return resp, nil
 	}
 	log.L().Info("", zap.String("task name", cfg.Name), zap.String("task", cfg.JSON()), zap.String("request", "StartTask"))
+	if req.StartTime != "" {
+		if err = adjustStartTime(stCfgs, req.StartTime); err != nil {
+			resp.Msg = err.Error()
+			// nolint:nilerr
+			return resp, nil
+		}
+	}
+	if req.DryRun {
+		if err = adjustDryRun(stCfgs); err != nil {
+			resp.Msg = err.Error()
+			// nolint:nilerr
+			return resp, nil
+		}
+	}
 
 	sourceRespCh := make(chan *pb.CommonWorkerResponse, len(stCfgs))
 	if len(req.Sources) > 0 {
@@ -482,11 +546,30 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 				return resp, nil
 			}
 			err = s.removeMetaData(ctx, cfg.Name, cfg.MetaSchema, cfg.TargetDB)
+			// the syncers of the replica targets keep their meta data in the replica targets.
+			for _, target := range cfg.Targets {
+				if err != nil {
+					break
+				}
+				err = removeDownstreamMetaData(ctx, cfg.Name, cfg.MetaSchema, target)
+			}
 			if err != nil {
 				resp.Msg = terror.Annotate(err, "while removing metadata").Error()
 				return resp, nil
 			}
 		}
+
+		var overlapMsg string
+		if conflicts := checkTaskOverlap(stCfgs, s.scheduler.GetSubTaskCfgs()); len(conflicts) > 0 {
+			overlapErr := terror.ErrMasterTaskOverlap.Generate(cfg.Name, strings.Join(conflicts, "; "))
+			if !req.AllowOverlap {
+				resp.Msg = overlapErr.Error()
+				return resp, nil
+			}
+			log.L().Warn("start task which writes to the same downstream tables as other tasks", zap.String("task", cfg.Name), zap.Strings("conflicts", conflicts))
+			overlapMsg = overlapErr.Error()
+		}
+
 		err = s.scheduler.AddSubTasks(latched, subtaskCfgPointersToInstances(stCfgs...)...)
 		if err != nil {
 			resp.Msg = err.Error()
@@ -502,6 +585,12 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 		if cfg.RemoveMeta {
 			resp.Msg = "`remove-meta` in task config is deprecated, please use `start-task ... --remove-meta` instead"
 		}
+		if overlapMsg != "" {
+			if resp.Msg != "" {
+				resp.Msg += "\n"
+			}
+			resp.Msg += overlapMsg
+		}
 		sourceResps = s.getSourceRespsAfterOperation(ctx, cfg.Name, sources, []string{}, req)
 	}
 
@@ -545,10 +634,23 @@ func (s *Server) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*
 		resp.Msg = terror.ErrMasterInvalidOperateOp.Generate(req.Op.String(), "task").Error()
 		return resp, nil
 	}
+	if err := s.checkClusterUpgrade(); err != nil {
+		resp.Msg = err.Error()
+		return resp, nil
+	}
+	if !req.Force {
+		if err := s.checkTaskLock(req.Name); err != nil {
+			resp.Msg = err.Error()
+			return resp, nil
+		}
+	}
 	var err error
-	if expect == pb.Stage_Stopped {
+	switch {
+	case expect == pb.Stage_Stopped:
 		err = s.scheduler.RemoveSubTasks(req.Name, sources...)
-	} else {
+	case expect == pb.Stage_Running && req.ResetErrorBudget:
+		err = s.scheduler.ResumeSubTaskWithResetErrorBudget(req.Name, sources...)
+	default:
 		err = s.scheduler.UpdateExpectSubTaskStage(expect, req.Name, sources...)
 	}
 	if err != nil {
@@ -557,6 +659,13 @@ func (s *Server) OperateTask(ctx context.Context, req *pb.OperateTaskRequest) (*
 		return resp, nil
 	}
 
+	if expect == pb.Stage_Stopped && len(req.Sources) == 0 {
+		// the lock of the stopped task is meaningless.
+		if _, err = ha.DeleteTaskLock(s.etcdClient, req.Name); err != nil {
+			log.L().Warn("fail to delete the lock of the stopped task", zap.String("task", req.Name), log.ShortError(err))
+		}
+	}
+
 	resp.Result = true
 	resp.Sources = s.getSourceRespsAfterOperation(ctx, req.Name, sources, []string{}, req)
 	return resp, nil
@@ -613,6 +722,12 @@ func (s *Server) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb
 		return resp2, err2
 	}
 
+	if err := s.checkClusterUpgrade(); err != nil {
+		return &pb.UpdateTaskResponse{
+			Result: false,
+			Msg:    err.Error(),
+		}, nil
+	}
 	cfg, stCfgs, err := s.generateSubTask(ctx, req.Task, ctlcommon.DefaultErrorCnt, ctlcommon.DefaultWarnCnt)
 	if err != nil {
 		// nolint:nilerr
@@ -623,7 +738,8 @@ func (s *Server) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb
 	}
 	log.L().Info("update task", zap.String("task name", cfg.Name), zap.Stringer("task", cfg))
 
-	workerRespCh := make(chan *pb.CommonWorkerResponse, len(stCfgs)+len(req.Sources))
+	resp := &pb.UpdateTaskResponse{}
+	var unknownSources []*pb.CommonWorkerResponse
 	if len(req.Sources) > 0 {
 		// specify only update task on partial sources
 		// filter sub-task-configs through user specified sources
@@ -637,32 +753,122 @@ func (s *Server) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb
 			if sourceCfg, ok := subtaskCfgs[source]; ok {
 				stCfgs = append(stCfgs, sourceCfg)
 			} else {
-				workerRespCh <- errorCommonWorkerResponse("source not found in task's config", source, "")
+				unknownSources = append(unknownSources, errorCommonWorkerResponse("source not found in task's config", source, ""))
 			}
 		}
 	}
+	if len(unknownSources) > 0 {
+		resp.Msg = "some sources are not found in task's config, nothing is updated"
+		resp.Sources = unknownSources
+		return resp, nil
+	}
 
-	// TODO: update task config
-	// s.scheduler.UpdateTaskCfg(*cfg)
+	release, err := s.scheduler.AcquireSubtaskLatch(cfg.Name)
+	if err != nil {
+		resp.Msg = terror.ErrSchedulerLatchInUse.Generate("UpdateTask", cfg.Name).Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	defer release()
 
-	workerRespMap := make(map[string]*pb.CommonWorkerResponse, len(stCfgs))
-	workers := make([]string, 0, len(stCfgs))
-	for len(workerRespCh) > 0 {
-		workerResp := <-workerRespCh
-		workerRespMap[workerResp.Source] = workerResp
-		workers = append(workers, workerResp.Source)
+	sort.Slice(stCfgs, func(i, j int) bool { return stCfgs[i].SourceID < stCfgs[j].SourceID })
+	oldCfgs := s.scheduler.GetSubTaskCfgsByTask(cfg.Name)
+	newCfgs := make([]config.SubTaskConfig, 0, len(stCfgs))
+	rollbackCfgs := make([]config.SubTaskConfig, 0, len(stCfgs))
+	for _, stCfg := range stCfgs {
+		oldCfg, ok := oldCfgs[stCfg.SourceID]
+		if !ok {
+			resp.Sources = append(resp.Sources, errorCommonWorkerResponse("subtask not found, please use `start-task` to add it", stCfg.SourceID, ""))
+			continue
+		}
+		newCfgs = append(newCfgs, *stCfg)
+		rollbackCfgs = append(rollbackCfgs, *oldCfg)
+	}
+	if len(resp.Sources) > 0 {
+		resp.Msg = "some subtasks are not found, nothing is updated"
+		return resp, nil
 	}
 
-	sort.Strings(workers)
-	workerResps := make([]*pb.CommonWorkerResponse, 0, len(workers))
-	for _, worker := range workers {
-		workerResps = append(workerResps, workerRespMap[worker])
+	// 1. check the new config on all dm-workers before applying it, nothing is changed if any of them fails.
+	resp.Sources = s.updateSubTasksOnWorkers(ctx, newCfgs, true)
+	if !allCommonWorkerResponsesOK(resp.Sources) {
+		resp.Msg = "fail to check the new config on some sources, nothing is updated"
+		return resp, nil
 	}
 
-	return &pb.UpdateTaskResponse{
-		Result:  true,
-		Sources: workerResps,
-	}, nil
+	// 2. apply the new config on all dm-workers and then switch the config in etcd, the subtasks are rolled back
+	// to the previous config if any of them fails.
+	resp.Sources = s.updateSubTasksOnWorkers(ctx, newCfgs, false)
+	if allCommonWorkerResponsesOK(resp.Sources) {
+		if err = s.scheduler.UpdateSubTaskCfgs(true, newCfgs...); err == nil {
+			resp.Result = true
+			return resp, nil
+		}
+		resp.Msg = fmt.Sprintf("fail to save the new config: %s, ", err.Error())
+	} else {
+		resp.Msg = "fail to apply the new config on some sources, "
+	}
+	rollbackResps := s.updateSubTasksOnWorkers(ctx, rollbackCfgs, false)
+	if allCommonWorkerResponsesOK(rollbackResps) {
+		resp.Msg += "all subtasks are rolled back to the previous config"
+	} else {
+		resp.Msg += "and fail to roll back some subtasks to the previous config, please stop the task and start it again"
+	}
+	for i, rollbackResp := range rollbackResps {
+		if !rollbackResp.Result {
+			resp.Sources[i].Result = false
+			resp.Sources[i].Msg = strings.TrimSpace(resp.Sources[i].Msg + " rollback failed: " + rollbackResp.Msg)
+		}
+	}
+	return resp, nil
+}
+
+// updateSubTasksOnWorkers sends the subtask configs to the bound dm-workers to check (dry run) or apply them,
+// the responses are in the same order as the configs.
+func (s *Server) updateSubTasksOnWorkers(ctx context.Context, cfgs []config.SubTaskConfig, dryRun bool) []*pb.CommonWorkerResponse {
+	resps := make([]*pb.CommonWorkerResponse, len(cfgs))
+	var wg sync.WaitGroup
+	for i := range cfgs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			source := cfgs[i].SourceID
+			worker := s.scheduler.GetWorkerBySource(source)
+			if worker == nil {
+				resps[i] = errorCommonWorkerResponse(fmt.Sprintf("source %s relevant worker-client not found", source), source, "")
+				return
+			}
+			task, err := cfgs[i].Toml()
+			if err != nil {
+				resps[i] = errorCommonWorkerResponse(err.Error(), source, worker.BaseInfo().Name)
+				return
+			}
+			workerReq := workerrpc.Request{
+				Type:          workerrpc.CmdUpdateSubTask,
+				UpdateSubTask: &pb.UpdateSubTaskRequest{Task: task, DryRun: dryRun},
+			}
+			workerResp, err := worker.SendRequest(ctx, &workerReq, s.cfg.RPCTimeout)
+			if err != nil {
+				resps[i] = errorCommonWorkerResponse(err.Error(), source, worker.BaseInfo().Name)
+				return
+			}
+			resps[i] = workerResp.UpdateSubTask
+			resps[i].Source = source
+			resps[i].Worker = worker.BaseInfo().Name
+		}(i)
+	}
+	wg.Wait()
+	return resps
+}
+
+// allCommonWorkerResponsesOK checks whether all the dm-workers succeed.
+func allCommonWorkerResponsesOK(resps []*pb.CommonWorkerResponse) bool {
+	for _, resp := range resps {
+		if !resp.Result {
+			return false
+		}
+	}
+	return true
 }
 
 type hasWokers interface {
@@ -710,6 +916,22 @@ func (s *Server) QueryStatus(ctx context.Context, req *pb.QueryStatusListRequest
 		return resp2, err2
 	}
 
+	var since time.Duration
+	if req.Since != "" {
+		var err error
+		since, err = time.ParseDuration(req.Since)
+		if err == nil && since <= 0 {
+			err = errors.New("should be positive")
+		}
+		if err != nil {
+			// nolint:nilerr
+			return &pb.QueryStatusListResponse{
+				Result: false,
+				Msg:    fmt.Sprintf("invalid since %s: %s", req.Since, err.Error()),
+			}, nil
+		}
+	}
+
 	sources, err := extractSources(s, req)
 	if err != nil {
 		// nolint:nilerr
@@ -739,6 +961,14 @@ func (s *Server) QueryStatus(ctx context.Context, req *pb.QueryStatusListRequest
 	resp := &pb.QueryStatusListResponse{
 		Result:  true,
 		Sources: workerResps,
+		Locks:   s.getTaskLocksForStatus(req.Name),
+	}
+	if since > 0 {
+		resp.ProgressHistory, err = s.progressHistory(req.Name, req.Sources, time.Now().Add(-since), workerResps)
+		if err != nil {
+			resp.Result = false
+			resp.Msg = err.Error()
+		}
 	}
 	return resp, nil
 }
@@ -858,6 +1088,7 @@ func (s *Server) PurgeWorkerRelay(ctx context.Context, req *pb.PurgeWorkerRelayR
 			Time:     req.Time,
 			Filename: req.Filename,
 			SubDir:   req.SubDir,
+			DryRun:   req.DryRun,
 		},
 	}
 
@@ -1300,6 +1531,43 @@ func (s *Server) OperateSource(ctx context.Context, req *pb.OperateSourceRequest
 				boundM[id] = s.scheduler.GetWorkerBySource(id)
 			}
 		}
+	case pb.SourceOp_DisableSource, pb.SourceOp_EnableSource:
+		sources := make([]string, 0, len(cfgs)+len(req.SourceID))
+		sources = append(sources, req.SourceID...)
+		for _, cfg := range cfgs {
+			sources = append(sources, cfg.SourceID)
+		}
+		if len(sources) == 0 {
+			resp.Msg = "must specify at least one source"
+			return resp, nil
+		}
+		for _, sid := range sources {
+			var (
+				err3 error
+				msg  string
+			)
+			if req.Op == pb.SourceOp_DisableSource {
+				err3 = s.scheduler.DisableSource(sid)
+				msg = "source is disabled, its relay and subtasks are paused"
+			} else {
+				err3 = s.scheduler.EnableSource(sid)
+				msg = "source is enabled, its relay and subtasks paused by disabling are resumed"
+			}
+			if err3 != nil {
+				resp.Sources = append(resp.Sources, errorCommonWorkerResponse(err3.Error(), sid, ""))
+				continue
+			}
+			resp.Sources = append(resp.Sources, &pb.CommonWorkerResponse{
+				Result: true,
+				Msg:    msg,
+				Source: sid,
+			})
+		}
+		resp.Result = true
+		for _, sourceResp := range resp.Sources {
+			resp.Result = resp.Result && sourceResp.Result
+		}
+		return resp, nil
 	default:
 		resp.Msg = terror.ErrMasterInvalidOperateOp.Generate(req.Op.String(), "source").Error()
 		return resp, nil
@@ -1335,6 +1603,13 @@ func (s *Server) OperateSource(ctx context.Context, req *pb.OperateSourceRequest
 	if len(sourceToCheck) > 0 {
 		resp.Sources = append(resp.Sources, s.getSourceRespsAfterOperation(ctx, "", sourceToCheck, workerToCheck, req)...)
 	}
+	if req.Op == pb.SourceOp_ShowSource {
+		for _, sourceResp := range resp.Sources {
+			if s.scheduler.IsSourceDisabled(sourceResp.Source) && sourceResp.Msg == "" {
+				sourceResp.Msg = "source is disabled"
+			}
+		}
+	}
 	return resp, nil
 }
 
@@ -1371,6 +1646,11 @@ func (s *Server) generateSubTask(ctx context.Context, task string, errCnt, warnC
 	if err != nil {
 		return nil, nil, terror.WithClass(err, terror.ClassDMMaster)
 	}
+	for _, target := range cfg.Targets {
+		if err = adjustTargetDB(ctx, target); err != nil {
+			return nil, nil, terror.WithClass(err, terror.ClassDMMaster)
+		}
+	}
 
 	sourceCfgs := s.getSourceConfigs(cfg.MySQLInstances)
 
@@ -1387,6 +1667,33 @@ func (s *Server) generateSubTask(ctx context.Context, task string, errCnt, warnC
 	return cfg, stCfgs, nil
 }
 
+// adjustStartTime sets the start time of `start-task --start-time` for the subtasks. the start time is converted
+// to RFC3339 format in the time zone of DM-master, so DM-workers in other time zones get the same time.
+func adjustStartTime(stCfgs []*config.SubTaskConfig, startTime string) error {
+	t, err := config.ParseStartTime(startTime)
+	if err != nil {
+		return err
+	}
+	for _, stCfg := range stCfgs {
+		if stCfg.Mode != config.ModeIncrement {
+			return terror.ErrConfigInvalidStartTime.Generate(startTime, fmt.Sprintf("task-mode %s is not supported", stCfg.Mode))
+		}
+		stCfg.StartTime = t.Format(time.RFC3339)
+	}
+	return nil
+}
+
+// adjustDryRun marks the subtasks of `start-task --dry-run`.
+func adjustDryRun(stCfgs []*config.SubTaskConfig) error {
+	for _, stCfg := range stCfgs {
+		stCfg.DryRun = true
+		if err := stCfg.VerifyDryRun(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func setUseTLS(tlsCfg *config.Security) {
 	if enableTLS(tlsCfg) {
 		useTLS.Store(true)
@@ -1421,7 +1728,6 @@ func withHost(addr string) string {
 }
 
 func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string, toDBCfg *config.DBConfig) error {
-	toDBCfg.Adjust()
 	// clear shard meta data for pessimistic/optimist
 	err := s.pessimist.RemoveMetaData(taskName)
 	if err != nil {
@@ -1436,6 +1742,12 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 		return err
 	}
 
+	return removeDownstreamMetaData(ctx, taskName, metaSchema, toDBCfg)
+}
+
+// removeDownstreamMetaData drops the meta tables of the task in the downstream database.
+func removeDownstreamMetaData(ctx context.Context, taskName, metaSchema string, toDBCfg *config.DBConfig) error {
+	toDBCfg.Adjust()
 	// set up db and clear meta data in downstream db
 	baseDB, err := conn.DefaultDBProvider.Apply(*toDBCfg)
 	if err != nil {
@@ -1455,7 +1767,7 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 
 	ctctx := tcontext.NewContext(ctx, log.With(zap.String("job", "remove metadata")))
 
-	sqls := make([]string, 0, 4)
+	sqls := make([]string, 0, 8)
 	// clear loader and syncer checkpoints
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.LoaderCheckpoint(taskName))))
@@ -1465,6 +1777,15 @@ func (s *Server) removeMetaData(ctx context.Context, taskName, metaSchema string
 		dbutil.TableName(metaSchema, cputil.SyncerShardMeta(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.SyncerOnlineDDL(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerAppliedGTID(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerSchemaSnapshot(taskName))))
+	// clear the side table of oversized rows and the audit table of apply summaries
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerOversizedRow(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.SyncerApplySummary(taskName))))
 
 	_, err = dbConn.ExecuteSQL(ctctx, nil, taskName, sqls)
 	if err == nil {
@@ -2000,7 +2321,7 @@ func (s *Server) createMasterClientByName(ctx context.Context, name string) (pb.
 	var conn *grpc.ClientConn
 	for _, clientURL := range clientURLs {
 		//nolint:staticcheck
-		conn, err = grpc.Dial(clientURL, tls.ToGRPCDialOption(), grpc.WithBackoffMaxDelay(3*time.Second))
+		conn, err = grpc.Dial(clientURL, tls.ToGRPCDialOption(), grpc.WithBackoffMaxDelay(3*time.Second), grpc.WithUserAgent(userAgentDMMaster))
 		if err == nil {
 			masterClient := pb.NewMasterClient(conn)
 			return masterClient, conn, nil
@@ -2032,6 +2353,9 @@ func (s *Server) GetCfg(ctx context.Context, req *pb.GetCfgRequest) (*pb.GetCfgR
 	if shouldRet {
 		return resp2, err2
 	}
+	if req.All {
+		return s.getAllCfgs(ctx), nil
+	}
 	// For the get-config command, you want to filter out fields that are not easily readable by humans,
 	// such as SSLXXBytes field in `Security` struct
 	switch req.Type {
@@ -2045,59 +2369,28 @@ func (s *Server) GetCfg(ctx context.Context, req *pb.GetCfgRequest) (*pb.GetCfgR
 		for _, subCfg := range subCfgMap {
 			subCfgList = append(subCfgList, subCfg)
 		}
-		sort.Slice(subCfgList, func(i, j int) bool {
-			return subCfgList[i].SourceID < subCfgList[j].SourceID
-		})
-
-		taskCfg := config.SubTaskConfigsToTaskConfig(subCfgList...)
-		taskCfg.TargetDB.Password = "******"
-		if taskCfg.TargetDB.Security != nil {
-			taskCfg.TargetDB.Security.ClearSSLBytesData()
-		}
-		cfg = taskCfg.String()
+		cfg = taskCfgString(subCfgList)
 	case pb.CfgType_MasterType:
-		if req.Name == s.cfg.Name {
-			cfg, err2 = s.cfg.Toml()
-			if err2 != nil {
-				resp2.Msg = err2.Error()
-			} else {
-				resp2.Result = true
-				resp2.Cfg = cfg
-			}
-			return resp2, nil
-		}
-
-		masterClient, grpcConn, err := s.createMasterClientByName(ctx, req.Name)
+		var err error
+		cfg, err = s.getMasterCfg(ctx, req.Name)
 		if err != nil {
 			resp2.Msg = err.Error()
 			// nolint:nilerr
 			return resp2, nil
 		}
-		defer grpcConn.Close()
-		masterResp, err := masterClient.GetMasterCfg(ctx, &pb.GetMasterCfgRequest{})
-		if err != nil {
-			resp2.Msg = err.Error()
-			// nolint:nilerr
-			return resp2, nil
-		}
-		cfg = masterResp.Cfg
 	case pb.CfgType_WorkerType:
 		worker := s.scheduler.GetWorkerByName(req.Name)
 		if worker == nil {
 			resp2.Msg = "worker not found"
 			return resp2, nil
 		}
-		workerReq := workerrpc.Request{
-			Type:         workerrpc.CmdGetWorkerCfg,
-			GetWorkerCfg: &pb.GetWorkerCfgRequest{},
-		}
-		workerResp, err := worker.SendRequest(ctx, &workerReq, s.cfg.RPCTimeout)
+		var err error
+		cfg, err = s.getWorkerCfg(ctx, worker)
 		if err != nil {
 			resp2.Msg = err.Error()
 			// nolint:nilerr
 			return resp2, nil
 		}
-		cfg = workerResp.GetWorkerCfg.Cfg
 	case pb.CfgType_SourceType:
 		sourceCfg := s.scheduler.GetSourceCfgByID(req.Name)
 		if sourceCfg == nil {
@@ -2105,11 +2398,7 @@ func (s *Server) GetCfg(ctx context.Context, req *pb.GetCfgRequest) (*pb.GetCfgR
 
 			return resp2, nil
 		}
-		sourceCfg.From.Password = "******"
-		if sourceCfg.From.Security != nil {
-			sourceCfg.From.Security.ClearSSLBytesData()
-		}
-		cfg, err2 = sourceCfg.Yaml()
+		cfg, err2 = sourceCfgString(sourceCfg)
 		if err2 != nil {
 			resp2.Msg = err2.Error()
 			// nolint:nilerr
@@ -2126,6 +2415,173 @@ func (s *Server) GetCfg(ctx context.Context, req *pb.GetCfgRequest) (*pb.GetCfgR
 	}, nil
 }
 
+// getAllCfgs gets all the task, source, master and worker configs. the task and source configs are read from etcd at
+// the same revision along with the revisions of their keys, so they're a snapshot of the configs observed by the
+// cluster. a master or worker config which fails to get is returned with the error message in its entry.
+func (s *Server) getAllCfgs(ctx context.Context) *pb.GetCfgResponse {
+	resp := &pb.GetCfgResponse{}
+	sourceCfgs, sourceRevs, rev, err := ha.GetAllSourceCfgWithRevision(s.etcdClient, 0)
+	if err != nil {
+		resp.Msg = err.Error()
+		return resp
+	}
+	subTaskCfgs, subTaskRevs, _, err := ha.GetAllSubTaskCfgWithRevision(s.etcdClient, rev)
+	if err != nil {
+		resp.Msg = err.Error()
+		return resp
+	}
+	resp.Revision = rev
+
+	// task name -> subtask configs and their revisions.
+	var (
+		taskSubCfgs = make(map[string][]*config.SubTaskConfig)
+		taskRevs    = make(map[string][]*pb.CfgRevision)
+	)
+	for source, cfgs := range subTaskCfgs {
+		for task := range cfgs {
+			subCfg := cfgs[task]
+			taskSubCfgs[task] = append(taskSubCfgs[task], &subCfg)
+			taskRevs[task] = append(taskRevs[task], cfgRevisionToPB(subTaskRevs[source][task]))
+		}
+	}
+	tasks := make([]string, 0, len(taskSubCfgs))
+	for task := range taskSubCfgs {
+		tasks = append(tasks, task)
+	}
+	sort.Strings(tasks)
+	for _, task := range tasks {
+		revs := taskRevs[task]
+		sort.Slice(revs, func(i, j int) bool {
+			return revs[i].Key < revs[j].Key
+		})
+		resp.Cfgs = append(resp.Cfgs, &pb.CfgEntry{
+			Type:      pb.CfgType_TaskType,
+			Name:      task,
+			Cfg:       taskCfgString(taskSubCfgs[task]),
+			Revisions: revs,
+		})
+	}
+
+	sources := make([]string, 0, len(sourceCfgs))
+	for source := range sourceCfgs {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		entry := &pb.CfgEntry{
+			Type:      pb.CfgType_SourceType,
+			Name:      source,
+			Revisions: []*pb.CfgRevision{cfgRevisionToPB(sourceRevs[source])},
+		}
+		if entry.Cfg, err = sourceCfgString(sourceCfgs[source]); err != nil {
+			entry.Msg = err.Error()
+		}
+		resp.Cfgs = append(resp.Cfgs, entry)
+	}
+
+	memberList, err := s.etcdClient.MemberList(ctx)
+	if err != nil {
+		resp.Msg = err.Error()
+		return resp
+	}
+	masters := make([]string, 0, len(memberList.Members))
+	for _, m := range memberList.Members {
+		masters = append(masters, m.Name)
+	}
+	sort.Strings(masters)
+	for _, master := range masters {
+		entry := &pb.CfgEntry{Type: pb.CfgType_MasterType, Name: master}
+		if entry.Cfg, err = s.getMasterCfg(ctx, master); err != nil {
+			entry.Msg = err.Error()
+		}
+		resp.Cfgs = append(resp.Cfgs, entry)
+	}
+
+	workers, err := s.scheduler.GetAllWorkers()
+	if err != nil {
+		resp.Msg = err.Error()
+		return resp
+	}
+	sort.Slice(workers, func(i, j int) bool {
+		return workers[i].BaseInfo().Name < workers[j].BaseInfo().Name
+	})
+	for _, worker := range workers {
+		entry := &pb.CfgEntry{Type: pb.CfgType_WorkerType, Name: worker.BaseInfo().Name}
+		if worker.Stage() == scheduler.WorkerOffline {
+			entry.Msg = "worker is offline"
+		} else if entry.Cfg, err = s.getWorkerCfg(ctx, worker); err != nil {
+			entry.Msg = err.Error()
+		}
+		resp.Cfgs = append(resp.Cfgs, entry)
+	}
+
+	resp.Result = true
+	return resp
+}
+
+// taskCfgString returns the task config merged from the subtask configs, the password and the SSL contents of the
+// downstream are masked.
+func taskCfgString(subCfgs []*config.SubTaskConfig) string {
+	sort.Slice(subCfgs, func(i, j int) bool {
+		return subCfgs[i].SourceID < subCfgs[j].SourceID
+	})
+	taskCfg := config.SubTaskConfigsToTaskConfig(subCfgs...)
+	taskCfg.TargetDB.Password = "******"
+	if taskCfg.TargetDB.Security != nil {
+		taskCfg.TargetDB.Security.ClearSSLBytesData()
+	}
+	return taskCfg.String()
+}
+
+// sourceCfgString returns the source config in YAML, the password and the SSL contents of the upstream are masked.
+func sourceCfgString(sourceCfg *config.SourceConfig) (string, error) {
+	sourceCfg.From.Password = "******"
+	if sourceCfg.From.Security != nil {
+		sourceCfg.From.Security.ClearSSLBytesData()
+	}
+	return sourceCfg.Yaml()
+}
+
+// getMasterCfg gets the config of the DM-master by name, the config of other DM-masters is got by RPC.
+func (s *Server) getMasterCfg(ctx context.Context, name string) (string, error) {
+	if name == s.cfg.Name {
+		return s.cfg.Toml()
+	}
+
+	masterClient, grpcConn, err := s.createMasterClientByName(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	defer grpcConn.Close()
+	masterResp, err := masterClient.GetMasterCfg(ctx, &pb.GetMasterCfgRequest{})
+	if err != nil {
+		return "", err
+	}
+	return masterResp.Cfg, nil
+}
+
+// getWorkerCfg gets the config of the DM-worker by RPC.
+func (s *Server) getWorkerCfg(ctx context.Context, worker *scheduler.Worker) (string, error) {
+	workerReq := workerrpc.Request{
+		Type:         workerrpc.CmdGetWorkerCfg,
+		GetWorkerCfg: &pb.GetWorkerCfgRequest{},
+	}
+	workerResp, err := worker.SendRequest(ctx, &workerReq, s.cfg.RPCTimeout)
+	if err != nil {
+		return "", err
+	}
+	return workerResp.GetWorkerCfg.Cfg, nil
+}
+
+func cfgRevisionToPB(rev ha.KeyRevision) *pb.CfgRevision {
+	return &pb.CfgRevision{
+		Key:            rev.Key,
+		CreateRevision: rev.CreateRevision,
+		ModRevision:    rev.ModRevision,
+		Version:        rev.Version,
+	}
+}
+
 // HandleError implements MasterServer.HandleError.
 func (s *Server) HandleError(ctx context.Context, req *pb.HandleErrorRequest) (*pb.HandleErrorResponse, error) {
 	var (
@@ -2152,10 +2608,11 @@ func (s *Server) HandleError(ctx context.Context, req *pb.HandleErrorRequest) (*
 	workerReq := workerrpc.Request{
 		Type: workerrpc.CmdHandleError,
 		HandleError: &pb.HandleWorkerErrorRequest{
-			Op:        req.Op,
-			Task:      req.Task,
-			BinlogPos: req.BinlogPos,
-			Sqls:      req.Sqls,
+			Op:         req.Op,
+			Task:       req.Task,
+			BinlogPos:  req.BinlogPos,
+			Sqls:       req.Sqls,
+			BinlogGTID: req.BinlogGTID,
 		},
 	}
 
@@ -2210,7 +2667,12 @@ func (s *Server) TransferSource(ctx context.Context, req *pb.TransferSourceReque
 		return resp2, err2
 	}
 
-	err := s.scheduler.TransferSource(req.Source, req.Worker)
+	var err error
+	if req.Handover {
+		resp2.PausedTasks, err = s.handoverSource(ctx, req.Source, req.Worker)
+	} else {
+		err = s.scheduler.TransferSource(req.Source, req.Worker)
+	}
 	if err != nil {
 		resp2.Msg = err.Error()
 		// nolint:nilerr
@@ -2249,6 +2711,41 @@ func (s *Server) OperateRelay(ctx context.Context, req *pb.OperateRelayRequest)
 	return resp2, nil
 }
 
+// RestoreCluster implements MasterServer.RestoreCluster.
+func (s *Server) RestoreCluster(ctx context.Context, req *pb.RestoreClusterRequest) (*pb.RestoreClusterResponse, error) {
+	var (
+		resp2 = &pb.RestoreClusterResponse{}
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	var backup ha.ClusterBackup
+	if err := json.Unmarshal([]byte(req.Backup), &backup); err != nil {
+		resp2.Msg = terror.ErrMasterInvalidClusterBackup.Delegate(err, "can't decode the backup").Error()
+		// nolint:nilerr
+		return resp2, nil
+	}
+	if backup.InternalVersion != upgrade.CurrentVersion.InternalNo {
+		resp2.Msg = terror.ErrMasterClusterBackupVersionMismatch.Generate(
+			backup.InternalVersion, backup.ReleaseVersion, upgrade.CurrentVersion.InternalNo).Error()
+		return resp2, nil
+	}
+	if _, err := ha.RestoreClusterBackup(s.etcdClient, backup, s.cfg.MaxTxnOps, s.cfg.MaxRequestBytes); err != nil {
+		resp2.Msg = err.Error()
+		// nolint:nilerr
+		return resp2, nil
+	}
+	log.L().Info("cluster states restored, resign the leader to reload them", zap.Int64("backup revision", backup.Revision),
+		zap.Int("kv count", len(backup.KVs)))
+	// the leader components load the cluster states only when starting, so they are restarted by the re-election.
+	s.election.Resign()
+	resp2.Result = true
+	return resp2, nil
+}
+
 // sharedLogic does some shared logic for each RPC implementation
 // arguments with `Pointer` suffix should be pointer to that variable its name indicated
 // return `true` means caller should return with variable that `xxPointer` modified.
@@ -2260,6 +2757,26 @@ func (s *Server) sharedLogic(ctx context.Context, req interface{}, respPointer i
 
 	log.L().Info("", zap.Any("payload", req), zap.String("request", methodName))
 
+	if err := s.clientLimiter.allow(ctx, methodName); err != nil {
+		log.L().Warn("request is throttled", zap.String("request", methodName), log.ShortError(err))
+		respType := reflect.ValueOf(respPointer).Elem().Type()
+		reflect.ValueOf(respPointer).Elem().Set(reflect.Zero(respType))
+		*errPointer = err
+		return true
+	}
+
+	if s.canFollowerRead(methodName, req) {
+		resp, err := s.followerRead(ctx, req)
+		if err != nil {
+			respType := reflect.ValueOf(respPointer).Elem().Type()
+			reflect.ValueOf(respPointer).Elem().Set(reflect.Zero(respType))
+		} else {
+			reflect.ValueOf(respPointer).Elem().Set(reflect.ValueOf(resp))
+		}
+		*errPointer = err
+		return true
+	}
+
 	// origin code:
 	//  isLeader, needForward := s.isLeaderAndNeedForward()
 	//	if !isLeader {
@@ -16,6 +16,7 @@ package master
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -43,6 +44,7 @@ import (
 	"go.etcd.io/etcd/clientv3"
 	"go.etcd.io/etcd/integration"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/pingcap/dm/checker"
 	common2 "github.com/pingcap/dm/dm/common"
@@ -61,6 +63,7 @@ import (
 	"github.com/pingcap/dm/pkg/shardddl/optimism"
 	"github.com/pingcap/dm/pkg/shardddl/pessimism"
 	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/upgrade"
 	"github.com/pingcap/dm/pkg/utils"
 )
 
@@ -447,6 +450,7 @@ func (t *testMaster) TestQueryStatus(c *check.C) {
 	defer ctrl.Finish()
 
 	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
 	sources, workers := defaultWorkerSource()
 	var cancels []context.CancelFunc
 
@@ -796,11 +800,43 @@ func (t *testMaster) TestCheckTask(c *check.C) {
 	t.clearSchedulerEnv(c, cancel, &wg)
 }
 
+func (t *testMaster) TestAdjustStartTime(c *check.C) {
+	stCfgs := []*config.SubTaskConfig{
+		{SourceID: "mysql-replica-01", Mode: config.ModeIncrement},
+		{SourceID: "mysql-replica-02", Mode: config.ModeIncrement},
+	}
+	c.Assert(adjustStartTime(stCfgs, "2021-07-01T12:00:00+08:00"), check.IsNil)
+	expected := time.Date(2021, 7, 1, 4, 0, 0, 0, time.UTC).Unix()
+	for _, stCfg := range stCfgs {
+		startTime, err := time.Parse(time.RFC3339, stCfg.StartTime)
+		c.Assert(err, check.IsNil)
+		c.Assert(startTime.Unix(), check.Equals, expected)
+	}
+
+	c.Assert(adjustStartTime(stCfgs, "2021-07-01"), check.ErrorMatches, ".*invalid start-time 2021-07-01: wrong format.*")
+	stCfgs[1].Mode = config.ModeAll
+	c.Assert(adjustStartTime(stCfgs, "2021-07-01 12:00:00"), check.ErrorMatches, ".*task-mode all is not supported.*")
+}
+
+func (t *testMaster) TestAdjustDryRun(c *check.C) {
+	stCfgs := []*config.SubTaskConfig{
+		{SourceID: "mysql-replica-01", Mode: config.ModeIncrement},
+		{SourceID: "mysql-replica-02", Mode: config.ModeIncrement},
+	}
+	c.Assert(adjustDryRun(stCfgs), check.IsNil)
+	c.Assert(stCfgs[0].DryRun, check.IsTrue)
+	c.Assert(stCfgs[1].DryRun, check.IsTrue)
+
+	stCfgs[1].Mode = config.ModeAll
+	c.Assert(terror.ErrConfigDryRunConflict.Equal(adjustDryRun(stCfgs)), check.IsTrue)
+}
+
 func (t *testMaster) TestStartTask(c *check.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
 
 	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
 	sources, workers := defaultWorkerSource()
 
 	// s.generateSubTask with error
@@ -933,6 +969,10 @@ func (t *testMaster) TestStartTaskWithRemoveMeta(c *check.C) {
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerShardMeta(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerOnlineDDL(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerAppliedGTID(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerSchemaSnapshot(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerOversizedRow(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerApplySummary(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c.Assert(len(server.pessimist.Locks()), check.Greater, 0)
 
@@ -1025,6 +1065,10 @@ func (t *testMaster) TestStartTaskWithRemoveMeta(c *check.C) {
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerCheckpoint(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerShardMeta(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerOnlineDDL(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerAppliedGTID(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerSchemaSnapshot(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerOversizedRow(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`.`%s`", cfg.MetaSchema, cputil.SyncerApplySummary(cfg.Name))).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 	c.Assert(len(server.optimist.Locks()), check.Greater, 0)
 
@@ -1080,6 +1124,7 @@ func (t *testMaster) TestOperateTask(c *check.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
 	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
 	sources, workers := defaultWorkerSource()
 
 	// test operate-task with invalid task name
@@ -1140,7 +1185,45 @@ func (t *testMaster) TestOperateTask(c *check.C) {
 		t.subTaskStageMatch(c, server.scheduler, taskName, source, pb.Stage_Paused)
 	}
 	c.Assert(resp.Sources, check.DeepEquals, sourceResps)
-	// 3. resume task
+	// 3. lock the task, resume task is rejected unless forced or unlocked
+	lockReq := &pb.OperateTaskLockRequest{
+		Op:       pb.TaskLockOp_LockTask,
+		Task:     taskName,
+		Holder:   "alice",
+		Reason:   "maintenance",
+		Duration: 3600,
+	}
+	lockResp, err := server.OperateTaskLock(context.Background(), lockReq)
+	c.Assert(err, check.IsNil)
+	c.Assert(lockResp.Result, check.IsTrue)
+	c.Assert(lockResp.Locks, check.HasLen, 1)
+	c.Assert(lockResp.Locks[0].Holder, check.Equals, "alice")
+	resp, err = server.OperateTask(context.Background(), resumeReq)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, `.*task test is locked by alice for "maintenance".*`)
+	for _, source := range sources {
+		t.subTaskStageMatch(c, server.scheduler, taskName, source, pb.Stage_Paused)
+	}
+	// another holder can't take over or release the lock unless forced
+	lockReq.Holder = "bob"
+	lockResp, err = server.OperateTaskLock(context.Background(), lockReq)
+	c.Assert(err, check.IsNil)
+	c.Assert(lockResp.Result, check.IsFalse)
+	c.Assert(lockResp.Msg, check.Matches, `.*task test is locked by alice.*`)
+	unlockReq := &pb.OperateTaskLockRequest{Op: pb.TaskLockOp_UnlockTask, Task: taskName, Holder: "bob"}
+	lockResp, err = server.OperateTaskLock(context.Background(), unlockReq)
+	c.Assert(err, check.IsNil)
+	c.Assert(lockResp.Result, check.IsFalse)
+	unlockReq.Holder = "alice"
+	lockResp, err = server.OperateTaskLock(context.Background(), unlockReq)
+	c.Assert(err, check.IsNil)
+	c.Assert(lockResp.Result, check.IsTrue)
+	lockResp, err = server.OperateTaskLock(context.Background(), unlockReq)
+	c.Assert(err, check.IsNil)
+	c.Assert(lockResp.Result, check.IsFalse)
+	c.Assert(lockResp.Msg, check.Matches, `.*task test is not locked.*`)
+	// 4. resume task
 	resp, err = server.OperateTask(context.Background(), resumeReq)
 	c.Assert(err, check.IsNil)
 	c.Assert(resp.Result, check.IsTrue)
@@ -1148,16 +1231,24 @@ func (t *testMaster) TestOperateTask(c *check.C) {
 		t.subTaskStageMatch(c, server.scheduler, taskName, source, pb.Stage_Running)
 	}
 	c.Assert(resp.Sources, check.DeepEquals, sourceResps)
-	// 4. test stop task successfully, remove partial sources
+	// 5. test stop task successfully, remove partial sources
 	resp, err = server.OperateTask(context.Background(), stopReq1)
 	c.Assert(err, check.IsNil)
 	c.Assert(resp.Result, check.IsTrue)
 	c.Assert(server.getTaskResources(taskName), check.DeepEquals, []string{sources[1]})
 	c.Assert(resp.Sources, check.DeepEquals, []*pb.CommonWorkerResponse{{Result: true, Source: sources[0]}})
-	// 5. test stop task successfully, remove all workers
+	// 6. test stop task successfully with force, remove all workers and the lock
+	lockReq.Holder = "alice"
+	lockResp, err = server.OperateTaskLock(context.Background(), lockReq)
+	c.Assert(err, check.IsNil)
+	c.Assert(lockResp.Result, check.IsTrue)
+	stopReq2.Force = true
 	resp, err = server.OperateTask(context.Background(), stopReq2)
 	c.Assert(err, check.IsNil)
 	c.Assert(resp.Result, check.IsTrue)
+	_, ok, _, err := ha.GetTaskLock(t.etcdTestCli, taskName)
+	c.Assert(err, check.IsNil)
+	c.Assert(ok, check.IsFalse)
 	c.Assert(len(server.getTaskResources(taskName)), check.Equals, 0)
 	c.Assert(resp.Sources, check.DeepEquals, []*pb.CommonWorkerResponse{{Result: true, Source: sources[1]}})
 	t.clearSchedulerEnv(c, cancel, &wg)
@@ -1954,8 +2045,15 @@ func (t *testMaster) TestGetCfg(c *check.C) {
 		Task:    taskConfig,
 		Sources: sources,
 	}
-	server.scheduler, _ = t.testMockScheduler(ctx, &wg, c, sources, workers, "",
-		makeWorkerClientsForHandle(ctrl, taskName, sources, workers, req))
+	workerClients := make(map[string]workerrpc.Client, len(workers))
+	for i := range workers {
+		mockWorkerClient := pbmock.NewMockWorkerClient(ctrl)
+		mockRevelantWorkerClient(mockWorkerClient, taskName, sources[i], req)
+		mockWorkerClient.EXPECT().GetWorkerCfg(gomock.Any(), gomock.Any()).Return(
+			&pb.GetWorkerCfgResponse{Cfg: fmt.Sprintf("name = %q", workers[i])}, nil).AnyTimes()
+		workerClients[workers[i]] = newMockRPCClient(mockWorkerClient)
+	}
+	server.scheduler, _ = t.testMockScheduler(ctx, &wg, c, sources, workers, "", workerClients)
 	server.etcdClient = t.etcdTestCli
 
 	// start task
@@ -1989,6 +2087,41 @@ func (t *testMaster) TestGetCfg(c *check.C) {
 	c.Assert(resp2.Result, check.IsFalse)
 	c.Assert(resp2.Msg, check.Equals, "task not found")
 
+	// get all configs with their revisions
+	resp9, err := server.GetCfg(context.Background(), &pb.GetCfgRequest{All: true})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp9.Result, check.IsTrue, check.Commentf(resp9.Msg))
+	c.Assert(resp9.Revision, check.Greater, int64(0))
+	cfgs := make(map[pb.CfgType][]*pb.CfgEntry)
+	for _, entry := range resp9.Cfgs {
+		cfgs[entry.Type] = append(cfgs[entry.Type], entry)
+	}
+	c.Assert(cfgs[pb.CfgType_TaskType], check.HasLen, 1)
+	taskEntry := cfgs[pb.CfgType_TaskType][0]
+	c.Assert(taskEntry.Name, check.Equals, taskName)
+	c.Assert(taskEntry.Cfg, check.Equals, resp1.Cfg)
+	c.Assert(taskEntry.Revisions, check.HasLen, len(sources))
+	for i, source := range sources {
+		c.Assert(taskEntry.Revisions[i].Key, check.Equals, common2.UpstreamSubTaskKeyAdapter.Encode(source, taskName))
+		c.Assert(taskEntry.Revisions[i].ModRevision, check.LessEqual, resp9.Revision)
+		c.Assert(taskEntry.Revisions[i].Version, check.Greater, int64(0))
+	}
+	c.Assert(cfgs[pb.CfgType_SourceType], check.HasLen, len(sources))
+	for i, entry := range cfgs[pb.CfgType_SourceType] {
+		c.Assert(entry.Name, check.Equals, sources[i])
+		c.Assert(strings.Contains(entry.Cfg, "source-id: "+sources[i]), check.IsTrue)
+		c.Assert(strings.Contains(entry.Cfg, "password: '******'"), check.IsTrue, check.Commentf(entry.Cfg))
+		c.Assert(entry.Revisions, check.HasLen, 1)
+		c.Assert(entry.Revisions[0].Key, check.Equals, common2.UpstreamConfigKeyAdapter.Encode(sources[i]))
+	}
+	c.Assert(cfgs[pb.CfgType_WorkerType], check.HasLen, len(workers))
+	for i, entry := range cfgs[pb.CfgType_WorkerType] {
+		c.Assert(entry.Name, check.Equals, workers[i])
+		c.Assert(entry.Cfg, check.Equals, fmt.Sprintf("name = %q", workers[i]), check.Commentf(entry.Msg))
+		c.Assert(entry.Revisions, check.HasLen, 0)
+	}
+	c.Assert(len(cfgs[pb.CfgType_MasterType]) > 0, check.IsTrue)
+
 	// test restart master
 	server.scheduler.Close()
 	c.Assert(server.scheduler.Start(ctx, t.etcdTestCli), check.IsNil)
@@ -2102,3 +2235,830 @@ func (t *testMaster) TestGRPCLongResponse(c *check.C) {
 	err = common.SendRequest(ctx, "StartTask", &pb.StartTaskRequest{}, &resp)
 	c.Assert(err, check.IsNil)
 }
+
+func (t *testMaster) TestRestoreCluster(c *check.C) {
+	ctx := context.Background()
+	server := testDefaultMasterServer(c)
+
+	resp, err := server.RestoreCluster(ctx, &pb.RestoreClusterRequest{Backup: "not a backup"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*invalid cluster backup.*")
+
+	content, err := json.Marshal(ha.ClusterBackup{InternalVersion: upgrade.CurrentVersion.InternalNo + 1, ReleaseVersion: "v100.0.0"})
+	c.Assert(err, check.IsNil)
+	resp, err = server.RestoreCluster(ctx, &pb.RestoreClusterRequest{Backup: string(content)})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*can't be restored into the cluster of internal version.*")
+}
+
+// mockBackupEtcdStream records the responses of BackupEtcd.
+type mockBackupEtcdStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	resps  []*pb.BackupEtcdResponse
+	onSend func(*pb.BackupEtcdResponse)
+}
+
+func (m *mockBackupEtcdStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockBackupEtcdStream) Send(resp *pb.BackupEtcdResponse) error {
+	m.resps = append(m.resps, resp)
+	if m.onSend != nil {
+		m.onSend(resp)
+	}
+	return nil
+}
+
+func (t *testMaster) TestBackupEtcd(c *check.C) {
+	defer func(size int64) {
+		etcdBackupPageSize = size
+	}(etcdBackupPageSize)
+	etcdBackupPageSize = 1
+
+	ctx := context.Background()
+	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
+
+	otherKey := "/other/key"
+	_, err := t.etcdTestCli.Put(ctx, otherKey, "other")
+	c.Assert(err, check.IsNil)
+	defer func() {
+		_, err2 := t.etcdTestCli.Delete(ctx, otherKey)
+		c.Assert(err2, check.IsNil)
+	}()
+	_, err = ha.PutRelayConfig(t.etcdTestCli, "mysql-replica-01", "worker1", "worker2")
+	c.Assert(err, check.IsNil)
+
+	// only the snapshot of DM's keys
+	stream := &mockBackupEtcdStream{ctx: ctx}
+	c.Assert(server.BackupEtcd(&pb.BackupEtcdRequest{DmOnly: true}, stream), check.IsNil)
+	c.Assert(stream.resps[len(stream.resps)-1].SnapshotDone, check.IsTrue)
+	var keys []string
+	rev := stream.resps[0].Revision
+	for _, resp := range stream.resps {
+		c.Assert(resp.Result, check.IsTrue)
+		c.Assert(resp.Snapshot, check.IsTrue)
+		c.Assert(resp.Revision, check.Equals, rev)
+		for _, kv := range resp.Kvs {
+			keys = append(keys, kv.Key)
+		}
+	}
+	c.Assert(keys, check.DeepEquals, []string{
+		common2.UpstreamRelayWorkerKeyAdapter.Encode("worker1"),
+		common2.UpstreamRelayWorkerKeyAdapter.Encode("worker2"),
+	})
+
+	// resume watching from the revision of the snapshot
+	ctx2, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream = &mockBackupEtcdStream{ctx: ctx2, onSend: func(*pb.BackupEtcdResponse) {
+		cancel()
+	}}
+	done := make(chan error, 1)
+	go func() {
+		done <- server.BackupEtcd(&pb.BackupEtcdRequest{DmOnly: true, Watch: true, Revision: rev}, stream)
+	}()
+	_, err = t.etcdTestCli.Put(ctx, otherKey, "other2")
+	c.Assert(err, check.IsNil)
+	_, err = ha.DeleteRelayConfig(t.etcdTestCli, "worker1")
+	c.Assert(err, check.IsNil)
+	c.Assert(<-done, check.IsNil)
+	c.Assert(stream.resps, check.HasLen, 1)
+	c.Assert(stream.resps[0].Snapshot, check.IsFalse)
+	c.Assert(stream.resps[0].Kvs, check.HasLen, 1)
+	c.Assert(stream.resps[0].Kvs[0].Key, check.Equals, common2.UpstreamRelayWorkerKeyAdapter.Encode("worker1"))
+	c.Assert(stream.resps[0].Kvs[0].Deleted, check.IsTrue)
+}
+
+func (t *testMaster) TestValidateConnectivity(c *check.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	server := testDefaultMasterServer(c)
+	sources, workers := defaultWorkerSource()
+
+	// neither task name nor task config is specified
+	resp, err := server.ValidateConnectivity(context.Background(), &pb.ValidateTaskConnectivityRequest{})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*must specify a running task or a task config file.*")
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	workerClients := make(map[string]workerrpc.Client, len(workers))
+	for i := range workers {
+		worker := workers[i]
+		mockWorkerClient := pbmock.NewMockWorkerClient(ctrl)
+		mockWorkerClient.EXPECT().ValidateConnectivity(
+			gomock.Any(),
+			gomock.Any(),
+		).DoAndReturn(func(_ context.Context, req *pb.ValidateConnectivityRequest, _ ...interface{}) (*pb.ValidateConnectivityResponse, error) {
+			stCfg := config.NewSubTaskConfig()
+			c.Assert(stCfg.Decode(req.SubTaskCfg, true), check.IsNil)
+			// only the downstream of the first source is privileged
+			privileged := stCfg.SourceID == sources[0]
+			return &pb.ValidateConnectivityResponse{Result: privileged, Worker: worker, Endpoints: []*pb.EndpointConnectivity{
+				{Role: "upstream", Address: "127.0.0.1:3306", Connected: true, Privileged: true},
+				{Role: "downstream", Address: "127.0.0.1:4000", Connected: true, Privileged: privileged},
+			}}, nil
+		})
+		workerClients[worker] = newMockRPCClient(mockWorkerClient)
+	}
+	server.scheduler, _ = t.testMockScheduler(ctx, &wg, c, sources, workers, "", workerClients)
+
+	// the task is not running
+	resp, err = server.ValidateConnectivity(context.Background(), &pb.ValidateTaskConnectivityRequest{Name: "test"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(terror.ErrSchedulerTaskNotExist.Generate("test").Error(), check.Equals, resp.Msg)
+
+	// validate by the task config, the failure of any source makes the result false
+	resp, err = server.ValidateConnectivity(context.Background(), &pb.ValidateTaskConnectivityRequest{Task: taskConfig})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Sources, check.HasLen, 2)
+	for i, sourceResp := range resp.Sources {
+		c.Assert(sourceResp.Source, check.Equals, sources[i])
+		c.Assert(sourceResp.Worker, check.Equals, server.scheduler.GetWorkerBySource(sources[i]).BaseInfo().Name)
+		c.Assert(sourceResp.Result, check.Equals, i == 0)
+		c.Assert(sourceResp.Endpoints, check.HasLen, 2)
+	}
+	t.clearSchedulerEnv(c, cancel, &wg)
+}
+
+func (t *testMaster) TestQuarantineTable(c *check.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	server := testDefaultMasterServer(c)
+	sources, workers := defaultWorkerSource()
+
+	resp, err := server.QuarantineTable(context.Background(), &pb.QuarantineTableRequest{Task: "test"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*invalid op InvalidQuarantineOp on quarantine.*")
+
+	resp, err = server.QuarantineTable(context.Background(), &pb.QuarantineTableRequest{Op: pb.QuarantineOp_AddQuarantine, Task: "test", Database: "db"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*must specify the database and table.*")
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	workerClients := make(map[string]workerrpc.Client, len(workers))
+	for i := range workers {
+		worker := workers[i]
+		mockWorkerClient := pbmock.NewMockWorkerClient(ctrl)
+		mockWorkerClient.EXPECT().QuarantineTable(
+			gomock.Any(),
+			gomock.Any(),
+		).DoAndReturn(func(_ context.Context, req *pb.QuarantineWorkerTableRequest, _ ...interface{}) (*pb.CommonWorkerResponse, error) {
+			c.Assert(req.Op, check.Equals, pb.QuarantineOp_AddQuarantine)
+			c.Assert(req.Task, check.Equals, "test")
+			c.Assert(req.Database, check.Equals, "db")
+			c.Assert(req.Table, check.Equals, "tb")
+			return &pb.CommonWorkerResponse{Result: true, Source: req.Source, Worker: worker}, nil
+		}).MaxTimes(1)
+		workerClients[worker] = newMockRPCClient(mockWorkerClient)
+	}
+	server.scheduler, _ = t.testMockScheduler(ctx, &wg, c, sources, workers, "", workerClients)
+
+	// the task is not running
+	resp, err = server.QuarantineTable(context.Background(), &pb.QuarantineTableRequest{Op: pb.QuarantineOp_ShowQuarantine, Task: "test"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*has no source or not exist.*")
+
+	resp, err = server.QuarantineTable(context.Background(), &pb.QuarantineTableRequest{
+		Op:       pb.QuarantineOp_AddQuarantine,
+		Task:     "test",
+		Sources:  []string{sources[1], sources[0]},
+		Database: "db",
+		Table:    "tb",
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsTrue)
+	c.Assert(resp.Sources, check.HasLen, 2)
+	for i, sourceResp := range resp.Sources {
+		c.Assert(sourceResp.Source, check.Equals, sources[i])
+		c.Assert(sourceResp.Result, check.IsTrue)
+	}
+	t.clearSchedulerEnv(c, cancel, &wg)
+}
+
+func (t *testMaster) TestOperateProject(c *check.C) {
+	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
+	ctx := context.Background()
+
+	resp, err := server.OperateProject(ctx, &pb.OperateProjectRequest{Name: "project"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*invalid op InvalidProjectOp on project.*")
+	resp, err = server.OperateProject(ctx, &pb.OperateProjectRequest{Op: pb.ProjectOp_CreateProject, Name: "project"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*must specify the tasks of the project.*")
+	resp, err = server.OperateProject(ctx, &pb.OperateProjectRequest{Op: pb.ProjectOp_CreateProject, Name: "project", Tasks: []string{"t1", "t1"}})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*task t1 is specified more than once.*")
+
+	// the tasks are not started yet
+	resp, err = server.OperateProject(ctx, &pb.OperateProjectRequest{Op: pb.ProjectOp_CreateProject, Name: "project", Tasks: []string{"full", "incr"}})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsTrue, check.Commentf("%s", resp.Msg))
+	defer func() {
+		_, _, err2 := ha.DeleteProject(t.etcdTestCli, "project")
+		c.Assert(err2, check.IsNil)
+	}()
+	c.Assert(resp.Projects, check.HasLen, 1)
+	c.Assert(resp.Projects[0].Phase, check.Equals, projectPhasePending)
+	c.Assert(resp.Projects[0].CutoverReady, check.IsFalse)
+	c.Assert(resp.Projects[0].Blockers, check.DeepEquals, []string{"task full is not started", "task incr is not started"})
+	resp, err = server.OperateProject(ctx, &pb.OperateProjectRequest{Op: pb.ProjectOp_CreateProject, Name: "project", Tasks: []string{"other"}})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*project project already exists.*")
+
+	resp, err = server.OperateProject(ctx, &pb.OperateProjectRequest{Op: pb.ProjectOp_ShowProject})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsTrue)
+	c.Assert(resp.Projects, check.HasLen, 1)
+	c.Assert(resp.Projects[0].Tasks, check.DeepEquals, []string{"full", "incr"})
+
+	resp, err = server.OperateProject(ctx, &pb.OperateProjectRequest{Op: pb.ProjectOp_DeleteProject, Name: "project"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsTrue)
+	for _, op := range []pb.ProjectOp{pb.ProjectOp_DeleteProject, pb.ProjectOp_ShowProject} {
+		resp, err = server.OperateProject(ctx, &pb.OperateProjectRequest{Op: op, Name: "project"})
+		c.Assert(err, check.IsNil)
+		c.Assert(resp.Result, check.IsFalse)
+		c.Assert(resp.Msg, check.Matches, ".*project project not found.*")
+	}
+}
+
+func (t *testMaster) TestCombineProjectStatus(c *check.C) {
+	var (
+		p      = ha.NewProject("project", []string{"full", "incr"})
+		source = func(task, source string, st *pb.SubTaskStatus) *pb.QueryStatusResponse {
+			st.Name = task
+			return &pb.QueryStatusResponse{
+				Result:        true,
+				SourceStatus:  &pb.SourceStatus{Source: source},
+				SubTaskStatus: []*pb.SubTaskStatus{st},
+			}
+		}
+		loading = func(finished, total, eta int64) *pb.SubTaskStatus {
+			return &pb.SubTaskStatus{Stage: pb.Stage_Running, Unit: pb.UnitType_Load, Status: &pb.SubTaskStatus_Load{Load: &pb.LoadStatus{
+				FinishedBytes: finished, TotalBytes: total, EstimatedRemainingSeconds: eta,
+			}}}
+		}
+		syncing = func(stage pb.Stage, synced bool, behind int64) *pb.SubTaskStatus {
+			return &pb.SubTaskStatus{Stage: stage, Unit: pb.UnitType_Sync, Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{
+				Synced: synced, SecondsBehindMaster: behind,
+			}}}
+		}
+	)
+
+	// the full task is loading, the incremental task is not started
+	status := combineProjectStatus(p, map[string][]*pb.QueryStatusResponse{"full": {
+		source("full", "s2", loading(10, 100, 30)),
+		source("full", "s1", loading(50, 100, 20)),
+	}})
+	c.Assert(status.Phase, check.Equals, projectPhaseLoad)
+	c.Assert(status.Progress, check.Equals, "30.00 %")
+	c.Assert(status.EstimatedRemainingSeconds, check.Equals, int64(-1))
+	c.Assert(status.CutoverReady, check.IsFalse)
+	c.Assert(status.Blockers, check.DeepEquals, []string{
+		"task full on source s1 is in Load unit",
+		"task full on source s2 is in Load unit",
+		"task incr is not started",
+	})
+	c.Assert(status.Subtasks, check.HasLen, 2)
+	c.Assert(status.Subtasks[0].Source, check.Equals, "s1")
+
+	// the full task is finished, the incremental task is dumping on one source
+	status = combineProjectStatus(p, map[string][]*pb.QueryStatusResponse{
+		"full": {source("full", "s1", &pb.SubTaskStatus{Stage: pb.Stage_Finished, Unit: pb.UnitType_Load})},
+		"incr": {
+			source("incr", "s1", &pb.SubTaskStatus{Stage: pb.Stage_Running, Unit: pb.UnitType_Dump}),
+			source("incr", "s2", syncing(pb.Stage_Paused, false, 0)),
+		},
+	})
+	c.Assert(status.Phase, check.Equals, projectPhaseDump)
+	c.Assert(status.Progress, check.Equals, "66.67 %")
+	c.Assert(status.EstimatedRemainingSeconds, check.Equals, int64(-1))
+	c.Assert(status.Blockers, check.DeepEquals, []string{
+		"task incr on source s1 is in Dump unit",
+		"task incr on source s2 is Paused in Sync unit",
+	})
+
+	// the incremental task is syncing
+	statuses := map[string][]*pb.QueryStatusResponse{
+		"full": {source("full", "s1", &pb.SubTaskStatus{Stage: pb.Stage_Finished, Unit: pb.UnitType_Load})},
+		"incr": {
+			source("incr", "s1", syncing(pb.Stage_Running, true, 0)),
+			source("incr", "s2", syncing(pb.Stage_Running, false, 10)),
+		},
+	}
+	status = combineProjectStatus(p, statuses)
+	c.Assert(status.Phase, check.Equals, projectPhaseSync)
+	c.Assert(status.Progress, check.Equals, "100.00 %")
+	c.Assert(status.EstimatedRemainingSeconds, check.Equals, int64(0))
+	c.Assert(status.Blockers, check.DeepEquals, []string{"task incr on source s2 has not caught up with upstream, 10 seconds behind master"})
+	c.Assert(status.CutoverReady, check.IsFalse)
+
+	statuses["incr"][1] = source("incr", "s2", syncing(pb.Stage_Running, true, 0))
+	status = combineProjectStatus(p, statuses)
+	c.Assert(status.Blockers, check.HasLen, 0)
+	c.Assert(status.CutoverReady, check.IsTrue)
+}
+
+func (t *testMaster) TestOperationAudit(c *check.C) {
+	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
+	s := auditedServer{server}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"user-agent", "dmctl grpc-go/1.26.0", common2.OperatorMetadataKey, "alice"))
+
+	lockReq := &pb.OperateTaskLockRequest{Op: pb.TaskLockOp_LockTask, Task: "test", Holder: "alice", Duration: 3600}
+	lockResp, err := s.OperateTaskLock(ctx, lockReq)
+	c.Assert(err, check.IsNil)
+	c.Assert(lockResp.Result, check.IsFalse)
+	// read-only requests and the requests forwarded by other DM-masters are not recorded.
+	_, err = s.OperateTaskLock(ctx, &pb.OperateTaskLockRequest{Op: pb.TaskLockOp_ShowTaskLock})
+	c.Assert(err, check.IsNil)
+	forwardCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "dm-master grpc-go/1.26.0"))
+	_, err = s.OperateTaskLock(forwardCtx, lockReq)
+	c.Assert(err, check.IsNil)
+	projectResp, err := s.OperateProject(context.Background(), &pb.OperateProjectRequest{
+		Op: pb.ProjectOp_CreateProject, Name: "project", Tasks: []string{"test"},
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(projectResp.Result, check.IsTrue)
+	defer func() {
+		_, _, err2 := ha.DeleteProject(t.etcdTestCli, "project")
+		c.Assert(err2, check.IsNil)
+	}()
+
+	resp, err := server.OperationHistory(context.Background(), &pb.OperationHistoryRequest{})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsTrue)
+	c.Assert(resp.Records, check.HasLen, 2)
+	c.Assert(resp.Records[0].Method, check.Equals, "OperateProject")
+	c.Assert(resp.Records[0].Operator, check.Equals, "")
+	c.Assert(resp.Records[0].ClientType, check.Equals, ClientTypeDefault)
+	c.Assert(resp.Records[0].Result, check.IsTrue)
+	c.Assert(resp.Records[1].Method, check.Equals, "OperateTaskLock")
+	c.Assert(resp.Records[1].Operator, check.Equals, "alice")
+	c.Assert(resp.Records[1].ClientType, check.Equals, ClientTypeDMCtl)
+	c.Assert(resp.Records[1].Master, check.Equals, server.cfg.Name)
+	c.Assert(resp.Records[1].Args, check.Equals, lockReq.String())
+	c.Assert(resp.Records[1].Result, check.IsFalse)
+	c.Assert(resp.Records[1].Msg, check.Matches, ".*task test has no source or not exist.*")
+
+	// filter the records.
+	resp, err = server.OperationHistory(context.Background(), &pb.OperationHistoryRequest{Operator: "alice"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Records, check.HasLen, 1)
+	c.Assert(resp.Records[0].Method, check.Equals, "OperateTaskLock")
+	resp, err = server.OperationHistory(context.Background(), &pb.OperationHistoryRequest{Method: "StartTask"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Records, check.HasLen, 0)
+	resp, err = server.OperationHistory(context.Background(), &pb.OperationHistoryRequest{Limit: 1})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Records, check.HasLen, 1)
+	c.Assert(resp.Records[0].Method, check.Equals, "OperateProject")
+
+	// the oldest records are trimmed.
+	server.cfg.OperationAuditMaxRecords = 1
+	_, err = s.OperateTaskLock(ctx, lockReq)
+	c.Assert(err, check.IsNil)
+	resp, err = server.OperationHistory(context.Background(), &pb.OperationHistoryRequest{})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Records, check.HasLen, 1)
+	c.Assert(resp.Records[0].Method, check.Equals, "OperateTaskLock")
+}
+
+func (t *testMaster) TestProgressHistory(c *check.C) {
+	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
+
+	// invalid `since`.
+	resp, err := server.QueryStatus(context.Background(), &pb.QueryStatusListRequest{Since: "1"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, "invalid since 1.*")
+	resp, err = server.QueryStatus(context.Background(), &pb.QueryStatusListRequest{Since: "-1h"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Msg, check.Equals, "invalid since -1h: should be positive")
+
+	syncStatus := func(source, binlog string, rows int64, errMsg string) *pb.QueryStatusResponse {
+		st := &pb.SubTaskStatus{
+			Name:   "test",
+			Stage:  pb.Stage_Running,
+			Unit:   pb.UnitType_Sync,
+			Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{SyncerBinlog: binlog, TotalEvents: rows}},
+		}
+		if errMsg != "" {
+			st.Stage = pb.Stage_Paused
+			st.Result = &pb.ProcessResult{Errors: []*pb.ProcessError{{Message: errMsg}}}
+		}
+		return &pb.QueryStatusResponse{
+			Result:        true,
+			SourceStatus:  &pb.SourceStatus{Source: source},
+			SubTaskStatus: []*pb.SubTaskStatus{st},
+		}
+	}
+	loadStatus := &pb.QueryStatusResponse{
+		Result:       true,
+		SourceStatus: &pb.SourceStatus{Source: "mysql-replica-02"},
+		SubTaskStatus: []*pb.SubTaskStatus{{
+			Name:   "test",
+			Stage:  pb.Stage_Running,
+			Unit:   pb.UnitType_Load,
+			Status: &pb.SubTaskStatus_Load{Load: &pb.LoadStatus{Progress: "50.00 %", FinishedRows: 10}},
+		}},
+	}
+
+	// the replica-01 moved and restarted after an error, the replica-02 switched from load to sync.
+	now := time.Now()
+	history := [][]*pb.QueryStatusResponse{
+		{syncStatus("mysql-replica-01", "(mysql-bin.000001, 100)", 10, ""), loadStatus},
+		{syncStatus("mysql-replica-01", "(mysql-bin.000001, 200)", 30, "mock error")},
+		{syncStatus("mysql-replica-01", "(mysql-bin.000001, 200)", 5, "")},
+	}
+	for i, resps := range history {
+		_, err = ha.PutProgressRecords(t.etcdTestCli, progressRecords(now.Add(time.Duration(i-3)*time.Minute), resps)...)
+		c.Assert(err, check.IsNil)
+	}
+	current := []*pb.QueryStatusResponse{
+		syncStatus("mysql-replica-01", "(mysql-bin.000001, 300)", 15, ""),
+		syncStatus("mysql-replica-02", "(mysql-bin.000002, 4)", 3, ""),
+		{Result: false, SourceStatus: &pb.SourceStatus{Source: "mysql-replica-03"}},
+	}
+
+	histories, err := server.progressHistory("test", nil, now.Add(-time.Hour), current)
+	c.Assert(err, check.IsNil)
+	c.Assert(histories, check.HasLen, 2)
+	h := histories[0]
+	c.Assert(h.Source, check.Equals, "mysql-replica-01")
+	c.Assert(h.Samples, check.Equals, int64(4))
+	c.Assert(h.First.Location, check.Equals, "(mysql-bin.000001, 100)")
+	c.Assert(h.Last.Location, check.Equals, "(mysql-bin.000001, 300)")
+	c.Assert(h.Moved, check.IsTrue)
+	c.Assert(h.RowsApplied, check.Equals, int64(20+5+10))
+	c.Assert(h.Errors, check.DeepEquals, []string{"mock error"})
+	h = histories[1]
+	c.Assert(h.Source, check.Equals, "mysql-replica-02")
+	c.Assert(h.Samples, check.Equals, int64(2))
+	c.Assert(h.First.Unit, check.Equals, pb.UnitType_Load)
+	c.Assert(h.Last.Unit, check.Equals, pb.UnitType_Sync)
+	c.Assert(h.Moved, check.IsTrue)
+	c.Assert(h.RowsApplied, check.Equals, int64(3))
+
+	// only the records within the window and of the sources are compared.
+	histories, err = server.progressHistory("test", []string{"mysql-replica-01"}, now.Add(-90*time.Second), current)
+	c.Assert(err, check.IsNil)
+	c.Assert(histories, check.HasLen, 1)
+	c.Assert(histories[0].Samples, check.Equals, int64(2))
+	c.Assert(histories[0].RowsApplied, check.Equals, int64(10))
+	c.Assert(histories[0].Errors, check.HasLen, 0)
+
+	// the subtask doesn't move.
+	histories, err = server.progressHistory("test", []string{"mysql-replica-01"}, now.Add(-90*time.Second), current[:1])
+	c.Assert(err, check.IsNil)
+	c.Assert(histories[0].Moved, check.IsTrue)
+	histories, err = server.progressHistory("test", []string{"mysql-replica-01"}, now.Add(-90*time.Second),
+		[]*pb.QueryStatusResponse{syncStatus("mysql-replica-01", "(mysql-bin.000001, 200)", 5, "")})
+	c.Assert(err, check.IsNil)
+	c.Assert(histories[0].Moved, check.IsFalse)
+	c.Assert(histories[0].RowsApplied, check.Equals, int64(0))
+}
+
+func (t *testMaster) TestUpdateTask(c *check.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
+	sources, workers := defaultWorkerSource()
+	taskName := "test"
+	defer func() {
+		conn.DefaultDBProvider = &conn.DefaultDBProviderImpl{}
+	}()
+	expectVersion := func() {
+		mock := conn.InitVersionDB(c)
+		mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'version'").WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).
+			AddRow("version", "5.7.25-TiDB-v4.0.2"))
+	}
+
+	// the results of the requests to the second dm-worker in order:
+	// 1. check fails.
+	// 2. check succeeds, apply fails, rollback succeeds.
+	// 3. check succeeds, apply succeeds.
+	var (
+		results = []bool{false, true, false, true, true, true}
+		dryRuns = []bool{true, true, false, false, true, false}
+		calls   int
+	)
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	workerClients := make(map[string]workerrpc.Client, len(workers))
+	for i := range workers {
+		worker, idx := workers[i], i
+		mockWorkerClient := pbmock.NewMockWorkerClient(ctrl)
+		mockWorkerClient.EXPECT().UpdateSubTask(
+			gomock.Any(),
+			gomock.Any(),
+		).DoAndReturn(func(_ context.Context, req *pb.UpdateSubTaskRequest, _ ...interface{}) (*pb.CommonWorkerResponse, error) {
+			cfg := config.NewSubTaskConfig()
+			c.Assert(cfg.Decode(req.Task, true), check.IsNil)
+			c.Assert(cfg.SourceID, check.Equals, sources[idx])
+			if idx == 0 {
+				return &pb.CommonWorkerResponse{Result: true}, nil
+			}
+			c.Assert(req.DryRun, check.Equals, dryRuns[calls])
+			resp := &pb.CommonWorkerResponse{Result: results[calls]}
+			if !resp.Result {
+				resp.Msg = fmt.Sprintf("mock error of call %d", calls)
+			}
+			calls++
+			return resp, nil
+		}).AnyTimes()
+		workerClients[worker] = newMockRPCClient(mockWorkerClient)
+	}
+	server.scheduler, _ = t.testMockScheduler(ctx, &wg, c, sources, workers, "", workerClients)
+
+	// the task is not started
+	expectVersion()
+	resp, err := server.UpdateTask(context.Background(), &pb.UpdateTaskRequest{Task: taskConfig})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, "some subtasks are not found.*")
+	c.Assert(resp.Sources, check.HasLen, 2)
+
+	expectVersion()
+	_, stCfgs, err := server.generateSubTask(context.Background(), taskConfig, common.DefaultErrorCnt, common.DefaultWarnCnt)
+	c.Assert(err, check.IsNil)
+	cfgs := make([]config.SubTaskConfig, 0, len(stCfgs))
+	for _, stCfg := range stCfgs {
+		stCfg.RouteRules = nil // the old config
+		cfgs = append(cfgs, *stCfg)
+	}
+	c.Assert(server.scheduler.AddSubTasks(false, cfgs...), check.IsNil)
+	checkRouteRules := func(updated bool) {
+		for _, source := range sources {
+			tcm, _, err2 := ha.GetSubTaskCfg(t.etcdTestCli, source, taskName, 0)
+			c.Assert(err2, check.IsNil)
+			c.Assert(len(tcm[taskName].RouteRules) > 0, check.Equals, updated)
+			c.Assert(len(server.scheduler.GetSubTaskCfgsByTask(taskName)[source].RouteRules) > 0, check.Equals, updated)
+		}
+	}
+
+	// an unknown source
+	expectVersion()
+	resp, err = server.UpdateTask(context.Background(), &pb.UpdateTaskRequest{Task: taskConfig, Sources: []string{"invalid-source"}})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Sources, check.HasLen, 1)
+	c.Assert(resp.Sources[0].Source, check.Equals, "invalid-source")
+
+	// fail to check the new config
+	expectVersion()
+	resp, err = server.UpdateTask(context.Background(), &pb.UpdateTaskRequest{Task: taskConfig})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, "fail to check the new config.*nothing is updated")
+	c.Assert(resp.Sources, check.HasLen, 2)
+	c.Assert(resp.Sources[0].Result, check.IsTrue)
+	c.Assert(resp.Sources[1].Result, check.IsFalse)
+	c.Assert(resp.Sources[1].Msg, check.Equals, "mock error of call 0")
+	c.Assert(resp.Sources[1].Source, check.Equals, sources[1])
+	c.Assert(resp.Sources[1].Worker, check.Equals, workers[1])
+	checkRouteRules(false)
+
+	// fail to apply the new config and roll back
+	expectVersion()
+	resp, err = server.UpdateTask(context.Background(), &pb.UpdateTaskRequest{Task: taskConfig})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, "fail to apply the new config.*all subtasks are rolled back to the previous config")
+	c.Assert(resp.Sources[1].Msg, check.Equals, "mock error of call 2")
+	checkRouteRules(false)
+
+	// update successfully
+	expectVersion()
+	resp, err = server.UpdateTask(context.Background(), &pb.UpdateTaskRequest{Task: taskConfig})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Result, check.IsTrue)
+	c.Assert(calls, check.Equals, len(results))
+	checkRouteRules(true)
+
+	t.clearSchedulerEnv(c, cancel, &wg)
+}
+
+func (t *testMaster) TestUpgradeCluster(c *check.C) {
+	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
+	sources, workers := defaultWorkerSource()
+	freeWorker := "127.0.0.1:8264"
+	taskName := "test"
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	server.scheduler, _ = t.testMockScheduler(ctx, &wg, c, sources, workers, "", makeNilWorkerClients(workers))
+	c.Assert(server.scheduler.AddWorker(freeWorker, freeWorker), check.IsNil)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c.Assert(ha.KeepAlive(ctx, t.etcdTestCli, freeWorker, keepAliveTTL), check.IsNil)
+	}()
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return server.scheduler.GetWorkerByName(freeWorker).Stage() == scheduler.WorkerFree
+	}), check.IsTrue)
+	subtasks := make([]config.SubTaskConfig, 0, len(sources))
+	for _, source := range sources {
+		subtasks = append(subtasks, config.SubTaskConfig{Name: taskName, SourceID: source, MetaSchema: "dm_meta"})
+	}
+	c.Assert(server.scheduler.AddSubTasks(false, subtasks...), check.IsNil)
+	defer func() {
+		_, err := t.etcdTestCli.Delete(context.Background(), common2.ClusterVersionKey)
+		c.Assert(err, check.IsNil)
+		_, err = t.etcdTestCli.Delete(context.Background(), common2.KeySchemaVersionKey)
+		c.Assert(err, check.IsNil)
+		conn.DefaultDBProvider = &conn.DefaultDBProviderImpl{}
+		t.clearSchedulerEnv(c, cancel, &wg)
+	}()
+
+	upgradeCluster := func(op pb.UpgradeClusterOp, version, worker string) *pb.UpgradeClusterResponse {
+		resp, err := server.UpgradeCluster(context.Background(), &pb.UpgradeClusterRequest{Op: op, Version: version, Worker: worker})
+		c.Assert(err, check.IsNil)
+		return resp
+	}
+
+	// not being upgraded.
+	resp := upgradeCluster(pb.UpgradeClusterOp_ShowUpgrade, "", "")
+	c.Assert(resp.Result, check.IsTrue)
+	c.Assert(resp.Upgrade, check.IsNil)
+	resp = upgradeCluster(pb.UpgradeClusterOp_PrepareWorkerUpgrade, "", workers[0])
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*cluster is not being upgraded.*")
+
+	// start the upgrade, the task operations are blocked.
+	resp = upgradeCluster(pb.UpgradeClusterOp_StartUpgrade, "", "")
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*must specify the version.*")
+	resp = upgradeCluster(pb.UpgradeClusterOp_StartUpgrade, utils.ReleaseVersion, "")
+	c.Assert(resp.Result, check.IsTrue)
+	c.Assert(resp.Upgrade.TargetVersion, check.Equals, utils.ReleaseVersion)
+	resp = upgradeCluster(pb.UpgradeClusterOp_StartUpgrade, utils.ReleaseVersion, "")
+	c.Assert(resp.Result, check.IsTrue)
+	resp = upgradeCluster(pb.UpgradeClusterOp_StartUpgrade, "v100.0.0", "")
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*cluster is being upgraded to "+utils.ReleaseVersion+".*")
+	opResp, err := server.OperateTask(context.Background(), &pb.OperateTaskRequest{Op: pb.TaskOp_Pause, Name: taskName})
+	c.Assert(err, check.IsNil)
+	c.Assert(opResp.Result, check.IsFalse)
+	c.Assert(opResp.Msg, check.Matches, ".*task operations are blocked.*")
+	stResp, err := server.StartTask(context.Background(), &pb.StartTaskRequest{Task: taskConfig})
+	c.Assert(err, check.IsNil)
+	c.Assert(stResp.Result, check.IsFalse)
+	c.Assert(stResp.Msg, check.Matches, ".*task operations are blocked.*")
+
+	// the source of the first worker is handed over to the free worker.
+	resp = upgradeCluster(pb.UpgradeClusterOp_PrepareWorkerUpgrade, "", workers[0])
+	c.Assert(resp.Result, check.IsTrue, check.Commentf("%s", resp.Msg))
+	c.Assert(resp.Upgrade.Workers, check.DeepEquals, []*pb.WorkerUpgrade{
+		{Worker: workers[0], Stage: ha.WorkerUpgradePrepared, Source: sources[0], HandoverTo: freeWorker},
+	})
+	c.Assert(server.scheduler.GetWorkerBySource(sources[0]).BaseInfo().Name, check.Equals, freeWorker)
+	t.subTaskStageMatch(c, server.scheduler, taskName, sources[0], pb.Stage_Running)
+
+	// no free worker for the second worker, the subtask is paused until it's resumed.
+	resp = upgradeCluster(pb.UpgradeClusterOp_PrepareWorkerUpgrade, "", workers[1])
+	c.Assert(resp.Result, check.IsTrue, check.Commentf("%s", resp.Msg))
+	c.Assert(resp.Upgrade.Workers[1], check.DeepEquals,
+		&pb.WorkerUpgrade{Worker: workers[1], Stage: ha.WorkerUpgradePrepared, Source: sources[1], PausedTasks: []string{taskName}})
+	t.subTaskStageMatch(c, server.scheduler, taskName, sources[1], pb.Stage_Paused)
+	resp = upgradeCluster(pb.UpgradeClusterOp_ResumeWorkerUpgrade, "", freeWorker)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*is not prepared for upgrade.*")
+	resp = upgradeCluster(pb.UpgradeClusterOp_ResumeWorkerUpgrade, "", workers[1])
+	c.Assert(resp.Result, check.IsTrue, check.Commentf("%s", resp.Msg))
+	c.Assert(resp.Upgrade.Workers[1], check.DeepEquals,
+		&pb.WorkerUpgrade{Worker: workers[1], Stage: ha.WorkerUpgradeResumed, Source: sources[1]})
+	t.subTaskStageMatch(c, server.scheduler, taskName, sources[1], pb.Stage_Running)
+
+	// the verification fails, the window is kept.
+	expectCheckpointColumns := func(columns ...string) sqlmock.Sqlmock {
+		mock := conn.InitMockDB(c)
+		rows := sqlmock.NewRows([]string{"COLUMN_NAME"})
+		for _, col := range columns {
+			rows.AddRow(col)
+		}
+		mock.ExpectQuery("SELECT COLUMN_NAME FROM information_schema.COLUMNS").
+			WithArgs("dm_meta", cputil.SyncerCheckpoint(taskName)).WillReturnRows(rows)
+		return mock
+	}
+	mock := expectCheckpointColumns("id", "binlog_gtid")
+	resp = upgradeCluster(pb.UpgradeClusterOp_FinishUpgrade, "", "")
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Upgrade, check.NotNil)
+	c.Assert(mock.ExpectationsWereMet(), check.IsNil)
+	c.Assert(resp.Msg, check.Matches, ".*checkpoint tables `dm_meta`.`test_syncer_checkpoint` are not migrated.*")
+	c.Assert(resp.Msg, check.Matches, ".*cluster version .* is not migrated.*")
+	c.Assert(resp.Msg, check.Matches, ".*etcd key schema version 0 is not migrated.*")
+	c.Assert(resp.Msg, check.Matches, fmt.Sprintf(".*DM-worker %s is not resumed.*", workers[0]))
+
+	// finish the upgrade after migrated and resumed.
+	resp = upgradeCluster(pb.UpgradeClusterOp_ResumeWorkerUpgrade, "", workers[0])
+	c.Assert(resp.Result, check.IsTrue, check.Commentf("%s", resp.Msg))
+	_, err = upgrade.PutVersion(t.etcdTestCli, upgrade.CurrentVersion)
+	c.Assert(err, check.IsNil)
+	c.Assert(upgrade.TryMigrateKeySchema(context.Background(), t.etcdTestCli), check.IsNil)
+	mock = expectCheckpointColumns()
+	resp = upgradeCluster(pb.UpgradeClusterOp_FinishUpgrade, "", "")
+	c.Assert(resp.Result, check.IsTrue, check.Commentf("%s", resp.Msg))
+	c.Assert(resp.Upgrade, check.IsNil)
+	c.Assert(mock.ExpectationsWereMet(), check.IsNil)
+	resp = upgradeCluster(pb.UpgradeClusterOp_ShowUpgrade, "", "")
+	c.Assert(resp.Result, check.IsTrue)
+	c.Assert(resp.Upgrade, check.IsNil)
+	c.Assert(server.checkClusterUpgrade(), check.IsNil)
+}
+
+func (t *testMaster) TestTransferSourceHandover(c *check.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	server := testDefaultMasterServer(c)
+	server.etcdClient = t.etcdTestCli
+	sources, workers := defaultWorkerSource()
+	freeWorker := "127.0.0.1:8264"
+	taskName := "test"
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	workerClients := makeWorkerClientsForHandle(ctrl, taskName, sources, workers, &pb.OperateTaskRequest{Op: pb.TaskOp_Pause})
+	server.scheduler, _ = t.testMockSchedulerForRelay(ctx, &wg, c, sources, workers, "", workerClients)
+	c.Assert(server.scheduler.AddWorker(freeWorker, freeWorker), check.IsNil)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c.Assert(ha.KeepAlive(ctx, t.etcdTestCli, freeWorker, keepAliveTTL), check.IsNil)
+	}()
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return server.scheduler.GetWorkerByName(freeWorker).Stage() == scheduler.WorkerFree
+	}), check.IsTrue)
+	subtasks := make([]config.SubTaskConfig, 0, len(sources))
+	for _, source := range sources {
+		subtasks = append(subtasks, config.SubTaskConfig{Name: taskName, SourceID: source, MetaSchema: "dm_meta"})
+	}
+	c.Assert(server.scheduler.AddSubTasks(false, subtasks...), check.IsNil)
+	defer t.clearSchedulerEnv(c, cancel, &wg)
+
+	transferSource := func(source, worker string, handover bool) *pb.TransferSourceResponse {
+		resp, err := server.TransferSource(context.Background(), &pb.TransferSourceRequest{Source: source, Worker: worker, Handover: handover})
+		c.Assert(err, check.IsNil)
+		return resp
+	}
+
+	// the source with running subtasks can't be transferred without handover.
+	resp := transferSource(sources[0], freeWorker, false)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(server.scheduler.GetWorkerBySource(sources[0]).BaseInfo().Name, check.Equals, workers[0])
+
+	// the target worker should be free.
+	resp = transferSource(sources[0], workers[1], true)
+	c.Assert(resp.Result, check.IsFalse)
+	t.subTaskStageMatch(c, server.scheduler, taskName, sources[0], pb.Stage_Running)
+
+	// hand over the source with the subtask and relay.
+	resp = transferSource(sources[0], freeWorker, true)
+	c.Assert(resp.Result, check.IsTrue, check.Commentf("%s", resp.Msg))
+	c.Assert(resp.PausedTasks, check.HasLen, 0)
+	c.Assert(server.scheduler.GetWorkerBySource(sources[0]).BaseInfo().Name, check.Equals, freeWorker)
+	relayWorkers, err := server.scheduler.GetRelayWorkers(sources[0])
+	c.Assert(err, check.IsNil)
+	c.Assert(relayWorkers, check.HasLen, 1)
+	c.Assert(relayWorkers[0].BaseInfo().Name, check.Equals, freeWorker)
+	t.relayStageMatch(c, server.scheduler, sources[0], pb.Stage_Running)
+	t.subTaskStageMatch(c, server.scheduler, taskName, sources[0], pb.Stage_Running)
+	c.Assert(server.scheduler.GetWorkerByName(workers[0]).Stage(), check.Equals, scheduler.WorkerFree)
+
+	// the locked task is not paused.
+	_, err = ha.PutTaskLock(t.etcdTestCli, ha.NewTaskLock(taskName, "someone", "migrating", time.Now().Add(time.Hour)))
+	c.Assert(err, check.IsNil)
+	resp = transferSource(sources[1], workers[0], true)
+	c.Assert(resp.Result, check.IsFalse)
+	c.Assert(resp.Msg, check.Matches, ".*locked.*")
+	c.Assert(server.scheduler.GetWorkerBySource(sources[1]).BaseInfo().Name, check.Equals, workers[1])
+	t.subTaskStageMatch(c, server.scheduler, taskName, sources[1], pb.Stage_Running)
+	_, err = ha.DeleteTaskLock(t.etcdTestCli, taskName)
+	c.Assert(err, check.IsNil)
+}
@@ -0,0 +1,347 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+const (
+	// SourceRegistryConsul discovers the upstream sources from the healthy instances of a Consul service.
+	SourceRegistryConsul = "consul"
+	// SourceRegistryDNSSRV discovers the upstream sources from the DNS SRV records.
+	SourceRegistryDNSSRV = "dns-srv"
+
+	defaultConsulAddress           = "http://127.0.0.1:8500"
+	defaultSourceDiscoveryInterval = "30s"
+	defaultDiscoveredSourcePrefix  = "auto-"
+	// the hash suffix of the too long source IDs is 9 characters, so the prefix should leave enough room.
+	maxDiscoveredSourcePrefixLength = 16
+)
+
+// SourceDiscoveryConfig is the config to discover the upstream MySQL endpoints from a service registry, the sources of
+// the endpoints are created from a template and removed when the endpoints disappear.
+type SourceDiscoveryConfig struct {
+	// Registry is the type of the service registry, "consul" or "dns-srv".
+	Registry string `toml:"registry" json:"registry"`
+	// Address is the HTTP address of the Consul agent, only used for Consul.
+	Address string `toml:"address" json:"address"`
+	// Service is the name of the Consul service or the SRV record, like "_mysql._tcp.shards.example.com".
+	Service string `toml:"service" json:"service"`
+	// Tags selects the Consul service instances with all the tags.
+	Tags []string `toml:"tags" json:"tags"`
+	// SourceIDPrefix is the prefix of the IDs of the discovered sources, only the sources with the prefix are
+	// managed by the discovery.
+	SourceIDPrefix string `toml:"source-id-prefix" json:"source-id-prefix"`
+	// SourceTemplate is the path of the source config file to create the sources, its source-id, host and port are
+	// replaced by the discovered endpoint.
+	SourceTemplate string `toml:"source-template" json:"source-template"`
+
+	IntervalStr string        `toml:"interval" json:"interval"`
+	Interval    time.Duration `toml:"-" json:"-"`
+
+	template *config.SourceConfig
+}
+
+// adjust adjusts and verifies the config, and loads the source template.
+func (c *SourceDiscoveryConfig) adjust() error {
+	switch c.Registry {
+	case SourceRegistryConsul:
+		if c.Address == "" {
+			c.Address = defaultConsulAddress
+		}
+	case SourceRegistryDNSSRV:
+	default:
+		return terror.ErrMasterConfigInvalidSourceDiscovery.Generate(fmt.Sprintf("registry should be %s or %s, but got %q", SourceRegistryConsul, SourceRegistryDNSSRV, c.Registry))
+	}
+	if c.Service == "" {
+		return terror.ErrMasterConfigInvalidSourceDiscovery.Generate("service should not be empty")
+	}
+	if len(c.Tags) > 0 && c.Registry != SourceRegistryConsul {
+		return terror.ErrMasterConfigInvalidSourceDiscovery.Generate("tags can only be used for consul")
+	}
+
+	if c.SourceIDPrefix == "" {
+		c.SourceIDPrefix = defaultDiscoveredSourcePrefix
+	}
+	if len(c.SourceIDPrefix) > maxDiscoveredSourcePrefixLength {
+		return terror.ErrMasterConfigInvalidSourceDiscovery.Generate(fmt.Sprintf("the length of source-id-prefix should not be more than %d", maxDiscoveredSourcePrefixLength))
+	}
+
+	if c.IntervalStr == "" {
+		c.IntervalStr = defaultSourceDiscoveryInterval
+	}
+	interval, err := time.ParseDuration(c.IntervalStr)
+	if err != nil {
+		return terror.ErrMasterConfigTimeoutParse.Delegate(err)
+	}
+	if interval <= 0 {
+		return terror.ErrMasterConfigInvalidSourceDiscovery.Generate(fmt.Sprintf("interval should be positive, but got %s", c.IntervalStr))
+	}
+	c.Interval = interval
+
+	if c.SourceTemplate == "" {
+		return terror.ErrMasterConfigInvalidSourceDiscovery.Generate("source-template should not be empty")
+	}
+	content, err := os.ReadFile(c.SourceTemplate)
+	if err != nil {
+		return terror.ErrMasterConfigInvalidSourceDiscovery.Delegate(err, "read source-template")
+	}
+	c.template, err = config.ParseYaml(string(content))
+	return err
+}
+
+// sourceEndpoint is an upstream MySQL endpoint found in the service registry.
+type sourceEndpoint struct {
+	ID   string // the ID of the instance in the registry, which is kept when the address changes.
+	Host string
+	Port int
+}
+
+// sourceRegistry lists the upstream MySQL endpoints.
+type sourceRegistry interface {
+	endpoints(ctx context.Context) ([]sourceEndpoint, error)
+}
+
+func newSourceRegistry(cfg *SourceDiscoveryConfig) sourceRegistry {
+	if cfg.Registry == SourceRegistryConsul {
+		return &consulRegistry{
+			address: strings.TrimSuffix(cfg.Address, "/"),
+			service: cfg.Service,
+			tags:    cfg.Tags,
+			client:  &http.Client{},
+		}
+	}
+	return &dnsSRVRegistry{name: cfg.Service, lookupSRV: net.DefaultResolver.LookupSRV}
+}
+
+// consulRegistry lists the healthy instances of a Consul service by the health API.
+type consulRegistry struct {
+	address string
+	service string
+	tags    []string
+	client  *http.Client
+}
+
+// consulServiceEntry is an entry returned by `/v1/health/service/:service`, only the used fields are decoded.
+type consulServiceEntry struct {
+	Node struct {
+		Node    string `json:"Node"`
+		Address string `json:"Address"`
+	} `json:"Node"`
+	Service struct {
+		ID      string   `json:"ID"`
+		Address string   `json:"Address"`
+		Port    int      `json:"Port"`
+		Tags    []string `json:"Tags"`
+	} `json:"Service"`
+}
+
+func (r *consulRegistry) endpoints(ctx context.Context) ([]sourceEndpoint, error) {
+	query := url.Values{"passing": []string{"true"}}
+	for _, tag := range r.tags {
+		query.Add("tag", tag)
+	}
+	u := fmt.Sprintf("%s/v1/health/service/%s?%s", r.address, url.PathEscape(r.service), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, terror.ErrMasterSourceDiscoveryFail.Delegate(err, SourceRegistryConsul)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, terror.ErrMasterSourceDiscoveryFail.Delegate(err, SourceRegistryConsul)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, terror.ErrMasterSourceDiscoveryFail.Delegate(fmt.Errorf("unexpected status %s", resp.Status), SourceRegistryConsul)
+	}
+	var entries []consulServiceEntry
+	if err = json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, terror.ErrMasterSourceDiscoveryFail.Delegate(err, SourceRegistryConsul)
+	}
+
+	endpoints := make([]sourceEndpoint, 0, len(entries))
+	for _, e := range entries {
+		// the old Consul agents only filter by one tag.
+		if !containsAll(e.Service.Tags, r.tags) {
+			continue
+		}
+		host := e.Service.Address
+		if host == "" {
+			host = e.Node.Address
+		}
+		// the service ID is only unique in the agent.
+		endpoints = append(endpoints, sourceEndpoint{ID: e.Node.Node + "-" + e.Service.ID, Host: host, Port: e.Service.Port})
+	}
+	return endpoints, nil
+}
+
+func containsAll(items, subset []string) bool {
+	set := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+	for _, item := range subset {
+		if _, ok := set[item]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// dnsSRVRegistry lists the targets of the SRV records.
+type dnsSRVRegistry struct {
+	name      string
+	lookupSRV func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+func (r *dnsSRVRegistry) endpoints(ctx context.Context) ([]sourceEndpoint, error) {
+	_, records, err := r.lookupSRV(ctx, "", "", r.name)
+	if err != nil {
+		return nil, terror.ErrMasterSourceDiscoveryFail.Delegate(err, SourceRegistryDNSSRV)
+	}
+	endpoints := make([]sourceEndpoint, 0, len(records))
+	for _, record := range records {
+		host := strings.TrimSuffix(record.Target, ".")
+		endpoints = append(endpoints, sourceEndpoint{ID: host, Host: host, Port: int(record.Port)})
+	}
+	return endpoints, nil
+}
+
+// discoveredSourceID generates the source ID of the instance in the registry, the too long ID is truncated and
+// suffixed with the hash of the instance ID.
+func discoveredSourceID(prefix, instance string) string {
+	id := prefix + strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '-' || r == '_' {
+			return r
+		}
+		return '-'
+	}, instance)
+	if len(id) > config.MaxSourceIDLength {
+		id = fmt.Sprintf("%s-%08x", id[:config.MaxSourceIDLength-9], crc32.ChecksumIEEE([]byte(instance)))
+	}
+	return id
+}
+
+// sourceDiscoveryLoop discovers the upstream sources periodically when the current member is the leader.
+func (s *Server) sourceDiscoveryLoop(ctx context.Context) {
+	registry := newSourceRegistry(s.cfg.SourceDiscovery)
+	ticker := time.NewTicker(s.cfg.SourceDiscovery.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if s.leader.Load() != oneselfLeader {
+			continue
+		}
+		s.discoverSources(ctx, registry)
+	}
+}
+
+// discoverSources creates the sources for the new endpoints in the registry, recreates the sources whose endpoints
+// change the addresses and removes the sources whose endpoints disappear. the sources used by the tasks are not
+// recreated or removed, and failing to operate a source only logs the error, so it's retried in the next round.
+func (s *Server) discoverSources(ctx context.Context, registry sourceRegistry) {
+	cfg := s.cfg.SourceDiscovery
+	logger := log.L().WithFields(zap.String("component", "source discovery"))
+
+	ctx2, cancel := context.WithTimeout(ctx, s.cfg.RPCTimeout)
+	endpoints, err := registry.endpoints(ctx2)
+	cancel()
+	if err != nil {
+		// keep the sources if the registry is unavailable.
+		logger.Warn("fail to list the upstream endpoints", log.ShortError(err))
+		return
+	}
+
+	discovered := make(map[string]sourceEndpoint, len(endpoints))
+	for _, e := range endpoints {
+		discovered[discoveredSourceID(cfg.SourceIDPrefix, e.ID)] = e
+	}
+	existing := make(map[string]*config.SourceConfig)
+	for id, sourceCfg := range s.scheduler.GetSourceCfgs() {
+		if strings.HasPrefix(id, cfg.SourceIDPrefix) {
+			existing[id] = sourceCfg
+		}
+	}
+
+	ids := make([]string, 0, len(discovered))
+	for id := range discovered {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		e := discovered[id]
+		if old, ok := existing[id]; ok {
+			if old.From.Host == e.Host && old.From.Port == e.Port {
+				continue
+			}
+			if tasks := s.scheduler.GetTaskNameListBySourceName(id); len(tasks) > 0 {
+				logger.Warn("the address of the source changes, but it's used by tasks",
+					zap.String("source", id), zap.String("host", e.Host), zap.Int("port", e.Port), zap.Strings("tasks", tasks))
+				continue
+			}
+			if err = s.scheduler.RemoveSourceCfg(id); err != nil {
+				logger.Error("fail to remove the source to update its address", zap.String("source", id), log.ShortError(err))
+				continue
+			}
+		}
+
+		sourceCfg := cfg.template.Clone()
+		sourceCfg.SourceID = id
+		sourceCfg.From.Host = e.Host
+		sourceCfg.From.Port = e.Port
+		if err = checkAndAdjustSourceConfigFunc(ctx, sourceCfg); err != nil {
+			logger.Error("fail to check the discovered source", zap.String("source", id), zap.String("host", e.Host), zap.Int("port", e.Port), log.ShortError(err))
+			continue
+		}
+		if err = s.scheduler.AddSourceCfg(sourceCfg); err != nil {
+			logger.Error("fail to create the discovered source", zap.String("source", id), log.ShortError(err))
+			continue
+		}
+		logger.Info("create the discovered source", zap.String("source", id), zap.String("host", e.Host), zap.Int("port", e.Port))
+	}
+
+	for id := range existing {
+		if _, ok := discovered[id]; ok {
+			continue
+		}
+		if err = s.scheduler.RemoveSourceCfg(id); err != nil {
+			logger.Warn("fail to remove the disappeared source", zap.String("source", id), log.ShortError(err))
+			continue
+		}
+		logger.Info("remove the disappeared source", zap.String("source", id))
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+const sourceTemplateContent = `
+source-id: "template"
+from:
+  host: "127.0.0.1"
+  port: 3306
+  user: "root"
+  password: ""
+`
+
+func writeSourceTemplate(c *check.C) string {
+	path := filepath.Join(c.MkDir(), "source-template.yaml")
+	c.Assert(os.WriteFile(path, []byte(sourceTemplateContent), 0o600), check.IsNil)
+	return path
+}
+
+func (t *testMaster) TestSourceDiscoveryConfig(c *check.C) {
+	template := writeSourceTemplate(c)
+
+	cfg := &SourceDiscoveryConfig{Registry: SourceRegistryConsul, Service: "mysql", SourceTemplate: template}
+	c.Assert(cfg.adjust(), check.IsNil)
+	c.Assert(cfg.Address, check.Equals, defaultConsulAddress)
+	c.Assert(cfg.SourceIDPrefix, check.Equals, defaultDiscoveredSourcePrefix)
+	c.Assert(cfg.Interval.String(), check.Equals, defaultSourceDiscoveryInterval)
+	c.Assert(cfg.template.From.User, check.Equals, "root")
+
+	cases := []*SourceDiscoveryConfig{
+		{Registry: "etcd", Service: "mysql", SourceTemplate: template},
+		{Registry: SourceRegistryDNSSRV, SourceTemplate: template},
+		{Registry: SourceRegistryDNSSRV, Service: "mysql", Tags: []string{"primary"}, SourceTemplate: template},
+		{Registry: SourceRegistryDNSSRV, Service: "mysql", SourceIDPrefix: "a-too-long-source-prefix-", SourceTemplate: template},
+		{Registry: SourceRegistryDNSSRV, Service: "mysql", IntervalStr: "0s", SourceTemplate: template},
+		{Registry: SourceRegistryDNSSRV, Service: "mysql"},
+		{Registry: SourceRegistryDNSSRV, Service: "mysql", SourceTemplate: template + ".not-exist"},
+	}
+	for _, cs := range cases {
+		c.Assert(terror.ErrMasterConfigInvalidSourceDiscovery.Equal(cs.adjust()), check.IsTrue, check.Commentf("%+v", cs))
+	}
+}
+
+func (t *testMaster) TestSourceRegistry(c *check.C) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Assert(r.URL.Path, check.Equals, "/v1/health/service/mysql")
+		c.Assert(r.URL.Query().Get("passing"), check.Equals, "true")
+		_, _ = w.Write([]byte(`[
+			{"Node": {"Node": "node1", "Address": "10.0.0.1"}, "Service": {"ID": "mysql-1", "Address": "", "Port": 3306, "Tags": ["primary", "shard"]}},
+			{"Node": {"Node": "node2", "Address": "10.0.0.2"}, "Service": {"ID": "mysql-2", "Address": "10.0.1.2", "Port": 3307, "Tags": ["primary"]}}
+		]`))
+	}))
+	defer ts.Close()
+
+	registry := newSourceRegistry(&SourceDiscoveryConfig{Registry: SourceRegistryConsul, Address: ts.URL + "/", Service: "mysql"})
+	endpoints, err := registry.endpoints(context.Background())
+	c.Assert(err, check.IsNil)
+	c.Assert(endpoints, check.DeepEquals, []sourceEndpoint{
+		{ID: "node1-mysql-1", Host: "10.0.0.1", Port: 3306},
+		{ID: "node2-mysql-2", Host: "10.0.1.2", Port: 3307},
+	})
+	// the tags are filtered again for the old Consul agents.
+	registry.(*consulRegistry).tags = []string{"primary", "shard"}
+	endpoints, err = registry.endpoints(context.Background())
+	c.Assert(err, check.IsNil)
+	c.Assert(endpoints, check.HasLen, 1)
+
+	dns := &dnsSRVRegistry{name: "_mysql._tcp.example.com", lookupSRV: func(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+		c.Assert(name, check.Equals, "_mysql._tcp.example.com")
+		return "", []*net.SRV{{Target: "db-1.example.com.", Port: 3306}}, nil
+	}}
+	endpoints, err = dns.endpoints(context.Background())
+	c.Assert(err, check.IsNil)
+	c.Assert(endpoints, check.DeepEquals, []sourceEndpoint{{ID: "db-1.example.com", Host: "db-1.example.com", Port: 3306}})
+	dns.lookupSRV = func(context.Context, string, string, string) (string, []*net.SRV, error) {
+		return "", nil, errors.New("no such host")
+	}
+	_, err = dns.endpoints(context.Background())
+	c.Assert(terror.ErrMasterSourceDiscoveryFail.Equal(err), check.IsTrue)
+
+	c.Assert(discoveredSourceID("auto-", "db-1.example.com"), check.Equals, "auto-db-1-example-com")
+	id := discoveredSourceID("auto-", "mysql-shard-0001.cluster.example.com")
+	c.Assert(id, check.HasLen, config.MaxSourceIDLength)
+	c.Assert(id, check.Not(check.Equals), discoveredSourceID("auto-", "mysql-shard-0001.cluster.example.org"))
+}
+
+type fakeSourceRegistry struct {
+	eps []sourceEndpoint
+	err error
+}
+
+func (r *fakeSourceRegistry) endpoints(context.Context) ([]sourceEndpoint, error) {
+	return r.eps, r.err
+}
+
+func (t *testMaster) TestDiscoverSources(c *check.C) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	defer t.clearSchedulerEnv(c, cancel, &wg)
+
+	server := testDefaultMasterServer(c)
+	server.scheduler, _ = t.testMockScheduler(ctx, &wg, c, nil, nil, "", t.workerClients)
+	server.cfg.SourceDiscovery = &SourceDiscoveryConfig{Registry: SourceRegistryDNSSRV, Service: "mysql", SourceTemplate: writeSourceTemplate(c)}
+	c.Assert(server.cfg.SourceDiscovery.adjust(), check.IsNil)
+
+	// the sources created manually are not managed.
+	manual := config.NewSourceConfig()
+	manual.SourceID = "mysql-replica-01"
+	c.Assert(server.scheduler.AddSourceCfg(manual), check.IsNil)
+
+	registry := &fakeSourceRegistry{eps: []sourceEndpoint{
+		{ID: "db-1", Host: "10.0.0.1", Port: 3306},
+		{ID: "db-2", Host: "10.0.0.2", Port: 3306},
+	}}
+	server.discoverSources(ctx, registry)
+	cfgs := server.scheduler.GetSourceCfgs()
+	c.Assert(cfgs, check.HasLen, 3)
+	c.Assert(cfgs["auto-db-1"].From.Host, check.Equals, "10.0.0.1")
+	c.Assert(cfgs["auto-db-1"].From.User, check.Equals, "root")
+	c.Assert(cfgs["auto-db-2"].From.Host, check.Equals, "10.0.0.2")
+
+	// the sources are kept if the registry is unavailable.
+	server.discoverSources(ctx, &fakeSourceRegistry{err: errors.New("unavailable")})
+	c.Assert(server.scheduler.GetSourceCfgs(), check.HasLen, 3)
+
+	// db-1 moves, db-2 disappears and db-3 appears.
+	registry.eps = []sourceEndpoint{
+		{ID: "db-1", Host: "10.0.1.1", Port: 3307},
+		{ID: "db-3", Host: "10.0.0.3", Port: 3306},
+	}
+	server.discoverSources(ctx, registry)
+	cfgs = server.scheduler.GetSourceCfgs()
+	c.Assert(cfgs, check.HasLen, 3)
+	c.Assert(cfgs["auto-db-1"].From.Host, check.Equals, "10.0.1.1")
+	c.Assert(cfgs["auto-db-1"].From.Port, check.Equals, 3307)
+	c.Assert(cfgs["auto-db-3"], check.NotNil)
+	c.Assert(cfgs["mysql-replica-01"], check.NotNil)
+}
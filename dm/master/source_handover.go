@@ -0,0 +1,154 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/master/scheduler"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// handoverSource transfers the source to the free DM-worker with its running subtasks and relay. the subtasks are
+// paused and their checkpoints are flushed before the source is transferred, so the new DM-worker can pull the relay
+// log from the checkpoints and resume the subtasks there. if fails halfway, the source is kept on or recovered to the
+// old DM-worker, and the subtasks paused but not resumed are returned.
+func (s *Server) handoverSource(ctx context.Context, source, worker string) ([]string, error) {
+	if err := s.checkClusterUpgrade(); err != nil {
+		return nil, err
+	}
+	if s.scheduler.GetSourceCfgByID(source) == nil {
+		return nil, terror.ErrSchedulerSourceCfgNotExist.Generate(source)
+	}
+	w := s.scheduler.GetWorkerByName(worker)
+	if w == nil {
+		return nil, terror.ErrSchedulerWorkerNotExist.Generate(worker)
+	}
+	oldWorker := s.scheduler.GetWorkerBySource(source)
+	if oldWorker == nil || oldWorker.BaseInfo().Name == worker {
+		// nothing to hand over.
+		return nil, s.scheduler.TransferSource(source, worker)
+	}
+	if stage := w.Stage(); stage != scheduler.WorkerFree {
+		return nil, terror.ErrSchedulerWorkerInvalidTrans.Generate(worker, stage, scheduler.WorkerBound)
+	}
+
+	relayStage := s.scheduler.GetExpectRelayStage(source).Expect
+
+	// 1. pause the running subtasks, the locked tasks are not touched.
+	var runningTasks []string
+	for task, subtasks := range s.scheduler.GetSubTaskCfgs() {
+		if _, ok := subtasks[source]; !ok {
+			continue
+		}
+		if s.scheduler.GetExpectSubTaskStage(task, source).Expect != pb.Stage_Running {
+			continue
+		}
+		if err := s.checkTaskLock(task); err != nil {
+			return nil, err
+		}
+		runningTasks = append(runningTasks, task)
+	}
+	sort.Strings(runningTasks)
+
+	var pausedTasks []string
+	for _, task := range runningTasks {
+		if err := s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Paused, task, source); err != nil {
+			return s.abortSourceHandover(source, pausedTasks, nil, relayStage, err)
+		}
+		pausedTasks = append(pausedTasks, task)
+	}
+
+	// 2. wait for the subtasks paused, the checkpoints are flushed when the subtasks exit.
+	for _, task := range pausedTasks {
+		req := &pb.OperateTaskRequest{Op: pb.TaskOp_Pause, Name: task, Sources: []string{source}}
+		ok, msg, _, err := s.waitOperationOk(ctx, oldWorker, task, source, req)
+		if err == nil && !ok {
+			err = terror.ErrMasterOperRespNotSuccess.Generate(msg)
+		}
+		if err != nil {
+			return s.abortSourceHandover(source, pausedTasks, nil, relayStage, err)
+		}
+	}
+
+	// 3. stop the relay started by `start-relay` on the old DM-worker, the relay log is pulled again by the new
+	// DM-worker. the relay enabled in the source config follows the bound source.
+	var relayWorker *scheduler.Worker
+	relayWorkers, err := s.scheduler.GetRelayWorkers(source)
+	if err != nil {
+		return s.abortSourceHandover(source, pausedTasks, nil, relayStage, err)
+	}
+	for _, rw := range relayWorkers {
+		if rw.BaseInfo().Name == oldWorker.BaseInfo().Name {
+			relayWorker = rw
+		}
+	}
+	if relayWorker != nil {
+		if err = s.scheduler.StopRelay(source, []string{relayWorker.BaseInfo().Name}); err != nil {
+			return s.abortSourceHandover(source, pausedTasks, nil, relayStage, err)
+		}
+	}
+
+	// 4. transfer the source and start the relay on the new DM-worker.
+	if err = s.scheduler.TransferSource(source, worker); err != nil {
+		return s.abortSourceHandover(source, pausedTasks, relayWorker, relayStage, err)
+	}
+	if relayWorker != nil {
+		if err = s.startHandoverRelay(source, worker, relayStage); err != nil {
+			return pausedTasks, terror.ErrMasterSourceHandoverFail.Delegate(err, source, oldWorker.BaseInfo().Name, worker)
+		}
+	}
+
+	// 5. resume the subtasks on the new DM-worker.
+	if err = s.resumePausedTasks(source, pausedTasks); err != nil {
+		return pausedTasks, terror.ErrMasterSourceHandoverFail.Delegate(err, source, oldWorker.BaseInfo().Name, worker)
+	}
+	log.L().Info("source handed over", zap.String("source", source), zap.String("from", oldWorker.BaseInfo().Name),
+		zap.String("to", worker), zap.Strings("resumed tasks", pausedTasks), zap.Bool("relay moved", relayWorker != nil))
+	return nil, nil
+}
+
+// abortSourceHandover recovers the relay on the old DM-worker and resumes the paused subtasks there, it returns the
+// subtasks failed to be resumed and the original error.
+func (s *Server) abortSourceHandover(source string, pausedTasks []string, relayWorker *scheduler.Worker, relayStage pb.Stage, err error) ([]string, error) {
+	log.L().Warn("fail to hand over source, recover it on the old worker", zap.String("source", source),
+		zap.Strings("paused tasks", pausedTasks), log.ShortError(err))
+	if relayWorker != nil {
+		if err2 := s.startHandoverRelay(source, relayWorker.BaseInfo().Name, relayStage); err2 != nil {
+			log.L().Warn("fail to start relay on the old worker", zap.String("source", source),
+				zap.String("worker", relayWorker.BaseInfo().Name), log.ShortError(err2))
+		}
+	}
+	if err2 := s.resumePausedTasks(source, pausedTasks); err2 != nil {
+		log.L().Warn("fail to resume the paused subtasks", zap.String("source", source), log.ShortError(err2))
+		return pausedTasks, err
+	}
+	return nil, err
+}
+
+// startHandoverRelay starts the relay of the source on the DM-worker, and keeps it paused if it's paused by the user.
+func (s *Server) startHandoverRelay(source, worker string, stage pb.Stage) error {
+	if err := s.scheduler.StartRelay(source, []string{worker}); err != nil {
+		return err
+	}
+	if stage == pb.Stage_Paused {
+		return s.scheduler.UpdateExpectRelayStage(pb.Stage_Paused, source)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// OperateTaskLock implements MasterServer.OperateTaskLock.
+func (s *Server) OperateTaskLock(ctx context.Context, req *pb.OperateTaskLockRequest) (*pb.OperateTaskLockResponse, error) {
+	var (
+		resp2 *pb.OperateTaskLockResponse
+		err2  error
+	)
+	shouldRet := s.sharedLogic(ctx, req, &resp2, &err2)
+	if shouldRet {
+		return resp2, err2
+	}
+
+	resp := &pb.OperateTaskLockResponse{}
+	var err error
+	switch req.Op {
+	case pb.TaskLockOp_LockTask:
+		err = s.lockTask(req)
+	case pb.TaskLockOp_UnlockTask:
+		err = s.unlockTask(req)
+	case pb.TaskLockOp_ShowTaskLock:
+		resp.Locks, err = s.getTaskLocks(req.Task)
+	default:
+		err = terror.ErrMasterInvalidOperateOp.Generate(req.Op.String(), "task lock")
+	}
+	if err != nil {
+		resp.Msg = err.Error()
+		// nolint:nilerr
+		return resp, nil
+	}
+	if req.Op == pb.TaskLockOp_LockTask {
+		resp.Locks, _ = s.getTaskLocks(req.Task)
+	}
+	resp.Result = true
+	return resp, nil
+}
+
+// lockTask locks the task by the holder, the lock held by the same holder is renewed.
+func (s *Server) lockTask(req *pb.OperateTaskLockRequest) error {
+	switch {
+	case req.Task == "":
+		return terror.ErrMasterWorkerArgsExtractor.Generatef("must specify the task to lock")
+	case req.Holder == "":
+		return terror.ErrMasterWorkerArgsExtractor.Generatef("must specify the holder of the lock")
+	case req.Duration <= 0:
+		return terror.ErrMasterWorkerArgsExtractor.Generatef("the duration of the lock must be positive")
+	}
+	if len(s.getTaskResources(req.Task)) == 0 {
+		return terror.ErrMasterWorkerArgsExtractor.Generatef("task %s has no source or not exist", req.Task)
+	}
+
+	s.taskLockMu.Lock()
+	defer s.taskLockMu.Unlock()
+	now := time.Now()
+	l, ok, _, err := ha.GetTaskLock(s.etcdClient, req.Task)
+	if err != nil {
+		return err
+	}
+	if ok && !l.Expired(now) && l.Holder != req.Holder && !req.Force {
+		return taskLockedError(l)
+	}
+
+	l = ha.NewTaskLock(req.Task, req.Holder, req.Reason, now.Add(time.Duration(req.Duration)*time.Second))
+	if _, err = ha.PutTaskLock(s.etcdClient, l); err != nil {
+		return err
+	}
+	log.L().Info("task locked", zap.Stringer("lock", l), zap.Bool("force", req.Force))
+	return nil
+}
+
+// unlockTask releases the lock of the task, only the holder can release it unless forced.
+func (s *Server) unlockTask(req *pb.OperateTaskLockRequest) error {
+	if req.Task == "" {
+		return terror.ErrMasterWorkerArgsExtractor.Generatef("must specify the task to unlock")
+	}
+
+	s.taskLockMu.Lock()
+	defer s.taskLockMu.Unlock()
+	l, ok, _, err := ha.GetTaskLock(s.etcdClient, req.Task)
+	if err != nil {
+		return err
+	}
+	if !ok || l.Expired(time.Now()) {
+		if ok {
+			// clean up the expired lock.
+			if _, err = ha.DeleteTaskLock(s.etcdClient, req.Task); err != nil {
+				return err
+			}
+		}
+		return terror.ErrMasterTaskNotLocked.Generate(req.Task)
+	}
+	if l.Holder != req.Holder && !req.Force {
+		return taskLockedError(l)
+	}
+
+	if _, err = ha.DeleteTaskLock(s.etcdClient, req.Task); err != nil {
+		return err
+	}
+	log.L().Info("task unlocked", zap.Stringer("lock", l), zap.String("operator", req.Holder), zap.Bool("force", req.Force))
+	return nil
+}
+
+// checkTaskLock returns an error if the task is locked.
+func (s *Server) checkTaskLock(task string) error {
+	l, ok, _, err := ha.GetTaskLock(s.etcdClient, task)
+	if err != nil {
+		return err
+	}
+	if ok && !l.Expired(time.Now()) {
+		return taskLockedError(l)
+	}
+	return nil
+}
+
+// getTaskLocks returns the unexpired locks of the task, or of all tasks if task is empty.
+func (s *Server) getTaskLocks(task string) ([]*pb.TaskLock, error) {
+	var locks map[string]ha.TaskLock
+	if task != "" {
+		l, ok, _, err := ha.GetTaskLock(s.etcdClient, task)
+		if err != nil || !ok {
+			return nil, err
+		}
+		locks = map[string]ha.TaskLock{task: l}
+	} else {
+		var err error
+		locks, _, err = ha.GetAllTaskLock(s.etcdClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	ret := make([]*pb.TaskLock, 0, len(locks))
+	for _, l := range locks {
+		if l.Expired(now) {
+			continue
+		}
+		ret = append(ret, &pb.TaskLock{
+			Task:       l.Task,
+			Holder:     l.Holder,
+			Reason:     l.Reason,
+			ExpireTime: l.Expire.Format(time.RFC3339),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Task < ret[j].Task
+	})
+	return ret, nil
+}
+
+// getTaskLocksForStatus returns the locks shown in the status of the task, the error is only logged.
+func (s *Server) getTaskLocksForStatus(task string) []*pb.TaskLock {
+	locks, err := s.getTaskLocks(task)
+	if err != nil {
+		log.L().Warn("fail to get task locks", zap.String("task", task), log.ShortError(err))
+	}
+	return locks
+}
+
+func taskLockedError(l ha.TaskLock) error {
+	reason := l.Reason
+	if reason == "" {
+		reason = "no reason"
+	}
+	return terror.ErrMasterTaskLocked.Generate(l.Task, l.Holder, fmt.Sprintf("%q", reason), l.Expire.Format(time.RFC3339))
+}
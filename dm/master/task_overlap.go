@@ -0,0 +1,197 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/cputil"
+)
+
+// downstreamObject is a downstream table (or all tables of a schema if table is empty) written by a task.
+// the schema and table of a target table may be wildcard patterns like `shard_db_*`, or regular expressions starting
+// with `~` like the block-allow list.
+type downstreamObject struct {
+	schema string
+	table  string
+	desc   string // like "checkpoint table" or "target table"
+	meta   bool   // whether it's a meta table of DM
+}
+
+func (o downstreamObject) String() string {
+	if o.table == "" {
+		return fmt.Sprintf("%s `%s`.*", o.desc, o.schema)
+	}
+	return fmt.Sprintf("%s `%s`.`%s`", o.desc, o.schema, o.table)
+}
+
+// overlaps returns whether two objects may be the same downstream table.
+// names are compared case-insensitively because TiDB ignores the case of table names. a meta table is only compared
+// with the target tables in a schema without pattern, a pattern hardly matches the meta schema of DM by design.
+func (o downstreamObject) overlaps(other downstreamObject) bool {
+	if (o.meta && !other.meta && isNamePattern(other.schema)) || (other.meta && !o.meta && isNamePattern(o.schema)) {
+		return false
+	}
+	return namesOverlap(o.schema, other.schema) &&
+		(o.table == "" || other.table == "" || namesOverlap(o.table, other.table))
+}
+
+// isNamePattern returns whether the name is a wildcard pattern or a regular expression.
+func isNamePattern(name string) bool {
+	return strings.HasPrefix(name, "~") || strings.ContainsAny(name, "*?[")
+}
+
+// namesOverlap returns whether two names or patterns may match the same name. it's decided exactly if one of them is
+// a plain name. two wildcard patterns overlap if the prefix before the first wildcard of one is the prefix of the
+// other, and two regular expressions are assumed to overlap only if they are the same.
+func namesOverlap(a, b string) bool {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	if isNamePattern(b) && !isNamePattern(a) {
+		a, b = b, a
+	}
+	switch {
+	case !isNamePattern(b):
+		// b is a plain name.
+		if strings.HasPrefix(a, "~") {
+			re, err := regexp.Compile("(?i)" + a[1:])
+			return err != nil || re.MatchString(b)
+		}
+		matched, err := path.Match(a, b)
+		return err != nil || matched
+	case strings.HasPrefix(a, "~") || strings.HasPrefix(b, "~"):
+		return a == b
+	default:
+		prefixA, prefixB := a[:strings.IndexAny(a, "*?[")], b[:strings.IndexAny(b, "*?[")]
+		return strings.HasPrefix(prefixA, prefixB) || strings.HasPrefix(prefixB, prefixA)
+	}
+}
+
+// downstreamObjects returns the meta tables and the target tables written by the subtask.
+func downstreamObjects(cfg *config.SubTaskConfig) []downstreamObject {
+	objects := []downstreamObject{
+		{schema: cfg.MetaSchema, table: cputil.LoaderCheckpoint(cfg.Name), desc: "loader checkpoint table", meta: true},
+		{schema: cfg.MetaSchema, table: cputil.SyncerCheckpoint(cfg.Name), desc: "syncer checkpoint table", meta: true},
+		{schema: cfg.MetaSchema, table: cputil.SyncerShardMeta(cfg.Name), desc: "sharding meta table", meta: true},
+		{schema: cfg.MetaSchema, table: cputil.SyncerOnlineDDL(cfg.Name), desc: "online DDL table", meta: true},
+		{schema: cfg.MetaSchema, table: cputil.SyncerOversizedRow(cfg.Name), desc: "oversized row table", meta: true},
+		{schema: cfg.MetaSchema, table: cputil.SyncerApplySummary(cfg.Name), desc: "apply summary table", meta: true},
+		{schema: cfg.MetaSchema, table: cputil.SyncerAppliedGTID(cfg.Name), desc: "applied gtid table", meta: true},
+		{schema: cfg.MetaSchema, table: cputil.SyncerSchemaSnapshot(cfg.Name), desc: "schema snapshot table", meta: true},
+	}
+	return append(objects, targetObjects(cfg)...)
+}
+
+// targetObjects returns the target tables of the subtask. the upstream tables are derived from the block-allow list,
+// and they are routed by the route rules, the tables not routed keep their own names. the target tables of the route
+// rules are also returned, because the tables matched by them are not known. the unrouted tables of a subtask without
+// block-allow list are not known, so they are not returned.
+func targetObjects(cfg *config.SubTaskConfig) []downstreamObject {
+	objects := make([]downstreamObject, 0, len(cfg.RouteRules))
+	for _, rule := range cfg.RouteRules {
+		if rule == nil || rule.TargetSchema == "" {
+			continue
+		}
+		table := rule.TargetTable
+		if table == "" {
+			// table name is kept when target table is not specified
+			table = rule.TablePattern
+		}
+		objects = append(objects, downstreamObject{schema: rule.TargetSchema, table: table, desc: "target table"})
+	}
+
+	if cfg.BAList == nil {
+		return objects
+	}
+	var upstream []downstreamObject
+	if len(cfg.BAList.DoTables) > 0 {
+		for _, tb := range cfg.BAList.DoTables {
+			if tb != nil {
+				upstream = append(upstream, downstreamObject{schema: tb.Schema, table: tb.Name})
+			}
+		}
+	} else {
+		for _, db := range cfg.BAList.DoDBs {
+			upstream = append(upstream, downstreamObject{schema: db})
+		}
+	}
+	// the router matches the patterns of the block-allow list like names, so a rule with the same pattern routes them.
+	tableRouter, err := router.NewTableRouter(cfg.CaseSensitive, cfg.RouteRules)
+	if err != nil {
+		tableRouter = nil
+	}
+	for _, obj := range upstream {
+		if tableRouter != nil && !strings.HasPrefix(obj.schema, "~") && !strings.HasPrefix(obj.table, "~") {
+			schema, table := obj.schema, obj.table
+			if !cfg.CaseSensitive {
+				schema, table = strings.ToLower(schema), strings.ToLower(table)
+			}
+			if len(tableRouter.Match(schema, table)) > 0 {
+				// routed to the target tables of the rules.
+				continue
+			}
+		}
+		obj.desc = "target table"
+		objects = append(objects, obj)
+	}
+	return objects
+}
+
+// downstreamAddr returns the address of the downstream database of the subtask.
+func downstreamAddr(cfg *config.SubTaskConfig) string {
+	return fmt.Sprintf("%s:%d", strings.ToLower(cfg.To.Host), cfg.To.Port)
+}
+
+// checkTaskOverlap returns the conflicts between the downstream tables written by the new subtasks and
+// those of the existing tasks on the same downstream database, sorted and deduplicated.
+// the existing subtasks with the same task name are skipped, because they belong to the same task.
+func checkTaskOverlap(stCfgs []*config.SubTaskConfig, existing map[string]map[string]config.SubTaskConfig) []string {
+	conflictSet := make(map[string]struct{})
+	for _, stCfg := range stCfgs {
+		objects := downstreamObjects(stCfg)
+		addr := downstreamAddr(stCfg)
+		for task, sourceCfgs := range existing {
+			if task == stCfg.Name {
+				continue
+			}
+			for source := range sourceCfgs {
+				oldCfg := sourceCfgs[source]
+				if downstreamAddr(&oldCfg) != addr {
+					continue
+				}
+				for _, oldObj := range downstreamObjects(&oldCfg) {
+					for _, obj := range objects {
+						if obj.overlaps(oldObj) {
+							conflictSet[fmt.Sprintf("%s of source %s overlaps with %s of task %s source %s",
+								obj, stCfg.SourceID, oldObj, task, source)] = struct{}{}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	conflicts := make([]string, 0, len(conflictSet))
+	for conflict := range conflictSet {
+		conflicts = append(conflicts, conflict)
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
+
+	"github.com/pingcap/dm/dm/config"
+)
+
+func (t *testMaster) TestCheckTaskOverlap(c *C) {
+	newSubTaskCfg := func(task, source, host, metaSchema string, routes ...*router.TableRule) config.SubTaskConfig {
+		return config.SubTaskConfig{
+			Name:       task,
+			SourceID:   source,
+			MetaSchema: metaSchema,
+			To:         config.DBConfig{Host: host, Port: 4000},
+			RouteRules: routes,
+		}
+	}
+	routeTable := &router.TableRule{SchemaPattern: "shard_db_*", TablePattern: "shard_table_*", TargetSchema: "db", TargetTable: "tbl"}
+	routeSchema := &router.TableRule{SchemaPattern: "other_db_*", TargetSchema: "db"}
+	routeOther := &router.TableRule{SchemaPattern: "shard_db_*", TablePattern: "shard_table_*", TargetSchema: "db", TargetTable: "tbl2"}
+
+	existing := map[string]map[string]config.SubTaskConfig{
+		"task1": {
+			"mysql-replica-01": newSubTaskCfg("task1", "mysql-replica-01", "127.0.0.1", "dm_meta", routeTable),
+		},
+	}
+
+	// no overlap
+	cfg := newSubTaskCfg("task2", "mysql-replica-02", "127.0.0.1", "dm_meta", routeOther)
+	c.Assert(checkTaskOverlap([]*config.SubTaskConfig{&cfg}, existing), HasLen, 0)
+
+	// same task is skipped
+	cfg = newSubTaskCfg("task1", "mysql-replica-02", "127.0.0.1", "dm_meta", routeTable)
+	c.Assert(checkTaskOverlap([]*config.SubTaskConfig{&cfg}, existing), HasLen, 0)
+
+	// different downstream
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", "127.0.0.2", "dm_meta", routeTable)
+	c.Assert(checkTaskOverlap([]*config.SubTaskConfig{&cfg}, existing), HasLen, 0)
+
+	// same target table
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", "127.0.0.1", "dm_meta", routeTable)
+	c.Assert(checkTaskOverlap([]*config.SubTaskConfig{&cfg}, existing), DeepEquals, []string{
+		"target table `db`.`tbl` of source mysql-replica-02 overlaps with target table `db`.`tbl` of task task1 source mysql-replica-01",
+	})
+
+	// all tables in the target schema
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", "127.0.0.1", "dm_meta", routeSchema)
+	c.Assert(checkTaskOverlap([]*config.SubTaskConfig{&cfg}, existing), DeepEquals, []string{
+		"target table `db`.* of source mysql-replica-02 overlaps with target table `db`.`tbl` of task task1 source mysql-replica-01",
+	})
+
+	// task names only differ in case share the same checkpoint tables
+	cfg = newSubTaskCfg("TASK1", "mysql-replica-02", "127.0.0.1", "DM_META")
+	conflicts := checkTaskOverlap([]*config.SubTaskConfig{&cfg}, existing)
+	c.Assert(conflicts, HasLen, 8)
+	c.Assert(conflicts[7], Equals, "syncer checkpoint table `DM_META`.`TASK1_syncer_checkpoint` of source mysql-replica-02 overlaps with syncer checkpoint table `dm_meta`.`task1_syncer_checkpoint` of task task1 source mysql-replica-01")
+
+	// route into the meta schema of another task
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", "127.0.0.1", "dm_meta2", &router.TableRule{SchemaPattern: "meta", TargetSchema: "dm_meta"})
+	c.Assert(checkTaskOverlap([]*config.SubTaskConfig{&cfg}, existing), HasLen, 8)
+}
+
+func (t *testMaster) TestCheckTaskOverlapUnrouted(c *C) {
+	newSubTaskCfg := func(task, source string, baList *filter.Rules, routes ...*router.TableRule) *config.SubTaskConfig {
+		return &config.SubTaskConfig{
+			Name:       task,
+			SourceID:   source,
+			MetaSchema: "dm_meta",
+			To:         config.DBConfig{Host: "127.0.0.1", Port: 4000},
+			BAList:     baList,
+			RouteRules: routes,
+		}
+	}
+	checkOverlap := func(cfg, oldCfg *config.SubTaskConfig) []string {
+		return checkTaskOverlap([]*config.SubTaskConfig{cfg}, map[string]map[string]config.SubTaskConfig{
+			oldCfg.Name: {oldCfg.SourceID: *oldCfg},
+		})
+	}
+
+	// two tasks replicate the same tables without routes.
+	oldCfg := newSubTaskCfg("task1", "mysql-replica-01", &filter.Rules{DoDBs: []string{"db1", "db2"}})
+	cfg := newSubTaskCfg("task2", "mysql-replica-02", &filter.Rules{DoDBs: []string{"DB1"}})
+	c.Assert(checkOverlap(cfg, oldCfg), DeepEquals, []string{
+		"target table `DB1`.* of source mysql-replica-02 overlaps with target table `db1`.* of task task1 source mysql-replica-01",
+	})
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", &filter.Rules{DoDBs: []string{"db3"}})
+	c.Assert(checkOverlap(cfg, oldCfg), HasLen, 0)
+
+	// tables are compared with the wildcards and regular expressions of the block-allow list.
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", &filter.Rules{DoTables: []*filter.Table{{Schema: "db*", Name: "tb"}}})
+	c.Assert(checkOverlap(cfg, oldCfg), HasLen, 2)
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", &filter.Rules{DoDBs: []string{"~^db[0-9]$"}})
+	c.Assert(checkOverlap(cfg, oldCfg), HasLen, 2)
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", &filter.Rules{DoDBs: []string{"~^other_.*"}})
+	c.Assert(checkOverlap(cfg, oldCfg), HasLen, 0)
+	oldCfg = newSubTaskCfg("task1", "mysql-replica-01", &filter.Rules{DoDBs: []string{"shard_*"}})
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", &filter.Rules{DoDBs: []string{"shard_db_*"}})
+	c.Assert(checkOverlap(cfg, oldCfg), HasLen, 1)
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", &filter.Rules{DoDBs: []string{"other_*"}})
+	c.Assert(checkOverlap(cfg, oldCfg), HasLen, 0)
+
+	// the routed tables are replaced by the targets of the route rules.
+	oldCfg = newSubTaskCfg("task1", "mysql-replica-01", &filter.Rules{DoTables: []*filter.Table{{Schema: "shard_db_1", Name: "tb"}}},
+		&router.TableRule{SchemaPattern: "shard_db_*", TablePattern: "tb", TargetSchema: "db", TargetTable: "tb"})
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", &filter.Rules{DoTables: []*filter.Table{{Schema: "shard_db_1", Name: "tb"}}})
+	c.Assert(checkOverlap(cfg, oldCfg), HasLen, 0)
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", &filter.Rules{DoDBs: []string{"db"}})
+	c.Assert(checkOverlap(cfg, oldCfg), DeepEquals, []string{
+		"target table `db`.* of source mysql-replica-02 overlaps with target table `db`.`tb` of task task1 source mysql-replica-01",
+	})
+
+	// the unrouted tables of a task without block-allow list are not known.
+	cfg = newSubTaskCfg("task2", "mysql-replica-02", nil)
+	c.Assert(checkOverlap(cfg, oldCfg), HasLen, 0)
+}
@@ -0,0 +1,257 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// defaultWatchTaskStatusInterval is the default interval to check the status of the watched subtasks.
+const defaultWatchTaskStatusInterval = 5 * time.Second
+
+// WatchTaskStatus implements MasterServer.WatchTaskStatus.
+// the status of the subtasks is checked periodically by the leader, and only the changed subtasks are streamed.
+// the followers forward the stream to the leader, and the errors are sent in the last response like BackupEtcd.
+func (s *Server) WatchTaskStatus(req *pb.WatchTaskStatusRequest, stream pb.Master_WatchTaskStatusServer) error {
+	ctx := stream.Context()
+	log.L().Info("", zap.Any("payload", req), zap.String("request", "WatchTaskStatus"))
+	if err := s.clientLimiter.allow(ctx, "WatchTaskStatus"); err != nil {
+		return err
+	}
+
+	interval := defaultWatchTaskStatusInterval
+	if req.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(req.Interval)
+		if err == nil && interval <= 0 {
+			err = errors.New("should be positive")
+		}
+		if err != nil {
+			return sendWatchTaskStatusError(stream, fmt.Errorf("invalid interval %s: %w", req.Interval, err))
+		}
+	}
+
+	isLeader, needForward := s.isLeaderAndNeedForward(ctx)
+	if !isLeader {
+		if needForward {
+			return s.forwardWatchTaskStatus(ctx, req, stream)
+		}
+		return terror.ErrMasterRequestIsNotForwardToLeader
+	}
+
+	watcher := newTaskStatusWatcher(req.Tasks, req.LagThreshold)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for first := true; ; first = false {
+		changes := watcher.update(s.queryWatchedStatus(ctx, req.Tasks))
+		if first || len(changes) > 0 {
+			if err := stream.Send(&pb.WatchTaskStatusResponse{
+				Result:  true,
+				Time:    time.Now().Format(time.RFC3339),
+				Changes: changes,
+			}); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if s.leader.Load() != oneselfLeader {
+			return sendWatchTaskStatusError(stream, errors.New("the DM-master is not the leader any more, please watch again"))
+		}
+	}
+}
+
+// forwardWatchTaskStatus forwards the stream from the leader to the client.
+func (s *Server) forwardWatchTaskStatus(ctx context.Context, req *pb.WatchTaskStatusRequest, stream pb.Master_WatchTaskStatusServer) error {
+	log.L().Info("forward the stream", zap.String("from", s.cfg.Name), zap.String("to", s.leader.Load()), zap.String("request", "WatchTaskStatus"))
+	leaderStream, err := s.leaderClient.WatchTaskStatus(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := leaderStream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// sendWatchTaskStatusError sends err to the client, the stream may be already broken so the error of sending is ignored.
+func sendWatchTaskStatusError(stream pb.Master_WatchTaskStatusServer, err error) error {
+	log.L().Warn("fail to watch the status of tasks", log.ShortError(err))
+	_ = stream.Send(&pb.WatchTaskStatusResponse{Msg: err.Error(), Time: time.Now().Format(time.RFC3339)})
+	return nil
+}
+
+// queryWatchedStatus queries the status of the subtasks of the tasks from DM-workers, or all the subtasks if tasks
+// is empty.
+func (s *Server) queryWatchedStatus(ctx context.Context, tasks []string) []*pb.QueryStatusResponse {
+	var (
+		sources  []string
+		taskName string
+	)
+	if len(tasks) == 0 {
+		sources = s.scheduler.BoundSources()
+	} else {
+		sourceSet := make(map[string]struct{})
+		for _, task := range tasks {
+			for _, source := range s.getTaskResources(task) {
+				if _, ok := sourceSet[source]; !ok {
+					sourceSet[source] = struct{}{}
+					sources = append(sources, source)
+				}
+			}
+		}
+		if len(tasks) == 1 {
+			taskName = tasks[0]
+		}
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+
+	ctx2, cancel := context.WithTimeout(ctx, s.cfg.RPCTimeout)
+	defer cancel()
+	return s.getStatusFromWorkers(ctx2, sources, taskName, false)
+}
+
+// taskStatusWatcher finds the changes of the status of the subtasks.
+type taskStatusWatcher struct {
+	tasks        map[string]struct{} // empty for all tasks
+	lagThreshold int64
+	last         map[string]*pb.SubTaskStatusChange // task/source -> the last streamed status
+}
+
+func newTaskStatusWatcher(tasks []string, lagThreshold int64) *taskStatusWatcher {
+	w := &taskStatusWatcher{
+		tasks:        make(map[string]struct{}, len(tasks)),
+		lagThreshold: lagThreshold,
+		last:         make(map[string]*pb.SubTaskStatusChange),
+	}
+	for _, task := range tasks {
+		w.tasks[task] = struct{}{}
+	}
+	return w
+}
+
+// update compares the status in the responses with the last streamed status and returns the changes. the subtasks
+// of the sources failing to query are kept as they were, because their status is unknown.
+func (w *taskStatusWatcher) update(resps []*pb.QueryStatusResponse) []*pb.SubTaskStatusChange {
+	var (
+		changes []*pb.SubTaskStatusChange
+		seen    = make(map[string]struct{})
+		failed  = make(map[string]struct{})
+	)
+	for _, resp := range resps {
+		if resp.SourceStatus == nil {
+			continue
+		}
+		source := resp.SourceStatus.Source
+		if !resp.Result {
+			failed[source] = struct{}{}
+			continue
+		}
+		for _, st := range resp.SubTaskStatus {
+			if st == nil || st.Name == "" || st.Stage == pb.Stage_InvalidStage {
+				continue
+			}
+			if _, ok := w.tasks[st.Name]; len(w.tasks) > 0 && !ok {
+				continue
+			}
+			cur := subTaskStatusChange(source, resp.SourceStatus.Worker, st)
+			key := st.Name + "/" + source
+			seen[key] = struct{}{}
+			if prev, ok := w.last[key]; ok && !w.changed(prev, cur) {
+				continue
+			}
+			w.last[key] = cur
+			changes = append(changes, cur)
+		}
+	}
+
+	var removed []string
+	for key, prev := range w.last {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		if _, ok := failed[prev.Source]; ok {
+			continue
+		}
+		removed = append(removed, key)
+	}
+	sort.Strings(removed)
+	for _, key := range removed {
+		prev := w.last[key]
+		delete(w.last, key)
+		changes = append(changes, &pb.SubTaskStatusChange{Task: prev.Task, Source: prev.Source, Removed: true})
+	}
+	return changes
+}
+
+// changed returns whether the status is changed, the changes of the lag less than the threshold are ignored.
+func (w *taskStatusWatcher) changed(prev, cur *pb.SubTaskStatusChange) bool {
+	if prev.Worker != cur.Worker || prev.Stage != cur.Stage || prev.Unit != cur.Unit || len(prev.Errors) != len(cur.Errors) {
+		return true
+	}
+	for i := range prev.Errors {
+		if prev.Errors[i] != cur.Errors[i] {
+			return true
+		}
+	}
+	delta := cur.SecondsBehindMaster - prev.SecondsBehindMaster
+	if delta < 0 {
+		delta = -delta
+	}
+	return delta > 0 && delta >= w.lagThreshold
+}
+
+func subTaskStatusChange(source, worker string, st *pb.SubTaskStatus) *pb.SubTaskStatusChange {
+	change := &pb.SubTaskStatusChange{
+		Task:   st.Name,
+		Source: source,
+		Worker: worker,
+		Stage:  st.Stage,
+		Unit:   st.Unit,
+	}
+	if status, ok := st.Status.(*pb.SubTaskStatus_Sync); ok && status.Sync != nil {
+		change.SecondsBehindMaster = status.Sync.SecondsBehindMaster
+	}
+	if st.Result != nil {
+		for _, e := range st.Result.Errors {
+			change.Errors = append(change.Errors, e.Message)
+		}
+	}
+	return change
+}
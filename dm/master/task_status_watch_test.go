@@ -0,0 +1,127 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+
+	"github.com/pingcap/check"
+	"google.golang.org/grpc"
+
+	"github.com/pingcap/dm/dm/pb"
+)
+
+// mockWatchTaskStatusStream records the responses of WatchTaskStatus.
+type mockWatchTaskStatusStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	resps  []*pb.WatchTaskStatusResponse
+	onSend func(*pb.WatchTaskStatusResponse)
+}
+
+func (m *mockWatchTaskStatusStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockWatchTaskStatusStream) Send(resp *pb.WatchTaskStatusResponse) error {
+	m.resps = append(m.resps, resp)
+	if m.onSend != nil {
+		m.onSend(resp)
+	}
+	return nil
+}
+
+func syncStatusResp(source, worker string, subTasks ...*pb.SubTaskStatus) *pb.QueryStatusResponse {
+	return &pb.QueryStatusResponse{
+		Result:        true,
+		SourceStatus:  &pb.SourceStatus{Source: source, Worker: worker},
+		SubTaskStatus: subTasks,
+	}
+}
+
+func syncSubTask(task string, stage pb.Stage, lag int64, errs ...string) *pb.SubTaskStatus {
+	st := &pb.SubTaskStatus{
+		Name:   task,
+		Stage:  stage,
+		Unit:   pb.UnitType_Sync,
+		Status: &pb.SubTaskStatus_Sync{Sync: &pb.SyncStatus{SecondsBehindMaster: lag}},
+	}
+	if len(errs) > 0 {
+		st.Result = &pb.ProcessResult{}
+		for _, e := range errs {
+			st.Result.Errors = append(st.Result.Errors, &pb.ProcessError{Message: e})
+		}
+	}
+	return st
+}
+
+func (t *testMaster) TestTaskStatusWatcher(c *check.C) {
+	w := newTaskStatusWatcher([]string{"task1", "task2"}, 10)
+
+	// all the watched subtasks are returned at first.
+	changes := w.update([]*pb.QueryStatusResponse{
+		syncStatusResp("source1", "worker1", syncSubTask("task1", pb.Stage_Running, 0), syncSubTask("task3", pb.Stage_Running, 0)),
+		syncStatusResp("source2", "worker2", syncSubTask("task1", pb.Stage_Running, 5), syncSubTask("task2", pb.Stage_Running, 0)),
+	})
+	c.Assert(changes, check.HasLen, 3)
+	c.Assert(changes[1], check.DeepEquals, &pb.SubTaskStatusChange{
+		Task: "task1", Source: "source2", Worker: "worker2", Stage: pb.Stage_Running, Unit: pb.UnitType_Sync, SecondsBehindMaster: 5,
+	})
+
+	// the small changes of the lag are ignored, and the subtasks of the failed source are kept.
+	changes = w.update([]*pb.QueryStatusResponse{
+		syncStatusResp("source1", "worker1", syncSubTask("task1", pb.Stage_Running, 9)),
+		{Result: false, Msg: "timeout", SourceStatus: &pb.SourceStatus{Source: "source2"}},
+	})
+	c.Assert(changes, check.HasLen, 0)
+
+	changes = w.update([]*pb.QueryStatusResponse{
+		syncStatusResp("source1", "worker1", syncSubTask("task1", pb.Stage_Running, 10)),
+		syncStatusResp("source2", "worker3", syncSubTask("task2", pb.Stage_Paused, 0, "error")),
+	})
+	c.Assert(changes, check.HasLen, 3)
+	c.Assert(changes[0].SecondsBehindMaster, check.Equals, int64(10))
+	c.Assert(changes[1].Worker, check.Equals, "worker3")
+	c.Assert(changes[1].Stage, check.Equals, pb.Stage_Paused)
+	c.Assert(changes[1].Errors, check.DeepEquals, []string{"error"})
+	c.Assert(changes[2], check.DeepEquals, &pb.SubTaskStatusChange{Task: "task1", Source: "source2", Removed: true})
+
+	// nothing changes.
+	changes = w.update([]*pb.QueryStatusResponse{
+		syncStatusResp("source1", "worker1", syncSubTask("task1", pb.Stage_Running, 1)),
+		syncStatusResp("source2", "worker3", syncSubTask("task2", pb.Stage_Paused, 0, "error")),
+	})
+	c.Assert(changes, check.HasLen, 0)
+}
+
+func (t *testMaster) TestWatchTaskStatus(c *check.C) {
+	server := testDefaultMasterServer(c)
+
+	stream := &mockWatchTaskStatusStream{ctx: context.Background()}
+	c.Assert(server.WatchTaskStatus(&pb.WatchTaskStatusRequest{Interval: "-1s"}, stream), check.IsNil)
+	c.Assert(stream.resps, check.HasLen, 1)
+	c.Assert(stream.resps[0].Result, check.IsFalse)
+	c.Assert(stream.resps[0].Msg, check.Matches, "invalid interval -1s.*")
+
+	// the first response is sent even if there is no subtask.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream = &mockWatchTaskStatusStream{ctx: ctx, onSend: func(*pb.WatchTaskStatusResponse) {
+		cancel()
+	}}
+	c.Assert(server.WatchTaskStatus(&pb.WatchTaskStatusRequest{Tasks: []string{"not-exist"}}, stream), check.IsNil)
+	c.Assert(stream.resps, check.HasLen, 1)
+	c.Assert(stream.resps[0].Result, check.IsTrue)
+	c.Assert(stream.resps[0].Changes, check.HasLen, 0)
+}
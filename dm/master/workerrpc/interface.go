@@ -47,6 +47,9 @@ const (
 	CmdOperateV1Meta
 	CmdHandleError
 	CmdGetWorkerCfg
+	CmdCleanOrphanSubTasks
+	CmdValidateConnectivity
+	CmdQuarantineTable
 )
 
 // Request wraps all dm-worker rpc requests.
@@ -62,6 +65,11 @@ type Request struct {
 	OperateV1Meta *pb.OperateV1MetaRequest
 	HandleError   *pb.HandleWorkerErrorRequest
 	GetWorkerCfg  *pb.GetWorkerCfgRequest
+
+	CleanOrphanSubTasks  *pb.CleanOrphanSubTasksRequest
+	ValidateConnectivity *pb.ValidateConnectivityRequest
+	QuarantineTable      *pb.QuarantineWorkerTableRequest
+	UpdateSubTask        *pb.UpdateSubTaskRequest
 }
 
 // Response wraps all dm-worker rpc responses.
@@ -77,6 +85,11 @@ type Response struct {
 	OperateV1Meta *pb.OperateV1MetaResponse
 	HandleError   *pb.CommonWorkerResponse
 	GetWorkerCfg  *pb.GetWorkerCfgResponse
+
+	CleanOrphanSubTasks  *pb.CleanOrphanSubTasksResponse
+	ValidateConnectivity *pb.ValidateConnectivityResponse
+	QuarantineTable      *pb.CommonWorkerResponse
+	UpdateSubTask        *pb.CommonWorkerResponse
 }
 
 // Client is a client that sends RPC.
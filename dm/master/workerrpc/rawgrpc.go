@@ -118,6 +118,14 @@ func callRPC(ctx context.Context, client pb.WorkerClient, req *Request) (*Respon
 		resp.HandleError, err = client.HandleError(ctx, req.HandleError)
 	case CmdGetWorkerCfg:
 		resp.GetWorkerCfg, err = client.GetWorkerCfg(ctx, req.GetWorkerCfg)
+	case CmdCleanOrphanSubTasks:
+		resp.CleanOrphanSubTasks, err = client.CleanOrphanSubTasks(ctx, req.CleanOrphanSubTasks)
+	case CmdValidateConnectivity:
+		resp.ValidateConnectivity, err = client.ValidateConnectivity(ctx, req.ValidateConnectivity)
+	case CmdQuarantineTable:
+		resp.QuarantineTable, err = client.QuarantineTable(ctx, req.QuarantineTable)
+	case CmdUpdateSubTask:
+		resp.UpdateSubTask, err = client.UpdateSubTask(ctx, req.UpdateSubTask)
 	default:
 		return nil, terror.ErrMasterGRPCInvalidReqType.Generate(req.Type)
 	}
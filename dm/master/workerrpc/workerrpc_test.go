@@ -76,6 +76,10 @@ func (t *testWorkerRPCSuite) TestGRPCClient(c *C) {
 			Type:        CmdHandleError,
 			HandleError: &pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Replace},
 		},
+		{
+			Type:          CmdUpdateSubTask,
+			UpdateSubTask: &pb.UpdateSubTaskRequest{DryRun: true},
+		},
 	}
 
 	workerCli.EXPECT().QueryStatus(gomock.Any(), reqs[0].QueryStatus)
@@ -83,6 +87,7 @@ func (t *testWorkerRPCSuite) TestGRPCClient(c *C) {
 	workerCli.EXPECT().OperateSchema(gomock.Any(), reqs[2].OperateSchema)
 	workerCli.EXPECT().OperateV1Meta(gomock.Any(), reqs[3].OperateV1Meta)
 	workerCli.EXPECT().HandleError(gomock.Any(), reqs[4].HandleError)
+	workerCli.EXPECT().UpdateSubTask(gomock.Any(), reqs[5].UpdateSubTask)
 
 	// others cmds are not supported.
 	// NOTE: update the end cmd in the below `for` loop when adding new cmds.
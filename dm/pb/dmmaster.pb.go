@@ -36,6 +36,8 @@ const (
 	SourceOp_UpdateSource    SourceOp = 2
 	SourceOp_StopSource      SourceOp = 3
 	SourceOp_ShowSource      SourceOp = 4
+	SourceOp_DisableSource   SourceOp = 5
+	SourceOp_EnableSource    SourceOp = 6
 )
 
 var SourceOp_name = map[int32]string{
@@ -44,6 +46,8 @@ var SourceOp_name = map[int32]string{
 	2: "UpdateSource",
 	3: "StopSource",
 	4: "ShowSource",
+	5: "DisableSource",
+	6: "EnableSource",
 }
 
 var SourceOp_value = map[string]int32{
@@ -52,6 +56,8 @@ var SourceOp_value = map[string]int32{
 	"UpdateSource":    2,
 	"StopSource":      3,
 	"ShowSource":      4,
+	"DisableSource":   5,
+	"EnableSource":    6,
 }
 
 func (x SourceOp) String() string {
@@ -152,10 +158,112 @@ func (RelayOpV2) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f9bef11f2a341f03, []int{3}
 }
 
+type TaskLockOp int32
+
+const (
+	TaskLockOp_InvalidTaskLockOp TaskLockOp = 0
+	TaskLockOp_LockTask          TaskLockOp = 1
+	TaskLockOp_UnlockTask        TaskLockOp = 2
+	TaskLockOp_ShowTaskLock      TaskLockOp = 3
+)
+
+var TaskLockOp_name = map[int32]string{
+	0: "InvalidTaskLockOp",
+	1: "LockTask",
+	2: "UnlockTask",
+	3: "ShowTaskLock",
+}
+
+var TaskLockOp_value = map[string]int32{
+	"InvalidTaskLockOp": 0,
+	"LockTask":          1,
+	"UnlockTask":        2,
+	"ShowTaskLock":      3,
+}
+
+func (x TaskLockOp) String() string {
+	return proto.EnumName(TaskLockOp_name, int32(x))
+}
+
+func (TaskLockOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{4}
+}
+
+type ProjectOp int32
+
+const (
+	ProjectOp_InvalidProjectOp ProjectOp = 0
+	ProjectOp_CreateProject    ProjectOp = 1
+	ProjectOp_DeleteProject    ProjectOp = 2
+	ProjectOp_ShowProject      ProjectOp = 3
+)
+
+var ProjectOp_name = map[int32]string{
+	0: "InvalidProjectOp",
+	1: "CreateProject",
+	2: "DeleteProject",
+	3: "ShowProject",
+}
+
+var ProjectOp_value = map[string]int32{
+	"InvalidProjectOp": 0,
+	"CreateProject":    1,
+	"DeleteProject":    2,
+	"ShowProject":      3,
+}
+
+func (x ProjectOp) String() string {
+	return proto.EnumName(ProjectOp_name, int32(x))
+}
+
+func (ProjectOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{5}
+}
+
+type UpgradeClusterOp int32
+
+const (
+	UpgradeClusterOp_InvalidUpgradeClusterOp UpgradeClusterOp = 0
+	UpgradeClusterOp_StartUpgrade            UpgradeClusterOp = 1
+	UpgradeClusterOp_PrepareWorkerUpgrade    UpgradeClusterOp = 2
+	UpgradeClusterOp_ResumeWorkerUpgrade     UpgradeClusterOp = 3
+	UpgradeClusterOp_FinishUpgrade           UpgradeClusterOp = 4
+	UpgradeClusterOp_ShowUpgrade             UpgradeClusterOp = 5
+)
+
+var UpgradeClusterOp_name = map[int32]string{
+	0: "InvalidUpgradeClusterOp",
+	1: "StartUpgrade",
+	2: "PrepareWorkerUpgrade",
+	3: "ResumeWorkerUpgrade",
+	4: "FinishUpgrade",
+	5: "ShowUpgrade",
+}
+
+var UpgradeClusterOp_value = map[string]int32{
+	"InvalidUpgradeClusterOp": 0,
+	"StartUpgrade":            1,
+	"PrepareWorkerUpgrade":    2,
+	"ResumeWorkerUpgrade":     3,
+	"FinishUpgrade":           4,
+	"ShowUpgrade":             5,
+}
+
+func (x UpgradeClusterOp) String() string {
+	return proto.EnumName(UpgradeClusterOp_name, int32(x))
+}
+
+func (UpgradeClusterOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{6}
+}
+
 type StartTaskRequest struct {
-	Task       string   `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
-	Sources    []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
-	RemoveMeta bool     `protobuf:"varint,3,opt,name=removeMeta,proto3" json:"removeMeta,omitempty"`
+	Task         string   `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Sources      []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	RemoveMeta   bool     `protobuf:"varint,3,opt,name=removeMeta,proto3" json:"removeMeta,omitempty"`
+	AllowOverlap bool     `protobuf:"varint,4,opt,name=allowOverlap,proto3" json:"allowOverlap,omitempty"`
+	StartTime    string   `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	DryRun       bool     `protobuf:"varint,6,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
 }
 
 func (m *StartTaskRequest) Reset()         { *m = StartTaskRequest{} }
@@ -212,6 +320,27 @@ func (m *StartTaskRequest) GetRemoveMeta() bool {
 	return false
 }
 
+func (m *StartTaskRequest) GetAllowOverlap() bool {
+	if m != nil {
+		return m.AllowOverlap
+	}
+	return false
+}
+
+func (m *StartTaskRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *StartTaskRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type StartTaskResponse struct {
 	Result  bool                    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg     string                  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -273,9 +402,11 @@ func (m *StartTaskResponse) GetSources() []*CommonWorkerResponse {
 }
 
 type OperateTaskRequest struct {
-	Op      TaskOp   `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskOp" json:"op,omitempty"`
-	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Sources []string `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	Op               TaskOp   `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskOp" json:"op,omitempty"`
+	Name             string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sources          []string `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	ResetErrorBudget bool     `protobuf:"varint,4,opt,name=resetErrorBudget,proto3" json:"resetErrorBudget,omitempty"`
+	Force            bool     `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
 }
 
 func (m *OperateTaskRequest) Reset()         { *m = OperateTaskRequest{} }
@@ -332,6 +463,20 @@ func (m *OperateTaskRequest) GetSources() []string {
 	return nil
 }
 
+func (m *OperateTaskRequest) GetResetErrorBudget() bool {
+	if m != nil {
+		return m.ResetErrorBudget
+	}
+	return false
+}
+
+func (m *OperateTaskRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type OperateTaskResponse struct {
 	Op      TaskOp                  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskOp" json:"op,omitempty"`
 	Result  bool                    `protobuf:"varint,2,opt,name=result,proto3" json:"result,omitempty"`
@@ -520,6 +665,7 @@ func (m *UpdateTaskResponse) GetSources() []*CommonWorkerResponse {
 type QueryStatusListRequest struct {
 	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Sources []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	Since   string   `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
 }
 
 func (m *QueryStatusListRequest) Reset()         { *m = QueryStatusListRequest{} }
@@ -569,10 +715,19 @@ func (m *QueryStatusListRequest) GetSources() []string {
 	return nil
 }
 
+func (m *QueryStatusListRequest) GetSince() string {
+	if m != nil {
+		return m.Since
+	}
+	return ""
+}
+
 type QueryStatusListResponse struct {
-	Result  bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Msg     string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Sources []*QueryStatusResponse `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	Result          bool                      `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg             string                    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Sources         []*QueryStatusResponse    `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	Locks           []*TaskLock               `protobuf:"bytes,4,rep,name=locks,proto3" json:"locks,omitempty"`
+	ProgressHistory []*SubTaskProgressHistory `protobuf:"bytes,5,rep,name=progressHistory,proto3" json:"progressHistory,omitempty"`
 }
 
 func (m *QueryStatusListResponse) Reset()         { *m = QueryStatusListResponse{} }
@@ -629,6 +784,221 @@ func (m *QueryStatusListResponse) GetSources() []*QueryStatusResponse {
 	return nil
 }
 
+func (m *QueryStatusListResponse) GetLocks() []*TaskLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *QueryStatusListResponse) GetProgressHistory() []*SubTaskProgressHistory {
+	if m != nil {
+		return m.ProgressHistory
+	}
+	return nil
+}
+
+// SubTaskProgress represents the progress of a subtask at a time.
+// location: the binlog location replicated by the sync unit, or the progress of the load unit
+// rows: the total rows replicated by the sync unit, or the rows restored by the load unit
+type SubTaskProgress struct {
+	Time     string   `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Stage    Stage    `protobuf:"varint,2,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
+	Unit     UnitType `protobuf:"varint,3,opt,name=unit,proto3,enum=pb.UnitType" json:"unit,omitempty"`
+	Location string   `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Gtid     string   `protobuf:"bytes,5,opt,name=gtid,proto3" json:"gtid,omitempty"`
+	Rows     int64    `protobuf:"varint,6,opt,name=rows,proto3" json:"rows,omitempty"`
+	Errors   []string `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (m *SubTaskProgress) Reset()         { *m = SubTaskProgress{} }
+func (m *SubTaskProgress) String() string { return proto.CompactTextString(m) }
+func (*SubTaskProgress) ProtoMessage()    {}
+func (*SubTaskProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{8}
+}
+func (m *SubTaskProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubTaskProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubTaskProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubTaskProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubTaskProgress.Merge(m, src)
+}
+func (m *SubTaskProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubTaskProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubTaskProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubTaskProgress proto.InternalMessageInfo
+
+func (m *SubTaskProgress) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *SubTaskProgress) GetStage() Stage {
+	if m != nil {
+		return m.Stage
+	}
+	return Stage_InvalidStage
+}
+
+func (m *SubTaskProgress) GetUnit() UnitType {
+	if m != nil {
+		return m.Unit
+	}
+	return UnitType_InvalidUnit
+}
+
+func (m *SubTaskProgress) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *SubTaskProgress) GetGtid() string {
+	if m != nil {
+		return m.Gtid
+	}
+	return ""
+}
+
+func (m *SubTaskProgress) GetRows() int64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *SubTaskProgress) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+// SubTaskProgressHistory compares the progress of a subtask within a time window.
+// first: the earliest progress in the window
+// last: the current progress
+// moved: whether the location or the unit changed within the window
+// rowsApplied: the rows replicated or restored within the window
+// errors: the distinct errors encountered within the window
+type SubTaskProgressHistory struct {
+	Task        string           `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Source      string           `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	First       *SubTaskProgress `protobuf:"bytes,3,opt,name=first,proto3" json:"first,omitempty"`
+	Last        *SubTaskProgress `protobuf:"bytes,4,opt,name=last,proto3" json:"last,omitempty"`
+	Moved       bool             `protobuf:"varint,5,opt,name=moved,proto3" json:"moved,omitempty"`
+	RowsApplied int64            `protobuf:"varint,6,opt,name=rowsApplied,proto3" json:"rowsApplied,omitempty"`
+	Errors      []string         `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	Samples     int64            `protobuf:"varint,8,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *SubTaskProgressHistory) Reset()         { *m = SubTaskProgressHistory{} }
+func (m *SubTaskProgressHistory) String() string { return proto.CompactTextString(m) }
+func (*SubTaskProgressHistory) ProtoMessage()    {}
+func (*SubTaskProgressHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{9}
+}
+func (m *SubTaskProgressHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubTaskProgressHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubTaskProgressHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubTaskProgressHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubTaskProgressHistory.Merge(m, src)
+}
+func (m *SubTaskProgressHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubTaskProgressHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubTaskProgressHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubTaskProgressHistory proto.InternalMessageInfo
+
+func (m *SubTaskProgressHistory) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
+}
+
+func (m *SubTaskProgressHistory) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *SubTaskProgressHistory) GetFirst() *SubTaskProgress {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *SubTaskProgressHistory) GetLast() *SubTaskProgress {
+	if m != nil {
+		return m.Last
+	}
+	return nil
+}
+
+func (m *SubTaskProgressHistory) GetMoved() bool {
+	if m != nil {
+		return m.Moved
+	}
+	return false
+}
+
+func (m *SubTaskProgressHistory) GetRowsApplied() int64 {
+	if m != nil {
+		return m.RowsApplied
+	}
+	return 0
+}
+
+func (m *SubTaskProgressHistory) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *SubTaskProgressHistory) GetSamples() int64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
 // ShowDDLLocksRequest used to query DDL locks which are un-resolved
 // task: task's name, empty for all tasks
 // sources: source need to query, empty for all sources
@@ -643,7 +1013,7 @@ func (m *ShowDDLLocksRequest) Reset()         { *m = ShowDDLLocksRequest{} }
 func (m *ShowDDLLocksRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowDDLLocksRequest) ProtoMessage()    {}
 func (*ShowDDLLocksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{8}
+	return fileDescriptor_f9bef11f2a341f03, []int{10}
 }
 func (m *ShowDDLLocksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +1079,7 @@ func (m *DDLLock) Reset()         { *m = DDLLock{} }
 func (m *DDLLock) String() string { return proto.CompactTextString(m) }
 func (*DDLLock) ProtoMessage()    {}
 func (*DDLLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{9}
+	return fileDescriptor_f9bef11f2a341f03, []int{11}
 }
 func (m *DDLLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +1167,7 @@ func (m *ShowDDLLocksResponse) Reset()         { *m = ShowDDLLocksResponse{} }
 func (m *ShowDDLLocksResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowDDLLocksResponse) ProtoMessage()    {}
 func (*ShowDDLLocksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{10}
+	return fileDescriptor_f9bef11f2a341f03, []int{12}
 }
 func (m *ShowDDLLocksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,7 +1231,7 @@ func (m *UnlockDDLLockRequest) Reset()         { *m = UnlockDDLLockRequest{} }
 func (m *UnlockDDLLockRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockDDLLockRequest) ProtoMessage()    {}
 func (*UnlockDDLLockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{11}
+	return fileDescriptor_f9bef11f2a341f03, []int{13}
 }
 func (m *UnlockDDLLockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -920,7 +1290,7 @@ func (m *UnlockDDLLockResponse) Reset()         { *m = UnlockDDLLockResponse{} }
 func (m *UnlockDDLLockResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockDDLLockResponse) ProtoMessage()    {}
 func (*UnlockDDLLockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{12}
+	return fileDescriptor_f9bef11f2a341f03, []int{14}
 }
 func (m *UnlockDDLLockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,7 +1343,7 @@ func (m *OperateWorkerRelayRequest) Reset()         { *m = OperateWorkerRelayReq
 func (m *OperateWorkerRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerRelayRequest) ProtoMessage()    {}
 func (*OperateWorkerRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{13}
+	return fileDescriptor_f9bef11f2a341f03, []int{15}
 }
 func (m *OperateWorkerRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1027,7 +1397,7 @@ func (m *OperateWorkerRelayResponse) Reset()         { *m = OperateWorkerRelayRe
 func (m *OperateWorkerRelayResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerRelayResponse) ProtoMessage()    {}
 func (*OperateWorkerRelayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{14}
+	return fileDescriptor_f9bef11f2a341f03, []int{16}
 }
 func (m *OperateWorkerRelayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,13 +1466,14 @@ type PurgeWorkerRelayRequest struct {
 	Time     int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
 	Filename string   `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
 	SubDir   string   `protobuf:"bytes,5,opt,name=subDir,proto3" json:"subDir,omitempty"`
+	DryRun   bool     `protobuf:"varint,6,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
 }
 
 func (m *PurgeWorkerRelayRequest) Reset()         { *m = PurgeWorkerRelayRequest{} }
 func (m *PurgeWorkerRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeWorkerRelayRequest) ProtoMessage()    {}
 func (*PurgeWorkerRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{15}
+	return fileDescriptor_f9bef11f2a341f03, []int{17}
 }
 func (m *PurgeWorkerRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,6 +1537,13 @@ func (m *PurgeWorkerRelayRequest) GetSubDir() string {
 	return ""
 }
 
+func (m *PurgeWorkerRelayRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type PurgeWorkerRelayResponse struct {
 	Result  bool                    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg     string                  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -1176,7 +1554,7 @@ func (m *PurgeWorkerRelayResponse) Reset()         { *m = PurgeWorkerRelayRespon
 func (m *PurgeWorkerRelayResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeWorkerRelayResponse) ProtoMessage()    {}
 func (*PurgeWorkerRelayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{16}
+	return fileDescriptor_f9bef11f2a341f03, []int{18}
 }
 func (m *PurgeWorkerRelayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1236,7 +1614,7 @@ func (m *CheckTaskRequest) Reset()         { *m = CheckTaskRequest{} }
 func (m *CheckTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckTaskRequest) ProtoMessage()    {}
 func (*CheckTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{17}
+	return fileDescriptor_f9bef11f2a341f03, []int{19}
 }
 func (m *CheckTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1295,7 +1673,7 @@ func (m *CheckTaskResponse) Reset()         { *m = CheckTaskResponse{} }
 func (m *CheckTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckTaskResponse) ProtoMessage()    {}
 func (*CheckTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{18}
+	return fileDescriptor_f9bef11f2a341f03, []int{20}
 }
 func (m *CheckTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,7 +1726,7 @@ func (m *OperateSourceRequest) Reset()         { *m = OperateSourceRequest{} }
 func (m *OperateSourceRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateSourceRequest) ProtoMessage()    {}
 func (*OperateSourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{19}
+	return fileDescriptor_f9bef11f2a341f03, []int{21}
 }
 func (m *OperateSourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1408,7 +1786,7 @@ func (m *OperateSourceResponse) Reset()         { *m = OperateSourceResponse{} }
 func (m *OperateSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateSourceResponse) ProtoMessage()    {}
 func (*OperateSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{20}
+	return fileDescriptor_f9bef11f2a341f03, []int{22}
 }
 func (m *OperateSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1845,7 @@ func (m *RegisterWorkerRequest) Reset()         { *m = RegisterWorkerRequest{} }
 func (m *RegisterWorkerRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterWorkerRequest) ProtoMessage()    {}
 func (*RegisterWorkerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{21}
+	return fileDescriptor_f9bef11f2a341f03, []int{23}
 }
 func (m *RegisterWorkerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1519,7 +1897,7 @@ func (m *RegisterWorkerResponse) Reset()         { *m = RegisterWorkerResponse{}
 func (m *RegisterWorkerResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterWorkerResponse) ProtoMessage()    {}
 func (*RegisterWorkerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{22}
+	return fileDescriptor_f9bef11f2a341f03, []int{24}
 }
 func (m *RegisterWorkerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1571,7 +1949,7 @@ func (m *OfflineMemberRequest) Reset()         { *m = OfflineMemberRequest{} }
 func (m *OfflineMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*OfflineMemberRequest) ProtoMessage()    {}
 func (*OfflineMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{23}
+	return fileDescriptor_f9bef11f2a341f03, []int{25}
 }
 func (m *OfflineMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1623,7 +2001,7 @@ func (m *OfflineMemberResponse) Reset()         { *m = OfflineMemberResponse{} }
 func (m *OfflineMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*OfflineMemberResponse) ProtoMessage()    {}
 func (*OfflineMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{24}
+	return fileDescriptor_f9bef11f2a341f03, []int{26}
 }
 func (m *OfflineMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1674,7 +2052,7 @@ func (m *OperateLeaderRequest) Reset()         { *m = OperateLeaderRequest{} }
 func (m *OperateLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateLeaderRequest) ProtoMessage()    {}
 func (*OperateLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{25}
+	return fileDescriptor_f9bef11f2a341f03, []int{27}
 }
 func (m *OperateLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2097,7 @@ func (m *OperateLeaderResponse) Reset()         { *m = OperateLeaderResponse{} }
 func (m *OperateLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateLeaderResponse) ProtoMessage()    {}
 func (*OperateLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{26}
+	return fileDescriptor_f9bef11f2a341f03, []int{28}
 }
 func (m *OperateLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1774,7 +2152,7 @@ func (m *MasterInfo) Reset()         { *m = MasterInfo{} }
 func (m *MasterInfo) String() string { return proto.CompactTextString(m) }
 func (*MasterInfo) ProtoMessage()    {}
 func (*MasterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{27}
+	return fileDescriptor_f9bef11f2a341f03, []int{29}
 }
 func (m *MasterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1849,7 +2227,7 @@ func (m *WorkerInfo) Reset()         { *m = WorkerInfo{} }
 func (m *WorkerInfo) String() string { return proto.CompactTextString(m) }
 func (*WorkerInfo) ProtoMessage()    {}
 func (*WorkerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{28}
+	return fileDescriptor_f9bef11f2a341f03, []int{30}
 }
 func (m *WorkerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1916,7 +2294,7 @@ func (m *ListLeaderMember) Reset()         { *m = ListLeaderMember{} }
 func (m *ListLeaderMember) String() string { return proto.CompactTextString(m) }
 func (*ListLeaderMember) ProtoMessage()    {}
 func (*ListLeaderMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{29}
+	return fileDescriptor_f9bef11f2a341f03, []int{31}
 }
 func (m *ListLeaderMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1975,7 +2353,7 @@ func (m *ListMasterMember) Reset()         { *m = ListMasterMember{} }
 func (m *ListMasterMember) String() string { return proto.CompactTextString(m) }
 func (*ListMasterMember) ProtoMessage()    {}
 func (*ListMasterMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{30}
+	return fileDescriptor_f9bef11f2a341f03, []int{32}
 }
 func (m *ListMasterMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2405,7 @@ func (m *ListWorkerMember) Reset()         { *m = ListWorkerMember{} }
 func (m *ListWorkerMember) String() string { return proto.CompactTextString(m) }
 func (*ListWorkerMember) ProtoMessage()    {}
 func (*ListWorkerMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{31}
+	return fileDescriptor_f9bef11f2a341f03, []int{33}
 }
 func (m *ListWorkerMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2082,7 +2460,7 @@ func (m *Members) Reset()         { *m = Members{} }
 func (m *Members) String() string { return proto.CompactTextString(m) }
 func (*Members) ProtoMessage()    {}
 func (*Members) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{32}
+	return fileDescriptor_f9bef11f2a341f03, []int{34}
 }
 func (m *Members) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2179,7 +2557,7 @@ func (m *ListMemberRequest) Reset()         { *m = ListMemberRequest{} }
 func (m *ListMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMemberRequest) ProtoMessage()    {}
 func (*ListMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{33}
+	return fileDescriptor_f9bef11f2a341f03, []int{35}
 }
 func (m *ListMemberRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2246,7 +2624,7 @@ func (m *ListMemberResponse) Reset()         { *m = ListMemberResponse{} }
 func (m *ListMemberResponse) String() string { return proto.CompactTextString(m) }
 func (*ListMemberResponse) ProtoMessage()    {}
 func (*ListMemberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{34}
+	return fileDescriptor_f9bef11f2a341f03, []int{36}
 }
 func (m *ListMemberResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2311,7 +2689,7 @@ func (m *OperateSchemaRequest) Reset()         { *m = OperateSchemaRequest{} }
 func (m *OperateSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateSchemaRequest) ProtoMessage()    {}
 func (*OperateSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{35}
+	return fileDescriptor_f9bef11f2a341f03, []int{37}
 }
 func (m *OperateSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2406,7 +2784,7 @@ func (m *OperateSchemaResponse) Reset()         { *m = OperateSchemaResponse{} }
 func (m *OperateSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateSchemaResponse) ProtoMessage()    {}
 func (*OperateSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{36}
+	return fileDescriptor_f9bef11f2a341f03, []int{38}
 }
 func (m *OperateSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2465,7 +2843,7 @@ func (m *GetSubTaskCfgRequest) Reset()         { *m = GetSubTaskCfgRequest{} }
 func (m *GetSubTaskCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSubTaskCfgRequest) ProtoMessage()    {}
 func (*GetSubTaskCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{37}
+	return fileDescriptor_f9bef11f2a341f03, []int{39}
 }
 func (m *GetSubTaskCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2511,7 +2889,7 @@ func (m *GetSubTaskCfgResponse) Reset()         { *m = GetSubTaskCfgResponse{} }
 func (m *GetSubTaskCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSubTaskCfgResponse) ProtoMessage()    {}
 func (*GetSubTaskCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{38}
+	return fileDescriptor_f9bef11f2a341f03, []int{40}
 }
 func (m *GetSubTaskCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,13 +2942,14 @@ func (m *GetSubTaskCfgResponse) GetCfgs() []string {
 type GetCfgRequest struct {
 	Type CfgType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.CfgType" json:"type,omitempty"`
 	Name string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	All  bool    `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
 }
 
 func (m *GetCfgRequest) Reset()         { *m = GetCfgRequest{} }
 func (m *GetCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCfgRequest) ProtoMessage()    {}
 func (*GetCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{39}
+	return fileDescriptor_f9bef11f2a341f03, []int{41}
 }
 func (m *GetCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2613,17 +2992,174 @@ func (m *GetCfgRequest) GetName() string {
 	return ""
 }
 
+func (m *GetCfgRequest) GetAll() bool {
+	if m != nil {
+		return m.All
+	}
+	return false
+}
+
+// CfgRevision is the revisions of an etcd key storing the config,
+// the history of the config can be read at these revisions of etcd.
+type CfgRevision struct {
+	Key            string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	CreateRevision int64  `protobuf:"varint,2,opt,name=createRevision,proto3" json:"createRevision,omitempty"`
+	ModRevision    int64  `protobuf:"varint,3,opt,name=modRevision,proto3" json:"modRevision,omitempty"`
+	Version        int64  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *CfgRevision) Reset()         { *m = CfgRevision{} }
+func (m *CfgRevision) String() string { return proto.CompactTextString(m) }
+func (*CfgRevision) ProtoMessage()    {}
+func (*CfgRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{42}
+}
+func (m *CfgRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CfgRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CfgRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CfgRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CfgRevision.Merge(m, src)
+}
+func (m *CfgRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *CfgRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_CfgRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CfgRevision proto.InternalMessageInfo
+
+func (m *CfgRevision) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CfgRevision) GetCreateRevision() int64 {
+	if m != nil {
+		return m.CreateRevision
+	}
+	return 0
+}
+
+func (m *CfgRevision) GetModRevision() int64 {
+	if m != nil {
+		return m.ModRevision
+	}
+	return 0
+}
+
+func (m *CfgRevision) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type CfgEntry struct {
+	Type CfgType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.CfgType" json:"type,omitempty"`
+	Name string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cfg  string  `protobuf:"bytes,3,opt,name=cfg,proto3" json:"cfg,omitempty"`
+	Msg  string  `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	// the revisions of the keys the config is stored in, one for every source of a task.
+	// it's empty for master and worker configs which are not stored in etcd.
+	Revisions []*CfgRevision `protobuf:"bytes,5,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (m *CfgEntry) Reset()         { *m = CfgEntry{} }
+func (m *CfgEntry) String() string { return proto.CompactTextString(m) }
+func (*CfgEntry) ProtoMessage()    {}
+func (*CfgEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{43}
+}
+func (m *CfgEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CfgEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CfgEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CfgEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CfgEntry.Merge(m, src)
+}
+func (m *CfgEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CfgEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CfgEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CfgEntry proto.InternalMessageInfo
+
+func (m *CfgEntry) GetType() CfgType {
+	if m != nil {
+		return m.Type
+	}
+	return CfgType_InvalidType
+}
+
+func (m *CfgEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CfgEntry) GetCfg() string {
+	if m != nil {
+		return m.Cfg
+	}
+	return ""
+}
+
+func (m *CfgEntry) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *CfgEntry) GetRevisions() []*CfgRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
 type GetCfgResponse struct {
-	Result bool   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Cfg    string `protobuf:"bytes,3,opt,name=cfg,proto3" json:"cfg,omitempty"`
+	Result   bool        `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg      string      `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Cfg      string      `protobuf:"bytes,3,opt,name=cfg,proto3" json:"cfg,omitempty"`
+	Revision int64       `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	Cfgs     []*CfgEntry `protobuf:"bytes,5,rep,name=cfgs,proto3" json:"cfgs,omitempty"`
 }
 
 func (m *GetCfgResponse) Reset()         { *m = GetCfgResponse{} }
 func (m *GetCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCfgResponse) ProtoMessage()    {}
 func (*GetCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{40}
+	return fileDescriptor_f9bef11f2a341f03, []int{44}
 }
 func (m *GetCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2673,6 +3209,20 @@ func (m *GetCfgResponse) GetCfg() string {
 	return ""
 }
 
+func (m *GetCfgResponse) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *GetCfgResponse) GetCfgs() []*CfgEntry {
+	if m != nil {
+		return m.Cfgs
+	}
+	return nil
+}
+
 type GetMasterCfgRequest struct {
 }
 
@@ -2680,7 +3230,7 @@ func (m *GetMasterCfgRequest) Reset()         { *m = GetMasterCfgRequest{} }
 func (m *GetMasterCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMasterCfgRequest) ProtoMessage()    {}
 func (*GetMasterCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{41}
+	return fileDescriptor_f9bef11f2a341f03, []int{45}
 }
 func (m *GetMasterCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2717,7 +3267,7 @@ func (m *GetMasterCfgResponse) Reset()         { *m = GetMasterCfgResponse{} }
 func (m *GetMasterCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMasterCfgResponse) ProtoMessage()    {}
 func (*GetMasterCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{42}
+	return fileDescriptor_f9bef11f2a341f03, []int{46}
 }
 func (m *GetMasterCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2754,18 +3304,19 @@ func (m *GetMasterCfgResponse) GetCfg() string {
 }
 
 type HandleErrorRequest struct {
-	Op        ErrorOp  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.ErrorOp" json:"op,omitempty"`
-	Task      string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
-	Sources   []string `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
-	BinlogPos string   `protobuf:"bytes,4,opt,name=binlogPos,proto3" json:"binlogPos,omitempty"`
-	Sqls      []string `protobuf:"bytes,5,rep,name=sqls,proto3" json:"sqls,omitempty"`
+	Op         ErrorOp  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.ErrorOp" json:"op,omitempty"`
+	Task       string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Sources    []string `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	BinlogPos  string   `protobuf:"bytes,4,opt,name=binlogPos,proto3" json:"binlogPos,omitempty"`
+	Sqls       []string `protobuf:"bytes,5,rep,name=sqls,proto3" json:"sqls,omitempty"`
+	BinlogGTID string   `protobuf:"bytes,6,opt,name=binlogGTID,proto3" json:"binlogGTID,omitempty"`
 }
 
 func (m *HandleErrorRequest) Reset()         { *m = HandleErrorRequest{} }
 func (m *HandleErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*HandleErrorRequest) ProtoMessage()    {}
 func (*HandleErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{43}
+	return fileDescriptor_f9bef11f2a341f03, []int{47}
 }
 func (m *HandleErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2829,6 +3380,13 @@ func (m *HandleErrorRequest) GetSqls() []string {
 	return nil
 }
 
+func (m *HandleErrorRequest) GetBinlogGTID() string {
+	if m != nil {
+		return m.BinlogGTID
+	}
+	return ""
+}
+
 type HandleErrorResponse struct {
 	Result  bool                    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg     string                  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -2839,7 +3397,7 @@ func (m *HandleErrorResponse) Reset()         { *m = HandleErrorResponse{} }
 func (m *HandleErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*HandleErrorResponse) ProtoMessage()    {}
 func (*HandleErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{44}
+	return fileDescriptor_f9bef11f2a341f03, []int{48}
 }
 func (m *HandleErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2892,13 +3450,16 @@ func (m *HandleErrorResponse) GetSources() []*CommonWorkerResponse {
 type TransferSourceRequest struct {
 	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
 	Worker string `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
+	// pause the running subtasks of the source and wait for their checkpoints flushed, move the relay to the new
+	// DM-worker, then resume the subtasks there. otherwise, the source can't be transferred with running subtasks.
+	Handover bool `protobuf:"varint,3,opt,name=handover,proto3" json:"handover,omitempty"`
 }
 
 func (m *TransferSourceRequest) Reset()         { *m = TransferSourceRequest{} }
 func (m *TransferSourceRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferSourceRequest) ProtoMessage()    {}
 func (*TransferSourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{45}
+	return fileDescriptor_f9bef11f2a341f03, []int{49}
 }
 func (m *TransferSourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2941,16 +3502,24 @@ func (m *TransferSourceRequest) GetWorker() string {
 	return ""
 }
 
+func (m *TransferSourceRequest) GetHandover() bool {
+	if m != nil {
+		return m.Handover
+	}
+	return false
+}
+
 type TransferSourceResponse struct {
-	Result bool   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result      bool     `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg         string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	PausedTasks []string `protobuf:"bytes,3,rep,name=pausedTasks,proto3" json:"pausedTasks,omitempty"`
 }
 
 func (m *TransferSourceResponse) Reset()         { *m = TransferSourceResponse{} }
 func (m *TransferSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferSourceResponse) ProtoMessage()    {}
 func (*TransferSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{46}
+	return fileDescriptor_f9bef11f2a341f03, []int{50}
 }
 func (m *TransferSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2993,6 +3562,13 @@ func (m *TransferSourceResponse) GetMsg() string {
 	return ""
 }
 
+func (m *TransferSourceResponse) GetPausedTasks() []string {
+	if m != nil {
+		return m.PausedTasks
+	}
+	return nil
+}
+
 type OperateRelayRequest struct {
 	Op     RelayOpV2 `protobuf:"varint,1,opt,name=op,proto3,enum=pb.RelayOpV2" json:"op,omitempty"`
 	Source string    `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
@@ -3003,7 +3579,7 @@ func (m *OperateRelayRequest) Reset()         { *m = OperateRelayRequest{} }
 func (m *OperateRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateRelayRequest) ProtoMessage()    {}
 func (*OperateRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{47}
+	return fileDescriptor_f9bef11f2a341f03, []int{51}
 }
 func (m *OperateRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3062,7 +3638,7 @@ func (m *OperateRelayResponse) Reset()         { *m = OperateRelayResponse{} }
 func (m *OperateRelayResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateRelayResponse) ProtoMessage()    {}
 func (*OperateRelayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9bef11f2a341f03, []int{48}
+	return fileDescriptor_f9bef11f2a341f03, []int{52}
 }
 func (m *OperateRelayResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3105,4439 +3681,15830 @@ func (m *OperateRelayResponse) GetMsg() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("pb.SourceOp", SourceOp_name, SourceOp_value)
-	proto.RegisterEnum("pb.LeaderOp", LeaderOp_name, LeaderOp_value)
-	proto.RegisterEnum("pb.CfgType", CfgType_name, CfgType_value)
-	proto.RegisterEnum("pb.RelayOpV2", RelayOpV2_name, RelayOpV2_value)
-	proto.RegisterType((*StartTaskRequest)(nil), "pb.StartTaskRequest")
-	proto.RegisterType((*StartTaskResponse)(nil), "pb.StartTaskResponse")
-	proto.RegisterType((*OperateTaskRequest)(nil), "pb.OperateTaskRequest")
-	proto.RegisterType((*OperateTaskResponse)(nil), "pb.OperateTaskResponse")
-	proto.RegisterType((*UpdateTaskRequest)(nil), "pb.UpdateTaskRequest")
-	proto.RegisterType((*UpdateTaskResponse)(nil), "pb.UpdateTaskResponse")
-	proto.RegisterType((*QueryStatusListRequest)(nil), "pb.QueryStatusListRequest")
-	proto.RegisterType((*QueryStatusListResponse)(nil), "pb.QueryStatusListResponse")
-	proto.RegisterType((*ShowDDLLocksRequest)(nil), "pb.ShowDDLLocksRequest")
-	proto.RegisterType((*DDLLock)(nil), "pb.DDLLock")
-	proto.RegisterType((*ShowDDLLocksResponse)(nil), "pb.ShowDDLLocksResponse")
-	proto.RegisterType((*UnlockDDLLockRequest)(nil), "pb.UnlockDDLLockRequest")
-	proto.RegisterType((*UnlockDDLLockResponse)(nil), "pb.UnlockDDLLockResponse")
-	proto.RegisterType((*OperateWorkerRelayRequest)(nil), "pb.OperateWorkerRelayRequest")
-	proto.RegisterType((*OperateWorkerRelayResponse)(nil), "pb.OperateWorkerRelayResponse")
-	proto.RegisterType((*PurgeWorkerRelayRequest)(nil), "pb.PurgeWorkerRelayRequest")
-	proto.RegisterType((*PurgeWorkerRelayResponse)(nil), "pb.PurgeWorkerRelayResponse")
-	proto.RegisterType((*CheckTaskRequest)(nil), "pb.CheckTaskRequest")
-	proto.RegisterType((*CheckTaskResponse)(nil), "pb.CheckTaskResponse")
-	proto.RegisterType((*OperateSourceRequest)(nil), "pb.OperateSourceRequest")
-	proto.RegisterType((*OperateSourceResponse)(nil), "pb.OperateSourceResponse")
-	proto.RegisterType((*RegisterWorkerRequest)(nil), "pb.RegisterWorkerRequest")
-	proto.RegisterType((*RegisterWorkerResponse)(nil), "pb.RegisterWorkerResponse")
-	proto.RegisterType((*OfflineMemberRequest)(nil), "pb.OfflineMemberRequest")
-	proto.RegisterType((*OfflineMemberResponse)(nil), "pb.OfflineMemberResponse")
-	proto.RegisterType((*OperateLeaderRequest)(nil), "pb.OperateLeaderRequest")
-	proto.RegisterType((*OperateLeaderResponse)(nil), "pb.OperateLeaderResponse")
-	proto.RegisterType((*MasterInfo)(nil), "pb.MasterInfo")
-	proto.RegisterType((*WorkerInfo)(nil), "pb.WorkerInfo")
-	proto.RegisterType((*ListLeaderMember)(nil), "pb.ListLeaderMember")
-	proto.RegisterType((*ListMasterMember)(nil), "pb.ListMasterMember")
-	proto.RegisterType((*ListWorkerMember)(nil), "pb.ListWorkerMember")
-	proto.RegisterType((*Members)(nil), "pb.Members")
-	proto.RegisterType((*ListMemberRequest)(nil), "pb.ListMemberRequest")
-	proto.RegisterType((*ListMemberResponse)(nil), "pb.ListMemberResponse")
-	proto.RegisterType((*OperateSchemaRequest)(nil), "pb.OperateSchemaRequest")
-	proto.RegisterType((*OperateSchemaResponse)(nil), "pb.OperateSchemaResponse")
-	proto.RegisterType((*GetSubTaskCfgRequest)(nil), "pb.GetSubTaskCfgRequest")
-	proto.RegisterType((*GetSubTaskCfgResponse)(nil), "pb.GetSubTaskCfgResponse")
-	proto.RegisterType((*GetCfgRequest)(nil), "pb.GetCfgRequest")
-	proto.RegisterType((*GetCfgResponse)(nil), "pb.GetCfgResponse")
-	proto.RegisterType((*GetMasterCfgRequest)(nil), "pb.GetMasterCfgRequest")
-	proto.RegisterType((*GetMasterCfgResponse)(nil), "pb.GetMasterCfgResponse")
-	proto.RegisterType((*HandleErrorRequest)(nil), "pb.HandleErrorRequest")
-	proto.RegisterType((*HandleErrorResponse)(nil), "pb.HandleErrorResponse")
-	proto.RegisterType((*TransferSourceRequest)(nil), "pb.TransferSourceRequest")
-	proto.RegisterType((*TransferSourceResponse)(nil), "pb.TransferSourceResponse")
-	proto.RegisterType((*OperateRelayRequest)(nil), "pb.OperateRelayRequest")
-	proto.RegisterType((*OperateRelayResponse)(nil), "pb.OperateRelayResponse")
+type RestoreClusterRequest struct {
+	Backup string `protobuf:"bytes,1,opt,name=backup,proto3" json:"backup,omitempty"`
 }
 
-func init() { proto.RegisterFile("dmmaster.proto", fileDescriptor_f9bef11f2a341f03) }
-
-var fileDescriptor_f9bef11f2a341f03 = []byte{
-	// 2040 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5f, 0x6f, 0x1b, 0xc7,
-	0x11, 0xe7, 0x91, 0x34, 0x45, 0x0d, 0x25, 0x86, 0x5e, 0x51, 0xd4, 0xf9, 0x2c, 0xd3, 0xca, 0x36,
-	0x09, 0x04, 0xa1, 0xb0, 0x60, 0xb5, 0x4f, 0x01, 0x52, 0x34, 0x26, 0x1d, 0x47, 0xa8, 0x5c, 0xa5,
-	0x27, 0x3b, 0x4d, 0x50, 0xa0, 0xc8, 0x91, 0x5c, 0x52, 0x07, 0x1d, 0xef, 0xce, 0x77, 0x47, 0xa9,
-	0x82, 0x91, 0x97, 0x7e, 0x80, 0xfe, 0x41, 0x1f, 0xf2, 0xd8, 0x87, 0x7e, 0x93, 0x3e, 0xf5, 0x31,
-	0x40, 0x81, 0xa2, 0x8f, 0x85, 0xdd, 0x0f, 0x52, 0xec, 0xec, 0xde, 0xdd, 0xde, 0x1f, 0xaa, 0xa5,
-	0x81, 0xea, 0xed, 0x66, 0x66, 0x39, 0xf3, 0x9b, 0x3f, 0x3b, 0x3b, 0xbb, 0x84, 0xf6, 0x64, 0x3e,
-	0xb7, 0xc2, 0x88, 0x05, 0x8f, 0xfc, 0xc0, 0x8b, 0x3c, 0x52, 0xf5, 0x47, 0x46, 0x7b, 0x32, 0xbf,
-	0xf2, 0x82, 0x8b, 0x98, 0x67, 0xec, 0xce, 0x3c, 0x6f, 0xe6, 0xb0, 0x43, 0xcb, 0xb7, 0x0f, 0x2d,
-	0xd7, 0xf5, 0x22, 0x2b, 0xb2, 0x3d, 0x37, 0x14, 0x52, 0xfa, 0x0d, 0x74, 0xce, 0x22, 0x2b, 0x88,
-	0x5e, 0x58, 0xe1, 0x85, 0xc9, 0x5e, 0x2d, 0x58, 0x18, 0x11, 0x02, 0xf5, 0xc8, 0x0a, 0x2f, 0x74,
-	0x6d, 0x4f, 0xdb, 0x5f, 0x37, 0xf1, 0x9b, 0xe8, 0xb0, 0x16, 0x7a, 0x8b, 0x60, 0xcc, 0x42, 0xbd,
-	0xba, 0x57, 0xdb, 0x5f, 0x37, 0x63, 0x92, 0xf4, 0x01, 0x02, 0x36, 0xf7, 0x2e, 0xd9, 0x73, 0x16,
-	0x59, 0x7a, 0x6d, 0x4f, 0xdb, 0x6f, 0x9a, 0x0a, 0x87, 0xbe, 0x82, 0xbb, 0x8a, 0x85, 0xd0, 0xf7,
-	0xdc, 0x90, 0x91, 0x1e, 0x34, 0x02, 0x16, 0x2e, 0x9c, 0x08, 0x8d, 0x34, 0x4d, 0x49, 0x91, 0x0e,
-	0xd4, 0xe6, 0xe1, 0x4c, 0xaf, 0xa2, 0x65, 0xfe, 0x49, 0x8e, 0x52, 0xc3, 0xb5, 0xbd, 0xda, 0x7e,
-	0xeb, 0x48, 0x7f, 0xe4, 0x8f, 0x1e, 0x0d, 0xbc, 0xf9, 0xdc, 0x73, 0x7f, 0x89, 0x7e, 0xc6, 0x4a,
-	0x13, 0x48, 0xf4, 0xd7, 0x40, 0x4e, 0x7d, 0x16, 0x58, 0x11, 0x53, 0xdd, 0x32, 0xa0, 0xea, 0xf9,
-	0x68, 0xaf, 0x7d, 0x04, 0x5c, 0x09, 0x17, 0x9e, 0xfa, 0x66, 0xd5, 0xf3, 0xb9, 0xcb, 0xae, 0x35,
-	0x67, 0xd2, 0x30, 0x7e, 0xab, 0x2e, 0xd7, 0x32, 0x2e, 0xd3, 0xdf, 0x6b, 0xb0, 0x95, 0x31, 0x20,
-	0xbd, 0xba, 0xc9, 0x42, 0xea, 0x71, 0xb5, 0xcc, 0xe3, 0x5a, 0xa9, 0xc7, 0xf5, 0xff, 0xd5, 0xe3,
-	0x4f, 0xe1, 0xee, 0x4b, 0x7f, 0x92, 0x73, 0x78, 0xa5, 0x3c, 0xd2, 0x00, 0x88, 0xaa, 0xe2, 0x56,
-	0x12, 0xf5, 0x19, 0xf4, 0x7e, 0xb1, 0x60, 0xc1, 0xf5, 0x59, 0x64, 0x45, 0x8b, 0xf0, 0xc4, 0x0e,
-	0x23, 0x05, 0x3b, 0x26, 0x44, 0x2b, 0x4f, 0x48, 0x0e, 0xfb, 0x25, 0xec, 0x14, 0xf4, 0xac, 0xec,
-	0xc0, 0xe3, 0xbc, 0x03, 0x3b, 0xdc, 0x01, 0x45, 0x6f, 0x11, 0xff, 0x00, 0xb6, 0xce, 0xce, 0xbd,
-	0xab, 0xe1, 0xf0, 0xe4, 0xc4, 0x1b, 0x5f, 0x84, 0xef, 0x16, 0xf8, 0x3f, 0x6b, 0xb0, 0x26, 0x35,
-	0x90, 0x36, 0x54, 0x8f, 0x87, 0xf2, 0x77, 0xd5, 0xe3, 0x61, 0xa2, 0xa9, 0xaa, 0x68, 0x22, 0x50,
-	0x9f, 0x7b, 0x13, 0x26, 0x4b, 0x06, 0xbf, 0x49, 0x17, 0xee, 0x78, 0x57, 0x2e, 0x0b, 0xf4, 0x3a,
-	0x32, 0x05, 0xc1, 0x57, 0x0e, 0x87, 0x27, 0xa1, 0x7e, 0x07, 0x0d, 0xe2, 0x37, 0x8f, 0x47, 0x78,
-	0xed, 0x8e, 0xd9, 0x44, 0x6f, 0x20, 0x57, 0x52, 0xc4, 0x80, 0xe6, 0xc2, 0x95, 0x92, 0x35, 0x94,
-	0x24, 0x34, 0x1d, 0x43, 0x37, 0xeb, 0xe6, 0xca, 0xb1, 0x7d, 0x1f, 0xee, 0x38, 0xfc, 0xa7, 0x32,
-	0xb2, 0x2d, 0x1e, 0x59, 0xa9, 0xce, 0x14, 0x12, 0xea, 0x40, 0xf7, 0xa5, 0xcb, 0x3f, 0x63, 0xbe,
-	0x0c, 0x66, 0x3e, 0x24, 0x14, 0x36, 0x02, 0xe6, 0x3b, 0xd6, 0x98, 0x9d, 0xa2, 0xc7, 0xc2, 0x4a,
-	0x86, 0x47, 0xf6, 0xa0, 0x35, 0xf5, 0x82, 0x31, 0x33, 0xb1, 0x0d, 0xc9, 0xa6, 0xa4, 0xb2, 0xe8,
-	0xa7, 0xb0, 0x9d, 0xb3, 0xb6, 0xaa, 0x4f, 0xd4, 0x84, 0x7b, 0xb2, 0x09, 0xc4, 0xe5, 0xed, 0x58,
-	0xd7, 0x31, 0xea, 0xfb, 0x4a, 0x2b, 0x40, 0x6f, 0x51, 0x2a, 0x7b, 0xc1, 0xf2, 0x5a, 0xf8, 0x4e,
-	0x03, 0xa3, 0x4c, 0xa9, 0x04, 0x77, 0xa3, 0xd6, 0xff, 0x6f, 0x87, 0xf9, 0x4e, 0x83, 0x9d, 0x2f,
-	0x16, 0xc1, 0xac, 0xcc, 0x59, 0xc5, 0x1f, 0x2d, 0x7b, 0x38, 0x18, 0xd0, 0xb4, 0x5d, 0x6b, 0x1c,
-	0xd9, 0x97, 0x4c, 0xa2, 0x4a, 0x68, 0xac, 0x6d, 0x7b, 0x2e, 0xb2, 0x53, 0x33, 0xf1, 0x9b, 0xaf,
-	0x9f, 0xda, 0x0e, 0xc3, 0xad, 0x2f, 0x4a, 0x39, 0xa1, 0xb1, 0x72, 0x17, 0xa3, 0xa1, 0x1d, 0xe8,
-	0x77, 0x50, 0x22, 0x29, 0xfa, 0x1b, 0xd0, 0x8b, 0xc0, 0x6e, 0xa5, 0x7d, 0x7d, 0x05, 0x9d, 0xc1,
-	0x39, 0x1b, 0x5f, 0xfc, 0xb7, 0xa6, 0xdb, 0x83, 0x06, 0x0b, 0x82, 0x81, 0x2b, 0x32, 0x53, 0x33,
-	0x25, 0xc5, 0xe3, 0x76, 0x65, 0x05, 0x2e, 0x17, 0x88, 0x20, 0xc4, 0x24, 0xfd, 0x04, 0xee, 0x2a,
-	0x9a, 0x57, 0x2e, 0xcd, 0x73, 0xe8, 0xca, 0x2a, 0x3a, 0x43, 0xa8, 0x31, 0xb8, 0x5d, 0xa5, 0x7e,
-	0x36, 0xb8, 0x7f, 0x42, 0x9c, 0x16, 0xd0, 0xd8, 0x73, 0xa7, 0xf6, 0x4c, 0x56, 0xa5, 0xa4, 0x78,
-	0x52, 0x84, 0xc7, 0xc7, 0x43, 0x79, 0x12, 0x26, 0x34, 0x5d, 0xc0, 0x76, 0xce, 0xd2, 0xad, 0x44,
-	0xfe, 0x29, 0x6c, 0x9b, 0x6c, 0x66, 0xf3, 0xd1, 0x27, 0x5e, 0x72, 0xe3, 0xb9, 0x61, 0x4d, 0x26,
-	0x01, 0x0b, 0x43, 0x69, 0x36, 0x26, 0xe9, 0x13, 0xe8, 0xe5, 0xd5, 0xac, 0x1c, 0xeb, 0x9f, 0x40,
-	0xf7, 0x74, 0x3a, 0x75, 0x6c, 0x97, 0x3d, 0x67, 0xf3, 0x51, 0x06, 0x49, 0x74, 0xed, 0x27, 0x48,
-	0xf8, 0x77, 0xd9, 0x98, 0xc1, 0x3b, 0x51, 0xee, 0xf7, 0x2b, 0x43, 0xf8, 0x71, 0x92, 0xee, 0x13,
-	0x66, 0x4d, 0x52, 0x08, 0x85, 0x74, 0x0b, 0xb1, 0x48, 0x37, 0x1a, 0xce, 0xfe, 0x6a, 0x65, 0xc3,
-	0xbf, 0xd3, 0x00, 0x9e, 0xe3, 0x00, 0x7a, 0xec, 0x4e, 0xbd, 0xd2, 0xe0, 0x1b, 0xd0, 0x9c, 0xa3,
-	0x5f, 0xc7, 0x43, 0xfc, 0x65, 0xdd, 0x4c, 0x68, 0x7e, 0x6a, 0x59, 0x8e, 0x9d, 0x34, 0x68, 0x41,
-	0xf0, 0x5f, 0xf8, 0x8c, 0x05, 0x2f, 0xcd, 0x13, 0xd1, 0x9e, 0xd6, 0xcd, 0x84, 0xe6, 0xc3, 0xe6,
-	0xd8, 0xb1, 0x99, 0x1b, 0xa1, 0x54, 0x9c, 0x6b, 0x0a, 0x87, 0x8e, 0x00, 0x44, 0x22, 0x97, 0xe2,
-	0x21, 0x50, 0xe7, 0xd9, 0x8f, 0x53, 0xc0, 0xbf, 0x39, 0x8e, 0x30, 0xb2, 0x66, 0xf1, 0x91, 0x2a,
-	0x08, 0xec, 0x37, 0x58, 0x6e, 0xb2, 0x13, 0x49, 0x8a, 0x9e, 0x40, 0x87, 0x4f, 0x18, 0x22, 0x68,
-	0x22, 0x67, 0x71, 0x68, 0xb4, 0xb4, 0xaa, 0xcb, 0x26, 0xca, 0xd8, 0x76, 0x2d, 0xb5, 0x4d, 0x7f,
-	0x2e, 0xb4, 0x89, 0x28, 0x2e, 0xd5, 0xb6, 0x0f, 0x6b, 0x62, 0xd0, 0x17, 0x27, 0x46, 0xeb, 0xa8,
-	0xcd, 0xd3, 0x99, 0x86, 0xde, 0x8c, 0xc5, 0xb1, 0x3e, 0x11, 0x85, 0x9b, 0xf4, 0x89, 0x4b, 0x42,
-	0x46, 0x5f, 0x1a, 0x3a, 0x33, 0x16, 0xd3, 0xbf, 0x68, 0xb0, 0x26, 0xd4, 0x84, 0xe4, 0x11, 0x34,
-	0x1c, 0xf4, 0x1a, 0x55, 0xb5, 0x8e, 0xba, 0x58, 0x53, 0xb9, 0x58, 0x7c, 0x5e, 0x31, 0xe5, 0x2a,
-	0xbe, 0x5e, 0xc0, 0xc2, 0x28, 0x28, 0xeb, 0x55, 0x6f, 0xf9, 0x7a, 0xb1, 0x8a, 0xaf, 0x17, 0x66,
-	0x31, 0x42, 0xca, 0x7a, 0xd5, 0x1b, 0xbe, 0x5e, 0xac, 0x7a, 0xd2, 0x84, 0x86, 0xa8, 0x25, 0x7e,
-	0xc9, 0x40, 0xbd, 0x99, 0x1d, 0xd8, 0xcb, 0xc0, 0x6d, 0x26, 0xb0, 0x7a, 0x19, 0x58, 0xcd, 0xc4,
-	0x7c, 0x2f, 0x63, 0xbe, 0x19, 0x9b, 0xe1, 0xe5, 0xc1, 0xd3, 0x17, 0x57, 0xa3, 0x20, 0x28, 0x03,
-	0xa2, 0x9a, 0x5c, 0xb9, 0xed, 0x7d, 0x08, 0x6b, 0x02, 0x7c, 0x66, 0x28, 0x92, 0xa1, 0x36, 0x63,
-	0x19, 0xfd, 0x87, 0x96, 0xf6, 0xf2, 0xf1, 0x39, 0x9b, 0x5b, 0xcb, 0x7b, 0x39, 0x8a, 0xd3, 0x0b,
-	0x4d, 0x61, 0x70, 0x5c, 0x7a, 0xa1, 0xe1, 0x5b, 0x6e, 0x62, 0x45, 0xd6, 0xc8, 0x0a, 0x93, 0x63,
-	0x37, 0xa6, 0xb9, 0xf7, 0x91, 0x35, 0x72, 0x98, 0x3c, 0x75, 0x05, 0x81, 0x9b, 0x03, 0xed, 0xe9,
-	0x0d, 0xb9, 0x39, 0x90, 0xe2, 0xab, 0xa7, 0xce, 0x22, 0x3c, 0xd7, 0xd7, 0xc4, 0x96, 0x46, 0x82,
-	0xa3, 0xe1, 0xa3, 0xa4, 0xde, 0x44, 0x26, 0x7e, 0xab, 0x27, 0x87, 0xf4, 0xeb, 0x56, 0x4e, 0x8e,
-	0x03, 0xe8, 0x3e, 0x63, 0xd1, 0xd9, 0x62, 0xc4, 0x8f, 0xd6, 0xc1, 0x74, 0x76, 0xc3, 0xc1, 0x41,
-	0x5f, 0xc2, 0x76, 0x6e, 0xed, 0xca, 0x10, 0x09, 0xd4, 0xc7, 0xd3, 0x59, 0x1c, 0x70, 0xfc, 0xa6,
-	0x43, 0xd8, 0x7c, 0xc6, 0x22, 0xc5, 0xf6, 0x43, 0xe5, 0xa8, 0x90, 0x83, 0xdd, 0x60, 0x3a, 0x7b,
-	0x71, 0xed, 0xb3, 0x1b, 0xce, 0x8d, 0x13, 0x68, 0xc7, 0x5a, 0x56, 0x46, 0xd5, 0x81, 0xda, 0x78,
-	0x9a, 0x8c, 0x84, 0xe3, 0xe9, 0x8c, 0x6e, 0xc3, 0xd6, 0x33, 0x26, 0xf7, 0x65, 0x8a, 0x8c, 0xee,
-	0x63, 0xb4, 0x14, 0xb6, 0x34, 0x25, 0x15, 0x68, 0xa9, 0x82, 0x3f, 0x6a, 0x40, 0x3e, 0xb7, 0xdc,
-	0x89, 0xc3, 0x9e, 0x06, 0x81, 0x17, 0x2c, 0x9d, 0x83, 0x51, 0xfa, 0x4e, 0x45, 0xba, 0x0b, 0xeb,
-	0x23, 0xdb, 0x75, 0xbc, 0xd9, 0x17, 0x5e, 0x28, 0xab, 0x34, 0x65, 0x60, 0x89, 0xbd, 0x72, 0x92,
-	0xbb, 0x0e, 0xff, 0xa6, 0x21, 0x6c, 0x65, 0x20, 0xdd, 0x4a, 0x81, 0x3d, 0x83, 0xed, 0x17, 0x81,
-	0xe5, 0x86, 0x53, 0x16, 0x64, 0x87, 0xaf, 0xf4, 0x3c, 0xd1, 0xd4, 0xf3, 0x44, 0x69, 0x3b, 0xc2,
-	0xb2, 0xa4, 0xf8, 0x70, 0x92, 0x57, 0xb4, 0xf2, 0x01, 0x3d, 0x49, 0x1e, 0x2a, 0x32, 0x03, 0xfb,
-	0x03, 0x25, 0x2b, 0x9b, 0xca, 0x3d, 0xe2, 0xcb, 0xa3, 0x78, 0x10, 0x94, 0x48, 0xab, 0x4b, 0x90,
-	0x8a, 0xd4, 0xc4, 0x48, 0x7f, 0x9a, 0xb4, 0xa8, 0x77, 0x9c, 0xbe, 0x0f, 0x46, 0xd0, 0x8c, 0x47,
-	0x51, 0xb2, 0x05, 0xef, 0x1d, 0xbb, 0x97, 0x96, 0x63, 0x4f, 0x62, 0x56, 0xa7, 0x42, 0xde, 0x83,
-	0x16, 0xbe, 0x22, 0x09, 0x56, 0x47, 0x23, 0x1d, 0xd8, 0x10, 0xcf, 0x15, 0x92, 0x53, 0x25, 0x6d,
-	0x80, 0xb3, 0xc8, 0xf3, 0x25, 0x5d, 0x43, 0xfa, 0xdc, 0xbb, 0x92, 0x74, 0xfd, 0xe0, 0x67, 0xd0,
-	0x8c, 0xe7, 0x1f, 0xc5, 0x46, 0xcc, 0xea, 0x54, 0xc8, 0x5d, 0xd8, 0x7c, 0x7a, 0x69, 0x8f, 0xa3,
-	0x84, 0xa5, 0x91, 0x1d, 0xd8, 0x1a, 0x58, 0xee, 0x98, 0x39, 0x59, 0x41, 0xf5, 0xe0, 0x2b, 0x58,
-	0x93, 0x5b, 0x94, 0x43, 0x93, 0xba, 0x38, 0xd9, 0xa9, 0x90, 0x0d, 0x68, 0xf2, 0x86, 0x81, 0x94,
-	0xc6, 0x61, 0x88, 0xfd, 0x83, 0x34, 0xc2, 0x14, 0xa5, 0x83, 0xb4, 0x80, 0x89, 0x10, 0x91, 0xae,
-	0x1f, 0x0c, 0x61, 0x3d, 0xc9, 0x06, 0xe9, 0x42, 0x47, 0xea, 0x4e, 0x78, 0x9d, 0x0a, 0xf7, 0x1d,
-	0x83, 0x81, 0xbc, 0x2f, 0x8f, 0x3a, 0x9a, 0x08, 0x8f, 0xe7, 0xc7, 0x8c, 0xea, 0xd1, 0x5f, 0xdb,
-	0xd0, 0x10, 0x66, 0xc9, 0xd7, 0xb0, 0x9e, 0x3c, 0xc0, 0x11, 0x3c, 0x52, 0xf3, 0x2f, 0x7e, 0xc6,
-	0x76, 0x8e, 0x2b, 0xf2, 0x47, 0x1f, 0xfe, 0xf6, 0xef, 0xff, 0xfe, 0x53, 0xf5, 0x1e, 0xed, 0x1e,
-	0x5a, 0xbe, 0x1d, 0x1e, 0x5e, 0x3e, 0xb6, 0x1c, 0xff, 0xdc, 0x7a, 0x7c, 0xc8, 0x37, 0x6a, 0xf8,
-	0xb1, 0x76, 0x40, 0xa6, 0xd0, 0x52, 0xde, 0xc1, 0x48, 0x8f, 0xab, 0x29, 0xbe, 0xbc, 0x19, 0x3b,
-	0x05, 0xbe, 0x34, 0xf0, 0x11, 0x1a, 0xd8, 0x33, 0xee, 0x97, 0x19, 0x38, 0x7c, 0xcd, 0xfb, 0xdc,
-	0xb7, 0xdc, 0xce, 0x27, 0x00, 0xe9, 0xdb, 0x14, 0x41, 0xb4, 0x85, 0xe7, 0x2e, 0xa3, 0x97, 0x67,
-	0x4b, 0x23, 0x15, 0xe2, 0x40, 0x4b, 0x79, 0xc6, 0x21, 0x46, 0xee, 0x5d, 0x47, 0x79, 0x77, 0x32,
-	0xee, 0x97, 0xca, 0xa4, 0xa6, 0x0f, 0x10, 0x6e, 0x9f, 0xec, 0xe6, 0xe0, 0x86, 0xb8, 0x54, 0xe2,
-	0x25, 0x03, 0xd8, 0x50, 0x5f, 0x4b, 0x08, 0x7a, 0x5f, 0xf2, 0x4c, 0x64, 0xe8, 0x45, 0x41, 0x02,
-	0xf9, 0x33, 0xd8, 0xcc, 0xbc, 0x4f, 0x10, 0x5c, 0x5c, 0xf6, 0x40, 0x62, 0xdc, 0x2b, 0x91, 0x24,
-	0x7a, 0xbe, 0x86, 0x5e, 0xf1, 0x3d, 0x01, 0xa3, 0xf8, 0x40, 0x49, 0x4a, 0xf1, 0x4e, 0x6f, 0xf4,
-	0x97, 0x89, 0x13, 0xd5, 0xa7, 0xd0, 0xc9, 0xdf, 0xbb, 0x09, 0x86, 0x6f, 0xc9, 0x33, 0x81, 0xb1,
-	0x5b, 0x2e, 0x4c, 0x14, 0x7e, 0x0c, 0xeb, 0xc9, 0xa5, 0x57, 0x14, 0x6a, 0xfe, 0x76, 0x2d, 0x0a,
-	0xb5, 0x70, 0x33, 0xa6, 0x15, 0x32, 0x83, 0xcd, 0xcc, 0x3d, 0x54, 0xc4, 0xab, 0xec, 0x12, 0x2c,
-	0xe2, 0x55, 0x7a, 0x69, 0xa5, 0xef, 0x63, 0x82, 0xef, 0x1b, 0xbd, 0x7c, 0x82, 0x45, 0x6f, 0xe7,
-	0xa5, 0x78, 0x0c, 0xed, 0xec, 0x95, 0x91, 0xdc, 0x13, 0x0d, 0xb4, 0xe4, 0x36, 0x6a, 0x18, 0x65,
-	0xa2, 0x04, 0x73, 0x00, 0x9b, 0x99, 0x9b, 0x9f, 0xc4, 0x5c, 0x72, 0x99, 0x94, 0x98, 0xcb, 0xae,
-	0x89, 0xf4, 0x87, 0x88, 0xf9, 0xa3, 0x83, 0x0f, 0x72, 0x98, 0xe5, 0x00, 0x79, 0xf8, 0x9a, 0x4f,
-	0x10, 0xdf, 0xc6, 0xc5, 0x79, 0x91, 0xc4, 0x49, 0x34, 0xb3, 0x4c, 0x9c, 0x32, 0xb7, 0xc7, 0x4c,
-	0x9c, 0xb2, 0x37, 0x44, 0xfa, 0x21, 0xda, 0x7c, 0x68, 0x18, 0x39, 0x9b, 0x62, 0xc0, 0x3e, 0x7c,
-	0xed, 0xf9, 0xb8, 0x6d, 0x7f, 0x05, 0x90, 0x8e, 0xc8, 0x62, 0xdb, 0x16, 0xa6, 0x74, 0xb1, 0x6d,
-	0x8b, 0x93, 0x34, 0xed, 0xa3, 0x0d, 0x9d, 0xf4, 0xca, 0xfd, 0x22, 0xd3, 0x34, 0xe3, 0x62, 0xf4,
-	0xcc, 0x64, 0x5c, 0x1d, 0x95, 0xb3, 0x19, 0xcf, 0x0c, 0x9b, 0x74, 0x0f, 0xad, 0x18, 0xc6, 0x76,
-	0x3e, 0xe3, 0xb8, 0x8c, 0x3b, 0xe1, 0xe0, 0xb4, 0x96, 0x0e, 0x81, 0xc2, 0x4e, 0xd9, 0x0c, 0x29,
-	0xec, 0x94, 0x4e, 0x8c, 0x71, 0xa7, 0x23, 0xfd, 0xbc, 0x9d, 0xc5, 0x48, 0x6d, 0x76, 0xe4, 0x05,
-	0x34, 0xc4, 0x54, 0x47, 0xee, 0x4a, 0x65, 0x8a, 0x7e, 0xa2, 0xb2, 0xa4, 0xe2, 0x1f, 0xa0, 0xe2,
-	0x07, 0xe4, 0xa6, 0x16, 0x4a, 0xbe, 0x81, 0x96, 0x32, 0x08, 0x89, 0x3e, 0x5d, 0x1c, 0xd6, 0x44,
-	0x9f, 0x2e, 0x99, 0x98, 0x96, 0x46, 0x89, 0xf1, 0x55, 0xb8, 0x2d, 0x06, 0xb0, 0xa1, 0x0e, 0x8a,
-	0xa2, 0xe9, 0x95, 0x4c, 0x94, 0x86, 0x5e, 0x14, 0x24, 0x1b, 0xe2, 0x18, 0xda, 0xd9, 0x89, 0x47,
-	0xec, 0xad, 0xd2, 0x71, 0x4a, 0xec, 0xad, 0xf2, 0x01, 0x89, 0x56, 0x38, 0x1e, 0x75, 0x24, 0x21,
-	0xea, 0x11, 0x94, 0x69, 0x4a, 0x7a, 0x51, 0x10, 0x2b, 0x79, 0xa2, 0xff, 0xed, 0x4d, 0x5f, 0xfb,
-	0xfe, 0x4d, 0x5f, 0xfb, 0xd7, 0x9b, 0xbe, 0xf6, 0x87, 0xb7, 0xfd, 0xca, 0xf7, 0x6f, 0xfb, 0x95,
-	0x7f, 0xbe, 0xed, 0x57, 0x46, 0x0d, 0xfc, 0xf7, 0xec, 0x47, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff,
-	0x8d, 0x0a, 0x25, 0x8c, 0x81, 0x1b, 0x00, 0x00,
+func (m *RestoreClusterRequest) Reset()         { *m = RestoreClusterRequest{} }
+func (m *RestoreClusterRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreClusterRequest) ProtoMessage()    {}
+func (*RestoreClusterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{53}
+}
+func (m *RestoreClusterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreClusterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreClusterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreClusterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreClusterRequest.Merge(m, src)
+}
+func (m *RestoreClusterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreClusterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreClusterRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_RestoreClusterRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *RestoreClusterRequest) GetBackup() string {
+	if m != nil {
+		return m.Backup
+	}
+	return ""
+}
 
-// MasterClient is the client API for Master service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MasterClient interface {
-	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error)
-	OperateTask(ctx context.Context, in *OperateTaskRequest, opts ...grpc.CallOption) (*OperateTaskResponse, error)
-	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error)
-	QueryStatus(ctx context.Context, in *QueryStatusListRequest, opts ...grpc.CallOption) (*QueryStatusListResponse, error)
-	// show un-resolved DDL locks
-	ShowDDLLocks(ctx context.Context, in *ShowDDLLocksRequest, opts ...grpc.CallOption) (*ShowDDLLocksResponse, error)
-	// used by dmctl to manually unlock DDL lock
-	UnlockDDLLock(ctx context.Context, in *UnlockDDLLockRequest, opts ...grpc.CallOption) (*UnlockDDLLockResponse, error)
-	// OperateWorkerRelayTask requests some dm-workers to operate relay unit
-	OperateWorkerRelayTask(ctx context.Context, in *OperateWorkerRelayRequest, opts ...grpc.CallOption) (*OperateWorkerRelayResponse, error)
-	// PurgeWorkerRelay purges relay log files for some dm-workers
-	PurgeWorkerRelay(ctx context.Context, in *PurgeWorkerRelayRequest, opts ...grpc.CallOption) (*PurgeWorkerRelayResponse, error)
-	// CheckTask checks legality of task configuration
-	CheckTask(ctx context.Context, in *CheckTaskRequest, opts ...grpc.CallOption) (*CheckTaskResponse, error)
-	// Operate an upstream MySQL source.
-	OperateSource(ctx context.Context, in *OperateSourceRequest, opts ...grpc.CallOption) (*OperateSourceResponse, error)
-	// RegisterWorker register the dm-workers.
-	RegisterWorker(ctx context.Context, in *RegisterWorkerRequest, opts ...grpc.CallOption) (*RegisterWorkerResponse, error)
-	// OfflineMember offline the dm cluster's members (master/worker).
-	OfflineMember(ctx context.Context, in *OfflineMemberRequest, opts ...grpc.CallOption) (*OfflineMemberResponse, error)
-	// OperateLeader do some operate on master:
-	//   - evict leader: make the master resign if it is leader, and will not campaign the leader again
-	//   - cancel evict leader: the master can campaign leader again.
-	OperateLeader(ctx context.Context, in *OperateLeaderRequest, opts ...grpc.CallOption) (*OperateLeaderResponse, error)
-	// ListMember list member information
-	ListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListMemberResponse, error)
-	OperateSchema(ctx context.Context, in *OperateSchemaRequest, opts ...grpc.CallOption) (*OperateSchemaResponse, error)
-	GetSubTaskCfg(ctx context.Context, in *GetSubTaskCfgRequest, opts ...grpc.CallOption) (*GetSubTaskCfgResponse, error)
-	// GetCfg get config
-	GetCfg(ctx context.Context, in *GetCfgRequest, opts ...grpc.CallOption) (*GetCfgResponse, error)
-	HandleError(ctx context.Context, in *HandleErrorRequest, opts ...grpc.CallOption) (*HandleErrorResponse, error)
-	GetMasterCfg(ctx context.Context, in *GetMasterCfgRequest, opts ...grpc.CallOption) (*GetMasterCfgResponse, error)
-	TransferSource(ctx context.Context, in *TransferSourceRequest, opts ...grpc.CallOption) (*TransferSourceResponse, error)
-	OperateRelay(ctx context.Context, in *OperateRelayRequest, opts ...grpc.CallOption) (*OperateRelayResponse, error)
+type RestoreClusterResponse struct {
+	Result bool   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-type masterClient struct {
-	cc *grpc.ClientConn
+func (m *RestoreClusterResponse) Reset()         { *m = RestoreClusterResponse{} }
+func (m *RestoreClusterResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreClusterResponse) ProtoMessage()    {}
+func (*RestoreClusterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{54}
 }
-
-func NewMasterClient(cc *grpc.ClientConn) MasterClient {
-	return &masterClient{cc}
+func (m *RestoreClusterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *masterClient) StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error) {
-	out := new(StartTaskResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/StartTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RestoreClusterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreClusterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RestoreClusterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreClusterResponse.Merge(m, src)
+}
+func (m *RestoreClusterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreClusterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreClusterResponse.DiscardUnknown(m)
 }
 
-func (c *masterClient) OperateTask(ctx context.Context, in *OperateTaskRequest, opts ...grpc.CallOption) (*OperateTaskResponse, error) {
-	out := new(OperateTaskResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/OperateTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RestoreClusterResponse proto.InternalMessageInfo
+
+func (m *RestoreClusterResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return out, nil
+	return false
 }
 
-func (c *masterClient) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error) {
-	out := new(UpdateTaskResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/UpdateTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RestoreClusterResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) QueryStatus(ctx context.Context, in *QueryStatusListRequest, opts ...grpc.CallOption) (*QueryStatusListResponse, error) {
-	out := new(QueryStatusListResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/QueryStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// TaskLock represents a lock on a task held by an operator,
+// mutating operations on the task are rejected unless forced before the lock expires.
+type TaskLock struct {
+	Task       string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Holder     string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpireTime string `protobuf:"bytes,4,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
 }
 
-func (c *masterClient) ShowDDLLocks(ctx context.Context, in *ShowDDLLocksRequest, opts ...grpc.CallOption) (*ShowDDLLocksResponse, error) {
-	out := new(ShowDDLLocksResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/ShowDDLLocks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TaskLock) Reset()         { *m = TaskLock{} }
+func (m *TaskLock) String() string { return proto.CompactTextString(m) }
+func (*TaskLock) ProtoMessage()    {}
+func (*TaskLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{55}
+}
+func (m *TaskLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TaskLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskLock.Merge(m, src)
+}
+func (m *TaskLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskLock.DiscardUnknown(m)
 }
 
-func (c *masterClient) UnlockDDLLock(ctx context.Context, in *UnlockDDLLockRequest, opts ...grpc.CallOption) (*UnlockDDLLockResponse, error) {
-	out := new(UnlockDDLLockResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/UnlockDDLLock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TaskLock proto.InternalMessageInfo
+
+func (m *TaskLock) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) OperateWorkerRelayTask(ctx context.Context, in *OperateWorkerRelayRequest, opts ...grpc.CallOption) (*OperateWorkerRelayResponse, error) {
-	out := new(OperateWorkerRelayResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/OperateWorkerRelayTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TaskLock) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) PurgeWorkerRelay(ctx context.Context, in *PurgeWorkerRelayRequest, opts ...grpc.CallOption) (*PurgeWorkerRelayResponse, error) {
-	out := new(PurgeWorkerRelayResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/PurgeWorkerRelay", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TaskLock) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) CheckTask(ctx context.Context, in *CheckTaskRequest, opts ...grpc.CallOption) (*CheckTaskResponse, error) {
-	out := new(CheckTaskResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/CheckTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TaskLock) GetExpireTime() string {
+	if m != nil {
+		return m.ExpireTime
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) OperateSource(ctx context.Context, in *OperateSourceRequest, opts ...grpc.CallOption) (*OperateSourceResponse, error) {
-	out := new(OperateSourceResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/OperateSource", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type OperateTaskLockRequest struct {
+	Op       TaskLockOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskLockOp" json:"op,omitempty"`
+	Task     string     `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Holder   string     `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	Reason   string     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration int64      `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Force    bool       `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (c *masterClient) RegisterWorker(ctx context.Context, in *RegisterWorkerRequest, opts ...grpc.CallOption) (*RegisterWorkerResponse, error) {
-	out := new(RegisterWorkerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/RegisterWorker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperateTaskLockRequest) Reset()         { *m = OperateTaskLockRequest{} }
+func (m *OperateTaskLockRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateTaskLockRequest) ProtoMessage()    {}
+func (*OperateTaskLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{56}
+}
+func (m *OperateTaskLockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperateTaskLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperateTaskLockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *OperateTaskLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateTaskLockRequest.Merge(m, src)
+}
+func (m *OperateTaskLockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperateTaskLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateTaskLockRequest.DiscardUnknown(m)
 }
 
-func (c *masterClient) OfflineMember(ctx context.Context, in *OfflineMemberRequest, opts ...grpc.CallOption) (*OfflineMemberResponse, error) {
-	out := new(OfflineMemberResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/OfflineMember", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OperateTaskLockRequest proto.InternalMessageInfo
+
+func (m *OperateTaskLockRequest) GetOp() TaskLockOp {
+	if m != nil {
+		return m.Op
 	}
-	return out, nil
+	return TaskLockOp_InvalidTaskLockOp
 }
 
-func (c *masterClient) OperateLeader(ctx context.Context, in *OperateLeaderRequest, opts ...grpc.CallOption) (*OperateLeaderResponse, error) {
-	out := new(OperateLeaderResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/OperateLeader", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperateTaskLockRequest) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) ListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListMemberResponse, error) {
-	out := new(ListMemberResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/ListMember", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperateTaskLockRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) OperateSchema(ctx context.Context, in *OperateSchemaRequest, opts ...grpc.CallOption) (*OperateSchemaResponse, error) {
-	out := new(OperateSchemaResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/OperateSchema", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperateTaskLockRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *masterClient) GetSubTaskCfg(ctx context.Context, in *GetSubTaskCfgRequest, opts ...grpc.CallOption) (*GetSubTaskCfgResponse, error) {
-	out := new(GetSubTaskCfgResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/GetSubTaskCfg", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperateTaskLockRequest) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	return out, nil
+	return 0
 }
 
-func (c *masterClient) GetCfg(ctx context.Context, in *GetCfgRequest, opts ...grpc.CallOption) (*GetCfgResponse, error) {
-	out := new(GetCfgResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/GetCfg", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperateTaskLockRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
 	}
-	return out, nil
+	return false
 }
 
-func (c *masterClient) HandleError(ctx context.Context, in *HandleErrorRequest, opts ...grpc.CallOption) (*HandleErrorResponse, error) {
-	out := new(HandleErrorResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/HandleError", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type OperateTaskLockResponse struct {
+	Result bool        `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg    string      `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Locks  []*TaskLock `protobuf:"bytes,3,rep,name=locks,proto3" json:"locks,omitempty"`
 }
 
-func (c *masterClient) GetMasterCfg(ctx context.Context, in *GetMasterCfgRequest, opts ...grpc.CallOption) (*GetMasterCfgResponse, error) {
-	out := new(GetMasterCfgResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/GetMasterCfg", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperateTaskLockResponse) Reset()         { *m = OperateTaskLockResponse{} }
+func (m *OperateTaskLockResponse) String() string { return proto.CompactTextString(m) }
+func (*OperateTaskLockResponse) ProtoMessage()    {}
+func (*OperateTaskLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{57}
+}
+func (m *OperateTaskLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperateTaskLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperateTaskLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *OperateTaskLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateTaskLockResponse.Merge(m, src)
+}
+func (m *OperateTaskLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperateTaskLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateTaskLockResponse.DiscardUnknown(m)
 }
 
-func (c *masterClient) TransferSource(ctx context.Context, in *TransferSourceRequest, opts ...grpc.CallOption) (*TransferSourceResponse, error) {
-	out := new(TransferSourceResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/TransferSource", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OperateTaskLockResponse proto.InternalMessageInfo
+
+func (m *OperateTaskLockResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return out, nil
+	return false
 }
 
-func (c *masterClient) OperateRelay(ctx context.Context, in *OperateRelayRequest, opts ...grpc.CallOption) (*OperateRelayResponse, error) {
-	out := new(OperateRelayResponse)
-	err := c.cc.Invoke(ctx, "/pb.Master/OperateRelay", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OperateTaskLockResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return out, nil
+	return ""
 }
 
-// MasterServer is the server API for Master service.
-type MasterServer interface {
-	StartTask(context.Context, *StartTaskRequest) (*StartTaskResponse, error)
-	OperateTask(context.Context, *OperateTaskRequest) (*OperateTaskResponse, error)
-	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
-	QueryStatus(context.Context, *QueryStatusListRequest) (*QueryStatusListResponse, error)
-	// show un-resolved DDL locks
-	ShowDDLLocks(context.Context, *ShowDDLLocksRequest) (*ShowDDLLocksResponse, error)
-	// used by dmctl to manually unlock DDL lock
-	UnlockDDLLock(context.Context, *UnlockDDLLockRequest) (*UnlockDDLLockResponse, error)
-	// OperateWorkerRelayTask requests some dm-workers to operate relay unit
-	OperateWorkerRelayTask(context.Context, *OperateWorkerRelayRequest) (*OperateWorkerRelayResponse, error)
-	// PurgeWorkerRelay purges relay log files for some dm-workers
-	PurgeWorkerRelay(context.Context, *PurgeWorkerRelayRequest) (*PurgeWorkerRelayResponse, error)
-	// CheckTask checks legality of task configuration
-	CheckTask(context.Context, *CheckTaskRequest) (*CheckTaskResponse, error)
-	// Operate an upstream MySQL source.
-	OperateSource(context.Context, *OperateSourceRequest) (*OperateSourceResponse, error)
-	// RegisterWorker register the dm-workers.
-	RegisterWorker(context.Context, *RegisterWorkerRequest) (*RegisterWorkerResponse, error)
-	// OfflineMember offline the dm cluster's members (master/worker).
-	OfflineMember(context.Context, *OfflineMemberRequest) (*OfflineMemberResponse, error)
-	// OperateLeader do some operate on master:
-	//   - evict leader: make the master resign if it is leader, and will not campaign the leader again
-	//   - cancel evict leader: the master can campaign leader again.
-	OperateLeader(context.Context, *OperateLeaderRequest) (*OperateLeaderResponse, error)
-	// ListMember list member information
-	ListMember(context.Context, *ListMemberRequest) (*ListMemberResponse, error)
-	OperateSchema(context.Context, *OperateSchemaRequest) (*OperateSchemaResponse, error)
-	GetSubTaskCfg(context.Context, *GetSubTaskCfgRequest) (*GetSubTaskCfgResponse, error)
-	// GetCfg get config
-	GetCfg(context.Context, *GetCfgRequest) (*GetCfgResponse, error)
-	HandleError(context.Context, *HandleErrorRequest) (*HandleErrorResponse, error)
-	GetMasterCfg(context.Context, *GetMasterCfgRequest) (*GetMasterCfgResponse, error)
-	TransferSource(context.Context, *TransferSourceRequest) (*TransferSourceResponse, error)
-	OperateRelay(context.Context, *OperateRelayRequest) (*OperateRelayResponse, error)
+func (m *OperateTaskLockResponse) GetLocks() []*TaskLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
 }
 
-// UnimplementedMasterServer can be embedded to have forward compatible implementations.
-type UnimplementedMasterServer struct {
+type ValidateTaskConnectivityRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Task string `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
 }
 
-func (*UnimplementedMasterServer) StartTask(ctx context.Context, req *StartTaskRequest) (*StartTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartTask not implemented")
+func (m *ValidateTaskConnectivityRequest) Reset()         { *m = ValidateTaskConnectivityRequest{} }
+func (m *ValidateTaskConnectivityRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateTaskConnectivityRequest) ProtoMessage()    {}
+func (*ValidateTaskConnectivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{58}
 }
-func (*UnimplementedMasterServer) OperateTask(ctx context.Context, req *OperateTaskRequest) (*OperateTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateTask not implemented")
+func (m *ValidateTaskConnectivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMasterServer) UpdateTask(ctx context.Context, req *UpdateTaskRequest) (*UpdateTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+func (m *ValidateTaskConnectivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateTaskConnectivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMasterServer) QueryStatus(ctx context.Context, req *QueryStatusListRequest) (*QueryStatusListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryStatus not implemented")
+func (m *ValidateTaskConnectivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateTaskConnectivityRequest.Merge(m, src)
 }
-func (*UnimplementedMasterServer) ShowDDLLocks(ctx context.Context, req *ShowDDLLocksRequest) (*ShowDDLLocksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowDDLLocks not implemented")
+func (m *ValidateTaskConnectivityRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMasterServer) UnlockDDLLock(ctx context.Context, req *UnlockDDLLockRequest) (*UnlockDDLLockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnlockDDLLock not implemented")
+func (m *ValidateTaskConnectivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateTaskConnectivityRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMasterServer) OperateWorkerRelayTask(ctx context.Context, req *OperateWorkerRelayRequest) (*OperateWorkerRelayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateWorkerRelayTask not implemented")
+
+var xxx_messageInfo_ValidateTaskConnectivityRequest proto.InternalMessageInfo
+
+func (m *ValidateTaskConnectivityRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
-func (*UnimplementedMasterServer) PurgeWorkerRelay(ctx context.Context, req *PurgeWorkerRelayRequest) (*PurgeWorkerRelayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurgeWorkerRelay not implemented")
+
+func (m *ValidateTaskConnectivityRequest) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
 }
-func (*UnimplementedMasterServer) CheckTask(ctx context.Context, req *CheckTaskRequest) (*CheckTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckTask not implemented")
+
+type ValidateTaskConnectivityResponse struct {
+	Result  bool                            `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg     string                          `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Sources []*ValidateConnectivityResponse `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
 }
-func (*UnimplementedMasterServer) OperateSource(ctx context.Context, req *OperateSourceRequest) (*OperateSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateSource not implemented")
+
+func (m *ValidateTaskConnectivityResponse) Reset()         { *m = ValidateTaskConnectivityResponse{} }
+func (m *ValidateTaskConnectivityResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateTaskConnectivityResponse) ProtoMessage()    {}
+func (*ValidateTaskConnectivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{59}
 }
-func (*UnimplementedMasterServer) RegisterWorker(ctx context.Context, req *RegisterWorkerRequest) (*RegisterWorkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterWorker not implemented")
+func (m *ValidateTaskConnectivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMasterServer) OfflineMember(ctx context.Context, req *OfflineMemberRequest) (*OfflineMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OfflineMember not implemented")
+func (m *ValidateTaskConnectivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateTaskConnectivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMasterServer) OperateLeader(ctx context.Context, req *OperateLeaderRequest) (*OperateLeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateLeader not implemented")
+func (m *ValidateTaskConnectivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateTaskConnectivityResponse.Merge(m, src)
 }
-func (*UnimplementedMasterServer) ListMember(ctx context.Context, req *ListMemberRequest) (*ListMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMember not implemented")
+func (m *ValidateTaskConnectivityResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMasterServer) OperateSchema(ctx context.Context, req *OperateSchemaRequest) (*OperateSchemaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateSchema not implemented")
+func (m *ValidateTaskConnectivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateTaskConnectivityResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMasterServer) GetSubTaskCfg(ctx context.Context, req *GetSubTaskCfgRequest) (*GetSubTaskCfgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubTaskCfg not implemented")
+
+var xxx_messageInfo_ValidateTaskConnectivityResponse proto.InternalMessageInfo
+
+func (m *ValidateTaskConnectivityResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
 }
-func (*UnimplementedMasterServer) GetCfg(ctx context.Context, req *GetCfgRequest) (*GetCfgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCfg not implemented")
+
+func (m *ValidateTaskConnectivityResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
-func (*UnimplementedMasterServer) HandleError(ctx context.Context, req *HandleErrorRequest) (*HandleErrorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleError not implemented")
+
+func (m *ValidateTaskConnectivityResponse) GetSources() []*ValidateConnectivityResponse {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
 }
-func (*UnimplementedMasterServer) GetMasterCfg(ctx context.Context, req *GetMasterCfgRequest) (*GetMasterCfgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMasterCfg not implemented")
+
+type BackupEtcdRequest struct {
+	DmOnly   bool  `protobuf:"varint,1,opt,name=dmOnly,proto3" json:"dmOnly,omitempty"`
+	Watch    bool  `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
+	Revision int64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
 }
-func (*UnimplementedMasterServer) TransferSource(ctx context.Context, req *TransferSourceRequest) (*TransferSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferSource not implemented")
+
+func (m *BackupEtcdRequest) Reset()         { *m = BackupEtcdRequest{} }
+func (m *BackupEtcdRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupEtcdRequest) ProtoMessage()    {}
+func (*BackupEtcdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{60}
 }
-func (*UnimplementedMasterServer) OperateRelay(ctx context.Context, req *OperateRelayRequest) (*OperateRelayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateRelay not implemented")
+func (m *BackupEtcdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMasterServer(s *grpc.Server, srv MasterServer) {
-	s.RegisterService(&_Master_serviceDesc, srv)
+func (m *BackupEtcdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupEtcdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupEtcdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupEtcdRequest.Merge(m, src)
+}
+func (m *BackupEtcdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupEtcdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupEtcdRequest.DiscardUnknown(m)
 }
 
-func _Master_StartTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartTaskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).StartTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/StartTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).StartTask(ctx, req.(*StartTaskRequest))
+var xxx_messageInfo_BackupEtcdRequest proto.InternalMessageInfo
+
+func (m *BackupEtcdRequest) GetDmOnly() bool {
+	if m != nil {
+		return m.DmOnly
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Master_OperateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateTaskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).OperateTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/OperateTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).OperateTask(ctx, req.(*OperateTaskRequest))
+func (m *BackupEtcdRequest) GetWatch() bool {
+	if m != nil {
+		return m.Watch
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Master_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTaskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).UpdateTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/UpdateTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+func (m *BackupEtcdRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Master_QueryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatusListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// EtcdKeyValue is a key-value pair in the snapshot, or a change of the key after the snapshot.
+type EtcdKeyValue struct {
+	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Deleted     bool   `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	ModRevision int64  `protobuf:"varint,4,opt,name=modRevision,proto3" json:"modRevision,omitempty"`
+}
+
+func (m *EtcdKeyValue) Reset()         { *m = EtcdKeyValue{} }
+func (m *EtcdKeyValue) String() string { return proto.CompactTextString(m) }
+func (*EtcdKeyValue) ProtoMessage()    {}
+func (*EtcdKeyValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{61}
+}
+func (m *EtcdKeyValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EtcdKeyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EtcdKeyValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MasterServer).QueryStatus(ctx, in)
+}
+func (m *EtcdKeyValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EtcdKeyValue.Merge(m, src)
+}
+func (m *EtcdKeyValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *EtcdKeyValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EtcdKeyValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EtcdKeyValue proto.InternalMessageInfo
+
+func (m *EtcdKeyValue) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/QueryStatus",
+	return ""
+}
+
+func (m *EtcdKeyValue) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).QueryStatus(ctx, req.(*QueryStatusListRequest))
+	return ""
+}
+
+func (m *EtcdKeyValue) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Master_ShowDDLLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShowDDLLocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *EtcdKeyValue) GetModRevision() int64 {
+	if m != nil {
+		return m.ModRevision
 	}
-	if interceptor == nil {
-		return srv.(MasterServer).ShowDDLLocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/ShowDDLLocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).ShowDDLLocks(ctx, req.(*ShowDDLLocksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Master_UnlockDDLLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockDDLLockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).UnlockDDLLock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/UnlockDDLLock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).UnlockDDLLock(ctx, req.(*UnlockDDLLockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// BackupEtcdResponse is a page of the snapshot or a batch of changes.
+// the snapshot is finished with a response whose snapshotDone is true, and then the changes are streamed if watching.
+type BackupEtcdResponse struct {
+	Result       bool            `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg          string          `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Revision     int64           `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Snapshot     bool            `protobuf:"varint,4,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	SnapshotDone bool            `protobuf:"varint,5,opt,name=snapshotDone,proto3" json:"snapshotDone,omitempty"`
+	Kvs          []*EtcdKeyValue `protobuf:"bytes,6,rep,name=kvs,proto3" json:"kvs,omitempty"`
 }
 
-func _Master_OperateWorkerRelayTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateWorkerRelayRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).OperateWorkerRelayTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/OperateWorkerRelayTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).OperateWorkerRelayTask(ctx, req.(*OperateWorkerRelayRequest))
+func (m *BackupEtcdResponse) Reset()         { *m = BackupEtcdResponse{} }
+func (m *BackupEtcdResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupEtcdResponse) ProtoMessage()    {}
+func (*BackupEtcdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{62}
+}
+func (m *BackupEtcdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupEtcdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupEtcdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BackupEtcdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupEtcdResponse.Merge(m, src)
+}
+func (m *BackupEtcdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupEtcdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupEtcdResponse.DiscardUnknown(m)
 }
 
-func _Master_PurgeWorkerRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurgeWorkerRelayRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).PurgeWorkerRelay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/PurgeWorkerRelay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).PurgeWorkerRelay(ctx, req.(*PurgeWorkerRelayRequest))
+var xxx_messageInfo_BackupEtcdResponse proto.InternalMessageInfo
+
+func (m *BackupEtcdResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Master_CheckTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckTaskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).CheckTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/CheckTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).CheckTask(ctx, req.(*CheckTaskRequest))
+func (m *BackupEtcdResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Master_OperateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateSourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).OperateSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/OperateSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).OperateSource(ctx, req.(*OperateSourceRequest))
+func (m *BackupEtcdResponse) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Master_RegisterWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterWorkerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).RegisterWorker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/RegisterWorker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).RegisterWorker(ctx, req.(*RegisterWorkerRequest))
+func (m *BackupEtcdResponse) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Master_OfflineMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OfflineMemberRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).OfflineMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/OfflineMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).OfflineMember(ctx, req.(*OfflineMemberRequest))
+func (m *BackupEtcdResponse) GetSnapshotDone() bool {
+	if m != nil {
+		return m.SnapshotDone
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Master_OperateLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateLeaderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).OperateLeader(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/OperateLeader",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).OperateLeader(ctx, req.(*OperateLeaderRequest))
+func (m *BackupEtcdResponse) GetKvs() []*EtcdKeyValue {
+	if m != nil {
+		return m.Kvs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Master_ListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMemberRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).ListMember(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/ListMember",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).ListMember(ctx, req.(*ListMemberRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuarantineTableRequest struct {
+	Op       QuarantineOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.QuarantineOp" json:"op,omitempty"`
+	Task     string       `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Sources  []string     `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+	Database string       `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	Table    string       `protobuf:"bytes,5,opt,name=table,proto3" json:"table,omitempty"`
 }
 
-func _Master_OperateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateSchemaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).OperateSchema(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/OperateSchema",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).OperateSchema(ctx, req.(*OperateSchemaRequest))
+func (m *QuarantineTableRequest) Reset()         { *m = QuarantineTableRequest{} }
+func (m *QuarantineTableRequest) String() string { return proto.CompactTextString(m) }
+func (*QuarantineTableRequest) ProtoMessage()    {}
+func (*QuarantineTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{63}
+}
+func (m *QuarantineTableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantineTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantineTableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuarantineTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantineTableRequest.Merge(m, src)
+}
+func (m *QuarantineTableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantineTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantineTableRequest.DiscardUnknown(m)
 }
 
-func _Master_GetSubTaskCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubTaskCfgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).GetSubTaskCfg(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/GetSubTaskCfg",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).GetSubTaskCfg(ctx, req.(*GetSubTaskCfgRequest))
+var xxx_messageInfo_QuarantineTableRequest proto.InternalMessageInfo
+
+func (m *QuarantineTableRequest) GetOp() QuarantineOp {
+	if m != nil {
+		return m.Op
 	}
-	return interceptor(ctx, in, info, handler)
+	return QuarantineOp_InvalidQuarantineOp
 }
 
-func _Master_GetCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCfgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QuarantineTableRequest) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	if interceptor == nil {
-		return srv.(MasterServer).GetCfg(ctx, in)
+	return ""
+}
+
+func (m *QuarantineTableRequest) GetSources() []string {
+	if m != nil {
+		return m.Sources
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/GetCfg",
+	return nil
+}
+
+func (m *QuarantineTableRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).GetCfg(ctx, req.(*GetCfgRequest))
+	return ""
+}
+
+func (m *QuarantineTableRequest) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Master_HandleError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HandleErrorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).HandleError(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/HandleError",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).HandleError(ctx, req.(*HandleErrorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuarantineTableResponse struct {
+	Result  bool                    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg     string                  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Sources []*CommonWorkerResponse `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
-func _Master_GetMasterCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMasterCfgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).GetMasterCfg(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/GetMasterCfg",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).GetMasterCfg(ctx, req.(*GetMasterCfgRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuarantineTableResponse) Reset()         { *m = QuarantineTableResponse{} }
+func (m *QuarantineTableResponse) String() string { return proto.CompactTextString(m) }
+func (*QuarantineTableResponse) ProtoMessage()    {}
+func (*QuarantineTableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{64}
 }
-
-func _Master_TransferSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferSourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).TransferSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/TransferSource",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).TransferSource(ctx, req.(*TransferSourceRequest))
+func (m *QuarantineTableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantineTableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantineTableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuarantineTableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantineTableResponse.Merge(m, src)
+}
+func (m *QuarantineTableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantineTableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantineTableResponse.DiscardUnknown(m)
 }
 
-func _Master_OperateRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateRelayRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MasterServer).OperateRelay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Master/OperateRelay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MasterServer).OperateRelay(ctx, req.(*OperateRelayRequest))
+var xxx_messageInfo_QuarantineTableResponse proto.InternalMessageInfo
+
+func (m *QuarantineTableResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Master_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Master",
-	HandlerType: (*MasterServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StartTask",
-			Handler:    _Master_StartTask_Handler,
-		},
-		{
-			MethodName: "OperateTask",
-			Handler:    _Master_OperateTask_Handler,
-		},
-		{
-			MethodName: "UpdateTask",
-			Handler:    _Master_UpdateTask_Handler,
-		},
-		{
-			MethodName: "QueryStatus",
-			Handler:    _Master_QueryStatus_Handler,
-		},
-		{
-			MethodName: "ShowDDLLocks",
-			Handler:    _Master_ShowDDLLocks_Handler,
-		},
-		{
-			MethodName: "UnlockDDLLock",
-			Handler:    _Master_UnlockDDLLock_Handler,
-		},
-		{
-			MethodName: "OperateWorkerRelayTask",
-			Handler:    _Master_OperateWorkerRelayTask_Handler,
-		},
-		{
-			MethodName: "PurgeWorkerRelay",
-			Handler:    _Master_PurgeWorkerRelay_Handler,
-		},
-		{
-			MethodName: "CheckTask",
-			Handler:    _Master_CheckTask_Handler,
-		},
-		{
-			MethodName: "OperateSource",
-			Handler:    _Master_OperateSource_Handler,
-		},
-		{
-			MethodName: "RegisterWorker",
-			Handler:    _Master_RegisterWorker_Handler,
-		},
-		{
-			MethodName: "OfflineMember",
-			Handler:    _Master_OfflineMember_Handler,
-		},
-		{
-			MethodName: "OperateLeader",
-			Handler:    _Master_OperateLeader_Handler,
-		},
-		{
-			MethodName: "ListMember",
-			Handler:    _Master_ListMember_Handler,
-		},
-		{
-			MethodName: "OperateSchema",
-			Handler:    _Master_OperateSchema_Handler,
-		},
-		{
-			MethodName: "GetSubTaskCfg",
-			Handler:    _Master_GetSubTaskCfg_Handler,
-		},
-		{
-			MethodName: "GetCfg",
-			Handler:    _Master_GetCfg_Handler,
-		},
-		{
-			MethodName: "HandleError",
-			Handler:    _Master_HandleError_Handler,
-		},
-		{
-			MethodName: "GetMasterCfg",
-			Handler:    _Master_GetMasterCfg_Handler,
-		},
-		{
-			MethodName: "TransferSource",
-			Handler:    _Master_TransferSource_Handler,
-		},
-		{
-			MethodName: "OperateRelay",
-			Handler:    _Master_OperateRelay_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dmmaster.proto",
+func (m *QuarantineTableResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-func (m *StartTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuarantineTableResponse) GetSources() []*CommonWorkerResponse {
+	if m != nil {
+		return m.Sources
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StartTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OperateProjectRequest struct {
+	Op    ProjectOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.ProjectOp" json:"op,omitempty"`
+	Name  string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tasks []string  `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
 }
 
-func (m *StartTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RemoveMeta {
-		i--
-		if m.RemoveMeta {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *OperateProjectRequest) Reset()         { *m = OperateProjectRequest{} }
+func (m *OperateProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateProjectRequest) ProtoMessage()    {}
+func (*OperateProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{65}
+}
+func (m *OperateProjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperateProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperateProjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+}
+func (m *OperateProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateProjectRequest.Merge(m, src)
+}
+func (m *OperateProjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperateProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateProjectRequest proto.InternalMessageInfo
+
+func (m *OperateProjectRequest) GetOp() ProjectOp {
+	if m != nil {
+		return m.Op
 	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
-		i--
-		dAtA[i] = 0xa
+	return ProjectOp_InvalidProjectOp
+}
+
+func (m *OperateProjectRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *StartTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperateProjectRequest) GetTasks() []string {
+	if m != nil {
+		return m.Tasks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StartTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OperateProjectResponse struct {
+	Result   bool             `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg      string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Projects []*ProjectStatus `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
 }
 
-func (m *StartTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *OperateProjectResponse) Reset()         { *m = OperateProjectResponse{} }
+func (m *OperateProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*OperateProjectResponse) ProtoMessage()    {}
+func (*OperateProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{66}
+}
+func (m *OperateProjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperateProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperateProjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *OperateProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateProjectResponse.Merge(m, src)
+}
+func (m *OperateProjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperateProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateProjectResponse proto.InternalMessageInfo
+
+func (m *OperateProjectResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *OperateProjectResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *OperateTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperateProjectResponse) GetProjects() []*ProjectStatus {
+	if m != nil {
+		return m.Projects
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *OperateTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProjectStatus represents the combined progress of the tasks in a migration project.
+type ProjectStatus struct {
+	Name                      string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tasks                     []string                `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Phase                     string                  `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	Progress                  string                  `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	EstimatedRemainingSeconds int64                   `protobuf:"varint,5,opt,name=estimatedRemainingSeconds,proto3" json:"estimatedRemainingSeconds,omitempty"`
+	CutoverReady              bool                    `protobuf:"varint,6,opt,name=cutoverReady,proto3" json:"cutoverReady,omitempty"`
+	Blockers                  []string                `protobuf:"bytes,7,rep,name=blockers,proto3" json:"blockers,omitempty"`
+	Subtasks                  []*ProjectSubTaskStatus `protobuf:"bytes,8,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
 }
 
-func (m *OperateTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *ProjectStatus) Reset()         { *m = ProjectStatus{} }
+func (m *ProjectStatus) String() string { return proto.CompactTextString(m) }
+func (*ProjectStatus) ProtoMessage()    {}
+func (*ProjectStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{67}
+}
+func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ProjectStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectStatus.Merge(m, src)
+}
+func (m *ProjectStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
+
+func (m *ProjectStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *OperateTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProjectStatus) GetTasks() []string {
+	if m != nil {
+		return m.Tasks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *OperateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProjectStatus) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
 }
 
-func (m *OperateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func (m *ProjectStatus) GetProgress() string {
+	if m != nil {
+		return m.Progress
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *ProjectStatus) GetEstimatedRemainingSeconds() int64 {
+	if m != nil {
+		return m.EstimatedRemainingSeconds
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *ProjectStatus) GetCutoverReady() bool {
+	if m != nil {
+		return m.CutoverReady
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *ProjectStatus) GetBlockers() []string {
+	if m != nil {
+		return m.Blockers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *UpdateTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProjectStatus) GetSubtasks() []*ProjectSubTaskStatus {
+	if m != nil {
+		return m.Subtasks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UpdateTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ProjectSubTaskStatus struct {
+	Task                      string   `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Source                    string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Unit                      UnitType `protobuf:"varint,3,opt,name=unit,proto3,enum=pb.UnitType" json:"unit,omitempty"`
+	Stage                     Stage    `protobuf:"varint,4,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
+	Progress                  string   `protobuf:"bytes,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	EstimatedRemainingSeconds int64    `protobuf:"varint,6,opt,name=estimatedRemainingSeconds,proto3" json:"estimatedRemainingSeconds,omitempty"`
+	SecondsBehindMaster       int64    `protobuf:"varint,7,opt,name=secondsBehindMaster,proto3" json:"secondsBehindMaster,omitempty"`
+	Synced                    bool     `protobuf:"varint,8,opt,name=synced,proto3" json:"synced,omitempty"`
 }
 
-func (m *UpdateTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *ProjectSubTaskStatus) Reset()         { *m = ProjectSubTaskStatus{} }
+func (m *ProjectSubTaskStatus) String() string { return proto.CompactTextString(m) }
+func (*ProjectSubTaskStatus) ProtoMessage()    {}
+func (*ProjectSubTaskStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{68}
+}
+func (m *ProjectSubTaskStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectSubTaskStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectSubTaskStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ProjectSubTaskStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectSubTaskStatus.Merge(m, src)
+}
+func (m *ProjectSubTaskStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectSubTaskStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectSubTaskStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectSubTaskStatus proto.InternalMessageInfo
+
+func (m *ProjectSubTaskStatus) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UpdateTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProjectSubTaskStatus) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProjectSubTaskStatus) GetUnit() UnitType {
+	if m != nil {
+		return m.Unit
+	}
+	return UnitType_InvalidUnit
 }
 
-func (m *UpdateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *ProjectSubTaskStatus) GetStage() Stage {
+	if m != nil {
+		return m.Stage
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+	return Stage_InvalidStage
+}
+
+func (m *ProjectSubTaskStatus) GetProgress() string {
+	if m != nil {
+		return m.Progress
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ProjectSubTaskStatus) GetEstimatedRemainingSeconds() int64 {
+	if m != nil {
+		return m.EstimatedRemainingSeconds
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryStatusListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProjectSubTaskStatus) GetSecondsBehindMaster() int64 {
+	if m != nil {
+		return m.SecondsBehindMaster
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStatusListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProjectSubTaskStatus) GetSynced() bool {
+	if m != nil {
+		return m.Synced
+	}
+	return false
 }
 
-func (m *QueryStatusListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+type OperationHistoryRequest struct {
+	Limit    int64  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Method   string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *OperationHistoryRequest) Reset()         { *m = OperationHistoryRequest{} }
+func (m *OperationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*OperationHistoryRequest) ProtoMessage()    {}
+func (*OperationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{69}
+}
+func (m *OperationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *OperationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationHistoryRequest.Merge(m, src)
+}
+func (m *OperationHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationHistoryRequest proto.InternalMessageInfo
+
+func (m *OperationHistoryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryStatusListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperationHistoryRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStatusListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OperationHistoryRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func (m *QueryStatusListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+type OperationHistoryResponse struct {
+	Result  bool               `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg     string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Records []*OperationRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *OperationHistoryResponse) Reset()         { *m = OperationHistoryResponse{} }
+func (m *OperationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*OperationHistoryResponse) ProtoMessage()    {}
+func (*OperationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{70}
+}
+func (m *OperationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *OperationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationHistoryResponse.Merge(m, src)
+}
+func (m *OperationHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationHistoryResponse proto.InternalMessageInfo
+
+func (m *OperationHistoryResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *OperationHistoryResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ShowDDLLocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperationHistoryResponse) GetRecords() []*OperationRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ShowDDLLocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OperationRecord represents an audit record of a mutating operation on DM-master.
+type OperationRecord struct {
+	Time       string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Method     string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Operator   string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	ClientType string `protobuf:"bytes,4,opt,name=clientType,proto3" json:"clientType,omitempty"`
+	ClientHost string `protobuf:"bytes,5,opt,name=clientHost,proto3" json:"clientHost,omitempty"`
+	Master     string `protobuf:"bytes,6,opt,name=master,proto3" json:"master,omitempty"`
+	Args       string `protobuf:"bytes,7,opt,name=args,proto3" json:"args,omitempty"`
+	Result     bool   `protobuf:"varint,8,opt,name=result,proto3" json:"result,omitempty"`
+	Msg        string `protobuf:"bytes,9,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (m *ShowDDLLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *OperationRecord) Reset()         { *m = OperationRecord{} }
+func (m *OperationRecord) String() string { return proto.CompactTextString(m) }
+func (*OperationRecord) ProtoMessage()    {}
+func (*OperationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{71}
+}
+func (m *OperationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *OperationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperationRecord.Merge(m, src)
+}
+func (m *OperationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperationRecord proto.InternalMessageInfo
+
+func (m *OperationRecord) GetTime() string {
+	if m != nil {
+		return m.Time
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DDLLock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperationRecord) GetMethod() string {
+	if m != nil {
+		return m.Method
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DDLLock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OperationRecord) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
 }
 
-func (m *DDLLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Unsynced) > 0 {
-		for iNdEx := len(m.Unsynced) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Unsynced[iNdEx])
-			copy(dAtA[i:], m.Unsynced[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Unsynced[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
+func (m *OperationRecord) GetClientType() string {
+	if m != nil {
+		return m.ClientType
 	}
-	if len(m.Synced) > 0 {
-		for iNdEx := len(m.Synced) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Synced[iNdEx])
-			copy(dAtA[i:], m.Synced[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Synced[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
+	return ""
+}
+
+func (m *OperationRecord) GetClientHost() string {
+	if m != nil {
+		return m.ClientHost
 	}
-	if len(m.DDLs) > 0 {
-		for iNdEx := len(m.DDLs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DDLs[iNdEx])
-			copy(dAtA[i:], m.DDLs[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.DDLs[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Mode) > 0 {
-		i -= len(m.Mode)
-		copy(dAtA[i:], m.Mode)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Mode)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ShowDDLLocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperationRecord) GetMaster() string {
+	if m != nil {
+		return m.Master
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ShowDDLLocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OperationRecord) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
 }
 
-func (m *ShowDDLLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Locks) > 0 {
-		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *OperationRecord) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *UnlockDDLLockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperationRecord) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UnlockDDLLockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MigrateKeySchemaRequest struct {
+	Rollback bool   `protobuf:"varint,1,opt,name=rollback,proto3" json:"rollback,omitempty"`
+	Version  uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	DryRun   bool   `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
 }
 
-func (m *UnlockDDLLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ForceRemove {
-		i--
-		if m.ForceRemove {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MigrateKeySchemaRequest) Reset()         { *m = MigrateKeySchemaRequest{} }
+func (m *MigrateKeySchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateKeySchemaRequest) ProtoMessage()    {}
+func (*MigrateKeySchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{72}
+}
+func (m *MigrateKeySchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateKeySchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateKeySchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.ReplaceOwner) > 0 {
-		i -= len(m.ReplaceOwner)
-		copy(dAtA[i:], m.ReplaceOwner)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ReplaceOwner)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MigrateKeySchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateKeySchemaRequest.Merge(m, src)
+}
+func (m *MigrateKeySchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateKeySchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateKeySchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateKeySchemaRequest proto.InternalMessageInfo
+
+func (m *MigrateKeySchemaRequest) GetRollback() bool {
+	if m != nil {
+		return m.Rollback
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *MigrateKeySchemaRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UnlockDDLLockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MigrateKeySchemaRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *UnlockDDLLockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MigrateKeySchemaResponse struct {
+	Result        bool               `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg           string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	FromVersion   uint64             `protobuf:"varint,3,opt,name=fromVersion,proto3" json:"fromVersion,omitempty"`
+	ToVersion     uint64             `protobuf:"varint,4,opt,name=toVersion,proto3" json:"toVersion,omitempty"`
+	LatestVersion uint64             `protobuf:"varint,5,opt,name=latestVersion,proto3" json:"latestVersion,omitempty"`
+	Changes       []*KeySchemaChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (m *UnlockDDLLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MigrateKeySchemaResponse) Reset()         { *m = MigrateKeySchemaResponse{} }
+func (m *MigrateKeySchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateKeySchemaResponse) ProtoMessage()    {}
+func (*MigrateKeySchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{73}
+}
+func (m *MigrateKeySchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateKeySchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateKeySchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MigrateKeySchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateKeySchemaResponse.Merge(m, src)
+}
+func (m *MigrateKeySchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateKeySchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateKeySchemaResponse.DiscardUnknown(m)
 }
 
-func (m *OperateWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MigrateKeySchemaResponse proto.InternalMessageInfo
+
+func (m *MigrateKeySchemaResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *OperateWorkerRelayRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MigrateKeySchemaResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-func (m *OperateWorkerRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *MigrateKeySchemaResponse) GetFromVersion() uint64 {
+	if m != nil {
+		return m.FromVersion
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *MigrateKeySchemaResponse) GetToVersion() uint64 {
+	if m != nil {
+		return m.ToVersion
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *OperateWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MigrateKeySchemaResponse) GetLatestVersion() uint64 {
+	if m != nil {
+		return m.LatestVersion
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *OperateWorkerRelayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MigrateKeySchemaResponse) GetChanges() []*KeySchemaChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
 
-func (m *OperateWorkerRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// KeySchemaChange represents a key changed when migrating the etcd key schema.
+type KeySchemaChange struct {
+	Version     uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	OldKey      string `protobuf:"bytes,3,opt,name=oldKey,proto3" json:"oldKey,omitempty"`
+	NewKey      string `protobuf:"bytes,4,opt,name=newKey,proto3" json:"newKey,omitempty"`
+}
+
+func (m *KeySchemaChange) Reset()         { *m = KeySchemaChange{} }
+func (m *KeySchemaChange) String() string { return proto.CompactTextString(m) }
+func (*KeySchemaChange) ProtoMessage()    {}
+func (*KeySchemaChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{74}
+}
+func (m *KeySchemaChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeySchemaChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeySchemaChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *KeySchemaChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeySchemaChange.Merge(m, src)
+}
+func (m *KeySchemaChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeySchemaChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeySchemaChange.DiscardUnknown(m)
 }
 
-func (m *PurgeWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_KeySchemaChange proto.InternalMessageInfo
+
+func (m *KeySchemaChange) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PurgeWorkerRelayRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeySchemaChange) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *PurgeWorkerRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SubDir) > 0 {
-		i -= len(m.SubDir)
-		copy(dAtA[i:], m.SubDir)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.SubDir)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Filename)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Time != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Time))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Inactive {
-		i--
-		if m.Inactive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *KeySchemaChange) GetOldKey() string {
+	if m != nil {
+		return m.OldKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PurgeWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeySchemaChange) GetNewKey() string {
+	if m != nil {
+		return m.NewKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PurgeWorkerRelayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpgradeClusterRequest struct {
+	Op      UpgradeClusterOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.UpgradeClusterOp" json:"op,omitempty"`
+	Version string           `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Worker  string           `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
 }
 
-func (m *PurgeWorkerRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *UpgradeClusterRequest) Reset()         { *m = UpgradeClusterRequest{} }
+func (m *UpgradeClusterRequest) String() string { return proto.CompactTextString(m) }
+func (*UpgradeClusterRequest) ProtoMessage()    {}
+func (*UpgradeClusterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{75}
+}
+func (m *UpgradeClusterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeClusterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeClusterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *UpgradeClusterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeClusterRequest.Merge(m, src)
+}
+func (m *UpgradeClusterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeClusterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeClusterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeClusterRequest proto.InternalMessageInfo
+
+func (m *UpgradeClusterRequest) GetOp() UpgradeClusterOp {
+	if m != nil {
+		return m.Op
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return UpgradeClusterOp_InvalidUpgradeClusterOp
+}
+
+func (m *UpgradeClusterRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CheckTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpgradeClusterRequest) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CheckTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// WorkerUpgrade is the upgrade progress of a DM-worker.
+type WorkerUpgrade struct {
+	Worker      string   `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
+	Stage       string   `protobuf:"bytes,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	Source      string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	HandoverTo  string   `protobuf:"bytes,4,opt,name=handoverTo,proto3" json:"handoverTo,omitempty"`
+	PausedTasks []string `protobuf:"bytes,5,rep,name=pausedTasks,proto3" json:"pausedTasks,omitempty"`
 }
 
-func (m *CheckTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WarnCnt != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.WarnCnt))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ErrCnt != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.ErrCnt))
-		i--
-		dAtA[i] = 0x10
+func (m *WorkerUpgrade) Reset()         { *m = WorkerUpgrade{} }
+func (m *WorkerUpgrade) String() string { return proto.CompactTextString(m) }
+func (*WorkerUpgrade) ProtoMessage()    {}
+func (*WorkerUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{76}
+}
+func (m *WorkerUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *WorkerUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerUpgrade.Merge(m, src)
+}
+func (m *WorkerUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerUpgrade proto.InternalMessageInfo
+
+func (m *WorkerUpgrade) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CheckTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkerUpgrade) GetStage() string {
+	if m != nil {
+		return m.Stage
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CheckTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WorkerUpgrade) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (m *CheckTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *WorkerUpgrade) GetHandoverTo() string {
+	if m != nil {
+		return m.HandoverTo
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *OperateSourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkerUpgrade) GetPausedTasks() []string {
+	if m != nil {
+		return m.PausedTasks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *OperateSourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClusterUpgrade is the window of a rolling upgrade of the cluster.
+type ClusterUpgrade struct {
+	TargetVersion string           `protobuf:"bytes,1,opt,name=targetVersion,proto3" json:"targetVersion,omitempty"`
+	FromVersion   string           `protobuf:"bytes,2,opt,name=fromVersion,proto3" json:"fromVersion,omitempty"`
+	StartTime     string           `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Workers       []*WorkerUpgrade `protobuf:"bytes,4,rep,name=workers,proto3" json:"workers,omitempty"`
 }
 
-func (m *OperateSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SourceID) > 0 {
-		for iNdEx := len(m.SourceID) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SourceID[iNdEx])
-			copy(dAtA[i:], m.SourceID[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.SourceID[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Config) > 0 {
-		for iNdEx := len(m.Config) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Config[iNdEx])
-			copy(dAtA[i:], m.Config[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Config[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *ClusterUpgrade) Reset()         { *m = ClusterUpgrade{} }
+func (m *ClusterUpgrade) String() string { return proto.CompactTextString(m) }
+func (*ClusterUpgrade) ProtoMessage()    {}
+func (*ClusterUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{77}
+}
+func (m *ClusterUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ClusterUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterUpgrade.Merge(m, src)
+}
+func (m *ClusterUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterUpgrade.DiscardUnknown(m)
 }
 
-func (m *OperateSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClusterUpgrade proto.InternalMessageInfo
+
+func (m *ClusterUpgrade) GetTargetVersion() string {
+	if m != nil {
+		return m.TargetVersion
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *OperateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClusterUpgrade) GetFromVersion() string {
+	if m != nil {
+		return m.FromVersion
+	}
+	return ""
 }
 
-func (m *OperateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *ClusterUpgrade) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RegisterWorkerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClusterUpgrade) GetWorkers() []*WorkerUpgrade {
+	if m != nil {
+		return m.Workers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RegisterWorkerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpgradeClusterResponse struct {
+	Result  bool            `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg     string          `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Upgrade *ClusterUpgrade `protobuf:"bytes,3,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
 }
 
-func (m *RegisterWorkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *UpgradeClusterResponse) Reset()         { *m = UpgradeClusterResponse{} }
+func (m *UpgradeClusterResponse) String() string { return proto.CompactTextString(m) }
+func (*UpgradeClusterResponse) ProtoMessage()    {}
+func (*UpgradeClusterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{78}
+}
+func (m *UpgradeClusterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeClusterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeClusterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpgradeClusterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeClusterResponse.Merge(m, src)
+}
+func (m *UpgradeClusterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeClusterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeClusterResponse.DiscardUnknown(m)
 }
 
-func (m *RegisterWorkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpgradeClusterResponse proto.InternalMessageInfo
+
+func (m *UpgradeClusterResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *RegisterWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpgradeClusterResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-func (m *RegisterWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (m *UpgradeClusterResponse) GetUpgrade() *ClusterUpgrade {
+	if m != nil {
+		return m.Upgrade
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *OfflineMemberRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type WatchTaskStatusRequest struct {
+	Tasks        []string `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Interval     string   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	LagThreshold int64    `protobuf:"varint,3,opt,name=lagThreshold,proto3" json:"lagThreshold,omitempty"`
+}
+
+func (m *WatchTaskStatusRequest) Reset()         { *m = WatchTaskStatusRequest{} }
+func (m *WatchTaskStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchTaskStatusRequest) ProtoMessage()    {}
+func (*WatchTaskStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{79}
+}
+func (m *WatchTaskStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchTaskStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchTaskStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *WatchTaskStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchTaskStatusRequest.Merge(m, src)
+}
+func (m *WatchTaskStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchTaskStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchTaskStatusRequest.DiscardUnknown(m)
 }
 
-func (m *OfflineMemberRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_WatchTaskStatusRequest proto.InternalMessageInfo
+
+func (m *WatchTaskStatusRequest) GetTasks() []string {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
 }
 
-func (m *OfflineMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+func (m *WatchTaskStatusRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *OfflineMemberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WatchTaskStatusRequest) GetLagThreshold() int64 {
+	if m != nil {
+		return m.LagThreshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *OfflineMemberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SubTaskStatusChange is the latest status of a subtask which is changed.
+type SubTaskStatusChange struct {
+	Task                string   `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Source              string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Worker              string   `protobuf:"bytes,3,opt,name=worker,proto3" json:"worker,omitempty"`
+	Stage               Stage    `protobuf:"varint,4,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
+	Unit                UnitType `protobuf:"varint,5,opt,name=unit,proto3,enum=pb.UnitType" json:"unit,omitempty"`
+	SecondsBehindMaster int64    `protobuf:"varint,6,opt,name=secondsBehindMaster,proto3" json:"secondsBehindMaster,omitempty"`
+	Errors              []string `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	Removed             bool     `protobuf:"varint,8,opt,name=removed,proto3" json:"removed,omitempty"`
 }
 
-func (m *OfflineMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *SubTaskStatusChange) Reset()         { *m = SubTaskStatusChange{} }
+func (m *SubTaskStatusChange) String() string { return proto.CompactTextString(m) }
+func (*SubTaskStatusChange) ProtoMessage()    {}
+func (*SubTaskStatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{80}
+}
+func (m *SubTaskStatusChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubTaskStatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubTaskStatusChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SubTaskStatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubTaskStatusChange.Merge(m, src)
+}
+func (m *SubTaskStatusChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubTaskStatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubTaskStatusChange.DiscardUnknown(m)
 }
 
-func (m *OperateLeaderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SubTaskStatusChange proto.InternalMessageInfo
+
+func (m *SubTaskStatusChange) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *OperateLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SubTaskStatusChange) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (m *OperateLeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+func (m *SubTaskStatusChange) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *OperateLeaderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SubTaskStatusChange) GetStage() Stage {
+	if m != nil {
+		return m.Stage
 	}
-	return dAtA[:n], nil
+	return Stage_InvalidStage
 }
 
-func (m *OperateLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SubTaskStatusChange) GetUnit() UnitType {
+	if m != nil {
+		return m.Unit
+	}
+	return UnitType_InvalidUnit
 }
 
-func (m *OperateLeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+func (m *SubTaskStatusChange) GetSecondsBehindMaster() int64 {
+	if m != nil {
+		return m.SecondsBehindMaster
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *SubTaskStatusChange) GetErrors() []string {
+	if m != nil {
+		return m.Errors
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MasterInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SubTaskStatusChange) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MasterInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// WatchTaskStatusResponse is a batch of changes found at the same time.
+type WatchTaskStatusResponse struct {
+	Result  bool                   `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg     string                 `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Time    string                 `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Changes []*SubTaskStatusChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (m *MasterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientURLs) > 0 {
-		for iNdEx := len(m.ClientURLs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ClientURLs[iNdEx])
-			copy(dAtA[i:], m.ClientURLs[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ClientURLs[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PeerURLs) > 0 {
-		for iNdEx := len(m.PeerURLs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PeerURLs[iNdEx])
-			copy(dAtA[i:], m.PeerURLs[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.PeerURLs[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Alive {
-		i--
-		if m.Alive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *WatchTaskStatusResponse) Reset()         { *m = WatchTaskStatusResponse{} }
+func (m *WatchTaskStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchTaskStatusResponse) ProtoMessage()    {}
+func (*WatchTaskStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9bef11f2a341f03, []int{81}
+}
+func (m *WatchTaskStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchTaskStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchTaskStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MemberID != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.MemberID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *WatchTaskStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchTaskStatusResponse.Merge(m, src)
+}
+func (m *WatchTaskStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchTaskStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchTaskStatusResponse.DiscardUnknown(m)
 }
 
-func (m *WorkerInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WatchTaskStatusResponse proto.InternalMessageInfo
+
+func (m *WatchTaskStatusResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *WorkerInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WatchTaskStatusResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-func (m *WorkerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x22
+func (m *WatchTaskStatusResponse) GetTime() string {
+	if m != nil {
+		return m.Time
 	}
-	if len(m.Stage) > 0 {
-		i -= len(m.Stage)
-		copy(dAtA[i:], m.Stage)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Stage)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *WatchTaskStatusResponse) GetChanges() []*SubTaskStatusChange {
+	if m != nil {
+		return m.Changes
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ListLeaderMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("pb.SourceOp", SourceOp_name, SourceOp_value)
+	proto.RegisterEnum("pb.LeaderOp", LeaderOp_name, LeaderOp_value)
+	proto.RegisterEnum("pb.CfgType", CfgType_name, CfgType_value)
+	proto.RegisterEnum("pb.RelayOpV2", RelayOpV2_name, RelayOpV2_value)
+	proto.RegisterEnum("pb.TaskLockOp", TaskLockOp_name, TaskLockOp_value)
+	proto.RegisterEnum("pb.ProjectOp", ProjectOp_name, ProjectOp_value)
+	proto.RegisterEnum("pb.UpgradeClusterOp", UpgradeClusterOp_name, UpgradeClusterOp_value)
+	proto.RegisterType((*StartTaskRequest)(nil), "pb.StartTaskRequest")
+	proto.RegisterType((*StartTaskResponse)(nil), "pb.StartTaskResponse")
+	proto.RegisterType((*OperateTaskRequest)(nil), "pb.OperateTaskRequest")
+	proto.RegisterType((*OperateTaskResponse)(nil), "pb.OperateTaskResponse")
+	proto.RegisterType((*UpdateTaskRequest)(nil), "pb.UpdateTaskRequest")
+	proto.RegisterType((*UpdateTaskResponse)(nil), "pb.UpdateTaskResponse")
+	proto.RegisterType((*QueryStatusListRequest)(nil), "pb.QueryStatusListRequest")
+	proto.RegisterType((*QueryStatusListResponse)(nil), "pb.QueryStatusListResponse")
+	proto.RegisterType((*SubTaskProgress)(nil), "pb.SubTaskProgress")
+	proto.RegisterType((*SubTaskProgressHistory)(nil), "pb.SubTaskProgressHistory")
+	proto.RegisterType((*ShowDDLLocksRequest)(nil), "pb.ShowDDLLocksRequest")
+	proto.RegisterType((*DDLLock)(nil), "pb.DDLLock")
+	proto.RegisterType((*ShowDDLLocksResponse)(nil), "pb.ShowDDLLocksResponse")
+	proto.RegisterType((*UnlockDDLLockRequest)(nil), "pb.UnlockDDLLockRequest")
+	proto.RegisterType((*UnlockDDLLockResponse)(nil), "pb.UnlockDDLLockResponse")
+	proto.RegisterType((*OperateWorkerRelayRequest)(nil), "pb.OperateWorkerRelayRequest")
+	proto.RegisterType((*OperateWorkerRelayResponse)(nil), "pb.OperateWorkerRelayResponse")
+	proto.RegisterType((*PurgeWorkerRelayRequest)(nil), "pb.PurgeWorkerRelayRequest")
+	proto.RegisterType((*PurgeWorkerRelayResponse)(nil), "pb.PurgeWorkerRelayResponse")
+	proto.RegisterType((*CheckTaskRequest)(nil), "pb.CheckTaskRequest")
+	proto.RegisterType((*CheckTaskResponse)(nil), "pb.CheckTaskResponse")
+	proto.RegisterType((*OperateSourceRequest)(nil), "pb.OperateSourceRequest")
+	proto.RegisterType((*OperateSourceResponse)(nil), "pb.OperateSourceResponse")
+	proto.RegisterType((*RegisterWorkerRequest)(nil), "pb.RegisterWorkerRequest")
+	proto.RegisterType((*RegisterWorkerResponse)(nil), "pb.RegisterWorkerResponse")
+	proto.RegisterType((*OfflineMemberRequest)(nil), "pb.OfflineMemberRequest")
+	proto.RegisterType((*OfflineMemberResponse)(nil), "pb.OfflineMemberResponse")
+	proto.RegisterType((*OperateLeaderRequest)(nil), "pb.OperateLeaderRequest")
+	proto.RegisterType((*OperateLeaderResponse)(nil), "pb.OperateLeaderResponse")
+	proto.RegisterType((*MasterInfo)(nil), "pb.MasterInfo")
+	proto.RegisterType((*WorkerInfo)(nil), "pb.WorkerInfo")
+	proto.RegisterType((*ListLeaderMember)(nil), "pb.ListLeaderMember")
+	proto.RegisterType((*ListMasterMember)(nil), "pb.ListMasterMember")
+	proto.RegisterType((*ListWorkerMember)(nil), "pb.ListWorkerMember")
+	proto.RegisterType((*Members)(nil), "pb.Members")
+	proto.RegisterType((*ListMemberRequest)(nil), "pb.ListMemberRequest")
+	proto.RegisterType((*ListMemberResponse)(nil), "pb.ListMemberResponse")
+	proto.RegisterType((*OperateSchemaRequest)(nil), "pb.OperateSchemaRequest")
+	proto.RegisterType((*OperateSchemaResponse)(nil), "pb.OperateSchemaResponse")
+	proto.RegisterType((*GetSubTaskCfgRequest)(nil), "pb.GetSubTaskCfgRequest")
+	proto.RegisterType((*GetSubTaskCfgResponse)(nil), "pb.GetSubTaskCfgResponse")
+	proto.RegisterType((*GetCfgRequest)(nil), "pb.GetCfgRequest")
+	proto.RegisterType((*CfgRevision)(nil), "pb.CfgRevision")
+	proto.RegisterType((*CfgEntry)(nil), "pb.CfgEntry")
+	proto.RegisterType((*GetCfgResponse)(nil), "pb.GetCfgResponse")
+	proto.RegisterType((*GetMasterCfgRequest)(nil), "pb.GetMasterCfgRequest")
+	proto.RegisterType((*GetMasterCfgResponse)(nil), "pb.GetMasterCfgResponse")
+	proto.RegisterType((*HandleErrorRequest)(nil), "pb.HandleErrorRequest")
+	proto.RegisterType((*HandleErrorResponse)(nil), "pb.HandleErrorResponse")
+	proto.RegisterType((*TransferSourceRequest)(nil), "pb.TransferSourceRequest")
+	proto.RegisterType((*TransferSourceResponse)(nil), "pb.TransferSourceResponse")
+	proto.RegisterType((*OperateRelayRequest)(nil), "pb.OperateRelayRequest")
+	proto.RegisterType((*OperateRelayResponse)(nil), "pb.OperateRelayResponse")
+	proto.RegisterType((*RestoreClusterRequest)(nil), "pb.RestoreClusterRequest")
+	proto.RegisterType((*RestoreClusterResponse)(nil), "pb.RestoreClusterResponse")
+	proto.RegisterType((*TaskLock)(nil), "pb.TaskLock")
+	proto.RegisterType((*OperateTaskLockRequest)(nil), "pb.OperateTaskLockRequest")
+	proto.RegisterType((*OperateTaskLockResponse)(nil), "pb.OperateTaskLockResponse")
+	proto.RegisterType((*ValidateTaskConnectivityRequest)(nil), "pb.ValidateTaskConnectivityRequest")
+	proto.RegisterType((*ValidateTaskConnectivityResponse)(nil), "pb.ValidateTaskConnectivityResponse")
+	proto.RegisterType((*BackupEtcdRequest)(nil), "pb.BackupEtcdRequest")
+	proto.RegisterType((*EtcdKeyValue)(nil), "pb.EtcdKeyValue")
+	proto.RegisterType((*BackupEtcdResponse)(nil), "pb.BackupEtcdResponse")
+	proto.RegisterType((*QuarantineTableRequest)(nil), "pb.QuarantineTableRequest")
+	proto.RegisterType((*QuarantineTableResponse)(nil), "pb.QuarantineTableResponse")
+	proto.RegisterType((*OperateProjectRequest)(nil), "pb.OperateProjectRequest")
+	proto.RegisterType((*OperateProjectResponse)(nil), "pb.OperateProjectResponse")
+	proto.RegisterType((*ProjectStatus)(nil), "pb.ProjectStatus")
+	proto.RegisterType((*ProjectSubTaskStatus)(nil), "pb.ProjectSubTaskStatus")
+	proto.RegisterType((*OperationHistoryRequest)(nil), "pb.OperationHistoryRequest")
+	proto.RegisterType((*OperationHistoryResponse)(nil), "pb.OperationHistoryResponse")
+	proto.RegisterType((*OperationRecord)(nil), "pb.OperationRecord")
+	proto.RegisterType((*MigrateKeySchemaRequest)(nil), "pb.MigrateKeySchemaRequest")
+	proto.RegisterType((*MigrateKeySchemaResponse)(nil), "pb.MigrateKeySchemaResponse")
+	proto.RegisterType((*KeySchemaChange)(nil), "pb.KeySchemaChange")
+	proto.RegisterType((*UpgradeClusterRequest)(nil), "pb.UpgradeClusterRequest")
+	proto.RegisterType((*WorkerUpgrade)(nil), "pb.WorkerUpgrade")
+	proto.RegisterType((*ClusterUpgrade)(nil), "pb.ClusterUpgrade")
+	proto.RegisterType((*UpgradeClusterResponse)(nil), "pb.UpgradeClusterResponse")
+	proto.RegisterType((*WatchTaskStatusRequest)(nil), "pb.WatchTaskStatusRequest")
+	proto.RegisterType((*SubTaskStatusChange)(nil), "pb.SubTaskStatusChange")
+	proto.RegisterType((*WatchTaskStatusResponse)(nil), "pb.WatchTaskStatusResponse")
+}
+
+func init() { proto.RegisterFile("dmmaster.proto", fileDescriptor_f9bef11f2a341f03) }
+
+var fileDescriptor_f9bef11f2a341f03 = []byte{
+	// 3897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3b, 0x4b, 0x6c, 0x24, 0x49,
+	0x56, 0xce, 0xfa, 0xd8, 0x55, 0xcf, 0xbf, 0x72, 0xb8, 0x5c, 0xae, 0xce, 0xee, 0x71, 0x7b, 0x63,
+	0x7b, 0x87, 0xc6, 0xec, 0x8e, 0x67, 0xcc, 0x9e, 0x46, 0x2c, 0x30, 0x6d, 0xf7, 0xce, 0x58, 0xe3,
+	0xa6, 0x7b, 0xd3, 0xee, 0xde, 0x59, 0x01, 0xd2, 0xa6, 0x33, 0xa3, 0xaa, 0x12, 0x67, 0x65, 0x66,
+	0x67, 0x66, 0xd9, 0x5b, 0x1a, 0x8d, 0x84, 0x96, 0x03, 0x68, 0x0f, 0x80, 0xe0, 0xb0, 0x88, 0xcb,
+	0x22, 0xed, 0x89, 0x0b, 0x88, 0x2b, 0x07, 0x4e, 0x1c, 0x38, 0xae, 0x84, 0x84, 0xe0, 0xb6, 0x9a,
+	0xe1, 0xce, 0x9d, 0x13, 0x8a, 0x78, 0x11, 0x99, 0x91, 0x59, 0x59, 0x6e, 0xca, 0xd2, 0xf6, 0x2d,
+	0xdf, 0x8b, 0xa8, 0x78, 0xbf, 0x88, 0xf7, 0xe2, 0xbd, 0x17, 0x05, 0x1b, 0xee, 0x78, 0x6c, 0x27,
+	0x29, 0x8b, 0xdf, 0x8b, 0xe2, 0x30, 0x0d, 0x49, 0x2d, 0xba, 0x34, 0x37, 0xdc, 0xf1, 0x4d, 0x18,
+	0x5f, 0x29, 0x9c, 0xf9, 0x60, 0x18, 0x86, 0x43, 0x9f, 0x1d, 0xda, 0x91, 0x77, 0x68, 0x07, 0x41,
+	0x98, 0xda, 0xa9, 0x17, 0x06, 0x09, 0x8e, 0xd2, 0x7f, 0x36, 0xa0, 0x73, 0x9e, 0xda, 0x71, 0x7a,
+	0x61, 0x27, 0x57, 0x16, 0x7b, 0x3d, 0x61, 0x49, 0x4a, 0x08, 0x34, 0x52, 0x3b, 0xb9, 0xea, 0x1b,
+	0xfb, 0xc6, 0xe3, 0xb6, 0x25, 0xbe, 0x49, 0x1f, 0x56, 0x92, 0x70, 0x12, 0x3b, 0x2c, 0xe9, 0xd7,
+	0xf6, 0xeb, 0x8f, 0xdb, 0x96, 0x02, 0xc9, 0x1e, 0x40, 0xcc, 0xc6, 0xe1, 0x35, 0x7b, 0xc6, 0x52,
+	0xbb, 0x5f, 0xdf, 0x37, 0x1e, 0xb7, 0x2c, 0x0d, 0x43, 0x28, 0xac, 0xd9, 0xbe, 0x1f, 0xde, 0x3c,
+	0xbf, 0x66, 0xb1, 0x6f, 0x47, 0xfd, 0x86, 0x98, 0x51, 0xc0, 0x91, 0x07, 0xd0, 0x4e, 0x04, 0x17,
+	0xde, 0x98, 0xf5, 0x9b, 0x82, 0x6c, 0x8e, 0x20, 0x3d, 0x58, 0x76, 0xe3, 0xa9, 0x35, 0x09, 0xfa,
+	0xcb, 0xe2, 0xb7, 0x12, 0xa2, 0xaf, 0x61, 0x4b, 0xe3, 0x3d, 0x89, 0xc2, 0x20, 0x11, 0x93, 0x63,
+	0x96, 0x4c, 0xfc, 0x54, 0xb0, 0xdf, 0xb2, 0x24, 0x44, 0x3a, 0x50, 0x1f, 0x27, 0xc3, 0x7e, 0x4d,
+	0x2c, 0xce, 0x3f, 0xc9, 0x51, 0x2e, 0x52, 0x7d, 0xbf, 0xfe, 0x78, 0xf5, 0xa8, 0xff, 0x5e, 0x74,
+	0xf9, 0xde, 0x71, 0x38, 0x1e, 0x87, 0xc1, 0xf7, 0x85, 0x0a, 0xd5, 0xa2, 0x99, 0xb0, 0xf4, 0xef,
+	0x0c, 0x20, 0xcf, 0x23, 0x16, 0xdb, 0x29, 0xd3, 0x35, 0x66, 0x42, 0x2d, 0x8c, 0x04, 0xc1, 0x8d,
+	0x23, 0xe0, 0xab, 0xf0, 0xc1, 0xe7, 0x91, 0x55, 0x0b, 0x23, 0xae, 0xcd, 0xc0, 0x1e, 0x33, 0x49,
+	0x59, 0x7c, 0xeb, 0xda, 0xac, 0x17, 0xb5, 0x79, 0x00, 0x9d, 0x98, 0x25, 0x2c, 0x7d, 0x1a, 0xc7,
+	0x61, 0xfc, 0x64, 0xe2, 0x0e, 0x59, 0x2a, 0x35, 0x36, 0x83, 0x27, 0x5d, 0x68, 0x0e, 0xc2, 0xd8,
+	0x41, 0x8d, 0xb5, 0x2c, 0x04, 0xe8, 0x5f, 0x18, 0xb0, 0x5d, 0x60, 0x51, 0x2a, 0xe6, 0x36, 0x1e,
+	0x73, 0xa5, 0xd5, 0xaa, 0x94, 0x56, 0xaf, 0x54, 0x5a, 0xe3, 0xff, 0xab, 0xb4, 0x8f, 0x60, 0xeb,
+	0x65, 0xe4, 0x96, 0x54, 0xb6, 0xd0, 0x26, 0xa3, 0x31, 0x10, 0x7d, 0x89, 0xb7, 0x62, 0xeb, 0x3f,
+	0x80, 0xde, 0xf7, 0x26, 0x2c, 0x9e, 0x9e, 0xa7, 0x76, 0x3a, 0x49, 0xce, 0xbc, 0x24, 0xd5, 0x78,
+	0x17, 0x26, 0x35, 0xaa, 0x4d, 0x5a, 0x3a, 0x20, 0x5d, 0x68, 0x26, 0x5e, 0xe0, 0x30, 0xa9, 0x46,
+	0x04, 0xe8, 0x2f, 0x0d, 0xd8, 0x9d, 0x59, 0x7e, 0x61, 0xb9, 0x3e, 0x28, 0xcb, 0xb5, 0xcb, 0xe5,
+	0xd2, 0xd6, 0x9d, 0x11, 0x8b, 0x50, 0x68, 0xfa, 0xa1, 0x73, 0xa5, 0xec, 0xb7, 0xa6, 0xb6, 0xc2,
+	0x59, 0xe8, 0x5c, 0x59, 0x38, 0x44, 0x4e, 0x60, 0x33, 0x8a, 0xc3, 0x61, 0xcc, 0x92, 0xe4, 0x13,
+	0x2f, 0x49, 0xc3, 0x78, 0xda, 0x6f, 0x8a, 0xd9, 0x26, 0x9f, 0x7d, 0x3e, 0xb9, 0xe4, 0x3f, 0x78,
+	0x51, 0x9c, 0x61, 0x95, 0x7f, 0x42, 0xff, 0xd5, 0x80, 0xcd, 0xd2, 0x5c, 0x61, 0x76, 0x2f, 0x57,
+	0x1d, 0xff, 0x26, 0x0f, 0xa1, 0x99, 0xa4, 0xf6, 0x10, 0x8f, 0xc8, 0xc6, 0x51, 0x5b, 0xd0, 0xe0,
+	0x08, 0x0b, 0xf1, 0x64, 0x1f, 0x1a, 0x93, 0xc0, 0x4b, 0x85, 0x02, 0x37, 0x90, 0xe3, 0x97, 0x81,
+	0x97, 0x5e, 0x4c, 0x23, 0x66, 0x89, 0x11, 0x62, 0x42, 0xcb, 0x0f, 0x1d, 0xe1, 0xda, 0xc4, 0x71,
+	0x69, 0x5b, 0x19, 0xcc, 0x49, 0x0e, 0x53, 0xcf, 0x95, 0x7e, 0x45, 0x7c, 0x73, 0x5c, 0x1c, 0xde,
+	0x24, 0xc2, 0xa1, 0xd4, 0x2d, 0xf1, 0xcd, 0xb5, 0xce, 0xf8, 0xe9, 0x4a, 0xfa, 0x2b, 0xc2, 0x80,
+	0x12, 0xa2, 0x3f, 0xae, 0x41, 0xaf, 0x5a, 0xe4, 0xca, 0x4d, 0xdc, 0x83, 0x65, 0x54, 0xb5, 0xb4,
+	0x93, 0x84, 0xc8, 0xaf, 0x43, 0x73, 0xe0, 0xc5, 0x09, 0x4a, 0xb1, 0x7a, 0xb4, 0x5d, 0xa1, 0x49,
+	0x0b, 0x67, 0x90, 0x5f, 0x83, 0x86, 0x6f, 0x27, 0x78, 0xf0, 0xe7, 0xcc, 0x14, 0x13, 0xf8, 0xd6,
+	0xe2, 0x7e, 0xd6, 0x55, 0x1e, 0x40, 0x00, 0x64, 0x1f, 0x56, 0xb9, 0x40, 0x1f, 0x45, 0x91, 0xef,
+	0x31, 0x57, 0xca, 0xa8, 0xa3, 0xe6, 0x89, 0x2a, 0x36, 0xb1, 0x3d, 0x8e, 0x7c, 0x96, 0xf4, 0x5b,
+	0xe2, 0x57, 0x0a, 0xa4, 0xc7, 0xb0, 0x7d, 0x3e, 0x0a, 0x6f, 0x4e, 0x4e, 0xce, 0xf8, 0x3e, 0x49,
+	0xee, 0x76, 0x8a, 0x7f, 0x66, 0xc0, 0x8a, 0x5c, 0x81, 0x6c, 0x40, 0xed, 0xf4, 0x44, 0xfe, 0xae,
+	0x76, 0x7a, 0x92, 0xad, 0x54, 0xd3, 0x56, 0x22, 0xd0, 0x18, 0x87, 0xae, 0x3a, 0x38, 0xe2, 0x9b,
+	0x8b, 0x1c, 0xde, 0x04, 0x2c, 0x96, 0x66, 0x46, 0x80, 0xcf, 0x3c, 0x39, 0x39, 0x4b, 0xc4, 0x2e,
+	0x6d, 0x5b, 0xe2, 0x5b, 0x18, 0x62, 0x1a, 0x38, 0x42, 0x03, 0x42, 0x48, 0x84, 0xf8, 0x5e, 0x99,
+	0x04, 0x72, 0x04, 0xc5, 0xcf, 0x60, 0xea, 0x40, 0xb7, 0x28, 0xe6, 0xc2, 0x27, 0xf2, 0x6b, 0xea,
+	0x78, 0xe1, 0x79, 0x5c, 0xe5, 0xc6, 0x93, 0xcb, 0xc9, 0xd3, 0x45, 0x7d, 0xe8, 0xbe, 0x0c, 0xf8,
+	0xa7, 0xc2, 0x4b, 0x65, 0x96, 0x55, 0x42, 0x61, 0x2d, 0x66, 0x91, 0x6f, 0x3b, 0xec, 0xb9, 0x90,
+	0x18, 0xa9, 0x14, 0x70, 0xdc, 0xd6, 0xc2, 0xed, 0x5b, 0x22, 0xe0, 0xca, 0xf0, 0xab, 0xa3, 0xe8,
+	0x47, 0xb0, 0x53, 0xa2, 0xb6, 0xa8, 0x4c, 0xd4, 0x82, 0x7b, 0x32, 0xa2, 0x28, 0x5f, 0xe9, 0xdb,
+	0x53, 0xc5, 0xf5, 0x7d, 0x2d, 0xae, 0x08, 0x69, 0xc5, 0xa8, 0x0c, 0x2c, 0xf3, 0xf7, 0xc2, 0x4f,
+	0x0d, 0x30, 0xab, 0x16, 0x95, 0xcc, 0xdd, 0xba, 0xea, 0xaf, 0x36, 0x5c, 0xfd, 0xa3, 0x01, 0xbb,
+	0x2f, 0x26, 0xf1, 0xb0, 0x4a, 0x58, 0x4d, 0x1e, 0xa3, 0xe8, 0xe5, 0x4d, 0x68, 0x79, 0x81, 0xed,
+	0xa4, 0xde, 0x35, 0x93, 0x5c, 0x65, 0x70, 0xe6, 0xf4, 0xea, 0xe8, 0x6d, 0x84, 0xd3, 0x33, 0xa1,
+	0x35, 0xf0, 0x7c, 0x26, 0xe2, 0x88, 0xf4, 0x58, 0x0a, 0x16, 0x3b, 0x77, 0x72, 0x79, 0xe2, 0xc5,
+	0xd2, 0x67, 0x49, 0x68, 0xee, 0x45, 0xe8, 0x47, 0xd0, 0x9f, 0x65, 0xf8, 0xad, 0xc4, 0xc8, 0xcf,
+	0xa0, 0x73, 0x3c, 0x62, 0xce, 0xd5, 0x9b, 0x22, 0x3b, 0x3a, 0x9c, 0xe3, 0x00, 0x2d, 0x56, 0xb7,
+	0x24, 0xc4, 0xf5, 0x79, 0x63, 0xc7, 0x01, 0x1f, 0x40, 0xe5, 0x28, 0x90, 0x7e, 0x07, 0xb6, 0xb4,
+	0x95, 0x17, 0xde, 0xb2, 0x23, 0xe8, 0xca, 0xdd, 0x75, 0x2e, 0x58, 0x55, 0xcc, 0x3d, 0xd0, 0xf6,
+	0x95, 0x08, 0x24, 0x38, 0x9c, 0x6f, 0x2c, 0x27, 0x0c, 0x06, 0xde, 0x50, 0xee, 0x56, 0x09, 0x71,
+	0x63, 0xa1, 0xc4, 0xa7, 0x27, 0xf2, 0xc2, 0x96, 0xc1, 0x74, 0x02, 0x3b, 0x25, 0x4a, 0x6f, 0x45,
+	0xf3, 0x4f, 0x61, 0xc7, 0x62, 0x43, 0x8f, 0xdf, 0xfe, 0xd5, 0x94, 0x5b, 0x2f, 0x27, 0xb6, 0xeb,
+	0xf2, 0xc0, 0x21, 0xc9, 0x2a, 0x90, 0x3e, 0x81, 0x5e, 0x79, 0x99, 0x85, 0x75, 0xfd, 0xdb, 0xd0,
+	0x7d, 0x3e, 0x18, 0xf8, 0x5e, 0xc0, 0x9e, 0xb1, 0xf1, 0x65, 0x81, 0x93, 0x74, 0x1a, 0xe5, 0xb1,
+	0x7e, 0x1a, 0xb1, 0xaa, 0xdb, 0x30, 0xf7, 0x50, 0xa5, 0xdf, 0x2f, 0xcc, 0xc2, 0xb7, 0x33, 0x73,
+	0x9f, 0x31, 0xdb, 0xcd, 0x59, 0x98, 0x31, 0x37, 0x0e, 0xa3, 0xb9, 0x05, 0xe1, 0xe2, 0xaf, 0x16,
+	0x26, 0xfc, 0xe7, 0x06, 0xc0, 0x33, 0x91, 0x83, 0x9d, 0x06, 0x83, 0xb0, 0x52, 0xf9, 0x26, 0xb4,
+	0xc6, 0x42, 0xae, 0xd3, 0x13, 0xf1, 0xcb, 0x86, 0x95, 0xc1, 0x3c, 0x9a, 0xd9, 0xbe, 0x97, 0x39,
+	0x6e, 0x04, 0xf8, 0x2f, 0x22, 0xc6, 0xe2, 0x97, 0xd6, 0x19, 0xba, 0xad, 0xb6, 0x95, 0xc1, 0x3c,
+	0xdd, 0x72, 0x7c, 0x8f, 0x05, 0xa9, 0x18, 0xc5, 0x78, 0xa7, 0x61, 0xe8, 0x25, 0x00, 0x1a, 0x72,
+	0x2e, 0x3f, 0x04, 0x1a, 0xdc, 0xfa, 0xca, 0x04, 0xfc, 0x5b, 0xdc, 0x51, 0xc5, 0x15, 0x4c, 0xdd,
+	0x51, 0xc5, 0xbd, 0x2b, 0xbf, 0xca, 0x34, 0xf4, 0xab, 0x0c, 0x3d, 0x83, 0x0e, 0xbf, 0xaf, 0xa2,
+	0xd2, 0xd0, 0x66, 0x4a, 0x35, 0x46, 0xbe, 0xab, 0xab, 0x12, 0x1f, 0x45, 0xbb, 0x9e, 0xd3, 0xa6,
+	0xbf, 0x87, 0xab, 0xa1, 0x16, 0xe7, 0xae, 0xf6, 0x18, 0x56, 0x30, 0xd7, 0xc5, 0x48, 0xb2, 0x7a,
+	0xb4, 0xc1, 0xcd, 0x99, 0xab, 0xde, 0x52, 0xc3, 0x6a, 0x3d, 0xd4, 0xc2, 0x6d, 0xeb, 0x61, 0x9e,
+	0x5c, 0x58, 0x2f, 0x57, 0x9d, 0xa5, 0x86, 0xe9, 0xcf, 0x0d, 0x58, 0xc1, 0x65, 0x12, 0xf2, 0x1e,
+	0x2c, 0xfb, 0x42, 0x6a, 0xb1, 0xd4, 0xea, 0x51, 0x57, 0xec, 0xa9, 0x92, 0x2e, 0x3e, 0x59, 0xb2,
+	0xe4, 0x2c, 0x3e, 0x1f, 0xd9, 0x12, 0x5a, 0xd0, 0xe6, 0xeb, 0xd2, 0xf2, 0xf9, 0x38, 0x8b, 0xcf,
+	0x47, 0xb2, 0xf2, 0x96, 0x98, 0xcd, 0xd7, 0xa5, 0xe1, 0xf3, 0x71, 0xd6, 0x93, 0x16, 0x2c, 0xe3,
+	0x5e, 0xe2, 0xc9, 0xb0, 0x58, 0xb7, 0x70, 0x02, 0x7b, 0x05, 0x76, 0x5b, 0x19, 0x5b, 0xbd, 0x02,
+	0x5b, 0xad, 0x8c, 0x7c, 0xaf, 0x40, 0xbe, 0xa5, 0xc8, 0xf0, 0xed, 0xc1, 0xcd, 0xa7, 0x76, 0x23,
+	0x02, 0x94, 0x01, 0xd1, 0x49, 0x2e, 0xec, 0xf6, 0xbe, 0x01, 0x2b, 0xc8, 0x7c, 0xe1, 0xb2, 0x24,
+	0x55, 0x6d, 0xa9, 0x31, 0xfa, 0x1f, 0x46, 0xee, 0xcb, 0x9d, 0x11, 0x1b, 0xdb, 0xf3, 0x7d, 0xb9,
+	0x18, 0xce, 0xf3, 0xee, 0x99, 0x0b, 0xe5, 0xfc, 0xbc, 0xdb, 0x84, 0x96, 0x6b, 0xa7, 0xf6, 0xa5,
+	0x9d, 0x64, 0xe1, 0x58, 0xc1, 0x5c, 0xfa, 0xd4, 0xbe, 0xf4, 0x55, 0x65, 0x02, 0x01, 0x71, 0x38,
+	0x04, 0x3d, 0x11, 0x8c, 0xf9, 0xe1, 0x10, 0x90, 0xc8, 0xca, 0xfd, 0x49, 0x32, 0xea, 0xaf, 0xc8,
+	0xac, 0x9c, 0x03, 0x9c, 0x1b, 0x7e, 0xc5, 0x14, 0xd7, 0xea, 0x96, 0x25, 0xbe, 0xf5, 0xc8, 0x21,
+	0xe5, 0x7a, 0x2b, 0x91, 0xe3, 0x00, 0xba, 0x1f, 0xb3, 0x54, 0x26, 0x14, 0xc7, 0x83, 0xe1, 0x2d,
+	0x81, 0x83, 0xbe, 0x84, 0x9d, 0xd2, 0xdc, 0x85, 0x59, 0x24, 0xd0, 0x70, 0x06, 0x43, 0xa5, 0x70,
+	0xf1, 0x4d, 0x5f, 0xc1, 0xfa, 0xc7, 0x2c, 0xd5, 0x68, 0x3f, 0xd4, 0x42, 0x85, 0xbc, 0xf0, 0x1d,
+	0x0f, 0x86, 0x98, 0xe0, 0xcd, 0x8b, 0x1b, 0x9c, 0x96, 0xed, 0xfb, 0x72, 0xab, 0xf2, 0x4f, 0xfa,
+	0x27, 0x06, 0xac, 0x8a, 0x55, 0xaf, 0xbd, 0x84, 0xa7, 0x7e, 0x1d, 0xa8, 0x5f, 0xb1, 0xa9, 0x3a,
+	0xf6, 0x57, 0x6c, 0x4a, 0xde, 0x85, 0x0d, 0x27, 0x66, 0x76, 0xca, 0xd4, 0x1c, 0x79, 0x21, 0x29,
+	0x61, 0xf9, 0xbd, 0x7a, 0x1c, 0xba, 0xd9, 0x24, 0xbc, 0x9c, 0xe8, 0x28, 0xbe, 0x97, 0xae, 0x59,
+	0x9c, 0xa8, 0x8c, 0xb3, 0x6e, 0x29, 0x90, 0xfe, 0x95, 0x01, 0xad, 0xe3, 0xc1, 0xf0, 0x69, 0x90,
+	0xc6, 0xd3, 0x3b, 0x4b, 0xe6, 0x0c, 0xb2, 0x8b, 0xac, 0x33, 0x18, 0x2a, 0xbd, 0x36, 0x72, 0xbd,
+	0x7e, 0x0b, 0xda, 0xb1, 0xe4, 0x25, 0x91, 0xd9, 0xf9, 0xa6, 0x5c, 0x5d, 0xf1, 0x68, 0xe5, 0x33,
+	0xe8, 0x4f, 0x0c, 0xd8, 0x50, 0x3a, 0x5f, 0xd8, 0x86, 0xb3, 0xfc, 0x98, 0xd0, 0x52, 0x6b, 0x4b,
+	0xf1, 0x33, 0x98, 0xa7, 0xeb, 0xc2, 0xe2, 0xcd, 0xbc, 0xc0, 0xa0, 0xd4, 0x21, 0xed, 0xbf, 0x03,
+	0xdb, 0x1f, 0x33, 0xe9, 0x03, 0xf3, 0x5d, 0x40, 0x1f, 0x8b, 0x9d, 0xa9, 0xa1, 0x25, 0xa3, 0x92,
+	0xbc, 0x91, 0x91, 0xa7, 0xff, 0x64, 0x00, 0xf9, 0xc4, 0x0e, 0x5c, 0x9f, 0x89, 0x82, 0xd8, 0xdc,
+	0x5c, 0x44, 0x8c, 0xde, 0xc9, 0x21, 0x3c, 0x80, 0xf6, 0xa5, 0x17, 0xf8, 0xe1, 0xf0, 0x45, 0x98,
+	0x48, 0xb5, 0xe7, 0x08, 0x71, 0x9c, 0x5f, 0xfb, 0x59, 0xbe, 0xc9, 0xbf, 0x79, 0x64, 0xc6, 0x09,
+	0x1f, 0x5f, 0x9c, 0x9e, 0x48, 0xa7, 0xa0, 0x61, 0x68, 0x02, 0xdb, 0x05, 0x96, 0xdf, 0xca, 0x61,
+	0x77, 0x60, 0xe7, 0x22, 0xb6, 0x83, 0x64, 0xc0, 0xe2, 0xe2, 0x45, 0x38, 0x8f, 0xed, 0x46, 0xa1,
+	0x4c, 0x91, 0x87, 0x00, 0x59, 0xbe, 0x90, 0x21, 0xc0, 0x84, 0xd6, 0xc8, 0x0e, 0xdc, 0xf0, 0x3a,
+	0x0b, 0x0e, 0x19, 0x4c, 0x5d, 0xe8, 0x95, 0x89, 0x2c, 0x2c, 0xdc, 0x3e, 0xac, 0x46, 0xf6, 0x24,
+	0x61, 0x2e, 0xf7, 0x34, 0xca, 0x1a, 0x3a, 0x8a, 0xba, 0x59, 0x5d, 0xb3, 0x90, 0x92, 0xbd, 0xa3,
+	0xd9, 0x7c, 0x5d, 0xcb, 0x14, 0x5f, 0x1d, 0xa9, 0x2b, 0x7d, 0x65, 0x39, 0x46, 0x0f, 0x75, 0xf5,
+	0x5c, 0x4e, 0xfa, 0xbb, 0x59, 0xb0, 0xb9, 0x63, 0x1e, 0x45, 0x0f, 0xf9, 0xcd, 0x3c, 0x49, 0xc3,
+	0x98, 0x1d, 0xfb, 0x13, 0xbe, 0x95, 0x35, 0x95, 0x5f, 0xda, 0xce, 0xd5, 0x24, 0x52, 0x2a, 0x47,
+	0x08, 0xef, 0xe0, 0xc5, 0x1f, 0x2c, 0x4c, 0x34, 0x80, 0x96, 0x2a, 0xe2, 0xcd, 0x4b, 0xc0, 0x46,
+	0xa1, 0xef, 0xe6, 0x66, 0x45, 0x08, 0x29, 0xd8, 0x89, 0x74, 0x71, 0x6d, 0x4b, 0x42, 0x7c, 0x33,
+	0xb3, 0x1f, 0x45, 0x5e, 0xcc, 0x44, 0x49, 0x1e, 0xf7, 0xbf, 0x86, 0xa1, 0xff, 0x60, 0x40, 0x4f,
+	0xab, 0x32, 0xeb, 0x65, 0x8c, 0x3d, 0xcd, 0x20, 0x1b, 0x7a, 0x75, 0xf1, 0x96, 0x73, 0x98, 0xb3,
+	0x57, 0x9f, 0xc3, 0x5e, 0xa3, 0xc0, 0x1e, 0x0f, 0xd7, 0x93, 0x18, 0xeb, 0x7d, 0x4d, 0x74, 0x3f,
+	0x0a, 0xce, 0xcb, 0xe2, 0xcb, 0x7a, 0x59, 0x7c, 0x08, 0xbb, 0x33, 0xfc, 0x2e, 0xbc, 0x49, 0x69,
+	0xb1, 0xb8, 0x53, 0x55, 0x3b, 0xa5, 0xa7, 0xf0, 0xf0, 0x95, 0xed, 0x7b, 0xaa, 0x58, 0x7d, 0x1c,
+	0x06, 0x01, 0x73, 0x52, 0xef, 0xda, 0x4b, 0xa7, 0xb7, 0xa5, 0x68, 0x15, 0x5a, 0xa1, 0x7f, 0x66,
+	0xc0, 0xfe, 0xfc, 0xb5, 0x16, 0xe6, 0xfe, 0xc3, 0xb2, 0xff, 0xd8, 0xe7, 0xfc, 0x2b, 0x02, 0x55,
+	0x8b, 0xe7, 0x7e, 0xe4, 0x0f, 0x61, 0xeb, 0x89, 0xd8, 0xad, 0x4f, 0x53, 0xc7, 0xd5, 0x36, 0xb4,
+	0x3b, 0x7e, 0x1e, 0xf8, 0x53, 0x45, 0x1a, 0x21, 0x6e, 0x81, 0x1b, 0x3b, 0x75, 0x46, 0xf2, 0x76,
+	0x89, 0x40, 0x21, 0x64, 0xd4, 0x8b, 0x21, 0x83, 0xc6, 0xb0, 0xc6, 0x17, 0xfe, 0x94, 0x4d, 0x5f,
+	0xd9, 0xfe, 0x84, 0x55, 0x04, 0xee, 0x2e, 0x34, 0xaf, 0xf9, 0x90, 0x14, 0x08, 0x01, 0xee, 0xbf,
+	0x5d, 0xe6, 0xb3, 0x94, 0xb9, 0xd2, 0x29, 0x29, 0xb0, 0x1c, 0xc0, 0x1b, 0x33, 0x01, 0x9c, 0xfe,
+	0x8b, 0x01, 0x44, 0x97, 0x69, 0x61, 0x7d, 0xde, 0x22, 0x90, 0xa8, 0x18, 0x04, 0x76, 0x94, 0x8c,
+	0x42, 0xd5, 0xbf, 0xc9, 0x60, 0x42, 0x61, 0x4d, 0x7d, 0x9f, 0x84, 0x81, 0x6a, 0xdf, 0x14, 0x70,
+	0x84, 0x42, 0xfd, 0xea, 0x3a, 0x11, 0x95, 0xcb, 0xd5, 0xa3, 0x8e, 0x08, 0x65, 0x9a, 0x7e, 0x2c,
+	0x3e, 0x48, 0xff, 0xd6, 0x80, 0xde, 0xf7, 0x26, 0x76, 0x6c, 0x07, 0xa9, 0x17, 0xb0, 0x0b, 0x7e,
+	0x2b, 0x55, 0x96, 0xd9, 0xd7, 0xce, 0x60, 0x07, 0x5b, 0x02, 0x6a, 0xde, 0xdb, 0xb9, 0x1e, 0xd3,
+	0x1b, 0xd8, 0x9d, 0xe1, 0xed, 0xad, 0x44, 0xbc, 0x1f, 0x66, 0xb7, 0xea, 0x17, 0x71, 0xf8, 0x47,
+	0xcc, 0x49, 0xe7, 0x06, 0x0a, 0x39, 0x7e, 0x4b, 0x9f, 0x4e, 0x88, 0x96, 0x87, 0x23, 0x04, 0xe8,
+	0xeb, 0xcc, 0xf5, 0x65, 0x14, 0x16, 0x96, 0xec, 0x5b, 0xd0, 0x8a, 0xf0, 0xc7, 0x4a, 0xb4, 0x2d,
+	0x8d, 0x25, 0xd9, 0xbb, 0xc9, 0xa6, 0xd0, 0x9f, 0xd5, 0x60, 0xbd, 0x30, 0x56, 0xe9, 0x43, 0x32,
+	0x76, 0x6b, 0x1a, 0xbb, 0x1c, 0x1b, 0x8d, 0xb8, 0xe1, 0x64, 0x6e, 0x2f, 0x00, 0x51, 0x63, 0x90,
+	0xcd, 0x04, 0x65, 0x51, 0x05, 0x93, 0xdf, 0x82, 0x7b, 0x2c, 0x49, 0xbd, 0xb1, 0x9d, 0x32, 0xd7,
+	0x62, 0x63, 0xdb, 0x0b, 0xbc, 0x60, 0x78, 0xce, 0x9c, 0x30, 0x70, 0x13, 0xe9, 0x6e, 0xe7, 0x4f,
+	0xe0, 0xdb, 0xdb, 0x99, 0xa4, 0xfc, 0x62, 0x60, 0x31, 0xdb, 0x9d, 0x4a, 0x37, 0x5c, 0xc0, 0x71,
+	0xea, 0x97, 0xdc, 0x5d, 0xb2, 0xac, 0x05, 0x91, 0xc1, 0xe4, 0xdb, 0xd0, 0x4a, 0x26, 0x97, 0x28,
+	0x48, 0x2b, 0xb7, 0xba, 0x12, 0x1f, 0x73, 0x11, 0xa5, 0x21, 0x35, 0x93, 0xfe, 0x7d, 0x0d, 0xba,
+	0x55, 0x53, 0x16, 0xea, 0xd1, 0xbc, 0xb9, 0xd1, 0x94, 0xf5, 0xaa, 0x1a, 0x73, 0x7a, 0x55, 0xba,
+	0x5e, 0x9b, 0x8b, 0xe8, 0x75, 0xf9, 0x4d, 0x7a, 0x7d, 0x1f, 0xb6, 0x13, 0xfc, 0x7c, 0xc2, 0x46,
+	0x5e, 0xe0, 0xe2, 0x3d, 0x59, 0xa4, 0x99, 0x75, 0xab, 0x6a, 0x48, 0xeb, 0x80, 0x60, 0xda, 0xb9,
+	0x9c, 0x75, 0x39, 0x64, 0x2c, 0xf4, 0xc2, 0x40, 0x75, 0xef, 0xe4, 0x21, 0xe9, 0x42, 0xd3, 0xf7,
+	0xc6, 0x1e, 0x6e, 0xe0, 0xba, 0x85, 0x80, 0xa8, 0x17, 0xb0, 0x74, 0x14, 0xba, 0x4a, 0x5f, 0x08,
+	0x71, 0x61, 0x43, 0xb1, 0x50, 0xa8, 0x02, 0x77, 0x06, 0xd3, 0x04, 0xfa, 0xb3, 0x44, 0xee, 0x70,
+	0x4e, 0x56, 0x62, 0xe6, 0x84, 0xb1, 0xab, 0x8e, 0x89, 0xe8, 0x86, 0x65, 0x0b, 0x5b, 0x62, 0xcc,
+	0x52, 0x73, 0xe8, 0xff, 0x18, 0xb0, 0x59, 0x1a, 0xac, 0x6c, 0x39, 0xde, 0x41, 0xa0, 0xbc, 0xf2,
+	0xc6, 0xb7, 0x83, 0xba, 0x12, 0xe5, 0x98, 0x7c, 0xfc, 0x93, 0x30, 0x49, 0xa5, 0xed, 0x35, 0x8c,
+	0x56, 0x74, 0x91, 0x05, 0x03, 0x59, 0x74, 0x21, 0xd0, 0xb0, 0xe3, 0x61, 0x22, 0x0c, 0xd9, 0xb6,
+	0xc4, 0xb7, 0xa6, 0xa0, 0x56, 0x95, 0x82, 0xda, 0xf9, 0xc5, 0x6f, 0x08, 0xbb, 0xcf, 0xbc, 0x21,
+	0x77, 0x46, 0x9f, 0xb2, 0x69, 0xb1, 0x3e, 0xc2, 0xe3, 0x53, 0xe8, 0xfb, 0xfc, 0x96, 0x29, 0xf5,
+	0x9c, 0xc1, 0x7a, 0xf6, 0x8a, 0x35, 0x49, 0x05, 0x6a, 0x4d, 0x86, 0x7a, 0xa1, 0xc9, 0xf0, 0x5f,
+	0x06, 0xf4, 0x67, 0x29, 0xdd, 0xe5, 0x9e, 0x3f, 0x88, 0xc3, 0xf1, 0x2b, 0x49, 0xbc, 0x2e, 0x88,
+	0xeb, 0x28, 0x9e, 0x79, 0xa5, 0xe1, 0x2b, 0x2d, 0xb5, 0x6e, 0x58, 0x39, 0x82, 0x3c, 0x82, 0x75,
+	0xdf, 0x4e, 0x59, 0x92, 0xaa, 0x19, 0x4d, 0x31, 0xa3, 0x88, 0xe4, 0xdb, 0xc6, 0x19, 0xd9, 0xc1,
+	0x90, 0xa9, 0x10, 0x2a, 0xb6, 0x4d, 0xc6, 0xf7, 0xb1, 0x18, 0xb3, 0xd4, 0x1c, 0xfa, 0x05, 0x6c,
+	0x96, 0xc6, 0x74, 0x05, 0x19, 0x45, 0x05, 0xed, 0xc3, 0xaa, 0xcb, 0x12, 0x27, 0xf6, 0xa2, 0x54,
+	0xa9, 0xaf, 0x6d, 0xe9, 0x28, 0xae, 0x8d, 0xd0, 0xe7, 0xc1, 0x5a, 0xdd, 0x66, 0x11, 0xe2, 0xf8,
+	0x80, 0xdd, 0x70, 0xbc, 0xbc, 0xcd, 0x22, 0x44, 0x43, 0xd8, 0x79, 0x19, 0x0d, 0x63, 0xdb, 0x2d,
+	0x67, 0x0c, 0x8f, 0xb4, 0x90, 0x25, 0x4a, 0x81, 0xc5, 0x69, 0x79, 0x93, 0x4d, 0xb7, 0x65, 0xbb,
+	0x60, 0x4b, 0xad, 0x9e, 0x97, 0x27, 0x39, 0x3f, 0x35, 0x60, 0x1d, 0xe3, 0xa7, 0x5c, 0x50, 0x9b,
+	0x69, 0x14, 0xd2, 0xbe, 0xae, 0xde, 0x9b, 0xaf, 0x28, 0x0c, 0xd7, 0x0b, 0xfe, 0x73, 0x0f, 0x40,
+	0x25, 0x85, 0x17, 0xa1, 0x3a, 0x22, 0x39, 0xa6, 0x9c, 0xe4, 0x35, 0x67, 0x93, 0xbc, 0x9f, 0x1b,
+	0xb0, 0x21, 0xa5, 0x53, 0xac, 0x3d, 0x82, 0xf5, 0xd4, 0x8e, 0x87, 0x2c, 0xb3, 0x38, 0x72, 0x58,
+	0x44, 0x96, 0xf7, 0x95, 0xb4, 0x4a, 0x69, 0x5f, 0xe5, 0x8f, 0x8c, 0xea, 0xe5, 0x47, 0x46, 0xbf,
+	0x91, 0xd7, 0x83, 0x1b, 0x79, 0x3c, 0x2e, 0x28, 0x29, 0x2f, 0x09, 0x47, 0xd0, 0x2b, 0x1b, 0x6c,
+	0xe1, 0x83, 0xf0, 0x4d, 0x58, 0x99, 0xe0, 0x1a, 0xb2, 0xd6, 0x4b, 0xc4, 0xdd, 0xa6, 0x20, 0xbb,
+	0xa5, 0xa6, 0xd0, 0x00, 0x7a, 0xdf, 0xe7, 0xb7, 0x68, 0x2d, 0xf6, 0xe5, 0x1e, 0x1b, 0x63, 0xa5,
+	0xa1, 0x07, 0x7d, 0xd1, 0x8e, 0x4c, 0x59, 0x7c, 0x6d, 0xfb, 0x92, 0x68, 0x06, 0xf3, 0x00, 0xed,
+	0xdb, 0xc3, 0x8b, 0x51, 0xcc, 0x12, 0x9e, 0x66, 0xc9, 0xbb, 0x6b, 0x01, 0x47, 0xff, 0xb8, 0x06,
+	0xdb, 0x85, 0x38, 0x2a, 0x8f, 0xc5, 0x22, 0xd1, 0x74, 0xce, 0xee, 0x7b, 0x73, 0x0c, 0x55, 0x61,
+	0xb8, 0x39, 0x37, 0x0c, 0xcf, 0x89, 0x85, 0xcb, 0xb7, 0xc6, 0xc2, 0x79, 0x4f, 0x1e, 0xf0, 0xb1,
+	0x9a, 0x0a, 0x92, 0x0a, 0xa4, 0x3f, 0x31, 0x60, 0x77, 0x46, 0xe7, 0x77, 0x29, 0x7f, 0xa6, 0xf9,
+	0x86, 0xc3, 0xe8, 0xf3, 0x41, 0xee, 0x9d, 0x1a, 0xf9, 0xab, 0x9d, 0x0a, 0x75, 0x67, 0x1e, 0xea,
+	0xe0, 0x4f, 0x0d, 0x68, 0xa9, 0x56, 0x25, 0xd9, 0x86, 0xcd, 0xd3, 0xe0, 0x9a, 0xe7, 0x6d, 0x0a,
+	0xd5, 0x59, 0x22, 0x9b, 0xb0, 0x2a, 0x5e, 0xc3, 0x21, 0xaa, 0x63, 0x90, 0x0e, 0xac, 0xe1, 0x9b,
+	0x29, 0x89, 0xa9, 0x91, 0x0d, 0x80, 0xf3, 0x34, 0x8c, 0x24, 0x5c, 0x17, 0xf0, 0x28, 0xbc, 0x91,
+	0x70, 0x83, 0x6c, 0xc1, 0xfa, 0x89, 0x97, 0xf0, 0xbb, 0xba, 0x44, 0x35, 0xf9, 0x22, 0x4f, 0x03,
+	0x0d, 0xb3, 0x7c, 0xf0, 0x29, 0xb4, 0x54, 0x13, 0x4d, 0x63, 0x44, 0xa1, 0x3a, 0x4b, 0x7c, 0x95,
+	0xa7, 0xd7, 0x9e, 0x93, 0x66, 0x28, 0x83, 0xec, 0xc2, 0xf6, 0xb1, 0x1d, 0x38, 0xcc, 0x2f, 0x0e,
+	0xd4, 0x0e, 0x3e, 0x83, 0x15, 0x59, 0x0d, 0xe5, 0xfc, 0xcb, 0xb5, 0x38, 0xd8, 0x59, 0x22, 0x6b,
+	0x58, 0xd2, 0x10, 0x90, 0xc1, 0x79, 0x45, 0x4b, 0x0a, 0x58, 0xc8, 0x82, 0x87, 0x53, 0xc0, 0x28,
+	0x8b, 0x60, 0x51, 0xc0, 0x8d, 0x83, 0x13, 0x68, 0x67, 0x85, 0x20, 0xd2, 0x85, 0x8e, 0x5c, 0x3b,
+	0xc3, 0x75, 0x96, 0xb8, 0x6c, 0x42, 0x63, 0x02, 0xf7, 0xea, 0xa8, 0x63, 0xa0, 0x0e, 0xc3, 0x48,
+	0x21, 0x6a, 0x07, 0xe7, 0x00, 0x79, 0xf5, 0x82, 0xec, 0xc0, 0x96, 0x62, 0x31, 0x43, 0x22, 0xa3,
+	0xfc, 0x9b, 0xe3, 0x90, 0x51, 0x7c, 0x6f, 0x21, 0xe0, 0x9a, 0xa0, 0x32, 0x0a, 0x6f, 0xd4, 0x2f,
+	0x3a, 0xf5, 0x83, 0xcf, 0xa0, 0x9d, 0xa5, 0x1e, 0x1a, 0x6b, 0x19, 0x0e, 0x75, 0x78, 0x2c, 0x0a,
+	0xd2, 0x12, 0xd9, 0x31, 0x84, 0x71, 0x44, 0x6e, 0xab, 0x50, 0x35, 0xc1, 0xee, 0x28, 0xbc, 0x51,
+	0x88, 0xfa, 0xc1, 0xdf, 0x18, 0xd0, 0x29, 0x87, 0x08, 0x72, 0x1f, 0x76, 0x25, 0x85, 0xf2, 0x90,
+	0xa6, 0x03, 0x39, 0xd4, 0x31, 0x48, 0x9f, 0xdf, 0xa3, 0x59, 0x64, 0xc7, 0xac, 0xe0, 0xfc, 0x3a,
+	0x35, 0x6e, 0x45, 0x8b, 0x25, 0x93, 0x71, 0x69, 0xa0, 0xce, 0x59, 0xfb, 0xae, 0x17, 0x78, 0xc9,
+	0x48, 0xa1, 0x1a, 0x8a, 0x35, 0x85, 0x68, 0x1e, 0xfd, 0x6f, 0x17, 0x96, 0xe5, 0x51, 0xfc, 0x01,
+	0xb4, 0xb3, 0x77, 0x9b, 0xa4, 0x2b, 0x4f, 0x7f, 0xe1, 0x09, 0xaa, 0xb9, 0x53, 0xc2, 0xe2, 0xb1,
+	0xa3, 0x0f, 0x7f, 0xfc, 0xef, 0xff, 0xfd, 0xd7, 0xb5, 0x7b, 0xb4, 0x7b, 0x68, 0x47, 0x5e, 0x72,
+	0x78, 0xfd, 0x81, 0xed, 0x47, 0x23, 0xfb, 0x83, 0x43, 0xe1, 0xf3, 0x3e, 0x34, 0x0e, 0xc8, 0x00,
+	0x56, 0xb5, 0x2a, 0x0f, 0xe9, 0xe5, 0x97, 0x45, 0xfd, 0xf1, 0xa1, 0xb9, 0x3b, 0x83, 0x97, 0x04,
+	0xde, 0x15, 0x04, 0xf6, 0xcd, 0xfb, 0x55, 0x04, 0x0e, 0x3f, 0xe7, 0x59, 0xd6, 0x17, 0x9c, 0xce,
+	0x77, 0x00, 0xf2, 0xf7, 0x88, 0x64, 0x07, 0x43, 0x73, 0xe9, 0x89, 0xa3, 0xd9, 0x2b, 0xa3, 0x25,
+	0x91, 0x25, 0xe2, 0xc3, 0xaa, 0xf6, 0x46, 0x8f, 0x98, 0xa5, 0x47, 0x7b, 0xda, 0x5b, 0x43, 0xf3,
+	0x7e, 0xe5, 0x98, 0x5c, 0xe9, 0x91, 0x60, 0x77, 0x8f, 0x3c, 0x28, 0xb1, 0x9b, 0x88, 0xa9, 0x92,
+	0x5f, 0x72, 0x8c, 0x3b, 0x50, 0x3d, 0x6a, 0x22, 0xe8, 0x6d, 0x66, 0x5f, 0x73, 0x99, 0xfd, 0xd9,
+	0x81, 0x8c, 0xe5, 0xef, 0xc2, 0x7a, 0xe1, 0x19, 0x11, 0xe9, 0xa3, 0x5b, 0x9e, 0x7d, 0xc7, 0x64,
+	0xde, 0xab, 0x18, 0xc9, 0xd6, 0xf9, 0x41, 0x96, 0x3c, 0x6b, 0xaf, 0x55, 0x84, 0x16, 0xdf, 0xd1,
+	0x8c, 0x32, 0xfb, 0xf4, 0xc6, 0xdc, 0x9b, 0x37, 0x9c, 0x2d, 0xfd, 0x1c, 0x3a, 0xe5, 0x67, 0x30,
+	0x44, 0xa8, 0x6f, 0xce, 0x6b, 0x1e, 0xf3, 0x41, 0xf5, 0x60, 0xb6, 0xe0, 0x87, 0xd0, 0xce, 0xde,
+	0xa0, 0xe0, 0x46, 0x2d, 0x3f, 0x76, 0xc1, 0x8d, 0x3a, 0xf3, 0x50, 0x85, 0x2e, 0x91, 0x21, 0xac,
+	0x17, 0x9e, 0x85, 0xa0, 0xbe, 0xaa, 0xde, 0xa4, 0xa0, 0xbe, 0x2a, 0xdf, 0x90, 0xd0, 0xaf, 0x09,
+	0x03, 0xdf, 0x37, 0x7b, 0x65, 0x03, 0x63, 0xb1, 0x83, 0x6f, 0xc5, 0x53, 0xd8, 0x28, 0xbe, 0xe0,
+	0x20, 0xf7, 0xb0, 0x0a, 0x5e, 0xf1, 0x38, 0xc4, 0x34, 0xab, 0x86, 0x32, 0x9e, 0x63, 0x58, 0x2f,
+	0x3c, 0xc4, 0x90, 0x3c, 0x57, 0xbc, 0xed, 0x90, 0x3c, 0x57, 0xbd, 0xda, 0xa0, 0xdf, 0x14, 0x3c,
+	0xbf, 0x7b, 0xf0, 0xa8, 0xc4, 0xb3, 0xec, 0xe7, 0x1e, 0x7e, 0x9e, 0x4e, 0x23, 0xf6, 0x85, 0xda,
+	0x9c, 0x57, 0x99, 0x9e, 0x30, 0x2c, 0x14, 0xf4, 0x54, 0x78, 0xcc, 0x51, 0xd0, 0x53, 0xf1, 0xc1,
+	0x06, 0xfd, 0x86, 0xa0, 0xf9, 0xd0, 0x34, 0x4b, 0x34, 0xb1, 0xdf, 0x7d, 0xf8, 0x79, 0x18, 0x89,
+	0x63, 0xfb, 0xfb, 0x00, 0x79, 0xc7, 0x1a, 0x8f, 0xed, 0x4c, 0xd3, 0x1c, 0x8f, 0xed, 0x6c, 0x63,
+	0x9b, 0xee, 0x09, 0x1a, 0x7d, 0xd2, 0xab, 0x96, 0x8b, 0x0c, 0x72, 0x8b, 0x63, 0x27, 0xb8, 0x60,
+	0x71, 0x3d, 0x33, 0x2b, 0x5a, 0xbc, 0x90, 0x49, 0xd1, 0x7d, 0x41, 0xc5, 0x34, 0x77, 0xca, 0x16,
+	0x17, 0xd3, 0xb8, 0x10, 0xbe, 0x68, 0x9e, 0xe6, 0x3d, 0x59, 0xa4, 0x53, 0xd5, 0xd2, 0x45, 0x3a,
+	0x95, 0x0d, 0x5c, 0xe5, 0xe9, 0xc8, 0x5e, 0x99, 0x8e, 0x2c, 0xa8, 0x28, 0xfb, 0x5c, 0xc0, 0x32,
+	0xb6, 0x0d, 0xc9, 0x96, 0x5c, 0x4c, 0x5b, 0x9f, 0xe8, 0x28, 0xb9, 0xf0, 0xd7, 0xc5, 0xc2, 0xef,
+	0x90, 0xdb, 0x5c, 0x28, 0xf9, 0x21, 0xac, 0x6a, 0xbd, 0x30, 0xf4, 0xd3, 0xb3, 0xfd, 0x3c, 0xf4,
+	0xd3, 0x15, 0x4d, 0xb3, 0xb9, 0x5a, 0xc2, 0x4b, 0x1d, 0xd7, 0xd2, 0x31, 0xac, 0xe9, 0xbd, 0x44,
+	0x74, 0x7a, 0x15, 0x4d, 0x47, 0xb3, 0x3f, 0x3b, 0x90, 0x1d, 0x88, 0x53, 0xd8, 0x28, 0x36, 0xb6,
+	0xf0, 0x6c, 0x55, 0x76, 0xd4, 0xf0, 0x6c, 0x55, 0xf7, 0xc1, 0xe8, 0x12, 0xe7, 0x47, 0xef, 0x2b,
+	0x11, 0x3d, 0x04, 0x15, 0x9c, 0x52, 0x7f, 0x76, 0x40, 0xe7, 0xa7, 0xd8, 0x29, 0x52, 0x67, 0xbd,
+	0xa2, 0xdd, 0xa4, 0xce, 0x7a, 0x55, 0x63, 0x89, 0x2e, 0x91, 0x33, 0x55, 0x28, 0xc9, 0xfa, 0x21,
+	0x18, 0x86, 0xaa, 0x9b, 0x3a, 0x18, 0x86, 0xe6, 0x34, 0x50, 0xe8, 0x12, 0x71, 0xa0, 0x5b, 0xd5,
+	0x47, 0x20, 0x5f, 0xd7, 0x3b, 0x0c, 0x73, 0xda, 0x21, 0xe6, 0xa3, 0xdb, 0x27, 0x65, 0x44, 0x7e,
+	0x07, 0x20, 0xaf, 0xd7, 0xe3, 0xe9, 0x9d, 0xe9, 0x49, 0xe0, 0xe9, 0x9d, 0x2d, 0xeb, 0xd3, 0xa5,
+	0xf7, 0x0d, 0x2e, 0x73, 0xa9, 0x26, 0xad, 0x42, 0x6f, 0x55, 0x11, 0x5d, 0x85, 0xde, 0xca, 0x22,
+	0x36, 0x1a, 0xa3, 0x58, 0x06, 0x26, 0xfa, 0xb1, 0x2e, 0x16, 0x9f, 0x4d, 0xb3, 0x6a, 0x48, 0x8f,
+	0x5c, 0xe5, 0x5a, 0x19, 0xb9, 0x5f, 0x28, 0x74, 0x15, 0xcb, 0x74, 0x18, 0xb9, 0xe6, 0x95, 0xd7,
+	0x70, 0xc1, 0x72, 0xad, 0x06, 0x17, 0x9c, 0x53, 0x2b, 0xc2, 0x05, 0xe7, 0x95, 0x77, 0x50, 0xd8,
+	0xe2, 0xed, 0x11, 0x85, 0xad, 0x2c, 0x5b, 0xa0, 0xb0, 0xd5, 0x09, 0x32, 0x5d, 0x22, 0xaf, 0x61,
+	0xb3, 0x94, 0x56, 0xa1, 0x15, 0xaa, 0xf3, 0x5b, 0xb4, 0xc2, 0x9c, 0x3c, 0x6c, 0xae, 0xb3, 0x11,
+	0x1d, 0x27, 0x79, 0x0d, 0x7a, 0xdf, 0x78, 0xd2, 0xff, 0xb7, 0x2f, 0xf7, 0x8c, 0x5f, 0x7c, 0xb9,
+	0x67, 0xfc, 0xf2, 0xcb, 0x3d, 0xe3, 0x2f, 0xbf, 0xda, 0x5b, 0xfa, 0xc5, 0x57, 0x7b, 0x4b, 0xff,
+	0xf9, 0xd5, 0xde, 0xd2, 0xe5, 0xb2, 0xf8, 0x1f, 0xd4, 0x6f, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x68, 0x28, 0x14, 0xb9, 0x4b, 0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MasterClient is the client API for Master service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MasterClient interface {
+	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error)
+	OperateTask(ctx context.Context, in *OperateTaskRequest, opts ...grpc.CallOption) (*OperateTaskResponse, error)
+	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error)
+	QueryStatus(ctx context.Context, in *QueryStatusListRequest, opts ...grpc.CallOption) (*QueryStatusListResponse, error)
+	// show un-resolved DDL locks
+	ShowDDLLocks(ctx context.Context, in *ShowDDLLocksRequest, opts ...grpc.CallOption) (*ShowDDLLocksResponse, error)
+	// used by dmctl to manually unlock DDL lock
+	UnlockDDLLock(ctx context.Context, in *UnlockDDLLockRequest, opts ...grpc.CallOption) (*UnlockDDLLockResponse, error)
+	// OperateWorkerRelayTask requests some dm-workers to operate relay unit
+	OperateWorkerRelayTask(ctx context.Context, in *OperateWorkerRelayRequest, opts ...grpc.CallOption) (*OperateWorkerRelayResponse, error)
+	// PurgeWorkerRelay purges relay log files for some dm-workers
+	PurgeWorkerRelay(ctx context.Context, in *PurgeWorkerRelayRequest, opts ...grpc.CallOption) (*PurgeWorkerRelayResponse, error)
+	// CheckTask checks legality of task configuration
+	CheckTask(ctx context.Context, in *CheckTaskRequest, opts ...grpc.CallOption) (*CheckTaskResponse, error)
+	// Operate an upstream MySQL source.
+	OperateSource(ctx context.Context, in *OperateSourceRequest, opts ...grpc.CallOption) (*OperateSourceResponse, error)
+	// RegisterWorker register the dm-workers.
+	RegisterWorker(ctx context.Context, in *RegisterWorkerRequest, opts ...grpc.CallOption) (*RegisterWorkerResponse, error)
+	// OfflineMember offline the dm cluster's members (master/worker).
+	OfflineMember(ctx context.Context, in *OfflineMemberRequest, opts ...grpc.CallOption) (*OfflineMemberResponse, error)
+	// OperateLeader do some operate on master:
+	//   - evict leader: make the master resign if it is leader, and will not campaign the leader again
+	//   - cancel evict leader: the master can campaign leader again.
+	OperateLeader(ctx context.Context, in *OperateLeaderRequest, opts ...grpc.CallOption) (*OperateLeaderResponse, error)
+	// ListMember list member information
+	ListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListMemberResponse, error)
+	OperateSchema(ctx context.Context, in *OperateSchemaRequest, opts ...grpc.CallOption) (*OperateSchemaResponse, error)
+	GetSubTaskCfg(ctx context.Context, in *GetSubTaskCfgRequest, opts ...grpc.CallOption) (*GetSubTaskCfgResponse, error)
+	// GetCfg get config
+	GetCfg(ctx context.Context, in *GetCfgRequest, opts ...grpc.CallOption) (*GetCfgResponse, error)
+	HandleError(ctx context.Context, in *HandleErrorRequest, opts ...grpc.CallOption) (*HandleErrorResponse, error)
+	GetMasterCfg(ctx context.Context, in *GetMasterCfgRequest, opts ...grpc.CallOption) (*GetMasterCfgResponse, error)
+	TransferSource(ctx context.Context, in *TransferSourceRequest, opts ...grpc.CallOption) (*TransferSourceResponse, error)
+	OperateRelay(ctx context.Context, in *OperateRelayRequest, opts ...grpc.CallOption) (*OperateRelayResponse, error)
+	// RestoreCluster restores the cluster states from a backup created by `cluster backup`, only a fresh cluster can be restored.
+	RestoreCluster(ctx context.Context, in *RestoreClusterRequest, opts ...grpc.CallOption) (*RestoreClusterResponse, error)
+	// OperateTaskLock locks, unlocks or shows the locks of tasks held by operators.
+	OperateTaskLock(ctx context.Context, in *OperateTaskLockRequest, opts ...grpc.CallOption) (*OperateTaskLockResponse, error)
+	// ValidateConnectivity checks the connectivity and privileges to all upstream and downstream databases of a task
+	// from the dm-workers which the sources are bound to, rather than from dm-master or dmctl.
+	ValidateConnectivity(ctx context.Context, in *ValidateTaskConnectivityRequest, opts ...grpc.CallOption) (*ValidateTaskConnectivityResponse, error)
+	// BackupEtcd streams a consistent snapshot of the etcd keyspace and then the changes after it, so external tools
+	// can back up the cluster states continuously without accessing etcd directly.
+	BackupEtcd(ctx context.Context, in *BackupEtcdRequest, opts ...grpc.CallOption) (Master_BackupEtcdClient, error)
+	// QuarantineTable suspends or resumes writing to a downstream table for the subtasks of a task without pausing them.
+	QuarantineTable(ctx context.Context, in *QuarantineTableRequest, opts ...grpc.CallOption) (*QuarantineTableResponse, error)
+	// OperateProject creates, deletes or shows the status of migration projects, a project groups the tasks of
+	// the dump, load and sync phases of one logical migration.
+	OperateProject(ctx context.Context, in *OperateProjectRequest, opts ...grpc.CallOption) (*OperateProjectResponse, error)
+	// OperationHistory shows the audit records of the mutating operations on DM-master, newer records come first.
+	OperationHistory(ctx context.Context, in *OperationHistoryRequest, opts ...grpc.CallOption) (*OperationHistoryResponse, error)
+	// MigrateKeySchema upgrades the etcd key schema to the latest version or rolls it back to an older version before
+	// downgrading DM-master, the changed keys can be checked by a dry run.
+	MigrateKeySchema(ctx context.Context, in *MigrateKeySchemaRequest, opts ...grpc.CallOption) (*MigrateKeySchemaResponse, error)
+	// UpgradeCluster coordinates a rolling upgrade of the cluster: it starts an upgrade window blocking the task
+	// operations, hands over or pauses the sources of DM-workers before they're restarted, resumes them afterwards,
+	// and verifies the version and schema migrations before finishing the window.
+	UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*UpgradeClusterResponse, error)
+	// WatchTaskStatus streams the stage, unit, lag and errors of the subtasks when they change, so monitoring tools
+	// don't need to poll QueryStatus. the first response contains all the watched subtasks.
+	WatchTaskStatus(ctx context.Context, in *WatchTaskStatusRequest, opts ...grpc.CallOption) (Master_WatchTaskStatusClient, error)
+}
+
+type masterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMasterClient(cc *grpc.ClientConn) MasterClient {
+	return &masterClient{cc}
+}
+
+func (c *masterClient) StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error) {
+	out := new(StartTaskResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/StartTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListLeaderMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) OperateTask(ctx context.Context, in *OperateTaskRequest, opts ...grpc.CallOption) (*OperateTaskResponse, error) {
+	out := new(OperateTaskResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OperateTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListLeaderMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
+func (c *masterClient) UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error) {
+	out := new(UpdateTaskResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/UpdateTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListMasterMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) QueryStatus(ctx context.Context, in *QueryStatusListRequest, opts ...grpc.CallOption) (*QueryStatusListResponse, error) {
+	out := new(QueryStatusListResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/QueryStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListMasterMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) ShowDDLLocks(ctx context.Context, in *ShowDDLLocksRequest, opts ...grpc.CallOption) (*ShowDDLLocksResponse, error) {
+	out := new(ShowDDLLocksResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/ShowDDLLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListMasterMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Masters) > 0 {
-		for iNdEx := len(m.Masters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Masters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
+func (c *masterClient) UnlockDDLLock(ctx context.Context, in *UnlockDDLLockRequest, opts ...grpc.CallOption) (*UnlockDDLLockResponse, error) {
+	out := new(UnlockDDLLockResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/UnlockDDLLock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListWorkerMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) OperateWorkerRelayTask(ctx context.Context, in *OperateWorkerRelayRequest, opts ...grpc.CallOption) (*OperateWorkerRelayResponse, error) {
+	out := new(OperateWorkerRelayResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OperateWorkerRelayTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListWorkerMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) PurgeWorkerRelay(ctx context.Context, in *PurgeWorkerRelayRequest, opts ...grpc.CallOption) (*PurgeWorkerRelayResponse, error) {
+	out := new(PurgeWorkerRelayResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/PurgeWorkerRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListWorkerMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
+func (c *masterClient) CheckTask(ctx context.Context, in *CheckTaskRequest, opts ...grpc.CallOption) (*CheckTaskResponse, error) {
+	out := new(CheckTaskResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/CheckTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Members) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) OperateSource(ctx context.Context, in *OperateSourceRequest, opts ...grpc.CallOption) (*OperateSourceResponse, error) {
+	out := new(OperateSourceResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OperateSource", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Members) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) RegisterWorker(ctx context.Context, in *RegisterWorkerRequest, opts ...grpc.CallOption) (*RegisterWorkerResponse, error) {
+	out := new(RegisterWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/RegisterWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Members) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Member != nil {
-		{
-			size := m.Member.Size()
-			i -= size
-			if _, err := m.Member.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (c *masterClient) OfflineMember(ctx context.Context, in *OfflineMemberRequest, opts ...grpc.CallOption) (*OfflineMemberResponse, error) {
+	out := new(OfflineMemberResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OfflineMember", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Members_Leader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) OperateLeader(ctx context.Context, in *OperateLeaderRequest, opts ...grpc.CallOption) (*OperateLeaderResponse, error) {
+	out := new(OperateLeaderResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OperateLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Members_Leader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Leader != nil {
-		{
-			size, err := m.Leader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmmaster(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *masterClient) ListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*ListMemberResponse, error) {
+	out := new(ListMemberResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/ListMember", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
-func (m *Members_Master) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *masterClient) OperateSchema(ctx context.Context, in *OperateSchemaRequest, opts ...grpc.CallOption) (*OperateSchemaResponse, error) {
+	out := new(OperateSchemaResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OperateSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Members_Master) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Master != nil {
-		{
-			size, err := m.Master.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmmaster(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *masterClient) GetSubTaskCfg(ctx context.Context, in *GetSubTaskCfgRequest, opts ...grpc.CallOption) (*GetSubTaskCfgResponse, error) {
+	out := new(GetSubTaskCfgResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/GetSubTaskCfg", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
-func (m *Members_Worker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *masterClient) GetCfg(ctx context.Context, in *GetCfgRequest, opts ...grpc.CallOption) (*GetCfgResponse, error) {
+	out := new(GetCfgResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/GetCfg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Members_Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Worker != nil {
-		{
-			size, err := m.Worker.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmmaster(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (c *masterClient) HandleError(ctx context.Context, in *HandleErrorRequest, opts ...grpc.CallOption) (*HandleErrorResponse, error) {
+	out := new(HandleErrorResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/HandleError", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
-func (m *ListMemberRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *masterClient) GetMasterCfg(ctx context.Context, in *GetMasterCfgRequest, opts ...grpc.CallOption) (*GetMasterCfgResponse, error) {
+	out := new(GetMasterCfgResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/GetMasterCfg", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListMemberRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) TransferSource(ctx context.Context, in *TransferSourceRequest, opts ...grpc.CallOption) (*TransferSourceResponse, error) {
+	out := new(TransferSourceResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/TransferSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ListMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Names) > 0 {
-		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Names[iNdEx])
-			copy(dAtA[i:], m.Names[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Names[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func (c *masterClient) OperateRelay(ctx context.Context, in *OperateRelayRequest, opts ...grpc.CallOption) (*OperateRelayResponse, error) {
+	out := new(OperateRelayResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OperateRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Worker {
-		i--
-		if m.Worker {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	return out, nil
+}
+
+func (c *masterClient) RestoreCluster(ctx context.Context, in *RestoreClusterRequest, opts ...grpc.CallOption) (*RestoreClusterResponse, error) {
+	out := new(RestoreClusterResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/RestoreCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Master {
-		i--
-		if m.Master {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return out, nil
+}
+
+func (c *masterClient) OperateTaskLock(ctx context.Context, in *OperateTaskLockRequest, opts ...grpc.CallOption) (*OperateTaskLockResponse, error) {
+	out := new(OperateTaskLockResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OperateTaskLock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Leader {
-		i--
-		if m.Leader {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *masterClient) ValidateConnectivity(ctx context.Context, in *ValidateTaskConnectivityRequest, opts ...grpc.CallOption) (*ValidateTaskConnectivityResponse, error) {
+	out := new(ValidateTaskConnectivityResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/ValidateConnectivity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListMemberResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) BackupEtcd(ctx context.Context, in *BackupEtcdRequest, opts ...grpc.CallOption) (Master_BackupEtcdClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Master_serviceDesc.Streams[0], "/pb.Master/BackupEtcd", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &masterBackupEtcdClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *ListMemberResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Master_BackupEtcdClient interface {
+	Recv() (*BackupEtcdResponse, error)
+	grpc.ClientStream
 }
 
-func (m *ListMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type masterBackupEtcdClient struct {
+	grpc.ClientStream
 }
 
-func (m *OperateSchemaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *masterBackupEtcdClient) Recv() (*BackupEtcdResponse, error) {
+	m := new(BackupEtcdResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OperateSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return m, nil
 }
 
-func (m *OperateSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sync {
-		i--
-		if m.Sync {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Flush {
-		i--
-		if m.Flush {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Schema) > 0 {
-		i -= len(m.Schema)
-		copy(dAtA[i:], m.Schema)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Schema)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Table) > 0 {
-		i -= len(m.Table)
-		copy(dAtA[i:], m.Table)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Table)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Database) > 0 {
-		i -= len(m.Database)
-		copy(dAtA[i:], m.Database)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Database)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+func (c *masterClient) QuarantineTable(ctx context.Context, in *QuarantineTableRequest, opts ...grpc.CallOption) (*QuarantineTableResponse, error) {
+	out := new(QuarantineTableResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/QuarantineTable", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *OperateSchemaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) OperateProject(ctx context.Context, in *OperateProjectRequest, opts ...grpc.CallOption) (*OperateProjectResponse, error) {
+	out := new(OperateProjectResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OperateProject", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OperateSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *OperateSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *masterClient) OperationHistory(ctx context.Context, in *OperationHistoryRequest, opts ...grpc.CallOption) (*OperationHistoryResponse, error) {
+	out := new(OperationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/OperationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetSubTaskCfgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) MigrateKeySchema(ctx context.Context, in *MigrateKeySchemaRequest, opts ...grpc.CallOption) (*MigrateKeySchemaResponse, error) {
+	out := new(MigrateKeySchemaResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/MigrateKeySchema", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetSubTaskCfgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *GetSubTaskCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *masterClient) UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*UpgradeClusterResponse, error) {
+	out := new(UpgradeClusterResponse)
+	err := c.cc.Invoke(ctx, "/pb.Master/UpgradeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetSubTaskCfgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *masterClient) WatchTaskStatus(ctx context.Context, in *WatchTaskStatusRequest, opts ...grpc.CallOption) (Master_WatchTaskStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Master_serviceDesc.Streams[1], "/pb.Master/WatchTaskStatus", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &masterWatchTaskStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *GetSubTaskCfgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Master_WatchTaskStatusClient interface {
+	Recv() (*WatchTaskStatusResponse, error)
+	grpc.ClientStream
 }
 
-func (m *GetSubTaskCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cfgs) > 0 {
-		for iNdEx := len(m.Cfgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Cfgs[iNdEx])
-			copy(dAtA[i:], m.Cfgs[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Cfgs[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type masterWatchTaskStatusClient struct {
+	grpc.ClientStream
 }
 
-func (m *GetCfgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *masterWatchTaskStatusClient) Recv() (*WatchTaskStatusResponse, error) {
+	m := new(WatchTaskStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *GetCfgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// MasterServer is the server API for Master service.
+type MasterServer interface {
+	StartTask(context.Context, *StartTaskRequest) (*StartTaskResponse, error)
+	OperateTask(context.Context, *OperateTaskRequest) (*OperateTaskResponse, error)
+	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
+	QueryStatus(context.Context, *QueryStatusListRequest) (*QueryStatusListResponse, error)
+	// show un-resolved DDL locks
+	ShowDDLLocks(context.Context, *ShowDDLLocksRequest) (*ShowDDLLocksResponse, error)
+	// used by dmctl to manually unlock DDL lock
+	UnlockDDLLock(context.Context, *UnlockDDLLockRequest) (*UnlockDDLLockResponse, error)
+	// OperateWorkerRelayTask requests some dm-workers to operate relay unit
+	OperateWorkerRelayTask(context.Context, *OperateWorkerRelayRequest) (*OperateWorkerRelayResponse, error)
+	// PurgeWorkerRelay purges relay log files for some dm-workers
+	PurgeWorkerRelay(context.Context, *PurgeWorkerRelayRequest) (*PurgeWorkerRelayResponse, error)
+	// CheckTask checks legality of task configuration
+	CheckTask(context.Context, *CheckTaskRequest) (*CheckTaskResponse, error)
+	// Operate an upstream MySQL source.
+	OperateSource(context.Context, *OperateSourceRequest) (*OperateSourceResponse, error)
+	// RegisterWorker register the dm-workers.
+	RegisterWorker(context.Context, *RegisterWorkerRequest) (*RegisterWorkerResponse, error)
+	// OfflineMember offline the dm cluster's members (master/worker).
+	OfflineMember(context.Context, *OfflineMemberRequest) (*OfflineMemberResponse, error)
+	// OperateLeader do some operate on master:
+	//   - evict leader: make the master resign if it is leader, and will not campaign the leader again
+	//   - cancel evict leader: the master can campaign leader again.
+	OperateLeader(context.Context, *OperateLeaderRequest) (*OperateLeaderResponse, error)
+	// ListMember list member information
+	ListMember(context.Context, *ListMemberRequest) (*ListMemberResponse, error)
+	OperateSchema(context.Context, *OperateSchemaRequest) (*OperateSchemaResponse, error)
+	GetSubTaskCfg(context.Context, *GetSubTaskCfgRequest) (*GetSubTaskCfgResponse, error)
+	// GetCfg get config
+	GetCfg(context.Context, *GetCfgRequest) (*GetCfgResponse, error)
+	HandleError(context.Context, *HandleErrorRequest) (*HandleErrorResponse, error)
+	GetMasterCfg(context.Context, *GetMasterCfgRequest) (*GetMasterCfgResponse, error)
+	TransferSource(context.Context, *TransferSourceRequest) (*TransferSourceResponse, error)
+	OperateRelay(context.Context, *OperateRelayRequest) (*OperateRelayResponse, error)
+	// RestoreCluster restores the cluster states from a backup created by `cluster backup`, only a fresh cluster can be restored.
+	RestoreCluster(context.Context, *RestoreClusterRequest) (*RestoreClusterResponse, error)
+	// OperateTaskLock locks, unlocks or shows the locks of tasks held by operators.
+	OperateTaskLock(context.Context, *OperateTaskLockRequest) (*OperateTaskLockResponse, error)
+	// ValidateConnectivity checks the connectivity and privileges to all upstream and downstream databases of a task
+	// from the dm-workers which the sources are bound to, rather than from dm-master or dmctl.
+	ValidateConnectivity(context.Context, *ValidateTaskConnectivityRequest) (*ValidateTaskConnectivityResponse, error)
+	// BackupEtcd streams a consistent snapshot of the etcd keyspace and then the changes after it, so external tools
+	// can back up the cluster states continuously without accessing etcd directly.
+	BackupEtcd(*BackupEtcdRequest, Master_BackupEtcdServer) error
+	// QuarantineTable suspends or resumes writing to a downstream table for the subtasks of a task without pausing them.
+	QuarantineTable(context.Context, *QuarantineTableRequest) (*QuarantineTableResponse, error)
+	// OperateProject creates, deletes or shows the status of migration projects, a project groups the tasks of
+	// the dump, load and sync phases of one logical migration.
+	OperateProject(context.Context, *OperateProjectRequest) (*OperateProjectResponse, error)
+	// OperationHistory shows the audit records of the mutating operations on DM-master, newer records come first.
+	OperationHistory(context.Context, *OperationHistoryRequest) (*OperationHistoryResponse, error)
+	// MigrateKeySchema upgrades the etcd key schema to the latest version or rolls it back to an older version before
+	// downgrading DM-master, the changed keys can be checked by a dry run.
+	MigrateKeySchema(context.Context, *MigrateKeySchemaRequest) (*MigrateKeySchemaResponse, error)
+	// UpgradeCluster coordinates a rolling upgrade of the cluster: it starts an upgrade window blocking the task
+	// operations, hands over or pauses the sources of DM-workers before they're restarted, resumes them afterwards,
+	// and verifies the version and schema migrations before finishing the window.
+	UpgradeCluster(context.Context, *UpgradeClusterRequest) (*UpgradeClusterResponse, error)
+	// WatchTaskStatus streams the stage, unit, lag and errors of the subtasks when they change, so monitoring tools
+	// don't need to poll QueryStatus. the first response contains all the watched subtasks.
+	WatchTaskStatus(*WatchTaskStatusRequest, Master_WatchTaskStatusServer) error
 }
 
-func (m *GetCfgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMasterServer can be embedded to have forward compatible implementations.
+type UnimplementedMasterServer struct {
 }
 
-func (m *GetCfgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMasterServer) StartTask(ctx context.Context, req *StartTaskRequest) (*StartTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTask not implemented")
+}
+func (*UnimplementedMasterServer) OperateTask(ctx context.Context, req *OperateTaskRequest) (*OperateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateTask not implemented")
+}
+func (*UnimplementedMasterServer) UpdateTask(ctx context.Context, req *UpdateTaskRequest) (*UpdateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (*UnimplementedMasterServer) QueryStatus(ctx context.Context, req *QueryStatusListRequest) (*QueryStatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStatus not implemented")
+}
+func (*UnimplementedMasterServer) ShowDDLLocks(ctx context.Context, req *ShowDDLLocksRequest) (*ShowDDLLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowDDLLocks not implemented")
+}
+func (*UnimplementedMasterServer) UnlockDDLLock(ctx context.Context, req *UnlockDDLLockRequest) (*UnlockDDLLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockDDLLock not implemented")
+}
+func (*UnimplementedMasterServer) OperateWorkerRelayTask(ctx context.Context, req *OperateWorkerRelayRequest) (*OperateWorkerRelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateWorkerRelayTask not implemented")
+}
+func (*UnimplementedMasterServer) PurgeWorkerRelay(ctx context.Context, req *PurgeWorkerRelayRequest) (*PurgeWorkerRelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeWorkerRelay not implemented")
+}
+func (*UnimplementedMasterServer) CheckTask(ctx context.Context, req *CheckTaskRequest) (*CheckTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTask not implemented")
+}
+func (*UnimplementedMasterServer) OperateSource(ctx context.Context, req *OperateSourceRequest) (*OperateSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateSource not implemented")
+}
+func (*UnimplementedMasterServer) RegisterWorker(ctx context.Context, req *RegisterWorkerRequest) (*RegisterWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWorker not implemented")
+}
+func (*UnimplementedMasterServer) OfflineMember(ctx context.Context, req *OfflineMemberRequest) (*OfflineMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfflineMember not implemented")
+}
+func (*UnimplementedMasterServer) OperateLeader(ctx context.Context, req *OperateLeaderRequest) (*OperateLeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateLeader not implemented")
+}
+func (*UnimplementedMasterServer) ListMember(ctx context.Context, req *ListMemberRequest) (*ListMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMember not implemented")
+}
+func (*UnimplementedMasterServer) OperateSchema(ctx context.Context, req *OperateSchemaRequest) (*OperateSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateSchema not implemented")
+}
+func (*UnimplementedMasterServer) GetSubTaskCfg(ctx context.Context, req *GetSubTaskCfgRequest) (*GetSubTaskCfgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubTaskCfg not implemented")
+}
+func (*UnimplementedMasterServer) GetCfg(ctx context.Context, req *GetCfgRequest) (*GetCfgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCfg not implemented")
+}
+func (*UnimplementedMasterServer) HandleError(ctx context.Context, req *HandleErrorRequest) (*HandleErrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleError not implemented")
+}
+func (*UnimplementedMasterServer) GetMasterCfg(ctx context.Context, req *GetMasterCfgRequest) (*GetMasterCfgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMasterCfg not implemented")
+}
+func (*UnimplementedMasterServer) TransferSource(ctx context.Context, req *TransferSourceRequest) (*TransferSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferSource not implemented")
+}
+func (*UnimplementedMasterServer) OperateRelay(ctx context.Context, req *OperateRelayRequest) (*OperateRelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateRelay not implemented")
+}
+func (*UnimplementedMasterServer) RestoreCluster(ctx context.Context, req *RestoreClusterRequest) (*RestoreClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCluster not implemented")
+}
+func (*UnimplementedMasterServer) OperateTaskLock(ctx context.Context, req *OperateTaskLockRequest) (*OperateTaskLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateTaskLock not implemented")
+}
+func (*UnimplementedMasterServer) ValidateConnectivity(ctx context.Context, req *ValidateTaskConnectivityRequest) (*ValidateTaskConnectivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConnectivity not implemented")
+}
+func (*UnimplementedMasterServer) BackupEtcd(req *BackupEtcdRequest, srv Master_BackupEtcdServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackupEtcd not implemented")
+}
+func (*UnimplementedMasterServer) QuarantineTable(ctx context.Context, req *QuarantineTableRequest) (*QuarantineTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantineTable not implemented")
+}
+func (*UnimplementedMasterServer) OperateProject(ctx context.Context, req *OperateProjectRequest) (*OperateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateProject not implemented")
+}
+func (*UnimplementedMasterServer) OperationHistory(ctx context.Context, req *OperationHistoryRequest) (*OperationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationHistory not implemented")
+}
+func (*UnimplementedMasterServer) MigrateKeySchema(ctx context.Context, req *MigrateKeySchemaRequest) (*MigrateKeySchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateKeySchema not implemented")
+}
+func (*UnimplementedMasterServer) UpgradeCluster(ctx context.Context, req *UpgradeClusterRequest) (*UpgradeClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeCluster not implemented")
+}
+func (*UnimplementedMasterServer) WatchTaskStatus(req *WatchTaskStatusRequest, srv Master_WatchTaskStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTaskStatus not implemented")
 }
 
-func (m *GetCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cfg) > 0 {
-		i -= len(m.Cfg)
-		copy(dAtA[i:], m.Cfg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Cfg)))
-		i--
-		dAtA[i] = 0x1a
+func RegisterMasterServer(s *grpc.Server, srv MasterServer) {
+	s.RegisterService(&_Master_serviceDesc, srv)
+}
+
+func _Master_StartTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MasterServer).StartTask(ctx, in)
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/StartTask",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).StartTask(ctx, req.(*StartTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMasterCfgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Master_OperateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateTaskRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MasterServer).OperateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OperateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OperateTask(ctx, req.(*OperateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMasterCfgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Master_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/UpdateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMasterCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Master_QueryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).QueryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/QueryStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).QueryStatus(ctx, req.(*QueryStatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMasterCfgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Master_ShowDDLLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowDDLLocksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MasterServer).ShowDDLLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/ShowDDLLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).ShowDDLLocks(ctx, req.(*ShowDDLLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetMasterCfgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetMasterCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cfg) > 0 {
-		i -= len(m.Cfg)
-		copy(dAtA[i:], m.Cfg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Cfg)))
-		i--
-		dAtA[i] = 0xa
+func _Master_UnlockDDLLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockDDLLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MasterServer).UnlockDDLLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/UnlockDDLLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).UnlockDDLLock(ctx, req.(*UnlockDDLLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HandleErrorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Master_OperateWorkerRelayTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateWorkerRelayRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HandleErrorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MasterServer).OperateWorkerRelayTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OperateWorkerRelayTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OperateWorkerRelayTask(ctx, req.(*OperateWorkerRelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HandleErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sqls) > 0 {
-		for iNdEx := len(m.Sqls) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sqls[iNdEx])
-			copy(dAtA[i:], m.Sqls[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sqls[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.BinlogPos) > 0 {
-		i -= len(m.BinlogPos)
-		copy(dAtA[i:], m.BinlogPos)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.BinlogPos)))
-		i--
-		dAtA[i] = 0x22
+func _Master_PurgeWorkerRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeWorkerRelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MasterServer).PurgeWorkerRelay(ctx, in)
 	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/PurgeWorkerRelay",
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).PurgeWorkerRelay(ctx, req.(*PurgeWorkerRelayRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HandleErrorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Master_CheckTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTaskRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HandleErrorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *HandleErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmmaster(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MasterServer).CheckTask(ctx, in)
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/CheckTask",
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).CheckTask(ctx, req.(*CheckTaskRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TransferSourceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Master_OperateSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateSourceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MasterServer).OperateSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OperateSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OperateSource(ctx, req.(*OperateSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TransferSourceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Master_RegisterWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MasterServer).RegisterWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/RegisterWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).RegisterWorker(ctx, req.(*RegisterWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TransferSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Worker) > 0 {
-		i -= len(m.Worker)
-		copy(dAtA[i:], m.Worker)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Worker)))
-		i--
-		dAtA[i] = 0x12
+func _Master_OfflineMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OfflineMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MasterServer).OfflineMember(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OfflineMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OfflineMember(ctx, req.(*OfflineMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TransferSourceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Master_OperateLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateLeaderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MasterServer).OperateLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OperateLeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OperateLeader(ctx, req.(*OperateLeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TransferSourceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TransferSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+func _Master_ListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(MasterServer).ListMember(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/ListMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).ListMember(ctx, req.(*ListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OperateRelayRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Master_OperateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateSchemaRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OperateRelayRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OperateRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Worker) > 0 {
-		for iNdEx := len(m.Worker) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Worker[iNdEx])
-			copy(dAtA[i:], m.Worker[iNdEx])
-			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Worker[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MasterServer).OperateSchema(ctx, in)
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OperateSchema",
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OperateSchema(ctx, req.(*OperateSchemaRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OperateRelayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Master_GetSubTaskCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubTaskCfgRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *OperateRelayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *OperateRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MasterServer).GetSubTaskCfg(ctx, in)
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/GetSubTaskCfg",
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintDmmaster(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDmmaster(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).GetSubTaskCfg(ctx, req.(*GetSubTaskCfgRequest))
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return interceptor(ctx, in, info, handler)
 }
-func (m *StartTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Master_GetCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCfgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if interceptor == nil {
+		return srv.(MasterServer).GetCfg(ctx, in)
 	}
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/GetCfg",
 	}
-	if m.RemoveMeta {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).GetCfg(ctx, req.(*GetCfgRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StartTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Master_HandleError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
+	if interceptor == nil {
+		return srv.(MasterServer).HandleError(ctx, in)
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/HandleError",
 	}
-	if len(m.Sources) > 0 {
-		for _, e := range m.Sources {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).HandleError(ctx, req.(*HandleErrorRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OperateTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Master_GetMasterCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMasterCfgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
+	if interceptor == nil {
+		return srv.(MasterServer).GetMasterCfg(ctx, in)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/GetMasterCfg",
 	}
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).GetMasterCfg(ctx, req.(*GetMasterCfgRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OperateTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
+func _Master_TransferSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Result {
-		n += 2
+	if interceptor == nil {
+		return srv.(MasterServer).TransferSource(ctx, in)
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/TransferSource",
 	}
-	if len(m.Sources) > 0 {
-		for _, e := range m.Sources {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).TransferSource(ctx, req.(*TransferSourceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Master_OperateRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateRelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if interceptor == nil {
+		return srv.(MasterServer).OperateRelay(ctx, in)
 	}
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OperateRelay",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OperateRelay(ctx, req.(*OperateRelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Master_RestoreCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
+	if interceptor == nil {
+		return srv.(MasterServer).RestoreCluster(ctx, in)
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/RestoreCluster",
 	}
-	if len(m.Sources) > 0 {
-		for _, e := range m.Sources {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).RestoreCluster(ctx, req.(*RestoreClusterRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStatusListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Master_OperateTaskLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateTaskLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if interceptor == nil {
+		return srv.(MasterServer).OperateTaskLock(ctx, in)
 	}
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OperateTaskLock",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OperateTaskLock(ctx, req.(*OperateTaskLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryStatusListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Master_ValidateConnectivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTaskConnectivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
+	if interceptor == nil {
+		return srv.(MasterServer).ValidateConnectivity(ctx, in)
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/ValidateConnectivity",
 	}
-	if len(m.Sources) > 0 {
-		for _, e := range m.Sources {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).ValidateConnectivity(ctx, req.(*ValidateTaskConnectivityRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ShowDDLLocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+func _Master_BackupEtcd_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupEtcdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(MasterServer).BackupEtcd(m, &masterBackupEtcdServer{stream})
 }
 
-func (m *DDLLock) Size() (n int) {
-	if m == nil {
-		return 0
+type Master_BackupEtcdServer interface {
+	Send(*BackupEtcdResponse) error
+	grpc.ServerStream
+}
+
+type masterBackupEtcdServer struct {
+	grpc.ServerStream
+}
+
+func (x *masterBackupEtcdServer) Send(m *BackupEtcdResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Master_QuarantineTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantineTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if interceptor == nil {
+		return srv.(MasterServer).QuarantineTable(ctx, in)
 	}
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/QuarantineTable",
 	}
-	l = len(m.Mode)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).QuarantineTable(ctx, req.(*QuarantineTableRequest))
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Master_OperateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DDLs) > 0 {
-		for _, s := range m.DDLs {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MasterServer).OperateProject(ctx, in)
 	}
-	if len(m.Synced) > 0 {
-		for _, s := range m.Synced {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OperateProject",
 	}
-	if len(m.Unsynced) > 0 {
-		for _, s := range m.Unsynced {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OperateProject(ctx, req.(*OperateProjectRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ShowDDLLocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Master_OperationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
+	if interceptor == nil {
+		return srv.(MasterServer).OperationHistory(ctx, in)
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/OperationHistory",
 	}
-	if len(m.Locks) > 0 {
-		for _, e := range m.Locks {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).OperationHistory(ctx, req.(*OperationHistoryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnlockDDLLockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Master_MigrateKeySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateKeySchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if interceptor == nil {
+		return srv.(MasterServer).MigrateKeySchema(ctx, in)
 	}
-	l = len(m.ReplaceOwner)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/MigrateKeySchema",
 	}
-	if m.ForceRemove {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).MigrateKeySchema(ctx, req.(*MigrateKeySchemaRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnlockDDLLockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Master_UpgradeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
+	if interceptor == nil {
+		return srv.(MasterServer).UpgradeCluster(ctx, in)
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Master/UpgradeCluster",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MasterServer).UpgradeCluster(ctx, req.(*UpgradeClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OperateWorkerRelayRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
-	}
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+func _Master_WatchTaskStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTaskStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(MasterServer).WatchTaskStatus(m, &masterWatchTaskStatusServer{stream})
 }
 
-func (m *OperateWorkerRelayResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
-	}
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	if len(m.Sources) > 0 {
-		for _, e := range m.Sources {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
-	}
-	return n
+type Master_WatchTaskStatusServer interface {
+	Send(*WatchTaskStatusResponse) error
+	grpc.ServerStream
 }
 
-func (m *PurgeWorkerRelayRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type masterWatchTaskStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *masterWatchTaskStatusServer) Send(m *WatchTaskStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Master_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Master",
+	HandlerType: (*MasterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartTask",
+			Handler:    _Master_StartTask_Handler,
+		},
+		{
+			MethodName: "OperateTask",
+			Handler:    _Master_OperateTask_Handler,
+		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _Master_UpdateTask_Handler,
+		},
+		{
+			MethodName: "QueryStatus",
+			Handler:    _Master_QueryStatus_Handler,
+		},
+		{
+			MethodName: "ShowDDLLocks",
+			Handler:    _Master_ShowDDLLocks_Handler,
+		},
+		{
+			MethodName: "UnlockDDLLock",
+			Handler:    _Master_UnlockDDLLock_Handler,
+		},
+		{
+			MethodName: "OperateWorkerRelayTask",
+			Handler:    _Master_OperateWorkerRelayTask_Handler,
+		},
+		{
+			MethodName: "PurgeWorkerRelay",
+			Handler:    _Master_PurgeWorkerRelay_Handler,
+		},
+		{
+			MethodName: "CheckTask",
+			Handler:    _Master_CheckTask_Handler,
+		},
+		{
+			MethodName: "OperateSource",
+			Handler:    _Master_OperateSource_Handler,
+		},
+		{
+			MethodName: "RegisterWorker",
+			Handler:    _Master_RegisterWorker_Handler,
+		},
+		{
+			MethodName: "OfflineMember",
+			Handler:    _Master_OfflineMember_Handler,
+		},
+		{
+			MethodName: "OperateLeader",
+			Handler:    _Master_OperateLeader_Handler,
+		},
+		{
+			MethodName: "ListMember",
+			Handler:    _Master_ListMember_Handler,
+		},
+		{
+			MethodName: "OperateSchema",
+			Handler:    _Master_OperateSchema_Handler,
+		},
+		{
+			MethodName: "GetSubTaskCfg",
+			Handler:    _Master_GetSubTaskCfg_Handler,
+		},
+		{
+			MethodName: "GetCfg",
+			Handler:    _Master_GetCfg_Handler,
+		},
+		{
+			MethodName: "HandleError",
+			Handler:    _Master_HandleError_Handler,
+		},
+		{
+			MethodName: "GetMasterCfg",
+			Handler:    _Master_GetMasterCfg_Handler,
+		},
+		{
+			MethodName: "TransferSource",
+			Handler:    _Master_TransferSource_Handler,
+		},
+		{
+			MethodName: "OperateRelay",
+			Handler:    _Master_OperateRelay_Handler,
+		},
+		{
+			MethodName: "RestoreCluster",
+			Handler:    _Master_RestoreCluster_Handler,
+		},
+		{
+			MethodName: "OperateTaskLock",
+			Handler:    _Master_OperateTaskLock_Handler,
+		},
+		{
+			MethodName: "ValidateConnectivity",
+			Handler:    _Master_ValidateConnectivity_Handler,
+		},
+		{
+			MethodName: "QuarantineTable",
+			Handler:    _Master_QuarantineTable_Handler,
+		},
+		{
+			MethodName: "OperateProject",
+			Handler:    _Master_OperateProject_Handler,
+		},
+		{
+			MethodName: "OperationHistory",
+			Handler:    _Master_OperationHistory_Handler,
+		},
+		{
+			MethodName: "MigrateKeySchema",
+			Handler:    _Master_MigrateKeySchema_Handler,
+		},
+		{
+			MethodName: "UpgradeCluster",
+			Handler:    _Master_UpgradeCluster_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BackupEtcd",
+			Handler:       _Master_BackupEtcd_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTaskStatus",
+			Handler:       _Master_WatchTaskStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dmmaster.proto",
+}
+
+func (m *StartTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StartTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Inactive {
-		n += 2
-	}
-	if m.Time != 0 {
-		n += 1 + sovDmmaster(uint64(m.Time))
-	}
-	l = len(m.Filename)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	l = len(m.SubDir)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	return n
-}
-
-func (m *PurgeWorkerRelayResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
+	if m.AllowOverlap {
+		i--
+		if m.AllowOverlap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.RemoveMeta {
+		i--
+		if m.RemoveMeta {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Sources) > 0 {
-		for _, e := range m.Sources {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CheckTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	if m.ErrCnt != 0 {
-		n += 1 + sovDmmaster(uint64(m.ErrCnt))
-	}
-	if m.WarnCnt != 0 {
-		n += 1 + sovDmmaster(uint64(m.WarnCnt))
+func (m *StartTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CheckTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StartTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OperateSourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperateTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.Config) > 0 {
-		for _, s := range m.Config {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
+	if m.ResetErrorBudget {
+		i--
+		if m.ResetErrorBudget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.SourceID) > 0 {
-		for _, s := range m.SourceID {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OperateSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperateTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
 	if len(m.Sources) > 0 {
-		for _, e := range m.Sources {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
-}
-
-func (m *RegisterWorkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterWorkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+func (m *UpdateTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OfflineMemberRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	return n
+func (m *UpdateTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OfflineMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperateLeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
+func (m *UpdateTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OperateLeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	return n
+func (m *UpdateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MasterInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	if m.MemberID != 0 {
-		n += 1 + sovDmmaster(uint64(m.MemberID))
-	}
-	if m.Alive {
-		n += 2
-	}
-	if len(m.PeerURLs) > 0 {
-		for _, s := range m.PeerURLs {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.ClientURLs) > 0 {
-		for _, s := range m.ClientURLs {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WorkerInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	l = len(m.Stage)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+func (m *QueryStatusListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListLeaderMember) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStatusListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatusListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Since) > 0 {
+		i -= len(m.Since)
+		copy(dAtA[i:], m.Since)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Since)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListMasterMember) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStatusListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatusListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatusListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.ProgressHistory) > 0 {
+		for iNdEx := len(m.ProgressHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProgressHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if len(m.Masters) > 0 {
-		for _, e := range m.Masters {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
-}
-
-func (m *ListWorkerMember) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Workers) > 0 {
-		for _, e := range m.Workers {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Members) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubTaskProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubTaskProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Member != nil {
-		n += m.Member.Size()
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	return n
-}
-
-func (m *Members_Leader) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Rows != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Rows))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Gtid) > 0 {
+		i -= len(m.Gtid)
+		copy(dAtA[i:], m.Gtid)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Gtid)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-func (m *Members_Master) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Master != nil {
-		l = m.Master.Size()
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.Unit != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-func (m *Members_Worker) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Stage != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Worker != nil {
-		l = m.Worker.Size()
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *ListMemberRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Leader {
-		n += 2
-	}
-	if m.Master {
-		n += 2
-	}
-	if m.Worker {
-		n += 2
-	}
-	if len(m.Names) > 0 {
-		for _, s := range m.Names {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+
+func (m *SubTaskProgressHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListMemberResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
-	}
-	return n
+func (m *SubTaskProgressHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperateSchemaRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SubTaskProgressHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
-	}
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.Samples != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x40
 	}
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	l = len(m.Database)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.RowsApplied != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.RowsApplied))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Table)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.Moved {
+		i--
+		if m.Moved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Schema)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.Last != nil {
+		{
+			size, err := m.Last.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmmaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Flush {
-		n += 2
+	if m.First != nil {
+		{
+			size, err := m.First.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmmaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Sync {
-		n += 2
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OperateSchemaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShowDDLLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowDDLLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShowDDLLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
 	if len(m.Sources) > 0 {
-		for _, e := range m.Sources {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetSubTaskCfgRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+func (m *DDLLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetSubTaskCfgResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DDLLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DDLLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Unsynced) > 0 {
+		for iNdEx := len(m.Unsynced) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Unsynced[iNdEx])
+			copy(dAtA[i:], m.Unsynced[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Unsynced[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if len(m.Cfgs) > 0 {
-		for _, s := range m.Cfgs {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Synced) > 0 {
+		for iNdEx := len(m.Synced) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Synced[iNdEx])
+			copy(dAtA[i:], m.Synced[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Synced[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	return n
-}
-
-func (m *GetCfgRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DDLs) > 0 {
+		for iNdEx := len(m.DDLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DDLs[iNdEx])
+			copy(dAtA[i:], m.DDLs[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.DDLs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovDmmaster(uint64(m.Type))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetCfgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShowDDLLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowDDLLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShowDDLLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Cfg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMasterCfgRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnlockDDLLockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetMasterCfgResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Cfg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	return n
+func (m *UnlockDDLLockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HandleErrorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnlockDDLLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
-	}
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
-	}
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
+	if m.ForceRemove {
+		i--
+		if m.ForceRemove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.BinlogPos)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.ReplaceOwner) > 0 {
+		i -= len(m.ReplaceOwner)
+		copy(dAtA[i:], m.ReplaceOwner)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ReplaceOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Sqls) > 0 {
-		for _, s := range m.Sqls {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
-		}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HandleErrorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnlockDDLLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockDDLLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockDDLLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Sources) > 0 {
-		for _, e := range m.Sources {
-			l = e.Size()
-			n += 1 + l + sovDmmaster(uint64(l))
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferSourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperateWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateWorkerRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateWorkerRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TransferSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperateWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateWorkerRelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateWorkerRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Result {
-		n += 2
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperateRelayRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PurgeWorkerRelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeWorkerRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeWorkerRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmmaster(uint64(m.Op))
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.SubDir) > 0 {
+		i -= len(m.SubDir)
+		copy(dAtA[i:], m.SubDir)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.SubDir)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Worker) > 0 {
-		for _, s := range m.Worker {
-			l = len(s)
-			n += 1 + l + sovDmmaster(uint64(l))
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Time != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Inactive {
+		i--
+		if m.Inactive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeWorkerRelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeWorkerRelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeWorkerRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WarnCnt != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.WarnCnt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ErrCnt != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.ErrCnt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceID) > 0 {
+		for iNdEx := len(m.SourceID) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SourceID[iNdEx])
+			copy(dAtA[i:], m.SourceID[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.SourceID[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Config) > 0 {
+		for iNdEx := len(m.Config) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Config[iNdEx])
+			copy(dAtA[i:], m.Config[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Config[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterWorkerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterWorkerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterWorkerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterWorkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OfflineMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OfflineMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OfflineMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OfflineMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OfflineMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OfflineMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateLeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateLeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateLeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateLeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MasterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MasterInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MasterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientURLs) > 0 {
+		for iNdEx := len(m.ClientURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClientURLs[iNdEx])
+			copy(dAtA[i:], m.ClientURLs[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ClientURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PeerURLs) > 0 {
+		for iNdEx := len(m.PeerURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PeerURLs[iNdEx])
+			copy(dAtA[i:], m.PeerURLs[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.PeerURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Alive {
+		i--
+		if m.Alive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MemberID != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.MemberID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Stage) > 0 {
+		i -= len(m.Stage)
+		copy(dAtA[i:], m.Stage)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Stage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListLeaderMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListLeaderMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListLeaderMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMasterMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMasterMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMasterMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Masters) > 0 {
+		for iNdEx := len(m.Masters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Masters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListWorkerMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListWorkerMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListWorkerMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Members) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Members) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Members) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Member != nil {
+		{
+			size := m.Member.Size()
+			i -= size
+			if _, err := m.Member.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Members_Leader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Members_Leader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Leader != nil {
+		{
+			size, err := m.Leader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmmaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Members_Master) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Members_Master) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Master != nil {
+		{
+			size, err := m.Master.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmmaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Members_Worker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Members_Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Worker != nil {
+		{
+			size, err := m.Worker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmmaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ListMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Worker {
+		i--
+		if m.Worker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Master {
+		i--
+		if m.Master {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Leader {
+		i--
+		if m.Leader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sync {
+		i--
+		if m.Sync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Flush {
+		i--
+		if m.Flush {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSubTaskCfgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSubTaskCfgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSubTaskCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSubTaskCfgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSubTaskCfgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSubTaskCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cfgs) > 0 {
+		for iNdEx := len(m.Cfgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cfgs[iNdEx])
+			copy(dAtA[i:], m.Cfgs[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Cfgs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCfgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCfgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.All {
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CfgRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CfgRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CfgRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ModRevision != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.ModRevision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreateRevision != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.CreateRevision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CfgEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CfgEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CfgEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Cfg) > 0 {
+		i -= len(m.Cfg)
+		copy(dAtA[i:], m.Cfg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Cfg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCfgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCfgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cfgs) > 0 {
+		for iNdEx := len(m.Cfgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cfgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Revision != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cfg) > 0 {
+		i -= len(m.Cfg)
+		copy(dAtA[i:], m.Cfg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Cfg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMasterCfgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMasterCfgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMasterCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMasterCfgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMasterCfgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMasterCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cfg) > 0 {
+		i -= len(m.Cfg)
+		copy(dAtA[i:], m.Cfg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Cfg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandleErrorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandleErrorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandleErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinlogGTID) > 0 {
+		i -= len(m.BinlogGTID)
+		copy(dAtA[i:], m.BinlogGTID)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.BinlogGTID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sqls) > 0 {
+		for iNdEx := len(m.Sqls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sqls[iNdEx])
+			copy(dAtA[i:], m.Sqls[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sqls[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BinlogPos) > 0 {
+		i -= len(m.BinlogPos)
+		copy(dAtA[i:], m.BinlogPos)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.BinlogPos)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandleErrorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandleErrorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandleErrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Handover {
+		i--
+		if m.Handover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedTasks) > 0 {
+		for iNdEx := len(m.PausedTasks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedTasks[iNdEx])
+			copy(dAtA[i:], m.PausedTasks[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.PausedTasks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateRelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Worker) > 0 {
+		for iNdEx := len(m.Worker) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Worker[iNdEx])
+			copy(dAtA[i:], m.Worker[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Worker[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateRelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateRelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateRelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreClusterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Backup) > 0 {
+		i -= len(m.Backup)
+		copy(dAtA[i:], m.Backup)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Backup)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreClusterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreClusterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpireTime) > 0 {
+		i -= len(m.ExpireTime)
+		copy(dAtA[i:], m.ExpireTime)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ExpireTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateTaskLockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateTaskLockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateTaskLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Duration != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateTaskLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateTaskLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateTaskLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateTaskConnectivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateTaskConnectivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateTaskConnectivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateTaskConnectivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateTaskConnectivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateTaskConnectivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupEtcdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupEtcdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupEtcdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Watch {
+		i--
+		if m.Watch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DmOnly {
+		i--
+		if m.DmOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EtcdKeyValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EtcdKeyValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EtcdKeyValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModRevision != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.ModRevision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupEtcdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupEtcdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupEtcdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kvs) > 0 {
+		for iNdEx := len(m.Kvs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kvs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SnapshotDone {
+		i--
+		if m.SnapshotDone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Revision != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantineTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantineTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantineTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantineTableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantineTableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantineTableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tasks[iNdEx])
+			copy(dAtA[i:], m.Tasks[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Tasks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subtasks) > 0 {
+		for iNdEx := len(m.Subtasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subtasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Blockers) > 0 {
+		for iNdEx := len(m.Blockers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blockers[iNdEx])
+			copy(dAtA[i:], m.Blockers[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Blockers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.CutoverReady {
+		i--
+		if m.CutoverReady {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EstimatedRemainingSeconds != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.EstimatedRemainingSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Progress) > 0 {
+		i -= len(m.Progress)
+		copy(dAtA[i:], m.Progress)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Progress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tasks[iNdEx])
+			copy(dAtA[i:], m.Tasks[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Tasks[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectSubTaskStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectSubTaskStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectSubTaskStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Synced {
+		i--
+		if m.Synced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SecondsBehindMaster != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.SecondsBehindMaster))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EstimatedRemainingSeconds != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.EstimatedRemainingSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Progress) > 0 {
+		i -= len(m.Progress)
+		copy(dAtA[i:], m.Progress)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Progress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Stage != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Unit != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Master) > 0 {
+		i -= len(m.Master)
+		copy(dAtA[i:], m.Master)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Master)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClientHost) > 0 {
+		i -= len(m.ClientHost)
+		copy(dAtA[i:], m.ClientHost)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ClientHost)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateKeySchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateKeySchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateKeySchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Rollback {
+		i--
+		if m.Rollback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrateKeySchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateKeySchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateKeySchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LatestVersion != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.LatestVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ToVersion != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.ToVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromVersion != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.FromVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeySchemaChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeySchemaChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeySchemaChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewKey) > 0 {
+		i -= len(m.NewKey)
+		copy(dAtA[i:], m.NewKey)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.NewKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldKey) > 0 {
+		i -= len(m.OldKey)
+		copy(dAtA[i:], m.OldKey)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.OldKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeClusterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedTasks) > 0 {
+		for iNdEx := len(m.PausedTasks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedTasks[iNdEx])
+			copy(dAtA[i:], m.PausedTasks[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.PausedTasks[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.HandoverTo) > 0 {
+		i -= len(m.HandoverTo)
+		copy(dAtA[i:], m.HandoverTo)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.HandoverTo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Stage) > 0 {
+		i -= len(m.Stage)
+		copy(dAtA[i:], m.Stage)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Stage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromVersion) > 0 {
+		i -= len(m.FromVersion)
+		copy(dAtA[i:], m.FromVersion)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.FromVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TargetVersion) > 0 {
+		i -= len(m.TargetVersion)
+		copy(dAtA[i:], m.TargetVersion)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.TargetVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeClusterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeClusterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmmaster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchTaskStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchTaskStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchTaskStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LagThreshold != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.LagThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Interval) > 0 {
+		i -= len(m.Interval)
+		copy(dAtA[i:], m.Interval)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Interval)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tasks[iNdEx])
+			copy(dAtA[i:], m.Tasks[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Tasks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubTaskStatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubTaskStatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SecondsBehindMaster != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.SecondsBehindMaster))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Unit != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Stage != 0 {
+		i = encodeVarintDmmaster(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchTaskStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchTaskStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchTaskStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmmaster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDmmaster(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDmmaster(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if m.RemoveMeta {
+		n += 2
+	}
+	if m.AllowOverlap {
+		n += 2
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *StartTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if m.ResetErrorBudget {
+		n += 2
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *OperateTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatusListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	l = len(m.Since)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatusListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if len(m.ProgressHistory) > 0 {
+		for _, e := range m.ProgressHistory {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubTaskProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmmaster(uint64(m.Stage))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovDmmaster(uint64(m.Unit))
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Gtid)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Rows != 0 {
+		n += 1 + sovDmmaster(uint64(m.Rows))
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubTaskProgressHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.First != nil {
+		l = m.First.Size()
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Last != nil {
+		l = m.Last.Size()
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Moved {
+		n += 2
+	}
+	if m.RowsApplied != 0 {
+		n += 1 + sovDmmaster(uint64(m.RowsApplied))
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if m.Samples != 0 {
+		n += 1 + sovDmmaster(uint64(m.Samples))
+	}
+	return n
+}
+
+func (m *ShowDDLLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DDLLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.DDLs) > 0 {
+		for _, s := range m.DDLs {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if len(m.Synced) > 0 {
+		for _, s := range m.Synced {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if len(m.Unsynced) > 0 {
+		for _, s := range m.Unsynced {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShowDDLLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnlockDDLLockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ReplaceOwner)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.ForceRemove {
+		n += 2
+	}
+	return n
+}
+
+func (m *UnlockDDLLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *OperateWorkerRelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateWorkerRelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PurgeWorkerRelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if m.Inactive {
+		n += 2
+	}
+	if m.Time != 0 {
+		n += 1 + sovDmmaster(uint64(m.Time))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.SubDir)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *PurgeWorkerRelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.ErrCnt != 0 {
+		n += 1 + sovDmmaster(uint64(m.ErrCnt))
+	}
+	if m.WarnCnt != 0 {
+		n += 1 + sovDmmaster(uint64(m.WarnCnt))
+	}
+	return n
+}
+
+func (m *CheckTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *OperateSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	if len(m.Config) > 0 {
+		for _, s := range m.Config {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if len(m.SourceID) > 0 {
+		for _, s := range m.SourceID {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterWorkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *OfflineMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *OfflineMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *OperateLeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	return n
+}
+
+func (m *OperateLeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *MasterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.MemberID != 0 {
+		n += 1 + sovDmmaster(uint64(m.MemberID))
+	}
+	if m.Alive {
+		n += 2
+	}
+	if len(m.PeerURLs) > 0 {
+		for _, s := range m.PeerURLs {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if len(m.ClientURLs) > 0 {
+		for _, s := range m.ClientURLs {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Stage)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *ListLeaderMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *ListMasterMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Masters) > 0 {
+		for _, e := range m.Masters {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListWorkerMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Members) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Member != nil {
+		n += m.Member.Size()
+	}
+	return n
+}
+
+func (m *Members_Leader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+func (m *Members_Master) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Master != nil {
+		l = m.Master.Size()
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+func (m *Members_Worker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Worker != nil {
+		l = m.Worker.Size()
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+func (m *ListMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leader {
+		n += 2
+	}
+	if m.Master {
+		n += 2
+	}
+	if m.Worker {
+		n += 2
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Flush {
+		n += 2
+	}
+	if m.Sync {
+		n += 2
+	}
+	return n
+}
+
+func (m *OperateSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetSubTaskCfgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSubTaskCfgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Cfgs) > 0 {
+		for _, s := range m.Cfgs {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetCfgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovDmmaster(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.All {
+		n += 2
+	}
+	return n
+}
+
+func (m *CfgRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.CreateRevision != 0 {
+		n += 1 + sovDmmaster(uint64(m.CreateRevision))
+	}
+	if m.ModRevision != 0 {
+		n += 1 + sovDmmaster(uint64(m.ModRevision))
+	}
+	if m.Version != 0 {
+		n += 1 + sovDmmaster(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *CfgEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovDmmaster(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Cfg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetCfgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Cfg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovDmmaster(uint64(m.Revision))
+	}
+	if len(m.Cfgs) > 0 {
+		for _, e := range m.Cfgs {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetMasterCfgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetMasterCfgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cfg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *HandleErrorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	l = len(m.BinlogPos)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sqls) > 0 {
+		for _, s := range m.Sqls {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	l = len(m.BinlogGTID)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *HandleErrorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Handover {
+		n += 2
+	}
+	return n
+}
+
+func (m *TransferSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.PausedTasks) > 0 {
+		for _, s := range m.PausedTasks {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateRelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Worker) > 0 {
+		for _, s := range m.Worker {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateRelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *RestoreClusterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Backup)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *RestoreClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ExpireTime)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *OperateTaskLockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovDmmaster(uint64(m.Duration))
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *OperateTaskLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidateTaskConnectivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidateTaskConnectivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BackupEtcdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DmOnly {
+		n += 2
+	}
+	if m.Watch {
+		n += 2
+	}
+	if m.Revision != 0 {
+		n += 1 + sovDmmaster(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *EtcdKeyValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	if m.ModRevision != 0 {
+		n += 1 + sovDmmaster(uint64(m.ModRevision))
+	}
+	return n
+}
+
+func (m *BackupEtcdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovDmmaster(uint64(m.Revision))
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	if m.SnapshotDone {
+		n += 2
+	}
+	if len(m.Kvs) > 0 {
+		for _, e := range m.Kvs {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuarantineTableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *QuarantineTableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Tasks) > 0 {
+		for _, s := range m.Tasks {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperateProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Projects) > 0 {
+		for _, e := range m.Projects {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Tasks) > 0 {
+		for _, s := range m.Tasks {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Progress)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.EstimatedRemainingSeconds != 0 {
+		n += 1 + sovDmmaster(uint64(m.EstimatedRemainingSeconds))
+	}
+	if m.CutoverReady {
+		n += 2
+	}
+	if len(m.Blockers) > 0 {
+		for _, s := range m.Blockers {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if len(m.Subtasks) > 0 {
+		for _, e := range m.Subtasks {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectSubTaskStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovDmmaster(uint64(m.Unit))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmmaster(uint64(m.Stage))
+	}
+	l = len(m.Progress)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.EstimatedRemainingSeconds != 0 {
+		n += 1 + sovDmmaster(uint64(m.EstimatedRemainingSeconds))
+	}
+	if m.SecondsBehindMaster != 0 {
+		n += 1 + sovDmmaster(uint64(m.SecondsBehindMaster))
+	}
+	if m.Synced {
+		n += 2
+	}
+	return n
+}
+
+func (m *OperationHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovDmmaster(uint64(m.Limit))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *OperationHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.ClientHost)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Master)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *MigrateKeySchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rollback {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDmmaster(uint64(m.Version))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *MigrateKeySchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.FromVersion != 0 {
+		n += 1 + sovDmmaster(uint64(m.FromVersion))
+	}
+	if m.ToVersion != 0 {
+		n += 1 + sovDmmaster(uint64(m.ToVersion))
+	}
+	if m.LatestVersion != 0 {
+		n += 1 + sovDmmaster(uint64(m.LatestVersion))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeySchemaChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovDmmaster(uint64(m.Version))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.OldKey)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.NewKey)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeClusterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmmaster(uint64(m.Op))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkerUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Stage)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.HandoverTo)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.PausedTasks) > 0 {
+		for _, s := range m.PausedTasks {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetVersion)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.FromVersion)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpgradeClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchTaskStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, s := range m.Tasks {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	l = len(m.Interval)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.LagThreshold != 0 {
+		n += 1 + sovDmmaster(uint64(m.LagThreshold))
+	}
+	return n
+}
+
+func (m *SubTaskStatusChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmmaster(uint64(m.Stage))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovDmmaster(uint64(m.Unit))
+	}
+	if m.SecondsBehindMaster != 0 {
+		n += 1 + sovDmmaster(uint64(m.SecondsBehindMaster))
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	if m.Removed {
+		n += 2
+	}
+	return n
+}
+
+func (m *WatchTaskStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovDmmaster(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDmmaster(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDmmaster(x uint64) (n int) {
+	return sovDmmaster(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StartTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveMeta", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemoveMeta = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowOverlap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowOverlap = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, &CommonWorkerResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= TaskOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetErrorBudget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetErrorBudget = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= TaskOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, &CommonWorkerResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, &CommonWorkerResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatusListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatusListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatusListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Since = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatusListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatusListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatusListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, &QueryStatusResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, &TaskLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressHistory = append(m.ProgressHistory, &SubTaskProgressHistory{})
+			if err := m.ProgressHistory[len(m.ProgressHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubTaskProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubTaskProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubTaskProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= UnitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gtid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			m.Rows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubTaskProgressHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubTaskProgressHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubTaskProgressHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.First == nil {
+				m.First = &SubTaskProgress{}
+			}
+			if err := m.First.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Last == nil {
+				m.Last = &SubTaskProgress{}
+			}
+			if err := m.Last.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Moved = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsApplied", wireType)
+			}
+			m.RowsApplied = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsApplied |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShowDDLLocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShowDDLLocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShowDDLLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DDLLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DDLLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DDLLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Synced = append(m.Synced, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsynced", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShowDDLLocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShowDDLLocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShowDDLLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, &DDLLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockDDLLockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockDDLLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplaceOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRemove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceRemove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockDDLLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockDDLLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockDDLLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateWorkerRelayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateWorkerRelayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateWorkerRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= RelayOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateWorkerRelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateWorkerRelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateWorkerRelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= RelayOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, &CommonWorkerResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeWorkerRelayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeWorkerRelayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeWorkerRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inactive = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeWorkerRelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeWorkerRelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeWorkerRelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, &CommonWorkerResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCnt", wireType)
+			}
+			m.ErrCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrCnt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarnCnt", wireType)
+			}
+			m.WarnCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarnCnt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateSourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateSourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= SourceOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceID = append(m.SourceID, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, &CommonWorkerResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterWorkerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterWorkerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterWorkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterWorkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterWorkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OfflineMemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OfflineMemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OfflineMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OfflineMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OfflineMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OfflineMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateLeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateLeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= LeaderOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateLeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateLeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MasterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MasterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MasterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
+			}
+			m.MemberID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Alive = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientURLs = append(m.ClientURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListLeaderMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListLeaderMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListLeaderMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListMasterMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListMasterMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListMasterMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Masters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Masters = append(m.Masters, &MasterInfo{})
+			if err := m.Masters[len(m.Masters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListWorkerMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListWorkerMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListWorkerMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, &WorkerInfo{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Members) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Members: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Members: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListLeaderMember{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Member = &Members_Leader{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListMasterMember{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Member = &Members_Master{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListWorkerMember{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Member = &Members_Worker{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListMemberRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListMemberRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leader = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Master = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Worker = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &Members{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperateSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperateSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= SchemaOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Flush = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sync = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *OperateRelayResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmmaster(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDmmaster(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDmmaster(x uint64) (n int) {
-	return sovDmmaster(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *StartTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *OperateSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7560,15 +19527,35 @@ func (m *StartTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7596,13 +19583,13 @@ func (m *StartTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -7612,29 +19599,84 @@ func (m *StartTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			m.Sources = append(m.Sources, &CommonWorkerResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoveMeta", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSubTaskCfgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSubTaskCfgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSubTaskCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -7644,12 +19686,24 @@ func (m *StartTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RemoveMeta = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -7674,7 +19728,7 @@ func (m *StartTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *GetSubTaskCfgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7697,10 +19751,10 @@ func (m *StartTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSubTaskCfgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSubTaskCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7757,9 +19811,9 @@ func (m *StartTaskResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cfgs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -7769,25 +19823,23 @@ func (m *StartTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, &CommonWorkerResponse{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cfgs = append(m.Cfgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7813,7 +19865,7 @@ func (m *StartTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *GetCfgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7836,17 +19888,17 @@ func (m *OperateTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCfgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Op = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -7856,7 +19908,7 @@ func (m *OperateTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= TaskOp(b&0x7F) << shift
+				m.Type |= CfgType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7894,10 +19946,10 @@ func (m *OperateTaskRequest) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -7907,24 +19959,12 @@ func (m *OperateTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -7949,7 +19989,7 @@ func (m *OperateTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *CfgRevision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7972,17 +20012,17 @@ func (m *OperateTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CfgRevision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CfgRevision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -7992,16 +20032,29 @@ func (m *OperateTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= TaskOp(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRevision", wireType)
 			}
-			var v int
+			m.CreateRevision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -8011,17 +20064,16 @@ func (m *OperateTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CreateRevision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModRevision", wireType)
 			}
-			var stringLen uint64
+			m.ModRevision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -8031,29 +20083,16 @@ func (m *OperateTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ModRevision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -8063,26 +20102,11 @@ func (m *OperateTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sources = append(m.Sources, &CommonWorkerResponse{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -8107,7 +20131,7 @@ func (m *OperateTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *CfgEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8130,15 +20154,34 @@ func (m *UpdateTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CfgEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CfgEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CfgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8166,11 +20209,11 @@ func (m *UpdateTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8198,7 +20241,73 @@ func (m *UpdateTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			m.Cfg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, &CfgRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8224,7 +20333,7 @@ func (m *UpdateTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *GetCfgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8247,10 +20356,10 @@ func (m *UpdateTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCfgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8303,11 +20412,62 @@ func (m *UpdateTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cfg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cfgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8334,8 +20494,8 @@ func (m *UpdateTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, &CommonWorkerResponse{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cfgs = append(m.Cfgs, &CfgEntry{})
+			if err := m.Cfgs[len(m.Cfgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8363,7 +20523,7 @@ func (m *UpdateTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatusListRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMasterCfgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8386,47 +20546,68 @@ func (m *QueryStatusListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatusListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMasterCfgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatusListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMasterCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMasterCfgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMasterCfgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMasterCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8454,7 +20635,7 @@ func (m *QueryStatusListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			m.Cfg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8480,7 +20661,7 @@ func (m *QueryStatusListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatusListResponse) Unmarshal(dAtA []byte) error {
+func (m *HandleErrorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8503,17 +20684,17 @@ func (m *QueryStatusListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatusListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HandleErrorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatusListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HandleErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var v int
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -8523,15 +20704,14 @@ func (m *QueryStatusListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Op |= ErrorOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8559,13 +20739,13 @@ func (m *QueryStatusListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -8575,82 +20755,59 @@ func (m *QueryStatusListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, &QueryStatusResponse{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmmaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogPos", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDmmaster
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ShowDDLLocksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmmaster
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShowDDLLocksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShowDDLLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BinlogPos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sqls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8678,11 +20835,11 @@ func (m *ShowDDLLocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = string(dAtA[iNdEx:postIndex])
+			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogGTID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8710,7 +20867,7 @@ func (m *ShowDDLLocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			m.BinlogGTID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8736,7 +20893,7 @@ func (m *ShowDDLLocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DDLLock) Unmarshal(dAtA []byte) error {
+func (m *HandleErrorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8757,51 +20914,19 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DDLLock: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DDLLock: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandleErrorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandleErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -8811,27 +20936,15 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Task = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Result = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8859,13 +20972,13 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mode = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -8875,27 +20988,82 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Sources = append(m.Sources, &CommonWorkerResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmmaster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferSourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmmaster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferSourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8923,11 +21091,11 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8955,13 +21123,13 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Synced = append(m.Synced, string(dAtA[iNdEx:postIndex]))
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unsynced", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handover", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -8971,24 +21139,12 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Handover = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -9013,7 +21169,7 @@ func (m *DDLLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShowDDLLocksResponse) Unmarshal(dAtA []byte) error {
+func (m *TransferSourceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9036,10 +21192,10 @@ func (m *ShowDDLLocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShowDDLLocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferSourceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShowDDLLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9096,9 +21252,9 @@ func (m *ShowDDLLocksResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTasks", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -9108,25 +21264,23 @@ func (m *ShowDDLLocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locks = append(m.Locks, &DDLLock{})
-			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PausedTasks = append(m.PausedTasks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9152,7 +21306,7 @@ func (m *ShowDDLLocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
+func (m *OperateRelayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9175,17 +21329,17 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockDDLLockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateRelayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockDDLLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var stringLen uint64
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -9195,27 +21349,14 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Op |= RelayOpV2(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplaceOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9243,13 +21384,13 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplaceOwner = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceRemove", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -9259,12 +21400,24 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceRemove = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = append(m.Worker, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -9289,7 +21442,7 @@ func (m *UnlockDDLLockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnlockDDLLockResponse) Unmarshal(dAtA []byte) error {
+func (m *OperateRelayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9312,10 +21465,10 @@ func (m *UnlockDDLLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnlockDDLLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateRelayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnlockDDLLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateRelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9394,7 +21547,7 @@ func (m *UnlockDDLLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateWorkerRelayRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreClusterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9405,46 +21558,27 @@ func (m *OperateWorkerRelayRequest) Unmarshal(dAtA []byte) error {
 				return ErrIntOverflowDmmaster
 			}
 			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperateWorkerRelayRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateWorkerRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= RelayOp(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return io.ErrUnexpectedEOF
 			}
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreClusterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backup", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9472,7 +21606,7 @@ func (m *OperateWorkerRelayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			m.Backup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9498,7 +21632,7 @@ func (m *OperateWorkerRelayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateWorkerRelayResponse) Unmarshal(dAtA []byte) error {
+func (m *RestoreClusterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9521,32 +21655,13 @@ func (m *OperateWorkerRelayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateWorkerRelayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreClusterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateWorkerRelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= RelayOp(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
@@ -9566,7 +21681,7 @@ func (m *OperateWorkerRelayResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Result = bool(v != 0)
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
@@ -9598,40 +21713,6 @@ func (m *OperateWorkerRelayResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sources = append(m.Sources, &CommonWorkerResponse{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -9656,7 +21737,7 @@ func (m *OperateWorkerRelayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeWorkerRelayRequest) Unmarshal(dAtA []byte) error {
+func (m *TaskLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9679,15 +21760,15 @@ func (m *PurgeWorkerRelayRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeWorkerRelayRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeWorkerRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9715,13 +21796,13 @@ func (m *PurgeWorkerRelayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -9731,34 +21812,27 @@ func (m *PurgeWorkerRelayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Inactive = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
 			}
-			m.Time = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9786,11 +21860,11 @@ func (m *PurgeWorkerRelayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9818,7 +21892,7 @@ func (m *PurgeWorkerRelayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubDir = string(dAtA[iNdEx:postIndex])
+			m.ExpireTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9844,7 +21918,7 @@ func (m *PurgeWorkerRelayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeWorkerRelayResponse) Unmarshal(dAtA []byte) error {
+func (m *OperateTaskLockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9867,17 +21941,17 @@ func (m *PurgeWorkerRelayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeWorkerRelayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateTaskLockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeWorkerRelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateTaskLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var v int
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -9887,15 +21961,14 @@ func (m *PurgeWorkerRelayResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Op |= TaskLockOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9923,13 +21996,13 @@ func (m *PurgeWorkerRelayResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -9939,26 +22012,95 @@ func (m *PurgeWorkerRelayResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, &CommonWorkerResponse{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -9983,7 +22125,7 @@ func (m *PurgeWorkerRelayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *OperateTaskLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10006,17 +22148,17 @@ func (m *CheckTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateTaskLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateTaskLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10026,29 +22168,17 @@ func (m *CheckTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Task = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Result = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrCnt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			m.ErrCnt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10058,16 +22188,29 @@ func (m *CheckTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrCnt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WarnCnt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 			}
-			m.WarnCnt = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10077,11 +22220,26 @@ func (m *CheckTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WarnCnt |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, &TaskLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -10106,7 +22264,7 @@ func (m *CheckTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidateTaskConnectivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10129,17 +22287,17 @@ func (m *CheckTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateTaskConnectivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateTaskConnectivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10149,15 +22307,27 @@ func (m *CheckTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10185,7 +22355,7 @@ func (m *CheckTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10211,7 +22381,7 @@ func (m *CheckTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateSourceRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidateTaskConnectivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10234,17 +22404,17 @@ func (m *OperateSourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateSourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateTaskConnectivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateTaskConnectivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.Op = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10254,14 +22424,15 @@ func (m *OperateSourceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= SourceOp(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Result = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10289,13 +22460,13 @@ func (m *OperateSourceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = append(m.Config, string(dAtA[iNdEx:postIndex]))
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10305,23 +22476,25 @@ func (m *OperateSourceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceID = append(m.SourceID, string(dAtA[iNdEx:postIndex]))
+			m.Sources = append(m.Sources, &ValidateConnectivityResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10347,7 +22520,7 @@ func (m *OperateSourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *BackupEtcdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10370,15 +22543,15 @@ func (m *OperateSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEtcdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEtcdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DmOnly", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10395,12 +22568,12 @@ func (m *OperateSourceResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
+			m.DmOnly = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Watch", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10410,29 +22583,17 @@ func (m *OperateSourceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Watch = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10442,26 +22603,11 @@ func (m *OperateSourceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Revision |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sources = append(m.Sources, &CommonWorkerResponse{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -10486,7 +22632,7 @@ func (m *OperateSourceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterWorkerRequest) Unmarshal(dAtA []byte) error {
+func (m *EtcdKeyValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10509,15 +22655,15 @@ func (m *RegisterWorkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterWorkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EtcdKeyValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterWorkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EtcdKeyValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10545,11 +22691,11 @@ func (m *RegisterWorkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10577,8 +22723,47 @@ func (m *RegisterWorkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModRevision", wireType)
+			}
+			m.ModRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -10603,7 +22788,7 @@ func (m *RegisterWorkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterWorkerResponse) Unmarshal(dAtA []byte) error {
+func (m *BackupEtcdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10626,10 +22811,10 @@ func (m *RegisterWorkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterWorkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupEtcdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupEtcdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10684,64 +22869,50 @@ func (m *RegisterWorkerResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmmaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OfflineMemberRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmmaster
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OfflineMemberRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OfflineMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			m.Snapshot = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotDone", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10751,29 +22922,17 @@ func (m *OfflineMemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.SnapshotDone = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10783,23 +22942,25 @@ func (m *OfflineMemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kvs = append(m.Kvs, &EtcdKeyValue{})
+			if err := m.Kvs[len(m.Kvs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10825,7 +22986,7 @@ func (m *OfflineMemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OfflineMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *QuarantineTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10848,17 +23009,17 @@ func (m *OfflineMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OfflineMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuarantineTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OfflineMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuarantineTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var v int
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10868,15 +23029,14 @@ func (m *OfflineMemberResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Op |= QuarantineOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10904,66 +23064,13 @@ func (m *OfflineMemberResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmmaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperateLeaderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmmaster
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperateLeaderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -10973,69 +23080,29 @@ func (m *OperateLeaderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= LeaderOp(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmmaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperateLeaderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmmaster
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperateLeaderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -11045,15 +23112,27 @@ func (m *OperateLeaderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11081,7 +23160,7 @@ func (m *OperateLeaderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11107,7 +23186,7 @@ func (m *OperateLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MasterInfo) Unmarshal(dAtA []byte) error {
+func (m *QuarantineTableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11130,66 +23209,15 @@ func (m *MasterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MasterInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MasterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
-			}
-			m.MemberID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MemberID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+			return fmt.Errorf("proto: QuarantineTableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantineTableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11206,10 +23234,10 @@ func (m *MasterInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Alive = bool(v != 0)
-		case 4:
+			m.Result = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11237,13 +23265,13 @@ func (m *MasterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerURLs = append(m.PeerURLs, string(dAtA[iNdEx:postIndex]))
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -11253,23 +23281,25 @@ func (m *MasterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientURLs = append(m.ClientURLs, string(dAtA[iNdEx:postIndex]))
+			m.Sources = append(m.Sources, &CommonWorkerResponse{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11295,7 +23325,7 @@ func (m *MasterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
+func (m *OperateProjectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11318,17 +23348,17 @@ func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateProjectRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var stringLen uint64
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -11338,27 +23368,14 @@ func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Op |= ProjectOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11386,43 +23403,11 @@ func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11450,7 +23435,7 @@ func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Tasks = append(m.Tasks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11476,7 +23461,7 @@ func (m *WorkerInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListLeaderMember) Unmarshal(dAtA []byte) error {
+func (m *OperateProjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11499,17 +23484,17 @@ func (m *ListLeaderMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListLeaderMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateProjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListLeaderMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -11519,27 +23504,15 @@ func (m *ListLeaderMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Result = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11567,13 +23540,13 @@ func (m *ListLeaderMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -11583,23 +23556,25 @@ func (m *ListLeaderMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Projects = append(m.Projects, &ProjectStatus{})
+			if err := m.Projects[len(m.Projects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11625,7 +23600,7 @@ func (m *ListLeaderMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListMasterMember) Unmarshal(dAtA []byte) error {
+func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11648,15 +23623,15 @@ func (m *ListMasterMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListMasterMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMasterMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11684,13 +23659,13 @@ func (m *ListMasterMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Masters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -11700,82 +23675,130 @@ func (m *ListMasterMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Masters = append(m.Masters, &MasterInfo{})
-			if err := m.Masters[len(m.Masters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tasks = append(m.Tasks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmmaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListWorkerMember) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmmaster
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Progress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedRemainingSeconds", wireType)
+			}
+			m.EstimatedRemainingSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedRemainingSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CutoverReady", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListWorkerMember: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListWorkerMember: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CutoverReady = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11803,11 +23826,11 @@ func (m *ListWorkerMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Blockers = append(m.Blockers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subtasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11834,8 +23857,8 @@ func (m *ListWorkerMember) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, &WorkerInfo{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Subtasks = append(m.Subtasks, &ProjectSubTaskStatus{})
+			if err := m.Subtasks[len(m.Subtasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11863,7 +23886,7 @@ func (m *ListWorkerMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Members) Unmarshal(dAtA []byte) error {
+func (m *ProjectSubTaskStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11886,17 +23909,17 @@ func (m *Members) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Members: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectSubTaskStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Members: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectSubTaskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -11906,32 +23929,29 @@ func (m *Members) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ListLeaderMember{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Member = &Members_Leader{v}
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -11941,32 +23961,67 @@ func (m *Members) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ListMasterMember{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Member = &Members_Master{v}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= UnitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -11976,27 +24031,82 @@ func (m *Members) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ListWorkerMember{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Member = &Members_Worker{v}
+			m.Progress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedRemainingSeconds", wireType)
+			}
+			m.EstimatedRemainingSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedRemainingSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsBehindMaster", wireType)
+			}
+			m.SecondsBehindMaster = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsBehindMaster |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -12021,7 +24131,7 @@ func (m *Members) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListMemberRequest) Unmarshal(dAtA []byte) error {
+func (m *OperationHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12044,17 +24154,17 @@ func (m *ListMemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListMemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -12064,17 +24174,16 @@ func (m *ListMemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Leader = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -12084,35 +24193,27 @@ func (m *ListMemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
 			}
-			m.Worker = bool(v != 0)
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12140,7 +24241,7 @@ func (m *ListMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12166,7 +24267,7 @@ func (m *ListMemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *OperationHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12189,10 +24290,10 @@ func (m *ListMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12249,7 +24350,7 @@ func (m *ListMemberResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12276,8 +24377,8 @@ func (m *ListMemberResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &Members{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &OperationRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12305,7 +24406,7 @@ func (m *ListMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *OperationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12328,17 +24429,49 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateSchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Op = 0
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -12348,14 +24481,27 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= SchemaOp(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12383,11 +24529,11 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12415,11 +24561,11 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			m.ClientType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientHost", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12447,11 +24593,11 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.ClientHost = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Master", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12479,11 +24625,11 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			m.Master = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12511,11 +24657,11 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.Args = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12532,12 +24678,12 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Flush = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			m.Result = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -12547,12 +24693,24 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Sync = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -12577,7 +24735,7 @@ func (m *OperateSchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateSchemaResponse) Unmarshal(dAtA []byte) error {
+func (m *MigrateKeySchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12600,15 +24758,15 @@ func (m *OperateSchemaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateSchemaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateKeySchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateKeySchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -12625,12 +24783,12 @@ func (m *OperateSchemaResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
+			m.Rollback = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -12640,29 +24798,16 @@ func (m *OperateSchemaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -12672,26 +24817,12 @@ func (m *OperateSchemaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sources = append(m.Sources, &CommonWorkerResponse{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -12716,7 +24847,7 @@ func (m *OperateSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSubTaskCfgRequest) Unmarshal(dAtA []byte) error {
+func (m *MigrateKeySchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12739,15 +24870,35 @@ func (m *GetSubTaskCfgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSubTaskCfgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateKeySchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSubTaskCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateKeySchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12775,7 +24926,98 @@ func (m *GetSubTaskCfgRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			m.FromVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			m.ToVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
+			}
+			m.LatestVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &KeySchemaChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12801,7 +25043,7 @@ func (m *GetSubTaskCfgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetSubTaskCfgResponse) Unmarshal(dAtA []byte) error {
+func (m *KeySchemaChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12824,17 +25066,17 @@ func (m *GetSubTaskCfgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetSubTaskCfgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeySchemaChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetSubTaskCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeySchemaChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -12844,15 +25086,46 @@ func (m *GetSubTaskCfgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12880,11 +25153,11 @@ func (m *GetSubTaskCfgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.OldKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cfgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12912,7 +25185,7 @@ func (m *GetSubTaskCfgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cfgs = append(m.Cfgs, string(dAtA[iNdEx:postIndex]))
+			m.NewKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12938,7 +25211,7 @@ func (m *GetSubTaskCfgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCfgRequest) Unmarshal(dAtA []byte) error {
+func (m *UpgradeClusterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12961,17 +25234,17 @@ func (m *GetCfgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCfgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeClusterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.Type = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -12981,14 +25254,14 @@ func (m *GetCfgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= CfgType(b&0x7F) << shift
+				m.Op |= UpgradeClusterOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13016,7 +25289,39 @@ func (m *GetCfgRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13042,7 +25347,7 @@ func (m *GetCfgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCfgResponse) Unmarshal(dAtA []byte) error {
+func (m *WorkerUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13065,17 +25370,17 @@ func (m *GetCfgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCfgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -13085,15 +25390,27 @@ func (m *GetCfgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13121,11 +25438,11 @@ func (m *GetCfgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Stage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13153,117 +25470,43 @@ func (m *GetCfgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cfg = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmmaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetMasterCfgRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmmaster
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoverTo", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetMasterCfgRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMasterCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmmaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetMasterCfgResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmmaster
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetMasterCfgResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMasterCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.HandoverTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTasks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13291,7 +25534,7 @@ func (m *GetMasterCfgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cfg = string(dAtA[iNdEx:postIndex])
+			m.PausedTasks = append(m.PausedTasks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13317,7 +25560,7 @@ func (m *GetMasterCfgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HandleErrorRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13340,34 +25583,15 @@ func (m *HandleErrorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HandleErrorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandleErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmmaster
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= ErrorOp(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13395,11 +25619,11 @@ func (m *HandleErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = string(dAtA[iNdEx:postIndex])
+			m.TargetVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13427,11 +25651,11 @@ func (m *HandleErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
+			m.FromVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinlogPos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13459,13 +25683,13 @@ func (m *HandleErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinlogPos = string(dAtA[iNdEx:postIndex])
+			m.StartTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sqls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -13475,23 +25699,25 @@ func (m *HandleErrorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
+			m.Workers = append(m.Workers, &WorkerUpgrade{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13517,7 +25743,7 @@ func (m *HandleErrorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HandleErrorResponse) Unmarshal(dAtA []byte) error {
+func (m *UpgradeClusterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13540,10 +25766,10 @@ func (m *HandleErrorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HandleErrorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeClusterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandleErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13600,7 +25826,7 @@ func (m *HandleErrorResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13627,8 +25853,10 @@ func (m *HandleErrorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, &CommonWorkerResponse{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Upgrade == nil {
+				m.Upgrade = &ClusterUpgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13656,7 +25884,7 @@ func (m *HandleErrorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferSourceRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchTaskStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13679,15 +25907,15 @@ func (m *TransferSourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferSourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchTaskStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferSourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchTaskStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13715,11 +25943,11 @@ func (m *TransferSourceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Tasks = append(m.Tasks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13747,8 +25975,27 @@ func (m *TransferSourceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
+			m.Interval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LagThreshold", wireType)
+			}
+			m.LagThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LagThreshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -13773,7 +26020,7 @@ func (m *TransferSourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferSourceResponse) Unmarshal(dAtA []byte) error {
+func (m *SubTaskStatusChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13796,17 +26043,17 @@ func (m *TransferSourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferSourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubTaskStatusChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubTaskStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -13816,15 +26063,27 @@ func (m *TransferSourceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13852,66 +26111,83 @@ func (m *TransferSourceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmmaster(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDmmaster
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperateRelayRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmmaster
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperateRelayRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= UnitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsBehindMaster", wireType)
 			}
-			m.Op = 0
+			m.SecondsBehindMaster = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -13921,14 +26197,14 @@ func (m *OperateRelayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= RelayOpV2(b&0x7F) << shift
+				m.SecondsBehindMaster |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13956,13 +26232,13 @@ func (m *OperateRelayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmmaster
@@ -13972,24 +26248,12 @@ func (m *OperateRelayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmmaster
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Worker = append(m.Worker, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Removed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -14014,7 +26278,7 @@ func (m *OperateRelayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateRelayResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchTaskStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14037,10 +26301,10 @@ func (m *OperateRelayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateRelayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchTaskStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateRelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchTaskStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14095,6 +26359,72 @@ func (m *OperateRelayResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &SubTaskStatusChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package pb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -271,6 +272,8 @@ const (
 	ErrorOp_Skip           ErrorOp = 1
 	ErrorOp_Replace        ErrorOp = 2
 	ErrorOp_Revert         ErrorOp = 3
+	ErrorOp_Inject         ErrorOp = 4
+	ErrorOp_Preview        ErrorOp = 5
 )
 
 var ErrorOp_name = map[int32]string{
@@ -278,6 +281,8 @@ var ErrorOp_name = map[int32]string{
 	1: "Skip",
 	2: "Replace",
 	3: "Revert",
+	4: "Inject",
+	5: "Preview",
 }
 
 var ErrorOp_value = map[string]int32{
@@ -285,6 +290,8 @@ var ErrorOp_value = map[string]int32{
 	"Skip":           1,
 	"Replace":        2,
 	"Revert":         3,
+	"Inject":         4,
+	"Preview":        5,
 }
 
 func (x ErrorOp) String() string {
@@ -295,6 +302,40 @@ func (ErrorOp) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{6}
 }
 
+type QuarantineOp int32
+
+const (
+	QuarantineOp_InvalidQuarantineOp QuarantineOp = 0
+	QuarantineOp_AddQuarantine       QuarantineOp = 1
+	QuarantineOp_ReleaseQuarantine   QuarantineOp = 2
+	QuarantineOp_ShowQuarantine      QuarantineOp = 3
+	QuarantineOp_RepairQuarantine    QuarantineOp = 4
+)
+
+var QuarantineOp_name = map[int32]string{
+	0: "InvalidQuarantineOp",
+	1: "AddQuarantine",
+	2: "ReleaseQuarantine",
+	3: "ShowQuarantine",
+	4: "RepairQuarantine",
+}
+
+var QuarantineOp_value = map[string]int32{
+	"InvalidQuarantineOp": 0,
+	"AddQuarantine":       1,
+	"ReleaseQuarantine":   2,
+	"ShowQuarantine":      3,
+	"RepairQuarantine":    4,
+}
+
+func (x QuarantineOp) String() string {
+	return proto.EnumName(QuarantineOp_name, int32(x))
+}
+
+func (QuarantineOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{7}
+}
+
 type QueryStatusRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
@@ -564,8 +605,8 @@ func (m *CheckStatus) GetDetail() []byte {
 }
 
 // DumpStatus represents status for dump unit
-// add fields later
 type DumpStatus struct {
+	ProgressDetail *Progress `protobuf:"bytes,1,opt,name=progressDetail,proto3" json:"progressDetail,omitempty"`
 }
 
 func (m *DumpStatus) Reset()         { *m = DumpStatus{} }
@@ -601,13 +642,24 @@ func (m *DumpStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DumpStatus proto.InternalMessageInfo
 
+func (m *DumpStatus) GetProgressDetail() *Progress {
+	if m != nil {
+		return m.ProgressDetail
+	}
+	return nil
+}
+
 // LoadStatus represents status for load unit
 type LoadStatus struct {
-	FinishedBytes  int64  `protobuf:"varint,1,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
-	TotalBytes     int64  `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
-	Progress       string `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
-	MetaBinlog     string `protobuf:"bytes,4,opt,name=metaBinlog,proto3" json:"metaBinlog,omitempty"`
-	MetaBinlogGTID string `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
+	FinishedBytes             int64     `protobuf:"varint,1,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
+	TotalBytes                int64     `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	Progress                  string    `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	MetaBinlog                string    `protobuf:"bytes,4,opt,name=metaBinlog,proto3" json:"metaBinlog,omitempty"`
+	MetaBinlogGTID            string    `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
+	FinishedRows              int64     `protobuf:"varint,6,opt,name=finishedRows,proto3" json:"finishedRows,omitempty"`
+	Bps                       int64     `protobuf:"varint,7,opt,name=bps,proto3" json:"bps,omitempty"`
+	EstimatedRemainingSeconds int64     `protobuf:"varint,8,opt,name=estimatedRemainingSeconds,proto3" json:"estimatedRemainingSeconds,omitempty"`
+	ProgressDetail            *Progress `protobuf:"bytes,9,opt,name=progressDetail,proto3" json:"progressDetail,omitempty"`
 }
 
 func (m *LoadStatus) Reset()         { *m = LoadStatus{} }
@@ -678,6 +730,34 @@ func (m *LoadStatus) GetMetaBinlogGTID() string {
 	return ""
 }
 
+func (m *LoadStatus) GetFinishedRows() int64 {
+	if m != nil {
+		return m.FinishedRows
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetBps() int64 {
+	if m != nil {
+		return m.Bps
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetEstimatedRemainingSeconds() int64 {
+	if m != nil {
+		return m.EstimatedRemainingSeconds
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetProgressDetail() *Progress {
+	if m != nil {
+		return m.ProgressDetail
+	}
+	return nil
+}
+
 // ShardingGroup represents a DDL sharding group, this is used by SyncStatus, and is differ from ShardingGroup in syncer pkg
 // target: target table name
 // DDL: in syncing DDL
@@ -762,18 +842,23 @@ func (m *ShardingGroup) GetUnsynced() []string {
 
 // SyncStatus represents status for sync unit
 type SyncStatus struct {
-	TotalEvents         int64            `protobuf:"varint,1,opt,name=totalEvents,proto3" json:"totalEvents,omitempty"`
-	TotalTps            int64            `protobuf:"varint,2,opt,name=totalTps,proto3" json:"totalTps,omitempty"`
-	RecentTps           int64            `protobuf:"varint,3,opt,name=recentTps,proto3" json:"recentTps,omitempty"`
-	MasterBinlog        string           `protobuf:"bytes,4,opt,name=masterBinlog,proto3" json:"masterBinlog,omitempty"`
-	MasterBinlogGtid    string           `protobuf:"bytes,5,opt,name=masterBinlogGtid,proto3" json:"masterBinlogGtid,omitempty"`
-	SyncerBinlog        string           `protobuf:"bytes,6,opt,name=syncerBinlog,proto3" json:"syncerBinlog,omitempty"`
-	SyncerBinlogGtid    string           `protobuf:"bytes,7,opt,name=syncerBinlogGtid,proto3" json:"syncerBinlogGtid,omitempty"`
-	BlockingDDLs        []string         `protobuf:"bytes,8,rep,name=blockingDDLs,proto3" json:"blockingDDLs,omitempty"`
-	UnresolvedGroups    []*ShardingGroup `protobuf:"bytes,9,rep,name=unresolvedGroups,proto3" json:"unresolvedGroups,omitempty"`
-	Synced              bool             `protobuf:"varint,10,opt,name=synced,proto3" json:"synced,omitempty"`
-	BinlogType          string           `protobuf:"bytes,11,opt,name=binlogType,proto3" json:"binlogType,omitempty"`
-	SecondsBehindMaster int64            `protobuf:"varint,12,opt,name=secondsBehindMaster,proto3" json:"secondsBehindMaster,omitempty"`
+	TotalEvents         int64             `protobuf:"varint,1,opt,name=totalEvents,proto3" json:"totalEvents,omitempty"`
+	TotalTps            int64             `protobuf:"varint,2,opt,name=totalTps,proto3" json:"totalTps,omitempty"`
+	RecentTps           int64             `protobuf:"varint,3,opt,name=recentTps,proto3" json:"recentTps,omitempty"`
+	MasterBinlog        string            `protobuf:"bytes,4,opt,name=masterBinlog,proto3" json:"masterBinlog,omitempty"`
+	MasterBinlogGtid    string            `protobuf:"bytes,5,opt,name=masterBinlogGtid,proto3" json:"masterBinlogGtid,omitempty"`
+	SyncerBinlog        string            `protobuf:"bytes,6,opt,name=syncerBinlog,proto3" json:"syncerBinlog,omitempty"`
+	SyncerBinlogGtid    string            `protobuf:"bytes,7,opt,name=syncerBinlogGtid,proto3" json:"syncerBinlogGtid,omitempty"`
+	BlockingDDLs        []string          `protobuf:"bytes,8,rep,name=blockingDDLs,proto3" json:"blockingDDLs,omitempty"`
+	UnresolvedGroups    []*ShardingGroup  `protobuf:"bytes,9,rep,name=unresolvedGroups,proto3" json:"unresolvedGroups,omitempty"`
+	Synced              bool              `protobuf:"varint,10,opt,name=synced,proto3" json:"synced,omitempty"`
+	BinlogType          string            `protobuf:"bytes,11,opt,name=binlogType,proto3" json:"binlogType,omitempty"`
+	SecondsBehindMaster int64             `protobuf:"varint,12,opt,name=secondsBehindMaster,proto3" json:"secondsBehindMaster,omitempty"`
+	LastEventTime       string            `protobuf:"bytes,13,opt,name=lastEventTime,proto3" json:"lastEventTime,omitempty"`
+	IdleDuration        int64             `protobuf:"varint,14,opt,name=idleDuration,proto3" json:"idleDuration,omitempty"`
+	RunningDDLs         []*DDLJobProgress `protobuf:"bytes,15,rep,name=runningDDLs,proto3" json:"runningDDLs,omitempty"`
+	ProgressDetail      *Progress         `protobuf:"bytes,16,opt,name=progressDetail,proto3" json:"progressDetail,omitempty"`
+	SyncWindowStart     string            `protobuf:"bytes,17,opt,name=syncWindowStart,proto3" json:"syncWindowStart,omitempty"`
 }
 
 func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
@@ -893,26 +978,70 @@ func (m *SyncStatus) GetSecondsBehindMaster() int64 {
 	return 0
 }
 
-// SourceStatus represents status for source runing on dm-worker
-type SourceStatus struct {
-	Source      string         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Worker      string         `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
-	Result      *ProcessResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
-	RelayStatus *RelayStatus   `protobuf:"bytes,4,opt,name=relayStatus,proto3" json:"relayStatus,omitempty"`
+func (m *SyncStatus) GetLastEventTime() string {
+	if m != nil {
+		return m.LastEventTime
+	}
+	return ""
 }
 
-func (m *SourceStatus) Reset()         { *m = SourceStatus{} }
-func (m *SourceStatus) String() string { return proto.CompactTextString(m) }
-func (*SourceStatus) ProtoMessage()    {}
-func (*SourceStatus) Descriptor() ([]byte, []int) {
+func (m *SyncStatus) GetIdleDuration() int64 {
+	if m != nil {
+		return m.IdleDuration
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetRunningDDLs() []*DDLJobProgress {
+	if m != nil {
+		return m.RunningDDLs
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetProgressDetail() *Progress {
+	if m != nil {
+		return m.ProgressDetail
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetSyncWindowStart() string {
+	if m != nil {
+		return m.SyncWindowStart
+	}
+	return ""
+}
+
+// Progress represents the progress of a unit in the same structure for all the units,
+// so the progress can be shown without knowing the unit.
+// finished: the amount of the work done, in `unit`
+// total: the amount of all the work, 0 if it's unknown
+// unit: the unit of the amounts, like "bytes" or "rows"
+// rate: the average amount done per second since the unit started or resumed
+// estimatedRemainingSeconds: -1 if it can't be estimated
+// phase: what the unit is doing now
+type Progress struct {
+	Finished                  int64  `protobuf:"varint,1,opt,name=finished,proto3" json:"finished,omitempty"`
+	Total                     int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Unit                      string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Rate                      int64  `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	EstimatedRemainingSeconds int64  `protobuf:"varint,5,opt,name=estimatedRemainingSeconds,proto3" json:"estimatedRemainingSeconds,omitempty"`
+	Phase                     string `protobuf:"bytes,6,opt,name=phase,proto3" json:"phase,omitempty"`
+}
+
+func (m *Progress) Reset()         { *m = Progress{} }
+func (m *Progress) String() string { return proto.CompactTextString(m) }
+func (*Progress) ProtoMessage()    {}
+func (*Progress) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{8}
 }
-func (m *SourceStatus) XXX_Unmarshal(b []byte) error {
+func (m *Progress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SourceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Progress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SourceStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Progress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -922,70 +1051,84 @@ func (m *SourceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SourceStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SourceStatus.Merge(m, src)
+func (m *Progress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Progress.Merge(m, src)
 }
-func (m *SourceStatus) XXX_Size() int {
+func (m *Progress) XXX_Size() int {
 	return m.Size()
 }
-func (m *SourceStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_SourceStatus.DiscardUnknown(m)
+func (m *Progress) XXX_DiscardUnknown() {
+	xxx_messageInfo_Progress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SourceStatus proto.InternalMessageInfo
+var xxx_messageInfo_Progress proto.InternalMessageInfo
 
-func (m *SourceStatus) GetSource() string {
+func (m *Progress) GetFinished() int64 {
 	if m != nil {
-		return m.Source
+		return m.Finished
 	}
-	return ""
+	return 0
 }
 
-func (m *SourceStatus) GetWorker() string {
+func (m *Progress) GetTotal() int64 {
 	if m != nil {
-		return m.Worker
+		return m.Total
+	}
+	return 0
+}
+
+func (m *Progress) GetUnit() string {
+	if m != nil {
+		return m.Unit
 	}
 	return ""
 }
 
-func (m *SourceStatus) GetResult() *ProcessResult {
+func (m *Progress) GetRate() int64 {
 	if m != nil {
-		return m.Result
+		return m.Rate
 	}
-	return nil
+	return 0
 }
 
-func (m *SourceStatus) GetRelayStatus() *RelayStatus {
+func (m *Progress) GetEstimatedRemainingSeconds() int64 {
 	if m != nil {
-		return m.RelayStatus
+		return m.EstimatedRemainingSeconds
 	}
-	return nil
+	return 0
 }
 
-// RelayStatus represents status for relay unit.
-type RelayStatus struct {
-	MasterBinlog       string         `protobuf:"bytes,1,opt,name=masterBinlog,proto3" json:"masterBinlog,omitempty"`
-	MasterBinlogGtid   string         `protobuf:"bytes,2,opt,name=masterBinlogGtid,proto3" json:"masterBinlogGtid,omitempty"`
-	RelaySubDir        string         `protobuf:"bytes,3,opt,name=relaySubDir,proto3" json:"relaySubDir,omitempty"`
-	RelayBinlog        string         `protobuf:"bytes,4,opt,name=relayBinlog,proto3" json:"relayBinlog,omitempty"`
-	RelayBinlogGtid    string         `protobuf:"bytes,5,opt,name=relayBinlogGtid,proto3" json:"relayBinlogGtid,omitempty"`
-	RelayCatchUpMaster bool           `protobuf:"varint,6,opt,name=relayCatchUpMaster,proto3" json:"relayCatchUpMaster,omitempty"`
-	Stage              Stage          `protobuf:"varint,7,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
-	Result             *ProcessResult `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+func (m *Progress) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
 }
 
-func (m *RelayStatus) Reset()         { *m = RelayStatus{} }
-func (m *RelayStatus) String() string { return proto.CompactTextString(m) }
-func (*RelayStatus) ProtoMessage()    {}
-func (*RelayStatus) Descriptor() ([]byte, []int) {
+// DDLJobProgress represents the progress of a DDL job in downstream TiDB, which is tracked asynchronously
+// after the execution of DDL timed out.
+type DDLJobProgress struct {
+	Ddl         string `protobuf:"bytes,1,opt,name=ddl,proto3" json:"ddl,omitempty"`
+	JobID       int64  `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	JobType     string `protobuf:"bytes,3,opt,name=jobType,proto3" json:"jobType,omitempty"`
+	SchemaState string `protobuf:"bytes,4,opt,name=schemaState,proto3" json:"schemaState,omitempty"`
+	State       string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	RowCount    int64  `protobuf:"varint,6,opt,name=rowCount,proto3" json:"rowCount,omitempty"`
+	StartTime   string `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime,omitempty"`
+}
+
+func (m *DDLJobProgress) Reset()         { *m = DDLJobProgress{} }
+func (m *DDLJobProgress) String() string { return proto.CompactTextString(m) }
+func (*DDLJobProgress) ProtoMessage()    {}
+func (*DDLJobProgress) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{9}
 }
-func (m *RelayStatus) XXX_Unmarshal(b []byte) error {
+func (m *DDLJobProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RelayStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DDLJobProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RelayStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DDLJobProgress.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -995,111 +1138,88 @@ func (m *RelayStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RelayStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RelayStatus.Merge(m, src)
+func (m *DDLJobProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DDLJobProgress.Merge(m, src)
 }
-func (m *RelayStatus) XXX_Size() int {
+func (m *DDLJobProgress) XXX_Size() int {
 	return m.Size()
 }
-func (m *RelayStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_RelayStatus.DiscardUnknown(m)
+func (m *DDLJobProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_DDLJobProgress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RelayStatus proto.InternalMessageInfo
+var xxx_messageInfo_DDLJobProgress proto.InternalMessageInfo
 
-func (m *RelayStatus) GetMasterBinlog() string {
+func (m *DDLJobProgress) GetDdl() string {
 	if m != nil {
-		return m.MasterBinlog
+		return m.Ddl
 	}
 	return ""
 }
 
-func (m *RelayStatus) GetMasterBinlogGtid() string {
+func (m *DDLJobProgress) GetJobID() int64 {
 	if m != nil {
-		return m.MasterBinlogGtid
+		return m.JobID
 	}
-	return ""
+	return 0
 }
 
-func (m *RelayStatus) GetRelaySubDir() string {
+func (m *DDLJobProgress) GetJobType() string {
 	if m != nil {
-		return m.RelaySubDir
+		return m.JobType
 	}
 	return ""
 }
 
-func (m *RelayStatus) GetRelayBinlog() string {
+func (m *DDLJobProgress) GetSchemaState() string {
 	if m != nil {
-		return m.RelayBinlog
+		return m.SchemaState
 	}
 	return ""
 }
 
-func (m *RelayStatus) GetRelayBinlogGtid() string {
+func (m *DDLJobProgress) GetState() string {
 	if m != nil {
-		return m.RelayBinlogGtid
+		return m.State
 	}
 	return ""
 }
 
-func (m *RelayStatus) GetRelayCatchUpMaster() bool {
-	if m != nil {
-		return m.RelayCatchUpMaster
-	}
-	return false
-}
-
-func (m *RelayStatus) GetStage() Stage {
+func (m *DDLJobProgress) GetRowCount() int64 {
 	if m != nil {
-		return m.Stage
+		return m.RowCount
 	}
-	return Stage_InvalidStage
+	return 0
 }
 
-func (m *RelayStatus) GetResult() *ProcessResult {
+func (m *DDLJobProgress) GetStartTime() string {
 	if m != nil {
-		return m.Result
+		return m.StartTime
 	}
-	return nil
+	return ""
 }
 
-// SubTaskStatus represents status for a sub task
-// name: sub task'name, when starting a sub task the name should be unique
-// stage: sub task's current stage
-// unit: sub task's current dm unit's UnitType
-// result: current unit's process result, when the stage is Running, no result
-// unresolvedDDLLockID: un-resolved sharding DDL lock ID (ref DDLLockInfo)
-//                      if needed, we can put this to SyncStatus
-// status: current unit's statistics
-//         for Load, includes total bytes, progress, etc.
-//         for Sync, includes TPS, binlog meta, etc.
-type SubTaskStatus struct {
-	Name                string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Stage               Stage          `protobuf:"varint,2,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
-	Unit                UnitType       `protobuf:"varint,3,opt,name=unit,proto3,enum=pb.UnitType" json:"unit,omitempty"`
-	Result              *ProcessResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
-	UnresolvedDDLLockID string         `protobuf:"bytes,5,opt,name=unresolvedDDLLockID,proto3" json:"unresolvedDDLLockID,omitempty"`
-	// Types that are valid to be assigned to Status:
-	//	*SubTaskStatus_Msg
-	//	*SubTaskStatus_Check
-	//	*SubTaskStatus_Dump
-	//	*SubTaskStatus_Load
-	//	*SubTaskStatus_Sync
-	Status isSubTaskStatus_Status `protobuf_oneof:"status"`
+// SourceStatus represents status for source runing on dm-worker
+type SourceStatus struct {
+	Source         string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Worker         string                 `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
+	Result         *ProcessResult         `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	RelayStatus    *RelayStatus           `protobuf:"bytes,4,opt,name=relayStatus,proto3" json:"relayStatus,omitempty"`
+	LatencySamples []*SourceLatencySample `protobuf:"bytes,5,rep,name=latencySamples,proto3" json:"latencySamples,omitempty"`
 }
 
-func (m *SubTaskStatus) Reset()         { *m = SubTaskStatus{} }
-func (m *SubTaskStatus) String() string { return proto.CompactTextString(m) }
-func (*SubTaskStatus) ProtoMessage()    {}
-func (*SubTaskStatus) Descriptor() ([]byte, []int) {
+func (m *SourceStatus) Reset()         { *m = SourceStatus{} }
+func (m *SourceStatus) String() string { return proto.CompactTextString(m) }
+func (*SourceStatus) ProtoMessage()    {}
+func (*SourceStatus) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{10}
 }
-func (m *SubTaskStatus) XXX_Unmarshal(b []byte) error {
+func (m *SourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SubTaskStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SourceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SubTaskStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SourceStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1109,151 +1229,160 @@ func (m *SubTaskStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *SubTaskStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubTaskStatus.Merge(m, src)
+func (m *SourceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceStatus.Merge(m, src)
 }
-func (m *SubTaskStatus) XXX_Size() int {
+func (m *SourceStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *SubTaskStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubTaskStatus.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SubTaskStatus proto.InternalMessageInfo
-
-type isSubTaskStatus_Status interface {
-	isSubTaskStatus_Status()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type SubTaskStatus_Msg struct {
-	Msg string `protobuf:"bytes,6,opt,name=msg,proto3,oneof" json:"msg,omitempty"`
-}
-type SubTaskStatus_Check struct {
-	Check *CheckStatus `protobuf:"bytes,7,opt,name=check,proto3,oneof" json:"check,omitempty"`
-}
-type SubTaskStatus_Dump struct {
-	Dump *DumpStatus `protobuf:"bytes,8,opt,name=dump,proto3,oneof" json:"dump,omitempty"`
-}
-type SubTaskStatus_Load struct {
-	Load *LoadStatus `protobuf:"bytes,9,opt,name=load,proto3,oneof" json:"load,omitempty"`
-}
-type SubTaskStatus_Sync struct {
-	Sync *SyncStatus `protobuf:"bytes,10,opt,name=sync,proto3,oneof" json:"sync,omitempty"`
+func (m *SourceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceStatus.DiscardUnknown(m)
 }
 
-func (*SubTaskStatus_Msg) isSubTaskStatus_Status()   {}
-func (*SubTaskStatus_Check) isSubTaskStatus_Status() {}
-func (*SubTaskStatus_Dump) isSubTaskStatus_Status()  {}
-func (*SubTaskStatus_Load) isSubTaskStatus_Status()  {}
-func (*SubTaskStatus_Sync) isSubTaskStatus_Status()  {}
+var xxx_messageInfo_SourceStatus proto.InternalMessageInfo
 
-func (m *SubTaskStatus) GetStatus() isSubTaskStatus_Status {
+func (m *SourceStatus) GetSource() string {
 	if m != nil {
-		return m.Status
+		return m.Source
 	}
-	return nil
+	return ""
 }
 
-func (m *SubTaskStatus) GetName() string {
+func (m *SourceStatus) GetWorker() string {
 	if m != nil {
-		return m.Name
+		return m.Worker
 	}
 	return ""
 }
 
-func (m *SubTaskStatus) GetStage() Stage {
+func (m *SourceStatus) GetResult() *ProcessResult {
 	if m != nil {
-		return m.Stage
+		return m.Result
 	}
-	return Stage_InvalidStage
+	return nil
 }
 
-func (m *SubTaskStatus) GetUnit() UnitType {
+func (m *SourceStatus) GetRelayStatus() *RelayStatus {
 	if m != nil {
-		return m.Unit
+		return m.RelayStatus
 	}
-	return UnitType_InvalidUnit
+	return nil
 }
 
-func (m *SubTaskStatus) GetResult() *ProcessResult {
+func (m *SourceStatus) GetLatencySamples() []*SourceLatencySample {
 	if m != nil {
-		return m.Result
+		return m.LatencySamples
 	}
 	return nil
 }
 
-func (m *SubTaskStatus) GetUnresolvedDDLLockID() string {
-	if m != nil {
-		return m.UnresolvedDDLLockID
-	}
-	return ""
+// SourceLatencySample represents upstream's position and the positions replicated by relay and subtasks
+// recorded at the same time by the latency probe of dm-worker.
+// lagBytes is the size of binlog between the replicated position and upstream's position, -1 if unknown.
+type SourceLatencySample struct {
+	Time             string               `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	MasterBinlog     string               `protobuf:"bytes,2,opt,name=masterBinlog,proto3" json:"masterBinlog,omitempty"`
+	MasterBinlogGtid string               `protobuf:"bytes,3,opt,name=masterBinlogGtid,proto3" json:"masterBinlogGtid,omitempty"`
+	RelayBinlog      string               `protobuf:"bytes,4,opt,name=relayBinlog,proto3" json:"relayBinlog,omitempty"`
+	RelayLagBytes    int64                `protobuf:"varint,5,opt,name=relayLagBytes,proto3" json:"relayLagBytes,omitempty"`
+	Tasks            []*TaskLatencySample `protobuf:"bytes,6,rep,name=tasks,proto3" json:"tasks,omitempty"`
 }
 
-func (m *SubTaskStatus) GetMsg() string {
-	if x, ok := m.GetStatus().(*SubTaskStatus_Msg); ok {
-		return x.Msg
-	}
+func (m *SourceLatencySample) Reset()         { *m = SourceLatencySample{} }
+func (m *SourceLatencySample) String() string { return proto.CompactTextString(m) }
+func (*SourceLatencySample) ProtoMessage()    {}
+func (*SourceLatencySample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{11}
+}
+func (m *SourceLatencySample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceLatencySample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceLatencySample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceLatencySample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceLatencySample.Merge(m, src)
+}
+func (m *SourceLatencySample) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceLatencySample) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceLatencySample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceLatencySample proto.InternalMessageInfo
+
+func (m *SourceLatencySample) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
 	return ""
 }
 
-func (m *SubTaskStatus) GetCheck() *CheckStatus {
-	if x, ok := m.GetStatus().(*SubTaskStatus_Check); ok {
-		return x.Check
+func (m *SourceLatencySample) GetMasterBinlog() string {
+	if m != nil {
+		return m.MasterBinlog
 	}
-	return nil
+	return ""
 }
 
-func (m *SubTaskStatus) GetDump() *DumpStatus {
-	if x, ok := m.GetStatus().(*SubTaskStatus_Dump); ok {
-		return x.Dump
+func (m *SourceLatencySample) GetMasterBinlogGtid() string {
+	if m != nil {
+		return m.MasterBinlogGtid
 	}
-	return nil
+	return ""
 }
 
-func (m *SubTaskStatus) GetLoad() *LoadStatus {
-	if x, ok := m.GetStatus().(*SubTaskStatus_Load); ok {
-		return x.Load
+func (m *SourceLatencySample) GetRelayBinlog() string {
+	if m != nil {
+		return m.RelayBinlog
 	}
-	return nil
+	return ""
 }
 
-func (m *SubTaskStatus) GetSync() *SyncStatus {
-	if x, ok := m.GetStatus().(*SubTaskStatus_Sync); ok {
-		return x.Sync
+func (m *SourceLatencySample) GetRelayLagBytes() int64 {
+	if m != nil {
+		return m.RelayLagBytes
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*SubTaskStatus) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*SubTaskStatus_Msg)(nil),
-		(*SubTaskStatus_Check)(nil),
-		(*SubTaskStatus_Dump)(nil),
-		(*SubTaskStatus_Load)(nil),
-		(*SubTaskStatus_Sync)(nil),
+func (m *SourceLatencySample) GetTasks() []*TaskLatencySample {
+	if m != nil {
+		return m.Tasks
 	}
+	return nil
 }
 
-// SubTaskStatusList used for internal jsonpb marshal
-type SubTaskStatusList struct {
-	Status []*SubTaskStatus `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+// TaskLatencySample represents the position replicated by the sync unit of a subtask in SourceLatencySample.
+type TaskLatencySample struct {
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SyncerBinlog     string `protobuf:"bytes,2,opt,name=syncerBinlog,proto3" json:"syncerBinlog,omitempty"`
+	SyncerBinlogGtid string `protobuf:"bytes,3,opt,name=syncerBinlogGtid,proto3" json:"syncerBinlogGtid,omitempty"`
+	LagBytes         int64  `protobuf:"varint,4,opt,name=lagBytes,proto3" json:"lagBytes,omitempty"`
 }
 
-func (m *SubTaskStatusList) Reset()         { *m = SubTaskStatusList{} }
-func (m *SubTaskStatusList) String() string { return proto.CompactTextString(m) }
-func (*SubTaskStatusList) ProtoMessage()    {}
-func (*SubTaskStatusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{11}
+func (m *TaskLatencySample) Reset()         { *m = TaskLatencySample{} }
+func (m *TaskLatencySample) String() string { return proto.CompactTextString(m) }
+func (*TaskLatencySample) ProtoMessage()    {}
+func (*TaskLatencySample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{12}
 }
-func (m *SubTaskStatusList) XXX_Unmarshal(b []byte) error {
+func (m *TaskLatencySample) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SubTaskStatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TaskLatencySample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SubTaskStatusList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TaskLatencySample.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1263,43 +1392,75 @@ func (m *SubTaskStatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *SubTaskStatusList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubTaskStatusList.Merge(m, src)
+func (m *TaskLatencySample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskLatencySample.Merge(m, src)
 }
-func (m *SubTaskStatusList) XXX_Size() int {
+func (m *TaskLatencySample) XXX_Size() int {
 	return m.Size()
 }
-func (m *SubTaskStatusList) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubTaskStatusList.DiscardUnknown(m)
+func (m *TaskLatencySample) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskLatencySample.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubTaskStatusList proto.InternalMessageInfo
+var xxx_messageInfo_TaskLatencySample proto.InternalMessageInfo
 
-func (m *SubTaskStatusList) GetStatus() []*SubTaskStatus {
+func (m *TaskLatencySample) GetName() string {
 	if m != nil {
-		return m.Status
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-// CheckError represents error for check unit
-// adds fields later
-type CheckError struct {
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+func (m *TaskLatencySample) GetSyncerBinlog() string {
+	if m != nil {
+		return m.SyncerBinlog
+	}
+	return ""
 }
 
-func (m *CheckError) Reset()         { *m = CheckError{} }
-func (m *CheckError) String() string { return proto.CompactTextString(m) }
-func (*CheckError) ProtoMessage()    {}
-func (*CheckError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{12}
+func (m *TaskLatencySample) GetSyncerBinlogGtid() string {
+	if m != nil {
+		return m.SyncerBinlogGtid
+	}
+	return ""
 }
-func (m *CheckError) XXX_Unmarshal(b []byte) error {
+
+func (m *TaskLatencySample) GetLagBytes() int64 {
+	if m != nil {
+		return m.LagBytes
+	}
+	return 0
+}
+
+// RelayStatus represents status for relay unit.
+type RelayStatus struct {
+	MasterBinlog       string            `protobuf:"bytes,1,opt,name=masterBinlog,proto3" json:"masterBinlog,omitempty"`
+	MasterBinlogGtid   string            `protobuf:"bytes,2,opt,name=masterBinlogGtid,proto3" json:"masterBinlogGtid,omitempty"`
+	RelaySubDir        string            `protobuf:"bytes,3,opt,name=relaySubDir,proto3" json:"relaySubDir,omitempty"`
+	RelayBinlog        string            `protobuf:"bytes,4,opt,name=relayBinlog,proto3" json:"relayBinlog,omitempty"`
+	RelayBinlogGtid    string            `protobuf:"bytes,5,opt,name=relayBinlogGtid,proto3" json:"relayBinlogGtid,omitempty"`
+	RelayCatchUpMaster bool              `protobuf:"varint,6,opt,name=relayCatchUpMaster,proto3" json:"relayCatchUpMaster,omitempty"`
+	Stage              Stage             `protobuf:"varint,7,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
+	Result             *ProcessResult    `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	SpaceStatus        *RelaySpaceStatus `protobuf:"bytes,9,opt,name=spaceStatus,proto3" json:"spaceStatus,omitempty"`
+	LastEventTime      string            `protobuf:"bytes,10,opt,name=lastEventTime,proto3" json:"lastEventTime,omitempty"`
+	IdleDuration       int64             `protobuf:"varint,11,opt,name=idleDuration,proto3" json:"idleDuration,omitempty"`
+	Growth             *RelayGrowth      `protobuf:"bytes,12,opt,name=growth,proto3" json:"growth,omitempty"`
+	DiskHealth         *RelayDiskHealth  `protobuf:"bytes,13,opt,name=diskHealth,proto3" json:"diskHealth,omitempty"`
+}
+
+func (m *RelayStatus) Reset()         { *m = RelayStatus{} }
+func (m *RelayStatus) String() string { return proto.CompactTextString(m) }
+func (*RelayStatus) ProtoMessage()    {}
+func (*RelayStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{13}
+}
+func (m *RelayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CheckError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RelayStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CheckError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RelayStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1309,88 +1470,134 @@ func (m *CheckError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *CheckError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckError.Merge(m, src)
+func (m *RelayStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayStatus.Merge(m, src)
 }
-func (m *CheckError) XXX_Size() int {
+func (m *RelayStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *CheckError) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckError.DiscardUnknown(m)
+func (m *RelayStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CheckError proto.InternalMessageInfo
+var xxx_messageInfo_RelayStatus proto.InternalMessageInfo
 
-func (m *CheckError) GetMsg() string {
+func (m *RelayStatus) GetMasterBinlog() string {
 	if m != nil {
-		return m.Msg
+		return m.MasterBinlog
 	}
 	return ""
 }
 
-// DumpError represents error for dump unit
-// add fields later
-type DumpError struct {
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+func (m *RelayStatus) GetMasterBinlogGtid() string {
+	if m != nil {
+		return m.MasterBinlogGtid
+	}
+	return ""
 }
 
-func (m *DumpError) Reset()         { *m = DumpError{} }
-func (m *DumpError) String() string { return proto.CompactTextString(m) }
-func (*DumpError) ProtoMessage()    {}
-func (*DumpError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{13}
+func (m *RelayStatus) GetRelaySubDir() string {
+	if m != nil {
+		return m.RelaySubDir
+	}
+	return ""
 }
-func (m *DumpError) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *RelayStatus) GetRelayBinlog() string {
+	if m != nil {
+		return m.RelayBinlog
+	}
+	return ""
 }
-func (m *DumpError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_DumpError.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *RelayStatus) GetRelayBinlogGtid() string {
+	if m != nil {
+		return m.RelayBinlogGtid
 	}
+	return ""
 }
-func (m *DumpError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DumpError.Merge(m, src)
+
+func (m *RelayStatus) GetRelayCatchUpMaster() bool {
+	if m != nil {
+		return m.RelayCatchUpMaster
+	}
+	return false
 }
-func (m *DumpError) XXX_Size() int {
-	return m.Size()
+
+func (m *RelayStatus) GetStage() Stage {
+	if m != nil {
+		return m.Stage
+	}
+	return Stage_InvalidStage
 }
-func (m *DumpError) XXX_DiscardUnknown() {
-	xxx_messageInfo_DumpError.DiscardUnknown(m)
+
+func (m *RelayStatus) GetResult() *ProcessResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
 }
 
-var xxx_messageInfo_DumpError proto.InternalMessageInfo
+func (m *RelayStatus) GetSpaceStatus() *RelaySpaceStatus {
+	if m != nil {
+		return m.SpaceStatus
+	}
+	return nil
+}
 
-func (m *DumpError) GetMsg() string {
+func (m *RelayStatus) GetLastEventTime() string {
 	if m != nil {
-		return m.Msg
+		return m.LastEventTime
 	}
 	return ""
 }
 
-// LoadError represents error for load unit
-type LoadError struct {
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+func (m *RelayStatus) GetIdleDuration() int64 {
+	if m != nil {
+		return m.IdleDuration
+	}
+	return 0
 }
 
-func (m *LoadError) Reset()         { *m = LoadError{} }
-func (m *LoadError) String() string { return proto.CompactTextString(m) }
-func (*LoadError) ProtoMessage()    {}
-func (*LoadError) Descriptor() ([]byte, []int) {
+func (m *RelayStatus) GetGrowth() *RelayGrowth {
+	if m != nil {
+		return m.Growth
+	}
+	return nil
+}
+
+func (m *RelayStatus) GetDiskHealth() *RelayDiskHealth {
+	if m != nil {
+		return m.DiskHealth
+	}
+	return nil
+}
+
+// RelayDiskHealth represents the health of the disk of relay dir checked in the background.
+// reason: why the disk is unhealthy, empty if it's healthy
+// readOnly: whether the probe file can't be written because the disk is read-only
+type RelayDiskHealth struct {
+	Healthy           bool   `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Reason            string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastCheckTime     string `protobuf:"bytes,3,opt,name=lastCheckTime,proto3" json:"lastCheckTime,omitempty"`
+	UsagePercent      int64  `protobuf:"varint,4,opt,name=usagePercent,proto3" json:"usagePercent,omitempty"`
+	InodeUsagePercent int64  `protobuf:"varint,5,opt,name=inodeUsagePercent,proto3" json:"inodeUsagePercent,omitempty"`
+	WriteLatencyMs    int64  `protobuf:"varint,6,opt,name=writeLatencyMs,proto3" json:"writeLatencyMs,omitempty"`
+	ReadOnly          bool   `protobuf:"varint,7,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+}
+
+func (m *RelayDiskHealth) Reset()         { *m = RelayDiskHealth{} }
+func (m *RelayDiskHealth) String() string { return proto.CompactTextString(m) }
+func (*RelayDiskHealth) ProtoMessage()    {}
+func (*RelayDiskHealth) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{14}
 }
-func (m *LoadError) XXX_Unmarshal(b []byte) error {
+func (m *RelayDiskHealth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LoadError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RelayDiskHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LoadError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RelayDiskHealth.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1400,151 +1607,89 @@ func (m *LoadError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *LoadError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadError.Merge(m, src)
+func (m *RelayDiskHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayDiskHealth.Merge(m, src)
 }
-func (m *LoadError) XXX_Size() int {
+func (m *RelayDiskHealth) XXX_Size() int {
 	return m.Size()
 }
-func (m *LoadError) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadError.DiscardUnknown(m)
+func (m *RelayDiskHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayDiskHealth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadError proto.InternalMessageInfo
+var xxx_messageInfo_RelayDiskHealth proto.InternalMessageInfo
 
-func (m *LoadError) GetMsg() string {
+func (m *RelayDiskHealth) GetHealthy() bool {
 	if m != nil {
-		return m.Msg
+		return m.Healthy
 	}
-	return ""
+	return false
 }
 
-// SyncSQLError represents a sql error in sync unit
-type SyncSQLError struct {
-	Msg                  string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	FailedBinlogPosition string `protobuf:"bytes,2,opt,name=failedBinlogPosition,proto3" json:"failedBinlogPosition,omitempty"`
-	ErrorSQL             string `protobuf:"bytes,3,opt,name=errorSQL,proto3" json:"errorSQL,omitempty"`
+func (m *RelayDiskHealth) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *SyncSQLError) Reset()         { *m = SyncSQLError{} }
-func (m *SyncSQLError) String() string { return proto.CompactTextString(m) }
-func (*SyncSQLError) ProtoMessage()    {}
-func (*SyncSQLError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{15}
-}
-func (m *SyncSQLError) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SyncSQLError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SyncSQLError.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *SyncSQLError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncSQLError.Merge(m, src)
-}
-func (m *SyncSQLError) XXX_Size() int {
-	return m.Size()
-}
-func (m *SyncSQLError) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncSQLError.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SyncSQLError proto.InternalMessageInfo
-
-func (m *SyncSQLError) GetMsg() string {
+func (m *RelayDiskHealth) GetLastCheckTime() string {
 	if m != nil {
-		return m.Msg
+		return m.LastCheckTime
 	}
 	return ""
 }
 
-func (m *SyncSQLError) GetFailedBinlogPosition() string {
+func (m *RelayDiskHealth) GetUsagePercent() int64 {
 	if m != nil {
-		return m.FailedBinlogPosition
+		return m.UsagePercent
 	}
-	return ""
+	return 0
 }
 
-func (m *SyncSQLError) GetErrorSQL() string {
+func (m *RelayDiskHealth) GetInodeUsagePercent() int64 {
 	if m != nil {
-		return m.ErrorSQL
+		return m.InodeUsagePercent
 	}
-	return ""
-}
-
-// SyncError represents error list for sync unit
-type SyncError struct {
-	Errors []*SyncSQLError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	return 0
 }
 
-func (m *SyncError) Reset()         { *m = SyncError{} }
-func (m *SyncError) String() string { return proto.CompactTextString(m) }
-func (*SyncError) ProtoMessage()    {}
-func (*SyncError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{16}
-}
-func (m *SyncError) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SyncError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SyncError.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *RelayDiskHealth) GetWriteLatencyMs() int64 {
+	if m != nil {
+		return m.WriteLatencyMs
 	}
-}
-func (m *SyncError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncError.Merge(m, src)
-}
-func (m *SyncError) XXX_Size() int {
-	return m.Size()
-}
-func (m *SyncError) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncError.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_SyncError proto.InternalMessageInfo
-
-func (m *SyncError) GetErrors() []*SyncSQLError {
+func (m *RelayDiskHealth) GetReadOnly() bool {
 	if m != nil {
-		return m.Errors
+		return m.ReadOnly
 	}
-	return nil
+	return false
 }
 
-// SourceError represents error for start/stop source on dm-worker
-type SourceError struct {
-	Source      string      `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Worker      string      `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
-	SourceError string      `protobuf:"bytes,3,opt,name=SourceError,proto3" json:"SourceError,omitempty"`
-	RelayError  *RelayError `protobuf:"bytes,4,opt,name=RelayError,proto3" json:"RelayError,omitempty"`
+// RelayGrowth represents the estimated growth of relay log files on disk.
+// hoursUntilFull and hoursUntilSpacePurge are -1 if they can't be estimated,
+// or the relay log is kept in check by the purge policy.
+type RelayGrowth struct {
+	WriteRateShort       float64 `protobuf:"fixed64,1,opt,name=writeRateShort,proto3" json:"writeRateShort,omitempty"`
+	WriteRateLong        float64 `protobuf:"fixed64,2,opt,name=writeRateLong,proto3" json:"writeRateLong,omitempty"`
+	HoursUntilFull       float64 `protobuf:"fixed64,3,opt,name=hoursUntilFull,proto3" json:"hoursUntilFull,omitempty"`
+	HoursUntilSpacePurge float64 `protobuf:"fixed64,4,opt,name=hoursUntilSpacePurge,proto3" json:"hoursUntilSpacePurge,omitempty"`
 }
 
-func (m *SourceError) Reset()         { *m = SourceError{} }
-func (m *SourceError) String() string { return proto.CompactTextString(m) }
-func (*SourceError) ProtoMessage()    {}
-func (*SourceError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{17}
+func (m *RelayGrowth) Reset()         { *m = RelayGrowth{} }
+func (m *RelayGrowth) String() string { return proto.CompactTextString(m) }
+func (*RelayGrowth) ProtoMessage()    {}
+func (*RelayGrowth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{15}
 }
-func (m *SourceError) XXX_Unmarshal(b []byte) error {
+func (m *RelayGrowth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SourceError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RelayGrowth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SourceError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RelayGrowth.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1554,63 +1699,68 @@ func (m *SourceError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SourceError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SourceError.Merge(m, src)
+func (m *RelayGrowth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayGrowth.Merge(m, src)
 }
-func (m *SourceError) XXX_Size() int {
+func (m *RelayGrowth) XXX_Size() int {
 	return m.Size()
 }
-func (m *SourceError) XXX_DiscardUnknown() {
-	xxx_messageInfo_SourceError.DiscardUnknown(m)
+func (m *RelayGrowth) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayGrowth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SourceError proto.InternalMessageInfo
+var xxx_messageInfo_RelayGrowth proto.InternalMessageInfo
 
-func (m *SourceError) GetSource() string {
+func (m *RelayGrowth) GetWriteRateShort() float64 {
 	if m != nil {
-		return m.Source
+		return m.WriteRateShort
 	}
-	return ""
+	return 0
 }
 
-func (m *SourceError) GetWorker() string {
+func (m *RelayGrowth) GetWriteRateLong() float64 {
 	if m != nil {
-		return m.Worker
+		return m.WriteRateLong
 	}
-	return ""
+	return 0
 }
 
-func (m *SourceError) GetSourceError() string {
+func (m *RelayGrowth) GetHoursUntilFull() float64 {
 	if m != nil {
-		return m.SourceError
+		return m.HoursUntilFull
 	}
-	return ""
+	return 0
 }
 
-func (m *SourceError) GetRelayError() *RelayError {
+func (m *RelayGrowth) GetHoursUntilSpacePurge() float64 {
 	if m != nil {
-		return m.RelayError
+		return m.HoursUntilSpacePurge
 	}
-	return nil
+	return 0
 }
 
-// RelayError represents error for relay unit.
-type RelayError struct {
-	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+// RelaySpaceStatus represents the disk space of relay log directory
+// and the last decision of purging relay log files by space.
+type RelaySpaceStatus struct {
+	Capacity      uint64 `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Available     uint64 `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	UsagePercent  int64  `protobuf:"varint,3,opt,name=usagePercent,proto3" json:"usagePercent,omitempty"`
+	LastCheckTime string `protobuf:"bytes,4,opt,name=lastCheckTime,proto3" json:"lastCheckTime,omitempty"`
+	LastDecision  string `protobuf:"bytes,5,opt,name=lastDecision,proto3" json:"lastDecision,omitempty"`
 }
 
-func (m *RelayError) Reset()         { *m = RelayError{} }
-func (m *RelayError) String() string { return proto.CompactTextString(m) }
-func (*RelayError) ProtoMessage()    {}
-func (*RelayError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{18}
+func (m *RelaySpaceStatus) Reset()         { *m = RelaySpaceStatus{} }
+func (m *RelaySpaceStatus) String() string { return proto.CompactTextString(m) }
+func (*RelaySpaceStatus) ProtoMessage()    {}
+func (*RelaySpaceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{16}
 }
-func (m *RelayError) XXX_Unmarshal(b []byte) error {
+func (m *RelaySpaceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RelayError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RelaySpaceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RelayError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RelaySpaceStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1620,56 +1770,91 @@ func (m *RelayError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RelayError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RelayError.Merge(m, src)
+func (m *RelaySpaceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelaySpaceStatus.Merge(m, src)
 }
-func (m *RelayError) XXX_Size() int {
+func (m *RelaySpaceStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *RelayError) XXX_DiscardUnknown() {
-	xxx_messageInfo_RelayError.DiscardUnknown(m)
+func (m *RelaySpaceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelaySpaceStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RelayError proto.InternalMessageInfo
+var xxx_messageInfo_RelaySpaceStatus proto.InternalMessageInfo
 
-func (m *RelayError) GetMsg() string {
+func (m *RelaySpaceStatus) GetCapacity() uint64 {
 	if m != nil {
-		return m.Msg
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *RelaySpaceStatus) GetAvailable() uint64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+func (m *RelaySpaceStatus) GetUsagePercent() int64 {
+	if m != nil {
+		return m.UsagePercent
+	}
+	return 0
+}
+
+func (m *RelaySpaceStatus) GetLastCheckTime() string {
+	if m != nil {
+		return m.LastCheckTime
 	}
 	return ""
 }
 
-// SubTaskError represents error for a sub task during running
+func (m *RelaySpaceStatus) GetLastDecision() string {
+	if m != nil {
+		return m.LastDecision
+	}
+	return ""
+}
+
+// SubTaskStatus represents status for a sub task
 // name: sub task'name, when starting a sub task the name should be unique
 // stage: sub task's current stage
 // unit: sub task's current dm unit's UnitType
-// error: current unit's error information
-//         for Sync, includes failed sql, failed sql pos in binlog, etc.
-type SubTaskError struct {
-	Name  string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Stage Stage    `protobuf:"varint,2,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
-	Unit  UnitType `protobuf:"varint,3,opt,name=unit,proto3,enum=pb.UnitType" json:"unit,omitempty"`
-	// Types that are valid to be assigned to Error:
-	//	*SubTaskError_Msg
-	//	*SubTaskError_Check
-	//	*SubTaskError_Dump
-	//	*SubTaskError_Load
-	//	*SubTaskError_Sync
-	Error isSubTaskError_Error `protobuf_oneof:"error"`
+// result: current unit's process result, when the stage is Running, no result
+// unresolvedDDLLockID: un-resolved sharding DDL lock ID (ref DDLLockInfo)
+//                      if needed, we can put this to SyncStatus
+// status: current unit's statistics
+//         for Load, includes total bytes, progress, etc.
+//         for Sync, includes TPS, binlog meta, etc.
+type SubTaskStatus struct {
+	Name                string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Stage               Stage          `protobuf:"varint,2,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
+	Unit                UnitType       `protobuf:"varint,3,opt,name=unit,proto3,enum=pb.UnitType" json:"unit,omitempty"`
+	Result              *ProcessResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	UnresolvedDDLLockID string         `protobuf:"bytes,5,opt,name=unresolvedDDLLockID,proto3" json:"unresolvedDDLLockID,omitempty"`
+	// Types that are valid to be assigned to Status:
+	//	*SubTaskStatus_Msg
+	//	*SubTaskStatus_Check
+	//	*SubTaskStatus_Dump
+	//	*SubTaskStatus_Load
+	//	*SubTaskStatus_Sync
+	Status    isSubTaskStatus_Status `protobuf_oneof:"status"`
+	CrashLoop bool                   `protobuf:"varint,11,opt,name=crashLoop,proto3" json:"crashLoop,omitempty"`
 }
 
-func (m *SubTaskError) Reset()         { *m = SubTaskError{} }
-func (m *SubTaskError) String() string { return proto.CompactTextString(m) }
-func (*SubTaskError) ProtoMessage()    {}
-func (*SubTaskError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{19}
+func (m *SubTaskStatus) Reset()         { *m = SubTaskStatus{} }
+func (m *SubTaskStatus) String() string { return proto.CompactTextString(m) }
+func (*SubTaskStatus) ProtoMessage()    {}
+func (*SubTaskStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{17}
 }
-func (m *SubTaskError) XXX_Unmarshal(b []byte) error {
+func (m *SubTaskStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SubTaskError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubTaskStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SubTaskError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubTaskStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1679,137 +1864,158 @@ func (m *SubTaskError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SubTaskError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubTaskError.Merge(m, src)
+func (m *SubTaskStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubTaskStatus.Merge(m, src)
 }
-func (m *SubTaskError) XXX_Size() int {
+func (m *SubTaskStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *SubTaskError) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubTaskError.DiscardUnknown(m)
+func (m *SubTaskStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubTaskStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubTaskError proto.InternalMessageInfo
+var xxx_messageInfo_SubTaskStatus proto.InternalMessageInfo
 
-type isSubTaskError_Error interface {
-	isSubTaskError_Error()
+type isSubTaskStatus_Status interface {
+	isSubTaskStatus_Status()
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type SubTaskError_Msg struct {
-	Msg string `protobuf:"bytes,4,opt,name=msg,proto3,oneof" json:"msg,omitempty"`
+type SubTaskStatus_Msg struct {
+	Msg string `protobuf:"bytes,6,opt,name=msg,proto3,oneof" json:"msg,omitempty"`
 }
-type SubTaskError_Check struct {
-	Check *CheckError `protobuf:"bytes,5,opt,name=check,proto3,oneof" json:"check,omitempty"`
+type SubTaskStatus_Check struct {
+	Check *CheckStatus `protobuf:"bytes,7,opt,name=check,proto3,oneof" json:"check,omitempty"`
 }
-type SubTaskError_Dump struct {
-	Dump *DumpError `protobuf:"bytes,6,opt,name=dump,proto3,oneof" json:"dump,omitempty"`
+type SubTaskStatus_Dump struct {
+	Dump *DumpStatus `protobuf:"bytes,8,opt,name=dump,proto3,oneof" json:"dump,omitempty"`
 }
-type SubTaskError_Load struct {
-	Load *LoadError `protobuf:"bytes,7,opt,name=load,proto3,oneof" json:"load,omitempty"`
+type SubTaskStatus_Load struct {
+	Load *LoadStatus `protobuf:"bytes,9,opt,name=load,proto3,oneof" json:"load,omitempty"`
 }
-type SubTaskError_Sync struct {
-	Sync *SyncError `protobuf:"bytes,8,opt,name=sync,proto3,oneof" json:"sync,omitempty"`
+type SubTaskStatus_Sync struct {
+	Sync *SyncStatus `protobuf:"bytes,10,opt,name=sync,proto3,oneof" json:"sync,omitempty"`
 }
 
-func (*SubTaskError_Msg) isSubTaskError_Error()   {}
-func (*SubTaskError_Check) isSubTaskError_Error() {}
-func (*SubTaskError_Dump) isSubTaskError_Error()  {}
-func (*SubTaskError_Load) isSubTaskError_Error()  {}
-func (*SubTaskError_Sync) isSubTaskError_Error()  {}
+func (*SubTaskStatus_Msg) isSubTaskStatus_Status()   {}
+func (*SubTaskStatus_Check) isSubTaskStatus_Status() {}
+func (*SubTaskStatus_Dump) isSubTaskStatus_Status()  {}
+func (*SubTaskStatus_Load) isSubTaskStatus_Status()  {}
+func (*SubTaskStatus_Sync) isSubTaskStatus_Status()  {}
 
-func (m *SubTaskError) GetError() isSubTaskError_Error {
+func (m *SubTaskStatus) GetStatus() isSubTaskStatus_Status {
 	if m != nil {
-		return m.Error
+		return m.Status
 	}
 	return nil
 }
 
-func (m *SubTaskError) GetName() string {
+func (m *SubTaskStatus) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *SubTaskError) GetStage() Stage {
+func (m *SubTaskStatus) GetStage() Stage {
 	if m != nil {
 		return m.Stage
 	}
 	return Stage_InvalidStage
 }
 
-func (m *SubTaskError) GetUnit() UnitType {
+func (m *SubTaskStatus) GetUnit() UnitType {
 	if m != nil {
 		return m.Unit
 	}
 	return UnitType_InvalidUnit
 }
 
-func (m *SubTaskError) GetMsg() string {
-	if x, ok := m.GetError().(*SubTaskError_Msg); ok {
+func (m *SubTaskStatus) GetResult() *ProcessResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SubTaskStatus) GetUnresolvedDDLLockID() string {
+	if m != nil {
+		return m.UnresolvedDDLLockID
+	}
+	return ""
+}
+
+func (m *SubTaskStatus) GetMsg() string {
+	if x, ok := m.GetStatus().(*SubTaskStatus_Msg); ok {
 		return x.Msg
 	}
 	return ""
 }
 
-func (m *SubTaskError) GetCheck() *CheckError {
-	if x, ok := m.GetError().(*SubTaskError_Check); ok {
+func (m *SubTaskStatus) GetCheck() *CheckStatus {
+	if x, ok := m.GetStatus().(*SubTaskStatus_Check); ok {
 		return x.Check
 	}
 	return nil
 }
 
-func (m *SubTaskError) GetDump() *DumpError {
-	if x, ok := m.GetError().(*SubTaskError_Dump); ok {
+func (m *SubTaskStatus) GetDump() *DumpStatus {
+	if x, ok := m.GetStatus().(*SubTaskStatus_Dump); ok {
 		return x.Dump
 	}
 	return nil
 }
 
-func (m *SubTaskError) GetLoad() *LoadError {
-	if x, ok := m.GetError().(*SubTaskError_Load); ok {
+func (m *SubTaskStatus) GetLoad() *LoadStatus {
+	if x, ok := m.GetStatus().(*SubTaskStatus_Load); ok {
 		return x.Load
 	}
 	return nil
 }
 
-func (m *SubTaskError) GetSync() *SyncError {
-	if x, ok := m.GetError().(*SubTaskError_Sync); ok {
+func (m *SubTaskStatus) GetSync() *SyncStatus {
+	if x, ok := m.GetStatus().(*SubTaskStatus_Sync); ok {
 		return x.Sync
 	}
 	return nil
 }
 
+func (m *SubTaskStatus) GetCrashLoop() bool {
+	if m != nil {
+		return m.CrashLoop
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
-func (*SubTaskError) XXX_OneofWrappers() []interface{} {
+func (*SubTaskStatus) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
-		(*SubTaskError_Msg)(nil),
-		(*SubTaskError_Check)(nil),
-		(*SubTaskError_Dump)(nil),
-		(*SubTaskError_Load)(nil),
-		(*SubTaskError_Sync)(nil),
+		(*SubTaskStatus_Msg)(nil),
+		(*SubTaskStatus_Check)(nil),
+		(*SubTaskStatus_Dump)(nil),
+		(*SubTaskStatus_Load)(nil),
+		(*SubTaskStatus_Sync)(nil),
 	}
 }
 
-// SubTaskErrorList used for internal jsonpb marshal
-type SubTaskErrorList struct {
-	Error []*SubTaskError `protobuf:"bytes,1,rep,name=error,proto3" json:"error,omitempty"`
+// SubTaskStatusList used for internal jsonpb marshal
+type SubTaskStatusList struct {
+	Status []*SubTaskStatus `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
 }
 
-func (m *SubTaskErrorList) Reset()         { *m = SubTaskErrorList{} }
-func (m *SubTaskErrorList) String() string { return proto.CompactTextString(m) }
-func (*SubTaskErrorList) ProtoMessage()    {}
-func (*SubTaskErrorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{20}
+func (m *SubTaskStatusList) Reset()         { *m = SubTaskStatusList{} }
+func (m *SubTaskStatusList) String() string { return proto.CompactTextString(m) }
+func (*SubTaskStatusList) ProtoMessage()    {}
+func (*SubTaskStatusList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{18}
 }
-func (m *SubTaskErrorList) XXX_Unmarshal(b []byte) error {
+func (m *SubTaskStatusList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SubTaskErrorList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubTaskStatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SubTaskErrorList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubTaskStatusList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1819,47 +2025,43 @@ func (m *SubTaskErrorList) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *SubTaskErrorList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubTaskErrorList.Merge(m, src)
+func (m *SubTaskStatusList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubTaskStatusList.Merge(m, src)
 }
-func (m *SubTaskErrorList) XXX_Size() int {
+func (m *SubTaskStatusList) XXX_Size() int {
 	return m.Size()
 }
-func (m *SubTaskErrorList) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubTaskErrorList.DiscardUnknown(m)
+func (m *SubTaskStatusList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubTaskStatusList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubTaskErrorList proto.InternalMessageInfo
+var xxx_messageInfo_SubTaskStatusList proto.InternalMessageInfo
 
-func (m *SubTaskErrorList) GetError() []*SubTaskError {
+func (m *SubTaskStatusList) GetStatus() []*SubTaskStatus {
 	if m != nil {
-		return m.Error
+		return m.Status
 	}
 	return nil
 }
 
-// ProcessResult represents results produced by a dm unit
-// isCanceled: indicates whether the process is canceled from external
-//             when Stop or Pause is requested from external, isCanceled will be true
-// errors: includes all (potential) errors occured when processing
-type ProcessResult struct {
-	IsCanceled bool            `protobuf:"varint,1,opt,name=isCanceled,proto3" json:"isCanceled,omitempty"`
-	Errors     []*ProcessError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
-	Detail     []byte          `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+// CheckError represents error for check unit
+// adds fields later
+type CheckError struct {
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (m *ProcessResult) Reset()         { *m = ProcessResult{} }
-func (m *ProcessResult) String() string { return proto.CompactTextString(m) }
-func (*ProcessResult) ProtoMessage()    {}
-func (*ProcessResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{21}
+func (m *CheckError) Reset()         { *m = CheckError{} }
+func (m *CheckError) String() string { return proto.CompactTextString(m) }
+func (*CheckError) ProtoMessage()    {}
+func (*CheckError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{19}
 }
-func (m *ProcessResult) XXX_Unmarshal(b []byte) error {
+func (m *CheckError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProcessResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProcessResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1869,64 +2071,43 @@ func (m *ProcessResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ProcessResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProcessResult.Merge(m, src)
+func (m *CheckError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckError.Merge(m, src)
 }
-func (m *ProcessResult) XXX_Size() int {
+func (m *CheckError) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProcessResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProcessResult.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ProcessResult proto.InternalMessageInfo
-
-func (m *ProcessResult) GetIsCanceled() bool {
-	if m != nil {
-		return m.IsCanceled
-	}
-	return false
+func (m *CheckError) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckError.DiscardUnknown(m)
 }
 
-func (m *ProcessResult) GetErrors() []*ProcessError {
-	if m != nil {
-		return m.Errors
-	}
-	return nil
-}
+var xxx_messageInfo_CheckError proto.InternalMessageInfo
 
-func (m *ProcessResult) GetDetail() []byte {
+func (m *CheckError) GetMsg() string {
 	if m != nil {
-		return m.Detail
+		return m.Msg
 	}
-	return nil
+	return ""
 }
 
-// ProcessError is same as terror used in dm
-// NOTE: currently stack trace is not supported, `Message` is the `terror.Error.getMsg` result
-// and `RawCause` is the `Error` result of error from `terror.Error.Cause()`.
-type ProcessError struct {
-	ErrCode    int32  `protobuf:"varint,1,opt,name=ErrCode,proto3" json:"ErrCode,omitempty"`
-	ErrClass   string `protobuf:"bytes,2,opt,name=ErrClass,proto3" json:"ErrClass,omitempty"`
-	ErrScope   string `protobuf:"bytes,3,opt,name=ErrScope,proto3" json:"ErrScope,omitempty"`
-	ErrLevel   string `protobuf:"bytes,4,opt,name=ErrLevel,proto3" json:"ErrLevel,omitempty"`
-	Message    string `protobuf:"bytes,5,opt,name=Message,proto3" json:"Message,omitempty"`
-	RawCause   string `protobuf:"bytes,6,opt,name=RawCause,proto3" json:"RawCause,omitempty"`
-	Workaround string `protobuf:"bytes,7,opt,name=Workaround,proto3" json:"Workaround,omitempty"`
+// DumpError represents error for dump unit
+// add fields later
+type DumpError struct {
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (m *ProcessError) Reset()         { *m = ProcessError{} }
-func (m *ProcessError) String() string { return proto.CompactTextString(m) }
-func (*ProcessError) ProtoMessage()    {}
-func (*ProcessError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{22}
+func (m *DumpError) Reset()         { *m = DumpError{} }
+func (m *DumpError) String() string { return proto.CompactTextString(m) }
+func (*DumpError) ProtoMessage()    {}
+func (*DumpError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{20}
 }
-func (m *ProcessError) XXX_Unmarshal(b []byte) error {
+func (m *DumpError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProcessError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DumpError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProcessError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DumpError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1936,91 +2117,89 @@ func (m *ProcessError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ProcessError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProcessError.Merge(m, src)
+func (m *DumpError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DumpError.Merge(m, src)
 }
-func (m *ProcessError) XXX_Size() int {
+func (m *DumpError) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProcessError) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProcessError.DiscardUnknown(m)
+func (m *DumpError) XXX_DiscardUnknown() {
+	xxx_messageInfo_DumpError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProcessError proto.InternalMessageInfo
+var xxx_messageInfo_DumpError proto.InternalMessageInfo
 
-func (m *ProcessError) GetErrCode() int32 {
+func (m *DumpError) GetMsg() string {
 	if m != nil {
-		return m.ErrCode
+		return m.Msg
 	}
-	return 0
+	return ""
 }
 
-func (m *ProcessError) GetErrClass() string {
-	if m != nil {
-		return m.ErrClass
-	}
-	return ""
+// LoadError represents error for load unit
+type LoadError struct {
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (m *ProcessError) GetErrScope() string {
-	if m != nil {
-		return m.ErrScope
+func (m *LoadError) Reset()         { *m = LoadError{} }
+func (m *LoadError) String() string { return proto.CompactTextString(m) }
+func (*LoadError) ProtoMessage()    {}
+func (*LoadError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{21}
+}
+func (m *LoadError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoadError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LoadError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *LoadError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadError.Merge(m, src)
+}
+func (m *LoadError) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoadError) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadError.DiscardUnknown(m)
 }
 
-func (m *ProcessError) GetErrLevel() string {
+var xxx_messageInfo_LoadError proto.InternalMessageInfo
+
+func (m *LoadError) GetMsg() string {
 	if m != nil {
-		return m.ErrLevel
+		return m.Msg
 	}
 	return ""
 }
 
-func (m *ProcessError) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
-}
-
-func (m *ProcessError) GetRawCause() string {
-	if m != nil {
-		return m.RawCause
-	}
-	return ""
-}
-
-func (m *ProcessError) GetWorkaround() string {
-	if m != nil {
-		return m.Workaround
-	}
-	return ""
-}
-
-// PurgeRelayRequest represents a request to purge relay log files for this dm-worker
-// inactive: whether purge inactive relay log files
-// time: whether purge relay log files before this time, the number of seconds elapsed since January 1, 1970 UTC
-// filename: whether purge relay log files before this filename
-// subDir: specify relay sub directory for @filename
-type PurgeRelayRequest struct {
-	Inactive bool   `protobuf:"varint,1,opt,name=inactive,proto3" json:"inactive,omitempty"`
-	Time     int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
-	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
-	SubDir   string `protobuf:"bytes,4,opt,name=subDir,proto3" json:"subDir,omitempty"`
+// SyncSQLError represents a sql error in sync unit
+type SyncSQLError struct {
+	Msg                  string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	FailedBinlogPosition string `protobuf:"bytes,2,opt,name=failedBinlogPosition,proto3" json:"failedBinlogPosition,omitempty"`
+	ErrorSQL             string `protobuf:"bytes,3,opt,name=errorSQL,proto3" json:"errorSQL,omitempty"`
 }
 
-func (m *PurgeRelayRequest) Reset()         { *m = PurgeRelayRequest{} }
-func (m *PurgeRelayRequest) String() string { return proto.CompactTextString(m) }
-func (*PurgeRelayRequest) ProtoMessage()    {}
-func (*PurgeRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{23}
+func (m *SyncSQLError) Reset()         { *m = SyncSQLError{} }
+func (m *SyncSQLError) String() string { return proto.CompactTextString(m) }
+func (*SyncSQLError) ProtoMessage()    {}
+func (*SyncSQLError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{22}
 }
-func (m *PurgeRelayRequest) XXX_Unmarshal(b []byte) error {
+func (m *SyncSQLError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PurgeRelayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SyncSQLError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PurgeRelayRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SyncSQLError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2030,69 +2209,56 @@ func (m *PurgeRelayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *PurgeRelayRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PurgeRelayRequest.Merge(m, src)
+func (m *SyncSQLError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncSQLError.Merge(m, src)
 }
-func (m *PurgeRelayRequest) XXX_Size() int {
+func (m *SyncSQLError) XXX_Size() int {
 	return m.Size()
 }
-func (m *PurgeRelayRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PurgeRelayRequest.DiscardUnknown(m)
+func (m *SyncSQLError) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncSQLError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PurgeRelayRequest proto.InternalMessageInfo
-
-func (m *PurgeRelayRequest) GetInactive() bool {
-	if m != nil {
-		return m.Inactive
-	}
-	return false
-}
+var xxx_messageInfo_SyncSQLError proto.InternalMessageInfo
 
-func (m *PurgeRelayRequest) GetTime() int64 {
+func (m *SyncSQLError) GetMsg() string {
 	if m != nil {
-		return m.Time
+		return m.Msg
 	}
-	return 0
+	return ""
 }
 
-func (m *PurgeRelayRequest) GetFilename() string {
+func (m *SyncSQLError) GetFailedBinlogPosition() string {
 	if m != nil {
-		return m.Filename
+		return m.FailedBinlogPosition
 	}
 	return ""
 }
 
-func (m *PurgeRelayRequest) GetSubDir() string {
+func (m *SyncSQLError) GetErrorSQL() string {
 	if m != nil {
-		return m.SubDir
+		return m.ErrorSQL
 	}
 	return ""
 }
 
-type OperateWorkerSchemaRequest struct {
-	Op       SchemaOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.SchemaOp" json:"op,omitempty"`
-	Task     string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
-	Source   string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Database string   `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
-	Table    string   `protobuf:"bytes,5,opt,name=table,proto3" json:"table,omitempty"`
-	Schema   string   `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
-	Flush    bool     `protobuf:"varint,7,opt,name=flush,proto3" json:"flush,omitempty"`
-	Sync     bool     `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
+// SyncError represents error list for sync unit
+type SyncError struct {
+	Errors []*SyncSQLError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (m *OperateWorkerSchemaRequest) Reset()         { *m = OperateWorkerSchemaRequest{} }
-func (m *OperateWorkerSchemaRequest) String() string { return proto.CompactTextString(m) }
-func (*OperateWorkerSchemaRequest) ProtoMessage()    {}
-func (*OperateWorkerSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{24}
+func (m *SyncError) Reset()         { *m = SyncError{} }
+func (m *SyncError) String() string { return proto.CompactTextString(m) }
+func (*SyncError) ProtoMessage()    {}
+func (*SyncError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{23}
 }
-func (m *OperateWorkerSchemaRequest) XXX_Unmarshal(b []byte) error {
+func (m *SyncError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OperateWorkerSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SyncError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OperateWorkerSchemaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SyncError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2102,94 +2268,111 @@ func (m *OperateWorkerSchemaRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *OperateWorkerSchemaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperateWorkerSchemaRequest.Merge(m, src)
+func (m *SyncError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncError.Merge(m, src)
 }
-func (m *OperateWorkerSchemaRequest) XXX_Size() int {
+func (m *SyncError) XXX_Size() int {
 	return m.Size()
 }
-func (m *OperateWorkerSchemaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperateWorkerSchemaRequest.DiscardUnknown(m)
+func (m *SyncError) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OperateWorkerSchemaRequest proto.InternalMessageInfo
+var xxx_messageInfo_SyncError proto.InternalMessageInfo
 
-func (m *OperateWorkerSchemaRequest) GetOp() SchemaOp {
+func (m *SyncError) GetErrors() []*SyncSQLError {
 	if m != nil {
-		return m.Op
+		return m.Errors
 	}
-	return SchemaOp_InvalidSchemaOp
+	return nil
 }
 
-func (m *OperateWorkerSchemaRequest) GetTask() string {
-	if m != nil {
-		return m.Task
-	}
-	return ""
+// SourceError represents error for start/stop source on dm-worker
+type SourceError struct {
+	Source      string      `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Worker      string      `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
+	SourceError string      `protobuf:"bytes,3,opt,name=SourceError,proto3" json:"SourceError,omitempty"`
+	RelayError  *RelayError `protobuf:"bytes,4,opt,name=RelayError,proto3" json:"RelayError,omitempty"`
 }
 
-func (m *OperateWorkerSchemaRequest) GetSource() string {
-	if m != nil {
-		return m.Source
-	}
-	return ""
+func (m *SourceError) Reset()         { *m = SourceError{} }
+func (m *SourceError) String() string { return proto.CompactTextString(m) }
+func (*SourceError) ProtoMessage()    {}
+func (*SourceError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{24}
 }
-
-func (m *OperateWorkerSchemaRequest) GetDatabase() string {
-	if m != nil {
-		return m.Database
+func (m *SourceError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *SourceError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceError.Merge(m, src)
+}
+func (m *SourceError) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceError) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceError.DiscardUnknown(m)
 }
 
-func (m *OperateWorkerSchemaRequest) GetTable() string {
+var xxx_messageInfo_SourceError proto.InternalMessageInfo
+
+func (m *SourceError) GetSource() string {
 	if m != nil {
-		return m.Table
+		return m.Source
 	}
 	return ""
 }
 
-func (m *OperateWorkerSchemaRequest) GetSchema() string {
+func (m *SourceError) GetWorker() string {
 	if m != nil {
-		return m.Schema
+		return m.Worker
 	}
 	return ""
 }
 
-func (m *OperateWorkerSchemaRequest) GetFlush() bool {
+func (m *SourceError) GetSourceError() string {
 	if m != nil {
-		return m.Flush
+		return m.SourceError
 	}
-	return false
+	return ""
 }
 
-func (m *OperateWorkerSchemaRequest) GetSync() bool {
+func (m *SourceError) GetRelayError() *RelayError {
 	if m != nil {
-		return m.Sync
+		return m.RelayError
 	}
-	return false
+	return nil
 }
 
-// copied `TaskMeta` from release-1.0 branch.
-type V1SubTaskMeta struct {
-	Op    TaskOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskOp" json:"op,omitempty"`
-	Stage Stage  `protobuf:"varint,2,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Task  []byte `protobuf:"bytes,4,opt,name=task,proto3" json:"task,omitempty"`
+// RelayError represents error for relay unit.
+type RelayError struct {
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (m *V1SubTaskMeta) Reset()         { *m = V1SubTaskMeta{} }
-func (m *V1SubTaskMeta) String() string { return proto.CompactTextString(m) }
-func (*V1SubTaskMeta) ProtoMessage()    {}
-func (*V1SubTaskMeta) Descriptor() ([]byte, []int) {
+func (m *RelayError) Reset()         { *m = RelayError{} }
+func (m *RelayError) String() string { return proto.CompactTextString(m) }
+func (*RelayError) ProtoMessage()    {}
+func (*RelayError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{25}
 }
-func (m *V1SubTaskMeta) XXX_Unmarshal(b []byte) error {
+func (m *RelayError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *V1SubTaskMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RelayError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_V1SubTaskMeta.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RelayError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2199,62 +2382,56 @@ func (m *V1SubTaskMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *V1SubTaskMeta) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_V1SubTaskMeta.Merge(m, src)
+func (m *RelayError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayError.Merge(m, src)
 }
-func (m *V1SubTaskMeta) XXX_Size() int {
+func (m *RelayError) XXX_Size() int {
 	return m.Size()
 }
-func (m *V1SubTaskMeta) XXX_DiscardUnknown() {
-	xxx_messageInfo_V1SubTaskMeta.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_V1SubTaskMeta proto.InternalMessageInfo
-
-func (m *V1SubTaskMeta) GetOp() TaskOp {
-	if m != nil {
-		return m.Op
-	}
-	return TaskOp_InvalidOp
+func (m *RelayError) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayError.DiscardUnknown(m)
 }
 
-func (m *V1SubTaskMeta) GetStage() Stage {
-	if m != nil {
-		return m.Stage
-	}
-	return Stage_InvalidStage
-}
+var xxx_messageInfo_RelayError proto.InternalMessageInfo
 
-func (m *V1SubTaskMeta) GetName() string {
+func (m *RelayError) GetMsg() string {
 	if m != nil {
-		return m.Name
+		return m.Msg
 	}
 	return ""
 }
 
-func (m *V1SubTaskMeta) GetTask() []byte {
-	if m != nil {
-		return m.Task
-	}
-	return nil
-}
-
-type OperateV1MetaRequest struct {
-	Op V1MetaOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.V1MetaOp" json:"op,omitempty"`
+// SubTaskError represents error for a sub task during running
+// name: sub task'name, when starting a sub task the name should be unique
+// stage: sub task's current stage
+// unit: sub task's current dm unit's UnitType
+// error: current unit's error information
+//         for Sync, includes failed sql, failed sql pos in binlog, etc.
+type SubTaskError struct {
+	Name  string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Stage Stage    `protobuf:"varint,2,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
+	Unit  UnitType `protobuf:"varint,3,opt,name=unit,proto3,enum=pb.UnitType" json:"unit,omitempty"`
+	// Types that are valid to be assigned to Error:
+	//	*SubTaskError_Msg
+	//	*SubTaskError_Check
+	//	*SubTaskError_Dump
+	//	*SubTaskError_Load
+	//	*SubTaskError_Sync
+	Error isSubTaskError_Error `protobuf_oneof:"error"`
 }
 
-func (m *OperateV1MetaRequest) Reset()         { *m = OperateV1MetaRequest{} }
-func (m *OperateV1MetaRequest) String() string { return proto.CompactTextString(m) }
-func (*OperateV1MetaRequest) ProtoMessage()    {}
-func (*OperateV1MetaRequest) Descriptor() ([]byte, []int) {
+func (m *SubTaskError) Reset()         { *m = SubTaskError{} }
+func (m *SubTaskError) String() string { return proto.CompactTextString(m) }
+func (*SubTaskError) ProtoMessage()    {}
+func (*SubTaskError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{26}
 }
-func (m *OperateV1MetaRequest) XXX_Unmarshal(b []byte) error {
+func (m *SubTaskError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OperateV1MetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubTaskError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OperateV1MetaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubTaskError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2264,43 +2441,137 @@ func (m *OperateV1MetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *OperateV1MetaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperateV1MetaRequest.Merge(m, src)
+func (m *SubTaskError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubTaskError.Merge(m, src)
 }
-func (m *OperateV1MetaRequest) XXX_Size() int {
+func (m *SubTaskError) XXX_Size() int {
 	return m.Size()
 }
-func (m *OperateV1MetaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperateV1MetaRequest.DiscardUnknown(m)
+func (m *SubTaskError) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubTaskError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OperateV1MetaRequest proto.InternalMessageInfo
+var xxx_messageInfo_SubTaskError proto.InternalMessageInfo
 
-func (m *OperateV1MetaRequest) GetOp() V1MetaOp {
+type isSubTaskError_Error interface {
+	isSubTaskError_Error()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type SubTaskError_Msg struct {
+	Msg string `protobuf:"bytes,4,opt,name=msg,proto3,oneof" json:"msg,omitempty"`
+}
+type SubTaskError_Check struct {
+	Check *CheckError `protobuf:"bytes,5,opt,name=check,proto3,oneof" json:"check,omitempty"`
+}
+type SubTaskError_Dump struct {
+	Dump *DumpError `protobuf:"bytes,6,opt,name=dump,proto3,oneof" json:"dump,omitempty"`
+}
+type SubTaskError_Load struct {
+	Load *LoadError `protobuf:"bytes,7,opt,name=load,proto3,oneof" json:"load,omitempty"`
+}
+type SubTaskError_Sync struct {
+	Sync *SyncError `protobuf:"bytes,8,opt,name=sync,proto3,oneof" json:"sync,omitempty"`
+}
+
+func (*SubTaskError_Msg) isSubTaskError_Error()   {}
+func (*SubTaskError_Check) isSubTaskError_Error() {}
+func (*SubTaskError_Dump) isSubTaskError_Error()  {}
+func (*SubTaskError_Load) isSubTaskError_Error()  {}
+func (*SubTaskError_Sync) isSubTaskError_Error()  {}
+
+func (m *SubTaskError) GetError() isSubTaskError_Error {
 	if m != nil {
-		return m.Op
+		return m.Error
 	}
-	return V1MetaOp_InvalidV1MetaOp
+	return nil
 }
 
-type OperateV1MetaResponse struct {
-	Result bool                      `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
-	Msg    string                    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Meta   map[string]*V1SubTaskMeta `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (m *SubTaskError) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *OperateV1MetaResponse) Reset()         { *m = OperateV1MetaResponse{} }
-func (m *OperateV1MetaResponse) String() string { return proto.CompactTextString(m) }
-func (*OperateV1MetaResponse) ProtoMessage()    {}
-func (*OperateV1MetaResponse) Descriptor() ([]byte, []int) {
+func (m *SubTaskError) GetStage() Stage {
+	if m != nil {
+		return m.Stage
+	}
+	return Stage_InvalidStage
+}
+
+func (m *SubTaskError) GetUnit() UnitType {
+	if m != nil {
+		return m.Unit
+	}
+	return UnitType_InvalidUnit
+}
+
+func (m *SubTaskError) GetMsg() string {
+	if x, ok := m.GetError().(*SubTaskError_Msg); ok {
+		return x.Msg
+	}
+	return ""
+}
+
+func (m *SubTaskError) GetCheck() *CheckError {
+	if x, ok := m.GetError().(*SubTaskError_Check); ok {
+		return x.Check
+	}
+	return nil
+}
+
+func (m *SubTaskError) GetDump() *DumpError {
+	if x, ok := m.GetError().(*SubTaskError_Dump); ok {
+		return x.Dump
+	}
+	return nil
+}
+
+func (m *SubTaskError) GetLoad() *LoadError {
+	if x, ok := m.GetError().(*SubTaskError_Load); ok {
+		return x.Load
+	}
+	return nil
+}
+
+func (m *SubTaskError) GetSync() *SyncError {
+	if x, ok := m.GetError().(*SubTaskError_Sync); ok {
+		return x.Sync
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SubTaskError) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SubTaskError_Msg)(nil),
+		(*SubTaskError_Check)(nil),
+		(*SubTaskError_Dump)(nil),
+		(*SubTaskError_Load)(nil),
+		(*SubTaskError_Sync)(nil),
+	}
+}
+
+// SubTaskErrorList used for internal jsonpb marshal
+type SubTaskErrorList struct {
+	Error []*SubTaskError `protobuf:"bytes,1,rep,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SubTaskErrorList) Reset()         { *m = SubTaskErrorList{} }
+func (m *SubTaskErrorList) String() string { return proto.CompactTextString(m) }
+func (*SubTaskErrorList) ProtoMessage()    {}
+func (*SubTaskErrorList) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{27}
 }
-func (m *OperateV1MetaResponse) XXX_Unmarshal(b []byte) error {
+func (m *SubTaskErrorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OperateV1MetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubTaskErrorList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OperateV1MetaResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubTaskErrorList.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2310,58 +2581,47 @@ func (m *OperateV1MetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *OperateV1MetaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperateV1MetaResponse.Merge(m, src)
+func (m *SubTaskErrorList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubTaskErrorList.Merge(m, src)
 }
-func (m *OperateV1MetaResponse) XXX_Size() int {
+func (m *SubTaskErrorList) XXX_Size() int {
 	return m.Size()
 }
-func (m *OperateV1MetaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperateV1MetaResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_OperateV1MetaResponse proto.InternalMessageInfo
-
-func (m *OperateV1MetaResponse) GetResult() bool {
-	if m != nil {
-		return m.Result
-	}
-	return false
+func (m *SubTaskErrorList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubTaskErrorList.DiscardUnknown(m)
 }
 
-func (m *OperateV1MetaResponse) GetMsg() string {
-	if m != nil {
-		return m.Msg
-	}
-	return ""
-}
+var xxx_messageInfo_SubTaskErrorList proto.InternalMessageInfo
 
-func (m *OperateV1MetaResponse) GetMeta() map[string]*V1SubTaskMeta {
+func (m *SubTaskErrorList) GetError() []*SubTaskError {
 	if m != nil {
-		return m.Meta
+		return m.Error
 	}
 	return nil
 }
 
-type HandleWorkerErrorRequest struct {
-	Op        ErrorOp  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.ErrorOp" json:"op,omitempty"`
-	Task      string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
-	BinlogPos string   `protobuf:"bytes,3,opt,name=binlogPos,proto3" json:"binlogPos,omitempty"`
-	Sqls      []string `protobuf:"bytes,4,rep,name=sqls,proto3" json:"sqls,omitempty"`
+// ProcessResult represents results produced by a dm unit
+// isCanceled: indicates whether the process is canceled from external
+//             when Stop or Pause is requested from external, isCanceled will be true
+// errors: includes all (potential) errors occured when processing
+type ProcessResult struct {
+	IsCanceled bool            `protobuf:"varint,1,opt,name=isCanceled,proto3" json:"isCanceled,omitempty"`
+	Errors     []*ProcessError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Detail     []byte          `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
 }
 
-func (m *HandleWorkerErrorRequest) Reset()         { *m = HandleWorkerErrorRequest{} }
-func (m *HandleWorkerErrorRequest) String() string { return proto.CompactTextString(m) }
-func (*HandleWorkerErrorRequest) ProtoMessage()    {}
-func (*HandleWorkerErrorRequest) Descriptor() ([]byte, []int) {
+func (m *ProcessResult) Reset()         { *m = ProcessResult{} }
+func (m *ProcessResult) String() string { return proto.CompactTextString(m) }
+func (*ProcessResult) ProtoMessage()    {}
+func (*ProcessResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{28}
 }
-func (m *HandleWorkerErrorRequest) XXX_Unmarshal(b []byte) error {
+func (m *ProcessResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HandleWorkerErrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProcessResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_HandleWorkerErrorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProcessResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2371,61 +2631,64 @@ func (m *HandleWorkerErrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *HandleWorkerErrorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HandleWorkerErrorRequest.Merge(m, src)
+func (m *ProcessResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessResult.Merge(m, src)
 }
-func (m *HandleWorkerErrorRequest) XXX_Size() int {
+func (m *ProcessResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *HandleWorkerErrorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HandleWorkerErrorRequest.DiscardUnknown(m)
+func (m *ProcessResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HandleWorkerErrorRequest proto.InternalMessageInfo
-
-func (m *HandleWorkerErrorRequest) GetOp() ErrorOp {
-	if m != nil {
-		return m.Op
-	}
-	return ErrorOp_InvalidErrorOp
-}
+var xxx_messageInfo_ProcessResult proto.InternalMessageInfo
 
-func (m *HandleWorkerErrorRequest) GetTask() string {
+func (m *ProcessResult) GetIsCanceled() bool {
 	if m != nil {
-		return m.Task
+		return m.IsCanceled
 	}
-	return ""
+	return false
 }
 
-func (m *HandleWorkerErrorRequest) GetBinlogPos() string {
+func (m *ProcessResult) GetErrors() []*ProcessError {
 	if m != nil {
-		return m.BinlogPos
+		return m.Errors
 	}
-	return ""
+	return nil
 }
 
-func (m *HandleWorkerErrorRequest) GetSqls() []string {
+func (m *ProcessResult) GetDetail() []byte {
 	if m != nil {
-		return m.Sqls
+		return m.Detail
 	}
 	return nil
 }
 
-type GetWorkerCfgRequest struct {
+// ProcessError is same as terror used in dm
+// NOTE: currently stack trace is not supported, `Message` is the `terror.Error.getMsg` result
+// and `RawCause` is the `Error` result of error from `terror.Error.Cause()`.
+type ProcessError struct {
+	ErrCode    int32  `protobuf:"varint,1,opt,name=ErrCode,proto3" json:"ErrCode,omitempty"`
+	ErrClass   string `protobuf:"bytes,2,opt,name=ErrClass,proto3" json:"ErrClass,omitempty"`
+	ErrScope   string `protobuf:"bytes,3,opt,name=ErrScope,proto3" json:"ErrScope,omitempty"`
+	ErrLevel   string `protobuf:"bytes,4,opt,name=ErrLevel,proto3" json:"ErrLevel,omitempty"`
+	Message    string `protobuf:"bytes,5,opt,name=Message,proto3" json:"Message,omitempty"`
+	RawCause   string `protobuf:"bytes,6,opt,name=RawCause,proto3" json:"RawCause,omitempty"`
+	Workaround string `protobuf:"bytes,7,opt,name=Workaround,proto3" json:"Workaround,omitempty"`
 }
 
-func (m *GetWorkerCfgRequest) Reset()         { *m = GetWorkerCfgRequest{} }
-func (m *GetWorkerCfgRequest) String() string { return proto.CompactTextString(m) }
-func (*GetWorkerCfgRequest) ProtoMessage()    {}
-func (*GetWorkerCfgRequest) Descriptor() ([]byte, []int) {
+func (m *ProcessError) Reset()         { *m = ProcessError{} }
+func (m *ProcessError) String() string { return proto.CompactTextString(m) }
+func (*ProcessError) ProtoMessage()    {}
+func (*ProcessError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{29}
 }
-func (m *GetWorkerCfgRequest) XXX_Unmarshal(b []byte) error {
+func (m *ProcessError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetWorkerCfgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProcessError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetWorkerCfgRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProcessError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2435,34 +2698,92 @@ func (m *GetWorkerCfgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetWorkerCfgRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWorkerCfgRequest.Merge(m, src)
+func (m *ProcessError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessError.Merge(m, src)
 }
-func (m *GetWorkerCfgRequest) XXX_Size() int {
+func (m *ProcessError) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetWorkerCfgRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWorkerCfgRequest.DiscardUnknown(m)
+func (m *ProcessError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWorkerCfgRequest proto.InternalMessageInfo
+var xxx_messageInfo_ProcessError proto.InternalMessageInfo
 
-type GetWorkerCfgResponse struct {
-	Cfg string `protobuf:"bytes,1,opt,name=cfg,proto3" json:"cfg,omitempty"`
+func (m *ProcessError) GetErrCode() int32 {
+	if m != nil {
+		return m.ErrCode
+	}
+	return 0
 }
 
-func (m *GetWorkerCfgResponse) Reset()         { *m = GetWorkerCfgResponse{} }
-func (m *GetWorkerCfgResponse) String() string { return proto.CompactTextString(m) }
-func (*GetWorkerCfgResponse) ProtoMessage()    {}
-func (*GetWorkerCfgResponse) Descriptor() ([]byte, []int) {
+func (m *ProcessError) GetErrClass() string {
+	if m != nil {
+		return m.ErrClass
+	}
+	return ""
+}
+
+func (m *ProcessError) GetErrScope() string {
+	if m != nil {
+		return m.ErrScope
+	}
+	return ""
+}
+
+func (m *ProcessError) GetErrLevel() string {
+	if m != nil {
+		return m.ErrLevel
+	}
+	return ""
+}
+
+func (m *ProcessError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ProcessError) GetRawCause() string {
+	if m != nil {
+		return m.RawCause
+	}
+	return ""
+}
+
+func (m *ProcessError) GetWorkaround() string {
+	if m != nil {
+		return m.Workaround
+	}
+	return ""
+}
+
+// PurgeRelayRequest represents a request to purge relay log files for this dm-worker
+// inactive: whether purge inactive relay log files
+// time: whether purge relay log files before this time, the number of seconds elapsed since January 1, 1970 UTC
+// filename: whether purge relay log files before this filename
+// subDir: specify relay sub directory for @filename
+type PurgeRelayRequest struct {
+	Inactive bool   `protobuf:"varint,1,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	Time     int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	SubDir   string `protobuf:"bytes,4,opt,name=subDir,proto3" json:"subDir,omitempty"`
+	DryRun   bool   `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (m *PurgeRelayRequest) Reset()         { *m = PurgeRelayRequest{} }
+func (m *PurgeRelayRequest) String() string { return proto.CompactTextString(m) }
+func (*PurgeRelayRequest) ProtoMessage()    {}
+func (*PurgeRelayRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_51a1b9e17fd67b10, []int{30}
 }
-func (m *GetWorkerCfgResponse) XXX_Unmarshal(b []byte) error {
+func (m *PurgeRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetWorkerCfgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PurgeRelayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetWorkerCfgResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PurgeRelayRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2472,1844 +2793,1801 @@ func (m *GetWorkerCfgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GetWorkerCfgResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWorkerCfgResponse.Merge(m, src)
+func (m *PurgeRelayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeRelayRequest.Merge(m, src)
 }
-func (m *GetWorkerCfgResponse) XXX_Size() int {
+func (m *PurgeRelayRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetWorkerCfgResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWorkerCfgResponse.DiscardUnknown(m)
+func (m *PurgeRelayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeRelayRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWorkerCfgResponse proto.InternalMessageInfo
+var xxx_messageInfo_PurgeRelayRequest proto.InternalMessageInfo
 
-func (m *GetWorkerCfgResponse) GetCfg() string {
+func (m *PurgeRelayRequest) GetInactive() bool {
 	if m != nil {
-		return m.Cfg
+		return m.Inactive
 	}
-	return ""
-}
-
-func init() {
-	proto.RegisterEnum("pb.TaskOp", TaskOp_name, TaskOp_value)
-	proto.RegisterEnum("pb.Stage", Stage_name, Stage_value)
-	proto.RegisterEnum("pb.UnitType", UnitType_name, UnitType_value)
-	proto.RegisterEnum("pb.RelayOp", RelayOp_name, RelayOp_value)
-	proto.RegisterEnum("pb.SchemaOp", SchemaOp_name, SchemaOp_value)
-	proto.RegisterEnum("pb.V1MetaOp", V1MetaOp_name, V1MetaOp_value)
-	proto.RegisterEnum("pb.ErrorOp", ErrorOp_name, ErrorOp_value)
-	proto.RegisterType((*QueryStatusRequest)(nil), "pb.QueryStatusRequest")
-	proto.RegisterType((*CommonWorkerResponse)(nil), "pb.CommonWorkerResponse")
-	proto.RegisterType((*QueryStatusResponse)(nil), "pb.QueryStatusResponse")
-	proto.RegisterType((*CheckStatus)(nil), "pb.CheckStatus")
-	proto.RegisterType((*DumpStatus)(nil), "pb.DumpStatus")
-	proto.RegisterType((*LoadStatus)(nil), "pb.LoadStatus")
-	proto.RegisterType((*ShardingGroup)(nil), "pb.ShardingGroup")
-	proto.RegisterType((*SyncStatus)(nil), "pb.SyncStatus")
-	proto.RegisterType((*SourceStatus)(nil), "pb.SourceStatus")
-	proto.RegisterType((*RelayStatus)(nil), "pb.RelayStatus")
-	proto.RegisterType((*SubTaskStatus)(nil), "pb.SubTaskStatus")
-	proto.RegisterType((*SubTaskStatusList)(nil), "pb.SubTaskStatusList")
-	proto.RegisterType((*CheckError)(nil), "pb.CheckError")
-	proto.RegisterType((*DumpError)(nil), "pb.DumpError")
-	proto.RegisterType((*LoadError)(nil), "pb.LoadError")
-	proto.RegisterType((*SyncSQLError)(nil), "pb.SyncSQLError")
-	proto.RegisterType((*SyncError)(nil), "pb.SyncError")
-	proto.RegisterType((*SourceError)(nil), "pb.SourceError")
-	proto.RegisterType((*RelayError)(nil), "pb.RelayError")
-	proto.RegisterType((*SubTaskError)(nil), "pb.SubTaskError")
-	proto.RegisterType((*SubTaskErrorList)(nil), "pb.SubTaskErrorList")
-	proto.RegisterType((*ProcessResult)(nil), "pb.ProcessResult")
-	proto.RegisterType((*ProcessError)(nil), "pb.ProcessError")
-	proto.RegisterType((*PurgeRelayRequest)(nil), "pb.PurgeRelayRequest")
-	proto.RegisterType((*OperateWorkerSchemaRequest)(nil), "pb.OperateWorkerSchemaRequest")
-	proto.RegisterType((*V1SubTaskMeta)(nil), "pb.V1SubTaskMeta")
-	proto.RegisterType((*OperateV1MetaRequest)(nil), "pb.OperateV1MetaRequest")
-	proto.RegisterType((*OperateV1MetaResponse)(nil), "pb.OperateV1MetaResponse")
-	proto.RegisterMapType((map[string]*V1SubTaskMeta)(nil), "pb.OperateV1MetaResponse.MetaEntry")
-	proto.RegisterType((*HandleWorkerErrorRequest)(nil), "pb.HandleWorkerErrorRequest")
-	proto.RegisterType((*GetWorkerCfgRequest)(nil), "pb.GetWorkerCfgRequest")
-	proto.RegisterType((*GetWorkerCfgResponse)(nil), "pb.GetWorkerCfgResponse")
-}
-
-func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
-
-var fileDescriptor_51a1b9e17fd67b10 = []byte{
-	// 2008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xe4, 0x48,
-	0x15, 0x6f, 0xb7, 0xbb, 0x3b, 0xdd, 0xaf, 0x3b, 0x19, 0x6f, 0x25, 0xb3, 0x34, 0x61, 0x68, 0x22,
-	0xcf, 0x6a, 0x08, 0x39, 0x44, 0x3b, 0x61, 0xd1, 0xa2, 0x95, 0x80, 0x21, 0xc9, 0x6c, 0x66, 0x21,
-	0x43, 0x66, 0xdc, 0x33, 0xcb, 0x11, 0x55, 0xdb, 0x95, 0x8e, 0x15, 0xb7, 0xed, 0x71, 0xd9, 0x89,
-	0xfa, 0x80, 0xf8, 0x08, 0x70, 0xe1, 0x80, 0xc4, 0x95, 0xeb, 0x1e, 0xf9, 0x08, 0xc0, 0x71, 0x85,
-	0x84, 0x84, 0x38, 0xa1, 0x99, 0xaf, 0xc1, 0x01, 0xbd, 0x57, 0x65, 0xbb, 0x3a, 0xe9, 0x9e, 0x61,
-	0x0e, 0xdc, 0xfc, 0x7e, 0xef, 0xd5, 0xab, 0x57, 0xbf, 0x7a, 0x7f, 0x6c, 0xc3, 0x46, 0x30, 0xbb,
-	0x4e, 0xb2, 0x4b, 0x91, 0xed, 0xa7, 0x59, 0x92, 0x27, 0xac, 0x99, 0x4e, 0xdc, 0x5d, 0x60, 0xcf,
-	0x0b, 0x91, 0xcd, 0xc7, 0x39, 0xcf, 0x0b, 0xe9, 0x89, 0x57, 0x85, 0x90, 0x39, 0x63, 0xd0, 0x8a,
-	0xf9, 0x4c, 0x0c, 0xad, 0x1d, 0x6b, 0xb7, 0xe7, 0xd1, 0xb3, 0x9b, 0xc2, 0xd6, 0x51, 0x32, 0x9b,
-	0x25, 0xf1, 0x2f, 0xc9, 0x87, 0x27, 0x64, 0x9a, 0xc4, 0x52, 0xb0, 0x0f, 0xa1, 0x93, 0x09, 0x59,
-	0x44, 0x39, 0x59, 0x77, 0x3d, 0x2d, 0x31, 0x07, 0xec, 0x99, 0x9c, 0x0e, 0x9b, 0xe4, 0x02, 0x1f,
-	0xd1, 0x52, 0x26, 0x45, 0xe6, 0x8b, 0xa1, 0x4d, 0xa0, 0x96, 0x10, 0x57, 0x71, 0x0d, 0x5b, 0x0a,
-	0x57, 0x92, 0xfb, 0x95, 0x05, 0x9b, 0x0b, 0xc1, 0xbd, 0xf7, 0x8e, 0x9f, 0xc0, 0x40, 0xed, 0xa1,
-	0x3c, 0xd0, 0xbe, 0xfd, 0x03, 0x67, 0x3f, 0x9d, 0xec, 0x8f, 0x0d, 0xdc, 0x5b, 0xb0, 0x62, 0x9f,
-	0xc2, 0xba, 0x2c, 0x26, 0x2f, 0xb8, 0xbc, 0xd4, 0xcb, 0x5a, 0x3b, 0xf6, 0x6e, 0xff, 0xe0, 0x03,
-	0x5a, 0x66, 0x2a, 0xbc, 0x45, 0x3b, 0xf7, 0x4f, 0x16, 0xf4, 0x8f, 0x2e, 0x84, 0xaf, 0x65, 0x0c,
-	0x34, 0xe5, 0x52, 0x8a, 0xa0, 0x0c, 0x54, 0x49, 0x6c, 0x0b, 0xda, 0x79, 0x92, 0xf3, 0x88, 0x42,
-	0x6d, 0x7b, 0x4a, 0x60, 0x23, 0x00, 0x59, 0xf8, 0xbe, 0x90, 0xf2, 0xbc, 0x88, 0x28, 0xd4, 0xb6,
-	0x67, 0x20, 0xe8, 0xed, 0x9c, 0x87, 0x91, 0x08, 0x88, 0xa6, 0xb6, 0xa7, 0x25, 0x36, 0x84, 0xb5,
-	0x6b, 0x9e, 0xc5, 0x61, 0x3c, 0x1d, 0xb6, 0x49, 0x51, 0x8a, 0xb8, 0x22, 0x10, 0x39, 0x0f, 0xa3,
-	0x61, 0x67, 0xc7, 0xda, 0x1d, 0x78, 0x5a, 0x72, 0x07, 0x00, 0xc7, 0xc5, 0x2c, 0xd5, 0x51, 0xff,
-	0xd9, 0x02, 0x38, 0x4d, 0x78, 0xa0, 0x83, 0xfe, 0x08, 0xd6, 0xcf, 0xc3, 0x38, 0x94, 0x17, 0x22,
-	0x38, 0x9c, 0xe7, 0x42, 0x52, 0xec, 0xb6, 0xb7, 0x08, 0x62, 0xb0, 0x14, 0xb5, 0x32, 0x69, 0x92,
-	0x89, 0x81, 0xb0, 0x6d, 0xe8, 0xa6, 0x59, 0x32, 0xcd, 0x84, 0x94, 0xfa, 0xb6, 0x2b, 0x19, 0xd7,
-	0xce, 0x44, 0xce, 0x0f, 0xc3, 0x38, 0x4a, 0xa6, 0xfa, 0xce, 0x0d, 0x84, 0x3d, 0x80, 0x8d, 0x5a,
-	0x3a, 0x79, 0xf1, 0xc5, 0x31, 0x9d, 0xab, 0xe7, 0xdd, 0x40, 0xdd, 0xdf, 0x5b, 0xb0, 0x3e, 0xbe,
-	0xe0, 0x59, 0x10, 0xc6, 0xd3, 0x93, 0x2c, 0x29, 0x52, 0x3c, 0x70, 0xce, 0xb3, 0xa9, 0xc8, 0x75,
-	0xe6, 0x6a, 0x09, 0xf3, 0xf9, 0xf8, 0xf8, 0x14, 0xe3, 0xb4, 0x31, 0x9f, 0xf1, 0x59, 0x9d, 0x33,
-	0x93, 0xf9, 0x69, 0xe2, 0xf3, 0x3c, 0x4c, 0x62, 0x1d, 0xe6, 0x22, 0x48, 0x39, 0x3b, 0x8f, 0x7d,
-	0x22, 0xdd, 0xa6, 0x9c, 0x25, 0x09, 0xcf, 0x57, 0xc4, 0x5a, 0xd3, 0x26, 0x4d, 0x25, 0xbb, 0xff,
-	0xb0, 0x01, 0xc6, 0xf3, 0xd8, 0xd7, 0x84, 0xee, 0x40, 0x9f, 0x88, 0x79, 0x7c, 0x25, 0xe2, 0xbc,
-	0xa4, 0xd3, 0x84, 0xd0, 0x19, 0x89, 0x2f, 0xd2, 0x92, 0xca, 0x4a, 0x66, 0xf7, 0xa0, 0x97, 0x09,
-	0x5f, 0xc4, 0x39, 0x2a, 0x6d, 0x52, 0xd6, 0x00, 0x73, 0x61, 0x30, 0xe3, 0x32, 0x17, 0xd9, 0x02,
-	0x99, 0x0b, 0x18, 0xdb, 0x03, 0xc7, 0x94, 0x4f, 0xf2, 0x30, 0xd0, 0x84, 0xde, 0xc2, 0xd1, 0x1f,
-	0x1d, 0xa2, 0xf4, 0xd7, 0x51, 0xfe, 0x4c, 0x0c, 0xfd, 0x99, 0x32, 0xf9, 0x5b, 0x53, 0xfe, 0x6e,
-	0xe2, 0xe8, 0x6f, 0x12, 0x25, 0xfe, 0x65, 0x18, 0x4f, 0xe9, 0x02, 0xba, 0x44, 0xd5, 0x02, 0xc6,
-	0x7e, 0x04, 0x4e, 0x11, 0x67, 0x42, 0x26, 0xd1, 0x95, 0x08, 0xe8, 0x1e, 0xe5, 0xb0, 0x67, 0x54,
-	0x9c, 0x79, 0xc3, 0xde, 0x2d, 0x53, 0xe3, 0x86, 0x40, 0x15, 0x99, 0xbe, 0xa1, 0x11, 0xc0, 0x84,
-	0x02, 0x79, 0x31, 0x4f, 0xc5, 0xb0, 0xaf, 0xb2, 0xac, 0x46, 0xd8, 0xc7, 0xb0, 0x29, 0x85, 0x9f,
-	0xc4, 0x81, 0x3c, 0x14, 0x17, 0x61, 0x1c, 0x3c, 0x25, 0x2e, 0x86, 0x03, 0xa2, 0x78, 0x99, 0xca,
-	0xfd, 0xa3, 0x05, 0x03, 0xb3, 0x6d, 0x18, 0x0d, 0xcd, 0x5a, 0xd1, 0xd0, 0x9a, 0x66, 0x43, 0x63,
-	0xdf, 0xab, 0x1a, 0x97, 0x6a, 0x44, 0x74, 0xbe, 0x67, 0x59, 0x82, 0x15, 0xee, 0x91, 0xa2, 0xea,
-	0x65, 0x0f, 0xa1, 0x9f, 0x89, 0x88, 0xcf, 0xab, 0x0e, 0x84, 0xf6, 0x77, 0xd0, 0xde, 0xab, 0x61,
-	0xcf, 0xb4, 0x71, 0xff, 0xda, 0x84, 0xbe, 0xa1, 0xbc, 0x95, 0x1b, 0xd6, 0xff, 0x98, 0x1b, 0xcd,
-	0x15, 0xb9, 0xb1, 0x53, 0x86, 0x54, 0x4c, 0x8e, 0xc3, 0x4c, 0x97, 0x8b, 0x09, 0x55, 0x16, 0x0b,
-	0xc9, 0x68, 0x42, 0x6c, 0x17, 0xee, 0x18, 0xa2, 0x91, 0x8a, 0x37, 0x61, 0xb6, 0x0f, 0x8c, 0xa0,
-	0x23, 0x9e, 0xfb, 0x17, 0x2f, 0x53, 0x7d, 0x3b, 0x1d, 0xba, 0xe2, 0x25, 0x1a, 0xf6, 0x1d, 0x68,
-	0xcb, 0x9c, 0x4f, 0x05, 0xa5, 0xe2, 0xc6, 0x41, 0x8f, 0x52, 0x07, 0x01, 0x4f, 0xe1, 0x06, 0xf9,
-	0xdd, 0x77, 0x90, 0xef, 0xfe, 0xa7, 0x09, 0xeb, 0x0b, 0x8d, 0x7e, 0xd9, 0x40, 0xac, 0x77, 0x6c,
-	0xae, 0xd8, 0x71, 0x07, 0x5a, 0x45, 0x1c, 0xaa, 0xcb, 0xde, 0x38, 0x18, 0xa0, 0xfe, 0x65, 0x1c,
-	0xe6, 0x98, 0x7d, 0x1e, 0x69, 0x8c, 0x98, 0x5a, 0xef, 0x4a, 0x88, 0x8f, 0x61, 0xb3, 0x4e, 0xfd,
-	0xe3, 0xe3, 0xd3, 0xd3, 0xc4, 0xbf, 0xac, 0x3a, 0xe3, 0x32, 0x15, 0x63, 0x6a, 0x1c, 0x52, 0x09,
-	0x3f, 0x69, 0xa8, 0x81, 0xf8, 0x5d, 0x68, 0xfb, 0x38, 0xa0, 0x88, 0x25, 0x9d, 0x50, 0xc6, 0xc4,
-	0x7a, 0xd2, 0xf0, 0x94, 0x9e, 0x7d, 0x04, 0xad, 0xa0, 0x98, 0xa5, 0x9a, 0xab, 0x0d, 0xb4, 0xab,
-	0x47, 0xc6, 0x93, 0x86, 0x47, 0x5a, 0xb4, 0x8a, 0x12, 0x1e, 0x0c, 0x7b, 0xb5, 0x55, 0x3d, 0x49,
-	0xd0, 0x0a, 0xb5, 0x68, 0x85, 0x35, 0x49, 0xf5, 0xa9, 0xad, 0xea, 0xf6, 0x88, 0x56, 0xa8, 0x3d,
-	0xec, 0x42, 0x47, 0xaa, 0x44, 0xfe, 0x31, 0x7c, 0xb0, 0xc0, 0xfe, 0x69, 0x28, 0x89, 0x2a, 0xa5,
-	0x1e, 0x5a, 0xab, 0xa6, 0x71, 0xb9, 0x7e, 0x04, 0x40, 0x67, 0x7a, 0x9c, 0x65, 0x49, 0x56, 0xbe,
-	0x15, 0x58, 0xd5, 0x5b, 0x81, 0xfb, 0x6d, 0xe8, 0xe1, 0x59, 0xde, 0xa2, 0xc6, 0x43, 0xac, 0x52,
-	0xa7, 0x30, 0xa0, 0xe8, 0x9f, 0x9f, 0xae, 0xb0, 0x60, 0x07, 0xb0, 0xa5, 0x46, 0xb3, 0x4a, 0xe7,
-	0x67, 0x89, 0x0c, 0x69, 0xc0, 0xa8, 0xc2, 0x5a, 0xaa, 0xc3, 0x11, 0x20, 0xd0, 0xdd, 0xf8, 0xf9,
-	0x69, 0x39, 0x2f, 0x4b, 0xd9, 0xfd, 0x01, 0xf4, 0x70, 0x47, 0xb5, 0xdd, 0x2e, 0x74, 0x48, 0x51,
-	0xf2, 0xe0, 0x54, 0x74, 0xea, 0x80, 0x3c, 0xad, 0x77, 0x7f, 0x6b, 0x41, 0x5f, 0xb5, 0x2b, 0xb5,
-	0xf2, 0x7d, 0xbb, 0xd5, 0xce, 0xc2, 0xf2, 0xb2, 0xde, 0x4d, 0x8f, 0xfb, 0x00, 0xd4, 0x70, 0x94,
-	0x41, 0xab, 0xbe, 0xde, 0x1a, 0xf5, 0x0c, 0x0b, 0xbc, 0x98, 0x5a, 0x5a, 0x42, 0xed, 0x1f, 0x9a,
-	0x30, 0xd0, 0x57, 0xaa, 0x4c, 0xfe, 0x4f, 0x65, 0xa7, 0x2b, 0xa3, 0x65, 0x56, 0xc6, 0x83, 0xb2,
-	0x32, 0xda, 0xf5, 0x31, 0xea, 0x2c, 0xaa, 0x0b, 0xe3, 0xbe, 0x2e, 0x8c, 0x0e, 0x99, 0xad, 0x97,
-	0x85, 0x51, 0x5a, 0xa9, 0xba, 0xb8, 0xaf, 0xeb, 0x62, 0xad, 0x36, 0xaa, 0x52, 0xaa, 0x2a, 0x8b,
-	0xfb, 0xba, 0x2c, 0xba, 0xb5, 0x51, 0x75, 0xcd, 0x55, 0x55, 0xac, 0x41, 0x9b, 0xae, 0xd3, 0xfd,
-	0x0c, 0x1c, 0x93, 0x1a, 0xaa, 0x89, 0x07, 0x5a, 0xb9, 0x90, 0x0a, 0x86, 0x91, 0xa7, 0xd7, 0xbe,
-	0x82, 0xf5, 0x85, 0xa6, 0x82, 0xb3, 0x31, 0x94, 0x47, 0x3c, 0xf6, 0x45, 0x54, 0xbd, 0x9c, 0x1a,
-	0x88, 0x91, 0x64, 0xcd, 0xda, 0xb3, 0x76, 0xb1, 0x90, 0x64, 0xc6, 0x2b, 0xa6, 0xbd, 0xf0, 0x8a,
-	0xf9, 0x77, 0x0b, 0x06, 0xe6, 0x02, 0x7c, 0x4b, 0x7d, 0x9c, 0x65, 0x47, 0x49, 0xa0, 0x6e, 0xb3,
-	0xed, 0x95, 0x22, 0xa6, 0x3e, 0x3e, 0x46, 0x5c, 0x4a, 0x9d, 0x81, 0x95, 0xac, 0x75, 0x63, 0x3f,
-	0x49, 0xcb, 0x8f, 0x86, 0x4a, 0xd6, 0xba, 0x53, 0x71, 0x25, 0x22, 0x3d, 0x6a, 0x2a, 0x19, 0x77,
-	0x7b, 0x2a, 0xa4, 0xc4, 0x34, 0x51, 0x1d, 0xb2, 0x14, 0x71, 0x95, 0xc7, 0xaf, 0x8f, 0x78, 0x21,
-	0x85, 0x7e, 0xbb, 0xa9, 0x64, 0xa4, 0x05, 0x3f, 0x6e, 0x78, 0x96, 0x14, 0x71, 0xf9, 0x4e, 0x63,
-	0x20, 0xee, 0x35, 0x7c, 0xf0, 0xac, 0xc8, 0xa6, 0x82, 0x92, 0xb8, 0xfc, 0x56, 0xda, 0x86, 0x6e,
-	0x18, 0x73, 0x3f, 0x0f, 0xaf, 0x84, 0x66, 0xb2, 0x92, 0x31, 0x7f, 0xf3, 0x70, 0x26, 0xf4, 0x4b,
-	0x1d, 0x3d, 0xa3, 0xfd, 0x79, 0x18, 0x09, 0xca, 0x6b, 0x7d, 0xa4, 0x52, 0xa6, 0x12, 0x55, 0xd3,
-	0x55, 0x7f, 0x09, 0x29, 0xc9, 0xfd, 0x97, 0x05, 0xdb, 0x67, 0xa9, 0xc8, 0x78, 0x2e, 0xd4, 0xd7,
-	0xd7, 0xd8, 0xbf, 0x10, 0x33, 0x5e, 0x86, 0x70, 0x0f, 0x9a, 0x49, 0x4a, 0x9b, 0xeb, 0x7c, 0x57,
-	0xea, 0xb3, 0xd4, 0x6b, 0x26, 0x29, 0x05, 0xc1, 0xe5, 0xa5, 0xe6, 0x96, 0x9e, 0x57, 0x7e, 0x8a,
-	0x6d, 0x43, 0x37, 0xe0, 0x39, 0x9f, 0x70, 0x29, 0x4a, 0x4e, 0x4b, 0x99, 0xbe, 0x5a, 0xf8, 0x24,
-	0x2a, 0x19, 0x55, 0x02, 0x79, 0xa2, 0xdd, 0x34, 0x9b, 0x5a, 0x42, 0xeb, 0xf3, 0xa8, 0x90, 0x17,
-	0x44, 0x63, 0xd7, 0x53, 0x02, 0xc6, 0x52, 0xe5, 0x7c, 0x57, 0xa5, 0xb8, 0x9b, 0xc3, 0xfa, 0x97,
-	0x0f, 0x75, 0xda, 0x3e, 0x15, 0x39, 0x67, 0xdb, 0xc6, 0x71, 0x00, 0x8f, 0x83, 0x1a, 0x7d, 0x98,
-	0x77, 0x56, 0x7f, 0xd9, 0x32, 0x6c, 0xa3, 0x65, 0x94, 0x0c, 0xb4, 0x28, 0x45, 0xe9, 0xd9, 0xfd,
-	0x04, 0xb6, 0x34, 0xa3, 0x5f, 0x3e, 0xc4, 0x5d, 0x57, 0x72, 0xa9, 0xd4, 0x6a, 0x7b, 0xf7, 0x2f,
-	0x16, 0xdc, 0xbd, 0xb1, 0xec, 0xbd, 0x3f, 0x4a, 0x3f, 0x85, 0x16, 0x7e, 0xc8, 0x0c, 0x6d, 0x2a,
-	0xad, 0xfb, 0xb8, 0xc7, 0x52, 0x97, 0xfb, 0x28, 0x3c, 0x8e, 0xf3, 0x6c, 0xee, 0xd1, 0x82, 0xed,
-	0x9f, 0x41, 0xaf, 0x82, 0xd0, 0xef, 0xa5, 0x98, 0x97, 0xdd, 0xf3, 0x52, 0xcc, 0x71, 0xb6, 0x5f,
-	0xf1, 0xa8, 0x50, 0xd4, 0xe8, 0x01, 0xb9, 0x40, 0xac, 0xa7, 0xf4, 0x9f, 0x35, 0x7f, 0x68, 0xb9,
-	0xbf, 0x86, 0xe1, 0x13, 0x1e, 0x07, 0x91, 0xce, 0x27, 0x55, 0xd4, 0x9a, 0x82, 0x6f, 0x19, 0x14,
-	0xf4, 0xd1, 0x0b, 0x69, 0xdf, 0x92, 0x4d, 0xf7, 0xa0, 0x37, 0x29, 0xc7, 0x99, 0x26, 0xbe, 0x06,
-	0xe8, 0xce, 0x5f, 0x45, 0x52, 0x7f, 0x40, 0xd1, 0xb3, 0x7b, 0x17, 0x36, 0x4f, 0x44, 0xae, 0xf6,
-	0x3e, 0x3a, 0x9f, 0xea, 0x9d, 0xdd, 0x5d, 0xd8, 0x5a, 0x84, 0x35, 0xb9, 0x0e, 0xd8, 0xfe, 0x79,
-	0x35, 0x2a, 0xfc, 0xf3, 0xe9, 0xde, 0xaf, 0xa0, 0xa3, 0xb2, 0x82, 0xad, 0x43, 0xef, 0x8b, 0xf8,
-	0x8a, 0x47, 0x61, 0x70, 0x96, 0x3a, 0x0d, 0xd6, 0x85, 0xd6, 0x38, 0x4f, 0x52, 0xc7, 0x62, 0x3d,
-	0x68, 0x3f, 0xc3, 0xb2, 0x76, 0x9a, 0x0c, 0xa0, 0x83, 0x9d, 0x6f, 0x26, 0x1c, 0x1b, 0xe1, 0x71,
-	0xce, 0xb3, 0xdc, 0x69, 0x21, 0xfc, 0x32, 0x0d, 0x78, 0x2e, 0x9c, 0x36, 0xdb, 0x00, 0xf8, 0x69,
-	0x91, 0x27, 0xda, 0xac, 0xb3, 0xf7, 0x1b, 0x32, 0x9b, 0xe2, 0xde, 0x03, 0xed, 0x9f, 0x64, 0xa7,
-	0xc1, 0xd6, 0xc0, 0xfe, 0x85, 0xb8, 0x76, 0x2c, 0xd6, 0x87, 0x35, 0xaf, 0x88, 0xf1, 0x53, 0x5b,
-	0xed, 0x41, 0xdb, 0x05, 0x8e, 0x8d, 0x0a, 0x0c, 0x22, 0x15, 0x81, 0xd3, 0x62, 0x03, 0xe8, 0x7e,
-	0xae, 0xbf, 0x9d, 0x9d, 0x36, 0xaa, 0xd0, 0x0c, 0xd7, 0x74, 0x50, 0x45, 0x1b, 0xa2, 0xb4, 0x86,
-	0x12, 0xad, 0x42, 0xa9, 0xbb, 0x77, 0x06, 0xdd, 0x72, 0x6c, 0xb1, 0x3b, 0xd0, 0xd7, 0x31, 0x20,
-	0xe4, 0x34, 0xf0, 0x10, 0x34, 0x9c, 0x1c, 0x0b, 0x0f, 0x8c, 0x03, 0xc8, 0x69, 0xe2, 0x13, 0x4e,
-	0x19, 0xc7, 0x26, 0x12, 0xe6, 0xb1, 0xef, 0xb4, 0xd0, 0x90, 0xba, 0x95, 0x13, 0xec, 0x3d, 0x85,
-	0x35, 0x7a, 0x3c, 0xc3, 0x4b, 0xdc, 0xd0, 0xfe, 0x34, 0xe2, 0x34, 0x90, 0x47, 0xdc, 0x5d, 0x59,
-	0x5b, 0xc8, 0x07, 0x1d, 0x47, 0xc9, 0x4d, 0x0c, 0x41, 0x71, 0xa3, 0x00, 0x1b, 0xe3, 0x2b, 0xdb,
-	0x0c, 0xdb, 0x84, 0x3b, 0x25, 0x47, 0x1a, 0x52, 0x0e, 0x4f, 0x44, 0xae, 0x00, 0xc7, 0x22, 0xff,
-	0x95, 0xd8, 0x44, 0x5a, 0x3d, 0x31, 0x4b, 0xae, 0x84, 0x46, 0xec, 0xbd, 0x47, 0xd0, 0x2d, 0x6b,
-	0xcd, 0x70, 0x58, 0x42, 0x95, 0x43, 0x05, 0x38, 0x56, 0xed, 0x41, 0x23, 0xcd, 0xbd, 0x47, 0x34,
-	0x63, 0x30, 0x55, 0x8d, 0x13, 0x6a, 0x44, 0xa7, 0xc6, 0x65, 0x98, 0xea, 0x8b, 0x13, 0x69, 0xc4,
-	0xfd, 0x2a, 0x39, 0xae, 0x44, 0x96, 0x3b, 0xf6, 0xc1, 0x57, 0x36, 0x74, 0x54, 0xfa, 0xb1, 0x47,
-	0xd0, 0x37, 0x7e, 0x3e, 0xb1, 0x0f, 0xb1, 0x10, 0x6e, 0xff, 0x2a, 0xdb, 0xfe, 0xc6, 0x2d, 0x5c,
-	0xe5, 0xac, 0xdb, 0x60, 0x3f, 0x01, 0xa8, 0xc7, 0x05, 0xbb, 0x4b, 0x33, 0xf4, 0xe6, 0xf8, 0xd8,
-	0x1e, 0xd2, 0x8b, 0xc6, 0x92, 0x1f, 0x6b, 0x6e, 0x83, 0xfd, 0x1c, 0xd6, 0x75, 0x67, 0x50, 0x24,
-	0xb1, 0x91, 0xd1, 0x2c, 0x96, 0x0c, 0x82, 0xb7, 0x3a, 0xfb, 0xbc, 0x72, 0xa6, 0xf8, 0x62, 0xc3,
-	0x25, 0x9d, 0x47, 0xb9, 0xf9, 0xe6, 0xca, 0x9e, 0xe4, 0x36, 0xd8, 0x09, 0xf4, 0x55, 0xe7, 0x50,
-	0x73, 0xfd, 0x1e, 0xda, 0xae, 0x6a, 0x25, 0x6f, 0x0d, 0xe8, 0x08, 0x06, 0x66, 0xb1, 0x33, 0x62,
-	0x72, 0x49, 0x57, 0x50, 0x4e, 0x96, 0xf5, 0x05, 0xb7, 0x71, 0x38, 0xfc, 0xdb, 0xeb, 0x91, 0xf5,
-	0xf5, 0xeb, 0x91, 0xf5, 0xef, 0xd7, 0x23, 0xeb, 0x77, 0x6f, 0x46, 0x8d, 0xaf, 0xdf, 0x8c, 0x1a,
-	0xff, 0x7c, 0x33, 0x6a, 0x4c, 0x3a, 0xf4, 0x93, 0xf3, 0xfb, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0x02, 0x25, 0x21, 0xbf, 0xf6, 0x14, 0x00, 0x00,
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// WorkerClient is the client API for Worker service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WorkerClient interface {
-	QueryStatus(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error)
-	// PurgeRelay purges relay log files for this dm-worker
-	PurgeRelay(ctx context.Context, in *PurgeRelayRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
-	// Operate (get/set/remove) schema for a specified upstream table.
-	// a `set`/`remove` operation should be an one-time operation (only take effect once),
-	// so we use a gRPC method rather than a etcd operation now (no persistent operation state).
-	OperateSchema(ctx context.Context, in *OperateWorkerSchemaRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
-	OperateV1Meta(ctx context.Context, in *OperateV1MetaRequest, opts ...grpc.CallOption) (*OperateV1MetaResponse, error)
-	HandleError(ctx context.Context, in *HandleWorkerErrorRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
-	GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest, opts ...grpc.CallOption) (*GetWorkerCfgResponse, error)
-}
-
-type workerClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
-	return &workerClient{cc}
+	return false
 }
 
-func (c *workerClient) QueryStatus(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error) {
-	out := new(QueryStatusResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/QueryStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PurgeRelayRequest) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	return out, nil
+	return 0
 }
 
-func (c *workerClient) PurgeRelay(ctx context.Context, in *PurgeRelayRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
-	out := new(CommonWorkerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/PurgeRelay", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PurgeRelayRequest) GetFilename() string {
+	if m != nil {
+		return m.Filename
 	}
-	return out, nil
+	return ""
 }
 
-func (c *workerClient) OperateSchema(ctx context.Context, in *OperateWorkerSchemaRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
-	out := new(CommonWorkerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/OperateSchema", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PurgeRelayRequest) GetSubDir() string {
+	if m != nil {
+		return m.SubDir
 	}
-	return out, nil
+	return ""
 }
 
-func (c *workerClient) OperateV1Meta(ctx context.Context, in *OperateV1MetaRequest, opts ...grpc.CallOption) (*OperateV1MetaResponse, error) {
-	out := new(OperateV1MetaResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/OperateV1Meta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PurgeRelayRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return out, nil
+	return false
 }
 
-func (c *workerClient) HandleError(ctx context.Context, in *HandleWorkerErrorRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
-	out := new(CommonWorkerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/HandleError", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *workerClient) GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest, opts ...grpc.CallOption) (*GetWorkerCfgResponse, error) {
-	out := new(GetWorkerCfgResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/GetWorkerCfg", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// WorkerServer is the server API for Worker service.
-type WorkerServer interface {
-	QueryStatus(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
-	// PurgeRelay purges relay log files for this dm-worker
-	PurgeRelay(context.Context, *PurgeRelayRequest) (*CommonWorkerResponse, error)
-	// Operate (get/set/remove) schema for a specified upstream table.
-	// a `set`/`remove` operation should be an one-time operation (only take effect once),
-	// so we use a gRPC method rather than a etcd operation now (no persistent operation state).
-	OperateSchema(context.Context, *OperateWorkerSchemaRequest) (*CommonWorkerResponse, error)
-	OperateV1Meta(context.Context, *OperateV1MetaRequest) (*OperateV1MetaResponse, error)
-	HandleError(context.Context, *HandleWorkerErrorRequest) (*CommonWorkerResponse, error)
-	GetWorkerCfg(context.Context, *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error)
-}
-
-// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
-type UnimplementedWorkerServer struct {
+type OperateWorkerSchemaRequest struct {
+	Op       SchemaOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.SchemaOp" json:"op,omitempty"`
+	Task     string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Source   string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Database string   `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	Table    string   `protobuf:"bytes,5,opt,name=table,proto3" json:"table,omitempty"`
+	Schema   string   `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+	Flush    bool     `protobuf:"varint,7,opt,name=flush,proto3" json:"flush,omitempty"`
+	Sync     bool     `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
 }
 
-func (*UnimplementedWorkerServer) QueryStatus(ctx context.Context, req *QueryStatusRequest) (*QueryStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryStatus not implemented")
+func (m *OperateWorkerSchemaRequest) Reset()         { *m = OperateWorkerSchemaRequest{} }
+func (m *OperateWorkerSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateWorkerSchemaRequest) ProtoMessage()    {}
+func (*OperateWorkerSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{31}
 }
-func (*UnimplementedWorkerServer) PurgeRelay(ctx context.Context, req *PurgeRelayRequest) (*CommonWorkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurgeRelay not implemented")
+func (m *OperateWorkerSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedWorkerServer) OperateSchema(ctx context.Context, req *OperateWorkerSchemaRequest) (*CommonWorkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateSchema not implemented")
+func (m *OperateWorkerSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperateWorkerSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedWorkerServer) OperateV1Meta(ctx context.Context, req *OperateV1MetaRequest) (*OperateV1MetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateV1Meta not implemented")
+func (m *OperateWorkerSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateWorkerSchemaRequest.Merge(m, src)
 }
-func (*UnimplementedWorkerServer) HandleError(ctx context.Context, req *HandleWorkerErrorRequest) (*CommonWorkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleError not implemented")
+func (m *OperateWorkerSchemaRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedWorkerServer) GetWorkerCfg(ctx context.Context, req *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerCfg not implemented")
+func (m *OperateWorkerSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateWorkerSchemaRequest.DiscardUnknown(m)
 }
 
-func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
-	s.RegisterService(&_Worker_serviceDesc, srv)
-}
+var xxx_messageInfo_OperateWorkerSchemaRequest proto.InternalMessageInfo
 
-func _Worker_QueryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).QueryStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/QueryStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).QueryStatus(ctx, req.(*QueryStatusRequest))
+func (m *OperateWorkerSchemaRequest) GetOp() SchemaOp {
+	if m != nil {
+		return m.Op
 	}
-	return interceptor(ctx, in, info, handler)
+	return SchemaOp_InvalidSchemaOp
 }
 
-func _Worker_PurgeRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurgeRelayRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).PurgeRelay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/PurgeRelay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).PurgeRelay(ctx, req.(*PurgeRelayRequest))
+func (m *OperateWorkerSchemaRequest) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Worker_OperateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateWorkerSchemaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).OperateSchema(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/OperateSchema",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).OperateSchema(ctx, req.(*OperateWorkerSchemaRequest))
+func (m *OperateWorkerSchemaRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Worker_OperateV1Meta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperateV1MetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).OperateV1Meta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/OperateV1Meta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).OperateV1Meta(ctx, req.(*OperateV1MetaRequest))
+func (m *OperateWorkerSchemaRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Worker_HandleError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HandleWorkerErrorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).HandleError(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/HandleError",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).HandleError(ctx, req.(*HandleWorkerErrorRequest))
+func (m *OperateWorkerSchemaRequest) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Worker_GetWorkerCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkerCfgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WorkerServer).GetWorkerCfg(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Worker/GetWorkerCfg",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).GetWorkerCfg(ctx, req.(*GetWorkerCfgRequest))
+func (m *OperateWorkerSchemaRequest) GetSchema() string {
+	if m != nil {
+		return m.Schema
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Worker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Worker",
-	HandlerType: (*WorkerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryStatus",
-			Handler:    _Worker_QueryStatus_Handler,
-		},
-		{
-			MethodName: "PurgeRelay",
-			Handler:    _Worker_PurgeRelay_Handler,
-		},
-		{
-			MethodName: "OperateSchema",
-			Handler:    _Worker_OperateSchema_Handler,
-		},
-		{
-			MethodName: "OperateV1Meta",
-			Handler:    _Worker_OperateV1Meta_Handler,
-		},
-		{
-			MethodName: "HandleError",
-			Handler:    _Worker_HandleError_Handler,
-		},
-		{
-			MethodName: "GetWorkerCfg",
-			Handler:    _Worker_GetWorkerCfg_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dmworker.proto",
+func (m *OperateWorkerSchemaRequest) GetFlush() bool {
+	if m != nil {
+		return m.Flush
+	}
+	return false
 }
 
-func (m *QueryStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperateWorkerSchemaRequest) GetSync() bool {
+	if m != nil {
+		return m.Sync
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// copied `TaskMeta` from release-1.0 branch.
+type V1SubTaskMeta struct {
+	Op    TaskOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskOp" json:"op,omitempty"`
+	Stage Stage  `protobuf:"varint,2,opt,name=stage,proto3,enum=pb.Stage" json:"stage,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Task  []byte `protobuf:"bytes,4,opt,name=task,proto3" json:"task,omitempty"`
 }
 
-func (m *QueryStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *V1SubTaskMeta) Reset()         { *m = V1SubTaskMeta{} }
+func (m *V1SubTaskMeta) String() string { return proto.CompactTextString(m) }
+func (*V1SubTaskMeta) ProtoMessage()    {}
+func (*V1SubTaskMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{32}
 }
-
-func (m *CommonWorkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *V1SubTaskMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *V1SubTaskMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_V1SubTaskMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *V1SubTaskMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_V1SubTaskMeta.Merge(m, src)
+}
+func (m *V1SubTaskMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *V1SubTaskMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_V1SubTaskMeta.DiscardUnknown(m)
 }
 
-func (m *CommonWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_V1SubTaskMeta proto.InternalMessageInfo
+
+func (m *V1SubTaskMeta) GetOp() TaskOp {
+	if m != nil {
+		return m.Op
+	}
+	return TaskOp_InvalidOp
 }
 
-func (m *CommonWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Worker) > 0 {
-		i -= len(m.Worker)
-		copy(dAtA[i:], m.Worker)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
-		i--
-		dAtA[i] = 0x22
+func (m *V1SubTaskMeta) GetStage() Stage {
+	if m != nil {
+		return m.Stage
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
+	return Stage_InvalidStage
+}
+
+func (m *V1SubTaskMeta) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *V1SubTaskMeta) GetTask() []byte {
+	if m != nil {
+		return m.Task
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+type OperateV1MetaRequest struct {
+	Op V1MetaOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.V1MetaOp" json:"op,omitempty"`
+}
+
+func (m *OperateV1MetaRequest) Reset()         { *m = OperateV1MetaRequest{} }
+func (m *OperateV1MetaRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateV1MetaRequest) ProtoMessage()    {}
+func (*OperateV1MetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{33}
+}
+func (m *OperateV1MetaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperateV1MetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperateV1MetaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OperateV1MetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateV1MetaRequest.Merge(m, src)
+}
+func (m *OperateV1MetaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperateV1MetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateV1MetaRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OperateV1MetaRequest proto.InternalMessageInfo
+
+func (m *OperateV1MetaRequest) GetOp() V1MetaOp {
+	if m != nil {
+		return m.Op
 	}
-	return dAtA[:n], nil
+	return V1MetaOp_InvalidV1MetaOp
 }
 
-func (m *QueryStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OperateV1MetaResponse struct {
+	Result bool                      `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg    string                    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Meta   map[string]*V1SubTaskMeta `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *QueryStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SubTaskStatus) > 0 {
-		for iNdEx := len(m.SubTaskStatus) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SubTaskStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.SourceStatus != nil {
-		{
-			size, err := m.SourceStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
+func (m *OperateV1MetaResponse) Reset()         { *m = OperateV1MetaResponse{} }
+func (m *OperateV1MetaResponse) String() string { return proto.CompactTextString(m) }
+func (*OperateV1MetaResponse) ProtoMessage()    {}
+func (*OperateV1MetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{34}
+}
+func (m *OperateV1MetaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperateV1MetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperateV1MetaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *OperateV1MetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateV1MetaResponse.Merge(m, src)
+}
+func (m *OperateV1MetaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperateV1MetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateV1MetaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateV1MetaResponse proto.InternalMessageInfo
+
+func (m *OperateV1MetaResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	if m.Result {
-		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *OperateV1MetaResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CheckStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OperateV1MetaResponse) GetMeta() map[string]*V1SubTaskMeta {
+	if m != nil {
+		return m.Meta
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CheckStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type HandleWorkerErrorRequest struct {
+	Op         ErrorOp  `protobuf:"varint,1,opt,name=op,proto3,enum=pb.ErrorOp" json:"op,omitempty"`
+	Task       string   `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	BinlogPos  string   `protobuf:"bytes,3,opt,name=binlogPos,proto3" json:"binlogPos,omitempty"`
+	Sqls       []string `protobuf:"bytes,4,rep,name=sqls,proto3" json:"sqls,omitempty"`
+	BinlogGTID string   `protobuf:"bytes,5,opt,name=binlogGTID,proto3" json:"binlogGTID,omitempty"`
 }
 
-func (m *CheckStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Warning != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Warning))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Failed != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Failed))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Successful != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Successful))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Total != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Passed {
-		i--
-		if m.Passed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *HandleWorkerErrorRequest) Reset()         { *m = HandleWorkerErrorRequest{} }
+func (m *HandleWorkerErrorRequest) String() string { return proto.CompactTextString(m) }
+func (*HandleWorkerErrorRequest) ProtoMessage()    {}
+func (*HandleWorkerErrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{35}
+}
+func (m *HandleWorkerErrorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandleWorkerErrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandleWorkerErrorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DumpStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *HandleWorkerErrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandleWorkerErrorRequest.Merge(m, src)
 }
-
-func (m *DumpStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HandleWorkerErrorRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *DumpStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *HandleWorkerErrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandleWorkerErrorRequest.DiscardUnknown(m)
 }
 
-func (m *LoadStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HandleWorkerErrorRequest proto.InternalMessageInfo
+
+func (m *HandleWorkerErrorRequest) GetOp() ErrorOp {
+	if m != nil {
+		return m.Op
 	}
-	return dAtA[:n], nil
+	return ErrorOp_InvalidErrorOp
 }
 
-func (m *LoadStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *HandleWorkerErrorRequest) GetTask() string {
+	if m != nil {
+		return m.Task
+	}
+	return ""
 }
 
-func (m *LoadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetaBinlogGTID) > 0 {
-		i -= len(m.MetaBinlogGTID)
-		copy(dAtA[i:], m.MetaBinlogGTID)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlogGTID)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.MetaBinlog) > 0 {
-		i -= len(m.MetaBinlog)
-		copy(dAtA[i:], m.MetaBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlog)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Progress) > 0 {
-		i -= len(m.Progress)
-		copy(dAtA[i:], m.Progress)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Progress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TotalBytes != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalBytes))
-		i--
-		dAtA[i] = 0x10
+func (m *HandleWorkerErrorRequest) GetBinlogPos() string {
+	if m != nil {
+		return m.BinlogPos
 	}
-	if m.FinishedBytes != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedBytes))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *HandleWorkerErrorRequest) GetSqls() []string {
+	if m != nil {
+		return m.Sqls
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ShardingGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HandleWorkerErrorRequest) GetBinlogGTID() string {
+	if m != nil {
+		return m.BinlogGTID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ShardingGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetWorkerCfgRequest struct {
 }
 
-func (m *ShardingGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Unsynced) > 0 {
-		for iNdEx := len(m.Unsynced) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Unsynced[iNdEx])
-			copy(dAtA[i:], m.Unsynced[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Unsynced[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Synced) > 0 {
-		for iNdEx := len(m.Synced) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Synced[iNdEx])
-			copy(dAtA[i:], m.Synced[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Synced[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.FirstLocation) > 0 {
-		i -= len(m.FirstLocation)
-		copy(dAtA[i:], m.FirstLocation)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.FirstLocation)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DDLs) > 0 {
-		for iNdEx := len(m.DDLs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DDLs[iNdEx])
-			copy(dAtA[i:], m.DDLs[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.DDLs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *GetWorkerCfgRequest) Reset()         { *m = GetWorkerCfgRequest{} }
+func (m *GetWorkerCfgRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWorkerCfgRequest) ProtoMessage()    {}
+func (*GetWorkerCfgRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{36}
+}
+func (m *GetWorkerCfgRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerCfgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerCfgRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GetWorkerCfgRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerCfgRequest.Merge(m, src)
+}
+func (m *GetWorkerCfgRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerCfgRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerCfgRequest.DiscardUnknown(m)
 }
 
-func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_GetWorkerCfgRequest proto.InternalMessageInfo
+
+type GetWorkerCfgResponse struct {
+	Cfg string `protobuf:"bytes,1,opt,name=cfg,proto3" json:"cfg,omitempty"`
 }
 
-func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SecondsBehindMaster != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.SecondsBehindMaster))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.BinlogType) > 0 {
-		i -= len(m.BinlogType)
-		copy(dAtA[i:], m.BinlogType)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogType)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Synced {
-		i--
-		if m.Synced {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.UnresolvedGroups) > 0 {
-		for iNdEx := len(m.UnresolvedGroups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnresolvedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.BlockingDDLs) > 0 {
-		for iNdEx := len(m.BlockingDDLs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BlockingDDLs[iNdEx])
-			copy(dAtA[i:], m.BlockingDDLs[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.BlockingDDLs[iNdEx])))
-			i--
-			dAtA[i] = 0x42
+func (m *GetWorkerCfgResponse) Reset()         { *m = GetWorkerCfgResponse{} }
+func (m *GetWorkerCfgResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWorkerCfgResponse) ProtoMessage()    {}
+func (*GetWorkerCfgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{37}
+}
+func (m *GetWorkerCfgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerCfgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerCfgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.SyncerBinlogGtid) > 0 {
-		i -= len(m.SyncerBinlogGtid)
-		copy(dAtA[i:], m.SyncerBinlogGtid)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlogGtid)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.SyncerBinlog) > 0 {
-		i -= len(m.SyncerBinlog)
-		copy(dAtA[i:], m.SyncerBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlog)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.MasterBinlogGtid) > 0 {
-		i -= len(m.MasterBinlogGtid)
-		copy(dAtA[i:], m.MasterBinlogGtid)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlogGtid)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.MasterBinlog) > 0 {
-		i -= len(m.MasterBinlog)
-		copy(dAtA[i:], m.MasterBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlog)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.RecentTps != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.RecentTps))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TotalTps != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalTps))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TotalEvents != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalEvents))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *GetWorkerCfgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerCfgResponse.Merge(m, src)
+}
+func (m *GetWorkerCfgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerCfgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerCfgResponse.DiscardUnknown(m)
 }
 
-func (m *SourceStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetWorkerCfgResponse proto.InternalMessageInfo
+
+func (m *GetWorkerCfgResponse) GetCfg() string {
+	if m != nil {
+		return m.Cfg
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SourceStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CleanOrphanSubTasksRequest struct {
 }
 
-func (m *SourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RelayStatus != nil {
-		{
-			size, err := m.RelayStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
+func (m *CleanOrphanSubTasksRequest) Reset()         { *m = CleanOrphanSubTasksRequest{} }
+func (m *CleanOrphanSubTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*CleanOrphanSubTasksRequest) ProtoMessage()    {}
+func (*CleanOrphanSubTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{38}
+}
+func (m *CleanOrphanSubTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanOrphanSubTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanOrphanSubTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
+}
+func (m *CleanOrphanSubTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanOrphanSubTasksRequest.Merge(m, src)
+}
+func (m *CleanOrphanSubTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanOrphanSubTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanOrphanSubTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanOrphanSubTasksRequest proto.InternalMessageInfo
+
+type CleanOrphanSubTasksResponse struct {
+	Result   bool     `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg      string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Source   string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	SubTasks []string `protobuf:"bytes,4,rep,name=subTasks,proto3" json:"subTasks,omitempty"`
+}
+
+func (m *CleanOrphanSubTasksResponse) Reset()         { *m = CleanOrphanSubTasksResponse{} }
+func (m *CleanOrphanSubTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*CleanOrphanSubTasksResponse) ProtoMessage()    {}
+func (*CleanOrphanSubTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{39}
+}
+func (m *CleanOrphanSubTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanOrphanSubTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanOrphanSubTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Worker) > 0 {
-		i -= len(m.Worker)
-		copy(dAtA[i:], m.Worker)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *CleanOrphanSubTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanOrphanSubTasksResponse.Merge(m, src)
+}
+func (m *CleanOrphanSubTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanOrphanSubTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanOrphanSubTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanOrphanSubTasksResponse proto.InternalMessageInfo
+
+func (m *CleanOrphanSubTasksResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *CleanOrphanSubTasksResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RelayStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CleanOrphanSubTasksResponse) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RelayStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CleanOrphanSubTasksResponse) GetSubTasks() []string {
+	if m != nil {
+		return m.SubTasks
+	}
+	return nil
 }
 
-func (m *RelayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
+type ValidateConnectivityRequest struct {
+	SubTaskCfg string `protobuf:"bytes,1,opt,name=subTaskCfg,proto3" json:"subTaskCfg,omitempty"`
+}
+
+func (m *ValidateConnectivityRequest) Reset()         { *m = ValidateConnectivityRequest{} }
+func (m *ValidateConnectivityRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateConnectivityRequest) ProtoMessage()    {}
+func (*ValidateConnectivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{40}
+}
+func (m *ValidateConnectivityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateConnectivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateConnectivityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x42
+		return b[:n], nil
 	}
-	if m.Stage != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *ValidateConnectivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateConnectivityRequest.Merge(m, src)
+}
+func (m *ValidateConnectivityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateConnectivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateConnectivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateConnectivityRequest proto.InternalMessageInfo
+
+func (m *ValidateConnectivityRequest) GetSubTaskCfg() string {
+	if m != nil {
+		return m.SubTaskCfg
 	}
-	if m.RelayCatchUpMaster {
-		i--
-		if m.RelayCatchUpMaster {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.RelayBinlogGtid) > 0 {
-		i -= len(m.RelayBinlogGtid)
-		copy(dAtA[i:], m.RelayBinlogGtid)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelayBinlogGtid)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.RelayBinlog) > 0 {
-		i -= len(m.RelayBinlog)
-		copy(dAtA[i:], m.RelayBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelayBinlog)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RelaySubDir) > 0 {
-		i -= len(m.RelaySubDir)
-		copy(dAtA[i:], m.RelaySubDir)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelaySubDir)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MasterBinlogGtid) > 0 {
-		i -= len(m.MasterBinlogGtid)
-		copy(dAtA[i:], m.MasterBinlogGtid)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlogGtid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MasterBinlog) > 0 {
-		i -= len(m.MasterBinlog)
-		copy(dAtA[i:], m.MasterBinlog)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlog)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SubTaskStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EndpointConnectivity is the result of validating an upstream or downstream database.
+type EndpointConnectivity struct {
+	Role       string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Connected  bool   `protobuf:"varint,3,opt,name=connected,proto3" json:"connected,omitempty"`
+	Privileged bool   `protobuf:"varint,4,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	Msg        string `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (m *SubTaskStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EndpointConnectivity) Reset()         { *m = EndpointConnectivity{} }
+func (m *EndpointConnectivity) String() string { return proto.CompactTextString(m) }
+func (*EndpointConnectivity) ProtoMessage()    {}
+func (*EndpointConnectivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{41}
 }
-
-func (m *SubTaskStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != nil {
-		{
-			size := m.Status.Size()
-			i -= size
-			if _, err := m.Status.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *EndpointConnectivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndpointConnectivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndpointConnectivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.UnresolvedDDLLockID) > 0 {
-		i -= len(m.UnresolvedDDLLockID)
-		copy(dAtA[i:], m.UnresolvedDDLLockID)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.UnresolvedDDLLockID)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *EndpointConnectivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndpointConnectivity.Merge(m, src)
+}
+func (m *EndpointConnectivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndpointConnectivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndpointConnectivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndpointConnectivity proto.InternalMessageInfo
+
+func (m *EndpointConnectivity) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	if m.Result != nil {
-		{
-			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *EndpointConnectivity) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.Unit != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Unit))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *EndpointConnectivity) GetConnected() bool {
+	if m != nil {
+		return m.Connected
 	}
-	if m.Stage != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
-		i--
-		dAtA[i] = 0x10
+	return false
+}
+
+func (m *EndpointConnectivity) GetPrivileged() bool {
+	if m != nil {
+		return m.Privileged
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *EndpointConnectivity) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SubTaskStatus_Msg) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidateConnectivityResponse struct {
+	Result    bool                    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	Msg       string                  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Source    string                  `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Worker    string                  `protobuf:"bytes,4,opt,name=worker,proto3" json:"worker,omitempty"`
+	Endpoints []*EndpointConnectivity `protobuf:"bytes,5,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
 }
 
-func (m *SubTaskStatus_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Msg)
-	copy(dAtA[i:], m.Msg)
-	i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-	i--
-	dAtA[i] = 0x32
-	return len(dAtA) - i, nil
+func (m *ValidateConnectivityResponse) Reset()         { *m = ValidateConnectivityResponse{} }
+func (m *ValidateConnectivityResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateConnectivityResponse) ProtoMessage()    {}
+func (*ValidateConnectivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{42}
 }
-func (m *SubTaskStatus_Check) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidateConnectivityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *SubTaskStatus_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Check != nil {
-		{
-			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
+func (m *ValidateConnectivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateConnectivityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *SubTaskStatus_Dump) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidateConnectivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateConnectivityResponse.Merge(m, src)
+}
+func (m *ValidateConnectivityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateConnectivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateConnectivityResponse.DiscardUnknown(m)
 }
 
-func (m *SubTaskStatus_Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Dump != nil {
-		{
-			size, err := m.Dump.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+var xxx_messageInfo_ValidateConnectivityResponse proto.InternalMessageInfo
+
+func (m *ValidateConnectivityResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
 	}
-	return len(dAtA) - i, nil
-}
-func (m *SubTaskStatus_Load) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return false
 }
 
-func (m *SubTaskStatus_Load) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Load != nil {
-		{
-			size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+func (m *ValidateConnectivityResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *SubTaskStatus_Sync) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *ValidateConnectivityResponse) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (m *SubTaskStatus_Sync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Sync != nil {
-		{
-			size, err := m.Sync.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+func (m *ValidateConnectivityResponse) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *SubTaskStatusList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ValidateConnectivityResponse) GetEndpoints() []*EndpointConnectivity {
+	if m != nil {
+		return m.Endpoints
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SubTaskStatusList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuarantineWorkerTableRequest struct {
+	Op       QuarantineOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.QuarantineOp" json:"op,omitempty"`
+	Task     string       `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Source   string       `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Database string       `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	Table    string       `protobuf:"bytes,5,opt,name=table,proto3" json:"table,omitempty"`
 }
 
-func (m *SubTaskStatusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		for iNdEx := len(m.Status) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Status[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuarantineWorkerTableRequest) Reset()         { *m = QuarantineWorkerTableRequest{} }
+func (m *QuarantineWorkerTableRequest) String() string { return proto.CompactTextString(m) }
+func (*QuarantineWorkerTableRequest) ProtoMessage()    {}
+func (*QuarantineWorkerTableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{43}
+}
+func (m *QuarantineWorkerTableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantineWorkerTableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantineWorkerTableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CheckError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuarantineWorkerTableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantineWorkerTableRequest.Merge(m, src)
 }
-
-func (m *CheckError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuarantineWorkerTableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantineWorkerTableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantineWorkerTableRequest.DiscardUnknown(m)
 }
 
-func (m *CheckError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuarantineWorkerTableRequest proto.InternalMessageInfo
+
+func (m *QuarantineWorkerTableRequest) GetOp() QuarantineOp {
+	if m != nil {
+		return m.Op
 	}
-	return len(dAtA) - i, nil
+	return QuarantineOp_InvalidQuarantineOp
 }
 
-func (m *DumpError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuarantineWorkerTableRequest) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DumpError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuarantineWorkerTableRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (m *DumpError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuarantineWorkerTableRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *LoadError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuarantineWorkerTableRequest) GetTable() string {
+	if m != nil {
+		return m.Table
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LoadError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StartSubTaskRequest struct {
+	Task string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 }
 
-func (m *LoadError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
+func (m *StartSubTaskRequest) Reset()         { *m = StartSubTaskRequest{} }
+func (m *StartSubTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*StartSubTaskRequest) ProtoMessage()    {}
+func (*StartSubTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{44}
+}
+func (m *StartSubTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartSubTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartSubTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StartSubTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartSubTaskRequest.Merge(m, src)
+}
+func (m *StartSubTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartSubTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartSubTaskRequest.DiscardUnknown(m)
 }
 
-func (m *SyncSQLError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StartSubTaskRequest proto.InternalMessageInfo
+
+func (m *StartSubTaskRequest) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SyncSQLError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdateSubTaskRequest struct {
+	Task   string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	DryRun bool   `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
 }
 
-func (m *SyncSQLError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ErrorSQL) > 0 {
-		i -= len(m.ErrorSQL)
-		copy(dAtA[i:], m.ErrorSQL)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrorSQL)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FailedBinlogPosition) > 0 {
-		i -= len(m.FailedBinlogPosition)
-		copy(dAtA[i:], m.FailedBinlogPosition)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.FailedBinlogPosition)))
-		i--
-		dAtA[i] = 0x12
+func (m *UpdateSubTaskRequest) Reset()         { *m = UpdateSubTaskRequest{} }
+func (m *UpdateSubTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateSubTaskRequest) ProtoMessage()    {}
+func (*UpdateSubTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{45}
+}
+func (m *UpdateSubTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateSubTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateSubTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *UpdateSubTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSubTaskRequest.Merge(m, src)
+}
+func (m *UpdateSubTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateSubTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSubTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSubTaskRequest proto.InternalMessageInfo
+
+func (m *UpdateSubTaskRequest) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SyncError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateSubTaskRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *SyncError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OperateSubTaskRequest struct {
+	Op   TaskOp `protobuf:"varint,1,opt,name=op,proto3,enum=pb.TaskOp" json:"op,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *SyncError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Errors) > 0 {
-		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *OperateSubTaskRequest) Reset()         { *m = OperateSubTaskRequest{} }
+func (m *OperateSubTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateSubTaskRequest) ProtoMessage()    {}
+func (*OperateSubTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{46}
+}
+func (m *OperateSubTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperateSubTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperateSubTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OperateSubTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateSubTaskRequest.Merge(m, src)
+}
+func (m *OperateSubTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperateSubTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateSubTaskRequest.DiscardUnknown(m)
 }
 
-func (m *SourceError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OperateSubTaskRequest proto.InternalMessageInfo
+
+func (m *OperateSubTaskRequest) GetOp() TaskOp {
+	if m != nil {
+		return m.Op
 	}
-	return dAtA[:n], nil
+	return TaskOp_InvalidOp
 }
 
-func (m *SourceError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OperateSubTaskRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *SourceError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RelayError != nil {
-		{
-			size, err := m.RelayError.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceError) > 0 {
-		i -= len(m.SourceError)
-		copy(dAtA[i:], m.SourceError)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SourceError)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Worker) > 0 {
-		i -= len(m.Worker)
-		copy(dAtA[i:], m.Worker)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("pb.TaskOp", TaskOp_name, TaskOp_value)
+	proto.RegisterEnum("pb.Stage", Stage_name, Stage_value)
+	proto.RegisterEnum("pb.UnitType", UnitType_name, UnitType_value)
+	proto.RegisterEnum("pb.RelayOp", RelayOp_name, RelayOp_value)
+	proto.RegisterEnum("pb.SchemaOp", SchemaOp_name, SchemaOp_value)
+	proto.RegisterEnum("pb.V1MetaOp", V1MetaOp_name, V1MetaOp_value)
+	proto.RegisterEnum("pb.ErrorOp", ErrorOp_name, ErrorOp_value)
+	proto.RegisterEnum("pb.QuarantineOp", QuarantineOp_name, QuarantineOp_value)
+	proto.RegisterType((*QueryStatusRequest)(nil), "pb.QueryStatusRequest")
+	proto.RegisterType((*CommonWorkerResponse)(nil), "pb.CommonWorkerResponse")
+	proto.RegisterType((*QueryStatusResponse)(nil), "pb.QueryStatusResponse")
+	proto.RegisterType((*CheckStatus)(nil), "pb.CheckStatus")
+	proto.RegisterType((*DumpStatus)(nil), "pb.DumpStatus")
+	proto.RegisterType((*LoadStatus)(nil), "pb.LoadStatus")
+	proto.RegisterType((*ShardingGroup)(nil), "pb.ShardingGroup")
+	proto.RegisterType((*SyncStatus)(nil), "pb.SyncStatus")
+	proto.RegisterType((*Progress)(nil), "pb.Progress")
+	proto.RegisterType((*DDLJobProgress)(nil), "pb.DDLJobProgress")
+	proto.RegisterType((*SourceStatus)(nil), "pb.SourceStatus")
+	proto.RegisterType((*SourceLatencySample)(nil), "pb.SourceLatencySample")
+	proto.RegisterType((*TaskLatencySample)(nil), "pb.TaskLatencySample")
+	proto.RegisterType((*RelayStatus)(nil), "pb.RelayStatus")
+	proto.RegisterType((*RelayDiskHealth)(nil), "pb.RelayDiskHealth")
+	proto.RegisterType((*RelayGrowth)(nil), "pb.RelayGrowth")
+	proto.RegisterType((*RelaySpaceStatus)(nil), "pb.RelaySpaceStatus")
+	proto.RegisterType((*SubTaskStatus)(nil), "pb.SubTaskStatus")
+	proto.RegisterType((*SubTaskStatusList)(nil), "pb.SubTaskStatusList")
+	proto.RegisterType((*CheckError)(nil), "pb.CheckError")
+	proto.RegisterType((*DumpError)(nil), "pb.DumpError")
+	proto.RegisterType((*LoadError)(nil), "pb.LoadError")
+	proto.RegisterType((*SyncSQLError)(nil), "pb.SyncSQLError")
+	proto.RegisterType((*SyncError)(nil), "pb.SyncError")
+	proto.RegisterType((*SourceError)(nil), "pb.SourceError")
+	proto.RegisterType((*RelayError)(nil), "pb.RelayError")
+	proto.RegisterType((*SubTaskError)(nil), "pb.SubTaskError")
+	proto.RegisterType((*SubTaskErrorList)(nil), "pb.SubTaskErrorList")
+	proto.RegisterType((*ProcessResult)(nil), "pb.ProcessResult")
+	proto.RegisterType((*ProcessError)(nil), "pb.ProcessError")
+	proto.RegisterType((*PurgeRelayRequest)(nil), "pb.PurgeRelayRequest")
+	proto.RegisterType((*OperateWorkerSchemaRequest)(nil), "pb.OperateWorkerSchemaRequest")
+	proto.RegisterType((*V1SubTaskMeta)(nil), "pb.V1SubTaskMeta")
+	proto.RegisterType((*OperateV1MetaRequest)(nil), "pb.OperateV1MetaRequest")
+	proto.RegisterType((*OperateV1MetaResponse)(nil), "pb.OperateV1MetaResponse")
+	proto.RegisterMapType((map[string]*V1SubTaskMeta)(nil), "pb.OperateV1MetaResponse.MetaEntry")
+	proto.RegisterType((*HandleWorkerErrorRequest)(nil), "pb.HandleWorkerErrorRequest")
+	proto.RegisterType((*GetWorkerCfgRequest)(nil), "pb.GetWorkerCfgRequest")
+	proto.RegisterType((*GetWorkerCfgResponse)(nil), "pb.GetWorkerCfgResponse")
+	proto.RegisterType((*CleanOrphanSubTasksRequest)(nil), "pb.CleanOrphanSubTasksRequest")
+	proto.RegisterType((*CleanOrphanSubTasksResponse)(nil), "pb.CleanOrphanSubTasksResponse")
+	proto.RegisterType((*ValidateConnectivityRequest)(nil), "pb.ValidateConnectivityRequest")
+	proto.RegisterType((*EndpointConnectivity)(nil), "pb.EndpointConnectivity")
+	proto.RegisterType((*ValidateConnectivityResponse)(nil), "pb.ValidateConnectivityResponse")
+	proto.RegisterType((*QuarantineWorkerTableRequest)(nil), "pb.QuarantineWorkerTableRequest")
+	proto.RegisterType((*StartSubTaskRequest)(nil), "pb.StartSubTaskRequest")
+	proto.RegisterType((*UpdateSubTaskRequest)(nil), "pb.UpdateSubTaskRequest")
+	proto.RegisterType((*OperateSubTaskRequest)(nil), "pb.OperateSubTaskRequest")
 }
 
-func (m *RelayError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
+
+var fileDescriptor_51a1b9e17fd67b10 = []byte{
+	// 3102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcb, 0x6f, 0x24, 0x57,
+	0xd5, 0xef, 0xea, 0x97, 0xdb, 0xa7, 0xfd, 0x28, 0x5f, 0x7b, 0x92, 0x8e, 0x33, 0x9f, 0x63, 0xd5,
+	0x44, 0x89, 0xe3, 0xef, 0xd3, 0x28, 0x99, 0xcc, 0x97, 0xa0, 0x88, 0x90, 0xc4, 0xee, 0x89, 0x67,
+	0x42, 0x0f, 0x9e, 0xa9, 0x9e, 0x49, 0x40, 0x2c, 0xd0, 0xed, 0xae, 0xeb, 0xee, 0x8a, 0xab, 0xab,
+	0x2a, 0xf5, 0xb0, 0xd5, 0xca, 0x82, 0x3f, 0x80, 0x05, 0x48, 0xc0, 0x02, 0x24, 0xd8, 0x21, 0x76,
+	0x88, 0x0d, 0x02, 0xb1, 0x46, 0x88, 0x65, 0xc4, 0x0a, 0x21, 0x21, 0x45, 0xc9, 0x9e, 0x7f, 0x01,
+	0x74, 0xce, 0xbd, 0x55, 0x75, 0xab, 0xdd, 0x6d, 0xcf, 0x48, 0x84, 0x5d, 0x9d, 0xdf, 0x39, 0x75,
+	0xea, 0xde, 0xf3, 0xbe, 0xb7, 0x1b, 0xd6, 0x9c, 0xc9, 0x79, 0x10, 0x9d, 0x8a, 0xe8, 0x66, 0x18,
+	0x05, 0x49, 0xc0, 0xaa, 0xe1, 0xc0, 0xda, 0x03, 0xf6, 0x30, 0x15, 0xd1, 0xb4, 0x9f, 0xf0, 0x24,
+	0x8d, 0x6d, 0xf1, 0x49, 0x2a, 0xe2, 0x84, 0x31, 0xa8, 0xfb, 0x7c, 0x22, 0x3a, 0xc6, 0xae, 0xb1,
+	0xb7, 0x6c, 0xd3, 0xb3, 0x15, 0xc2, 0xd6, 0x61, 0x30, 0x99, 0x04, 0xfe, 0x47, 0xa4, 0xc3, 0x16,
+	0x71, 0x18, 0xf8, 0xb1, 0x60, 0xcf, 0x40, 0x33, 0x12, 0x71, 0xea, 0x25, 0x24, 0xdd, 0xb2, 0x15,
+	0xc5, 0x4c, 0xa8, 0x4d, 0xe2, 0x51, 0xa7, 0x4a, 0x2a, 0xf0, 0x11, 0x25, 0xe3, 0x20, 0x8d, 0x86,
+	0xa2, 0x53, 0x23, 0x50, 0x51, 0x88, 0xcb, 0x75, 0x75, 0xea, 0x12, 0x97, 0x94, 0xf5, 0x1b, 0x03,
+	0x36, 0x4b, 0x8b, 0x7b, 0xea, 0x2f, 0xde, 0x86, 0x15, 0xf9, 0x0d, 0xa9, 0x81, 0xbe, 0xdb, 0xbe,
+	0x65, 0xde, 0x0c, 0x07, 0x37, 0xfb, 0x1a, 0x6e, 0x97, 0xa4, 0xd8, 0x9b, 0xb0, 0x1a, 0xa7, 0x83,
+	0x47, 0x3c, 0x3e, 0x55, 0xaf, 0xd5, 0x77, 0x6b, 0x7b, 0xed, 0x5b, 0x1b, 0xf4, 0x9a, 0xce, 0xb0,
+	0xcb, 0x72, 0xd6, 0xaf, 0x0c, 0x68, 0x1f, 0x8e, 0xc5, 0x50, 0xd1, 0xb8, 0xd0, 0x90, 0xc7, 0xb1,
+	0x70, 0xb2, 0x85, 0x4a, 0x8a, 0x6d, 0x41, 0x23, 0x09, 0x12, 0xee, 0xd1, 0x52, 0x1b, 0xb6, 0x24,
+	0xd8, 0x0e, 0x40, 0x9c, 0x0e, 0x87, 0x22, 0x8e, 0x4f, 0x52, 0x8f, 0x96, 0xda, 0xb0, 0x35, 0x04,
+	0xb5, 0x9d, 0x70, 0xd7, 0x13, 0x0e, 0x99, 0xa9, 0x61, 0x2b, 0x8a, 0x75, 0x60, 0xe9, 0x9c, 0x47,
+	0xbe, 0xeb, 0x8f, 0x3a, 0x0d, 0x62, 0x64, 0x24, 0xbe, 0xe1, 0x88, 0x84, 0xbb, 0x5e, 0xa7, 0xb9,
+	0x6b, 0xec, 0xad, 0xd8, 0x8a, 0xb2, 0x0e, 0x00, 0xba, 0xe9, 0x24, 0x54, 0xab, 0xbc, 0x0d, 0x6b,
+	0x61, 0x14, 0x8c, 0x22, 0x11, 0xc7, 0x5d, 0x29, 0x6d, 0x90, 0x99, 0x56, 0x70, 0xbf, 0x0f, 0x14,
+	0xc7, 0x9e, 0x91, 0xb1, 0x3e, 0xaf, 0x02, 0xf4, 0x02, 0xee, 0x28, 0x25, 0x2f, 0xc2, 0xea, 0x89,
+	0xeb, 0xbb, 0xf1, 0x58, 0x38, 0x07, 0xd3, 0x44, 0xc4, 0xa4, 0xa3, 0x66, 0x97, 0x41, 0xdc, 0x22,
+	0xed, 0x55, 0x8a, 0x54, 0x49, 0x44, 0x43, 0xd8, 0x36, 0xb4, 0xb2, 0xcf, 0xa8, 0x18, 0xc9, 0x69,
+	0x7c, 0x77, 0x22, 0x12, 0x7e, 0xe0, 0xfa, 0x5e, 0x30, 0x52, 0x91, 0xa2, 0x21, 0xec, 0x25, 0x58,
+	0x2b, 0xa8, 0xa3, 0x47, 0xf7, 0xba, 0x64, 0x8d, 0x65, 0x7b, 0x06, 0x65, 0x16, 0xac, 0x64, 0x8b,
+	0xb2, 0x83, 0xf3, 0x98, 0x4c, 0x53, 0xb3, 0x4b, 0x18, 0x46, 0xd2, 0x20, 0x8c, 0x3b, 0x4b, 0xc4,
+	0xc2, 0x47, 0xf6, 0x75, 0x78, 0x4e, 0xc4, 0x89, 0x3b, 0xe1, 0x89, 0x70, 0x6c, 0x31, 0xe1, 0x2e,
+	0x1a, 0xb8, 0x2f, 0x86, 0x81, 0xef, 0xc4, 0x9d, 0x16, 0xc9, 0x2d, 0x16, 0x98, 0x63, 0xe2, 0xe5,
+	0x27, 0x30, 0xf1, 0x4f, 0x0d, 0x58, 0xed, 0x8f, 0x79, 0xe4, 0xb8, 0xfe, 0xe8, 0x28, 0x0a, 0xd2,
+	0x10, 0x1d, 0x9a, 0xf0, 0x68, 0x24, 0x12, 0x95, 0x99, 0x8a, 0xc2, 0x7c, 0xed, 0x76, 0x7b, 0x68,
+	0xd1, 0x1a, 0xe6, 0x2b, 0x3e, 0x4b, 0x8f, 0x44, 0x71, 0xd2, 0x0b, 0x86, 0x3c, 0x71, 0x03, 0x5f,
+	0x19, 0xb4, 0x0c, 0x52, 0x4e, 0x4e, 0xfd, 0x21, 0x05, 0x55, 0x8d, 0x72, 0x92, 0x28, 0xf4, 0x44,
+	0xea, 0x2b, 0x4e, 0x83, 0x38, 0x39, 0x6d, 0xfd, 0xae, 0x01, 0xd0, 0x9f, 0xfa, 0x43, 0xe5, 0xfa,
+	0x5d, 0x68, 0x93, 0x0b, 0xef, 0x9c, 0x09, 0x3f, 0xc9, 0x1c, 0xaf, 0x43, 0xa8, 0x8c, 0xc8, 0x47,
+	0x61, 0xe6, 0xf4, 0x9c, 0x66, 0xd7, 0x61, 0x39, 0x12, 0x43, 0xe1, 0x27, 0xc8, 0xac, 0x11, 0xb3,
+	0x00, 0xd0, 0x59, 0x13, 0x1e, 0x27, 0x22, 0x2a, 0xb9, 0xbd, 0x84, 0xb1, 0x7d, 0x30, 0x75, 0xfa,
+	0x28, 0x71, 0x1d, 0xe5, 0xfa, 0x0b, 0x38, 0xea, 0xa3, 0x4d, 0x64, 0xfa, 0x9a, 0x52, 0x9f, 0x8e,
+	0xa1, 0x3e, 0x9d, 0x26, 0x7d, 0x4b, 0x52, 0xdf, 0x2c, 0x8e, 0xfa, 0x06, 0x5e, 0x30, 0x3c, 0x75,
+	0xfd, 0x11, 0x39, 0xa0, 0x45, 0xa6, 0x2a, 0x61, 0xec, 0x6d, 0x30, 0x53, 0x3f, 0x12, 0x71, 0xe0,
+	0x9d, 0x09, 0x87, 0xfc, 0x18, 0x77, 0x96, 0xb5, 0x8a, 0xa2, 0x7b, 0xd8, 0xbe, 0x20, 0xaa, 0x79,
+	0x08, 0x64, 0x11, 0x51, 0x1e, 0xda, 0x01, 0x18, 0xd0, 0x42, 0x1e, 0x4d, 0x43, 0xd1, 0x69, 0xcb,
+	0x7c, 0x28, 0x10, 0xf6, 0x2a, 0x6c, 0xc6, 0x32, 0xfc, 0x0e, 0xc4, 0xd8, 0xf5, 0x9d, 0xfb, 0x64,
+	0x8b, 0xce, 0x0a, 0x99, 0x78, 0x1e, 0x0b, 0x23, 0xc6, 0xe3, 0x71, 0x42, 0x4e, 0x7b, 0xe4, 0x4e,
+	0x44, 0x67, 0x55, 0x46, 0x4c, 0x09, 0xc4, 0x2d, 0xbb, 0x8e, 0x27, 0xba, 0x69, 0x24, 0xc3, 0x6a,
+	0x4d, 0xe6, 0x8f, 0x8e, 0xb1, 0xdb, 0xd0, 0x8e, 0x52, 0xdf, 0xcf, 0xac, 0xb2, 0x4e, 0xbb, 0x65,
+	0xb8, 0xdb, 0x6e, 0xb7, 0xf7, 0x41, 0x30, 0xc8, 0x43, 0x5e, 0x17, 0x9b, 0x93, 0x25, 0xe6, 0xd5,
+	0x59, 0xc2, 0xf6, 0x60, 0x1d, 0x2d, 0xf2, 0x91, 0xeb, 0x3b, 0xc1, 0x79, 0x3f, 0xe1, 0x51, 0xd2,
+	0xd9, 0xa0, 0x75, 0xcf, 0xc2, 0xd6, 0x1f, 0x0c, 0x68, 0x65, 0x6a, 0x30, 0x26, 0xb3, 0x94, 0x57,
+	0x21, 0x9b, 0xd3, 0xe5, 0xfa, 0x5c, 0xcb, 0xea, 0x33, 0x83, 0x7a, 0xea, 0xbb, 0x89, 0xca, 0x23,
+	0x7a, 0x46, 0x2c, 0xe2, 0x89, 0xa0, 0xb8, 0xac, 0xd9, 0xf4, 0x7c, 0x79, 0xa9, 0x68, 0x5c, 0x55,
+	0x2a, 0xb6, 0xa0, 0x11, 0x8e, 0x79, 0x2c, 0x54, 0x68, 0x4a, 0xc2, 0xfa, 0x93, 0x01, 0x6b, 0x65,
+	0xd3, 0x61, 0x8d, 0x72, 0x1c, 0x4f, 0x15, 0x02, 0x7c, 0xc4, 0x57, 0x3f, 0x0e, 0x06, 0xf7, 0xba,
+	0xd9, 0xb2, 0x89, 0xc0, 0xf6, 0xf0, 0x71, 0x30, 0xa0, 0x20, 0x91, 0x2b, 0xcf, 0x48, 0x4c, 0xdc,
+	0x78, 0x38, 0x16, 0x13, 0x8e, 0x89, 0x2c, 0x54, 0x6e, 0xe9, 0x10, 0x6a, 0x8c, 0x89, 0x27, 0xf3,
+	0x49, 0x12, 0x68, 0xba, 0x28, 0x38, 0x3f, 0x0c, 0x52, 0x3f, 0x51, 0xd5, 0x33, 0xa7, 0x31, 0x9d,
+	0x63, 0x34, 0x36, 0xc5, 0x8f, 0xcc, 0x9a, 0x02, 0xb0, 0xfe, 0x61, 0xc0, 0x8a, 0xde, 0x78, 0xb5,
+	0x91, 0xc0, 0x58, 0x30, 0x12, 0x54, 0xf5, 0x91, 0x80, 0xbd, 0x92, 0xb7, 0x7e, 0xd9, 0xca, 0x37,
+	0x54, 0x68, 0x60, 0x8f, 0xb4, 0x89, 0x91, 0x4f, 0x03, 0xaf, 0x41, 0x3b, 0x12, 0x1e, 0x9f, 0xe6,
+	0x3d, 0x1c, 0xe5, 0xd7, 0x51, 0xde, 0x2e, 0x60, 0x5b, 0x97, 0x61, 0xef, 0xc0, 0x9a, 0xc7, 0x13,
+	0xe1, 0x0f, 0xa7, 0x7d, 0x3e, 0x09, 0x3d, 0x11, 0x53, 0xe9, 0x6b, 0xdf, 0x7a, 0xb6, 0x18, 0x18,
+	0x7a, 0x3a, 0xdf, 0x9e, 0x11, 0xb7, 0xfe, 0x69, 0xc0, 0xe6, 0x1c, 0x39, 0x0c, 0x93, 0xc4, 0x2d,
+	0xe6, 0xa9, 0x44, 0xe5, 0x51, 0xa9, 0xb4, 0x55, 0x9f, 0xb0, 0xb4, 0xd5, 0x16, 0x94, 0xb6, 0x5d,
+	0xb5, 0xdf, 0x52, 0xa5, 0xd4, 0x21, 0xcc, 0x6f, 0x22, 0x7b, 0x7c, 0x24, 0x1b, 0xb0, 0x0c, 0xc6,
+	0x32, 0xc8, 0xfe, 0x17, 0x1a, 0x09, 0x8f, 0x4f, 0xb1, 0x31, 0xe2, 0xde, 0xaf, 0xe1, 0xde, 0x71,
+	0xc6, 0x29, 0xef, 0x5c, 0xca, 0x58, 0x3f, 0x36, 0x60, 0xe3, 0x02, 0x73, 0xde, 0xf8, 0x78, 0xa1,
+	0xf2, 0x56, 0x9f, 0xb0, 0xf2, 0xd6, 0x16, 0x54, 0xde, 0x6d, 0x68, 0x79, 0xd9, 0x3e, 0x64, 0xf6,
+	0xe5, 0xb4, 0xf5, 0xeb, 0x3a, 0xb4, 0x35, 0x27, 0x5f, 0x30, 0xb5, 0xf1, 0x84, 0xa6, 0xae, 0x5e,
+	0x61, 0xea, 0x7e, 0x3a, 0xe8, 0xba, 0x91, 0x5a, 0xa2, 0x0e, 0x3d, 0x81, 0x33, 0xf6, 0x60, 0x5d,
+	0x23, 0xb5, 0xa6, 0x35, 0x0b, 0xb3, 0x9b, 0xc0, 0x08, 0x3a, 0xe4, 0xc9, 0x70, 0xfc, 0x38, 0x54,
+	0x75, 0xbc, 0x49, 0xcd, 0x60, 0x0e, 0x87, 0xbd, 0x40, 0x49, 0x3b, 0x92, 0xe9, 0xb7, 0x76, 0x6b,
+	0x99, 0x82, 0x17, 0x01, 0x5b, 0xe2, 0x5a, 0x12, 0xb5, 0xae, 0x4a, 0xa2, 0x37, 0xa0, 0x1d, 0x87,
+	0x3c, 0x9f, 0x9f, 0xe5, 0xd4, 0xb2, 0x55, 0x24, 0x51, 0xc1, 0xb3, 0x75, 0xc1, 0x8b, 0xad, 0x04,
+	0x9e, 0xa4, 0x95, 0xb4, 0xe7, 0xb4, 0x92, 0x97, 0xa1, 0x39, 0x8a, 0x82, 0xf3, 0x64, 0x4c, 0x9d,
+	0x4b, 0xcf, 0xe0, 0x23, 0x82, 0x6d, 0xc5, 0x66, 0xaf, 0x03, 0x38, 0x6e, 0x7c, 0x7a, 0x57, 0x70,
+	0x2f, 0x19, 0x53, 0xeb, 0x6a, 0xdf, 0xda, 0xcc, 0x85, 0xbb, 0x39, 0xcb, 0xd6, 0xc4, 0xac, 0x7f,
+	0x19, 0xb0, 0x3e, 0xc3, 0xc7, 0x82, 0x39, 0xa6, 0xa7, 0xa9, 0x1a, 0xdb, 0x33, 0x52, 0x1e, 0x3c,
+	0x78, 0x1c, 0xf8, 0x59, 0x55, 0x92, 0x54, 0xb6, 0x5b, 0x1a, 0xfd, 0x69, 0xb7, 0xb5, 0x62, 0xb7,
+	0x39, 0x88, 0xbb, 0x4d, 0x63, 0x3e, 0x12, 0x0f, 0x44, 0x84, 0xe3, 0x8d, 0x8a, 0xda, 0x12, 0xc6,
+	0xfe, 0x0f, 0x36, 0x5c, 0x3f, 0x70, 0xc4, 0x63, 0x5d, 0x50, 0xa6, 0xe9, 0x45, 0x06, 0x8e, 0xbc,
+	0xe7, 0x91, 0x9b, 0x64, 0xc5, 0xe6, 0x7e, 0x36, 0xcc, 0xce, 0xa0, 0x54, 0xb0, 0x05, 0x77, 0x8e,
+	0x7d, 0x6f, 0x4a, 0x41, 0xd1, 0xb2, 0x73, 0xda, 0xfa, 0xbd, 0xa1, 0x72, 0x45, 0x9a, 0x33, 0xd7,
+	0x69, 0xf3, 0x44, 0xf4, 0xc7, 0x41, 0x24, 0x47, 0x4d, 0xc3, 0x9e, 0x41, 0x71, 0xcf, 0x39, 0xd2,
+	0x0b, 0x7c, 0x99, 0xd0, 0x86, 0x5d, 0x06, 0x51, 0xdb, 0x38, 0x48, 0xa3, 0xf8, 0xb1, 0x9f, 0xb8,
+	0xde, 0xfb, 0xa9, 0x27, 0xcf, 0x35, 0x86, 0x3d, 0x83, 0xb2, 0x5b, 0xb0, 0x55, 0x20, 0x14, 0x55,
+	0x0f, 0xd2, 0x68, 0x24, 0x7b, 0x92, 0x61, 0xcf, 0xe5, 0x59, 0x7f, 0x34, 0xc0, 0x9c, 0x8d, 0x42,
+	0xdc, 0xea, 0x90, 0x87, 0x7c, 0xe8, 0x26, 0xd2, 0x7b, 0x75, 0x3b, 0xa7, 0xb1, 0x37, 0xf1, 0x33,
+	0xee, 0x7a, 0x7c, 0xe0, 0x09, 0x5a, 0x6e, 0xdd, 0x2e, 0x80, 0x0b, 0xee, 0xa9, 0xcd, 0x71, 0xcf,
+	0x05, 0x47, 0xd7, 0x17, 0x38, 0x1a, 0x81, 0xae, 0x18, 0xba, 0x31, 0x86, 0xb5, 0xcc, 0xeb, 0x12,
+	0x66, 0xfd, 0xbc, 0x06, 0xab, 0xa5, 0xb3, 0xe4, 0xdc, 0xa2, 0x99, 0xa7, 0x72, 0x75, 0x41, 0x2a,
+	0xef, 0x6a, 0x33, 0xc9, 0x9a, 0x1c, 0x94, 0x1e, 0xfb, 0x6e, 0x82, 0xed, 0x5d, 0x4d, 0x28, 0x45,
+	0xb2, 0xd7, 0xaf, 0x4a, 0xf6, 0x57, 0x61, 0xb3, 0x98, 0x3e, 0xbb, 0xdd, 0x5e, 0x2f, 0x18, 0x9e,
+	0xe6, 0xc7, 0xa8, 0x79, 0x2c, 0xc6, 0xe4, 0x89, 0x9b, 0x46, 0x95, 0xbb, 0x15, 0x79, 0xe6, 0x7e,
+	0x19, 0x1a, 0x43, 0x34, 0x05, 0x45, 0x9a, 0xca, 0x57, 0xed, 0x50, 0x7c, 0xb7, 0x62, 0x4b, 0x3e,
+	0x7b, 0x11, 0xea, 0x4e, 0x3a, 0x09, 0x55, 0x11, 0x5a, 0xa3, 0xe9, 0x30, 0x3f, 0x95, 0xde, 0xad,
+	0xd8, 0xc4, 0x45, 0x29, 0x2f, 0xe0, 0x8e, 0x2a, 0x3d, 0x24, 0x55, 0x1c, 0x3b, 0x51, 0x0a, 0xb9,
+	0x28, 0x85, 0x1d, 0x82, 0xca, 0x8c, 0x92, 0x2a, 0x4e, 0x28, 0x28, 0x85, 0x5c, 0x0c, 0x80, 0x61,
+	0xc4, 0xe3, 0x71, 0x2f, 0x08, 0x42, 0x2a, 0x36, 0x2d, 0xbb, 0x00, 0x0e, 0x5a, 0xd0, 0x8c, 0xe5,
+	0x39, 0xfe, 0x1b, 0xb0, 0x51, 0xf2, 0x4d, 0xcf, 0x8d, 0xc9, 0x90, 0x92, 0xdd, 0x31, 0x16, 0x5d,
+	0x07, 0x64, 0xef, 0xef, 0x00, 0xd0, 0x8e, 0xef, 0x44, 0x51, 0x10, 0x65, 0xd7, 0x12, 0x46, 0x7e,
+	0x2d, 0x61, 0xfd, 0x0f, 0x2c, 0xe3, 0x4e, 0x2f, 0x61, 0xe3, 0x16, 0x17, 0xb1, 0x43, 0x58, 0xa1,
+	0xbd, 0x3d, 0xec, 0x2d, 0x90, 0xc0, 0x6c, 0x92, 0x77, 0x03, 0xb2, 0x8b, 0x3c, 0x08, 0x62, 0x97,
+	0xea, 0xab, 0xac, 0x5a, 0x73, 0x79, 0x98, 0x38, 0x02, 0xd5, 0xf5, 0x1f, 0xf6, 0xb2, 0xa3, 0x77,
+	0x46, 0x5b, 0xff, 0x0f, 0xcb, 0xf8, 0x45, 0xf9, 0xb9, 0x3d, 0x68, 0x12, 0x23, 0xb3, 0x83, 0x99,
+	0x1b, 0x5b, 0x2d, 0xc8, 0x56, 0x7c, 0xeb, 0x87, 0x06, 0xb4, 0xe5, 0x34, 0x24, 0xdf, 0x7c, 0xda,
+	0x61, 0x6f, 0xb7, 0xf4, 0x7a, 0xd6, 0x66, 0x75, 0x8d, 0x37, 0x01, 0xa8, 0x02, 0x48, 0x81, 0x7a,
+	0xe1, 0xfc, 0x02, 0xb5, 0x35, 0x09, 0x74, 0x4c, 0x41, 0xcd, 0x31, 0xed, 0xcf, 0xaa, 0xb0, 0xa2,
+	0x5c, 0x2a, 0x45, 0xbe, 0xa2, 0xa4, 0x54, 0x79, 0x53, 0xd7, 0xf3, 0xe6, 0xa5, 0x2c, 0x6f, 0x1a,
+	0xc5, 0x36, 0x8a, 0x28, 0x2a, 0xd2, 0xe6, 0x86, 0x4a, 0x9b, 0x26, 0x89, 0xad, 0x66, 0x69, 0x93,
+	0x49, 0xc9, 0xac, 0xb9, 0xa1, 0xb2, 0x66, 0xa9, 0x10, 0xca, 0x43, 0x2a, 0x4f, 0x9a, 0x1b, 0x2a,
+	0x69, 0x5a, 0x85, 0x50, 0xee, 0xe6, 0x2c, 0x67, 0x0e, 0x96, 0xa0, 0x41, 0xee, 0xb4, 0xde, 0x02,
+	0x53, 0x37, 0x0d, 0xe5, 0xc4, 0x4b, 0x8a, 0x59, 0x0a, 0x05, 0x4d, 0xc8, 0x56, 0xef, 0x7e, 0x02,
+	0xab, 0xa5, 0x92, 0x83, 0x87, 0x57, 0x37, 0x3e, 0xe4, 0xfe, 0x50, 0x78, 0xf9, 0xed, 0x98, 0x86,
+	0x68, 0x41, 0x56, 0x2d, 0x34, 0x2b, 0x15, 0xa5, 0x20, 0xd3, 0xee, 0xb8, 0x6a, 0xa5, 0x3b, 0xae,
+	0xbf, 0x1a, 0xb0, 0xa2, 0xbf, 0x80, 0x6d, 0xfd, 0x4e, 0x14, 0x1d, 0x06, 0x8e, 0xf4, 0x66, 0xc3,
+	0xce, 0x48, 0x0c, 0x7d, 0x7c, 0xf4, 0x78, 0x1c, 0xab, 0x08, 0xcc, 0x69, 0xc5, 0xeb, 0x0f, 0x83,
+	0xfc, 0xf8, 0x94, 0xd3, 0x8a, 0xd7, 0x13, 0x67, 0xc2, 0x53, 0x8d, 0x20, 0xa7, 0xf1, 0x6b, 0xf7,
+	0x45, 0x8c, 0xbd, 0x43, 0xd5, 0xcf, 0x8c, 0xc4, 0xb7, 0x6c, 0x7e, 0x7e, 0xc8, 0xd3, 0xfc, 0x8c,
+	0x97, 0xd3, 0x68, 0x96, 0x8f, 0x82, 0xe8, 0x94, 0x47, 0x41, 0xea, 0x67, 0x97, 0x0e, 0x1a, 0x82,
+	0x19, 0xb5, 0x41, 0xcd, 0x8f, 0xa2, 0x38, 0xbb, 0xad, 0xdd, 0x86, 0x96, 0xeb, 0xf3, 0x61, 0xe2,
+	0x9e, 0x09, 0x65, 0xca, 0x9c, 0xce, 0x4f, 0x1e, 0xf2, 0x48, 0x28, 0x4f, 0x1e, 0x74, 0xf4, 0xf5,
+	0x04, 0x05, 0xb6, 0xda, 0x53, 0x46, 0x53, 0x8e, 0xca, 0xa9, 0x56, 0xdd, 0xc5, 0x4a, 0x8a, 0xcc,
+	0x1c, 0x4d, 0xed, 0x54, 0x76, 0xb3, 0x96, 0xad, 0x28, 0xeb, 0xef, 0x06, 0x6c, 0x1f, 0x87, 0x02,
+	0x0f, 0xbe, 0xf2, 0x5e, 0xb8, 0x4f, 0xc7, 0xc7, 0x6c, 0x69, 0xd7, 0xa1, 0x1a, 0x84, 0xb4, 0x28,
+	0x95, 0x08, 0x92, 0x7d, 0x1c, 0xda, 0xd5, 0x20, 0xa4, 0xc5, 0xf1, 0xf8, 0x54, 0x19, 0x9d, 0x9e,
+	0x17, 0x5e, 0x12, 0x6f, 0x43, 0xcb, 0xe1, 0x09, 0x1f, 0xe0, 0xd1, 0x58, 0x19, 0x3b, 0xa3, 0xe9,
+	0xbc, 0x4e, 0x4d, 0x5d, 0x1d, 0x53, 0x89, 0x20, 0x4d, 0xf4, 0x35, 0x65, 0x66, 0x45, 0xa1, 0xf4,
+	0x89, 0x97, 0xc6, 0x63, 0x35, 0x0a, 0x49, 0x02, 0xd7, 0x92, 0x27, 0x43, 0x4b, 0xc6, 0xbe, 0x95,
+	0xc0, 0xea, 0x87, 0xaf, 0xa9, 0x78, 0xbe, 0x2f, 0x12, 0xce, 0xb6, 0xb5, 0xed, 0x40, 0x76, 0x30,
+	0x52, 0x9b, 0xb9, 0xb2, 0x2c, 0x64, 0xb5, 0xa4, 0xa6, 0xd5, 0x92, 0xcc, 0x02, 0x75, 0x8a, 0x5d,
+	0x7a, 0xb6, 0x6e, 0xc3, 0x96, 0xb2, 0xe8, 0x87, 0xaf, 0xe1, 0x57, 0x17, 0xda, 0x52, 0xb2, 0xe5,
+	0xe7, 0xad, 0x3f, 0x1b, 0x70, 0x6d, 0xe6, 0xb5, 0xa7, 0xbe, 0x2e, 0x7f, 0x13, 0xea, 0x13, 0x91,
+	0xf0, 0x4e, 0x8d, 0x72, 0xee, 0x06, 0x7e, 0x63, 0xae, 0xca, 0x9b, 0x48, 0xdc, 0xf1, 0x93, 0x68,
+	0x6a, 0xd3, 0x0b, 0xdb, 0x1f, 0xc0, 0x72, 0x0e, 0xa1, 0xde, 0x53, 0x31, 0xcd, 0xca, 0xea, 0xa9,
+	0x98, 0xe2, 0x48, 0x70, 0xc6, 0xbd, 0x54, 0x9a, 0x46, 0x75, 0xce, 0x92, 0x61, 0x6d, 0xc9, 0x7f,
+	0xab, 0xfa, 0x35, 0xc3, 0xfa, 0x85, 0x01, 0x9d, 0xbb, 0xdc, 0x77, 0x3c, 0x15, 0x50, 0x32, 0xdd,
+	0x95, 0x0d, 0x9e, 0xd7, 0x6c, 0xd0, 0x46, 0x35, 0xc4, 0xbd, 0x24, 0x9c, 0xae, 0xc3, 0xf2, 0x20,
+	0x6b, 0x74, 0xca, 0xf2, 0x05, 0x40, 0x4e, 0xff, 0xc4, 0x8b, 0xd5, 0xdd, 0x27, 0x3d, 0x17, 0xf7,
+	0x6a, 0xda, 0x1d, 0xb2, 0x86, 0x58, 0xd7, 0x60, 0xf3, 0x48, 0x24, 0x72, 0x6d, 0x87, 0x27, 0x23,
+	0xb5, 0x32, 0x6b, 0x0f, 0xb6, 0xca, 0xb0, 0xb2, 0xbe, 0x09, 0xb5, 0xe1, 0x49, 0xde, 0x64, 0x86,
+	0x27, 0x23, 0xeb, 0x3a, 0x6c, 0x1f, 0x7a, 0x82, 0xfb, 0xc7, 0x51, 0x38, 0xe6, 0xbe, 0xb2, 0x42,
+	0xf6, 0xd3, 0x8b, 0xf5, 0x29, 0x3c, 0x3f, 0x97, 0xfb, 0x1f, 0xfb, 0xb5, 0x65, 0x1b, 0x5a, 0xea,
+	0x57, 0x8b, 0x6c, 0xdf, 0x39, 0x6d, 0xbd, 0x0d, 0xcf, 0x7f, 0xc8, 0x3d, 0xd7, 0xe1, 0x89, 0x38,
+	0x0c, 0x7c, 0x5f, 0x60, 0x0d, 0x71, 0x93, 0xbc, 0xd0, 0xd0, 0x2f, 0x14, 0x24, 0x7a, 0x98, 0x6f,
+	0x49, 0x43, 0xac, 0x9f, 0x18, 0xb0, 0x75, 0xc7, 0x77, 0xc2, 0xc0, 0xf5, 0x13, 0xfd, 0x7d, 0xba,
+	0x26, 0x0b, 0xbc, 0xbc, 0x8d, 0xe2, 0x33, 0x56, 0x48, 0xee, 0x38, 0x74, 0xd5, 0x2f, 0x57, 0x9d,
+	0x91, 0x34, 0xa6, 0xc9, 0xb7, 0x85, 0x3c, 0xff, 0xe3, 0x98, 0x96, 0x01, 0xb8, 0x88, 0x30, 0x72,
+	0xcf, 0x5c, 0x4f, 0x8c, 0xd4, 0x4f, 0x21, 0x2d, 0x5b, 0x43, 0x32, 0x4b, 0x34, 0x8a, 0xae, 0xfe,
+	0x5b, 0x03, 0xae, 0xcf, 0xdf, 0xd6, 0x57, 0xfd, 0x13, 0x16, 0x7b, 0x03, 0x96, 0x85, 0x32, 0x48,
+	0x76, 0x99, 0xd4, 0xa1, 0xb0, 0x9d, 0x63, 0x25, 0xbb, 0x10, 0xb5, 0x7e, 0x69, 0xc0, 0xf5, 0x87,
+	0x29, 0x8f, 0xb8, 0x9f, 0xb8, 0xbe, 0x4a, 0x84, 0x47, 0x58, 0xd5, 0x32, 0x57, 0xec, 0x6a, 0x89,
+	0x40, 0xcd, 0xb1, 0x90, 0xfe, 0x6f, 0x14, 0x57, 0xeb, 0x15, 0xd8, 0xa4, 0x4b, 0x55, 0x15, 0xa0,
+	0xda, 0x0f, 0x87, 0xf4, 0x51, 0xa3, 0xf8, 0xa8, 0x75, 0x00, 0x5b, 0x8f, 0x43, 0xb4, 0xfd, 0xd5,
+	0xb2, 0x5a, 0x9b, 0xa9, 0x96, 0xda, 0xcc, 0x51, 0x5e, 0xdc, 0x66, 0x94, 0x5c, 0x56, 0x91, 0xb3,
+	0x82, 0x5b, 0x2d, 0x0a, 0xee, 0xfe, 0xf7, 0xa0, 0x29, 0x25, 0xd8, 0x2a, 0x2c, 0xdf, 0xf3, 0xcf,
+	0x30, 0x2c, 0x8e, 0x43, 0xb3, 0xc2, 0x5a, 0x50, 0xef, 0x27, 0x41, 0x68, 0x1a, 0x6c, 0x19, 0x1a,
+	0x0f, 0xb0, 0x1b, 0x9b, 0x55, 0x06, 0xd0, 0xc4, 0x81, 0x65, 0x22, 0xcc, 0x1a, 0xc2, 0xb4, 0x63,
+	0xb3, 0x8e, 0xb0, 0xdc, 0x91, 0xd9, 0x60, 0x6b, 0x00, 0xef, 0xa5, 0x49, 0xa0, 0xc4, 0x9a, 0xfb,
+	0xdf, 0x27, 0xb1, 0x11, 0x26, 0xfe, 0x8a, 0xd2, 0x4f, 0xb4, 0x59, 0x61, 0x4b, 0x50, 0xfb, 0x96,
+	0x38, 0x37, 0x0d, 0xd6, 0x86, 0x25, 0x5b, 0xde, 0x7b, 0xcb, 0x6f, 0xd0, 0xe7, 0x1c, 0xb3, 0x86,
+	0x0c, 0x5c, 0x44, 0x28, 0x1c, 0xb3, 0xce, 0x56, 0xa0, 0xf5, 0xbe, 0xba, 0x91, 0x36, 0x1b, 0xc8,
+	0x42, 0x31, 0x7c, 0xa7, 0x89, 0x2c, 0xfa, 0x20, 0x52, 0x4b, 0x48, 0xd1, 0x5b, 0x48, 0xb5, 0xf6,
+	0x8f, 0xa1, 0x95, 0x4d, 0x9b, 0x6c, 0x1d, 0xda, 0x6a, 0x0d, 0x08, 0x99, 0x15, 0xdc, 0x04, 0xcd,
+	0x94, 0xa6, 0x81, 0x1b, 0xc6, 0xb9, 0xd1, 0xac, 0xe2, 0x13, 0x0e, 0x87, 0x66, 0x8d, 0x8c, 0x30,
+	0xf5, 0x87, 0x66, 0x1d, 0x05, 0x69, 0xc6, 0x30, 0x9d, 0xfd, 0xfb, 0xb0, 0x44, 0x8f, 0xc7, 0x68,
+	0xd1, 0x35, 0xa5, 0x4f, 0x21, 0x66, 0x05, 0xed, 0x88, 0x5f, 0x97, 0xd2, 0x06, 0xda, 0x83, 0xb6,
+	0x23, 0xe9, 0x2a, 0x2e, 0x41, 0xda, 0x46, 0x02, 0x35, 0x5c, 0x5f, 0x36, 0x04, 0xb0, 0x4d, 0x58,
+	0xcf, 0x6c, 0xa4, 0x20, 0xa9, 0xf0, 0x48, 0x24, 0x12, 0x30, 0x0d, 0xd2, 0x9f, 0x93, 0x55, 0x34,
+	0xab, 0x2d, 0x26, 0xc1, 0x99, 0x50, 0x48, 0x6d, 0xff, 0x5d, 0x68, 0x65, 0x9d, 0x50, 0x53, 0x98,
+	0x41, 0xb9, 0x42, 0x09, 0x98, 0x46, 0xa1, 0x41, 0x21, 0xd5, 0xfd, 0xef, 0xd0, 0x68, 0x88, 0x7d,
+	0x44, 0xdb, 0xa1, 0x42, 0x54, 0x68, 0x9c, 0xba, 0xa1, 0x72, 0x9c, 0x08, 0x3d, 0x3e, 0xcc, 0x83,
+	0xe3, 0x4c, 0x44, 0x89, 0x59, 0xc3, 0xe7, 0x7b, 0xfe, 0xc7, 0x62, 0x88, 0xd1, 0x81, 0x9e, 0x8a,
+	0xc4, 0x99, 0x2b, 0xce, 0xcd, 0xc6, 0xfe, 0xa7, 0xb0, 0xa2, 0x67, 0x26, 0x7b, 0x16, 0x36, 0x95,
+	0x7e, 0x1d, 0x36, 0x2b, 0x6c, 0x03, 0x56, 0xdf, 0x73, 0x34, 0xd0, 0x34, 0xd8, 0x35, 0xd8, 0xb0,
+	0x85, 0x27, 0x78, 0x2c, 0x34, 0xb8, 0x8a, 0x4b, 0xec, 0x8f, 0x83, 0x73, 0x0d, 0xab, 0xb1, 0x2d,
+	0x30, 0x6d, 0x11, 0x72, 0x37, 0xd2, 0xd0, 0xfa, 0xad, 0x1f, 0x2c, 0x41, 0x53, 0xd6, 0x0e, 0xf6,
+	0x2e, 0xb4, 0xb5, 0x9f, 0xd2, 0xd9, 0x33, 0xb2, 0x64, 0xcc, 0xfe, 0xf0, 0xbf, 0xfd, 0xec, 0x05,
+	0x5c, 0x96, 0x48, 0xab, 0xc2, 0xde, 0x01, 0x28, 0x46, 0x4f, 0x46, 0xd7, 0xc2, 0x17, 0x46, 0xd1,
+	0x6d, 0x2a, 0x6e, 0xf3, 0xfe, 0x26, 0x60, 0x55, 0xd8, 0x37, 0x61, 0x35, 0xcb, 0x61, 0x39, 0x88,
+	0xed, 0x68, 0x03, 0xc6, 0x9c, 0xe1, 0xf1, 0x52, 0x65, 0xef, 0xe7, 0xca, 0xa4, 0x17, 0x59, 0x67,
+	0xce, 0xb4, 0x22, 0xd5, 0x3c, 0xb7, 0x70, 0x8e, 0xb1, 0x2a, 0xec, 0x08, 0xda, 0x72, 0xd8, 0x90,
+	0x87, 0x84, 0xeb, 0x28, 0xbb, 0x68, 0xfa, 0xb8, 0x74, 0x41, 0x87, 0xb0, 0xa2, 0xf7, 0x7f, 0x46,
+	0x96, 0x9c, 0x33, 0x28, 0x48, 0x25, 0xf3, 0x46, 0x05, 0xab, 0xc2, 0xbe, 0x0d, 0x9b, 0x73, 0x9a,
+	0xbf, 0x34, 0xd4, 0xe2, 0x99, 0x61, 0xfb, 0x85, 0x85, 0xfc, 0x5c, 0xf3, 0x77, 0x61, 0x6b, 0x5e,
+	0x0b, 0x64, 0xf4, 0xea, 0x25, 0x3d, 0x7f, 0x7b, 0x77, 0xb1, 0x40, 0xae, 0xfc, 0x18, 0xd6, 0x8b,
+	0xb8, 0xa3, 0x36, 0xc5, 0x76, 0xcb, 0x3d, 0xe9, 0x62, 0x07, 0xbb, 0xca, 0x98, 0x7a, 0x77, 0x91,
+	0xc6, 0x9c, 0xd3, 0x6f, 0x2e, 0x55, 0x72, 0x04, 0x6b, 0xe5, 0x9e, 0xc1, 0xf4, 0x48, 0x78, 0x0a,
+	0x45, 0x77, 0x60, 0xb5, 0xd4, 0xc0, 0x64, 0xac, 0xcd, 0xeb, 0x69, 0x97, 0xa9, 0x39, 0xe8, 0xfc,
+	0xe5, 0x8b, 0x1d, 0xe3, 0xb3, 0x2f, 0x76, 0x8c, 0xcf, 0xbf, 0xd8, 0x31, 0x7e, 0xf4, 0xe5, 0x4e,
+	0xe5, 0xb3, 0x2f, 0x77, 0x2a, 0x7f, 0xfb, 0x72, 0xa7, 0x32, 0x68, 0xd2, 0xff, 0x71, 0x5e, 0xff,
+	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xaf, 0x87, 0x78, 0xa1, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WorkerClient is the client API for Worker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkerClient interface {
+	QueryStatus(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error)
+	// PurgeRelay purges relay log files for this dm-worker
+	PurgeRelay(ctx context.Context, in *PurgeRelayRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	// Operate (get/set/remove) schema for a specified upstream table.
+	// a `set`/`remove` operation should be an one-time operation (only take effect once),
+	// so we use a gRPC method rather than a etcd operation now (no persistent operation state).
+	OperateSchema(ctx context.Context, in *OperateWorkerSchemaRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	OperateV1Meta(ctx context.Context, in *OperateV1MetaRequest, opts ...grpc.CallOption) (*OperateV1MetaResponse, error)
+	HandleError(ctx context.Context, in *HandleWorkerErrorRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest, opts ...grpc.CallOption) (*GetWorkerCfgResponse, error)
+	// CleanOrphanSubTasks is called by DM-master when it finds the source or subtasks running in this dm-worker are not
+	// consistent with the bounds and subtasks in etcd. dm-worker checks them with etcd again and stops the stale ones.
+	CleanOrphanSubTasks(ctx context.Context, in *CleanOrphanSubTasksRequest, opts ...grpc.CallOption) (*CleanOrphanSubTasksResponse, error)
+	// ValidateConnectivity checks the connectivity and privileges to the upstream and downstream databases of a subtask
+	// from this dm-worker, the subtask doesn't need to be running.
+	ValidateConnectivity(ctx context.Context, in *ValidateConnectivityRequest, opts ...grpc.CallOption) (*ValidateConnectivityResponse, error)
+	// QuarantineTable suspends or resumes writing to a downstream table of a subtask, the events of a quarantined table are
+	// buffered and replayed after it's released. the quarantined tables are not persistent like OperateSchema.
+	QuarantineTable(ctx context.Context, in *QuarantineWorkerTableRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	// StartSubTask and OperateSubTask manage the subtasks directly when dm-worker runs in standalone mode without DM-master,
+	// the subtask configs and stages are persisted in the embedded etcd of dm-worker.
+	StartSubTask(ctx context.Context, in *StartSubTaskRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	OperateSubTask(ctx context.Context, in *OperateSubTaskRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+	// UpdateSubTask updates the config of a paused subtask, DM-master checks the new config on all dm-workers with
+	// `dryRun` before applying it, so the config of a task is updated on all dm-workers or none of them.
+	UpdateSubTask(ctx context.Context, in *UpdateSubTaskRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error)
+}
+
+type workerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
+	return &workerClient{cc}
+}
+
+func (c *workerClient) QueryStatus(ctx context.Context, in *QueryStatusRequest, opts ...grpc.CallOption) (*QueryStatusResponse, error) {
+	out := new(QueryStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/QueryStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RelayError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) PurgeRelay(ctx context.Context, in *PurgeRelayRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/PurgeRelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *RelayError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0xa
+func (c *workerClient) OperateSchema(ctx context.Context, in *OperateWorkerSchemaRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/OperateSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SubTaskError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) OperateV1Meta(ctx context.Context, in *OperateV1MetaRequest, opts ...grpc.CallOption) (*OperateV1MetaResponse, error) {
+	out := new(OperateV1MetaResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/OperateV1Meta", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SubTaskError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) HandleError(ctx context.Context, in *HandleWorkerErrorRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/HandleError", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SubTaskError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Error != nil {
-		{
-			size := m.Error.Size()
-			i -= size
-			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if m.Unit != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Unit))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Stage != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *workerClient) GetWorkerCfg(ctx context.Context, in *GetWorkerCfgRequest, opts ...grpc.CallOption) (*GetWorkerCfgResponse, error) {
+	out := new(GetWorkerCfgResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/GetWorkerCfg", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SubTaskError_Msg) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) CleanOrphanSubTasks(ctx context.Context, in *CleanOrphanSubTasksRequest, opts ...grpc.CallOption) (*CleanOrphanSubTasksResponse, error) {
+	out := new(CleanOrphanSubTasksResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/CleanOrphanSubTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SubTaskError_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Msg)
-	copy(dAtA[i:], m.Msg)
-	i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
-	i--
-	dAtA[i] = 0x22
-	return len(dAtA) - i, nil
-}
-func (m *SubTaskError_Check) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) ValidateConnectivity(ctx context.Context, in *ValidateConnectivityRequest, opts ...grpc.CallOption) (*ValidateConnectivityResponse, error) {
+	out := new(ValidateConnectivityResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/ValidateConnectivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SubTaskError_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Check != nil {
-		{
-			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func (c *workerClient) QuarantineTable(ctx context.Context, in *QuarantineWorkerTableRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/QuarantineTable", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
-func (m *SubTaskError_Dump) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (c *workerClient) StartSubTask(ctx context.Context, in *StartSubTaskRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/StartSubTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SubTaskError_Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Dump != nil {
-		{
-			size, err := m.Dump.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
-}
-func (m *SubTaskError_Load) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SubTaskError_Load) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Load != nil {
-		{
-			size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+func (c *workerClient) OperateSubTask(ctx context.Context, in *OperateSubTaskRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/OperateSubTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
-}
-func (m *SubTaskError_Sync) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *SubTaskError_Sync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Sync != nil {
-		{
-			size, err := m.Sync.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDmworker(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	return len(dAtA) - i, nil
-}
-func (m *SubTaskErrorList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) UpdateSubTask(ctx context.Context, in *UpdateSubTaskRequest, opts ...grpc.CallOption) (*CommonWorkerResponse, error) {
+	out := new(CommonWorkerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/UpdateSubTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SubTaskErrorList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// WorkerServer is the server API for Worker service.
+type WorkerServer interface {
+	QueryStatus(context.Context, *QueryStatusRequest) (*QueryStatusResponse, error)
+	// PurgeRelay purges relay log files for this dm-worker
+	PurgeRelay(context.Context, *PurgeRelayRequest) (*CommonWorkerResponse, error)
+	// Operate (get/set/remove) schema for a specified upstream table.
+	// a `set`/`remove` operation should be an one-time operation (only take effect once),
+	// so we use a gRPC method rather than a etcd operation now (no persistent operation state).
+	OperateSchema(context.Context, *OperateWorkerSchemaRequest) (*CommonWorkerResponse, error)
+	OperateV1Meta(context.Context, *OperateV1MetaRequest) (*OperateV1MetaResponse, error)
+	HandleError(context.Context, *HandleWorkerErrorRequest) (*CommonWorkerResponse, error)
+	GetWorkerCfg(context.Context, *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error)
+	// CleanOrphanSubTasks is called by DM-master when it finds the source or subtasks running in this dm-worker are not
+	// consistent with the bounds and subtasks in etcd. dm-worker checks them with etcd again and stops the stale ones.
+	CleanOrphanSubTasks(context.Context, *CleanOrphanSubTasksRequest) (*CleanOrphanSubTasksResponse, error)
+	// ValidateConnectivity checks the connectivity and privileges to the upstream and downstream databases of a subtask
+	// from this dm-worker, the subtask doesn't need to be running.
+	ValidateConnectivity(context.Context, *ValidateConnectivityRequest) (*ValidateConnectivityResponse, error)
+	// QuarantineTable suspends or resumes writing to a downstream table of a subtask, the events of a quarantined table are
+	// buffered and replayed after it's released. the quarantined tables are not persistent like OperateSchema.
+	QuarantineTable(context.Context, *QuarantineWorkerTableRequest) (*CommonWorkerResponse, error)
+	// StartSubTask and OperateSubTask manage the subtasks directly when dm-worker runs in standalone mode without DM-master,
+	// the subtask configs and stages are persisted in the embedded etcd of dm-worker.
+	StartSubTask(context.Context, *StartSubTaskRequest) (*CommonWorkerResponse, error)
+	OperateSubTask(context.Context, *OperateSubTaskRequest) (*CommonWorkerResponse, error)
+	// UpdateSubTask updates the config of a paused subtask, DM-master checks the new config on all dm-workers with
+	// `dryRun` before applying it, so the config of a task is updated on all dm-workers or none of them.
+	UpdateSubTask(context.Context, *UpdateSubTaskRequest) (*CommonWorkerResponse, error)
 }
 
-func (m *SubTaskErrorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		for iNdEx := len(m.Error) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Error[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkerServer struct {
 }
 
-func (m *ProcessResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedWorkerServer) QueryStatus(ctx context.Context, req *QueryStatusRequest) (*QueryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStatus not implemented")
+}
+func (*UnimplementedWorkerServer) PurgeRelay(ctx context.Context, req *PurgeRelayRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeRelay not implemented")
+}
+func (*UnimplementedWorkerServer) OperateSchema(ctx context.Context, req *OperateWorkerSchemaRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateSchema not implemented")
+}
+func (*UnimplementedWorkerServer) OperateV1Meta(ctx context.Context, req *OperateV1MetaRequest) (*OperateV1MetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateV1Meta not implemented")
+}
+func (*UnimplementedWorkerServer) HandleError(ctx context.Context, req *HandleWorkerErrorRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleError not implemented")
+}
+func (*UnimplementedWorkerServer) GetWorkerCfg(ctx context.Context, req *GetWorkerCfgRequest) (*GetWorkerCfgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerCfg not implemented")
+}
+func (*UnimplementedWorkerServer) CleanOrphanSubTasks(ctx context.Context, req *CleanOrphanSubTasksRequest) (*CleanOrphanSubTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanOrphanSubTasks not implemented")
+}
+func (*UnimplementedWorkerServer) ValidateConnectivity(ctx context.Context, req *ValidateConnectivityRequest) (*ValidateConnectivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConnectivity not implemented")
+}
+func (*UnimplementedWorkerServer) QuarantineTable(ctx context.Context, req *QuarantineWorkerTableRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantineTable not implemented")
+}
+func (*UnimplementedWorkerServer) StartSubTask(ctx context.Context, req *StartSubTaskRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSubTask not implemented")
+}
+func (*UnimplementedWorkerServer) OperateSubTask(ctx context.Context, req *OperateSubTaskRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateSubTask not implemented")
+}
+func (*UnimplementedWorkerServer) UpdateSubTask(ctx context.Context, req *UpdateSubTaskRequest) (*CommonWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubTask not implemented")
 }
 
-func (m *ProcessResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
+	s.RegisterService(&_Worker_serviceDesc, srv)
 }
 
-func (m *ProcessResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x1a
+func _Worker_QueryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Errors) > 0 {
-		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDmworker(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(WorkerServer).QueryStatus(ctx, in)
 	}
-	if m.IsCanceled {
-		i--
-		if m.IsCanceled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/QueryStatus",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).QueryStatus(ctx, req.(*QueryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProcessError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Worker_PurgeRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRelayRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(WorkerServer).PurgeRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/PurgeRelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).PurgeRelay(ctx, req.(*PurgeRelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProcessError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Worker_OperateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateWorkerSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).OperateSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/OperateSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).OperateSchema(ctx, req.(*OperateWorkerSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ProcessError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Workaround) > 0 {
-		i -= len(m.Workaround)
-		copy(dAtA[i:], m.Workaround)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Workaround)))
-		i--
-		dAtA[i] = 0x3a
+func _Worker_OperateV1Meta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateV1MetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RawCause) > 0 {
-		i -= len(m.RawCause)
-		copy(dAtA[i:], m.RawCause)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RawCause)))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(WorkerServer).OperateV1Meta(ctx, in)
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/OperateV1Meta",
 	}
-	if len(m.ErrLevel) > 0 {
-		i -= len(m.ErrLevel)
-		copy(dAtA[i:], m.ErrLevel)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrLevel)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).OperateV1Meta(ctx, req.(*OperateV1MetaRequest))
 	}
-	if len(m.ErrScope) > 0 {
-		i -= len(m.ErrScope)
-		copy(dAtA[i:], m.ErrScope)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrScope)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_HandleError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandleWorkerErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ErrClass) > 0 {
-		i -= len(m.ErrClass)
-		copy(dAtA[i:], m.ErrClass)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrClass)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(WorkerServer).HandleError(ctx, in)
 	}
-	if m.ErrCode != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.ErrCode))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/HandleError",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).HandleError(ctx, req.(*HandleWorkerErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_GetWorkerCfg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerCfgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).GetWorkerCfg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/GetWorkerCfg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).GetWorkerCfg(ctx, req.(*GetWorkerCfgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_CleanOrphanSubTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanOrphanSubTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).CleanOrphanSubTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/CleanOrphanSubTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).CleanOrphanSubTasks(ctx, req.(*CleanOrphanSubTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ValidateConnectivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConnectivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ValidateConnectivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/ValidateConnectivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ValidateConnectivity(ctx, req.(*ValidateConnectivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_QuarantineTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantineWorkerTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).QuarantineTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/QuarantineTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).QuarantineTable(ctx, req.(*QuarantineWorkerTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_StartSubTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSubTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).StartSubTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/StartSubTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).StartSubTask(ctx, req.(*StartSubTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_OperateSubTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateSubTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).OperateSubTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/OperateSubTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).OperateSubTask(ctx, req.(*OperateSubTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_UpdateSubTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).UpdateSubTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/UpdateSubTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).UpdateSubTask(ctx, req.(*UpdateSubTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Worker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Worker",
+	HandlerType: (*WorkerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryStatus",
+			Handler:    _Worker_QueryStatus_Handler,
+		},
+		{
+			MethodName: "PurgeRelay",
+			Handler:    _Worker_PurgeRelay_Handler,
+		},
+		{
+			MethodName: "OperateSchema",
+			Handler:    _Worker_OperateSchema_Handler,
+		},
+		{
+			MethodName: "OperateV1Meta",
+			Handler:    _Worker_OperateV1Meta_Handler,
+		},
+		{
+			MethodName: "HandleError",
+			Handler:    _Worker_HandleError_Handler,
+		},
+		{
+			MethodName: "GetWorkerCfg",
+			Handler:    _Worker_GetWorkerCfg_Handler,
+		},
+		{
+			MethodName: "CleanOrphanSubTasks",
+			Handler:    _Worker_CleanOrphanSubTasks_Handler,
+		},
+		{
+			MethodName: "ValidateConnectivity",
+			Handler:    _Worker_ValidateConnectivity_Handler,
+		},
+		{
+			MethodName: "QuarantineTable",
+			Handler:    _Worker_QuarantineTable_Handler,
+		},
+		{
+			MethodName: "StartSubTask",
+			Handler:    _Worker_StartSubTask_Handler,
+		},
+		{
+			MethodName: "OperateSubTask",
+			Handler:    _Worker_OperateSubTask_Handler,
+		},
+		{
+			MethodName: "UpdateSubTask",
+			Handler:    _Worker_UpdateSubTask_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dmworker.proto",
+}
+
+func (m *QueryStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeRelayRequest) Marshal() (dAtA []byte, err error) {
+func (m *CommonWorkerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4319,38 +4597,40 @@ func (m *PurgeRelayRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommonWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommonWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SubDir) > 0 {
-		i -= len(m.SubDir)
-		copy(dAtA[i:], m.SubDir)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SubDir)))
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Filename)))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Time != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Time))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Inactive {
+	if m.Result {
 		i--
-		if m.Inactive {
+		if m.Result {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -4361,7 +4641,7 @@ func (m *PurgeRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OperateWorkerSchemaRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4371,80 +4651,63 @@ func (m *OperateWorkerSchemaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperateWorkerSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperateWorkerSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sync {
-		i--
-		if m.Sync {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Flush {
-		i--
-		if m.Flush {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.SubTaskStatus) > 0 {
+		for iNdEx := len(m.SubTaskStatus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubTaskStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Schema) > 0 {
-		i -= len(m.Schema)
-		copy(dAtA[i:], m.Schema)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Schema)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Table) > 0 {
-		i -= len(m.Table)
-		copy(dAtA[i:], m.Table)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Table)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Database) > 0 {
-		i -= len(m.Database)
-		copy(dAtA[i:], m.Database)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Database)))
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+	if m.SourceStatus != nil {
+		{
+			size, err := m.SourceStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *V1SubTaskMeta) Marshal() (dAtA []byte, err error) {
+func (m *CheckStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4454,44 +4717,57 @@ func (m *V1SubTaskMeta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *V1SubTaskMeta) MarshalTo(dAtA []byte) (int, error) {
+func (m *CheckStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *V1SubTaskMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CheckStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Detail)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
+	if m.Warning != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Warning))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if m.Stage != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
+	if m.Failed != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Successful != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Successful))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Total != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Total))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperateV1MetaRequest) Marshal() (dAtA []byte, err error) {
+func (m *DumpStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4501,25 +4777,32 @@ func (m *OperateV1MetaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperateV1MetaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DumpStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperateV1MetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DumpStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+	if m.ProgressDetail != nil {
+		{
+			size, err := m.ProgressDetail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperateV1MetaResponse) Marshal() (dAtA []byte, err error) {
+func (m *LoadStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4529,114 +4812,78 @@ func (m *OperateV1MetaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperateV1MetaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *LoadStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperateV1MetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LoadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Meta) > 0 {
-		for k := range m.Meta {
-			v := m.Meta[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintDmworker(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
+	if m.ProgressDetail != nil {
+		{
+			size, err := m.ProgressDetail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDmworker(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDmworker(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+	if m.EstimatedRemainingSeconds != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.EstimatedRemainingSeconds))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x40
 	}
-	if m.Result {
+	if m.Bps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Bps))
 		i--
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x38
+	}
+	if m.FinishedRows != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedRows))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x30
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *HandleWorkerErrorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.MetaBinlogGTID) > 0 {
+		i -= len(m.MetaBinlogGTID)
+		copy(dAtA[i:], m.MetaBinlogGTID)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlogGTID)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HandleWorkerErrorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *HandleWorkerErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sqls) > 0 {
-		for iNdEx := len(m.Sqls) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sqls[iNdEx])
-			copy(dAtA[i:], m.Sqls[iNdEx])
-			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Sqls[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if len(m.MetaBinlog) > 0 {
+		i -= len(m.MetaBinlog)
+		copy(dAtA[i:], m.MetaBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlog)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.BinlogPos) > 0 {
-		i -= len(m.BinlogPos)
-		copy(dAtA[i:], m.BinlogPos)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogPos)))
+	if len(m.Progress) > 0 {
+		i -= len(m.Progress)
+		copy(dAtA[i:], m.Progress)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Progress)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Task) > 0 {
-		i -= len(m.Task)
-		copy(dAtA[i:], m.Task)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+	if m.TotalBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalBytes))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Op != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+	if m.FinishedBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedBytes))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetWorkerCfgRequest) Marshal() (dAtA []byte, err error) {
+func (m *ShardingGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4646,20 +4893,61 @@ func (m *GetWorkerCfgRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetWorkerCfgRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ShardingGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetWorkerCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ShardingGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Unsynced) > 0 {
+		for iNdEx := len(m.Unsynced) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Unsynced[iNdEx])
+			copy(dAtA[i:], m.Unsynced[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Unsynced[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Synced) > 0 {
+		for iNdEx := len(m.Synced) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Synced[iNdEx])
+			copy(dAtA[i:], m.Synced[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Synced[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FirstLocation) > 0 {
+		i -= len(m.FirstLocation)
+		copy(dAtA[i:], m.FirstLocation)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.FirstLocation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DDLs) > 0 {
+		for iNdEx := len(m.DDLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DDLs[iNdEx])
+			copy(dAtA[i:], m.DDLs[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.DDLs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetWorkerCfgResponse) Marshal() (dAtA []byte, err error) {
+func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4669,899 +4957,6743 @@ func (m *GetWorkerCfgResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetWorkerCfgResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetWorkerCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Cfg) > 0 {
-		i -= len(m.Cfg)
-		copy(dAtA[i:], m.Cfg)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Cfg)))
+	if len(m.SyncWindowStart) > 0 {
+		i -= len(m.SyncWindowStart)
+		copy(dAtA[i:], m.SyncWindowStart)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncWindowStart)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintDmworker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDmworker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.ProgressDetail != nil {
+		{
+			size, err := m.ProgressDetail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RunningDDLs) > 0 {
+		for iNdEx := len(m.RunningDDLs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RunningDDLs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.IdleDuration != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.IdleDuration))
+		i--
+		dAtA[i] = 0x70
 	}
-	return n
-}
-
-func (m *CommonWorkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LastEventTime) > 0 {
+		i -= len(m.LastEventTime)
+		copy(dAtA[i:], m.LastEventTime)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.LastEventTime)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
+	if m.SecondsBehindMaster != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.SecondsBehindMaster))
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.BinlogType) > 0 {
+		i -= len(m.BinlogType)
+		copy(dAtA[i:], m.BinlogType)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogType)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Synced {
+		i--
+		if m.Synced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.UnresolvedGroups) > 0 {
+		for iNdEx := len(m.UnresolvedGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnresolvedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	return n
-}
-
-func (m *QueryStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BlockingDDLs) > 0 {
+		for iNdEx := len(m.BlockingDDLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockingDDLs[iNdEx])
+			copy(dAtA[i:], m.BlockingDDLs[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.BlockingDDLs[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
+	if len(m.SyncerBinlogGtid) > 0 {
+		i -= len(m.SyncerBinlogGtid)
+		copy(dAtA[i:], m.SyncerBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlogGtid)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.SyncerBinlog) > 0 {
+		i -= len(m.SyncerBinlog)
+		copy(dAtA[i:], m.SyncerBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlog)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.SourceStatus != nil {
-		l = m.SourceStatus.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.MasterBinlogGtid) > 0 {
+		i -= len(m.MasterBinlogGtid)
+		copy(dAtA[i:], m.MasterBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlogGtid)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.SubTaskStatus) > 0 {
-		for _, e := range m.SubTaskStatus {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+	if len(m.MasterBinlog) > 0 {
+		i -= len(m.MasterBinlog)
+		copy(dAtA[i:], m.MasterBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlog)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.RecentTps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.RecentTps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalTps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalTps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalEvents != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.TotalEvents))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CheckStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Progress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Progress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Progress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Passed {
-		n += 2
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Total != 0 {
-		n += 1 + sovDmworker(uint64(m.Total))
+	if m.EstimatedRemainingSeconds != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.EstimatedRemainingSeconds))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Successful != 0 {
-		n += 1 + sovDmworker(uint64(m.Successful))
+	if m.Rate != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Rate))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Failed != 0 {
-		n += 1 + sovDmworker(uint64(m.Failed))
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Unit)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Warning != 0 {
-		n += 1 + sovDmworker(uint64(m.Warning))
+	if m.Total != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Finished != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Finished))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DumpStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DDLJobProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LoadStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DDLJobProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DDLJobProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FinishedBytes != 0 {
-		n += 1 + sovDmworker(uint64(m.FinishedBytes))
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.TotalBytes != 0 {
-		n += 1 + sovDmworker(uint64(m.TotalBytes))
+	if m.RowCount != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.RowCount))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Progress)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.MetaBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.SchemaState) > 0 {
+		i -= len(m.SchemaState)
+		copy(dAtA[i:], m.SchemaState)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SchemaState)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.MetaBinlogGTID)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.JobType) > 0 {
+		i -= len(m.JobType)
+		copy(dAtA[i:], m.JobType)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.JobType)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.JobID != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.JobID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Ddl) > 0 {
+		i -= len(m.Ddl)
+		copy(dAtA[i:], m.Ddl)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Ddl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ShardingGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SourceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	if len(m.DDLs) > 0 {
-		for _, s := range m.DDLs {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
+	if len(m.LatencySamples) > 0 {
+		for iNdEx := len(m.LatencySamples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatencySamples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = len(m.FirstLocation)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	if len(m.Synced) > 0 {
-		for _, s := range m.Synced {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
+	if m.RelayStatus != nil {
+		{
+			size, err := m.RelayStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Unsynced) > 0 {
-		for _, s := range m.Unsynced {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SourceLatencySample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceLatencySample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceLatencySample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TotalEvents != 0 {
-		n += 1 + sovDmworker(uint64(m.TotalEvents))
-	}
-	if m.TotalTps != 0 {
-		n += 1 + sovDmworker(uint64(m.TotalTps))
-	}
-	if m.RecentTps != 0 {
-		n += 1 + sovDmworker(uint64(m.RecentTps))
-	}
-	l = len(m.MasterBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.MasterBinlogGtid)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.SyncerBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.SyncerBinlogGtid)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	if len(m.BlockingDDLs) > 0 {
-		for _, s := range m.BlockingDDLs {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.UnresolvedGroups) > 0 {
-		for _, e := range m.UnresolvedGroups {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+	if m.RelayLagBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.RelayLagBytes))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Synced {
-		n += 2
+	if len(m.RelayBinlog) > 0 {
+		i -= len(m.RelayBinlog)
+		copy(dAtA[i:], m.RelayBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelayBinlog)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.BinlogType)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.MasterBinlogGtid) > 0 {
+		i -= len(m.MasterBinlogGtid)
+		copy(dAtA[i:], m.MasterBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlogGtid)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SecondsBehindMaster != 0 {
-		n += 1 + sovDmworker(uint64(m.SecondsBehindMaster))
+	if len(m.MasterBinlog) > 0 {
+		i -= len(m.MasterBinlog)
+		copy(dAtA[i:], m.MasterBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlog)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SourceStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TaskLatencySample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskLatencySample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskLatencySample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.LagBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.LagBytes))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.SyncerBinlogGtid) > 0 {
+		i -= len(m.SyncerBinlogGtid)
+		copy(dAtA[i:], m.SyncerBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlogGtid)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.SyncerBinlog) > 0 {
+		i -= len(m.SyncerBinlog)
+		copy(dAtA[i:], m.SyncerBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SyncerBinlog)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RelayStatus != nil {
-		l = m.RelayStatus.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RelayStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MasterBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.DiskHealth != nil {
+		{
+			size, err := m.DiskHealth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = len(m.MasterBinlogGtid)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Growth != nil {
+		{
+			size, err := m.Growth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.RelaySubDir)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.IdleDuration != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.IdleDuration))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.RelayBinlog)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.LastEventTime) > 0 {
+		i -= len(m.LastEventTime)
+		copy(dAtA[i:], m.LastEventTime)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.LastEventTime)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.RelayBinlogGtid)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.SpaceStatus != nil {
+		{
+			size, err := m.SpaceStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.RelayCatchUpMaster {
-		n += 2
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
 	if m.Stage != 0 {
-		n += 1 + sovDmworker(uint64(m.Stage))
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.RelayCatchUpMaster {
+		i--
+		if m.RelayCatchUpMaster {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if len(m.RelayBinlogGtid) > 0 {
+		i -= len(m.RelayBinlogGtid)
+		copy(dAtA[i:], m.RelayBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelayBinlogGtid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RelayBinlog) > 0 {
+		i -= len(m.RelayBinlog)
+		copy(dAtA[i:], m.RelayBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelayBinlog)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RelaySubDir) > 0 {
+		i -= len(m.RelaySubDir)
+		copy(dAtA[i:], m.RelaySubDir)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RelaySubDir)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MasterBinlogGtid) > 0 {
+		i -= len(m.MasterBinlogGtid)
+		copy(dAtA[i:], m.MasterBinlogGtid)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlogGtid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MasterBinlog) > 0 {
+		i -= len(m.MasterBinlog)
+		copy(dAtA[i:], m.MasterBinlog)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MasterBinlog)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SubTaskStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayDiskHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayDiskHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayDiskHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Stage != 0 {
-		n += 1 + sovDmworker(uint64(m.Stage))
+	if m.WriteLatencyMs != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.WriteLatencyMs))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Unit != 0 {
-		n += 1 + sovDmworker(uint64(m.Unit))
+	if m.InodeUsagePercent != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.InodeUsagePercent))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.UsagePercent != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.UsagePercent))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.UnresolvedDDLLockID)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.LastCheckTime) > 0 {
+		i -= len(m.LastCheckTime)
+		copy(dAtA[i:], m.LastCheckTime)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.LastCheckTime)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Status != nil {
-		n += m.Status.Size()
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SubTaskStatus_Msg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayGrowth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	n += 1 + l + sovDmworker(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
-func (m *SubTaskStatus_Check) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Check != nil {
-		l = m.Check.Size()
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
+
+func (m *RelayGrowth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *SubTaskStatus_Dump) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RelayGrowth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Dump != nil {
-		l = m.Dump.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.HoursUntilSpacePurge != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HoursUntilSpacePurge))))
+		i--
+		dAtA[i] = 0x21
 	}
-	return n
-}
-func (m *SubTaskStatus_Load) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Load != nil {
-		l = m.Load.Size()
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
-}
-func (m *SubTaskStatus_Sync) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sync != nil {
-		l = m.Sync.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.HoursUntilFull != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HoursUntilFull))))
+		i--
+		dAtA[i] = 0x19
 	}
-	return n
-}
-func (m *SubTaskStatusList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.WriteRateLong != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.WriteRateLong))))
+		i--
+		dAtA[i] = 0x11
 	}
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		for _, e := range m.Status {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+	if m.WriteRateShort != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.WriteRateShort))))
+		i--
+		dAtA[i] = 0x9
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CheckError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+func (m *RelaySpaceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DumpError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
+func (m *RelaySpaceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LoadError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RelaySpaceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.LastDecision) > 0 {
+		i -= len(m.LastDecision)
+		copy(dAtA[i:], m.LastDecision)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.LastDecision)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *SyncSQLError) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LastCheckTime) > 0 {
+		i -= len(m.LastCheckTime)
+		copy(dAtA[i:], m.LastCheckTime)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.LastCheckTime)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.UsagePercent != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.UsagePercent))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.FailedBinlogPosition)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Available != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Available))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ErrorSQL)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Capacity != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Errors) > 0 {
-		for _, e := range m.Errors {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+func (m *SubTaskStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SourceError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.SourceError)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	if m.RelayError != nil {
-		l = m.RelayError.Size()
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
+func (m *SubTaskStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RelayError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SubTaskStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.CrashLoop {
+		i--
+		if m.CrashLoop {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	return n
-}
-
-func (m *SubTaskError) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Status != nil {
+		{
+			size := m.Status.Size()
+			i -= size
+			if _, err := m.Status.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.UnresolvedDDLLockID) > 0 {
+		i -= len(m.UnresolvedDDLLockID)
+		copy(dAtA[i:], m.UnresolvedDDLLockID)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.UnresolvedDDLLockID)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Stage != 0 {
-		n += 1 + sovDmworker(uint64(m.Stage))
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Unit != 0 {
-		n += 1 + sovDmworker(uint64(m.Unit))
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Error != nil {
-		n += m.Error.Size()
+	if m.Stage != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
-func (m *SubTaskError_Msg) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	l = len(m.Msg)
-	n += 1 + l + sovDmworker(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *SubTaskError_Check) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Check != nil {
-		l = m.Check.Size()
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
+
+func (m *SubTaskStatus_Msg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *SubTaskError_Dump) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Dump != nil {
-		l = m.Dump.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+
+func (m *SubTaskStatus_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Msg)
+	copy(dAtA[i:], m.Msg)
+	i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+	i--
+	dAtA[i] = 0x32
+	return len(dAtA) - i, nil
+}
+func (m *SubTaskStatus_Check) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskStatus_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Check != nil {
+		{
+			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *SubTaskError_Load) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubTaskStatus_Dump) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskStatus_Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Dump != nil {
+		{
+			size, err := m.Dump.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
+	return len(dAtA) - i, nil
+}
+func (m *SubTaskStatus_Load) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskStatus_Load) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Load != nil {
-		l = m.Load.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+		{
+			size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *SubTaskError_Sync) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *SubTaskStatus_Sync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskStatus_Sync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Sync != nil {
-		l = m.Sync.Size()
-		n += 1 + l + sovDmworker(uint64(l))
+		{
+			size, err := m.Sync.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *SubTaskErrorList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		for _, e := range m.Error {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
-		}
+func (m *SubTaskStatusList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ProcessResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SubTaskStatusList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskStatusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsCanceled {
-		n += 2
-	}
-	if len(m.Errors) > 0 {
-		for _, e := range m.Errors {
-			l = e.Size()
-			n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Status) > 0 {
+		for iNdEx := len(m.Status) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Status[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProcessError) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ErrCode != 0 {
-		n += 1 + sovDmworker(uint64(m.ErrCode))
-	}
-	l = len(m.ErrClass)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.ErrScope)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.ErrLevel)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.RawCause)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.Workaround)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PurgeRelayRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DumpError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DumpError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DumpError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Inactive {
-		n += 2
-	}
-	if m.Time != 0 {
-		n += 1 + sovDmworker(uint64(m.Time))
-	}
-	l = len(m.Filename)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.SubDir)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperateWorkerSchemaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LoadError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoadError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmworker(uint64(m.Op))
-	}
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncSQLError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Database)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *SyncSQLError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncSQLError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorSQL) > 0 {
+		i -= len(m.ErrorSQL)
+		copy(dAtA[i:], m.ErrorSQL)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrorSQL)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Table)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.FailedBinlogPosition) > 0 {
+		i -= len(m.FailedBinlogPosition)
+		copy(dAtA[i:], m.FailedBinlogPosition)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.FailedBinlogPosition)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Schema)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Flush {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Sync {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *SyncError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *V1SubTaskMeta) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SourceError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmworker(uint64(m.Op))
+	if m.RelayError != nil {
+		{
+			size, err := m.RelayError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Stage != 0 {
-		n += 1 + sovDmworker(uint64(m.Stage))
+	if len(m.SourceError) > 0 {
+		i -= len(m.SourceError)
+		copy(dAtA[i:], m.SourceError)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SourceError)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperateV1MetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmworker(uint64(m.Op))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperateV1MetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SubTaskError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SubTaskError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Result {
-		n += 2
+	if m.Error != nil {
+		{
+			size := m.Error.Size()
+			i -= size
+			if _, err := m.Error.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
+	if m.Unit != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Meta) > 0 {
-		for k, v := range m.Meta {
-			_ = k
+	if m.Stage != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubTaskError_Msg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskError_Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Msg)
+	copy(dAtA[i:], m.Msg)
+	i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *SubTaskError_Check) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskError_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Check != nil {
+		{
+			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SubTaskError_Dump) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskError_Dump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Dump != nil {
+		{
+			size, err := m.Dump.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SubTaskError_Load) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskError_Load) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Load != nil {
+		{
+			size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SubTaskError_Sync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskError_Sync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Sync != nil {
+		{
+			size, err := m.Sync.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDmworker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SubTaskErrorList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubTaskErrorList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubTaskErrorList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		for iNdEx := len(m.Error) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Error[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProcessResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.IsCanceled {
+		i--
+		if m.IsCanceled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProcessError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProcessError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProcessError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Workaround) > 0 {
+		i -= len(m.Workaround)
+		copy(dAtA[i:], m.Workaround)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Workaround)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RawCause) > 0 {
+		i -= len(m.RawCause)
+		copy(dAtA[i:], m.RawCause)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.RawCause)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ErrLevel) > 0 {
+		i -= len(m.ErrLevel)
+		copy(dAtA[i:], m.ErrLevel)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrLevel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ErrScope) > 0 {
+		i -= len(m.ErrScope)
+		copy(dAtA[i:], m.ErrScope)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrScope)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ErrClass) > 0 {
+		i -= len(m.ErrClass)
+		copy(dAtA[i:], m.ErrClass)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.ErrClass)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ErrCode != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.ErrCode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeRelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeRelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeRelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SubDir) > 0 {
+		i -= len(m.SubDir)
+		copy(dAtA[i:], m.SubDir)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SubDir)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Time != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Inactive {
+		i--
+		if m.Inactive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateWorkerSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateWorkerSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateWorkerSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sync {
+		i--
+		if m.Sync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Flush {
+		i--
+		if m.Flush {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *V1SubTaskMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *V1SubTaskMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *V1SubTaskMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Stage != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateV1MetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateV1MetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateV1MetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateV1MetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateV1MetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateV1MetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meta) > 0 {
+		for k := range m.Meta {
+			v := m.Meta[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintDmworker(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDmworker(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDmworker(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandleWorkerErrorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandleWorkerErrorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandleWorkerErrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinlogGTID) > 0 {
+		i -= len(m.BinlogGTID)
+		copy(dAtA[i:], m.BinlogGTID)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogGTID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sqls) > 0 {
+		for iNdEx := len(m.Sqls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sqls[iNdEx])
+			copy(dAtA[i:], m.Sqls[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.Sqls[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BinlogPos) > 0 {
+		i -= len(m.BinlogPos)
+		copy(dAtA[i:], m.BinlogPos)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.BinlogPos)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkerCfgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerCfgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerCfgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkerCfgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerCfgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerCfgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cfg) > 0 {
+		i -= len(m.Cfg)
+		copy(dAtA[i:], m.Cfg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Cfg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanOrphanSubTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanOrphanSubTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanOrphanSubTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanOrphanSubTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanOrphanSubTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanOrphanSubTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubTasks) > 0 {
+		for iNdEx := len(m.SubTasks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubTasks[iNdEx])
+			copy(dAtA[i:], m.SubTasks[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.SubTasks[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateConnectivityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateConnectivityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateConnectivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubTaskCfg) > 0 {
+		i -= len(m.SubTaskCfg)
+		copy(dAtA[i:], m.SubTaskCfg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.SubTaskCfg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndpointConnectivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndpointConnectivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndpointConnectivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Privileged {
+		i--
+		if m.Privileged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Connected {
+		i--
+		if m.Connected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateConnectivityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateConnectivityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateConnectivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Result {
+		i--
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantineWorkerTableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantineWorkerTableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantineWorkerTableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartSubTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartSubTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartSubTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateSubTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSubTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSubTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperateSubTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperateSubTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperateSubTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Op != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDmworker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDmworker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *CommonWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.SourceStatus != nil {
+		l = m.SourceStatus.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.SubTaskStatus) > 0 {
+		for _, e := range m.SubTaskStatus {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Passed {
+		n += 2
+	}
+	if m.Total != 0 {
+		n += 1 + sovDmworker(uint64(m.Total))
+	}
+	if m.Successful != 0 {
+		n += 1 + sovDmworker(uint64(m.Successful))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovDmworker(uint64(m.Failed))
+	}
+	if m.Warning != 0 {
+		n += 1 + sovDmworker(uint64(m.Warning))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *DumpStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProgressDetail != nil {
+		l = m.ProgressDetail.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *LoadStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinishedBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.FinishedBytes))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalBytes))
+	}
+	l = len(m.Progress)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.MetaBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.MetaBinlogGTID)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.FinishedRows != 0 {
+		n += 1 + sovDmworker(uint64(m.FinishedRows))
+	}
+	if m.Bps != 0 {
+		n += 1 + sovDmworker(uint64(m.Bps))
+	}
+	if m.EstimatedRemainingSeconds != 0 {
+		n += 1 + sovDmworker(uint64(m.EstimatedRemainingSeconds))
+	}
+	if m.ProgressDetail != nil {
+		l = m.ProgressDetail.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardingGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.DDLs) > 0 {
+		for _, s := range m.DDLs {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	l = len(m.FirstLocation)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Synced) > 0 {
+		for _, s := range m.Synced {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	if len(m.Unsynced) > 0 {
+		for _, s := range m.Unsynced {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalEvents != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalEvents))
+	}
+	if m.TotalTps != 0 {
+		n += 1 + sovDmworker(uint64(m.TotalTps))
+	}
+	if m.RecentTps != 0 {
+		n += 1 + sovDmworker(uint64(m.RecentTps))
+	}
+	l = len(m.MasterBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.MasterBinlogGtid)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SyncerBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SyncerBinlogGtid)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.BlockingDDLs) > 0 {
+		for _, s := range m.BlockingDDLs {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	if len(m.UnresolvedGroups) > 0 {
+		for _, e := range m.UnresolvedGroups {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	if m.Synced {
+		n += 2
+	}
+	l = len(m.BinlogType)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.SecondsBehindMaster != 0 {
+		n += 1 + sovDmworker(uint64(m.SecondsBehindMaster))
+	}
+	l = len(m.LastEventTime)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.IdleDuration != 0 {
+		n += 1 + sovDmworker(uint64(m.IdleDuration))
+	}
+	if len(m.RunningDDLs) > 0 {
+		for _, e := range m.RunningDDLs {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	if m.ProgressDetail != nil {
+		l = m.ProgressDetail.Size()
+		n += 2 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SyncWindowStart)
+	if l > 0 {
+		n += 2 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *Progress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Finished != 0 {
+		n += 1 + sovDmworker(uint64(m.Finished))
+	}
+	if m.Total != 0 {
+		n += 1 + sovDmworker(uint64(m.Total))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Rate != 0 {
+		n += 1 + sovDmworker(uint64(m.Rate))
+	}
+	if m.EstimatedRemainingSeconds != 0 {
+		n += 1 + sovDmworker(uint64(m.EstimatedRemainingSeconds))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *DDLJobProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ddl)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.JobID != 0 {
+		n += 1 + sovDmworker(uint64(m.JobID))
+	}
+	l = len(m.JobType)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SchemaState)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RowCount != 0 {
+		n += 1 + sovDmworker(uint64(m.RowCount))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SourceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RelayStatus != nil {
+		l = m.RelayStatus.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.LatencySamples) > 0 {
+		for _, e := range m.LatencySamples {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceLatencySample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.MasterBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.MasterBinlogGtid)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RelayBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RelayLagBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.RelayLagBytes))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskLatencySample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SyncerBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SyncerBinlogGtid)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.LagBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.LagBytes))
+	}
+	return n
+}
+
+func (m *RelayStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MasterBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.MasterBinlogGtid)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RelaySubDir)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RelayBinlog)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RelayBinlogGtid)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RelayCatchUpMaster {
+		n += 2
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmworker(uint64(m.Stage))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.SpaceStatus != nil {
+		l = m.SpaceStatus.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.LastEventTime)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.IdleDuration != 0 {
+		n += 1 + sovDmworker(uint64(m.IdleDuration))
+	}
+	if m.Growth != nil {
+		l = m.Growth.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.DiskHealth != nil {
+		l = m.DiskHealth.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayDiskHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Healthy {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.LastCheckTime)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.UsagePercent != 0 {
+		n += 1 + sovDmworker(uint64(m.UsagePercent))
+	}
+	if m.InodeUsagePercent != 0 {
+		n += 1 + sovDmworker(uint64(m.InodeUsagePercent))
+	}
+	if m.WriteLatencyMs != 0 {
+		n += 1 + sovDmworker(uint64(m.WriteLatencyMs))
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *RelayGrowth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WriteRateShort != 0 {
+		n += 9
+	}
+	if m.WriteRateLong != 0 {
+		n += 9
+	}
+	if m.HoursUntilFull != 0 {
+		n += 9
+	}
+	if m.HoursUntilSpacePurge != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *RelaySpaceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Capacity != 0 {
+		n += 1 + sovDmworker(uint64(m.Capacity))
+	}
+	if m.Available != 0 {
+		n += 1 + sovDmworker(uint64(m.Available))
+	}
+	if m.UsagePercent != 0 {
+		n += 1 + sovDmworker(uint64(m.UsagePercent))
+	}
+	l = len(m.LastCheckTime)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.LastDecision)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SubTaskStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmworker(uint64(m.Stage))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovDmworker(uint64(m.Unit))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.UnresolvedDDLLockID)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Status != nil {
+		n += m.Status.Size()
+	}
+	if m.CrashLoop {
+		n += 2
+	}
+	return n
+}
+
+func (m *SubTaskStatus_Msg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	n += 1 + l + sovDmworker(uint64(l))
+	return n
+}
+func (m *SubTaskStatus_Check) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Check != nil {
+		l = m.Check.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskStatus_Dump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dump != nil {
+		l = m.Dump.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskStatus_Load) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Load != nil {
+		l = m.Load.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskStatus_Sync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sync != nil {
+		l = m.Sync.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskStatusList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		for _, e := range m.Status {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *DumpError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *LoadError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncSQLError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.FailedBinlogPosition)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.ErrorSQL)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SourceError)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.RelayError != nil {
+		l = m.RelayError.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *SubTaskError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmworker(uint64(m.Stage))
+	}
+	if m.Unit != 0 {
+		n += 1 + sovDmworker(uint64(m.Unit))
+	}
+	if m.Error != nil {
+		n += m.Error.Size()
+	}
+	return n
+}
+
+func (m *SubTaskError_Msg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	n += 1 + l + sovDmworker(uint64(l))
+	return n
+}
+func (m *SubTaskError_Check) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Check != nil {
+		l = m.Check.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskError_Dump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dump != nil {
+		l = m.Dump.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskError_Load) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Load != nil {
+		l = m.Load.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskError_Sync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sync != nil {
+		l = m.Sync.Size()
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+func (m *SubTaskErrorList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		for _, e := range m.Error {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProcessResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsCanceled {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ErrCode != 0 {
+		n += 1 + sovDmworker(uint64(m.ErrCode))
+	}
+	l = len(m.ErrClass)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.ErrScope)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.ErrLevel)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.RawCause)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Workaround)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *PurgeRelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inactive {
+		n += 2
+	}
+	if m.Time != 0 {
+		n += 1 + sovDmworker(uint64(m.Time))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.SubDir)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *OperateWorkerSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Flush {
+		n += 2
+	}
+	if m.Sync {
+		n += 2
+	}
+	return n
+}
+
+func (m *V1SubTaskMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDmworker(uint64(m.Stage))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *OperateV1MetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	return n
+}
+
+func (m *OperateV1MetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Meta) > 0 {
+		for k, v := range m.Meta {
+			_ = k
 			_ = v
 			l = 0
 			if v != nil {
 				l = v.Size()
 				l += 1 + sovDmworker(uint64(l))
 			}
-			mapEntrySize := 1 + len(k) + sovDmworker(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovDmworker(uint64(mapEntrySize))
-		}
-	}
-	return n
-}
-
-func (m *HandleWorkerErrorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Op != 0 {
-		n += 1 + sovDmworker(uint64(m.Op))
-	}
-	l = len(m.Task)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	l = len(m.BinlogPos)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	if len(m.Sqls) > 0 {
-		for _, s := range m.Sqls {
-			l = len(s)
-			n += 1 + l + sovDmworker(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *GetWorkerCfgRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *GetWorkerCfgResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Cfg)
-	if l > 0 {
-		n += 1 + l + sovDmworker(uint64(l))
-	}
-	return n
-}
-
-func sovDmworker(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDmworker(x uint64) (n int) {
-	return sovDmworker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			mapEntrySize := 1 + len(k) + sovDmworker(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovDmworker(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *HandleWorkerErrorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.BinlogPos)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Sqls) > 0 {
+		for _, s := range m.Sqls {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	l = len(m.BinlogGTID)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *GetWorkerCfgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetWorkerCfgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cfg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *CleanOrphanSubTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CleanOrphanSubTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.SubTasks) > 0 {
+		for _, s := range m.SubTasks {
+			l = len(s)
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidateConnectivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SubTaskCfg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *EndpointConnectivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.Connected {
+		n += 2
+	}
+	if m.Privileged {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidateConnectivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuarantineWorkerTableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *StartSubTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateSubTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *OperateSubTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Op != 0 {
+		n += 1 + sovDmworker(uint64(m.Op))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	return n
+}
+
+func sovDmworker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDmworker(x uint64) (n int) {
+	return sovDmworker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommonWorkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommonWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceStatus == nil {
+				m.SourceStatus = &SourceStatus{}
+			}
+			if err := m.SourceStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubTaskStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubTaskStatus = append(m.SubTaskStatus, &SubTaskStatus{})
+			if err := m.SubTaskStatus[len(m.SubTaskStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			}
+			m.Successful = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Successful |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			m.Warning = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Warning |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
+			if m.Detail == nil {
+				m.Detail = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DumpStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DumpStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DumpStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDetail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProgressDetail == nil {
+				m.ProgressDetail = &Progress{}
+			}
+			if err := m.ProgressDetail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedBytes", wireType)
+			}
+			m.FinishedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaBinlog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaBinlogGTID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaBinlogGTID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedRows", wireType)
+			}
+			m.FinishedRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+			}
+			m.Bps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedRemainingSeconds", wireType)
+			}
+			m.EstimatedRemainingSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedRemainingSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDetail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProgressDetail == nil {
+				m.ProgressDetail = &Progress{}
+			}
+			if err := m.ProgressDetail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardingGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardingGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Synced = append(m.Synced, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unsynced", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEvents", wireType)
+			}
+			m.TotalEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEvents |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTps", wireType)
+			}
+			m.TotalTps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentTps", wireType)
+			}
+			m.RecentTps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecentTps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncerBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlogGtid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncerBinlogGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockingDDLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockingDDLs = append(m.BlockingDDLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnresolvedGroups = append(m.UnresolvedGroups, &ShardingGroup{})
+			if err := m.UnresolvedGroups[len(m.UnresolvedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synced = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinlogType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsBehindMaster", wireType)
+			}
+			m.SecondsBehindMaster = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsBehindMaster |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleDuration", wireType)
+			}
+			m.IdleDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningDDLs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningDDLs = append(m.RunningDDLs, &DDLJobProgress{})
+			if err := m.RunningDDLs[len(m.RunningDDLs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDetail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProgressDetail == nil {
+				m.ProgressDetail = &Progress{}
+			}
+			if err := m.ProgressDetail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWindowStart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncWindowStart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Progress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Progress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Progress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			m.Finished = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finished |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedRemainingSeconds", wireType)
+			}
+			m.EstimatedRemainingSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedRemainingSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DDLJobProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DDLJobProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DDLJobProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ddl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ddl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+			}
+			m.JobID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowCount", wireType)
+			}
+			m.RowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayStatus == nil {
+				m.RelayStatus = &RelayStatus{}
+			}
+			if err := m.RelayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencySamples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatencySamples = append(m.LatencySamples, &SourceLatencySample{})
+			if err := m.LatencySamples[len(m.LatencySamples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceLatencySample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceLatencySample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceLatencySample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayLagBytes", wireType)
+			}
+			m.RelayLagBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayLagBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &TaskLatencySample{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskLatencySample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskLatencySample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskLatencySample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncerBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlogGtid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncerBinlogGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LagBytes", wireType)
+			}
+			m.LagBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LagBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelaySubDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelaySubDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayBinlog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlogGtid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayBinlogGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayCatchUpMaster", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RelayCatchUpMaster = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= Stage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SpaceStatus == nil {
+				m.SpaceStatus = &RelaySpaceStatus{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatusRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.SpaceStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5589,7 +11721,98 @@ func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.LastEventTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleDuration", wireType)
+			}
+			m.IdleDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Growth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Growth == nil {
+				m.Growth = &RelayGrowth{}
+			}
+			if err := m.Growth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskHealth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DiskHealth == nil {
+				m.DiskHealth = &RelayDiskHealth{}
+			}
+			if err := m.DiskHealth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5615,7 +11838,7 @@ func (m *QueryStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
+func (m *RelayDiskHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5638,15 +11861,15 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommonWorkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayDiskHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommonWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayDiskHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -5663,10 +11886,10 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
+			m.Healthy = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5694,11 +11917,11 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCheckTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5726,13 +11949,13 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.LastCheckTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsagePercent", wireType)
 			}
-			var stringLen uint64
+			m.UsagePercent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -5742,24 +11965,69 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UsagePercent |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InodeUsagePercent", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+			m.InodeUsagePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InodeUsagePercent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteLatencyMs", wireType)
+			}
+			m.WriteLatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteLatencyMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ReadOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -5784,7 +12052,7 @@ func (m *CommonWorkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *RelayGrowth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5807,134 +12075,56 @@ func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelayGrowth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelayGrowth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteRateShort", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Result = bool(v != 0)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.WriteRateShort = float64(math.Float64frombits(v))
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteRateLong", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.WriteRateLong = float64(math.Float64frombits(v))
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceStatus", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoursUntilFull", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourceStatus == nil {
-				m.SourceStatus = &SourceStatus{}
-			}
-			if err := m.SourceStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HoursUntilFull = float64(math.Float64frombits(v))
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubTaskStatus", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoursUntilSpacePurge", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubTaskStatus = append(m.SubTaskStatus, &SubTaskStatus{})
-			if err := m.SubTaskStatus[len(m.SubTaskStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HoursUntilSpacePurge = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -5959,7 +12149,7 @@ func (m *QueryStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckStatus) Unmarshal(dAtA []byte) error {
+func (m *RelaySpaceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5982,17 +12172,17 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelaySpaceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelaySpaceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			var v int
+			m.Capacity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6002,17 +12192,16 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Capacity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Passed = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			m.Total = 0
+			m.Available = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6022,16 +12211,16 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int32(b&0x7F) << shift
+				m.Available |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsagePercent", wireType)
 			}
-			m.Successful = 0
+			m.UsagePercent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6041,16 +12230,16 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
+				m.UsagePercent |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCheckTime", wireType)
 			}
-			m.Failed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6060,35 +12249,29 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			m.Warning = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Warning |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastCheckTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDecision", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6098,25 +12281,23 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
-			if m.Detail == nil {
-				m.Detail = []byte{}
-			}
+			m.LastDecision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6142,7 +12323,7 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DumpStatus) Unmarshal(dAtA []byte) error {
+func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6165,70 +12346,49 @@ func (m *DumpStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DumpStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubTaskStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DumpStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubTaskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDmworker
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LoadStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LoadStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			m.FinishedBytes = 0
+			m.Stage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6238,16 +12398,16 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinishedBytes |= int64(b&0x7F) << shift
+				m.Stage |= Stage(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			m.TotalBytes = 0
+			m.Unit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6257,16 +12417,16 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalBytes |= int64(b&0x7F) << shift
+				m.Unit |= UnitType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6276,27 +12436,31 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Progress = string(dAtA[iNdEx:postIndex])
+			if m.Result == nil {
+				m.Result = &ProcessResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedDDLLockID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6324,11 +12488,11 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaBinlog = string(dAtA[iNdEx:postIndex])
+			m.UnresolvedDDLLockID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaBinlogGTID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6356,66 +12520,48 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaBinlogGTID = string(dAtA[iNdEx:postIndex])
+			m.Status = &SubTaskStatus_Msg{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthDmworker
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &CheckStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShardingGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShardingGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Status = &SubTaskStatus_Check{v}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6425,29 +12571,32 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			v := &DumpStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Status = &SubTaskStatus_Dump{v}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DDLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6457,29 +12606,32 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DDLs = append(m.DDLs, string(dAtA[iNdEx:postIndex]))
+			v := &LoadStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Status = &SubTaskStatus_Load{v}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6489,29 +12641,32 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstLocation = string(dAtA[iNdEx:postIndex])
+			v := &SyncStatus{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Status = &SubTaskStatus_Sync{v}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashLoop", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6521,29 +12676,70 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.CrashLoop = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Synced = append(m.Synced, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubTaskStatusList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubTaskStatusList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unsynced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -6553,23 +12749,25 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unsynced = append(m.Unsynced, string(dAtA[iNdEx:postIndex]))
+			m.Status = append(m.Status, &SubTaskStatus{})
+			if err := m.Status[len(m.Status)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6595,7 +12793,7 @@ func (m *ShardingGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStatus) Unmarshal(dAtA []byte) error {
+func (m *CheckError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6618,72 +12816,15 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalEvents", wireType)
-			}
-			m.TotalEvents = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalEvents |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTps", wireType)
-			}
-			m.TotalTps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalTps |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentTps", wireType)
-			}
-			m.RecentTps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RecentTps |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6711,75 +12852,64 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlog", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DumpError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncerBinlog = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DumpError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DumpError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncerBinlogGtid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6807,97 +12937,64 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncerBinlogGtid = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockingDDLs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockingDDLs = append(m.BlockingDDLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedGroups", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnresolvedGroups = append(m.UnresolvedGroups, &ShardingGroup{})
-			if err := m.UnresolvedGroups[len(m.UnresolvedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Synced = bool(v != 0)
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinlogType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6925,27 +13022,8 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinlogType = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsBehindMaster", wireType)
-			}
-			m.SecondsBehindMaster = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SecondsBehindMaster |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -6970,7 +13048,7 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceStatus) Unmarshal(dAtA []byte) error {
+func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6993,15 +13071,15 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncSQLError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncSQLError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7029,11 +13107,11 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedBinlogPosition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7061,13 +13139,13 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
+			m.FailedBinlogPosition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorSQL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7077,31 +13155,80 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ErrorSQL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7128,10 +13255,8 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RelayStatus == nil {
-				m.RelayStatus = &RelayStatus{}
-			}
-			if err := m.RelayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Errors = append(m.Errors, &SyncSQLError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7159,7 +13284,7 @@ func (m *SourceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RelayStatus) Unmarshal(dAtA []byte) error {
+func (m *SourceError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7182,15 +13307,15 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RelayStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7218,11 +13343,11 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlog = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterBinlogGtid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7250,11 +13375,11 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterBinlogGtid = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelaySubDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7282,13 +13407,13 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelaySubDir = string(dAtA[iNdEx:postIndex])
+			m.SourceError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayError", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7298,100 +13423,86 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayBinlog = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayBinlogGtid", wireType)
+			if m.RelayError == nil {
+				m.RelayError = &RelayError{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.RelayError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayBinlogGtid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayCatchUpMaster", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
 			}
-			m.RelayCatchUpMaster = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Stage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7401,27 +13512,23 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7447,7 +13554,7 @@ func (m *RelayStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
+func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7470,10 +13577,10 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubTaskError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubTaskError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7547,74 +13654,6 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Result == nil {
-				m.Result = &ProcessResult{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnresolvedDDLLockID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnresolvedDDLLockID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
@@ -7644,9 +13683,9 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = &SubTaskStatus_Msg{string(dAtA[iNdEx:postIndex])}
+			m.Error = &SubTaskError_Msg{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
@@ -7675,13 +13714,13 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CheckStatus{}
+			v := &CheckError{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Status = &SubTaskStatus_Check{v}
+			m.Error = &SubTaskError_Check{v}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
 			}
@@ -7710,13 +13749,13 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DumpStatus{}
+			v := &DumpError{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Status = &SubTaskStatus_Dump{v}
+			m.Error = &SubTaskError_Dump{v}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
 			}
@@ -7745,13 +13784,13 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LoadStatus{}
+			v := &LoadError{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Status = &SubTaskStatus_Load{v}
+			m.Error = &SubTaskError_Load{v}
 			iNdEx = postIndex
-		case 10:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
@@ -7780,11 +13819,11 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SyncStatus{}
+			v := &SyncError{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Status = &SubTaskStatus_Sync{v}
+			m.Error = &SubTaskError_Sync{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7810,7 +13849,7 @@ func (m *SubTaskStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
+func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7833,15 +13872,15 @@ func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskStatusList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubTaskErrorList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskStatusList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubTaskErrorList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7868,8 +13907,8 @@ func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = append(m.Status, &SubTaskStatus{})
-			if err := m.Status[len(m.Status)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Error = append(m.Error, &SubTaskError{})
+			if err := m.Error[len(m.Error)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7897,7 +13936,7 @@ func (m *SubTaskStatusList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckError) Unmarshal(dAtA []byte) error {
+func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7920,17 +13959,37 @@ func (m *CheckError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckError: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCanceled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCanceled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -7940,82 +13999,31 @@ func (m *CheckError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DumpError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Errors = append(m.Errors, &ProcessError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DumpError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DumpError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8025,23 +14033,25 @@ func (m *DumpError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
+			if m.Detail == nil {
+				m.Detail = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8067,7 +14077,7 @@ func (m *DumpError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadError) Unmarshal(dAtA []byte) error {
+func (m *ProcessError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8090,15 +14100,34 @@ func (m *LoadError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadError: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
+			}
+			m.ErrCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8126,64 +14155,75 @@ func (m *LoadError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.ErrClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrScope", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
+			m.ErrScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrLevel", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncSQLError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncSQLError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8211,11 +14251,11 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedBinlogPosition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCause", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8243,11 +14283,11 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedBinlogPosition = string(dAtA[iNdEx:postIndex])
+			m.RawCause = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorSQL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workaround", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8275,7 +14315,7 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorSQL = string(dAtA[iNdEx:postIndex])
+			m.Workaround = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8301,7 +14341,7 @@ func (m *SyncSQLError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncError) Unmarshal(dAtA []byte) error {
+func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8324,17 +14364,88 @@ func (m *SyncError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncError: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeRelayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inactive = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
-			var msglen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDir", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8344,26 +14455,44 @@ func (m *SyncError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Errors = append(m.Errors, &SyncSQLError{})
-			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubDir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -8388,7 +14517,7 @@ func (m *SyncError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SourceError) Unmarshal(dAtA []byte) error {
+func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8411,17 +14540,17 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SourceError: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateWorkerSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SourceError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateWorkerSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var stringLen uint64
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8431,27 +14560,14 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Op |= SchemaOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8479,11 +14595,11 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8511,13 +14627,13 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceError = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8527,84 +14643,27 @@ func (m *SourceError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RelayError == nil {
-				m.RelayError = &RelayError{}
-			}
-			if err := m.RelayError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RelayError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RelayError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RelayError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8632,64 +14691,11 @@ func (m *RelayError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDmworker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubTaskError) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDmworker
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskError: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskError: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8717,13 +14723,13 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
 			}
-			m.Stage = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8733,16 +14739,17 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Flush = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			m.Unit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8752,48 +14759,70 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Unit |= UnitType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Sync = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = &SubTaskError_Msg{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: V1SubTaskMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: V1SubTaskMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8803,32 +14832,16 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Op |= TaskOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &CheckError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Check{v}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dump", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			var msglen int
+			m.Stage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8838,32 +14851,16 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Stage |= Stage(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DumpError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Dump{v}
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8873,32 +14870,29 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LoadError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Error = &SubTaskError_Load{v}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8908,26 +14902,25 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SyncError{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Task = append(m.Task[:0], dAtA[iNdEx:postIndex]...)
+			if m.Task == nil {
+				m.Task = []byte{}
 			}
-			m.Error = &SubTaskError_Sync{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8953,7 +14946,7 @@ func (m *SubTaskError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
+func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8976,17 +14969,17 @@ func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubTaskErrorList: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateV1MetaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubTaskErrorList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateV1MetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var msglen int
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -8996,26 +14989,11 @@ func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Op |= V1MetaOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = append(m.Error, &SubTaskError{})
-			if err := m.Error[len(m.Error)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -9040,7 +15018,7 @@ func (m *SubTaskErrorList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessResult) Unmarshal(dAtA []byte) error {
+func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9063,15 +15041,15 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateV1MetaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateV1MetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCanceled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9088,12 +15066,12 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsCanceled = bool(v != 0)
+			m.Result = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9103,31 +15081,29 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Errors = append(m.Errors, &ProcessError{})
-			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9137,25 +15113,120 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Detail = append(m.Detail[:0], dAtA[iNdEx:postIndex]...)
-			if m.Detail == nil {
-				m.Detail = []byte{}
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Meta == nil {
+				m.Meta = make(map[string]*V1SubTaskMeta)
+			}
+			var mapkey string
+			var mapvalue *V1SubTaskMeta
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDmworker
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDmworker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDmworker
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &V1SubTaskMeta{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDmworker(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthDmworker
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Meta[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9181,7 +15252,7 @@ func (m *ProcessResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessError) Unmarshal(dAtA []byte) error {
+func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9204,17 +15275,17 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessError: wiretype end group for non-group")
+			return fmt.Errorf("proto: HandleWorkerErrorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HandleWorkerErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.ErrCode = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9224,14 +15295,14 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrCode |= int32(b&0x7F) << shift
+				m.Op |= ErrorOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9259,11 +15330,11 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrClass = string(dAtA[iNdEx:postIndex])
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogPos", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9291,11 +15362,11 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrScope = string(dAtA[iNdEx:postIndex])
+			m.BinlogPos = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sqls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9323,11 +15394,11 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrLevel = string(dAtA[iNdEx:postIndex])
+			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinlogGTID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9355,72 +15426,61 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.BinlogGTID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCause", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawCause = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workaround", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workaround = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerCfgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -9445,7 +15505,7 @@ func (m *ProcessError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
+func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9468,86 +15528,15 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeRelayRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkerCfgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeRelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inactive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Inactive = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
-			}
-			m.Time = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			return fmt.Errorf("proto: GetWorkerCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubDir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9575,7 +15564,7 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubDir = string(dAtA[iNdEx:postIndex])
+			m.Cfg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9601,7 +15590,7 @@ func (m *PurgeRelayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *CleanOrphanSubTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9624,68 +15613,70 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateWorkerSchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanOrphanSubTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateWorkerSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanOrphanSubTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= SchemaOp(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CleanOrphanSubTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CleanOrphanSubTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CleanOrphanSubTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9695,27 +15686,15 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.Result = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9743,11 +15722,11 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9775,11 +15754,11 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubTasks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9807,48 +15786,8 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = string(dAtA[iNdEx:postIndex])
+			m.SubTasks = append(m.SubTasks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flush", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Flush = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Sync = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -9873,7 +15812,7 @@ func (m *OperateWorkerSchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
+func (m *ValidateConnectivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9895,88 +15834,18 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: V1SubTaskMeta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: V1SubTaskMeta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
-			}
-			m.Op = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Op |= TaskOp(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
-			}
-			m.Stage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Stage |= Stage(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDmworker
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDmworker
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateConnectivityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateConnectivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubTaskCfg", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -9986,25 +15855,23 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Task = append(m.Task[:0], dAtA[iNdEx:postIndex]...)
-			if m.Task == nil {
-				m.Task = []byte{}
-			}
+			m.SubTaskCfg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10030,7 +15897,7 @@ func (m *V1SubTaskMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
+func (m *EndpointConnectivity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10053,17 +15920,81 @@ func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateV1MetaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndpointConnectivity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateV1MetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndpointConnectivity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Connected", wireType)
 			}
-			m.Op = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10073,11 +16004,64 @@ func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= V1MetaOp(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Connected = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Privileged = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -10102,7 +16086,7 @@ func (m *OperateV1MetaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidateConnectivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10125,10 +16109,10 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperateV1MetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateConnectivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperateV1MetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateConnectivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10185,9 +16169,9 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDmworker
@@ -10197,120 +16181,89 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDmworker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDmworker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Meta == nil {
-				m.Meta = make(map[string]*V1SubTaskMeta)
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			var mapkey string
-			var mapvalue *V1SubTaskMeta
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDmworker
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDmworker
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDmworker
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &V1SubTaskMeta{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipDmworker(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthDmworker
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Meta[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, &EndpointConnectivity{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10336,7 +16289,7 @@ func (m *OperateV1MetaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
+func (m *QuarantineWorkerTableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10359,10 +16312,10 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HandleWorkerErrorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuarantineWorkerTableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandleWorkerErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuarantineWorkerTableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10379,7 +16332,7 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= ErrorOp(b&0x7F) << shift
+				m.Op |= QuarantineOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10418,7 +16371,7 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinlogPos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10446,11 +16399,11 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinlogPos = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sqls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10478,7 +16431,39 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sqls = append(m.Sqls, string(dAtA[iNdEx:postIndex]))
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10504,7 +16489,7 @@ func (m *HandleWorkerErrorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
+func (m *StartSubTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10527,12 +16512,149 @@ func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerCfgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartSubTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerCfgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartSubTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateSubTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateSubTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSubTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -10557,7 +16679,7 @@ func (m *GetWorkerCfgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
+func (m *OperateSubTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10580,15 +16702,34 @@ func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerCfgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperateSubTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerCfgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperateSubTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			m.Op = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Op |= TaskOp(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cfg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10616,7 +16757,7 @@ func (m *GetWorkerCfgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cfg = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
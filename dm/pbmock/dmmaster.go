@@ -36,6 +36,26 @@ func (m *MockMasterClient) EXPECT() *MockMasterClientMockRecorder {
 	return m.recorder
 }
 
+// BackupEtcd mocks base method.
+func (m *MockMasterClient) BackupEtcd(arg0 context.Context, arg1 *pb.BackupEtcdRequest, arg2 ...grpc.CallOption) (pb.Master_BackupEtcdClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BackupEtcd", varargs...)
+	ret0, _ := ret[0].(pb.Master_BackupEtcdClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupEtcd indicates an expected call of BackupEtcd.
+func (mr *MockMasterClientMockRecorder) BackupEtcd(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupEtcd", reflect.TypeOf((*MockMasterClient)(nil).BackupEtcd), varargs...)
+}
+
 // CheckTask mocks base method.
 func (m *MockMasterClient) CheckTask(arg0 context.Context, arg1 *pb.CheckTaskRequest, arg2 ...grpc.CallOption) (*pb.CheckTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +176,26 @@ func (mr *MockMasterClientMockRecorder) ListMember(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMember", reflect.TypeOf((*MockMasterClient)(nil).ListMember), varargs...)
 }
 
+// MigrateKeySchema mocks base method.
+func (m *MockMasterClient) MigrateKeySchema(arg0 context.Context, arg1 *pb.MigrateKeySchemaRequest, arg2 ...grpc.CallOption) (*pb.MigrateKeySchemaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateKeySchema", varargs...)
+	ret0, _ := ret[0].(*pb.MigrateKeySchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateKeySchema indicates an expected call of MigrateKeySchema.
+func (mr *MockMasterClientMockRecorder) MigrateKeySchema(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateKeySchema", reflect.TypeOf((*MockMasterClient)(nil).MigrateKeySchema), varargs...)
+}
+
 // OfflineMember mocks base method.
 func (m *MockMasterClient) OfflineMember(arg0 context.Context, arg1 *pb.OfflineMemberRequest, arg2 ...grpc.CallOption) (*pb.OfflineMemberResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +236,26 @@ func (mr *MockMasterClientMockRecorder) OperateLeader(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateLeader", reflect.TypeOf((*MockMasterClient)(nil).OperateLeader), varargs...)
 }
 
+// OperateProject mocks base method.
+func (m *MockMasterClient) OperateProject(arg0 context.Context, arg1 *pb.OperateProjectRequest, arg2 ...grpc.CallOption) (*pb.OperateProjectResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperateProject", varargs...)
+	ret0, _ := ret[0].(*pb.OperateProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateProject indicates an expected call of OperateProject.
+func (mr *MockMasterClientMockRecorder) OperateProject(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateProject", reflect.TypeOf((*MockMasterClient)(nil).OperateProject), varargs...)
+}
+
 // OperateRelay mocks base method.
 func (m *MockMasterClient) OperateRelay(arg0 context.Context, arg1 *pb.OperateRelayRequest, arg2 ...grpc.CallOption) (*pb.OperateRelayResponse, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +336,26 @@ func (mr *MockMasterClientMockRecorder) OperateTask(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateTask", reflect.TypeOf((*MockMasterClient)(nil).OperateTask), varargs...)
 }
 
+// OperateTaskLock mocks base method.
+func (m *MockMasterClient) OperateTaskLock(arg0 context.Context, arg1 *pb.OperateTaskLockRequest, arg2 ...grpc.CallOption) (*pb.OperateTaskLockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperateTaskLock", varargs...)
+	ret0, _ := ret[0].(*pb.OperateTaskLockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateTaskLock indicates an expected call of OperateTaskLock.
+func (mr *MockMasterClientMockRecorder) OperateTaskLock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateTaskLock", reflect.TypeOf((*MockMasterClient)(nil).OperateTaskLock), varargs...)
+}
+
 // OperateWorkerRelayTask mocks base method.
 func (m *MockMasterClient) OperateWorkerRelayTask(arg0 context.Context, arg1 *pb.OperateWorkerRelayRequest, arg2 ...grpc.CallOption) (*pb.OperateWorkerRelayResponse, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +376,26 @@ func (mr *MockMasterClientMockRecorder) OperateWorkerRelayTask(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateWorkerRelayTask", reflect.TypeOf((*MockMasterClient)(nil).OperateWorkerRelayTask), varargs...)
 }
 
+// OperationHistory mocks base method.
+func (m *MockMasterClient) OperationHistory(arg0 context.Context, arg1 *pb.OperationHistoryRequest, arg2 ...grpc.CallOption) (*pb.OperationHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperationHistory", varargs...)
+	ret0, _ := ret[0].(*pb.OperationHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperationHistory indicates an expected call of OperationHistory.
+func (mr *MockMasterClientMockRecorder) OperationHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperationHistory", reflect.TypeOf((*MockMasterClient)(nil).OperationHistory), varargs...)
+}
+
 // PurgeWorkerRelay mocks base method.
 func (m *MockMasterClient) PurgeWorkerRelay(arg0 context.Context, arg1 *pb.PurgeWorkerRelayRequest, arg2 ...grpc.CallOption) (*pb.PurgeWorkerRelayResponse, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +416,26 @@ func (mr *MockMasterClientMockRecorder) PurgeWorkerRelay(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWorkerRelay", reflect.TypeOf((*MockMasterClient)(nil).PurgeWorkerRelay), varargs...)
 }
 
+// QuarantineTable mocks base method.
+func (m *MockMasterClient) QuarantineTable(arg0 context.Context, arg1 *pb.QuarantineTableRequest, arg2 ...grpc.CallOption) (*pb.QuarantineTableResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuarantineTable", varargs...)
+	ret0, _ := ret[0].(*pb.QuarantineTableResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuarantineTable indicates an expected call of QuarantineTable.
+func (mr *MockMasterClientMockRecorder) QuarantineTable(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuarantineTable", reflect.TypeOf((*MockMasterClient)(nil).QuarantineTable), varargs...)
+}
+
 // QueryStatus mocks base method.
 func (m *MockMasterClient) QueryStatus(arg0 context.Context, arg1 *pb.QueryStatusListRequest, arg2 ...grpc.CallOption) (*pb.QueryStatusListResponse, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +476,26 @@ func (mr *MockMasterClientMockRecorder) RegisterWorker(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWorker", reflect.TypeOf((*MockMasterClient)(nil).RegisterWorker), varargs...)
 }
 
+// RestoreCluster mocks base method.
+func (m *MockMasterClient) RestoreCluster(arg0 context.Context, arg1 *pb.RestoreClusterRequest, arg2 ...grpc.CallOption) (*pb.RestoreClusterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreCluster", varargs...)
+	ret0, _ := ret[0].(*pb.RestoreClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCluster indicates an expected call of RestoreCluster.
+func (mr *MockMasterClientMockRecorder) RestoreCluster(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCluster", reflect.TypeOf((*MockMasterClient)(nil).RestoreCluster), varargs...)
+}
+
 // ShowDDLLocks mocks base method.
 func (m *MockMasterClient) ShowDDLLocks(arg0 context.Context, arg1 *pb.ShowDDLLocksRequest, arg2 ...grpc.CallOption) (*pb.ShowDDLLocksResponse, error) {
 	m.ctrl.T.Helper()
@@ -456,6 +596,66 @@ func (mr *MockMasterClientMockRecorder) UpdateTask(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockMasterClient)(nil).UpdateTask), varargs...)
 }
 
+// UpgradeCluster mocks base method.
+func (m *MockMasterClient) UpgradeCluster(arg0 context.Context, arg1 *pb.UpgradeClusterRequest, arg2 ...grpc.CallOption) (*pb.UpgradeClusterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpgradeCluster", varargs...)
+	ret0, _ := ret[0].(*pb.UpgradeClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeCluster indicates an expected call of UpgradeCluster.
+func (mr *MockMasterClientMockRecorder) UpgradeCluster(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeCluster", reflect.TypeOf((*MockMasterClient)(nil).UpgradeCluster), varargs...)
+}
+
+// ValidateConnectivity mocks base method.
+func (m *MockMasterClient) ValidateConnectivity(arg0 context.Context, arg1 *pb.ValidateTaskConnectivityRequest, arg2 ...grpc.CallOption) (*pb.ValidateTaskConnectivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateConnectivity", varargs...)
+	ret0, _ := ret[0].(*pb.ValidateTaskConnectivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateConnectivity indicates an expected call of ValidateConnectivity.
+func (mr *MockMasterClientMockRecorder) ValidateConnectivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateConnectivity", reflect.TypeOf((*MockMasterClient)(nil).ValidateConnectivity), varargs...)
+}
+
+// WatchTaskStatus mocks base method.
+func (m *MockMasterClient) WatchTaskStatus(arg0 context.Context, arg1 *pb.WatchTaskStatusRequest, arg2 ...grpc.CallOption) (pb.Master_WatchTaskStatusClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchTaskStatus", varargs...)
+	ret0, _ := ret[0].(pb.Master_WatchTaskStatusClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchTaskStatus indicates an expected call of WatchTaskStatus.
+func (mr *MockMasterClientMockRecorder) WatchTaskStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchTaskStatus", reflect.TypeOf((*MockMasterClient)(nil).WatchTaskStatus), varargs...)
+}
+
 // MockMasterServer is a mock of MasterServer interface.
 type MockMasterServer struct {
 	ctrl     *gomock.Controller
@@ -479,6 +679,20 @@ func (m *MockMasterServer) EXPECT() *MockMasterServerMockRecorder {
 	return m.recorder
 }
 
+// BackupEtcd mocks base method.
+func (m *MockMasterServer) BackupEtcd(arg0 *pb.BackupEtcdRequest, arg1 pb.Master_BackupEtcdServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupEtcd", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackupEtcd indicates an expected call of BackupEtcd.
+func (mr *MockMasterServerMockRecorder) BackupEtcd(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupEtcd", reflect.TypeOf((*MockMasterServer)(nil).BackupEtcd), arg0, arg1)
+}
+
 // CheckTask mocks base method.
 func (m *MockMasterServer) CheckTask(arg0 context.Context, arg1 *pb.CheckTaskRequest) (*pb.CheckTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -569,6 +783,21 @@ func (mr *MockMasterServerMockRecorder) ListMember(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMember", reflect.TypeOf((*MockMasterServer)(nil).ListMember), arg0, arg1)
 }
 
+// MigrateKeySchema mocks base method.
+func (m *MockMasterServer) MigrateKeySchema(arg0 context.Context, arg1 *pb.MigrateKeySchemaRequest) (*pb.MigrateKeySchemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateKeySchema", arg0, arg1)
+	ret0, _ := ret[0].(*pb.MigrateKeySchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateKeySchema indicates an expected call of MigrateKeySchema.
+func (mr *MockMasterServerMockRecorder) MigrateKeySchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateKeySchema", reflect.TypeOf((*MockMasterServer)(nil).MigrateKeySchema), arg0, arg1)
+}
+
 // OfflineMember mocks base method.
 func (m *MockMasterServer) OfflineMember(arg0 context.Context, arg1 *pb.OfflineMemberRequest) (*pb.OfflineMemberResponse, error) {
 	m.ctrl.T.Helper()
@@ -599,6 +828,21 @@ func (mr *MockMasterServerMockRecorder) OperateLeader(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateLeader", reflect.TypeOf((*MockMasterServer)(nil).OperateLeader), arg0, arg1)
 }
 
+// OperateProject mocks base method.
+func (m *MockMasterServer) OperateProject(arg0 context.Context, arg1 *pb.OperateProjectRequest) (*pb.OperateProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperateProject", arg0, arg1)
+	ret0, _ := ret[0].(*pb.OperateProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateProject indicates an expected call of OperateProject.
+func (mr *MockMasterServerMockRecorder) OperateProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateProject", reflect.TypeOf((*MockMasterServer)(nil).OperateProject), arg0, arg1)
+}
+
 // OperateRelay mocks base method.
 func (m *MockMasterServer) OperateRelay(arg0 context.Context, arg1 *pb.OperateRelayRequest) (*pb.OperateRelayResponse, error) {
 	m.ctrl.T.Helper()
@@ -659,6 +903,21 @@ func (mr *MockMasterServerMockRecorder) OperateTask(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateTask", reflect.TypeOf((*MockMasterServer)(nil).OperateTask), arg0, arg1)
 }
 
+// OperateTaskLock mocks base method.
+func (m *MockMasterServer) OperateTaskLock(arg0 context.Context, arg1 *pb.OperateTaskLockRequest) (*pb.OperateTaskLockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperateTaskLock", arg0, arg1)
+	ret0, _ := ret[0].(*pb.OperateTaskLockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateTaskLock indicates an expected call of OperateTaskLock.
+func (mr *MockMasterServerMockRecorder) OperateTaskLock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateTaskLock", reflect.TypeOf((*MockMasterServer)(nil).OperateTaskLock), arg0, arg1)
+}
+
 // OperateWorkerRelayTask mocks base method.
 func (m *MockMasterServer) OperateWorkerRelayTask(arg0 context.Context, arg1 *pb.OperateWorkerRelayRequest) (*pb.OperateWorkerRelayResponse, error) {
 	m.ctrl.T.Helper()
@@ -674,6 +933,21 @@ func (mr *MockMasterServerMockRecorder) OperateWorkerRelayTask(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateWorkerRelayTask", reflect.TypeOf((*MockMasterServer)(nil).OperateWorkerRelayTask), arg0, arg1)
 }
 
+// OperationHistory mocks base method.
+func (m *MockMasterServer) OperationHistory(arg0 context.Context, arg1 *pb.OperationHistoryRequest) (*pb.OperationHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperationHistory", arg0, arg1)
+	ret0, _ := ret[0].(*pb.OperationHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperationHistory indicates an expected call of OperationHistory.
+func (mr *MockMasterServerMockRecorder) OperationHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperationHistory", reflect.TypeOf((*MockMasterServer)(nil).OperationHistory), arg0, arg1)
+}
+
 // PurgeWorkerRelay mocks base method.
 func (m *MockMasterServer) PurgeWorkerRelay(arg0 context.Context, arg1 *pb.PurgeWorkerRelayRequest) (*pb.PurgeWorkerRelayResponse, error) {
 	m.ctrl.T.Helper()
@@ -689,6 +963,21 @@ func (mr *MockMasterServerMockRecorder) PurgeWorkerRelay(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWorkerRelay", reflect.TypeOf((*MockMasterServer)(nil).PurgeWorkerRelay), arg0, arg1)
 }
 
+// QuarantineTable mocks base method.
+func (m *MockMasterServer) QuarantineTable(arg0 context.Context, arg1 *pb.QuarantineTableRequest) (*pb.QuarantineTableResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuarantineTable", arg0, arg1)
+	ret0, _ := ret[0].(*pb.QuarantineTableResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuarantineTable indicates an expected call of QuarantineTable.
+func (mr *MockMasterServerMockRecorder) QuarantineTable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuarantineTable", reflect.TypeOf((*MockMasterServer)(nil).QuarantineTable), arg0, arg1)
+}
+
 // QueryStatus mocks base method.
 func (m *MockMasterServer) QueryStatus(arg0 context.Context, arg1 *pb.QueryStatusListRequest) (*pb.QueryStatusListResponse, error) {
 	m.ctrl.T.Helper()
@@ -719,6 +1008,21 @@ func (mr *MockMasterServerMockRecorder) RegisterWorker(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterWorker", reflect.TypeOf((*MockMasterServer)(nil).RegisterWorker), arg0, arg1)
 }
 
+// RestoreCluster mocks base method.
+func (m *MockMasterServer) RestoreCluster(arg0 context.Context, arg1 *pb.RestoreClusterRequest) (*pb.RestoreClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCluster", arg0, arg1)
+	ret0, _ := ret[0].(*pb.RestoreClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCluster indicates an expected call of RestoreCluster.
+func (mr *MockMasterServerMockRecorder) RestoreCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCluster", reflect.TypeOf((*MockMasterServer)(nil).RestoreCluster), arg0, arg1)
+}
+
 // ShowDDLLocks mocks base method.
 func (m *MockMasterServer) ShowDDLLocks(arg0 context.Context, arg1 *pb.ShowDDLLocksRequest) (*pb.ShowDDLLocksResponse, error) {
 	m.ctrl.T.Helper()
@@ -793,3 +1097,47 @@ func (mr *MockMasterServerMockRecorder) UpdateTask(arg0, arg1 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockMasterServer)(nil).UpdateTask), arg0, arg1)
 }
+
+// UpgradeCluster mocks base method.
+func (m *MockMasterServer) UpgradeCluster(arg0 context.Context, arg1 *pb.UpgradeClusterRequest) (*pb.UpgradeClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeCluster", arg0, arg1)
+	ret0, _ := ret[0].(*pb.UpgradeClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeCluster indicates an expected call of UpgradeCluster.
+func (mr *MockMasterServerMockRecorder) UpgradeCluster(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeCluster", reflect.TypeOf((*MockMasterServer)(nil).UpgradeCluster), arg0, arg1)
+}
+
+// ValidateConnectivity mocks base method.
+func (m *MockMasterServer) ValidateConnectivity(arg0 context.Context, arg1 *pb.ValidateTaskConnectivityRequest) (*pb.ValidateTaskConnectivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateConnectivity", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ValidateTaskConnectivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateConnectivity indicates an expected call of ValidateConnectivity.
+func (mr *MockMasterServerMockRecorder) ValidateConnectivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateConnectivity", reflect.TypeOf((*MockMasterServer)(nil).ValidateConnectivity), arg0, arg1)
+}
+
+// WatchTaskStatus mocks base method.
+func (m *MockMasterServer) WatchTaskStatus(arg0 *pb.WatchTaskStatusRequest, arg1 pb.Master_WatchTaskStatusServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchTaskStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchTaskStatus indicates an expected call of WatchTaskStatus.
+func (mr *MockMasterServerMockRecorder) WatchTaskStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchTaskStatus", reflect.TypeOf((*MockMasterServer)(nil).WatchTaskStatus), arg0, arg1)
+}
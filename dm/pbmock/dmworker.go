@@ -36,6 +36,26 @@ func (m *MockWorkerClient) EXPECT() *MockWorkerClientMockRecorder {
 	return m.recorder
 }
 
+// CleanOrphanSubTasks mocks base method.
+func (m *MockWorkerClient) CleanOrphanSubTasks(arg0 context.Context, arg1 *pb.CleanOrphanSubTasksRequest, arg2 ...grpc.CallOption) (*pb.CleanOrphanSubTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CleanOrphanSubTasks", varargs...)
+	ret0, _ := ret[0].(*pb.CleanOrphanSubTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanOrphanSubTasks indicates an expected call of CleanOrphanSubTasks.
+func (mr *MockWorkerClientMockRecorder) CleanOrphanSubTasks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanOrphanSubTasks", reflect.TypeOf((*MockWorkerClient)(nil).CleanOrphanSubTasks), varargs...)
+}
+
 // GetWorkerCfg mocks base method.
 func (m *MockWorkerClient) GetWorkerCfg(arg0 context.Context, arg1 *pb.GetWorkerCfgRequest, arg2 ...grpc.CallOption) (*pb.GetWorkerCfgResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +116,26 @@ func (mr *MockWorkerClientMockRecorder) OperateSchema(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSchema", reflect.TypeOf((*MockWorkerClient)(nil).OperateSchema), varargs...)
 }
 
+// OperateSubTask mocks base method.
+func (m *MockWorkerClient) OperateSubTask(arg0 context.Context, arg1 *pb.OperateSubTaskRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OperateSubTask", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateSubTask indicates an expected call of OperateSubTask.
+func (mr *MockWorkerClientMockRecorder) OperateSubTask(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSubTask", reflect.TypeOf((*MockWorkerClient)(nil).OperateSubTask), varargs...)
+}
+
 // OperateV1Meta mocks base method.
 func (m *MockWorkerClient) OperateV1Meta(arg0 context.Context, arg1 *pb.OperateV1MetaRequest, arg2 ...grpc.CallOption) (*pb.OperateV1MetaResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +176,26 @@ func (mr *MockWorkerClientMockRecorder) PurgeRelay(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRelay", reflect.TypeOf((*MockWorkerClient)(nil).PurgeRelay), varargs...)
 }
 
+// QuarantineTable mocks base method.
+func (m *MockWorkerClient) QuarantineTable(arg0 context.Context, arg1 *pb.QuarantineWorkerTableRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuarantineTable", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuarantineTable indicates an expected call of QuarantineTable.
+func (mr *MockWorkerClientMockRecorder) QuarantineTable(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuarantineTable", reflect.TypeOf((*MockWorkerClient)(nil).QuarantineTable), varargs...)
+}
+
 // QueryStatus mocks base method.
 func (m *MockWorkerClient) QueryStatus(arg0 context.Context, arg1 *pb.QueryStatusRequest, arg2 ...grpc.CallOption) (*pb.QueryStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +216,66 @@ func (mr *MockWorkerClientMockRecorder) QueryStatus(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockWorkerClient)(nil).QueryStatus), varargs...)
 }
 
+// StartSubTask mocks base method.
+func (m *MockWorkerClient) StartSubTask(arg0 context.Context, arg1 *pb.StartSubTaskRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartSubTask", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSubTask indicates an expected call of StartSubTask.
+func (mr *MockWorkerClientMockRecorder) StartSubTask(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSubTask", reflect.TypeOf((*MockWorkerClient)(nil).StartSubTask), varargs...)
+}
+
+// UpdateSubTask mocks base method.
+func (m *MockWorkerClient) UpdateSubTask(arg0 context.Context, arg1 *pb.UpdateSubTaskRequest, arg2 ...grpc.CallOption) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSubTask", varargs...)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubTask indicates an expected call of UpdateSubTask.
+func (mr *MockWorkerClientMockRecorder) UpdateSubTask(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubTask", reflect.TypeOf((*MockWorkerClient)(nil).UpdateSubTask), varargs...)
+}
+
+// ValidateConnectivity mocks base method.
+func (m *MockWorkerClient) ValidateConnectivity(arg0 context.Context, arg1 *pb.ValidateConnectivityRequest, arg2 ...grpc.CallOption) (*pb.ValidateConnectivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateConnectivity", varargs...)
+	ret0, _ := ret[0].(*pb.ValidateConnectivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateConnectivity indicates an expected call of ValidateConnectivity.
+func (mr *MockWorkerClientMockRecorder) ValidateConnectivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateConnectivity", reflect.TypeOf((*MockWorkerClient)(nil).ValidateConnectivity), varargs...)
+}
+
 // MockWorkerServer is a mock of WorkerServer interface.
 type MockWorkerServer struct {
 	ctrl     *gomock.Controller
@@ -179,6 +299,21 @@ func (m *MockWorkerServer) EXPECT() *MockWorkerServerMockRecorder {
 	return m.recorder
 }
 
+// CleanOrphanSubTasks mocks base method.
+func (m *MockWorkerServer) CleanOrphanSubTasks(arg0 context.Context, arg1 *pb.CleanOrphanSubTasksRequest) (*pb.CleanOrphanSubTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanOrphanSubTasks", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CleanOrphanSubTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanOrphanSubTasks indicates an expected call of CleanOrphanSubTasks.
+func (mr *MockWorkerServerMockRecorder) CleanOrphanSubTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanOrphanSubTasks", reflect.TypeOf((*MockWorkerServer)(nil).CleanOrphanSubTasks), arg0, arg1)
+}
+
 // GetWorkerCfg mocks base method.
 func (m *MockWorkerServer) GetWorkerCfg(arg0 context.Context, arg1 *pb.GetWorkerCfgRequest) (*pb.GetWorkerCfgResponse, error) {
 	m.ctrl.T.Helper()
@@ -224,6 +359,21 @@ func (mr *MockWorkerServerMockRecorder) OperateSchema(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSchema", reflect.TypeOf((*MockWorkerServer)(nil).OperateSchema), arg0, arg1)
 }
 
+// OperateSubTask mocks base method.
+func (m *MockWorkerServer) OperateSubTask(arg0 context.Context, arg1 *pb.OperateSubTaskRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperateSubTask", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperateSubTask indicates an expected call of OperateSubTask.
+func (mr *MockWorkerServerMockRecorder) OperateSubTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperateSubTask", reflect.TypeOf((*MockWorkerServer)(nil).OperateSubTask), arg0, arg1)
+}
+
 // OperateV1Meta mocks base method.
 func (m *MockWorkerServer) OperateV1Meta(arg0 context.Context, arg1 *pb.OperateV1MetaRequest) (*pb.OperateV1MetaResponse, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +404,21 @@ func (mr *MockWorkerServerMockRecorder) PurgeRelay(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRelay", reflect.TypeOf((*MockWorkerServer)(nil).PurgeRelay), arg0, arg1)
 }
 
+// QuarantineTable mocks base method.
+func (m *MockWorkerServer) QuarantineTable(arg0 context.Context, arg1 *pb.QuarantineWorkerTableRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuarantineTable", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuarantineTable indicates an expected call of QuarantineTable.
+func (mr *MockWorkerServerMockRecorder) QuarantineTable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuarantineTable", reflect.TypeOf((*MockWorkerServer)(nil).QuarantineTable), arg0, arg1)
+}
+
 // QueryStatus mocks base method.
 func (m *MockWorkerServer) QueryStatus(arg0 context.Context, arg1 *pb.QueryStatusRequest) (*pb.QueryStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -268,3 +433,48 @@ func (mr *MockWorkerServerMockRecorder) QueryStatus(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryStatus", reflect.TypeOf((*MockWorkerServer)(nil).QueryStatus), arg0, arg1)
 }
+
+// StartSubTask mocks base method.
+func (m *MockWorkerServer) StartSubTask(arg0 context.Context, arg1 *pb.StartSubTaskRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSubTask", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSubTask indicates an expected call of StartSubTask.
+func (mr *MockWorkerServerMockRecorder) StartSubTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSubTask", reflect.TypeOf((*MockWorkerServer)(nil).StartSubTask), arg0, arg1)
+}
+
+// UpdateSubTask mocks base method.
+func (m *MockWorkerServer) UpdateSubTask(arg0 context.Context, arg1 *pb.UpdateSubTaskRequest) (*pb.CommonWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubTask", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CommonWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubTask indicates an expected call of UpdateSubTask.
+func (mr *MockWorkerServerMockRecorder) UpdateSubTask(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubTask", reflect.TypeOf((*MockWorkerServer)(nil).UpdateSubTask), arg0, arg1)
+}
+
+// ValidateConnectivity mocks base method.
+func (m *MockWorkerServer) ValidateConnectivity(arg0 context.Context, arg1 *pb.ValidateConnectivityRequest) (*pb.ValidateConnectivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateConnectivity", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ValidateConnectivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateConnectivity indicates an expected call of ValidateConnectivity.
+func (mr *MockWorkerServerMockRecorder) ValidateConnectivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateConnectivity", reflect.TypeOf((*MockWorkerServer)(nil).ValidateConnectivity), arg0, arg1)
+}
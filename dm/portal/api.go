@@ -60,19 +60,26 @@ type Handler struct {
 
 	// the timeout for connect database and query, unit: second
 	timeout int
+
+	// used to access the sources through dm-master, nil if dm-master's address is not configured
+	master *masterClient
 }
 
 // NewHandler returns a new Handler.
-func NewHandler(path string, timeout int) *Handler {
+func NewHandler(path string, timeout int, masterAddr string) *Handler {
 	rd := render.New(render.Options{
 		IndentJSON: true,
 	})
 
-	return &Handler{
+	h := &Handler{
 		path:    strings.TrimRight(path, "/"),
 		timeout: timeout,
 		r:       rd,
 	}
+	if masterAddr != "" {
+		h.master = newMasterClient(masterAddr, timeout)
+	}
+	return h
 }
 
 // CommonResult is the common result.
@@ -114,7 +121,26 @@ type AnalyzeResult struct {
 func (p *Handler) Check(w http.ResponseWriter, req *http.Request) {
 	log.L().Info("receive Check request")
 
-	db, addr, err := getDBConnFunc(req, p.timeout)
+	connReq, err := readConnRequest(req)
+	if err == nil && p.proxyByMaster(connReq) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(p.timeout)*time.Second)
+		defer cancel()
+		// dm-master can list the schemas means the source can be connected.
+		_, err = p.master.getSchemas(ctx, connReq.SourceID)
+		if err == nil {
+			log.L().Info("check success through dm-master", zap.String("source", connReq.SourceID))
+		}
+	} else if err == nil {
+		var (
+			db   *sql.DB
+			addr string
+		)
+		db, addr, err = getDBConnFunc(connReq.DBConfig, p.timeout)
+		if err == nil {
+			defer db.Close()
+			log.L().Info("check success", zap.String("address", addr))
+		}
+	}
 	if err != nil {
 		log.L().Error("connect to database failed", zap.Error(err))
 		p.genJSONResp(w, http.StatusBadRequest, CheckResult{
@@ -125,8 +151,6 @@ func (p *Handler) Check(w http.ResponseWriter, req *http.Request) {
 		})
 		return
 	}
-	defer db.Close()
-	log.L().Info("check success", zap.String("address", addr))
 
 	p.genJSONResp(w, http.StatusOK, CheckResult{
 		CommonResult: CommonResult{
@@ -140,7 +164,23 @@ func (p *Handler) Check(w http.ResponseWriter, req *http.Request) {
 func (p *Handler) GetSchemaInfo(w http.ResponseWriter, req *http.Request) {
 	log.L().Info("receive GetSchemaInfo request")
 
-	db, addr, err := getDBConnFunc(req, p.timeout)
+	var allTables []TablesInSchema
+	connReq, err := readConnRequest(req)
+	if err == nil && p.proxyByMaster(connReq) {
+		log.L().Info("find all the tables through dm-master", zap.String("source", connReq.SourceID))
+		allTables, err = p.getSchemaInfoFromMaster(connReq.SourceID)
+	} else if err == nil {
+		var (
+			db   *sql.DB
+			addr string
+		)
+		db, addr, err = getDBConnFunc(connReq.DBConfig, p.timeout)
+		if err == nil {
+			defer db.Close()
+			log.L().Info("find all the tables", zap.String("address", addr))
+			allTables, err = p.getSchemaInfoFromDB(db)
+		}
+	}
 	if err != nil {
 		p.genJSONResp(w, http.StatusBadRequest, SchemaInfoResult{
 			CommonResult: CommonResult{
@@ -150,22 +190,24 @@ func (p *Handler) GetSchemaInfo(w http.ResponseWriter, req *http.Request) {
 		})
 		return
 	}
-	defer db.Close()
-	log.L().Info("find all the tables", zap.String("address", addr))
 
+	p.genJSONResp(w, http.StatusOK, SchemaInfoResult{
+		CommonResult: CommonResult{
+			Result: success,
+			Error:  "",
+		},
+		Tables: allTables,
+	})
+}
+
+func (p *Handler) getSchemaInfoFromDB(db *sql.DB) ([]TablesInSchema, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(p.timeout)*time.Second)
 	defer cancel()
 
 	schemas, err := dbutil.GetSchemas(ctx, db)
 	if err != nil {
 		log.L().Error("get schemas failed", zap.Error(err))
-		p.genJSONResp(w, http.StatusBadRequest, SchemaInfoResult{
-			CommonResult: CommonResult{
-				Result: failed,
-				Error:  err.Error(),
-			},
-		})
-		return
+		return nil, err
 	}
 
 	allTables := make([]TablesInSchema, 0, len(schemas))
@@ -177,24 +219,38 @@ func (p *Handler) GetSchemaInfo(w http.ResponseWriter, req *http.Request) {
 		tables, err := dbutil.GetTables(ctx, db, schema)
 		if err != nil {
 			log.L().Error("get tables failed", zap.String("schema", schema), zap.Error(err))
-			p.genJSONResp(w, http.StatusBadRequest, SchemaInfoResult{
-				CommonResult: CommonResult{
-					Result: failed,
-					Error:  err.Error(),
-				},
-			})
-			return
+			return nil, err
 		}
 		allTables = append(allTables, TablesInSchema{Schema: schema, Tables: tables})
 	}
+	return allTables, nil
+}
 
-	p.genJSONResp(w, http.StatusOK, SchemaInfoResult{
-		CommonResult: CommonResult{
-			Result: success,
-			Error:  "",
-		},
-		Tables: allTables,
-	})
+func (p *Handler) getSchemaInfoFromMaster(sourceID string) ([]TablesInSchema, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(p.timeout)*time.Second)
+	defer cancel()
+
+	schemas, err := p.master.getSchemas(ctx, sourceID)
+	if err != nil {
+		log.L().Error("get schemas through dm-master failed", zap.String("source", sourceID), zap.Error(err))
+		return nil, err
+	}
+
+	allTables := make([]TablesInSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		tables, err := p.master.getTables(ctx, sourceID, schema)
+		if err != nil {
+			log.L().Error("get tables through dm-master failed", zap.String("source", sourceID), zap.String("schema", schema), zap.Error(err))
+			return nil, err
+		}
+		allTables = append(allTables, TablesInSchema{Schema: schema, Tables: tables})
+	}
+	return allTables, nil
+}
+
+// proxyByMaster returns whether to fetch the information of the source through dm-master.
+func (p *Handler) proxyByMaster(connReq *connRequest) bool {
+	return p.master != nil && connReq.SourceID != ""
 }
 
 // GenerateConfig generates config file used for dm.
@@ -435,15 +491,26 @@ func (p *Handler) genJSONResp(w io.Writer, status int, v interface{}) {
 	}
 }
 
-var getDBConnFunc = getDBConnFromReq
+// connRequest is the request of Check and GetSchemaInfo.
+// if SourceID is specified and dm-master's address is configured, the database is accessed through dm-master,
+// otherwise the database is connected directly with DBConfig.
+type connRequest struct {
+	DBConfig
+	SourceID string `json:"source-id"`
+}
 
-func getDBConnFromReq(req *http.Request, timeout int) (*sql.DB, string, error) {
-	dbCfg := &DBConfig{}
-	if err := readJSON(req.Body, dbCfg); err != nil {
-		return nil, "", errors.Trace(err)
+func readConnRequest(req *http.Request) (*connRequest, error) {
+	connReq := &connRequest{}
+	if err := readJSON(req.Body, connReq); err != nil {
+		return nil, errors.Trace(err)
 	}
+	return connReq, nil
+}
+
+var getDBConnFunc = getDBConn
 
-	db, err := openDB(*dbCfg, timeout)
+func getDBConn(dbCfg DBConfig, timeout int) (*sql.DB, string, error) {
+	db, err := openDB(dbCfg, timeout)
 	if err != nil {
 		return nil, "", errors.Trace(err)
 	}
@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/mock"
 
 	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/openapi"
 )
 
 var _ = Suite(&testPortalSuite{})
@@ -55,7 +56,7 @@ func TestSuite(t *testing.T) {
 }
 
 func (t *testPortalSuite) SetUpSuite(c *C) {
-	t.portalHandler = NewHandler(c.MkDir(), 10)
+	t.portalHandler = NewHandler(c.MkDir(), 10, "")
 	t.allTables = []TablesInSchema{
 		{
 			Schema: "db_1",
@@ -177,9 +178,10 @@ func (t *testPortalSuite) TestCheck(c *C) {
 	// don't need connection to database, and will return StatusOK
 	getDBConnFunc = t.getMockDB
 	defer func() {
-		getDBConnFunc = getDBConnFromReq
+		getDBConnFunc = getDBConn
 	}()
 
+	req = httptest.NewRequest("POST", "/check", bytes.NewReader(dbCfgBytes))
 	resp = httptest.NewRecorder()
 	t.portalHandler.Check(resp, req)
 	c.Assert(resp.Code, Equals, http.StatusOK)
@@ -211,9 +213,10 @@ func (t *testPortalSuite) TestGetSchemaInfo(c *C) {
 
 	getDBConnFunc = t.getMockDB
 	defer func() {
-		getDBConnFunc = getDBConnFromReq
+		getDBConnFunc = getDBConn
 	}()
 
+	req = httptest.NewRequest("POST", "/schema", bytes.NewReader(dbCfgBytes))
 	resp = httptest.NewRecorder()
 	t.portalHandler.GetSchemaInfo(resp, req)
 	c.Assert(resp.Code, Equals, http.StatusOK)
@@ -231,6 +234,123 @@ func (t *testPortalSuite) TestGetSchemaInfo(c *C) {
 	}
 }
 
+func (t *testPortalSuite) TestThroughMaster(c *C) {
+	// mock the OpenAPI of dm-master.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/sources/source-1/schemas", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]string{"db_1", "db_2"})
+	})
+	mux.HandleFunc("/api/v1/sources/source-1/schemas/db_1", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]string{"t_1", "t_2"})
+	})
+	mux.HandleFunc("/api/v1/sources/source-1/schemas/db_2", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]string{})
+	})
+	mux.HandleFunc("/api/v1/sources/source-2/schemas", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 46006, "error_msg": "source config with ID source-2 not exists"})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	handler := NewHandler(c.MkDir(), 10, server.URL)
+
+	// check and get schema info through dm-master
+	req := httptest.NewRequest("POST", "/check", strings.NewReader(`{"source-id": "source-1"}`))
+	resp := httptest.NewRecorder()
+	handler.Check(resp, req)
+	c.Assert(resp.Code, Equals, http.StatusOK)
+
+	req = httptest.NewRequest("POST", "/schema", strings.NewReader(`{"source-id": "source-1"}`))
+	resp = httptest.NewRecorder()
+	handler.GetSchemaInfo(resp, req)
+	c.Assert(resp.Code, Equals, http.StatusOK)
+	schemaInfoResult := new(SchemaInfoResult)
+	c.Assert(readJSON(resp.Body, schemaInfoResult), IsNil)
+	c.Assert(schemaInfoResult.Result, Equals, success)
+	c.Assert(schemaInfoResult.Tables, DeepEquals, t.allTables[:2])
+
+	// the error of dm-master is returned
+	req = httptest.NewRequest("POST", "/check", strings.NewReader(`{"source-id": "source-2"}`))
+	resp = httptest.NewRecorder()
+	handler.Check(resp, req)
+	c.Assert(resp.Code, Equals, http.StatusBadRequest)
+	checkResult := &CheckResult{}
+	c.Assert(readJSON(resp.Body, checkResult), IsNil)
+	c.Assert(checkResult.Result, Equals, failed)
+	c.Assert(checkResult.Error, Equals, "source config with ID source-2 not exists")
+
+	// connect to the database directly if source-id is not specified
+	getDBConnFunc = t.getMockDB
+	defer func() {
+		getDBConnFunc = getDBConn
+	}()
+	dbCfgBytes := getTestDBCfgBytes(c, &config.DBConfig{Host: "127.0.0.1", Port: 3306})
+	req = httptest.NewRequest("POST", "/schema", bytes.NewReader(dbCfgBytes))
+	resp = httptest.NewRecorder()
+	handler.GetSchemaInfo(resp, req)
+	c.Assert(resp.Code, Equals, http.StatusOK)
+	c.Assert(readJSON(resp.Body, schemaInfoResult), IsNil)
+	c.Assert(schemaInfoResult.Tables, HasLen, len(t.allTables)-1)
+}
+
+func (t *testPortalSuite) TestTaskProgress(c *C) {
+	// dm-master's address is not configured.
+	req := httptest.NewRequest("GET", "/task_progress", nil)
+	resp := httptest.NewRecorder()
+	t.portalHandler.TaskProgress(resp, req)
+	c.Assert(resp.Code, Equals, http.StatusBadRequest)
+
+	// mock the OpenAPI of dm-master.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/tasks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(openapi.GetTaskListResponse{Total: 1, Data: []openapi.Task{{Name: "task-1"}}})
+	})
+	mux.HandleFunc("/api/v1/tasks/task-1/status", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(openapi.GetTaskStatusResponse{Total: 4, Data: []openapi.SubTaskStatus{
+			{SourceName: "source-1", WorkerName: "worker-1", Stage: "Running", Unit: "Dump"},
+			{SourceName: "source-2", WorkerName: "worker-2", Stage: "Running", Unit: "Load", LoadStatus: &openapi.LoadStatus{FinishedBytes: 50, TotalBytes: 100}},
+			{SourceName: "source-3", WorkerName: "worker-3", Stage: "Running", Unit: "Sync", SyncStatus: &openapi.SyncStatus{SecondsBehindMaster: 10}},
+			{SourceName: "source-4", WorkerName: "worker-4", Stage: "Paused", Unit: "Sync", SyncStatus: &openapi.SyncStatus{SecondsBehindMaster: 30}},
+		}})
+	})
+	mux.HandleFunc("/api/v1/tasks/task-2/status", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 46018, "error_msg": "task with name task-2 not exist"})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	handler := NewHandler(c.MkDir(), 10, server.URL)
+	req = httptest.NewRequest("GET", "/task_progress", nil)
+	resp = httptest.NewRecorder()
+	handler.TaskProgress(resp, req)
+	c.Assert(resp.Code, Equals, http.StatusOK)
+	result := &TaskProgressResult{}
+	c.Assert(readJSON(resp.Body, result), IsNil)
+	c.Assert(result.Tasks, HasLen, 1)
+	task := result.Tasks[0]
+	c.Assert(task.Task, Equals, "task-1")
+	c.Assert(task.DumpProgress, Equals, 75.0)
+	c.Assert(task.LoadProgress, Equals, 62.5)
+	c.Assert(task.BinlogLag, Equals, int64(30))
+	c.Assert(task.Subtasks, DeepEquals, []SubTaskProgress{
+		{Source: "source-1", Worker: "worker-1", Stage: "Running", Unit: "Dump", BinlogLag: -1},
+		{Source: "source-2", Worker: "worker-2", Stage: "Running", Unit: "Load", DumpProgress: 100, LoadProgress: 50, BinlogLag: -1},
+		{Source: "source-3", Worker: "worker-3", Stage: "Running", Unit: "Sync", DumpProgress: 100, LoadProgress: 100, BinlogLag: 10},
+		{Source: "source-4", Worker: "worker-4", Stage: "Paused", Unit: "Sync", DumpProgress: 100, LoadProgress: 100, BinlogLag: 30},
+	})
+
+	// the error of dm-master is returned
+	req = httptest.NewRequest("GET", "/task_progress?task=task-2", nil)
+	resp = httptest.NewRecorder()
+	handler.TaskProgress(resp, req)
+	c.Assert(resp.Code, Equals, http.StatusBadRequest)
+	c.Assert(readJSON(resp.Body, result), IsNil)
+	c.Assert(result.Result, Equals, failed)
+	c.Assert(result.Error, Equals, "task with name task-2 not exist")
+}
+
 func (t *testPortalSuite) TestGenerateAndDownloadAndAnalyzeConfig(c *C) {
 	t.initTaskCfg()
 
@@ -322,7 +442,7 @@ func (t *testPortalSuite) TestAnalyzeRuleName(c *C) {
 	}
 }
 
-func (t *testPortalSuite) getMockDB(req *http.Request, timeout int) (*sql.DB, string, error) {
+func (t *testPortalSuite) getMockDB(dbCfg DBConfig, timeout int) (*sql.DB, string, error) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		return nil, "", err
@@ -44,6 +44,9 @@ type Config struct {
 	// Timeout is the timeout for connect database and query, unit: second
 	Timeout int
 
+	// MasterAddr is the address of dm-master, used to access the sources and query the progress of the tasks through dm-master
+	MasterAddr string
+
 	printVersion bool
 }
 
@@ -56,6 +59,7 @@ func NewConfig() *Config {
 	fs.IntVar(&cfg.Port, "port", 8280, "the port for server to listen")
 	fs.StringVar(&cfg.TaskFilePath, "task-file-path", "/tmp/", "the path used to save generated task config file")
 	fs.IntVar(&cfg.Timeout, "timeout", 5, "the timeout for connect database and query, unit: second")
+	fs.StringVar(&cfg.MasterAddr, "master-addr", "", "the address of dm-master, if specified, the sources can be accessed through dm-master by source-id, and the progress of the tasks can be queried")
 	fs.BoolVar(&cfg.printVersion, "V", false, "prints version and exit")
 
 	return cfg
@@ -109,7 +113,7 @@ func (cfg *Config) Valid() error {
 
 // String return config's string.
 func (cfg *Config) String() string {
-	return fmt.Sprintf("dm-portal config: { port: %d, task-file-path: %s }", cfg.Port, cfg.TaskFilePath)
+	return fmt.Sprintf("dm-portal config: { port: %d, task-file-path: %s, master-addr: %s }", cfg.Port, cfg.TaskFilePath, cfg.MasterAddr)
 }
 
 // DMTaskConfig is the dm task's config used for dm-portal.
@@ -150,9 +154,9 @@ func (d *DMTaskConfig) Verify() error {
 	switch d.TaskMode {
 	case "":
 		return errors.New("task mode should not be empty")
-	case config.ModeAll, config.ModeFull, config.ModeIncrement:
+	case config.ModeAll, config.ModeFull, config.ModeIncrement, config.ModeSchemaOnly:
 	default:
-		return errors.New("task mode should be 'all', 'full' or 'incremental'")
+		return errors.New("task mode should be 'all', 'full', 'incremental' or 'schema-only'")
 	}
 
 	for _, mysqlInstance := range d.MySQLInstances {
@@ -34,7 +34,7 @@ func (t *testConfigSuite) TestConfig(c *C) {
 			"/tmp",
 			5,
 			true,
-			"dm-portal config: { port: 1234, task-file-path: /tmp }",
+			"dm-portal config: { port: 1234, task-file-path: /tmp, master-addr:  }",
 		}, {
 			123456,
 			"tmp",
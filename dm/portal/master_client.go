@@ -0,0 +1,102 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+
+	"github.com/pingcap/dm/openapi"
+)
+
+// masterClient fetches the schema information of the upstream and the status of the tasks
+// through the OpenAPI of dm-master, dm-master already holds the credentials of the sources, so dm-portal doesn't need
+// network access or credentials to every upstream MySQL.
+type masterClient struct {
+	baseURL string
+	cli     *http.Client
+}
+
+func newMasterClient(addr string, timeout int) *masterClient {
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+	return &masterClient{
+		baseURL: strings.TrimRight(addr, "/"),
+		cli:     &http.Client{Timeout: time.Duration(timeout) * time.Second},
+	}
+}
+
+// getSchemas gets all the non-system schemas of the source.
+func (m *masterClient) getSchemas(ctx context.Context, sourceID string) ([]string, error) {
+	var schemas openapi.SchemaNameList
+	err := m.get(ctx, fmt.Sprintf("/api/v1/sources/%s/schemas", url.PathEscape(sourceID)), &schemas)
+	return schemas, err
+}
+
+// getTables gets all the tables in the schema of the source.
+func (m *masterClient) getTables(ctx context.Context, sourceID, schema string) ([]string, error) {
+	var tables openapi.TableNameList
+	err := m.get(ctx, fmt.Sprintf("/api/v1/sources/%s/schemas/%s", url.PathEscape(sourceID), url.PathEscape(schema)), &tables)
+	return tables, err
+}
+
+// getTaskNames gets the names of all the tasks.
+func (m *masterClient) getTaskNames(ctx context.Context) ([]string, error) {
+	var resp openapi.GetTaskListResponse
+	if err := m.get(ctx, "/api/v1/tasks", &resp); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(resp.Data))
+	for _, task := range resp.Data {
+		names = append(names, task.Name)
+	}
+	return names, nil
+}
+
+// getTaskStatus gets the status of all the subtasks of the task, which is queried from dm-workers by dm-master.
+func (m *masterClient) getTaskStatus(ctx context.Context, taskName string) ([]openapi.SubTaskStatus, error) {
+	var resp openapi.GetTaskStatusResponse
+	err := m.get(ctx, fmt.Sprintf("/api/v1/tasks/%s/status", url.PathEscape(taskName)), &resp)
+	return resp.Data, err
+}
+
+func (m *masterClient) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.baseURL+path, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// the request will be redirected to the leader of dm-master automatically.
+	resp, err := m.cli.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		errResp := &openapi.ErrorWithMessage{}
+		if err = readJSON(resp.Body, errResp); err != nil || errResp.ErrorMsg == "" {
+			return errors.Errorf("request %s to dm-master failed, status: %s", path, resp.Status)
+		}
+		return errors.New(errResp.ErrorMsg)
+	}
+	return errors.Trace(json.NewDecoder(resp.Body).Decode(v))
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portal
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pingcap/errors"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/openapi"
+	"github.com/pingcap/dm/pkg/log"
+)
+
+// TaskProgressResult is the result of TaskProgress.
+type TaskProgressResult struct {
+	CommonResult
+	Tasks []TaskProgress `json:"tasks"`
+}
+
+// TaskProgress is the progress of a task aggregated from its subtasks.
+type TaskProgress struct {
+	Task string `json:"task"`
+	// the average progress of the subtasks, in percent.
+	DumpProgress float64 `json:"dump-progress"`
+	LoadProgress float64 `json:"load-progress"`
+	// the max seconds behind master of the subtasks in the sync unit, -1 if no subtask is in the sync unit.
+	BinlogLag int64             `json:"binlog-lag"`
+	Subtasks  []SubTaskProgress `json:"subtasks"`
+}
+
+// SubTaskProgress is the progress of a subtask.
+type SubTaskProgress struct {
+	Source string `json:"source"`
+	Worker string `json:"worker"`
+	Stage  string `json:"stage"`
+	Unit   string `json:"unit"`
+	// the dump unit doesn't report its progress, so it's 0 until the dump unit finishes.
+	DumpProgress float64 `json:"dump-progress"`
+	LoadProgress float64 `json:"load-progress"`
+	// -1 if the subtask is not in the sync unit.
+	BinlogLag int64 `json:"binlog-lag"`
+}
+
+// TaskProgress gets the progress of the tasks through dm-master, all the tasks are returned if `task` is not specified.
+func (p *Handler) TaskProgress(w http.ResponseWriter, req *http.Request) {
+	log.L().Info("receive TaskProgress request")
+
+	tasks, err := p.getTaskProgress(req.URL.Query().Get("task"))
+	if err != nil {
+		log.L().Error("get task progress through dm-master failed", zap.Error(err))
+		p.genJSONResp(w, http.StatusBadRequest, TaskProgressResult{
+			CommonResult: CommonResult{
+				Result: failed,
+				Error:  err.Error(),
+			},
+		})
+		return
+	}
+
+	p.genJSONResp(w, http.StatusOK, TaskProgressResult{
+		CommonResult: CommonResult{
+			Result: success,
+			Error:  "",
+		},
+		Tasks: tasks,
+	})
+}
+
+func (p *Handler) getTaskProgress(taskName string) ([]TaskProgress, error) {
+	if p.master == nil {
+		return nil, errors.New("dm-master's address is not configured")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(p.timeout)*time.Second)
+	defer cancel()
+
+	taskNames := []string{taskName}
+	if taskName == "" {
+		var err error
+		if taskNames, err = p.master.getTaskNames(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	tasks := make([]TaskProgress, 0, len(taskNames))
+	for _, name := range taskNames {
+		status, err := p.master.getTaskStatus(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, aggregateTaskProgress(name, status))
+	}
+	return tasks, nil
+}
+
+// aggregateTaskProgress aggregates the progress of the subtasks.
+func aggregateTaskProgress(taskName string, status []openapi.SubTaskStatus) TaskProgress {
+	task := TaskProgress{
+		Task:      taskName,
+		BinlogLag: -1,
+		Subtasks:  make([]SubTaskProgress, 0, len(status)),
+	}
+	for _, st := range status {
+		sub := subTaskProgress(st)
+		task.DumpProgress += sub.DumpProgress
+		task.LoadProgress += sub.LoadProgress
+		if sub.BinlogLag > task.BinlogLag {
+			task.BinlogLag = sub.BinlogLag
+		}
+		task.Subtasks = append(task.Subtasks, sub)
+	}
+	if len(status) > 0 {
+		task.DumpProgress /= float64(len(status))
+		task.LoadProgress /= float64(len(status))
+	}
+	return task
+}
+
+func subTaskProgress(st openapi.SubTaskStatus) SubTaskProgress {
+	sub := SubTaskProgress{
+		Source:    st.SourceName,
+		Worker:    st.WorkerName,
+		Stage:     st.Stage,
+		Unit:      st.Unit,
+		BinlogLag: -1,
+	}
+	finished := st.Stage == pb.Stage_Finished.String()
+	switch st.Unit {
+	case pb.UnitType_Dump.String():
+		if finished {
+			sub.DumpProgress = 100
+		}
+	case pb.UnitType_Load.String():
+		sub.DumpProgress = 100
+		switch {
+		case finished:
+			sub.LoadProgress = 100
+		case st.LoadStatus != nil && st.LoadStatus.TotalBytes > 0:
+			sub.LoadProgress = float64(st.LoadStatus.FinishedBytes) * 100 / float64(st.LoadStatus.TotalBytes)
+		}
+	case pb.UnitType_Sync.String():
+		// the full data is already migrated, or not needed in the incremental mode.
+		sub.DumpProgress = 100
+		sub.LoadProgress = 100
+		if st.SyncStatus != nil {
+			sub.BinlogLag = st.SyncStatus.SecondsBehindMaster
+		}
+	}
+	return sub
+}
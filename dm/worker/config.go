@@ -38,6 +38,8 @@ var SampleConfigFile string
 var (
 	defaultKeepAliveTTL      = int64(60)      // 1 minute
 	defaultRelayKeepAliveTTL = int64(60 * 30) // 30 minutes
+
+	defaultRecoverSubTaskConcurrency = 4
 )
 
 func init() {
@@ -71,6 +73,10 @@ func NewConfig() *Config {
 	fs.StringVar(&cfg.Name, "name", "", "human-readable name for DM-worker member")
 	fs.Int64Var(&cfg.KeepAliveTTL, "keepalive-ttl", defaultKeepAliveTTL, "dm-worker's TTL for keepalive with etcd (in seconds)")
 	fs.Int64Var(&cfg.RelayKeepAliveTTL, "relay-keepalive-ttl", defaultRelayKeepAliveTTL, "dm-worker's TTL for keepalive with etcd when handle relay enabled sources (in seconds)")
+	fs.StringVar(&cfg.SourceConfig, "source-config", "", "path of the source config file, run in standalone mode without DM-master if specified")
+	fs.StringVar(&cfg.DataDir, "data-dir", "", `path to the data directory in standalone mode (default "default.${name}")`)
+	fs.IntVar(&cfg.RecoverSubTaskConcurrency, "recover-subtask-concurrency", defaultRecoverSubTaskConcurrency, "max number of subtasks recovered concurrently when dm-worker starts to handle a source")
+	fs.IntVar(&cfg.DownstreamPoolSize, "downstream-pool-size", 0, "max number of downstream connections shared by the DMLs of subtasks, 0 means not shared")
 
 	fs.StringVar(&cfg.SSLCA, "ssl-ca", "", "path of file that contains list of trusted SSL CAs for connection")
 	fs.StringVar(&cfg.SSLCert, "ssl-cert", "", "path of file that contains X509 certificate in PEM format for connection")
@@ -99,6 +105,21 @@ type Config struct {
 	KeepAliveTTL      int64 `toml:"keepalive-ttl" json:"keepalive-ttl"`
 	RelayKeepAliveTTL int64 `toml:"relay-keepalive-ttl" json:"relay-keepalive-ttl"`
 
+	// standalone mode, the source config and subtasks are persisted in an embedded etcd rather than DM-master.
+	SourceConfig string `toml:"source-config" json:"source-config"`
+	DataDir      string `toml:"data-dir" json:"data-dir"`
+
+	// subtasks of the source are recovered in background with bounded concurrency, to avoid overloading upstream and
+	// downstream when there are many subtasks.
+	RecoverSubTaskConcurrency int `toml:"recover-subtask-concurrency" json:"recover-subtask-concurrency"`
+
+	// the DMLs of the subtasks targeting the same downstream share a pool of at most `downstream-pool-size`
+	// connections, every subtask holds a fair share of the pool. 0 means every subtask has its own connections.
+	DownstreamPoolSize int `toml:"downstream-pool-size" json:"downstream-pool-size"`
+
+	// labels are reported to DM-master along with the keepalive, and used by the `label-affinity` scheduler policy.
+	Labels map[string]string `toml:"labels" json:"labels"`
+
 	// tls config
 	config.Security
 
@@ -202,6 +223,22 @@ func (c *Config) adjust() error {
 		c.Name = c.AdvertiseAddr
 	}
 
+	if c.RecoverSubTaskConcurrency <= 0 {
+		c.RecoverSubTaskConcurrency = defaultRecoverSubTaskConcurrency
+	}
+	if c.DownstreamPoolSize < 0 {
+		c.DownstreamPoolSize = 0
+	}
+
+	if c.Standalone() {
+		if c.Join != "" {
+			return terror.ErrWorkerInvalidStandaloneConfig.Generate("`join` can't be specified together with `source-config`")
+		}
+		if c.DataDir == "" {
+			c.DataDir = "default." + c.Name
+		}
+	}
+
 	if c.Join != "" {
 		c.Join = utils.WrapSchemes(c.Join, c.SSLCA != "")
 	}
@@ -209,6 +246,11 @@ func (c *Config) adjust() error {
 	return nil
 }
 
+// Standalone returns whether the dm-worker runs in standalone mode without DM-master.
+func (c *Config) Standalone() bool {
+	return c.SourceConfig != ""
+}
+
 // configFromFile loads config from file.
 func (c *Config) configFromFile(path string) error {
 	metaData, err := toml.DecodeFile(path, c)
@@ -0,0 +1,154 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/conn"
+)
+
+// roles of the endpoints in the result of validating connectivity.
+const (
+	endpointRoleUpstream   = "upstream"
+	endpointRoleDownstream = "downstream"
+)
+
+// downstreamPrivileges are the privileges required to replicate data and save checkpoints in downstream.
+var downstreamPrivileges = []mysql.PrivilegeType{
+	mysql.SelectPriv,
+	mysql.InsertPriv,
+	mysql.UpdatePriv,
+	mysql.DeletePriv,
+	mysql.CreatePriv,
+	mysql.DropPriv,
+	mysql.AlterPriv,
+	mysql.IndexPriv,
+}
+
+// validateConnectivity checks the connectivity and privileges to the upstream and downstream databases of the subtask.
+// cfg should have decrypted passwords.
+func validateConnectivity(ctx context.Context, cfg *config.SubTaskConfig) []*pb.EndpointConnectivity {
+	endpoints := make([]*pb.EndpointConnectivity, 0, 2+len(cfg.Targets))
+	endpoints = append(endpoints, validateEndpoint(endpointRoleUpstream, cfg.From, func(db *conn.BaseDB) string {
+		return checkUpstreamPrivileges(ctx, db, cfg.From, cfg.Mode)
+	}))
+	for _, target := range append([]config.DBConfig{cfg.To}, cfg.Targets...) {
+		endpoints = append(endpoints, validateEndpoint(endpointRoleDownstream, target, func(db *conn.BaseDB) string {
+			grants, err := dbutil.ShowGrants(ctx, db.DB, "", "")
+			if err != nil {
+				return err.Error()
+			}
+			return verifyDownstreamPrivileges(grants)
+		}))
+	}
+	return endpoints
+}
+
+// validateEndpoint connects to the database and checks the privileges by checkPrivileges, which returns
+// the reason if the privileges are not enough.
+func validateEndpoint(role string, dbCfg config.DBConfig, checkPrivileges func(*conn.BaseDB) string) *pb.EndpointConnectivity {
+	endpoint := &pb.EndpointConnectivity{
+		Role:    role,
+		Address: net.JoinHostPort(dbCfg.Host, strconv.Itoa(dbCfg.Port)),
+	}
+	db, err := conn.DefaultDBProvider.Apply(dbCfg)
+	if err != nil {
+		endpoint.Msg = err.Error()
+		return endpoint
+	}
+	defer db.Close()
+	endpoint.Connected = true
+	endpoint.Msg = checkPrivileges(db)
+	endpoint.Privileged = endpoint.Msg == ""
+	return endpoint
+}
+
+// checkUpstreamPrivileges checks the dump privileges for full data migration and the replication privileges for
+// incremental replication in upstream like `check-task` does.
+func checkUpstreamPrivileges(ctx context.Context, db *conn.BaseDB, dbCfg config.DBConfig, mode string) string {
+	dbInfo := &dbutil.DBConfig{Host: dbCfg.Host, Port: dbCfg.Port, User: dbCfg.User}
+	var checkers []check.Checker
+	if mode != config.ModeIncrement {
+		checkers = append(checkers, check.NewSourceDumpPrivilegeChecker(db.DB, dbInfo))
+	}
+	if mode != config.ModeFull && mode != config.ModeSchemaOnly {
+		checkers = append(checkers, check.NewSourceReplicationPrivilegeChecker(db.DB, dbInfo))
+	}
+
+	var msgs []string
+	for _, checker := range checkers {
+		result := checker.Check(ctx)
+		if result.State == check.StateSuccess {
+			continue
+		}
+		for _, e := range result.Errors {
+			msgs = append(msgs, e.ShortErr)
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// verifyDownstreamPrivileges returns the lacking privileges in downstream by the result of `SHOW GRANTS`.
+// a privilege granted on any database or table is treated as granted, because the target tables may be routed.
+func verifyDownstreamPrivileges(grants []string) string {
+	lack := make(map[mysql.PrivilegeType]struct{}, len(downstreamPrivileges))
+	for _, priv := range downstreamPrivileges {
+		lack[priv] = struct{}{}
+	}
+
+	p := parser.New()
+	for _, grant := range grants {
+		stmt, err := p.ParseOneStmt(grant, "", "")
+		if err != nil {
+			return fmt.Sprintf("fail to parse grant %s: %v", grant, err)
+		}
+		// grants of proxies and roles are ignored.
+		grantStmt, ok := stmt.(*ast.GrantStmt)
+		if !ok {
+			continue
+		}
+		for _, privElem := range grantStmt.Privs {
+			if privElem.Priv == mysql.AllPriv {
+				return ""
+			}
+			if len(privElem.Cols) == 0 {
+				delete(lack, privElem.Priv)
+			}
+		}
+	}
+	if len(lack) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(lack))
+	for priv := range lack {
+		names = append(names, strings.ToUpper(priv.String()))
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("lack of privileges %s in downstream", strings.Join(names, ", "))
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
+)
+
+type testConnectivity struct{}
+
+var _ = Suite(&testConnectivity{})
+
+func (t *testConnectivity) TestVerifyDownstreamPrivileges(c *C) {
+	cases := []struct {
+		grants []string
+		msg    string
+	}{
+		{
+			[]string{"GRANT ALL PRIVILEGES ON *.* TO 'root'@'%'"},
+			"",
+		},
+		{
+			[]string{"GRANT USAGE ON *.* TO 'dm'@'%'", "GRANT ALL PRIVILEGES ON `db`.* TO 'dm'@'%'"},
+			"",
+		},
+		{
+			[]string{
+				"GRANT SELECT,INSERT,UPDATE,DELETE,CREATE,DROP ON *.* TO 'dm'@'%'",
+				"GRANT ALTER ON `db`.`tb` TO 'dm'@'%'",
+				"GRANT INDEX (`c`) ON `db`.`tb` TO 'dm'@'%'",
+				"GRANT 'r1' TO 'dm'@'%'",
+			},
+			"lack of privileges INDEX in downstream",
+		},
+		{
+			[]string{"GRANT USAGE ON *.* TO 'dm'@'%'"},
+			"lack of privileges ALTER, CREATE, DELETE, DROP, INDEX, INSERT, SELECT, UPDATE in downstream",
+		},
+	}
+	for _, cs := range cases {
+		c.Assert(verifyDownstreamPrivileges(cs.grants), Equals, cs.msg, Commentf("grants: %v", cs.grants))
+	}
+	c.Assert(verifyDownstreamPrivileges([]string{"invalid grant"}), Matches, "fail to parse grant invalid grant.*")
+}
+
+func (t *testConnectivity) TestValidateEndpoint(c *C) {
+	mock := conn.InitMockDB(c)
+	defer func() {
+		conn.DefaultDBProvider = &conn.DefaultDBProviderImpl{}
+	}()
+	mock.ExpectQuery("SHOW GRANTS").WillReturnRows(sqlmock.NewRows([]string{"Grants for User"}).
+		AddRow("GRANT RELOAD,REPLICATION SLAVE ON *.* TO 'dm'@'%'"))
+
+	cfg := config.NewSubTaskConfig()
+	cfg.From = config.DBConfig{Host: "127.0.0.1", Port: 3306, User: "dm"}
+	endpoint := validateEndpoint(endpointRoleUpstream, cfg.From, func(db *conn.BaseDB) string {
+		return checkUpstreamPrivileges(context.Background(), db, cfg.From, config.ModeIncrement)
+	})
+	c.Assert(endpoint.Role, Equals, endpointRoleUpstream)
+	c.Assert(endpoint.Address, Equals, "127.0.0.1:3306")
+	c.Assert(endpoint.Connected, IsTrue)
+	c.Assert(endpoint.Privileged, IsFalse)
+	c.Assert(endpoint.Msg, Matches, ".*lack.*REPLICATION CLIENT.*")
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
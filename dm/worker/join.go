@@ -101,7 +101,7 @@ func (s *Server) KeepAlive() {
 		})
 
 		{
-			err1 := ha.KeepAlive(s.kaCtx, s.etcdClient, s.cfg.Name, s.cfg.KeepAliveTTL)
+			err1 := ha.KeepAliveWithLoad(s.kaCtx, s.etcdClient, s.cfg.Name, s.cfg.KeepAliveTTL, loadReportInterval, s.workerLoad)
 			log.L().Warn("keepalive with master goroutine paused", zap.Error(err1))
 		}
 
@@ -0,0 +1,120 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/binlog"
+)
+
+// latencyProbeCapacity is the max count of samples kept by the latency probe.
+// with the default status interval (30s) it covers about 10 minutes.
+const latencyProbeCapacity = 20
+
+// latencyProbe keeps the recent samples of upstream's position and the positions replicated by relay and subtasks,
+// so the lag trends can be observed in `query-status` even without external monitoring.
+type latencyProbe struct {
+	sync.RWMutex
+	samples  []*pb.SourceLatencySample // ring buffer
+	next     int                       // index to put the next sample
+	capacity int
+}
+
+func newLatencyProbe(capacity int) *latencyProbe {
+	return &latencyProbe{
+		samples:  make([]*pb.SourceLatencySample, 0, capacity),
+		capacity: capacity,
+	}
+}
+
+// record adds a sample, the oldest sample is dropped when full.
+func (p *latencyProbe) record(sample *pb.SourceLatencySample) {
+	p.Lock()
+	defer p.Unlock()
+	if len(p.samples) < p.capacity {
+		p.samples = append(p.samples, sample)
+	} else {
+		p.samples[p.next] = sample
+	}
+	p.next = (p.next + 1) % p.capacity
+}
+
+// Samples returns all kept samples, oldest first.
+func (p *latencyProbe) Samples() []*pb.SourceLatencySample {
+	p.RLock()
+	defer p.RUnlock()
+	samples := make([]*pb.SourceLatencySample, 0, len(p.samples))
+	if len(p.samples) < p.capacity {
+		return append(samples, p.samples...)
+	}
+	samples = append(samples, p.samples[p.next:]...)
+	return append(samples, p.samples[:p.next]...)
+}
+
+// probeLatency records upstream's position in sourceStatus alongside the positions replicated by relay and subtasks.
+func (w *SourceWorker) probeLatency(sourceStatus *binlog.SourceStatus) {
+	sample := &pb.SourceLatencySample{
+		Time:             sourceStatus.UpdateTime.Format(time.RFC3339),
+		MasterBinlog:     sourceStatus.Location.Position.String(),
+		MasterBinlogGtid: sourceStatus.Location.GTIDSetStr(),
+		RelayLagBytes:    -1,
+	}
+
+	w.RLock()
+	if w.relayEnabled.Load() && w.relayHolder != nil {
+		if relayStatus := w.relayHolder.Status(sourceStatus); relayStatus != nil {
+			sample.RelayBinlog = relayStatus.RelayBinlog
+			if relayPos, err := binlog.PositionFromPosStr(relayStatus.RelayBinlog); err == nil {
+				sample.RelayLagBytes = sourceStatus.Binlogs.After(relayPos)
+			}
+		}
+	}
+	for name, st := range w.subTaskHolder.getAllSubTasks() {
+		location, ok := st.SyncerLocation()
+		if !ok {
+			continue
+		}
+		taskSample := &pb.TaskLatencySample{
+			Name:         name,
+			SyncerBinlog: location.Position.String(),
+			LagBytes:     -1,
+		}
+		if location.GetGTID() != nil {
+			taskSample.SyncerBinlogGtid = location.GetGTID().String()
+		}
+		if realPos, err := binlog.RealMySQLPos(location.Position); err == nil {
+			taskSample.LagBytes = sourceStatus.Binlogs.After(realPos)
+		} else {
+			w.l.Warn("fail to parse syncer position for latency probe", zap.String("task", name), zap.Error(err))
+		}
+		sample.Tasks = append(sample.Tasks, taskSample)
+	}
+	w.RUnlock()
+
+	sort.Slice(sample.Tasks, func(i, j int) bool {
+		return sample.Tasks[i].Name < sample.Tasks[j].Name
+	})
+	w.latencyProbe.record(sample)
+}
+
+// LatencySamples returns the recent samples of the latency probe, oldest first.
+func (w *SourceWorker) LatencySamples() []*pb.SourceLatencySample {
+	return w.latencyProbe.Samples()
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/binlog"
+)
+
+type testLatencyProbe struct{}
+
+var _ = Suite(&testLatencyProbe{})
+
+func (t *testLatencyProbe) TestRingBuffer(c *C) {
+	p := newLatencyProbe(3)
+	c.Assert(p.Samples(), HasLen, 0)
+
+	times := func(samples []*pb.SourceLatencySample) []string {
+		res := make([]string, 0, len(samples))
+		for _, s := range samples {
+			res = append(res, s.Time)
+		}
+		return res
+	}
+	for i := 0; i < 2; i++ {
+		p.record(&pb.SourceLatencySample{Time: strconv.Itoa(i)})
+	}
+	c.Assert(times(p.Samples()), DeepEquals, []string{"0", "1"})
+
+	for i := 2; i < 7; i++ {
+		p.record(&pb.SourceLatencySample{Time: strconv.Itoa(i)})
+	}
+	c.Assert(times(p.Samples()), DeepEquals, []string{"4", "5", "6"})
+}
+
+func (t *testLatencyProbe) TestProbeLatency(c *C) {
+	cfg := loadSourceConfigWithoutPassword(c)
+	w, err := NewSourceWorker(cfg, nil, "")
+	c.Assert(err, IsNil)
+
+	now := time.Now()
+	status := &binlog.SourceStatus{
+		Location:   binlog.NewLocation(mysql.MySQLFlavor),
+		UpdateTime: now,
+	}
+	status.Location.Position = mysql.Position{Name: "mysql-bin.000002", Pos: 100}
+
+	// relay not enabled and no subtask
+	w.probeLatency(status)
+	samples := w.LatencySamples()
+	c.Assert(samples, HasLen, 1)
+	c.Assert(samples[0], DeepEquals, &pb.SourceLatencySample{
+		Time:          now.Format(time.RFC3339),
+		MasterBinlog:  "(mysql-bin.000002, 100)",
+		RelayLagBytes: -1,
+	})
+
+	// subtasks not in sync unit are ignored
+	w.subTaskHolder.recordSubTask(NewSubTaskWithStage(&config.SubTaskConfig{Name: "task"}, pb.Stage_Paused, nil, ""))
+	w.probeLatency(status)
+	samples = w.LatencySamples()
+	c.Assert(samples, HasLen, 2)
+	c.Assert(samples[1].Tasks, HasLen, 0)
+}
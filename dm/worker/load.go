@@ -0,0 +1,64 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"time"
+
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/mem"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// loadReportInterval is the interval to report the load of the worker to DM-master along with the keepalive.
+var loadReportInterval = 10 * time.Second
+
+// workerLoad collects the load of the worker, the failed items are reported as zero.
+func (s *Server) workerLoad() ha.WorkerLoad {
+	load := ha.WorkerLoad{
+		Name:   s.cfg.Name,
+		Labels: s.cfg.Labels,
+	}
+
+	// the usage since the last call.
+	if percents, err := cpu.Percent(0, false); err != nil {
+		log.L().Warn("fail to get cpu usage", zap.Error(err))
+	} else if len(percents) > 0 {
+		load.CPUUsage = percents[0]
+	}
+	if vm, err := mem.VirtualMemory(); err != nil {
+		log.L().Warn("fail to get memory usage", zap.Error(err))
+	} else {
+		load.MemoryUsage = vm.UsedPercent
+	}
+
+	// the relay log takes most of the disk space, so the disk of the relay directory is preferred.
+	dir := "."
+	if w := s.getWorker(true); w != nil {
+		load.BoundSources = 1
+		if w.cfg.RelayDir != "" {
+			dir = w.cfg.RelayDir
+		}
+	}
+	if size, err := utils.GetStorageSize(dir); err != nil {
+		log.L().Warn("fail to get disk usage", zap.String("dir", dir), zap.Error(err))
+	} else if size.Capacity > 0 {
+		load.DiskUsage = float64(size.Capacity-size.Available) * 100 / float64(size.Capacity)
+	}
+	return load
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/dm/dm/common"
 	"github.com/pingcap/dm/dumpling"
 	"github.com/pingcap/dm/loader"
+	"github.com/pingcap/dm/pkg/conn"
 	"github.com/pingcap/dm/pkg/log"
 	"github.com/pingcap/dm/pkg/metricsproxy"
 	"github.com/pingcap/dm/pkg/utils"
@@ -49,6 +50,14 @@ var (
 			Help:      "state of task, 0 - invalidStage, 1 - New, 2 - Running, 3 - Paused, 4 - Stopped, 5 - Finished",
 		}, []string{"task", "source_id", "worker"})
 
+	taskCrashLoop = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "worker",
+			Name:      "task_crash_loop",
+			Help:      "whether the task is in crash loop, it's not auto resumed until resumed manually",
+		}, []string{"task", "source_id", "worker"})
+
 	// opErrCounter cleans on worker close, which is the same time dm-worker exits, so no explicit clean.
 	opErrCounter = metricsproxy.NewCounterVec(
 		prometheus.CounterOpts{
@@ -109,20 +118,25 @@ func RegistryMetrics() {
 	registry.MustRegister(cpuUsageGauge)
 
 	registry.MustRegister(taskState)
+	registry.MustRegister(taskCrashLoop)
 	registry.MustRegister(opErrCounter)
 
 	relay.RegisterMetrics(registry)
 	dumpling.RegisterMetrics(registry)
 	loader.RegisterMetrics(registry)
 	syncer.RegisterMetrics(registry)
+	conn.RegisterMetrics(registry)
 	prometheus.DefaultGatherer = registry
 }
 
-// InitStatus initializes the HTTP status server.
-func InitStatus(lis net.Listener) {
+// InitStatus initializes the HTTP status server, the extra handlers are also registered if specified.
+func InitStatus(lis net.Listener, handlers map[string]http.Handler) {
 	mux := http.NewServeMux()
 	mux.Handle("/status", &statusHandler{})
 	mux.Handle("/metrics", promhttp.Handler())
+	for pattern, handler := range handlers {
+		mux.Handle(pattern, handler)
+	}
 
 	mux.HandleFunc("/debug/pprof/", pprof.Index)
 	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
@@ -0,0 +1,64 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/streamer"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// relayIndexResponse is the response of GET /relay/index.
+type relayIndexResponse struct {
+	Worker  string                  `json:"worker"`
+	SubDirs []*streamer.RelaySubDir `json:"sub-dirs"`
+}
+
+// relayIndexHandler serves the read-only HTTP API which lists the relay log files of the source handled by the worker,
+// so external tools can archive them without parsing the relay meta files.
+//   - GET /relay/index: list the relay sub directories, the relay log files in them with their start/end positions,
+//     GTID sets and timestamps.
+type relayIndexHandler struct {
+	s *Server
+}
+
+func (h *relayIndexHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	sw := h.s.getWorker(true)
+	if sw == nil {
+		http.Error(w, terror.ErrWorkerNoStart.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	subDirs, err := sw.RelayLogIndex()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	if err = enc.Encode(&relayIndexResponse{Worker: h.s.cfg.Name, SubDirs: subDirs}); err != nil {
+		log.L().Error("fail to marshal response", zap.Error(err))
+	}
+}
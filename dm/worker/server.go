@@ -15,8 +15,11 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
+	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -37,9 +40,13 @@ import (
 	toolutils "github.com/pingcap/tidb-tools/pkg/utils"
 	"github.com/soheilhy/cmux"
 	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/embed"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 var (
@@ -74,6 +81,7 @@ type Server struct {
 	svr        *grpc.Server
 	worker     *SourceWorker
 	etcdClient *clientv3.Client
+	etcd       *embed.Etcd // only used in standalone mode
 
 	// relay status will never be put in server.sourceStatus
 	sourceStatus pb.SourceStatus
@@ -104,15 +112,28 @@ func (s *Server) Start() error {
 	}
 	s.rootLis = tls.WrapListener(rootLis)
 
-	s.etcdClient, err = clientv3.New(clientv3.Config{
-		Endpoints:            GetJoinURLs(s.cfg.Join),
-		DialTimeout:          dialTimeout,
-		DialKeepAliveTime:    keepaliveTime,
-		DialKeepAliveTimeout: keepaliveTimeout,
-		TLS:                  tls.TLSConfig(),
-	})
-	if err != nil {
-		return err
+	httpHandlers := map[string]http.Handler{"/relay/index": &relayIndexHandler{s: s}}
+	if s.cfg.Standalone() {
+		s.etcd, s.etcdClient, err = startStandaloneEtcd(s.cfg)
+		if err != nil {
+			return err
+		}
+		if err = s.bootstrapStandalone(s.ctx); err != nil {
+			return err
+		}
+		httpHandlers["/subtasks"] = &subTaskHandler{s: s}
+		log.L().Info("dm-worker runs in standalone mode", zap.String("source config", s.cfg.SourceConfig), zap.String("data dir", s.cfg.DataDir))
+	} else {
+		s.etcdClient, err = clientv3.New(clientv3.Config{
+			Endpoints:            GetJoinURLs(s.cfg.Join),
+			DialTimeout:          dialTimeout,
+			DialKeepAliveTime:    keepaliveTime,
+			DialKeepAliveTimeout: keepaliveTimeout,
+			TLS:                  tls.TLSConfig(),
+		})
+		if err != nil {
+			return err
+		}
 	}
 
 	s.setWorker(nil, true)
@@ -123,11 +144,13 @@ func (s *Server) Start() error {
 		s.wg.Done()
 	}()
 
-	s.wg.Add(1)
-	go func() {
-		s.syncMasterEndpoints(s.ctx)
-		s.wg.Done()
-	}()
+	if !s.cfg.Standalone() {
+		s.wg.Add(1)
+		go func() {
+			s.syncMasterEndpoints(s.ctx)
+			s.wg.Done()
+		}()
+	}
 
 	s.startKeepAlive()
 
@@ -187,6 +210,10 @@ func (s *Server) Start() error {
 	// NOTE: don't need to set tls config, because rootLis already use tls
 	s.svr = grpc.NewServer()
 	pb.RegisterWorkerServer(s.svr, s)
+	// register health service and reflection for probes and debugging tools like grpcurl
+	healthSvr := health.NewServer()
+	healthpb.RegisterHealthServer(s.svr, healthSvr)
+	reflection.Register(s.svr)
 
 	grpcExitCh := make(chan struct{}, 1)
 	s.wg.Add(1)
@@ -201,6 +228,8 @@ func (s *Server) Start() error {
 		defer s.wg.Done()
 		select {
 		case <-ctx.Done():
+			// set all services to NOT_SERVING before stopping
+			healthSvr.Shutdown()
 			if s.svr != nil {
 				// GracefulStop can not cancel active stream RPCs
 				// and the stream RPC may block on Recv or Send
@@ -214,7 +243,7 @@ func (s *Server) Start() error {
 	httpExitCh := make(chan struct{}, 1)
 	s.wg.Add(1)
 	go func() {
-		InitStatus(httpL) // serve status
+		InitStatus(httpL, httpHandlers) // serve status
 		httpExitCh <- struct{}{}
 	}()
 	go func(ctx context.Context) {
@@ -494,6 +523,12 @@ func (s *Server) doClose() {
 func (s *Server) Close() {
 	s.stopKeepAlive()
 	s.doClose()
+	s.Lock()
+	defer s.Unlock()
+	if s.etcd != nil {
+		s.etcd.Close()
+		s.etcd = nil
+	}
 }
 
 // if needLock is false, we should make sure Server has been locked in caller.
@@ -769,6 +804,7 @@ func (s *Server) QueryStatus(ctx context.Context, req *pb.QueryStatusRequest) (*
 
 	var err error
 	resp.SubTaskStatus, sourceStatus.RelayStatus, err = w.QueryStatus(ctx, req.Name)
+	sourceStatus.LatencySamples = w.LatencySamples()
 
 	if err != nil {
 		resp.Msg = fmt.Sprintf("error when get master status: %v", err)
@@ -787,6 +823,22 @@ func (s *Server) PurgeRelay(ctx context.Context, req *pb.PurgeRelayRequest) (*pb
 		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
 	}
 
+	if req.DryRun {
+		result, err := w.PurgeRelayDryRun(ctx, req)
+		if err != nil {
+			log.L().Error("fail to dry run purge relay", zap.String("request", "PurgeRelay"), zap.Stringer("payload", req), zap.Error(err))
+			return makeCommonWorkerResponse(err), nil
+		}
+		// return relay log files to be purged in the `msg` field.
+		msg, err := json.Marshal(result)
+		if err != nil {
+			return makeCommonWorkerResponse(errors.Trace(err)), nil
+		}
+		resp := makeCommonWorkerResponse(nil)
+		resp.Msg = string(msg)
+		return resp, nil
+	}
+
 	err := w.PurgeRelay(ctx, req)
 	if err != nil {
 		log.L().Error("fail to purge relay", zap.String("request", "PurgeRelay"), zap.Stringer("payload", req), zap.Error(err))
@@ -819,6 +871,56 @@ func (s *Server) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaR
 	}, nil
 }
 
+// QuarantineTable adds, releases or shows the quarantined downstream tables of a subtask.
+func (s *Server) QuarantineTable(ctx context.Context, req *pb.QuarantineWorkerTableRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "QuarantineTable"), zap.Stringer("payload", req))
+
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call QuarantineTable, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	} else if req.Source != w.cfg.SourceID {
+		log.L().Error("fail to call QuarantineTable, because source mismatch", zap.String("request", req.Source), zap.String("current", w.cfg.SourceID))
+		return makeCommonWorkerResponse(terror.ErrWorkerSourceNotMatch.Generate()), nil
+	}
+
+	msg, err := w.QuarantineTable(ctx, req)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	return &pb.CommonWorkerResponse{
+		Result: true,
+		Msg:    msg, // the status of quarantined tables for `show`
+		Source: req.Source,
+		Worker: s.cfg.Name,
+	}, nil
+}
+
+// UpdateSubTask implements WorkerServer.UpdateSubTask.
+func (s *Server) UpdateSubTask(ctx context.Context, req *pb.UpdateSubTaskRequest) (*pb.CommonWorkerResponse, error) {
+	// NOTE: the subtask config may contain passwords, so don't log the payload.
+	log.L().Info("", zap.String("request", "UpdateSubTask"), zap.Bool("dry run", req.DryRun))
+
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call UpdateSubTask, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+	cfg := config.NewSubTaskConfig()
+	if err := cfg.Decode(req.Task, true); err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	if cfg.SourceID != w.cfg.SourceID {
+		log.L().Error("fail to call UpdateSubTask, because source mismatch", zap.String("request", cfg.SourceID), zap.String("current", w.cfg.SourceID))
+		return makeCommonWorkerResponse(terror.ErrWorkerSourceNotMatch.Generate()), nil
+	}
+
+	resp := makeCommonWorkerResponse(w.UpdateSubTask(cfg, req.DryRun))
+	resp.Source = cfg.SourceID
+	resp.Worker = s.cfg.Name
+	return resp, nil
+}
+
 func (s *Server) getOrStartWorker(cfg *config.SourceConfig, needLock bool) (*SourceWorker, error) {
 	if needLock {
 		s.Lock()
@@ -838,6 +940,10 @@ func (s *Server) getOrStartWorker(cfg *config.SourceConfig, needLock bool) (*Sou
 	if err != nil {
 		return nil, err
 	}
+	if s.cfg.RecoverSubTaskConcurrency > 0 {
+		w.recoverConcurrency = s.cfg.RecoverSubTaskConcurrency
+	}
+	w.downstreamPoolSize = s.cfg.DownstreamPoolSize
 	s.setWorker(w, false)
 
 	go w.Start()
@@ -887,7 +993,7 @@ func getMinLocInAllSubTasks(ctx context.Context, subTaskCfgs map[string]config.S
 }
 
 func getMinLocForSubTask(ctx context.Context, subTaskCfg config.SubTaskConfig) (minLoc *binlog.Location, err error) {
-	if subTaskCfg.Mode == config.ModeFull {
+	if subTaskCfg.Mode == config.ModeFull || subTaskCfg.Mode == config.ModeSchemaOnly {
 		return nil, nil
 	}
 	subTaskCfg2, err := subTaskCfg.DecryptPassword()
@@ -922,11 +1028,13 @@ func (s *Server) HandleError(ctx context.Context, req *pb.HandleWorkerErrorReque
 		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
 	}
 
-	if err := w.HandleError(ctx, req); err != nil {
+	msg, err := w.HandleError(ctx, req)
+	if err != nil {
 		return makeCommonWorkerResponse(err), nil
 	}
 	return &pb.CommonWorkerResponse{
 		Result: true,
+		Msg:    msg,
 		Worker: s.cfg.Name,
 	}, nil
 }
@@ -940,3 +1048,75 @@ func (s *Server) GetWorkerCfg(ctx context.Context, req *pb.GetWorkerCfgRequest)
 	resp.Cfg, err = s.cfg.Toml()
 	return resp, err
 }
+
+// CleanOrphanSubTasks implements WorkerServer.CleanOrphanSubTasks.
+func (s *Server) CleanOrphanSubTasks(ctx context.Context, req *pb.CleanOrphanSubTasksRequest) (*pb.CleanOrphanSubTasksResponse, error) {
+	log.L().Info("", zap.String("request", "CleanOrphanSubTasks"), zap.Stringer("payload", req))
+	resp := &pb.CleanOrphanSubTasksResponse{Result: true}
+
+	// hold the lock so the source bound can't be changed by observeSourceBound during checking.
+	s.Lock()
+	defer s.Unlock()
+	w := s.getWorker(false)
+	if w == nil || !w.subTaskEnabled.Load() {
+		return resp, nil
+	}
+
+	sbm, _, err := ha.GetSourceBound(s.etcdClient, s.cfg.Name)
+	if err != nil {
+		resp.Result = false
+		resp.Msg = err.Error()
+		return resp, nil
+	}
+	if bound, ok := sbm[s.cfg.Name]; !ok || bound.Source != w.cfg.SourceID {
+		// the source bound may be deleted or changed when the dm-worker failed to handle it.
+		log.L().Warn("source is not bound to this worker in etcd, stop handling its subtasks",
+			zap.String("source", w.cfg.SourceID), zap.String("bound source", bound.Source))
+		resp.Source = w.cfg.SourceID
+		for name := range w.subTaskHolder.getAllSubTasks() {
+			resp.SubTasks = append(resp.SubTasks, name)
+		}
+		sort.Strings(resp.SubTasks)
+		w.DisableHandleSubtasks()
+		if !w.relayEnabled.Load() {
+			log.L().Info("relay is not enabled after disabling subtask, so stop worker")
+			err = s.stopWorker(w.cfg.SourceID, false)
+		}
+	} else {
+		resp.SubTasks, err = w.stopOrphanSubTasks()
+	}
+	if err != nil {
+		resp.Result = false
+		resp.Msg = err.Error()
+	}
+	return resp, nil
+}
+
+// ValidateConnectivity implements WorkerServer.ValidateConnectivity.
+func (s *Server) ValidateConnectivity(ctx context.Context, req *pb.ValidateConnectivityRequest) (*pb.ValidateConnectivityResponse, error) {
+	// the payload is not logged because it contains the passwords.
+	log.L().Info("", zap.String("request", "ValidateConnectivity"))
+	resp := &pb.ValidateConnectivityResponse{Result: true, Worker: s.cfg.Name}
+
+	cfg := config.NewSubTaskConfig()
+	if err := cfg.Decode(req.SubTaskCfg, true); err != nil {
+		resp.Result = false
+		resp.Msg = err.Error()
+		return resp, nil
+	}
+	resp.Source = cfg.SourceID
+	cfg, err := cfg.DecryptPassword()
+	if err != nil {
+		resp.Result = false
+		resp.Msg = err.Error()
+		return resp, nil
+	}
+
+	resp.Endpoints = validateConnectivity(ctx, cfg)
+	for _, endpoint := range resp.Endpoints {
+		if !endpoint.Connected || !endpoint.Privileged {
+			resp.Result = false
+		}
+	}
+	return resp, nil
+}
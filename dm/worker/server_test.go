@@ -30,6 +30,7 @@ import (
 	"go.etcd.io/etcd/embed"
 	v3rpc "go.etcd.io/etcd/etcdserver/api/v3rpc/rpctypes"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/pingcap/dm/dm/config"
 	"github.com/pingcap/dm/dm/pb"
@@ -159,6 +160,7 @@ func (t *testServer) TestServer(c *C) {
 
 	t.testHTTPInterface(c, "status")
 	t.testHTTPInterface(c, "metrics")
+	t.testHealthCheck(c, workerAddr1)
 
 	// create client
 	cli := t.createClient(c, workerAddr1)
@@ -452,6 +454,16 @@ func (t *testServer) testHTTPInterface(c *C, uri string) {
 	c.Assert(err, IsNil)
 }
 
+func (t *testServer) testHealthCheck(c *C, addr string) {
+	//nolint:staticcheck
+	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBackoffMaxDelay(3*time.Second))
+	c.Assert(err, IsNil)
+	defer conn.Close()
+	resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Status, Equals, healthpb.HealthCheckResponse_SERVING)
+}
+
 func (t *testServer) createClient(c *C, addr string) pb.WorkerClient {
 	//nolint:staticcheck
 	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBackoffMaxDelay(3*time.Second))
@@ -16,6 +16,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/pingcap/dm/pkg/etcdutil"
 	"github.com/pingcap/dm/pkg/ha"
 	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/schema"
 	"github.com/pingcap/dm/pkg/streamer"
 	"github.com/pingcap/dm/pkg/terror"
 	"github.com/pingcap/dm/pkg/utils"
@@ -61,6 +63,7 @@ type SourceWorker struct {
 	l log.Logger
 
 	sourceStatus atomic.Value // stores a pointer to SourceStatus
+	latencyProbe *latencyProbe
 
 	// subtask functionality
 	subTaskEnabled atomic.Bool
@@ -68,6 +71,11 @@ type SourceWorker struct {
 	subTaskCancel  context.CancelFunc
 	subTaskWg      sync.WaitGroup
 	subTaskHolder  *subTaskHolder
+	// subtasks are recovered in background when starting to handle subtasks
+	subTaskRecovery    *subTaskRecovery
+	recoverConcurrency int
+	// the size of the downstream connection pool shared by the subtasks, 0 means not shared
+	downstreamPoolSize int
 
 	// relay functionality
 	// during relayEnabled == true, relayHolder and relayPurger should not be nil
@@ -89,11 +97,14 @@ type SourceWorker struct {
 // and EnableSubtask later.
 func NewSourceWorker(cfg *config.SourceConfig, etcdClient *clientv3.Client, name string) (w *SourceWorker, err error) {
 	w = &SourceWorker{
-		cfg:           cfg,
-		subTaskHolder: newSubTaskHolder(),
-		l:             log.With(zap.String("component", "worker controller")),
-		etcdClient:    etcdClient,
-		name:          name,
+		cfg:                cfg,
+		subTaskHolder:      newSubTaskHolder(),
+		subTaskRecovery:    newSubTaskRecovery(),
+		recoverConcurrency: defaultRecoverSubTaskConcurrency,
+		latencyProbe:       newLatencyProbe(latencyProbeCapacity),
+		l:                  log.With(zap.String("component", "worker controller")),
+		etcdClient:         etcdClient,
+		name:               name,
 	}
 	// keep running until canceled in `Close`.
 	w.ctx, w.cancel = context.WithCancel(context.Background())
@@ -169,6 +180,7 @@ func (w *SourceWorker) Start() {
 				if time.Since(status.UpdateTime) < printTaskInterval/2 {
 					w.l.Info("we just updated the source status, skip once",
 						zap.Time("last update time", status.UpdateTime))
+					w.probeLatency(status)
 					continue
 				}
 			}
@@ -178,6 +190,7 @@ func (w *SourceWorker) Start() {
 			}
 
 			sourceStatus := w.sourceStatus.Load().(*binlog.SourceStatus)
+			w.probeLatency(sourceStatus)
 			if w.l.Core().Enabled(zap.DebugLevel) {
 				w.l.Debug("runtime status", zap.String("status", w.GetUnitAndSourceStatusJSON("", sourceStatus)))
 			}
@@ -415,6 +428,9 @@ func (w *SourceWorker) EnableHandleSubtasks() error {
 
 	w.l.Info("starting to handle mysql source", zap.String("sourceCfg", w.cfg.String()), zap.Any("subTasks", subTaskCfgM))
 
+	// the subtasks are created here, and recovered (run) in background later.
+	sts := make([]*SubTask, 0, len(subTaskCfgM))
+	expects := make([]pb.Stage, 0, len(subTaskCfgM))
 	for _, subTaskCfg := range subTaskCfgM {
 		expectStage := subTaskStages[subTaskCfg.Name]
 		if expectStage.IsDeleted {
@@ -423,11 +439,23 @@ func (w *SourceWorker) EnableHandleSubtasks() error {
 		w.l.Info("start to create subtask", zap.String("sourceID", subTaskCfg.SourceID), zap.String("task", subTaskCfg.Name))
 		// "for range" of a map will use same value address, so we'd better not pass value address to other function
 		clone := subTaskCfg
-		if err2 := w.StartSubTask(&clone, expectStage.Expect, false); err2 != nil {
+		st, err2 := w.createSubTask(&clone)
+		if err2 != nil {
 			w.subTaskHolder.closeAllSubTasks()
 			return err2
 		}
+		if st != nil {
+			sts = append(sts, st)
+			expects = append(expects, expectStage.Expect)
+		}
 	}
+	w.subTaskRecovery.add(sts, expects)
+
+	w.subTaskWg.Add(1)
+	go func() {
+		defer w.subTaskWg.Done()
+		w.recoverSubTasks(w.subTaskCtx)
+	}()
 
 	w.subTaskWg.Add(1)
 	go func() {
@@ -458,6 +486,8 @@ func (w *SourceWorker) DisableHandleSubtasks() {
 
 	// close all sub tasks
 	w.subTaskHolder.closeAllSubTasks()
+	// no subtask uses the cached upstream table structures now
+	schema.GetUpstreamCache().RemoveSource(w.cfg.SourceID)
 	w.l.Info("handling subtask enabled")
 }
 
@@ -485,10 +515,20 @@ func (w *SourceWorker) StartSubTask(cfg *config.SubTaskConfig, expectStage pb.St
 		defer w.Unlock()
 	}
 
+	st, err := w.createSubTask(cfg)
+	if err != nil || st == nil {
+		return err
+	}
+	st.Run(expectStage)
+	return nil
+}
+
+// createSubTask creates a subtask and records it, returns nil subtask if it fails before running.
+func (w *SourceWorker) createSubTask(cfg *config.SubTaskConfig) (*SubTask, error) {
 	// copy some config item from dm-worker's source config
 	err := copyConfigFromSource(cfg, w.cfg, w.relayEnabled.Load())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// directly put cfg into subTaskHolder
@@ -497,31 +537,31 @@ func (w *SourceWorker) StartSubTask(cfg *config.SubTaskConfig, expectStage pb.St
 	w.subTaskHolder.recordSubTask(st)
 	if w.closed.Load() {
 		st.fail(terror.ErrWorkerAlreadyClosed.Generate())
-		return nil
+		return nil, nil
 	}
 
 	cfg2, err := cfg.DecryptPassword()
 	if err != nil {
 		st.fail(errors.Annotate(err, "start sub task"))
-		return nil
+		return nil, nil
 	}
 	st.cfg = cfg2
 	// inject worker name to this subtask config
 	st.cfg.WorkerName = w.name
+	st.cfg.DownstreamPoolSize = w.downstreamPoolSize
 
 	if w.relayEnabled.Load() && w.relayPurger.Purging() {
 		// TODO: retry until purged finished
 		st.fail(terror.ErrWorkerRelayIsPurging.Generate(cfg.Name))
-		return nil
+		return nil, nil
 	}
 
 	w.l.Info("subtask created", zap.Stringer("config", cfg2))
-	st.Run(expectStage)
-	return nil
+	return st, nil
 }
 
-// UpdateSubTask update config for a sub task.
-func (w *SourceWorker) UpdateSubTask(cfg *config.SubTaskConfig) error {
+// UpdateSubTask update config for a sub task, the config is only checked if `dryRun` is true.
+func (w *SourceWorker) UpdateSubTask(cfg *config.SubTaskConfig, dryRun bool) error {
 	w.Lock()
 	defer w.Unlock()
 
@@ -534,6 +574,9 @@ func (w *SourceWorker) UpdateSubTask(cfg *config.SubTaskConfig) error {
 		return terror.ErrWorkerSubTaskNotFound.Generate(cfg.Name)
 	}
 
+	if dryRun {
+		return st.CheckUpdate(cfg)
+	}
 	w.l.Info("update sub task", zap.String("task", cfg.Name))
 	return st.Update(cfg)
 }
@@ -598,10 +641,48 @@ func (w *SourceWorker) QueryStatus(ctx context.Context, name string) ([]*pb.SubT
 	subtaskStatus := w.Status(name, sourceStatus)
 	if w.relayEnabled.Load() {
 		relayStatus = w.relayHolder.Status(sourceStatus)
+		if relayStatus != nil && w.relayPurger != nil {
+			relayStatus.SpaceStatus = w.relayPurger.SpaceStatus()
+		}
 	}
 	return subtaskStatus, relayStatus, nil
 }
 
+// stopOrphanSubTasks stops the subtasks which have no subtask config or subtask stage in etcd, and returns their names.
+// these subtasks may be left after a partial failure, and they should not replicate data anymore.
+func (w *SourceWorker) stopOrphanSubTasks() ([]string, error) {
+	// get the running subtasks before reading etcd, so the subtasks started after reading etcd are not treated as orphans.
+	sts := w.subTaskHolder.getAllSubTasks()
+	if len(sts) == 0 {
+		return nil, nil
+	}
+	subTaskStages, subTaskCfgm, _, err := ha.GetSubTaskStageConfig(w.etcdClient, w.cfg.SourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	stopped := make([]string, 0, len(sts))
+	for name := range sts {
+		_, hasCfg := subTaskCfgm[name]
+		_, hasStage := subTaskStages[name]
+		if hasCfg && hasStage {
+			continue
+		}
+		w.l.Warn("stop orphan subtask", zap.String("task", name), zap.Bool("has config", hasCfg), zap.Bool("has stage", hasStage))
+		err = w.OperateSubTask(name, pb.TaskOp_Stop)
+		if terror.ErrWorkerSubTaskNotFound.Equal(err) {
+			// already stopped by the subtask stage watcher.
+			continue
+		}
+		if err != nil {
+			return stopped, err
+		}
+		stopped = append(stopped, name)
+	}
+	sort.Strings(stopped)
+	return stopped, nil
+}
+
 func (w *SourceWorker) resetSubtaskStage() (int64, error) {
 	subTaskStages, subTaskCfgm, revSubTask, err := w.fetchSubTasksAndAdjust()
 	if err != nil {
@@ -722,10 +803,15 @@ func (w *SourceWorker) handleSubTaskStage(ctx context.Context, stageCh chan ha.S
 
 // operateSubTaskStage returns TaskOp.String() additionally to record metrics.
 func (w *SourceWorker) operateSubTaskStage(stage ha.Stage, subTaskCfg config.SubTaskConfig) (string, error) {
+	if w.subTaskRecovery.deferStage(stage) {
+		log.L().Info("subtask is not recovered yet, the stage will be applied after recovered", zap.Stringer("stage", stage))
+		return opErrTypeBeforeOp, nil
+	}
 	var op pb.TaskOp
 	switch {
 	case stage.Expect == pb.Stage_Running, stage.Expect == pb.Stage_Paused:
-		if st := w.subTaskHolder.findSubTask(stage.Task); st == nil {
+		st := w.subTaskHolder.findSubTask(stage.Task)
+		if st == nil {
 			// create the subtask for expected running and paused stage.
 			log.L().Info("start to create subtask", zap.String("sourceID", subTaskCfg.SourceID), zap.String("task", subTaskCfg.Name))
 			err := w.StartSubTask(&subTaskCfg, stage.Expect, true)
@@ -733,6 +819,9 @@ func (w *SourceWorker) operateSubTaskStage(stage ha.Stage, subTaskCfg config.Sub
 		}
 		if stage.Expect == pb.Stage_Running {
 			op = pb.TaskOp_Resume
+			if stage.ResetErrorBudget {
+				st.ResetErrorBudget()
+			}
 		} else if stage.Expect == pb.Stage_Paused {
 			op = pb.TaskOp_Pause
 		}
@@ -893,6 +982,47 @@ func (w *SourceWorker) PurgeRelay(ctx context.Context, req *pb.PurgeRelayRequest
 		return nil
 	}
 
+	if err := w.updateActiveRelayLog(ctx); err != nil {
+		return err
+	}
+	return w.relayPurger.Do(ctx, req)
+}
+
+// PurgeRelayDryRun returns relay log files which will be purged by PurgeRelay, without purging them.
+func (w *SourceWorker) PurgeRelayDryRun(ctx context.Context, req *pb.PurgeRelayRequest) (*purger.DryRunResult, error) {
+	if w.closed.Load() {
+		return nil, terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	if !w.relayEnabled.Load() {
+		w.l.Warn("enable-relay is false, ignore purge relay")
+		return &purger.DryRunResult{}, nil
+	}
+
+	if err := w.updateActiveRelayLog(ctx); err != nil {
+		return nil, err
+	}
+	return w.relayPurger.DryRun(ctx, req)
+}
+
+// RelayLogIndex returns the index of the relay log files of the source, nil is returned if relay is not enabled.
+func (w *SourceWorker) RelayLogIndex() ([]*streamer.RelaySubDir, error) {
+	if w.closed.Load() {
+		return nil, terror.ErrWorkerAlreadyClosed.Generate()
+	}
+
+	w.RLock()
+	defer w.RUnlock()
+	if !w.relayEnabled.Load() {
+		w.l.Warn("enable-relay is false, no relay log index")
+		return nil, nil
+	}
+	return streamer.ReadRelayLogIndex(w.cfg.RelayDir)
+}
+
+// updateActiveRelayLog updates active relay log of subtasks by their global checkpoints
+// if the worker isn't handling subtasks, so relay log files still needed by subtasks won't be purged.
+func (w *SourceWorker) updateActiveRelayLog(ctx context.Context) error {
 	if !w.subTaskEnabled.Load() {
 		w.l.Info("worker received purge-relay but didn't handling subtasks, read global checkpoint to decided active relay log")
 
@@ -916,7 +1046,7 @@ func (w *SourceWorker) PurgeRelay(ctx context.Context, req *pb.PurgeRelayRequest
 			}
 		}
 	}
-	return w.relayPurger.Do(ctx, req)
+	return nil
 }
 
 // ForbidPurge implements PurgeInterceptor.ForbidPurge.
@@ -954,6 +1084,23 @@ func (w *SourceWorker) OperateSchema(ctx context.Context, req *pb.OperateWorkerS
 	return st.OperateSchema(ctx, req)
 }
 
+// QuarantineTable adds, releases or shows the quarantined downstream tables of a subtask.
+func (w *SourceWorker) QuarantineTable(ctx context.Context, req *pb.QuarantineWorkerTableRequest) (string, error) {
+	w.Lock()
+	if w.closed.Load() {
+		w.Unlock()
+		return "", terror.ErrWorkerAlreadyClosed.Generate()
+	}
+	st := w.subTaskHolder.findSubTask(req.Task)
+	// releasing a table may take a long time, so don't hold the lock.
+	w.Unlock()
+	if st == nil {
+		return "", terror.ErrWorkerSubTaskNotFound.Generate(req.Task)
+	}
+
+	return st.QuarantineTable(ctx, req)
+}
+
 // copyConfigFromSource copies config items from source config and worker's relayEnabled to sub task.
 func copyConfigFromSource(cfg *config.SubTaskConfig, sourceCfg *config.SourceConfig, enableRelay bool) error {
 	cfg.From = sourceCfg.From
@@ -963,6 +1110,7 @@ func copyConfigFromSource(cfg *config.SubTaskConfig, sourceCfg *config.SourceCon
 	cfg.RelayDir = sourceCfg.RelayDir
 	cfg.EnableGTID = sourceCfg.EnableGTID
 	cfg.UseRelay = enableRelay
+	cfg.NetRateLimit = sourceCfg.NetRateLimit
 
 	// we can remove this from SubTaskConfig later, because syncer will always read from relay
 	cfg.AutoFixGTID = sourceCfg.AutoFixGTID
@@ -1023,17 +1171,17 @@ func (w *SourceWorker) getAllSubTaskStatus() map[string]*pb.SubTaskStatus {
 }
 
 // HandleError handle worker error.
-func (w *SourceWorker) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) error {
+func (w *SourceWorker) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
 	w.Lock()
 	defer w.Unlock()
 
 	if w.closed.Load() {
-		return terror.ErrWorkerAlreadyClosed.Generate()
+		return "", terror.ErrWorkerAlreadyClosed.Generate()
 	}
 
 	st := w.subTaskHolder.findSubTask(req.Task)
 	if st == nil {
-		return terror.ErrWorkerSubTaskNotFound.Generate(req.Task)
+		return "", terror.ErrWorkerSubTaskNotFound.Generate(req.Task)
 	}
 
 	return st.HandleError(ctx, req)
@@ -105,7 +105,7 @@ func (t *testServer) testWorker(c *C) {
 
 	err = w.UpdateSubTask(&config.SubTaskConfig{
 		Name: "testStartTask",
-	})
+	}, false)
 	c.Assert(err, ErrorMatches, ".*worker already closed.*")
 
 	err = w.OperateSubTask("testSubTask", pb.TaskOp_Stop)
@@ -551,6 +551,58 @@ func (t *testWorkerEtcdCompact) TestWatchSubtaskStageEtcdCompact(c *C) {
 	wg.Wait()
 }
 
+func (t *testWorkerEtcdCompact) TestStopOrphanSubTasks(c *C) {
+	masterAddr := tempurl.Alloc()[len("http://"):]
+	etcdDir := c.MkDir()
+	ETCD, err := createMockETCD(etcdDir, "http://"+masterAddr)
+	c.Assert(err, IsNil)
+	defer ETCD.Close()
+
+	etcdCli, err := clientv3.New(clientv3.Config{
+		Endpoints:            GetJoinURLs(masterAddr),
+		DialTimeout:          dialTimeout,
+		DialKeepAliveTime:    keepaliveTime,
+		DialKeepAliveTimeout: keepaliveTimeout,
+	})
+	c.Assert(err, IsNil)
+	sourceCfg := loadSourceConfigWithoutPassword(c)
+	sourceCfg.From = config.GetDBConfigForTest()
+	sourceCfg.EnableRelay = false
+
+	w, err := NewSourceWorker(sourceCfg, etcdCli, "")
+	c.Assert(err, IsNil)
+	defer w.Close()
+	go func() {
+		w.Start()
+	}()
+	c.Assert(utils.WaitSomething(50, 100*time.Millisecond, func() bool {
+		return !w.closed.Load()
+	}), IsTrue)
+
+	// no running subtasks.
+	stopped, err := w.stopOrphanSubTasks()
+	c.Assert(err, IsNil)
+	c.Assert(stopped, HasLen, 0)
+
+	// only subtaskCfg1 exists in etcd, subtaskCfg2 is an orphan.
+	subtaskCfg1 := config.SubTaskConfig{}
+	c.Assert(subtaskCfg1.DecodeFile(subtaskSampleFile, true), IsNil)
+	subtaskCfg1.MydumperPath = mydumperPath
+	subtaskCfg2 := subtaskCfg1
+	subtaskCfg2.Name = "orphan-task"
+	_, err = ha.PutSubTaskCfgStage(etcdCli, []config.SubTaskConfig{subtaskCfg1},
+		[]ha.Stage{ha.NewSubTaskStage(pb.Stage_Running, sourceCfg.SourceID, subtaskCfg1.Name)})
+	c.Assert(err, IsNil)
+	c.Assert(w.StartSubTask(&subtaskCfg1, pb.Stage_Running, true), IsNil)
+	c.Assert(w.StartSubTask(&subtaskCfg2, pb.Stage_Running, true), IsNil)
+
+	stopped, err = w.stopOrphanSubTasks()
+	c.Assert(err, IsNil)
+	c.Assert(stopped, DeepEquals, []string{subtaskCfg2.Name})
+	c.Assert(w.subTaskHolder.findSubTask(subtaskCfg1.Name), NotNil)
+	c.Assert(w.subTaskHolder.findSubTask(subtaskCfg2.Name), IsNil)
+}
+
 func (t *testWorkerEtcdCompact) TestWatchRelayStageEtcdCompact(c *C) {
 	var (
 		masterAddr   = tempurl.Alloc()[len("http://"):]
@@ -0,0 +1,255 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/embed"
+	"go.etcd.io/etcd/etcdserver/api/v3client"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/checker"
+	"github.com/pingcap/dm/dm/config"
+	ctlcommon "github.com/pingcap/dm/dm/ctl/common"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/conn"
+	"github.com/pingcap/dm/pkg/ha"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// In standalone mode, dm-worker runs without DM-master. It starts an embedded single-member etcd in `data-dir` (only
+// accessed in process), puts the source config and the bound relationship of itself into it, and the subtasks are
+// managed by StartSubTask/OperateSubTask, so the source and the subtasks are handled as being scheduled by DM-master.
+
+var (
+	standaloneEtcdStartTimeout = time.Minute
+
+	// adjustSourceConfigFunc is used to mock in tests.
+	adjustSourceConfigFunc = adjustSourceConfig
+)
+
+// startStandaloneEtcd starts the embedded etcd and returns an in-process client of it.
+func startStandaloneEtcd(cfg *Config) (*embed.Etcd, *clientv3.Client, error) {
+	etcdCfg := embed.NewConfig()
+	etcdCfg.Name = cfg.Name
+	etcdCfg.Dir = cfg.DataDir
+	// no client or peer is connected through the network.
+	etcdCfg.LCUrls = nil
+	etcdCfg.LPUrls = nil
+	etcdCfg.InitialCluster = etcdCfg.InitialClusterFromName(etcdCfg.Name)
+
+	logger := log.L().WithFields(zap.String("component", "embed etcd"))
+	logger.Logger = logger.WithOptions(zap.IncreaseLevel(zap.WarnLevel))
+	etcdCfg.ZapLoggerBuilder = embed.NewZapCoreLoggerBuilder(logger.Logger, logger.Core(), log.Props().Syncer)
+	etcdCfg.Logger = "zap"
+
+	e, err := embed.StartEtcd(etcdCfg)
+	if err != nil {
+		return nil, nil, terror.ErrWorkerInvalidStandaloneConfig.Delegate(err, "start embed etcd")
+	}
+	select {
+	case <-e.Server.ReadyNotify():
+	case <-time.After(standaloneEtcdStartTimeout):
+		e.Server.Stop()
+		return nil, nil, terror.ErrWorkerInvalidStandaloneConfig.Generatef("start embed etcd timeout %v", standaloneEtcdStartTimeout)
+	}
+	return e, v3client.New(e.Server), nil
+}
+
+func adjustSourceConfig(ctx context.Context, cfg *config.SourceConfig) error {
+	fromDB, err := conn.DefaultDBProvider.Apply(*cfg.GenerateDBConfig())
+	if err != nil {
+		return err
+	}
+	defer fromDB.Close()
+	if err = cfg.Adjust(ctx, fromDB.DB); err != nil {
+		return err
+	}
+	return cfg.Verify()
+}
+
+// bootstrapStandalone loads the source config from `source-config` and binds the source to this dm-worker, the relay
+// is started if enabled and its stage has not been set before.
+func (s *Server) bootstrapStandalone(ctx context.Context) error {
+	sourceCfg, err := config.LoadFromFile(s.cfg.SourceConfig)
+	if err != nil {
+		return err
+	}
+	if err = adjustSourceConfigFunc(ctx, sourceCfg); err != nil {
+		return err
+	}
+	if _, err = ha.PutSourceCfg(s.etcdClient, sourceCfg); err != nil {
+		return err
+	}
+
+	bound := ha.NewSourceBound(sourceCfg.SourceID, s.cfg.Name)
+	if !sourceCfg.EnableRelay {
+		if _, err = ha.DeleteRelayConfig(s.etcdClient, s.cfg.Name); err != nil {
+			return err
+		}
+		_, err = ha.PutSourceBound(s.etcdClient, bound)
+		return err
+	}
+	relayStage, _, err := ha.GetRelayStage(s.etcdClient, sourceCfg.SourceID)
+	if err != nil {
+		return err
+	}
+	if relayStage.IsEmpty() {
+		relayStage = ha.NewRelayStage(pb.Stage_Running, sourceCfg.SourceID)
+	}
+	_, err = ha.PutRelayStageRelayConfigSourceBound(s.etcdClient, relayStage, bound)
+	return err
+}
+
+// StartSubTask implements WorkerServer.StartSubTask.
+func (s *Server) StartSubTask(ctx context.Context, req *pb.StartSubTaskRequest) (*pb.CommonWorkerResponse, error) {
+	// NOTE: the subtask config may contain passwords, so don't log the payload.
+	log.L().Info("", zap.String("request", "StartSubTask"))
+	if !s.cfg.Standalone() {
+		return makeCommonWorkerResponse(terror.ErrWorkerNotStandalone.Generate()), nil
+	}
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call StartSubTask, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	cfg := config.NewSubTaskConfig()
+	if err := cfg.Decode(req.Task, true); err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	if cfg.SourceID != w.cfg.SourceID {
+		log.L().Error("fail to call StartSubTask, because source mismatch", zap.String("request", cfg.SourceID), zap.String("current", w.cfg.SourceID))
+		return makeCommonWorkerResponse(terror.ErrWorkerSourceNotMatch.Generate()), nil
+	}
+	if cfg.ShardMode != "" {
+		return makeCommonWorkerResponse(terror.ErrWorkerStandaloneUnsupported.Generate("shard DDL coordination")), nil
+	}
+
+	cfgm, _, err := ha.GetSubTaskCfg(s.etcdClient, cfg.SourceID, cfg.Name, 0)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	if _, ok := cfgm[cfg.Name]; ok {
+		return makeCommonWorkerResponse(terror.ErrWorkerSubTaskExists.Generate(cfg.Name)), nil
+	}
+	if err = checker.CheckSyncConfigFunc(ctx, []*config.SubTaskConfig{cfg}, ctlcommon.DefaultErrorCnt, ctlcommon.DefaultWarnCnt); err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+
+	_, err = ha.PutSubTaskCfgStage(s.etcdClient, []config.SubTaskConfig{*cfg},
+		[]ha.Stage{ha.NewSubTaskStage(pb.Stage_Running, cfg.SourceID, cfg.Name)})
+	return makeCommonWorkerResponse(err), nil
+}
+
+// OperateSubTask implements WorkerServer.OperateSubTask.
+func (s *Server) OperateSubTask(ctx context.Context, req *pb.OperateSubTaskRequest) (*pb.CommonWorkerResponse, error) {
+	log.L().Info("", zap.String("request", "OperateSubTask"), zap.Stringer("payload", req))
+	if !s.cfg.Standalone() {
+		return makeCommonWorkerResponse(terror.ErrWorkerNotStandalone.Generate()), nil
+	}
+	w := s.getWorker(true)
+	if w == nil {
+		log.L().Warn("fail to call OperateSubTask, because no mysql source is being handled in the worker")
+		return makeCommonWorkerResponse(terror.ErrWorkerNoStart.Generate()), nil
+	}
+
+	source := w.cfg.SourceID
+	cfgm, _, err := ha.GetSubTaskCfg(s.etcdClient, source, req.Name, 0)
+	if err != nil {
+		return makeCommonWorkerResponse(err), nil
+	}
+	cfg, ok := cfgm[req.Name]
+	if !ok {
+		return makeCommonWorkerResponse(terror.ErrWorkerSubTaskNotFound.Generate(req.Name)), nil
+	}
+
+	switch req.Op {
+	case pb.TaskOp_Pause:
+		_, err = ha.PutSubTaskStage(s.etcdClient, ha.NewSubTaskStage(pb.Stage_Paused, source, req.Name))
+	case pb.TaskOp_Resume:
+		_, err = ha.PutSubTaskStage(s.etcdClient, ha.NewSubTaskStage(pb.Stage_Running, source, req.Name))
+	case pb.TaskOp_Stop:
+		_, err = ha.DeleteSubTaskCfgStage(s.etcdClient, []config.SubTaskConfig{cfg},
+			[]ha.Stage{ha.NewSubTaskStage(pb.Stage_Stopped, source, req.Name)})
+	default:
+		err = terror.ErrWorkerUpdateTaskStage.Generatef("invalid operate %s on subtask %v", req.Op, req.Name)
+	}
+	return makeCommonWorkerResponse(err), nil
+}
+
+// subTaskHandler serves the HTTP API to manage the subtasks in standalone mode.
+//   - GET /subtasks?name=task: query the status of the subtask, or all subtasks if name is empty.
+//   - POST /subtasks: start a subtask with the config in TOML format as the body.
+//   - PUT /subtasks?name=task&op=pause|resume: pause or resume the subtask.
+//   - DELETE /subtasks?name=task: stop the subtask.
+type subTaskHandler struct {
+	s *Server
+}
+
+func (h *subTaskHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var (
+		resp proto.Message
+		err  error
+		name = req.URL.Query().Get("name")
+	)
+	switch req.Method {
+	case http.MethodGet:
+		resp, err = h.s.QueryStatus(req.Context(), &pb.QueryStatusRequest{Name: name})
+	case http.MethodPost:
+		var body []byte
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err = h.s.StartSubTask(req.Context(), &pb.StartSubTaskRequest{Task: string(body)})
+	case http.MethodPut:
+		var op pb.TaskOp
+		switch strings.ToLower(req.URL.Query().Get("op")) {
+		case "pause":
+			op = pb.TaskOp_Pause
+		case "resume":
+			op = pb.TaskOp_Resume
+		default:
+			http.Error(w, "op should be pause or resume", http.StatusBadRequest)
+			return
+		}
+		resp, err = h.s.OperateSubTask(req.Context(), &pb.OperateSubTaskRequest{Op: op, Name: name})
+	case http.MethodDelete:
+		resp, err = h.s.OperateSubTask(req.Context(), &pb.OperateSubTaskRequest{Op: pb.TaskOp_Stop, Name: name})
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	mar := jsonpb.Marshaler{EmitDefaults: true, Indent: "    "}
+	if err = mar.Marshal(w, resp); err != nil {
+		log.L().Error("fail to marshal response", zap.Error(err))
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/pingcap/check"
+	"github.com/tikv/pd/pkg/tempurl"
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/checker"
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/dm/unit"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+func (t *testServer) TestStandaloneConfig(c *C) {
+	cfg := NewConfig()
+	c.Assert(cfg.Parse([]string{"-worker-addr=127.0.0.1:8262", "-source-config=./source.yaml"}), IsNil)
+	c.Assert(cfg.Standalone(), IsTrue)
+	c.Assert(cfg.DataDir, Equals, "default.127.0.0.1:8262")
+
+	cfg = NewConfig()
+	err := cfg.Parse([]string{"-worker-addr=127.0.0.1:8262", "-source-config=./source.yaml", "-join=127.0.0.1:8261"})
+	c.Assert(terror.ErrWorkerInvalidStandaloneConfig.Equal(err), IsTrue)
+}
+
+func (t *testServer) TestStandalone(c *C) {
+	workerAddr := tempurl.Alloc()[len("http://"):]
+	cfg := NewConfig()
+	c.Assert(cfg.Parse([]string{"-worker-addr=" + workerAddr, "-source-config=" + sourceSampleFile, "-data-dir=" + c.MkDir()}), IsNil)
+
+	adjustSourceConfigFunc = func(context.Context, *config.SourceConfig) error { return nil }
+	checker.CheckSyncConfigFunc = func(context.Context, []*config.SubTaskConfig, int64, int64) error { return nil }
+	NewRelayHolder = NewDummyRelayHolder
+	createUnits = func(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, worker string) []unit.Unit {
+		return []unit.Unit{NewMockUnit(pb.UnitType_Dump), NewMockUnit(pb.UnitType_Load), NewMockUnit(pb.UnitType_Sync)}
+	}
+	defer func() {
+		adjustSourceConfigFunc = adjustSourceConfig
+		checker.CheckSyncConfigFunc = checker.CheckSyncConfig
+		NewRelayHolder = NewRealRelayHolder
+		createUnits = createRealUnits
+	}()
+
+	startServer := func() *Server {
+		s := NewServer(cfg)
+		go func() {
+			c.Assert(s.Start(), IsNil)
+		}()
+		c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+			return !s.closed.Load() && s.getWorker(true) != nil
+		}), IsTrue)
+		return s
+	}
+	s := startServer()
+	cli := t.createClient(c, workerAddr)
+
+	task, err := os.ReadFile(subtaskSampleFile)
+	c.Assert(err, IsNil)
+	resp, err := cli.StartSubTask(context.Background(), &pb.StartSubTaskRequest{Task: string(task)})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Result, IsTrue, Commentf("%s", resp.Msg))
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return checkSubTaskStatus(cli, pb.Stage_Running)
+	}), IsTrue)
+	resp, err = cli.StartSubTask(context.Background(), &pb.StartSubTaskRequest{Task: string(task)})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Result, IsFalse)
+	c.Assert(resp.Msg, Matches, ".*already exists.*")
+
+	// pause through the HTTP API
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, "http://"+workerAddr+"/subtasks?name=sub-task-name&op=pause", nil)
+	c.Assert(err, IsNil)
+	req.Close = true // don't reuse the connection after the server restarts
+	httpResp, err := http.DefaultClient.Do(req)
+	c.Assert(err, IsNil)
+	c.Assert(httpResp.StatusCode, Equals, http.StatusOK)
+	c.Assert(httpResp.Body.Close(), IsNil)
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return checkSubTaskStatus(cli, pb.Stage_Paused)
+	}), IsTrue)
+
+	// the subtask is recovered from the embedded etcd after restarting
+	s.Close()
+	s = startServer()
+	defer s.Close()
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return checkSubTaskStatus(cli, pb.Stage_Paused)
+	}), IsTrue)
+
+	resp, err = cli.OperateSubTask(context.Background(), &pb.OperateSubTaskRequest{Op: pb.TaskOp_Resume, Name: "sub-task-name"})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Result, IsTrue, Commentf("%s", resp.Msg))
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return checkSubTaskStatus(cli, pb.Stage_Running)
+	}), IsTrue)
+
+	// stop through the HTTP API
+	req, err = http.NewRequestWithContext(context.Background(), http.MethodDelete, "http://"+workerAddr+"/subtasks?name=sub-task-name", nil)
+	c.Assert(err, IsNil)
+	req.Close = true
+	httpResp, err = http.DefaultClient.Do(req)
+	c.Assert(err, IsNil)
+	var body bytes.Buffer
+	_, err = body.ReadFrom(httpResp.Body)
+	c.Assert(err, IsNil)
+	c.Assert(httpResp.Body.Close(), IsNil)
+	c.Assert(body.String(), Matches, `(?s).*"result": true.*`)
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		return s.getWorker(true).subTaskHolder.findSubTask("sub-task-name") == nil
+	}), IsTrue)
+
+	resp, err = cli.OperateSubTask(context.Background(), &pb.OperateSubTaskRequest{Op: pb.TaskOp_Pause, Name: "sub-task-name"})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Result, IsFalse)
+	c.Assert(resp.Msg, Matches, ".*not found.*")
+	c.Assert(utils.IsDirExists(filepath.Join(cfg.DataDir, "member")), IsTrue)
+}
+
+func (t *testServer) TestNotStandalone(c *C) {
+	s := NewServer(NewConfig())
+	resp, err := s.StartSubTask(context.Background(), &pb.StartSubTaskRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Result, IsFalse)
+	c.Assert(resp.Msg, Matches, ".*only available when dm-worker runs in standalone mode.*")
+	resp, err = s.OperateSubTask(context.Background(), &pb.OperateSubTaskRequest{Op: pb.TaskOp_Pause})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Result, IsFalse)
+}
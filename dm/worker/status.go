@@ -88,6 +88,9 @@ func (w *SourceWorker) Status(stName string, sourceStatus *binlog.SourceStatus)
 				Result:              st.Result(),
 				UnresolvedDDLLockID: lockID,
 			}
+			if w.taskStatusChecker != nil {
+				stStatus.CrashLoop = w.taskStatusChecker.CrashLoop(name)
+			}
 
 			if cu != nil {
 				stStatus.Unit = cu.Type()
@@ -103,6 +106,8 @@ func (w *SourceWorker) Status(stName string, sourceStatus *binlog.SourceStatus)
 				case pb.UnitType_Sync:
 					stStatus.Status = &pb.SubTaskStatus_Sync{Sync: us.(*pb.SyncStatus)}
 				}
+			} else if msg := w.subTaskRecovery.progress(name); msg != "" {
+				stStatus.Status = &pb.SubTaskStatus_Msg{Msg: msg}
 			}
 		}
 		status = append(status, &stStatus)
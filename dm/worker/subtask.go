@@ -20,6 +20,10 @@ import (
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/pingcap/failpoint"
+	bf "github.com/pingcap/tidb-tools/pkg/binlog-filter"
+	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/atomic"
@@ -65,7 +69,7 @@ func createRealUnits(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, wor
 			us = append(us, loader.NewLightning(cfg, etcdClient, workerName))
 		}
 		us = append(us, syncer.NewSyncer(cfg, etcdClient))
-	case config.ModeFull:
+	case config.ModeFull, config.ModeSchemaOnly:
 		// NOTE: maybe need another checker in the future?
 		us = append(us, dumpling.NewDumpling(cfg))
 		if cfg.TiDB.Backend == "" {
@@ -551,6 +555,42 @@ func (st *SubTask) Update(cfg *config.SubTaskConfig) error {
 	return nil
 }
 
+// CheckUpdate checks whether the sub task's config can be updated to `cfg` without applying it.
+func (st *SubTask) CheckUpdate(cfg *config.SubTaskConfig) error {
+	if st.Stage() != pb.Stage_Paused {
+		return terror.ErrWorkerUpdateTaskStage.Generate(st.Stage().String())
+	}
+
+	// only the rules are updated by the units, other items take no effect until the subtask is restarted.
+	st.RLock()
+	old := st.cfg
+	st.RUnlock()
+	switch {
+	case cfg.Mode != old.Mode:
+		return terror.ErrWorkerUpdateSubTaskConfig.Generate("task-mode", cfg.Name)
+	case cfg.ShardMode != old.ShardMode:
+		return terror.ErrWorkerUpdateSubTaskConfig.Generate("shard-mode", cfg.Name)
+	case cfg.MetaSchema != old.MetaSchema:
+		return terror.ErrWorkerUpdateSubTaskConfig.Generate("meta-schema", cfg.Name)
+	case cfg.CaseSensitive != old.CaseSensitive:
+		return terror.ErrWorkerUpdateSubTaskConfig.Generate("case-sensitive", cfg.Name)
+	}
+
+	if _, err := filter.New(cfg.CaseSensitive, cfg.BAList); err != nil {
+		return terror.ErrSyncerUnitGenBAList.Delegate(err)
+	}
+	if _, err := router.NewTableRouter(cfg.CaseSensitive, cfg.RouteRules); err != nil {
+		return terror.ErrSyncerUnitGenTableRouter.Delegate(err)
+	}
+	if _, err := bf.NewBinlogEvent(cfg.CaseSensitive, cfg.FilterRules); err != nil {
+		return terror.ErrSyncerUnitGenBinlogEventFilter.Delegate(err)
+	}
+	if _, err := cm.NewMapping(cfg.CaseSensitive, cfg.ColumnMappingRules); err != nil {
+		return terror.ErrSyncerUnitGenColumnMapping.Delegate(err)
+	}
+	return nil
+}
+
 // OperateSchema operates schema for an upstream table.
 func (st *SubTask) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaRequest) (schema string, err error) {
 	if st.Stage() != pb.Stage_Paused {
@@ -565,6 +605,21 @@ func (st *SubTask) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchem
 	return syncUnit.OperateSchema(ctx, req)
 }
 
+// QuarantineTable adds, releases or shows the quarantined downstream tables, the subtask doesn't need to be paused.
+func (st *SubTask) QuarantineTable(ctx context.Context, req *pb.QuarantineWorkerTableRequest) (string, error) {
+	cu := st.CurrUnit()
+	syncUnit, ok := cu.(*syncer.Syncer)
+	if !ok {
+		unitType := pb.UnitType_InvalidUnit
+		if cu != nil {
+			unitType = cu.Type()
+		}
+		return "", terror.ErrWorkerOperSyncUnitOnly.Generate(unitType)
+	}
+
+	return syncUnit.QuarantineTable(ctx, req)
+}
+
 // UpdateFromConfig updates config for `From`.
 func (st *SubTask) UpdateFromConfig(cfg *config.SubTaskConfig) error {
 	st.Lock()
@@ -582,6 +637,16 @@ func (st *SubTask) UpdateFromConfig(cfg *config.SubTaskConfig) error {
 	return nil
 }
 
+// ResetErrorBudget resets the error budget of the downstream if current unit is sync unit.
+func (st *SubTask) ResetErrorBudget() {
+	st.Lock()
+	defer st.Unlock()
+
+	if syncUnit, ok := st.currUnit.(*syncer.Syncer); ok {
+		syncUnit.ResetErrorBudget()
+	}
+}
+
 // CheckUnit checks whether current unit is sync unit.
 func (st *SubTask) CheckUnit() bool {
 	st.Lock()
@@ -610,6 +675,18 @@ func (st *SubTask) ShardDDLOperation() *pessimism.Operation {
 	return syncer2.ShardDDLOperation()
 }
 
+// SyncerLocation returns the location replicated by the sync unit, returns false if current unit is not sync unit.
+func (st *SubTask) SyncerLocation() (binlog.Location, bool) {
+	st.RLock()
+	defer st.RUnlock()
+
+	syncUnit, ok := st.currUnit.(*syncer.Syncer)
+	if !ok {
+		return binlog.Location{}, false
+	}
+	return syncUnit.FlushedLocation(), true
+}
+
 // unitTransWaitCondition waits when transferring from current unit to next unit.
 // Currently there is only one wait condition
 // from Load unit to Sync unit, wait for relay-log catched up with mydumper binlog position.
@@ -699,22 +776,23 @@ func (st *SubTask) fail(err error) {
 	})
 }
 
-// HandleError handle error for syncer unit.
-func (st *SubTask) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) error {
+// HandleError handle error for syncer unit, the returned message describes the error event when previewing.
+func (st *SubTask) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
 	syncUnit, ok := st.currUnit.(*syncer.Syncer)
 	if !ok {
-		return terror.ErrWorkerOperSyncUnitOnly.Generate(st.currUnit.Type())
+		return "", terror.ErrWorkerOperSyncUnitOnly.Generate(st.currUnit.Type())
 	}
 
-	err := syncUnit.HandleError(ctx, req)
+	msg, err := syncUnit.HandleError(ctx, req)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	if st.Stage() == pb.Stage_Paused {
+	// previewing changes nothing, so the subtask keeps paused.
+	if req.Op != pb.ErrorOp_Preview && st.Stage() == pb.Stage_Paused {
 		err = st.Resume()
 	}
-	return err
+	return msg, err
 }
 
 func updateTaskMetric(task, sourceID string, stage pb.Stage, workerName string) {
@@ -0,0 +1,201 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/ha"
+)
+
+// recoveringSubTask is a subtask created but not run yet when dm-worker starts to handle the source.
+type recoveringSubTask struct {
+	st *SubTask
+	// the latest expected stage, it may be changed by the user before the subtask is recovered.
+	expect     pb.Stage
+	deleted    bool
+	recovering bool
+}
+
+// subTaskRecovery recovers subtasks with bounded concurrency, so that a dm-worker handling many subtasks doesn't
+// connect to upstream and downstream, load checkpoints and so on for all of them at once after restarting.
+type subTaskRecovery struct {
+	sync.Mutex
+	// subtasks waiting to be recovered, in recovering order.
+	queue []*recoveringSubTask
+	// task name -> subtasks waiting to be or being recovered.
+	tasks map[string]*recoveringSubTask
+}
+
+func newSubTaskRecovery() *subTaskRecovery {
+	return &subTaskRecovery{tasks: make(map[string]*recoveringSubTask)}
+}
+
+// add adds subtasks to be recovered. the subtasks with higher priority are recovered earlier, then the subtasks
+// expected to be running.
+func (r *subTaskRecovery) add(sts []*SubTask, expects []pb.Stage) {
+	r.Lock()
+	defer r.Unlock()
+	for i, st := range sts {
+		t := &recoveringSubTask{st: st, expect: expects[i]}
+		r.queue = append(r.queue, t)
+		r.tasks[st.cfg.Name] = t
+	}
+	sort.SliceStable(r.queue, func(i, j int) bool {
+		ti, tj := r.queue[i], r.queue[j]
+		if ti.st.cfg.Priority != tj.st.cfg.Priority {
+			return ti.st.cfg.Priority > tj.st.cfg.Priority
+		}
+		if (ti.expect == pb.Stage_Running) != (tj.expect == pb.Stage_Running) {
+			return ti.expect == pb.Stage_Running
+		}
+		return ti.st.cfg.Name < tj.st.cfg.Name
+	})
+}
+
+// next returns the next subtask to recover, nil if no subtask is waiting.
+func (r *subTaskRecovery) next() *recoveringSubTask {
+	r.Lock()
+	defer r.Unlock()
+	if len(r.queue) == 0 {
+		return nil
+	}
+	t := r.queue[0]
+	r.queue = r.queue[1:]
+	t.recovering = true
+	return t
+}
+
+// done marks the subtask recovered, and returns its latest expected stage.
+func (r *subTaskRecovery) done(name string) (expect pb.Stage, deleted bool) {
+	r.Lock()
+	defer r.Unlock()
+	t := r.tasks[name]
+	delete(r.tasks, name)
+	return t.expect, t.deleted
+}
+
+// deferStage records the stage of a subtask waiting to be or being recovered, and returns whether it's recorded. the
+// stage is applied after the subtask is recovered.
+func (r *subTaskRecovery) deferStage(stage ha.Stage) bool {
+	r.Lock()
+	defer r.Unlock()
+	t, ok := r.tasks[stage.Task]
+	if !ok {
+		return false
+	}
+	if stage.IsDeleted {
+		t.deleted = true
+	} else {
+		t.expect = stage.Expect
+	}
+	return true
+}
+
+// progress returns the recovering progress of the subtask, empty if it has been recovered.
+func (r *subTaskRecovery) progress(name string) string {
+	r.Lock()
+	defer r.Unlock()
+	t, ok := r.tasks[name]
+	switch {
+	case !ok:
+		return ""
+	case t.recovering:
+		return "recovering after dm-worker started to handle the source"
+	}
+	for i, t2 := range r.queue {
+		if t2 == t {
+			return fmt.Sprintf("waiting to be recovered after dm-worker started to handle the source, %d subtasks ahead", i)
+		}
+	}
+	return ""
+}
+
+// clear forgets all subtasks not recovered.
+func (r *subTaskRecovery) clear() {
+	r.Lock()
+	defer r.Unlock()
+	r.queue = nil
+	r.tasks = make(map[string]*recoveringSubTask)
+}
+
+// recoverSubTasks runs the subtasks created when starting to handle the source with bounded concurrency, until all of
+// them are recovered or ctx is canceled.
+func (w *SourceWorker) recoverSubTasks(ctx context.Context) {
+	concurrency := w.recoverConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultRecoverSubTaskConcurrency
+	}
+	start := time.Now()
+	w.l.Info("start to recover subtasks", zap.Int("concurrency", concurrency))
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				t := w.subTaskRecovery.next()
+				if t == nil {
+					return
+				}
+				w.recoverSubTask(t)
+			}
+		}()
+	}
+	wg.Wait()
+	// the subtasks not recovered are closed when disabling handling subtasks.
+	w.subTaskRecovery.clear()
+	w.l.Info("finish recovering subtasks", zap.Duration("cost time", time.Since(start)), zap.Error(ctx.Err()))
+}
+
+func (w *SourceWorker) recoverSubTask(t *recoveringSubTask) {
+	name := t.st.cfg.Name
+	// the subtask may be stopped as an orphan subtask.
+	if w.subTaskHolder.findSubTask(name) == t.st && t.st.Stage() == pb.Stage_New {
+		// the expected stage may be changed while waiting.
+		w.subTaskRecovery.Lock()
+		expect, deleted := t.expect, t.deleted
+		w.subTaskRecovery.Unlock()
+		if !deleted {
+			w.l.Info("recover subtask", zap.String("task", name), zap.Stringer("expect stage", expect))
+			t.st.Run(expect)
+		}
+	}
+
+	expect, deleted := w.subTaskRecovery.done(name)
+	var op pb.TaskOp
+	switch {
+	case deleted && w.subTaskHolder.findSubTask(name) == t.st:
+		op = pb.TaskOp_Stop
+	case expect == pb.Stage_Running && t.st.Stage() == pb.Stage_Paused:
+		op = pb.TaskOp_Resume
+	case expect == pb.Stage_Paused && t.st.Stage() == pb.Stage_Running:
+		op = pb.TaskOp_Pause
+	default:
+		return
+	}
+	w.l.Info("apply the stage changed while recovering subtask", zap.String("task", name), zap.Stringer("op", op))
+	if err := w.OperateSubTask(name, op); err != nil {
+		opErrCounter.WithLabelValues(w.name, op.String()).Inc()
+		w.l.Error("fail to operate subtask after recovered", zap.String("task", name), zap.Stringer("op", op), zap.Error(err))
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+
+	. "github.com/pingcap/check"
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/dm/unit"
+	"github.com/pingcap/dm/pkg/ha"
+)
+
+type testSubTaskRecovery struct{}
+
+var _ = Suite(&testSubTaskRecovery{})
+
+func (t *testSubTaskRecovery) newSubTask(name string, priority int) *SubTask {
+	cfg := &config.SubTaskConfig{Name: name, Mode: config.ModeIncrement, Priority: priority}
+	return NewSubTask(cfg, nil, "worker")
+}
+
+func (t *testSubTaskRecovery) TestRecoveryOrder(c *C) {
+	r := newSubTaskRecovery()
+	r.add([]*SubTask{
+		t.newSubTask("paused", 0),
+		t.newSubTask("b", 0),
+		t.newSubTask("a", 0),
+		t.newSubTask("urgent", 10),
+	}, []pb.Stage{pb.Stage_Paused, pb.Stage_Running, pb.Stage_Running, pb.Stage_Paused})
+
+	c.Assert(r.progress("a"), Matches, "waiting to be recovered.*, 1 subtasks ahead")
+	c.Assert(r.progress("paused"), Matches, "waiting to be recovered.*, 3 subtasks ahead")
+	c.Assert(r.progress("not-exist"), Equals, "")
+
+	names := make([]string, 0, 4)
+	for t := r.next(); t != nil; t = r.next() {
+		names = append(names, t.st.cfg.Name)
+	}
+	c.Assert(names, DeepEquals, []string{"urgent", "a", "b", "paused"})
+	c.Assert(r.progress("a"), Matches, "recovering.*")
+
+	// the stage changed before recovered is deferred.
+	c.Assert(r.deferStage(ha.NewSubTaskStage(pb.Stage_Paused, "source", "a")), IsTrue)
+	expect, deleted := r.done("a")
+	c.Assert(expect, Equals, pb.Stage_Paused)
+	c.Assert(deleted, IsFalse)
+	c.Assert(r.progress("a"), Equals, "")
+	c.Assert(r.deferStage(ha.NewSubTaskStage(pb.Stage_Paused, "source", "a")), IsFalse)
+}
+
+func (t *testSubTaskRecovery) TestRecoverSubTasks(c *C) {
+	createUnits = func(cfg *config.SubTaskConfig, etcdClient *clientv3.Client, worker string) []unit.Unit {
+		return []unit.Unit{NewMockUnit(pb.UnitType_Sync)}
+	}
+	defer func() {
+		createUnits = createRealUnits
+	}()
+
+	w, err := NewSourceWorker(loadSourceConfigWithoutPassword(c), nil, "worker")
+	c.Assert(err, IsNil)
+	w.closed.Store(false)
+	w.recoverConcurrency = 2
+
+	sts := []*SubTask{t.newSubTask("running", 0), t.newSubTask("paused", 0), t.newSubTask("deleted", 0), t.newSubTask("resumed", 0)}
+	for _, st := range sts {
+		w.subTaskHolder.recordSubTask(st)
+	}
+	w.subTaskRecovery.add(sts, []pb.Stage{pb.Stage_Running, pb.Stage_Running, pb.Stage_Running, pb.Stage_Paused})
+	status := w.Status("deleted", nil)
+	c.Assert(status[0].Stage, Equals, pb.Stage_New)
+	c.Assert(status[0].GetMsg(), Matches, "waiting to be recovered.*")
+
+	// the stages changed while waiting to be recovered.
+	_, err = w.operateSubTaskStage(ha.NewSubTaskStage(pb.Stage_Paused, "source", "paused"), config.SubTaskConfig{})
+	c.Assert(err, IsNil)
+	deletedStage := ha.NewSubTaskStage(pb.Stage_Running, "source", "deleted")
+	deletedStage.IsDeleted = true
+	_, err = w.operateSubTaskStage(deletedStage, config.SubTaskConfig{})
+	c.Assert(err, IsNil)
+	_, err = w.operateSubTaskStage(ha.NewSubTaskStage(pb.Stage_Running, "source", "resumed"), config.SubTaskConfig{})
+	c.Assert(err, IsNil)
+
+	w.recoverSubTasks(context.Background())
+	c.Assert(sts[0].Stage(), Equals, pb.Stage_Running)
+	c.Assert(sts[1].Stage(), Equals, pb.Stage_Paused)
+	c.Assert(w.subTaskHolder.findSubTask("deleted"), IsNil)
+	c.Assert(sts[3].Stage(), Equals, pb.Stage_Running)
+	c.Assert(w.subTaskRecovery.progress("running"), Equals, "")
+	w.subTaskHolder.closeAllSubTasks()
+}
@@ -29,6 +29,7 @@ import (
 
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
 	"go.etcd.io/etcd/clientv3"
 )
 
@@ -519,3 +520,33 @@ func (t *testSubTask) TestSubtaskFastQuit(c *C) {
 	}
 	c.Assert(st.Stage(), Equals, pb.Stage_Stopped)
 }
+
+func (t *testSubTask) TestSubtaskCheckUpdate(c *C) {
+	cfg := &config.SubTaskConfig{
+		Name: "testSubtaskCheckUpdate",
+		Mode: config.ModeAll,
+	}
+	newCfg := func() *config.SubTaskConfig {
+		return &config.SubTaskConfig{
+			Name:       "testSubtaskCheckUpdate",
+			Mode:       config.ModeAll,
+			RouteRules: []*router.TableRule{{SchemaPattern: "db*", TargetSchema: "db"}},
+		}
+	}
+
+	st := NewSubTaskWithStage(cfg, pb.Stage_Running, nil, "worker")
+	c.Assert(st.CheckUpdate(newCfg()), ErrorMatches, ".*can only update task on Paused stage.*")
+
+	st = NewSubTaskWithStage(cfg, pb.Stage_Paused, nil, "worker")
+	c.Assert(st.CheckUpdate(newCfg()), IsNil)
+
+	cfg2 := newCfg()
+	cfg2.Mode = config.ModeIncrement
+	c.Assert(st.CheckUpdate(cfg2), ErrorMatches, ".*can't update `task-mode` of subtask testSubtaskCheckUpdate online.*")
+
+	cfg2 = newCfg()
+	cfg2.RouteRules = append(cfg2.RouteRules, &router.TableRule{SchemaPattern: "db*", TargetSchema: "db2"})
+	c.Assert(st.CheckUpdate(cfg2), NotNil)
+	// the config is not changed
+	c.Assert(st.cfg.RouteRules, HasLen, 0)
+}
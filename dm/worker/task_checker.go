@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/pingcap/failpoint"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -42,6 +43,9 @@ import (
 // 	DefaultBackoffFactor   float64 = 2
 // )
 
+// resumeBudgetWindow is the window in which the auto resumes of a task are limited by `resume-budget`.
+var resumeBudgetWindow = time.Hour
+
 // ResumeStrategy represents what we can do when we meet a paused task in task status checker.
 type ResumeStrategy int
 
@@ -56,6 +60,8 @@ type ResumeStrategy int
 //	2. update latestBlockTime
 // ResumeSkip:
 //	1. update latestPausedTime
+// ResumeCrashLoop:
+//	1. mark the task in crash loop, it's not auto resumed until resumed manually
 // ResumeDispatch:
 //	1. update latestPausedTime
 //	2. dispatch auto resume task
@@ -74,13 +80,17 @@ const (
 	ResumeNoSense
 	// ResumeDispatch means we will dispatch an auto resume operation in this check round for the paused task.
 	ResumeDispatch
+	// When the task is still paused after `resume-budget` auto resumes in the last hour, or it's already in crash
+	// loop, we will apply ResumeCrashLoop strategy and stop auto resuming it until it's resumed manually.
+	ResumeCrashLoop
 )
 
 var resumeStrategy2Str = map[ResumeStrategy]string{
-	ResumeIgnore:   "ignore task",
-	ResumeSkip:     "skip task resume",
-	ResumeNoSense:  "resume task makes no sense",
-	ResumeDispatch: "dispatch auto resume",
+	ResumeIgnore:    "ignore task",
+	ResumeSkip:      "skip task resume",
+	ResumeNoSense:   "resume task makes no sense",
+	ResumeDispatch:  "dispatch auto resume",
+	ResumeCrashLoop: "task is in crash loop",
 }
 
 // String implements fmt.Stringer interface.
@@ -99,6 +109,8 @@ type TaskStatusChecker interface {
 	Start()
 	// Close closes the checker
 	Close()
+	// CrashLoop returns whether the task is in crash loop
+	CrashLoop(taskName string) bool
 }
 
 // NewTaskStatusChecker is a TaskStatusChecker initializer.
@@ -117,6 +129,13 @@ type backoffController struct {
 	// task name -> the latest auto resume time
 	latestResumeTime map[string]time.Time
 
+	// task name -> the auto resume times in the last `resumeBudgetWindow`
+	resumeHistory map[string][]time.Time
+
+	// task name -> the time the task enters crash loop, it's read by query-status
+	crashLoopMu   sync.RWMutex
+	crashLoopTime map[string]time.Time
+
 	latestRelayPausedTime time.Time
 	latestRelayBlockTime  time.Time
 	latestRelayResumeTime time.Time
@@ -130,7 +149,66 @@ func newBackoffController() *backoffController {
 		latestPausedTime: make(map[string]time.Time),
 		latestBlockTime:  make(map[string]time.Time),
 		latestResumeTime: make(map[string]time.Time),
+		resumeHistory:    make(map[string][]time.Time),
+		crashLoopTime:    make(map[string]time.Time),
+	}
+}
+
+// recordResume records an auto resume of the task, and removes the records out of `resumeBudgetWindow`.
+func (bc *backoffController) recordResume(taskName string, now time.Time) {
+	history := append(bc.resumeHistory[taskName], now)
+	i := 0
+	for i < len(history) && now.Sub(history[i]) >= resumeBudgetWindow {
+		i++
+	}
+	bc.resumeHistory[taskName] = history[i:]
+}
+
+// resumeBudgetExhausted returns whether the task has been auto resumed `budget` times in the last
+// `resumeBudgetWindow`, 0 budget means no limit.
+func (bc *backoffController) resumeBudgetExhausted(taskName string, budget int, now time.Time) bool {
+	if budget <= 0 {
+		return false
+	}
+	count := 0
+	for _, t := range bc.resumeHistory[taskName] {
+		if now.Sub(t) < resumeBudgetWindow {
+			count++
+		}
+	}
+	return count >= budget
+}
+
+// inCrashLoop returns whether the task is in crash loop.
+func (bc *backoffController) inCrashLoop(taskName string) bool {
+	bc.crashLoopMu.RLock()
+	defer bc.crashLoopMu.RUnlock()
+	_, ok := bc.crashLoopTime[taskName]
+	return ok
+}
+
+// setCrashLoop marks the task in crash loop, it returns false if the task is already in crash loop.
+func (bc *backoffController) setCrashLoop(taskName string) bool {
+	bc.crashLoopMu.Lock()
+	defer bc.crashLoopMu.Unlock()
+	if _, ok := bc.crashLoopTime[taskName]; ok {
+		return false
+	}
+	bc.crashLoopTime[taskName] = time.Now()
+	return true
+}
+
+// clearCrashLoop removes the crash loop mark and the auto resume records of the task, it returns false if the task
+// is not in crash loop.
+func (bc *backoffController) clearCrashLoop(taskName string) bool {
+	bc.crashLoopMu.Lock()
+	defer bc.crashLoopMu.Unlock()
+	if _, ok := bc.crashLoopTime[taskName]; !ok {
+		return false
 	}
+	delete(bc.crashLoopTime, taskName)
+	delete(bc.resumeHistory, taskName)
+	return true
 }
 
 // realTaskStatusChecker is not thread-safe.
@@ -177,6 +255,11 @@ func (tsc *realTaskStatusChecker) Start() {
 	}()
 }
 
+// CrashLoop implements TaskStatusChecker.CrashLoop.
+func (tsc *realTaskStatusChecker) CrashLoop(taskName string) bool {
+	return tsc.bc.inCrashLoop(taskName)
+}
+
 // Close implements TaskStatusChecker.Close.
 func (tsc *realTaskStatusChecker) Close() {
 	if !tsc.closed.CAS(false, true) {
@@ -271,6 +354,11 @@ func (tsc *realTaskStatusChecker) getResumeStrategy(stStatus *pb.SubTaskStatus,
 		}
 	}
 
+	// the task keeps failing after auto resumes, stop resuming it to avoid hammering upstream and downstream
+	if tsc.bc.inCrashLoop(stStatus.Name) || tsc.bc.resumeBudgetExhausted(stStatus.Name, tsc.cfg.ResumeBudget, time.Now()) {
+		return ResumeCrashLoop
+	}
+
 	// auto resume interval does not exceed backoff duration, skip this paused task
 	if time.Since(tsc.bc.latestResumeTime[stStatus.Name]) < duration {
 		return ResumeSkip
@@ -354,6 +442,10 @@ func (tsc *realTaskStatusChecker) checkTaskStatus() {
 				delete(tsc.bc.latestPausedTime, taskName)
 				delete(tsc.bc.latestBlockTime, taskName)
 				delete(tsc.bc.latestResumeTime, taskName)
+				delete(tsc.bc.resumeHistory, taskName)
+				if tsc.bc.clearCrashLoop(taskName) {
+					taskCrashLoop.DeleteAllAboutLabels(prometheus.Labels{"task": taskName, "source_id": tsc.w.cfg.SourceID})
+				}
 			}
 		}
 	}()
@@ -375,6 +467,11 @@ func (tsc *realTaskStatusChecker) checkTaskStatus() {
 				// after each rollback, reset this timer
 				tsc.bc.latestPausedTime[taskName] = time.Now()
 			}
+			// the task in crash loop is resumed, paused or stopped manually
+			if tsc.bc.clearCrashLoop(taskName) {
+				tsc.l.Info("task leaves crash loop", zap.String("task", taskName))
+				taskCrashLoop.DeleteAllAboutLabels(prometheus.Labels{"task": taskName, "source_id": tsc.w.cfg.SourceID})
+			}
 		case ResumeNoSense:
 			// this strategy doesn't forward or rollback backoff
 			tsc.bc.latestPausedTime[taskName] = time.Now()
@@ -396,8 +493,17 @@ func (tsc *realTaskStatusChecker) checkTaskStatus() {
 			} else {
 				tsc.l.Info("dispatch auto resume task", zap.String("task", taskName))
 				tsc.bc.latestResumeTime[taskName] = time.Now()
+				tsc.bc.recordResume(taskName, time.Now())
 				bf.BoundaryForward()
 			}
+		case ResumeCrashLoop:
+			// this strategy doesn't forward or rollback backoff
+			tsc.bc.latestPausedTime[taskName] = time.Now()
+			if tsc.bc.setCrashLoop(taskName) {
+				tsc.l.Error("task enters crash loop, it will not be auto resumed until resumed manually", zap.String("task", taskName),
+					zap.Int("resume budget", tsc.cfg.ResumeBudget), zap.Duration("budget window", resumeBudgetWindow))
+				taskCrashLoop.WithLabelValues(taskName, tsc.w.cfg.SourceID, tsc.w.name).Set(1)
+			}
 		}
 	}
 }
@@ -190,6 +190,86 @@ func (s *testTaskCheckerSuite) TestCheck(c *check.C) {
 	}
 }
 
+func (s *testTaskCheckerSuite) TestCrashLoop(c *check.C) {
+	taskName := "test-crash-loop-task"
+
+	NewRelayHolder = NewDummyRelayHolder
+	dir := c.MkDir()
+	cfg := loadSourceConfigWithoutPassword(c)
+	cfg.RelayDir = dir
+	cfg.MetaDir = dir
+	w, err := NewSourceWorker(cfg, nil, "")
+	c.Assert(err, check.IsNil)
+	w.closed.Store(false)
+
+	tsc := NewRealTaskStatusChecker(config.CheckerConfig{
+		CheckEnable:     true,
+		CheckInterval:   config.Duration{Duration: config.DefaultCheckInterval},
+		BackoffRollback: config.Duration{Duration: 200 * time.Millisecond},
+		BackoffMin:      config.Duration{Duration: 1 * time.Millisecond},
+		BackoffMax:      config.Duration{Duration: 1 * time.Millisecond},
+		BackoffFactor:   config.DefaultBackoffFactor,
+		ResumeBudget:    2,
+	}, w)
+	c.Assert(tsc.Init(), check.IsNil)
+	rtsc, ok := tsc.(*realTaskStatusChecker)
+	c.Assert(ok, check.IsTrue)
+
+	st := &SubTask{
+		cfg:   &config.SubTaskConfig{Name: taskName},
+		stage: pb.Stage_Running,
+		l:     log.With(zap.String("subtask", taskName)),
+	}
+	rtsc.w.subTaskHolder.recordSubTask(st)
+	rtsc.check()
+
+	// auto resumed twice
+	paused := func() {
+		st.stage = pb.Stage_Paused
+		st.result = &pb.ProcessResult{
+			IsCanceled: false,
+			Errors:     []*pb.ProcessError{unknownProcessError},
+		}
+	}
+	for i := 0; i < 2; i++ {
+		paused()
+		time.Sleep(2 * time.Millisecond)
+		rtsc.check()
+		c.Assert(rtsc.bc.resumeHistory[taskName], check.HasLen, i+1)
+		c.Assert(tsc.CrashLoop(taskName), check.IsFalse)
+	}
+
+	// the budget is exhausted, the task enters crash loop and is not auto resumed any more
+	paused()
+	latestResumeTime := rtsc.bc.latestResumeTime[taskName]
+	for i := 0; i < 3; i++ {
+		time.Sleep(2 * time.Millisecond)
+		rtsc.check()
+		c.Assert(tsc.CrashLoop(taskName), check.IsTrue)
+		c.Assert(rtsc.bc.latestResumeTime[taskName], check.Equals, latestResumeTime)
+	}
+	c.Assert(rtsc.getResumeStrategy(&pb.SubTaskStatus{Name: taskName, Stage: pb.Stage_Paused, Result: st.result}, 0), check.Equals, ResumeCrashLoop)
+
+	// the task is resumed manually, the budget is reset
+	st.stage = pb.Stage_Running
+	st.result = nil
+	rtsc.check()
+	c.Assert(tsc.CrashLoop(taskName), check.IsFalse)
+	c.Assert(rtsc.bc.resumeHistory[taskName], check.HasLen, 0)
+	paused()
+	time.Sleep(2 * time.Millisecond)
+	rtsc.check()
+	c.Assert(rtsc.bc.resumeHistory[taskName], check.HasLen, 1)
+	c.Assert(tsc.CrashLoop(taskName), check.IsFalse)
+
+	// the auto resumes out of the window are not counted
+	c.Assert(rtsc.bc.resumeBudgetExhausted(taskName, 1, time.Now()), check.IsTrue)
+	c.Assert(rtsc.bc.resumeBudgetExhausted(taskName, 1, time.Now().Add(resumeBudgetWindow)), check.IsFalse)
+	c.Assert(rtsc.bc.resumeBudgetExhausted(taskName, 0, time.Now()), check.IsFalse)
+	rtsc.bc.recordResume(taskName, time.Now().Add(resumeBudgetWindow))
+	c.Assert(rtsc.bc.resumeHistory[taskName], check.HasLen, 1)
+}
+
 func (s *testTaskCheckerSuite) TestCheckTaskIndependent(c *check.C) {
 	var (
 		task1                 = "task1"
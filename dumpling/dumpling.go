@@ -45,7 +45,13 @@ type Dumpling struct {
 	logger log.Logger
 
 	dumpConfig *export.Config
-	closed     atomic.Bool
+	// dumpling connects to the upstream through it if the tunnel is configured
+	tunnel *conn.Tunnel
+	closed atomic.Bool
+
+	// used to estimate the progress
+	startTime atomic.Time
+	finish    atomic.Bool
 }
 
 // NewDumpling creates a new Dumpling.
@@ -64,7 +70,7 @@ func (m *Dumpling) Init(ctx context.Context) error {
 		return err
 	}
 	m.detectSQLMode(ctx)
-	m.dumpConfig.SessionParams["time_zone"] = "+00:00"
+	m.dumpConfig.SessionParams["time_zone"] = m.cfg.To.TimeZone()
 	m.logger.Info("create dumpling", zap.Stringer("config", m.dumpConfig))
 	return nil
 }
@@ -87,6 +93,8 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	})
 
 	begin := time.Now()
+	m.startTime.Store(begin)
+	m.finish.Store(false)
 	errs := make([]*pb.ProcessError, 0, 1)
 
 	failpoint.Inject("dumpUnitProcessForever", func() {
@@ -142,6 +150,7 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	}
 
 	if len(errs) == 0 {
+		m.finish.Store(!isCanceled)
 		m.logger.Info("dump data finished", zap.Duration("cost time", time.Since(begin)))
 	} else {
 		m.logger.Error("dump data exits with error", zap.Duration("cost time", time.Since(begin)),
@@ -167,6 +176,7 @@ func (m *Dumpling) Close() {
 
 	m.removeLabelValuesWithTaskInMetrics(m.cfg.Name, m.cfg.SourceID)
 	// do nothing, external will cancel the command (if running)
+	m.tunnel.Close()
 	m.closed.Store(true)
 }
 
@@ -197,8 +207,37 @@ func (m *Dumpling) Update(cfg *config.SubTaskConfig) error {
 
 // Status implements Unit.Status.
 func (m *Dumpling) Status(_ *binlog.SourceStatus) interface{} {
-	// NOTE: try to add some status, like dumped file count
-	return &pb.DumpStatus{}
+	finishedRows, totalRows := readProgress(m.cfg.Name, m.cfg.SourceID)
+	return &pb.DumpStatus{
+		ProgressDetail: progressDetail(finishedRows, totalRows, m.startTime.Load(), m.finish.Load(), time.Now()),
+	}
+}
+
+// progressDetail returns the progress in rows of the dumped data, the total rows are estimated by dumpling.
+func progressDetail(finishedRows, totalRows int64, startTime time.Time, finish bool, now time.Time) *pb.Progress {
+	progress := &pb.Progress{
+		Finished:                  finishedRows,
+		Total:                     totalRows,
+		Unit:                      "rows",
+		EstimatedRemainingSeconds: -1,
+		Phase:                     "dumping",
+	}
+	if finish {
+		progress.EstimatedRemainingSeconds = 0
+		progress.Phase = "finished"
+		return progress
+	}
+	if startTime.IsZero() {
+		return progress
+	}
+	if elapsed := int64(now.Sub(startTime).Seconds()); elapsed > 0 {
+		progress.Rate = finishedRows / elapsed
+	}
+	// the estimated total rows may be less than the actual rows.
+	if progress.Rate > 0 && totalRows > finishedRows {
+		progress.EstimatedRemainingSeconds = (totalRows - finishedRows) / progress.Rate
+	}
+	return progress
 }
 
 // Type implements Unit.Type.
@@ -214,7 +253,12 @@ func (m *Dumpling) IsFreshTask(ctx context.Context) (bool, error) {
 // constructArgs constructs arguments for exec.Command.
 func (m *Dumpling) constructArgs() (*export.Config, error) {
 	cfg := m.cfg
-	db := cfg.From
+	db, tunnel, err := conn.ApplyTunnel(cfg.From)
+	if err != nil {
+		return nil, err
+	}
+	m.tunnel.Close()
+	m.tunnel = tunnel
 
 	dumpConfig := export.DefaultConfig()
 
@@ -233,9 +277,9 @@ func (m *Dumpling) constructArgs() (*export.Config, error) {
 	dumpConfig.TableFilter = tableFilter
 	dumpConfig.CompleteInsert = true // always keep column name in `INSERT INTO` statements.
 	dumpConfig.Logger = m.logger.Logger
-	// force using UTC timezone
+	// dump the timestamps in the session time zone of the target database, which is UTC by default.
 	dumpConfig.SessionParams = map[string]interface{}{
-		"time_zone": "+00:00",
+		"time_zone": cfg.To.TimeZone(),
 	}
 
 	if cfg.Threads > 0 {
@@ -288,6 +332,11 @@ func (m *Dumpling) constructArgs() (*export.Config, error) {
 		dumpConfig.Rows = 200000
 	}
 
+	// only the schemas are migrated in schema-only mode.
+	if cfg.Mode == config.ModeSchemaOnly {
+		dumpConfig.NoData = true
+	}
+
 	if !cfg.CaseSensitive {
 		dumpConfig.TableFilter = filter.CaseInsensitive(dumpConfig.TableFilter)
 	}
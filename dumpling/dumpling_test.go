@@ -123,3 +123,53 @@ func (d *testDumplingSuite) TestDefaultConfig(c *C) {
 	c.Assert(dumpling.dumpConfig.StatementSize, Not(Equals), export.UnspecifiedSize)
 	c.Assert(dumpling.dumpConfig.Rows, Not(Equals), export.UnspecifiedSize)
 }
+
+func (d *testDumplingSuite) TestSessionTimeZone(c *C) {
+	dumpling := NewDumpling(d.cfg)
+	dumpConfig, err := dumpling.constructArgs()
+	c.Assert(err, IsNil)
+	c.Assert(dumpConfig.SessionParams["time_zone"], Equals, "+00:00")
+
+	cfg := *d.cfg
+	cfg.To.Session = map[string]string{"TIME_ZONE": "Asia/Shanghai"}
+	dumpling = NewDumpling(&cfg)
+	dumpConfig, err = dumpling.constructArgs()
+	c.Assert(err, IsNil)
+	c.Assert(dumpConfig.SessionParams["time_zone"], Equals, "Asia/Shanghai")
+}
+
+func (d *testDumplingSuite) TestSchemaOnly(c *C) {
+	dumpConfig, err := NewDumpling(d.cfg).constructArgs()
+	c.Assert(err, IsNil)
+	c.Assert(dumpConfig.NoData, IsFalse)
+
+	cfg := *d.cfg
+	cfg.Mode = config.ModeSchemaOnly
+	dumpConfig, err = NewDumpling(&cfg).constructArgs()
+	c.Assert(err, IsNil)
+	c.Assert(dumpConfig.NoData, IsTrue)
+}
+
+func (d *testDumplingSuite) TestProgressDetail(c *C) {
+	now := time.Now()
+	// not started yet.
+	progress := progressDetail(0, 0, time.Time{}, false, now)
+	c.Assert(progress, DeepEquals, &pb.Progress{Unit: "rows", EstimatedRemainingSeconds: -1, Phase: "dumping"})
+
+	progress = progressDetail(100, 1000, now.Add(-10*time.Second), false, now)
+	c.Assert(progress, DeepEquals, &pb.Progress{
+		Finished:                  100,
+		Total:                     1000,
+		Unit:                      "rows",
+		Rate:                      10,
+		EstimatedRemainingSeconds: 90,
+		Phase:                     "dumping",
+	})
+	// the estimated total rows are less than the actual rows.
+	progress = progressDetail(1200, 1000, now.Add(-10*time.Second), false, now)
+	c.Assert(progress.EstimatedRemainingSeconds, Equals, int64(-1))
+
+	progress = progressDetail(1200, 1000, now.Add(-10*time.Second), true, now)
+	c.Assert(progress.EstimatedRemainingSeconds, Equals, int64(0))
+	c.Assert(progress.Phase, Equals, "finished")
+}
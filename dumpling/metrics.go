@@ -38,6 +38,37 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	export.RegisterMetrics(registry)
 }
 
+// readProgress reads the finished rows and the estimated total rows of the task from the metrics of dumpling,
+// which are not exposed in other ways.
+func readProgress(task, source string) (int64, int64) {
+	mfs, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		return 0, 0
+	}
+	var finishedRows, totalRows float64
+	for _, mf := range mfs {
+		name := mf.GetName()
+		if name != "dumpling_dump_finished_rows" && name != "dumpling_dump_estimate_total_rows" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			labels := make(map[string]string, len(m.GetLabel()))
+			for _, label := range m.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["task"] != task || labels["source_id"] != source {
+				continue
+			}
+			if name == "dumpling_dump_finished_rows" {
+				finishedRows = m.GetGauge().GetValue()
+			} else {
+				totalRows = m.GetCounter().GetValue()
+			}
+		}
+	}
+	return int64(finishedRows), int64(totalRows)
+}
+
 func (m *Dumpling) removeLabelValuesWithTaskInMetrics(task, source string) {
 	labels := prometheus.Labels{"task": task, "source_id": source}
 	dumplingExitWithErrorCounter.DeleteAllAboutLabels(labels)
@@ -50,6 +50,21 @@ type CheckPoint interface {
 	// IsTableFinished query if table has finished
 	IsTableFinished(db, table string) bool
 
+	// IsSchemaRestored checks if the schema object recorded as `filename` (see `isSchemaCheckpointFile`) was restored
+	IsSchemaRestored(filename string) bool
+
+	// SaveSchemaRestored records the schema object of db / table as restored, set `table` to "" for db
+	SaveSchemaRestored(tctx *tcontext.Context, filename, db, table string) error
+
+	// IsFileCleaned checks if the data file was cleaned up after imported
+	IsFileCleaned(filename string) bool
+
+	// GetCleanedFiles returns the cleaned data files and their end positions, schema -> table -> filename -> end position
+	GetCleanedFiles() map[string]map[string]map[string]int64
+
+	// SaveFileCleaned records the data file of db / table as cleaned up, it should be called before the file is cleaned
+	SaveFileCleaned(tctx *tcontext.Context, filename, db, table string, endPos int64) error
+
 	// CalcProgress calculate which table has finished and which table partial restored
 	CalcProgress(allFiles map[string]Tables2DataFiles) error
 
@@ -95,6 +110,14 @@ type RemoteCheckPoint struct {
 		sync.RWMutex
 		pos map[string]map[string]FilePosSet // schema -> table -> FilePosSet(filename -> [cur, end])
 	}
+	restoredSchemas struct {
+		sync.RWMutex
+		files map[string]struct{} // filename of restored schema objects
+	}
+	cleanedFiles struct {
+		sync.RWMutex
+		pos map[string]map[string]map[string]int64 // schema -> table -> filename -> end position
+	}
 	finishedTables map[string]struct{}
 	logger         log.Logger
 }
@@ -115,6 +138,8 @@ func newRemoteCheckPoint(tctx *tcontext.Context, cfg *config.SubTaskConfig, id s
 		logger:         tctx.L().WithFields(zap.String("component", "remote checkpoint")),
 	}
 	cp.restoringFiles.pos = make(map[string]map[string]FilePosSet)
+	cp.restoredSchemas.files = make(map[string]struct{})
+	cp.cleanedFiles.pos = make(map[string]map[string]map[string]int64)
 
 	err = cp.prepare(tctx)
 	if err != nil {
@@ -188,12 +213,27 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 	cp.restoringFiles.Lock()
 	defer cp.restoringFiles.Unlock()
 	cp.restoringFiles.pos = make(map[string]map[string]FilePosSet) // reset to empty
+	cp.restoredSchemas.Lock()
+	defer cp.restoredSchemas.Unlock()
+	cp.restoredSchemas.files = make(map[string]struct{}) // reset to empty
+	cp.cleanedFiles.Lock()
+	defer cp.cleanedFiles.Unlock()
+	cp.cleanedFiles.pos = make(map[string]map[string]map[string]int64) // reset to empty
 	for rows.Next() {
 		err := rows.Scan(&filename, &schema, &table, &offset, &endPos)
 		if err != nil {
 			return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
 		}
 
+		if isSchemaCheckpointFile(filename) {
+			cp.restoredSchemas.files[filename] = struct{}{}
+			continue
+		}
+		if file, ok := cleanedDataFile(filename); ok {
+			cp.addCleanedFile(file, schema, table, endPos)
+			continue
+		}
+
 		if _, ok := cp.restoringFiles.pos[schema]; !ok {
 			cp.restoringFiles.pos[schema] = make(map[string]FilePosSet)
 		}
@@ -266,6 +306,86 @@ func (cp *RemoteCheckPoint) IsTableFinished(db, table string) bool {
 	return false
 }
 
+// IsSchemaRestored implements CheckPoint.IsSchemaRestored.
+func (cp *RemoteCheckPoint) IsSchemaRestored(filename string) bool {
+	cp.restoredSchemas.RLock()
+	defer cp.restoredSchemas.RUnlock()
+	_, ok := cp.restoredSchemas.files[filename]
+	return ok
+}
+
+// SaveSchemaRestored implements CheckPoint.SaveSchemaRestored.
+func (cp *RemoteCheckPoint) SaveSchemaRestored(tctx *tcontext.Context, filename, db, table string) error {
+	sql2 := fmt.Sprintf("INSERT INTO %s (`id`, `filename`, `cp_schema`, `cp_table`, `offset`, `end_pos`) VALUES(?,?,?,?,?,?)", cp.tableName)
+	args := []interface{}{cp.id, filename, db, table, 0, 0}
+	cp.connMutex.Lock()
+	err := cp.conn.executeSQL(tctx, []string{sql2}, args)
+	cp.connMutex.Unlock()
+	if err != nil && !isErrDupEntry(err) {
+		return terror.WithScope(terror.Annotate(err, "save schema checkpoint"), terror.ScopeDownstream)
+	}
+	cp.restoredSchemas.Lock()
+	defer cp.restoredSchemas.Unlock()
+	cp.restoredSchemas.files[filename] = struct{}{}
+	return nil
+}
+
+// IsFileCleaned implements CheckPoint.IsFileCleaned.
+func (cp *RemoteCheckPoint) IsFileCleaned(filename string) bool {
+	cp.cleanedFiles.RLock()
+	defer cp.cleanedFiles.RUnlock()
+	db, table, err := getDBAndTableFromFilename(filename)
+	if err != nil {
+		return false
+	}
+	_, ok := cp.cleanedFiles.pos[db][table][filename]
+	return ok
+}
+
+// GetCleanedFiles implements CheckPoint.GetCleanedFiles.
+func (cp *RemoteCheckPoint) GetCleanedFiles() map[string]map[string]map[string]int64 {
+	cp.cleanedFiles.RLock()
+	defer cp.cleanedFiles.RUnlock()
+	results := make(map[string]map[string]map[string]int64, len(cp.cleanedFiles.pos))
+	for db, tables := range cp.cleanedFiles.pos {
+		results[db] = make(map[string]map[string]int64, len(tables))
+		for table, files := range tables {
+			results[db][table] = make(map[string]int64, len(files))
+			for file, endPos := range files {
+				results[db][table][file] = endPos
+			}
+		}
+	}
+	return results
+}
+
+// SaveFileCleaned implements CheckPoint.SaveFileCleaned.
+func (cp *RemoteCheckPoint) SaveFileCleaned(tctx *tcontext.Context, filename, db, table string, endPos int64) error {
+	sql2 := fmt.Sprintf("INSERT INTO %s (`id`, `filename`, `cp_schema`, `cp_table`, `offset`, `end_pos`) VALUES(?,?,?,?,?,?)", cp.tableName)
+	args := []interface{}{cp.id, filename + cleanedFileSuffix, db, table, endPos, endPos}
+	cp.connMutex.Lock()
+	err := cp.conn.executeSQL(tctx, []string{sql2}, args)
+	cp.connMutex.Unlock()
+	if err != nil && !isErrDupEntry(err) {
+		return terror.WithScope(terror.Annotate(err, "save cleaned file checkpoint"), terror.ScopeDownstream)
+	}
+	cp.cleanedFiles.Lock()
+	defer cp.cleanedFiles.Unlock()
+	cp.addCleanedFile(filename, db, table, endPos)
+	return nil
+}
+
+// addCleanedFile adds the cleaned data file into memory, the caller should hold the lock of `cleanedFiles`.
+func (cp *RemoteCheckPoint) addCleanedFile(filename, db, table string, endPos int64) {
+	if _, ok := cp.cleanedFiles.pos[db]; !ok {
+		cp.cleanedFiles.pos[db] = make(map[string]map[string]int64)
+	}
+	if _, ok := cp.cleanedFiles.pos[db][table]; !ok {
+		cp.cleanedFiles.pos[db][table] = make(map[string]int64)
+	}
+	cp.cleanedFiles.pos[db][table][filename] = endPos
+}
+
 // CalcProgress implements CheckPoint.CalcProgress.
 func (cp *RemoteCheckPoint) CalcProgress(allFiles map[string]Tables2DataFiles) error {
 	cp.restoringFiles.RLock()
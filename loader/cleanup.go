@@ -0,0 +1,183 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// uploadChunkSize is the size of the chunks when uploading a data file to the external storage.
+const uploadChunkSize = 4 * 1024 * 1024
+
+// dumpFileCleaner cleans up the data files once they are imported according to `cleanup-policy`,
+// so the loader doesn't need the disk space of all data files in the whole importing.
+type dumpFileCleaner struct {
+	policy     string
+	archiveDir string
+	storage    storage.ExternalStorage
+}
+
+// newDumpFileCleaner creates a dumpFileCleaner, nil is returned if the data files are kept.
+func newDumpFileCleaner(ctx context.Context, cfg config.LoaderConfig) (*dumpFileCleaner, error) {
+	c := &dumpFileCleaner{policy: cfg.CleanupPolicy}
+	switch cfg.CleanupPolicy {
+	case config.CleanupDelete:
+	case config.CleanupArchive:
+		if err := os.MkdirAll(cfg.ArchiveDir, 0o755); err != nil {
+			return nil, terror.ErrLoadUnitCleanupDumpFile.Delegate(err, cfg.ArchiveDir)
+		}
+		c.archiveDir = cfg.ArchiveDir
+	case config.CleanupUpload:
+		backend, err := storage.ParseBackend(cfg.ArchiveStorage, nil)
+		if err != nil {
+			return nil, terror.ErrLoadUnitCleanupDumpFile.Delegate(err, cfg.ArchiveStorage)
+		}
+		c.storage, err = storage.New(ctx, backend, &storage.ExternalStorageOptions{})
+		if err != nil {
+			return nil, terror.ErrLoadUnitCleanupDumpFile.Delegate(err, cfg.ArchiveStorage)
+		}
+	default:
+		return nil, nil
+	}
+	return c, nil
+}
+
+// clean cleans up the data file in the dump directory.
+func (c *dumpFileCleaner) clean(ctx context.Context, dir, file string) error {
+	path := filepath.Join(dir, file)
+	var err error
+	switch c.policy {
+	case config.CleanupArchive:
+		err = c.archive(path, filepath.Join(c.archiveDir, file))
+	case config.CleanupUpload:
+		err = c.upload(ctx, path, file)
+	}
+	if err == nil {
+		err = os.Remove(path)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return terror.ErrLoadUnitCleanupDumpFile.Delegate(err, file)
+	}
+	return nil
+}
+
+// archive copies the data file into the archive directory, the file is renamed into it if they are in the same file system.
+func (c *dumpFileCleaner) archive(path, target string) error {
+	if err := os.Rename(path, target); err == nil {
+		return nil
+	}
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
+
+// upload uploads the data file to the external storage.
+func (c *dumpFileCleaner) upload(ctx context.Context, path, name string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	w, err := c.storage.Create(ctx, name)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, err2 := src.Read(buf)
+		if n > 0 {
+			if _, err3 := w.Write(ctx, buf[:n]); err3 != nil {
+				return err3
+			}
+		}
+		if err2 == io.EOF {
+			break
+		}
+		if err2 != nil {
+			return err2
+		}
+	}
+	return w.Close(ctx)
+}
+
+// cleanDataFile cleans up the data file of the job if it's imported, failing to clean up only logs the error and
+// the data file is kept.
+func (l *Loader) cleanDataFile(ctx context.Context, job *fileJob) {
+	if l.cleaner == nil {
+		return
+	}
+	pos, ok := l.checkPoint.GetRestoringFileInfo(job.schema, job.table)[job.dataFile]
+	if !ok || len(pos) != 2 || pos[0] != pos[1] {
+		return
+	}
+	// record before cleaning up, so a missing data file is known as imported when resuming.
+	tctx := tcontext.NewContext(ctx, l.logger)
+	if err := l.checkPoint.SaveFileCleaned(tctx, job.dataFile, job.schema, job.table, pos[1]); err != nil {
+		l.logger.Warn("fail to record the cleaned data file, keep it", zap.String("data file", job.dataFile), log.ShortError(err))
+		return
+	}
+	if err := l.cleaner.clean(ctx, l.cfg.Dir, job.dataFile); err != nil {
+		l.logger.Warn("fail to clean up the data file", zap.String("data file", job.dataFile), zap.String("policy", l.cleaner.policy), log.ShortError(err))
+		return
+	}
+	l.logger.Info("cleaned up the data file", zap.String("data file", job.dataFile), zap.String("policy", l.cleaner.policy))
+}
+
+// prepareCleanedFiles adds the data files which were cleaned up after imported back to the files to restore,
+// so the progress is calculated as if they still exist.
+func (l *Loader) prepareCleanedFiles() {
+	for db, tables := range l.checkPoint.GetCleanedFiles() {
+		db2Tables, ok := l.db2Tables[db]
+		if !ok {
+			continue
+		}
+		for table, files := range tables {
+			dataFiles, ok := db2Tables[table]
+			if !ok {
+				continue
+			}
+			for file, endPos := range files {
+				if utils.IsFileExists(filepath.Join(l.cfg.Dir, file)) {
+					continue
+				}
+				l.addDataFileSize(db, table, endPos)
+				dataFiles = append(dataFiles, file)
+			}
+			db2Tables[table] = dataFiles
+		}
+	}
+}
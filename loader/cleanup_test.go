@@ -0,0 +1,114 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/pingcap/check"
+	"go.uber.org/atomic"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+var _ = Suite(&testCleanupSuite{})
+
+type testCleanupSuite struct{}
+
+func (t *testCleanupSuite) TestDumpFileCleaner(c *C) {
+	var (
+		ctx     = context.Background()
+		dir     = c.MkDir()
+		file    = "db.tbl.0.sql"
+		path    = filepath.Join(dir, file)
+		content = []byte("INSERT INTO `tbl` VALUES (1);\n")
+	)
+
+	cleaner, err := newDumpFileCleaner(ctx, config.LoaderConfig{})
+	c.Assert(err, IsNil)
+	c.Assert(cleaner, IsNil)
+	cleaner, err = newDumpFileCleaner(ctx, config.LoaderConfig{CleanupPolicy: config.CleanupKeep})
+	c.Assert(err, IsNil)
+	c.Assert(cleaner, IsNil)
+
+	// delete
+	c.Assert(os.WriteFile(path, content, 0o644), IsNil)
+	cleaner, err = newDumpFileCleaner(ctx, config.LoaderConfig{CleanupPolicy: config.CleanupDelete})
+	c.Assert(err, IsNil)
+	c.Assert(cleaner.clean(ctx, dir, file), IsNil)
+	c.Assert(utils.IsFileExists(path), IsFalse)
+	// cleaning up a cleaned file is fine.
+	c.Assert(cleaner.clean(ctx, dir, file), IsNil)
+
+	// archive
+	archiveDir := filepath.Join(c.MkDir(), "archive")
+	c.Assert(os.WriteFile(path, content, 0o644), IsNil)
+	cleaner, err = newDumpFileCleaner(ctx, config.LoaderConfig{CleanupPolicy: config.CleanupArchive, ArchiveDir: archiveDir})
+	c.Assert(err, IsNil)
+	c.Assert(cleaner.clean(ctx, dir, file), IsNil)
+	c.Assert(utils.IsFileExists(path), IsFalse)
+	archived, err := os.ReadFile(filepath.Join(archiveDir, file))
+	c.Assert(err, IsNil)
+	c.Assert(archived, DeepEquals, content)
+
+	// upload
+	storageDir := c.MkDir()
+	c.Assert(os.WriteFile(path, content, 0o644), IsNil)
+	cleaner, err = newDumpFileCleaner(ctx, config.LoaderConfig{CleanupPolicy: config.CleanupUpload, ArchiveStorage: "local://" + storageDir})
+	c.Assert(err, IsNil)
+	c.Assert(cleaner.clean(ctx, dir, file), IsNil)
+	c.Assert(utils.IsFileExists(path), IsFalse)
+	uploaded, err := os.ReadFile(filepath.Join(storageDir, file))
+	c.Assert(err, IsNil)
+	c.Assert(uploaded, DeepEquals, content)
+}
+
+func (t *testCleanupSuite) TestPrepareCleanedFiles(c *C) {
+	dir := c.MkDir()
+	c.Assert(os.WriteFile(filepath.Join(dir, "db.tbl.1.sql"), []byte("INSERT INTO `tbl` VALUES (2);\n"), 0o644), IsNil)
+	// cleaned up but failed to delete.
+	c.Assert(os.WriteFile(filepath.Join(dir, "db.tbl.2.sql"), []byte("INSERT INTO `tbl` VALUES (3);\n"), 0o644), IsNil)
+
+	cp := &RemoteCheckPoint{logger: log.L()}
+	cp.cleanedFiles.pos = make(map[string]map[string]map[string]int64)
+	cp.addCleanedFile("db.tbl.0.sql", "db", "tbl", 30)
+	cp.addCleanedFile("db.tbl.2.sql", "db", "tbl", 30)
+	cp.addCleanedFile("db.skipped.0.sql", "db", "skipped", 20)
+	c.Assert(cp.IsFileCleaned("db.tbl.0.sql"), IsTrue)
+	c.Assert(cp.IsFileCleaned("db.tbl.1.sql"), IsFalse)
+
+	l := &Loader{
+		cfg:                         &config.SubTaskConfig{LoaderConfig: config.LoaderConfig{Dir: dir}},
+		checkPoint:                  cp,
+		db2Tables:                   map[string]Tables2DataFiles{"db": {"tbl": {"db.tbl.1.sql", "db.tbl.2.sql"}}},
+		dbTableDataTotalSize:        make(map[string]map[string]*atomic.Int64),
+		dbTableDataFinishedSize:     make(map[string]map[string]*atomic.Int64),
+		dbTableDataLastFinishedSize: make(map[string]map[string]int64),
+	}
+	l.prepareCleanedFiles()
+	c.Assert(l.db2Tables, DeepEquals, map[string]Tables2DataFiles{"db": {"tbl": {"db.tbl.1.sql", "db.tbl.2.sql", "db.tbl.0.sql"}}})
+	c.Assert(l.totalDataSize.Load(), Equals, int64(30))
+	c.Assert(l.dbTableDataTotalSize["db"]["tbl"].Load(), Equals, int64(30))
+
+	file, ok := cleanedDataFile("db.tbl.0.sql" + cleanedFileSuffix)
+	c.Assert(ok, IsTrue)
+	c.Assert(file, Equals, "db.tbl.0.sql")
+	c.Assert(isSchemaCheckpointFile("db.tbl.0.sql"+cleanedFileSuffix), IsFalse)
+	_, ok = cleanedDataFile("db.tbl.0.sql")
+	c.Assert(ok, IsFalse)
+}
@@ -294,6 +294,7 @@ func parseTable(ctx *tcontext.Context, r *router.Table, schema, table, file stri
 		targetTable:    dstTable,
 		columnNameList: columns,
 		insertHeadStmt: fmt.Sprintf("INSERT INTO `%s` %sVALUES", dstTable, columnNameFields),
+		foreignKeys:    extractForeignKeys(ct),
 	}, nil
 }
 
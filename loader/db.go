@@ -63,6 +63,7 @@ func (conn *DBConn) querySQL(ctx *tcontext.Context, query string, args ...interf
 						log.ShortError(err))
 					return false
 				}
+				sqlRetriesTotal.WithLabelValues("query", conn.cfg.Name).Inc()
 				return true
 			}
 			if dbutil.IsRetryableError(err) {
@@ -70,6 +71,7 @@ func (conn *DBConn) querySQL(ctx *tcontext.Context, query string, args ...interf
 					zap.String("query", utils.TruncateString(query, -1)),
 					zap.String("argument", utils.TruncateInterface(args, -1)),
 					log.ShortError(err))
+				sqlRetriesTotal.WithLabelValues("query", conn.cfg.Name).Inc()
 				return true
 			}
 			return false
@@ -133,6 +135,7 @@ func (conn *DBConn) executeSQL(ctx *tcontext.Context, queries []string, args ...
 						log.ShortError(err))
 					return false
 				}
+				sqlRetriesTotal.WithLabelValues("stmt_exec", conn.cfg.Name).Inc()
 				return true
 			}
 			if dbutil.IsRetryableError(err) {
@@ -140,6 +143,7 @@ func (conn *DBConn) executeSQL(ctx *tcontext.Context, queries []string, args ...
 					zap.String("queries", utils.TruncateInterface(queries, -1)),
 					zap.String("arguments", utils.TruncateInterface(args, -1)),
 					log.ShortError(err))
+				sqlRetriesTotal.WithLabelValues("stmt_exec", conn.cfg.Name).Inc()
 				return true
 			}
 			return false
@@ -234,3 +238,7 @@ func isErrTableExists(err error) bool {
 func isErrDupEntry(err error) bool {
 	return utils.IsMySQLError(err, tmysql.ErrDupEntry)
 }
+
+func isErrDupKeyName(err error) bool {
+	return utils.IsMySQLError(err, tmysql.ErrDupKeyName) || utils.IsMySQLError(err, tmysql.ErrFkDupName)
+}
@@ -16,7 +16,9 @@ package loader
 import (
 	"context"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/docker/go-units"
 	"github.com/pingcap/failpoint"
@@ -25,6 +27,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
 	lcfg "github.com/pingcap/tidb/br/pkg/lightning/config"
 	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -63,6 +66,7 @@ type LightningLoader struct {
 	closed         atomic.Bool
 	metaBinlog     atomic.String
 	metaBinlogGTID atomic.String
+	speed          restoreSpeed
 	cancel         context.CancelFunc // for per task context, which maybe different from lightning context
 }
 
@@ -162,6 +166,19 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 		}
 		cfg.Checkpoint.DSN = param.ToDSN()
 		cfg.TiDB.StrSQLMode = l.cfg.LoaderConfig.SQLMode
+		// only sql_mode of the session variables is supported by lightning.
+		ignoredVars := make([]string, 0, len(l.cfg.To.Session))
+		for k, v := range l.cfg.To.Session {
+			switch strings.ToLower(k) {
+			case "sql_mode":
+				cfg.TiDB.StrSQLMode = v
+			default:
+				ignoredVars = append(ignoredVars, k)
+			}
+		}
+		if len(ignoredVars) > 0 {
+			l.logger.Info("session variables are not applied by lightning", zap.Strings("variables", ignoredVars))
+		}
 		if err = cfg.Adjust(ctx); err != nil {
 			return err
 		}
@@ -169,6 +186,7 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 		taskCtx, cancel := context.WithCancel(ctx)
 		l.cancel = cancel
 		l.Unlock()
+		l.speed.start(0)
 		err = l.core.RunOnce(taskCtx, cfg, nil)
 		if err == nil {
 			l.finish.Store(true)
@@ -179,7 +197,7 @@ func (l *LightningLoader) restore(ctx context.Context) error {
 	} else {
 		l.finish.Store(true)
 	}
-	if l.cfg.Mode == config.ModeFull {
+	if l.cfg.Mode == config.ModeFull || l.cfg.Mode == config.ModeSchemaOnly {
 		if err = delLoadTask(l.cli, l.cfg, l.workerName); err != nil {
 			return err
 		}
@@ -241,6 +259,8 @@ func (l *LightningLoader) IsFreshTask(ctx context.Context) (bool, error) {
 func (l *LightningLoader) Close() {
 	l.Pause()
 	l.closed.Store(true)
+	speedGauge.DeleteAllAboutLabels(prometheus.Labels{"task": l.cfg.Name})
+	taskRemainingTimeGauge.DeleteAllAboutLabels(prometheus.Labels{"task": l.cfg.Name})
 }
 
 // Pause pauses the process, and it can be resumed later
@@ -284,13 +304,19 @@ func (l *LightningLoader) Update(cfg *config.SubTaskConfig) error {
 // Status returns the unit's current status.
 func (l *LightningLoader) Status(_ *binlog.SourceStatus) interface{} {
 	finished, total := l.core.Status()
-	progress := percent(finished, total, l.finish.Load())
+	finish := l.finish.Load()
+	progress := percent(finished, total, finish)
+	bps, remainingSeconds := l.speed.estimate(finished, total, finish, time.Now())
+	setSpeedMetrics(l.cfg.Name, l.cfg.SourceID, bps, remainingSeconds)
 	s := &pb.LoadStatus{
-		FinishedBytes:  finished,
-		TotalBytes:     total,
-		Progress:       progress,
-		MetaBinlog:     l.metaBinlog.Load(),
-		MetaBinlogGTID: l.metaBinlogGTID.Load(),
+		FinishedBytes:             finished,
+		TotalBytes:                total,
+		Progress:                  progress,
+		MetaBinlog:                l.metaBinlog.Load(),
+		MetaBinlogGTID:            l.metaBinlogGTID.Load(),
+		Bps:                       bps,
+		EstimatedRemainingSeconds: remainingSeconds,
+		ProgressDetail:            progressDetail(finished, total, bps, remainingSeconds, finish),
 	}
 	return s
 }
@@ -43,6 +43,7 @@ import (
 	cm "github.com/pingcap/tidb-tools/pkg/column-mapping"
 	"github.com/pingcap/tidb-tools/pkg/filter"
 	router "github.com/pingcap/tidb-tools/pkg/table-router"
+	"github.com/pingcap/tidb/parser/ast"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
@@ -71,6 +72,7 @@ type dataJob struct {
 	absPath      string
 	offset       int64
 	lastOffset   int64
+	rows         int64
 }
 
 type fileJob struct {
@@ -213,6 +215,8 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 				continue
 			}
 			txnHistogram.WithLabelValues(w.cfg.Name, w.cfg.WorkerName, w.cfg.SourceID, job.schema, job.table).Observe(time.Since(startTime).Seconds())
+			loadedRowsCounter.WithLabelValues(w.cfg.Name, w.cfg.SourceID, job.schema, job.table).Add(float64(job.rows))
+			loadedBytesCounter.WithLabelValues(w.cfg.Name, w.cfg.SourceID, job.schema, job.table).Add(float64(job.offset - job.lastOffset))
 			failpoint.Inject("loaderCPUpdateOffsetError", func(_ failpoint.Value) {
 				job.file = "notafile" + job.file
 			})
@@ -221,11 +225,12 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 				hasError = true
 				continue
 			}
-			// update finished offset after checkpoint updated
-			w.loader.finishedDataSize.Store(job.offset)
+			// update finished offset after checkpoint updated, the finished size is accumulated from offsets of all files
+			w.loader.finishedDataSize.Add(job.offset - job.lastOffset)
+			w.loader.finishedRows.Add(job.rows)
 			if _, ok := w.loader.dbTableDataFinishedSize[job.sourceSchema]; ok {
 				if _, ok := w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable]; ok {
-					w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable].Store(job.offset)
+					w.loader.dbTableDataFinishedSize[job.sourceSchema][job.sourceTable].Add(job.offset - job.lastOffset)
 				}
 			}
 		}
@@ -258,6 +263,7 @@ func (w *Worker) run(ctx context.Context, fileJobQueue chan *fileJob, runFatalCh
 				}
 				return
 			}
+			w.loader.cleanDataFile(ctx, job)
 		}
 	}
 }
@@ -333,6 +339,8 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 	lastOffset := cur
 
 	data := make([]byte, 0, 1024*1024)
+	// dumped INSERT statements put every row in a separate line which starts with '('
+	var rows int64
 	br := bufio.NewReader(f)
 	for {
 		select {
@@ -355,10 +363,14 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 		}
 
 		data = append(data, []byte(line)...)
+		if realLine[0] == '(' {
+			rows++
+		}
 		if realLine[len(realLine)-1] == ';' {
 			query := strings.TrimSpace(string(data))
 			if strings.HasPrefix(query, "/*") && strings.HasSuffix(query, "*/;") {
 				data = data[0:0]
+				rows = 0
 				continue
 			}
 
@@ -379,6 +391,10 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 			}
 
 			data = data[0:0]
+			if rows == 0 {
+				// all rows are in the same line as INSERT INTO
+				rows = 1
+			}
 
 			j := &dataJob{
 				sql:          query,
@@ -390,8 +406,10 @@ func (w *Worker) dispatchSQL(ctx context.Context, file string, offset int64, tab
 				absPath:      file,
 				offset:       cur,
 				lastOffset:   lastOffset,
+				rows:         rows,
 			}
 			lastOffset = cur
+			rows = 0
 
 			w.jobQueue <- j
 		}
@@ -407,6 +425,7 @@ type tableInfo struct {
 	targetTable    string
 	columnNameList []string
 	insertHeadStmt string
+	foreignKeys    []*ast.Constraint // added after all data loaded
 }
 
 // Loader can load your mydumper data into TiDB database.
@@ -417,6 +436,7 @@ type Loader struct {
 	cli        *clientv3.Client
 	workerName string
 	checkPoint CheckPoint
+	cleaner    *dumpFileCleaner // nil if the data files are kept after imported
 
 	logger log.Logger
 
@@ -424,6 +444,8 @@ type Loader struct {
 	// table -> data files
 	db2Tables  map[string]Tables2DataFiles
 	tableInfos map[string]*tableInfo
+	// db -> view -> view schema file
+	db2Views map[string]map[string]string
 
 	fileJobQueue chan *fileJob
 
@@ -437,6 +459,8 @@ type Loader struct {
 	totalFileCount   atomic.Int64 // schema + table + data
 	totalDataSize    atomic.Int64
 	finishedDataSize atomic.Int64
+	finishedRows     atomic.Int64 // rows restored since the load unit started or resumed
+	speed            restoreSpeed
 
 	// to calculate remainingTimeGauge metric, map will be init in `l.prepare.prepareDataFiles`
 	dbTableDataTotalSize        map[string]map[string]*atomic.Int64
@@ -458,6 +482,9 @@ type Loader struct {
 	fileJobQueueClosed atomic.Bool
 	finish             atomic.Bool
 	closed             atomic.Bool
+
+	// the time when the loader is paused, used to observe pauseHistogram when resuming
+	pauseTime time.Time
 }
 
 // NewLoader creates a new Loader.
@@ -534,7 +561,8 @@ func (l *Loader) Init(ctx context.Context) (err error) {
 	if lcfg.To.Session == nil {
 		lcfg.To.Session = make(map[string]string)
 	}
-	lcfg.To.Session["time_zone"] = "+00:00"
+	// the dumped timestamps are in the session time zone of the target database, see dumpling.
+	config.AdjustTargetDBTimeZone(&lcfg.To)
 
 	hasSQLMode := false
 	for k := range l.cfg.To.Session {
@@ -553,6 +581,14 @@ func (l *Loader) Init(ctx context.Context) (err error) {
 	}
 
 	l.logger.Info("loader's sql_mode is", zap.String("sqlmode", lcfg.To.Session["sql_mode"]))
+	if err = conn.AddSessionLabels(tctx.Ctx, &lcfg.To, l.cfg.Name, l.cfg.SourceID); err != nil {
+		l.logger.Warn("cannot label the downstream sessions with the task and source", log.ShortError(err))
+	}
+
+	l.cleaner, err = newDumpFileCleaner(ctx, l.cfg.LoaderConfig)
+	if err != nil {
+		return err
+	}
 
 	l.toDB, l.toDBConns, err = createConns(tctx, lcfg, l.cfg.PoolSize)
 	if err != nil {
@@ -719,12 +755,14 @@ func (l *Loader) Restore(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	l.prepareCleanedFiles()
 	err = l.checkPoint.CalcProgress(l.db2Tables)
 	if err != nil {
 		l.logger.Error("calc load process", log.ShortError(err))
 		return err
 	}
 	l.loadFinishedSize()
+	l.speed.start(l.finishedDataSize.Load())
 	if err2 := l.initAndStartWorkerPool(ctx); err2 != nil {
 		l.logger.Error("initial and start worker pools failed", log.ShortError(err))
 		return err2
@@ -742,11 +780,16 @@ func (l *Loader) Restore(ctx context.Context) error {
 	l.closeFileJobQueue() // all data file dispatched, close it
 	l.workerWg.Wait()
 
+	// foreign keys are added only after all data loaded
+	if err == nil && l.checkPoint.AllFinished() {
+		err = l.restoreConstraints(ctx)
+	}
+
 	if err == nil {
 		l.finish.Store(true)
 		l.logger.Info("all data files have been finished", zap.Duration("cost time", time.Since(begin)))
 		if l.checkPoint.AllFinished() {
-			if l.cfg.Mode == config.ModeFull {
+			if l.cfg.Mode == config.ModeFull || l.cfg.Mode == config.ModeSchemaOnly {
 				if err = delLoadTask(l.cli, l.cfg, l.workerName); err != nil {
 					return err
 				}
@@ -816,6 +859,7 @@ func (l *Loader) Pause() {
 	}
 
 	l.stopLoad()
+	l.pauseTime = time.Now()
 }
 
 // Resume resumes the paused process.
@@ -825,6 +869,11 @@ func (l *Loader) Resume(ctx context.Context, pr chan pb.ProcessResult) {
 		return
 	}
 
+	if !l.pauseTime.IsZero() {
+		pauseHistogram.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Observe(time.Since(l.pauseTime).Seconds())
+		l.pauseTime = time.Time{}
+	}
+
 	if err := l.resetDBs(ctx); err != nil {
 		pr <- pb.ProcessResult{
 			IsCanceled: false,
@@ -1010,10 +1059,12 @@ func (l *Loader) prepareDataFiles(files map[string]struct{}) error {
 			continue
 		}
 
-		// ignore view / triggers
-		if strings.Contains(file, "-schema-view.sql") || strings.Contains(file, "-schema-triggers.sql") ||
-			strings.Contains(file, "-schema-post.sql") {
-			l.logger.Warn("ignore unsupport view/trigger file", zap.String("file", file))
+		// views are restored by `prepareViewFiles`, ignore triggers
+		if strings.Contains(file, viewSchemaSuffix) {
+			continue
+		}
+		if strings.Contains(file, "-schema-triggers.sql") || strings.Contains(file, "-schema-post.sql") {
+			l.logger.Warn("ignore unsupport trigger file", zap.String("file", file))
 			continue
 		}
 
@@ -1040,19 +1091,7 @@ func (l *Loader) prepareDataFiles(files map[string]struct{}) error {
 		if err != nil {
 			return err
 		}
-		l.totalDataSize.Add(size)
-		l.totalFileCount.Add(1) // for data
-		if _, ok := l.dbTableDataTotalSize[db]; !ok {
-			l.dbTableDataTotalSize[db] = make(map[string]*atomic.Int64)
-			l.dbTableDataFinishedSize[db] = make(map[string]*atomic.Int64)
-			l.dbTableDataLastFinishedSize[db] = make(map[string]int64)
-		}
-		if _, ok := l.dbTableDataTotalSize[db][table]; !ok {
-			l.dbTableDataTotalSize[db][table] = atomic.NewInt64(0)
-			l.dbTableDataFinishedSize[db][table] = atomic.NewInt64(0)
-			l.dbTableDataLastFinishedSize[db][table] = 0
-		}
-		l.dbTableDataTotalSize[db][table].Add(size)
+		l.addDataFileSize(db, table, size)
 
 		dataFiles = append(dataFiles, file)
 		dataFilesNumber++
@@ -1064,6 +1103,45 @@ func (l *Loader) prepareDataFiles(files map[string]struct{}) error {
 	return nil
 }
 
+// addDataFileSize adds the size of a data file of db / table into the total size to restore.
+func (l *Loader) addDataFileSize(db, table string, size int64) {
+	l.totalDataSize.Add(size)
+	l.totalFileCount.Add(1) // for data
+	if _, ok := l.dbTableDataTotalSize[db]; !ok {
+		l.dbTableDataTotalSize[db] = make(map[string]*atomic.Int64)
+		l.dbTableDataFinishedSize[db] = make(map[string]*atomic.Int64)
+		l.dbTableDataLastFinishedSize[db] = make(map[string]int64)
+	}
+	if _, ok := l.dbTableDataTotalSize[db][table]; !ok {
+		l.dbTableDataTotalSize[db][table] = atomic.NewInt64(0)
+		l.dbTableDataFinishedSize[db][table] = atomic.NewInt64(0)
+		l.dbTableDataLastFinishedSize[db][table] = 0
+	}
+	l.dbTableDataTotalSize[db][table].Add(size)
+}
+
+func (l *Loader) prepareViewFiles(files map[string]struct{}) {
+	for file := range files {
+		db, view, ok := getDBAndViewFromFilename(file)
+		if !ok {
+			continue
+		}
+		if l.skipSchemaAndTable(&filter.Table{Schema: db, Name: view}) {
+			l.logger.Warn("ignore view schema file", zap.String("view schema file", file))
+			continue
+		}
+		if _, ok := l.db2Tables[db]; !ok {
+			l.logger.Warn("can't find schema create file of view, ignore it", zap.String("view schema file", file))
+			continue
+		}
+		if _, ok := l.db2Views[db]; !ok {
+			l.db2Views[db] = make(map[string]string)
+		}
+		l.db2Views[db][view] = file
+		l.totalFileCount.Add(1) // for view
+	}
+}
+
 func (l *Loader) prepare() error {
 	begin := time.Now()
 	defer func() {
@@ -1073,9 +1151,11 @@ func (l *Loader) prepare() error {
 	// reset some counter used to calculate progress
 	l.totalDataSize.Store(0)
 	l.finishedDataSize.Store(0) // reset before load from checkpoint
+	l.finishedRows.Store(0)
 	l.dbTableDataTotalSize = make(map[string]map[string]*atomic.Int64)
 	l.dbTableDataFinishedSize = make(map[string]map[string]*atomic.Int64)
 	l.dbTableDataLastFinishedSize = make(map[string]map[string]int64)
+	l.db2Views = make(map[string]map[string]string)
 
 	// check if mydumper dir data exists.
 	if !utils.IsDirExists(l.cfg.Dir) {
@@ -1106,6 +1186,7 @@ func (l *Loader) prepare() error {
 	/* Mydumper file names format
 	 * db    {db}-schema-create.sql
 	 * table {db}.{table}-schema.sql
+	 * view  {db}.{view}-schema-view.sql
 	 * sql   {db}.{table}.{part}.sql or {db}.{table}.sql
 	 */
 
@@ -1119,13 +1200,17 @@ func (l *Loader) prepare() error {
 		return err
 	}
 
+	// Sql file for create view
+	l.prepareViewFiles(files)
+
 	// Sql file for restore data
 	return l.prepareDataFiles(files)
 }
 
 // restoreSchema creates schema.
 func (l *Loader) restoreSchema(ctx context.Context, conn *DBConn, sqlFile, schema string) error {
-	if l.checkPoint.IsTableCreated(schema, "") {
+	cpFile := schemaCheckpointFile(schemaObjectDatabase, schema, "")
+	if l.checkPoint.IsTableCreated(schema, "") || l.checkPoint.IsSchemaRestored(cpFile) {
 		l.logger.Info("database already exists in checkpoint, skip creating it", zap.String("schema", schema), zap.String("db schema file", sqlFile))
 		return nil
 	}
@@ -1137,12 +1222,13 @@ func (l *Loader) restoreSchema(ctx context.Context, conn *DBConn, sqlFile, schem
 			return terror.Annotatef(err, "run db schema failed - dbfile %s", sqlFile)
 		}
 	}
-	return nil
+	return l.checkPoint.SaveSchemaRestored(tcontext.NewContext(ctx, l.logger), cpFile, schema, "")
 }
 
 // restoreTable creates table.
 func (l *Loader) restoreTable(ctx context.Context, conn *DBConn, sqlFile, schema, table string) error {
-	if l.checkPoint.IsTableCreated(schema, table) {
+	cpFile := schemaCheckpointFile(schemaObjectTable, schema, table)
+	if l.checkPoint.IsTableCreated(schema, table) || l.checkPoint.IsSchemaRestored(cpFile) {
 		l.logger.Info("table already exists in checkpoint, skip creating it", zap.String("schema", schema), zap.String("table", table), zap.String("db schema file", sqlFile))
 		return nil
 	}
@@ -1154,10 +1240,50 @@ func (l *Loader) restoreTable(ctx context.Context, conn *DBConn, sqlFile, schema
 			return terror.Annotatef(err, "run table schema failed - dbfile %s", sqlFile)
 		}
 	}
-	return nil
+	return l.checkPoint.SaveSchemaRestored(tcontext.NewContext(ctx, l.logger), cpFile, schema, table)
+}
+
+// restoreView creates view, the placeholder table of view has been created before.
+func (l *Loader) restoreView(ctx context.Context, conn *DBConn, sqlFile, schema, view string) error {
+	cpFile := schemaCheckpointFile(schemaObjectView, schema, view)
+	if l.checkPoint.IsSchemaRestored(cpFile) {
+		l.logger.Info("view already exists in checkpoint, skip creating it", zap.String("schema", schema), zap.String("view", view), zap.String("view schema file", sqlFile))
+		return nil
+	}
+	err := l.restoreStructure(ctx, conn, sqlFile, schema, view)
+	if err != nil {
+		return terror.Annotatef(err, "run view schema failed - dbfile %s", sqlFile)
+	}
+	return l.checkPoint.SaveSchemaRestored(tcontext.NewContext(ctx, l.logger), cpFile, schema, view)
 }
 
-// restoreStruture creates schema or table.
+// restoreForeignKeys adds the foreign key constraints which are stripped when creating table.
+func (l *Loader) restoreForeignKeys(ctx context.Context, conn *DBConn, schema, table string) error {
+	cpFile := schemaCheckpointFile(schemaObjectConstraint, schema, table)
+	if l.checkPoint.IsSchemaRestored(cpFile) {
+		l.logger.Info("foreign keys already exist in checkpoint, skip adding them", zap.String("schema", schema), zap.String("table", table))
+		return nil
+	}
+	tctx := tcontext.NewContext(ctx, l.logger)
+	sqls, err := genAddForeignKeySQLs(tctx, l.tableRouter, l.tableInfos[tableName(schema, table)])
+	if err != nil {
+		return err
+	}
+	for _, sql := range sqls {
+		l.logger.Debug("add foreign key statement", zap.String("sql", sql))
+		err = conn.executeSQL(tctx, []string{sql})
+		if err != nil {
+			if isErrDupKeyName(err) {
+				l.logger.Info("foreign key already exists, skip it", zap.String("sql", sql))
+				continue
+			}
+			return terror.Annotatef(terror.WithScope(err, terror.ScopeDownstream), "add foreign key failed - table %s", tableName(schema, table))
+		}
+	}
+	return l.checkPoint.SaveSchemaRestored(tctx, cpFile, schema, table)
+}
+
+// restoreStruture creates schema, table or view.
 func (l *Loader) restoreStructure(ctx context.Context, conn *DBConn, sqlFile string, schema string, table string) error {
 	f, err := os.Open(sqlFile)
 	if err != nil {
@@ -1167,6 +1293,10 @@ func (l *Loader) restoreStructure(ctx context.Context, conn *DBConn, sqlFile str
 
 	tctx := tcontext.NewContext(ctx, l.logger)
 	ansiquote := strings.Contains(l.cfg.SQLMode, "ANSI_QUOTES")
+	p, err := utils.GetParserFromSQLModeStr(l.cfg.SQLMode)
+	if err != nil {
+		return err
+	}
 
 	data := make([]byte, 0, 1024*1024)
 	br := bufio.NewReader(f)
@@ -1193,11 +1323,27 @@ func (l *Loader) restoreStructure(ctx context.Context, conn *DBConn, sqlFile str
 			dstSchema, dstTable := fetchMatchedLiteral(tctx, l.tableRouter, schema, table)
 			// for table
 			if table != "" {
+				query, err = stripForeignKeys(p, query, ansiquote)
+				if err != nil {
+					return err
+				}
 				sqls = append(sqls, "USE `"+unescapePercent(dstSchema, l.logger)+"`;")
 				query = renameShardingTable(query, table, dstTable, ansiquote)
 			} else {
 				query = renameShardingSchema(query, schema, dstSchema, ansiquote)
 			}
+			if len(l.cfg.CharsetConvert) > 0 {
+				query, err = convertCharset(p, query, l.cfg.CharsetConvert, ansiquote)
+				if err != nil {
+					return err
+				}
+			}
+			if l.cfg.SchemaCharset != "" {
+				query, err = rewriteCharset(p, query, l.cfg.SchemaCharset, l.cfg.SchemaCollation, ansiquote)
+				if err != nil {
+					return err
+				}
+			}
 
 			l.logger.Debug("schema create statement", zap.String("sql", query))
 
@@ -1250,7 +1396,8 @@ type restoreSchemaJob struct {
 	session  *DBConn
 	database string // database name
 	table    string // table name, empty if it's a schema of database
-	filepath string // file path of dumpped schema file
+	filepath string // file path of dumpped schema file, empty for constraints
+	kind     schemaObjectKind
 }
 
 // `jobQueue` of schema restoring which (only) support consumptions concurrently.
@@ -1341,111 +1488,181 @@ func (q *jobQueue) startConsumers(handler func(ctx context.Context, job *restore
 	}
 }
 
-func (l *Loader) restoreData(ctx context.Context) error {
-	begin := time.Now()
-	dispatchMap := make(map[string]*fileJob)
-	concurrency := l.cfg.PoolSize
-	// `for v := range map` would present random order
-	// `dbs` array keep same order for restore schema job generating
-	var err error
-	dbs := make([]string, 0, len(l.db2Tables))
-	for db := range l.db2Tables {
-		dbs = append(dbs, db)
+// restoreSchemaObjects restores schema objects concurrently and waits for all of them finished.
+func (l *Loader) restoreSchemaObjects(ctx context.Context, jobs []*restoreSchemaJob) error {
+	if len(jobs) == 0 {
+		return nil
 	}
-	tctx := tcontext.NewContext(ctx, l.logger)
 
-	// run consumers of restore database schema queue
-	dbRestoreQueue := newJobQueue(ctx, concurrency, concurrency /** length of queue */)
-	dbRestoreQueue.startConsumers(func(ctx context.Context, job *restoreSchemaJob) error {
-		// restore database schema
-		job.loader.logger.Info("start to create schema", zap.String("schema file", job.filepath))
-		err2 := job.loader.restoreSchema(ctx, job.session, job.filepath, job.database)
+	// run consumers of restore schema queue
+	concurrency := l.cfg.PoolSize
+	restoreQueue := newJobQueue(ctx, concurrency, concurrency /** length of queue */)
+	restoreQueue.startConsumers(func(ctx context.Context, job *restoreSchemaJob) error {
+		logger := job.loader.logger.WithFields(zap.Stringer("kind", job.kind), zap.String("schema", job.database), zap.String("name", job.table))
+		logger.Info("start to restore schema object", zap.String("schema file", job.filepath))
+		var err2 error
+		switch job.kind {
+		case schemaObjectDatabase:
+			err2 = job.loader.restoreSchema(ctx, job.session, job.filepath, job.database)
+		case schemaObjectTable:
+			err2 = job.loader.restoreTable(ctx, job.session, job.filepath, job.database, job.table)
+		case schemaObjectView:
+			err2 = job.loader.restoreView(ctx, job.session, job.filepath, job.database, job.table)
+		case schemaObjectConstraint:
+			err2 = job.loader.restoreForeignKeys(ctx, job.session, job.database, job.table)
+		}
 		if err2 != nil {
 			return err2
 		}
-		job.loader.logger.Info("finish to create schema", zap.String("schema file", job.filepath))
+		logger.Info("finish to restore schema object", zap.String("schema file", job.filepath))
 		return nil
 	})
 
-	// push database schema restoring jobs to the queue
-	for _, db := range dbs {
-		schemaFile := l.cfg.Dir + "/" + db + "-schema-create.sql" // cache friendly
-		err = dbRestoreQueue.push(&restoreSchemaJob{
-			loader:   l,
-			database: db,
-			table:    "",
-			filepath: schemaFile,
-		})
-		if err != nil {
+	// push schema restoring jobs to the queue
+	var err error
+	for _, job := range jobs {
+		if err = restoreQueue.push(job); err != nil {
 			break
 		}
 	}
 
 	// check producing error
 	if err != nil {
-		runtimeErr := dbRestoreQueue.close()
+		runtimeErr := restoreQueue.close()
 		if errors.ErrorEqual(err, context.Canceled) {
 			err = runtimeErr
 		}
 		return err
 	}
 	// wait whole task done & close queue
-	err = dbRestoreQueue.close()
+	return restoreQueue.close()
+}
+
+// restoreViews restores views level by level in the order of their dependencies.
+func (l *Loader) restoreViews(ctx context.Context) error {
+	if len(l.db2Views) == 0 {
+		return nil
+	}
+	p, err := utils.GetParserFromSQLModeStr(l.cfg.SQLMode)
 	if err != nil {
 		return err
 	}
 
-	// run consumers of restore table schema queue
-	tblRestoreQueue := newJobQueue(ctx, concurrency, concurrency /** length of queue */)
-	tblRestoreQueue.startConsumers(func(ctx context.Context, job *restoreSchemaJob) error {
-		job.loader.logger.Info("start to create table", zap.String("table file", job.filepath))
-		err2 := job.loader.restoreTable(ctx, job.session, job.filepath, job.database, job.table)
-		if err2 != nil {
-			return err2
+	var (
+		views = make([]*restoreSchemaJob, 0, len(l.db2Views))
+		deps  = make(map[string][]*filter.Table, len(l.db2Views))
+	)
+	for db, db2View := range l.db2Views {
+		for view, file := range db2View {
+			schemaFile := l.cfg.Dir + "/" + file
+			if !l.checkPoint.IsSchemaRestored(schemaCheckpointFile(schemaObjectView, db, view)) {
+				deps[tableName(db, view)], err = parseViewDependencies(p, db, schemaFile)
+				if err != nil {
+					return terror.Annotatef(err, "parse view %s/%s", db, view)
+				}
+			}
+			views = append(views, &restoreSchemaJob{
+				loader:   l,
+				database: db,
+				table:    view,
+				filepath: schemaFile,
+				kind:     schemaObjectView,
+			})
+		}
+	}
+
+	for _, level := range sortViewsByDependency(views, deps) {
+		if err = l.restoreSchemaObjects(ctx, level); err != nil {
+			return err
 		}
-		job.loader.logger.Info("finish to create table", zap.String("table file", job.filepath))
+	}
+	l.logger.Info("finish to create views", zap.Int("count", len(views)))
+	return nil
+}
+
+// restoreConstraints adds foreign key constraints after all data loaded.
+func (l *Loader) restoreConstraints(ctx context.Context) error {
+	var jobs []*restoreSchemaJob
+	for _, info := range l.tableInfos {
+		if len(info.foreignKeys) == 0 {
+			continue
+		}
+		jobs = append(jobs, &restoreSchemaJob{
+			loader:   l,
+			database: info.sourceSchema,
+			table:    info.sourceTable,
+			kind:     schemaObjectConstraint,
+		})
+	}
+	if len(jobs) == 0 {
 		return nil
-	})
+	}
 
-	// push table schema restoring jobs to the queue
-tblSchemaLoop:
+	begin := time.Now()
+	if err := l.restoreSchemaObjects(ctx, jobs); err != nil {
+		return err
+	}
+	l.logger.Info("finish to add foreign keys", zap.Int("table count", len(jobs)), zap.Duration("cost time", time.Since(begin)))
+	return nil
+}
+
+func (l *Loader) restoreData(ctx context.Context) error {
+	begin := time.Now()
+	dispatchMap := make(map[string]*fileJob)
+	// `for v := range map` would present random order
+	// `dbs` array keep same order for restore schema job generating
+	var err error
+	dbs := make([]string, 0, len(l.db2Tables))
+	for db := range l.db2Tables {
+		dbs = append(dbs, db)
+	}
+	tctx := tcontext.NewContext(ctx, l.logger)
+
+	// restore database schemas
+	dbJobs := make([]*restoreSchemaJob, 0, len(dbs))
+	for _, db := range dbs {
+		dbJobs = append(dbJobs, &restoreSchemaJob{
+			loader:   l,
+			database: db,
+			table:    "",
+			filepath: l.cfg.Dir + "/" + db + dbSchemaSuffix, // cache friendly
+			kind:     schemaObjectDatabase,
+		})
+	}
+	if err = l.restoreSchemaObjects(ctx, dbJobs); err != nil {
+		return err
+	}
+
+	// restore table schemas, foreign keys are stripped so tables can be created concurrently
+	var tblJobs []*restoreSchemaJob
 	for _, db := range dbs {
 		for table := range l.db2Tables[db] {
-			schemaFile := l.cfg.Dir + "/" + db + "." + table + "-schema.sql" // cache friendly
+			schemaFile := l.cfg.Dir + "/" + db + "." + table + tableSchemaSuffix // cache friendly
 			if _, ok := l.tableInfos[tableName(db, table)]; !ok {
 				l.tableInfos[tableName(db, table)], err = parseTable(tctx, l.tableRouter, db, table, schemaFile, l.cfg.LoaderConfig.SQLMode)
 				if err != nil {
-					err = terror.Annotatef(err, "parse table %s/%s", db, table)
-					break tblSchemaLoop
+					return terror.Annotatef(err, "parse table %s/%s", db, table)
 				}
 			}
 			if l.checkPoint.IsTableFinished(db, table) {
 				l.logger.Info("table has finished, skip it.", zap.String("schema", db), zap.String("table", table))
 				continue
 			}
-			err = tblRestoreQueue.push(&restoreSchemaJob{
+			tblJobs = append(tblJobs, &restoreSchemaJob{
 				loader:   l,
 				database: db,
 				table:    table,
 				filepath: schemaFile,
+				kind:     schemaObjectTable,
 			})
-			if err != nil {
-				break tblSchemaLoop
-			}
 		}
 	}
-
-	// check producing error
-	if err != nil {
-		runtimeErr := tblRestoreQueue.close()
-		if errors.ErrorEqual(err, context.Canceled) {
-			err = runtimeErr
-		}
+	if err = l.restoreSchemaObjects(ctx, tblJobs); err != nil {
 		return err
 	}
-	// wait whole task done & close queue
-	err = tblRestoreQueue.close()
-	if err != nil {
+
+	// restore views after all tables, views depend on other views are restored later
+	if err = l.restoreViews(ctx); err != nil {
 		return err
 	}
 
@@ -1467,6 +1684,10 @@ tblSchemaLoop:
 				default:
 					// do nothing
 				}
+				if l.checkPoint.IsFileCleaned(file) && !utils.IsFileExists(filepath.Join(l.cfg.Dir, file)) {
+					l.logger.Debug("data file has been cleaned up, skip it", zap.String("schema", db), zap.String("table", table), zap.String("data file", file))
+					continue
+				}
 				l.logger.Debug("dispatch data file", zap.String("schema", db), zap.String("table", table), zap.String("data file", file))
 
 				offset := int64(uninitializedOffset)
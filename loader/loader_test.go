@@ -15,9 +15,15 @@ package loader
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"time"
 
 	. "github.com/pingcap/check"
 	"github.com/pingcap/errors"
+
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/log"
 )
 
 var _ = Suite(&testLoaderSuite{})
@@ -80,3 +86,58 @@ func (*testLoaderSuite) TestJobQueue(c *C) {
 		c.Assert(err, Equals, testcase.exceptedErr)
 	}
 }
+
+func (*testLoaderSuite) TestDispatchSQLRows(c *C) {
+	content := "/*!40101 SET NAMES binary*/;\n" +
+		"INSERT INTO `t` VALUES\n" +
+		"(1,'a'),\n" +
+		"(2,'b'),\n" +
+		"(3,'c');\n" +
+		"INSERT INTO `t` VALUES (4,'d'),(5,'e');\n"
+	file := filepath.Join(c.MkDir(), "db.t.0.sql")
+	c.Assert(os.WriteFile(file, []byte(content), 0o644), IsNil)
+
+	w := &Worker{
+		jobQueue: make(chan *dataJob, 10),
+		loader:   &Loader{},
+		logger:   log.L(),
+	}
+	table := &tableInfo{sourceSchema: "db", sourceTable: "t", targetSchema: "db", targetTable: "t"}
+	c.Assert(w.dispatchSQL(context.Background(), file, 0, table), IsNil)
+	close(w.jobQueue)
+
+	jobs := make([]*dataJob, 0, 2)
+	for job := range w.jobQueue {
+		jobs = append(jobs, job)
+	}
+	c.Assert(jobs, HasLen, 2)
+	c.Assert(jobs[0].rows, Equals, int64(3))
+	c.Assert(jobs[0].lastOffset, Equals, int64(0))
+	c.Assert(jobs[1].rows, Equals, int64(1))
+	c.Assert(jobs[1].offset, Equals, int64(len(content)))
+}
+
+func (*testLoaderSuite) TestRestoreSpeed(c *C) {
+	var speed restoreSpeed
+	now := time.Now()
+	bps, remaining := speed.estimate(100, 1000, false, now)
+	c.Assert(bps, Equals, int64(0))
+	c.Assert(remaining, Equals, int64(-1))
+
+	// 100 bytes are restored before resuming.
+	speed.start(100)
+	speed.startTime = now.Add(-10 * time.Second)
+	bps, remaining = speed.estimate(100, 1000, false, now)
+	c.Assert(bps, Equals, int64(0))
+	c.Assert(remaining, Equals, int64(-1))
+	bps, remaining = speed.estimate(300, 1000, false, now)
+	c.Assert(bps, Equals, int64(20))
+	c.Assert(remaining, Equals, int64(35))
+
+	bps, remaining = speed.estimate(1000, 1000, true, now)
+	c.Assert(bps, Equals, int64(0))
+	c.Assert(remaining, Equals, int64(0))
+
+	progress := progressDetail(1000, 1000, bps, remaining, true)
+	c.Assert(progress, DeepEquals, &pb.Progress{Finished: 1000, Total: 1000, Unit: "bytes", Phase: "finished"})
+}
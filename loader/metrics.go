@@ -29,6 +29,14 @@ var (
 			Help:      "Total count of tidb execution errors",
 		}, []string{"task", "source_id"})
 
+	sqlRetriesTotal = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "sql_retries_total",
+			Help:      "total number of sql retries",
+		}, []string{"type", "task"})
+
 	queryHistogram = metricsproxy.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "dm",
@@ -56,6 +64,31 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(0.000005, 2, 25),
 		}, []string{"type", "task"})
 
+	loadedRowsCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "loaded_rows_total",
+			Help:      "total number of rows loaded into every target table",
+		}, []string{"task", "source_id", "target_schema", "target_table"})
+
+	loadedBytesCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "loaded_bytes_total",
+			Help:      "total size of data files loaded into every target table",
+		}, []string{"task", "source_id", "target_schema", "target_table"})
+
+	pauseHistogram = metricsproxy.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "pause_duration_time",
+			Help:      "Bucketed histogram of the time (s) from pausing to resuming the load unit.",
+			Buckets:   prometheus.ExponentialBuckets(0.5, 2, 20),
+		}, []string{"task", "source_id"})
+
 	dataFileGauge = metricsproxy.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "dm",
@@ -104,31 +137,59 @@ var (
 			Name:      "remaining_time",
 			Help:      "the remaining time in second to finish load process",
 		}, []string{"task", "worker", "source_id", "source_schema", "source_table"})
+
+	speedGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "speed",
+			Help:      "the average restore speed in bytes per second since the load unit started or resumed",
+		}, []string{"task", "source_id"})
+
+	taskRemainingTimeGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "loader",
+			Name:      "task_remaining_time",
+			Help:      "the estimated remaining time in second to finish load process of the subtask",
+		}, []string{"task", "source_id"})
 )
 
 // RegisterMetrics registers metrics.
 func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(tidbExecutionErrorCounter)
+	registry.MustRegister(sqlRetriesTotal)
 	registry.MustRegister(txnHistogram)
 	registry.MustRegister(queryHistogram)
 	registry.MustRegister(stmtHistogram)
+	registry.MustRegister(loadedRowsCounter)
+	registry.MustRegister(loadedBytesCounter)
+	registry.MustRegister(pauseHistogram)
 	registry.MustRegister(dataFileGauge)
 	registry.MustRegister(tableGauge)
 	registry.MustRegister(dataSizeGauge)
 	registry.MustRegister(progressGauge)
 	registry.MustRegister(loaderExitWithErrorCounter)
 	registry.MustRegister(remainingTimeGauge)
+	registry.MustRegister(speedGauge)
+	registry.MustRegister(taskRemainingTimeGauge)
 }
 
 func (l *Loader) removeLabelValuesWithTaskInMetrics(task string) {
 	tidbExecutionErrorCounter.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	sqlRetriesTotal.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	txnHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	queryHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	stmtHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	loadedRowsCounter.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	loadedBytesCounter.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	pauseHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	dataFileGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	tableGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	dataSizeGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	progressGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	loaderExitWithErrorCounter.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	remainingTimeGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	speedGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	taskRemainingTimeGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 }
@@ -0,0 +1,427 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/model"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	parserpkg "github.com/pingcap/dm/pkg/parser"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// suffixes of dumped schema files, and the suffix of the (virtual) file recording
+// the foreign key constraints of a table in checkpoint.
+const (
+	dbSchemaSuffix         = "-schema-create.sql"
+	tableSchemaSuffix      = "-schema.sql"
+	viewSchemaSuffix       = "-schema-view.sql"
+	constraintSchemaSuffix = "-schema-fk.sql"
+	// cleanedFileSuffix is appended to the filename of a data file to record it's cleaned up in checkpoint.
+	cleanedFileSuffix = ".cleaned"
+)
+
+// schemaObjectKind represents the kind of a schema object restored by loader.
+// schema objects are restored by kind in order: databases -> tables -> views -> constraints.
+type schemaObjectKind int
+
+const (
+	schemaObjectDatabase schemaObjectKind = iota
+	schemaObjectTable
+	schemaObjectView
+	schemaObjectConstraint
+)
+
+// String implements fmt.Stringer.
+func (k schemaObjectKind) String() string {
+	switch k {
+	case schemaObjectDatabase:
+		return "database"
+	case schemaObjectTable:
+		return "table"
+	case schemaObjectView:
+		return "view"
+	case schemaObjectConstraint:
+		return "constraint"
+	}
+	return "unknown"
+}
+
+// schemaCheckpointFile returns the filename used to record the schema object in checkpoint.
+func schemaCheckpointFile(kind schemaObjectKind, db, name string) string {
+	switch kind {
+	case schemaObjectDatabase:
+		return db + dbSchemaSuffix
+	case schemaObjectTable:
+		return db + "." + name + tableSchemaSuffix
+	case schemaObjectView:
+		return db + "." + name + viewSchemaSuffix
+	default:
+		return db + "." + name + constraintSchemaSuffix
+	}
+}
+
+// isSchemaCheckpointFile checks whether the filename in checkpoint records a schema object rather than a data file.
+func isSchemaCheckpointFile(filename string) bool {
+	for _, suffix := range []string{dbSchemaSuffix, tableSchemaSuffix, viewSchemaSuffix, constraintSchemaSuffix} {
+		if strings.HasSuffix(filename, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanedDataFile extracts the data file from the filename which records a cleaned data file in checkpoint.
+func cleanedDataFile(filename string) (string, bool) {
+	if !strings.HasSuffix(filename, cleanedFileSuffix) {
+		return "", false
+	}
+	return strings.TrimSuffix(filename, cleanedFileSuffix), true
+}
+
+// getDBAndViewFromFilename extracts db and view name from a filename like `db.view-schema-view.sql`.
+func getDBAndViewFromFilename(filename string) (string, string, bool) {
+	if !strings.HasSuffix(filename, viewSchemaSuffix) {
+		return "", "", false
+	}
+	fields := strings.Split(strings.TrimSuffix(filename, viewSchemaSuffix), ".")
+	if len(fields) != 2 {
+		return "", "", false
+	}
+	return fields[0], fields[1], true
+}
+
+// tableRefVisitor collects all tables referenced by a statement.
+type tableRefVisitor struct {
+	tables []*filter.Table
+}
+
+// Enter implements ast.Visitor.
+func (v *tableRefVisitor) Enter(in ast.Node) (ast.Node, bool) {
+	if t, ok := in.(*ast.TableName); ok {
+		v.tables = append(v.tables, &filter.Table{Schema: t.Schema.O, Name: t.Name.O})
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor.
+func (v *tableRefVisitor) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// parseViewDependencies returns the tables referenced by the `CREATE VIEW` statement of view file.
+// tables without schema are considered to be in `schema`.
+func parseViewDependencies(p *parser.Parser, schema, file string) ([]*filter.Table, error) {
+	statement, err := exportStatement(file)
+	if err != nil {
+		return nil, err
+	}
+	stmts, err := parserpkg.Parse(p, string(statement), "", "")
+	if err != nil {
+		return nil, terror.ErrLoadUnitParseStatement.Delegate(err, statement)
+	}
+
+	var deps []*filter.Table
+	for _, stmt := range stmts {
+		cv, ok := stmt.(*ast.CreateViewStmt)
+		if !ok {
+			continue
+		}
+		v := &tableRefVisitor{}
+		cv.Select.Accept(v)
+		for _, t := range v.tables {
+			if t.Schema == "" {
+				t.Schema = schema
+			}
+			deps = append(deps, t)
+		}
+	}
+	return deps, nil
+}
+
+// sortViewsByDependency splits views into levels, views in one level can be restored concurrently
+// and only depend on views in the previous levels.
+// views in a dependency cycle (which should not happen) are put into the last level.
+func sortViewsByDependency(views []*restoreSchemaJob, deps map[string][]*filter.Table) [][]*restoreSchemaJob {
+	byName := make(map[string]*restoreSchemaJob, len(views))
+	for _, v := range views {
+		byName[tableName(v.database, v.table)] = v
+	}
+
+	// view -> count of views it depends on, and view -> views depend on it
+	inDegree := make(map[string]int, len(views))
+	dependents := make(map[string][]string, len(views))
+	for _, v := range views {
+		name := tableName(v.database, v.table)
+		seen := make(map[string]struct{})
+		for _, t := range deps[name] {
+			dep := tableName(t.Schema, t.Name)
+			if _, ok := byName[dep]; !ok || dep == name {
+				continue
+			}
+			if _, ok := seen[dep]; ok {
+				continue
+			}
+			seen[dep] = struct{}{}
+			inDegree[name]++
+			dependents[dep] = append(dependents[dep], name)
+		}
+	}
+
+	var (
+		levels  [][]*restoreSchemaJob
+		current []string
+		sorted  = 0
+	)
+	for _, v := range views {
+		name := tableName(v.database, v.table)
+		if inDegree[name] == 0 {
+			current = append(current, name)
+		}
+	}
+	for len(current) > 0 {
+		sort.Strings(current)
+		level := make([]*restoreSchemaJob, 0, len(current))
+		var next []string
+		for _, name := range current {
+			level = append(level, byName[name])
+			for _, d := range dependents[name] {
+				inDegree[d]--
+				if inDegree[d] == 0 {
+					next = append(next, d)
+				}
+			}
+		}
+		levels = append(levels, level)
+		sorted += len(level)
+		current = next
+	}
+
+	if sorted < len(views) {
+		var cycle []*restoreSchemaJob
+		for _, v := range views {
+			if inDegree[tableName(v.database, v.table)] > 0 {
+				cycle = append(cycle, v)
+			}
+		}
+		levels = append(levels, cycle)
+	}
+	return levels
+}
+
+// restoreFlags returns the flags to restore a statement, names are quoted in the same way as the dumped files.
+func restoreFlags(ansiquote bool) format.RestoreFlags {
+	if ansiquote {
+		return format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreNameDoubleQuotes
+	}
+	return format.DefaultRestoreFlags
+}
+
+// extractForeignKeys returns the foreign key constraints of a `CREATE TABLE` statement.
+func extractForeignKeys(ct *ast.CreateTableStmt) []*ast.Constraint {
+	var fks []*ast.Constraint
+	for _, c := range ct.Constraints {
+		if c.Tp == ast.ConstraintForeignKey {
+			fks = append(fks, c)
+		}
+	}
+	return fks
+}
+
+// stripForeignKeys removes foreign key constraints from a `CREATE TABLE` statement,
+// so tables can be created and loaded in any order, and constraints are added after all data are loaded.
+// other statements are returned as is.
+func stripForeignKeys(p *parser.Parser, query string, ansiquote bool) (string, error) {
+	if !strings.Contains(strings.ToUpper(query), "FOREIGN KEY") {
+		return query, nil
+	}
+	stmt, err := p.ParseOneStmt(query, "", "")
+	if err != nil {
+		return "", terror.ErrLoadUnitParseStatement.Delegate(err, query)
+	}
+	ct, ok := stmt.(*ast.CreateTableStmt)
+	if !ok || len(extractForeignKeys(ct)) == 0 {
+		return query, nil
+	}
+
+	constraints := make([]*ast.Constraint, 0, len(ct.Constraints))
+	for _, c := range ct.Constraints {
+		if c.Tp != ast.ConstraintForeignKey {
+			constraints = append(constraints, c)
+		}
+	}
+	ct.Constraints = constraints
+
+	var bf bytes.Buffer
+	if err = ct.Restore(format.NewRestoreCtx(restoreFlags(ansiquote), &bf)); err != nil {
+		return "", terror.ErrRestoreASTNode.Delegate(err)
+	}
+	return bf.String() + ";", nil
+}
+
+// rewriteCharset rewrites the charset and collation of a `CREATE DATABASE` or `CREATE TABLE` statement, including the
+// columns with an explicit charset or collation. the collations are dropped if collation is empty, so the default
+// collation of the charset is used. other statements are returned as is.
+func rewriteCharset(p *parser.Parser, query, cs, co string, ansiquote bool) (string, error) {
+	upper := strings.ToUpper(query)
+	if !strings.HasPrefix(upper, "CREATE DATABASE") && !strings.HasPrefix(upper, "CREATE SCHEMA") &&
+		!strings.HasPrefix(upper, "CREATE TABLE") {
+		return query, nil
+	}
+	stmt, err := p.ParseOneStmt(query, "", "")
+	if err != nil {
+		return "", terror.ErrLoadUnitParseStatement.Delegate(err, query)
+	}
+
+	switch s := stmt.(type) {
+	case *ast.CreateDatabaseStmt:
+		options := make([]*ast.DatabaseOption, 0, len(s.Options)+2)
+		for _, opt := range s.Options {
+			if opt.Tp != ast.DatabaseOptionCharset && opt.Tp != ast.DatabaseOptionCollate {
+				options = append(options, opt)
+			}
+		}
+		options = append(options, &ast.DatabaseOption{Tp: ast.DatabaseOptionCharset, Value: cs})
+		if co != "" {
+			options = append(options, &ast.DatabaseOption{Tp: ast.DatabaseOptionCollate, Value: co})
+		}
+		s.Options = options
+	case *ast.CreateTableStmt:
+		for _, col := range s.Cols {
+			rewriteColumnCharset(col, cs, co)
+		}
+		options := make([]*ast.TableOption, 0, len(s.Options)+2)
+		for _, opt := range s.Options {
+			if opt.Tp != ast.TableOptionCharset && opt.Tp != ast.TableOptionCollate {
+				options = append(options, opt)
+			}
+		}
+		options = append(options, &ast.TableOption{Tp: ast.TableOptionCharset, StrValue: cs})
+		if co != "" {
+			options = append(options, &ast.TableOption{Tp: ast.TableOptionCollate, StrValue: co})
+		}
+		s.Options = options
+	default:
+		return query, nil
+	}
+
+	var bf bytes.Buffer
+	if err = stmt.Restore(format.NewRestoreCtx(restoreFlags(ansiquote), &bf)); err != nil {
+		return "", terror.ErrRestoreASTNode.Delegate(err)
+	}
+	return bf.String() + ";", nil
+}
+
+// convertCharset converts the charsets of a `CREATE DATABASE` or `CREATE TABLE` statement by the charset-convert rules,
+// other statements are returned as is.
+func convertCharset(p *parser.Parser, query string, rules []*config.CharsetConvertRule, ansiquote bool) (string, error) {
+	upper := strings.ToUpper(query)
+	if !strings.HasPrefix(upper, "CREATE DATABASE") && !strings.HasPrefix(upper, "CREATE SCHEMA") &&
+		!strings.HasPrefix(upper, "CREATE TABLE") {
+		return query, nil
+	}
+	stmt, err := p.ParseOneStmt(query, "", "")
+	if err != nil {
+		return "", terror.ErrLoadUnitParseStatement.Delegate(err, query)
+	}
+	if !parserpkg.ConvertCharset(stmt, config.CharsetConvertFunc(rules)) {
+		return query, nil
+	}
+
+	var bf bytes.Buffer
+	if err = stmt.Restore(format.NewRestoreCtx(restoreFlags(ansiquote), &bf)); err != nil {
+		return "", terror.ErrRestoreASTNode.Delegate(err)
+	}
+	return bf.String() + ";", nil
+}
+
+// rewriteColumnCharset rewrites the explicit charset and collation of a column, the binary ones are kept.
+func rewriteColumnCharset(col *ast.ColumnDef, cs, co string) {
+	// the collation may be either an option of the column or a part of the type.
+	hasCollateOption := false
+	options := make([]*ast.ColumnOption, 0, len(col.Options))
+	for _, opt := range col.Options {
+		if opt.Tp == ast.ColumnOptionCollate && opt.StrValue != charset.CollationBin {
+			if co == "" {
+				continue
+			}
+			opt.StrValue = co
+			hasCollateOption = true
+		}
+		options = append(options, opt)
+	}
+	col.Options = options
+
+	if col.Tp == nil {
+		return
+	}
+	switch {
+	case col.Tp.Charset != "" && col.Tp.Charset != charset.CharsetBin:
+		col.Tp.Charset = cs
+		if !hasCollateOption {
+			col.Tp.Collate = co
+		}
+	case col.Tp.Collate != "" && col.Tp.Collate != charset.CollationBin:
+		col.Tp.Collate = co
+	}
+}
+
+// genAddForeignKeySQLs generates `ALTER TABLE ... ADD CONSTRAINT` statements for foreign keys of table,
+// both the table and the referenced tables are routed to target.
+func genAddForeignKeySQLs(tctx *tcontext.Context, r *router.Table, info *tableInfo) ([]string, error) {
+	sqls := make([]string, 0, len(info.foreignKeys))
+	for _, fk := range info.foreignKeys {
+		refer := fk.Refer.Table
+		referSchema := refer.Schema.O
+		if referSchema == "" {
+			referSchema = info.sourceSchema
+		}
+		dstSchema, dstTable := fetchMatchedLiteral(tctx, r, referSchema, refer.Name.O)
+		stmt := &ast.AlterTableStmt{
+			Table: &ast.TableName{Schema: model.NewCIStr(info.targetSchema), Name: model.NewCIStr(info.targetTable)},
+			Specs: []*ast.AlterTableSpec{{
+				Tp: ast.AlterTableAddConstraint,
+				Constraint: &ast.Constraint{
+					Tp:   ast.ConstraintForeignKey,
+					Name: fk.Name,
+					Keys: fk.Keys,
+					Refer: &ast.ReferenceDef{
+						Table:                   &ast.TableName{Schema: model.NewCIStr(dstSchema), Name: model.NewCIStr(dstTable)},
+						IndexPartSpecifications: fk.Refer.IndexPartSpecifications,
+						OnDelete:                fk.Refer.OnDelete,
+						OnUpdate:                fk.Refer.OnUpdate,
+						Match:                   fk.Refer.Match,
+					},
+				},
+			}},
+		}
+		var bf bytes.Buffer
+		if err := stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &bf)); err != nil {
+			return nil, terror.ErrRestoreASTNode.Delegate(err)
+		}
+		sqls = append(sqls, bf.String())
+	}
+	return sqls, nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+)
+
+var _ = Suite(&testSchemaSuite{})
+
+type testSchemaSuite struct{}
+
+func (t *testSchemaSuite) TestSchemaCheckpointFile(c *C) {
+	cases := []struct {
+		kind schemaObjectKind
+		file string
+	}{
+		{schemaObjectDatabase, "db-schema-create.sql"},
+		{schemaObjectTable, "db.tb-schema.sql"},
+		{schemaObjectView, "db.tb-schema-view.sql"},
+		{schemaObjectConstraint, "db.tb-schema-fk.sql"},
+	}
+	for _, cs := range cases {
+		file := schemaCheckpointFile(cs.kind, "db", "tb")
+		c.Assert(file, Equals, cs.file)
+		c.Assert(isSchemaCheckpointFile(file), IsTrue)
+	}
+	c.Assert(isSchemaCheckpointFile("db.tb.sql"), IsFalse)
+	c.Assert(isSchemaCheckpointFile("db.tb.0.sql"), IsFalse)
+
+	db, view, ok := getDBAndViewFromFilename("db.v1-schema-view.sql")
+	c.Assert(ok, IsTrue)
+	c.Assert(db, Equals, "db")
+	c.Assert(view, Equals, "v1")
+	_, _, ok = getDBAndViewFromFilename("db.v1-schema.sql")
+	c.Assert(ok, IsFalse)
+}
+
+func (t *testSchemaSuite) TestSortViewsByDependency(c *C) {
+	newJob := func(view string) *restoreSchemaJob {
+		return &restoreSchemaJob{database: "db", table: view, kind: schemaObjectView}
+	}
+	v1, v2, v3, v4 := newJob("v1"), newJob("v2"), newJob("v3"), newJob("v4")
+	deps := map[string][]*filter.Table{
+		tableName("db", "v1"): {{Schema: "db", Name: "t1"}},
+		tableName("db", "v2"): {{Schema: "db", Name: "v1"}, {Schema: "db", Name: "v1"}, {Schema: "db", Name: "t2"}},
+		tableName("db", "v3"): {{Schema: "db", Name: "v1"}, {Schema: "db", Name: "v2"}},
+		tableName("db", "v4"): {{Schema: "db", Name: "t2"}},
+	}
+	levels := sortViewsByDependency([]*restoreSchemaJob{v3, v2, v4, v1}, deps)
+	c.Assert(levels, DeepEquals, [][]*restoreSchemaJob{{v1, v4}, {v2}, {v3}})
+
+	// views in a cycle are put into the last level
+	deps[tableName("db", "v1")] = []*filter.Table{{Schema: "db", Name: "v3"}}
+	levels = sortViewsByDependency([]*restoreSchemaJob{v1, v2, v3, v4}, deps)
+	c.Assert(levels, DeepEquals, [][]*restoreSchemaJob{{v4}, {v1, v2, v3}})
+}
+
+func (t *testSchemaSuite) TestParseViewDependencies(c *C) {
+	dir := c.MkDir()
+	file := filepath.Join(dir, "db.v2-schema-view.sql")
+	content := "/*!40101 SET NAMES binary*/;\nDROP TABLE IF EXISTS `v2`;\nDROP VIEW IF EXISTS `v2`;\n" +
+		"CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`%` SQL SECURITY DEFINER VIEW `v2` AS SELECT `a` FROM `v1` JOIN `db2`.`t1` USING (`a`);\n"
+	c.Assert(os.WriteFile(file, []byte(content), 0o644), IsNil)
+
+	deps, err := parseViewDependencies(parser.New(), "db", file)
+	c.Assert(err, IsNil)
+	c.Assert(deps, DeepEquals, []*filter.Table{{Schema: "db", Name: "v1"}, {Schema: "db2", Name: "t1"}})
+}
+
+func (t *testSchemaSuite) TestForeignKeys(c *C) {
+	p := parser.New()
+
+	// statements without foreign keys are kept as is
+	query := "CREATE TABLE `t1` (`id` int PRIMARY KEY);"
+	stripped, err := stripForeignKeys(p, query, false)
+	c.Assert(err, IsNil)
+	c.Assert(stripped, Equals, query)
+
+	query = "CREATE TABLE `t2` (`id` int PRIMARY KEY, `pid` int, CONSTRAINT `fk_1` FOREIGN KEY (`pid`) REFERENCES `t1` (`id`) ON DELETE CASCADE);"
+	stripped, err = stripForeignKeys(p, query, false)
+	c.Assert(err, IsNil)
+	c.Assert(stripped, Equals, "CREATE TABLE `t2` (`id` INT PRIMARY KEY,`pid` INT);")
+	stripped, err = stripForeignKeys(p, query, true)
+	c.Assert(err, IsNil)
+	c.Assert(stripped, Equals, `CREATE TABLE "t2" ("id" INT PRIMARY KEY,"pid" INT);`)
+
+	stmt, err := p.ParseOneStmt(query, "", "")
+	c.Assert(err, IsNil)
+	fks := extractForeignKeys(stmt.(*ast.CreateTableStmt))
+	c.Assert(fks, HasLen, 1)
+
+	r, err := router.NewTableRouter(false, []*router.TableRule{
+		{SchemaPattern: "db", TargetSchema: "db_target"},
+		{SchemaPattern: "db", TablePattern: "t1", TargetSchema: "db_target", TargetTable: "t1_target"},
+	})
+	c.Assert(err, IsNil)
+	info := &tableInfo{
+		sourceSchema: "db",
+		sourceTable:  "t2",
+		targetSchema: "db_target",
+		targetTable:  "t2",
+		foreignKeys:  fks,
+	}
+	sqls, err := genAddForeignKeySQLs(tcontext.Background(), r, info)
+	c.Assert(err, IsNil)
+	c.Assert(sqls, DeepEquals, []string{
+		"ALTER TABLE `db_target`.`t2` ADD CONSTRAINT `fk_1` FOREIGN KEY (`pid`) REFERENCES `db_target`.`t1_target`(`id`) ON DELETE CASCADE",
+	})
+}
+
+func (t *testSchemaSuite) TestRewriteCharset(c *C) {
+	p := parser.New()
+
+	// other statements are kept as is
+	query := "SET NAMES binary;"
+	rewritten, err := rewriteCharset(p, query, "utf8mb4", "", false)
+	c.Assert(err, IsNil)
+	c.Assert(rewritten, Equals, query)
+
+	query = "CREATE DATABASE `db` /*!40100 DEFAULT CHARACTER SET latin1 */;"
+	rewritten, err = rewriteCharset(p, query, "utf8mb4", "utf8mb4_general_ci", false)
+	c.Assert(err, IsNil)
+	c.Assert(rewritten, Equals, "CREATE DATABASE `db` CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci;")
+
+	query = "CREATE TABLE `t` (`id` int PRIMARY KEY, `a` varchar(10) CHARACTER SET latin1 COLLATE latin1_bin, " +
+		"`b` varchar(10) COLLATE latin1_bin, `c` varbinary(10)) ENGINE=InnoDB DEFAULT CHARSET=latin1 COLLATE=latin1_bin;"
+	rewritten, err = rewriteCharset(p, query, "utf8mb4", "", false)
+	c.Assert(err, IsNil)
+	c.Assert(rewritten, Equals, "CREATE TABLE `t` (`id` INT PRIMARY KEY,`a` VARCHAR(10) CHARACTER SET UTF8MB4,"+
+		"`b` VARCHAR(10),`c` VARBINARY(10)) ENGINE = InnoDB DEFAULT CHARACTER SET = UTF8MB4;")
+	rewritten, err = rewriteCharset(p, query, "utf8mb4", "utf8mb4_bin", true)
+	c.Assert(err, IsNil)
+	c.Assert(rewritten, Equals, `CREATE TABLE "t" ("id" INT PRIMARY KEY,"a" VARCHAR(10) CHARACTER SET UTF8MB4 COLLATE utf8mb4_bin,`+
+		`"b" VARCHAR(10) COLLATE utf8mb4_bin,"c" VARBINARY(10)) ENGINE = InnoDB DEFAULT CHARACTER SET = UTF8MB4 DEFAULT COLLATE = UTF8MB4_BIN;`)
+}
+
+func (t *testSchemaSuite) TestConvertCharset(c *C) {
+	p := parser.New()
+	rules := []*config.CharsetConvertRule{{From: "latin1", To: "utf8mb4"}}
+
+	query := "CREATE TABLE `t` (`id` int PRIMARY KEY, `a` varchar(10)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+	converted, err := convertCharset(p, query, rules, false)
+	c.Assert(err, IsNil)
+	c.Assert(converted, Equals, query)
+
+	query = "CREATE TABLE `t` (`id` int PRIMARY KEY, `a` varchar(10) COLLATE latin1_bin) ENGINE=InnoDB DEFAULT CHARSET=latin1;"
+	converted, err = convertCharset(p, query, rules, false)
+	c.Assert(err, IsNil)
+	c.Assert(converted, Equals, "CREATE TABLE `t` (`id` INT PRIMARY KEY,`a` VARCHAR(10) CHARACTER SET UTF8MB4) ENGINE = InnoDB DEFAULT CHARACTER SET = UTF8MB4;")
+}
@@ -14,6 +14,7 @@
 package loader
 
 import (
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -22,17 +23,79 @@ import (
 	"github.com/pingcap/dm/pkg/binlog"
 )
 
+// restoreSpeed estimates the restore speed and the remaining time by the bytes restored since the load unit started or
+// resumed, so the data already restored before won't make the estimation too optimistic.
+type restoreSpeed struct {
+	sync.RWMutex
+	startTime  time.Time
+	startBytes int64
+}
+
+// start resets the baseline of the estimation, finishedBytes is the restored bytes loaded from checkpoint.
+func (r *restoreSpeed) start(finishedBytes int64) {
+	r.Lock()
+	defer r.Unlock()
+	r.startTime = time.Now()
+	r.startBytes = finishedBytes
+}
+
+// estimate returns the average restore speed in bytes per second and the estimated remaining seconds, the remaining
+// seconds is -1 if it can't be estimated yet.
+func (r *restoreSpeed) estimate(finishedBytes, totalBytes int64, finish bool, now time.Time) (int64, int64) {
+	if finish {
+		return 0, 0
+	}
+	r.RLock()
+	startTime, startBytes := r.startTime, r.startBytes
+	r.RUnlock()
+	if startTime.IsZero() {
+		return 0, -1
+	}
+	elapsed := now.Sub(startTime).Seconds()
+	if elapsed <= 0 || finishedBytes <= startBytes {
+		return 0, -1
+	}
+	bps := float64(finishedBytes-startBytes) / elapsed
+	remaining := totalBytes - finishedBytes
+	if remaining < 0 {
+		remaining = 0
+	}
+	return int64(bps), int64(float64(remaining) / bps)
+}
+
+// progressDetail returns the progress in bytes of the restored data.
+func progressDetail(finishedBytes, totalBytes, bps, remainingSeconds int64, finish bool) *pb.Progress {
+	phase := "restoring"
+	if finish {
+		phase = "finished"
+	}
+	return &pb.Progress{
+		Finished:                  finishedBytes,
+		Total:                     totalBytes,
+		Unit:                      "bytes",
+		Rate:                      bps,
+		EstimatedRemainingSeconds: remainingSeconds,
+		Phase:                     phase,
+	}
+}
+
 // Status implements Unit.Status.
 func (l *Loader) Status(_ *binlog.SourceStatus) interface{} {
 	finishedSize := l.finishedDataSize.Load()
 	totalSize := l.totalDataSize.Load()
-	progress := percent(finishedSize, totalSize, l.finish.Load())
+	finish := l.finish.Load()
+	progress := percent(finishedSize, totalSize, finish)
+	bps, remainingSeconds := l.speed.estimate(finishedSize, totalSize, finish, time.Now())
 	s := &pb.LoadStatus{
-		FinishedBytes:  finishedSize,
-		TotalBytes:     totalSize,
-		Progress:       progress,
-		MetaBinlog:     l.metaBinlog.Load(),
-		MetaBinlogGTID: l.metaBinlogGTID.Load(),
+		FinishedBytes:             finishedSize,
+		TotalBytes:                totalSize,
+		Progress:                  progress,
+		MetaBinlog:                l.metaBinlog.Load(),
+		MetaBinlogGTID:            l.metaBinlogGTID.Load(),
+		FinishedRows:              l.finishedRows.Load(),
+		Bps:                       bps,
+		EstimatedRemainingSeconds: remainingSeconds,
+		ProgressDetail:            progressDetail(finishedSize, totalSize, bps, remainingSeconds, finish),
 	}
 	go l.printStatus()
 	return s
@@ -58,10 +121,25 @@ func (l *Loader) printStatus() {
 	}
 	l.dbTableDataLastUpdatedTime = time.Now()
 
+	finish := l.finish.Load()
+	bps, remainingSeconds := l.speed.estimate(finishedSize, totalSize, finish, time.Now())
 	l.logger.Info("progress status of load",
 		zap.Int64("finished_bytes", finishedSize),
 		zap.Int64("total_bytes", totalSize),
+		zap.Int64("finished_rows", l.finishedRows.Load()),
 		zap.Int64("total_file_count", totalFileCount),
-		zap.String("progress", percent(finishedSize, totalSize, l.finish.Load())))
-	progressGauge.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Set(progress(finishedSize, totalSize, l.finish.Load()))
+		zap.String("progress", percent(finishedSize, totalSize, finish)),
+		zap.Int64("bps", bps),
+		zap.Int64("estimated_remaining_seconds", remainingSeconds))
+	progressGauge.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Set(progress(finishedSize, totalSize, finish))
+	setSpeedMetrics(l.cfg.Name, l.cfg.SourceID, bps, remainingSeconds)
+}
+
+// setSpeedMetrics sets the task level restore speed and remaining time metrics, the remaining time is not updated if
+// it can't be estimated yet.
+func setSpeedMetrics(task, sourceID string, bps, remainingSeconds int64) {
+	speedGauge.WithLabelValues(task, sourceID).Set(float64(bps))
+	if remainingSeconds >= 0 {
+		taskRemainingTimeGauge.WithLabelValues(task, sourceID).Set(float64(remainingSeconds))
+	}
 }
@@ -144,8 +144,8 @@ func getMydumpMetadata(cli *clientv3.Client, cfg *config.SubTaskConfig, workerNa
 // cleanDumpFiles is called when finish restoring data, to clean useless files.
 func cleanDumpFiles(cfg *config.SubTaskConfig) {
 	log.L().Info("clean dump files")
-	if cfg.Mode == config.ModeFull {
-		// in full-mode all files won't be need in the future
+	if cfg.Mode == config.ModeFull || cfg.Mode == config.ModeSchemaOnly {
+		// in full-mode and schema-only mode all files won't be need in the future
 		if err := os.RemoveAll(cfg.Dir); err != nil {
 			log.L().Warn("error when remove loaded dump folder", zap.String("data folder", cfg.Dir), zap.Error(err))
 		}
@@ -703,85 +703,88 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w923LbOJa/gtXuQ3eXZEm24yTemgfHdme8azspW6neqa6sAoGQhDEI0ABotyblf5/C",
-	"hSRIghTlS9ruZB6mHRLAOTj3C0B97SEeJ5xhpmRv/2tPoiWOofnzkKZSYXEG9f/rB4ngCRaKYPMaRpF5",
-	"GmGJBEkU4ay3b55iKQGfA7XEAKVCYKZAbBYBjEe41+/hP2CcUNzb7423X2+NtkZb4/0323vjXr+nVol+",
-	"LpUgbNG76/cgJTe4DoczShgGUkGVOmhEOjA+BCVSnK8645xiyPSyFMMIB/An0l/J7MEN7bAog7FBtdif",
-	"XSawsbt+T+DrlAgc9fZ/tzOzzebY9S2RP+ez+eyfGCkNyjHnNy6u/kTmzHjKoqnkqUB4mu2+DNMMAXYI",
-	"0ENyZt0a3Otg45W8poNRG0AFF82g9Mu1QMzYEIQ6D+0S3XmoSV/GNESoIFMFhgpPoLy6wNcplqrOWIFj",
-	"foOnMVbQEmAOU6p6+3NIJe5XCHK7xGqppZgDOw/oeSCCCs6gxIAwEPFbJpXAMM4f90Ki7aE+pcRi9l8C",
-	"z3v7vf8cFiZk6OzH8NKMP4cxPtWj7/o9BeXVull66zW6+lt2y4SId4QpVtjCvcAy4UziOv309O670PgU",
-	"e7gLQD0WgovfiFqeYSmDUqmhQ/03wHpsr1/ByDydIi2gtbnmHUBWeB1swhReYKGB26mxXDTNjB1S60S3",
-	"WKjv4xMi83usSo5Bk6aZ3Fqm9H+JwrFcR+2ywymoDYWAK/NvriA1XKyQorIdO65vobdvwhrQx9+EM8xP",
-	"vAkr7Y+IvV3w26B9aXz3oyJul3xq9LVVeESaW6P39Cg/Lr3TWbHmt8B+AmcUXyqRIpWKFgNvEZwi40qn",
-	"8pqWnfnhxfHB5BhMDt6dHoMvavwF/PSFRF8AYeqn8fhncP5hAs4/nZ6Cg0+TD9OT88OL47Pj80n/48XJ",
-	"2cHFP8D/Hv/DzvgZDH+Z/MfvyKo7jqaERfiPz+Dw9NPl5Pji+Aj8MvwZHJ+/Pzk//tsJY/zoHTg6/vXg",
-	"0+kEHP794OLyePK3VM3fxLNdcPjh9PRgcpz9ezojLBSauK3VI5RoFgyWlCZZYLh5vj6e8aZna3lUDbHq",
-	"lMPISUTNIRWROuUwAikjquYK54QRucTRdLZS7gkXMVRWfPZ2g05QxwWaYpQvPHErqOC9ny4UiYKDEsEX",
-	"OjQOvjQiugFOFTpWdlVezwNd3koA8RDJP5joAoc0JI8gK0kAMsGI4sBGJhgY3gLhJtSYQlO5LMWaNv0p",
-	"r/qbIApLk1ZYMdUATJKxxOgq4YQpIPUTqMDRGUCQWTkgCsC5zj4ElgoKRdjCTDNhXjAQvaZTxJnCLLA3",
-	"eU3BiqfgFjLl7bAU+QcsAPiCxoUJyLRUm4E++IK2m1/thF89QO//O6j4K4bqm/2URDCjOU8UiYlUBAG5",
-	"hCLSZNTyo60quCVqabMhxxrO6AqkEkfgdokZgC40BRyhVEidFjSteXR0CuJSOJqzpiL1Pp9CgvsxFaFo",
-	"WWAKV4DyBUB62TQBCacErQDibE4WqQ2l60H0HwkRzo1lYjqqyqgZZENxRWwimoPr9et6zVJKtWpUEn7P",
-	"9ug/xY31czncnb1RDfRkqbMtO1gLZoIF4RFBkNKVVRFAbFJeEIBIYLcV9YFbHNxAmuJ9YEBoPkmMOIvk",
-	"/bAXOIaETWUCES7tYPyqiv8ZYSROYzAXGIOIyCtgZhkc3r+7D/hQMnWh977egfhMK4uBrWR43qC8RJq4",
-	"VNcOAHNCNVss7lasCjvxk61DzAjbGun/jftg/Pb1259DClqCm3uZMvD3k5OjrPKSIVICiN/C8Rxtbw8w",
-	"Gr0ZjMf47WC2DdFgtL27DdF4PBqNdvbHg9dvdt+GcDBUaUfBEi4r+2iEHh8BBBVaTtNkGud1w8aihBkL",
-	"0sRaqJw7nkes238LJSKBlTVlIyIwUlystGkTuK5SUnFtofx9bw1lOjNLhmxvuNaUEdFKZWm5i5QxPXld",
-	"fFUW1qAQ+dsNcbiJ6BnaIcN7aXxAXtWo65n1EaZUZ2ok/SIjWB9yVrKAS4xSQdSqDsZ4JlcWlJKW7Xvf",
-	"8G1OMI3ALaEUzDBYkijCzHqsBVZ5pOAvVFoEzAWPzRBjeefaytXtUtmAICzUFFLKb3E0RayO9iGPY87A",
-	"uStkXl6eAj2HzAmCNp7LibWWOFLSKYLN0Yy3sDVV2Uhf2oIyqxfWO2lc+ldvOb2Pj8dnwJrB4f+9Gr11",
-	"f1e3th7qFV41Az0s4GmuJILc6K1d4VVmiYEHfA28arhRpmWABnUEg9rhIp33gqdJIEeOaF447M7oORFS",
-	"TSlH1suEpugQD0ebLaugWGAVHJqyzRespX9m9X6x59pGcrQ9gEGi2qpS3dTY57Vgjplks/BhHWvcqcTG",
-	"qZkAK9VWw5hKaQ1ByOe6FeteZsmDptFaGddGCfdKQpqRQClvuYgaV8wHlJfc2X21F1yPi2bszEtvnZ2d",
-	"0V4o+kuyALytzGOjdC2fniFvrQtl48o9g0ZsXXmhW/fHOtu6Aj6kMJjKUJDisNMvaxgKztV6e+Tt3YmT",
-	"45sD6UlFvyTxzQrU4rO99lqzz7ajBt0ct0+2Jnh58BPqaKxvS1hfLnmM1VJ781vBQ2FTFuTIHJk2fvs5",
-	"xMNkUOCEEgQbZNF2BRsW1ume6zy6QJGugG1PurJIbvqqfcbBeEPZ8hEJyo6CQhmqdCgJmRoMgC5kbioJ",
-	"dUg18mJOKO0Becx73+SjIbtsyIYauJ+hqIOPojPdjmYlKdzujkuesjhv1tsa2hcWxENSmbUYeDLSqe9p",
-	"u1elzqcvgLXlwnLHk+5ix5O1UvenbKLU5qiFg5TDqKNd8mrj3imDCtehvMqsUd35dbdk90hiF7n0u8qr",
-	"5+/ScDpro7+O279cMVRs31T/w9vXr4CB5ONgKqD9UJwrsOT0BkdTE6ZydDVtKPG3GuzsmEiQfuFzHs1W",
-	"OKO322dQrgpytBS69K5BmgY6Jc6w2XUDm51pShC20FQJgfDrubdLgpZ5VYhIkE3eKJmtld46FskC1hJh",
-	"pqYq6doAcjXQ6QwvCYu8ulOXuXmWFOg06HetOyqNaN6R7ffgm+yMXQe87JTuNPD0YKEz1zae2wEVtkOB",
-	"QcoG2So+61vVupQur00pfUL4myxxvd+tMlZmT5AZVT0I0cnLYX2lahKrkDKbxttDC2pNTdm6pk3cQaq6",
-	"8WwyE3NCNf1ESrE7HEj0LEg/lkavO6TwjrBTvvjVLHah1wrV8DFbQobw1B7QnGbt+CVkC7y2i+gl8zYl",
-	"AjJNdNYE5twexnTnPqOIgoSmC8K6nMs0nVSLSTkEi+KBO1ZWKU7WT8UZDHTElbXWGhsHxaKNhwub3b4v",
-	"EPIqnKtxNo1Sk5uowGpLfqvpt4QssjW+OSVI4cjsxOSaaayVkd9gcSuI7Y6ak2mfQy5eK/g0Dp5O0/y4",
-	"hStTyedc2wGosHYpHpQES+m6iL1+r2gphoFZl2qruF0k0maph3Z8fuIhJgsBFc7lvUptLVduDDBj+t0P",
-	"6hhdP7OTKzpQqcttsI2JmXAEFXwHJc4OSzZQPcPctWEzQs9TSvVGGBI4xsweqoHUHNQohAqaQZ3imwKF",
-	"NUpdEcjq/oNcqfI6bFYDJidUyVbYKKVeWAKosu4exTeY1kwiWTAusHVCgfqEfpyFn7lQtIwpkRZEMe1i",
-	"wR0O7nBS/QhDApXCwmRG1nQ3I9M0vMDr/48ET9ZjddfAgV9TSp28az0LJBGlngufAy2JuX5pKapXiBBn",
-	"kkiFGQp0how5YUpwCjILQ5gLV0yzxzbGudBGbW7Ox+arAShlKrSslnmTKh4igV4u3EvUll4nRRERddO8",
-	"NczgT51Rra1sB0zVUmAYlc8l7Fa9jSGYnaDphzhzUVkw1CNx48rjveDSdsbapZsk4IQhsZkEeEaoQQAE",
-	"Tuh0BhUqnywa109O+GvpSG0pOCP/ykGZNQD+A6PUPNL6cJ1CpogBFT72kNCO5Ktu5N40bI4Oc+ffGhs2",
-	"hQKh2LBwivWCRSVVKUCMduZotL23M9h+g14PxmP8egD3Xu0M9tBo9mY3evV2vjPaHw9ej3bHu9s7/dGr",
-	"3de70Q7yhr/ZebU92B7tRLPt3b0o2on2x4Px61HwFke5LOfdyjAv3HmLlpkJL1NoN5jbPU3pt6UY2+TF",
-	"SmFKAyoDgSnUFq39oJNW6NyVIsfjdfFF1Ybf2Thh43WqlqAcsjUSubqjzsGWJ8nrUksfjyY21GK35gNC",
-	"NkhU3L8f44eMsmOqVbHG5qVZIJO8gLbr1920Xbb2VTtKlJ8XNaStfXBLaISgiLJ8rJzwzAa/PLBiWWtS",
-	"NVUyla1zh4P6DriqIK6tDRZHoAx2SLqKTnxTHvmYzIg4loBxlSfH2Y5lhS3je1KwIwA162Ae1xEvSPoW",
-	"FS5lSi0ELxL3doq/xE7/Zo3++7Tun6gr3t4HDzG90sRpK9y3BFDNrdW6US0gNjauXIdKgkypFXftXtnW",
-	"tVrXdrhHK7i9+XtnEhGdG0J6xFEgfTw6Ax8SzA4+noCjD4eaJ4L29ntLpRK5PxxGHMmthLAFgskW4vHw",
-	"X8uhItFsoJVrYB0i4WworXabuGLOTRGcKLOTGoAbLKSFvb013hqZelaCGUxIb7+3ozXLiIRaGmyHMCHD",
-	"m/HQXbMZ2iqseeUMbn698iQy4A4+noSuKJoSs70zZGZvj0YuEc3OqsHEVjD0fv4p7TGtwhy3KU7rlUjD",
-	"hIoapQhpq3LX7+0+Ihq1q6gB0HNIKI6MGMk0jqFY9fY1JYEjsH8XPNMnBRdSy5ob0vusZzcwZvjV/mH8",
-	"952VN4ptmTLAqQ/zOSUMW7Kd21pTAgWMseXy77Xil4deFkHp51pgell9tefh0PP1xdaHC2p2uaf/uSY4",
-	"uwHD+Mw4yi1dKzf7OzEys2MdNay4P/ttNCxwX/eFaZj3RYKNNMwxZvjVOYeNNMw5tQ4a5qPXrGEeDt+3",
-	"hpW/L9HKyCjeypALatZ7rI44+p/LD+cNqlRGS6+Vnwivi1vEETDgCqwijioYuZigBZ2/T85OO6GjB65B",
-	"Z6lsebwJHRterjc9xa33dcKsIbug1VwxyQ8sGpG+TrFYeTJN1HKajwjIcLi9GJDfRzV8gTv+ASH1b0HQ",
-	"7LhThQXVIQUrsqzLZByyifT28ySX2clFFwW/49Hq0fabfX+gvkEHDcw0uLsaycffAIXnZoPsbWzA8K3P",
-	"2xBb60o2/OoVWta7Ef/jKmuVjvKZudeYMnKdli/oNHuUct2nk0dpPCl+169V3rg9r8wTW8eHVLpTh9mp",
-	"SpPGuV5FyDqYFR5oF3YfTWaCH7t5ASJrhQzAhwrsMIGptBVOY3xarNZHPdIcRH0Bgvu5i6t9bkw1vPCO",
-	"Js9TZk/2Zod2HspsgWUad+P2hRn6g91PyG7Ljafkt/cRwg6BoL3Q2iUcfALmNt+pedK4sHKJ94WkwNm1",
-	"IdvMaIpBu4rH8Kv9owhhOgiL6QE+P1nptzR8GsAXe+8IPtgPelIpLR+MfVlCavth95dRBYXq5LGK+1kv",
-	"xWE9QdpXu6N2V+50aGTvXqKzdMeYn9JZ5tdIuvjK/Mbm8xG01sM236S4Uvky3gsxVP4nfP3vID+GSPGk",
-	"o+1yd/y+Z9NVueb4V7FcEZFPbbqUgEzO3ferm6Vs4oa9mPLTE4la/WTCX0XWMkHIgy8OoP3WmO2vrJEu",
-	"W7db5wGzz7Y+caOy9nXYABFMDZK6D2M/H4eSY1WQ235uu70vYKK3ib1/9hRyX/8s+p/ZH3DfKH8p3QFo",
-	"P4kvVP5JzTJnq2o0/GpOm2/SFnCs38gq+3feAuY4x6GjMW46IR9IrAOfSgmU+2vfu/fhPujbKi+z350V",
-	"7o00uS7KZsJkS/btrt7U6p+zOH1+yranXym5e7mNgHuIhi0pdyrt/5COlyodrm9wD/F4WJeguEnSvVPw",
-	"HN3Vj5bFnxUTt/YtHizAG/YxCnHu3Mv4TqX5R1PlWapRsLPyyFqk580o3jiN8RQr/yWFH9r1zLSr33x3",
-	"rYnkmSx0pnnDL6S85NytroPSE/FawWcTJ/RDV37oyrdu3bX8KNSLdYqtCpmkTQrp/QjQD6W8N/DvRSUf",
-	"v0Cx9keo/ip9quIXszbQ3PaYttvpDe8jtN9ToX2jqtg38Dcv9KCIkdZMeqrSab4QIG4yaSpfAF/xdCvi",
-	"MSTMXP/uaSK7BRp/zKH9xnnE0QOvmQ+vU4KuBvaEne1yDWT+u6UlseqFjK37vPE3QdKhl78dKPdjn576",
-	"BZDM7g/m47IHd5/v/h0AAP//ygaUOLJ9AAA=",
+	"H4sIAAAAAAAC/+w9W3Pbtpp/Bavdh7YjWZLtOIl3zkNiuznetZ2MrUz3TCerQCAkoQYBGgDtqBn/9zO4",
+	"kARJkKJ8Se0m5+HUIUHgw3e/AfraQzxOOMNMyd7+155ESxxD8+cBTaXC4hTq/9cPEsETLBTB5jWMIvM0",
+	"whIJkijCWW/fPMVSAj4HaokBSoXATIHYTAIYj3Cv38NfYJxQ3Nvvjbdfbo22Rlvj/Vfbe+Nev6dWiX4u",
+	"lSBs0bvt9yAl17i+DmeUMAykgip1qxHplvFXUCLF+awzzimGTE9LMYxwAH4i/ZnMHtzQDpMyGBtQi/3Z",
+	"aQIbu+33BL5KicBRb/93+2W22Ry6vkXyp/xrPvsDI6WXcsT5jYvLv5A4M56yaCp5KhCeZrsvr2mGADsE",
+	"6CE5sW4M7PVl45W8ooNR24IKLpqX0i/XLmLGhlao09BO0Z2GGvVlSEOIChJVYKjwBMrLc3yVYqnqhBU4",
+	"5td4GmMFLQLmMKWqtz+HVOJ+BSE3S6yWmos5sN8B/R2IoIIzKDEgDET8hkklMIzzx70Qa3ugTymxkP2X",
+	"wPPefu8/h4UKGTr9Mbww489gjE/06Nt+T0F5ue4rvfUaXv0tu2lCyDvEFCts1z3HMuFM4jr+9Ofdd6Hh",
+	"KfZwG1j1SAgufiNqeYqlDHKlXh3qvwHWY3v9CkTm6RRpBq19a94BZJnXrU2Ywgss9OL201gumr6MHVDr",
+	"WLeYqO/DE0LzO6xKhkGjphndmqf0f4nCsVyH7bLBKbANhYAr82+uIDVUrKCish07rm9Xb9+EVaAPvwmn",
+	"mB95E5bbHxB6O+G3AfvC2O4HBdxO+djga63wgDi3Su/xQX5YfKezYs5vAf0Ezii+UCJFKhUtCt4COEXG",
+	"lE7lFS0b84PzozeTIzB58/bkCHxW48/gp88k+gwIUz+Nxz+Ds/cTcPbx5AS8+Th5Pz0+Ozg/Oj06m/Q/",
+	"nB+fvjn/F/jfo3/ZL34Gw18m//E7suKOoylhEf7yCRycfLyYHJ0fHYJfhj+Do7N3x2dH/zhmjB++BYdH",
+	"v775eDIBB/98c35xNPlHquav4tkuOHh/cvJmcpT9ezojLOSauK3VPZRoFnSWlEZZYLh5vt6f8T7P5vKw",
+	"GiLVCYeR44iaQSo8dcphBFJGVM0Uzgkjcomj6Wyl3BMuYqgs++ztBo2g9gs0xihfeOxWYMF7P10oEgUH",
+	"JYIvtGscfGlYdAOYKnis7Ko8n7d0eSsBwEMof2+8CxySkNyDrAQByDgjigPrmWBgaAuE+6BGFJrKZcnX",
+	"tOFPedbfBFFYmrDCsqlewAQZS4wuE06YAlI/gQocngIEmeUDogCc6+hDYKmgUIQtzGfGzQs6old0ijhT",
+	"mAX2Jq8oWPEU3ECmvB2WPP+ABgCf0bhQAZmUajXQB5/RdvOrnfCre8j9fwcFf8VQfbMfkwhmOOeJIjGR",
+	"iiAgl1BEGo2af7RWBTdELW005EjDGV2BVOII3CwxA9C5poAjlAqpw4KmOQ8PT0Bcckdz0lS43qdTiHE/",
+	"pCLkLQtM4QpQvgBIT5smIOGUoBVAnM3JIrWudN2J/pIQ4cxYxqajKo+aQdYVV8QGovlyvX5drllKqRaN",
+	"SsDv6R79p7i2di5fd2dvVFt6stTRlh2sGTPBgvCIIEjpyooIIDYoLxBAJLDbivrATQ6uIU3xPjBLaDpJ",
+	"jDiL5N2gFziGhE1lAhEu7WD8ogr/KWEkTmMwFxiDiMhLYL4yMLx7e5flQ8HUud77egPiE63MBjaT4VmD",
+	"8hRp4kJdOwDMCdVksbBbtir0xE82DzEjbGuk/zfug/Hrl69/Dgload3cypQXfzc5PswyLxkgpQXxazie",
+	"o+3tAUajV4PxGL8ezLYhGoy2d7chGo9Ho9HO/njw8tXu6xAMBivtIFjEZWkfDdDDA4CgQstpmkzjPG/Y",
+	"mJQwY0GaWA2VU8eziHX9b1eJSGBmjdmICIwUFyut2gSui5RUXGsof99bQ5nOzJQh3RvONWVItFxZmu48",
+	"ZUx/vM6/KjNrkIn87YYo3IT0DOyQ4r0wNiDPatTlzNoIk6ozOZJ+ERGsdzkrUcAFRqkgalVfxlgmlxaU",
+	"kpb1e9/QbU4wjcANoRTMMFiSKMLMWqwFVrmn4E9UmgTMBY/NEKN551rL1fVSWYEgLNQUUspvcDRFrA72",
+	"AY9jzsCZS2ReXJwA/Q2ZEwStP5cjay1ypKRTBJu9GW9iq6qykT63BXlWT6x30jj1r950eh8fjk6BVYPD",
+	"/3sxeu3+rm5t/aqXeNW86EGxnqZKIsi13tolXmWaGHiLr1mv6m6UcRnAQR3AoHQ4T+ed4GkSiJEjmicO",
+	"uxN6ToRUU8qRtTKhT7SLh6PNplVQLLAKDk3Z5hPWwj8ze7/Yc20jOdjegkGk2qxSXdXY5zVnjplgs7Bh",
+	"HXPcqcTGqBkHK9Vaw6hKaRVByOa6GetWZsmDqtFqGVdGCddKQpKRQClvuIgaZ8wHlKfc2X2xF5yPi2bo",
+	"zEtvnp2d0V7I+0syB7wtzWO9dM2fniJvzQtl48o1g0ZoXXqhW/XHGtu6AN4nMZjKkJPioNMvaxAKztV6",
+	"feTt3bGTo5tb0uOKfonjmwWoxWZ75bVmm21HDboZbh9tTevlzk+oorG+LGFtueQxVkttzW8ED7lNmZMj",
+	"c2Da6O3HEPfjQYETShBs4EVbFWyYWId7rvLoHEW6ArY86dIiueqr1hkH4w15ywckyDsKCmWw0iElZHIw",
+	"ADqXuSkl1CHUyJM5obAH5D7vXYOPhuiyIRpqoH4GonY+isp0O5iVoHC7Oyx5yOKsWW9raF/YJe4TyqyF",
+	"wOORTnVPW70qVT59BqxNF+Y7nnRnO56s5bq/ZBOlMkfNHaQcRh31kpcb97oMKlSH8jLTRnXj112T3SGI",
+	"XeTc7zKvnr1Lw+Gs9f46bv9ixVCxfZP9D29fvwJmJR8GkwHth/xcgSWn1ziaGjeVo8tpQ4q/VWFnbSJB",
+	"/IX7PJq1cIZvt88gXxXoaEl06V2DNA1USpxis/MGNjvTmCBsobESWsLP594sCVrmWSEiQfbxRsFsLfXW",
+	"MUkW0JYIMzVVSdcCkMuBTmd4SVjk5Z26fJtHSYFKg37XuqPSiOYd2XoPvs567DrAZT/pjgNPDhY6cm2j",
+	"uR1QITsUGKRskM3ik75VrEvh8tqQ0keEv8kS1fvdMmNl8gSJUZWDEJ68GNYXqia2CgmzKbzdN6HWVJSt",
+	"S9rENVLVlWeTmpgTqvEnUopdcyDRX0H6oTR6XZPCW8JO+OJXM9m5niuUw8dsCRnCU9ugOc3K8UvIFnht",
+	"FdEL5m1IBGSa6KgJzLltxnR9n1FEQULTBWFd+jJNJdVCUnbBonjg2soqycl6V5yBQHtcWWmtsXBQTNrY",
+	"XNhs9n2GkJfhWI2zaZSa2EQFZlvyG42/JWSRzfHNKUEKR2YnJtZMYy2M/BqLG0FsddR0pn0KmXgt4NM4",
+	"2J2m6XEDVyaTz7nWA1BhbVK8VRIspasi9vq9oqQYXsyaVJvF7cKRNko9sOPzjoeYLARUOOf3KrY1X7kx",
+	"wIzpd2/UMbJ+aj+uyEAlL7fBNibmg0Oo4FsocdYs2YD1DHL9tg8+23kGnNHVZ1vYtU0afi1eAqgjTw26",
+	"LDd9epSap5RqTDAkcIyZ7cqB5pm3hqZbwaP2dSd3qdjRGh1R4e8qOoNErrJOWEsHNFgoMa6wkXE9sQRQ",
+	"ZcVCiq8xrWlYsmBcYGvTAukO/TjzZnMeaxlTQi2IYtrFIDgYXK9TvSMigUphYQItawmagWkaXsD1/4eC",
+	"J+uhum2gwMESo0ssDnLZCKhC5wAjO3RN5X8G0SWfz6cx/BLYDlZWDihnCyyVrfvnhfjMqMBUca3IERAY",
+	"ab24ck0pRi9K4xxFWGGtRrdK2HgRj2SwT91BJTil+u8W0OogweiPVOb1rRtI7N+kyFDUAe4GljWqa82w",
+	"3quICcPaS6xa5ACJOqTUm/jh15RSp041TwRi1FJJj8+B1lO5+tbA1BOQiDNJpMIMBQqPxloxJTgFmQEj",
+	"zHnDppZo+y640DZzbtqv89kAlDIVWneVZTVVPIRuPV24VK0dCR1zR0TULf/WMFt/6mx2nZBmwFQtBYZR",
+	"ue1lt0pNgzD7gcYf4sw5/cFIgsSNM4/3glPbL9ZO3cQBxwyJzTjAM1ENDCBwQqczqFC5cW1cb8zx59KB",
+	"wFJwRv7MlzJzAPwFo9Q80vrxKoVMEbNUuKsmoR3RV93InXHYHHzkvmVr6NHkaYZCj8LnqufDKpFwscRo",
+	"Z45G23s7g+1X6OVgPMYvB3Dvxc5gD41mr3ajF6/nO6P98eDlaHe8u73TH73Yfbkb7SBv+KudF9uD7dFO",
+	"NNve3YuinWh/PBi/HAWVbznr6x36MS9cO0/LlwkvY2g3mDp4nMpCS66/yaspecENoAwEplBrtHZrqgU6",
+	"d62Qo/E697Wqw2+tF7nxPFVNUI4IGpFc3VFnX97j5HWZCx+OJjLUQoPm/jMbgyjuH7/yIxLZMZKvaGPz",
+	"0kyQcV5A2vXrbtIuW8v2HTnKD7sbsiJ9cENohKCIsnC/HE/PBr/cMyFeq4E2JcpV4R/VY8YOsKogrK31",
+	"O4egbO0QdxWNHk1piockRsSxBIyrPPeS7VhWyDK+IwY7LqBmHdTjOuQFUd8iwqVAvAXhRV6oHePPsZFk",
+	"sz6Su3SGPFLTRXubRYjolRphW12oxYFqrtzXlWqxYmNd1BVAJciEWnHXTSDbiqLrqlp36DRo7y24NYGI",
+	"0tSihxwF0gmHp+B9gtmbD8fg8P2Bpomgvf3eUqlE7g+HEUdyKyFsgWCyhXg8/HM5VCSaDbRwDaxBJJwN",
+	"pZVu41fMuamxEGV2UlvgGgtp197eGm+NTLo0wQwmpLff29GSZVhCLQ20Q5iQ4fV46E5xDW2S37xyCjc/",
+	"vXscmeXefDgOnYA1FQx7JM18vT0auUA0a4WEic1o6f38IW0XYKGO2wSn9cStIUJFjFKEtFa57fd2HxCM",
+	"2knnwNJzSCiODBvJNI6hWPX2NSaBQ7B/1UAmTwoupOY1N6T3SX/dQJjhV/uHsd+3lt8otlnwAKXez+eU",
+	"MGzRdmZzjwkUMMaWyr/XcqseeJkHpZ9rhull6fueB0PPlxebSimw2eUaiE81xtkNKMYnRlFu8Vq5OKIT",
+	"ITM91lHCiuPZ30bCAsfBn5mEeRdebCRhjjDDr844bCRhzqh1kDAfvGYJ82D4viWsfH1JKyGjeCsDLihZ",
+	"77A65Oh/Lt6fNYhSGSw9V37goM5uEUfALFdAFXFUgcj5BC3g/HNyetIJHD1wDThLZcslTeBY93K96iku",
+	"VVjHzHpl57SaE0x5P6xh6asUi5XH00Qtp/mIAA+Hq9cB/n1QxRe4QiLApP4hG5p101VIUB1SkCKLukzE",
+	"IZtQb2+/ucgaY50X/JZHqwfbb3a9RX2DbjUw08vd1lA+/gYgPDUdZOvIgOEbn7YhstaFbPjVS7SsNyP+",
+	"3T1rhY7ymTk2mzJylZbPfzVblHLep5NFaTyIcNuvZd64bYfnic3jQypdU2vWtGvCOFerCGkHM8M99cLu",
+	"g/FM8C6lZ8CylskAvC/DDhOYSpvhNMqnRWt90CNNn/MzYNxPXUztUyOqoYXX+T5PmW0cz8r39yW2wDKN",
+	"u1H73Az9Qe5HJLelxmPS27vjsoMjaM9Ld3EHH4G4zUe2HtUvrJwRfyYhcHYqzRYzmnzQruwx/Ora3nIX",
+	"pgOzmBrg0+OVfkvBp2H5Yu8dlw/Wgx6VS8t918+LSW097O48qqBQnSxWcfzvuRisRwj7akcgb8uVDg3s",
+	"7XM0lq4n7zGNZX5KqYutzA8EPx1Ga222+SbJlcrFi89EUfk3RPvXbD8ES/Gko+5yR0i/Z9VVOUX7d9Fc",
+	"EZGPrbqUgEzO3fXozVw2ccOeTfrpkVit3pnwd+G1jBFy54sDaK+ys/WVNdxl83brLGB2K/AjFyprlw8H",
+	"kGBykNTdu/50DEoOVYFue5t7e13AeG8Te7zxMfi+fuv+X1kfcFfgP5fqALS/uCBUfmNrmbJVMRp+Nd3m",
+	"m5QFHOk30sr+kcqAOs5h6KiMmzrkA4F14CaeQLq/9nMK/rr3urrneda7s8S94SZXRdmMmWzKvt3Um1z9",
+	"U2anT49Z9vQzJbfPtxBwB9awKeVOqf0f3PFcucPVDe7AHverEhQnSbpXCp6iufpRsvirfOLWusW9GXjD",
+	"OkbBzp1rGd8pN/8oqjxJMQpWVh5YivR3M4o3DmM8wcp/qOOHdD0x6eo3n11rQnnGC51x3vADPM85dqvL",
+	"oPRYvJbw2cQI/ZCVH7LyrUt3Lb859myNYqtAJmmTQHq/MfVDKO+8+Pcikg+foFj7G2d/lzpV8YNsG0hu",
+	"u0/brXvDu+P4e0q0b5QV+wb25pk2ihQXggW409wQIK4zbiofAF/xdCviMSTMHP/uaSS7CRp/K6T9xHnE",
+	"0T2PmQ+vUoIuB7bDzla5BjL/WdwSW/VCytbdnv1NgHTg5W8Hyv2WrCd+ASCz84P5uOzB7afbfwcAAP//",
+	"yGqfdRGAAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
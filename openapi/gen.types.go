@@ -31,6 +31,8 @@ const (
 	TaskTaskModeFull TaskTaskMode = "full"
 
 	TaskTaskModeIncremental TaskTaskMode = "incremental"
+
+	TaskTaskModeSchemaOnly TaskTaskMode = "schema-only"
 )
 
 // ClusterMaster defines model for ClusterMaster.
@@ -353,7 +355,7 @@ type Task struct {
 	// downstream database configuration
 	TargetConfig TaskTargetDataBase `json:"target_config"`
 
-	// migrate mode
+	// migrate mode, `schema-only` only creates the schemas and tables in downstream
 	TaskMode TaskTaskMode `json:"task_mode"`
 }
 
@@ -368,7 +370,7 @@ type TaskOnDuplicate string
 // the way to coordinate DDL
 type TaskShardMode string
 
-// migrate mode
+// migrate mode, `schema-only` only creates the schemas and tables in downstream
 type TaskTaskMode string
 
 // Filtering rules at binlog level
@@ -0,0 +1,104 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"sync"
+	"time"
+)
+
+// IdleThreshold is the max duration without receiving any binlog event (including heartbeats)
+// before the binlog connection to the upstream is considered suspect.
+var IdleThreshold = 3 * MasterHeartbeatPeriod
+
+// IdleDetector detects whether a binlog connection has received no event for too long.
+// all methods of a nil IdleDetector do nothing, so the detection can be disabled by passing nil.
+type IdleDetector struct {
+	mu        sync.RWMutex
+	threshold time.Duration
+	// the time when the last event received, zero if no event received yet.
+	lastEventTime time.Time
+	// the beginning of the current detection period, updated when an event received or the connection marked suspect.
+	since   time.Time
+	suspect bool
+}
+
+// NewIdleDetector creates a new IdleDetector.
+func NewIdleDetector(threshold time.Duration) *IdleDetector {
+	return &IdleDetector{
+		threshold: threshold,
+		since:     time.Now(),
+	}
+}
+
+// Mark records that an event is received at `now`.
+func (d *IdleDetector) Mark(now time.Time) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.lastEventTime = now
+	d.since = now
+	d.suspect = false
+}
+
+// Check returns true if no event is received for the threshold since the last event or the last time it returned true,
+// the connection is marked suspect until the next event received.
+func (d *IdleDetector) Check(now time.Time) bool {
+	if d == nil || d.threshold <= 0 {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if now.Sub(d.since) < d.threshold {
+		return false
+	}
+	d.since = now
+	d.suspect = true
+	return true
+}
+
+// Suspect returns whether the connection is suspect.
+func (d *IdleDetector) Suspect() bool {
+	if d == nil {
+		return false
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.suspect
+}
+
+// LastEventTime returns the time when the last event received, zero if no event received yet.
+func (d *IdleDetector) LastEventTime() time.Time {
+	if d == nil {
+		return time.Time{}
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.lastEventTime
+}
+
+// IdleDuration returns the duration since the last event received, zero if no event received yet.
+func (d *IdleDetector) IdleDuration(now time.Time) time.Duration {
+	if d == nil {
+		return 0
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.lastEventTime.IsZero() {
+		return 0
+	}
+	return now.Sub(d.lastEventTime)
+}
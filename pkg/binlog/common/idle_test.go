@@ -0,0 +1,59 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testCommonSuite) TestIdleDetector(c *C) {
+	// nil detector disables the detection.
+	var nilDetector *IdleDetector
+	nilDetector.Mark(time.Now())
+	c.Assert(nilDetector.Check(time.Now()), IsFalse)
+	c.Assert(nilDetector.Suspect(), IsFalse)
+	c.Assert(nilDetector.LastEventTime().IsZero(), IsTrue)
+	c.Assert(nilDetector.IdleDuration(time.Now()), Equals, time.Duration(0))
+
+	threshold := time.Minute
+	d := NewIdleDetector(threshold)
+	start := d.since
+	c.Assert(d.LastEventTime().IsZero(), IsTrue)
+	c.Assert(d.IdleDuration(start.Add(time.Hour)), Equals, time.Duration(0))
+
+	// no event received since created.
+	c.Assert(d.Check(start.Add(threshold/2)), IsFalse)
+	c.Assert(d.Suspect(), IsFalse)
+	c.Assert(d.Check(start.Add(threshold)), IsTrue)
+	c.Assert(d.Suspect(), IsTrue)
+	// only report again after another threshold.
+	c.Assert(d.Check(start.Add(threshold*3/2)), IsFalse)
+	c.Assert(d.Check(start.Add(threshold*2)), IsTrue)
+
+	// receive an event.
+	now := start.Add(threshold * 3)
+	d.Mark(now)
+	c.Assert(d.Suspect(), IsFalse)
+	c.Assert(d.LastEventTime(), Equals, now)
+	c.Assert(d.IdleDuration(now.Add(time.Second)), Equals, time.Second)
+	c.Assert(d.Check(now.Add(threshold-time.Second)), IsFalse)
+	c.Assert(d.Check(now.Add(threshold)), IsTrue)
+	c.Assert(d.Suspect(), IsTrue)
+
+	// zero threshold disables the detection.
+	d = NewIdleDetector(0)
+	c.Assert(d.Check(time.Now().Add(time.Hour)), IsFalse)
+}
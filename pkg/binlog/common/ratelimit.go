@@ -0,0 +1,67 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"golang.org/x/time/rate"
+)
+
+// NetRateLimiter throttles the bandwidth of reading binlog events from the upstream.
+// the binlog events are buffered in go-mysql and the socket, so blocking the reading of events
+// also slows down the reading from the network after the buffers are full.
+// all methods of a nil NetRateLimiter do nothing, so the limit can be disabled by passing nil.
+type NetRateLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewNetRateLimiter creates a new NetRateLimiter with the limit of `mbPerSecond` MB/s, returns nil if no limit.
+func NewNetRateLimiter(mbPerSecond int64) *NetRateLimiter {
+	if mbPerSecond <= 0 {
+		return nil
+	}
+	bytesPerSecond := int(mbPerSecond * 1024 * 1024)
+	// allow to burst one second of data.
+	return &NetRateLimiter{limiter: rate.NewLimiter(rate.Limit(bytesPerSecond), bytesPerSecond)}
+}
+
+// WaitEvent blocks until the binlog event can be read from the upstream, or the context is done.
+func (l *NetRateLimiter) WaitEvent(ctx context.Context, ev *replication.BinlogEvent) error {
+	if l == nil || ev == nil || ev.Header == nil {
+		return nil
+	}
+	return l.Wait(ctx, int(ev.Header.EventSize))
+}
+
+// Wait blocks until `n` bytes can be read from the upstream, or the context is done.
+func (l *NetRateLimiter) Wait(ctx context.Context, n int) error {
+	if l == nil {
+		return nil
+	}
+	burst := l.limiter.Burst()
+	// a binlog event may be larger than the burst, wait for it in pieces.
+	for n > 0 {
+		m := n
+		if m > burst {
+			m = burst
+		}
+		if err := l.limiter.WaitN(ctx, m); err != nil {
+			return err
+		}
+		n -= m
+	}
+	return nil
+}
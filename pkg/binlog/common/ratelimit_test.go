@@ -0,0 +1,46 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+)
+
+func (t *testCommonSuite) TestNetRateLimiter(c *C) {
+	ctx := context.Background()
+
+	// no limit.
+	c.Assert(NewNetRateLimiter(0), IsNil)
+	c.Assert(NewNetRateLimiter(-1), IsNil)
+	var nilLimiter *NetRateLimiter
+	c.Assert(nilLimiter.Wait(ctx, 1024*1024*1024), IsNil)
+	c.Assert(nilLimiter.WaitEvent(ctx, &replication.BinlogEvent{}), IsNil)
+
+	l := NewNetRateLimiter(1)
+	c.Assert(l.limiter.Burst(), Equals, 1024*1024)
+
+	// the burst can be read immediately.
+	start := time.Now()
+	c.Assert(l.WaitEvent(ctx, &replication.BinlogEvent{Header: &replication.EventHeader{EventSize: 1024 * 1024}}), IsNil)
+	c.Assert(time.Since(start), Less, 100*time.Millisecond)
+
+	// an event larger than the burst is throttled instead of failing, and canceling the context breaks the waiting.
+	ctx2, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	c.Assert(l.Wait(ctx2, 3*1024*1024), NotNil)
+}
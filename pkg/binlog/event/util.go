@@ -346,6 +346,15 @@ func getSQLMode(statusVars []byte) (mysql.SQLMode, error) {
 	return mysql.SQLMode(v), err
 }
 
+// GenSQLModeStatusVars generates the status_vars of a query event which only contains the sql_mode,
+// so the query generated by DM can be parsed with the same sql_mode as it's checked.
+func GenSQLModeStatusVars(mode mysql.SQLMode) []byte {
+	buf := make([]byte, 1+statusVarsFixedLength[QSqlModeCode])
+	buf[0] = QSqlModeCode
+	binary.LittleEndian.PutUint64(buf[1:], uint64(mode))
+	return buf
+}
+
 // GetParserForStatusVars gets a parser for binlog which is suitable for its sql_mode in statusVars.
 func GetParserForStatusVars(statusVars []byte) (*parser.Parser, error) {
 	parser2 := parser.New()
@@ -20,6 +20,8 @@ import (
 	"io"
 
 	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser/mysql"
+	_ "github.com/pingcap/tidb/types/parser_driver"
 
 	"github.com/pingcap/dm/pkg/terror"
 )
@@ -125,3 +127,32 @@ func (t *testUtilSuite) TestStatusVarsToKV(c *C) {
 		c.Assert(vars, DeepEquals, t.output)
 	}
 }
+
+func (t *testUtilSuite) TestGetParserForStatusVars(c *C) {
+	var (
+		ansiDDL      = `CREATE TABLE "db"."tb" ("c" VARCHAR(10) DEFAULT 'a')`
+		backslashDDL = `CREATE TABLE db.tb (c VARCHAR(10) DEFAULT 'a\')`
+	)
+
+	// no sql_mode in status_vars, like the events generated by DM before.
+	p, err := GetParserForStatusVars(nil)
+	c.Assert(err, NotNil)
+	_, err = p.ParseOneStmt(ansiDDL, "", "")
+	c.Assert(err, NotNil)
+
+	statusVars := GenSQLModeStatusVars(mysql.ModeANSIQuotes | mysql.ModeNoBackslashEscapes)
+	vars, err := statusVarsToKV(statusVars)
+	c.Assert(err, IsNil)
+	c.Assert(vars, HasLen, 1)
+	mode, err := getSQLMode(statusVars)
+	c.Assert(err, IsNil)
+	c.Assert(mode, Equals, mysql.ModeANSIQuotes|mysql.ModeNoBackslashEscapes)
+
+	p, err = GetParserForStatusVars(statusVars)
+	c.Assert(err, IsNil)
+	_, err = p.ParseOneStmt(ansiDDL, "", "")
+	c.Assert(err, IsNil)
+	// the trailing backslash doesn't escape the quote.
+	_, err = p.ParseOneStmt(backslashDDL, "", "")
+	c.Assert(err, IsNil)
+}
@@ -103,6 +103,10 @@ func GetGTIDsForPosFromStreamer(ctx context.Context, r Streamer, endPos gmysql.P
 			}
 			latestGSet = gSet.Origin()
 			flavor = gmysql.MySQLFlavor
+			if endPos.Pos <= e.Header.LogPos {
+				// the position is in the header of the file, like the beginning of the file
+				latestPos = endPos.Pos
+			}
 		case *replication.MariadbGTIDListEvent:
 			// a MariadbGTIDListEvent logged in every binlog to record the current replication state if GTID enabled
 			// ref: https://mariadb.com/kb/en/library/gtid_list_event/
@@ -112,6 +116,10 @@ func GetGTIDsForPosFromStreamer(ctx context.Context, r Streamer, endPos gmysql.P
 			}
 			latestGSet = gSet.Origin()
 			flavor = gmysql.MariaDBFlavor
+			if endPos.Pos <= e.Header.LogPos {
+				// the position is in the header of the file, like the beginning of the file
+				latestPos = endPos.Pos
+			}
 		}
 
 		if latestPos == endPos.Pos {
@@ -131,6 +139,72 @@ func GetGTIDsForPosFromStreamer(ctx context.Context, r Streamer, endPos gmysql.P
 	}
 }
 
+// GetFirstEventTimestamp gets the timestamp of the first event (FormatDescriptionEvent) in a binlog file from a Streamer,
+// which should start from the beginning of the file. fake RotateEvents are skipped.
+func GetFirstEventTimestamp(ctx context.Context, r Streamer) (uint32, error) {
+	for {
+		e, err := r.GetEvent(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if e.Header.EventType == replication.ROTATE_EVENT && utils.IsFakeRotateEvent(e.Header) {
+			continue
+		}
+		return e.Header.Timestamp, nil
+	}
+}
+
+// GetTxnPosForTimestamp tries to get the end position of the last transaction before the first transaction whose events
+// are written at or after the timestamp from a Streamer, which should start from the beginning of `endPos.Name`.
+// endPos.Pos is the end position of the file (like the position of SHOW MASTER STATUS for the latest file), it returns
+// endPos if all events before it are written before the timestamp, and returns false if the Streamer rotates to the
+// next file before finding such transaction.
+func GetTxnPosForTimestamp(ctx context.Context, r Streamer, ts uint32, endPos gmysql.Position) (gmysql.Position, bool, error) {
+	latestPos := gmysql.Position{Name: endPos.Name, Pos: 4}
+	for {
+		e, err := r.GetEvent(ctx)
+		if err != nil {
+			return latestPos, false, err
+		}
+
+		// NOTE: only update latestPos for DDL/XID to get an complete transaction.
+		switch ev := e.Event.(type) {
+		case *replication.RotateEvent:
+			if utils.IsFakeRotateEvent(e.Header) {
+				continue
+			}
+			return latestPos, false, nil
+		case *replication.FormatDescriptionEvent, *replication.PreviousGTIDsEvent, *replication.MariadbGTIDListEvent:
+			// header events of the file, skip them whatever their timestamps are
+		case *replication.QueryEvent:
+			if e.Header.Timestamp >= ts {
+				return latestPos, true, nil
+			}
+			parser2, err2 := event.GetParserForStatusVars(ev.StatusVars)
+			if err2 != nil {
+				log.L().Warn("found error when get sql_mode from binlog status_vars", zap.Error(err2))
+			}
+			if common.CheckIsDDL(string(ev.Query), parser2) {
+				latestPos.Pos = e.Header.LogPos
+			}
+		case *replication.XIDEvent:
+			if e.Header.Timestamp >= ts {
+				return latestPos, true, nil
+			}
+			latestPos.Pos = e.Header.LogPos
+		default:
+			if e.Header.Timestamp >= ts {
+				return latestPos, true, nil
+			}
+		}
+
+		if e.Header.LogPos >= endPos.Pos {
+			// all events before endPos are written before the timestamp
+			return endPos, true, nil
+		}
+	}
+}
+
 // GetGTIDsForPos tries to get GTID sets for the specified binlog position (for the corresponding txn).
 // NOTE: this method is very similar with `relay/writer/file_util.go/getTxnPosGTIDs`, unify them if needed later.
 // NOTE: this method is not well tested directly, but more tests have already been done for `relay/writer/file_util.go/getTxnPosGTIDs`.
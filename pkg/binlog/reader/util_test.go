@@ -0,0 +1,137 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"io"
+	"math"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/pkg/binlog/event"
+	"github.com/pingcap/dm/pkg/gtid"
+)
+
+var _ = Suite(&testUtilSuite{})
+
+type testUtilSuite struct{}
+
+// sliceStreamer is a Streamer which returns the events in a slice, and returns io.EOF at the end.
+type sliceStreamer struct {
+	events []*replication.BinlogEvent
+}
+
+func (s *sliceStreamer) GetEvent(_ context.Context) (*replication.BinlogEvent, error) {
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	e := s.events[0]
+	s.events = s.events[1:]
+	return e, nil
+}
+
+func (t *testUtilSuite) TestGetTxnPosForTimestamp(c *C) {
+	var (
+		ctx      = context.Background()
+		flavor   = gmysql.MySQLFlavor
+		serverID = uint32(101)
+		fileName = "mysql-bin.000001"
+	)
+	gSet, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14")
+	c.Assert(err, IsNil)
+	latestGTID, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:14")
+	c.Assert(err, IsNil)
+
+	// header events at 100, DDL at 200, DML transaction at 300, DDL at 400
+	events, _, err := event.GenCommonFileHeader(flavor, serverID, gSet)
+	c.Assert(err, IsNil)
+	fakeRotate, err := event.GenRotateEvent(&replication.EventHeader{ServerID: serverID}, 0, []byte(fileName), 4)
+	c.Assert(err, IsNil)
+	events = append([]*replication.BinlogEvent{fakeRotate}, events...)
+	for _, e := range events[1:] {
+		e.Header.Timestamp = 100
+	}
+	latestPos := events[len(events)-1].Header.LogPos
+
+	result, err := event.GenCreateDatabaseEvents(flavor, serverID, latestPos, latestGTID, "db")
+	c.Assert(err, IsNil)
+	for _, e := range result.Events {
+		e.Header.Timestamp = 200
+	}
+	events = append(events, result.Events...)
+	ddl1End := result.LatestPos
+
+	header := &replication.EventHeader{Timestamp: 300, ServerID: serverID, Flags: 0x01}
+	gtidEv, err := event.GenCommonGTIDEvent(flavor, serverID, result.LatestPos, result.LatestGTID)
+	c.Assert(err, IsNil)
+	gtidEv.Header.Timestamp = 300
+	beginEv, err := event.GenQueryEvent(header, gtidEv.Header.LogPos, 0, 0, 0, nil, []byte("db"), []byte("BEGIN"))
+	c.Assert(err, IsNil)
+	xidEv, err := event.GenXIDEvent(header, beginEv.Header.LogPos, 10)
+	c.Assert(err, IsNil)
+	events = append(events, gtidEv, beginEv, xidEv)
+	dmlEnd := xidEv.Header.LogPos
+
+	latestGTID, err = gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:16")
+	c.Assert(err, IsNil)
+	result, err = event.GenCreateDatabaseEvents(flavor, serverID, dmlEnd, latestGTID, "db2")
+	c.Assert(err, IsNil)
+	for _, e := range result.Events {
+		e.Header.Timestamp = 400
+	}
+	events = append(events, result.Events...)
+	fileEnd := result.LatestPos
+
+	// first event timestamp
+	ts, err := GetFirstEventTimestamp(ctx, &sliceStreamer{events: events})
+	c.Assert(err, IsNil)
+	c.Assert(ts, Equals, uint32(100))
+
+	cases := []struct {
+		ts  uint32
+		pos uint32
+	}{
+		{50, 4},
+		{150, 4},
+		{200, 4},
+		{250, ddl1End},
+		{300, ddl1End},
+		{350, dmlEnd},
+		{500, fileEnd},
+	}
+	endPos := gmysql.Position{Name: fileName, Pos: fileEnd}
+	for _, cs := range cases {
+		pos, found, err2 := GetTxnPosForTimestamp(ctx, &sliceStreamer{events: events}, cs.ts, endPos)
+		c.Assert(err2, IsNil)
+		c.Assert(found, IsTrue)
+		c.Assert(pos, DeepEquals, gmysql.Position{Name: fileName, Pos: cs.pos}, Commentf("ts %d", cs.ts))
+	}
+
+	// rotate to the next file
+	rotateEv, err := event.GenRotateEvent(&replication.EventHeader{Timestamp: 400, ServerID: serverID}, fileEnd, []byte("mysql-bin.000002"), 4)
+	c.Assert(err, IsNil)
+	endPos.Pos = math.MaxUint32
+	pos, found, err := GetTxnPosForTimestamp(ctx, &sliceStreamer{events: append(events, rotateEv)}, 500, endPos)
+	c.Assert(err, IsNil)
+	c.Assert(found, IsFalse)
+	c.Assert(pos.Pos, Equals, fileEnd)
+
+	// GTID sets of the beginning of the file are the previous GTID sets
+	gs, err := GetGTIDsForPosFromStreamer(ctx, &sliceStreamer{events: events}, gmysql.Position{Name: fileName, Pos: 4})
+	c.Assert(err, IsNil)
+	c.Assert(gs.String(), Equals, gSet.String())
+}
@@ -74,6 +74,15 @@ func GetBinaryLogs(ctx context.Context, db *sql.DB) (FileSizes, error) {
 	return files, nil
 }
 
+// Names returns the binlog filenames in FileSizes.
+func (b FileSizes) Names() []string {
+	names := make([]string, 0, len(b))
+	for _, file := range b {
+		names = append(names, file.name)
+	}
+	return names
+}
+
 // After returns the total size of binlog after `fromFile` in FileSizes.
 func (b FileSizes) After(fromFile gmysql.Position) int64 {
 	var total int64
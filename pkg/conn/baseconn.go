@@ -72,6 +72,9 @@ type BaseConn struct {
 	DBConn *sql.Conn
 
 	RetryStrategy retry.Strategy
+
+	// prefixed to the statements to label them, set from the SessionLabel of the BaseDB
+	comment string
 }
 
 // NewBaseConn builds BaseConn to connect real DB.
@@ -79,7 +82,7 @@ func NewBaseConn(conn *sql.Conn, strategy retry.Strategy) *BaseConn {
 	if strategy == nil {
 		strategy = &retry.FiniteRetryStrategy{}
 	}
-	return &BaseConn{conn, strategy}
+	return &BaseConn{DBConn: conn, RetryStrategy: strategy}
 }
 
 // SetRetryStrategy set retry strategy for baseConn.
@@ -100,7 +103,7 @@ func (conn *BaseConn) QuerySQL(tctx *tcontext.Context, query string, args ...int
 		zap.String("query", utils.TruncateString(query, -1)),
 		zap.String("argument", utils.TruncateInterface(args, -1)))
 
-	rows, err := conn.DBConn.QueryContext(tctx.Context(), query, args...)
+	rows, err := conn.DBConn.QueryContext(tctx.Context(), conn.comment+query, args...)
 	if err != nil {
 		tctx.L().ErrorFilterContextCanceled("query statement failed",
 			zap.String("query", utils.TruncateString(query, -1)),
@@ -167,7 +170,7 @@ func (conn *BaseConn) ExecuteSQLWithIgnoreError(tctx *tcontext.Context, hVec *me
 		}
 
 		startTime = time.Now()
-		_, err = txn.ExecContext(tctx.Context(), query, arg...)
+		_, err = txn.ExecContext(tctx.Context(), conn.comment+query, arg...)
 		if err == nil {
 			if hVec != nil {
 				hVec.WithLabelValues("stmt", task).Observe(time.Since(startTime).Seconds())
@@ -64,7 +64,7 @@ func (t *testBaseConnSuite) TestBaseConn(c *C) {
 	dbConn, err := db.Conn(tctx.Context())
 	c.Assert(err, IsNil)
 
-	baseConn = &BaseConn{dbConn, nil}
+	baseConn = &BaseConn{DBConn: dbConn}
 
 	err = baseConn.SetRetryStrategy(&retry.FiniteRetryStrategy{})
 	c.Assert(err, IsNil)
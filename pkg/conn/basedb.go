@@ -18,6 +18,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"strconv"
 	"sync"
@@ -32,6 +33,7 @@ import (
 	"github.com/pingcap/dm/pkg/utils"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
 	toolutils "github.com/pingcap/tidb-tools/pkg/utils"
 )
 
@@ -56,11 +58,31 @@ func init() {
 var mockDB sqlmock.Sqlmock
 
 // Apply will build BaseDB with DBConfig.
-func (d *DefaultDBProviderImpl) Apply(config config.DBConfig) (*BaseDB, error) {
+func (d *DefaultDBProviderImpl) Apply(config config.DBConfig) (baseDB *BaseDB, err error) {
 	// maxAllowedPacket=0 can be used to automatically fetch the max_allowed_packet variable from server on every connection.
 	// https://github.com/go-sql-driver/mysql#maxallowedpacket
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/?charset=utf8mb4&interpolateParams=true&maxAllowedPacket=0",
-		config.User, config.Password, config.Host, config.Port)
+	maxAllowedPacket := 0
+	if config.MaxAllowedPacket != nil {
+		maxAllowedPacket = *config.MaxAllowedPacket
+	}
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	// connect to the local address of the tunnel if configured, but keep `addr` for metrics.
+	dialAddr := addr
+	var tunnel *Tunnel
+	if config.Tunnel != nil {
+		tunnel, err = NewTunnel(config.Tunnel, config.Host, config.Port)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err != nil {
+				tunnel.Close()
+			}
+		}()
+		dialAddr = tunnel.Addr().String()
+	}
+	dsn := fmt.Sprintf("%s:%s@tcp(%s)/?charset=utf8mb4&interpolateParams=true&maxAllowedPacket=%d",
+		config.User, config.Password, dialAddr, maxAllowedPacket)
 
 	doFuncInClose := func() {}
 	if config.Security != nil {
@@ -77,6 +99,10 @@ func (d *DefaultDBProviderImpl) Apply(config config.DBConfig) (*BaseDB, error) {
 		if config.Host == "127.0.0.1" {
 			tlsConfig.InsecureSkipVerify = true
 		}
+		// verify the certificate of the database instead of the local address of the tunnel.
+		if tunnel != nil && tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = config.Host
+		}
 
 		name := "dm" + strconv.FormatInt(atomic.AddInt64(&customID, 1), 10)
 		err = mysql.RegisterTLSConfig(name, tlsConfig)
@@ -89,18 +115,43 @@ func (d *DefaultDBProviderImpl) Apply(config config.DBConfig) (*BaseDB, error) {
 			mysql.DeregisterTLSConfig(name)
 		}
 	}
+	if tunnel != nil {
+		closeTLS := doFuncInClose
+		doFuncInClose = func() {
+			closeTLS()
+			tunnel.Close()
+		}
+	}
 
-	var maxIdleConns int
+	var (
+		maxIdleConns              int
+		readTimeout, writeTimeout string
+	)
 	rawCfg := config.RawDBCfg
 	if rawCfg != nil {
-		if rawCfg.ReadTimeout != "" {
-			dsn += fmt.Sprintf("&readTimeout=%s", rawCfg.ReadTimeout)
-		}
-		if rawCfg.WriteTimeout != "" {
-			dsn += fmt.Sprintf("&writeTimeout=%s", rawCfg.WriteTimeout)
-		}
+		readTimeout = rawCfg.ReadTimeout
+		writeTimeout = rawCfg.WriteTimeout
 		maxIdleConns = rawCfg.MaxIdleConns
 	}
+	// the options set by users override the values chosen by DM.
+	if config.ReadTimeout != "" {
+		readTimeout = config.ReadTimeout
+	}
+	if config.WriteTimeout != "" {
+		writeTimeout = config.WriteTimeout
+	}
+	if config.MaxIdleConns > 0 {
+		maxIdleConns = config.MaxIdleConns
+	}
+	if readTimeout != "" {
+		dsn += fmt.Sprintf("&readTimeout=%s", readTimeout)
+	}
+	if writeTimeout != "" {
+		dsn += fmt.Sprintf("&writeTimeout=%s", writeTimeout)
+	}
+	if config.DialTimeout != "" {
+		dsn += fmt.Sprintf("&timeout=%s", config.DialTimeout)
+	}
 
 	for key, val := range config.Session {
 		// for num such as 1/"1", format as key='1'
@@ -127,14 +178,22 @@ func (d *DefaultDBProviderImpl) Apply(config config.DBConfig) (*BaseDB, error) {
 		err = errors.New("injected error")
 	})
 	if err != nil {
+		dialFailureCounter.WithLabelValues(addr).Inc()
 		db.Close()
 		doFuncInClose()
 		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
 	}
 
 	db.SetMaxIdleConns(maxIdleConns)
+	if config.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(config.MaxOpenConns)
+	}
 
-	return NewBaseDB(db, doFuncInClose), nil
+	baseDB = NewBaseDB(db, doFuncInClose)
+	baseDB.addr = addr
+	baseDB.label = config.SessionLabel
+	poolStats.add(baseDB)
+	return baseDB, nil
 }
 
 // BaseDB wraps *sql.DB, control the BaseConn.
@@ -149,6 +208,11 @@ type BaseDB struct {
 
 	// this function will do when close the BaseDB
 	doFuncInClose func()
+
+	// the address of the database, used in metrics, empty if the BaseDB is not created by DefaultDBProvider
+	addr string
+	// label of the connections, nil if they are not labeled
+	label *config.SessionLabel
 }
 
 // NewBaseDB returns *BaseDB object.
@@ -161,19 +225,36 @@ func NewBaseDB(db *sql.DB, doFuncInClose func()) *BaseDB {
 func (d *BaseDB) GetBaseConn(ctx context.Context) (*BaseConn, error) {
 	conn, err := d.DB.Conn(ctx)
 	if err != nil {
+		d.observeDialFailure()
 		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
 	}
 	err = conn.PingContext(ctx)
 	if err != nil {
+		d.observeDialFailure()
 		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
 	}
+	if d.label != nil && d.label.ResourceGroup != "" {
+		if _, err = conn.ExecContext(ctx, "SET RESOURCE GROUP "+dbutil.ColumnName(d.label.ResourceGroup)); err != nil {
+			conn.Close()
+			return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+		}
+	}
 	baseConn := NewBaseConn(conn, d.Retry)
+	if d.label != nil {
+		baseConn.comment = d.label.Comment
+	}
 	d.mu.Lock()
 	defer d.mu.Unlock()
 	d.conns[baseConn] = struct{}{}
 	return baseConn, nil
 }
 
+func (d *BaseDB) observeDialFailure() {
+	if d.addr != "" {
+		dialFailureCounter.WithLabelValues(d.addr).Inc()
+	}
+}
+
 // CloseBaseConn release BaseConn resource from BaseDB, and close BaseConn.
 func (d *BaseDB) CloseBaseConn(conn *BaseConn) error {
 	d.mu.Lock()
@@ -196,6 +277,7 @@ func (d *BaseDB) Close() error {
 			err = terr
 		}
 	}
+	poolStats.remove(d)
 	terr := d.DB.Close()
 	d.doFuncInClose()
 
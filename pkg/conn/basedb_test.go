@@ -14,10 +14,14 @@
 package conn
 
 import (
+	"errors"
+	"regexp"
+
 	. "github.com/pingcap/check"
 	"github.com/pingcap/failpoint"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
 	"github.com/pingcap/dm/dm/config"
 	tcontext "github.com/pingcap/dm/pkg/context"
@@ -62,6 +66,40 @@ func (t *testBaseDBSuite) TestGetBaseConn(c *C) {
 	c.Assert(baseDB.Close(), IsNil)
 }
 
+func (t *testBaseDBSuite) TestSessionLabel(c *C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	baseDB := NewBaseDB(db, func() {})
+	baseDB.label = &config.SessionLabel{Comment: "/* dm-task:test */ ", ResourceGroup: "rg_dm"}
+	tctx := tcontext.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("SET RESOURCE GROUP `rg_dm`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbConn, err := baseDB.GetBaseConn(tctx.Context())
+	c.Assert(err, IsNil)
+
+	mock.ExpectQuery(regexp.QuoteMeta("/* dm-task:test */ select 1")).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1"))
+	// nolint:sqlclosecheck
+	rows, err := dbConn.QuerySQL(tctx, "select 1")
+	c.Assert(err, IsNil)
+	c.Assert(rows.Close(), IsNil)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("/* dm-task:test */ create database test")).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	_, err = dbConn.ExecuteSQL(tctx, testStmtHistogram, "test", []string{"create database test"})
+	c.Assert(err, IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the connection is not returned if the resource group can't be set.
+	baseDB.label.ResourceGroup = "not_exist"
+	mock.ExpectExec(regexp.QuoteMeta("SET RESOURCE GROUP `not_exist`")).WillReturnError(errors.New("unknown resource group"))
+	_, err = baseDB.GetBaseConn(tctx.Context())
+	c.Assert(err, ErrorMatches, ".*unknown resource group.*")
+	// the pooled connection and the DB are closed.
+	mock.ExpectClose()
+	mock.ExpectClose()
+	c.Assert(baseDB.Close(), IsNil)
+}
+
 func (t *testBaseDBSuite) TestFailDBPing(c *C) {
 	c.Assert(failpoint.Enable("github.com/pingcap/dm/pkg/conn/failDBPing", "return"), IsNil)
 	//nolint:errcheck
@@ -76,3 +114,25 @@ func (t *testBaseDBSuite) TestFailDBPing(c *C) {
 	err = mockDB.ExpectationsWereMet()
 	c.Assert(err, IsNil)
 }
+
+func (t *testBaseDBSuite) TestConnMetrics(c *C) {
+	// the port is not listened, so the connection is refused.
+	cfg := config.DBConfig{User: "root", Host: "127.0.0.1", Port: 1, DialTimeout: "1s"}
+	cfg.Adjust()
+	_, err := DefaultDBProvider.Apply(cfg)
+	c.Assert(err, NotNil)
+	c.Assert(testutil.ToFloat64(dialFailureCounter.WithLabelValues("127.0.0.1:1")), Equals, float64(1))
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	baseDB := NewBaseDB(db, func() {})
+	baseDB.addr = "127.0.0.1:4000"
+	poolStats.add(baseDB)
+	_, err = baseDB.GetBaseConn(tcontext.Background().Context())
+	c.Assert(err, IsNil)
+	c.Assert(testutil.CollectAndCount(poolStats), Equals, 5)
+
+	mock.ExpectClose()
+	c.Assert(baseDB.Close(), IsNil)
+	c.Assert(testutil.CollectAndCount(poolStats), Equals, 0)
+}
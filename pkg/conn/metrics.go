@@ -0,0 +1,117 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conn
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pingcap/dm/pkg/metricsproxy"
+)
+
+var (
+	dialFailureCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "conn",
+			Name:      "dial_failure",
+			Help:      "number of failures when establishing database connections",
+		}, []string{"addr"})
+
+	poolStats = &poolStatsCollector{
+		dbs: make(map[*BaseDB]struct{}),
+		openConnsDesc: prometheus.NewDesc("dm_conn_pool_open_connections",
+			"number of established connections of the connection pools, both in use and idle", []string{"addr"}, nil),
+		inUseDesc: prometheus.NewDesc("dm_conn_pool_in_use_connections",
+			"number of connections currently in use of the connection pools", []string{"addr"}, nil),
+		idleDesc: prometheus.NewDesc("dm_conn_pool_idle_connections",
+			"number of idle connections of the connection pools", []string{"addr"}, nil),
+		waitCountDesc: prometheus.NewDesc("dm_conn_pool_wait_count",
+			"total number of connections waited for of the alive connection pools", []string{"addr"}, nil),
+		waitDurationDesc: prometheus.NewDesc("dm_conn_pool_wait_duration_seconds",
+			"total time blocked waiting for a new connection of the alive connection pools", []string{"addr"}, nil),
+	}
+)
+
+// RegisterMetrics registers metrics.
+func RegisterMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(dialFailureCounter)
+	registry.MustRegister(poolStats)
+}
+
+// poolStatsCollector collects the stats of the alive connection pools created by DefaultDBProvider, the pools
+// connected to the same address are summed up.
+type poolStatsCollector struct {
+	mu  sync.Mutex
+	dbs map[*BaseDB]struct{}
+
+	openConnsDesc    *prometheus.Desc
+	inUseDesc        *prometheus.Desc
+	idleDesc         *prometheus.Desc
+	waitCountDesc    *prometheus.Desc
+	waitDurationDesc *prometheus.Desc
+}
+
+func (c *poolStatsCollector) add(db *BaseDB) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dbs[db] = struct{}{}
+}
+
+func (c *poolStatsCollector) remove(db *BaseDB) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.dbs, db)
+}
+
+// Describe implements prometheus.Collector.
+func (c *poolStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.openConnsDesc
+	ch <- c.inUseDesc
+	ch <- c.idleDesc
+	ch <- c.waitCountDesc
+	ch <- c.waitDurationDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *poolStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	type stats struct {
+		open, inUse, idle, waitCount, waitSeconds float64
+	}
+	c.mu.Lock()
+	statsByAddr := make(map[string]*stats)
+	for db := range c.dbs {
+		s := db.DB.Stats()
+		st, ok := statsByAddr[db.addr]
+		if !ok {
+			st = &stats{}
+			statsByAddr[db.addr] = st
+		}
+		st.open += float64(s.OpenConnections)
+		st.inUse += float64(s.InUse)
+		st.idle += float64(s.Idle)
+		st.waitCount += float64(s.WaitCount)
+		st.waitSeconds += s.WaitDuration.Seconds()
+	}
+	c.mu.Unlock()
+
+	for addr, st := range statsByAddr {
+		ch <- prometheus.MustNewConstMetric(c.openConnsDesc, prometheus.GaugeValue, st.open, addr)
+		ch <- prometheus.MustNewConstMetric(c.inUseDesc, prometheus.GaugeValue, st.inUse, addr)
+		ch <- prometheus.MustNewConstMetric(c.idleDesc, prometheus.GaugeValue, st.idle, addr)
+		ch <- prometheus.MustNewConstMetric(c.waitCountDesc, prometheus.GaugeValue, st.waitCount, addr)
+		ch <- prometheus.MustNewConstMetric(c.waitDurationDesc, prometheus.GaugeValue, st.waitSeconds, addr)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conn
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pingcap/errors"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+const (
+	// SessionLabelTaskVar and SessionLabelSourceVar are the user variables which label the downstream sessions, they
+	// can be queried from `performance_schema.user_variables_by_thread` of MySQL.
+	SessionLabelTaskVar   = "@dm_task"
+	SessionLabelSourceVar = "@dm_source"
+
+	// tidbSessionAliasVar is the session variable of TiDB which is shown in the processlist and slow query logs.
+	tidbSessionAliasVar    = "tidb_session_alias"
+	maxTiDBSessionAliasLen = 64
+)
+
+// AddSessionLabels adds session variables into the DB config to label every connection created by the config with
+// the DM task and source, so the connections can be attributed in the processlist and slow query logs of downstream.
+// `tidb_session_alias` is only added when the downstream supports it. the variables specified by users are kept.
+func AddSessionLabels(ctx context.Context, cfg *config.DBConfig, task, source string) error {
+	if cfg.Session == nil {
+		cfg.Session = make(map[string]string)
+	}
+	task, source = sanitizeSessionLabel(task), sanitizeSessionLabel(source)
+	setIfNotExist := func(k, v string) bool {
+		for k2 := range cfg.Session {
+			if strings.EqualFold(k, k2) {
+				return false
+			}
+		}
+		cfg.Session[k] = v
+		return true
+	}
+	setIfNotExist(SessionLabelTaskVar, task)
+	setIfNotExist(SessionLabelSourceVar, source)
+
+	alias := "dm/" + task + "/" + source
+	if len(alias) > maxTiDBSessionAliasLen {
+		alias = alias[:maxTiDBSessionAliasLen]
+	}
+	// probe with the config before adding the variable, otherwise every connection fails if it's not supported.
+	probeCfg := *cfg
+	probeCfg.RawDBCfg = config.DefaultRawDBConfig().SetMaxIdleConns(1)
+	db, err := DefaultDBProvider.Apply(probeCfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	conn, err := db.DB.Conn(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer conn.Close()
+	_, err = utils.GetSessionVariable(ctx, conn, tidbSessionAliasVar)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+	setIfNotExist(tidbSessionAliasVar, alias)
+	return nil
+}
+
+// sanitizeSessionLabel replaces the characters which may break the session variables in DSN with '_'.
+func sanitizeSessionLabel(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.', r == ':':
+			return r
+		default:
+			return '_'
+		}
+	}, s)
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conn
+
+import (
+	"context"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+)
+
+func (t *testBaseDBSuite) TestAddSessionLabels(c *C) {
+	ctx := context.Background()
+	oldProvider := DefaultDBProvider
+	defer func() {
+		DefaultDBProvider = oldProvider
+	}()
+
+	// downstream supports `tidb_session_alias`
+	mock := InitMockDB(c)
+	mock.ExpectQuery("SHOW VARIABLES LIKE 'tidb_session_alias'").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("tidb_session_alias", ""))
+	cfg := config.DBConfig{Session: map[string]string{"sql_mode": "ANSI_QUOTES"}}
+	c.Assert(AddSessionLabels(ctx, &cfg, "task'1", "mysql-replica-01"), IsNil)
+	c.Assert(cfg.Session, DeepEquals, map[string]string{
+		"sql_mode":            "ANSI_QUOTES",
+		SessionLabelTaskVar:   "task_1",
+		SessionLabelSourceVar: "mysql-replica-01",
+		tidbSessionAliasVar:   "dm/task_1/mysql-replica-01",
+	})
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// downstream doesn't support `tidb_session_alias`, and the variables specified by users are kept
+	mock = InitMockDB(c)
+	mock.ExpectQuery("SHOW VARIABLES LIKE 'tidb_session_alias'").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}))
+	cfg = config.DBConfig{Session: map[string]string{"@DM_TASK": "custom"}}
+	c.Assert(AddSessionLabels(ctx, &cfg, "task", "source"), IsNil)
+	c.Assert(cfg.Session, DeepEquals, map[string]string{
+		"@DM_TASK":            "custom",
+		SessionLabelSourceVar: "source",
+	})
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
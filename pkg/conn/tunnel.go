@@ -0,0 +1,301 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conn
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"golang.org/x/net/proxy"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+const tunnelDialTimeout = 10 * time.Second
+
+// Tunnel forwards the connections to a local address to the database through an SSH bastion or a SOCKS5 proxy.
+// the MySQL driver and go-mysql only dial TCP addresses, so they connect to the local address of the tunnel instead.
+type Tunnel struct {
+	cfg      *config.TunnelConfig
+	target   string
+	listener net.Listener
+
+	sshCfg *ssh.ClientConfig
+	socks  proxy.Dialer
+
+	mu     sync.Mutex // protects following fields
+	client *ssh.Client
+	conns  map[net.Conn]struct{}
+
+	wg     sync.WaitGroup
+	closed atomic.Bool
+	l      log.Logger
+}
+
+// NewTunnel creates a tunnel to the database at `host`:`port` and starts to forward connections.
+func NewTunnel(cfg *config.TunnelConfig, host string, port int) (*Tunnel, error) {
+	t := &Tunnel{
+		cfg:    cfg,
+		target: net.JoinHostPort(host, strconv.Itoa(port)),
+		conns:  make(map[net.Conn]struct{}),
+	}
+	t.l = log.With(zap.String("component", "tunnel"), zap.String("type", cfg.Type), zap.String("target", t.target))
+
+	bastion := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	password := utils.DecryptOrPlaintext(cfg.Password)
+	switch cfg.Type {
+	case config.TunnelSSH:
+		sshCfg, err := newSSHClientConfig(cfg, password)
+		if err != nil {
+			return nil, terror.ErrConnTunnel.Delegate(err, t.target, cfg.Type)
+		}
+		t.sshCfg = sshCfg
+		// dial the SSH server now to find the wrong config as early as possible.
+		t.client, err = ssh.Dial("tcp", bastion, t.sshCfg)
+		if err != nil {
+			return nil, terror.ErrConnTunnel.Delegate(err, t.target, cfg.Type)
+		}
+	case config.TunnelSOCKS5:
+		var auth *proxy.Auth
+		if cfg.User != "" {
+			auth = &proxy.Auth{User: cfg.User, Password: password}
+		}
+		dialer, err := proxy.SOCKS5("tcp", bastion, auth, &net.Dialer{Timeout: tunnelDialTimeout})
+		if err != nil {
+			return nil, terror.ErrConnTunnel.Delegate(err, t.target, cfg.Type)
+		}
+		t.socks = dialer
+	default:
+		return nil, terror.ErrConfigInvalidTunnel.Generate("unknown type " + cfg.Type)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		if t.client != nil {
+			t.client.Close()
+		}
+		return nil, terror.ErrConnTunnel.Delegate(err, t.target, cfg.Type)
+	}
+	t.listener = listener
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		t.serve()
+	}()
+	t.l.Info("tunnel started", zap.Stringer("local address", t.Addr()))
+	return t, nil
+}
+
+func newSSHClientConfig(cfg *config.TunnelConfig, password string) (*ssh.ClientConfig, error) {
+	var auths []ssh.AuthMethod
+	if cfg.PrivateKey != "" {
+		key, err := os.ReadFile(cfg.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		var passphraseErr *ssh.PassphraseMissingError
+		if errors.As(err, &passphraseErr) && password != "" {
+			// the password is used as the passphrase of the private key.
+			signer, err = ssh.ParsePrivateKeyWithPassphrase(key, []byte(password))
+		}
+		if err != nil {
+			return nil, err
+		}
+		auths = append(auths, ssh.PublicKeys(signer))
+	}
+	if password != "" {
+		auths = append(auths, ssh.Password(password))
+	}
+
+	// nolint:gosec
+	hostKeyCallback := ssh.InsecureIgnoreHostKey()
+	if cfg.KnownHosts != "" {
+		var err error
+		hostKeyCallback, err = knownhosts.New(cfg.KnownHosts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ssh.ClientConfig{
+		User:            cfg.User,
+		Auth:            auths,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         tunnelDialTimeout,
+	}, nil
+}
+
+// ApplyTunnel opens a tunnel if the DB config requires one, and returns a copy of the DB config whose
+// address is replaced by the local address of the tunnel. the returned tunnel should be closed by the caller,
+// it's nil if no tunnel is configured.
+func ApplyTunnel(cfg config.DBConfig) (config.DBConfig, *Tunnel, error) {
+	if cfg.Tunnel == nil {
+		return cfg, nil, nil
+	}
+	t, err := NewTunnel(cfg.Tunnel, cfg.Host, cfg.Port)
+	if err != nil {
+		return cfg, nil, err
+	}
+	addr := t.Addr()
+	cfg.Host = addr.IP.String()
+	cfg.Port = addr.Port
+	cfg.Tunnel = nil
+	return cfg, t, nil
+}
+
+// Addr returns the local address of the tunnel.
+func (t *Tunnel) Addr() *net.TCPAddr {
+	return t.listener.Addr().(*net.TCPAddr)
+}
+
+// Close stops forwarding and closes all forwarded connections, it can be called on a nil tunnel.
+func (t *Tunnel) Close() {
+	if t == nil || !t.closed.CAS(false, true) {
+		return
+	}
+	t.listener.Close()
+	t.mu.Lock()
+	for c := range t.conns {
+		c.Close()
+	}
+	if t.client != nil {
+		t.client.Close()
+		t.client = nil
+	}
+	t.mu.Unlock()
+	t.wg.Wait()
+	t.l.Info("tunnel closed")
+}
+
+func (t *Tunnel) serve() {
+	for {
+		local, err := t.listener.Accept()
+		if err != nil {
+			if !t.closed.Load() {
+				t.l.Error("fail to accept connection", zap.Error(err))
+			}
+			return
+		}
+		t.wg.Add(1)
+		go func() {
+			defer t.wg.Done()
+			t.forward(local)
+		}()
+	}
+}
+
+func (t *Tunnel) forward(local net.Conn) {
+	remote, err := t.dial()
+	if err != nil {
+		t.l.Warn("fail to dial the target", zap.Error(err))
+		local.Close()
+		return
+	}
+	if !t.track(local, remote) {
+		local.Close()
+		remote.Close()
+		return
+	}
+	defer t.untrack(local, remote)
+
+	done := make(chan struct{}, 2)
+	pipe := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go pipe(remote, local)
+	go pipe(local, remote)
+	// close both connections when any direction is finished, then wait for the other one.
+	<-done
+	local.Close()
+	remote.Close()
+	<-done
+}
+
+// dial connects to the target through the bastion or the proxy.
+func (t *Tunnel) dial() (net.Conn, error) {
+	if t.socks != nil {
+		return t.socks.Dial("tcp", t.target)
+	}
+
+	// the SSH connection may be broken, re-dial it once if fail to open a channel.
+	for i := 0; ; i++ {
+		client, err := t.sshClient()
+		if err != nil {
+			return nil, err
+		}
+		remote, err := client.Dial("tcp", t.target)
+		if err == nil || i > 0 {
+			return remote, err
+		}
+		t.l.Warn("fail to dial through SSH connection, re-dial the SSH server", zap.Error(err))
+		t.mu.Lock()
+		if t.client == client {
+			client.Close()
+			t.client = nil
+		}
+		t.mu.Unlock()
+	}
+}
+
+func (t *Tunnel) sshClient() (*ssh.Client, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed.Load() {
+		return nil, net.ErrClosed
+	}
+	if t.client == nil {
+		client, err := ssh.Dial("tcp", net.JoinHostPort(t.cfg.Host, strconv.Itoa(t.cfg.Port)), t.sshCfg)
+		if err != nil {
+			return nil, err
+		}
+		t.client = client
+	}
+	return t.client, nil
+}
+
+// track records the forwarded connections to close them when closing the tunnel, returns false if already closed.
+func (t *Tunnel) track(conns ...net.Conn) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed.Load() {
+		return false
+	}
+	for _, c := range conns {
+		t.conns[c] = struct{}{}
+	}
+	return true
+}
+
+func (t *Tunnel) untrack(conns ...net.Conn) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, c := range conns {
+		delete(t.conns, c)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conn
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+)
+
+var _ = Suite(&testTunnelSuite{})
+
+type testTunnelSuite struct{}
+
+// serveSOCKS5 serves a SOCKS5 proxy without authentication, which only supports CONNECT to IPv4 addresses.
+func serveSOCKS5(c *C, l net.Listener) {
+	for {
+		client, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer client.Close()
+			buf := make([]byte, 10)
+			// greeting: VER NMETHODS METHODS, reply no authentication.
+			if _, err2 := io.ReadFull(client, buf[:2]); err2 != nil {
+				return
+			}
+			if _, err2 := io.ReadFull(client, make([]byte, buf[1])); err2 != nil {
+				return
+			}
+			_, _ = client.Write([]byte{5, 0})
+			// request: VER CMD RSV ATYP(IPv4) DST.ADDR DST.PORT.
+			if _, err2 := io.ReadFull(client, buf); err2 != nil {
+				return
+			}
+			c.Check(buf[:4], DeepEquals, []byte{5, 1, 0, 1})
+			target := net.JoinHostPort(net.IP(buf[4:8]).String(), strconv.Itoa(int(binary.BigEndian.Uint16(buf[8:]))))
+			remote, err2 := net.Dial("tcp", target)
+			if err2 != nil {
+				_, _ = client.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0})
+				return
+			}
+			defer remote.Close()
+			_, _ = client.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+			go func() {
+				_, _ = io.Copy(remote, client)
+			}()
+			_, _ = io.Copy(client, remote)
+		}()
+	}
+}
+
+func (t *testTunnelSuite) TestSOCKS5Tunnel(c *C) {
+	// an echo server as the database.
+	db, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer db.Close()
+	go func() {
+		for {
+			conn, err2 := db.Accept()
+			if err2 != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	proxyListener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	defer proxyListener.Close()
+	go serveSOCKS5(c, proxyListener)
+
+	dbAddr := db.Addr().(*net.TCPAddr)
+	proxyAddr := proxyListener.Addr().(*net.TCPAddr)
+	dbCfg := config.DBConfig{Host: "127.0.0.1", Port: dbAddr.Port, User: "root"}
+
+	// no tunnel.
+	cfg, tunnel, err := ApplyTunnel(dbCfg)
+	c.Assert(err, IsNil)
+	c.Assert(tunnel, IsNil)
+	c.Assert(cfg, DeepEquals, dbCfg)
+	tunnel.Close()
+
+	dbCfg.Tunnel = &config.TunnelConfig{Type: config.TunnelSOCKS5, Host: "127.0.0.1", Port: proxyAddr.Port}
+	cfg, tunnel, err = ApplyTunnel(dbCfg)
+	c.Assert(err, IsNil)
+	c.Assert(tunnel, NotNil)
+	c.Assert(cfg.Tunnel, IsNil)
+	c.Assert(cfg.Host, Equals, "127.0.0.1")
+	c.Assert(cfg.Port, Equals, tunnel.Addr().Port)
+	c.Assert(cfg.Port, Not(Equals), dbAddr.Port)
+	c.Assert(dbCfg.Tunnel, NotNil)
+
+	// the data is forwarded through the proxy.
+	local, err := net.Dial("tcp", tunnel.Addr().String())
+	c.Assert(err, IsNil)
+	defer local.Close()
+	_, err = local.Write([]byte("ping"))
+	c.Assert(err, IsNil)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(local, buf)
+	c.Assert(err, IsNil)
+	c.Assert(string(buf), Equals, "ping")
+
+	// the forwarded connections are closed with the tunnel.
+	tunnel.Close()
+	tunnel.Close()
+	_, err = local.Read(buf)
+	c.Assert(err, NotNil)
+	_, err = net.Dial("tcp", tunnel.Addr().String())
+	c.Assert(err, NotNil)
+}
+
+func (t *testTunnelSuite) TestSSHTunnelFail(c *C) {
+	// nothing listens on the address of the SSH server.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	port := l.Addr().(*net.TCPAddr).Port
+	c.Assert(l.Close(), IsNil)
+
+	cfg := &config.TunnelConfig{Type: config.TunnelSSH, Host: "127.0.0.1", Port: port, User: "dm", Password: "123456"}
+	_, err = NewTunnel(cfg, "127.0.0.1", 3306)
+	c.Assert(err, ErrorMatches, ".*fail to connect to 127.0.0.1:3306 through the ssh tunnel.*")
+
+	cfg.PrivateKey = "./not-exist-key"
+	_, err = NewTunnel(cfg, "127.0.0.1", 3306)
+	c.Assert(err, ErrorMatches, ".*fail to connect to 127.0.0.1:3306 through the ssh tunnel.*")
+}
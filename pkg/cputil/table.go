@@ -32,3 +32,23 @@ func SyncerShardMeta(task string) string {
 func SyncerOnlineDDL(task string) string {
 	return task + "_onlineddl"
 }
+
+// SyncerOversizedRow returns syncer's side table name for rows exceed `max-row-size`.
+func SyncerOversizedRow(task string) string {
+	return task + "_syncer_oversized_row"
+}
+
+// SyncerApplySummary returns syncer's audit table name for the summaries of the row changes applied to downstream.
+func SyncerApplySummary(task string) string {
+	return task + "_syncer_apply_summary"
+}
+
+// SyncerAppliedGTID returns syncer's table name for the location of the last transaction applied in `exactly-once` mode.
+func SyncerAppliedGTID(task string) string {
+	return task + "_dm_applied_gtid"
+}
+
+// SyncerSchemaSnapshot returns syncer's table name for the snapshot of the schema tracker.
+func SyncerSchemaSnapshot(task string) string {
+	return task + "_syncer_schema_snapshot"
+}
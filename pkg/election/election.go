@@ -107,6 +107,9 @@ type Election struct {
 
 	resignCh chan struct{}
 
+	// syncedAt is the unix nano time of the last successful SyncLeader.
+	syncedAt atomic.Int64
+
 	l log.Logger
 }
 
@@ -174,6 +177,36 @@ func (e *Election) LeaderInfo(ctx context.Context) (string, string, string, erro
 	return string(resp.Kvs[0].Key), leaderInfo.ID, leaderInfo.Addr, nil
 }
 
+// SyncLeader gets the current leader's information with a linearizable read, which is served only after the
+// etcd member has caught up with the raft leader, so the data read from this member later are not older than the
+// returned revision. it's used by the followers to bound the staleness of their read-only caches.
+func (e *Election) SyncLeader(ctx context.Context) (*CampaignerInfo, int64, error) {
+	start := time.Now()
+	resp, err := e.cli.Get(ctx, e.key, clientv3.WithFirstCreate()...)
+	if err != nil {
+		return nil, 0, terror.ErrElectionGetLeaderIDFail.Delegate(err)
+	} else if len(resp.Kvs) == 0 {
+		return nil, 0, terror.ErrElectionGetLeaderIDFail.Delegate(concurrency.ErrElectionNoLeader)
+	}
+
+	leaderInfo, err := getCampaignerInfo(resp.Kvs[0].Value)
+	if err != nil {
+		return nil, 0, terror.ErrElectionGetLeaderIDFail.Delegate(err)
+	}
+	e.syncedAt.Store(start.UnixNano())
+	return leaderInfo, resp.Header.Revision, nil
+}
+
+// Staleness returns the elapsed time since the last successful SyncLeader.
+// a negative value is returned if SyncLeader never succeeded.
+func (e *Election) Staleness() time.Duration {
+	syncedAt := e.syncedAt.Load()
+	if syncedAt == 0 {
+		return -1
+	}
+	return time.Since(time.Unix(0, syncedAt))
+}
+
 // LeaderNotify returns a channel that can fetch the leader's information when the member become the leader or retire from the leader, or get a new leader.
 // leader's information can be nil which means this member is leader and retire.
 func (e *Election) LeaderNotify() <-chan *CampaignerInfo {
@@ -413,3 +413,38 @@ func (t *testElectionSuite) TestElectionDeleteKey(c *C) {
 	c.Assert(err, IsNil)
 	wg.Wait()
 }
+
+func (t *testElectionSuite) TestElectionSyncLeader(c *C) {
+	var (
+		timeout    = 3 * time.Second
+		sessionTTL = 60
+		key        = "unit-test/election-sync-leader"
+		ID         = "member"
+		addr       = "127.0.0.1:1234"
+	)
+	cli, err := etcdutil.CreateClient([]string{t.endPoint}, nil)
+	c.Assert(err, IsNil)
+	defer cli.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	e, err := NewElection(ctx, cli, sessionTTL, key, ID, addr, t.notifyBlockTime)
+	c.Assert(err, IsNil)
+	defer e.Close()
+	c.Assert(e.Staleness() < 0, IsTrue)
+
+	select {
+	case leader := <-e.LeaderNotify():
+		c.Assert(leader.ID, Equals, ID)
+	case <-time.After(timeout):
+		c.Fatal("leader campaign timeout")
+	}
+
+	leader, rev, err := e.SyncLeader(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(leader.ID, Equals, ID)
+	c.Assert(leader.Addr, Equals, addr)
+	c.Assert(rev > 0, IsTrue)
+	staleness := e.Staleness()
+	c.Assert(staleness >= 0 && staleness < timeout, IsTrue)
+}
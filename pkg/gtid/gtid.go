@@ -42,6 +42,11 @@ type Set interface {
 	// should become `00c04543-f584-11e9-a765-0242ac120002:1-60`.
 	Truncate(end Set) error
 
+	// Minus returns the GTIDs in the current set but not in other, the current set is not changed.
+	// like `00c04543-f584-11e9-a765-0242ac120002:1-100` minus `00c04543-f584-11e9-a765-0242ac120002:1-60`
+	// is `00c04543-f584-11e9-a765-0242ac120002:61-100`.
+	Minus(other Set) Set
+
 	String() string
 }
 
@@ -257,6 +262,49 @@ func (g *MySQLGTIDSet) Truncate(end Set) error {
 	return nil
 }
 
+// Minus implements Set.Minus.
+func (g *MySQLGTIDSet) Minus(other Set) Set {
+	ret := MinGTIDSet(mysql.MySQLFlavor).(*MySQLGTIDSet)
+	if g == nil || g.set == nil {
+		return ret
+	}
+	var otherSets map[string]*mysql.UUIDSet
+	if otherGs, ok := other.(*MySQLGTIDSet); ok && otherGs != nil && otherGs.set != nil {
+		otherSets = otherGs.set.Sets
+	}
+
+	for sid, setG := range g.set.Sets {
+		var subtrahend mysql.IntervalSlice
+		if setO, ok := otherSets[sid]; ok {
+			subtrahend = setO.Intervals.Normalize()
+		}
+		var intervals mysql.IntervalSlice
+		for _, interG := range setG.Intervals.Normalize() {
+			// the Stop of the interval is exclusive.
+			start := interG.Start
+			for _, interO := range subtrahend {
+				if interO.Stop <= start {
+					continue
+				}
+				if interO.Start >= interG.Stop {
+					break
+				}
+				if interO.Start > start {
+					intervals = append(intervals, mysql.Interval{Start: start, Stop: interO.Start})
+				}
+				start = interO.Stop
+			}
+			if start < interG.Stop {
+				intervals = append(intervals, mysql.Interval{Start: start, Stop: interG.Stop})
+			}
+		}
+		if len(intervals) > 0 {
+			ret.set.AddSet(&mysql.UUIDSet{SID: setG.SID, Intervals: intervals})
+		}
+	}
+	return ret
+}
+
 func (g *MySQLGTIDSet) String() string {
 	if g.set == nil {
 		return ""
@@ -419,6 +467,28 @@ func (m *MariadbGTIDSet) Truncate(end Set) error {
 	return nil
 }
 
+// Minus implements Set.Minus.
+// the GTID of a domain is kept if its sequence number is greater than other's, because the MariaDB GTID set only
+// records the latest GTID of every domain.
+func (m *MariadbGTIDSet) Minus(other Set) Set {
+	ret := MinGTIDSet(mysql.MariaDBFlavor).(*MariadbGTIDSet)
+	if m == nil || m.set == nil {
+		return ret
+	}
+	var otherSets map[uint32]*mysql.MariadbGTID
+	if otherGS, ok := other.(*MariadbGTIDSet); ok && otherGS != nil && otherGS.set != nil {
+		otherSets = otherGS.set.Sets
+	}
+
+	for did, mGTID := range m.set.Sets {
+		if oGTID, ok := otherSets[did]; ok && oGTID.SequenceNumber >= mGTID.SequenceNumber {
+			continue
+		}
+		ret.set.Sets[did] = mGTID.Clone()
+	}
+	return ret
+}
+
 func (m *MariadbGTIDSet) String() string {
 	if m.set == nil {
 		return ""
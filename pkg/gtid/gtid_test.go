@@ -405,3 +405,65 @@ func (s *testGTIDSuite) TestMariaDBGTIDTruncate(c *C) {
 		}
 	}
 }
+
+func (s *testGTIDSuite) TestMySQLGTIDMinus(c *C) {
+	var (
+		flavor = "mysql"
+		g1, _  = ParserGTID(flavor, "00c04543-f584-11e9-a765-0242ac120002:1-100")
+		gNil   *MySQLGTIDSet
+	)
+	c.Assert(g1.Minus(nil).String(), Equals, g1.String())
+	c.Assert(g1.Minus(gNil).String(), Equals, g1.String())
+	c.Assert(gNil.Minus(g1).String(), Equals, "")
+
+	cases := []struct {
+		minuend    string
+		subtrahend string
+		difference string
+	}{
+		{
+			minuend:    "00c04543-f584-11e9-a765-0242ac120002:1-100",
+			subtrahend: "00c04543-f584-11e9-a765-0242ac120002:1-60",
+			difference: "00c04543-f584-11e9-a765-0242ac120002:61-100",
+		},
+		{
+			minuend:    "00c04543-f584-11e9-a765-0242ac120002:1-100",
+			subtrahend: "00c04543-f584-11e9-a765-0242ac120002:1-100,03fc0263-28c7-11e7-a653-6c0b84d59f30:1-10",
+			difference: "",
+		},
+		{
+			minuend:    "00c04543-f584-11e9-a765-0242ac120002:1-100,03fc0263-28c7-11e7-a653-6c0b84d59f30:1-10",
+			subtrahend: "00c04543-f584-11e9-a765-0242ac120002:10-20:50-60",
+			difference: "00c04543-f584-11e9-a765-0242ac120002:1-9:21-49:61-100,03fc0263-28c7-11e7-a653-6c0b84d59f30:1-10",
+		},
+		{
+			minuend:    "00c04543-f584-11e9-a765-0242ac120002:5-10:20-30",
+			subtrahend: "00c04543-f584-11e9-a765-0242ac120002:1-25",
+			difference: "00c04543-f584-11e9-a765-0242ac120002:26-30",
+		},
+	}
+	for _, cs := range cases {
+		mg, err := ParserGTID(flavor, cs.minuend)
+		c.Assert(err, IsNil)
+		sg, err := ParserGTID(flavor, cs.subtrahend)
+		c.Assert(err, IsNil)
+		dg, err := ParserGTID(flavor, cs.difference)
+		c.Assert(err, IsNil)
+		c.Assert(mg.Minus(sg).Equal(dg), IsTrue, Commentf("%s - %s = %s", cs.minuend, cs.subtrahend, mg.Minus(sg)))
+		// the minuend is not changed
+		c.Assert(mg.String(), Equals, cs.minuend)
+	}
+}
+
+func (s *testGTIDSuite) TestMariaDBGTIDMinus(c *C) {
+	var (
+		flavor = "mariadb"
+		g1, _  = ParserGTID(flavor, "1-2-3,2-2-5")
+		g2, _  = ParserGTID(flavor, "1-3-3,2-2-4,3-2-1")
+		gNil   *MariadbGTIDSet
+	)
+	c.Assert(g1.Minus(nil).String(), Equals, g1.String())
+	c.Assert(gNil.Minus(g1).String(), Equals, "")
+	c.Assert(g1.Minus(g2).String(), Equals, "2-2-5")
+	c.Assert(g2.Minus(g1).String(), Equals, "3-2-1")
+}
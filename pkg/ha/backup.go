@@ -0,0 +1,275 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"strings"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/pkg/etcdutil"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// backupKeyAdapters are the prefixes of the cluster states in a backup.
+// the states bound to the DM-worker instances, like the registered workers, the source bounds and the load tasks,
+// are not backed up, they are rebuilt by the scheduler of the restored cluster. the relay configs are kept, so the
+// DM-workers with the same names pull relay logs as before.
+var backupKeyAdapters = []common.KeyAdapter{
+	common.UpstreamConfigKeyAdapter,
+	common.UpstreamRelayWorkerKeyAdapter,
+	common.UpstreamDisabledKeyAdapter,
+	common.UpstreamSubTaskKeyAdapter,
+	common.StageRelayKeyAdapter,
+	common.StageSubTaskKeyAdapter,
+	common.ShardDDLPessimismInfoKeyAdapter,
+	common.ShardDDLPessimismOperationKeyAdapter,
+	common.ShardDDLOptimismSourceTablesKeyAdapter,
+	common.ShardDDLOptimismInfoKeyAdapter,
+	common.ShardDDLOptimismOperationKeyAdapter,
+	common.ShardDDLOptimismInitSchemaKeyAdapter,
+	common.ShardDDLOptimismDroppedColumnsKeyAdapter,
+	common.ProjectKeyAdapter,
+}
+
+// ClusterBackup is a snapshot of the cluster states in etcd, used to restore them into another cluster.
+type ClusterBackup struct {
+	// InternalVersion and ReleaseVersion are the cluster version when backing up, the layout of the states may be
+	// changed between internal versions.
+	InternalVersion uint64      `json:"internal-version"`
+	ReleaseVersion  string      `json:"release-version"`
+	Revision        int64       `json:"revision"`
+	KVs             []ClusterKV `json:"kvs"`
+}
+
+// ClusterKV is a key-value pair of the cluster states.
+type ClusterKV struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// GetClusterBackup gets the cluster states in one txn, the version fields are left to the caller.
+func GetClusterBackup(cli *clientv3.Client) (ClusterBackup, error) {
+	ops := make([]clientv3.Op, 0, len(backupKeyAdapters))
+	for _, adapter := range backupKeyAdapters {
+		ops = append(ops, clientv3.OpGet(adapter.Path(), clientv3.WithPrefix()))
+	}
+	resp, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, ops...)
+	if err != nil {
+		return ClusterBackup{}, err
+	}
+
+	backup := ClusterBackup{Revision: rev}
+	for _, r := range resp.Responses {
+		for _, kv := range r.GetResponseRange().Kvs {
+			backup.KVs = append(backup.KVs, ClusterKV{Key: string(kv.Key), Value: string(kv.Value)})
+		}
+	}
+	return backup, nil
+}
+
+// restoreRequestBytesMargin is reserved in a restoring txn for the compares and the encoding overhead of the request.
+const restoreRequestBytesMargin = 64 * 1024
+
+// RestoreClusterBackup puts the cluster states of the backup in batches of txns, every batch holds at most maxTxnOps
+// ops and maxRequestBytes bytes, which are the limits of the etcd cluster. it fails if any cluster states exist, so
+// the backup can only be restored into a fresh cluster. the emptiness is checked by the compares of the first batch,
+// and if a later batch fails, the written cluster states are deleted so the backup can be restored again.
+func RestoreClusterBackup(cli *clientv3.Client, backup ClusterBackup, maxTxnOps, maxRequestBytes uint) (int64, error) {
+	for _, kv := range backup.KVs {
+		if !isBackupKey(kv.Key) {
+			return 0, terror.ErrMasterInvalidClusterBackup.Generate("unexpected key " + kv.Key)
+		}
+	}
+
+	cmps := make([]clientv3.Cmp, 0, len(backupKeyAdapters))
+	for _, adapter := range backupKeyAdapters {
+		cmps = append(cmps, clientv3.Compare(clientv3.CreateRevision(adapter.Path()), "=", 0).WithPrefix())
+	}
+	var (
+		rev      int64
+		ops      = make([]clientv3.Op, 0, maxTxnOps)
+		size     uint
+		maxBytes = maxRequestBytes / 2
+		first    = true
+	)
+	if maxRequestBytes > 2*restoreRequestBytesMargin {
+		maxBytes = maxRequestBytes - restoreRequestBytesMargin
+	}
+	flush := func() error {
+		resp, rev2, err := etcdutil.DoOpsInOneCmpsTxnWithRetry(cli, cmps, ops, nil)
+		if err != nil {
+			return err
+		}
+		if !resp.Succeeded {
+			return terror.ErrMasterClusterNotEmpty.Generate()
+		}
+		rev, ops, size, first, cmps = rev2, ops[:0], 0, false, nil
+		return nil
+	}
+	for _, kv := range backup.KVs {
+		kvSize := uint(len(kv.Key) + len(kv.Value))
+		if len(ops) > 0 && (uint(len(ops)) >= maxTxnOps || size+kvSize > maxBytes) {
+			if err := flush(); err != nil {
+				return 0, rollbackClusterBackup(cli, first, err)
+			}
+		}
+		ops = append(ops, clientv3.OpPut(kv.Key, kv.Value))
+		size += kvSize
+	}
+	if len(ops) > 0 || first {
+		if err := flush(); err != nil {
+			return 0, rollbackClusterBackup(cli, first, err)
+		}
+	}
+	return rev, nil
+}
+
+// rollbackClusterBackup deletes the cluster states written by the previous batches of a failed restoring, nothing is
+// written if the first batch fails.
+func rollbackClusterBackup(cli *clientv3.Client, first bool, err error) error {
+	if first {
+		return err
+	}
+	ops := make([]clientv3.Op, 0, len(backupKeyAdapters))
+	for _, adapter := range backupKeyAdapters {
+		ops = append(ops, clientv3.OpDelete(adapter.Path(), clientv3.WithPrefix()))
+	}
+	if _, _, err2 := etcdutil.DoOpsInOneTxnWithRetry(cli, ops...); err2 != nil {
+		log.L().Error("fail to delete the cluster states of a failed restoring", log.ShortError(err2))
+	}
+	return err
+}
+
+func isBackupKey(key string) bool {
+	for _, adapter := range backupKeyAdapters {
+		prefix := adapter.Path()
+		if strings.HasPrefix(key, prefix) && len(key) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// dmKeyPrefixes are the prefixes of all keys written by DM in etcd.
+var dmKeyPrefixes = []string{"/dm-master/", "/dm-worker/", "/dm-cluster/"}
+
+// EtcdKV is a key-value pair in the snapshot of etcd, or a change of the key after the snapshot.
+type EtcdKV struct {
+	Key         string
+	Value       string
+	Deleted     bool
+	ModRevision int64
+}
+
+// IsDMKey returns whether the key is written by DM.
+func IsDMKey(key string) bool {
+	for _, prefix := range dmKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetEtcdSnapshot reads the whole keyspace of etcd page by page at the revision of the first page, so the pages are
+// a consistent snapshot. fn is called with every non-empty page and the last page, and the revision of the snapshot.
+// if dmOnly is true, the keys not written by DM are excluded. it returns the revision of the snapshot.
+func GetEtcdSnapshot(ctx context.Context, cli *clientv3.Client, pageSize int64, dmOnly bool, fn func(kvs []EtcdKV, rev int64, last bool) error) (int64, error) {
+	var (
+		rev int64
+		key = ""
+	)
+	for {
+		opts := []clientv3.OpOption{clientv3.WithFromKey(), clientv3.WithLimit(pageSize), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend)}
+		if rev > 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		ctx2, cancel := context.WithTimeout(ctx, etcdutil.DefaultRequestTimeout)
+		resp, err := cli.Get(ctx2, key, opts...)
+		cancel()
+		if err != nil {
+			return 0, err
+		}
+		if rev == 0 {
+			rev = resp.Header.Revision
+		}
+
+		kvs := make([]EtcdKV, 0, len(resp.Kvs))
+		for _, kv := range resp.Kvs {
+			if dmOnly && !IsDMKey(string(kv.Key)) {
+				continue
+			}
+			kvs = append(kvs, EtcdKV{Key: string(kv.Key), Value: string(kv.Value), ModRevision: kv.ModRevision})
+		}
+		last := !resp.More || len(resp.Kvs) == 0
+		if len(kvs) > 0 || last {
+			if err = fn(kvs, rev, last); err != nil {
+				return 0, err
+			}
+		}
+		if last {
+			return rev, nil
+		}
+		// the next page starts from the key right after the last key of this page.
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+// WatchEtcdChanges watches the changes of the whole keyspace of etcd after the revision until ctx is done, fn is
+// called with the changes and the revision of every watch response. if dmOnly is true, the changes of the keys not
+// written by DM are excluded.
+func WatchEtcdChanges(ctx context.Context, cli *clientv3.Client, revision int64, dmOnly bool, fn func(kvs []EtcdKV, rev int64) error) error {
+	wCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := cli.Watch(wCtx, "", clientv3.WithFromKey(), clientv3.WithRev(revision+1))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case resp, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if resp.Canceled {
+				return resp.Err()
+			}
+
+			kvs := make([]EtcdKV, 0, len(resp.Events))
+			for _, ev := range resp.Events {
+				if dmOnly && !IsDMKey(string(ev.Kv.Key)) {
+					continue
+				}
+				kv := EtcdKV{Key: string(ev.Kv.Key), ModRevision: ev.Kv.ModRevision}
+				if ev.Type == mvccpb.DELETE {
+					kv.Deleted = true
+				} else {
+					kv.Value = string(ev.Kv.Value)
+				}
+				kvs = append(kvs, kv)
+			}
+			if len(kvs) == 0 {
+				continue
+			}
+			if err := fn(kvs, resp.Header.Revision); err != nil {
+				return err
+			}
+		}
+	}
+}
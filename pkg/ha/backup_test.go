@@ -0,0 +1,196 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	. "github.com/pingcap/check"
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/embed"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+func (t *testForEtcd) TestClusterBackupEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		worker = "dm-worker-1"
+		source = "mysql-replica-1"
+		task   = "task-1"
+	)
+	cfg, err := config.LoadFromFile(sourceSampleFilePath)
+	c.Assert(err, IsNil)
+	cfg.SourceID = source
+	stCfg := config.SubTaskConfig{}
+	c.Assert(stCfg.DecodeFile(subTaskSampleFile, true), IsNil)
+	stCfg.SourceID = source
+	stCfg.Name = task
+
+	_, err = PutSourceCfg(etcdTestCli, cfg)
+	c.Assert(err, IsNil)
+	_, err = PutRelayConfig(etcdTestCli, source, worker)
+	c.Assert(err, IsNil)
+	_, err = PutSubTaskCfgStage(etcdTestCli, []config.SubTaskConfig{stCfg}, []Stage{NewSubTaskStage(pb.Stage_Running, source, task)})
+	c.Assert(err, IsNil)
+	// the source bound is not backed up.
+	_, err = PutSourceBound(etcdTestCli, NewSourceBound(source, worker))
+	c.Assert(err, IsNil)
+
+	backup, err := GetClusterBackup(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(backup.Revision, Greater, int64(0))
+	c.Assert(backup.KVs, HasLen, 4)
+
+	// can't restore into a cluster with states.
+	_, err = RestoreClusterBackup(etcdTestCli, backup, embed.DefaultMaxTxnOps, embed.DefaultMaxRequestBytes)
+	c.Assert(terror.ErrMasterClusterNotEmpty.Equal(err), IsTrue)
+
+	clearTestInfoOperation(c)
+	invalid := ClusterBackup{KVs: []ClusterKV{{Key: "/dm-worker/r/", Value: "{}"}}}
+	_, err = RestoreClusterBackup(etcdTestCli, invalid, embed.DefaultMaxTxnOps, embed.DefaultMaxRequestBytes)
+	c.Assert(terror.ErrMasterInvalidClusterBackup.Equal(err), IsTrue)
+
+	_, err = RestoreClusterBackup(etcdTestCli, backup, embed.DefaultMaxTxnOps, embed.DefaultMaxRequestBytes)
+	c.Assert(err, IsNil)
+	cfgM, _, err := GetSourceCfg(etcdTestCli, source, 0)
+	c.Assert(err, IsNil)
+	c.Assert(cfgM[source], DeepEquals, cfg)
+	relayWorkers, _, err := GetAllRelayConfig(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(relayWorkers, DeepEquals, map[string]map[string]struct{}{source: {worker: {}}})
+	stage, _, err := GetSubTaskStage(etcdTestCli, source, task)
+	c.Assert(err, IsNil)
+	c.Assert(stage[task].Expect, Equals, pb.Stage_Running)
+	bounds, _, err := GetSourceBound(etcdTestCli, worker)
+	c.Assert(err, IsNil)
+	c.Assert(bounds, HasLen, 0)
+}
+
+func (t *testForEtcd) TestRestoreClusterBackupInBatches(c *C) {
+	defer clearTestInfoOperation(c)
+
+	countStates := func() int64 {
+		resp, err := etcdTestCli.Get(context.Background(), common.UpstreamConfigKeyAdapter.Path(), clientv3.WithPrefix(), clientv3.WithCountOnly())
+		c.Assert(err, IsNil)
+		return resp.Count
+	}
+
+	// the KVs are more than the max txn ops of the etcd cluster.
+	var backup ClusterBackup
+	kvCount := 3*int(embed.DefaultMaxTxnOps) + 1
+	for i := 0; i < kvCount; i++ {
+		backup.KVs = append(backup.KVs, ClusterKV{Key: common.UpstreamConfigKeyAdapter.Encode(fmt.Sprintf("source-%d", i)), Value: "{}"})
+	}
+	rev, err := RestoreClusterBackup(etcdTestCli, backup, embed.DefaultMaxTxnOps, embed.DefaultMaxRequestBytes)
+	c.Assert(err, IsNil)
+	c.Assert(rev, Greater, int64(0))
+	c.Assert(countStates(), Equals, int64(kvCount))
+
+	// the first batch checks the cluster is empty.
+	_, err = RestoreClusterBackup(etcdTestCli, backup, embed.DefaultMaxTxnOps, embed.DefaultMaxRequestBytes)
+	c.Assert(terror.ErrMasterClusterNotEmpty.Equal(err), IsTrue)
+	c.Assert(countStates(), Equals, int64(kvCount))
+	clearTestInfoOperation(c)
+
+	// the KVs are larger than the max request bytes of the etcd cluster.
+	value := strings.Repeat("v", 256*1024)
+	backup.KVs = backup.KVs[:0]
+	for i := 0; i < 10; i++ {
+		backup.KVs = append(backup.KVs, ClusterKV{Key: common.UpstreamConfigKeyAdapter.Encode(fmt.Sprintf("source-%d", i)), Value: value})
+	}
+	_, err = RestoreClusterBackup(etcdTestCli, backup, embed.DefaultMaxTxnOps, embed.DefaultMaxRequestBytes)
+	c.Assert(err, IsNil)
+	c.Assert(countStates(), Equals, int64(10))
+	clearTestInfoOperation(c)
+
+	// the written states are deleted if a later batch fails, the last KV exceeds the max request bytes.
+	backup.KVs = append(backup.KVs, ClusterKV{Key: common.UpstreamConfigKeyAdapter.Encode("source-large"), Value: strings.Repeat("v", 2*int(embed.DefaultMaxRequestBytes))})
+	_, err = RestoreClusterBackup(etcdTestCli, backup, embed.DefaultMaxTxnOps, embed.DefaultMaxRequestBytes)
+	c.Assert(err, NotNil)
+	c.Assert(countStates(), Equals, int64(0))
+}
+
+func (t *testForEtcd) TestEtcdSnapshotAndChanges(c *C) {
+	defer clearTestInfoOperation(c)
+	ctx := context.Background()
+
+	otherKey := "/other/key"
+	defer func() {
+		_, err := etcdTestCli.Delete(ctx, otherKey)
+		c.Assert(err, IsNil)
+	}()
+	_, err := etcdTestCli.Put(ctx, otherKey, "other")
+	c.Assert(err, IsNil)
+	for _, worker := range []string{"dm-worker-1", "dm-worker-2", "dm-worker-3"} {
+		_, err = PutRelayConfig(etcdTestCli, "mysql-replica-1", worker)
+		c.Assert(err, IsNil)
+	}
+
+	// read a key in every page
+	snapshot := func(dmOnly bool) ([]EtcdKV, int64) {
+		var (
+			kvs  []EtcdKV
+			done bool
+		)
+		rev, err2 := GetEtcdSnapshot(ctx, etcdTestCli, 1, dmOnly, func(page []EtcdKV, _ int64, last bool) error {
+			c.Assert(done, IsFalse)
+			// the last page may be empty if its key is excluded
+			if !last {
+				c.Assert(page, HasLen, 1)
+			}
+			kvs = append(kvs, page...)
+			done = last
+			return nil
+		})
+		c.Assert(err2, IsNil)
+		c.Assert(done, IsTrue)
+		return kvs, rev
+	}
+	kvs, rev := snapshot(false)
+	c.Assert(kvs, HasLen, 4)
+	c.Assert(kvs[3].Key, Equals, otherKey)
+	kvs, rev2 := snapshot(true)
+	c.Assert(kvs, HasLen, 3)
+	c.Assert(rev2, Equals, rev)
+	for _, kv := range kvs {
+		c.Assert(IsDMKey(kv.Key), IsTrue)
+		c.Assert(kv.ModRevision, LessEqual, rev)
+	}
+
+	// the changes after the snapshot
+	_, err = etcdTestCli.Put(ctx, otherKey, "other2")
+	c.Assert(err, IsNil)
+	_, err = DeleteRelayConfig(etcdTestCli, "dm-worker-1")
+	c.Assert(err, IsNil)
+
+	ctx2, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var changes []EtcdKV
+	err = WatchEtcdChanges(ctx2, etcdTestCli, rev, true, func(kvs []EtcdKV, _ int64) error {
+		changes = append(changes, kvs...)
+		cancel()
+		return nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(changes, HasLen, 1)
+	c.Assert(changes[0].Deleted, IsTrue)
+	c.Assert(changes[0].Key, Equals, kvs[0].Key)
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/pkg/etcdutil"
+)
+
+const (
+	// WorkerUpgradePrepared means the sources of the DM-worker are handed over or its subtasks are paused,
+	// so it can be restarted with the new binary.
+	WorkerUpgradePrepared = "prepared"
+	// WorkerUpgradeResumed means the paused subtasks of the DM-worker are resumed after it's upgraded.
+	WorkerUpgradeResumed = "resumed"
+)
+
+// WorkerUpgrade is the upgrade progress of a DM-worker.
+type WorkerUpgrade struct {
+	Worker string `json:"worker"`
+	Stage  string `json:"stage"`
+	Source string `json:"source"` // the source bound to the DM-worker when it's prepared
+	// the free DM-worker which the source is handed over to, empty if no free DM-worker.
+	HandoverTo string `json:"handover-to"`
+	// the subtasks of the source paused until the DM-worker is upgraded, empty if the source is handed over.
+	PausedTasks []string `json:"paused-tasks"`
+}
+
+// ClusterUpgrade represents the window of a rolling upgrade of the cluster, the task operations are blocked until
+// the upgrade is finished.
+type ClusterUpgrade struct {
+	TargetVersion string                    `json:"target-version"` // the release version upgraded to
+	FromVersion   string                    `json:"from-version"`   // the release version of the cluster when started
+	StartTime     time.Time                 `json:"start-time"`
+	Workers       map[string]*WorkerUpgrade `json:"workers"` // worker-name -> progress
+}
+
+// NewClusterUpgrade creates a new ClusterUpgrade instance.
+func NewClusterUpgrade(targetVersion, fromVersion string, startTime time.Time) ClusterUpgrade {
+	return ClusterUpgrade{
+		TargetVersion: targetVersion,
+		FromVersion:   fromVersion,
+		StartTime:     startTime,
+		Workers:       make(map[string]*WorkerUpgrade),
+	}
+}
+
+// String implements Stringer interface.
+func (u ClusterUpgrade) String() string {
+	s, _ := u.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (u ClusterUpgrade) toJSON() (string, error) {
+	data, err := json.Marshal(u)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// PutClusterUpgrade puts the upgrade window into etcd, the previous one is overwritten.
+func PutClusterUpgrade(cli *clientv3.Client, u ClusterUpgrade) (int64, error) {
+	value, err := u.toJSON()
+	if err != nil {
+		return 0, err
+	}
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpPut(common.ClusterUpgradeKey, value))
+	return rev, err
+}
+
+// GetClusterUpgrade gets the upgrade window, the second return value indicates whether the cluster is being upgraded.
+func GetClusterUpgrade(cli *clientv3.Client) (ClusterUpgrade, bool, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	var u ClusterUpgrade
+	resp, err := cli.Get(ctx, common.ClusterUpgradeKey)
+	if err != nil {
+		return u, false, 0, err
+	}
+	if resp.Count == 0 {
+		return u, false, resp.Header.Revision, nil
+	}
+	if err = json.Unmarshal(resp.Kvs[0].Value, &u); err != nil {
+		return u, false, 0, err
+	}
+	if u.Workers == nil {
+		u.Workers = make(map[string]*WorkerUpgrade)
+	}
+	return u, true, resp.Header.Revision, nil
+}
+
+// DeleteClusterUpgrade deletes the upgrade window.
+func DeleteClusterUpgrade(cli *clientv3.Client) (int64, error) {
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpDelete(common.ClusterUpgradeKey))
+	return rev, err
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestClusterUpgradeEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	_, ok, _, err := GetClusterUpgrade(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+
+	u := NewClusterUpgrade("v2.0.7", "v2.0.6", time.Now())
+	_, err = PutClusterUpgrade(etcdTestCli, u)
+	c.Assert(err, IsNil)
+	u2, ok, _, err := GetClusterUpgrade(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+	c.Assert(u2.TargetVersion, Equals, u.TargetVersion)
+	c.Assert(u2.FromVersion, Equals, u.FromVersion)
+	c.Assert(u2.StartTime.Equal(u.StartTime), IsTrue)
+	c.Assert(u2.Workers, HasLen, 0)
+
+	// record the progress of the workers.
+	u.Workers["worker1"] = &WorkerUpgrade{Worker: "worker1", Stage: WorkerUpgradePrepared, Source: "source1", PausedTasks: []string{"task1"}}
+	u.Workers["worker2"] = &WorkerUpgrade{Worker: "worker2", Stage: WorkerUpgradeResumed, Source: "source2", HandoverTo: "worker3"}
+	_, err = PutClusterUpgrade(etcdTestCli, u)
+	c.Assert(err, IsNil)
+	u2, _, _, err = GetClusterUpgrade(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(u2.Workers, DeepEquals, u.Workers)
+
+	_, err = DeleteClusterUpgrade(etcdTestCli)
+	c.Assert(err, IsNil)
+	_, ok, _, err = GetClusterUpgrade(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+}
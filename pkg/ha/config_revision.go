@@ -0,0 +1,102 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.etcd.io/etcd/mvcc/mvccpb"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/etcdutil"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// KeyRevision is the revisions of a key in etcd, the history of the key can be read with `ModRevision` and
+// `CreateRevision` as the revision of etcd.
+type KeyRevision struct {
+	Key            string
+	CreateRevision int64
+	ModRevision    int64
+	Version        int64
+}
+
+func keyRevisionFromKV(kv *mvccpb.KeyValue) KeyRevision {
+	return KeyRevision{
+		Key:            string(kv.Key),
+		CreateRevision: kv.CreateRevision,
+		ModRevision:    kv.ModRevision,
+		Version:        kv.Version,
+	}
+}
+
+// GetAllSourceCfgWithRevision gets all upstream source configs and the revisions of their keys at the revision.
+// k/v: source ID -> source config, source ID -> key revision.
+// if rev is 0, the latest revision is used. it also returns the revision the configs are read at.
+func GetAllSourceCfgWithRevision(cli *clientv3.Client, rev int64) (map[string]*config.SourceConfig, map[string]KeyRevision, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.UpstreamConfigKeyAdapter.Path(), clientv3.WithPrefix(), clientv3.WithRev(rev))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	var (
+		scm = make(map[string]*config.SourceConfig, len(resp.Kvs))
+		krm = make(map[string]KeyRevision, len(resp.Kvs))
+	)
+	for _, kv := range resp.Kvs {
+		var cfg config.SourceConfig
+		if err = cfg.Parse(string(kv.Value)); err != nil {
+			return nil, nil, 0, terror.ErrConfigEtcdParse.Delegate(err, kv.Key)
+		}
+		scm[cfg.SourceID] = &cfg
+		krm[cfg.SourceID] = keyRevisionFromKV(kv)
+	}
+	return scm, krm, resp.Header.Revision, nil
+}
+
+// GetAllSubTaskCfgWithRevision gets all subtask configs and the revisions of their keys at the revision.
+// k/v: source ID -> task name -> subtask config, source ID -> task name -> key revision.
+// if rev is 0, the latest revision is used. it also returns the revision the configs are read at.
+func GetAllSubTaskCfgWithRevision(cli *clientv3.Client, rev int64) (map[string]map[string]config.SubTaskConfig, map[string]map[string]KeyRevision, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.UpstreamSubTaskKeyAdapter.Path(), clientv3.WithPrefix(), clientv3.WithRev(rev))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	var (
+		cfgs = make(map[string]map[string]config.SubTaskConfig)
+		krm  = make(map[string]map[string]KeyRevision)
+	)
+	for _, kv := range resp.Kvs {
+		cfg := config.SubTaskConfig{}
+		if err = cfg.Decode(string(kv.Value), true); err != nil {
+			return nil, nil, 0, err
+		}
+		if _, ok := cfgs[cfg.SourceID]; !ok {
+			cfgs[cfg.SourceID] = make(map[string]config.SubTaskConfig)
+			krm[cfg.SourceID] = make(map[string]KeyRevision)
+		}
+		cfgs[cfg.SourceID][cfg.Name] = cfg
+		krm[cfg.SourceID][cfg.Name] = keyRevisionFromKV(kv)
+	}
+	return cfgs, krm, resp.Header.Revision, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/dm/config"
+)
+
+func (t *testForEtcd) TestConfigWithRevision(c *C) {
+	defer clearTestInfoOperation(c)
+
+	cfg, err := config.LoadFromFile(sourceSampleFilePath)
+	c.Assert(err, IsNil)
+	source := cfg.SourceID
+	c.Assert(cfg.From.Security.LoadTLSContent(), IsNil)
+
+	subCfg := config.SubTaskConfig{}
+	c.Assert(subCfg.DecodeFile(subTaskSampleFile, true), IsNil)
+	c.Assert(subCfg.SourceID, Equals, source)
+	task := subCfg.Name
+
+	// no config exists.
+	scm, srm, rev1, err := GetAllSourceCfgWithRevision(etcdTestCli, 0)
+	c.Assert(err, IsNil)
+	c.Assert(rev1, Greater, int64(0))
+	c.Assert(scm, HasLen, 0)
+	c.Assert(srm, HasLen, 0)
+	stm, trm, _, err := GetAllSubTaskCfgWithRevision(etcdTestCli, 0)
+	c.Assert(err, IsNil)
+	c.Assert(stm, HasLen, 0)
+	c.Assert(trm, HasLen, 0)
+
+	// put the configs, then update the source config.
+	rev2, err := PutSourceCfg(etcdTestCli, cfg)
+	c.Assert(err, IsNil)
+	rev3, err := PutSubTaskCfgStage(etcdTestCli, []config.SubTaskConfig{subCfg}, []Stage{})
+	c.Assert(err, IsNil)
+	cfg2 := *cfg
+	cfg2.EnableGTID = !cfg.EnableGTID
+	rev4, err := PutSourceCfg(etcdTestCli, &cfg2)
+	c.Assert(err, IsNil)
+
+	scm, srm, rev, err := GetAllSourceCfgWithRevision(etcdTestCli, 0)
+	c.Assert(err, IsNil)
+	c.Assert(rev, Equals, rev4)
+	c.Assert(scm, HasLen, 1)
+	c.Assert(scm[source], DeepEquals, &cfg2)
+	c.Assert(srm[source], DeepEquals, KeyRevision{
+		Key:            common.UpstreamConfigKeyAdapter.Encode(source),
+		CreateRevision: rev2,
+		ModRevision:    rev4,
+		Version:        2,
+	})
+
+	stm, trm, rev, err = GetAllSubTaskCfgWithRevision(etcdTestCli, 0)
+	c.Assert(err, IsNil)
+	c.Assert(rev, Equals, rev4)
+	c.Assert(stm[source][task], DeepEquals, subCfg)
+	c.Assert(trm[source][task], DeepEquals, KeyRevision{
+		Key:            common.UpstreamSubTaskKeyAdapter.Encode(source, task),
+		CreateRevision: rev3,
+		ModRevision:    rev3,
+		Version:        1,
+	})
+
+	// read the history of the source config by the revision.
+	scm, srm, rev, err = GetAllSourceCfgWithRevision(etcdTestCli, rev3)
+	c.Assert(err, IsNil)
+	c.Assert(rev, Equals, rev4)
+	c.Assert(scm[source], DeepEquals, cfg)
+	c.Assert(srm[source].ModRevision, Equals, rev2)
+	c.Assert(srm[source].Version, Equals, int64(1))
+}
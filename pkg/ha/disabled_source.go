@@ -0,0 +1,114 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/pkg/etcdutil"
+)
+
+// DisabledSource represents a source disabled by user, its relay and subtasks are paused,
+// but the config, bound and checkpoints are kept, so it can be enabled again.
+type DisabledSource struct {
+	Source string `json:"source"` // the source ID of the upstream.
+	// the relay and subtasks which are running before disabled, they will be resumed after enabled.
+	RelayRunning bool     `json:"relay-running"`
+	RunningTasks []string `json:"running-tasks"`
+}
+
+// NewDisabledSource creates a new DisabledSource instance.
+func NewDisabledSource(source string) DisabledSource {
+	return DisabledSource{Source: source}
+}
+
+// String implements Stringer interface.
+func (d DisabledSource) String() string {
+	s, _ := d.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (d DisabledSource) toJSON() (string, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// GetAllDisabledSource gets all disabled sources.
+// k/v: sourceID -> DisabledSource.
+func GetAllDisabledSource(cli *clientv3.Client) (map[string]DisabledSource, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.UpstreamDisabledKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dss := make(map[string]DisabledSource, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var ds DisabledSource
+		if err = json.Unmarshal(kv.Value, &ds); err != nil {
+			return nil, 0, err
+		}
+		dss[ds.Source] = ds
+	}
+	return dss, resp.Header.Revision, nil
+}
+
+// PutDisabledSourceStage puts the following data in one txn.
+// - disabled source.
+// - relay stage.
+// - subtask stage.
+func PutDisabledSourceStage(cli *clientv3.Client, ds DisabledSource, relayStages, subTaskStages []Stage) (int64, error) {
+	value, err := ds.toJSON()
+	if err != nil {
+		return 0, err
+	}
+	ops := []clientv3.Op{clientv3.OpPut(common.UpstreamDisabledKeyAdapter.Encode(ds.Source), value)}
+	return doOpsWithStages(cli, ops, relayStages, subTaskStages)
+}
+
+// DeleteDisabledSourceStage deletes the disabled source and puts the relay and subtask stages in one txn.
+func DeleteDisabledSourceStage(cli *clientv3.Client, source string, relayStages, subTaskStages []Stage) (int64, error) {
+	ops := []clientv3.Op{deleteDisabledSourceOp(source)}
+	return doOpsWithStages(cli, ops, relayStages, subTaskStages)
+}
+
+func doOpsWithStages(cli *clientv3.Client, ops []clientv3.Op, relayStages, subTaskStages []Stage) (int64, error) {
+	relayOps, err := putRelayStageOp(relayStages...)
+	if err != nil {
+		return 0, err
+	}
+	subTaskOps, err := putSubTaskStageOp(subTaskStages...)
+	if err != nil {
+		return 0, err
+	}
+	ops = append(ops, relayOps...)
+	ops = append(ops, subTaskOps...)
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, ops...)
+	return rev, err
+}
+
+// deleteDisabledSourceOp returns a DELETE etcd operation for the disabled source.
+func deleteDisabledSourceOp(source string) clientv3.Op {
+	return clientv3.OpDelete(common.UpstreamDisabledKeyAdapter.Encode(source))
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/pb"
+)
+
+func (t *testForEtcd) TestDisabledSourceEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		source = "mysql-replica-1"
+		task1  = "task-1"
+		task2  = "task-2"
+	)
+	dss, rev1, err := GetAllDisabledSource(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(dss, HasLen, 0)
+
+	// disable the source, pause the relay and the running subtask.
+	ds := NewDisabledSource(source)
+	ds.RelayRunning = true
+	ds.RunningTasks = []string{task1}
+	rev2, err := PutDisabledSourceStage(etcdTestCli, ds,
+		[]Stage{NewRelayStage(pb.Stage_Paused, source)}, []Stage{NewSubTaskStage(pb.Stage_Paused, source, task1)})
+	c.Assert(err, IsNil)
+	c.Assert(rev2, Greater, rev1)
+
+	dss, rev3, err := GetAllDisabledSource(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(rev3, Equals, rev2)
+	c.Assert(dss, DeepEquals, map[string]DisabledSource{source: ds})
+	relayStage, _, err := GetRelayStage(etcdTestCli, source)
+	c.Assert(err, IsNil)
+	c.Assert(relayStage.Expect, Equals, pb.Stage_Paused)
+	stm, _, err := GetSubTaskStage(etcdTestCli, source, task1)
+	c.Assert(err, IsNil)
+	c.Assert(stm[task1].Expect, Equals, pb.Stage_Paused)
+
+	// enable the source, resume the relay and the subtask.
+	_, err = DeleteDisabledSourceStage(etcdTestCli, source,
+		[]Stage{NewRelayStage(pb.Stage_Running, source)}, []Stage{NewSubTaskStage(pb.Stage_Running, source, task1)})
+	c.Assert(err, IsNil)
+	dss, _, err = GetAllDisabledSource(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(dss, HasLen, 0)
+	relayStage, _, err = GetRelayStage(etcdTestCli, source)
+	c.Assert(err, IsNil)
+	c.Assert(relayStage.Expect, Equals, pb.Stage_Running)
+	stm, _, err = GetSubTaskStage(etcdTestCli, source, task1)
+	c.Assert(err, IsNil)
+	c.Assert(stm[task1].Expect, Equals, pb.Stage_Running)
+	stm, _, err = GetSubTaskStage(etcdTestCli, source, task2)
+	c.Assert(err, IsNil)
+	c.Assert(stm, HasLen, 0)
+
+	// the disabled source is deleted with the source config.
+	_, err = PutDisabledSourceStage(etcdTestCli, NewDisabledSource(source), nil, nil)
+	c.Assert(err, IsNil)
+	_, err = DeleteSourceCfgRelayStageSourceBound(etcdTestCli, source, "")
+	c.Assert(err, IsNil)
+	dss, _, err = GetAllDisabledSource(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(dss, HasLen, 0)
+}
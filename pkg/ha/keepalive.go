@@ -80,6 +80,14 @@ func workerEventFromKey(key string) (WorkerEvent, error) {
 // this key will be kept in etcd until the worker is blocked or failed
 // k/v: workerName -> join time.
 func KeepAlive(ctx context.Context, cli *clientv3.Client, workerName string, keepAliveTTL int64) error {
+	return KeepAliveWithLoad(ctx, cli, workerName, keepAliveTTL, 0, nil)
+}
+
+// KeepAliveWithLoad acts like KeepAlive, and it also reports the load of the worker returned by `loadFn` every
+// `reportInterval` with the same lease, so the load is deleted along with the keepalive key.
+// the load is not reported if `loadFn` is nil.
+func KeepAliveWithLoad(ctx context.Context, cli *clientv3.Client, workerName string, keepAliveTTL int64,
+	reportInterval time.Duration, loadFn func() WorkerLoad) error {
 	// TTL in KeepAliveUpdateCh has higher priority
 	for len(KeepAliveUpdateCh) > 0 {
 		keepAliveTTL = <-KeepAliveUpdateCh
@@ -115,6 +123,22 @@ func KeepAlive(ctx context.Context, cli *clientv3.Client, workerName string, kee
 		return err
 	}
 
+	var reportCh <-chan time.Time
+	reportLoad := func() {
+		if loadFn == nil {
+			return
+		}
+		if err2 := putWorkerLoad(cli, loadFn(), leaseID); err2 != nil {
+			log.L().Warn("fail to report the load of worker", zap.Error(err2))
+		}
+	}
+	if loadFn != nil && reportInterval > 0 {
+		ticker := time.NewTicker(reportInterval)
+		defer ticker.Stop()
+		reportCh = ticker.C
+	}
+	reportLoad()
+
 	// once we put the key successfully, we should revoke lease before we quit keepalive normally
 	defer func() {
 		_, err2 := revokeLease(cli, leaseID)
@@ -140,6 +164,8 @@ func KeepAlive(ctx context.Context, cli *clientv3.Client, workerName string, kee
 		case <-ctx.Done():
 			log.L().Info("ctx is canceled, keepalive will exit now")
 			return nil
+		case <-reportCh:
+			reportLoad()
 		case newTTL := <-KeepAliveUpdateCh:
 			if newTTL == currentKeepAliveTTL {
 				log.L().Info("ignore same keepalive TTL change", zap.Int64("TTL", newTTL))
@@ -161,6 +187,8 @@ func KeepAlive(ctx context.Context, cli *clientv3.Client, workerName string, kee
 			}
 			currentKeepAliveTTL = newTTL
 			log.L().Info("dynamically changed keepalive TTL to", zap.Int64("ttl in seconds", newTTL))
+			// attach the load to the new lease before the old one is revoked.
+			reportLoad()
 
 			// after new keepalive succeed, we cancel the old keepalive
 			_, err2 := revokeLease(cli, oldLeaseID)
@@ -0,0 +1,141 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/pkg/etcdutil"
+)
+
+// OperationRecord represents an audit record of a mutating operation on DM-master, like starting a task or purging
+// the relay log, it records who did the operation, with what arguments and the outcome of it.
+type OperationRecord struct {
+	ID         string    `json:"id"` // ordered by the time of the operation
+	Time       time.Time `json:"time"`
+	Method     string    `json:"method"`
+	Operator   string    `json:"operator"`    // the operator reported by the client, like the OS user of dmctl
+	ClientType string    `json:"client-type"` // "dmctl", "http" or "default"
+	ClientHost string    `json:"client-host"`
+	Master     string    `json:"master"` // the DM-master which received the request
+	Args       string    `json:"args"`   // the request with passwords hidden
+	Result     bool      `json:"result"`
+	Msg        string    `json:"msg"`
+}
+
+// NewOperationRecord creates a new OperationRecord instance, the ID is generated from the time.
+func NewOperationRecord(t time.Time, method, operator, clientType, clientHost, master, args string, result bool, msg string) OperationRecord {
+	return OperationRecord{
+		ID:         fmt.Sprintf("%020d", t.UnixNano()),
+		Time:       t,
+		Method:     method,
+		Operator:   operator,
+		ClientType: clientType,
+		ClientHost: clientHost,
+		Master:     master,
+		Args:       args,
+		Result:     result,
+		Msg:        msg,
+	}
+}
+
+// String implements Stringer interface.
+func (r OperationRecord) String() string {
+	s, _ := r.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (r OperationRecord) toJSON() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// PutOperationRecord puts the operation record into etcd.
+func PutOperationRecord(cli *clientv3.Client, r OperationRecord) (int64, error) {
+	value, err := r.toJSON()
+	if err != nil {
+		return 0, err
+	}
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpPut(common.OperationAuditKeyAdapter.Encode(r.ID), value))
+	return rev, err
+}
+
+// GetOperationRecords gets the latest `limit` operation records, newer records come first.
+// all records are returned if `limit` is not positive.
+func GetOperationRecords(cli *clientv3.Client, limit int64) ([]OperationRecord, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	opts := []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend)}
+	if limit > 0 {
+		opts = append(opts, clientv3.WithLimit(limit))
+	}
+	resp, err := cli.Get(ctx, common.OperationAuditKeyAdapter.Path(), opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	records := make([]OperationRecord, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var r OperationRecord
+		if err = json.Unmarshal(kv.Value, &r); err != nil {
+			return nil, 0, err
+		}
+		records = append(records, r)
+	}
+	return records, resp.Header.Revision, nil
+}
+
+// TrimOperationRecords deletes the oldest operation records to keep at most `maxRecords` records.
+// the first return value is the number of the deleted records.
+func TrimOperationRecords(cli *clientv3.Client, maxRecords int) (int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	prefix := common.OperationAuditKeyAdapter.Path()
+	resp, err := cli.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return 0, err
+	}
+	exceeded := resp.Count - int64(maxRecords)
+	if exceeded <= 0 {
+		return 0, nil
+	}
+
+	// find the newest one of the records to delete, and delete all records older than it.
+	resp, err = cli.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly(),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend), clientv3.WithLimit(exceeded))
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+	end := string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	txnResp, _, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpDelete(prefix, clientv3.WithRange(end)))
+	if err != nil {
+		return 0, err
+	}
+	return txnResp.Responses[0].GetResponseDeleteRange().Deleted, nil
+}
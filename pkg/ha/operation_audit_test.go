@@ -0,0 +1,70 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestOperationAuditEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	now := time.Now()
+	records := []OperationRecord{
+		NewOperationRecord(now, "StartTask", "alice", "dmctl", "127.0.0.1", "dm-master-1", `task:"name: test"`, true, ""),
+		NewOperationRecord(now.Add(time.Second), "OperateTask", "bob", "http", "127.0.0.2", "dm-master-1", `op:Pause name:"test"`, false, "task test not exist"),
+		NewOperationRecord(now.Add(2*time.Second), "PurgeWorkerRelay", "", "default", "127.0.0.3", "dm-master-2", `inactive:true`, true, ""),
+	}
+	c.Assert(records[0].ID < records[1].ID, IsTrue)
+
+	rs, _, err := GetOperationRecords(etcdTestCli, 0)
+	c.Assert(err, IsNil)
+	c.Assert(rs, HasLen, 0)
+
+	for _, r := range records {
+		_, err = PutOperationRecord(etcdTestCli, r)
+		c.Assert(err, IsNil)
+	}
+
+	// newer records come first.
+	rs, _, err = GetOperationRecords(etcdTestCli, 0)
+	c.Assert(err, IsNil)
+	c.Assert(rs, HasLen, 3)
+	for i, r := range rs {
+		expected := records[len(records)-1-i]
+		c.Assert(r.ID, Equals, expected.ID)
+		c.Assert(r.Time.Equal(expected.Time), IsTrue)
+		r.Time = expected.Time
+		c.Assert(r, DeepEquals, expected)
+	}
+	rs, _, err = GetOperationRecords(etcdTestCli, 2)
+	c.Assert(err, IsNil)
+	c.Assert(rs, HasLen, 2)
+	c.Assert(rs[0].ID, Equals, records[2].ID)
+	c.Assert(rs[1].ID, Equals, records[1].ID)
+
+	// trim the oldest records.
+	deleted, err := TrimOperationRecords(etcdTestCli, 3)
+	c.Assert(err, IsNil)
+	c.Assert(deleted, Equals, int64(0))
+	deleted, err = TrimOperationRecords(etcdTestCli, 1)
+	c.Assert(err, IsNil)
+	c.Assert(deleted, Equals, int64(2))
+	rs, _, err = GetOperationRecords(etcdTestCli, 0)
+	c.Assert(err, IsNil)
+	c.Assert(rs, HasLen, 1)
+	c.Assert(rs[0].ID, Equals, records[2].ID)
+}
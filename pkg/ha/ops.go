@@ -47,16 +47,19 @@ func PutRelayStageRelayConfigSourceBound(cli *clientv3.Client, stage Stage, boun
 // - upstream source config.
 // - relay stage.
 // - source bound relationship.
+// - disabled source.
 func DeleteSourceCfgRelayStageSourceBound(cli *clientv3.Client, source, worker string) (int64, error) {
 	sourceCfgOp := deleteSourceCfgOp(source)
 	relayStageOp := deleteRelayStageOp(source)
 	sourceBoundOp := deleteSourceBoundOp(worker)
 	lastBoundOp := deleteLastSourceBoundOp(worker)
-	ops := make([]clientv3.Op, 0, 3+len(sourceBoundOp))
+	disabledSourceOp := deleteDisabledSourceOp(source)
+	ops := make([]clientv3.Op, 0, 4+len(sourceBoundOp))
 	ops = append(ops, sourceCfgOp)
 	ops = append(ops, relayStageOp)
 	ops = append(ops, sourceBoundOp...)
 	ops = append(ops, lastBoundOp)
+	ops = append(ops, disabledSourceOp)
 
 	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, ops...)
 	return rev, err
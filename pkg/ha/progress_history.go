@@ -0,0 +1,169 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/etcdutil"
+)
+
+// SubTaskProgress represents the progress of a subtask in a ProgressRecord.
+type SubTaskProgress struct {
+	Source   string      `json:"source"`
+	Stage    pb.Stage    `json:"stage"`
+	Unit     pb.UnitType `json:"unit"`
+	Location string      `json:"location"` // the binlog location of the sync unit, or the progress of the load unit
+	GTID     string      `json:"gtid"`
+	Rows     int64       `json:"rows"` // the rows replicated by the sync unit, or the rows restored by the load unit
+	Errors   []string    `json:"errors,omitempty"`
+}
+
+// ProgressRecord represents the progress of the subtasks of a task recorded periodically by DM-master, it's used to
+// find out how far the task progressed over a time window.
+type ProgressRecord struct {
+	ID       string            `json:"id"` // ordered by the time of the record
+	Time     time.Time         `json:"time"`
+	Task     string            `json:"task"`
+	SubTasks []SubTaskProgress `json:"subtasks"`
+}
+
+// progressRecordID returns the ID of the record at the time, it's also used to seek the records by time.
+func progressRecordID(t time.Time) string {
+	return fmt.Sprintf("%020d", t.UnixNano())
+}
+
+// NewProgressRecord creates a new ProgressRecord instance, the ID is generated from the time.
+func NewProgressRecord(t time.Time, task string, subTasks []SubTaskProgress) ProgressRecord {
+	return ProgressRecord{
+		ID:       progressRecordID(t),
+		Time:     t,
+		Task:     task,
+		SubTasks: subTasks,
+	}
+}
+
+// String implements Stringer interface.
+func (r ProgressRecord) String() string {
+	s, _ := r.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (r ProgressRecord) toJSON() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// PutProgressRecords puts the progress records into etcd.
+func PutProgressRecords(cli *clientv3.Client, records ...ProgressRecord) (int64, error) {
+	ops := make([]clientv3.Op, 0, len(records))
+	for _, r := range records {
+		value, err := r.toJSON()
+		if err != nil {
+			return 0, err
+		}
+		ops = append(ops, clientv3.OpPut(common.ProgressHistoryKeyAdapter.Encode(r.Task, r.ID), value))
+	}
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, ops...)
+	return rev, err
+}
+
+// GetProgressRecords gets the progress records of the task since the time, older records come first.
+// the records of all tasks are returned if `task` is empty.
+func GetProgressRecords(cli *clientv3.Client, task string, since time.Time) ([]ProgressRecord, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	var (
+		resp *clientv3.GetResponse
+		err  error
+	)
+	if task != "" {
+		prefix := common.ProgressHistoryKeyAdapter.Encode(task)
+		resp, err = cli.Get(ctx, common.ProgressHistoryKeyAdapter.Encode(task, progressRecordID(since)),
+			clientv3.WithRange(clientv3.GetPrefixRangeEnd(prefix)), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	} else {
+		resp, err = cli.Get(ctx, common.ProgressHistoryKeyAdapter.Path(), clientv3.WithPrefix(),
+			clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	records := make([]ProgressRecord, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var r ProgressRecord
+		if err = json.Unmarshal(kv.Value, &r); err != nil {
+			return nil, 0, err
+		}
+		if r.Time.Before(since) {
+			continue
+		}
+		records = append(records, r)
+	}
+	return records, resp.Header.Revision, nil
+}
+
+// DeleteProgressRecordsBefore deletes the progress records of all tasks older than the time.
+// the first return value is the number of the deleted records.
+func DeleteProgressRecordsBefore(cli *clientv3.Client, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.ProgressHistoryKeyAdapter.Path(), clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return 0, err
+	}
+
+	// the records of a task are ordered by the ID, so they can be deleted by range.
+	endID := progressRecordID(before)
+	tasks := make(map[string]struct{})
+	var ops []clientv3.Op
+	for _, kv := range resp.Kvs {
+		keys, err2 := common.ProgressHistoryKeyAdapter.Decode(string(kv.Key))
+		if err2 != nil {
+			return 0, err2
+		}
+		task := keys[0]
+		if _, ok := tasks[task]; ok || keys[1] >= endID {
+			continue
+		}
+		tasks[task] = struct{}{}
+		ops = append(ops, clientv3.OpDelete(common.ProgressHistoryKeyAdapter.Encode(task),
+			clientv3.WithRange(common.ProgressHistoryKeyAdapter.Encode(task, endID))))
+	}
+	if len(ops) == 0 {
+		return 0, nil
+	}
+	txnResp, _, err := etcdutil.DoOpsInOneTxnWithRetry(cli, ops...)
+	if err != nil {
+		return 0, err
+	}
+	var deleted int64
+	for _, r := range txnResp.Responses {
+		deleted += r.GetResponseDeleteRange().Deleted
+	}
+	return deleted, nil
+}
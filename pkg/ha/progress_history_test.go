@@ -0,0 +1,78 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/pb"
+)
+
+func (t *testForEtcd) TestProgressHistoryEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	now := time.Now()
+	progress := []SubTaskProgress{{
+		Source:   "mysql-replica-1",
+		Stage:    pb.Stage_Running,
+		Unit:     pb.UnitType_Sync,
+		Location: "(mysql-bin.000001, 2345)",
+		Rows:     100,
+	}}
+	records := []ProgressRecord{
+		NewProgressRecord(now.Add(-2*time.Hour), "task1", progress),
+		NewProgressRecord(now.Add(-time.Hour), "task1", progress),
+		NewProgressRecord(now.Add(-time.Hour), "task2", nil),
+		NewProgressRecord(now, "task1", progress),
+	}
+
+	rs, _, err := GetProgressRecords(etcdTestCli, "", time.Time{})
+	c.Assert(err, IsNil)
+	c.Assert(rs, HasLen, 0)
+
+	_, err = PutProgressRecords(etcdTestCli, records...)
+	c.Assert(err, IsNil)
+
+	// older records come first.
+	rs, _, err = GetProgressRecords(etcdTestCli, "task1", time.Time{})
+	c.Assert(err, IsNil)
+	c.Assert(rs, HasLen, 3)
+	for i, r := range rs {
+		expected := []ProgressRecord{records[0], records[1], records[3]}[i]
+		c.Assert(r.Time.Equal(expected.Time), IsTrue)
+		r.Time = expected.Time
+		c.Assert(r, DeepEquals, expected)
+	}
+	rs, _, err = GetProgressRecords(etcdTestCli, "task1", now.Add(-90*time.Minute))
+	c.Assert(err, IsNil)
+	c.Assert(rs, HasLen, 2)
+	c.Assert(rs[0].ID, Equals, records[1].ID)
+	rs, _, err = GetProgressRecords(etcdTestCli, "", now.Add(-90*time.Minute))
+	c.Assert(err, IsNil)
+	c.Assert(rs, HasLen, 3)
+
+	// delete the old records of all tasks.
+	deleted, err := DeleteProgressRecordsBefore(etcdTestCli, now.Add(-3*time.Hour))
+	c.Assert(err, IsNil)
+	c.Assert(deleted, Equals, int64(0))
+	deleted, err = DeleteProgressRecordsBefore(etcdTestCli, now.Add(-time.Minute))
+	c.Assert(err, IsNil)
+	c.Assert(deleted, Equals, int64(3))
+	rs, _, err = GetProgressRecords(etcdTestCli, "", time.Time{})
+	c.Assert(err, IsNil)
+	c.Assert(rs, HasLen, 1)
+	c.Assert(rs[0].ID, Equals, records[3].ID)
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/pkg/etcdutil"
+)
+
+// Project represents a migration project, which groups the tasks of the dump, load and sync phases of one logical
+// migration, like a task of `full` mode followed by a task of `incremental` mode.
+type Project struct {
+	Name  string   `json:"name"`
+	Tasks []string `json:"tasks"`
+}
+
+// NewProject creates a new Project instance.
+func NewProject(name string, tasks []string) Project {
+	return Project{
+		Name:  name,
+		Tasks: tasks,
+	}
+}
+
+// String implements Stringer interface.
+func (p Project) String() string {
+	s, _ := p.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (p Project) toJSON() (string, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// PutProjectIfNotExist puts the project into etcd if no project with the same name exists.
+// the first return value indicates whether the project is put.
+func PutProjectIfNotExist(cli *clientv3.Client, p Project) (bool, int64, error) {
+	value, err := p.toJSON()
+	if err != nil {
+		return false, 0, err
+	}
+	key := common.ProjectKeyAdapter.Encode(p.Name)
+	cmp := clientv3.Compare(clientv3.CreateRevision(key), "=", 0)
+	resp, rev, err := etcdutil.DoOpsInOneCmpsTxnWithRetry(cli, []clientv3.Cmp{cmp}, []clientv3.Op{clientv3.OpPut(key, value)}, []clientv3.Op{})
+	if err != nil {
+		return false, 0, err
+	}
+	return resp.Succeeded, rev, nil
+}
+
+// GetProject gets the project by name.
+// the second return value indicates whether the project exists.
+func GetProject(cli *clientv3.Client, name string) (Project, bool, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	var p Project
+	resp, err := cli.Get(ctx, common.ProjectKeyAdapter.Encode(name))
+	if err != nil {
+		return p, false, 0, err
+	}
+	if resp.Count == 0 {
+		return p, false, resp.Header.Revision, nil
+	}
+	if err = json.Unmarshal(resp.Kvs[0].Value, &p); err != nil {
+		return p, false, 0, err
+	}
+	return p, true, resp.Header.Revision, nil
+}
+
+// GetAllProject gets all projects.
+// k/v: project-name -> Project.
+func GetAllProject(cli *clientv3.Client) (map[string]Project, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.ProjectKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	projects := make(map[string]Project, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var p Project
+		if err = json.Unmarshal(kv.Value, &p); err != nil {
+			return nil, 0, err
+		}
+		projects[p.Name] = p
+	}
+	return projects, resp.Header.Revision, nil
+}
+
+// DeleteProject deletes the project, the tasks of it are not affected.
+// the first return value indicates whether the project existed.
+func DeleteProject(cli *clientv3.Client, name string) (bool, int64, error) {
+	resp, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpDelete(common.ProjectKeyAdapter.Encode(name)))
+	if err != nil {
+		return false, 0, err
+	}
+	return resp.Responses[0].GetResponseDeleteRange().Deleted > 0, rev, nil
+}
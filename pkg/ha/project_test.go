@@ -0,0 +1,65 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestProjectEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		project1 = NewProject("project-1", []string{"task-full", "task-incr"})
+		project2 = NewProject("project-2", []string{"task-all"})
+	)
+
+	_, ok, _, err := GetProject(etcdTestCli, project1.Name)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+
+	// put the projects.
+	ok, rev1, err := PutProjectIfNotExist(etcdTestCli, project1)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+	ok, rev2, err := PutProjectIfNotExist(etcdTestCli, project2)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+	c.Assert(rev2, Greater, rev1)
+
+	// can't put again.
+	ok, _, err = PutProjectIfNotExist(etcdTestCli, NewProject(project1.Name, []string{"task-other"}))
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+
+	p, ok, _, err := GetProject(etcdTestCli, project1.Name)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+	c.Assert(p, DeepEquals, project1)
+	projects, rev3, err := GetAllProject(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(rev3, Equals, rev2)
+	c.Assert(projects, DeepEquals, map[string]Project{project1.Name: project1, project2.Name: project2})
+
+	// delete the project.
+	ok, _, err = DeleteProject(etcdTestCli, project1.Name)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+	ok, _, err = DeleteProject(etcdTestCli, project1.Name)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+	projects, _, err = GetAllProject(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(projects, DeepEquals, map[string]Project{project2.Name: project2})
+}
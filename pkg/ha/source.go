@@ -129,13 +129,21 @@ func ClearTestInfoOperation(cli *clientv3.Client) error {
 	clearSubTask := clientv3.OpDelete(common.UpstreamSubTaskKeyAdapter.Path(), clientv3.WithPrefix())
 	clearWorkerInfo := clientv3.OpDelete(common.WorkerRegisterKeyAdapter.Path(), clientv3.WithPrefix())
 	clearWorkerKeepAlive := clientv3.OpDelete(common.WorkerKeepAliveKeyAdapter.Path(), clientv3.WithPrefix())
+	clearWorkerLoad := clientv3.OpDelete(common.WorkerLoadKeyAdapter.Path(), clientv3.WithPrefix())
 	clearBound := clientv3.OpDelete(common.UpstreamBoundWorkerKeyAdapter.Path(), clientv3.WithPrefix())
 	clearLastBound := clientv3.OpDelete(common.UpstreamLastBoundWorkerKeyAdapter.Path(), clientv3.WithPrefix())
 	clearRelayStage := clientv3.OpDelete(common.StageRelayKeyAdapter.Path(), clientv3.WithPrefix())
 	clearRelayConfig := clientv3.OpDelete(common.UpstreamRelayWorkerKeyAdapter.Path(), clientv3.WithPrefix())
 	clearSubTaskStage := clientv3.OpDelete(common.StageSubTaskKeyAdapter.Path(), clientv3.WithPrefix())
 	clearLoadTasks := clientv3.OpDelete(common.LoadTaskKeyAdapter.Path(), clientv3.WithPrefix())
+	clearDisabledSource := clientv3.OpDelete(common.UpstreamDisabledKeyAdapter.Path(), clientv3.WithPrefix())
+	clearTaskLock := clientv3.OpDelete(common.TaskLockKeyAdapter.Path(), clientv3.WithPrefix())
+	clearProject := clientv3.OpDelete(common.ProjectKeyAdapter.Path(), clientv3.WithPrefix())
+	clearOperationAudit := clientv3.OpDelete(common.OperationAuditKeyAdapter.Path(), clientv3.WithPrefix())
+	clearProgressHistory := clientv3.OpDelete(common.ProgressHistoryKeyAdapter.Path(), clientv3.WithPrefix())
+	clearClusterUpgrade := clientv3.OpDelete(common.ClusterUpgradeKey)
 	_, _, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clearSource, clearSubTask, clearWorkerInfo, clearBound,
-		clearLastBound, clearWorkerKeepAlive, clearRelayStage, clearRelayConfig, clearSubTaskStage, clearLoadTasks)
+		clearLastBound, clearWorkerKeepAlive, clearWorkerLoad, clearRelayStage, clearRelayConfig, clearSubTaskStage, clearLoadTasks,
+		clearDisabledSource, clearTaskLock, clearProject, clearOperationAudit, clearProgressHistory, clearClusterUpgrade)
 	return err
 }
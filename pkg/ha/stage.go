@@ -34,6 +34,8 @@ type Stage struct {
 	Expect pb.Stage `json:"expect"`         // the expectant stage.
 	Source string   `json:"source"`         // the source ID of the upstream.
 	Task   string   `json:"task,omitempty"` // the task name for subtask; empty for relay.
+	// whether to reset the error budget of the subtask when resuming it, only used for subtask.
+	ResetErrorBudget bool `json:"reset-error-budget,omitempty"`
 
 	// only used to report to the caller of the watcher, do not marsh it.
 	// if it's true, it means the stage has been deleted in etcd.
@@ -0,0 +1,122 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/pkg/etcdutil"
+)
+
+// TaskLock represents a lock on a task held by an operator, like "task held by alice for maintenance until T".
+// the mutating operations on a locked task are rejected unless forced, until the lock is released or expired.
+type TaskLock struct {
+	Task   string    `json:"task"`
+	Holder string    `json:"holder"`
+	Reason string    `json:"reason"`
+	Expire time.Time `json:"expire"`
+}
+
+// NewTaskLock creates a new TaskLock instance.
+func NewTaskLock(task, holder, reason string, expire time.Time) TaskLock {
+	return TaskLock{
+		Task:   task,
+		Holder: holder,
+		Reason: reason,
+		Expire: expire,
+	}
+}
+
+// Expired returns whether the lock is expired at `now`.
+func (l TaskLock) Expired(now time.Time) bool {
+	return !now.Before(l.Expire)
+}
+
+// String implements Stringer interface.
+func (l TaskLock) String() string {
+	s, _ := l.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (l TaskLock) toJSON() (string, error) {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// PutTaskLock puts the lock of the task into etcd, the previous lock of the task is overwritten.
+func PutTaskLock(cli *clientv3.Client, l TaskLock) (int64, error) {
+	value, err := l.toJSON()
+	if err != nil {
+		return 0, err
+	}
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpPut(common.TaskLockKeyAdapter.Encode(l.Task), value))
+	return rev, err
+}
+
+// GetTaskLock gets the lock of the task, the lock may be expired.
+// the second return value indicates whether the lock exists.
+func GetTaskLock(cli *clientv3.Client, task string) (TaskLock, bool, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	var l TaskLock
+	resp, err := cli.Get(ctx, common.TaskLockKeyAdapter.Encode(task))
+	if err != nil {
+		return l, false, 0, err
+	}
+	if resp.Count == 0 {
+		return l, false, resp.Header.Revision, nil
+	}
+	if err = json.Unmarshal(resp.Kvs[0].Value, &l); err != nil {
+		return l, false, 0, err
+	}
+	return l, true, resp.Header.Revision, nil
+}
+
+// GetAllTaskLock gets the locks of all tasks, the locks may be expired.
+// k/v: task-name -> TaskLock.
+func GetAllTaskLock(cli *clientv3.Client) (map[string]TaskLock, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.TaskLockKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	locks := make(map[string]TaskLock, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var l TaskLock
+		if err = json.Unmarshal(kv.Value, &l); err != nil {
+			return nil, 0, err
+		}
+		locks[l.Task] = l
+	}
+	return locks, resp.Header.Revision, nil
+}
+
+// DeleteTaskLock deletes the lock of the task.
+func DeleteTaskLock(cli *clientv3.Client, task string) (int64, error) {
+	_, rev, err := etcdutil.DoOpsInOneTxnWithRetry(cli, clientv3.OpDelete(common.TaskLockKeyAdapter.Encode(task)))
+	return rev, err
+}
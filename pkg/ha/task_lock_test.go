@@ -0,0 +1,81 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (t *testForEtcd) TestTaskLockEtcd(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		task1  = "task-1"
+		task2  = "task-2"
+		now    = time.Now()
+		lock1  = NewTaskLock(task1, "alice", "maintenance", now.Add(time.Hour))
+		lock2  = NewTaskLock(task2, "bob", "", now.Add(-time.Minute))
+		equals = func(l1, l2 TaskLock) {
+			c.Assert(l1.Task, Equals, l2.Task)
+			c.Assert(l1.Holder, Equals, l2.Holder)
+			c.Assert(l1.Reason, Equals, l2.Reason)
+			c.Assert(l1.Expire.Equal(l2.Expire), IsTrue)
+		}
+	)
+	c.Assert(lock1.Expired(now), IsFalse)
+	c.Assert(lock1.Expired(lock1.Expire), IsTrue)
+	c.Assert(lock2.Expired(now), IsTrue)
+
+	_, ok, _, err := GetTaskLock(etcdTestCli, task1)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+
+	// put the locks.
+	rev1, err := PutTaskLock(etcdTestCli, lock1)
+	c.Assert(err, IsNil)
+	rev2, err := PutTaskLock(etcdTestCli, lock2)
+	c.Assert(err, IsNil)
+	c.Assert(rev2, Greater, rev1)
+
+	l, ok, _, err := GetTaskLock(etcdTestCli, task1)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsTrue)
+	equals(l, lock1)
+	locks, rev3, err := GetAllTaskLock(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(rev3, Equals, rev2)
+	c.Assert(locks, HasLen, 2)
+	equals(locks[task1], lock1)
+	equals(locks[task2], lock2)
+
+	// overwrite the lock.
+	lock1.Holder = "bob"
+	_, err = PutTaskLock(etcdTestCli, lock1)
+	c.Assert(err, IsNil)
+	l, _, _, err = GetTaskLock(etcdTestCli, task1)
+	c.Assert(err, IsNil)
+	equals(l, lock1)
+
+	// delete the lock.
+	_, err = DeleteTaskLock(etcdTestCli, task1)
+	c.Assert(err, IsNil)
+	_, ok, _, err = GetTaskLock(etcdTestCli, task1)
+	c.Assert(err, IsNil)
+	c.Assert(ok, IsFalse)
+	locks, _, err = GetAllTaskLock(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(locks, HasLen, 1)
+}
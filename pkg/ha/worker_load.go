@@ -0,0 +1,89 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/pkg/etcdutil"
+)
+
+// WorkerLoad represents the load and labels of the DM-worker reported along with the keepalive.
+type WorkerLoad struct {
+	Name         string            `json:"name"`
+	CPUUsage     float64           `json:"cpu-usage"`    // the CPU usage of the node in percent.
+	MemoryUsage  float64           `json:"memory-usage"` // the memory usage of the node in percent.
+	DiskUsage    float64           `json:"disk-usage"`   // the disk usage of the deploy directory in percent.
+	BoundSources int               `json:"bound-sources"`
+	Labels       map[string]string `json:"labels,omitempty"`
+}
+
+// String implements Stringer interface.
+func (l WorkerLoad) String() string {
+	s, _ := l.toJSON()
+	return s
+}
+
+// toJSON returns the string of JSON represent.
+func (l WorkerLoad) toJSON() (string, error) {
+	data, err := json.Marshal(l)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// workerLoadFromJSON constructs WorkerLoad from its JSON represent.
+func workerLoadFromJSON(s string) (l WorkerLoad, err error) {
+	err = json.Unmarshal([]byte(s), &l)
+	return
+}
+
+// putWorkerLoad puts the load of the DM-worker into etcd with the lease of the keepalive.
+func putWorkerLoad(cli *clientv3.Client, load WorkerLoad, leaseID clientv3.LeaseID) error {
+	value, err := load.toJSON()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+	_, err = cli.Put(ctx, common.WorkerLoadKeyAdapter.Encode(load.Name), value, clientv3.WithLease(leaseID))
+	return err
+}
+
+// GetAllWorkerLoads gets the load of all DM-workers which are keeping alive.
+// k/v: worker-name -> worker load.
+func GetAllWorkerLoads(cli *clientv3.Client) (map[string]WorkerLoad, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.WorkerLoadKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	loads := make(map[string]WorkerLoad, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		load, err2 := workerLoadFromJSON(string(kv.Value))
+		if err2 != nil {
+			return nil, 0, err2
+		}
+		loads[load.Name] = load
+	}
+	return loads, resp.Header.Revision, nil
+}
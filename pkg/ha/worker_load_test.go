@@ -0,0 +1,69 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+func (t *testForEtcd) TestKeepAliveWithLoad(c *C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		worker  = "worker-1"
+		reports int32
+		labels  = map[string]string{"zone": "z1"}
+	)
+	loadFn := func() WorkerLoad {
+		return WorkerLoad{
+			Name:         worker,
+			CPUUsage:     10,
+			BoundSources: int(atomic.AddInt32(&reports, 1)),
+			Labels:       labels,
+		}
+	}
+
+	loads, _, err := GetAllWorkerLoads(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(loads, HasLen, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.Assert(KeepAliveWithLoad(ctx, etcdTestCli, worker, 10, 50*time.Millisecond, loadFn), IsNil)
+		close(done)
+	}()
+
+	// the load is reported periodically.
+	c.Assert(utils.WaitSomething(30, 100*time.Millisecond, func() bool {
+		loads, _, err = GetAllWorkerLoads(etcdTestCli)
+		c.Assert(err, IsNil)
+		return len(loads) == 1 && loads[worker].BoundSources > 1
+	}), IsTrue)
+	c.Assert(loads[worker].CPUUsage, Equals, float64(10))
+	c.Assert(loads[worker].Labels, DeepEquals, labels)
+
+	// the load is deleted along with the keepalive key.
+	cancel()
+	<-done
+	loads, _, err = GetAllWorkerLoads(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(loads, HasLen, 0)
+}
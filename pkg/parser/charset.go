@@ -0,0 +1,159 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+)
+
+// ConvertCharset converts the charsets and collations of the databases, tables and columns in a DDL statement by
+// convert, which returns the charset and collation to convert to, and false if the charset is not converted.
+// the collations of a converted charset are replaced by the returned collation, or dropped if it's empty.
+// it returns whether the statement is changed.
+func ConvertCharset(stmt ast.StmtNode, convert func(cs string) (string, string, bool)) bool {
+	changed := false
+	switch s := stmt.(type) {
+	case *ast.CreateDatabaseStmt:
+		s.Options, changed = convertDatabaseOptions(s.Options, convert)
+	case *ast.AlterDatabaseStmt:
+		s.Options, changed = convertDatabaseOptions(s.Options, convert)
+	case *ast.CreateTableStmt:
+		for _, col := range s.Cols {
+			changed = convertColumnCharset(col, convert) || changed
+		}
+		var optionsChanged bool
+		s.Options, optionsChanged = convertTableOptions(s.Options, convert)
+		changed = changed || optionsChanged
+	case *ast.AlterTableStmt:
+		for _, spec := range s.Specs {
+			for _, col := range spec.NewColumns {
+				changed = convertColumnCharset(col, convert) || changed
+			}
+			var optionsChanged bool
+			spec.Options, optionsChanged = convertTableOptions(spec.Options, convert)
+			changed = changed || optionsChanged
+		}
+	}
+	return changed
+}
+
+// convertCharsetCollation returns the charset and collation to convert to, the charset is derived from the collation
+// if it's empty.
+func convertCharsetCollation(cs, co string, convert func(cs string) (string, string, bool)) (string, string, bool) {
+	if cs == "" && co != "" {
+		if collation, err := charset.GetCollationByName(co); err == nil {
+			cs = collation.CharsetName
+		}
+	}
+	if cs == "" || cs == charset.CharsetBin {
+		return "", "", false
+	}
+	return convert(cs)
+}
+
+func convertDatabaseOptions(options []*ast.DatabaseOption, convert func(cs string) (string, string, bool)) ([]*ast.DatabaseOption, bool) {
+	var cs, co string
+	for _, opt := range options {
+		switch opt.Tp {
+		case ast.DatabaseOptionCharset:
+			cs = opt.Value
+		case ast.DatabaseOptionCollate:
+			co = opt.Value
+		}
+	}
+	toCharset, toCollation, ok := convertCharsetCollation(cs, co, convert)
+	if !ok {
+		return options, false
+	}
+
+	converted := make([]*ast.DatabaseOption, 0, len(options)+1)
+	for _, opt := range options {
+		if opt.Tp != ast.DatabaseOptionCharset && opt.Tp != ast.DatabaseOptionCollate {
+			converted = append(converted, opt)
+		}
+	}
+	converted = append(converted, &ast.DatabaseOption{Tp: ast.DatabaseOptionCharset, Value: toCharset})
+	if toCollation != "" {
+		converted = append(converted, &ast.DatabaseOption{Tp: ast.DatabaseOptionCollate, Value: toCollation})
+	}
+	return converted, true
+}
+
+func convertTableOptions(options []*ast.TableOption, convert func(cs string) (string, string, bool)) ([]*ast.TableOption, bool) {
+	var (
+		cs, co    string
+		charsetOp *ast.TableOption
+	)
+	for _, opt := range options {
+		switch opt.Tp {
+		case ast.TableOptionCharset:
+			if !opt.Default {
+				cs = opt.StrValue
+				charsetOp = opt
+			}
+		case ast.TableOptionCollate:
+			co = opt.StrValue
+		}
+	}
+	toCharset, toCollation, ok := convertCharsetCollation(cs, co, convert)
+	if !ok {
+		return options, false
+	}
+
+	converted := make([]*ast.TableOption, 0, len(options)+1)
+	for _, opt := range options {
+		if opt.Tp != ast.TableOptionCharset && opt.Tp != ast.TableOptionCollate {
+			converted = append(converted, opt)
+		}
+	}
+	// keep `CONVERT TO` of ALTER TABLE.
+	newCharsetOp := &ast.TableOption{Tp: ast.TableOptionCharset, StrValue: toCharset}
+	if charsetOp != nil {
+		newCharsetOp.UintValue = charsetOp.UintValue
+	}
+	converted = append(converted, newCharsetOp)
+	if toCollation != "" {
+		converted = append(converted, &ast.TableOption{Tp: ast.TableOptionCollate, StrValue: toCollation})
+	}
+	return converted, true
+}
+
+// convertColumnCharset converts the explicit charset and collation of a column, the collation may be either a part of
+// the type or an option of the column.
+func convertColumnCharset(col *ast.ColumnDef, convert func(cs string) (string, string, bool)) bool {
+	if col.Tp == nil {
+		return false
+	}
+	co := col.Tp.Collate
+	for _, opt := range col.Options {
+		if opt.Tp == ast.ColumnOptionCollate {
+			co = opt.StrValue
+		}
+	}
+	toCharset, toCollation, ok := convertCharsetCollation(col.Tp.Charset, co, convert)
+	if !ok {
+		return false
+	}
+
+	options := make([]*ast.ColumnOption, 0, len(col.Options))
+	for _, opt := range col.Options {
+		if opt.Tp != ast.ColumnOptionCollate {
+			options = append(options, opt)
+		}
+	}
+	col.Options = options
+	col.Tp.Charset, col.Tp.Collate = toCharset, toCollation
+	return true
+}
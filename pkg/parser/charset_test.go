@@ -0,0 +1,81 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"bytes"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/format"
+)
+
+func (t *testParserSuite) TestConvertCharset(c *C) {
+	convert := func(cs string) (string, string, bool) {
+		switch cs {
+		case "latin1":
+			return "utf8mb4", "utf8mb4_bin", true
+		case "utf8":
+			return "utf8mb4", "", true
+		}
+		return "", "", false
+	}
+	cases := []struct {
+		sql      string
+		expected string
+	}{
+		{
+			"CREATE DATABASE `db` /*!40100 DEFAULT CHARACTER SET latin1 */",
+			"CREATE DATABASE `db` CHARACTER SET = utf8mb4 COLLATE = utf8mb4_bin",
+		},
+		{
+			"ALTER DATABASE `db` COLLATE utf8_general_ci",
+			"ALTER DATABASE `db` CHARACTER SET = utf8mb4",
+		},
+		{
+			"CREATE TABLE `t` (`a` varchar(10) CHARACTER SET utf8 COLLATE utf8_bin, `b` text COLLATE latin1_bin, " +
+				"`c` varchar(10) CHARACTER SET ascii, `d` varbinary(10)) ENGINE=InnoDB DEFAULT CHARSET=latin1",
+			"CREATE TABLE `t` (`a` VARCHAR(10) CHARACTER SET UTF8MB4,`b` TEXT CHARACTER SET UTF8MB4 COLLATE utf8mb4_bin," +
+				"`c` VARCHAR(10) CHARACTER SET ASCII,`d` VARBINARY(10)) ENGINE = InnoDB DEFAULT CHARACTER SET = UTF8MB4 DEFAULT COLLATE = UTF8MB4_BIN",
+		},
+		{
+			"ALTER TABLE `t` CONVERT TO CHARACTER SET utf8",
+			"ALTER TABLE `t` CONVERT TO CHARACTER SET UTF8MB4",
+		},
+		{
+			"ALTER TABLE `t` MODIFY COLUMN `a` varchar(20) CHARACTER SET latin1 NOT NULL",
+			"ALTER TABLE `t` MODIFY COLUMN `a` VARCHAR(20) CHARACTER SET UTF8MB4 COLLATE utf8mb4_bin NOT NULL",
+		},
+	}
+	p := parser.New()
+	for _, cs := range cases {
+		stmt, err := p.ParseOneStmt(cs.sql, "", "")
+		c.Assert(err, IsNil)
+		c.Assert(ConvertCharset(stmt, convert), IsTrue, Commentf("%s", cs.sql))
+		var bf bytes.Buffer
+		c.Assert(stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &bf)), IsNil)
+		c.Assert(bf.String(), Equals, cs.expected)
+	}
+
+	// not converted.
+	for _, sql := range []string{
+		"CREATE TABLE `t` (`a` varchar(10) CHARACTER SET ascii, `b` blob) DEFAULT CHARSET=utf8mb4",
+		"ALTER TABLE `t` ADD COLUMN `a` int",
+		"DROP TABLE `t`",
+	} {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		c.Assert(err, IsNil)
+		c.Assert(ConvertCharset(stmt, convert), IsFalse, Commentf("%s", sql))
+	}
+}
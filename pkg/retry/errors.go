@@ -66,6 +66,7 @@ var (
 		int32(terror.ErrDumpUnitGlobalLock.Code()):          {},
 		int32(terror.ErrDumpUnitRuntime.Code()):             {},
 		int32(terror.ErrSyncerUnitDMLColumnNotMatch.Code()): {},
+		int32(terror.ErrSyncerErrorBudgetExhausted.Code()):  {},
 	}
 
 	// UnresumableRelayErrCodes is a set of unresumeable relay unit err codes.
@@ -203,7 +203,8 @@ func (tr *Tracker) GetCreateTable(ctx context.Context, table *filter.Table) (str
 
 	row := req.GetRow(0)
 	str := row.GetString(1) // the first column is the table name.
-	// returned as single line.
+	// returned as single line, the partitioning is in a new line following the table options.
+	str = strings.ReplaceAll(str, "\nPARTITION BY", " PARTITION BY")
 	str = strings.ReplaceAll(str, "\n", "")
 	str = strings.ReplaceAll(str, "  ", " ")
 	return str, nil
@@ -221,6 +222,24 @@ func (tr *Tracker) AllSchemas() []*model.DBInfo {
 	return filteredSchemas
 }
 
+// AllTableInfos returns the table infos of all tables visible to the tracker (excluding system tables), keyed by
+// schema name and table name. the table info of a table is not changed until the table is altered.
+func (tr *Tracker) AllTableInfos() map[string]map[string]*model.TableInfo {
+	is := tr.dom.InfoSchema()
+	tables := make(map[string]map[string]*model.TableInfo)
+	for _, db := range is.AllSchemas() {
+		if filter.IsSystemSchema(db.Name.L) {
+			continue
+		}
+		mSchema := make(map[string]*model.TableInfo)
+		for _, tbl := range is.SchemaTables(db.Name) {
+			mSchema[tbl.Meta().Name.O] = tbl.Meta()
+		}
+		tables[db.Name.O] = mSchema
+	}
+	return tables
+}
+
 // GetSingleColumnIndices returns indices of input column if input column only has single-column indices
 // returns nil if input column has no indices, or has multi-column indices.
 func (tr *Tracker) GetSingleColumnIndices(db, tbl, col string) ([]*model.IndexInfo, error) {
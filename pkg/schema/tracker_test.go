@@ -150,6 +150,13 @@ func (s *trackerSuite) TestTiDBAndSessionCfg(c *C) {
 	err = tracker.Exec(ctx, "testdb", "alter table \"foo\" drop primary key")
 	c.Assert(err, IsNil)
 
+	// the partitioning is kept in the single line.
+	err = tracker.Exec(ctx, "testdb", "create table \"bar\" (a int) partition by range (a) (partition p0 values less than (10))")
+	c.Assert(err, IsNil)
+	cts, err = tracker.GetCreateTable(context.Background(), &filter.Table{Schema: "testdb", Name: "bar"})
+	c.Assert(err, IsNil)
+	c.Assert(cts, Equals, "CREATE TABLE \"bar\" ( \"a\" int(11) DEFAULT NULL) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin PARTITION BY RANGE ( `a` ) ( PARTITION `p0` VALUES LESS THAN (10))")
+
 	// test user could specify tidb_enable_clustered_index in config
 	sessionCfg = map[string]string{
 		"sql_mode":                    "NO_ZERO_DATE,NO_ZERO_IN_DATE,ANSI_QUOTES",
@@ -493,6 +500,15 @@ func (s *trackerSuite) TestAllSchemas(c *C) {
 	}
 	c.Assert(existingNames, Equals, 31)
 
+	// the table infos are the ones returned by GetTableInfo.
+	allTables := tracker.AllTableInfos()
+	c.Assert(allTables, HasLen, 3)
+	c.Assert(allTables["testdb1"], HasLen, 2)
+	c.Assert(allTables["testdb3"], HasLen, 0)
+	ti, err := tracker.GetTableInfo(&filter.Table{Schema: "testdb1", Name: "c"})
+	c.Assert(err, IsNil)
+	c.Assert(allTables["testdb1"]["c"], Equals, ti)
+
 	// reset the tracker. all schemas should be gone.
 	err = tracker.Reset()
 	c.Assert(err, IsNil)
@@ -0,0 +1,161 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+var (
+	upstreamCache     *UpstreamCache // singleton instance
+	upstreamCacheOnce sync.Once
+)
+
+// UpstreamCache caches the CREATE TABLE statements fetched from upstream sources.
+// it's shared by all subtasks in a DM-worker, so a table is fetched from the upstream only once
+// even if multiple subtasks need it at the same time.
+// a cached statement is discarded when a newer DDL of the table is replicated.
+type UpstreamCache struct {
+	mu      sync.Mutex
+	sources map[string]*sourceUpstreamCache
+
+	// fetch is used to fetch the CREATE TABLE statement, it's replaced in tests.
+	fetch func(ctx context.Context, db dbutil.QueryExecutor, schemaName, tableName string) (string, error)
+}
+
+type sourceUpstreamCache struct {
+	// table ID -> cached statement
+	tables map[string]*upstreamTable
+	// table ID -> location of the latest replicated DDL, used to discard the outdated statement only once
+	// when multiple subtasks replicate the same DDL.
+	versions map[string]binlog.Location
+}
+
+type upstreamTable struct {
+	schema    string
+	done      chan struct{} // closed after fetched
+	createSQL string
+	err       error
+}
+
+// GetUpstreamCache gets the UpstreamCache instance.
+func GetUpstreamCache() *UpstreamCache {
+	upstreamCacheOnce.Do(func() {
+		upstreamCache = NewUpstreamCache()
+	})
+	return upstreamCache
+}
+
+// NewUpstreamCache creates a new UpstreamCache.
+func NewUpstreamCache() *UpstreamCache {
+	return &UpstreamCache{
+		sources: make(map[string]*sourceUpstreamCache),
+		fetch:   dbutil.GetCreateTableSQL,
+	}
+}
+
+func (c *UpstreamCache) source(sourceID string) *sourceUpstreamCache {
+	sc, ok := c.sources[sourceID]
+	if !ok {
+		sc = &sourceUpstreamCache{
+			tables:   make(map[string]*upstreamTable),
+			versions: make(map[string]binlog.Location),
+		}
+		c.sources[sourceID] = sc
+	}
+	return sc
+}
+
+// GetCreateTableSQL returns the CREATE TABLE statement of the table in the upstream source.
+// concurrent callers for the same table wait for one query, a failed query is not cached
+// and the waiting callers query again by themselves.
+func (c *UpstreamCache) GetCreateTableSQL(ctx context.Context, sourceID string, db dbutil.QueryExecutor, table *filter.Table) (string, error) {
+	tableID := table.String()
+	for {
+		c.mu.Lock()
+		sc := c.source(sourceID)
+		t, ok := sc.tables[tableID]
+		if !ok {
+			t = &upstreamTable{schema: table.Schema, done: make(chan struct{})}
+			sc.tables[tableID] = t
+		}
+		c.mu.Unlock()
+
+		if !ok {
+			t.createSQL, t.err = c.fetch(ctx, db, table.Schema, table.Name)
+			if t.err != nil {
+				t.err = terror.WithScope(terror.DBErrorAdapt(t.err, terror.ErrDBDriverError), terror.ScopeUpstream)
+				c.mu.Lock()
+				if sc.tables[tableID] == t {
+					delete(sc.tables, tableID)
+				}
+				c.mu.Unlock()
+			}
+			close(t.done)
+			return t.createSQL, t.err
+		}
+
+		select {
+		case <-t.done:
+			if t.err == nil {
+				return t.createSQL, nil
+			}
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+// Invalidate discards the cached statement of the table after a DDL at location is replicated.
+// DDLs not newer than the last one are ignored, so a DDL replicated by multiple subtasks only discards it once.
+// if the table name is empty, all tables in the schema are discarded.
+func (c *UpstreamCache) Invalidate(sourceID string, table *filter.Table, location binlog.Location, enableGTID bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sc, ok := c.sources[sourceID]
+	if !ok {
+		return
+	}
+
+	if table.Name == "" {
+		for tableID, t := range sc.tables {
+			if t.schema == table.Schema {
+				delete(sc.tables, tableID)
+			}
+		}
+		return
+	}
+
+	tableID := table.String()
+	if version, ok := sc.versions[tableID]; ok && binlog.CompareLocation(location, version, enableGTID) <= 0 {
+		return
+	}
+	sc.versions[tableID] = location.Clone()
+	delete(sc.tables, tableID)
+}
+
+// RemoveSource removes all cached statements of the source.
+func (c *UpstreamCache) RemoveSource(sourceID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sources, sourceID)
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"go.uber.org/atomic"
+
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+var _ = Suite(&upstreamCacheSuite{})
+
+type upstreamCacheSuite struct{}
+
+func (s *upstreamCacheSuite) TestUpstreamCache(c *C) {
+	var (
+		ctx      = context.Background()
+		cache    = NewUpstreamCache()
+		fetchCnt atomic.Int32
+		fetchErr error
+		block    = make(chan struct{})
+		tb1      = &filter.Table{Schema: "db", Name: "tb1"}
+		tb2      = &filter.Table{Schema: "db", Name: "tb2"}
+		loc1     = binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 100}}
+		loc2     = binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 200}}
+	)
+	cache.fetch = func(_ context.Context, _ dbutil.QueryExecutor, schemaName, tableName string) (string, error) {
+		<-block
+		fetchCnt.Inc()
+		return "CREATE TABLE `" + tableName + "` (`c` int)", fetchErr
+	}
+
+	// concurrent callers share one query
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			createSQL, err := cache.GetCreateTableSQL(ctx, "source-1", nil, tb1)
+			c.Assert(err, IsNil)
+			c.Assert(createSQL, Equals, "CREATE TABLE `tb1` (`c` int)")
+		}()
+	}
+	close(block)
+	wg.Wait()
+	c.Assert(fetchCnt.Load(), Equals, int32(1))
+
+	// different sources are cached separately
+	_, err := cache.GetCreateTableSQL(ctx, "source-2", nil, tb1)
+	c.Assert(err, IsNil)
+	c.Assert(fetchCnt.Load(), Equals, int32(2))
+
+	// a DDL replicated by multiple subtasks only invalidates the cache once
+	cache.Invalidate("source-1", tb1, loc1, false)
+	_, err = cache.GetCreateTableSQL(ctx, "source-1", nil, tb1)
+	c.Assert(err, IsNil)
+	c.Assert(fetchCnt.Load(), Equals, int32(3))
+	cache.Invalidate("source-1", tb1, loc1, false)
+	_, err = cache.GetCreateTableSQL(ctx, "source-1", nil, tb1)
+	c.Assert(err, IsNil)
+	c.Assert(fetchCnt.Load(), Equals, int32(3))
+	cache.Invalidate("source-1", tb1, loc2, false)
+	_, err = cache.GetCreateTableSQL(ctx, "source-1", nil, tb1)
+	c.Assert(err, IsNil)
+	c.Assert(fetchCnt.Load(), Equals, int32(4))
+
+	// invalidate the whole schema
+	_, err = cache.GetCreateTableSQL(ctx, "source-1", nil, tb2)
+	c.Assert(err, IsNil)
+	c.Assert(fetchCnt.Load(), Equals, int32(5))
+	cache.Invalidate("source-1", &filter.Table{Schema: "db"}, loc2, false)
+	c.Assert(cache.sources["source-1"].tables, HasLen, 0)
+
+	// failed query is not cached
+	fetchErr = errors.New("mock fetch error")
+	_, err = cache.GetCreateTableSQL(ctx, "source-1", nil, tb2)
+	c.Assert(terror.ErrDBDriverError.Equal(err), IsTrue)
+	c.Assert(cache.sources["source-1"].tables, HasLen, 0)
+	fetchErr = nil
+	_, err = cache.GetCreateTableSQL(ctx, "source-1", nil, tb2)
+	c.Assert(err, IsNil)
+	c.Assert(fetchCnt.Load(), Equals, int32(7))
+
+	cache.RemoveSource("source-1")
+	c.Assert(cache.sources, HasLen, 1)
+	c.Assert(GetUpstreamCache(), Equals, GetUpstreamCache())
+}
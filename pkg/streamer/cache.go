@@ -0,0 +1,299 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+)
+
+// All BinlogReaders of the subtasks reading the same relay directory share an eventCache. The relay log file being
+// written is parsed only once by the shared parser of the cache, and the recent events are kept in memory, every
+// reader has its own offset (cursor) in the file and gets the events after it from the memory.
+// A reader falls behind the cached events (or reading an older file) parses the file by its own parser as before,
+// and shares the cached events again after catching up.
+
+var fileHeaderLen = int64(len(replication.BinLogFileHeader))
+
+var (
+	// eventCacheCapacity is the total size of the events kept in memory for a relay directory.
+	eventCacheCapacity int64 = 64 * 1024 * 1024
+	// maxCachedFiles is the max number of relay log files (or segments) having events in memory.
+	maxCachedFiles = 4
+
+	errEventCacheMiss = errors.New("events are not cached")
+
+	eventCaches   = make(map[string]*eventCache)
+	eventCachesMu sync.Mutex
+)
+
+// cachedEvent is a parsed event and its offset in the relay log file.
+type cachedEvent struct {
+	offset int64
+	e      *replication.BinlogEvent
+}
+
+// cachedFile holds the recent events of a relay log file.
+type cachedFile struct {
+	path   string
+	parser *replication.BinlogParser
+	fde    *replication.BinlogEvent
+	events []cachedEvent
+	start  int64 // offset of the first cached event
+	end    int64 // offset after the last parsed event, the next parse starts from here
+	size   int64 // total size of the cached events
+}
+
+type eventCache struct {
+	mu       sync.Mutex
+	key      string
+	refs     int
+	timezone *time.Location
+	size     int64
+	files    []*cachedFile // in the order of being cached
+}
+
+func eventCacheKey(relayDir string, timezone *time.Location) string {
+	if timezone == nil {
+		return relayDir
+	}
+	// the parsed events depend on the timezone.
+	return relayDir + "|" + timezone.String()
+}
+
+// acquireEventCache gets the event cache shared by the readers of the relay directory, releaseEventCache should be
+// called after no longer used.
+func acquireEventCache(relayDir string, timezone *time.Location) *eventCache {
+	key := eventCacheKey(relayDir, timezone)
+	eventCachesMu.Lock()
+	defer eventCachesMu.Unlock()
+	c, ok := eventCaches[key]
+	if !ok {
+		c = &eventCache{key: key, timezone: timezone}
+		eventCaches[key] = c
+	}
+	c.refs++
+	return c
+}
+
+// releaseEventCache releases the event cache, the events are dropped after all readers released it.
+func releaseEventCache(c *eventCache) {
+	eventCachesMu.Lock()
+	defer eventCachesMu.Unlock()
+	c.refs--
+	if c.refs <= 0 {
+		delete(eventCaches, c.key)
+	}
+}
+
+func (c *eventCache) newParser() *replication.BinlogParser {
+	// keep the same as the parser of BinlogReader.
+	parser := replication.NewBinlogParser()
+	parser.SetVerifyChecksum(true)
+	parser.SetUseDecimal(false)
+	if c.timezone != nil {
+		parser.SetTimestampStringLocation(c.timezone)
+	}
+	return parser
+}
+
+// parse sends the FormatDescriptionEvent and the events from offset to the end of the relay log file to onEvent, like
+// BinlogParser.ParseFile. if the file is not cached and create is true, it's parsed from the beginning and cached.
+// errEventCacheMiss is returned if the events from offset are not in memory.
+func (c *eventCache) parse(ctx context.Context, path string, offset int64, create bool, onEvent replication.OnEventFunc) error {
+	c.mu.Lock()
+	f := c.getFile(path, create)
+	if f == nil {
+		c.mu.Unlock()
+		return errEventCacheMiss
+	}
+	parseErr := c.load(f)
+	if f.fde == nil && offset <= fileHeaderLen {
+		// not even a FormatDescriptionEvent is written, nothing to send.
+		c.mu.Unlock()
+		return parseErr
+	}
+	if f.fde != nil && offset <= fileHeaderLen {
+		offset = fileHeaderLen + int64(f.fde.Header.EventSize)
+	}
+
+	idx := sort.Search(len(f.events), func(i int) bool { return f.events[i].offset >= offset })
+	if f.fde == nil || offset < f.start || offset > f.end ||
+		(idx < len(f.events) && f.events[idx].offset != offset) {
+		c.mu.Unlock()
+		return errEventCacheMiss
+	}
+	// the cached events are only appended or dropped from the front, so the snapshot can be read without the lock.
+	fde, events := f.fde, f.events[idx:]
+	c.mu.Unlock()
+
+	if err := onEvent(copyEvent(fde)); err != nil {
+		return err
+	}
+	for _, ce := range events {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := onEvent(copyEvent(ce.e)); err != nil {
+			return err
+		}
+	}
+	return parseErr
+}
+
+// getFile gets the cached file, or caches a new one if create is true.
+func (c *eventCache) getFile(path string, create bool) *cachedFile {
+	for _, f := range c.files {
+		if f.path == path {
+			return f
+		}
+	}
+	if !create {
+		return nil
+	}
+	f := &cachedFile{
+		path:   path,
+		parser: c.newParser(),
+		start:  fileHeaderLen,
+		end:    fileHeaderLen,
+	}
+	c.files = append(c.files, f)
+	for len(c.files) > maxCachedFiles {
+		c.size -= c.files[0].size
+		c.files = c.files[1:]
+	}
+	return f
+}
+
+// load parses the events written after the cached ones.
+func (c *eventCache) load(f *cachedFile) error {
+	if f.end > fileHeaderLen && !f.unchanged() {
+		// the relay log file is truncated (and maybe written again) when recovering, cache it again.
+		c.size -= f.size
+		*f = cachedFile{path: f.path, parser: c.newParser(), start: fileHeaderLen, end: fileHeaderLen}
+	}
+
+	var (
+		offset = f.end
+		added  int64
+	)
+	err := f.parser.ParseFile(f.path, f.end, func(e *replication.BinlogEvent) error {
+		if _, ok := e.Event.(*replication.FormatDescriptionEvent); ok && offset == f.end {
+			// the first FormatDescriptionEvent is sent by the parser if parsing from the middle of the file.
+			f.fde = e
+			if f.end == fileHeaderLen {
+				offset += int64(e.Header.EventSize)
+				f.start, f.end = offset, offset
+			}
+			return nil
+		}
+		f.events = append(f.events, cachedEvent{offset: offset, e: e})
+		offset += int64(e.Header.EventSize)
+		f.end = offset
+		added += int64(e.Header.EventSize)
+		return nil
+	})
+	f.size += added
+	c.size += added
+	c.evict()
+	return err
+}
+
+// unchanged checks whether the last cached event is still in the file.
+func (f *cachedFile) unchanged() bool {
+	fd, err := os.Open(f.path)
+	if err != nil {
+		return false
+	}
+	defer fd.Close()
+	if len(f.events) == 0 {
+		fi, err2 := fd.Stat()
+		return err2 == nil && fi.Size() >= f.end
+	}
+	last := f.events[len(f.events)-1]
+	buf := make([]byte, len(last.e.RawData))
+	if _, err = fd.ReadAt(buf, last.offset); err != nil {
+		return false
+	}
+	return bytes.Equal(buf, last.e.RawData)
+}
+
+// evict drops the oldest events until the size is under the capacity.
+func (c *eventCache) evict() {
+	for _, f := range c.files {
+		if c.size <= eventCacheCapacity {
+			return
+		}
+		n := 0
+		for n < len(f.events) && c.size > eventCacheCapacity {
+			size := int64(f.events[n].e.Header.EventSize)
+			c.size -= size
+			f.size -= size
+			n++
+		}
+		f.events = f.events[n:]
+		if len(f.events) > 0 {
+			f.start = f.events[0].offset
+		} else {
+			f.start = f.end
+		}
+	}
+}
+
+// copyEvent copies the event, so the fields modified by the reader don't affect the other readers.
+func copyEvent(e *replication.BinlogEvent) *replication.BinlogEvent {
+	header := *e.Header
+	ce := &replication.BinlogEvent{RawData: e.RawData, Header: &header, Event: e.Event}
+	switch ev := e.Event.(type) {
+	case *replication.RotateEvent:
+		ev2 := *ev
+		ce.Event = &ev2
+	case *replication.XIDEvent:
+		ev2 := *ev
+		ce.Event = &ev2
+	case *replication.QueryEvent:
+		ev2 := *ev
+		ce.Event = &ev2
+	case *replication.RowsEvent:
+		// the values may be changed by column mapping.
+		ev2 := *ev
+		ev2.Rows = make([][]interface{}, len(ev.Rows))
+		for i, row := range ev.Rows {
+			ev2.Rows[i] = append([]interface{}(nil), row...)
+		}
+		ce.Event = &ev2
+	}
+	return ce
+}
+
+// parseFileFrom parses the relay log file from the beginning, but only sends the FormatDescriptionEvent and the events
+// from offset to onEvent, so the parser knows the table maps of the transaction containing offset.
+func parseFileFrom(parser *replication.BinlogParser, path string, offset int64, onEvent replication.OnEventFunc) error {
+	next := fileHeaderLen
+	return parser.ParseFile(path, fileHeaderLen, func(e *replication.BinlogEvent) error {
+		cur := next
+		next += int64(e.Header.EventSize)
+		if cur == fileHeaderLen || cur >= offset {
+			return onEvent(e)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/pkg/binlog/event"
+	"github.com/pingcap/dm/pkg/gtid"
+)
+
+var _ = Suite(&testCacheSuite{})
+
+type testCacheSuite struct{}
+
+// genRelayEvents generates a FormatDescriptionEvent (if from the beginning) and count DDL events.
+func (t *testCacheSuite) genRelayEvents(c *C, latestPos uint32, count int, db string) ([]*replication.BinlogEvent, uint32) {
+	var events []*replication.BinlogEvent
+	if latestPos <= 4 {
+		header := &replication.EventHeader{Timestamp: uint32(time.Now().Unix()), ServerID: 11}
+		ev, err := event.GenFormatDescriptionEvent(header, 4)
+		c.Assert(err, IsNil)
+		latestPos = ev.Header.LogPos
+		events = append(events, ev)
+	}
+	latestGTID, err := gtid.ParserGTID(gmysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1")
+	c.Assert(err, IsNil)
+	for i := 0; i < count; i++ {
+		evs, err := event.GenDDLEvents(gmysql.MySQLFlavor, 1, latestPos, latestGTID, db, fmt.Sprintf("CREATE TABLE t%d (c1 INT)", i))
+		c.Assert(err, IsNil)
+		events = append(events, evs.Events...)
+		latestPos = evs.LatestPos
+		latestGTID = evs.LatestGTID
+	}
+	return events, latestPos
+}
+
+func (t *testCacheSuite) writeEvents(c *C, path string, events []*replication.BinlogEvent, truncate bool) {
+	flag := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if truncate {
+		flag = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	}
+	f, err := os.OpenFile(path, flag, 0o644)
+	c.Assert(err, IsNil)
+	defer f.Close()
+	if truncate {
+		_, err = f.Write(replication.BinLogFileHeader)
+		c.Assert(err, IsNil)
+	}
+	for _, e := range events {
+		_, err = f.Write(e.RawData)
+		c.Assert(err, IsNil)
+	}
+}
+
+func collectEvents(fn func(onEvent replication.OnEventFunc) error) ([]*replication.BinlogEvent, error) {
+	var events []*replication.BinlogEvent
+	err := fn(func(e *replication.BinlogEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	return events, err
+}
+
+func (t *testCacheSuite) parseByCache(ec *eventCache, path string, offset int64, create bool) ([]*replication.BinlogEvent, error) {
+	return collectEvents(func(onEvent replication.OnEventFunc) error {
+		return ec.parse(context.Background(), path, offset, create, onEvent)
+	})
+}
+
+func (t *testCacheSuite) parseByParser(c *C, path string, offset int64) []*replication.BinlogEvent {
+	events, err := collectEvents(func(onEvent replication.OnEventFunc) error {
+		return replication.NewBinlogParser().ParseFile(path, offset, onEvent)
+	})
+	c.Assert(err, IsNil)
+	return events
+}
+
+func (t *testCacheSuite) assertSameEvents(c *C, obtained, expected []*replication.BinlogEvent) {
+	c.Assert(obtained, HasLen, len(expected))
+	for i := range expected {
+		c.Assert(bytes.Equal(obtained[i].RawData, expected[i].RawData), IsTrue, Commentf("event %d", i))
+	}
+}
+
+func (t *testCacheSuite) TestEventCache(c *C) {
+	var (
+		dir  = c.MkDir()
+		path = filepath.Join(dir, "mysql-bin.000001")
+	)
+	ec := acquireEventCache(dir, nil)
+	c.Assert(acquireEventCache(dir, nil), Equals, ec)
+	c.Assert(acquireEventCache(dir, time.UTC), Not(Equals), ec)
+
+	events1, latestPos := t.genRelayEvents(c, 4, 3, "db1")
+	t.writeEvents(c, path, events1, true)
+
+	// not cached
+	_, err := t.parseByCache(ec, path, 4, false)
+	c.Assert(err, Equals, errEventCacheMiss)
+	// the first reader caches the file
+	obtained, err := t.parseByCache(ec, path, 4, true)
+	c.Assert(err, IsNil)
+	t.assertSameEvents(c, obtained, t.parseByParser(c, path, 4))
+	c.Assert(ec.files, HasLen, 1)
+	c.Assert(ec.files[0].end, Equals, int64(latestPos))
+
+	// more events are written, the other reader reads from the middle of the file
+	events2, _ := t.genRelayEvents(c, latestPos, 2, "db2")
+	t.writeEvents(c, path, events2, false)
+	for _, offset := range []int64{int64(events1[1].Header.LogPos), int64(latestPos)} {
+		obtained, err = t.parseByCache(ec, path, offset, false)
+		c.Assert(err, IsNil)
+		t.assertSameEvents(c, obtained, t.parseByParser(c, path, offset))
+	}
+	// not at the beginning of an event
+	_, err = t.parseByCache(ec, path, int64(latestPos)+1, false)
+	c.Assert(err, Equals, errEventCacheMiss)
+
+	// the events modified by a reader don't affect the others
+	obtained, err = t.parseByCache(ec, path, int64(latestPos), false)
+	c.Assert(err, IsNil)
+	var modified bool
+	for _, e := range obtained {
+		if ev, ok := e.Event.(*replication.QueryEvent); ok {
+			ev.Schema = []byte("modified")
+			e.Header.LogPos = 0
+			modified = true
+		}
+	}
+	c.Assert(modified, IsTrue)
+	obtained, err = t.parseByCache(ec, path, int64(latestPos), false)
+	c.Assert(err, IsNil)
+	for _, e := range obtained {
+		if ev, ok := e.Event.(*replication.QueryEvent); ok {
+			c.Assert(string(ev.Schema), Equals, "db2")
+			c.Assert(e.Header.LogPos, Not(Equals), uint32(0))
+		}
+	}
+
+	// the file is truncated and written again when recovering
+	events3, _ := t.genRelayEvents(c, 4, 4, "db3")
+	t.writeEvents(c, path, events3, true)
+	obtained, err = t.parseByCache(ec, path, 4, false)
+	c.Assert(err, IsNil)
+	t.assertSameEvents(c, obtained, t.parseByParser(c, path, 4))
+
+	// the oldest events are evicted
+	capacity := eventCacheCapacity
+	eventCacheCapacity = int64(events3[len(events3)-1].Header.EventSize)
+	defer func() {
+		eventCacheCapacity = capacity
+	}()
+	events4, _ := t.genRelayEvents(c, events3[len(events3)-1].Header.LogPos, 1, "db4")
+	t.writeEvents(c, path, events4, false)
+	_, err = t.parseByCache(ec, path, 4, false)
+	c.Assert(err, Equals, errEventCacheMiss)
+	offset := int64(events4[len(events4)-1].Header.LogPos - events4[len(events4)-1].Header.EventSize)
+	obtained, err = t.parseByCache(ec, path, offset, false)
+	c.Assert(err, IsNil)
+	t.assertSameEvents(c, obtained, t.parseByParser(c, path, offset))
+
+	// released by all readers
+	releaseEventCache(ec)
+	c.Assert(acquireEventCache(dir, nil), Equals, ec)
+	releaseEventCache(ec)
+	releaseEventCache(ec)
+	c.Assert(acquireEventCache(dir, nil), Not(Equals), ec)
+}
+
+func (t *testCacheSuite) TestParseFileFrom(c *C) {
+	path := filepath.Join(c.MkDir(), "mysql-bin.000001")
+	events, _ := t.genRelayEvents(c, 4, 3, "db")
+	t.writeEvents(c, path, events, true)
+
+	offset := int64(events[2].Header.LogPos)
+	obtained, err := collectEvents(func(onEvent replication.OnEventFunc) error {
+		return parseFileFrom(replication.NewBinlogParser(), path, offset, onEvent)
+	})
+	c.Assert(err, IsNil)
+	t.assertSameEvents(c, obtained, t.parseByParser(c, path, offset))
+}
@@ -233,6 +233,19 @@ func relayLogUpdatedOrNewCreated(ctx context.Context, watcherInterval time.Durat
 				updatePathCh <- latestFilePath
 				return
 			default:
+				// the relay log file may be rotated locally, then the new segment should be parsed
+				segments, err := LoadSegments(dir, latestFile)
+				if err != nil {
+					errCh <- terror.Annotatef(err, "load segments of %s", latestFile)
+					return
+				}
+				if segments[len(segments)-1].Filename != filepath.Base(latestFilePath) {
+					log.L().Info("relay log file is rotated into a new segment", zap.String("file path", latestFilePath),
+						zap.Stringer("segment", segments[len(segments)-1]))
+					updatePathCh <- latestFilePath
+					return
+				}
+
 				// current watched file size have no change means that no new writes have been made
 				// our relay meta file will be updated immediately after receive the rotate event
 				// although we cannot ensure that the binlog filename in the meta is the next file after latestFile
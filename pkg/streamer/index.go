@@ -0,0 +1,176 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"github.com/go-mysql-org/go-mysql/replication"
+
+	"github.com/pingcap/dm/pkg/binlog/event"
+	"github.com/pingcap/dm/pkg/gtid"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// RelayLogFile is the index of an upstream binlog file in a relay sub directory.
+type RelayLogFile struct {
+	Name     string   `json:"name"`
+	Segments []string `json:"segments"` // local relay log files holding the events, in ascending order
+	StartPos int64    `json:"start-pos"`
+	EndPos   int64    `json:"end-pos"` // position in the upstream binlog file of the end of the last segment
+	// StartGTID is the GTID set in the PreviousGTIDsEvent (or MariadbGTIDListEvent) of the file, and EndGTID is the
+	// one of the next file, or the GTID set in relay meta for the latest file.
+	StartGTID string `json:"start-gtid"`
+	EndGTID   string `json:"end-gtid"`
+	// StartTime is the timestamp of the first event of the file, and EndTime is the modification time of the last
+	// segment, both are unix seconds.
+	StartTime int64 `json:"start-time"`
+	EndTime   int64 `json:"end-time"`
+}
+
+// RelaySubDir is the index of a relay sub directory.
+type RelaySubDir struct {
+	Name   string          `json:"name"` // UUID with suffix
+	UUID   string          `json:"uuid"`
+	Suffix int             `json:"suffix"`
+	Meta   *Meta           `json:"meta"` // nil if relay meta doesn't exist
+	Files  []*RelayLogFile `json:"files"`
+}
+
+// ReadRelayLogIndex reads the index of all relay log files in relayDir, the sub directories are in the order of the
+// UUID index file and the ones already purged are ignored.
+func ReadRelayLogIndex(relayDir string) ([]*RelaySubDir, error) {
+	if relayDir == "" {
+		return nil, terror.ErrEmptyRelayDir.Generate()
+	}
+	uuids, err := utils.ParseUUIDIndex(filepath.Join(relayDir, utils.UUIDIndexFilename))
+	if err != nil {
+		return nil, err
+	}
+
+	subDirs := make([]*RelaySubDir, 0, len(uuids))
+	for _, name := range uuids {
+		dir := filepath.Join(relayDir, name)
+		if !utils.IsDirExists(dir) {
+			continue
+		}
+		uuid, suffix, err2 := utils.ParseSuffixForUUID(name)
+		if err2 != nil {
+			return nil, err2
+		}
+		subDir := &RelaySubDir{Name: name, UUID: uuid, Suffix: suffix}
+		if subDir.Meta, err2 = readRelayMeta(dir); err2 != nil {
+			return nil, err2
+		}
+		if subDir.Files, err2 = readRelayLogFiles(dir, subDir.Meta); err2 != nil {
+			return nil, err2
+		}
+		subDirs = append(subDirs, subDir)
+	}
+	return subDirs, nil
+}
+
+// readRelayMeta reads the relay meta in the relay sub directory, nil is returned if it doesn't exist.
+func readRelayMeta(dir string) (*Meta, error) {
+	metaPath := filepath.Join(dir, utils.MetaFilename)
+	if !utils.IsFileExists(metaPath) {
+		return nil, nil
+	}
+	meta := &Meta{}
+	if _, err := toml.DecodeFile(metaPath, meta); err != nil {
+		return nil, terror.Annotate(err, "decode relay meta toml file failed")
+	}
+	return meta, nil
+}
+
+// readRelayLogFiles reads the index of the relay log files in the relay sub directory.
+func readRelayLogFiles(dir string, meta *Meta) ([]*RelayLogFile, error) {
+	names, err := CollectAllBinlogFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*RelayLogFile, 0, len(names))
+	for i, name := range names {
+		segments, err2 := LoadSegments(dir, name)
+		if err2 != nil {
+			return nil, err2
+		}
+		file := &RelayLogFile{Name: name, StartPos: 4, Segments: make([]string, 0, len(segments))}
+		for _, seg := range segments {
+			file.Segments = append(file.Segments, seg.Filename)
+		}
+		last := segments[len(segments)-1]
+		fp := filepath.Join(dir, last.Filename)
+		fi, err2 := os.Stat(fp)
+		if err2 != nil {
+			return nil, terror.ErrGetRelayLogStat.Delegate(err2, fp)
+		}
+		file.EndPos = last.ToUpstream(fi.Size())
+		file.EndTime = fi.ModTime().Unix()
+
+		file.StartTime, file.StartGTID, err2 = readRelayLogHeader(filepath.Join(dir, name))
+		if err2 != nil {
+			return nil, err2
+		}
+		if i > 0 {
+			files[i-1].EndGTID = file.StartGTID
+		}
+		if meta != nil && meta.BinLogName == name {
+			file.EndGTID = meta.BinlogGTID
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// readRelayLogHeader reads the timestamp of the first event and the previous GTID set at the beginning of the relay
+// log file, the GTID set is empty if the file has none.
+func readRelayLogHeader(path string) (int64, string, error) {
+	var (
+		ts  int64
+		gs  gtid.Set
+		err error
+	)
+	parser := replication.NewBinlogParser()
+	parser.SetVerifyChecksum(true)
+	parser.SetUseDecimal(false)
+	onEvent := func(e *replication.BinlogEvent) error {
+		if ts == 0 {
+			ts = int64(e.Header.Timestamp)
+		}
+		switch e.Header.EventType {
+		case replication.ROTATE_EVENT, replication.FORMAT_DESCRIPTION_EVENT:
+			return nil
+		case replication.PREVIOUS_GTIDS_EVENT:
+			gs, err = event.GTIDsFromPreviousGTIDsEvent(e)
+		case replication.MARIADB_GTID_LIST_EVENT:
+			gs, err = event.GTIDsFromMariaDBGTIDListEvent(e)
+		}
+		// the previous GTID set always follows the FormatDescriptionEvent, no need to parse the rest of the file.
+		parser.Stop()
+		return err
+	}
+	// the latest file may be being written, an incomplete event is ignored.
+	if err2 := parser.ParseFile(path, 4, onEvent); err2 != nil && !isIgnorableParseError(err2) {
+		return 0, "", terror.ErrParserParseRelayLog.Delegate(err2, path)
+	}
+	if gs == nil {
+		return ts, "", nil
+	}
+	return ts, gs.String(), nil
+}
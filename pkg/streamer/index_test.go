@@ -0,0 +1,103 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	"os"
+	"path/filepath"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/pkg/binlog/event"
+	"github.com/pingcap/dm/pkg/gtid"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+var _ = Suite(&testIndexSuite{})
+
+type testIndexSuite struct{}
+
+func (t *testIndexSuite) TestReadRelayLogIndex(c *C) {
+	var (
+		relayDir = c.MkDir()
+		uuid     = "53ea0ed1-9bf8-11e6-8bea-64006a897c73"
+		subDir1  = utils.AddSuffixForUUID(uuid, 1)
+		subDir2  = utils.AddSuffixForUUID(uuid, 2)
+		purged   = utils.AddSuffixForUUID(uuid, 3)
+		gs1      = "53ea0ed1-9bf8-11e6-8bea-64006a897c73:1-10"
+		gs2      = "53ea0ed1-9bf8-11e6-8bea-64006a897c73:1-20"
+		gsMeta   = "53ea0ed1-9bf8-11e6-8bea-64006a897c73:1-25"
+		binlog1  = "mysql-bin.000001"
+		binlog2  = "mysql-bin.000002"
+	)
+
+	_, err := ReadRelayLogIndex("")
+	c.Assert(err, NotNil)
+	// no index file
+	subDirs, err := ReadRelayLogIndex(relayDir)
+	c.Assert(err, IsNil)
+	c.Assert(subDirs, HasLen, 0)
+
+	writeFile := func(dir, name, gsStr string, extra int) int64 {
+		gSet, err2 := gtid.ParserGTID(gmysql.MySQLFlavor, gsStr)
+		c.Assert(err2, IsNil)
+		_, data, err2 := event.GenCommonFileHeader(gmysql.MySQLFlavor, 1, gSet)
+		c.Assert(err2, IsNil)
+		data = append(data, make([]byte, extra)...)
+		c.Assert(os.WriteFile(filepath.Join(dir, name), data, 0o644), IsNil)
+		return int64(len(data))
+	}
+
+	c.Assert(os.WriteFile(filepath.Join(relayDir, utils.UUIDIndexFilename), []byte(subDir1+"\n"+subDir2+"\n"+purged+"\n"), 0o644), IsNil)
+	dir1 := filepath.Join(relayDir, subDir1)
+	c.Assert(os.MkdirAll(dir1, 0o755), IsNil)
+	size1 := writeFile(dir1, binlog1, gs1, 100)
+	writeFile(dir1, binlog2, gs2, 0)
+	seg := Segment{Filename: SegmentFilename(binlog2, 2), BinlogName: binlog2, StartPos: 1000, HeaderSize: 124}
+	c.Assert(AppendSegment(dir1, seg), IsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir1, seg.Filename), make([]byte, 200), 0o644), IsNil)
+	meta := "binlog-name = \"" + binlog2 + "\"\nbinlog-pos = 1076\nbinlog-gtid = \"" + gsMeta + "\"\n"
+	c.Assert(os.WriteFile(filepath.Join(dir1, utils.MetaFilename), []byte(meta), 0o644), IsNil)
+	// the sub directory just created has no relay log file and relay meta.
+	c.Assert(os.MkdirAll(filepath.Join(relayDir, subDir2), 0o755), IsNil)
+
+	subDirs, err = ReadRelayLogIndex(relayDir)
+	c.Assert(err, IsNil)
+	c.Assert(subDirs, HasLen, 2)
+	c.Assert(subDirs[0].Name, Equals, subDir1)
+	c.Assert(subDirs[0].UUID, Equals, uuid)
+	c.Assert(subDirs[0].Suffix, Equals, 1)
+	c.Assert(subDirs[0].Meta, DeepEquals, &Meta{BinLogName: binlog2, BinLogPos: 1076, BinlogGTID: gsMeta})
+	files := subDirs[0].Files
+	c.Assert(files, HasLen, 2)
+	c.Assert(files[0].Name, Equals, binlog1)
+	c.Assert(files[0].Segments, DeepEquals, []string{binlog1})
+	c.Assert(files[0].StartPos, Equals, int64(4))
+	c.Assert(files[0].EndPos, Equals, size1)
+	c.Assert(files[0].StartGTID, Equals, gs1)
+	c.Assert(files[0].EndGTID, Equals, gs2)
+	c.Assert(files[0].StartTime, Not(Equals), int64(0))
+	c.Assert(files[0].EndTime, Not(Equals), int64(0))
+	c.Assert(files[1].Name, Equals, binlog2)
+	c.Assert(files[1].Segments, DeepEquals, []string{binlog2, seg.Filename})
+	c.Assert(files[1].EndPos, Equals, int64(1076))
+	c.Assert(files[1].StartGTID, Equals, gs2)
+	c.Assert(files[1].EndGTID, Equals, gsMeta)
+
+	c.Assert(subDirs[1].Name, Equals, subDir2)
+	c.Assert(subDirs[1].Suffix, Equals, 2)
+	c.Assert(subDirs[1].Meta, IsNil)
+	c.Assert(subDirs[1].Files, HasLen, 0)
+}
@@ -16,7 +16,6 @@ package streamer
 import (
 	"context"
 	"fmt"
-	"os"
 	"path"
 	"path/filepath"
 	"strings"
@@ -65,6 +64,9 @@ type BinlogReaderConfig struct {
 type BinlogReader struct {
 	cfg    *BinlogReaderConfig
 	parser *replication.BinlogParser
+	cache  *eventCache // shared with the other readers of the relay directory
+	// the relay log file being parsed by the own parser continuously, so the parser knows the table maps in it.
+	ownParsedFile string
 
 	indexPath string   // relay server-uuid index file path
 	uuids     []string // master UUIDs (relay sub dir)
@@ -97,6 +99,7 @@ func NewBinlogReader(logger log.Logger, cfg *BinlogReaderConfig) *BinlogReader {
 	return &BinlogReader{
 		cfg:       cfg,
 		parser:    parser,
+		cache:     acquireEventCache(cfg.RelayDir, cfg.Timezone),
 		indexPath: path.Join(cfg.RelayDir, utils.UUIDIndexFilename),
 		cancel:    cancel,
 		tctx:      newtctx,
@@ -112,11 +115,11 @@ func (r *BinlogReader) checkRelayPos(pos mysql.Position) error {
 	pos = realPos
 	relayFilepath := path.Join(r.cfg.RelayDir, currentUUID, pos.Name)
 	r.tctx.L().Info("start to check relay log file", zap.String("path", relayFilepath), zap.Stringer("position", pos))
-	fi, err := os.Stat(relayFilepath)
+	end, err := RelayFileEnd(path.Join(r.cfg.RelayDir, currentUUID), pos.Name)
 	if err != nil {
-		return terror.ErrGetRelayLogStat.Delegate(err, relayFilepath)
+		return err
 	}
-	if fi.Size() < int64(pos.Pos) {
+	if end < int64(pos.Pos) {
 		return terror.ErrRelayLogGivenPosTooBig.Generate(pos)
 	}
 	return nil
@@ -531,6 +534,13 @@ func (r *BinlogReader) parseFile(
 	}
 
 	fullPath := filepath.Join(relayLogDir, relayLogFile)
+	// the relay log file may be rotated locally, parse all its segments from the one holding the offset.
+	segments, err := LoadSegments(relayLogDir, relayLogFile)
+	if err != nil {
+		return false, false, 0, "", "", false, err
+	}
+	firstSegment := FindSegment(segments, offset)
+	lastSegment := segments[len(segments)-1]
 
 	if firstParse {
 		// if the file is the first time to parse, send a fake ROTATE_EVENT before parse binlog file
@@ -548,10 +558,21 @@ func (r *BinlogReader) parseFile(
 		r.tctx.L().Debug("start parse relay log file", zap.String("file", fullPath), zap.Int64("offset", offset))
 	}
 
-	// use parser.ParseFile directly now, if needed we can change to use FileReader.
-	err = r.parser.ParseFile(fullPath, offset, onEventFunc)
+	for i := firstSegment; i < len(segments); i++ {
+		seg := segments[i]
+		fullPath = filepath.Join(relayLogDir, seg.Filename)
+		segOffset := seg.HeaderSize
+		if i == firstSegment {
+			segOffset = seg.ToLocal(offset)
+		}
+		// the segment being written is shared with the other readers through the event cache.
+		err = r.parseSegment(ctx, fullPath, segOffset, firstParse || i != firstSegment, possibleLast && i == len(segments)-1, onEventFunc)
+		if err != nil {
+			break
+		}
+	}
 	if err != nil {
-		if possibleLast && isIgnorableParseError(err) {
+		if possibleLast && fullPath == filepath.Join(relayLogDir, lastSegment.Filename) && isIgnorableParseError(err) {
 			r.tctx.L().Warn("fail to parse relay log file, meet some ignorable error", zap.String("file", fullPath), zap.Int64("offset", offset), zap.Error(err))
 			// the file is truncated, we send a mock event with `IGNORABLE_EVENT` to notify the the consumer
 			// TODO: should add a integration test for this
@@ -595,7 +616,7 @@ func (r *BinlogReader) parseFile(
 	wg.Add(1)
 	go func(latestPos int64) {
 		defer wg.Done()
-		relayLogUpdatedOrNewCreated(newCtx, watcherInterval, relayLogDir, fullPath, relayLogFile, latestPos, updatePathCh, updateErrCh)
+		relayLogUpdatedOrNewCreated(newCtx, watcherInterval, relayLogDir, fullPath, relayLogFile, lastSegment.ToLocal(latestPos), updatePathCh, updateErrCh)
 	}(latestPos)
 
 	select {
@@ -614,7 +635,7 @@ func (r *BinlogReader) parseFile(
 		}
 		return true, false, 0, switchResp.nextUUID, switchResp.nextBinlogName, false, nil
 	case updatePath := <-updatePathCh:
-		if strings.HasSuffix(updatePath, relayLogFile) {
+		if strings.HasSuffix(updatePath, lastSegment.Filename) {
 			// current relay log file updated, need to re-parse it
 			return false, true, latestPos, "", "", replaceWithHeartbeat, nil
 		}
@@ -627,6 +648,26 @@ func (r *BinlogReader) parseFile(
 	}
 }
 
+// parseSegment parses the relay log file (segment) from offset, the events are got from the event cache if possible,
+// otherwise parsed by the own parser. atBoundary means offset is not in the middle of a transaction.
+func (r *BinlogReader) parseSegment(ctx context.Context, fullPath string, offset int64, atBoundary, cacheable bool, onEvent replication.OnEventFunc) error {
+	if r.cache != nil {
+		err := r.cache.parse(ctx, fullPath, offset, cacheable, onEvent)
+		if err != errEventCacheMiss {
+			r.ownParsedFile = ""
+			return err
+		}
+	}
+	if !atBoundary && r.ownParsedFile != fullPath {
+		// the own parser doesn't know the table maps of the transaction, parse from the beginning of the file.
+		r.ownParsedFile = fullPath
+		return parseFileFrom(r.parser, fullPath, offset, onEvent)
+	}
+	r.ownParsedFile = fullPath
+	// use parser.ParseFile directly now, if needed we can change to use FileReader.
+	return r.parser.ParseFile(fullPath, offset, onEvent)
+}
+
 // updateUUIDs re-parses UUID index file and updates UUID list.
 func (r *BinlogReader) updateUUIDs() error {
 	uuids, err := utils.ParseUUIDIndex(r.indexPath)
@@ -646,6 +687,10 @@ func (r *BinlogReader) Close() {
 	r.cancel()
 	r.parser.Stop()
 	r.wg.Wait()
+	if r.cache != nil {
+		releaseEventCache(r.cache)
+		r.cache = nil
+	}
 	r.tctx.L().Info("binlog reader closed")
 }
 
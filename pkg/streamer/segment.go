@@ -0,0 +1,213 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// SegmentIndexFilename is the name of the index file in the relay sub directory, which records the segments of the
+// upstream binlog files when the relay log files are rotated locally.
+const SegmentIndexFilename = "relay.segment.index"
+
+// Segment is a local relay log file holding the events of an upstream binlog file from StartPos.
+// the relay log file named after the upstream binlog file is its first segment which is not recorded in the index,
+// the others begin with the binlog file header and the FormatDescriptionEvent of the upstream binlog file, and the
+// events in them keep the positions in the upstream binlog file.
+type Segment struct {
+	Filename   string // local relay log filename
+	BinlogName string // upstream binlog filename
+	StartPos   int64  // position in the upstream binlog file of the first event after the header
+	HeaderSize int64  // size of the binlog file header and the FormatDescriptionEvent
+}
+
+// ToLocal converts a position in the upstream binlog file to the offset in the segment.
+func (s Segment) ToLocal(pos int64) int64 {
+	return pos - s.StartPos + s.HeaderSize
+}
+
+// ToUpstream converts an offset in the segment to the position in the upstream binlog file.
+func (s Segment) ToUpstream(offset int64) int64 {
+	return offset - s.HeaderSize + s.StartPos
+}
+
+func (s Segment) String() string {
+	return fmt.Sprintf("%s %s %d %d", s.Filename, s.BinlogName, s.StartPos, s.HeaderSize)
+}
+
+// SegmentFilename returns the filename of the seq-th (starts from 2) segment of the upstream binlog file.
+// it's not a valid binlog filename, so it's never collected as a relay log file.
+func SegmentFilename(binlogName string, seq int) string {
+	return fmt.Sprintf("%s.seg%06d", binlogName, seq)
+}
+
+// loadSegmentIndex loads all segments recorded in the index file in dir, an incomplete record written before crashing
+// is ignored.
+func loadSegmentIndex(dir string) ([]Segment, error) {
+	data, err := os.ReadFile(filepath.Join(dir, SegmentIndexFilename))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, terror.ErrRelaySegmentIndex.Delegate(err, "read")
+	}
+	if idx := bytes.LastIndexByte(data, '\n'); idx+1 < len(data) {
+		data = data[:idx+1]
+	}
+
+	var segments []Segment
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return nil, terror.ErrRelaySegmentIndex.Generatef("record %q is not valid", line)
+		}
+		seg := Segment{Filename: fields[0], BinlogName: fields[1]}
+		seg.StartPos, err = strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return nil, terror.ErrRelaySegmentIndex.Delegate(err, "parse record "+line)
+		}
+		seg.HeaderSize, err = strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return nil, terror.ErrRelaySegmentIndex.Delegate(err, "parse record "+line)
+		}
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+// LoadSegments loads the segments of the upstream binlog file in dir in ascending order, the first one is always the
+// relay log file named after it.
+func LoadSegments(dir, binlogName string) ([]Segment, error) {
+	all, err := loadSegmentIndex(dir)
+	if err != nil {
+		return nil, err
+	}
+	segments := []Segment{{Filename: binlogName, BinlogName: binlogName}}
+	for _, seg := range all {
+		if seg.BinlogName == binlogName {
+			segments = append(segments, seg)
+		}
+	}
+	return segments, nil
+}
+
+// FindSegment returns the index of the segment which holds the event starting from pos.
+func FindSegment(segments []Segment, pos int64) int {
+	for i := len(segments) - 1; i > 0; i-- {
+		if segments[i].StartPos <= pos {
+			return i
+		}
+	}
+	return 0
+}
+
+// AppendSegment appends the segment to the index file in dir.
+func AppendSegment(dir string, seg Segment) error {
+	f, err := os.OpenFile(filepath.Join(dir, SegmentIndexFilename), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return terror.ErrRelaySegmentIndex.Delegate(err, "open")
+	}
+	defer f.Close()
+	if _, err = f.WriteString(seg.String() + "\n"); err != nil {
+		return terror.ErrRelaySegmentIndex.Delegate(err, "append "+seg.String())
+	}
+	return terror.ErrRelaySegmentIndex.Delegate(f.Sync(), "sync")
+}
+
+// RemoveSegments removes the segments of the upstream binlog files (except the first ones) in dir and their records
+// in the index file.
+func RemoveSegments(dir string, binlogNames []string) error {
+	all, err := loadSegmentIndex(dir)
+	if err != nil || len(all) == 0 {
+		return err
+	}
+	removed := make(map[string]struct{}, len(binlogNames))
+	for _, name := range binlogNames {
+		removed[name] = struct{}{}
+	}
+
+	var (
+		buf     bytes.Buffer
+		changed bool
+	)
+	for _, seg := range all {
+		if _, ok := removed[seg.BinlogName]; !ok {
+			buf.WriteString(seg.String() + "\n")
+			continue
+		}
+		changed = true
+		if err = os.Remove(filepath.Join(dir, seg.Filename)); err != nil && !os.IsNotExist(err) {
+			return terror.ErrRelayRemoveFileFail.Delegate(err, "segment", seg.Filename)
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return terror.ErrRelaySegmentIndex.Delegate(
+		utils.WriteFileAtomic(filepath.Join(dir, SegmentIndexFilename), buf.Bytes(), 0o644), "rewrite")
+}
+
+// RelayFileEnd returns the position in the upstream binlog file of the end of its last segment in dir.
+func RelayFileEnd(dir, binlogName string) (int64, error) {
+	segments, err := LoadSegments(dir, binlogName)
+	if err != nil {
+		return 0, err
+	}
+	last := segments[len(segments)-1]
+	fp := filepath.Join(dir, last.Filename)
+	fi, err := os.Stat(fp)
+	if err != nil {
+		return 0, terror.ErrGetRelayLogStat.Delegate(err, fp)
+	}
+	return last.ToUpstream(fi.Size()), nil
+}
+
+// ParseRelayFile parses the events of the upstream binlog file in dir from pos through all its segments, the
+// FormatDescriptionEvents at the beginning of the segments except the first parsed one are skipped.
+func ParseRelayFile(p *replication.BinlogParser, dir, binlogName string, pos int64, onEvent replication.OnEventFunc) error {
+	segments, err := LoadSegments(dir, binlogName)
+	if err != nil {
+		return err
+	}
+	first := FindSegment(segments, pos)
+	skipFDE := func(e *replication.BinlogEvent) error {
+		if e.Header.EventType == replication.FORMAT_DESCRIPTION_EVENT {
+			return nil
+		}
+		return onEvent(e)
+	}
+	for i := first; i < len(segments); i++ {
+		seg := segments[i]
+		offset, f := seg.HeaderSize, skipFDE
+		if i == first {
+			offset, f = seg.ToLocal(pos), onEvent
+		}
+		if err = p.ParseFile(filepath.Join(dir, seg.Filename), offset, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
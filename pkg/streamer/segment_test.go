@@ -0,0 +1,93 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streamer
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+var _ = Suite(&testSegmentSuite{})
+
+type testSegmentSuite struct{}
+
+func (t *testSegmentSuite) TestSegments(c *C) {
+	var (
+		dir     = c.MkDir()
+		binlog1 = "mysql-bin.000001"
+		binlog2 = "mysql-bin.000002"
+		seg12   = Segment{Filename: SegmentFilename(binlog1, 2), BinlogName: binlog1, StartPos: 1000, HeaderSize: 124}
+		seg13   = Segment{Filename: SegmentFilename(binlog1, 3), BinlogName: binlog1, StartPos: 2000, HeaderSize: 124}
+		seg22   = Segment{Filename: SegmentFilename(binlog2, 2), BinlogName: binlog2, StartPos: 500, HeaderSize: 124}
+	)
+	c.Assert(seg12.Filename, Equals, "mysql-bin.000001.seg000002")
+	c.Assert(seg12.ToLocal(1100), Equals, int64(224))
+	c.Assert(seg12.ToUpstream(224), Equals, int64(1100))
+
+	// no index file
+	segments, err := LoadSegments(dir, binlog1)
+	c.Assert(err, IsNil)
+	c.Assert(segments, DeepEquals, []Segment{{Filename: binlog1, BinlogName: binlog1}})
+	c.Assert(RemoveSegments(dir, []string{binlog1}), IsNil)
+
+	for _, seg := range []Segment{seg12, seg22, seg13} {
+		c.Assert(AppendSegment(dir, seg), IsNil)
+		c.Assert(os.WriteFile(filepath.Join(dir, seg.Filename), make([]byte, 200), 0o644), IsNil)
+	}
+	c.Assert(os.WriteFile(filepath.Join(dir, binlog1), make([]byte, 1000), 0o644), IsNil)
+
+	segments, err = LoadSegments(dir, binlog1)
+	c.Assert(err, IsNil)
+	c.Assert(segments, DeepEquals, []Segment{{Filename: binlog1, BinlogName: binlog1}, seg12, seg13})
+	c.Assert(FindSegment(segments, 4), Equals, 0)
+	c.Assert(FindSegment(segments, 999), Equals, 0)
+	c.Assert(FindSegment(segments, 1000), Equals, 1)
+	c.Assert(FindSegment(segments, 2500), Equals, 2)
+
+	end, err := RelayFileEnd(dir, binlog1)
+	c.Assert(err, IsNil)
+	c.Assert(end, Equals, seg13.ToUpstream(200))
+
+	// an incomplete record written before crashing is ignored
+	f, err := os.OpenFile(filepath.Join(dir, SegmentIndexFilename), os.O_WRONLY|os.O_APPEND, 0o644)
+	c.Assert(err, IsNil)
+	_, err = f.WriteString(SegmentFilename(binlog2, 3) + " " + binlog2)
+	c.Assert(err, IsNil)
+	c.Assert(f.Close(), IsNil)
+	segments, err = LoadSegments(dir, binlog2)
+	c.Assert(err, IsNil)
+	c.Assert(segments, DeepEquals, []Segment{{Filename: binlog2, BinlogName: binlog2}, seg22})
+
+	// remove segments of binlog1
+	c.Assert(RemoveSegments(dir, []string{binlog1}), IsNil)
+	segments, err = LoadSegments(dir, binlog1)
+	c.Assert(err, IsNil)
+	c.Assert(segments, HasLen, 1)
+	for _, seg := range []Segment{seg12, seg13} {
+		_, err = os.Stat(filepath.Join(dir, seg.Filename))
+		c.Assert(os.IsNotExist(err), IsTrue)
+	}
+	segments, err = LoadSegments(dir, binlog2)
+	c.Assert(err, IsNil)
+	c.Assert(segments, DeepEquals, []Segment{{Filename: binlog2, BinlogName: binlog2}, seg22})
+
+	// invalid record
+	c.Assert(os.WriteFile(filepath.Join(dir, SegmentIndexFilename), []byte("a b c\n"), 0o644), IsNil)
+	_, err = LoadSegments(dir, binlog1)
+	c.Assert(terror.ErrRelaySegmentIndex.Equal(err), IsTrue)
+}
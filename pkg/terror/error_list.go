@@ -191,6 +191,12 @@ const (
 
 	// pkg/binlog.
 	codeBinlogNotLogColumn
+
+	// pkg/upgrade.
+	codeUpgradeKeySchemaFail
+
+	// pkg/conn.
+	codeConnTunnel
 )
 
 // Config related error code list.
@@ -244,6 +250,46 @@ const (
 	codeConfigInvalidChunkFileSize
 	codeConfigOnlineDDLInvalidRegex
 	codeConfigOnlineDDLMistakeRegex
+	codeConfigInvalidMaxRowSize
+	codeConfigOversizedRowPolicyNotSupport
+	codeConfigSinkTypeNotSupport
+	codeConfigSinkProtocolNotSupport
+	codeConfigInvalidSink
+	codeConfigInvalidPurge
+	codeConfigInvalidTargets
+	codeConfigInvalidStartTime
+	codeConfigColumnTransformationNotFound
+	codeConfigInvalidColumnTransformation
+	codeConfigInvalidRelayArchive
+	codeConfigMetaFileConflict
+	codeConfigGeneratedColumnMismatchNotSupport
+	codeConfigInvalidPartitionRule
+	codeConfigInvalidDBConnOption
+	codeConfigInvalidErrorBudget
+	codeConfigInvalidSyncerResource
+	codeConfigInvalidDDLTimeout
+	codeConfigBroadcastRouteNotFound
+	codeConfigInvalidBroadcastRoute
+	codeConfigInvalidRelayFile
+	codeConfigTableRecreateActionNotSupport
+	codeConfigInvalidCleanupPolicy
+	codeConfigInvalidNetRateLimit
+	codeConfigInvalidFillRateLimit
+	codeConfigInvalidTunnel
+	codeConfigLoadDataPolicyNotSupport
+	codeConfigInvalidDDLWindow
+	codeConfigMinimalRowImageConflict
+	codeConfigInvalidShardVerify
+	codeConfigInvalidSyncWindow
+	codeConfigInvalidResumeBudget
+	codeConfigInvalidRelayTransformer
+	codeConfigInvalidDownstreamLabel
+	codeConfigExactlyOnceConflict
+	codeConfigDryRunConflict
+	codeConfigInvalidPartitionDDLPolicy
+	codeConfigInvalidRelayDiskCheck
+	codeConfigInvalidSchemaCharset
+	codeConfigInvalidCharsetConvert
 )
 
 // Binlog operation error code list.
@@ -329,6 +375,13 @@ const (
 	codeRelayPurgeArgsNotValid
 	codePreviousGTIDsNotValid
 	codeRotateEventWithDifferentServerID
+	codeRelayArchiveFailed
+	codeRelayWriterEventCorrupted
+	codeRelayReaderIdle
+	codeRelaySegmentIndex
+	codeRelayTransformerNotFound
+	codeRelayTransformerFailed
+	codeRelayDiskUnhealthy
 )
 
 // Dump unit error code.
@@ -360,6 +413,7 @@ const (
 	codeLoadTaskWorkerNotMatch
 	codeLoadCheckPointNotMatch
 	codeLoadBackendNotMatch
+	codeLoadUnitCleanupDumpFile
 )
 
 // Sync unit error code.
@@ -433,6 +487,35 @@ const (
 	codeSyncerParseDDL
 	codeSyncerUnsupportedStmt
 	codeSyncerGetEvent
+	codeSyncerOversizedRow
+	codeSyncerSinkEmit
+	codeSyncerSchemaDrift
+	codeSyncerStartTime
+	codeSyncerColumnTransformation
+	codeSyncerGeneratedColumnMismatch
+	codeSyncerPartitionNotFound
+	codeSyncerPartitionNotSupport
+	codeSyncerErrorBudgetExhausted
+	codeSyncerDDLJobNotFound
+	codeSyncerDDLJobFailed
+	codeSyncerTableNotQuarantined
+	codeSyncerQuarantinedTableDDL
+	codeSyncerQuarantineSpill
+	codeSyncerQuarantineNotSupport
+	codeSyncerUpstreamSwitchWithoutGTID
+	codeSyncerUpstreamSwitchErrantGTID
+	codeSyncerTableRecreated
+	codeSyncerLoadDataNotSupported
+	codeSyncerHookLoad
+	codeSyncerHookExecute
+	codeSyncerHookInvalidRowChange
+	codeSyncerRepairNotSupport
+	codeSyncerRepairTable
+	codeSyncerDryRunWrite
+	codeSyncerPartitionDDLNotSupport
+	codeSyncerCharsetTranscode
+	codeSyncerExactlyOnceTxnTooLarge
+	codeSyncerTruncateRowWithoutKey
 )
 
 // DM-master error code.
@@ -492,6 +575,23 @@ const (
 	codeMasterFailToImportFromV10x
 	codeMasterInconsistentOptimistDDLsAndInfo
 	codeMasterOptimisticTableInfobeforeNotExist
+	codeMasterTaskOverlap
+	codeMasterConfigInvalidClientRateLimit
+	codeMasterRPCThrottled
+	codeMasterInvalidClusterBackup
+	codeMasterClusterBackupVersionMismatch
+	codeMasterClusterNotEmpty
+	codeMasterTaskLocked
+	codeMasterTaskNotLocked
+	codeMasterProjectExists
+	codeMasterProjectNotFound
+	codeMasterClusterUpgrading
+	codeMasterClusterNotUpgrading
+	codeMasterClusterUpgradeVerifyFail
+	codeMasterConfigInvalidSchedulerPolicy
+	codeMasterConfigInvalidSourceDiscovery
+	codeMasterSourceDiscoveryFail
+	codeMasterSourceHandoverFail
 )
 
 // DM-worker error code.
@@ -575,6 +675,10 @@ const (
 	codeWorkerFailConnectMaster
 	codeWorkerWaitRelayCatchupGTID
 	codeWorkerRelayConfigChanging
+	codeWorkerInvalidStandaloneConfig
+	codeWorkerNotStandalone
+	codeWorkerStandaloneUnsupported
+	codeWorkerUpdateSubTaskConfig
 )
 
 // DM-tracer error code.
@@ -636,6 +740,8 @@ const (
 	codeSchedulerSourceCfgUpdate
 	codeSchedulerWrongWorkerInput
 	codeSchedulerCantTransferToRelayWorker
+	codeSchedulerSourceDisabled
+	codeSchedulerSourceNotDisabled
 )
 
 // dmctl error code.
@@ -651,6 +757,12 @@ const (
 	codeOpenAPITaskSourceNotFound
 )
 
+// shard conflict error code.
+const (
+	codeShardConflictSchema ErrCode = iota + 49501
+	codeShardConflictRow
+)
+
 // default error code.
 const (
 	codeNotSet ErrCode = iota + 50000
@@ -799,6 +911,7 @@ var (
 	// pkg/conn.
 	ErrConnInvalidTLSConfig  = New(codeConnInvalidTLSConfig, ClassFunctional, ScopeInternal, LevelMedium, "invalid TLS config", "Please check the `ssl-ca`, `ssl-cert` and `ssl-key` config.")
 	ErrConnRegistryTLSConfig = New(codeConnRegistryTLSConfig, ClassFunctional, ScopeInternal, LevelMedium, "fail to registry TLS config", "")
+	ErrConnTunnel            = New(codeConnTunnel, ClassFunctional, ScopeUpstream, LevelHigh, "fail to connect to %s through the %s tunnel", "Please check the `tunnel` config of the source and whether the bastion or proxy is reachable.")
 
 	// pkg/upgrade.
 	ErrUpgradeVersionEtcdFail = New(codeUpgradeVersionEtcdFail, ClassFunctional, ScopeInternal, LevelHigh, "fail to operate DM cluster version in etcd", "Please use `list-member --master` to confirm whether the DM-master cluster is healthy")
@@ -834,6 +947,9 @@ var (
 	// pkg/binlog.
 	ErrBinlogNotLogColumn = New(codeBinlogNotLogColumn, ClassBinlogOp, ScopeUpstream, LevelHigh, "upstream didn't log enough columns in binlog", "Please check if session `binlog_row_image` variable is not FULL, restart task to the location from where FULL binlog_row_image is used.")
 
+	// pkg/upgrade.
+	ErrUpgradeKeySchemaFail = New(codeUpgradeKeySchemaFail, ClassFunctional, ScopeInternal, LevelHigh, "fail to migrate the etcd key schema", "Please use `key-schema --dry-run` to check the changes, and use `list-member --master` to confirm whether the DM-master cluster is healthy")
+
 	// Config related error.
 	ErrConfigCheckItemNotSupport    = New(codeConfigCheckItemNotSupport, ClassConfig, ScopeInternal, LevelMedium, "checking item %s is not supported\n%s", "Please check `ignore-checking-items` config in task configuration file, which can be set including `all`/`dump_privilege`/`replication_privilege`/`version`/`binlog_enable`/`binlog_format`/`binlog_row_image`/`table_schema`/`schema_of_shard_tables`/`auto_increment_ID`.")
 	ErrConfigTomlTransform          = New(codeConfigTomlTransform, ClassConfig, ScopeInternal, LevelMedium, "%s", "Please check the configuration file has correct TOML format.")
@@ -842,7 +958,7 @@ var (
 	ErrConfigEmptySourceID          = New(codeConfigEmptySourceID, ClassConfig, ScopeInternal, LevelMedium, "empty source-id not valid", "Please check the `source-id` config in configuration file.")
 	ErrConfigTooLongSourceID        = New(codeConfigTooLongSourceID, ClassConfig, ScopeInternal, LevelMedium, "too long source-id not valid", "Please check the `source-id` config in configuration file. The max source id length is 32.")
 	ErrConfigOnlineSchemeNotSupport = New(codeConfigOnlineSchemeNotSupport, ClassConfig, ScopeInternal, LevelMedium, "online scheme %s not supported", "Please check the `online-ddl-scheme` config in task configuration file. Only `ghost` and `pt` are currently supported.")
-	ErrConfigInvalidTimezone        = New(codeConfigInvalidTimezone, ClassConfig, ScopeInternal, LevelMedium, "invalid timezone string: %s", "Please check the `timezone` config in task configuration file.")
+	ErrConfigInvalidTimezone        = New(codeConfigInvalidTimezone, ClassConfig, ScopeInternal, LevelMedium, "invalid timezone string: %s", "Please check the session variable `time_zone` of the target database in task configuration file.")
 	ErrConfigParseFlagSet           = New(codeConfigParseFlagSet, ClassConfig, ScopeInternal, LevelMedium, "parse subtask config flag set", "")
 	ErrConfigDecryptDBPassword      = New(codeConfigDecryptDBPassword, ClassConfig, ScopeInternal, LevelMedium, "decrypt DB password %s failed", "")
 	ErrConfigMetaInvalid            = New(codeConfigMetaInvalid, ClassConfig, ScopeInternal, LevelMedium, "must specify `binlog-name` without GTID enabled for the source or specify `binlog-gtid` with GTID enabled for the source", "Please check the `meta` config in task configuration file.")
@@ -854,7 +970,7 @@ var (
 	ErrConfigSyncerCfgConflict      = New(codeConfigSyncerCfgConflict, ClassConfig, ScopeInternal, LevelMedium, "syncer-config-name and syncer should only specify one", "Please check the `syncer-config-name` and `syncer` config in task configuration file.")
 	ErrConfigReadCfgFromFile        = New(codeConfigReadCfgFromFile, ClassConfig, ScopeInternal, LevelMedium, "read config file %v", "")
 	ErrConfigNeedUniqueTaskName     = New(codeConfigNeedUniqueTaskName, ClassConfig, ScopeInternal, LevelMedium, "must specify a unique task name", "Please check the `name` config in task configuration file.")
-	ErrConfigInvalidTaskMode        = New(codeConfigInvalidTaskMode, ClassConfig, ScopeInternal, LevelMedium, "please specify right task-mode, support `full`, `incremental`, `all`", "Please check the `task-mode` config in task configuration file.")
+	ErrConfigInvalidTaskMode        = New(codeConfigInvalidTaskMode, ClassConfig, ScopeInternal, LevelMedium, "please specify right task-mode, support `full`, `incremental`, `all`, `schema-only`", "Please check the `task-mode` config in task configuration file.")
 	ErrConfigNeedTargetDB           = New(codeConfigNeedTargetDB, ClassConfig, ScopeInternal, LevelMedium, "must specify target-database", "Please check the `target-database` config in task configuration file.")
 	ErrConfigMetadataNotSet         = New(codeConfigMetadataNotSet, ClassConfig, ScopeInternal, LevelMedium, "mysql-instance(%d) must set meta for task-mode %s", "Please check the `meta` config in task configuration file.")
 	ErrConfigRouteRuleNotFound      = New(codeConfigRouteRuleNotFound, ClassConfig, ScopeInternal, LevelMedium, "mysql-instance(%d)'s route-rules %s not exist in routes", "Please check the `route-rules` config in task configuration file.")
@@ -886,6 +1002,46 @@ var (
 		"config '%s' regex pattern '%s' invalid, reason: %s", "Please check if params is correctly in the configuration file.")
 	ErrConfigOnlineDDLMistakeRegex = New(codeConfigOnlineDDLMistakeRegex, ClassConfig, ScopeInternal, LevelHigh,
 		"online ddl sql '%s' invalid, table %s fail to match '%s' online ddl regex", "Please update your `shadow-table-rules` or `trash-table-rules` in the configuration file.")
+	ErrConfigInvalidMaxRowSize                 = New(codeConfigInvalidMaxRowSize, ClassConfig, ScopeInternal, LevelHigh, "invalid `max-row-size` %d", "Please check the `max-row-size` config in task configuration file, it should not be negative.")
+	ErrConfigOversizedRowPolicyNotSupport      = New(codeConfigOversizedRowPolicyNotSupport, ClassConfig, ScopeInternal, LevelHigh, "oversized row policy %s not supported", "Please check the `oversized-row-policy` config in task configuration file. Only `fail`, `truncate` and `side-table` are supported.")
+	ErrConfigSinkTypeNotSupport                = New(codeConfigSinkTypeNotSupport, ClassConfig, ScopeInternal, LevelHigh, "sink type %s not supported", "Please check the `sink` config in task configuration file. Only `kafka` is supported.")
+	ErrConfigSinkProtocolNotSupport            = New(codeConfigSinkProtocolNotSupport, ClassConfig, ScopeInternal, LevelHigh, "sink protocol %s not supported", "Please check the `sink` config in task configuration file. Only `canal-json`, `maxwell` and `avro` are supported.")
+	ErrConfigInvalidSink                       = New(codeConfigInvalidSink, ClassConfig, ScopeInternal, LevelHigh, "invalid sink config: %s", "Please check the `sink` config in task configuration file.")
+	ErrConfigInvalidPurge                      = New(codeConfigInvalidPurge, ClassConfig, ScopeInternal, LevelHigh, "invalid purge config: %s", "Please check the `purge` config in source configuration file.")
+	ErrConfigInvalidTargets                    = New(codeConfigInvalidTargets, ClassConfig, ScopeInternal, LevelHigh, "invalid targets config: %s", "Please check the `targets` config in task configuration file.")
+	ErrConfigInvalidStartTime                  = New(codeConfigInvalidStartTime, ClassConfig, ScopeInternal, LevelHigh, "invalid start-time %s: %s", "Please use the format of `2006-01-02 15:04:05` or RFC3339, and only use start-time in `incremental` task-mode.")
+	ErrConfigColumnTransformationNotFound      = New(codeConfigColumnTransformationNotFound, ClassConfig, ScopeInternal, LevelHigh, "mysql-instance(%d)'s column-transformation-rules %s not exist in column-transformations", "Please check the `column-transformation-rules` config in task configuration file.")
+	ErrConfigInvalidColumnTransformation       = New(codeConfigInvalidColumnTransformation, ClassConfig, ScopeInternal, LevelHigh, "invalid column transformation %s: %s", "Please check the `column-transformations` config in task configuration file.")
+	ErrConfigInvalidRelayArchive               = New(codeConfigInvalidRelayArchive, ClassConfig, ScopeInternal, LevelHigh, "invalid relay-archive config: %s", "Please check the `relay-archive` config in source configuration file.")
+	ErrConfigMetaFileConflict                  = New(codeConfigMetaFileConflict, ClassConfig, ScopeInternal, LevelMedium, "`metadata-file` can't be specified together with `binlog-name` or `binlog-gtid`", "Please check the `meta` config in task configuration file.")
+	ErrConfigGeneratedColumnMismatchNotSupport = New(codeConfigGeneratedColumnMismatchNotSupport, ClassConfig, ScopeInternal, LevelMedium, "generated column mismatch policy %s not supported", "Please check the `generated-column-mismatch` config in task configuration file. Only `error` and `skip` are supported.")
+	ErrConfigInvalidPartitionRule              = New(codeConfigInvalidPartitionRule, ClassConfig, ScopeInternal, LevelMedium, "invalid partition rule %d: %s", "Please check the `partition-rules` config in task configuration file.")
+	ErrConfigInvalidDBConnOption               = New(codeConfigInvalidDBConnOption, ClassConfig, ScopeInternal, LevelMedium, "invalid connection option %s of database %s:%d: %s", "Please check the connection options in the database config, the timeouts should be durations like `30s`.")
+	ErrConfigInvalidErrorBudget                = New(codeConfigInvalidErrorBudget, ClassConfig, ScopeInternal, LevelMedium, "invalid `error-budget` %d or `error-budget-window` %d", "Please check the `error-budget` and `error-budget-window` config in task configuration file, they should not be negative.")
+	ErrConfigInvalidSyncerResource             = New(codeConfigInvalidSyncerResource, ClassConfig, ScopeInternal, LevelMedium, "invalid `worker-count` %d, `queue-size` %d or `memory-quota` %d", "Please check the `worker-count`, `queue-size` and `memory-quota` config in task configuration file, `worker-count` and `queue-size` should be positive and `memory-quota` should not be negative.")
+	ErrConfigInvalidDDLTimeout                 = New(codeConfigInvalidDDLTimeout, ClassConfig, ScopeInternal, LevelMedium, "invalid `ddl-timeout` %d", "Please check the `ddl-timeout` config in task configuration file, it should not be negative.")
+	ErrConfigBroadcastRouteNotFound            = New(codeConfigBroadcastRouteNotFound, ClassConfig, ScopeInternal, LevelHigh, "mysql-instance(%d)'s broadcast-route-rules %s not exist in broadcast-routes", "Please check the `broadcast-route-rules` config in task configuration file.")
+	ErrConfigInvalidBroadcastRoute             = New(codeConfigInvalidBroadcastRoute, ClassConfig, ScopeInternal, LevelHigh, "invalid broadcast route %s: %s", "Please check the `broadcast-routes` config in task configuration file.")
+	ErrConfigInvalidRelayFile                  = New(codeConfigInvalidRelayFile, ClassConfig, ScopeInternal, LevelHigh, "invalid relay-file config: %s", "Please check the `relay-file` config in source configuration file.")
+	ErrConfigTableRecreateActionNotSupport     = New(codeConfigTableRecreateActionNotSupport, ClassConfig, ScopeInternal, LevelMedium, "table recreate action %s not supported: %s", "Please check the `on-table-recreate` config in task configuration file. Only `check` and `redump` are supported.")
+	ErrConfigInvalidCleanupPolicy              = New(codeConfigInvalidCleanupPolicy, ClassConfig, ScopeInternal, LevelMedium, "invalid cleanup policy %s: %s", "Please check the `cleanup-policy` config of loaders in task configuration file. Only `keep`, `delete`, `archive` with `archive-dir` and `upload` with `archive-storage` are supported.")
+	ErrConfigInvalidNetRateLimit               = New(codeConfigInvalidNetRateLimit, ClassConfig, ScopeInternal, LevelHigh, "invalid net-rate-limit %d, it should not be negative", "Please check the `net-rate-limit` config in source configuration file.")
+	ErrConfigInvalidFillRateLimit              = New(codeConfigInvalidFillRateLimit, ClassConfig, ScopeInternal, LevelMedium, "invalid `fill-missing-columns-rate-limit` %d", "Please check the `fill-missing-columns-rate-limit` config in task configuration file, it should not be negative.")
+	ErrConfigInvalidTunnel                     = New(codeConfigInvalidTunnel, ClassConfig, ScopeInternal, LevelHigh, "invalid tunnel config: %s", "Please check the `tunnel` config in source configuration file.")
+	ErrConfigLoadDataPolicyNotSupport          = New(codeConfigLoadDataPolicyNotSupport, ClassConfig, ScopeInternal, LevelMedium, "load data policy %s not supported", "Please check the `load-data-policy` config in task configuration file. Only `skip`, `error` and `reconstruct` are supported.")
+	ErrConfigInvalidDDLWindow                  = New(codeConfigInvalidDDLWindow, ClassConfig, ScopeInternal, LevelMedium, "invalid ddl window %s: %s", "Please check the `ddl-window` and `ddl-window-policy` config in task configuration file. The window should be like `01:00-05:00`, and only `buffer` and `block` policies are supported.")
+	ErrConfigMinimalRowImageConflict           = New(codeConfigMinimalRowImageConflict, ClassConfig, ScopeInternal, LevelMedium, "`minimal-row-image` can't be used with %s", "Please check the `minimal-row-image` config in task configuration file, the DMLs built from the partial row images can't be compacted, written to a sink or passed to a hook plugin, and `fill-missing-columns` fills the full row images instead.")
+	ErrConfigInvalidShardVerify                = New(codeConfigInvalidShardVerify, ClassConfig, ScopeInternal, LevelMedium, "invalid shard verify config: %s", "Please check the `shard-verify-interval` and `shard-conflict-sample-rate` config in task configuration file, the interval should not be negative and the sample rate should be in [0, 100].")
+	ErrConfigInvalidSyncWindow                 = New(codeConfigInvalidSyncWindow, ClassConfig, ScopeInternal, LevelMedium, "invalid sync window %s: %s", "Please check the `sync-window` config in task configuration file. The window should be like `22:00-06:00`.")
+	ErrConfigInvalidResumeBudget               = New(codeConfigInvalidResumeBudget, ClassConfig, ScopeInternal, LevelMedium, "invalid `resume-budget` %d, it should not be negative", "Please check the `resume-budget` config of `checker` in source configuration file.")
+	ErrConfigInvalidRelayTransformer           = New(codeConfigInvalidRelayTransformer, ClassConfig, ScopeInternal, LevelMedium, "invalid relay-transformers config: %s", "Please check the `relay-transformers` config in source configuration file.")
+	ErrConfigInvalidDownstreamLabel            = New(codeConfigInvalidDownstreamLabel, ClassConfig, ScopeInternal, LevelMedium, "invalid downstream-label config: %s", "Please check the `downstream-label` config in task configuration file.")
+	ErrConfigExactlyOnceConflict               = New(codeConfigExactlyOnceConflict, ClassConfig, ScopeInternal, LevelMedium, "`exactly-once` can't be used with %s", "Please check the `exactly-once` config in task configuration file, the transactions are applied in one DML queue of the downstream database, and the row changes are buffered until the transactions end, so a sink, additional targets, `slow-digest-threshold` and `memory-quota` are not supported.")
+	ErrConfigDryRunConflict                    = New(codeConfigDryRunConflict, ClassConfig, ScopeInternal, LevelMedium, "`start-task --dry-run` can't be used with %s", "Please remove the option from the task configuration file, a dry-run subtask only replicates the binlog in `incremental` mode and doesn't write the downstream database.")
+	ErrConfigInvalidPartitionDDLPolicy         = New(codeConfigInvalidPartitionDDLPolicy, ClassConfig, ScopeInternal, LevelMedium, "invalid partition ddl policy %s: %s", "Please check the `partition-ddl-policy` config in task configuration file. Only `pass`, `drop` and `convert` are supported, and `convert` can't be used in shard mode or with a sink.")
+	ErrConfigInvalidRelayDiskCheck             = New(codeConfigInvalidRelayDiskCheck, ClassConfig, ScopeInternal, LevelHigh, "invalid relay-disk-check config: %s", "Please check the `relay-disk-check` config in source configuration file.")
+	ErrConfigInvalidSchemaCharset              = New(codeConfigInvalidSchemaCharset, ClassConfig, ScopeInternal, LevelMedium, "invalid schema-charset %s or schema-collation %s: %s", "Please check the `schema-charset` and `schema-collation` config of loaders in task configuration file.")
+	ErrConfigInvalidCharsetConvert             = New(codeConfigInvalidCharsetConvert, ClassConfig, ScopeInternal, LevelMedium, "invalid charset-convert rule %d: %s", "Please check the `charset-convert` config in task configuration file.")
 
 	// Binlog operation error.
 	ErrBinlogExtractPosition = New(codeBinlogExtractPosition, ClassBinlogOp, ScopeInternal, LevelHigh, "", "")
@@ -961,6 +1117,13 @@ var (
 	ErrRelayPurgeArgsNotValid            = New(codeRelayPurgeArgsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "args (%T) %+v not valid", "")
 	ErrPreviousGTIDsNotValid             = New(codePreviousGTIDsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "previousGTIDs %s not valid", "")
 	ErrRotateEventWithDifferentServerID  = New(codeRotateEventWithDifferentServerID, ClassRelayUnit, ScopeInternal, LevelHigh, "receive fake rotate event with different server_id", "Please use `resume-relay` command if upstream database has changed")
+	ErrRelayArchiveFailed                = New(codeRelayArchiveFailed, ClassRelayUnit, ScopeInternal, LevelLow, "archive binlog event at %s:%d to %s", "Please check whether the `relay-archive` endpoint is available.")
+	ErrRelayWriterEventCorrupted         = New(codeRelayWriterEventCorrupted, ClassRelayUnit, ScopeInternal, LevelMedium, "binlog event %+v is corrupted: %s", "The event will be requested again from the upstream, please check the network if it happens frequently.")
+	ErrRelayReaderIdle                   = New(codeRelayReaderIdle, ClassRelayUnit, ScopeUpstream, LevelMedium, "no binlog event received from the upstream for %s, the connection is suspect", "The binlog connection will be reconnected, please check the network and the upstream database if it happens frequently.")
+	ErrRelaySegmentIndex                 = New(codeRelaySegmentIndex, ClassRelayUnit, ScopeInternal, LevelHigh, "relay log segment index %s", "")
+	ErrRelayTransformerNotFound          = New(codeRelayTransformerNotFound, ClassRelayUnit, ScopeInternal, LevelMedium, "relay transformer %s not found", "Please check the `name` of `relay-transformers` in source configuration file, only the registered transformers can be used.")
+	ErrRelayTransformerFailed            = New(codeRelayTransformerFailed, ClassRelayUnit, ScopeInternal, LevelHigh, "relay transformer %s fail to transform the binlog event at %d", "Please check the relay transformer, or set its `on-error` to `skip` to pass the events through unchanged when it fails.")
+	ErrRelayDiskUnhealthy                = New(codeRelayDiskUnhealthy, ClassRelayUnit, ScopeInternal, LevelHigh, "the disk of relay dir %s is unhealthy: %s", "Please check the disk of the relay dir, and resume the relay after it's recovered.")
 
 	// Dump unit error.
 	ErrDumpUnitRuntime        = New(codeDumpUnitRuntime, ClassDumpUnit, ScopeInternal, LevelHigh, "mydumper/dumpling runs with error, with output (may empty): %s", "")
@@ -988,6 +1151,7 @@ var (
 	ErrLoadTaskWorkerNotMatch      = New(codeLoadTaskWorkerNotMatch, ClassFunctional, ScopeInternal, LevelHigh, "different worker in load stage, previous worker: %s, current worker: %s", "Please check if the previous worker is online.")
 	ErrLoadTaskCheckPointNotMatch  = New(codeLoadCheckPointNotMatch, ClassFunctional, ScopeInternal, LevelHigh, "inconsistent checkpoints between loader and target database", "If you want to redo the whole task, please check that you have not forgotten to add -remove-meta flag for start-task command.")
 	ErrLoadBackendNotSupport       = New(codeLoadBackendNotMatch, ClassFunctional, ScopeInternal, LevelHigh, "DM do not support backend %s ", "If you do not understand the configure `tidb.backend` you can just delete it.")
+	ErrLoadUnitCleanupDumpFile     = New(codeLoadUnitCleanupDumpFile, ClassLoadUnit, ScopeInternal, LevelMedium, "fail to clean up dump file %s", "Please check the `cleanup-policy`, `archive-dir` and `archive-storage` config of loaders in task configuration file.")
 
 	// Sync unit error.
 	ErrSyncerUnitPanic                   = New(codeSyncerUnitPanic, ClassSyncUnit, ScopeInternal, LevelHigh, "panic error: %v", "")
@@ -1060,6 +1224,35 @@ var (
 	ErrSyncerParseDDL                       = New(codeSyncerParseDDL, ClassSyncUnit, ScopeInternal, LevelHigh, "parse DDL: %s", "Please confirm your DDL statement is correct and needed. For TiDB compatible DDL, see https://docs.pingcap.com/tidb/stable/mysql-compatibility#ddl. You can use `handle-error` command to skip or replace the DDL or add a binlog filter rule to ignore it if the DDL is not needed.")
 	ErrSyncerUnsupportedStmt                = New(codeSyncerUnsupportedStmt, ClassSyncUnit, ScopeInternal, LevelHigh, "`%s` statement not supported in %s mode", "")
 	ErrSyncerGetEvent                       = New(codeSyncerGetEvent, ClassSyncUnit, ScopeUpstream, LevelHigh, "get binlog event error: %v", "Please check if the binlog file could be parsed by `mysqlbinlog`.")
+	ErrSyncerOversizedRow                   = New(codeSyncerOversizedRow, ClassSyncUnit, ScopeInternal, LevelHigh, "row of table %s with size %d exceeds `max-row-size` %d", "Please increase `max-row-size`, or set `oversized-row-policy` to `truncate` or `side-table` in task configuration file.")
+	ErrSyncerSinkEmit                       = New(codeSyncerSinkEmit, ClassSyncUnit, ScopeDownstream, LevelHigh, "emit %d messages to sink", "Please check the status of the Kafka cluster and the `sink` config in task configuration file.")
+	ErrSyncerSchemaDrift                    = New(codeSyncerSchemaDrift, ClassSyncUnit, ScopeDownstream, LevelMedium, "downstream table %s differs from the table structure of %s tracked by DM: %s", "Please check whether the downstream table is changed manually, then revert the change or use `operate-schema set` to update the table structure tracked by DM.")
+	ErrSyncerStartTime                      = New(codeSyncerStartTime, ClassSyncUnit, ScopeUpstream, LevelHigh, "fail to find binlog position for start-time %s: %s", "Please check whether the binlog files at the start-time are purged.")
+	ErrSyncerColumnTransformation           = New(codeSyncerColumnTransformation, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to transform column %s of table %s: %s", "Please check the `column-transformations` config in task configuration file.")
+	ErrSyncerGeneratedColumnMismatch        = New(codeSyncerGeneratedColumnMismatch, ClassSyncUnit, ScopeDownstream, LevelHigh, "generated columns of table %s are different from upstream table %s: %s", "Please make the generated columns of the downstream table the same as upstream, or set `generated-column-mismatch` to `skip` in task configuration file.")
+	ErrSyncerPartitionNotFound              = New(codeSyncerPartitionNotFound, ClassSyncUnit, ScopeDownstream, LevelHigh, "table %s has no partition for value %v of column %s", "Please add a partition for the value to the downstream table, or filter out the row changes.")
+	ErrSyncerPartitionNotSupport            = New(codeSyncerPartitionNotSupport, ClassSyncUnit, ScopeDownstream, LevelHigh, "can't locate the partitions of table %s: %s", "Please check the `partition-rules` config in task configuration file. Only the tables partitioned by RANGE or LIST on a single integer column are supported.")
+	ErrSyncerErrorBudgetExhausted           = New(codeSyncerErrorBudgetExhausted, ClassSyncUnit, ScopeDownstream, LevelHigh, "more than %d retryable errors of the downstream in %s, the error budget is exhausted", "Please check the downstream database, then use `resume-task --reset-error-budget` to resume the task.")
+	ErrSyncerDDLJobNotFound                 = New(codeSyncerDDLJobNotFound, ClassSyncUnit, ScopeDownstream, LevelHigh, "can't find the DDL job of %s in downstream after the execution timed out", "Please check whether the DDL is executed in downstream, then resume the task.")
+	ErrSyncerDDLJobFailed                   = New(codeSyncerDDLJobFailed, ClassSyncUnit, ScopeDownstream, LevelHigh, "the DDL job %d of %s in downstream is %s", "Please check the DDL job by `ADMIN SHOW DDL JOBS` in downstream, then resume the task.")
+	ErrSyncerTableNotQuarantined            = New(codeSyncerTableNotQuarantined, ClassSyncUnit, ScopeInternal, LevelMedium, "table %s is not quarantined", "")
+	ErrSyncerQuarantinedTableDDL            = New(codeSyncerQuarantinedTableDDL, ClassSyncUnit, ScopeInternal, LevelHigh, "DDL %s on quarantined table %s can't be replicated", "Please release the table by `quarantine-table release`, then resume the task.")
+	ErrSyncerQuarantineSpill                = New(codeSyncerQuarantineSpill, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to spill the buffered events of quarantined table %s to disk", "Please check the disk space of dm-worker.")
+	ErrSyncerQuarantineNotSupport           = New(codeSyncerQuarantineNotSupport, ClassSyncUnit, ScopeInternal, LevelMedium, "quarantine is not supported %s", "")
+	ErrSyncerUpstreamSwitchWithoutGTID      = New(codeSyncerUpstreamSwitchWithoutGTID, ClassSyncUnit, ScopeUpstream, LevelHigh, "upstream is switched from server %s to %s, which is only supported when GTID is enabled", "Please set `enable-gtid: true` in the source config, or update the checkpoint to the binlog position of the new primary manually.")
+	ErrSyncerUpstreamSwitchErrantGTID       = New(codeSyncerUpstreamSwitchErrantGTID, ClassSyncUnit, ScopeUpstream, LevelHigh, "transactions %s have been migrated but are not executed in the new primary %s of upstream", "Please apply the missing transactions to the new primary, or update the checkpoint to the GTID sets of the new primary manually.")
+	ErrSyncerTableRecreated                 = New(codeSyncerTableRecreated, ClassSyncUnit, ScopeInternal, LevelHigh, "table %s is recreated with a different structure: %s", "Please check the rules of the table and the downstream table, then resume the task.")
+	ErrSyncerLoadDataNotSupported           = New(codeSyncerLoadDataNotSupported, ClassSyncUnit, ScopeInternal, LevelHigh, "can't replicate LOAD DATA statement: %s", "Please set `load-data-policy: skip` to skip it, or use `binlog_format=ROW` in upstream.")
+	ErrSyncerHookLoad                       = New(codeSyncerHookLoad, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to load hook plugin %s", "Please check the `hook-plugin` config in task configuration file, the plugin should export `NewHook func() (hook.Hook, error)` and be built with the same Go version and dependencies as DM-worker.")
+	ErrSyncerHookExecute                    = New(codeSyncerHookExecute, ClassSyncUnit, ScopeInternal, LevelHigh, "hook plugin fails to handle %s", "Please check the hook plugin.")
+	ErrSyncerHookInvalidRowChange           = New(codeSyncerHookInvalidRowChange, ClassSyncUnit, ScopeInternal, LevelHigh, "hook plugin returns invalid row change of table %s: %s", "Please check the hook plugin, the returned row changes should be of the same target table and have the same columns.")
+	ErrSyncerRepairNotSupport               = New(codeSyncerRepairNotSupport, ClassSyncUnit, ScopeInternal, LevelMedium, "repair is not supported %s", "")
+	ErrSyncerRepairTable                    = New(codeSyncerRepairTable, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to repair table %s when %s", "Please check the error, the table can be repaired again by `quarantine-table repair`. if it fails when replaying, please release the table by `quarantine-table release`.")
+	ErrSyncerDryRunWrite                    = New(codeSyncerDryRunWrite, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to write the SQL statements of the dry-run subtask to %s", "Please check the disk space and permission of the working directory of dm-worker.")
+	ErrSyncerPartitionDDLNotSupport         = New(codeSyncerPartitionDDLNotSupport, ClassSyncUnit, ScopeInternal, LevelHigh, "can't convert the partition operation of DDL %s: %s", "Please set `partition-ddl-policy: drop` to skip the partition operations, the rows in the dropped or truncated partitions are kept in downstream then.")
+	ErrSyncerCharsetTranscode               = New(codeSyncerCharsetTranscode, ClassSyncUnit, ScopeInternal, LevelHigh, "fail to transcode column %s of table %s from charset %s", "Please check the values of the column in upstream, or disable `transcode` of the `charset-convert` rule if the values are already encoded in UTF-8.")
+	ErrSyncerExactlyOnceTxnTooLarge         = New(codeSyncerExactlyOnceTxnTooLarge, ClassSyncUnit, ScopeInternal, LevelHigh, "the transaction has more than %d row changes, which are buffered in memory in `exactly-once` mode", "Please disable `exactly-once` to replicate the large transaction, its row changes are applied in several downstream transactions then.")
+	ErrSyncerTruncateRowWithoutKey          = New(codeSyncerTruncateRowWithoutKey, ClassSyncUnit, ScopeInternal, LevelHigh, "can't truncate the oversized row of table %s which has no primary key or not null unique key", "The truncated row can't be matched by the later row changes of it in downstream. Please add a primary key or a not null unique key to the table, or set `oversized-row-policy` to `side-table` in task configuration file.")
 
 	// DM-master error.
 	ErrMasterSQLOpNilRequest        = New(codeMasterSQLOpNilRequest, ClassDMMaster, ScopeInternal, LevelMedium, "nil request not valid", "")
@@ -1122,6 +1315,23 @@ var (
 
 	ErrMasterInconsistentOptimisticDDLsAndInfo = New(codeMasterInconsistentOptimistDDLsAndInfo, ClassDMMaster, ScopeInternal, LevelHigh, "inconsistent count of optimistic ddls and table infos, ddls: %d, table info: %d", "")
 	ErrMasterOptimisticTableInfoBeforeNotExist = New(codeMasterOptimisticTableInfobeforeNotExist, ClassDMMaster, ScopeInternal, LevelHigh, "table-info-before not exist in optimistic ddls: %v", "")
+	ErrMasterTaskOverlap                       = New(codeMasterTaskOverlap, ClassDMMaster, ScopeInternal, LevelHigh, "task %s writes to the same downstream tables as other tasks: %s", "Please use different `meta-schema` or route rules for the tasks, or use `start-task --allow-overlap` if it's expected.")
+	ErrMasterConfigInvalidClientRateLimit      = New(codeMasterConfigInvalidClientRateLimit, ClassDMMaster, ScopeInternal, LevelMedium, "invalid client-rate-limits config: %s", "Please check the `client-rate-limits` config in DM-master configuration file.")
+	ErrMasterRPCThrottled                      = New(codeMasterRPCThrottled, ClassDMMaster, ScopeInternal, LevelLow, "request %s from %s client %s is throttled, retry after %s", "Please reduce the request rate of the client, or increase the `client-rate-limits` config of DM-master.")
+	ErrMasterInvalidClusterBackup              = New(codeMasterInvalidClusterBackup, ClassDMMaster, ScopeInternal, LevelHigh, "invalid cluster backup: %s", "Please use the file created by `cluster backup` command.")
+	ErrMasterClusterBackupVersionMismatch      = New(codeMasterClusterBackupVersionMismatch, ClassDMMaster, ScopeInternal, LevelHigh, "cluster backup of internal version %d (release version %s) can't be restored into the cluster of internal version %d", "Please restore the backup into a DM cluster of the same version.")
+	ErrMasterClusterNotEmpty                   = New(codeMasterClusterNotEmpty, ClassDMMaster, ScopeInternal, LevelHigh, "cluster states already exist, can't restore the backup", "Please restore the backup into a fresh DM cluster without any sources and tasks.")
+	ErrMasterTaskLocked                        = New(codeMasterTaskLocked, ClassDMMaster, ScopeInternal, LevelLow, "task %s is locked by %s for %s until %s", "Please contact the holder of the lock, or use `--force` to operate the task anyway.")
+	ErrMasterTaskNotLocked                     = New(codeMasterTaskNotLocked, ClassDMMaster, ScopeInternal, LevelLow, "task %s is not locked", "")
+	ErrMasterProjectExists                     = New(codeMasterProjectExists, ClassDMMaster, ScopeInternal, LevelLow, "project %s already exists", "Please delete the project first if you want to recreate it.")
+	ErrMasterProjectNotFound                   = New(codeMasterProjectNotFound, ClassDMMaster, ScopeInternal, LevelLow, "project %s not found", "")
+	ErrMasterClusterUpgrading                  = New(codeMasterClusterUpgrading, ClassDMMaster, ScopeInternal, LevelLow, "cluster is being upgraded to %s since %s, task operations are blocked", "Please finish the upgrade by `cluster upgrade finish` first.")
+	ErrMasterClusterNotUpgrading               = New(codeMasterClusterNotUpgrading, ClassDMMaster, ScopeInternal, LevelLow, "cluster is not being upgraded", "Please start the upgrade by `cluster upgrade start` first.")
+	ErrMasterClusterUpgradeVerifyFail          = New(codeMasterClusterUpgradeVerifyFail, ClassDMMaster, ScopeInternal, LevelHigh, "fail to verify the upgrade of the cluster: %s", "Please fix the problems and finish the upgrade again.")
+	ErrMasterConfigInvalidSchedulerPolicy      = New(codeMasterConfigInvalidSchedulerPolicy, ClassDMMaster, ScopeInternal, LevelMedium, "scheduler policy %s is not supported", "Please use `least-loaded`, `round-robin` or `label-affinity`.")
+	ErrMasterConfigInvalidSourceDiscovery      = New(codeMasterConfigInvalidSourceDiscovery, ClassDMMaster, ScopeInternal, LevelMedium, "invalid source-discovery config: %s", "Please check the `source-discovery` config in DM-master configuration file.")
+	ErrMasterSourceDiscoveryFail               = New(codeMasterSourceDiscoveryFail, ClassDMMaster, ScopeInternal, LevelMedium, "fail to discover the upstream sources from %s", "Please check the service registry is accessible.")
+	ErrMasterSourceHandoverFail                = New(codeMasterSourceHandoverFail, ClassDMMaster, ScopeInternal, LevelHigh, "fail to hand over source %s from worker %s to worker %s", "Please check the status of the subtasks of the source, and resume the subtasks paused for the handover by `resume-task` if needed.")
 
 	// DM-worker error.
 	ErrWorkerParseFlagSet            = New(codeWorkerParseFlagSet, ClassDMWorker, ScopeInternal, LevelMedium, "parse dm-worker config flag set", "")
@@ -1204,6 +1414,10 @@ var (
 	ErrWorkerTLSConfigNotValid              = New(codeWorkerTLSConfigNotValid, ClassDMWorker, ScopeInternal, LevelHigh, "TLS config not valid", "Please check the `ssl-ca`, `ssl-cert` and `ssl-key` config in worker configuration file.")
 	ErrWorkerFailConnectMaster              = New(codeWorkerFailConnectMaster, ClassDMWorker, ScopeInternal, LevelHigh, "cannot join with master endpoints: %v, error: %v", "Please check network connection of worker and check worker name is unique.")
 	ErrWorkerRelayConfigChanging            = New(codeWorkerRelayConfigChanging, ClassDMWorker, ScopeInternal, LevelLow, "relay config of worker %s is changed too frequently, last relay source %s:, new relay source %s", "Please try again later")
+	ErrWorkerInvalidStandaloneConfig        = New(codeWorkerInvalidStandaloneConfig, ClassDMWorker, ScopeInternal, LevelMedium, "invalid standalone mode config: %s", "Please check the `source-config`, `data-dir` and `join` config in worker configuration file.")
+	ErrWorkerNotStandalone                  = New(codeWorkerNotStandalone, ClassDMWorker, ScopeInternal, LevelMedium, "the operation is only available when dm-worker runs in standalone mode", "Please operate the task through DM-master.")
+	ErrWorkerStandaloneUnsupported          = New(codeWorkerStandaloneUnsupported, ClassDMWorker, ScopeInternal, LevelMedium, "%s is not supported when dm-worker runs in standalone mode", "")
+	ErrWorkerUpdateSubTaskConfig            = New(codeWorkerUpdateSubTaskConfig, ClassDMWorker, ScopeInternal, LevelMedium, "can't update `%s` of subtask %s online", "Please stop the task and start it with the new config.")
 
 	// DM-tracer error.
 	ErrTracerParseFlagSet        = New(codeTracerParseFlagSet, ClassDMTracer, ScopeInternal, LevelMedium, "parse dm-tracer config flag set", "")
@@ -1267,6 +1481,8 @@ var (
 	ErrSchedulerSourceCfgUpdate           = New(codeSchedulerSourceCfgUpdate, ClassScheduler, ScopeInternal, LevelLow, "source can only update relay-log related parts for now", "")
 	ErrSchedulerWrongWorkerInput          = New(codeSchedulerWrongWorkerInput, ClassScheduler, ScopeInternal, LevelMedium, "require DM master to modify worker [%s] with source [%s], but currently the worker is bound to source [%s]", "")
 	ErrSchedulerCantTransferToRelayWorker = New(codeSchedulerCantTransferToRelayWorker, ClassScheduler, ScopeInternal, LevelMedium, "require DM worker to be bound to source [%s], but it has been started relay for source [%s]", "")
+	ErrSchedulerSourceDisabled            = New(codeSchedulerSourceDisabled, ClassScheduler, ScopeInternal, LevelMedium, "source %s is disabled", "Please use `operate-source enable` to enable the source first.")
+	ErrSchedulerSourceNotDisabled         = New(codeSchedulerSourceNotDisabled, ClassScheduler, ScopeInternal, LevelLow, "source %s is not disabled", "")
 
 	// dmctl.
 	ErrCtlGRPCCreateConn = New(codeCtlGRPCCreateConn, ClassDMCtl, ScopeInternal, LevelHigh, "can not create grpc connection", "Please check your network connection.")
@@ -1277,6 +1493,10 @@ var (
 	ErrOpenAPICommonError        = New(codeOpenAPICommon, ClassOpenAPI, ScopeInternal, LevelHigh, "some unexpected errors have occurred, please check the detailed error message", "")
 	ErrOpenAPITaskSourceNotFound = New(codeOpenAPITaskSourceNotFound, ClassOpenAPI, ScopeInternal, LevelHigh, "data source configuration not found", "Please check if the data source exists in the configuration file.")
 
+	// shard conflict.
+	ErrShardConflictSchema = New(codeShardConflictSchema, ClassShardConflict, ScopeUpstream, LevelHigh, "shard tables %s merged into %s have incompatible schemas: %s", "Please make the schemas of the shard tables compatible, the same columns should have the same types and the primary keys should be the same.")
+	ErrShardConflictRow    = New(codeShardConflictRow, ClassShardConflict, ScopeUpstream, LevelHigh, "shard tables %s merged into %s write different rows with the same primary key %s", "Please check the data of the shard tables, the rows of different shards should not have the same primary key, or use `column-mappings` to distinguish them.")
+
 	// default error.
 	ErrNotSet = New(codeNotSet, ClassNotSet, ScopeNotSet, LevelHigh, "", "")
 )
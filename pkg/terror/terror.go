@@ -52,6 +52,7 @@ const (
 	ClassDMCtl
 	ClassNotSet
 	ClassOpenAPI
+	ClassShardConflict
 )
 
 var errClass2Str = map[ErrClass]string{
@@ -75,6 +76,7 @@ var errClass2Str = map[ErrClass]string{
 	ClassDMCtl:         "dmctl",
 	ClassNotSet:        "not-set",
 	ClassOpenAPI:       "openapi",
+	ClassShardConflict: "shard-conflict",
 }
 
 // String implements fmt.Stringer interface.
@@ -0,0 +1,282 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.etcd.io/etcd/clientv3"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/pkg/etcdutil"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// KeyMigration migrates the etcd keys of an adapter to another adapter, the keys are decoded by the old adapter and
+// encoded by the new adapter, and the values are converted if needed.
+type KeyMigration struct {
+	Description string
+	Old         common.KeyAdapter
+	New         common.KeyAdapter
+	// Convert converts the value when upgrading, nil means the value is kept.
+	Convert func(value string) (string, error)
+	// Revert reverts the converted value when rolling back, it must be set if Convert is set.
+	Revert func(value string) (string, error)
+}
+
+// keySchemaMigrations records the key migrations to upgrade the etcd key schema,
+// keySchemaMigrations[i] upgrades the key schema from version i to version i+1.
+// NOTE: append new migrations when the layout of the metadata in etcd is changed, never modify the released ones.
+var keySchemaMigrations = [][]KeyMigration{
+	// version 1, the keys of the upstream config and the relay stage are encoded in hex since v2.0.2.
+	{
+		{
+			Description: "encode the keys of the upstream config in hex",
+			Old:         common.UpstreamConfigKeyAdapterV1,
+			New:         common.UpstreamConfigKeyAdapter,
+		},
+		{
+			Description: "encode the keys of the relay stage in hex",
+			Old:         common.StageRelayKeyAdapterV1,
+			New:         common.StageRelayKeyAdapter,
+		},
+	},
+}
+
+// LatestKeySchemaVersion returns the latest version of the etcd key schema supported by this DM-master.
+func LatestKeySchemaVersion() uint64 {
+	return uint64(len(keySchemaMigrations))
+}
+
+// KeyChange represents a key changed when migrating the etcd key schema.
+type KeyChange struct {
+	Version     uint64 `json:"version"` // the version of the migration which changes the key
+	Description string `json:"description"`
+	OldKey      string `json:"old-key"`
+	NewKey      string `json:"new-key"`
+}
+
+// KeySchemaPlan represents the changes to migrate the etcd key schema from a version to another version,
+// it's a rollback if `To` is less than `From`.
+type KeySchemaPlan struct {
+	From    uint64      `json:"from"`
+	To      uint64      `json:"to"`
+	Changes []KeyChange `json:"changes"`
+
+	ops []clientv3.Op
+	rev int64 // the mod revision of the version key when planning, used to detect concurrent migrations.
+}
+
+// GetKeySchemaVersion gets the version of the etcd key schema and the mod revision of it,
+// the version is 0 for the clusters created before the key schema is versioned.
+func GetKeySchemaVersion(cli *clientv3.Client) (uint64, int64, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.KeySchemaVersionKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	if resp.Count == 0 {
+		return 0, 0, nil
+	}
+	ver, err := strconv.ParseUint(string(resp.Kvs[0].Value), 10, 64)
+	if err != nil {
+		return 0, 0, terror.ErrUpgradeKeySchemaFail.Delegate(err, "invalid key schema version")
+	}
+	return ver, resp.Kvs[0].ModRevision, nil
+}
+
+// keyOverlay overlays the pending changes on the keys in etcd, so the later migrations see the changes of the
+// earlier migrations in one plan.
+type keyOverlay struct {
+	ctx     context.Context
+	cli     *clientv3.Client
+	changed map[string]*string // nil for the deleted keys
+}
+
+// getPrefix gets the keys and values of the prefix with the pending changes.
+func (o *keyOverlay) getPrefix(prefix string) (map[string]string, error) {
+	resp, err := o.cli.Get(o.ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	kvs := make(map[string]string, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		kvs[string(kv.Key)] = string(kv.Value)
+	}
+	for k, v := range o.changed {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		if v == nil {
+			delete(kvs, k)
+		} else {
+			kvs[k] = *v
+		}
+	}
+	return kvs, nil
+}
+
+// move moves the keys of the prefix of `from` to the keys encoded by `to`, and converts the values by `convert`.
+func (o *keyOverlay) move(from, to common.KeyAdapter, convert func(string) (string, error)) ([][2]string, error) {
+	kvs, err := o.getPrefix(from.Path())
+	if err != nil {
+		return nil, err
+	}
+	oldKeys := make([]string, 0, len(kvs))
+	for k := range kvs {
+		oldKeys = append(oldKeys, k)
+	}
+	sort.Strings(oldKeys)
+
+	moved := make([][2]string, 0, len(oldKeys))
+	for _, oldKey := range oldKeys {
+		keys, err2 := from.Decode(oldKey)
+		if err2 != nil {
+			return nil, err2
+		}
+		newKey := to.Encode(keys...)
+		value := kvs[oldKey]
+		if convert != nil {
+			if value, err2 = convert(value); err2 != nil {
+				return nil, terror.ErrUpgradeKeySchemaFail.Delegate(err2, "fail to convert the value of "+oldKey)
+			}
+		}
+		o.changed[oldKey] = nil
+		o.changed[newKey] = &value
+		moved = append(moved, [2]string{oldKey, newKey})
+	}
+	return moved, nil
+}
+
+// ops returns the etcd operations of the pending changes.
+func (o *keyOverlay) ops() []clientv3.Op {
+	keys := make([]string, 0, len(o.changed))
+	for k := range o.changed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	ops := make([]clientv3.Op, 0, len(keys))
+	for _, k := range keys {
+		if v := o.changed[k]; v == nil {
+			ops = append(ops, clientv3.OpDelete(k))
+		} else {
+			ops = append(ops, clientv3.OpPut(k, *v))
+		}
+	}
+	return ops
+}
+
+// PlanKeySchemaMigration plans the changes to migrate the etcd key schema from the current version to the version `to`,
+// nothing is changed so it can be used as a dry run.
+func PlanKeySchemaMigration(ctx context.Context, cli *clientv3.Client, to uint64) (*KeySchemaPlan, error) {
+	from, rev, err := GetKeySchemaVersion(cli)
+	if err != nil {
+		return nil, err
+	}
+	latest := LatestKeySchemaVersion()
+	if from > latest {
+		return nil, terror.ErrUpgradeKeySchemaFail.Generatef("the key schema version %d is newer than the latest version %d supported by this DM-master", from, latest)
+	}
+	if to > latest {
+		return nil, terror.ErrUpgradeKeySchemaFail.Generatef("the target key schema version %d is newer than the latest version %d", to, latest)
+	}
+
+	plan := &KeySchemaPlan{From: from, To: to, Changes: []KeyChange{}, rev: rev}
+	overlay := &keyOverlay{ctx: ctx, cli: cli, changed: make(map[string]*string)}
+	addChanges := func(ver uint64, m KeyMigration, moved [][2]string) {
+		for _, pair := range moved {
+			plan.Changes = append(plan.Changes, KeyChange{Version: ver, Description: m.Description, OldKey: pair[0], NewKey: pair[1]})
+		}
+	}
+	// upgrade from the older versions one by one.
+	for ver := from; ver < to; ver++ {
+		for _, m := range keySchemaMigrations[ver] {
+			moved, err2 := overlay.move(m.Old, m.New, m.Convert)
+			if err2 != nil {
+				return nil, err2
+			}
+			addChanges(ver+1, m, moved)
+		}
+	}
+	// or roll back from the newer versions in the reversed order.
+	for ver := from; ver > to; ver-- {
+		migrations := keySchemaMigrations[ver-1]
+		for i := len(migrations) - 1; i >= 0; i-- {
+			m := migrations[i]
+			moved, err2 := overlay.move(m.New, m.Old, m.Revert)
+			if err2 != nil {
+				return nil, err2
+			}
+			addChanges(ver, m, moved)
+		}
+	}
+	plan.ops = overlay.ops()
+	return plan, nil
+}
+
+// ApplyKeySchemaPlan applies the changes of the plan and updates the key schema version in one transaction,
+// it fails without any change if the key schema is migrated by others after planning.
+func ApplyKeySchemaPlan(cli *clientv3.Client, plan *KeySchemaPlan) error {
+	// ModRevision is 0 if the version key doesn't exist.
+	cmp := clientv3.Compare(clientv3.ModRevision(common.KeySchemaVersionKey), "=", plan.rev)
+	ops := append(plan.ops, clientv3.OpPut(common.KeySchemaVersionKey, strconv.FormatUint(plan.To, 10)))
+	resp, _, err := etcdutil.DoOpsInOneCmpsTxnWithRetry(cli, []clientv3.Cmp{cmp}, ops, []clientv3.Op{})
+	if err != nil {
+		return terror.ErrUpgradeKeySchemaFail.Delegate(err, "fail to apply the key schema migration")
+	}
+	if !resp.Succeeded {
+		return terror.ErrUpgradeKeySchemaFail.Generate("the key schema is migrated by others concurrently, please retry")
+	}
+	return nil
+}
+
+// MigrateKeySchema migrates the etcd key schema to the version `to`, it's a rollback if `to` is less than the current
+// version. the plan is returned without any change if `dryRun` is true.
+func MigrateKeySchema(ctx context.Context, cli *clientv3.Client, to uint64, dryRun bool) (*KeySchemaPlan, error) {
+	plan, err := PlanKeySchemaMigration(ctx, cli, to)
+	if err != nil || dryRun || plan.From == plan.To {
+		return plan, err
+	}
+	if err = ApplyKeySchemaPlan(cli, plan); err != nil {
+		return nil, err
+	}
+	log.L().Info("migrated the etcd key schema", zap.Uint64("from", plan.From), zap.Uint64("to", plan.To), zap.Int("changed keys", len(plan.Changes)))
+	return plan, nil
+}
+
+// TryMigrateKeySchema tries to upgrade the etcd key schema to the latest version, it's called by the DM-master leader
+// before the scheduler starts. the key schema of a newer version is kept, because it's often an older DM-master
+// becomes the leader during a rolling upgrade.
+func TryMigrateKeySchema(ctx context.Context, cli *clientv3.Client) error {
+	ver, _, err := GetKeySchemaVersion(cli)
+	if err != nil {
+		return err
+	}
+	if latest := LatestKeySchemaVersion(); ver >= latest {
+		if ver > latest {
+			log.L().Warn("the key schema version is newer than the latest version supported, skip migrating",
+				zap.Uint64("version", ver), zap.Uint64("latest version", latest))
+		}
+		return nil
+	}
+	_, err = MigrateKeySchema(ctx, cli, LatestKeySchemaVersion(), false)
+	return err
+}
@@ -0,0 +1,180 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	. "github.com/pingcap/check"
+	"go.etcd.io/etcd/clientv3"
+
+	"github.com/pingcap/dm/dm/common"
+)
+
+func (t *testForEtcd) TestMigrateKeySchema(c *C) {
+	defer clearTestData(c)
+	ctx := context.Background()
+
+	// mock the migrations, v1 encodes the keys in hex, v2 moves the keys and upper-cases the values.
+	oldMigrations := keySchemaMigrations
+	defer func() {
+		keySchemaMigrations = oldMigrations
+	}()
+	var (
+		v0Adapter = common.UpstreamConfigKeyAdapterV1
+		v1Adapter = common.UpstreamConfigKeyAdapter
+		v2Adapter = common.UpstreamDisabledKeyAdapter
+	)
+	defer func() {
+		_, err := etcdTestCli.Delete(ctx, v0Adapter.Path(), clientv3.WithPrefix())
+		c.Assert(err, IsNil)
+		_, err = etcdTestCli.Delete(ctx, v1Adapter.Path(), clientv3.WithPrefix())
+		c.Assert(err, IsNil)
+		_, err = etcdTestCli.Delete(ctx, v2Adapter.Path(), clientv3.WithPrefix())
+		c.Assert(err, IsNil)
+	}()
+	keySchemaMigrations = [][]KeyMigration{
+		{{Description: "v1", Old: v0Adapter, New: v1Adapter}},
+		{{
+			Description: "v2",
+			Old:         v1Adapter,
+			New:         v2Adapter,
+			Convert: func(value string) (string, error) {
+				return strings.ToUpper(value), nil
+			},
+			Revert: func(value string) (string, error) {
+				return strings.ToLower(value), nil
+			},
+		}},
+	}
+	c.Assert(LatestKeySchemaVersion(), Equals, uint64(2))
+
+	_, err := etcdTestCli.Put(ctx, v0Adapter.Encode("mysql-replica-01"), "source1")
+	c.Assert(err, IsNil)
+	_, err = etcdTestCli.Put(ctx, v0Adapter.Encode("mysql-replica-02"), "source2")
+	c.Assert(err, IsNil)
+	getValue := func(key string) (string, bool) {
+		resp, err2 := etcdTestCli.Get(ctx, key)
+		c.Assert(err2, IsNil)
+		if resp.Count == 0 {
+			return "", false
+		}
+		return string(resp.Kvs[0].Value), true
+	}
+
+	// dry run changes nothing.
+	plan, err := MigrateKeySchema(ctx, etcdTestCli, 2, true)
+	c.Assert(err, IsNil)
+	c.Assert(plan.From, Equals, uint64(0))
+	c.Assert(plan.To, Equals, uint64(2))
+	c.Assert(plan.Changes, DeepEquals, []KeyChange{
+		{Version: 1, Description: "v1", OldKey: v0Adapter.Encode("mysql-replica-01"), NewKey: v1Adapter.Encode("mysql-replica-01")},
+		{Version: 1, Description: "v1", OldKey: v0Adapter.Encode("mysql-replica-02"), NewKey: v1Adapter.Encode("mysql-replica-02")},
+		{Version: 2, Description: "v2", OldKey: v1Adapter.Encode("mysql-replica-01"), NewKey: v2Adapter.Encode("mysql-replica-01")},
+		{Version: 2, Description: "v2", OldKey: v1Adapter.Encode("mysql-replica-02"), NewKey: v2Adapter.Encode("mysql-replica-02")},
+	})
+	ver, _, err := GetKeySchemaVersion(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(ver, Equals, uint64(0))
+	value, ok := getValue(v0Adapter.Encode("mysql-replica-01"))
+	c.Assert(ok, IsTrue)
+	c.Assert(value, Equals, "source1")
+
+	// upgrade to the latest version by the leader.
+	c.Assert(TryMigrateKeySchema(ctx, etcdTestCli), IsNil)
+	ver, _, err = GetKeySchemaVersion(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(ver, Equals, uint64(2))
+	_, ok = getValue(v0Adapter.Encode("mysql-replica-01"))
+	c.Assert(ok, IsFalse)
+	_, ok = getValue(v1Adapter.Encode("mysql-replica-01"))
+	c.Assert(ok, IsFalse)
+	value, ok = getValue(v2Adapter.Encode("mysql-replica-02"))
+	c.Assert(ok, IsTrue)
+	c.Assert(value, Equals, "SOURCE2")
+
+	// nothing to do for the latest version.
+	plan, err = MigrateKeySchema(ctx, etcdTestCli, 2, false)
+	c.Assert(err, IsNil)
+	c.Assert(plan.Changes, HasLen, 0)
+	c.Assert(TryMigrateKeySchema(ctx, etcdTestCli), IsNil)
+
+	// roll back to version 1.
+	plan, err = MigrateKeySchema(ctx, etcdTestCli, 1, false)
+	c.Assert(err, IsNil)
+	c.Assert(plan.From, Equals, uint64(2))
+	c.Assert(plan.To, Equals, uint64(1))
+	c.Assert(plan.Changes, HasLen, 2)
+	c.Assert(plan.Changes[0].OldKey, Equals, v2Adapter.Encode("mysql-replica-01"))
+	c.Assert(plan.Changes[0].NewKey, Equals, v1Adapter.Encode("mysql-replica-01"))
+	value, ok = getValue(v1Adapter.Encode("mysql-replica-01"))
+	c.Assert(ok, IsTrue)
+	c.Assert(value, Equals, "source1")
+	_, ok = getValue(v2Adapter.Encode("mysql-replica-01"))
+	c.Assert(ok, IsFalse)
+
+	// the versions not supported.
+	_, err = MigrateKeySchema(ctx, etcdTestCli, 3, true)
+	c.Assert(err, ErrorMatches, ".*target key schema version 3 is newer than the latest version 2.*")
+	keySchemaMigrations = keySchemaMigrations[:0]
+	_, err = MigrateKeySchema(ctx, etcdTestCli, 0, true)
+	c.Assert(err, ErrorMatches, ".*key schema version 1 is newer than the latest version 0 supported.*")
+	// an older DM-master keeps the newer key schema.
+	c.Assert(TryMigrateKeySchema(ctx, etcdTestCli), IsNil)
+	ver, _, err = GetKeySchemaVersion(etcdTestCli)
+	c.Assert(err, IsNil)
+	c.Assert(ver, Equals, uint64(1))
+}
+
+func (t *testForEtcd) TestApplyKeySchemaPlanFail(c *C) {
+	defer clearTestData(c)
+	ctx := context.Background()
+
+	oldMigrations := keySchemaMigrations
+	defer func() {
+		keySchemaMigrations = oldMigrations
+	}()
+	adapter := common.UpstreamConfigKeyAdapterV1
+	defer func() {
+		_, err := etcdTestCli.Delete(ctx, adapter.Path(), clientv3.WithPrefix())
+		c.Assert(err, IsNil)
+	}()
+	_, err := etcdTestCli.Put(ctx, adapter.Encode("mysql-replica-01"), "source1")
+	c.Assert(err, IsNil)
+
+	// fail to convert the value.
+	keySchemaMigrations = [][]KeyMigration{{{
+		Old: adapter,
+		New: common.UpstreamConfigKeyAdapter,
+		Convert: func(string) (string, error) {
+			return "", errors.New("invalid value")
+		},
+	}}}
+	_, err = MigrateKeySchema(ctx, etcdTestCli, 1, false)
+	c.Assert(err, ErrorMatches, ".*fail to convert the value of .*invalid value.*")
+
+	// the key schema is migrated concurrently after planning.
+	keySchemaMigrations[0][0].Convert = nil
+	plan, err := PlanKeySchemaMigration(ctx, etcdTestCli, 1)
+	c.Assert(err, IsNil)
+	c.Assert(plan.Changes, HasLen, 1)
+	_, err = etcdTestCli.Put(ctx, common.KeySchemaVersionKey, "1")
+	c.Assert(err, IsNil)
+	c.Assert(ApplyKeySchemaPlan(etcdTestCli, plan), ErrorMatches, ".*migrated by others concurrently.*")
+	value, err := etcdTestCli.Get(ctx, adapter.Encode("mysql-replica-01"))
+	c.Assert(err, IsNil)
+	c.Assert(value.Kvs, HasLen, 1)
+}
@@ -16,6 +16,8 @@ package upgrade
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pingcap/tidb-tools/pkg/dbutil"
@@ -226,6 +228,70 @@ func upgradeToVer2(cli *clientv3.Client, uctx Context) error {
 	return nil
 }
 
+// checkpointColumnsByUpgrade records the columns added to the syncer checkpoint table by the upgrades.
+var checkpointColumnsByUpgrade = []string{"exit_safe_binlog_name", "exit_safe_binlog_pos", "exit_safe_binlog_gtid"}
+
+// CheckCheckpointSchema checks whether the syncer checkpoint tables of the subtasks have the columns added by the
+// upgrades, and returns the tables missing any column. the tables not created yet are skipped.
+func CheckCheckpointSchema(ctx context.Context, subTaskCfgs map[string]map[string]config.SubTaskConfig) ([]string, error) {
+	var missing []string
+	checked := make(map[string]struct{})
+	for _, m := range subTaskCfgs {
+		for _, subCfg := range m {
+			schema, table := subCfg.MetaSchema, cputil.SyncerCheckpoint(subCfg.Name)
+			tableName := dbutil.TableName(schema, table)
+			if _, ok := checked[tableName]; ok {
+				continue
+			}
+			checked[tableName] = struct{}{}
+
+			subCfg2, err := subCfg.DecryptPassword()
+			if err != nil {
+				return nil, err
+			}
+			columns, err := getTableColumns(ctx, subCfg2.To, schema, table)
+			if err != nil {
+				return nil, err
+			}
+			if len(columns) == 0 {
+				continue
+			}
+			for _, col := range checkpointColumnsByUpgrade {
+				if _, ok := columns[col]; !ok {
+					missing = append(missing, tableName)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// getTableColumns returns the lower case column names of the table, empty if the table doesn't exist.
+func getTableColumns(ctx context.Context, cfg config.DBConfig, schema, table string) (map[string]struct{}, error) {
+	db, err := conn.DefaultDBProvider.Apply(cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.DB.QueryContext(ctx, "SELECT COLUMN_NAME FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?", schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns := make(map[string]struct{})
+	for rows.Next() {
+		var col string
+		if err = rows.Scan(&col); err != nil {
+			return nil, err
+		}
+		columns[strings.ToLower(col)] = struct{}{}
+	}
+	return columns, rows.Err()
+}
+
 // upgradeToVer3 does upgrade operations from Ver2 (v2.0.0-GA) to Ver3 (v2.0.2) to upgrade etcd key encodings.
 // This func should be called before scheduler start.
 func upgradeToVer3(ctx context.Context, cli *clientv3.Client) error {
@@ -16,13 +16,18 @@ package upgrade
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	. "github.com/pingcap/check"
 	"go.etcd.io/etcd/clientv3"
 	"go.etcd.io/etcd/integration"
 
 	"github.com/pingcap/dm/dm/common"
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
 )
 
 var (
@@ -46,7 +51,8 @@ func TestUpgrade(t *testing.T) {
 
 func clearTestData(c *C) {
 	clearVersion := clientv3.OpDelete(common.ClusterVersionKey)
-	_, err := etcdTestCli.Txn(context.Background()).Then(clearVersion).Commit()
+	clearKeySchemaVersion := clientv3.OpDelete(common.KeySchemaVersionKey)
+	_, err := etcdTestCli.Txn(context.Background()).Then(clearVersion, clearKeySchemaVersion).Commit()
 	c.Assert(err, IsNil)
 }
 
@@ -162,3 +168,52 @@ func (t *testForEtcd) TestUpgradeToVer3(c *C) {
 	}
 	c.Assert(upgradeToVer3(ctx, bigTxnTestCli), IsNil)
 }
+
+func (t *testForEtcd) TestCheckCheckpointSchema(c *C) {
+	oldProvider := conn.DefaultDBProvider
+	defer func() {
+		conn.DefaultDBProvider = oldProvider
+	}()
+
+	ctx := context.Background()
+	query := regexp.QuoteMeta("SELECT COLUMN_NAME FROM information_schema.COLUMNS")
+	cfgs := map[string]map[string]config.SubTaskConfig{
+		"task1": {
+			"source1": {Name: "task1", SourceID: "source1", MetaSchema: "dm_meta"},
+		},
+	}
+
+	// no subtask.
+	missing, err := CheckCheckpointSchema(ctx, nil)
+	c.Assert(err, IsNil)
+	c.Assert(missing, HasLen, 0)
+
+	// the checkpoint table is upgraded.
+	mock := conn.InitMockDB(c)
+	rows := sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id").AddRow("cp_schema").AddRow("binlog_gtid")
+	for _, col := range checkpointColumnsByUpgrade {
+		rows.AddRow(strings.ToUpper(col))
+	}
+	mock.ExpectQuery(query).WithArgs("dm_meta", "task1_syncer_checkpoint").WillReturnRows(rows)
+	missing, err = CheckCheckpointSchema(ctx, cfgs)
+	c.Assert(err, IsNil)
+	c.Assert(missing, HasLen, 0)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the checkpoint table is not upgraded.
+	mock = conn.InitMockDB(c)
+	mock.ExpectQuery(query).WithArgs("dm_meta", "task1_syncer_checkpoint").WillReturnRows(
+		sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id").AddRow("binlog_gtid"))
+	missing, err = CheckCheckpointSchema(ctx, cfgs)
+	c.Assert(err, IsNil)
+	c.Assert(missing, DeepEquals, []string{"`dm_meta`.`task1_syncer_checkpoint`"})
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the checkpoint table is not created yet.
+	mock = conn.InitMockDB(c)
+	mock.ExpectQuery(query).WithArgs("dm_meta", "task1_syncer_checkpoint").WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}))
+	missing, err = CheckCheckpointSchema(ctx, cfgs)
+	c.Assert(err, IsNil)
+	c.Assert(missing, HasLen, 0)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
@@ -415,6 +415,20 @@ func GetParser(ctx context.Context, db *sql.DB) (*parser.Parser, error) {
 	return GetParserForConn(ctx, c)
 }
 
+// GetSQLMode gets the session variable sql_mode of sql.DB.
+func GetSQLMode(ctx context.Context, db *sql.DB) (tmysql.SQLMode, error) {
+	c, err := db.Conn(ctx)
+	if err != nil {
+		return tmysql.ModeNone, err
+	}
+	defer c.Close()
+	sqlMode, err := GetSessionVariable(ctx, c, "sql_mode")
+	if err != nil {
+		return tmysql.ModeNone, err
+	}
+	return tmysql.GetSQLMode(sqlMode)
+}
+
 // GetParserForConn gets a parser for sql.Conn which is suitable for session variable sql_mode.
 func GetParserForConn(ctx context.Context, conn *sql.Conn) (*parser.Parser, error) {
 	sqlMode, err := GetSessionVariable(ctx, conn, "sql_mode")
@@ -18,4 +18,7 @@ package utils
 type StorageSize struct {
 	Capacity  uint64
 	Available uint64
+	// the total and free inodes, 0 if not supported
+	Inodes     uint64
+	FreeInodes uint64
 }
@@ -50,6 +50,9 @@ func GetStorageSize(dir string) (size StorageSize, err error) {
 	// Available blocks * size per block = available space in bytes
 	size.Available = stat.Bavail * bSize
 	size.Capacity = stat.Blocks * bSize
+	// the type of `ffree` is int64 on some platforms.
+	size.Inodes = uint64(stat.Files)     // nolint:unconvert
+	size.FreeInodes = uint64(stat.Ffree) // nolint:unconvert
 
 	return
 }
@@ -263,3 +263,33 @@ func proxyFields() []zap.Field {
 	}
 	return fields
 }
+
+// ParseTimeZone parses the value of MySQL session variable `time_zone`, which is an offset like "+08:00" or a named
+// time zone like "Asia/Shanghai". "SYSTEM" is not supported because the time zone of the database server is unknown.
+func ParseTimeZone(tz string) (*time.Location, error) {
+	if len(tz) == 6 && (tz[0] == '+' || tz[0] == '-') && tz[3] == ':' {
+		hour, err1 := strconv.Atoi(tz[1:3])
+		minute, err2 := strconv.Atoi(tz[4:6])
+		// MySQL supports offsets in [-13:59, +14:00].
+		if err1 != nil || err2 != nil || minute >= 60 || hour*60+minute > 14*60 {
+			return nil, terror.ErrConfigInvalidTimezone.Generate(tz)
+		}
+		offset := (hour*60 + minute) * 60
+		if offset == 0 {
+			return time.UTC, nil
+		}
+		if tz[0] == '-' {
+			offset = -offset
+		}
+		return time.FixedZone(tz, offset), nil
+	}
+	switch strings.ToLower(tz) {
+	case "", "system", "local":
+		return nil, terror.ErrConfigInvalidTimezone.Generate(tz)
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, terror.ErrConfigInvalidTimezone.Delegate(err, tz)
+	}
+	return loc, nil
+}
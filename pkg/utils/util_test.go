@@ -22,6 +22,8 @@ import (
 	"github.com/go-mysql-org/go-mysql/mysql"
 	. "github.com/pingcap/check"
 	"github.com/pingcap/tidb/errno"
+
+	"github.com/pingcap/dm/pkg/terror"
 )
 
 func (t *testUtilsSuite) TestDecodeBinlogPosition(c *C) {
@@ -320,3 +322,30 @@ func (t *testUtilsSuite) TestProxyFields(c *C) {
 		}
 	}
 }
+
+func (t *testUtilsSuite) TestParseTimeZone(c *C) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		tz     string
+		offset int
+	}{
+		{"+00:00", 0},
+		{"-00:00", 0},
+		{"+08:00", 8 * 3600},
+		{"-05:30", -(5*3600 + 30*60)},
+		{"+14:00", 14 * 3600},
+		{"UTC", 0},
+		{"Asia/Shanghai", 8 * 3600},
+	}
+	for _, cs := range cases {
+		loc, err := ParseTimeZone(cs.tz)
+		c.Assert(err, IsNil, Commentf("%s", cs.tz))
+		_, offset := now.In(loc).Zone()
+		c.Assert(offset, Equals, cs.offset, Commentf("%s", cs.tz))
+	}
+
+	for _, tz := range []string{"", "SYSTEM", "system", "Local", "+14:01", "+08:60", "+8:00", "08:00", "Unknown/Zone"} {
+		_, err := ParseTimeZone(tz)
+		c.Assert(terror.ErrConfigInvalidTimezone.Equal(err), IsTrue, Commentf("%s", tz))
+	}
+}
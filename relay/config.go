@@ -23,6 +23,7 @@ import (
 
 // Config is the configuration for Relay.
 type Config struct {
+	SourceID    string          `toml:"source-id" json:"source-id"`
 	EnableGTID  bool            `toml:"enable-gtid" json:"enable-gtid"`
 	AutoFixGTID bool            `toml:"auto-fix-gtid" json:"auto-fix-gtid"`
 	RelayDir    string          `toml:"relay-dir" json:"relay-dir"`
@@ -39,6 +40,24 @@ type Config struct {
 
 	// for binlog reader retry
 	ReaderRetry retry.ReaderRetryConfig `toml:"reader-retry" json:"reader-retry"`
+
+	// for archiving relay log events
+	Archive config.RelayArchiveConfig `toml:"relay-archive" json:"relay-archive"`
+
+	// for rotating relay log files locally
+	File config.RelayFileConfig `toml:"relay-file" json:"relay-file"`
+
+	// the max bandwidth (MB/s) of reading binlog from the upstream, 0 means no limit
+	NetRateLimit int64 `toml:"net-rate-limit" json:"net-rate-limit"`
+
+	// for estimating the time until the disk is full under the purge policy
+	Purge config.PurgeConfig `toml:"purge" json:"purge"`
+
+	// the pluggable transformers run on the relay log events in order
+	Transformers []config.RelayTransformerConfig `toml:"relay-transformers" json:"relay-transformers"`
+
+	// for checking the health of the disk of relay dir
+	DiskCheck config.RelayDiskCheckConfig `toml:"relay-disk-check" json:"relay-disk-check"`
 }
 
 func (c *Config) String() string {
@@ -53,6 +72,7 @@ func (c *Config) String() string {
 func FromSourceCfg(sourceCfg *config.SourceConfig) *Config {
 	clone := sourceCfg.DecryptPassword()
 	cfg := &Config{
+		SourceID:    clone.SourceID,
 		EnableGTID:  clone.EnableGTID,
 		AutoFixGTID: clone.AutoFixGTID,
 		Flavor:      clone.Flavor,
@@ -70,6 +90,13 @@ func FromSourceCfg(sourceCfg *config.SourceConfig) *Config {
 			BackoffJitter:   clone.Checker.BackoffJitter,
 			BackoffFactor:   clone.Checker.BackoffFactor,
 		},
+		Archive: clone.RelayArchive,
+		File:    clone.RelayFile,
+		Purge:   clone.Purge,
+
+		NetRateLimit: clone.NetRateLimit,
+		Transformers: clone.RelayTransformers,
+		DiskCheck:    clone.RelayDiskCheck,
 	}
 	return cfg
 }
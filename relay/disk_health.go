@@ -0,0 +1,222 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// diskProbeFile is written and synced into relay dir to check the write latency and whether the disk is read-only.
+const diskProbeFile = ".dm-disk-probe"
+
+// diskHealthChecker checks the health of the disk of relay dir in the background,
+// the relay process is stopped with ErrRelayDiskUnhealthy when the disk becomes unhealthy.
+type diskHealthChecker struct {
+	dir      string
+	sourceID string
+	cfg      config.RelayDiskCheckConfig
+	logger   log.Logger
+
+	mu     sync.RWMutex
+	health *pb.RelayDiskHealth // nil before the first check
+	err    error               // not nil if the disk is unhealthy
+	// closed when the disk becomes unhealthy, and recreated when it recovers.
+	unhealthy chan struct{}
+
+	// replaced in tests.
+	getStorageSize func(dir string) (utils.StorageSize, error)
+	probe          func(dir string) (time.Duration, error)
+}
+
+// newDiskHealthChecker returns nil if the check is disabled.
+func newDiskHealthChecker(cfg *Config, logger log.Logger) *diskHealthChecker {
+	if cfg.DiskCheck.Interval.Duration <= 0 {
+		return nil
+	}
+	return &diskHealthChecker{
+		dir:            cfg.RelayDir,
+		sourceID:       cfg.SourceID,
+		cfg:            cfg.DiskCheck,
+		logger:         logger,
+		unhealthy:      make(chan struct{}),
+		getStorageSize: utils.GetStorageSize,
+		probe:          probeDiskWrite,
+	}
+}
+
+// run checks the disk every `interval` until ctx is done.
+func (c *diskHealthChecker) run(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.Interval.Duration)
+	defer ticker.Stop()
+	for {
+		c.check(time.Now())
+		select {
+		case <-ctx.Done():
+			relayDiskHealthyGauge.DeleteAllAboutLabels(prometheus.Labels{"source": c.sourceID})
+			relayDiskUsageGauge.DeleteAllAboutLabels(prometheus.Labels{"source": c.sourceID})
+			relayDiskWriteLatencyGauge.DeleteAllAboutLabels(prometheus.Labels{"source": c.sourceID})
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check checks the disk once, the check is skipped if relay dir is not created yet.
+func (c *diskHealthChecker) check(now time.Time) {
+	if _, err := os.Stat(c.dir); os.IsNotExist(err) {
+		return
+	}
+
+	health := &pb.RelayDiskHealth{
+		Healthy:           true,
+		LastCheckTime:     now.Format(time.RFC3339),
+		InodeUsagePercent: -1,
+	}
+	size, err := c.getStorageSize(c.dir)
+	if err != nil {
+		health.Healthy = false
+		health.Reason = fmt.Sprintf("fail to get the storage size: %v", err)
+	} else {
+		health.UsagePercent = usagePercent(size.Capacity, size.Available)
+		if size.Inodes > 0 {
+			health.InodeUsagePercent = usagePercent(size.Inodes, size.FreeInodes)
+		}
+		switch {
+		case c.cfg.MaxUsage > 0 && health.UsagePercent >= c.cfg.MaxUsage:
+			health.Healthy = false
+			health.Reason = fmt.Sprintf("space usage %d%% reaches %d%%", health.UsagePercent, c.cfg.MaxUsage)
+		case c.cfg.MaxInodeUsage > 0 && health.InodeUsagePercent >= c.cfg.MaxInodeUsage:
+			health.Healthy = false
+			health.Reason = fmt.Sprintf("inode usage %d%% reaches %d%%", health.InodeUsagePercent, c.cfg.MaxInodeUsage)
+		}
+	}
+
+	latency, err := c.probe(c.dir)
+	health.WriteLatencyMs = latency.Milliseconds()
+	switch {
+	case err != nil:
+		health.ReadOnly = errors.Is(err, syscall.EROFS)
+		health.Healthy = false
+		health.Reason = fmt.Sprintf("fail to write the probe file: %v", err)
+	case c.cfg.MaxWriteLatency.Duration > 0 && latency >= c.cfg.MaxWriteLatency.Duration:
+		if health.Healthy {
+			health.Healthy = false
+			health.Reason = fmt.Sprintf("write latency %s reaches %s", latency, c.cfg.MaxWriteLatency.Duration)
+		}
+	}
+
+	c.setHealth(health)
+}
+
+func (c *diskHealthChecker) setHealth(health *pb.RelayDiskHealth) {
+	healthyValue := 0.0
+	if health.Healthy {
+		healthyValue = 1
+	}
+	relayDiskHealthyGauge.WithLabelValues(c.sourceID).Set(healthyValue)
+	relayDiskUsageGauge.WithLabelValues(c.sourceID, "space").Set(float64(health.UsagePercent))
+	if health.InodeUsagePercent >= 0 {
+		relayDiskUsageGauge.WithLabelValues(c.sourceID, "inode").Set(float64(health.InodeUsagePercent))
+	}
+	relayDiskWriteLatencyGauge.WithLabelValues(c.sourceID).Set(float64(health.WriteLatencyMs) / 1000)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	wasHealthy := c.err == nil
+	c.health = health
+	switch {
+	case wasHealthy && !health.Healthy:
+		c.err = terror.ErrRelayDiskUnhealthy.Generate(c.dir, health.Reason)
+		close(c.unhealthy)
+		c.logger.Error("the disk of relay dir becomes unhealthy", zap.String("relay dir", c.dir), zap.String("reason", health.Reason))
+	case !wasHealthy && health.Healthy:
+		c.err = nil
+		c.unhealthy = make(chan struct{})
+		c.logger.Info("the disk of relay dir recovers", zap.String("relay dir", c.dir))
+	case !health.Healthy:
+		// update the reason.
+		c.err = terror.ErrRelayDiskUnhealthy.Generate(c.dir, health.Reason)
+	}
+}
+
+// Err returns ErrRelayDiskUnhealthy if the disk is unhealthy.
+func (c *diskHealthChecker) Err() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.err
+}
+
+// Unhealthy returns a channel which is closed when the disk becomes unhealthy.
+func (c *diskHealthChecker) Unhealthy() <-chan struct{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.unhealthy
+}
+
+// Status returns the result of the last check, nil if not checked yet.
+func (c *diskHealthChecker) Status() *pb.RelayDiskHealth {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.health == nil {
+		return nil
+	}
+	clone := *c.health
+	return &clone
+}
+
+// usagePercent returns the used percent of total.
+func usagePercent(total, free uint64) int64 {
+	if total == 0 || free > total {
+		return 0
+	}
+	return int64((total - free) * 100 / total)
+}
+
+// probeDiskWrite writes and syncs a probe file into the dir, and returns the time it takes.
+func probeDiskWrite(dir string) (time.Duration, error) {
+	path := filepath.Join(dir, diskProbeFile)
+	begin := time.Now()
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return time.Since(begin), err
+	}
+	_, err = f.Write([]byte(begin.Format(time.RFC3339Nano)))
+	if err == nil {
+		err = f.Sync()
+	}
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	latency := time.Since(begin)
+	if err != nil {
+		return latency, err
+	}
+	return latency, os.Remove(path)
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+var _ = Suite(&testDiskHealthSuite{})
+
+type testDiskHealthSuite struct{}
+
+func (t *testDiskHealthSuite) TestDiskHealthChecker(c *C) {
+	cfg := &Config{SourceID: "source-1", RelayDir: filepath.Join(c.MkDir(), "relay")}
+	c.Assert(newDiskHealthChecker(cfg, log.L()), IsNil)
+
+	cfg.DiskCheck = config.RelayDiskCheckConfig{
+		Interval:        config.Duration{Duration: time.Second},
+		MaxUsage:        90,
+		MaxInodeUsage:   90,
+		MaxWriteLatency: config.Duration{Duration: time.Second},
+	}
+	checker := newDiskHealthChecker(cfg, log.L())
+	c.Assert(checker, NotNil)
+	size := utils.StorageSize{Capacity: 100, Available: 50, Inodes: 100, FreeInodes: 50}
+	checker.getStorageSize = func(string) (utils.StorageSize, error) { return size, nil }
+	var (
+		latency  time.Duration
+		probeErr error
+	)
+	checker.probe = func(string) (time.Duration, error) { return latency, probeErr }
+
+	// skipped if relay dir is not created yet.
+	checker.check(time.Now())
+	c.Assert(checker.Status(), IsNil)
+	c.Assert(os.MkdirAll(cfg.RelayDir, 0o755), IsNil)
+
+	checker.check(time.Now())
+	health := checker.Status()
+	c.Assert(health.Healthy, IsTrue)
+	c.Assert(health.UsagePercent, Equals, int64(50))
+	c.Assert(health.InodeUsagePercent, Equals, int64(50))
+	c.Assert(checker.Err(), IsNil)
+	unhealthy := checker.Unhealthy()
+
+	cases := []struct {
+		size     utils.StorageSize
+		latency  time.Duration
+		probeErr error
+		reason   string
+		readOnly bool
+	}{
+		{size: utils.StorageSize{Capacity: 100, Available: 10}, reason: "space usage 90% reaches 90%"},
+		{size: utils.StorageSize{Capacity: 100, Available: 50, Inodes: 100, FreeInodes: 5}, reason: "inode usage 95% reaches 90%"},
+		{size: size, latency: 2 * time.Second, reason: "write latency 2s reaches 1s"},
+		{size: size, probeErr: &os.PathError{Op: "open", Path: cfg.RelayDir, Err: syscall.EROFS}, reason: "fail to write the probe file", readOnly: true},
+	}
+	for _, cs := range cases {
+		size, latency, probeErr = cs.size, cs.latency, cs.probeErr
+		checker.check(time.Now())
+		health = checker.Status()
+		c.Assert(health.Healthy, IsFalse)
+		c.Assert(health.Reason, Matches, cs.reason+".*")
+		c.Assert(health.ReadOnly, Equals, cs.readOnly)
+		c.Assert(terror.ErrRelayDiskUnhealthy.Equal(checker.Err()), IsTrue)
+		select {
+		case <-unhealthy:
+		default:
+			c.Fatal("should notify that the disk becomes unhealthy")
+		}
+	}
+
+	// recovers.
+	size, latency, probeErr = utils.StorageSize{Capacity: 100, Available: 50}, 0, nil
+	checker.check(time.Now())
+	c.Assert(checker.Status().Healthy, IsTrue)
+	c.Assert(checker.Status().InodeUsagePercent, Equals, int64(-1))
+	c.Assert(checker.Err(), IsNil)
+	select {
+	case <-checker.Unhealthy():
+		c.Fatal("should not notify when the disk is healthy")
+	default:
+	}
+}
+
+func (t *testDiskHealthSuite) TestProcessUntilDiskUnhealthy(c *C) {
+	cfg := &Config{
+		SourceID:  "source-1",
+		RelayDir:  c.MkDir(),
+		DiskCheck: config.RelayDiskCheckConfig{Interval: config.Duration{Duration: time.Second}, MaxUsage: 90},
+	}
+	r := NewRealRelay(cfg).(*Relay)
+	size := utils.StorageSize{Capacity: 100, Available: 95}
+	r.diskChecker.getStorageSize = func(string) (utils.StorageSize, error) { return size, nil }
+	r.diskChecker.check(time.Now())
+	c.Assert(r.diskChecker.Err(), IsNil)
+
+	// the relay fails immediately if the disk is unhealthy.
+	size.Available = 5
+	r.diskChecker.check(time.Now())
+	result := r.Process(context.Background())
+	c.Assert(result.Errors, HasLen, 1)
+	c.Assert(result.Errors[0].ErrCode, Equals, int32(terror.ErrRelayDiskUnhealthy.Code()))
+	c.Assert(r.Status(nil).(*pb.RelayStatus).DiskHealth.Healthy, IsFalse)
+
+	// the probe file is removed.
+	size.Available = 95
+	r.diskChecker.check(time.Now())
+	c.Assert(r.diskChecker.Err(), IsNil)
+	_, err := os.Stat(filepath.Join(cfg.RelayDir, diskProbeFile))
+	c.Assert(os.IsNotExist(err), IsTrue)
+}
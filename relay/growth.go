@@ -0,0 +1,163 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+const (
+	// growthShortWindow and growthLongWindow are the sliding windows to estimate the write rate of relay log.
+	growthShortWindow = 5 * time.Minute
+	growthLongWindow  = time.Hour
+
+	// unknownHours means the estimated hours can't be calculated, or the disk won't be full.
+	unknownHours = -1
+)
+
+type growthSample struct {
+	t       time.Time
+	written uint64 // cumulative bytes written when sampled
+}
+
+// growthEstimator estimates the growth rate of relay log files on disk and the time until the disk is full.
+type growthEstimator struct {
+	mu      sync.Mutex
+	written uint64
+	// samples are ordered by time, the ones older than the long window are dropped except the latest of them.
+	samples []growthSample
+	// the last storage size of the relay directory.
+	size      utils.StorageSize
+	sizeValid bool
+}
+
+func newGrowthEstimator() *growthEstimator {
+	return &growthEstimator{}
+}
+
+// Add records that n bytes are written into relay log files.
+func (g *growthEstimator) Add(n uint64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.written += n
+}
+
+// Sample records the cumulative written bytes and the storage size of the relay directory at `now`.
+func (g *growthEstimator) Sample(now time.Time, size utils.StorageSize) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.size = size
+	g.sizeValid = true
+	g.samples = append(g.samples, growthSample{t: now, written: g.written})
+
+	// keep one sample no later than the beginning of the long window.
+	i := 0
+	for i+1 < len(g.samples) && !g.samples[i+1].t.After(now.Add(-growthLongWindow)) {
+		i++
+	}
+	g.samples = g.samples[i:]
+}
+
+// Rate returns the write rate (bytes per second) in the window before the latest sample, 0 if not enough samples.
+func (g *growthEstimator) Rate(window time.Duration) float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.rate(window)
+}
+
+func (g *growthEstimator) rate(window time.Duration) float64 {
+	if len(g.samples) < 2 {
+		return 0
+	}
+	last := g.samples[len(g.samples)-1]
+	begin := last.t.Add(-window)
+	// use the earliest sample in the window, or the latest one before the window if no other sample in it.
+	i := 0
+	for i < len(g.samples)-1 && g.samples[i].t.Before(begin) {
+		i++
+	}
+	if i == len(g.samples)-1 {
+		i--
+	}
+	base := g.samples[i]
+	elapsed := last.t.Sub(base.t).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(last.written-base.written) / elapsed
+}
+
+// Status returns the estimated growth of relay log under the purge policy.
+func (g *growthEstimator) Status(purge config.PurgeConfig) *pb.RelayGrowth {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	shortRate, longRate := g.rate(growthShortWindow), g.rate(growthLongWindow)
+	status := &pb.RelayGrowth{
+		WriteRateShort:       shortRate,
+		WriteRateLong:        longRate,
+		HoursUntilFull:       unknownHours,
+		HoursUntilSpacePurge: unknownHours,
+	}
+	if !g.sizeValid {
+		return status
+	}
+	// prefer the faster rate to warn operators earlier.
+	rate := shortRate
+	if longRate > rate {
+		rate = longRate
+	}
+	status.HoursUntilFull, status.HoursUntilSpacePurge = estimateHours(rate, g.size, purge)
+	return status
+}
+
+// estimateHours estimates the hours until the disk is full and until the purge by space is triggered,
+// with `rate` bytes per second written into relay log files. unknownHours is returned if the relay log
+// doesn't grow, the corresponding purge is not enabled, or the purge by time can keep up with the growth.
+func estimateHours(rate float64, size utils.StorageSize, purge config.PurgeConfig) (untilFull, untilSpacePurge float64) {
+	untilFull, untilSpacePurge = unknownHours, unknownHours
+	if rate <= 0 {
+		return
+	}
+	// relay log files older than `expires` are purged, so at most `expires` hours of relay log are kept.
+	if purge.Expires > 0 && rate*float64(purge.Expires)*3600 < float64(size.Available) {
+		return
+	}
+	untilFull = float64(size.Available) / rate / 3600
+
+	// the purge by space is triggered when the available space drops to the larger reserve.
+	var reserve float64
+	enabled := false
+	if purge.RemainSpace > 0 {
+		reserve = float64(purge.RemainSpace) * 1024 * 1024 * 1024
+		enabled = true
+	}
+	if purge.HighWatermark > 0 {
+		if r := float64(size.Capacity) * float64(100-purge.HighWatermark) / 100; r > reserve {
+			reserve = r
+		}
+		enabled = true
+	}
+	if enabled {
+		untilSpacePurge = 0
+		if available := float64(size.Available); available > reserve {
+			untilSpacePurge = (available - reserve) / rate / 3600
+		}
+	}
+	return
+}
@@ -0,0 +1,100 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+var _ = Suite(&testGrowthSuite{})
+
+type testGrowthSuite struct{}
+
+func (t *testGrowthSuite) TestGrowthEstimator(c *C) {
+	var (
+		gb   uint64 = 1024 * 1024 * 1024
+		size        = utils.StorageSize{Capacity: 100 * gb, Available: 36 * gb}
+		now         = time.Now()
+		g           = newGrowthEstimator()
+	)
+
+	// no sample yet.
+	c.Assert(g.Rate(growthShortWindow), Equals, 0.0)
+	status := g.Status(config.PurgeConfig{})
+	c.Assert(status.HoursUntilFull, Equals, float64(unknownHours))
+	c.Assert(status.HoursUntilSpacePurge, Equals, float64(unknownHours))
+
+	// write 1 MB/s in the first hour, then 10 MB/s in the last 5 minutes.
+	for i := 0; i <= 60; i++ {
+		g.Add(60 * 1024 * 1024)
+		g.Sample(now.Add(time.Duration(i)*time.Minute), size)
+	}
+	now = now.Add(time.Hour)
+	for i := 1; i <= 5; i++ {
+		g.Add(600 * 1024 * 1024)
+		g.Sample(now.Add(time.Duration(i)*time.Minute), size)
+	}
+	// samples older than the long window are dropped.
+	c.Assert(g.samples[0].t.After(now.Add(5*time.Minute-growthLongWindow)), IsFalse)
+	c.Assert(g.samples[1].t.After(now.Add(5*time.Minute-growthLongWindow)), IsTrue)
+	c.Assert(g.Rate(growthShortWindow), Equals, 10.0*1024*1024)
+	longRate := g.Rate(growthLongWindow)
+	c.Assert(longRate > 1.0*1024*1024 && longRate < 10.0*1024*1024, IsTrue)
+
+	// 36 GB available at 10 MB/s is about 1 hour.
+	status = g.Status(config.PurgeConfig{})
+	c.Assert(status.WriteRateShort, Equals, 10.0*1024*1024)
+	c.Assert(status.HoursUntilFull, Equals, 36.0*1024/10/3600)
+	c.Assert(status.HoursUntilSpacePurge, Equals, float64(unknownHours))
+}
+
+func (t *testGrowthSuite) TestEstimateHours(c *C) {
+	var (
+		gb   uint64 = 1024 * 1024 * 1024
+		size        = utils.StorageSize{Capacity: 100 * gb, Available: 36 * gb}
+		rate        = float64(gb) / 3600 // 1 GB per hour
+	)
+
+	untilFull, untilSpacePurge := estimateHours(0, size, config.PurgeConfig{})
+	c.Assert(untilFull, Equals, float64(unknownHours))
+	c.Assert(untilSpacePurge, Equals, float64(unknownHours))
+
+	untilFull, untilSpacePurge = estimateHours(rate, size, config.PurgeConfig{})
+	c.Assert(untilFull, Equals, 36.0)
+	c.Assert(untilSpacePurge, Equals, float64(unknownHours))
+
+	// the purge by time keeps up with the growth.
+	untilFull, untilSpacePurge = estimateHours(rate, size, config.PurgeConfig{Expires: 24, RemainSpace: 15})
+	c.Assert(untilFull, Equals, float64(unknownHours))
+	c.Assert(untilSpacePurge, Equals, float64(unknownHours))
+
+	// the purge by time can't keep up with the growth.
+	untilFull, untilSpacePurge = estimateHours(rate, size, config.PurgeConfig{Expires: 48, RemainSpace: 15})
+	c.Assert(untilFull, Equals, 36.0)
+	c.Assert(untilSpacePurge, Equals, 21.0)
+
+	// the larger reserve of remain-space and high-watermark is used.
+	untilFull, untilSpacePurge = estimateHours(rate, size, config.PurgeConfig{RemainSpace: 15, HighWatermark: 80})
+	c.Assert(untilFull, Equals, 36.0)
+	c.Assert(untilSpacePurge, Equals, 16.0)
+
+	// the purge by space is already triggered.
+	_, untilSpacePurge = estimateHours(rate, size, config.PurgeConfig{RemainSpace: 40})
+	c.Assert(untilSpacePurge, Equals, 0.0)
+}
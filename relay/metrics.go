@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/dm/pkg/metricsproxy"
 	"github.com/pingcap/dm/pkg/terror"
 	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/relay/transformer"
 )
 
 var (
@@ -62,6 +63,49 @@ var (
 			Help:      "the space of storage for relay component",
 		}, []string{"type"}) // type can be 'capacity' and 'available'.
 
+	// estimated by the written bytes of relay log in the sliding windows.
+	relayWriteRateGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "write_rate",
+			Help:      "the write rate (bytes/s) of relay log in the sliding window",
+		}, []string{"source", "window"}) // window can be '5m' and '1h'.
+
+	// should alert if it's small, -1 means the disk won't be full under the purge policy.
+	relayHoursUntilFullGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "hours_until_full",
+			Help:      "the estimated hours until the storage for relay log is full",
+		}, []string{"source", "type"}) // type can be 'full' and 'space_purge'.
+
+	// should alert if it's 0, the relay is paused then.
+	relayDiskHealthyGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "disk_healthy",
+			Help:      "whether the disk of relay dir is healthy, 1 for healthy and 0 for unhealthy",
+		}, []string{"source"})
+
+	relayDiskUsageGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "disk_usage",
+			Help:      "the usage (percent) of the disk of relay dir checked by relay-disk-check",
+		}, []string{"source", "type"}) // type can be 'space' and 'inode'.
+
+	relayDiskWriteLatencyGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "disk_write_latency",
+			Help:      "the latency (s) of writing and syncing a probe file into relay dir",
+		}, []string{"source"})
+
 	// should alert.
 	relayLogDataCorruptionCounter = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -141,6 +185,11 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(relayLogFileGauge)
 	registry.MustRegister(relaySubDirIndex)
 	registry.MustRegister(relayLogSpaceGauge)
+	registry.MustRegister(relayWriteRateGauge)
+	registry.MustRegister(relayHoursUntilFullGauge)
+	registry.MustRegister(relayDiskHealthyGauge)
+	registry.MustRegister(relayDiskUsageGauge)
+	registry.MustRegister(relayDiskWriteLatencyGauge)
 	registry.MustRegister(relayLogDataCorruptionCounter)
 	registry.MustRegister(relayLogWriteSizeHistogram)
 	registry.MustRegister(relayLogWriteDurationHistogram)
@@ -149,9 +198,11 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(binlogReadDurationHistogram)
 	registry.MustRegister(binlogTransformDurationHistogram)
 	registry.MustRegister(relayExitWithErrorCounter)
+	transformer.RegisterMetrics(registry)
 }
 
-func reportRelayLogSpaceInBackground(ctx context.Context, dirpath string) error {
+func reportRelayLogSpaceInBackground(ctx context.Context, cfg *Config, growth *growthEstimator) error {
+	dirpath := cfg.RelayDir
 	if len(dirpath) == 0 {
 		return terror.ErrRelayLogDirpathEmpty.Generate()
 	}
@@ -167,6 +218,8 @@ func reportRelayLogSpaceInBackground(ctx context.Context, dirpath string) error
 		for {
 			select {
 			case <-ctx.Done():
+				relayWriteRateGauge.DeleteAllAboutLabels(prometheus.Labels{"source": cfg.SourceID})
+				relayHoursUntilFullGauge.DeleteAllAboutLabels(prometheus.Labels{"source": cfg.SourceID})
 				return
 			case <-ticker.C:
 				size, err := utils.GetStorageSize(dirpath)
@@ -175,6 +228,13 @@ func reportRelayLogSpaceInBackground(ctx context.Context, dirpath string) error
 				} else {
 					relayLogSpaceGauge.WithLabelValues("capacity").Set(float64(size.Capacity))
 					relayLogSpaceGauge.WithLabelValues("available").Set(float64(size.Available))
+
+					growth.Sample(time.Now(), size)
+					status := growth.Status(cfg.Purge)
+					relayWriteRateGauge.WithLabelValues(cfg.SourceID, "5m").Set(status.WriteRateShort)
+					relayWriteRateGauge.WithLabelValues(cfg.SourceID, "1h").Set(status.WriteRateLong)
+					relayHoursUntilFullGauge.WithLabelValues(cfg.SourceID, "full").Set(status.HoursUntilFull)
+					relayHoursUntilFullGauge.WithLabelValues(cfg.SourceID, "space_purge").Set(status.HoursUntilSpacePurge)
 				}
 			}
 		}
@@ -130,10 +130,15 @@ func collectRelayFilesBeforeFileAndTime(logger log.Logger, relayBaseDir string,
 		for _, f := range shortFiles {
 			fp := filepath.Join(dir, f)
 			if safeTime.Unix() > 0 {
-				// check modified time
-				fs, err := os.Stat(fp)
+				// check modified time of the last segment if rotated locally
+				segments, err := relayFileSegments(fp)
 				if err != nil {
-					return nil, terror.ErrGetRelayLogStat.Delegate(err, fp)
+					return nil, err
+				}
+				last := segments[len(segments)-1]
+				fs, err := os.Stat(last)
+				if err != nil {
+					return nil, terror.ErrGetRelayLogStat.Delegate(err, last)
 				}
 				if fs.ModTime().After(safeTime) {
 					hasAll = false // newer found, reset to false
@@ -167,10 +172,8 @@ func purgeRelayFiles(logger log.Logger, files []*subRelayFiles) error {
 
 	for _, subRelay := range files {
 		for _, f := range subRelay.files {
-			logger.Info("purging relay log file", zap.String("file", f))
-			err := os.Remove(f)
-			if err != nil {
-				return terror.ErrRelayRemoveFileFail.Delegate(err, "file", f)
+			if _, err := removeRelayFile(logger, f); err != nil {
+				return err
 			}
 		}
 		if subRelay.hasAll {
@@ -184,3 +187,99 @@ func purgeRelayFiles(logger log.Logger, files []*subRelayFiles) error {
 	}
 	return nil
 }
+
+// purgeRelayFilesBySize purges relay log files in order until @size bytes are freed, and returns the freed bytes.
+func purgeRelayFilesBySize(logger log.Logger, files []*subRelayFiles, size uint64) (uint64, error) {
+	var freed uint64
+	for _, subRelay := range files {
+		for _, f := range subRelay.files {
+			if freed >= size {
+				return freed, nil
+			}
+			size, err := removeRelayFile(logger, f)
+			if err != nil {
+				return freed, err
+			}
+			freed += uint64(size)
+		}
+		if subRelay.hasAll {
+			// if all relay log files removed, remove the directory and all other files (like relay.meta)
+			logger.Info("purging relay log directory", zap.String("directory", subRelay.dir))
+			if err := os.RemoveAll(subRelay.dir); err != nil {
+				return freed, terror.ErrRelayRemoveFileFail.Delegate(err, "dir", subRelay.dir)
+			}
+		}
+	}
+	return freed, nil
+}
+
+// DryRunResult represents the relay log files to be purged in dry run mode.
+type DryRunResult struct {
+	Strategy   string   `json:"strategy"`
+	Files      []string `json:"files"`
+	TotalBytes int64    `json:"total-bytes"`
+}
+
+// newDryRunResult collects the paths and the total size of the relay log files.
+func newDryRunResult(tp strategyType, files []*subRelayFiles) (*DryRunResult, error) {
+	result := &DryRunResult{
+		Strategy: tp.String(),
+		Files:    make([]string, 0),
+	}
+	for _, subRelay := range files {
+		for _, f := range subRelay.files {
+			size, err := relayFileSize(f)
+			if err != nil {
+				return nil, err
+			}
+			result.Files = append(result.Files, f)
+			result.TotalBytes += size
+		}
+	}
+	return result, nil
+}
+
+// relayFileSegments returns the paths of the relay log file and its segments rotated locally.
+func relayFileSegments(f string) ([]string, error) {
+	dir, name := filepath.Split(f)
+	segments, err := streamer.LoadSegments(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(segments))
+	for _, seg := range segments {
+		paths = append(paths, filepath.Join(dir, seg.Filename))
+	}
+	return paths, nil
+}
+
+// relayFileSize returns the total size of the relay log file and its segments.
+func relayFileSize(f string) (int64, error) {
+	paths, err := relayFileSegments(f)
+	if err != nil {
+		return 0, err
+	}
+	var size int64
+	for _, p := range paths {
+		fs, err := os.Stat(p)
+		if err != nil {
+			return 0, terror.ErrGetRelayLogStat.Delegate(err, p)
+		}
+		size += fs.Size()
+	}
+	return size, nil
+}
+
+// removeRelayFile removes the relay log file and its segments, and returns the freed bytes.
+func removeRelayFile(logger log.Logger, f string) (int64, error) {
+	size, err := relayFileSize(f)
+	if err != nil {
+		return 0, err
+	}
+	logger.Info("purging relay log file", zap.String("file", f))
+	if err = os.Remove(f); err != nil {
+		return 0, terror.ErrRelayRemoveFileFail.Delegate(err, "file", f)
+	}
+	dir, name := filepath.Split(f)
+	return size, streamer.RemoveSegments(dir, []string{name})
+}
@@ -58,6 +58,10 @@ type Purger interface {
 	Purging() bool
 	// Do does the purge process one time
 	Do(ctx context.Context, req *pb.PurgeRelayRequest) error
+	// DryRun returns the relay log files to be purged by Do, without purging them
+	DryRun(ctx context.Context, req *pb.PurgeRelayRequest) (*DryRunResult, error)
+	// SpaceStatus returns the disk space status of relay log directory and the last decision of space strategy
+	SpaceStatus() *pb.RelaySpaceStatus
 }
 
 // NewPurger creates a new purger.
@@ -108,7 +112,7 @@ func (p *RelayPurger) Start() {
 		return
 	}
 
-	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0) {
+	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0 && p.cfg.HighWatermark <= 0) {
 		return // no need do purge in the background
 	}
 
@@ -165,9 +169,39 @@ func (p *RelayPurger) Purging() bool {
 
 // Do does the purge process one time.
 func (p *RelayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
+	ps, args, err := p.parseRequest(req)
+	if err != nil {
+		return err
+	}
+	return p.doPurge(ps, args)
+}
+
+// DryRun returns the relay log files which will be purged by Do with the same request, but does not purge them.
+func (p *RelayPurger) DryRun(ctx context.Context, req *pb.PurgeRelayRequest) (*DryRunResult, error) {
+	ps, args, err := p.parseRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	earliest := p.earliestActiveRelayLog()
+	if earliest == nil {
+		return nil, terror.ErrRelayNoActiveRelayLog.Generate()
+	}
+	args.SetActiveRelayLog(earliest)
+
+	p.logger.Info("dry run purging relay log files", zap.Stringer("type", ps.Type()), zap.Any("args", args))
+	files, err := ps.DryRun(args)
+	if err != nil {
+		return nil, err
+	}
+	return newDryRunResult(ps.Type(), files)
+}
+
+// parseRequest returns the strategy and its args for the purge request.
+func (p *RelayPurger) parseRequest(req *pb.PurgeRelayRequest) (PurgeStrategy, StrategyArgs, error) {
 	uuids, err := utils.ParseUUIDIndex(p.indexPath)
 	if err != nil {
-		return terror.Annotatef(err, "parse UUID index file %s", p.indexPath)
+		return nil, nil, terror.Annotatef(err, "parse UUID index file %s", p.indexPath)
 	}
 
 	switch {
@@ -177,7 +211,7 @@ func (p *RelayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 			relayBaseDir: p.baseRelayDir,
 			uuids:        uuids,
 		}
-		return p.doPurge(ps, args)
+		return ps, args, nil
 	case req.Time > 0:
 		ps := p.strategies[strategyTime]
 		args := &timeArgs{
@@ -185,7 +219,7 @@ func (p *RelayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 			safeTime:     time.Unix(req.Time, 0),
 			uuids:        uuids,
 		}
-		return p.doPurge(ps, args)
+		return ps, args, nil
 	case len(req.Filename) > 0:
 		ps := p.strategies[strategyFilename]
 		args := &filenameArgs{
@@ -194,9 +228,9 @@ func (p *RelayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 			subDir:       req.SubDir,
 			uuids:        uuids,
 		}
-		return p.doPurge(ps, args)
+		return ps, args, nil
 	default:
-		return terror.ErrRelayPurgeRequestNotValid.Generate(req)
+		return nil, nil, terror.ErrRelayPurgeRequestNotValid.Generate(req)
 	}
 }
 
@@ -253,12 +287,15 @@ func (p *RelayPurger) check() (PurgeStrategy, StrategyArgs, error) {
 	// 1. strategyInactive only used by dmctl manually
 	// 2. strategyFilename only used by dmctl manually
 
-	// 3. strategySpace should be started if set RemainSpace
-	if p.cfg.RemainSpace > 0 {
+	// 3. strategySpace should be started if set RemainSpace or HighWatermark
+	if p.cfg.RemainSpace > 0 || p.cfg.HighWatermark > 0 {
 		args := &spaceArgs{
-			relayBaseDir: p.baseRelayDir,
-			remainSpace:  p.cfg.RemainSpace,
-			uuids:        uuids,
+			relayBaseDir:    p.baseRelayDir,
+			remainSpace:     p.cfg.RemainSpace,
+			highWatermark:   p.cfg.HighWatermark,
+			lowWatermark:    p.cfg.LowWatermark,
+			targetFreeBytes: p.cfg.TargetFreeBytes,
+			uuids:           uuids,
 		}
 		ps := p.strategies[strategySpace]
 		need, err := ps.Check(args)
@@ -291,6 +328,27 @@ func (p *RelayPurger) check() (PurgeStrategy, StrategyArgs, error) {
 	return nil, nil, nil
 }
 
+// SpaceStatus implements interface of Purger.
+func (p *RelayPurger) SpaceStatus() *pb.RelaySpaceStatus {
+	status := &pb.RelaySpaceStatus{}
+	storageSize, err := getStorageSize(p.baseRelayDir)
+	if err != nil {
+		p.logger.Warn("fail to get storage size", zap.String("directory", p.baseRelayDir), zap.Error(err))
+	} else {
+		status.Capacity = storageSize.Capacity
+		status.Available = storageSize.Available
+		status.UsagePercent = usagePercent(storageSize)
+	}
+	if ss, ok := p.strategies[strategySpace].(*spaceStrategy); ok {
+		lastCheck, decision := ss.getDecision()
+		if !lastCheck.IsZero() {
+			status.LastCheckTime = lastCheck.Format(time.RFC3339)
+		}
+		status.LastDecision = decision
+	}
+	return status
+}
+
 // earliestActiveRelayLog returns the current earliest active relay log info.
 func (p *RelayPurger) earliestActiveRelayLog() *streamer.RelayLogInfo {
 	var earliest *streamer.RelayLogInfo
@@ -328,3 +386,13 @@ func (d *dummyPurger) Purging() bool {
 func (d *dummyPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 	return nil
 }
+
+// DryRun implements interface of Purger.
+func (d *dummyPurger) DryRun(ctx context.Context, req *pb.PurgeRelayRequest) (*DryRunResult, error) {
+	return &DryRunResult{}, nil
+}
+
+// SpaceStatus implements interface of Purger.
+func (d *dummyPurger) SpaceStatus() *pb.RelaySpaceStatus {
+	return nil
+}
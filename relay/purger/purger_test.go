@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/dm/dm/config"
 	"github.com/pingcap/dm/dm/pb"
 	"github.com/pingcap/dm/pkg/streamer"
+	"github.com/pingcap/dm/pkg/terror"
 	"github.com/pingcap/dm/pkg/utils"
 )
 
@@ -184,6 +185,63 @@ func (t *testPurgerSuite) TestPurgeManuallyFilename(c *C) {
 	}
 }
 
+func (t *testPurgerSuite) TestPurgeManuallyDryRun(c *C) {
+	// create relay log dir
+	baseDir, err := os.MkdirTemp("", "test_purge_manually_dry_run")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(baseDir)
+
+	// prepare files and directories
+	relayDirsPath, relayFilesPath, _ := t.genRelayLogFiles(c, baseDir, -1, -1)
+	c.Assert(len(relayDirsPath), Equals, 3)
+	c.Assert(len(relayFilesPath), Equals, 3)
+
+	err = t.genUUIDIndexFile(baseDir)
+	c.Assert(err, IsNil)
+
+	cfg := config.PurgeConfig{
+		Interval: 0, // disable automatically
+	}
+
+	purger := NewPurger(cfg, baseDir, []RelayOperator{t}, nil)
+	fileSize := int64(len("meaningless file content"))
+
+	req := &pb.PurgeRelayRequest{
+		Inactive: true,
+		DryRun:   true,
+	}
+	result, err := purger.DryRun(context.Background(), req)
+	c.Assert(err, IsNil)
+	c.Assert(result.Strategy, Equals, strategyInactive.String())
+	expected := append(append([]string{}, relayFilesPath[0]...), relayFilesPath[1][:2]...)
+	c.Assert(result.Files, DeepEquals, expected)
+	c.Assert(result.TotalBytes, Equals, fileSize*int64(len(expected)))
+
+	req = &pb.PurgeRelayRequest{
+		Filename: t.relayFiles[0][2],
+		SubDir:   t.uuids[0],
+		DryRun:   true,
+	}
+	result, err = purger.DryRun(context.Background(), req)
+	c.Assert(err, IsNil)
+	c.Assert(result.Strategy, Equals, strategyFilename.String())
+	c.Assert(result.Files, DeepEquals, relayFilesPath[0][:2])
+	c.Assert(result.TotalBytes, Equals, fileSize*2)
+
+	// nothing purged
+	for _, dir := range relayDirsPath {
+		c.Assert(utils.IsDirExists(dir), IsTrue)
+	}
+	for _, files := range relayFilesPath {
+		for _, fp := range files {
+			c.Assert(utils.IsFileExists(fp), IsTrue)
+		}
+	}
+
+	_, err = purger.DryRun(context.Background(), &pb.PurgeRelayRequest{DryRun: true})
+	c.Assert(terror.ErrRelayPurgeRequestNotValid.Equal(err), IsTrue)
+}
+
 func (t *testPurgerSuite) TestPurgeAutomaticallyTime(c *C) {
 	// create relay log dir
 	baseDir, err := os.MkdirTemp("", "test_purge_automatically_time")
@@ -271,6 +329,75 @@ func (t *testPurgerSuite) TestPurgeAutomaticallySpace(c *C) {
 	}
 }
 
+func (t *testPurgerSuite) TestPurgeSpaceWatermark(c *C) {
+	// create relay log dir
+	baseDir, err := os.MkdirTemp("", "test_purge_space_watermark")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(baseDir)
+
+	// prepare files and directories
+	relayDirsPath, relayFilesPath, _ := t.genRelayLogFiles(c, baseDir, -1, -1)
+	err = t.genUUIDIndexFile(baseDir)
+	c.Assert(err, IsNil)
+
+	storageSize := utils.StorageSize{Capacity: 1000, Available: 100}
+	getStorageSize = func(string) (utils.StorageSize, error) {
+		return storageSize, nil
+	}
+	defer func() {
+		getStorageSize = utils.GetStorageSize
+	}()
+
+	cfg := config.PurgeConfig{
+		HighWatermark: 95,
+		LowWatermark:  85,
+	}
+	purger := NewPurger(cfg, baseDir, []RelayOperator{t}, nil).(*RelayPurger)
+
+	// 90% not reaches high watermark
+	ps, args, err := purger.check()
+	c.Assert(err, IsNil)
+	c.Assert(ps, IsNil)
+	c.Assert(args, IsNil)
+	status := purger.SpaceStatus()
+	c.Assert(status.Capacity, Equals, uint64(1000))
+	c.Assert(status.Available, Equals, uint64(100))
+	c.Assert(status.UsagePercent, Equals, int64(90))
+	c.Assert(status.LastCheckTime, Not(Equals), "")
+	c.Assert(status.LastDecision, Matches, "skip.*")
+
+	// 95% reaches high watermark, 100 bytes need to be purged to drop to low watermark
+	storageSize.Available = 50
+	ps, args, err = purger.check()
+	c.Assert(err, IsNil)
+	c.Assert(ps.Type(), Equals, strategySpace)
+	c.Assert(purger.SpaceStatus().LastDecision, Matches, "purge, disk usage 95% reaches high watermark 95%")
+	c.Assert(purger.doPurge(ps, args), IsNil)
+	c.Assert(purger.SpaceStatus().LastDecision, Equals, "purged 120 bytes of 100 bytes to free")
+
+	// only the earliest relay log files are purged
+	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][0]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][1]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[1][2]), IsTrue)
+
+	// target-free-bytes limits the bytes purged in one check
+	c.Assert(os.RemoveAll(baseDir), IsNil)
+	c.Assert(os.Mkdir(baseDir, 0o700), IsNil)
+	relayDirsPath, relayFilesPath, _ = t.genRelayLogFiles(c, baseDir, -1, -1)
+	c.Assert(t.genUUIDIndexFile(baseDir), IsNil)
+	cfg.TargetFreeBytes = 30
+	purger = NewPurger(cfg, baseDir, []RelayOperator{t}, nil).(*RelayPurger)
+	ps, args, err = purger.check()
+	c.Assert(err, IsNil)
+	c.Assert(purger.doPurge(ps, args), IsNil)
+	c.Assert(purger.SpaceStatus().LastDecision, Equals, "purged 48 bytes of 30 bytes to free")
+	c.Assert(utils.IsDirExists(relayDirsPath[0]), IsTrue)
+	c.Assert(utils.IsFileExists(relayFilesPath[0][0]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[0][1]), IsFalse)
+	c.Assert(utils.IsFileExists(relayFilesPath[0][2]), IsTrue)
+}
+
 func (t *testPurgerSuite) genRelayLogFiles(c *C, baseDir string, safeTimeIdxI, safeTimeIdxJ int) ([]string, [][]string, time.Time) {
 	var (
 		relayDirsPath  = make([]string, 0, 3)
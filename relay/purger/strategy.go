@@ -52,6 +52,9 @@ type PurgeStrategy interface {
 	// Do does the purge process one time
 	Do(args interface{}) error
 
+	// DryRun returns the relay log files to be purged by Do, without purging them
+	DryRun(args interface{}) ([]*subRelayFiles, error)
+
 	// Purging indicates whether is doing purge
 	Purging() bool
 
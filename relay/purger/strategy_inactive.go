@@ -77,6 +77,15 @@ func (s *inactiveStrategy) Do(args interface{}) error {
 	return purgeRelayFilesBeforeFile(s.logger, ia.relayBaseDir, ia.uuids, ia.activeRelayLog)
 }
 
+func (s *inactiveStrategy) DryRun(args interface{}) ([]*subRelayFiles, error) {
+	ia, ok := args.(*inactiveArgs)
+	if !ok {
+		return nil, terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
+	}
+
+	return getRelayFilesBeforeFile(s.logger, ia.relayBaseDir, ia.uuids, ia.activeRelayLog)
+}
+
 func (s *inactiveStrategy) Purging() bool {
 	return s.purging.Load()
 }
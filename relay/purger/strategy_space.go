@@ -16,6 +16,8 @@ package purger
 import (
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -26,12 +28,20 @@ import (
 	"github.com/pingcap/dm/pkg/utils"
 )
 
+// getStorageSize is used to get the disk space of relay log directory, it's replaced in tests.
+var getStorageSize = utils.GetStorageSize
+
 // spaceArgs represents args needed by spaceStrategy.
 type spaceArgs struct {
-	relayBaseDir   string
-	remainSpace    int64 // if remain space (GB) in @RelayBaseDir less than this, then it can be purged
-	uuids          []string
-	activeRelayLog *streamer.RelayLogInfo // earliest active relay log info
+	relayBaseDir    string
+	remainSpace     int64 // if remain space (GB) in @RelayBaseDir less than this, then it can be purged
+	highWatermark   int64 // if disk usage (percent) reaches this, then it can be purged
+	lowWatermark    int64 // purge until disk usage (percent) drops to this
+	targetFreeBytes int64 // max bytes purged by watermarks in one check, 0 means no limit
+	uuids           []string
+	activeRelayLog  *streamer.RelayLogInfo // earliest active relay log info
+
+	storageSize *utils.StorageSize // disk space got in Check
 }
 
 func (sa *spaceArgs) SetActiveRelayLog(active *streamer.RelayLogInfo) {
@@ -39,14 +49,46 @@ func (sa *spaceArgs) SetActiveRelayLog(active *streamer.RelayLogInfo) {
 }
 
 func (sa *spaceArgs) String() string {
-	return fmt.Sprintf("(RelayBaseDir: %s, AllowMinRemainSpace: %dGB, UUIDs: %s, ActiveRelayLog: %s)",
-		sa.relayBaseDir, sa.remainSpace, strings.Join(sa.uuids, ";"), sa.activeRelayLog)
+	return fmt.Sprintf("(RelayBaseDir: %s, AllowMinRemainSpace: %dGB, HighWatermark: %d%%, LowWatermark: %d%%, TargetFreeBytes: %d, UUIDs: %s, ActiveRelayLog: %s)",
+		sa.relayBaseDir, sa.remainSpace, sa.highWatermark, sa.lowWatermark, sa.targetFreeBytes, strings.Join(sa.uuids, ";"), sa.activeRelayLog)
+}
+
+// bytesToFree returns how many bytes should be purged to make disk usage drop to low watermark
+// and remain space not less than @remainSpace.
+func (sa *spaceArgs) bytesToFree(size utils.StorageSize) uint64 {
+	var toFree uint64
+	used := size.Capacity - size.Available
+	if lowBytes := size.Capacity * uint64(sa.lowWatermark) / 100; used > lowBytes {
+		toFree = used - lowBytes
+	}
+	if sa.remainSpace > 0 {
+		requiredBytes := uint64(sa.remainSpace) * 1024 * 1024 * 1024
+		if size.Available < requiredBytes && requiredBytes-size.Available > toFree {
+			toFree = requiredBytes - size.Available
+		}
+	}
+	if sa.targetFreeBytes > 0 && toFree > uint64(sa.targetFreeBytes) {
+		toFree = uint64(sa.targetFreeBytes)
+	}
+	return toFree
+}
+
+// usagePercent returns the used percent of the disk.
+func usagePercent(size utils.StorageSize) int64 {
+	if size.Capacity == 0 {
+		return 0
+	}
+	return int64((size.Capacity - size.Available) * 100 / size.Capacity)
 }
 
 // spaceStrategy represents a relay purge strategy by remain space in dm-worker node.
 type spaceStrategy struct {
 	purging atomic.Bool
 
+	mu           sync.Mutex
+	lastCheck    time.Time
+	lastDecision string
+
 	logger log.Logger
 }
 
@@ -62,13 +104,26 @@ func (s *spaceStrategy) Check(args interface{}) (bool, error) {
 		return false, terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
 	}
 
-	storageSize, err := utils.GetStorageSize(sa.relayBaseDir)
+	storageSize, err := getStorageSize(sa.relayBaseDir)
 	if err != nil {
 		return false, terror.Annotatef(err, "get storage size for directory %s", sa.relayBaseDir)
 	}
+	sa.storageSize = &storageSize
 
+	usage := usagePercent(storageSize)
 	requiredBytes := uint64(sa.remainSpace) * 1024 * 1024 * 1024
-	return storageSize.Available < requiredBytes, nil
+	var decision string
+	switch {
+	case sa.highWatermark > 0 && usage >= sa.highWatermark:
+		decision = fmt.Sprintf("purge, disk usage %d%% reaches high watermark %d%%", usage, sa.highWatermark)
+	case sa.remainSpace > 0 && storageSize.Available < requiredBytes:
+		decision = fmt.Sprintf("purge, available space %d bytes is less than remain space %dGB", storageSize.Available, sa.remainSpace)
+	default:
+		s.setDecision(fmt.Sprintf("skip, disk usage %d%%, available space %d bytes", usage, storageSize.Available))
+		return false, nil
+	}
+	s.setDecision(decision)
+	return true, nil
 }
 
 func (s *spaceStrategy) Do(args interface{}) error {
@@ -82,9 +137,52 @@ func (s *spaceStrategy) Do(args interface{}) error {
 		return terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
 	}
 
-	// NOTE: we purge all inactive relay log files when available space less than @remainSpace
-	// maybe we can refine this to purge only part of this files every time
-	return purgeRelayFilesBeforeFile(s.logger, sa.relayBaseDir, sa.uuids, sa.activeRelayLog)
+	if sa.highWatermark <= 0 {
+		// NOTE: we purge all inactive relay log files when available space less than @remainSpace
+		// maybe we can refine this to purge only part of this files every time
+		return purgeRelayFilesBeforeFile(s.logger, sa.relayBaseDir, sa.uuids, sa.activeRelayLog)
+	}
+
+	// purge the earliest inactive relay log files until disk usage drops to low watermark
+	if sa.storageSize == nil {
+		storageSize, err := getStorageSize(sa.relayBaseDir)
+		if err != nil {
+			return terror.Annotatef(err, "get storage size for directory %s", sa.relayBaseDir)
+		}
+		sa.storageSize = &storageSize
+	}
+	toFree := sa.bytesToFree(*sa.storageSize)
+	files, err := getRelayFilesBeforeFile(s.logger, sa.relayBaseDir, sa.uuids, sa.activeRelayLog)
+	if err != nil {
+		return terror.Annotatef(err, "get relay files from directory %s before file %+v with UUIDs %v", sa.relayBaseDir, sa.activeRelayLog, sa.uuids)
+	}
+	freed, err := purgeRelayFilesBySize(s.logger, files, toFree)
+	s.setDecision(fmt.Sprintf("purged %d bytes of %d bytes to free", freed, toFree))
+	return err
+}
+
+// setDecision records the decision of the last check or purge.
+func (s *spaceStrategy) setDecision(decision string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastCheck = time.Now()
+	s.lastDecision = decision
+}
+
+// getDecision returns the time and the decision of the last check or purge.
+func (s *spaceStrategy) getDecision() (time.Time, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastCheck, s.lastDecision
+}
+
+func (s *spaceStrategy) DryRun(args interface{}) ([]*subRelayFiles, error) {
+	sa, ok := args.(*spaceArgs)
+	if !ok {
+		return nil, terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
+	}
+
+	return getRelayFilesBeforeFile(s.logger, sa.relayBaseDir, sa.uuids, sa.activeRelayLog)
 }
 
 func (s *spaceStrategy) Purging() bool {
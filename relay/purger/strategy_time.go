@@ -79,6 +79,15 @@ func (s *timeStrategy) Do(args interface{}) error {
 	return purgeRelayFilesBeforeFileAndTime(s.logger, ta.relayBaseDir, ta.uuids, ta.activeRelayLog, ta.safeTime)
 }
 
+func (s *timeStrategy) DryRun(args interface{}) ([]*subRelayFiles, error) {
+	ta, ok := args.(*timeArgs)
+	if !ok {
+		return nil, terror.ErrRelayPurgeArgsNotValid.Generate(args, args)
+	}
+
+	return getRelayFilesBeforeFileAndTime(s.logger, ta.relayBaseDir, ta.uuids, ta.activeRelayLog, ta.safeTime)
+}
+
 func (s *timeStrategy) Purging() bool {
 	return s.purging.Load()
 }
@@ -16,6 +16,7 @@ package reader
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
@@ -58,6 +59,10 @@ type Config struct {
 	GTIDs      gtid.Set
 	EnableGTID bool
 	MasterID   string // the identifier for the master, used when logging.
+	// IdleDetector detects whether no event received for too long, nil to disable the detection.
+	IdleDetector *common.IdleDetector
+	// NetRateLimiter throttles reading binlog events from the upstream, nil means no limit.
+	NetRateLimiter *common.NetRateLimiter
 }
 
 // reader implements Reader interface.
@@ -140,7 +145,17 @@ func (r *reader) GetEvent(ctx context.Context) (Result, error) {
 
 		if err == nil {
 			result.Event = ev
+			r.cfg.IdleDetector.Mark(time.Now())
+			if err = r.cfg.NetRateLimiter.WaitEvent(ctx, ev); err != nil {
+				return result, err
+			}
 		} else if isRetryableError(err) {
+			if r.cfg.IdleDetector.Check(time.Now()) {
+				idle := r.cfg.IdleDetector.IdleDuration(time.Now())
+				r.logger.Warn("no binlog event received for too long, the connection is suspect",
+					zap.String("master", r.cfg.MasterID), zap.Duration("idle duration", idle))
+				return result, terror.ErrRelayReaderIdle.Generate(common.IdleThreshold)
+			}
 			r.logger.Info("get retryable error when reading binlog event", log.ShortError(err))
 			continue
 		}
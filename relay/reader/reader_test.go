@@ -22,7 +22,9 @@ import (
 	"github.com/pingcap/check"
 	"github.com/pingcap/errors"
 
+	"github.com/pingcap/dm/pkg/binlog/common"
 	br "github.com/pingcap/dm/pkg/binlog/reader"
+	"github.com/pingcap/dm/pkg/terror"
 )
 
 var _ = check.Suite(&testReaderSuite{})
@@ -152,3 +154,41 @@ func (t *testReaderSuite) TestGetEventWithError(c *check.C) {
 	}
 	c.Assert(results, check.DeepEquals, expected)
 }
+
+func (t *testReaderSuite) TestGetEventIdle(c *check.C) {
+	cfg := &Config{
+		SyncConfig: replication.BinlogSyncerConfig{
+			ServerID: 101,
+		},
+		MasterID:     "test-master",
+		IdleDetector: common.NewIdleDetector(time.Millisecond),
+	}
+
+	r := NewReader(cfg)
+	concreteR := r.(*reader)
+	mockR := br.NewMockReader()
+	concreteR.in = mockR
+	c.Assert(r.Start(), check.IsNil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	concreteMR := mockR.(*br.MockReader)
+
+	// receive an event.
+	go func() {
+		c.Assert(concreteMR.PushEvent(ctx, &replication.BinlogEvent{}), check.IsNil)
+	}()
+	_, err := r.GetEvent(ctx)
+	c.Assert(err, check.IsNil)
+	c.Assert(cfg.IdleDetector.LastEventTime().IsZero(), check.IsFalse)
+	c.Assert(cfg.IdleDetector.Suspect(), check.IsFalse)
+
+	// timeout after idle for the threshold, the connection is suspect.
+	time.Sleep(2 * time.Millisecond)
+	go func() {
+		c.Assert(concreteMR.PushError(ctx, context.DeadlineExceeded), check.IsNil)
+	}()
+	_, err = r.GetEvent(ctx)
+	c.Assert(terror.ErrRelayReaderIdle.Equal(err), check.IsTrue)
+	c.Assert(cfg.IdleDetector.Suspect(), check.IsTrue)
+}
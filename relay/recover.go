@@ -0,0 +1,136 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/pingcap/tidb/parser"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pingcap/dm/pkg/log"
+	pkgstreamer "github.com/pingcap/dm/pkg/streamer"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/relay/writer"
+)
+
+// defaultRecoverConcurrency is the max number of relay sub directories verified concurrently when relay starts.
+var defaultRecoverConcurrency = 4
+
+// verifyRelaySubDirs verifies the latest relay log file of all previous relay sub directories (except the current one)
+// with a bounded worker pool, and truncates the corrupt/incomplete binlog events/transactions in them.
+// the current sub directory is still recovered by tryRecoverLatestFile because its meta may need to be updated.
+// newParser is called once for every worker because a parser can't be used concurrently.
+func (r *Relay) verifyRelaySubDirs(ctx context.Context, newParser func() (*parser.Parser, error)) error {
+	uuids, err := utils.ParseUUIDIndex(filepath.Join(r.cfg.RelayDir, utils.UUIDIndexFilename))
+	if err != nil {
+		return err
+	}
+	currentUUID := r.meta.UUID()
+	subDirs := make([]string, 0, len(uuids))
+	for _, uuid := range uuids {
+		if uuid != currentUUID {
+			subDirs = append(subDirs, uuid)
+		}
+	}
+	if len(subDirs) == 0 {
+		return nil
+	}
+
+	concurrency := defaultRecoverConcurrency
+	if concurrency > len(subDirs) {
+		concurrency = len(subDirs)
+	}
+	r.logger.Info("start to verify relay sub directories", zap.Int("count", len(subDirs)), zap.Int("concurrency", concurrency))
+
+	var (
+		startTime = time.Now()
+		finished  atomic.Int64
+		subDirCh  = make(chan string, len(subDirs))
+	)
+	for _, uuid := range subDirs {
+		subDirCh <- uuid
+	}
+	close(subDirCh)
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	for i := 0; i < concurrency; i++ {
+		eg.Go(func() error {
+			parser2, err2 := newParser()
+			if err2 != nil {
+				return err2
+			}
+			for uuid := range subDirCh {
+				if err2 = egCtx.Err(); err2 != nil {
+					return err2
+				}
+				if err2 = r.verifyRelaySubDir(egCtx, parser2, uuid); err2 != nil {
+					return err2
+				}
+				r.logger.Info("relay sub directory verified", zap.String("UUID", uuid),
+					zap.Int64("finished", finished.Inc()), zap.Int("total", len(subDirs)))
+			}
+			return nil
+		})
+	}
+	if err = eg.Wait(); err != nil {
+		return err
+	}
+	r.logger.Info("all relay sub directories verified", zap.Int("count", len(subDirs)), zap.Duration("cost time", time.Since(startTime)))
+	return nil
+}
+
+// verifyRelaySubDir verifies the latest relay log file in the relay sub directory.
+func (r *Relay) verifyRelaySubDir(ctx context.Context, parser2 *parser.Parser, uuid string) error {
+	dir := filepath.Join(r.cfg.RelayDir, uuid)
+	if !utils.IsDirExists(dir) {
+		r.logger.Warn("relay sub directory not found, skip to verify it", zap.String("UUID", uuid))
+		return nil
+	}
+	files, err := pkgstreamer.CollectAllBinlogFiles(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	cfg := &writer.FileConfig{
+		RelayDir: dir,
+		Filename: files[len(files)-1],
+	}
+	writer2 := writer.NewFileWriter(r.logger, cfg, parser2)
+	if err = writer2.Start(); err != nil {
+		return terror.Annotatef(err, "start verify writer for UUID %s with config %+v", uuid, cfg)
+	}
+	defer func() {
+		if err2 := writer2.Close(); err2 != nil {
+			r.logger.Error("fail to close verify writer", zap.String("UUID", uuid), zap.Reflect("config", cfg), log.ShortError(err2))
+		}
+	}()
+
+	result, err := writer2.Recover(ctx)
+	if err != nil {
+		return terror.Annotatef(err, "verify for UUID %s with config %+v", uuid, cfg)
+	}
+	if result.Truncated {
+		r.logger.Warn("incomplete relay log file truncated", zap.String("UUID", uuid), zap.Stringer("to position", result.LatestPos))
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/docker/go-units"
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/errors"
@@ -105,6 +106,8 @@ type Relay struct {
 	db        *conn.BaseDB
 	cfg       *Config
 	syncerCfg replication.BinlogSyncerConfig
+	// go-mysql connects to the upstream through it if the tunnel is configured
+	tunnel *conn.Tunnel
 
 	meta   Meta
 	closed atomic.Bool
@@ -112,31 +115,51 @@ type Relay struct {
 
 	logger log.Logger
 
+	// idleDetector detects whether the connection to the upstream received no event for too long.
+	idleDetector *common.IdleDetector
+	// netRateLimiter throttles reading binlog from the upstream, it's shared by the readers after reconnecting.
+	netRateLimiter *common.NetRateLimiter
+	// growth estimates the growth of relay log files and the time until the disk is full.
+	growth *growthEstimator
+	// diskChecker checks the health of the disk of relay dir, nil if it's disabled.
+	diskChecker *diskHealthChecker
+
 	activeRelayLog struct {
 		sync.RWMutex
 		info *pkgstreamer.RelayLogInfo
+		// the relay log files not archived yet should not be purged.
+		archiveWriter *writer.ArchiveWriter
 	}
 }
 
 // NewRealRelay creates an instance of Relay.
 func NewRealRelay(cfg *Config) Process {
+	logger := log.With(zap.String("component", "relay log"))
 	return &Relay{
-		cfg:    cfg,
-		meta:   NewLocalMeta(cfg.Flavor, cfg.RelayDir),
-		logger: log.With(zap.String("component", "relay log")),
+		cfg:          cfg,
+		meta:         NewLocalMeta(cfg.Flavor, cfg.RelayDir),
+		logger:       logger,
+		idleDetector: common.NewIdleDetector(common.IdleThreshold),
+		growth:       newGrowthEstimator(),
+		diskChecker:  newDiskHealthChecker(cfg, logger),
+
+		netRateLimiter: common.NewNetRateLimiter(cfg.NetRateLimit),
 	}
 }
 
 // Init implements the dm.Unit interface.
 // NOTE when Init encounters an error, it will make DM-worker exit when it boots up and assigned relay.
 func (r *Relay) Init(ctx context.Context) (err error) {
-	return reportRelayLogSpaceInBackground(ctx, r.cfg.RelayDir)
+	if r.diskChecker != nil {
+		go r.diskChecker.run(ctx)
+	}
+	return reportRelayLogSpaceInBackground(ctx, r.cfg, r.growth)
 }
 
 // Process implements the dm.Unit interface.
 func (r *Relay) Process(ctx context.Context) pb.ProcessResult {
 	errs := make([]*pb.ProcessError, 0, 1)
-	err := r.process(ctx)
+	err := r.processUntilDiskUnhealthy(ctx)
 	if err != nil && errors.Cause(err) != replication.ErrSyncClosed {
 		relayExitWithErrorCounter.Inc()
 		r.logger.Error("process exit", zap.Error(err))
@@ -158,6 +181,36 @@ func (r *Relay) Process(ctx context.Context) pb.ProcessResult {
 	}
 }
 
+// processUntilDiskUnhealthy runs process, and stops it with ErrRelayDiskUnhealthy when the disk of relay dir becomes
+// unhealthy, then the relay is paused. it fails immediately if the disk is still unhealthy when resuming.
+func (r *Relay) processUntilDiskUnhealthy(ctx context.Context) error {
+	if r.diskChecker == nil {
+		return r.process(ctx)
+	}
+	if err := r.diskChecker.Err(); err != nil {
+		return err
+	}
+
+	processCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	unhealthy := r.diskChecker.Unhealthy()
+	go func() {
+		select {
+		case <-unhealthy:
+			r.logger.Warn("stop relay because the disk of relay dir is unhealthy")
+			cancel()
+		case <-processCtx.Done():
+		}
+	}()
+	err := r.process(processCtx)
+	if ctx.Err() == nil {
+		if err2 := r.diskChecker.Err(); err2 != nil {
+			return err2
+		}
+	}
+	return err
+}
+
 func (r *Relay) process(ctx context.Context) error {
 	err := r.setSyncConfig()
 	if err != nil {
@@ -203,6 +256,13 @@ func (r *Relay) process(ctx context.Context) error {
 	} else {
 		// connected to last source
 		r.updateMetricsRelaySubDirIndex()
+		// verify relay log files in previous sub directories concurrently, they may be left incomplete by a crash.
+		err = r.verifyRelaySubDirs(ctx, func() (*parser.Parser, error) {
+			return utils.GetParser(ctx, r.db.DB)
+		})
+		if err != nil {
+			return err
+		}
 		// if not a new server, try to recover the latest relay log file.
 		err = r.tryRecoverLatestFile(ctx, parser2)
 		if err != nil {
@@ -288,7 +348,10 @@ func (r *Relay) process(ctx context.Context) error {
 		return err
 	}
 
-	transformer2 := transformer.NewTransformer(parser2)
+	transformer2, err := transformer.NewChain(parser2, r.cfg.Transformers)
+	if err != nil {
+		return err
+	}
 
 	go r.doIntervalOps(ctx)
 
@@ -330,6 +393,10 @@ func (r *Relay) process(ctx context.Context) error {
 						goto checkError
 					}
 					tResult := transformer2.Transform(res.Event)
+					if tResult.Err != nil {
+						err = tResult.Err
+						goto checkError
+					}
 					// do not count skip event
 					if !tResult.Ignore {
 						i++
@@ -400,7 +467,7 @@ func (r *Relay) tryRecoverLatestFile(ctx context.Context, parser2 *parser.Parser
 	}()
 	r.logger.Info("started recover writer", zap.String("UUID", uuid), zap.Reflect("config", cfg))
 
-	// NOTE: recover a relay log file with too many binlog events may take a little long time.
+	// NOTE: a large relay log file with the checksum is scanned in chunks concurrently when recovering.
 	result, err := writer2.Recover(ctx)
 	if err == nil {
 		relayLogHasMore := result.LatestPos.Compare(latestPos) > 0 ||
@@ -518,6 +585,9 @@ func (r *Relay) handleEvents(
 		transformTimer := time.Now()
 		tResult := transformer2.Transform(e)
 		binlogTransformDurationHistogram.Observe(time.Since(transformTimer).Seconds())
+		if tResult.Err != nil {
+			return eventIndex, tResult.Err
+		}
 		if len(tResult.NextLogName) > 0 && tResult.NextLogName > lastPos.Name {
 			lastPos = mysql.Position{
 				Name: tResult.NextLogName,
@@ -551,7 +621,12 @@ func (r *Relay) handleEvents(
 		r.logger.Debug("writing binlog event", zap.Reflect("header", e.Header))
 		wResult, err := writer2.WriteEvent(e)
 		if err != nil {
-			relayLogWriteErrorCounter.Inc()
+			if terror.ErrRelayWriterEventCorrupted.Equal(err) {
+				relayLogDataCorruptionCounter.Inc()
+				r.logger.Warn("reject corrupted binlog event, will request it again", zap.Stringer("last pos", lastPos), log.ShortError(err))
+			} else {
+				relayLogWriteErrorCounter.Inc()
+			}
 			return eventIndex, err
 		} else if wResult.Ignore {
 			r.logger.Info("ignore event by writer",
@@ -583,6 +658,7 @@ func (r *Relay) handleEvents(
 		}
 
 		relayLogWriteSizeHistogram.Observe(float64(e.Header.EventSize))
+		r.growth.Add(uint64(e.Header.EventSize))
 		relayLogPosGauge.WithLabelValues("relay").Set(float64(lastPos.Pos))
 		if index, err2 := binlog.GetFilenameIndex(lastPos.Name); err2 != nil {
 			r.logger.Error("parse binlog file name", zap.String("file name", lastPos.Name), log.ShortError(err2))
@@ -736,6 +812,7 @@ func (r *Relay) doIntervalOps(ctx context.Context) {
 					r.logger.Info("flush meta finished", zap.Stringer("meta", r.meta))
 				}
 			}
+			r.updateArchiveMetrics()
 			r.RUnlock()
 		case <-masterStatusTicker.C:
 			r.RLock()
@@ -796,11 +873,14 @@ func (r *Relay) setUpReader(ctx context.Context) (reader.Reader, error) {
 	uuid, pos := r.meta.Pos()
 	_, gs := r.meta.GTID()
 	cfg := &reader.Config{
-		SyncConfig: r.syncerCfg,
-		Pos:        pos,
-		GTIDs:      gs,
-		MasterID:   r.masterNode(),
-		EnableGTID: r.cfg.EnableGTID,
+		SyncConfig:   r.syncerCfg,
+		Pos:          pos,
+		GTIDs:        gs,
+		MasterID:     r.masterNode(),
+		EnableGTID:   r.cfg.EnableGTID,
+		IdleDetector: r.idleDetector,
+
+		NetRateLimiter: r.netRateLimiter,
 	}
 
 	reader2 := reader.NewReader(cfg)
@@ -819,15 +899,31 @@ func (r *Relay) setUpReader(ctx context.Context) (reader.Reader, error) {
 func (r *Relay) setUpWriter(parser2 *parser.Parser) (writer.Writer, error) {
 	uuid, pos := r.meta.Pos()
 	cfg := &writer.FileConfig{
-		RelayDir: r.meta.Dir(),
-		Filename: pos.Name,
+		RelayDir:    r.meta.Dir(),
+		Filename:    pos.Name,
+		MaxFileSize: r.cfg.File.MaxSize * units.MiB,
+		MaxFileAge:  r.cfg.File.MaxAge.Duration,
 	}
 	writer2 := writer.NewFileWriter(r.logger, cfg, parser2)
+	var archiveWriter *writer.ArchiveWriter
+	if r.cfg.Archive.URL != "" {
+		archiveCfg := &writer.ArchiveConfig{
+			RelayDir:  cfg.RelayDir,
+			Filename:  cfg.Filename,
+			QueueSize: r.cfg.Archive.QueueSize,
+		}
+		archiver := writer.NewHTTPArchiver(r.cfg.Archive.URL, r.cfg.Archive.Timeout.Duration)
+		archiveWriter = writer.NewArchiveWriter(r.logger, archiveCfg, writer2, archiver)
+		writer2 = archiveWriter
+	}
 	if err := writer2.Start(); err != nil {
 		return nil, terror.Annotatef(err, "start writer for UUID %s with config %+v", uuid, cfg)
 	}
+	r.activeRelayLog.Lock()
+	r.activeRelayLog.archiveWriter = archiveWriter
+	r.activeRelayLog.Unlock()
 
-	r.logger.Info("started underlying writer", zap.String("UUID", uuid), zap.Reflect("config", cfg))
+	r.logger.Info("started underlying writer", zap.String("UUID", uuid), zap.Reflect("config", cfg), zap.Bool("archive", archiveWriter != nil))
 	return writer2, nil
 }
 
@@ -883,6 +979,8 @@ func (r *Relay) Close() {
 	r.stopSync()
 
 	r.closeDB()
+	r.tunnel.Close()
+	r.tunnel = nil
 
 	r.closed.Store(true)
 	r.logger.Info("relay unit closed")
@@ -901,6 +999,14 @@ func (r *Relay) Status(sourceStatus *binlog.SourceStatus) interface{} {
 	if _, relayGTIDSet := r.meta.GTID(); relayGTIDSet != nil {
 		rs.RelayBinlogGtid = relayGTIDSet.String()
 	}
+	if lastEventTime := r.idleDetector.LastEventTime(); !lastEventTime.IsZero() {
+		rs.LastEventTime = lastEventTime.Format(time.RFC3339)
+		rs.IdleDuration = int64(r.idleDetector.IdleDuration(time.Now()).Seconds())
+	}
+	rs.Growth = r.growth.Status(r.cfg.Purge)
+	if r.diskChecker != nil {
+		rs.DiskHealth = r.diskChecker.Status()
+	}
 
 	if sourceStatus != nil {
 		masterPos, masterGTID := sourceStatus.Location.Position, sourceStatus.Location.GetGTID()
@@ -1006,11 +1112,42 @@ func (r *Relay) setActiveRelayLog(filename string) {
 	r.activeRelayLog.Unlock()
 }
 
+// updateArchiveMetrics updates the metrics of the acknowledged position of the archived relay log.
+func (r *Relay) updateArchiveMetrics() {
+	r.activeRelayLog.RLock()
+	archiveWriter := r.activeRelayLog.archiveWriter
+	r.activeRelayLog.RUnlock()
+	if archiveWriter == nil {
+		return
+	}
+	acked := archiveWriter.Acked()
+	if acked.Name == "" {
+		return
+	}
+	relayLogPosGauge.WithLabelValues("archive").Set(float64(acked.Pos))
+	if index, err := binlog.GetFilenameIndex(acked.Name); err != nil {
+		r.logger.Error("parse archived binlog file name", zap.String("file name", acked.Name), log.ShortError(err))
+	} else {
+		relayLogFileGauge.WithLabelValues("archive").Set(float64(index))
+	}
+}
+
 // ActiveRelayLog returns the current active RelayLogInfo.
+// if the relay log is archived, the earliest relay log file not archived yet is returned to prevent it from purging.
 func (r *Relay) ActiveRelayLog() *pkgstreamer.RelayLogInfo {
 	r.activeRelayLog.RLock()
 	defer r.activeRelayLog.RUnlock()
-	return r.activeRelayLog.info
+	info := r.activeRelayLog.info
+	if info == nil || r.activeRelayLog.archiveWriter == nil {
+		return info
+	}
+	acked := r.activeRelayLog.archiveWriter.Acked()
+	if acked.Name == "" || mysql.CompareBinlogFileName(acked.Name, info.Filename) >= 0 {
+		return info
+	}
+	cloned := *info
+	cloned.Filename = acked.Name
+	return &cloned
 }
 
 func (r *Relay) setSyncConfig() error {
@@ -1030,11 +1167,18 @@ func (r *Relay) setSyncConfig() error {
 		}
 	}
 
+	from, tunnel, err := conn.ApplyTunnel(r.cfg.From)
+	if err != nil {
+		return err
+	}
+	r.tunnel.Close()
+	r.tunnel = tunnel
+
 	syncerCfg := replication.BinlogSyncerConfig{
 		ServerID:  r.cfg.ServerID,
 		Flavor:    r.cfg.Flavor,
-		Host:      r.cfg.From.Host,
-		Port:      uint16(r.cfg.From.Port),
+		Host:      from.Host,
+		Port:      uint16(from.Port),
 		User:      r.cfg.From.User,
 		Password:  r.cfg.From.Password,
 		Charset:   r.cfg.Charset,
@@ -590,3 +590,66 @@ func (t *testRelaySuite) verifyMetadata(c *C, r *Relay, uuidExpected string,
 	c.Assert(err, IsNil)
 	c.Assert(UUIDs, DeepEquals, uuidsExpected)
 }
+
+func (t *testRelaySuite) TestVerifyRelaySubDirs(c *C) {
+	var (
+		uuids = []string{
+			"24ecd093-8cec-11e9-aa0d-0242ac170002",
+			"3ccc475b-2343-11e7-be21-6c0b84d59f30",
+			"53bfca22-690d-11e7-8a62-18ded7a37b78",
+		}
+		previousGTIDSetStr = "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14,53bfca22-690d-11e7-8a62-18ded7a37b78:1-495"
+		latestGTIDStr1     = "3ccc475b-2343-11e7-be21-6c0b84d59f30:14"
+		latestGTIDStr2     = "53bfca22-690d-11e7-8a62-18ded7a37b78:495"
+		filename           = "mysql-bin.000001"
+		startPos           = gmysql.Position{Name: filename, Pos: 123}
+
+		relayCfg = newRelayCfg(c, gmysql.MySQLFlavor)
+		r        = NewRelay(relayCfg).(*Relay)
+		ctx      = context.Background()
+	)
+	newParser := func() (*parser.Parser, error) {
+		return parser.New(), nil
+	}
+
+	// no UUID index file, nothing to verify.
+	c.Assert(r.verifyRelaySubDirs(ctx, newParser), IsNil)
+
+	previousGTIDSet, err := gtid.ParserGTID(relayCfg.Flavor, previousGTIDSetStr)
+	c.Assert(err, IsNil)
+	latestGTID1, err := gtid.ParserGTID(relayCfg.Flavor, latestGTIDStr1)
+	c.Assert(err, IsNil)
+	latestGTID2, err := gtid.ParserGTID(relayCfg.Flavor, latestGTIDStr2)
+	c.Assert(err, IsNil)
+	_, _, data := genBinlogEventsWithGTIDs(c, relayCfg.Flavor, previousGTIDSet, latestGTID1, latestGTID2)
+
+	// write relay log files with some invalid data into every sub directory.
+	invalidData := append(append([]byte{}, data...), []byte("invalid event data")...)
+	subDirs := make([]string, 0, len(uuids))
+	for i, uuid := range uuids {
+		c.Assert(r.meta.AddDir(uuid, &startPos, nil, 0), IsNil)
+		subDirs = append(subDirs, fmt.Sprintf("%s.%06d", uuid, i+1))
+		c.Assert(os.WriteFile(filepath.Join(relayCfg.RelayDir, subDirs[i], filename), invalidData, 0o600), IsNil)
+	}
+
+	defer func(origin int) {
+		defaultRecoverConcurrency = origin
+	}(defaultRecoverConcurrency)
+	defaultRecoverConcurrency = 2
+	c.Assert(r.verifyRelaySubDirs(ctx, newParser), IsNil)
+	// previous sub directories are truncated, the current one is left to tryRecoverLatestFile.
+	for i, subDir := range subDirs {
+		fs, err2 := os.Stat(filepath.Join(relayCfg.RelayDir, subDir, filename))
+		c.Assert(err2, IsNil)
+		if i == len(subDirs)-1 {
+			c.Assert(fs.Size(), Equals, int64(len(invalidData)))
+		} else {
+			c.Assert(fs.Size(), Equals, int64(len(data)))
+		}
+	}
+
+	// error from creating parser.
+	c.Assert(r.verifyRelaySubDirs(ctx, func() (*parser.Parser, error) {
+		return nil, errors.New("create parser error")
+	}), ErrorMatches, "create parser error")
+}
@@ -61,7 +61,9 @@ func (rr *ReaderRetry) Check(ctx context.Context, err error) bool {
 	failpoint.Inject("RelayAllowRetry", func() {
 		failpoint.Return(true)
 	})
-	if !retry.IsConnectionError(err) {
+	// a corrupted event is rejected by the relay writer, retry to request it again.
+	// an idle connection is suspect, retry to reconnect it.
+	if !retry.IsConnectionError(err) && !terror.ErrRelayWriterEventCorrupted.Equal(err) && !terror.ErrRelayReaderIdle.Equal(err) {
 		return false
 	}
 
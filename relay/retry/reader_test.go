@@ -21,6 +21,8 @@ import (
 
 	gmysql "github.com/go-mysql-org/go-mysql/mysql"
 	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/pkg/terror"
 )
 
 func TestSuite(t *testing.T) {
@@ -67,6 +69,10 @@ func (t *testReaderRetrySuite) TestRetry(c *C) {
 	// check un-retryable error
 	c.Assert(rr.Check(ctx, unRetryableErr), IsFalse)
 
+	// corrupted events rejected by the writer are retryable
+	c.Assert(rr.Check(ctx, terror.ErrRelayWriterEventCorrupted.Generate(nil, "checksum mismatch")), IsTrue)
+	c.Assert(rr.Check(ctx, terror.ErrRelayReaderIdle.Generate(time.Minute)), IsTrue)
+
 	// check with context timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Millisecond)
 	defer cancel()
@@ -0,0 +1,228 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transformer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"strings"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/log"
+)
+
+// names of the builtin plugins.
+const (
+	PluginChecksum  = "checksum"
+	PluginFilter    = "filter"
+	PluginAnonymize = "anonymize"
+	PluginAnnotate  = "annotate"
+)
+
+const (
+	paramEventTypes = "event-types"
+	paramMask       = "mask"
+	paramLabel      = "label"
+
+	defaultMask = '*'
+
+	// the post-header of QUERY_EVENT: thread ID (4), execution time (4), schema length (1), error code (2),
+	// status variables length (2).
+	queryEventPostHeaderLen = 13
+)
+
+func init() {
+	RegisterPlugin(PluginChecksum, newChecksumPlugin)
+	RegisterPlugin(PluginFilter, newFilterPlugin)
+	RegisterPlugin(PluginAnonymize, newAnonymizePlugin)
+	RegisterPlugin(PluginAnnotate, newAnnotatePlugin)
+}
+
+// parseEventTypes parses the event types separated by comma, the names are the same as `replication.EventType.String`,
+// like `QueryEvent`, `WriteRowsEventV2`.
+func parseEventTypes(s string) (map[replication.EventType]struct{}, error) {
+	known := make(map[string]replication.EventType)
+	for i := 0; i <= 0xff; i++ {
+		tp := replication.EventType(i)
+		if _, ok := known[tp.String()]; !ok {
+			known[tp.String()] = tp
+		}
+	}
+
+	types := make(map[replication.EventType]struct{})
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		tp, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown event type %s", name)
+		}
+		types[tp] = struct{}{}
+	}
+	return types, nil
+}
+
+// checksumPlugin validates the CRC32 checksum of the events, to find the corrupted events before writing them into the
+// relay log. nothing is checked if the checksum is disabled in the upstream.
+type checksumPlugin struct{}
+
+func newChecksumPlugin(map[string]string) (Plugin, error) {
+	return checksumPlugin{}, nil
+}
+
+// Transform implements Plugin.Transform.
+func (checksumPlugin) Transform(e *replication.BinlogEvent, checksumAlg byte) (string, error) {
+	if checksumAlg != replication.BINLOG_CHECKSUM_ALG_CRC32 {
+		return "", nil
+	}
+	dataLen := len(e.RawData) - replication.BinlogChecksumLength
+	if dataLen < replication.EventHeaderSize {
+		return "", fmt.Errorf("the event length %d is too short", len(e.RawData))
+	}
+	expected := binary.LittleEndian.Uint32(e.RawData[dataLen:])
+	if computed := crc32.ChecksumIEEE(e.RawData[:dataLen]); computed != expected {
+		return "", fmt.Errorf("checksum mismatch, expected %d, computed %d", expected, computed)
+	}
+	return "", nil
+}
+
+// filterPlugin ignores the events of the types in `event-types`, the ignored events are not written into the relay log.
+type filterPlugin struct {
+	types map[replication.EventType]struct{}
+}
+
+func newFilterPlugin(params map[string]string) (Plugin, error) {
+	types, err := parseEventTypes(params[paramEventTypes])
+	if err != nil {
+		return nil, err
+	}
+	if len(types) == 0 {
+		return nil, fmt.Errorf("%s is required", paramEventTypes)
+	}
+	return &filterPlugin{types: types}, nil
+}
+
+// Transform implements Plugin.Transform.
+func (p *filterPlugin) Transform(e *replication.BinlogEvent, _ byte) (string, error) {
+	if _, ok := p.types[e.Header.EventType]; ok {
+		return fmt.Sprintf("event type %s filtered", e.Header.EventType), nil
+	}
+	return "", nil
+}
+
+// anonymizePlugin masks the string literals in the statements of QUERY_EVENT in place, the length of the event is kept.
+// NOTE: the values in the rows events are not masked.
+type anonymizePlugin struct {
+	mask byte
+}
+
+func newAnonymizePlugin(params map[string]string) (Plugin, error) {
+	p := &anonymizePlugin{mask: defaultMask}
+	if mask, ok := params[paramMask]; ok {
+		if len(mask) != 1 || mask[0] == '\'' || mask[0] == '"' || mask[0] == '\\' || mask[0] >= 0x80 {
+			return nil, fmt.Errorf("%s should be a single ASCII character except quotes and backslash, but got %q", paramMask, mask)
+		}
+		p.mask = mask[0]
+	}
+	return p, nil
+}
+
+// Transform implements Plugin.Transform.
+func (p *anonymizePlugin) Transform(e *replication.BinlogEvent, checksumAlg byte) (string, error) {
+	if e.Header.EventType != replication.QUERY_EVENT {
+		return "", nil
+	}
+	body, err := eventBody(e.RawData, checksumAlg)
+	if err != nil {
+		return "", err
+	}
+	if len(body) < queryEventPostHeaderLen {
+		return "", fmt.Errorf("the query event body length %d is too short", len(body))
+	}
+	schemaLen := int(body[8])
+	statusLen := int(binary.LittleEndian.Uint16(body[11:queryEventPostHeaderLen]))
+	start := queryEventPostHeaderLen + statusLen + schemaLen + 1 // the schema is terminated by 0x00
+	if start > len(body) {
+		return "", fmt.Errorf("the query event body length %d is too short for the query starts at %d", len(body), start)
+	}
+	maskQuotedLiterals(body[start:], p.mask)
+	return "", nil
+}
+
+// maskQuotedLiterals replaces the characters of the string literals quoted by ' or " in the query with mask, the quotes
+// are kept. backslash escapes and doubled quotes are handled, the identifiers quoted by ` are kept.
+func maskQuotedLiterals(query []byte, mask byte) {
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote == 0:
+			if c == '\'' || c == '"' || c == '`' {
+				quote = c
+			}
+		case quote == '`':
+			if c == '`' {
+				quote = 0
+			}
+		case c == '\\' && i+1 < len(query):
+			query[i], query[i+1] = mask, mask
+			i++
+		case c == quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				query[i], query[i+1] = mask, mask
+				i++
+			} else {
+				quote = 0
+			}
+		default:
+			query[i] = mask
+		}
+	}
+}
+
+// annotatePlugin logs and counts the events of the types in `event-types` (all events if not specified) with `label`,
+// the events are not changed.
+type annotatePlugin struct {
+	label string
+	types map[replication.EventType]struct{}
+}
+
+func newAnnotatePlugin(params map[string]string) (Plugin, error) {
+	types, err := parseEventTypes(params[paramEventTypes])
+	if err != nil {
+		return nil, err
+	}
+	label := params[paramLabel]
+	if label == "" {
+		label = PluginAnnotate
+	}
+	return &annotatePlugin{label: label, types: types}, nil
+}
+
+// Transform implements Plugin.Transform.
+func (p *annotatePlugin) Transform(e *replication.BinlogEvent, _ byte) (string, error) {
+	if len(p.types) > 0 {
+		if _, ok := p.types[e.Header.EventType]; !ok {
+			return "", nil
+		}
+	}
+	transformerAnnotateCounter.WithLabelValues(p.label, e.Header.EventType.String()).Inc()
+	log.L().Info("annotate relay log event", zap.String("label", p.label), zap.Reflect("header", e.Header))
+	return "", nil
+}
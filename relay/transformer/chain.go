@@ -0,0 +1,193 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transformer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/parser"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// Plugin is a pluggable transformer in the chain of a source, it runs after the builtin transformer for the events not
+// ignored by it, except the FormatDescriptionEvent and RotateEvent which the relay log files depend on.
+// the plugin can change the RawData of the event in place, but the length must be kept, because the positions of the
+// following events in the relay log file should be the same as the upstream. the checksum is updated by the chain.
+type Plugin interface {
+	// Transform transforms the event, it returns a non-empty reason if the event should be ignored.
+	Transform(e *replication.BinlogEvent, checksumAlg byte) (ignoreReason string, err error)
+}
+
+// PluginFactory creates a Plugin with the params in the source config.
+type PluginFactory func(params map[string]string) (Plugin, error)
+
+var (
+	pluginsMu sync.RWMutex
+	plugins   = make(map[string]PluginFactory)
+)
+
+// RegisterPlugin registers the factory of a Plugin by name, so it can be used in `relay-transformers` of the source
+// config. it should be called in `init` of the package defining the plugin, the plugin with the same name is replaced.
+func RegisterPlugin(name string, factory PluginFactory) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+	plugins[name] = factory
+}
+
+// RegisteredPlugins returns the names of the registered plugins in order.
+func RegisteredPlugins() []string {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	return pluginNames()
+}
+
+// pluginNames returns the names of the registered plugins in order, pluginsMu should be held.
+func pluginNames() []string {
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// chainedPlugin is a Plugin in the chain with its config.
+type chainedPlugin struct {
+	name    string
+	onError string
+	plugin  Plugin
+}
+
+// chain runs the builtin transformer and the plugins in order.
+type chain struct {
+	base        Transformer
+	plugins     []chainedPlugin
+	checksumAlg byte
+}
+
+// NewChain creates a Transformer which runs the builtin transformer and then the plugins configured in order.
+func NewChain(parser2 *parser.Parser, cfgs []config.RelayTransformerConfig) (Transformer, error) {
+	base := NewTransformer(parser2)
+	if len(cfgs) == 0 {
+		return base, nil
+	}
+
+	c := &chain{base: base, plugins: make([]chainedPlugin, 0, len(cfgs))}
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	for _, cfg := range cfgs {
+		factory, ok := plugins[cfg.Name]
+		if !ok {
+			return nil, terror.ErrRelayTransformerNotFound.Generate(fmt.Sprintf("%s (registered: %s)", cfg.Name, strings.Join(pluginNames(), ", ")))
+		}
+		plugin, err := factory(cfg.Params)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidRelayTransformer.Delegate(err, cfg.Name)
+		}
+		c.plugins = append(c.plugins, chainedPlugin{name: cfg.Name, onError: cfg.OnError, plugin: plugin})
+	}
+	return c, nil
+}
+
+// Transform implements Transformer.Transform.
+func (c *chain) Transform(e *replication.BinlogEvent) Result {
+	result := c.base.Transform(e)
+	if fde, ok := e.Event.(*replication.FormatDescriptionEvent); ok {
+		c.checksumAlg = fde.ChecksumAlgorithm
+		return result
+	}
+	if result.Ignore || e.Header.EventType == replication.ROTATE_EVENT {
+		return result
+	}
+
+	for _, p := range c.plugins {
+		reason, err := c.runPlugin(p, e)
+		if err != nil {
+			transformerErrorCounter.WithLabelValues(p.name).Inc()
+			if p.onError == config.RelayTransformerOnErrorFail {
+				result.Err = terror.ErrRelayTransformerFailed.Delegate(err, p.name, e.Header.LogPos)
+				return result
+			}
+			log.L().Warn("relay transformer fails, pass the event through unchanged by it", zap.String("transformer", p.name),
+				zap.Reflect("header", e.Header), log.ShortError(err))
+			continue
+		}
+		if reason != "" {
+			transformerIgnoreCounter.WithLabelValues(p.name).Inc()
+			result.Ignore = true
+			result.IgnoreReason = fmt.Sprintf("%s by relay transformer %s", reason, p.name)
+			return result
+		}
+	}
+	return result
+}
+
+// runPlugin runs the plugin on the event, the RawData of the event is restored if the plugin fails or panics, so the
+// failure of a plugin doesn't affect the other plugins and the event.
+func (c *chain) runPlugin(p chainedPlugin, e *replication.BinlogEvent) (reason string, err error) {
+	origin := append([]byte(nil), e.RawData...)
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+		transformerDurationHistogram.WithLabelValues(p.name).Observe(time.Since(start).Seconds())
+		if err != nil {
+			e.RawData = origin
+		}
+	}()
+
+	reason, err = p.plugin.Transform(e, c.checksumAlg)
+	if err != nil || bytes.Equal(origin, e.RawData) {
+		return reason, err
+	}
+	if len(e.RawData) != len(origin) {
+		return "", fmt.Errorf("the event length is changed from %d to %d", len(origin), len(e.RawData))
+	}
+	updateChecksum(e.RawData, c.checksumAlg)
+	return reason, nil
+}
+
+// eventBody returns the body of the event, without the header and the checksum.
+func eventBody(rawData []byte, checksumAlg byte) ([]byte, error) {
+	end := len(rawData)
+	if checksumAlg == replication.BINLOG_CHECKSUM_ALG_CRC32 {
+		end -= replication.BinlogChecksumLength
+	}
+	if end < replication.EventHeaderSize {
+		return nil, fmt.Errorf("the event length %d is too short", len(rawData))
+	}
+	return rawData[replication.EventHeaderSize:end], nil
+}
+
+// updateChecksum updates the CRC32 checksum at the end of the event.
+func updateChecksum(rawData []byte, checksumAlg byte) {
+	if checksumAlg != replication.BINLOG_CHECKSUM_ALG_CRC32 || len(rawData) < replication.BinlogChecksumLength {
+		return
+	}
+	dataLen := len(rawData) - replication.BinlogChecksumLength
+	binary.LittleEndian.PutUint32(rawData[dataLen:], crc32.ChecksumIEEE(rawData[:dataLen]))
+}
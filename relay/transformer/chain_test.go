@@ -0,0 +1,156 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transformer
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog/event"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// mockPlugin runs fn on the events.
+type mockPlugin struct {
+	fn func(e *replication.BinlogEvent) (string, error)
+}
+
+func (p *mockPlugin) Transform(e *replication.BinlogEvent, _ byte) (string, error) {
+	return p.fn(e)
+}
+
+func (t *testTransformerSuite) TestMaskQuotedLiterals(c *check.C) {
+	cases := []struct {
+		query  string
+		masked string
+	}{
+		{"INSERT INTO t VALUES (1, 'abc')", "INSERT INTO t VALUES (1, '***')"},
+		{`UPDATE t SET a = "x'y" WHERE b = 'it''s'`, `UPDATE t SET a = "***" WHERE b = '*****'`},
+		{`INSERT INTO t VALUES ('a\'b', 'c')`, `INSERT INTO t VALUES ('****', '*')`},
+		{"INSERT INTO `t'1` VALUES ('')", "INSERT INTO `t'1` VALUES ('')"},
+		{"CREATE TABLE t (id INT)", "CREATE TABLE t (id INT)"},
+	}
+	for _, cs := range cases {
+		query := []byte(cs.query)
+		maskQuotedLiterals(query, defaultMask)
+		c.Assert(string(query), check.Equals, cs.masked)
+	}
+}
+
+func (t *testTransformerSuite) TestChain(c *check.C) {
+	header := &replication.EventHeader{ServerID: 11}
+	fde, err := event.GenFormatDescriptionEvent(header, 4)
+	c.Assert(err, check.IsNil)
+	genQuery := func(query string) *replication.BinlogEvent {
+		ev, err2 := event.GenQueryEvent(header, 456, 0, 0, 0, nil, []byte("db"), []byte(query))
+		c.Assert(err2, check.IsNil)
+		return ev
+	}
+	genXID := func() *replication.BinlogEvent {
+		ev, err2 := event.GenXIDEvent(header, 456, 1)
+		c.Assert(err2, check.IsNil)
+		return ev
+	}
+	checksum, err := newChecksumPlugin(nil)
+	c.Assert(err, check.IsNil)
+
+	// no transformers configured.
+	tran, err := NewChain(parser.New(), nil)
+	c.Assert(err, check.IsNil)
+	_, ok := tran.(*chain)
+	c.Assert(ok, check.IsFalse)
+
+	// unknown transformer and invalid params.
+	_, err = NewChain(parser.New(), []config.RelayTransformerConfig{{Name: "unknown"}})
+	c.Assert(terror.ErrRelayTransformerNotFound.Equal(err), check.IsTrue)
+	_, err = NewChain(parser.New(), []config.RelayTransformerConfig{{Name: PluginFilter}})
+	c.Assert(terror.ErrConfigInvalidRelayTransformer.Equal(err), check.IsTrue)
+	_, err = NewChain(parser.New(), []config.RelayTransformerConfig{{Name: PluginFilter, Params: map[string]string{paramEventTypes: "NoSuchEvent"}}})
+	c.Assert(terror.ErrConfigInvalidRelayTransformer.Equal(err), check.IsTrue)
+	_, err = NewChain(parser.New(), []config.RelayTransformerConfig{{Name: PluginAnonymize, Params: map[string]string{paramMask: "'"}}})
+	c.Assert(terror.ErrConfigInvalidRelayTransformer.Equal(err), check.IsTrue)
+
+	tran, err = NewChain(parser.New(), []config.RelayTransformerConfig{
+		{Name: PluginChecksum, OnError: config.RelayTransformerOnErrorFail},
+		{Name: PluginAnonymize, Params: map[string]string{paramMask: "#"}},
+		{Name: PluginFilter, Params: map[string]string{paramEventTypes: "XIDEvent"}},
+		{Name: PluginAnnotate, Params: map[string]string{paramLabel: "test", paramEventTypes: "QueryEvent"}},
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(tran.Transform(fde).Ignore, check.IsFalse)
+
+	// the string literals are masked with the length kept and the checksum updated.
+	ev := genQuery("INSERT INTO t VALUES (1, 'secret')")
+	size := len(ev.RawData)
+	result := tran.Transform(ev)
+	c.Assert(result.Err, check.IsNil)
+	c.Assert(result.Ignore, check.IsFalse)
+	c.Assert(ev.RawData, check.HasLen, size)
+	c.Assert(bytes.Contains(ev.RawData, []byte("'######'")), check.IsTrue)
+	_, err = checksum.Transform(ev, replication.BINLOG_CHECKSUM_ALG_CRC32)
+	c.Assert(err, check.IsNil)
+
+	// filtered by type.
+	result = tran.Transform(genXID())
+	c.Assert(result.Ignore, check.IsTrue)
+	c.Assert(result.IgnoreReason, check.Matches, ".*by relay transformer filter")
+
+	// corrupted event fails the chain with `on-error: fail`.
+	ev = genQuery("INSERT INTO t VALUES (1, 'secret')")
+	ev.RawData[len(ev.RawData)-1]++
+	result = tran.Transform(ev)
+	c.Assert(terror.ErrRelayTransformerFailed.Equal(result.Err), check.IsTrue)
+
+	// the failed and panicked plugins with `on-error: skip` don't change the event.
+	RegisterPlugin("mock-fail", func(map[string]string) (Plugin, error) {
+		return &mockPlugin{fn: func(e *replication.BinlogEvent) (string, error) {
+			e.RawData[len(e.RawData)-5] = 'x'
+			return "", errors.New("mock error")
+		}}, nil
+	})
+	RegisterPlugin("mock-panic", func(map[string]string) (Plugin, error) {
+		return &mockPlugin{fn: func(e *replication.BinlogEvent) (string, error) {
+			e.RawData = e.RawData[:1]
+			panic("mock panic")
+		}}, nil
+	})
+	RegisterPlugin("mock-resize", func(map[string]string) (Plugin, error) {
+		return &mockPlugin{fn: func(e *replication.BinlogEvent) (string, error) {
+			e.RawData = append(e.RawData, 0)
+			return "", nil
+		}}, nil
+	})
+	c.Assert(RegisteredPlugins(), check.DeepEquals, []string{
+		PluginAnnotate, PluginAnonymize, PluginChecksum, PluginFilter, "mock-fail", "mock-panic", "mock-resize",
+	})
+	tran, err = NewChain(parser.New(), []config.RelayTransformerConfig{
+		{Name: "mock-fail", OnError: config.RelayTransformerOnErrorSkip},
+		{Name: "mock-panic", OnError: config.RelayTransformerOnErrorSkip},
+		{Name: "mock-resize", OnError: config.RelayTransformerOnErrorSkip},
+		{Name: PluginChecksum, OnError: config.RelayTransformerOnErrorFail},
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(tran.Transform(fde).Ignore, check.IsFalse)
+	ev = genQuery("INSERT INTO t VALUES (1, 'secret')")
+	origin := append([]byte(nil), ev.RawData...)
+	result = tran.Transform(ev)
+	c.Assert(result.Err, check.IsNil)
+	c.Assert(result.Ignore, check.IsFalse)
+	c.Assert(ev.RawData, check.DeepEquals, origin)
+}
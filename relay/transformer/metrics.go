@@ -0,0 +1,62 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transformer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	transformerDurationHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "transformer_duration",
+			Help:      "bucketed histogram of transform time (s) of single binlog event by the relay transformer",
+			Buckets:   prometheus.ExponentialBuckets(0.000005, 2, 25),
+		}, []string{"transformer"})
+
+	transformerIgnoreCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "transformer_ignore_count",
+			Help:      "counter of binlog events ignored by the relay transformer",
+		}, []string{"transformer"})
+
+	// should alert.
+	transformerErrorCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "transformer_error_count",
+			Help:      "counter of binlog events the relay transformer fails to transform",
+		}, []string{"transformer"})
+
+	transformerAnnotateCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "relay",
+			Name:      "transformer_annotate_count",
+			Help:      "counter of binlog events annotated by the relay transformer",
+		}, []string{"label", "type"})
+)
+
+// RegisterMetrics registers metrics.
+func RegisterMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(transformerDurationHistogram)
+	registry.MustRegister(transformerIgnoreCounter)
+	registry.MustRegister(transformerErrorCounter)
+	registry.MustRegister(transformerAnnotateCounter)
+}
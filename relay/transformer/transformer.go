@@ -34,6 +34,7 @@ type Result struct {
 	NextLogName  string        // next binlog filename, only valid for RotateEvent
 	GTIDSet      mysql.GTIDSet // GTIDSet got from QueryEvent and XIDEvent when RawModeEnabled not true
 	CanSaveGTID  bool          // whether can save GTID into meta, true for DDL query and XIDEvent
+	Err          error         // the error of a transformer which fails with `on-error: fail`, the relay should stop
 }
 
 // Transformer receives binlog events from a reader and transforms them.
@@ -87,6 +88,9 @@ func (t *transformer) Transform(e *replication.BinlogEvent) Result {
 		// when RawModeEnabled not true, XIDEvent will be parsed.
 		result.GTIDSet = ev.GSet
 		result.CanSaveGTID = true // need save GTID for XID
+	case *replication.BeginLoadQueryEvent, *replication.ExecuteLoadQueryEvent:
+		// LOAD DATA statement in statement format, the syncer needs both the loaded file and the statement
+		// to replicate it, so they are always kept.
 	case *replication.GenericEvent:
 		// handle some un-parsed events
 		if e.Header.EventType == replication.HEARTBEAT_EVENT {
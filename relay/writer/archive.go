@@ -0,0 +1,338 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/streamer"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+const (
+	// ArchiveAckFilename is the name of the file which records the acknowledged position of the archiver.
+	ArchiveAckFilename = "relay.archive"
+
+	defaultArchiveQueueSize     = 1024
+	defaultArchiveRetryInterval = 3 * time.Second
+	archiveAckSaveInterval      = time.Second
+)
+
+// Archiver archives binlog events to a remote endpoint.
+type Archiver interface {
+	// Archive archives an event of the relay log file, the event is acknowledged if no error returned.
+	// the same event may be archived more than once after failures.
+	Archive(ctx context.Context, filename string, ev *replication.BinlogEvent) error
+	// Close closes the archiver.
+	Close() error
+}
+
+// ArchiveConfig is the configuration used by the ArchiveWriter.
+type ArchiveConfig struct {
+	RelayDir      string        // directory to store relay log files.
+	Filename      string        // the startup relay log filename, same as FileConfig.Filename.
+	QueueSize     int           // max events waiting for archiving in memory.
+	RetryInterval time.Duration // interval to retry a failed archiving.
+}
+
+type archiveItem struct {
+	filename string
+	ev       *replication.BinlogEvent
+}
+
+// archiveAck is the acknowledged position persisted in the relay directory.
+type archiveAck struct {
+	BinLogName string `toml:"binlog-name" json:"binlog-name"`
+	BinLogPos  uint32 `toml:"binlog-pos" json:"binlog-pos"`
+}
+
+// ArchiveWriter implements Writer interface.
+// it writes binlog events by the underlying writer, and archives the written events by an Archiver asynchronously.
+// the archiving never blocks the underlying writer, events dropped when the queue is full or not archived before
+// closing are archived from the relay log files later, and events already acknowledged are never archived again.
+type ArchiveWriter struct {
+	Writer
+
+	cfg      *ArchiveConfig
+	archiver Archiver
+
+	filename atomic.String // current relay log filename
+	queue    chan archiveItem
+	// whether some events need to be archived from the relay log files.
+	lagging atomic.Bool
+
+	ackMu     sync.RWMutex
+	acked     gmysql.Position
+	ackSaved  gmysql.Position
+	lastSaved time.Time
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	logger log.Logger
+}
+
+// NewArchiveWriter creates an ArchiveWriter instance which wraps the underlying writer.
+func NewArchiveWriter(logger log.Logger, cfg *ArchiveConfig, w Writer, archiver Archiver) *ArchiveWriter {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultArchiveQueueSize
+	}
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = defaultArchiveRetryInterval
+	}
+	aw := &ArchiveWriter{
+		Writer:   w,
+		cfg:      cfg,
+		archiver: archiver,
+		queue:    make(chan archiveItem, cfg.QueueSize),
+		logger:   logger.WithFields(zap.String("sub component", "relay archive writer")),
+	}
+	aw.filename.Store(cfg.Filename)
+	return aw
+}
+
+// Start implements Writer.Start.
+func (w *ArchiveWriter) Start() error {
+	if err := w.Writer.Start(); err != nil {
+		return err
+	}
+	if err := w.loadAck(); err != nil {
+		return err
+	}
+	w.logger.Info("start archiving relay log", zap.Stringer("acknowledged position", w.Acked()))
+
+	w.ctx, w.cancel = context.WithCancel(context.Background())
+	w.wg.Add(1)
+	go w.run()
+	return nil
+}
+
+// Close implements Writer.Close.
+// the events not archived yet are dropped, they will be archived from the relay log files after restarted.
+func (w *ArchiveWriter) Close() error {
+	if w.cancel != nil {
+		w.cancel()
+		w.wg.Wait()
+		if err := w.saveAck(true); err != nil {
+			w.logger.Error("fail to save acknowledged position", zap.Error(err))
+		}
+		if err := w.archiver.Close(); err != nil {
+			w.logger.Error("fail to close archiver", zap.Error(err))
+		}
+	}
+	return w.Writer.Close()
+}
+
+// WriteEvent implements Writer.WriteEvent.
+func (w *ArchiveWriter) WriteEvent(ev *replication.BinlogEvent) (Result, error) {
+	res, err := w.Writer.WriteEvent(ev)
+	if err != nil {
+		return res, err
+	}
+
+	filename := w.filename.Load()
+	// track the filename in the same way as FileWriter, the RotateEvent belongs to the previous file.
+	if rotateEv, ok := ev.Event.(*replication.RotateEvent); ok {
+		nextFile := string(rotateEv.NextLogName)
+		if gmysql.CompareBinlogFileName(nextFile, filename) == 1 {
+			w.filename.Store(nextFile)
+		}
+	}
+	if res.Ignore || filename == "" {
+		return res, nil
+	}
+
+	select {
+	case w.queue <- archiveItem{filename: filename, ev: ev}:
+	default:
+		if !w.lagging.Swap(true) {
+			w.logger.Warn("archive queue is full, the events will be archived from relay log files later",
+				zap.String("file", filename), zap.Uint32("position", ev.Header.LogPos))
+		}
+	}
+	return res, nil
+}
+
+// Acked returns the position of the last acknowledged event.
+func (w *ArchiveWriter) Acked() gmysql.Position {
+	w.ackMu.RLock()
+	defer w.ackMu.RUnlock()
+	return w.acked
+}
+
+func (w *ArchiveWriter) run() {
+	defer w.wg.Done()
+
+	for {
+		if w.lagging.Swap(false) {
+			if err := w.archiveFromFiles(); err != nil {
+				if w.ctx.Err() != nil {
+					return
+				}
+				w.logger.Warn("fail to archive events from relay log files, will retry", zap.Error(err))
+				w.lagging.Store(true)
+				select {
+				case <-w.ctx.Done():
+					return
+				case <-time.After(w.cfg.RetryInterval):
+				}
+				continue
+			}
+		}
+
+		select {
+		case <-w.ctx.Done():
+			return
+		case item := <-w.queue:
+			if err := w.archive(item.filename, item.ev); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// archive archives an event until succeed, it only returns an error when the writer is closed.
+func (w *ArchiveWriter) archive(filename string, ev *replication.BinlogEvent) error {
+	pos := gmysql.Position{Name: filename, Pos: ev.Header.LogPos}
+	if pos.Compare(w.Acked()) <= 0 {
+		return nil
+	}
+
+	for {
+		err := w.archiver.Archive(w.ctx, filename, ev)
+		if err == nil {
+			break
+		}
+		if w.ctx.Err() != nil {
+			return w.ctx.Err()
+		}
+		w.logger.Warn("fail to archive binlog event, will retry", zap.Stringer("position", pos), zap.Error(err))
+		select {
+		case <-w.ctx.Done():
+			return w.ctx.Err()
+		case <-time.After(w.cfg.RetryInterval):
+		}
+	}
+
+	w.ackMu.Lock()
+	w.acked = pos
+	w.ackMu.Unlock()
+	if err := w.saveAck(false); err != nil {
+		w.logger.Warn("fail to save acknowledged position", zap.Stringer("position", pos), zap.Error(err))
+	}
+	return nil
+}
+
+// archiveFromFiles archives the events after the acknowledged position from the relay log files.
+func (w *ArchiveWriter) archiveFromFiles() error {
+	acked := w.Acked()
+	var (
+		files []string
+		err   error
+	)
+	if acked.Name == "" {
+		files, err = streamer.CollectAllBinlogFiles(w.cfg.RelayDir)
+	} else {
+		files, err = streamer.CollectBinlogFilesCmp(w.cfg.RelayDir, acked.Name, streamer.FileCmpBiggerEqual)
+	}
+	if err != nil {
+		return err
+	}
+
+	parser := replication.NewBinlogParser()
+	parser.SetRawMode(true)
+	parser.SetVerifyChecksum(false)
+	for _, file := range files {
+		var offset int64
+		if file == acked.Name {
+			offset = int64(acked.Pos)
+		}
+		w.logger.Info("archive events from relay log file", zap.String("file", file), zap.Int64("offset", offset))
+		err = streamer.ParseRelayFile(parser, w.cfg.RelayDir, file, offset, func(ev *replication.BinlogEvent) error {
+			return w.archive(file, ev)
+		})
+		if err != nil {
+			// NOTE: the last event of the last file may be partially written now, it will be archived in the next retry.
+			return terror.Annotatef(err, "archive events from relay log file %s", file)
+		}
+	}
+	return nil
+}
+
+// loadAck loads the acknowledged position from the relay directory. if not exists, only the events written after now
+// are archived.
+func (w *ArchiveWriter) loadAck() error {
+	filename := filepath.Join(w.cfg.RelayDir, ArchiveAckFilename)
+	var ack archiveAck
+	if utils.IsFileExists(filename) {
+		if _, err := toml.DecodeFile(filename, &ack); err != nil {
+			return terror.ErrRelayLoadMetaData.Delegate(err)
+		}
+		w.lagging.Store(true)
+	} else {
+		files, err := streamer.CollectAllBinlogFiles(w.cfg.RelayDir)
+		if err != nil {
+			return err
+		}
+		if len(files) > 0 {
+			ack.BinLogName = files[len(files)-1]
+			end, err := streamer.RelayFileEnd(w.cfg.RelayDir, ack.BinLogName)
+			if err != nil {
+				return terror.ErrRelayLoadMetaData.Delegate(err)
+			}
+			ack.BinLogPos = uint32(end)
+		}
+	}
+
+	w.ackMu.Lock()
+	defer w.ackMu.Unlock()
+	w.acked = gmysql.Position{Name: ack.BinLogName, Pos: ack.BinLogPos}
+	w.ackSaved = w.acked
+	return nil
+}
+
+// saveAck saves the acknowledged position into the relay directory, it's throttled unless force is true.
+func (w *ArchiveWriter) saveAck(force bool) error {
+	w.ackMu.Lock()
+	defer w.ackMu.Unlock()
+	if w.acked.Compare(w.ackSaved) == 0 || (!force && time.Since(w.lastSaved) < archiveAckSaveInterval) {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	err := toml.NewEncoder(&buf).Encode(archiveAck{BinLogName: w.acked.Name, BinLogPos: w.acked.Pos})
+	if err != nil {
+		return terror.ErrRelayFlushLocalMeta.Delegate(err)
+	}
+	err = utils.WriteFileAtomic(filepath.Join(w.cfg.RelayDir, ArchiveAckFilename), buf.Bytes(), 0o644)
+	if err != nil {
+		return terror.ErrRelayFlushLocalMeta.Delegate(err)
+	}
+	w.ackSaved = w.acked
+	w.lastSaved = time.Now()
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/check"
+
+	"github.com/pingcap/dm/pkg/binlog/event"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// mockArchiver records the archived events, and fails to archive when `fail` is true.
+type mockArchiver struct {
+	mu        sync.Mutex
+	fail      bool
+	positions []gmysql.Position
+	closed    bool
+}
+
+func (a *mockArchiver) Archive(_ context.Context, filename string, ev *replication.BinlogEvent) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.fail {
+		return errors.New("mock archive error")
+	}
+	a.positions = append(a.positions, gmysql.Position{Name: filename, Pos: ev.Header.LogPos})
+	return nil
+}
+
+func (a *mockArchiver) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.closed = true
+	return nil
+}
+
+func (a *mockArchiver) setFail(fail bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.fail = fail
+}
+
+func (a *mockArchiver) archived() []gmysql.Position {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]gmysql.Position(nil), a.positions...)
+}
+
+func (t *testFileWriterSuite) TestArchiveWriter(c *check.C) {
+	var (
+		relayDir  = c.MkDir()
+		filename  = "test-mysql-bin.000001"
+		header    = &replication.EventHeader{Timestamp: uint32(time.Now().Unix()), ServerID: 11}
+		latestPos uint32
+		expected  []gmysql.Position
+	)
+	formatDescEv, err := event.GenFormatDescriptionEvent(header, 4)
+	c.Assert(err, check.IsNil)
+	newWriter := func(archiver Archiver, queueSize int) *ArchiveWriter {
+		cfg := &ArchiveConfig{RelayDir: relayDir, Filename: filename, QueueSize: queueSize, RetryInterval: 10 * time.Millisecond}
+		w := NewArchiveWriter(log.L(), cfg, NewFileWriter(log.L(), &FileConfig{RelayDir: relayDir, Filename: filename}, t.parser), archiver)
+		c.Assert(w.Start(), check.IsNil)
+		// the FormatDescriptionEvent already exists in the relay log file, it is ignored and not archived.
+		res, err2 := w.WriteEvent(formatDescEv)
+		c.Assert(err2, check.IsNil)
+		c.Assert(res.Ignore, check.IsTrue)
+		return w
+	}
+	writeQueries := func(w Writer, count int) {
+		for i := 0; i < count; i++ {
+			ev, err := event.GenQueryEvent(header, latestPos, 0, 0, 0, nil, []byte("db"), []byte("BEGIN"))
+			c.Assert(err, check.IsNil)
+			res, err := w.WriteEvent(ev)
+			c.Assert(err, check.IsNil)
+			c.Assert(res.Ignore, check.IsFalse)
+			latestPos = ev.Header.LogPos
+			expected = append(expected, gmysql.Position{Name: filename, Pos: latestPos})
+		}
+	}
+	waitArchived := func(a *mockArchiver, w *ArchiveWriter, count int) {
+		c.Assert(utils.WaitSomething(100, 10*time.Millisecond, func() bool {
+			return len(a.archived()) >= count
+		}), check.IsTrue)
+		c.Assert(w.Acked(), check.DeepEquals, expected[len(expected)-1])
+	}
+
+	// write events before archiving is enabled, they are not archived.
+	fw := NewFileWriter(log.L(), &FileConfig{RelayDir: relayDir, Filename: filename}, t.parser)
+	c.Assert(fw.Start(), check.IsNil)
+	_, err = fw.WriteEvent(formatDescEv)
+	c.Assert(err, check.IsNil)
+	latestPos = formatDescEv.Header.LogPos
+	writeQueries(fw, 2)
+	c.Assert(fw.Close(), check.IsNil)
+	expected = nil
+
+	// only the events written after enabled are archived.
+	a1 := &mockArchiver{}
+	w1 := newWriter(a1, 0)
+	c.Assert(w1.Acked(), check.DeepEquals, gmysql.Position{Name: filename, Pos: latestPos})
+	writeQueries(w1, 3)
+	waitArchived(a1, w1, 3)
+	c.Assert(a1.archived(), check.DeepEquals, expected)
+	c.Assert(w1.Close(), check.IsNil)
+	c.Assert(a1.closed, check.IsTrue)
+	c.Assert(utils.IsFileExists(filepath.Join(relayDir, ArchiveAckFilename)), check.IsTrue)
+
+	// events dropped when the archiver is failing are archived from the relay log file in order.
+	a2 := &mockArchiver{fail: true}
+	w2 := newWriter(a2, 1)
+	c.Assert(w2.Acked(), check.DeepEquals, expected[len(expected)-1])
+	expected = nil
+	writeQueries(w2, 10)
+	a2.setFail(false)
+	waitArchived(a2, w2, 10)
+	c.Assert(a2.archived(), check.DeepEquals, expected)
+
+	// a rotate event switches to the next file.
+	nextFilename := "test-mysql-bin.000002"
+	rotateEv, err := event.GenRotateEvent(header, latestPos, []byte(nextFilename), 4)
+	c.Assert(err, check.IsNil)
+	_, err = w2.WriteEvent(rotateEv)
+	c.Assert(err, check.IsNil)
+	expected = append(expected, gmysql.Position{Name: filename, Pos: rotateEv.Header.LogPos})
+	_, err = w2.WriteEvent(formatDescEv)
+	c.Assert(err, check.IsNil)
+	filename = nextFilename
+	latestPos = formatDescEv.Header.LogPos
+	expected = append(expected, gmysql.Position{Name: filename, Pos: latestPos})
+	writeQueries(w2, 1)
+	waitArchived(a2, w2, 13)
+	c.Assert(a2.archived(), check.DeepEquals, expected)
+
+	// events not archived before closing are archived after restarted.
+	a2.setFail(true)
+	writeQueries(w2, 2)
+	c.Assert(w2.Close(), check.IsNil)
+	a3 := &mockArchiver{}
+	w3 := newWriter(a3, 0)
+	waitArchived(a3, w3, 2)
+	c.Assert(a3.archived(), check.DeepEquals, expected[len(expected)-2:])
+	c.Assert(w3.Close(), check.IsNil)
+}
+
+func (t *testFileWriterSuite) TestHTTPArchiver(c *check.C) {
+	var (
+		mu       sync.Mutex
+		status   = http.StatusOK
+		received [][]byte
+		headers  []http.Header
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		c.Assert(err, check.IsNil)
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, body)
+		headers = append(headers, r.Header)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	header := &replication.EventHeader{Timestamp: uint32(time.Now().Unix()), ServerID: 11}
+	ev, err := event.GenFormatDescriptionEvent(header, 4)
+	c.Assert(err, check.IsNil)
+
+	a := NewHTTPArchiver(server.URL, time.Second)
+	c.Assert(a.Archive(context.Background(), "mysql-bin.000001", ev), check.IsNil)
+	c.Assert(received, check.HasLen, 1)
+	c.Assert(received[0], check.DeepEquals, ev.RawData)
+	c.Assert(headers[0].Get(ArchiveHeaderFile), check.Equals, "mysql-bin.000001")
+	c.Assert(headers[0].Get(ArchiveHeaderPos), check.Equals, strconv.Itoa(int(ev.Header.LogPos)))
+
+	mu.Lock()
+	status = http.StatusServiceUnavailable
+	mu.Unlock()
+	err = a.Archive(context.Background(), "mysql-bin.000001", ev)
+	c.Assert(terror.ErrRelayArchiveFailed.Equal(err), check.IsTrue)
+	c.Assert(err, check.ErrorMatches, ".*503.*")
+	c.Assert(a.Close(), check.IsNil)
+}
@@ -15,6 +15,9 @@ package writer
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
 	"os"
 	"path/filepath"
 	"sync"
@@ -32,6 +35,7 @@ import (
 	"github.com/pingcap/dm/pkg/binlog/event"
 	bw "github.com/pingcap/dm/pkg/binlog/writer"
 	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/streamer"
 	"github.com/pingcap/dm/pkg/terror"
 	"github.com/pingcap/dm/pkg/utils"
 )
@@ -40,6 +44,11 @@ import (
 type FileConfig struct {
 	RelayDir string // directory to store relay log files.
 	Filename string // the startup relay log filename, if not set then a fake RotateEvent must be the first event.
+
+	// rotate to a new segment of the relay log file after a transaction if the current one reaches the size or is
+	// opened for the duration, 0 means disabled. see streamer.Segment.
+	MaxFileSize int64
+	MaxFileAge  time.Duration
 }
 
 // FileWriter implements Writer interface.
@@ -58,6 +67,16 @@ type FileWriter struct {
 
 	filename atomic.String // current binlog filename
 
+	// the segment of the current binlog file being written, it's the binlog file itself if never rotated locally.
+	segment       streamer.Segment
+	segmentSeq    int
+	segmentOpened time.Time
+	// raw data of the FormatDescriptionEvent of the current binlog file, written at the beginning of every segment.
+	fdeRaw []byte
+
+	// checksum algorithm of the events, got from the latest FormatDescriptionEvent.
+	checksumAlg byte
+
 	logger log.Logger
 }
 
@@ -124,6 +143,11 @@ func (w *FileWriter) WriteEvent(ev *replication.BinlogEvent) (Result, error) {
 		return Result{}, terror.ErrRelayWriterNeedStart.Generate(w.stage, common.StagePrepared)
 	}
 
+	// verify the event before it lands in the relay log file, so the corrupted event can be requested again.
+	if err := w.verifyEvent(ev); err != nil {
+		return Result{}, err
+	}
+
 	switch ev.Event.(type) {
 	case *replication.FormatDescriptionEvent:
 		return w.handleFormatDescriptionEvent(ev)
@@ -149,6 +173,37 @@ func (w *FileWriter) Flush() error {
 	return terror.ErrRelayWriterNotOpened.Generate()
 }
 
+// verifyEvent verifies the length and the checksum of the event.
+func (w *FileWriter) verifyEvent(ev *replication.BinlogEvent) error {
+	if ev.Header == nil || len(ev.RawData) < replication.EventHeaderSize {
+		return terror.ErrRelayWriterEventCorrupted.Generate(ev.Header, fmt.Sprintf("event length %d is less than the header length", len(ev.RawData)))
+	}
+	if int(ev.Header.EventSize) != len(ev.RawData) {
+		return terror.ErrRelayWriterEventCorrupted.Generate(ev.Header, fmt.Sprintf("event size %d in the header mismatches the event length %d", ev.Header.EventSize, len(ev.RawData)))
+	}
+
+	checksumAlg := w.checksumAlg
+	if fde, ok := ev.Event.(*replication.FormatDescriptionEvent); ok {
+		// the checksum algorithm of the FormatDescriptionEvent is decided by itself.
+		checksumAlg = fde.ChecksumAlgorithm
+	}
+	if checksumAlg == replication.BINLOG_CHECKSUM_ALG_CRC32 {
+		if len(ev.RawData) < replication.EventHeaderSize+replication.BinlogChecksumLength {
+			return terror.ErrRelayWriterEventCorrupted.Generate(ev.Header, fmt.Sprintf("event length %d is less than the header and checksum length", len(ev.RawData)))
+		}
+		dataLen := len(ev.RawData) - replication.BinlogChecksumLength
+		expected := binary.LittleEndian.Uint32(ev.RawData[dataLen:])
+		if computed := crc32.ChecksumIEEE(ev.RawData[:dataLen]); computed != expected {
+			return terror.ErrRelayWriterEventCorrupted.Generate(ev.Header, fmt.Sprintf("checksum mismatch, expected %d, computed %d", expected, computed))
+		}
+	}
+
+	if fde, ok := ev.Event.(*replication.FormatDescriptionEvent); ok {
+		w.checksumAlg = fde.ChecksumAlgorithm
+	}
+	return nil
+}
+
 // offset returns the current offset of the binlog file.
 // it is only used for testing now.
 func (w *FileWriter) offset() int64 {
@@ -179,13 +234,21 @@ func (w *FileWriter) handleFormatDescriptionEvent(ev *replication.BinlogEvent) (
 		return Result{}, terror.ErrRelayBinlogNameNotValid.Generatef("binlog filename %s not valid", w.filename.Load())
 	}
 
-	// open/create a new binlog file
-	filename := filepath.Join(w.cfg.RelayDir, w.filename.Load())
+	// open/create a new binlog file, or the last segment of it if rotated locally before
+	segments, err := streamer.LoadSegments(w.cfg.RelayDir, w.filename.Load())
+	if err != nil {
+		return Result{}, err
+	}
+	w.segment = segments[len(segments)-1]
+	w.segmentSeq = len(segments)
+	w.segmentOpened = time.Now()
+	w.fdeRaw = ev.RawData
+	filename := filepath.Join(w.cfg.RelayDir, w.segment.Filename)
 	outCfg := &bw.FileWriterConfig{
 		Filename: filename,
 	}
 	out := bw.NewFileWriter(w.logger, outCfg)
-	err := out.Start()
+	err = out.Start()
 	if err != nil {
 		return Result{}, terror.Annotatef(err, "start underlying binlog writer for %s", filename)
 	}
@@ -296,9 +359,74 @@ func (w *FileWriter) handleEventDefault(ev *replication.BinlogEvent) (Result, er
 
 	// write the non-duplicate event
 	err = w.out.Write(ev.RawData)
+	if err != nil {
+		return Result{}, terror.Annotatef(err, "write event %+v", ev.Header)
+	}
+
+	if w.needRotateSegment(ev) {
+		err = w.rotateSegment()
+	}
 	return Result{
 		Ignore: false,
-	}, terror.Annotatef(err, "write event %+v", ev.Header)
+	}, err
+}
+
+// needRotateSegment checks whether need to rotate to a new segment after the event written, the relay log file is only
+// rotated after a transaction completed, so the recovering only needs to handle the latest segment.
+func (w *FileWriter) needRotateSegment(ev *replication.BinlogEvent) bool {
+	if w.cfg.MaxFileSize <= 0 && w.cfg.MaxFileAge <= 0 {
+		return false
+	}
+	if !checkIsTxnEnd(ev, w.parser) {
+		return false
+	}
+	return (w.cfg.MaxFileSize > 0 && w.offset() >= w.cfg.MaxFileSize) ||
+		(w.cfg.MaxFileAge > 0 && time.Since(w.segmentOpened) >= w.cfg.MaxFileAge)
+}
+
+// rotateSegment rotates to a new segment of the current binlog file:
+//   1. create the segment with the binlog file header and the FormatDescriptionEvent
+//   2. record the segment in the index, an unrecorded segment left by crashing is overwritten later
+//   3. write the following events of the binlog file into the segment
+func (w *FileWriter) rotateSegment() error {
+	seg := streamer.Segment{
+		Filename:   streamer.SegmentFilename(w.filename.Load(), w.segmentSeq+1),
+		BinlogName: w.filename.Load(),
+		StartPos:   w.segment.ToUpstream(w.offset()),
+		HeaderSize: int64(len(replication.BinLogFileHeader) + len(w.fdeRaw)),
+	}
+	w.logger.Info("rotating to a new segment of the relay log file", zap.Stringer("segment", seg), zap.Reflect("status", w.out.Status()))
+	if err := w.out.Close(); err != nil {
+		return terror.Annotate(err, "close previous underlying binlog writer")
+	}
+	w.out = nil
+
+	filename := filepath.Join(w.cfg.RelayDir, seg.Filename)
+	if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+		return terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "remove unrecorded segment %s", filename)
+	}
+	out := bw.NewFileWriter(w.logger, &bw.FileWriterConfig{Filename: filename})
+	if err := out.Start(); err != nil {
+		return terror.Annotatef(err, "start underlying binlog writer for %s", filename)
+	}
+	w.out = out.(*bw.FileWriter)
+	if err := w.out.Write(replication.BinLogFileHeader); err != nil {
+		return terror.Annotatef(err, "write binlog file header for %s", filename)
+	}
+	if err := w.out.Write(w.fdeRaw); err != nil {
+		return terror.Annotatef(err, "write FormatDescriptionEvent for %s", filename)
+	}
+	if err := w.out.Flush(); err != nil {
+		return terror.Annotatef(err, "flush segment %s", filename)
+	}
+	if err := streamer.AppendSegment(w.cfg.RelayDir, seg); err != nil {
+		return err
+	}
+
+	w.segment = seg
+	w.segmentSeq++
+	w.segmentOpened = time.Now()
+	return nil
 }
 
 // handlePotentialHoleOrDuplicate combines handleFileHoleExist and handleDuplicateEventsExist.
@@ -339,7 +467,7 @@ func (w *FileWriter) handleFileHoleExist(ev *replication.BinlogEvent) (bool, err
 	if !ok {
 		return false, terror.ErrRelayWriterStatusNotValid.Generate(w.out.Status())
 	}
-	fileOffset := outFs.Offset
+	fileOffset := w.segment.ToUpstream(outFs.Offset)
 	holeSize := evStartPos - fileOffset
 	if holeSize <= 0 {
 		// no hole exists, but duplicate events may exists, this should be handled in another place.
@@ -368,8 +496,14 @@ func (w *FileWriter) handleFileHoleExist(ev *replication.BinlogEvent) (bool, err
 
 // handleDuplicateEventsExist tries to handle a potential duplicate event in the binlog file.
 func (w *FileWriter) handleDuplicateEventsExist(ev *replication.BinlogEvent) (Result, error) {
-	filename := filepath.Join(w.cfg.RelayDir, w.filename.Load())
-	duplicate, err := checkIsDuplicateEvent(filename, ev)
+	// the event may be written into a previous segment
+	segments, err := streamer.LoadSegments(w.cfg.RelayDir, w.filename.Load())
+	if err != nil {
+		return Result{}, err
+	}
+	seg := segments[streamer.FindSegment(segments, int64(ev.Header.LogPos-ev.Header.EventSize))]
+	filename := filepath.Join(w.cfg.RelayDir, seg.Filename)
+	duplicate, err := checkIsDuplicateEventInSegment(filename, seg, ev)
 	if err != nil {
 		return Result{}, terror.Annotatef(err, "check event %+v whether duplicate in %s", ev.Header, filename)
 	} else if duplicate {
@@ -404,11 +538,33 @@ func (w *FileWriter) doRecovering(ctx context.Context) (RecoverResult, error) {
 		return RecoverResult{}, terror.ErrRelayWriterGetFileStat.Delegate(err, filename)
 	}
 
+	// only the last segment needs to be recovered if rotated locally, but the GTID set is got from all segments
+	segments, err := streamer.LoadSegments(w.cfg.RelayDir, w.filename.Load())
+	if err != nil {
+		return RecoverResult{}, err
+	}
+	filenames := make([]string, 0, len(segments))
+	for _, seg := range segments {
+		filenames = append(filenames, filepath.Join(w.cfg.RelayDir, seg.Filename))
+	}
+	seg := segments[len(segments)-1]
+	if len(segments) > 1 {
+		filename = filenames[len(filenames)-1]
+		if fs, err = os.Stat(filename); err != nil {
+			return RecoverResult{}, terror.ErrRelayWriterGetFileStat.Delegate(err, filename)
+		}
+	}
+
 	// get latest pos/GTID set for all completed transactions from the file
-	latestPos, latestGTIDs, err := getTxnPosGTIDs(ctx, filename, w.parser)
+	latestPos, latestGTIDs, err := getTxnPosGTIDsFromSegments(ctx, filenames, w.parser)
 	if err != nil {
 		return RecoverResult{}, terror.Annotatef(err, "get latest pos/GTID set from %s", filename)
 	}
+	// the offset in the last segment, a segment always begins after a completed transaction
+	latestOffset := seg.ToLocal(latestPos)
+	if latestOffset < seg.HeaderSize {
+		latestOffset = seg.HeaderSize
+	}
 
 	// mock file truncated by recover
 	failpoint.Inject("MockRecoverRelayWriter", func() {
@@ -417,14 +573,14 @@ func (w *FileWriter) doRecovering(ctx context.Context) (RecoverResult, error) {
 	})
 
 	// in most cases, we think the file is fine, so compare the size is simpler.
-	if fs.Size() == latestPos {
+	if fs.Size() == latestOffset {
 		return RecoverResult{
 			Truncated:   false,
 			LatestPos:   gmysql.Position{Name: w.filename.Load(), Pos: uint32(latestPos)},
 			LatestGTIDs: latestGTIDs,
 		}, nil
-	} else if fs.Size() < latestPos {
-		return RecoverResult{}, terror.ErrRelayWriterLatestPosGTFileSize.Generate(latestPos, fs.Size())
+	} else if fs.Size() < latestOffset {
+		return RecoverResult{}, terror.ErrRelayWriterLatestPosGTFileSize.Generate(latestOffset, fs.Size())
 	}
 
 	failpoint.Label("bypass")
@@ -435,9 +591,9 @@ func (w *FileWriter) doRecovering(ctx context.Context) (RecoverResult, error) {
 		return RecoverResult{}, terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "open %s", filename)
 	}
 	defer f.Close()
-	err = f.Truncate(latestPos)
+	err = f.Truncate(latestOffset)
 	if err != nil {
-		return RecoverResult{}, terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "truncate %s to %d", filename, latestPos)
+		return RecoverResult{}, terror.Annotatef(terror.ErrRelayWriterFileOperate.New(err.Error()), "truncate %s to %d", filename, latestOffset)
 	}
 
 	return RecoverResult{
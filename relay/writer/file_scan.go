@@ -0,0 +1,369 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/parser"
+
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/relay/common"
+)
+
+var (
+	// recoverChunkSize is the size of the chunks of a relay log file scanned in parallel when recovering, the file
+	// smaller than two chunks is read sequentially.
+	recoverChunkSize int64 = 64 * 1024 * 1024
+	// recoverConcurrency is the max number of the chunks scanned concurrently.
+	recoverConcurrency = runtime.NumCPU()
+)
+
+const (
+	// the size of the window read from the file at once by eventScanner.
+	scanWindowSize = 1024 * 1024
+	// check whether the context is done every this many events.
+	scanCheckCtxInterval = 1024
+)
+
+// scanTxnRecords handles the txnRecords of the events in a binlog file in order. if the checksum is enabled and the
+// file is large, the file is split into chunks which are scanned in parallel, the boundaries of the events in a chunk
+// are found by the checksum, otherwise the file is read sequentially.
+// like reading sequentially, it stops at the first incomplete or corrupted event.
+func scanTxnRecords(ctx context.Context, filename string, p *parser.Parser, handle func(txnRecord) error) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return terror.ErrRelayWriterFileOperate.Delegate(err, filename)
+	}
+	defer f.Close()
+	fs, err := f.Stat()
+	if err != nil {
+		return terror.ErrRelayWriterGetFileStat.Delegate(err, filename)
+	}
+	if fs.Size() < 2*recoverChunkSize || recoverConcurrency < 2 {
+		return readTxnRecords(ctx, filename, p, handle)
+	}
+
+	s, ok, err := newEventScanner(f, fs.Size(), p)
+	if err != nil {
+		return terror.Annotatef(err, "scan %s", filename)
+	}
+	if !ok {
+		return readTxnRecords(ctx, filename, p, handle)
+	}
+	return s.scan(ctx, handle)
+}
+
+// chunkResult is the result of scanning a chunk of a binlog file.
+type chunkResult struct {
+	start   int64 // the offset of the first event found in the chunk, -1 if not found
+	end     int64 // the offset after the last event scanned, it may be beyond the chunk
+	stopped bool  // whether an incomplete or corrupted event is met
+	records []txnRecord
+	err     error
+}
+
+// eventScanner scans the events of a binlog file with the CRC32 checksum in parallel.
+type eventScanner struct {
+	f         *os.File
+	size      int64
+	fde       *replication.BinlogEvent
+	fdeRaw    []byte
+	headerLen int64 // the size of the binlog file header and the FormatDescriptionEvent
+	shift     int64 // the difference between the position in the upstream binlog file and the offset in the file
+
+	parserMu sync.Mutex // protects parser, which is not thread-safe
+	parser   *parser.Parser
+}
+
+// newEventScanner creates an eventScanner, false is returned if the file can't be scanned in parallel because the
+// checksum is disabled or it has no event after the FormatDescriptionEvent.
+func newEventScanner(f *os.File, size int64, p *parser.Parser) (*eventScanner, bool, error) {
+	s := &eventScanner{f: f, size: size, parser: p}
+	w := &scanWindow{f: f, size: size}
+	headerLen := int64(len(replication.BinLogFileHeader))
+	fdeRaw, ok, err := w.readEvent(headerLen, false)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	bp := replication.NewBinlogParser()
+	e, err := bp.Parse(fdeRaw)
+	if err != nil {
+		return nil, false, err
+	}
+	fde, ok := e.Event.(*replication.FormatDescriptionEvent)
+	if !ok || fde.ChecksumAlgorithm != replication.BINLOG_CHECKSUM_ALG_CRC32 {
+		return nil, false, nil
+	}
+	s.fdeRaw = append([]byte(nil), fdeRaw...)
+	s.fde = &replication.BinlogEvent{RawData: s.fdeRaw, Header: e.Header, Event: fde}
+	s.headerLen = headerLen + int64(len(fdeRaw))
+
+	// the events are continuous in the relay log file, so the first event after the FormatDescriptionEvent gives the
+	// difference between the positions and the offsets, which is used to find the boundaries of the events.
+	next, ok, err := w.readEvent(s.headerLen, true)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	s.shift = int64(binary.LittleEndian.Uint32(next[13:17])) - s.headerLen - int64(len(next))
+	return s, true, nil
+}
+
+// scan scans the chunks in parallel, and handles the txnRecords of them in order.
+func (s *eventScanner) scan(ctx context.Context, handle func(txnRecord) error) error {
+	rec, _, err := newTxnRecord(s.fde, s.isDDL)
+	if err != nil {
+		return err
+	}
+	if err = handle(rec); err != nil {
+		return err
+	}
+
+	var (
+		count   = int((s.size - s.headerLen + recoverChunkSize - 1) / recoverChunkSize)
+		results = make([]chan chunkResult, count)
+		limit   = make(chan struct{}, recoverConcurrency)
+		wg      sync.WaitGroup
+	)
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		wg.Wait() // the file is closed after scanning
+	}()
+	for i := range results {
+		results[i] = make(chan chunkResult, 1)
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			select {
+			case limit <- struct{}{}:
+			case <-ctx.Done():
+				results[i] <- chunkResult{err: ctx.Err()}
+				return
+			}
+			defer func() { <-limit }()
+			start, end := s.chunk(i)
+			if i == 0 {
+				results[i] <- s.walk(ctx, start, end) // the first chunk begins with the events after the header
+			} else {
+				results[i] <- s.scanChunk(ctx, start, end)
+			}
+		}(i)
+	}
+
+	// stitch the results, a chunk is rescanned from the end of the previous chunk if its first event found by the
+	// checksum is not there, which is rare but possible if the checksum of some bytes in the event matches by chance.
+	next := s.headerLen
+	for i := range results {
+		res := <-results[i]
+		if res.err != nil {
+			return res.err
+		}
+		_, end := s.chunk(i)
+		if next >= end {
+			continue // covered by a large event in the previous chunk
+		}
+		if res.start != next {
+			if res = s.walk(ctx, next, end); res.err != nil {
+				return res.err
+			}
+		}
+		for _, rec := range res.records {
+			if err = handle(rec); err != nil {
+				return err
+			}
+		}
+		next = res.end
+		if res.stopped {
+			break
+		}
+	}
+	return nil
+}
+
+// chunk returns the range of the i-th chunk, the header is not included.
+func (s *eventScanner) chunk(i int) (int64, int64) {
+	start := s.headerLen + int64(i)*recoverChunkSize
+	end := start + recoverChunkSize
+	if end > s.size {
+		end = s.size
+	}
+	return start, end
+}
+
+// scanChunk finds the first event in [start, end) by the checksum, then walks the events from it.
+func (s *eventScanner) scanChunk(ctx context.Context, start, end int64) chunkResult {
+	w := &scanWindow{f: s.f, size: s.size}
+	for offset := start; offset < end; offset++ {
+		if (offset-start)%scanWindowSize == 0 && ctx.Err() != nil {
+			return chunkResult{err: ctx.Err()}
+		}
+		ok, err := s.isBoundary(w, offset)
+		if err != nil {
+			return chunkResult{err: err}
+		}
+		if ok {
+			return s.walk(ctx, offset, end)
+		}
+	}
+	return chunkResult{start: -1, end: end}
+}
+
+// isBoundary checks whether an event begins at the offset, by the header and the checksum.
+func (s *eventScanner) isBoundary(w *scanWindow, offset int64) (bool, error) {
+	header, err := w.read(offset, replication.EventHeaderSize)
+	if err != nil || header == nil {
+		return false, err
+	}
+	size := int64(binary.LittleEndian.Uint32(header[9:13]))
+	// the position is 32-bit and wraps if the binlog file is larger than 4GB, so it's compared modulo 2^32.
+	logPos := binary.LittleEndian.Uint32(header[13:17])
+	if size < replication.EventHeaderSize+replication.BinlogChecksumLength || offset+size > s.size ||
+		logPos != uint32(offset+size+s.shift) {
+		return false, nil
+	}
+	_, ok, err := w.readEvent(offset, true)
+	return ok, err
+}
+
+// walk walks the events from the offset until the end of the chunk, the checksum of every event is verified.
+func (s *eventScanner) walk(ctx context.Context, offset, end int64) chunkResult {
+	res := chunkResult{start: offset}
+	w := &scanWindow{f: s.f, size: s.size}
+	bp := replication.NewBinlogParser()
+	bp.SetVerifyChecksum(false) // verified before parsing
+	if _, err := bp.Parse(s.fdeRaw); err != nil {
+		return chunkResult{err: err}
+	}
+
+	for i := 0; offset < end; i++ {
+		if i%scanCheckCtxInterval == 0 && ctx.Err() != nil {
+			return chunkResult{err: ctx.Err()}
+		}
+		data, ok, err := w.readEvent(offset, true)
+		if err != nil {
+			return chunkResult{err: err}
+		}
+		if !ok {
+			res.stopped = true
+			break
+		}
+		rec, ok, err := s.record(bp, data)
+		if err != nil {
+			return chunkResult{err: err}
+		}
+		if ok {
+			res.records = append(res.records, rec)
+		}
+		offset += int64(len(data))
+	}
+	res.end = offset
+	return res
+}
+
+// record parses the event if it's needed to get the position/GTID set, and returns the txnRecord of it.
+func (s *eventScanner) record(bp *replication.BinlogParser, data []byte) (txnRecord, bool, error) {
+	switch replication.EventType(data[4]) {
+	case replication.QUERY_EVENT, replication.XID_EVENT, replication.GTID_EVENT, replication.ANONYMOUS_GTID_EVENT,
+		replication.MARIADB_GTID_EVENT, replication.PREVIOUS_GTIDS_EVENT, replication.MARIADB_GTID_LIST_EVENT:
+	default:
+		return txnRecord{}, false, nil
+	}
+	e, err := bp.Parse(data)
+	if err != nil {
+		return txnRecord{}, false, err
+	}
+	return newTxnRecord(e, s.isDDL)
+}
+
+// isDDL checks whether the query is a DDL, `BEGIN` which is most of the queries is checked without the parser.
+func (s *eventScanner) isDDL(query string) bool {
+	if query == "BEGIN" {
+		return false
+	}
+	s.parserMu.Lock()
+	defer s.parserMu.Unlock()
+	return common.CheckIsDDL(query, s.parser)
+}
+
+// scanWindow reads a binlog file through a window of it, to reduce the system calls.
+type scanWindow struct {
+	f      *os.File
+	size   int64
+	buf    []byte
+	offset int64 // the offset of buf in the file
+}
+
+// read reads n bytes at the offset, nil is returned if the file is shorter.
+// the returned slice is only valid until the next read.
+func (w *scanWindow) read(offset, n int64) ([]byte, error) {
+	if offset+n > w.size {
+		return nil, nil
+	}
+	if offset >= w.offset && offset+n <= w.offset+int64(len(w.buf)) {
+		return w.buf[offset-w.offset : offset-w.offset+n], nil
+	}
+
+	size := n
+	if size < scanWindowSize {
+		size = scanWindowSize
+	}
+	if offset+size > w.size {
+		size = w.size - offset
+	}
+	if int64(cap(w.buf)) < size {
+		w.buf = make([]byte, size)
+	}
+	w.buf = w.buf[:size]
+	w.offset = offset
+	if _, err := w.f.ReadAt(w.buf, offset); err != nil && err != io.EOF {
+		w.buf = w.buf[:0]
+		return nil, terror.ErrRelayWriterFileOperate.Delegate(err, w.f.Name())
+	}
+	return w.buf[:n], nil
+}
+
+// readEvent reads the event at the offset, false is returned if there's no complete event or the checksum mismatches.
+// the returned slice is only valid until the next read.
+func (w *scanWindow) readEvent(offset int64, verifyChecksum bool) ([]byte, bool, error) {
+	header, err := w.read(offset, replication.EventHeaderSize)
+	if err != nil || header == nil {
+		return nil, false, err
+	}
+	size := int64(binary.LittleEndian.Uint32(header[9:13]))
+	if size < replication.EventHeaderSize {
+		return nil, false, nil
+	}
+	data, err := w.read(offset, size)
+	if err != nil || data == nil {
+		return nil, false, err
+	}
+	if verifyChecksum {
+		if size < replication.EventHeaderSize+replication.BinlogChecksumLength {
+			return nil, false, nil
+		}
+		dataLen := size - replication.BinlogChecksumLength
+		if crc32.ChecksumIEEE(data[:dataLen]) != binary.LittleEndian.Uint32(data[dataLen:]) {
+			return nil, false, nil
+		}
+	}
+	return data, true, nil
+}
@@ -0,0 +1,296 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"math"
+	"os"
+	"path/filepath"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+
+	"github.com/pingcap/dm/pkg/binlog/event"
+	"github.com/pingcap/dm/pkg/gtid"
+)
+
+func (t *testFileUtilSuite) TestScanTxnRecordsInParallel(c *check.C) {
+	defer func(chunkSize int64, concurrency int) {
+		recoverChunkSize = chunkSize
+		recoverConcurrency = concurrency
+	}(recoverChunkSize, recoverConcurrency)
+
+	var (
+		filename      = filepath.Join(c.MkDir(), "test-mysql-bin.000001")
+		flavor        = gmysql.MySQLFlavor
+		parser2       = parser.New()
+		allData       bytes.Buffer
+		lastTxnEvents []*replication.BinlogEvent
+	)
+	previousGTIDSet, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14")
+	c.Assert(err, check.IsNil)
+	latestGTID, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:14")
+	c.Assert(err, check.IsNil)
+	g, err := event.NewGenerator(flavor, 11, 0, latestGTID, previousGTIDSet, 10)
+	c.Assert(err, check.IsNil)
+
+	_, data, err := g.GenFileHeader()
+	c.Assert(err, check.IsNil)
+	allData.Write(data)
+	_, data, err = g.GenDDLEvents("db", "CREATE TABLE `db`.`tbl` (c1 INT, c2 VARCHAR(1024))")
+	c.Assert(err, check.IsNil)
+	allData.Write(data)
+	for i := 0; i < 200; i++ {
+		// rows with different sizes, and a large one covering some chunks.
+		value := bytes.Repeat([]byte{'a'}, i%7*10)
+		if i == 100 {
+			value = bytes.Repeat([]byte{'b'}, 250)
+		}
+		dmlData := []*event.DMLData{{
+			TableID:    8,
+			Schema:     "db",
+			Table:      "tbl",
+			ColumnType: []byte{gmysql.MYSQL_TYPE_LONG, gmysql.MYSQL_TYPE_STRING},
+			Rows:       [][]interface{}{{int32(i), string(value)}},
+		}}
+		lastTxnEvents, data, err = g.GenDMLEvents(replication.WRITE_ROWS_EVENTv2, dmlData)
+		c.Assert(err, check.IsNil)
+		allData.Write(data)
+	}
+	c.Assert(os.WriteFile(filename, allData.Bytes(), 0o644), check.IsNil)
+
+	compare := func() {
+		recoverChunkSize = 1 << 30
+		expectedPos, expectedGTIDs, err2 := getTxnPosGTIDs(context.Background(), filename, parser2)
+		c.Assert(err2, check.IsNil)
+		for _, chunkSize := range []int64{128, 1000, 4096} {
+			recoverChunkSize = chunkSize
+			recoverConcurrency = 4
+			pos, gSet, err2 := getTxnPosGTIDs(context.Background(), filename, parser2)
+			c.Assert(err2, check.IsNil)
+			c.Assert(pos, check.Equals, expectedPos)
+			c.Assert(gSet, check.DeepEquals, expectedGTIDs)
+		}
+	}
+
+	// all transactions are completed.
+	compare()
+	expectedGTIDs, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-215")
+	c.Assert(err, check.IsNil)
+	recoverChunkSize = 256
+	pos, gSet, err := getTxnPosGTIDs(context.Background(), filename, parser2)
+	c.Assert(err, check.IsNil)
+	c.Assert(pos, check.Equals, int64(allData.Len()))
+	c.Assert(gSet, check.DeepEquals, expectedGTIDs)
+
+	// an incomplete event at the end.
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_APPEND, 0o644)
+	c.Assert(err, check.IsNil)
+	_, err = f.Write(lastTxnEvents[0].RawData[:len(lastTxnEvents[0].RawData)-2])
+	c.Assert(err, check.IsNil)
+	c.Assert(f.Close(), check.IsNil)
+	compare()
+
+	// a corrupted event in the middle, the events after it are not scanned.
+	content := allData.Bytes()
+	content[len(content)/2] ^= 0xff
+	c.Assert(os.WriteFile(filename, content, 0o644), check.IsNil)
+	compare()
+	pos, _, err = getTxnPosGTIDs(context.Background(), filename, parser2)
+	c.Assert(err, check.IsNil)
+	c.Assert(pos < int64(len(content)/2), check.IsTrue)
+}
+
+func (t *testFileUtilSuite) TestScanTxnRecordsFalseBoundary(c *check.C) {
+	defer func(chunkSize int64, concurrency int) {
+		recoverChunkSize = chunkSize
+		recoverConcurrency = concurrency
+	}(recoverChunkSize, recoverConcurrency)
+
+	var (
+		filename  = filepath.Join(c.MkDir(), "test-mysql-bin.000001")
+		flavor    = gmysql.MySQLFlavor
+		parser2   = parser.New()
+		allData   bytes.Buffer
+		rowsStart int
+		rowsEnd   int
+	)
+	previousGTIDSet, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14")
+	c.Assert(err, check.IsNil)
+	latestGTID, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:14")
+	c.Assert(err, check.IsNil)
+	g, err := event.NewGenerator(flavor, 11, 0, latestGTID, previousGTIDSet, 10)
+	c.Assert(err, check.IsNil)
+
+	headerEvents, data, err := g.GenFileHeader()
+	c.Assert(err, check.IsNil)
+	allData.Write(data)
+	headerLen := len(replication.BinLogFileHeader) + len(headerEvents[0].RawData)
+	_, data, err = g.GenDDLEvents("db", "CREATE TABLE `db`.`tbl` (c1 INT, c2 VARCHAR(1024))")
+	c.Assert(err, check.IsNil)
+	allData.Write(data)
+	for i := 0; i < 200; i++ {
+		value := bytes.Repeat([]byte{'a'}, i%7*10)
+		if i == 100 {
+			value = bytes.Repeat([]byte{'b'}, 250)
+		}
+		dmlData := []*event.DMLData{{
+			TableID:    8,
+			Schema:     "db",
+			Table:      "tbl",
+			ColumnType: []byte{gmysql.MYSQL_TYPE_LONG, gmysql.MYSQL_TYPE_STRING},
+			Rows:       [][]interface{}{{int32(i), string(value)}},
+		}}
+		events, data, err2 := g.GenDMLEvents(replication.WRITE_ROWS_EVENTv2, dmlData)
+		c.Assert(err2, check.IsNil)
+		if i == 100 {
+			offset := allData.Len()
+			for _, e := range events {
+				if e.Header.EventType == replication.WRITE_ROWS_EVENTv2 {
+					rowsStart, rowsEnd = offset, offset+len(e.RawData)
+				}
+				offset += len(e.RawData)
+			}
+		}
+		allData.Write(data)
+	}
+
+	// the third chunk begins in the large rows event, and the rows event ends in it.
+	recoverChunkSize = int64(rowsEnd-200-headerLen) / 2
+	recoverConcurrency = 4
+	fakeOffset := headerLen + 2*int(recoverChunkSize) + 50
+
+	// put a fake event with the matched position and checksum into the value of the large row.
+	fake := make([]byte, 40)
+	fake[4] = byte(replication.ROWS_QUERY_EVENT)
+	binary.LittleEndian.PutUint32(fake[9:13], uint32(len(fake)))
+	binary.LittleEndian.PutUint32(fake[13:17], uint32(fakeOffset+len(fake)))
+	binary.LittleEndian.PutUint32(fake[36:], crc32.ChecksumIEEE(fake[:36]))
+	content := allData.Bytes()
+	c.Assert(content[fakeOffset-1], check.Equals, byte('b'))
+	c.Assert(content[fakeOffset+len(fake)], check.Equals, byte('b'))
+	copy(content[fakeOffset:], fake)
+	binary.LittleEndian.PutUint32(content[rowsEnd-4:], crc32.ChecksumIEEE(content[rowsStart:rowsEnd-4]))
+	c.Assert(os.WriteFile(filename, content, 0o644), check.IsNil)
+
+	f, err := os.Open(filename)
+	c.Assert(err, check.IsNil)
+	defer f.Close()
+	s, ok, err := newEventScanner(f, int64(len(content)), parser2)
+	c.Assert(err, check.IsNil)
+	c.Assert(ok, check.IsTrue)
+	c.Assert(s.size > s.headerLen+3*recoverChunkSize, check.IsTrue)
+	// the second chunk is walked until the end of the large rows event.
+	start, end := s.chunk(1)
+	res := s.scanChunk(context.Background(), start, end)
+	c.Assert(res.err, check.IsNil)
+	c.Assert(res.end, check.Equals, int64(rowsEnd))
+	// the first event of the third chunk found by the checksum is the fake one, so it's rescanned from the end of the
+	// large rows event.
+	start, end = s.chunk(2)
+	c.Assert(start < int64(fakeOffset), check.IsTrue)
+	c.Assert(int64(rowsEnd) < end, check.IsTrue)
+	res = s.scanChunk(context.Background(), start, end)
+	c.Assert(res.err, check.IsNil)
+	c.Assert(res.start, check.Equals, int64(fakeOffset))
+	c.Assert(res.stopped, check.IsTrue)
+
+	expectedGTIDs, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-215")
+	c.Assert(err, check.IsNil)
+	pos, gSet, err := getTxnPosGTIDs(context.Background(), filename, parser2)
+	c.Assert(err, check.IsNil)
+	c.Assert(pos, check.Equals, int64(len(content)))
+	c.Assert(gSet, check.DeepEquals, expectedGTIDs)
+}
+
+func (t *testFileUtilSuite) TestScanTxnRecordsWrappedPos(c *check.C) {
+	defer func(chunkSize int64, concurrency int) {
+		recoverChunkSize = chunkSize
+		recoverConcurrency = concurrency
+	}(recoverChunkSize, recoverConcurrency)
+
+	var (
+		filename = filepath.Join(c.MkDir(), "test-mysql-bin.000001")
+		flavor   = gmysql.MySQLFlavor
+		parser2  = parser.New()
+		allData  bytes.Buffer
+		offsets  []int64
+	)
+	previousGTIDSet, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14")
+	c.Assert(err, check.IsNil)
+	latestGTID, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:14")
+	c.Assert(err, check.IsNil)
+	g, err := event.NewGenerator(flavor, 11, 0, latestGTID, previousGTIDSet, 10)
+	c.Assert(err, check.IsNil)
+
+	// the positions of the events after the FormatDescriptionEvent are near 4GB, like in a large binlog file, so
+	// they wrap in the file.
+	headerEvents, data, err := g.GenFileHeader()
+	c.Assert(err, check.IsNil)
+	headerLen := len(replication.BinLogFileHeader) + len(headerEvents[0].RawData)
+	allData.Write(data[:headerLen])
+	prevGTIDsEv, err := event.GenPreviousGTIDsEvent(headerEvents[1].Header, math.MaxUint32-2000, previousGTIDSet)
+	c.Assert(err, check.IsNil)
+	allData.Write(prevGTIDsEv.RawData)
+	g.LatestPos = prevGTIDsEv.Header.LogPos
+	for i := 0; i < 100; i++ {
+		dmlData := []*event.DMLData{{
+			TableID:    8,
+			Schema:     "db",
+			Table:      "tbl",
+			ColumnType: []byte{gmysql.MYSQL_TYPE_LONG, gmysql.MYSQL_TYPE_STRING},
+			Rows:       [][]interface{}{{int32(i), string(bytes.Repeat([]byte{'a'}, i%7*10))}},
+		}}
+		events, data, err2 := g.GenDMLEvents(replication.WRITE_ROWS_EVENTv2, dmlData)
+		c.Assert(err2, check.IsNil)
+		offset := int64(allData.Len())
+		for _, e := range events {
+			offsets = append(offsets, offset)
+			offset += int64(len(e.RawData))
+		}
+		allData.Write(data)
+	}
+	c.Assert(g.LatestPos < prevGTIDsEv.Header.LogPos, check.IsTrue)
+	c.Assert(os.WriteFile(filename, allData.Bytes(), 0o644), check.IsNil)
+
+	f, err := os.Open(filename)
+	c.Assert(err, check.IsNil)
+	defer f.Close()
+	s, ok, err := newEventScanner(f, int64(allData.Len()), parser2)
+	c.Assert(err, check.IsNil)
+	c.Assert(ok, check.IsTrue)
+	w := &scanWindow{f: f, size: s.size}
+	for _, offset := range offsets {
+		ok, err = s.isBoundary(w, offset)
+		c.Assert(err, check.IsNil)
+		c.Assert(ok, check.IsTrue, check.Commentf("offset %d", offset))
+	}
+
+	recoverChunkSize = 1 << 30
+	expectedPos, expectedGTIDs, err := getTxnPosGTIDs(context.Background(), filename, parser2)
+	c.Assert(err, check.IsNil)
+	c.Assert(expectedPos, check.Equals, int64(g.LatestPos))
+	recoverChunkSize = 256
+	recoverConcurrency = 4
+	pos, gSet, err := getTxnPosGTIDs(context.Background(), filename, parser2)
+	c.Assert(err, check.IsNil)
+	c.Assert(pos, check.Equals, expectedPos)
+	c.Assert(gSet, check.DeepEquals, expectedGTIDs)
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/pingcap/dm/pkg/binlog/event"
 	"github.com/pingcap/dm/pkg/gtid"
 	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/streamer"
+	"github.com/pingcap/dm/pkg/terror"
 )
 
 var _ = check.Suite(&testFileWriterSuite{})
@@ -223,6 +225,65 @@ func (t *testFileWriterSuite) TestFormatDescriptionEvent(c *check.C) {
 	c.Assert(events[1], check.DeepEquals, queryEv)
 }
 
+func (t *testFileWriterSuite) TestCorruptedEvent(c *check.C) {
+	var (
+		cfg = &FileConfig{
+			RelayDir: c.MkDir(),
+			Filename: "test-mysql-bin.000001",
+		}
+		header = &replication.EventHeader{
+			Timestamp: uint32(time.Now().Unix()),
+			ServerID:  11,
+			Flags:     0x01,
+		}
+		latestPos uint32 = 4
+	)
+	formatDescEv, err := event.GenFormatDescriptionEvent(header, latestPos)
+	c.Assert(err, check.IsNil)
+	latestPos = formatDescEv.Header.LogPos
+	queryEv, err := event.GenQueryEvent(header, latestPos, 0, 0, 0, nil, []byte("schema"), []byte("BEGIN"))
+	c.Assert(err, check.IsNil)
+
+	w := NewFileWriter(log.L(), cfg, t.parser)
+	defer w.Close()
+	c.Assert(w.Start(), check.IsNil)
+
+	// corrupted FormatDescriptionEvent is rejected
+	corruptedFDE := *formatDescEv
+	corruptedFDE.RawData = append([]byte{}, formatDescEv.RawData...)
+	corruptedFDE.RawData[replication.EventHeaderSize] ^= 0xff
+	_, err = w.WriteEvent(&corruptedFDE)
+	c.Assert(terror.ErrRelayWriterEventCorrupted.Equal(err), check.IsTrue)
+	t.verifyFilenameOffset(c, w, cfg.Filename, 0)
+
+	result, err := w.WriteEvent(formatDescEv)
+	c.Assert(err, check.IsNil)
+	c.Assert(result.Ignore, check.IsFalse)
+	fileSize := int64(len(replication.BinLogFileHeader) + len(formatDescEv.RawData))
+	t.verifyFilenameOffset(c, w, cfg.Filename, fileSize)
+
+	// checksum mismatch
+	corruptedEv := *queryEv
+	corruptedEv.RawData = append([]byte{}, queryEv.RawData...)
+	corruptedEv.RawData[len(corruptedEv.RawData)-5] ^= 0xff
+	_, err = w.WriteEvent(&corruptedEv)
+	c.Assert(terror.ErrRelayWriterEventCorrupted.Equal(err), check.IsTrue)
+	c.Assert(err, check.ErrorMatches, ".*checksum mismatch.*")
+
+	// length mismatch
+	corruptedEv.RawData = queryEv.RawData[:len(queryEv.RawData)-1]
+	_, err = w.WriteEvent(&corruptedEv)
+	c.Assert(terror.ErrRelayWriterEventCorrupted.Equal(err), check.IsTrue)
+	c.Assert(err, check.ErrorMatches, ".*mismatches the event length.*")
+	t.verifyFilenameOffset(c, w, cfg.Filename, fileSize)
+
+	// the valid event is written
+	result, err = w.WriteEvent(queryEv)
+	c.Assert(err, check.IsNil)
+	c.Assert(result.Ignore, check.IsFalse)
+	t.verifyFilenameOffset(c, w, cfg.Filename, fileSize+int64(len(queryEv.RawData)))
+}
+
 func (t *testFileWriterSuite) verifyFilenameOffset(c *check.C, w Writer, filename string, offset int64) {
 	wf, ok := w.(*FileWriter)
 	c.Assert(ok, check.IsTrue)
@@ -441,6 +502,114 @@ func (t *testFileWriterSuite) TestWriteMultiEvents(c *check.C) {
 	c.Assert(obtainData, check.DeepEquals, allData.Bytes())
 }
 
+func (t *testFileWriterSuite) TestRotateSegment(c *check.C) {
+	var (
+		flavor                    = gmysql.MySQLFlavor
+		serverID           uint32 = 11
+		latestPos          uint32
+		previousGTIDSetStr        = "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14,53bfca22-690d-11e7-8a62-18ded7a37b78:1-495"
+		latestGTIDStr             = "3ccc475b-2343-11e7-be21-6c0b84d59f30:14"
+		expectedGTIDsStr          = "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-17,53bfca22-690d-11e7-8a62-18ded7a37b78:1-495"
+		latestXID          uint64 = 10
+
+		cfg = &FileConfig{
+			RelayDir:    c.MkDir(),
+			Filename:    "test-mysql-bin.000001",
+			MaxFileSize: 1, // rotate after every transaction
+		}
+	)
+	previousGTIDSet, err := gtid.ParserGTID(flavor, previousGTIDSetStr)
+	c.Assert(err, check.IsNil)
+	latestGTID, err := gtid.ParserGTID(flavor, latestGTIDStr)
+	c.Assert(err, check.IsNil)
+	expectedGTIDs, err := gtid.ParserGTID(flavor, expectedGTIDsStr)
+	c.Assert(err, check.IsNil)
+
+	// file header, 2 DDL and 1 DML
+	allEvents := make([]*replication.BinlogEvent, 0, 10)
+	var allData bytes.Buffer
+	g, err := event.NewGenerator(flavor, serverID, latestPos, latestGTID, previousGTIDSet, latestXID)
+	c.Assert(err, check.IsNil)
+	events, data, err := g.GenFileHeader()
+	c.Assert(err, check.IsNil)
+	allEvents = append(allEvents, events...)
+	allData.Write(data)
+	for _, query := range []string{"CREATE DATABASE `db`", "CREATE TABLE `db`.`tbl` (c1 INT)"} {
+		events, data, err = g.GenDDLEvents("db", query)
+		c.Assert(err, check.IsNil)
+		allEvents = append(allEvents, events...)
+		allData.Write(data)
+	}
+	dmlEvents, data, err := g.GenDMLEvents(replication.WRITE_ROWS_EVENTv2, []*event.DMLData{
+		{TableID: 8, Schema: "db", Table: "tbl", ColumnType: []byte{gmysql.MYSQL_TYPE_LONG}, Rows: [][]interface{}{{int32(1)}}},
+	})
+	c.Assert(err, check.IsNil)
+	allEvents = append(allEvents, dmlEvents...)
+	allData.Write(data)
+
+	w := NewFileWriter(log.L(), cfg, t.parser)
+	c.Assert(w.Start(), check.IsNil)
+	for _, ev := range allEvents {
+		result, err2 := w.WriteEvent(ev)
+		c.Assert(err2, check.IsNil)
+		c.Assert(result.Ignore, check.IsFalse)
+	}
+
+	// 3 segments after the first relay log file, the last one is empty
+	segments, err := streamer.LoadSegments(cfg.RelayDir, cfg.Filename)
+	c.Assert(err, check.IsNil)
+	c.Assert(segments, check.HasLen, 4)
+	headerSize := int64(len(replication.BinLogFileHeader) + len(allEvents[0].RawData))
+	last := segments[3]
+	c.Assert(last.Filename, check.Equals, streamer.SegmentFilename(cfg.Filename, 4))
+	c.Assert(last.StartPos, check.Equals, int64(allData.Len()))
+	c.Assert(last.HeaderSize, check.Equals, headerSize)
+	t.verifyFilenameOffset(c, w, cfg.Filename, headerSize)
+	end, err := streamer.RelayFileEnd(cfg.RelayDir, cfg.Filename)
+	c.Assert(err, check.IsNil)
+	c.Assert(end, check.Equals, int64(allData.Len()))
+
+	// all events are read back through the segments
+	var obtainData bytes.Buffer
+	obtainData.Write(replication.BinLogFileHeader)
+	p := replication.NewBinlogParser()
+	p.SetRawMode(true)
+	err = streamer.ParseRelayFile(p, cfg.RelayDir, cfg.Filename, 4, func(e *replication.BinlogEvent) error {
+		obtainData.Write(e.RawData)
+		return nil
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(obtainData.Bytes(), check.DeepEquals, allData.Bytes())
+
+	// events in the previous segments are duplicate
+	for _, ev := range dmlEvents {
+		result, err2 := w.WriteEvent(ev)
+		c.Assert(err2, check.IsNil)
+		c.Assert(result.Ignore, check.IsTrue)
+		c.Assert(result.IgnoreReason, check.Equals, ignoreReasonAlreadyExists)
+	}
+	c.Assert(w.Close(), check.IsNil)
+
+	// write an incomplete transaction into the last segment, and recover it
+	f, err := os.OpenFile(filepath.Join(cfg.RelayDir, last.Filename), os.O_WRONLY|os.O_APPEND, 0o644)
+	c.Assert(err, check.IsNil)
+	_, err = f.Write(dmlEvents[0].RawData)
+	c.Assert(err, check.IsNil)
+	c.Assert(f.Close(), check.IsNil)
+
+	w = NewFileWriter(log.L(), cfg, t.parser)
+	defer w.Close()
+	c.Assert(w.Start(), check.IsNil)
+	result, err := w.Recover(context.Background())
+	c.Assert(err, check.IsNil)
+	c.Assert(result.Truncated, check.IsTrue)
+	c.Assert(result.LatestPos, check.DeepEquals, gmysql.Position{Name: cfg.Filename, Pos: uint32(allData.Len())})
+	c.Assert(result.LatestGTIDs, check.DeepEquals, expectedGTIDs)
+	fs, err := os.Stat(filepath.Join(cfg.RelayDir, last.Filename))
+	c.Assert(err, check.IsNil)
+	c.Assert(fs.Size(), check.Equals, headerSize)
+}
+
 func (t *testFileWriterSuite) TestHandleFileHoleExist(c *check.C) {
 	var (
 		cfg = &FileConfig{
@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/dm/pkg/binlog/event"
 	"github.com/pingcap/dm/pkg/binlog/reader"
 	"github.com/pingcap/dm/pkg/gtid"
+	"github.com/pingcap/dm/pkg/streamer"
 	"github.com/pingcap/dm/pkg/terror"
 	"github.com/pingcap/dm/relay/common"
 )
@@ -127,13 +128,18 @@ func checkFormatDescriptionEventExist(filename string) (bool, error) {
 // It is not safe if there other routine is writing the file.
 // NOTE: handle cases when file size > 4GB.
 func checkIsDuplicateEvent(filename string, ev *replication.BinlogEvent) (bool, error) {
+	return checkIsDuplicateEventInSegment(filename, streamer.Segment{}, ev)
+}
+
+// checkIsDuplicateEventInSegment checks if the event is a duplicate event in the segment of a binlog file.
+func checkIsDuplicateEventInSegment(filename string, seg streamer.Segment, ev *replication.BinlogEvent) (bool, error) {
 	// 1. check event start/end pos with the file size, and it's enough for most cases
 	fs, err := os.Stat(filename)
 	if err != nil {
 		return false, terror.Annotatef(terror.ErrRelayCheckIsDuplicateEvent.New(err.Error()), "get stat for %s", filename)
 	}
-	evStartPos := int64(ev.Header.LogPos - ev.Header.EventSize)
-	evEndPos := int64(ev.Header.LogPos)
+	evStartPos := seg.ToLocal(int64(ev.Header.LogPos - ev.Header.EventSize))
+	evEndPos := seg.ToLocal(int64(ev.Header.LogPos))
 	if fs.Size() <= evStartPos {
 		return false, nil // the event not in the file
 	} else if fs.Size() < evEndPos {
@@ -161,10 +167,167 @@ func checkIsDuplicateEvent(filename string, ev *replication.BinlogEvent) (bool,
 	return true, nil
 }
 
+// checkIsTxnEnd checks whether the event completes a transaction, that is a XIDEvent or a DDL in QueryEvent.
+func checkIsTxnEnd(ev *replication.BinlogEvent, p *parser.Parser) bool {
+	switch e := ev.Event.(type) {
+	case *replication.XIDEvent:
+		return true
+	case *replication.QueryEvent:
+		return common.CheckIsDDL(string(e.Query), p)
+	default:
+		return false
+	}
+}
+
 // getTxnPosGTIDs gets position/GTID set for all completed transactions from a binlog file.
 // It is not safe if there other routine is writing the file.
 // NOTE: we use a int64 rather than a uint32 to represent the latest transaction's end log pos.
 func getTxnPosGTIDs(ctx context.Context, filename string, p *parser.Parser) (int64, gtid.Set, error) {
+	return getTxnPosGTIDsFromSegments(ctx, []string{filename}, p)
+}
+
+// txnRecordType is the type of a txnRecord.
+type txnRecordType int
+
+const (
+	txnRecordFDE     txnRecordType = iota + 1 // FormatDescriptionEvent
+	txnRecordTxnEnd                           // XIDEvent or DDL in QueryEvent
+	txnRecordGTID                             // GTIDEvent or MariadbGTIDEvent
+	txnRecordGTIDSet                          // PreviousGTIDsEvent or MariadbGTIDListEvent
+)
+
+// txnRecord is the information of a binlog event needed to get the position/GTID set of the completed transactions.
+type txnRecord struct {
+	tp     txnRecordType
+	header replication.EventHeader
+	value  string // the GTID of txnRecordGTID, or the GTID set of txnRecordGTIDSet
+	flavor string // the flavor of txnRecordGTIDSet
+}
+
+// newTxnRecord returns the txnRecord of the event, false if the event is not needed.
+func newTxnRecord(e *replication.BinlogEvent, isDDL func(query string) bool) (txnRecord, bool, error) {
+	rec := txnRecord{header: *e.Header}
+	switch ev := e.Event.(type) {
+	case *replication.FormatDescriptionEvent:
+		rec.tp = txnRecordFDE
+	case *replication.QueryEvent:
+		if !isDDL(string(ev.Query)) {
+			return rec, false, nil
+		}
+		rec.tp = txnRecordTxnEnd
+	case *replication.XIDEvent:
+		rec.tp = txnRecordTxnEnd
+	case *replication.GTIDEvent:
+		// learn from: https://github.com/go-mysql-org/go-mysql/blob/c6ab05a85eb86dc51a27ceed6d2f366a32874a24/replication/binlogsyncer.go#L736
+		u, _ := uuid.FromBytes(ev.SID)
+		rec.tp = txnRecordGTID
+		rec.value = fmt.Sprintf("%s:%d", u.String(), ev.GNO)
+	case *replication.MariadbGTIDEvent:
+		// learn from: https://github.com/go-mysql-org/go-mysql/blob/c6ab05a85eb86dc51a27ceed6d2f366a32874a24/replication/binlogsyncer.go#L745
+		GTID := ev.GTID
+		rec.tp = txnRecordGTID
+		rec.value = fmt.Sprintf("%d-%d-%d", GTID.DomainID, GTID.ServerID, GTID.SequenceNumber)
+	case *replication.PreviousGTIDsEvent:
+		// if GTID enabled, we can get a PreviousGTIDEvent after the FormatDescriptionEvent
+		// ref: https://github.com/mysql/mysql-server/blob/8cc757da3d87bf4a1f07dcfb2d3c96fed3806870/sql/binlog.cc#L4549
+		// ref: https://github.com/mysql/mysql-server/blob/8cc757da3d87bf4a1f07dcfb2d3c96fed3806870/sql/binlog.cc#L5161
+		rec.tp = txnRecordGTIDSet
+		rec.value = ev.GTIDSets
+		rec.flavor = gmysql.MySQLFlavor
+	case *replication.MariadbGTIDListEvent:
+		// a MariadbGTIDListEvent logged in every binlog to record the current replication state if GTID enabled
+		// ref: https://mariadb.com/kb/en/library/gtid_list_event/
+		gSet, err := event.GTIDsFromMariaDBGTIDListEvent(e)
+		if err != nil {
+			return rec, false, terror.Annotatef(err, "get GTID set from MariadbGTIDListEvent %+v", e.Header)
+		}
+		rec.tp = txnRecordGTIDSet
+		rec.value = gSet.String()
+		rec.flavor = gmysql.MariaDBFlavor
+	default:
+		return rec, false, nil
+	}
+	return rec, true, nil
+}
+
+// txnRecorder gets the position/GTID set of the completed transactions from the txnRecords in order.
+// NOTE: only update pos/GTID set for DDL/XID to get an complete transaction.
+type txnRecorder struct {
+	latestPos   int64
+	latestGSet  gmysql.GTIDSet
+	nextGTIDStr string // can be recorded if the coming transaction completed
+	flavor      string
+}
+
+// apply applies the record of the event in the segment.
+func (r *txnRecorder) apply(rec txnRecord, firstSegment bool) error {
+	switch rec.tp {
+	case txnRecordFDE:
+		if firstSegment { // the FormatDescriptionEvent of a segment has the position of the first segment
+			r.latestPos = int64(rec.header.LogPos)
+		}
+	case txnRecordTxnEnd:
+		if r.latestGSet != nil { // GTID may not be enabled in the binlog
+			if err := r.latestGSet.Update(r.nextGTIDStr); err != nil {
+				return terror.ErrRelayUpdateGTID.Delegate(err, r.latestGSet, r.nextGTIDStr)
+			}
+		}
+		r.latestPos = int64(rec.header.LogPos)
+	case txnRecordGTID:
+		if r.latestGSet == nil {
+			if rec.header.EventType == replication.MARIADB_GTID_EVENT {
+				return terror.ErrRelayNeedMaGTIDListEvBeforeGTIDEv.Generate(&rec.header)
+			}
+			return terror.ErrRelayNeedPrevGTIDEvBeforeGTIDEv.Generate(&rec.header)
+		}
+		r.nextGTIDStr = rec.value
+	case txnRecordGTIDSet:
+		gSet, err := gtid.ParserGTID(rec.flavor, rec.value)
+		if err != nil {
+			return err
+		}
+		r.latestGSet = gSet.Origin()
+		r.flavor = rec.flavor
+		r.latestPos = int64(rec.header.LogPos)
+	}
+	return nil
+}
+
+// result returns the position/GTID set of the completed transactions.
+func (r *txnRecorder) result() (int64, gtid.Set, error) {
+	if r.latestGSet == nil {
+		return r.latestPos, nil, nil
+	}
+	latestGTIDs, err := gtid.ParserGTID(r.flavor, r.latestGSet.String())
+	if err != nil {
+		return 0, nil, terror.Annotatef(err, "parse GTID set %s with flavor %s", r.latestGSet.String(), r.flavor)
+	}
+	return r.latestPos, latestGTIDs, nil
+}
+
+// getTxnPosGTIDsFromSegments gets position/GTID set for all completed transactions from the segments of a binlog file,
+// the position is in the upstream binlog file.
+func getTxnPosGTIDsFromSegments(ctx context.Context, filenames []string, p *parser.Parser) (int64, gtid.Set, error) {
+	recorder := &txnRecorder{}
+	for i, filename := range filenames {
+		first := i == 0
+		err := scanTxnRecords(ctx, filename, p, func(rec txnRecord) error {
+			return recorder.apply(rec, first)
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	latestPos, latestGTIDs, err := recorder.result()
+	if err != nil {
+		return 0, nil, err
+	}
+	return latestPos, latestGTIDs, ctx.Err() // return the error if the context is done.
+}
+
+// readTxnRecords reads the events of a binlog file one by one, and handles the txnRecords of them in order.
+func readTxnRecords(ctx context.Context, filename string, p *parser.Parser, handle func(txnRecord) error) error {
 	// use a FileReader to parse the binlog file.
 	rCfg := &reader.FileReaderConfig{
 		EnableRawMode: false, // in order to get GTID set, we always disable RawMode.
@@ -174,93 +337,30 @@ func getTxnPosGTIDs(ctx context.Context, filename string, p *parser.Parser) (int
 	defer r.Close()
 	err := r.StartSyncByPos(startPos) // we always parse the file by pos
 	if err != nil {
-		return 0, nil, terror.Annotatef(err, "start sync by pos %s for %s", startPos, filename)
+		return terror.Annotatef(err, "start sync by pos %s for %s", startPos, filename)
 	}
 
-	var (
-		latestPos   int64
-		latestGSet  gmysql.GTIDSet
-		nextGTIDStr string // can be recorded if the coming transaction completed
-		flavor      string
-	)
+	isDDL := func(query string) bool {
+		return common.CheckIsDDL(query, p)
+	}
 	for {
 		var e *replication.BinlogEvent
 		ctx2, cancel2 := context.WithTimeout(ctx, time.Second)
 		e, err = r.GetEvent(ctx2)
 		cancel2()
 		if err != nil {
-			break // now, we stop to parse for any errors even is context done
+			return nil // now, we stop to parse for any errors even is context done
 		}
 
-		// NOTE: only update pos/GTID set for DDL/XID to get an complete transaction.
-		switch ev := e.Event.(type) {
-		case *replication.FormatDescriptionEvent:
-			latestPos = int64(e.Header.LogPos)
-		case *replication.QueryEvent:
-			isDDL := common.CheckIsDDL(string(ev.Query), p)
-			if isDDL {
-				if latestGSet != nil { // GTID may not be enabled in the binlog
-					err = latestGSet.Update(nextGTIDStr)
-					if err != nil {
-						return 0, nil, terror.ErrRelayUpdateGTID.Delegate(err, latestGSet, nextGTIDStr)
-					}
-				}
-				latestPos = int64(e.Header.LogPos)
-			}
-		case *replication.XIDEvent:
-			if latestGSet != nil { // GTID may not be enabled in the binlog
-				err = latestGSet.Update(nextGTIDStr)
-				if err != nil {
-					return 0, nil, terror.ErrRelayUpdateGTID.Delegate(err, latestGSet, nextGTIDStr)
-				}
-			}
-			latestPos = int64(e.Header.LogPos)
-		case *replication.GTIDEvent:
-			if latestGSet == nil {
-				return 0, nil, terror.ErrRelayNeedPrevGTIDEvBeforeGTIDEv.Generate(e.Header)
-			}
-			// learn from: https://github.com/go-mysql-org/go-mysql/blob/c6ab05a85eb86dc51a27ceed6d2f366a32874a24/replication/binlogsyncer.go#L736
-			u, _ := uuid.FromBytes(ev.SID)
-			nextGTIDStr = fmt.Sprintf("%s:%d", u.String(), ev.GNO)
-		case *replication.MariadbGTIDEvent:
-			if latestGSet == nil {
-				return 0, nil, terror.ErrRelayNeedMaGTIDListEvBeforeGTIDEv.Generate(e.Header)
-			}
-			// learn from: https://github.com/go-mysql-org/go-mysql/blob/c6ab05a85eb86dc51a27ceed6d2f366a32874a24/replication/binlogsyncer.go#L745
-			GTID := ev.GTID
-			nextGTIDStr = fmt.Sprintf("%d-%d-%d", GTID.DomainID, GTID.ServerID, GTID.SequenceNumber)
-		case *replication.PreviousGTIDsEvent:
-			// if GTID enabled, we can get a PreviousGTIDEvent after the FormatDescriptionEvent
-			// ref: https://github.com/mysql/mysql-server/blob/8cc757da3d87bf4a1f07dcfb2d3c96fed3806870/sql/binlog.cc#L4549
-			// ref: https://github.com/mysql/mysql-server/blob/8cc757da3d87bf4a1f07dcfb2d3c96fed3806870/sql/binlog.cc#L5161
-			var gSet gtid.Set
-			gSet, err = gtid.ParserGTID(gmysql.MySQLFlavor, ev.GTIDSets)
-			if err != nil {
-				return 0, nil, err
-			}
-			latestGSet = gSet.Origin()
-			flavor = gmysql.MySQLFlavor
-			latestPos = int64(e.Header.LogPos)
-		case *replication.MariadbGTIDListEvent:
-			// a MariadbGTIDListEvent logged in every binlog to record the current replication state if GTID enabled
-			// ref: https://mariadb.com/kb/en/library/gtid_list_event/
-			gSet, err2 := event.GTIDsFromMariaDBGTIDListEvent(e)
-			if err2 != nil {
-				return 0, nil, terror.Annotatef(err2, "get GTID set from MariadbGTIDListEvent %+v", e.Header)
-			}
-			latestGSet = gSet.Origin()
-			flavor = gmysql.MariaDBFlavor
-			latestPos = int64(e.Header.LogPos)
+		rec, ok, err2 := newTxnRecord(e, isDDL)
+		if err2 != nil {
+			return err2
 		}
-	}
-
-	var latestGTIDs gtid.Set
-	if latestGSet != nil {
-		latestGTIDs, err = gtid.ParserGTID(flavor, latestGSet.String())
-		if err != nil {
-			return 0, nil, terror.Annotatef(err, "parse GTID set %s with flavor %s", latestGSet.String(), flavor)
+		if !ok {
+			continue
+		}
+		if err2 = handle(rec); err2 != nil {
+			return err2
 		}
 	}
-
-	return latestPos, latestGTIDs, ctx.Err() // return the error if the context is done.
 }
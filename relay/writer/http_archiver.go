@@ -0,0 +1,85 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// headers of the requests sent by HTTPArchiver.
+const (
+	ArchiveHeaderFile = "X-DM-Relay-File" // the relay log filename of the event
+	ArchiveHeaderPos  = "X-DM-Relay-Pos"  // the end position of the event in the relay log file
+
+	defaultArchiveTimeout = 10 * time.Second
+)
+
+// HTTPArchiver archives binlog events to an HTTP endpoint.
+// every event is POSTed as the body of a request, and a 2xx response acknowledges the event.
+// the endpoint should use the file and position headers to deduplicate the events.
+type HTTPArchiver struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPArchiver creates an HTTPArchiver.
+func NewHTTPArchiver(url string, timeout time.Duration) *HTTPArchiver {
+	if timeout <= 0 {
+		timeout = defaultArchiveTimeout
+	}
+	return &HTTPArchiver{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Archive implements Archiver.Archive.
+func (a *HTTPArchiver) Archive(ctx context.Context, filename string, ev *replication.BinlogEvent) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(ev.RawData))
+	if err != nil {
+		return terror.ErrRelayArchiveFailed.Delegate(err, filename, ev.Header.LogPos, a.url)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set(ArchiveHeaderFile, filename)
+	req.Header.Set(ArchiveHeaderPos, strconv.FormatUint(uint64(ev.Header.LogPos), 10))
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return terror.ErrRelayArchiveFailed.Delegate(err, filename, ev.Header.LogPos, a.url)
+	}
+	defer resp.Body.Close()
+	// drain the body to reuse the connection.
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return terror.ErrRelayArchiveFailed.Delegate(fmt.Errorf("unexpected status %s", resp.Status), filename, ev.Header.LogPos, a.url)
+	}
+	return nil
+}
+
+// Close implements Archiver.Close.
+func (a *HTTPArchiver) Close() error {
+	a.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/cputil"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+// tableApplyCount is the number of the row changes applied to a downstream table.
+type tableApplyCount struct {
+	inserted int64
+	updated  int64
+	deleted  int64
+}
+
+// applySummary counts the row changes applied to the downstream tables since it's reset.
+type applySummary struct {
+	sync.Mutex
+	counts map[filter.Table]*tableApplyCount
+}
+
+func newApplySummary() *applySummary {
+	return &applySummary{counts: make(map[filter.Table]*tableApplyCount)}
+}
+
+// add counts a row change executed in downstream.
+func (a *applySummary) add(tp opType, table *filter.Table) {
+	if table == nil {
+		return
+	}
+	a.Lock()
+	defer a.Unlock()
+	cnt, ok := a.counts[*table]
+	if !ok {
+		cnt = &tableApplyCount{}
+		a.counts[*table] = cnt
+	}
+	switch tp {
+	case insert:
+		cnt.inserted++
+	case update:
+		cnt.updated++
+	case del:
+		cnt.deleted++
+	}
+}
+
+// reset returns the counts and clears them.
+func (a *applySummary) reset() map[filter.Table]*tableApplyCount {
+	a.Lock()
+	defer a.Unlock()
+	counts := a.counts
+	a.counts = make(map[filter.Table]*tableApplyCount)
+	return counts
+}
+
+// applySummaryRecorder writes the summaries of the row changes applied in each interval into an audit table in the
+// downstream meta schema, so downstream owners could query what DM changed and when.
+type applySummaryRecorder struct {
+	cfg       *config.SubTaskConfig
+	tableName string // qualified table name: `dm_meta`.`task_syncer_apply_summary`
+
+	db     *conn.BaseDB
+	dbConn *dbconn.DBConn
+	logCtx *tcontext.Context
+}
+
+// newApplySummaryRecorder creates a new applySummaryRecorder.
+func newApplySummaryRecorder(tctx *tcontext.Context, cfg *config.SubTaskConfig) *applySummaryRecorder {
+	return &applySummaryRecorder{
+		cfg:       cfg,
+		tableName: dbutil.TableName(cfg.MetaSchema, cputil.SyncerApplySummary(cfg.Name)),
+		logCtx:    tcontext.Background().WithLogger(tctx.L().WithFields(zap.String("component", "apply summary recorder"))),
+	}
+}
+
+// init creates the connection and the audit table.
+func (r *applySummaryRecorder) init(tctx *tcontext.Context) error {
+	recorderDB := r.cfg.To
+	recorderDB.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxCheckPointTimeout)
+	db, dbConns, err := dbconn.CreateConns(tctx, r.cfg, recorderDB, 1)
+	if err != nil {
+		return err
+	}
+	r.db = db
+	r.dbConn = dbConns[0]
+
+	sqls := []string{
+		fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(r.cfg.MetaSchema)),
+		`CREATE TABLE IF NOT EXISTS ` + r.tableName + ` (
+			id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			source_id VARCHAR(32) NOT NULL,
+			target_schema VARCHAR(128) NOT NULL,
+			target_table VARCHAR(128) NOT NULL,
+			rows_inserted BIGINT NOT NULL,
+			rows_updated BIGINT NOT NULL,
+			rows_deleted BIGINT NOT NULL,
+			start_binlog_name VARCHAR(128),
+			start_binlog_pos INT UNSIGNED,
+			start_binlog_gtid TEXT,
+			end_binlog_name VARCHAR(128),
+			end_binlog_pos INT UNSIGNED,
+			end_binlog_gtid TEXT,
+			start_time timestamp NOT NULL,
+			end_time timestamp NOT NULL,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX idx_end_time (end_time)
+		)`,
+	}
+	_, err = r.dbConn.ExecuteSQL(tctx, sqls)
+	r.logCtx.L().Info("create apply summary table", zap.Strings("statements", sqls))
+	return terror.WithScope(err, terror.ScopeDownstream)
+}
+
+// record writes a summary row for every table changed in the interval, in a single transaction.
+func (r *applySummaryRecorder) record(tctx *tcontext.Context, counts map[filter.Table]*tableApplyCount,
+	startLoc, endLoc binlog.Location, startTime, endTime time.Time) error {
+	if len(counts) == 0 {
+		return nil
+	}
+	tables := make([]filter.Table, 0, len(counts))
+	for table := range counts {
+		tables = append(tables, table)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Schema != tables[j].Schema {
+			return tables[i].Schema < tables[j].Schema
+		}
+		return tables[i].Name < tables[j].Name
+	})
+
+	var (
+		query = `INSERT INTO ` + r.tableName + ` (source_id, target_schema, target_table, rows_inserted, rows_updated, rows_deleted,
+			start_binlog_name, start_binlog_pos, start_binlog_gtid, end_binlog_name, end_binlog_pos, end_binlog_gtid, start_time, end_time) VALUES `
+		placeholders = make([]string, 0, len(tables))
+		args         = make([]interface{}, 0, len(tables)*14)
+	)
+	for _, table := range tables {
+		cnt := counts[table]
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		args = append(args, r.cfg.SourceID, table.Schema, table.Name, cnt.inserted, cnt.updated, cnt.deleted,
+			startLoc.Position.Name, startLoc.Position.Pos, startLoc.GTIDSetStr(),
+			endLoc.Position.Name, endLoc.Position.Pos, endLoc.GTIDSetStr(), startTime, endTime)
+	}
+	_, err := r.dbConn.ExecuteSQL(tctx, []string{query + strings.Join(placeholders, ", ")}, args)
+	return terror.WithScope(err, terror.ScopeDownstream)
+}
+
+// close closes the connection.
+func (r *applySummaryRecorder) close() {
+	dbconn.CloseBaseDB(r.logCtx, r.db)
+}
+
+// applySummaryLoop writes the summaries of the row changes applied to downstream every `apply-summary-interval`, the
+// positions covered by an interval are the global checkpoints at its beginning and end. failing to write only logs a
+// warning, and the counts of that interval are dropped.
+func (s *Syncer) applySummaryLoop(ctx context.Context) {
+	interval := time.Duration(s.cfg.ApplySummaryInterval) * time.Second
+	logger := s.applySummaryRecorder.logCtx.L()
+
+	startLoc, startTime := s.checkpoint.GlobalPoint(), time.Now()
+	flush := func(tctx *tcontext.Context) {
+		endLoc, endTime := s.checkpoint.GlobalPoint(), time.Now()
+		counts := s.applySummary.reset()
+		if err := s.applySummaryRecorder.record(tctx, counts, startLoc, endLoc, startTime, endTime); err != nil {
+			logger.Warn("fail to write apply summary", zap.Int("tables", len(counts)),
+				zap.Stringer("start location", startLoc), zap.Stringer("end location", endLoc), log.ShortError(err))
+		}
+		startLoc, startTime = endLoc, endTime
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// write the counts of the last incomplete interval before exiting.
+			flushCtx, cancel := context.WithTimeout(context.Background(), utils.DefaultDBTimeout)
+			flush(s.tctx.WithContext(flushCtx).WithLogger(logger))
+			cancel()
+			return
+		case <-ticker.C:
+		}
+		flush(s.tctx.WithContext(ctx).WithLogger(logger))
+	}
+}
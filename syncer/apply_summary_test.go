@@ -0,0 +1,79 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/retry"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestApplySummary(c *C) {
+	var (
+		tb1 = &filter.Table{Schema: "db", Name: "tb1"}
+		tb2 = &filter.Table{Schema: "db", Name: "tb2"}
+	)
+	summary := newApplySummary()
+	summary.add(insert, tb1)
+	summary.add(insert, tb1)
+	summary.add(update, tb1)
+	summary.add(del, tb2)
+	summary.add(insert, nil)
+	counts := summary.reset()
+	c.Assert(counts, DeepEquals, map[filter.Table]*tableApplyCount{
+		*tb1: {inserted: 2, updated: 1},
+		*tb2: {deleted: 1},
+	})
+	c.Assert(summary.reset(), HasLen, 0)
+
+	tctx := tcontext.Background()
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "mysql-replica-01", MetaSchema: "dm_meta"}
+	recorder := newApplySummaryRecorder(tctx, cfg)
+	c.Assert(recorder.tableName, Equals, "`dm_meta`.`test_syncer_apply_summary`")
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(tctx.Context())
+	c.Assert(err, IsNil)
+	recorder.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+
+	var (
+		startLoc  = binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 4}}
+		endLoc    = binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 1234}}
+		startTime = time.Now()
+		endTime   = startTime.Add(time.Minute)
+	)
+	// nothing changed, nothing written.
+	c.Assert(recorder.record(tctx, nil, startLoc, endLoc, startTime, endTime), IsNil)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `dm_meta`.`test_syncer_apply_summary`")).WithArgs(
+		"mysql-replica-01", "db", "tb1", 2, 1, 0, "mysql-bin.000001", 4, "", "mysql-bin.000001", 1234, "", startTime, endTime,
+		"mysql-replica-01", "db", "tb2", 0, 0, 1, "mysql-bin.000001", 4, "", "mysql-bin.000001", 1234, "", startTime, endTime,
+	).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	c.Assert(recorder.record(tctx, counts, startLoc, endLoc, startTime, endTime), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
@@ -0,0 +1,151 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
+	"github.com/pingcap/tidb/parser/ast"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/log"
+	parserpkg "github.com/pingcap/dm/pkg/parser"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// genBroadcastRouters generates a table router for every broadcast route rule, so a table can be matched by
+// several rules with the same patterns.
+func genBroadcastRouters(caseSensitive bool, rules []*router.TableRule) ([]*router.Table, error) {
+	routers := make([]*router.Table, 0, len(rules))
+	for _, rule := range rules {
+		r, err := router.NewTableRouter(caseSensitive, []*router.TableRule{rule})
+		if err != nil {
+			return nil, terror.ErrSyncerUnitGenTableRouter.Delegate(err)
+		}
+		routers = append(routers, r)
+	}
+	return routers, nil
+}
+
+// broadcastTargets returns the additional target tables of sourceTable, the target table routed by `routes`
+// is excluded.
+func (s *Syncer) broadcastTargets(sourceTable, targetTable *filter.Table) []*filter.Table {
+	if len(s.broadcastRouters) == 0 || sourceTable.Schema == "" {
+		return nil
+	}
+
+	schemaL, tableL := sourceTable.Schema, sourceTable.Name
+	if !s.cfg.CaseSensitive {
+		schemaL, tableL = strings.ToLower(schemaL), strings.ToLower(tableL)
+	}
+	seen := map[string]struct{}{targetTable.String(): {}}
+	var targets []*filter.Table
+	for _, r := range s.broadcastRouters {
+		// the router returns the source table if no rule is matched.
+		if len(r.Match(schemaL, tableL)) == 0 {
+			continue
+		}
+		schema, table, err := r.Route(sourceTable.Schema, sourceTable.Name)
+		if err != nil {
+			s.tctx.L().Error("fail to route table by broadcast route", zap.Stringer("table", sourceTable), zap.Error(err))
+			continue
+		}
+		target := &filter.Table{Schema: schema, Name: table}
+		if _, ok := seen[target.String()]; ok {
+			continue
+		}
+		seen[target.String()] = struct{}{}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// cloneDMLsForTarget clones the DMLs to be written into another target table.
+func cloneDMLsForTarget(dmls []*DML, targetTable *filter.Table) []*DML {
+	res := make([]*DML, 0, len(dmls))
+	for _, dml := range dmls {
+		clone := *dml
+		clone.targetTableID = utils.GenTableID(targetTable)
+		// the partitions are located for every target table.
+		clone.partitions = nil
+		res = append(res, &clone)
+	}
+	return res
+}
+
+// genBroadcastDDLs generates the DDLs of the broadcast target tables for a routed DDL. DDLs on several tables like
+// `RENAME TABLE` are not broadcast because the broadcast target of the other tables may be different.
+func (s *Syncer) genBroadcastDDLs(info *ddlInfo) ([]string, error) {
+	if len(info.sourceTables) != 1 {
+		return nil, nil
+	}
+	targets := s.broadcastTargets(info.sourceTables[0], info.targetTables[0])
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	_, isCreateTable := info.originStmt.(*ast.CreateTableStmt)
+	ddls := make([]string, 0, 2*len(targets))
+	for _, target := range targets {
+		if info.deleteRows {
+			ddls = append(ddls, deleteRowsSQL(target, info.deleteWhere))
+			continue
+		}
+		// info.originStmt is already rewritten to the target table of `routes`, so rewrite it again.
+		ddl, err := parserpkg.RenameDDLTable(info.originStmt, []*filter.Table{target})
+		if err != nil {
+			return nil, err
+		}
+		// the broadcast target schema may be not created by the DDLs of the upstream.
+		if isCreateTable {
+			ddls = append(ddls, fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dbutil.ColumnName(target.Schema)))
+		}
+		ddls = append(ddls, ddl)
+	}
+	// restore the statement for the following processes.
+	if _, err := parserpkg.RenameDDLTable(info.originStmt, info.targetTables); err != nil {
+		return nil, err
+	}
+	return ddls, nil
+}
+
+// handleBroadcastDDLs executes the DDLs of the broadcast target tables, the previous DMLs should be flushed.
+// the DDLs are executed before the DDL of the target table, so they may be re-executed when resuming and the errors
+// of existing objects are ignored.
+func (s *Syncer) handleBroadcastDDLs(qec *queryEventContext) error {
+	// the error of the previous DMLs is reported by the main process, like the DDL jobs.
+	if len(qec.broadcastDDLs) == 0 || s.execError.Load() != nil {
+		return nil
+	}
+	ddls, err := s.hookDDLs(qec.tctx, qec.broadcastDDLs)
+	if err != nil {
+		return err
+	}
+	qec.tctx.L().Info("execute broadcast DDLs", zap.String("event", "query"), zap.Strings("ddls", ddls))
+	if _, err = s.executeDDLs(qec.tctx, s.ddlDBConn, ddls); err != nil {
+		qec.tctx.L().Error("fail to execute broadcast DDLs", zap.Strings("ddls", ddls), log.ShortError(err))
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	for _, ddl := range ddls {
+		if schema, table, err2 := ddlTargetTable(ddl); err2 == nil && table != "" {
+			s.partitions.ResetLocator(&filter.Table{Schema: schema, Name: table})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	router "github.com/pingcap/tidb-tools/pkg/table-router"
+	"github.com/pingcap/tidb/parser"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+)
+
+var _ = Suite(&testBroadcastSuite{})
+
+type testBroadcastSuite struct{}
+
+func (t *testBroadcastSuite) newSyncer(c *C) *Syncer {
+	cfg := &config.SubTaskConfig{
+		RouteRules: []*router.TableRule{
+			{SchemaPattern: "db", TablePattern: "tb*", TargetSchema: "db", TargetTable: "tb"},
+		},
+		BroadcastRouteRules: []*router.TableRule{
+			{SchemaPattern: "db", TablePattern: "tb*", TargetSchema: "report"},
+			{SchemaPattern: "db", TablePattern: "tb*", TargetSchema: "archive", TargetTable: "tb_all"},
+			// same as the target of `routes`
+			{SchemaPattern: "db", TablePattern: "tb1", TargetSchema: "db", TargetTable: "tb"},
+			// duplicated target
+			{SchemaPattern: "db", TablePattern: "tb1", TargetSchema: "report", TargetTable: "tb1"},
+		},
+	}
+	syncer := NewSyncer(cfg, nil)
+	syncer.tctx = tcontext.Background()
+	c.Assert(syncer.genRouter(), IsNil)
+	return syncer
+}
+
+func (t *testBroadcastSuite) TestBroadcastTargets(c *C) {
+	syncer := t.newSyncer(c)
+
+	source := &filter.Table{Schema: "db", Name: "tb1"}
+	targets := syncer.broadcastTargets(source, syncer.route(source))
+	c.Assert(targets, DeepEquals, []*filter.Table{
+		{Schema: "report", Name: "tb1"},
+		{Schema: "archive", Name: "tb_all"},
+	})
+
+	c.Assert(syncer.broadcastTargets(&filter.Table{Schema: "db2", Name: "tb1"}, &filter.Table{Schema: "db2", Name: "tb1"}), HasLen, 0)
+
+	dmls := []*DML{{targetTableID: "`db`.`tb`", sourceTable: source, partitions: []string{"p0"}}}
+	clones := cloneDMLsForTarget(dmls, targets[1])
+	c.Assert(clones, HasLen, 1)
+	c.Assert(clones[0].targetTableID, Equals, "`archive`.`tb_all`")
+	c.Assert(clones[0].partitions, IsNil)
+	c.Assert(clones[0].sourceTable, Equals, source)
+	c.Assert(dmls[0].targetTableID, Equals, "`db`.`tb`")
+}
+
+func (t *testBroadcastSuite) TestGenBroadcastDDLs(c *C) {
+	syncer := t.newSyncer(c)
+	p := parser.New()
+
+	info, err := syncer.routeDDL(p, "db", "CREATE TABLE `db`.`tb1` (`id` INT PRIMARY KEY)")
+	c.Assert(err, IsNil)
+	ddls, err := syncer.genBroadcastDDLs(info)
+	c.Assert(err, IsNil)
+	c.Assert(ddls, DeepEquals, []string{
+		"CREATE DATABASE IF NOT EXISTS `report`",
+		"CREATE TABLE `report`.`tb1` (`id` INT PRIMARY KEY)",
+		"CREATE DATABASE IF NOT EXISTS `archive`",
+		"CREATE TABLE `archive`.`tb_all` (`id` INT PRIMARY KEY)",
+	})
+	c.Assert(info.routedDDL, Equals, "CREATE TABLE `db`.`tb` (`id` INT PRIMARY KEY)")
+
+	info, err = syncer.routeDDL(p, "db", "ALTER TABLE `db`.`tb2` ADD COLUMN `c` INT")
+	c.Assert(err, IsNil)
+	ddls, err = syncer.genBroadcastDDLs(info)
+	c.Assert(err, IsNil)
+	c.Assert(ddls, DeepEquals, []string{
+		"ALTER TABLE `report`.`tb2` ADD COLUMN `c` INT",
+		"ALTER TABLE `archive`.`tb_all` ADD COLUMN `c` INT",
+	})
+
+	// DDLs on several tables are not broadcast
+	info, err = syncer.routeDDL(p, "db", "RENAME TABLE `db`.`tb1` TO `db`.`tb3`")
+	c.Assert(err, IsNil)
+	ddls, err = syncer.genBroadcastDDLs(info)
+	c.Assert(err, IsNil)
+	c.Assert(ddls, HasLen, 0)
+}
@@ -63,9 +63,12 @@ func (c *causality) run() {
 		metrics.QueueSizeGauge.WithLabelValues(c.task, "causality_input", c.source).Set(float64(len(c.inCh)))
 
 		startTime := time.Now()
-		if j.tp == flush {
+		switch j.tp {
+		case flush:
 			c.reset()
-		} else {
+		case asyncFlush:
+			// DML queues are not synchronized by asyncFlush job, so the relations should be kept.
+		default:
 			keys := j.dml.identifyKeys()
 			// detectConflict before add
 			if c.detectConflict(keys) {
@@ -0,0 +1,227 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+	parserpkg "github.com/pingcap/dm/pkg/parser"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// transcodeColumn is a string column whose values are decoded into UTF-8.
+type transcodeColumn struct {
+	offset  int
+	name    string
+	charset string
+}
+
+// CharsetConvertGroup converts the charsets in DDLs and transcodes the string values in row changes by the
+// charset-convert rules.
+type CharsetConvertGroup struct {
+	convert  func(cs string) (string, string, bool)
+	decoders map[string]func(string) (string, error) // charset -> decoder, only the charsets to transcode
+	columns  map[string][]transcodeColumn            // tableName -> columns to transcode
+
+	// the column charsets are resolved from the CREATE TABLE statement of upstream, because the tracked table may be
+	// created with the converted charsets in downstream.
+	getCreateTableSQL func(ctx context.Context, table *filter.Table) (string, error)
+}
+
+// NewCharsetConvertGroup creates a CharsetConvertGroup, it returns nil if there is no rule.
+func NewCharsetConvertGroup(rules []*config.CharsetConvertRule, getCreateTableSQL func(ctx context.Context, table *filter.Table) (string, error)) *CharsetConvertGroup {
+	if len(rules) == 0 {
+		return nil
+	}
+	g := &CharsetConvertGroup{
+		convert:           config.CharsetConvertFunc(rules),
+		decoders:          map[string]func(string) (string, error){},
+		columns:           map[string][]transcodeColumn{},
+		getCreateTableSQL: getCreateTableSQL,
+	}
+	for _, rule := range rules {
+		if !rule.Transcode {
+			continue
+		}
+		enc, _ := charset.Lookup(rule.From)
+		if enc == nil {
+			continue
+		}
+		g.decoders[rule.From] = func(s string) (string, error) {
+			return enc.NewDecoder().String(s)
+		}
+	}
+	return g
+}
+
+// ConvertDDL converts the charsets in the routed DDL.
+func (g *CharsetConvertGroup) ConvertDDL(tctx *tcontext.Context, info *ddlInfo) error {
+	if g == nil || !parserpkg.ConvertCharset(info.originStmt, g.convert) {
+		return nil
+	}
+	// originStmt is already renamed to the target tables.
+	routedDDL, err := parserpkg.RenameDDLTable(info.originStmt, info.targetTables)
+	if err != nil {
+		return err
+	}
+	tctx.L().Info("convert the charsets of DDL", zap.String("statement", info.originDDL), zap.String("routed statement", routedDDL))
+	info.routedDDL = routedDDL
+	return nil
+}
+
+// ResetColumns deletes the resolved columns of the table. This should be called after table structure changed.
+func (g *CharsetConvertGroup) ResetColumns(table *filter.Table) {
+	if g == nil {
+		return
+	}
+	delete(g.columns, utils.GenTableID(table))
+}
+
+// getColumns returns the columns to transcode of given table.
+// This function will lazy resolve the columns if not initialized.
+func (g *CharsetConvertGroup) getColumns(tctx *tcontext.Context, table *filter.Table, ti *model.TableInfo) []transcodeColumn {
+	tableID := utils.GenTableID(table)
+	if ret, ok := g.columns[tableID]; ok {
+		return ret
+	}
+
+	charsets, err := g.upstreamColumnCharsets(tctx.Ctx, table)
+	if err != nil {
+		// the table may be dropped in upstream, the tracked table is the best we have.
+		tctx.L().Warn("fail to resolve the column charsets from upstream, use the tracked table instead",
+			zap.Stringer("table", table), log.ShortError(err))
+		charsets = make(map[string]string, len(ti.Columns))
+		for _, col := range ti.Columns {
+			charsets[col.Name.L] = strings.ToLower(col.Charset)
+		}
+	}
+
+	var ret []transcodeColumn
+	for _, col := range ti.Columns {
+		cs := charsets[col.Name.L]
+		if _, ok := g.decoders[cs]; ok && isStringType(col.Tp) {
+			ret = append(ret, transcodeColumn{offset: col.Offset, name: col.Name.O, charset: cs})
+		}
+	}
+	g.columns[tableID] = ret
+	return ret
+}
+
+// upstreamColumnCharsets returns the charsets of the string columns in the upstream table, column name -> charset.
+func (g *CharsetConvertGroup) upstreamColumnCharsets(ctx context.Context, table *filter.Table) (map[string]string, error) {
+	createSQL, err := g.getCreateTableSQL(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := parser.New().ParseOneStmt(createSQL, "", "")
+	if err != nil {
+		return nil, terror.ErrSyncerUnitParseStmt.Delegate(err, createSQL)
+	}
+	ct, ok := stmt.(*ast.CreateTableStmt)
+	if !ok {
+		return nil, terror.ErrSyncerUnitParseStmt.Generate(createSQL)
+	}
+
+	var tableCharset, tableCollation string
+	for _, opt := range ct.Options {
+		switch opt.Tp {
+		case ast.TableOptionCharset:
+			tableCharset = opt.StrValue
+		case ast.TableOptionCollate:
+			tableCollation = opt.StrValue
+		}
+	}
+	tableCharset = charsetOf(tableCharset, tableCollation)
+
+	charsets := make(map[string]string, len(ct.Cols))
+	for _, col := range ct.Cols {
+		collation := col.Tp.Collate
+		for _, opt := range col.Options {
+			if opt.Tp == ast.ColumnOptionCollate {
+				collation = opt.StrValue
+			}
+		}
+		cs := charsetOf(col.Tp.Charset, collation)
+		if cs == "" {
+			cs = tableCharset
+		}
+		charsets[col.Name.Name.L] = cs
+	}
+	return charsets, nil
+}
+
+// charsetOf returns the lowercase charset, it's derived from the collation if it's empty.
+func charsetOf(cs, co string) string {
+	if cs == "" && co != "" {
+		if collation, err := charset.GetCollationByName(co); err == nil {
+			cs = collation.CharsetName
+		}
+	}
+	return strings.ToLower(cs)
+}
+
+// TranscodeRows decodes the string values of the `from` columns into UTF-8, the original rows are not modified.
+func (g *CharsetConvertGroup) TranscodeRows(tctx *tcontext.Context, table *filter.Table, ti *model.TableInfo, rows [][]interface{}) ([][]interface{}, error) {
+	if g == nil || len(g.decoders) == 0 {
+		return rows, nil
+	}
+	columns := g.getColumns(tctx, table, ti)
+	if len(columns) == 0 {
+		return rows, nil
+	}
+
+	ret := make([][]interface{}, len(rows))
+	for i, row := range rows {
+		if len(row) != len(ti.Columns) {
+			return nil, terror.ErrSyncerUnitDMLColumnNotMatch.Generate(len(ti.Columns), len(row))
+		}
+		newRow := make([]interface{}, len(row))
+		copy(newRow, row)
+		for _, col := range columns {
+			v, err := g.transcode(newRow[col.offset], col.charset)
+			if err != nil {
+				return nil, terror.ErrSyncerCharsetTranscode.Delegate(err, col.name, table, col.charset)
+			}
+			newRow[col.offset] = v
+		}
+		ret[i] = newRow
+	}
+	return ret, nil
+}
+
+// transcode decodes a string value into UTF-8, other values are kept.
+func (g *CharsetConvertGroup) transcode(v interface{}, cs string) (interface{}, error) {
+	decode := g.decoders[cs]
+	switch x := v.(type) {
+	case string:
+		return decode(x)
+	case []byte:
+		s, err := decode(string(x))
+		return []byte(s), err
+	default:
+		return v, nil
+	}
+}
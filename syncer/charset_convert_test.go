@@ -0,0 +1,109 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+func (s *testSyncerSuite) TestCharsetConvertGroup(c *C) {
+	c.Assert(NewCharsetConvertGroup(nil, nil), IsNil)
+
+	rules := []*config.CharsetConvertRule{
+		{From: "latin1", To: "utf8mb4", Collation: "utf8mb4_bin", Transcode: true},
+		{From: "utf8", To: "utf8mb4"},
+	}
+	for i, rule := range rules {
+		c.Assert(rule.Adjust(i), IsNil)
+	}
+	createSQL := "CREATE TABLE `t` (`id` int, `a` varchar(10), `b` varchar(10) CHARACTER SET utf8, " +
+		"`c` blob, `d` text COLLATE latin1_bin) DEFAULT CHARSET=latin1"
+	var upstreamErr error
+	g := NewCharsetConvertGroup(rules, func(ctx context.Context, table *filter.Table) (string, error) {
+		return createSQL, upstreamErr
+	})
+	tctx := tcontext.Background()
+
+	// the table is tracked with the converted charsets, the column charsets are resolved from upstream.
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, "create table t(id int, a varchar(10), b varchar(10), c blob, d text) default charset=utf8mb4")
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "db", Name: "t"}
+
+	rows := [][]interface{}{
+		{1, "caf\xe9", "café", []byte("caf\xe9"), []byte("na\xefve")},
+		{2, nil, nil, nil, nil},
+	}
+	got, err := g.TranscodeRows(tctx, table, ti, rows)
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, [][]interface{}{
+		{1, "café", "café", []byte("caf\xe9"), []byte("naïve")},
+		{2, nil, nil, nil, nil},
+	})
+	// the original rows are not modified.
+	c.Assert(rows[0][1], Equals, "caf\xe9")
+
+	// the resolved columns are cached until reset.
+	upstreamErr = errors.New("table not exists")
+	got, err = g.TranscodeRows(tctx, table, ti, rows)
+	c.Assert(err, IsNil)
+	c.Assert(got[0][1], Equals, "café")
+	_, err = g.TranscodeRows(tctx, table, ti, [][]interface{}{{1, "a"}})
+	c.Assert(terror.ErrSyncerUnitDMLColumnNotMatch.Equal(err), IsTrue)
+	// fall back to the tracked table, which has no latin1 column.
+	g.ResetColumns(table)
+	got, err = g.TranscodeRows(tctx, table, ti, rows)
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, rows)
+
+	// convert the DDL.
+	stmt, err := p.ParseOneStmt("ALTER TABLE `db`.`t` MODIFY COLUMN `a` varchar(20) CHARACTER SET latin1", "", "")
+	c.Assert(err, IsNil)
+	targetTables := []*filter.Table{{Schema: "db2", Name: "t2"}}
+	info := &ddlInfo{
+		originDDL:    "ALTER TABLE `db`.`t` MODIFY COLUMN `a` varchar(20) CHARACTER SET latin1",
+		routedDDL:    "ALTER TABLE `db2`.`t2` MODIFY COLUMN `a` VARCHAR(20) CHARACTER SET LATIN1",
+		originStmt:   stmt,
+		sourceTables: []*filter.Table{table},
+		targetTables: targetTables,
+	}
+	c.Assert(g.ConvertDDL(tctx, info), IsNil)
+	c.Assert(info.routedDDL, Equals, "ALTER TABLE `db2`.`t2` MODIFY COLUMN `a` VARCHAR(20) CHARACTER SET UTF8MB4 COLLATE utf8mb4_bin")
+
+	// not converted DDL is kept.
+	stmt, err = p.ParseOneStmt("ALTER TABLE `db`.`t` ADD COLUMN `e` int", "", "")
+	c.Assert(err, IsNil)
+	info = &ddlInfo{originStmt: stmt, routedDDL: "ALTER TABLE `db2`.`t2` ADD COLUMN `e` INT", targetTables: targetTables}
+	c.Assert(g.ConvertDDL(tctx, info), IsNil)
+	c.Assert(info.routedDDL, Equals, "ALTER TABLE `db2`.`t2` ADD COLUMN `e` INT")
+
+	// nil group does nothing.
+	var nilGroup *CharsetConvertGroup
+	got, err = nilGroup.TranscodeRows(tctx, table, ti, rows)
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, rows)
+	c.Assert(nilGroup.ConvertDDL(tctx, info), IsNil)
+	nilGroup.ResetColumns(table)
+}
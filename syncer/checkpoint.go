@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -59,6 +60,9 @@ var (
 	globalCpSchema       = "" // global checkpoint's cp_schema
 	globalCpTable        = "" // global checkpoint's cp_table
 	maxCheckPointTimeout = "1m"
+
+	// max table checkpoints flushed in one SQL statement.
+	checkpointFlushBatchSize = 128
 )
 
 type binlogPoint struct {
@@ -69,6 +73,9 @@ type binlogPoint struct {
 
 	flushedLocation binlog.Location // location which flushed permanently
 	flushedTI       *model.TableInfo
+	// the serialized flushedTI, it's warmed when loading checkpoints and reused when flushing the point with
+	// an unchanged table info, to avoid serializing and rewriting the large table info again. nil if not cached.
+	flushedTIBytes []byte
 
 	enableGTID bool
 }
@@ -102,6 +109,31 @@ func (b *binlogPoint) flush() {
 	defer b.Unlock()
 	b.flushedLocation = b.location
 	b.flushedTI = b.ti
+	b.flushedTIBytes = nil
+}
+
+// flushBy marks the point as flushed to the location of a snapshot taken before,
+// the flushed location never goes back.
+// tiBytes is the serialized ti, nil if unknown.
+func (b *binlogPoint) flushBy(location binlog.Location, ti *model.TableInfo, tiBytes []byte) {
+	b.Lock()
+	defer b.Unlock()
+	if binlog.CompareLocation(location, b.flushedLocation, b.enableGTID) < 0 {
+		return
+	}
+	b.flushedLocation = location
+	b.flushedTI = ti
+	b.flushedTIBytes = tiBytes
+}
+
+// cachedTIBytes returns the serialized table info if ti is the flushed one and it's cached.
+func (b *binlogPoint) cachedTIBytes(ti *model.TableInfo) ([]byte, bool) {
+	b.RLock()
+	defer b.RUnlock()
+	if ti != b.flushedTI || b.flushedTIBytes == nil {
+		return nil, false
+	}
+	return b.flushedTIBytes, true
 }
 
 func (b *binlogPoint) rollback(schemaTracker *schema.Tracker, schema string) (isSchemaChanged bool) {
@@ -152,6 +184,13 @@ func (b *binlogPoint) FlushedMySQLLocation() binlog.Location {
 	return b.flushedLocation
 }
 
+// snapshot returns the point and the table schema associated at it.
+func (b *binlogPoint) snapshot() (binlog.Location, *model.TableInfo) {
+	b.RLock()
+	defer b.RUnlock()
+	return b.location.Clone(), b.ti
+}
+
 // TableInfo returns the table schema associated at the current binlog position.
 func (b *binlogPoint) TableInfo() *model.TableInfo {
 	b.RLock()
@@ -214,6 +253,14 @@ type CheckPoint interface {
 	// corresponding to Meta.Flush
 	FlushPointsExcept(tctx *tcontext.Context, exceptTables []*filter.Table, extraSQLs []string, extraArgs [][]interface{}) error
 
+	// Snapshot takes a snapshot of the global checkpoint and tables' checkpoints
+	// which are not flushed yet, the snapshot can be flushed later by FlushSnapshotPointsExcept
+	Snapshot() *SnapshotInfo
+
+	// FlushSnapshotPointsExcept flushes the checkpoints in the snapshot like
+	// FlushPointsExcept, checkpoints which are already flushed to a newer location are skipped
+	FlushSnapshotPointsExcept(tctx *tcontext.Context, snapshot *SnapshotInfo, exceptTables []*filter.Table, extraSQLs []string, extraArgs [][]interface{}) error
+
 	// FlushPointWithTableInfo flushed the table point with given table info
 	FlushPointWithTableInfo(tctx *tcontext.Context, table *filter.Table, ti *model.TableInfo) error
 
@@ -256,6 +303,28 @@ type CheckPoint interface {
 	CheckAndUpdate(ctx context.Context, schemas map[string]string, tables map[string]map[string]string) error
 }
 
+// tablePointSnapshot is a table checkpoint in the snapshot.
+type tablePointSnapshot struct {
+	point    *binlogPoint
+	location binlog.Location
+	ti       *model.TableInfo
+}
+
+// SnapshotInfo contains the checkpoints in memory when the snapshot is taken.
+type SnapshotInfo struct {
+	globalPoint                binlog.Location
+	safeModeExitPoint          *binlog.Location
+	needFlushSafeModeExitPoint bool
+
+	// source-schema -> source-table -> checkpoint, only contains the out of date tables' checkpoints
+	points map[string]map[string]tablePointSnapshot
+}
+
+// GlobalPoint returns the global checkpoint in the snapshot.
+func (s *SnapshotInfo) GlobalPoint() binlog.Location {
+	return s.globalPoint
+}
+
 // RemoteCheckPoint implements CheckPoint
 // which using target database to store info
 // NOTE: now we sync from relay log, so not add GTID support yet
@@ -282,6 +351,9 @@ type RemoteCheckPoint struct {
 	//   this global checkpoint is next-binlog-pos
 	globalPoint         *binlogPoint
 	globalPointSaveTime time.Time
+	// lastSnapshotCreationTime is used to decide whether to flush checkpoints again,
+	// the snapshot may be flushed asynchronously after it's taken.
+	lastSnapshotCreationTime time.Time
 
 	// safeModeExitPoint is set in RemoteCheckPoint.Load (from downstream DB) and LoadMeta (from metadata file).
 	// it is unset (set nil) in RemoteCheckPoint.Clear, and when syncer's stream pass its location.
@@ -323,6 +395,15 @@ func (cp *RemoteCheckPoint) Init(tctx *tcontext.Context) error {
 	return cp.prepare(tctx)
 }
 
+// executeSQL executes the statements on the checkpoint table. nothing is executed for a dry-run subtask, whose
+// checkpoints are only kept in memory so the subtask running for real later isn't affected.
+func (cp *RemoteCheckPoint) executeSQL(tctx *tcontext.Context, queries []string, args ...[]interface{}) (int, error) {
+	if cp.cfg.DryRun {
+		return len(queries), nil
+	}
+	return cp.dbConn.ExecuteSQL(tctx, queries, args...)
+}
+
 // Close implements CheckPoint.Close.
 func (cp *RemoteCheckPoint) Close() {
 	dbconn.CloseBaseDB(cp.logCtx, cp.db)
@@ -342,7 +423,7 @@ func (cp *RemoteCheckPoint) Clear(tctx *tcontext.Context) error {
 	// use a new context apart from syncer, to make sure when syncer call `cancel` checkpoint could update
 	tctx2, cancel := tctx.WithContext(context.Background()).WithTimeout(maxDMLConnectionDuration)
 	defer cancel()
-	_, err := cp.dbConn.ExecuteSQL(
+	_, err := cp.executeSQL(
 		tctx2,
 		[]string{`DELETE FROM ` + cp.tableName + ` WHERE id = ?`},
 		[]interface{}{cp.id},
@@ -353,6 +434,7 @@ func (cp *RemoteCheckPoint) Clear(tctx *tcontext.Context) error {
 
 	cp.globalPoint = newBinlogPoint(binlog.NewLocation(cp.cfg.Flavor), binlog.NewLocation(cp.cfg.Flavor), nil, nil, cp.cfg.EnableGTID)
 	cp.globalPointSaveTime = time.Time{}
+	cp.lastSnapshotCreationTime = time.Time{}
 	cp.points = make(map[string]map[string]*binlogPoint)
 	cp.safeModeExitPoint = nil
 
@@ -420,7 +502,7 @@ func (cp *RemoteCheckPoint) DeleteTablePoint(tctx *tcontext.Context, table *filt
 	tctx2, cancel := tctx.WithContext(context.Background()).WithTimeout(maxDMLConnectionDuration)
 	defer cancel()
 	cp.logCtx.L().Info("delete table checkpoint", zap.String("schema", sourceSchema), zap.String("table", sourceTable))
-	_, err := cp.dbConn.ExecuteSQL(
+	_, err := cp.executeSQL(
 		tctx2,
 		[]string{`DELETE FROM ` + cp.tableName + ` WHERE id = ? AND cp_schema = ? AND cp_table = ?`},
 		[]interface{}{cp.id, sourceSchema, sourceTable},
@@ -445,7 +527,7 @@ func (cp *RemoteCheckPoint) DeleteSchemaPoint(tctx *tcontext.Context, sourceSche
 	tctx2, cancel := tctx.WithContext(context.Background()).WithTimeout(maxDMLConnectionDuration)
 	defer cancel()
 	cp.logCtx.L().Info("delete schema checkpoint", zap.String("schema", sourceSchema))
-	_, err := cp.dbConn.ExecuteSQL(
+	_, err := cp.executeSQL(
 		tctx2,
 		[]string{`DELETE FROM ` + cp.tableName + ` WHERE id = ? AND cp_schema = ?`},
 		[]interface{}{cp.id, sourceSchema},
@@ -462,6 +544,7 @@ func (cp *RemoteCheckPoint) DeleteSchemaPoint(tctx *tcontext.Context, sourceSche
 //   - Query event e1, location is gset1
 //   - Rows event e2, location is gset1
 //   - XID event, location is gset2
+//
 // We should note that e1 is not older than e2
 // For binlog position replication, currently DM will split rows changes of an event to jobs, so some job may has save position.
 // if useLE is true, we use less than or equal.
@@ -499,9 +582,45 @@ func (cp *RemoteCheckPoint) SaveGlobalPoint(location binlog.Location) {
 
 // FlushPointsExcept implements CheckPoint.FlushPointsExcept.
 func (cp *RemoteCheckPoint) FlushPointsExcept(tctx *tcontext.Context, exceptTables []*filter.Table, extraSQLs []string, extraArgs [][]interface{}) error {
-	cp.RLock()
-	defer cp.RUnlock()
+	return cp.FlushSnapshotPointsExcept(tctx, cp.Snapshot(), exceptTables, extraSQLs, extraArgs)
+}
+
+// Snapshot implements CheckPoint.Snapshot.
+func (cp *RemoteCheckPoint) Snapshot() *SnapshotInfo {
+	cp.Lock()
+	defer cp.Unlock()
+
+	snapshot := &SnapshotInfo{
+		globalPoint:                cp.globalPoint.MySQLLocation().Clone(),
+		safeModeExitPoint:          cp.safeModeExitPoint,
+		needFlushSafeModeExitPoint: cp.needFlushSafeModeExitPoint,
+		points:                     make(map[string]map[string]tablePointSnapshot),
+	}
+	for schema, mSchema := range cp.points {
+		for table, point := range mSchema {
+			if !point.outOfDate() {
+				continue
+			}
+			if _, ok := snapshot.points[schema]; !ok {
+				snapshot.points[schema] = make(map[string]tablePointSnapshot)
+			}
+			location, ti := point.snapshot()
+			snapshot.points[schema][table] = tablePointSnapshot{point: point, location: location, ti: ti}
+		}
+	}
 
+	cp.lastSnapshotCreationTime = time.Now()
+	return snapshot
+}
+
+// FlushSnapshotPointsExcept implements CheckPoint.FlushSnapshotPointsExcept.
+func (cp *RemoteCheckPoint) FlushSnapshotPointsExcept(
+	tctx *tcontext.Context,
+	snapshot *SnapshotInfo,
+	exceptTables []*filter.Table,
+	extraSQLs []string,
+	extraArgs [][]interface{},
+) error {
 	// convert slice to map
 	excepts := make(map[string]map[string]struct{})
 	for _, schemaTable := range exceptTables {
@@ -516,38 +635,63 @@ func (cp *RemoteCheckPoint) FlushPointsExcept(tctx *tcontext.Context, exceptTabl
 
 	sqls := make([]string, 0, 100)
 	args := make([][]interface{}, 0, 100)
+	// the table checkpoints are grouped into batches, and the ones whose table info is not changed since
+	// the last flush are grouped separately to not rewrite their table info.
+	var (
+		tiRows, locationRows []checkpointRow
+		tiBytesOfPoints      = make([][]byte, 0, 100)
+		points               = make([]tablePointSnapshot, 0, 100)
+	)
 
-	if cp.globalPoint.outOfDate() || cp.globalPointSaveTime.IsZero() || cp.needFlushSafeModeExitPoint {
-		locationG := cp.GlobalPoint()
-		sqlG, argG := cp.genUpdateSQL(globalCpSchema, globalCpTable, locationG, cp.safeModeExitPoint, nil, true)
+	// only hold the lock when generating SQLs, so saving checkpoints is not blocked by the flushing.
+	cp.RLock()
+	flushedG := cp.globalPoint.FlushedMySQLLocation()
+	flushGlobal := binlog.CompareLocation(snapshot.globalPoint, flushedG, cp.cfg.EnableGTID) > 0 ||
+		(binlog.CompareLocation(snapshot.globalPoint, flushedG, cp.cfg.EnableGTID) == 0 &&
+			(cp.globalPointSaveTime.IsZero() || snapshot.needFlushSafeModeExitPoint))
+	if flushGlobal {
+		sqlG, argG := cp.genUpdateSQL(globalCpSchema, globalCpTable, snapshot.globalPoint, snapshot.safeModeExitPoint, nil, true)
 		sqls = append(sqls, sqlG)
 		args = append(args, argG)
 	}
 
-	points := make([]*binlogPoint, 0, 100)
-
-	for schema, mSchema := range cp.points {
-		for table, point := range mSchema {
+	for schema, mSchema := range snapshot.points {
+		for table, ps := range mSchema {
 			if _, ok1 := excepts[schema]; ok1 {
 				if _, ok2 := excepts[schema][table]; ok2 {
 					continue
 				}
 			}
-			if point.outOfDate() {
-				tiBytes, err := json.Marshal(point.ti)
+			// the table checkpoint may be deleted after the snapshot is taken
+			if point, ok := cp.points[schema][table]; !ok || point != ps.point {
+				continue
+			}
+			if binlog.CompareLocation(ps.location, ps.point.FlushedMySQLLocation(), cp.cfg.EnableGTID) <= 0 {
+				continue
+			}
+			row := checkpointRow{schema: schema, table: table, location: ps.location}
+			if tiBytes, ok := ps.point.cachedTIBytes(ps.ti); ok {
+				row.tiBytes = tiBytes
+				locationRows = append(locationRows, row)
+			} else {
+				tiBytes, err := json.Marshal(ps.ti)
 				if err != nil {
+					cp.RUnlock()
 					return terror.ErrSchemaTrackerCannotSerialize.Delegate(err, schema, table)
 				}
-
-				location := point.MySQLLocation()
-				sql2, arg := cp.genUpdateSQL(schema, table, location, nil, tiBytes, false)
-				sqls = append(sqls, sql2)
-				args = append(args, arg)
-
-				points = append(points, point)
+				row.tiBytes = tiBytes
+				tiRows = append(tiRows, row)
 			}
+
+			points = append(points, ps)
+			tiBytesOfPoints = append(tiBytesOfPoints, row.tiBytes)
 		}
 	}
+	cp.RUnlock()
+
+	sqls, args = cp.appendBatchUpdateSQLs(sqls, args, tiRows, true)
+	sqls, args = cp.appendBatchUpdateSQLs(sqls, args, locationRows, false)
+
 	for i := range extraSQLs {
 		sqls = append(sqls, extraSQLs[i])
 		args = append(args, extraArgs[i])
@@ -556,18 +700,24 @@ func (cp *RemoteCheckPoint) FlushPointsExcept(tctx *tcontext.Context, exceptTabl
 	// use a new context apart from syncer, to make sure when syncer call `cancel` checkpoint could update
 	tctx2, cancel := tctx.WithContext(context.Background()).WithTimeout(maxDMLConnectionDuration)
 	defer cancel()
-	_, err := cp.dbConn.ExecuteSQL(tctx2, sqls, args...)
+	_, err := cp.executeSQL(tctx2, sqls, args...)
 	if err != nil {
 		return err
 	}
 
-	cp.globalPoint.flush()
-	for _, point := range points {
-		point.flush()
+	cp.Lock()
+	defer cp.Unlock()
+	if flushGlobal {
+		cp.globalPoint.flushBy(snapshot.globalPoint, nil, nil)
+	}
+	for i, ps := range points {
+		ps.point.flushBy(ps.location, ps.ti, tiBytesOfPoints[i])
 	}
 
 	cp.globalPointSaveTime = time.Now()
-	cp.needFlushSafeModeExitPoint = false
+	if flushGlobal && cp.safeModeExitPoint == snapshot.safeModeExitPoint {
+		cp.needFlushSafeModeExitPoint = false
+	}
 	return nil
 }
 
@@ -599,7 +749,7 @@ func (cp *RemoteCheckPoint) FlushPointWithTableInfo(tctx *tcontext.Context, tabl
 	// use a new context apart from syncer, to make sure when syncer call `cancel` checkpoint could update
 	tctx2, cancel := tctx.WithContext(context.Background()).WithTimeout(utils.DefaultDBTimeout)
 	defer cancel()
-	_, err = cp.dbConn.ExecuteSQL(tctx2, sqls, args...)
+	_, err = cp.executeSQL(tctx2, sqls, args...)
 	if err != nil {
 		return err
 	}
@@ -628,7 +778,7 @@ func (cp *RemoteCheckPoint) FlushSafeModeExitPoint(tctx *tcontext.Context) error
 	// use a new context apart from syncer, to make sure when syncer call `cancel` checkpoint could update
 	tctx2, cancel := tctx.WithContext(context.Background()).WithTimeout(maxDMLConnectionDuration)
 	defer cancel()
-	_, err := cp.dbConn.ExecuteSQL(tctx2, sqls, args...)
+	_, err := cp.executeSQL(tctx2, sqls, args...)
 	if err != nil {
 		return err
 	}
@@ -680,7 +830,7 @@ func (cp *RemoteCheckPoint) String() string {
 func (cp *RemoteCheckPoint) CheckGlobalPoint() bool {
 	cp.RLock()
 	defer cp.RUnlock()
-	return time.Since(cp.globalPointSaveTime) >= time.Duration(cp.cfg.CheckpointFlushInterval)*time.Second
+	return time.Since(cp.lastSnapshotCreationTime) >= time.Duration(cp.cfg.CheckpointFlushInterval)*time.Second
 }
 
 // Rollback implements CheckPoint.Rollback.
@@ -744,7 +894,7 @@ func (cp *RemoteCheckPoint) createSchema(tctx *tcontext.Context) error {
 	// TODO(lance6716): change ColumnName to IdentName or something
 	sql2 := fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(cp.cfg.MetaSchema))
 	args := make([]interface{}, 0)
-	_, err := cp.dbConn.ExecuteSQL(tctx, []string{sql2}, [][]interface{}{args}...)
+	_, err := cp.executeSQL(tctx, []string{sql2}, [][]interface{}{args}...)
 	cp.logCtx.L().Info("create checkpoint schema", zap.String("statement", sql2))
 	return err
 }
@@ -768,7 +918,7 @@ func (cp *RemoteCheckPoint) createTable(tctx *tcontext.Context) error {
 			UNIQUE KEY uk_id_schema_table (id, cp_schema, cp_table)
 		)`,
 	}
-	_, err := cp.dbConn.ExecuteSQL(tctx, sqls)
+	_, err := cp.executeSQL(tctx, sqls)
 	cp.logCtx.L().Info("create checkpoint table", zap.Strings("statements", sqls))
 	return err
 }
@@ -777,6 +927,10 @@ func (cp *RemoteCheckPoint) createTable(tctx *tcontext.Context) error {
 func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 	cp.Lock()
 	defer cp.Unlock()
+	if cp.cfg.DryRun {
+		// the checkpoint table isn't created.
+		return nil
+	}
 
 	query := `SELECT cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global FROM ` + cp.tableName + ` WHERE id = ?`
 	rows, err := cp.dbConn.QuerySQL(tctx, query, cp.id)
@@ -877,7 +1031,10 @@ func (cp *RemoteCheckPoint) Load(tctx *tcontext.Context) error {
 			mSchema = make(map[string]*binlogPoint)
 			cp.points[cpSchema] = mSchema
 		}
-		mSchema[cpTable] = newBinlogPoint(location, location, ti, ti, cp.cfg.EnableGTID)
+		point := newBinlogPoint(location, location, ti, ti, cp.cfg.EnableGTID)
+		// warm the cache, so the table info is not rewritten until it's changed.
+		point.flushedTIBytes = tiBytes
+		mSchema[cpTable] = point
 	}
 
 	return terror.WithScope(terror.DBErrorAdapt(rows.Err(), terror.ErrDBDriverError), terror.ScopeDownstream)
@@ -932,7 +1089,7 @@ func (cp *RemoteCheckPoint) LoadMeta() error {
 	case config.ModeAll:
 		// NOTE: syncer must continue the syncing follow loader's tail, so we parse mydumper's output
 		// refine when master / slave switching added and checkpoint mechanism refactored
-		location, safeModeExitLoc, err = cp.parseMetaData()
+		location, safeModeExitLoc, err = cp.parseMetaData(path.Join(cp.cfg.Dir, "metadata"))
 		if err != nil {
 			return err
 		}
@@ -943,6 +1100,14 @@ func (cp *RemoteCheckPoint) LoadMeta() error {
 			cp.globalPoint = newBinlogPoint(binlog.NewLocation(cp.cfg.Flavor), binlog.NewLocation(cp.cfg.Flavor), nil, nil, cp.cfg.EnableGTID)
 			return nil
 		}
+		if cp.cfg.Meta.MetadataFile != "" {
+			// the full data is imported by other tools, continue the syncing follow the dumped metadata.
+			location, safeModeExitLoc, err = cp.parseMetaData(cp.cfg.Meta.MetadataFile)
+			if err != nil {
+				return err
+			}
+			break
+		}
 		gset, err := gtid.ParserGTID(cp.cfg.Flavor, cp.cfg.Meta.BinLogGTID)
 		if err != nil {
 			return err
@@ -1020,10 +1185,71 @@ func (cp *RemoteCheckPoint) genUpdateSQL(cpSchema, cpTable string, location binl
 	return sql2, args
 }
 
-func (cp *RemoteCheckPoint) parseMetaData() (*binlog.Location, *binlog.Location, error) {
-	// `metadata` is mydumper's output meta file name
-	filename := path.Join(cp.cfg.Dir, "metadata")
+// checkpointRow is a table checkpoint to be flushed.
+type checkpointRow struct {
+	schema   string
+	table    string
+	location binlog.Location
+	tiBytes  []byte
+}
+
+// appendBatchUpdateSQLs appends the SQLs to flush the table checkpoints in batches of checkpointFlushBatchSize rows,
+// the table info is not updated if updateTI is false.
+func (cp *RemoteCheckPoint) appendBatchUpdateSQLs(
+	sqls []string, args [][]interface{}, rows []checkpointRow, updateTI bool,
+) ([]string, [][]interface{}) {
+	for len(rows) > 0 {
+		n := len(rows)
+		if n > checkpointFlushBatchSize {
+			n = checkpointFlushBatchSize
+		}
+		sql2, arg := cp.genBatchUpdateSQL(rows[:n], updateTI)
+		sqls = append(sqls, sql2)
+		args = append(args, arg)
+		rows = rows[n:]
+	}
+	return sqls, args
+}
+
+// genBatchUpdateSQL generates a SQL to flush the table checkpoints like genUpdateSQL.
+func (cp *RemoteCheckPoint) genBatchUpdateSQL(rows []checkpointRow, updateTI bool) (string, []interface{}) {
+	var buf strings.Builder
+	buf.WriteString(`INSERT INTO ` + cp.tableName + `
+		(id, cp_schema, cp_table, binlog_name, binlog_pos, binlog_gtid, exit_safe_binlog_name, exit_safe_binlog_pos, exit_safe_binlog_gtid, table_info, is_global) VALUES`)
+	args := make([]interface{}, 0, len(rows)*11)
+	for i, row := range rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`
+		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+		tiBytes := row.tiBytes
+		if len(tiBytes) == 0 {
+			tiBytes = []byte("null")
+		}
+		args = append(args, cp.id, row.schema, row.table, row.location.Position.Name, row.location.Position.Pos,
+			row.location.GTIDSetStr(), "", uint32(0), "", string(tiBytes), false)
+	}
+	buf.WriteString(`
+		ON DUPLICATE KEY UPDATE
+			binlog_name = VALUES(binlog_name),
+			binlog_pos = VALUES(binlog_pos),
+			binlog_gtid = VALUES(binlog_gtid),
+			exit_safe_binlog_name = VALUES(exit_safe_binlog_name),
+			exit_safe_binlog_pos = VALUES(exit_safe_binlog_pos),
+			exit_safe_binlog_gtid = VALUES(exit_safe_binlog_gtid),`)
+	if updateTI {
+		buf.WriteString(`
+			table_info = VALUES(table_info),`)
+	}
+	buf.WriteString(`
+			is_global = VALUES(is_global);
+	`)
+	return buf.String(), args
+}
 
+// parseMetaData parses the `metadata` file written by mydumper or dumpling.
+func (cp *RemoteCheckPoint) parseMetaData(filename string) (*binlog.Location, *binlog.Location, error) {
 	loc, loc2, err := dumpling.ParseMetaData(filename, cp.cfg.Flavor)
 	if err != nil {
 		toPrint, err2 := os.ReadFile(filename)
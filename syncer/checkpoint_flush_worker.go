@@ -0,0 +1,135 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/log"
+)
+
+const checkpointFlushTaskChanSize = 16
+
+// checkpointFlushTask is a snapshot of checkpoints to be flushed.
+type checkpointFlushTask struct {
+	snapshot *SnapshotInfo
+	// flushWg is done after all DML jobs before the snapshot are executed, nil for synchronous flush.
+	flushWg *sync.WaitGroup
+
+	exceptTables  []*filter.Table
+	shardMetaSQLs []string
+	shardMetaArgs [][]interface{}
+	// the snapshot of the schema tracker flushed with the checkpoints, nil if it's not recorded.
+	schemaSnapshot *schemaSnapshot
+}
+
+// checkpointFlushWorker flushes checkpoints in background.
+// the tasks queued while the worker is waiting or flushing are merged into one flush (group commit),
+// only the latest snapshot is written to the downstream.
+type checkpointFlushWorker struct {
+	input        chan *checkpointFlushTask
+	pending      sync.WaitGroup // counts tasks not flushed yet
+	maxStaleness time.Duration
+
+	mu           sync.Mutex
+	pendingSince []time.Time // add time of tasks not flushed yet
+
+	flushFunc func(*checkpointFlushTask) error
+	fatalFunc func(error)
+	logger    log.Logger
+}
+
+func newCheckpointFlushWorker(
+	maxStaleness time.Duration,
+	flushFunc func(*checkpointFlushTask) error,
+	fatalFunc func(error),
+	logger log.Logger,
+) *checkpointFlushWorker {
+	return &checkpointFlushWorker{
+		input:        make(chan *checkpointFlushTask, checkpointFlushTaskChanSize),
+		maxStaleness: maxStaleness,
+		flushFunc:    flushFunc,
+		fatalFunc:    fatalFunc,
+		logger:       logger,
+	}
+}
+
+// run flushes tasks until the worker is closed.
+func (w *checkpointFlushWorker) run() {
+	for task := range w.input {
+		task.flushWg.Wait()
+
+		merged := 1
+	drain:
+		for {
+			select {
+			case next, ok := <-w.input:
+				if !ok {
+					break drain
+				}
+				next.flushWg.Wait()
+				task = next
+				merged++
+			default:
+				break drain
+			}
+		}
+
+		if merged > 1 {
+			w.logger.Debug("merge checkpoint flush tasks", zap.Int("count", merged))
+		}
+		if err := w.flushFunc(task); err != nil {
+			w.fatalFunc(err)
+		}
+		w.done(merged)
+	}
+}
+
+// add adds a task, it blocks if too many tasks are waiting.
+func (w *checkpointFlushWorker) add(task *checkpointFlushTask) {
+	w.pending.Add(1)
+	w.mu.Lock()
+	w.pendingSince = append(w.pendingSince, time.Now())
+	w.mu.Unlock()
+	w.input <- task
+}
+
+func (w *checkpointFlushWorker) done(n int) {
+	w.mu.Lock()
+	w.pendingSince = w.pendingSince[n:]
+	w.mu.Unlock()
+	w.pending.Add(-n)
+}
+
+// stale returns true if the oldest task is not flushed after maxStaleness,
+// the caller should wait for the worker and flush checkpoints synchronously.
+func (w *checkpointFlushWorker) stale() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.pendingSince) > 0 && time.Since(w.pendingSince[0]) > w.maxStaleness
+}
+
+// wait waits for all added tasks flushed.
+func (w *checkpointFlushWorker) wait() {
+	w.pending.Wait()
+}
+
+// close closes the input, run returns after all added tasks flushed.
+func (w *checkpointFlushWorker) close() {
+	close(w.input)
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/pkg/log"
+)
+
+var _ = Suite(&testCheckpointFlushWorkerSuite{})
+
+type testCheckpointFlushWorkerSuite struct{}
+
+func (t *testCheckpointFlushWorkerSuite) TestCheckpointFlushWorker(c *C) {
+	var (
+		flushed []*checkpointFlushTask
+		errs    []error
+		flushMu sync.Mutex
+	)
+	w := newCheckpointFlushWorker(time.Hour, func(task *checkpointFlushTask) error {
+		flushMu.Lock()
+		defer flushMu.Unlock()
+		flushed = append(flushed, task)
+		if len(flushed) == 1 {
+			return errors.New("mock flush error")
+		}
+		return nil
+	}, func(err error) {
+		errs = append(errs, err)
+	}, log.L())
+
+	// tasks queued are merged, only the latest one is flushed
+	tasks := make([]*checkpointFlushTask, 4)
+	for i := 0; i < 3; i++ {
+		tasks[i] = &checkpointFlushTask{flushWg: newAsyncFlushJob(2).flushWg}
+		w.add(tasks[i])
+		tasks[i].flushWg.Add(-2)
+	}
+	runDone := make(chan struct{})
+	go func() {
+		w.run()
+		close(runDone)
+	}()
+	w.wait()
+	c.Assert(flushed, DeepEquals, []*checkpointFlushTask{tasks[2]})
+	c.Assert(errs, HasLen, 1)
+
+	// task is flushed after the DML jobs before it are executed
+	tasks[3] = &checkpointFlushTask{flushWg: newAsyncFlushJob(2).flushWg}
+	w.add(tasks[3])
+	c.Assert(w.stale(), IsFalse)
+	w.maxStaleness = 0
+	c.Assert(w.stale(), IsTrue)
+	tasks[3].flushWg.Done()
+	time.Sleep(10 * time.Millisecond)
+	flushMu.Lock()
+	c.Assert(flushed, HasLen, 1)
+	flushMu.Unlock()
+	tasks[3].flushWg.Done()
+	w.wait()
+	c.Assert(flushed, DeepEquals, []*checkpointFlushTask{tasks[2], tasks[3]})
+	c.Assert(errs, HasLen, 1)
+	c.Assert(w.stale(), IsFalse)
+
+	w.close()
+	<-runDone
+}
@@ -15,6 +15,7 @@ package syncer
 
 import (
 	"context"
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -29,6 +30,7 @@ import (
 	"github.com/pingcap/dm/pkg/gtid"
 	"github.com/pingcap/dm/pkg/retry"
 	"github.com/pingcap/dm/pkg/schema"
+	"github.com/pingcap/dm/pkg/terror"
 	"github.com/pingcap/dm/syncer/dbconn"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -38,6 +40,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tidb-tools/pkg/dbutil"
 	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
 	"go.uber.org/zap/zapcore"
 )
 
@@ -188,6 +191,19 @@ func (s *testCheckpointSuite) testGlobalCheckPoint(c *C, cp CheckPoint) {
 	c.Assert(cp.GlobalPoint().Position, Equals, pos1)
 	c.Assert(cp.FlushedGlobalPoint().Position, Equals, pos1)
 
+	// try load from the metadata file specified in config
+	pos1.Pos = 2048
+	metadataFile := filepath.Join(c.MkDir(), "metadata")
+	err = os.WriteFile(metadataFile, []byte(fmt.Sprintf("SHOW MASTER STATUS:\n\tLog: %s\n\tPos: %d\n\tGTID:\n\n", pos1.Name, pos1.Pos)), 0o644)
+	c.Assert(err, IsNil)
+	s.cfg.Meta = &config.Meta{MetadataFile: metadataFile}
+	err = cp.LoadMeta()
+	c.Assert(err, IsNil)
+	c.Assert(cp.GlobalPoint().Position, Equals, pos1)
+	c.Assert(cp.FlushedGlobalPoint().Position, Equals, pos1)
+	s.cfg.Meta.MetadataFile = filepath.Join(c.MkDir(), "not-exist")
+	c.Assert(terror.ErrParseMydumperMeta.Equal(cp.LoadMeta()), IsTrue)
+
 	s.cfg.Mode = oldMode
 	s.cfg.Meta = nil
 
@@ -479,5 +495,166 @@ func (s *testCheckpointSuite) testTableCheckPoint(c *C, cp CheckPoint) {
 	rcp = cp.(*RemoteCheckPoint)
 	c.Assert(rcp.points[schemaName][tableName].TableInfo(), NotNil)
 	c.Assert(rcp.points[schemaName][tableName].flushedTI, NotNil)
+	// the cache of the serialized table info is warmed by loading.
+	cachedTIBytes, ok := rcp.points[schemaName][tableName].cachedTIBytes(rcp.points[schemaName][tableName].TableInfo())
+	c.Assert(ok, IsTrue)
+	c.Assert(cachedTIBytes, DeepEquals, tiBytes)
 	c.Assert(*rcp.safeModeExitPoint, DeepEquals, binlog.InitLocation(pos2, gs))
 }
+
+func (s *testCheckpointSuite) TestSnapshotCheckPoint(c *C) {
+	tctx := tcontext.Background()
+	cfg := *s.cfg
+	cfg.EnableGTID = false
+	cfg.CheckpointFlushInterval = 30
+	cp := NewRemoteCheckPoint(tctx, &cfg, cpid)
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	defer func() {
+		mock.ExpectClose()
+		cp.Close()
+	}()
+	s.prepareCheckPointSQL()
+
+	dbConn, err := db.Conn(tcontext.Background().Context())
+	c.Assert(err, IsNil)
+	cp.(*RemoteCheckPoint).dbConn = &dbconn.DBConn{Cfg: &cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	mock.ExpectBegin()
+	mock.ExpectExec(clearCheckPointSQL).WithArgs(cpid).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.Clear(tctx), IsNil)
+
+	var (
+		table = &filter.Table{Schema: "test_db", Name: "test_table"}
+		pos1  = mysql.Position{Name: "mysql-bin.000003", Pos: 1943}
+		pos2  = mysql.Position{Name: "mysql-bin.000003", Pos: 2000}
+		pos3  = mysql.Position{Name: "mysql-bin.000003", Pos: 3000}
+	)
+	c.Assert(cp.CheckGlobalPoint(), IsTrue)
+	cp.SaveGlobalPoint(binlog.Location{Position: pos1})
+	cp.SaveTablePoint(table, binlog.Location{Position: pos1}, nil)
+	snapshot1 := cp.Snapshot()
+	c.Assert(snapshot1.GlobalPoint().Position, DeepEquals, pos1)
+	c.Assert(cp.CheckGlobalPoint(), IsFalse)
+
+	// save newer checkpoints after the snapshot is taken
+	cp.SaveGlobalPoint(binlog.Location{Position: pos2})
+	cp.SaveTablePoint(table, binlog.Location{Position: pos2}, nil)
+	snapshot2 := cp.Snapshot()
+
+	// only the checkpoints in the snapshot are flushed
+	mock.ExpectBegin()
+	mock.ExpectExec(flushCheckPointSQL).WithArgs(cpid, "", "", pos1.Name, pos1.Pos, "", "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(flushCheckPointSQL).WithArgs(cpid, table.Schema, table.Name, pos1.Name, pos1.Pos, "", "", 0, "", "null", false).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.FlushSnapshotPointsExcept(tctx, snapshot1, nil, nil, nil), IsNil)
+	c.Assert(cp.FlushedGlobalPoint().Position, DeepEquals, pos1)
+	c.Assert(cp.GlobalPoint().Position, DeepEquals, pos2)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(flushCheckPointSQL).WithArgs(cpid, "", "", pos2.Name, pos2.Pos, "", "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(flushCheckPointSQL).WithArgs(cpid, table.Schema, table.Name, pos2.Name, pos2.Pos, "", "", 0, "", "null", false).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.FlushSnapshotPointsExcept(tctx, snapshot2, nil, nil, nil), IsNil)
+	c.Assert(cp.FlushedGlobalPoint().Position, DeepEquals, pos2)
+
+	// an older snapshot doesn't make the flushed checkpoints go back
+	c.Assert(cp.FlushSnapshotPointsExcept(tctx, snapshot1, nil, nil, nil), IsNil)
+	c.Assert(cp.FlushedGlobalPoint().Position, DeepEquals, pos2)
+
+	// table checkpoint deleted after the snapshot is taken is skipped
+	cp.SaveTablePoint(table, binlog.Location{Position: pos3}, nil)
+	snapshot3 := cp.Snapshot()
+	mock.ExpectBegin()
+	mock.ExpectExec(deleteCheckPointSQL).WithArgs(cpid, table.Schema, table.Name).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.DeleteTablePoint(tctx, table), IsNil)
+	c.Assert(cp.FlushSnapshotPointsExcept(tctx, snapshot3, nil, nil, nil), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
+
+func (s *testCheckpointSuite) TestBatchFlushCheckPoint(c *C) {
+	tctx := tcontext.Background()
+	cfg := *s.cfg
+	cfg.EnableGTID = false
+	cp := NewRemoteCheckPoint(tctx, &cfg, cpid)
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	defer func() {
+		mock.ExpectClose()
+		cp.Close()
+	}()
+	s.prepareCheckPointSQL()
+
+	dbConn, err := db.Conn(tcontext.Background().Context())
+	c.Assert(err, IsNil)
+	cp.(*RemoteCheckPoint).dbConn = &dbconn.DBConn{Cfg: &cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+
+	defer func(size int) {
+		checkpointFlushBatchSize = size
+	}(checkpointFlushBatchSize)
+	checkpointFlushBatchSize = 2
+
+	var (
+		tables = []*filter.Table{
+			{Schema: "test_db", Name: "t1"},
+			{Schema: "test_db", Name: "t2"},
+			{Schema: "test_db", Name: "t3"},
+		}
+		pos1 = mysql.Position{Name: "mysql-bin.000003", Pos: 1943}
+		pos2 = mysql.Position{Name: "mysql-bin.000003", Pos: 2000}
+		// the table info is updated
+		updateTISQL = `INSERT INTO .* VALUES\s*\(\?.*\),\s*\(\?.*\)\s*ON DUPLICATE KEY UPDATE.* table_info = VALUES\(table_info\),.*`
+		oneRowSQL   = `INSERT INTO .* VALUES\s*\([?, ]*\)\s*ON DUPLICATE KEY UPDATE.*`
+		// the table info is not updated
+		locationSQL = `INSERT INTO .* VALUES\s*\(\?.*\),\s*\(\?.*\)\s*ON DUPLICATE KEY UPDATE.* exit_safe_binlog_gtid = VALUES\(exit_safe_binlog_gtid\),\s*is_global = .*`
+	)
+	rowArgs := func(table *filter.Table, pos mysql.Position) []driver.Value {
+		return []driver.Value{cpid, table.Schema, table.Name, pos.Name, pos.Pos, "", "", 0, "", "null", false}
+	}
+
+	// the table checkpoints are flushed in batches.
+	cp.SaveGlobalPoint(binlog.Location{Position: pos1})
+	for _, table := range tables {
+		cp.SaveTablePoint(table, binlog.Location{Position: pos1}, nil)
+	}
+	snapshot := cp.Snapshot()
+	c.Assert(snapshot.points["test_db"], HasLen, 3)
+	mock.ExpectBegin()
+	mock.ExpectExec(flushCheckPointSQL).WithArgs(cpid, "", "", pos1.Name, pos1.Pos, "", "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	// the order of tables in a batch is not determined.
+	mock.ExpectExec(updateTISQL).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(oneRowSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.FlushSnapshotPointsExcept(tctx, snapshot, nil, nil, nil), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the table info is not changed, so only the locations are updated.
+	cp.SaveGlobalPoint(binlog.Location{Position: pos2})
+	cp.SaveTablePoint(tables[0], binlog.Location{Position: pos2}, nil)
+	cp.SaveTablePoint(tables[1], binlog.Location{Position: pos2}, nil)
+	mock.ExpectBegin()
+	mock.ExpectExec(flushCheckPointSQL).WithArgs(cpid, "", "", pos2.Name, pos2.Pos, "", "", 0, "", "null", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(locationSQL).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	c.Assert(cp.FlushPointsExcept(tctx, []*filter.Table{tables[2]}, nil, nil), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the changed table info is rewritten.
+	ti := &model.TableInfo{ID: 1, Name: model.NewCIStr("t3")}
+	tiBytes, err := json.Marshal(ti)
+	c.Assert(err, IsNil)
+	cp.SaveTablePoint(tables[2], binlog.Location{Position: pos2}, ti)
+	args := rowArgs(tables[2], pos2)
+	args[9] = string(tiBytes)
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO .* table_info = VALUES\(table_info\),.*`).WithArgs(args...).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	c.Assert(cp.FlushPointsExcept(tctx, nil, nil, nil), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+	cachedTIBytes, ok := cp.(*RemoteCheckPoint).points["test_db"]["t3"].cachedTIBytes(ti)
+	c.Assert(ok, IsTrue)
+	c.Assert(cachedTIBytes, DeepEquals, tiBytes)
+}
@@ -0,0 +1,237 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math/rand"
+	"unicode"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// columnTransform is a column transformation applied on a column of a table.
+type columnTransform struct {
+	offset int
+	flen   int
+	rule   *config.ColumnTransformation
+}
+
+// ColumnTransformGroup transforms the values of the columns in row changes by the column transformations.
+type ColumnTransformGroup struct {
+	configs    map[string][]*config.ColumnTransformation // tableName -> raw config
+	transforms map[string][]columnTransform              // tableName -> transforms
+}
+
+// NewColumnTransformGroup creates a ColumnTransformGroup.
+func NewColumnTransformGroup(transformConfig []*config.ColumnTransformation) *ColumnTransformGroup {
+	ret := &ColumnTransformGroup{
+		configs:    map[string][]*config.ColumnTransformation{},
+		transforms: map[string][]columnTransform{},
+	}
+	for _, c := range transformConfig {
+		tableName := dbutil.TableName(c.Schema, c.Table)
+		ret.configs[tableName] = append(ret.configs[tableName], c)
+	}
+	return ret
+}
+
+// getTransforms returns the transforms of given table.
+// This function will lazy calculate transforms if not initialized.
+func (g *ColumnTransformGroup) getTransforms(table *filter.Table, ti *model.TableInfo) ([]columnTransform, error) {
+	tableID := utils.GenTableID(table)
+	if ret, ok := g.transforms[tableID]; ok {
+		return ret, nil
+	}
+	configs, ok := g.configs[tableID]
+	if !ok {
+		return nil, nil
+	}
+
+	var (
+		ret         []columnTransform
+		transformed = make(map[int]struct{})
+	)
+	for _, c := range configs {
+		for _, name := range c.Columns {
+			col := model.FindColumnInfo(ti.Columns, name)
+			if col == nil {
+				// a renamed column may leak the sensitive data, so we stop the replication.
+				return nil, terror.ErrSyncerColumnTransformation.Generate(name, tableID, "column not exist")
+			}
+			// a column is transformed by the first matched transformation.
+			if _, ok2 := transformed[col.Offset]; ok2 {
+				continue
+			}
+			if col.IsGenerated() {
+				return nil, terror.ErrSyncerColumnTransformation.Generate(name, tableID, "generated column can't be transformed")
+			}
+			switch c.Type {
+			case config.ColumnTransformNull, config.ColumnTransformConstant:
+				if isUniqueColumn(ti, col) {
+					return nil, terror.ErrSyncerColumnTransformation.Generate(name, tableID, "column in primary key or unique key can't be transformed to "+c.Type)
+				}
+			case config.ColumnTransformHash, config.ColumnTransformMask:
+				if !isStringType(col.Tp) {
+					return nil, terror.ErrSyncerColumnTransformation.Generate(name, tableID, "only string columns can be transformed by "+c.Type)
+				}
+			}
+			transformed[col.Offset] = struct{}{}
+			ret = append(ret, columnTransform{offset: col.Offset, flen: col.Flen, rule: c})
+		}
+	}
+	g.transforms[tableID] = ret
+	return ret, nil
+}
+
+// ResetTransforms deletes the transforms generated before. This should be called after table structure changed.
+func (g *ColumnTransformGroup) ResetTransforms(table *filter.Table) {
+	if g == nil {
+		return
+	}
+	delete(g.transforms, utils.GenTableID(table))
+}
+
+// TransformRows transforms the rows of given table, the original rows are not modified. It returns whether the rows
+// are transformed.
+func (g *ColumnTransformGroup) TransformRows(table *filter.Table, ti *model.TableInfo, rows [][]interface{}) ([][]interface{}, bool, error) {
+	if g == nil {
+		return rows, false, nil
+	}
+	transforms, err := g.getTransforms(table, ti)
+	if err != nil || len(transforms) == 0 {
+		return rows, false, err
+	}
+
+	ret := make([][]interface{}, len(rows))
+	for i, row := range rows {
+		if len(row) != len(ti.Columns) {
+			return nil, false, terror.ErrSyncerUnitDMLColumnNotMatch.Generate(len(ti.Columns), len(row))
+		}
+		newRow := make([]interface{}, len(row))
+		copy(newRow, row)
+		for _, t := range transforms {
+			newRow[t.offset] = t.transform(newRow[t.offset])
+		}
+		ret[i] = newRow
+	}
+	return ret, true, nil
+}
+
+// transform transforms a value, NULL values are kept.
+func (t *columnTransform) transform(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	switch t.rule.Type {
+	case config.ColumnTransformNull:
+		return nil
+	case config.ColumnTransformConstant:
+		return t.rule.Value
+	}
+
+	var s string
+	switch x := v.(type) {
+	case string:
+		s = x
+	case []byte:
+		s = string(x)
+	default:
+		return v
+	}
+	if t.rule.Type == config.ColumnTransformHash {
+		s = hashString(s, t.rule.Value, t.flen)
+	} else {
+		s = maskString(s, t.rule.Value, t.rule.KeepPrefix, t.rule.KeepSuffix)
+	}
+	if _, ok := v.([]byte); ok {
+		return []byte(s)
+	}
+	return s
+}
+
+// hashString returns the hex encoded HMAC-SHA256 of the string keyed by the salt, the result is truncated to `flen`
+// to fit into the column.
+func hashString(s, salt string, flen int) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(s))
+	ret := hex.EncodeToString(mac.Sum(nil))
+	if flen > 0 && flen < len(ret) {
+		ret = ret[:flen]
+	}
+	return ret
+}
+
+// maskString replaces the digits and ASCII letters of the string with pseudo-random digits and letters of the same
+// case, other letters are replaced with '*', and other characters are kept. the random sequence is seeded by the
+// HMAC-SHA256 of the string, so the same string is always masked to the same result.
+// if keepPrefix + keepSuffix is not less than the length of the string, the whole string is masked.
+func maskString(s, salt string, keepPrefix, keepSuffix int) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(s))
+	//nolint:gosec
+	rnd := rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(mac.Sum(nil)))))
+
+	runes := []rune(s)
+	if keepPrefix+keepSuffix >= len(runes) {
+		keepPrefix, keepSuffix = 0, 0
+	}
+	for i := keepPrefix; i < len(runes)-keepSuffix; i++ {
+		r := runes[i]
+		switch {
+		case r >= '0' && r <= '9':
+			runes[i] = '0' + rune(rnd.Intn(10))
+		case r >= 'a' && r <= 'z':
+			runes[i] = 'a' + rune(rnd.Intn(26))
+		case r >= 'A' && r <= 'Z':
+			runes[i] = 'A' + rune(rnd.Intn(26))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
+
+func isStringType(tp byte) bool {
+	return types.IsTypeChar(tp) || types.IsTypeBlob(tp) || tp == mysql.TypeVarString
+}
+
+// isUniqueColumn returns whether the column is in the primary key or a unique key.
+func isUniqueColumn(ti *model.TableInfo, col *model.ColumnInfo) bool {
+	if mysql.HasPriKeyFlag(col.Flag) || mysql.HasUniKeyFlag(col.Flag) {
+		return true
+	}
+	for _, idx := range ti.Indices {
+		if !idx.Primary && !idx.Unique {
+			continue
+		}
+		for _, idxCol := range idx.Columns {
+			if idxCol.Offset == col.Offset {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+func (s *testSyncerSuite) TestColumnTransformGroup(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, `create table t(
+		id int primary key,
+		email varchar(40),
+		phone varchar(20),
+		token char(8),
+		note blob,
+		age int,
+		uid int,
+		unique key(uid, age))`)
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "db", Name: "t"}
+
+	g := NewColumnTransformGroup([]*config.ColumnTransformation{
+		{Schema: "db", Table: "t", Columns: []string{"email", "note"}, Type: config.ColumnTransformMask, Value: "salt", KeepPrefix: 1, KeepSuffix: 4},
+		{Schema: "db", Table: "t", Columns: []string{"Email", "phone"}, Type: config.ColumnTransformNull},
+		{Schema: "db", Table: "t", Columns: []string{"token"}, Type: config.ColumnTransformHash, Value: "salt"},
+	})
+
+	// not transformed tables
+	rows := [][]interface{}{{1, "a@b.com", "123", "x", nil, 10, 1}}
+	got, transformed, err := g.TransformRows(&filter.Table{Schema: "db", Name: "t2"}, ti, rows)
+	c.Assert(err, IsNil)
+	c.Assert(transformed, IsFalse)
+	c.Assert(got, DeepEquals, rows)
+
+	rows = [][]interface{}{
+		{1, "john.doe@example.com", "+1-555-0100", "token", []byte("Note 42"), 10, 1},
+		{2, nil, nil, nil, nil, 20, 2},
+	}
+	got, transformed, err = g.TransformRows(table, ti, rows)
+	c.Assert(err, IsNil)
+	c.Assert(transformed, IsTrue)
+	// the original rows are not modified
+	c.Assert(rows[0][1], Equals, "john.doe@example.com")
+
+	email := got[0][1].(string)
+	c.Assert(email, Matches, `j[a-z]{3}\.[a-z]{3}@[a-z]{7}\.com`)
+	c.Assert(email, Not(Equals), "john.doe@example.com")
+	c.Assert(got[0][2], IsNil)
+	c.Assert(got[0][3], Equals, hashString("token", "salt", 8))
+	c.Assert(got[0][3], HasLen, 8)
+	note := got[0][4].([]byte)
+	c.Assert(string(note), Matches, `N[a-z]{3} [0-9]{2}`)
+	c.Assert(got[0][5], Equals, 10)
+	c.Assert(got[1], DeepEquals, rows[1])
+
+	// deterministic
+	got2, _, err := g.TransformRows(table, ti, rows)
+	c.Assert(err, IsNil)
+	c.Assert(got2, DeepEquals, got)
+
+	// invalid transformations
+	cases := []struct {
+		rule *config.ColumnTransformation
+		msg  string
+	}{
+		{&config.ColumnTransformation{Columns: []string{"not_exist"}, Type: config.ColumnTransformNull}, ".*column not exist.*"},
+		{&config.ColumnTransformation{Columns: []string{"id"}, Type: config.ColumnTransformConstant}, ".*primary key or unique key.*"},
+		{&config.ColumnTransformation{Columns: []string{"uid"}, Type: config.ColumnTransformNull}, ".*primary key or unique key.*"},
+		{&config.ColumnTransformation{Columns: []string{"age"}, Type: config.ColumnTransformHash}, ".*only string columns.*"},
+	}
+	for _, cs := range cases {
+		cs.rule.Schema, cs.rule.Table = "db", "t"
+		g = NewColumnTransformGroup([]*config.ColumnTransformation{cs.rule})
+		_, _, err = g.TransformRows(table, ti, rows)
+		c.Assert(terror.ErrSyncerColumnTransformation.Equal(err), IsTrue)
+		c.Assert(err, ErrorMatches, cs.msg)
+	}
+}
+
+func (s *testSyncerSuite) TestMaskString(c *C) {
+	c.Assert(maskString("", "", 1, 1), Equals, "")
+	// too short to keep the prefix and suffix
+	masked := maskString("ab", "", 1, 1)
+	c.Assert(masked, Matches, "[a-z]{2}")
+	c.Assert(maskString("13800138000", "", 3, 4), Matches, `138[0-9]{4}8000`)
+	c.Assert(maskString("张三-Z", "", 0, 0), Matches, `\*\*-[A-Z]`)
+	// different salts give different results
+	c.Assert(maskString("13800138000", "a", 0, 0), Not(Equals), maskString("13800138000", "b", 0, 0))
+}
@@ -0,0 +1,209 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/syncer/metrics"
+)
+
+// compactor compacts the DML jobs of the same row before they are sent to causality, to reduce the write
+// amplification of hot rows in downstream.
+// the jobs are buffered until a non-DML job comes, the buffer is full or no more jobs are waiting in the input
+// channel, so no extra latency is introduced when the downstream keeps up with the upstream.
+// the rules to compact a DML job into a previous DML job of the same row are:
+// INSERT + UPDATE => INSERT, UPDATE + UPDATE => UPDATE, ANY + DELETE => DELETE, ANY + INSERT => INSERT (in safe mode).
+// only the tables with exactly one PK or NOT NULL UK are compacted, otherwise the order of the rows changes may
+// break other unique keys. the UPDATE changing the key is never compacted and all buffered jobs are flushed before it.
+type compactor struct {
+	inCh       chan *job
+	outCh      chan *job
+	bufferSize int
+	logger     log.Logger
+
+	buffer []*job         // buffered jobs, the compacted jobs are set to nil.
+	keyMap map[string]int // compact key -> index of the job in buffer
+
+	// for metrics
+	task   string
+	source string
+}
+
+// compactorWrap creates and runs a compactor instance.
+func compactorWrap(inCh chan *job, syncer *Syncer) chan *job {
+	bufferSize := syncer.cfg.QueueSize * syncer.cfg.WorkerCount
+	compactor := &compactor{
+		inCh:       inCh,
+		outCh:      make(chan *job, syncer.cfg.QueueSize),
+		bufferSize: bufferSize,
+		logger:     syncer.tctx.Logger.WithFields(zap.String("component", "compactor")),
+		buffer:     make([]*job, 0, bufferSize),
+		keyMap:     make(map[string]int),
+		task:       syncer.cfg.Name,
+		source:     syncer.cfg.SourceID,
+	}
+
+	go func() {
+		compactor.run()
+		compactor.close()
+	}()
+
+	return compactor.outCh
+}
+
+// run receives jobs, compacts and sends the DML jobs, and sends other jobs after all buffered jobs are sent.
+func (c *compactor) run() {
+	for j := range c.inCh {
+		metrics.QueueSizeGauge.WithLabelValues(c.task, "compactor_input", c.source).Set(float64(len(c.inCh)))
+
+		switch j.tp {
+		case insert, update, del:
+			startTime := time.Now()
+			c.compactJob(j)
+			metrics.CompactDurationHistogram.WithLabelValues(c.task, c.source).Observe(time.Since(startTime).Seconds())
+			if len(c.buffer) >= c.bufferSize || len(c.inCh) == 0 {
+				c.flushBuffer()
+			}
+		default:
+			c.flushBuffer()
+			c.outCh <- j
+		}
+	}
+	c.flushBuffer()
+}
+
+// close closes outer channel.
+func (c *compactor) close() {
+	close(c.outCh)
+}
+
+// flushBuffer sends all buffered jobs in order.
+func (c *compactor) flushBuffer() {
+	for _, j := range c.buffer {
+		if j != nil {
+			c.outCh <- j
+		}
+	}
+	c.buffer = c.buffer[:0]
+	c.keyMap = make(map[string]int)
+}
+
+// compactJob compacts the job into the buffered job of the same row if exists, and buffers it.
+func (c *compactor) compactJob(j *job) {
+	key := compactKey(j.dml, j.dml.originValues)
+	if key == "" {
+		c.buffer = append(c.buffer, j)
+		return
+	}
+	if j.tp == update {
+		if oldKey := compactKey(j.dml, j.dml.originOldValues); oldKey != key {
+			c.logger.Debug("update changes the key, flush the buffered jobs", zap.String("old key", oldKey), zap.String("key", key))
+			c.flushBuffer()
+			c.buffer = append(c.buffer, j)
+			return
+		}
+	}
+
+	if idx, ok := c.keyMap[key]; ok {
+		prev := c.buffer[idx]
+		if dml := compactDML(prev.dml, j.dml); dml != nil {
+			c.logger.Debug("compact dml", zap.Stringer("previous", prev.dml), zap.Stringer("current", j.dml), zap.Stringer("result", dml))
+			c.buffer[idx] = nil
+			j.dml = dml
+			j.tp = dml.op
+			// the memory quota of the compacted job is released together with the current job.
+			j.memSize += prev.memSize
+			metrics.CompactedJobsCounter.WithLabelValues(c.task, c.source).Inc()
+		}
+	}
+	c.keyMap[key] = len(c.buffer)
+	c.buffer = append(c.buffer, j)
+}
+
+// compactKey returns the key to identify the row of the values, an empty string is returned if the table can't be
+// compacted.
+func compactKey(dml *DML, values []interface{}) string {
+	ti := dml.sourceTableInfo
+	uniqueCount := 0
+	if ti.PKIsHandle {
+		uniqueCount++
+	}
+	for _, idx := range ti.Indices {
+		if idx.Unique {
+			uniqueCount++
+		}
+	}
+	if uniqueCount != 1 {
+		return ""
+	}
+	idx := findFitIndex(ti)
+	if idx == nil {
+		return ""
+	}
+	cols, vals := getColumnData(ti.Columns, idx, values)
+	return genKeyList(dml.targetTableID, cols, vals)
+}
+
+// compactDML compacts the current DML into the previous DML of the same row, returns nil if they can't be compacted.
+func compactDML(prev, cur *DML) *DML {
+	var (
+		op                         = cur.op
+		safeMode                   = prev.safeMode || cur.safeMode
+		oldValues, originOldValues []interface{}
+	)
+	switch cur.op {
+	case insert:
+		// the row may still exist in downstream if the previous one is DELETE.
+		safeMode = true
+	case del:
+		safeMode = cur.safeMode
+	case update:
+		switch prev.op {
+		case insert:
+			op = insert
+		case update:
+			oldValues, originOldValues = prev.oldValues, prev.originOldValues
+		default:
+			return nil
+		}
+	}
+	dml := newDML(op, safeMode, cur.targetTableID, cur.sourceTable, oldValues, cur.values, originOldValues, cur.originValues, cur.columns, cur.sourceTableInfo)
+	dml.partitions = mergePartitions(prev.partitions, cur.partitions)
+	return dml
+}
+
+// mergePartitions returns the union of the partitions written by two DMLs, the row may be moved across partitions.
+func mergePartitions(prev, cur []string) []string {
+	if len(prev) == 0 || len(cur) == 0 {
+		return cur
+	}
+	merged := append([]string{}, prev...)
+	for _, p := range cur {
+		found := false
+		for _, q := range prev {
+			if p == q {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, p)
+		}
+	}
+	return merged
+}
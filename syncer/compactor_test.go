@@ -0,0 +1,180 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+)
+
+func (s *testSyncerSuite) TestCompactJob(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, "create table tb(a int primary key, b int)")
+	c.Assert(err, IsNil)
+	ti2, err := createTableInfo(p, se, 2, "create table tb2(a int primary key, b int unique)")
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "test", Name: "tb"}
+	table2 := &filter.Table{Schema: "test", Name: "tb2"}
+	location := binlog.NewLocation("")
+	ec := &eventContext{startLocation: &location, currentLocation: &location, lastLocation: &location}
+
+	cases := []struct {
+		ops      []opType
+		vals     [][]interface{} // old values and values for update
+		expected []opType
+		safeMode []bool
+		oldVals  [][]interface{}
+		newVals  [][]interface{}
+	}{
+		{
+			// INSERT + UPDATE + UPDATE => INSERT
+			ops:      []opType{insert, update, update},
+			vals:     [][]interface{}{{1, 1}, {1, 1}, {1, 2}, {1, 2}, {1, 3}},
+			expected: []opType{insert},
+			safeMode: []bool{false},
+			oldVals:  [][]interface{}{nil},
+			newVals:  [][]interface{}{{1, 3}},
+		},
+		{
+			// UPDATE + UPDATE => UPDATE
+			ops:      []opType{update, update},
+			vals:     [][]interface{}{{1, 1}, {1, 2}, {1, 2}, {1, 3}},
+			expected: []opType{update},
+			safeMode: []bool{false},
+			oldVals:  [][]interface{}{{1, 1}},
+			newVals:  [][]interface{}{{1, 3}},
+		},
+		{
+			// UPDATE + DELETE + INSERT => INSERT in safe mode
+			ops:      []opType{update, del, insert},
+			vals:     [][]interface{}{{1, 1}, {1, 2}, {1, 2}, {1, 3}},
+			expected: []opType{insert},
+			safeMode: []bool{true},
+			oldVals:  [][]interface{}{nil},
+			newVals:  [][]interface{}{{1, 3}},
+		},
+		{
+			// INSERT + DELETE => DELETE, different rows are not compacted
+			ops:      []opType{insert, insert, del},
+			vals:     [][]interface{}{{1, 1}, {2, 2}, {1, 1}},
+			expected: []opType{insert, del},
+			safeMode: []bool{false, false},
+			oldVals:  [][]interface{}{nil, nil},
+			newVals:  [][]interface{}{{2, 2}, {1, 1}},
+		},
+		{
+			// UPDATE changing the key is not compacted
+			ops:      []opType{insert, update, update},
+			vals:     [][]interface{}{{1, 1}, {1, 1}, {2, 1}, {2, 1}, {2, 2}},
+			expected: []opType{insert, update, update},
+			safeMode: []bool{false, false, false},
+			oldVals:  [][]interface{}{nil, {1, 1}, {2, 1}},
+			newVals:  [][]interface{}{{1, 1}, {2, 1}, {2, 2}},
+		},
+	}
+
+	for i, cs := range cases {
+		comment := Commentf("case %d", i)
+		ch := make(chan *job, 10)
+		cp := &compactor{
+			outCh:      ch,
+			bufferSize: 10,
+			logger:     log.L(),
+			keyMap:     make(map[string]int),
+		}
+		vals := cs.vals
+		for _, op := range cs.ops {
+			var oldVal, val []interface{}
+			if op == update {
+				oldVal, val, vals = vals[0], vals[1], vals[2:]
+			} else {
+				val, vals = vals[0], vals[1:]
+			}
+			dml := newDML(op, false, "`test`.`tb`", table, oldVal, val, oldVal, val, ti.Columns, ti)
+			cp.compactJob(newDMLJob(op, table, table, dml, ec))
+		}
+		cp.flushBuffer()
+		c.Assert(ch, HasLen, len(cs.expected), comment)
+		for k, op := range cs.expected {
+			j := <-ch
+			c.Assert(j.tp, Equals, op, comment)
+			c.Assert(j.dml.op, Equals, op, comment)
+			c.Assert(j.dml.safeMode, Equals, cs.safeMode[k], comment)
+			c.Assert(j.dml.originOldValues, DeepEquals, cs.oldVals[k], comment)
+			c.Assert(j.dml.originValues, DeepEquals, cs.newVals[k], comment)
+		}
+	}
+
+	// tables with more than one unique key are not compacted
+	ch := make(chan *job, 10)
+	cp := &compactor{outCh: ch, bufferSize: 10, logger: log.L(), keyMap: make(map[string]int)}
+	for _, val := range [][]interface{}{{1, 1}, {1, 2}} {
+		dml := newDML(update, false, "`test`.`tb2`", table2, val, val, val, val, ti2.Columns, ti2)
+		cp.compactJob(newDMLJob(update, table2, table2, dml, ec))
+	}
+	cp.flushBuffer()
+	c.Assert(ch, HasLen, 2)
+}
+
+func (s *testSyncerSuite) TestCompactor(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, "create table tb(a int primary key, b int)")
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "test", Name: "tb"}
+	location := binlog.NewLocation("")
+	ec := &eventContext{startLocation: &location, currentLocation: &location, lastLocation: &location}
+
+	jobCh := make(chan *job, 10)
+	syncer := &Syncer{
+		cfg: &config.SubTaskConfig{
+			SyncerConfig: config.SyncerConfig{
+				QueueSize:   1024,
+				WorkerCount: 1,
+			},
+			Name:     "task",
+			SourceID: "source",
+		},
+		tctx: tcontext.Background().WithLogger(log.L()),
+	}
+	// send the jobs before running, so they are all buffered and compacted.
+	for i := 0; i < 3; i++ {
+		dml := newDML(update, false, "`test`.`tb`", table, []interface{}{1, i}, []interface{}{1, i + 1}, []interface{}{1, i}, []interface{}{1, i + 1}, ti.Columns, ti)
+		jobCh <- newDMLJob(update, table, table, dml, ec)
+	}
+	jobCh <- newFlushJob()
+	dml := newDML(del, false, "`test`.`tb`", table, nil, []interface{}{1, 3}, nil, []interface{}{1, 3}, ti.Columns, ti)
+	jobCh <- newDMLJob(del, table, table, dml, ec)
+	close(jobCh)
+
+	compactorCh := compactorWrap(jobCh, syncer)
+	var results []opType
+	for j := range compactorCh {
+		results = append(results, j.tp)
+		if j.tp == update {
+			c.Assert(j.dml.originOldValues, DeepEquals, []interface{}{1, 0})
+			c.Assert(j.dml.originValues, DeepEquals, []interface{}{1, 3})
+		}
+	}
+	// the buffered jobs are sent before the flush job, and the jobs after it are not compacted with them.
+	c.Assert(results, DeepEquals, []opType{update, flush, del})
+}
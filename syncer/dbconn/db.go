@@ -61,10 +61,19 @@ type DBConn struct {
 
 	// generate new BaseConn and close old one
 	ResetBaseConnFn func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error)
+
+	// limits the retryable errors of the downstream, nil means no limit
+	ErrorBudget *ErrorBudget
+
+	// acquires BaseConn from the shared pool for every execution if not nil, BaseConn is nil when not executing
+	Shared *SharedPoolClient
 }
 
 // ResetConn reset one worker connection from specify *BaseDB.
 func (conn *DBConn) ResetConn(tctx *tcontext.Context) error {
+	if conn.Shared != nil && conn.BaseConn == nil {
+		return nil
+	}
 	baseConn, err := conn.ResetBaseConnFn(tctx, conn.BaseConn)
 	if err != nil {
 		return err
@@ -97,6 +106,9 @@ func (conn *DBConn) QuerySQL(tctx *tcontext.Context, query string, args ...inter
 				return true
 			}
 			if dbutil.IsRetryableError(err) {
+				if !conn.ErrorBudget.Consume(time.Now()) {
+					return false
+				}
 				tctx.L().Warn("query statement", zap.Int("retry", retryTime),
 					zap.String("query", utils.TruncateString(query, -1)),
 					zap.String("argument", utils.TruncateInterface(args, -1)),
@@ -137,7 +149,7 @@ func (conn *DBConn) QuerySQL(tctx *tcontext.Context, query string, args ...inter
 			zap.String("query", utils.TruncateString(query, -1)),
 			zap.String("argument", utils.TruncateInterface(args, -1)),
 			log.ShortError(err))
-		return nil, err
+		return nil, conn.wrapErrorBudgetExhausted(err)
 	}
 	return ret.(*sql.Rows), nil
 }
@@ -156,6 +168,21 @@ func (conn *DBConn) ExecuteSQLWithIgnore(tctx *tcontext.Context, ignoreError fun
 		return 0, nil
 	}
 
+	if conn != nil && conn.Shared != nil {
+		baseConn, err := conn.Shared.acquire(tctx)
+		if err != nil {
+			return 0, err
+		}
+		conn.BaseConn = baseConn
+		affected, err := conn.executeSQLWithIgnore(tctx, ignoreError, queries, args...)
+		conn.Shared.release(conn.BaseConn, err == nil)
+		conn.BaseConn = nil
+		return affected, err
+	}
+	return conn.executeSQLWithIgnore(tctx, ignoreError, queries, args...)
+}
+
+func (conn *DBConn) executeSQLWithIgnore(tctx *tcontext.Context, ignoreError func(error) bool, queries []string, args ...[]interface{}) (int, error) {
 	if conn == nil || conn.BaseConn == nil {
 		return 0, terror.ErrDBUnExpect.Generate("database base connection not valid")
 	}
@@ -181,6 +208,9 @@ func (conn *DBConn) ExecuteSQLWithIgnore(tctx *tcontext.Context, ignoreError fun
 				return true
 			}
 			if dbutil.IsRetryableError(err) {
+				if !conn.ErrorBudget.Consume(time.Now()) {
+					return false
+				}
 				tctx.L().Warn("execute statements", zap.Int("retry", retryTime),
 					zap.String("queries", utils.TruncateInterface(queries, -1)),
 					zap.String("arguments", utils.TruncateInterface(args, -1)),
@@ -222,11 +252,20 @@ func (conn *DBConn) ExecuteSQLWithIgnore(tctx *tcontext.Context, ignoreError fun
 			zap.String("queries", utils.TruncateInterface(queries, -1)),
 			zap.String("arguments", utils.TruncateInterface(args, -1)),
 			log.ShortError(err))
-		return ret.(int), err
+		return ret.(int), conn.wrapErrorBudgetExhausted(err)
 	}
 	return ret.(int), nil
 }
 
+// wrapErrorBudgetExhausted wraps err as ErrSyncerErrorBudgetExhausted if the error budget is exhausted,
+// so the task will be paused and not be resumed automatically.
+func (conn *DBConn) wrapErrorBudgetExhausted(err error) error {
+	if !conn.ErrorBudget.Exhausted() || !dbutil.IsRetryableError(err) {
+		return err
+	}
+	return terror.ErrSyncerErrorBudgetExhausted.Delegate(err, conn.ErrorBudget.Budget(), conn.ErrorBudget.Window())
+}
+
 // ExecuteSQL does some SQL executions.
 func (conn *DBConn) ExecuteSQL(tctx *tcontext.Context, queries []string, args ...[]interface{}) (int, error) {
 	return conn.ExecuteSQLWithIgnore(tctx, nil, queries, args...)
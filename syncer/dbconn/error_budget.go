@@ -0,0 +1,99 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbconn
+
+import (
+	"sync"
+	"time"
+)
+
+// ErrorBudget limits how many retryable errors of the downstream can be retried in a sliding window.
+// It's shared by all DBConns of a subtask, so it's thread-safe. A nil ErrorBudget never exhausts.
+type ErrorBudget struct {
+	sync.Mutex
+
+	budget int
+	window time.Duration
+	// times of the consumed retryable errors in the window, in ascending order
+	consumed  []time.Time
+	exhausted bool
+}
+
+// NewErrorBudget creates a new ErrorBudget, returns nil if budget is not positive.
+func NewErrorBudget(budget int, window time.Duration) *ErrorBudget {
+	if budget <= 0 {
+		return nil
+	}
+	return &ErrorBudget{
+		budget:   budget,
+		window:   window,
+		consumed: make([]time.Time, 0, budget),
+	}
+}
+
+// Consume records a retryable error happened at now, returns false if the budget is exhausted.
+// Once exhausted, it keeps exhausted until Reset is called.
+func (b *ErrorBudget) Consume(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+	b.Lock()
+	defer b.Unlock()
+	if b.exhausted {
+		return false
+	}
+
+	i := 0
+	for i < len(b.consumed) && now.Sub(b.consumed[i]) >= b.window {
+		i++
+	}
+	b.consumed = append(b.consumed[:0], b.consumed[i:]...)
+	if len(b.consumed) >= b.budget {
+		b.exhausted = true
+		return false
+	}
+	b.consumed = append(b.consumed, now)
+	return true
+}
+
+// Exhausted returns whether the budget is exhausted.
+func (b *ErrorBudget) Exhausted() bool {
+	if b == nil {
+		return false
+	}
+	b.Lock()
+	defer b.Unlock()
+	return b.exhausted
+}
+
+// Reset refills the budget.
+func (b *ErrorBudget) Reset() {
+	if b == nil {
+		return
+	}
+	b.Lock()
+	defer b.Unlock()
+	b.consumed = b.consumed[:0]
+	b.exhausted = false
+}
+
+// Budget returns the number of retryable errors allowed in the window.
+func (b *ErrorBudget) Budget() int {
+	return b.budget
+}
+
+// Window returns the length of the sliding window.
+func (b *ErrorBudget) Window() time.Duration {
+	return b.window
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbconn
+
+import (
+	"testing"
+	"time"
+)
+
+func TestErrorBudget(t *testing.T) {
+	var nilBudget *ErrorBudget
+	if NewErrorBudget(0, time.Minute) != nilBudget {
+		t.Fatal("error budget should be nil if budget is not positive")
+	}
+	// nil budget never exhausts.
+	if !nilBudget.Consume(time.Now()) || nilBudget.Exhausted() {
+		t.Fatal("nil error budget should never exhaust")
+	}
+	nilBudget.Reset()
+
+	b := NewErrorBudget(2, time.Minute)
+	now := time.Now()
+	if !b.Consume(now) || !b.Consume(now.Add(time.Second)) {
+		t.Fatal("should consume the budget")
+	}
+	// the oldest error slides out of the window.
+	if !b.Consume(now.Add(time.Minute)) {
+		t.Fatal("should consume the budget after the oldest error slides out")
+	}
+	if b.Exhausted() {
+		t.Fatal("error budget should not be exhausted")
+	}
+	if b.Consume(now.Add(time.Minute)) {
+		t.Fatal("should not consume the exhausted budget")
+	}
+	if !b.Exhausted() {
+		t.Fatal("error budget should be exhausted")
+	}
+	// keep exhausted even if errors slide out of the window.
+	if b.Consume(now.Add(time.Hour)) {
+		t.Fatal("should not consume the exhausted budget before reset")
+	}
+
+	b.Reset()
+	if b.Exhausted() || !b.Consume(now.Add(time.Hour)) {
+		t.Fatal("error budget should be refilled after reset")
+	}
+}
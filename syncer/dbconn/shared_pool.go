@@ -0,0 +1,210 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbconn
+
+import (
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// sharedPools are the shared downstream connection pools of the subtasks in this process, keyed by sharedPoolKey.
+var sharedPools = struct {
+	sync.Mutex
+	pools map[string]*SharedPool
+}{pools: make(map[string]*SharedPool)}
+
+// SharedPool shares the connections to a downstream among the subtasks on a DM-worker, which use the same
+// connection settings. a connection is acquired for every batch of DMLs and released after it's executed, so the
+// subtasks don't hold idle connections. to be fair, a subtask can hold at most the pool size divided by the number
+// of subtasks using the pool, but at least one connection.
+type SharedPool struct {
+	key  string
+	db   *conn.BaseDB
+	size int
+
+	mu       sync.Mutex
+	clients  map[*SharedPoolClient]int // client -> the number of connections in use
+	inUse    int
+	idle     []*conn.BaseConn
+	released chan struct{} // closed when a connection is released
+}
+
+// SharedPoolClient is a subtask using a SharedPool.
+type SharedPoolClient struct {
+	pool *SharedPool
+}
+
+// sharedPoolKey returns the key of the pool, the connections can be shared only if all the settings are the same.
+func sharedPoolKey(dbCfg config.DBConfig) string {
+	// they are not marshaled in String.
+	return fmt.Sprintf("%s|%s|%+v|%+v", dbCfg.String(), dbCfg.Password, dbCfg.RawDBCfg, dbCfg.SessionLabel)
+}
+
+// JoinSharedPool joins the pool of the downstream, the pool is created with `size` connections at most if not exists.
+func JoinSharedPool(tctx *tcontext.Context, dbCfg config.DBConfig, size int) (*SharedPoolClient, error) {
+	key := sharedPoolKey(dbCfg)
+	sharedPools.Lock()
+	defer sharedPools.Unlock()
+	pool, ok := sharedPools.pools[key]
+	if !ok {
+		db, err := CreateBaseDB(dbCfg)
+		if err != nil {
+			return nil, err
+		}
+		pool = &SharedPool{
+			key:      key,
+			db:       db,
+			size:     size,
+			clients:  make(map[*SharedPoolClient]int),
+			released: make(chan struct{}),
+		}
+		sharedPools.pools[key] = pool
+		tctx.L().Info("create shared downstream connection pool", zap.String("downstream", dbCfg.Host), zap.Int("size", size))
+	}
+	client := &SharedPoolClient{pool: pool}
+	pool.mu.Lock()
+	pool.clients[client] = 0
+	pool.mu.Unlock()
+	return client, nil
+}
+
+// DB returns the DB of the pool, it should not be closed by the clients.
+func (c *SharedPoolClient) DB() *conn.BaseDB {
+	return c.pool.db
+}
+
+// Leave leaves the pool, the pool is closed when all the clients leave.
+func (c *SharedPoolClient) Leave(tctx *tcontext.Context) {
+	p := c.pool
+	sharedPools.Lock()
+	defer sharedPools.Unlock()
+	p.mu.Lock()
+	delete(p.clients, c)
+	remain := len(p.clients)
+	// the fair share of the other clients grows.
+	p.notify()
+	p.mu.Unlock()
+	if remain > 0 {
+		return
+	}
+	delete(sharedPools.pools, p.key)
+	// the connections in use are closed with the DB.
+	CloseBaseDB(tctx, p.db)
+	tctx.L().Info("close shared downstream connection pool")
+}
+
+// notify wakes up the clients waiting for connections, it should be called with p.mu held.
+func (p *SharedPool) notify() {
+	close(p.released)
+	p.released = make(chan struct{})
+}
+
+// fairShare returns the number of connections a client can hold at most, it should be called with p.mu held.
+func (p *SharedPool) fairShare() int {
+	share := p.size / len(p.clients)
+	if share < 1 {
+		return 1
+	}
+	return share
+}
+
+// acquire acquires a connection, it waits until the pool and the fair share of the client are not exhausted.
+func (c *SharedPoolClient) acquire(tctx *tcontext.Context) (*conn.BaseConn, error) {
+	p := c.pool
+	for {
+		p.mu.Lock()
+		if p.inUse < p.size && c.inUseLocked() < p.fairShare() {
+			p.inUse++
+			p.clients[c]++
+			var baseConn *conn.BaseConn
+			if n := len(p.idle); n > 0 {
+				baseConn = p.idle[n-1]
+				p.idle = p.idle[:n-1]
+			}
+			p.mu.Unlock()
+			if baseConn != nil {
+				return baseConn, nil
+			}
+			baseConn, err := p.db.GetBaseConn(tctx.Context())
+			if err != nil {
+				c.release(nil, false)
+				return nil, terror.WithScope(err, terror.ScopeDownstream)
+			}
+			return baseConn, nil
+		}
+		released := p.released
+		p.mu.Unlock()
+
+		select {
+		case <-tctx.Context().Done():
+			return nil, terror.ErrDBExecuteFailed.Delegate(tctx.Context().Err(), "acquire connection from shared pool")
+		case <-released:
+		}
+	}
+}
+
+func (c *SharedPoolClient) inUseLocked() int {
+	return c.pool.clients[c]
+}
+
+// release releases the connection to the pool, the connection is closed if it can't be reused, like it may be broken
+// after an error.
+func (c *SharedPoolClient) release(baseConn *conn.BaseConn, reuse bool) {
+	p := c.pool
+	if baseConn != nil && !reuse {
+		// nolint:errcheck
+		p.db.CloseBaseConn(baseConn)
+		baseConn = nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inUse--
+	if _, ok := p.clients[c]; ok {
+		p.clients[c]--
+	}
+	if baseConn != nil {
+		p.idle = append(p.idle, baseConn)
+	}
+	p.notify()
+}
+
+// CreateSharedConns returns `count` connections which acquire connections from the shared pool for every execution.
+// they can only execute statements, querying is not supported because the rows hold the connection.
+func CreateSharedConns(tctx *tcontext.Context, cfg *config.SubTaskConfig, dbCfg config.DBConfig, count, size int) (*SharedPoolClient, []*DBConn, error) {
+	client, err := JoinSharedPool(tctx, dbCfg, size)
+	if err != nil {
+		return nil, nil, err
+	}
+	db := client.DB()
+	conns := make([]*DBConn, 0, count)
+	for i := 0; i < count; i++ {
+		resetBaseConnFn := func(tctx *tcontext.Context, baseConn *conn.BaseConn) (*conn.BaseConn, error) {
+			err := db.CloseBaseConn(baseConn)
+			if err != nil {
+				tctx.L().Warn("failed to close BaseConn in reset", log.ShortError(err))
+			}
+			return db.GetBaseConn(tctx.Context())
+		}
+		conns = append(conns, &DBConn{Cfg: cfg, ResetBaseConnFn: resetBaseConnFn, Shared: client})
+	}
+	return client, conns, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbconn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+func TestSharedPool(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.MatchExpectationsInOrder(false)
+	tctx := tcontext.Background()
+	pool := &SharedPool{
+		db:       conn.NewBaseDB(db, func() {}),
+		size:     3,
+		clients:  make(map[*SharedPoolClient]int),
+		released: make(chan struct{}),
+	}
+	c1, c2 := &SharedPoolClient{pool: pool}, &SharedPoolClient{pool: pool}
+	pool.clients[c1] = 0
+
+	// a single client can use the whole pool.
+	conns := make([]*conn.BaseConn, 0, 3)
+	for i := 0; i < 3; i++ {
+		baseConn, err2 := c1.acquire(tctx)
+		if err2 != nil {
+			t.Fatal(err2)
+		}
+		conns = append(conns, baseConn)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	_, err = c1.acquire(tctx.WithContext(ctx))
+	cancel()
+	if !terror.ErrDBExecuteFailed.Equal(err) {
+		t.Fatalf("should fail to acquire when the pool is exhausted, got %v", err)
+	}
+
+	// the fair share of every client is 1 after another client joins.
+	pool.mu.Lock()
+	pool.clients[c2] = 0
+	pool.mu.Unlock()
+	c1.release(conns[0], true)
+	c1.release(conns[1], true)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	_, err = c1.acquire(tctx.WithContext(ctx))
+	cancel()
+	if !terror.ErrDBExecuteFailed.Equal(err) {
+		t.Fatalf("should fail to acquire more than the fair share, got %v", err)
+	}
+	baseConn, err := c2.acquire(tctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if baseConn != conns[1] {
+		t.Fatal("should reuse the idle connection")
+	}
+
+	// the waiting client is woken up when a connection is released.
+	done := make(chan error, 1)
+	go func() {
+		ctx2, cancel2 := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel2()
+		_, err2 := c2.acquire(tctx.WithContext(ctx2))
+		done <- err2
+	}()
+	time.Sleep(10 * time.Millisecond)
+	pool.mu.Lock()
+	delete(pool.clients, c1)
+	pool.notify()
+	pool.mu.Unlock()
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	pool.mu.Lock()
+	inUse, idle := pool.inUse, len(pool.idle)
+	pool.mu.Unlock()
+	if inUse != 3 || idle != 0 {
+		t.Fatalf("unexpected pool state, in use %d, idle %d", inUse, idle)
+	}
+	// the connection can't be reused after an error is closed.
+	c2.release(baseConn, false)
+	pool.mu.Lock()
+	inUse, idle = pool.inUse, len(pool.idle)
+	pool.mu.Unlock()
+	if inUse != 2 || idle != 0 {
+		t.Fatalf("unexpected pool state, in use %d, idle %d", inUse, idle)
+	}
+}
@@ -76,6 +76,11 @@ func (conn *UpStreamConn) GetParser(ctx context.Context) (*parser.Parser, error)
 	return utils.GetParser(ctx, conn.BaseDB.DB)
 }
 
+// GetSQLMode returns the sql_mode of upstream.
+func (conn *UpStreamConn) GetSQLMode(ctx context.Context) (tmysql.SQLMode, error) {
+	return utils.GetSQLMode(ctx, conn.BaseDB.DB)
+}
+
 // KillConn kills a connection in upstream.
 func (conn *UpStreamConn) KillConn(ctx context.Context, connID uint32) error {
 	return utils.KillConn(ctx, conn.BaseDB.DB, connID)
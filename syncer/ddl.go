@@ -200,6 +200,11 @@ type ddlInfo struct {
 	originStmt   ast.StmtNode
 	sourceTables []*filter.Table
 	targetTables []*filter.Table
+
+	// DROP/TRUNCATE PARTITION is converted to DELETE by `partition-ddl-policy: convert`, empty `deleteWhere` means
+	// all the rows are deleted.
+	deleteRows  bool
+	deleteWhere string
 }
 
 func (d *ddlInfo) String() string {
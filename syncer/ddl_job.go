@@ -0,0 +1,307 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/parser"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/pb"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+	parserpkg "github.com/pingcap/dm/pkg/parser"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+var (
+	// ddlJobPollInterval is the interval to poll the DDL job in downstream after the execution of DDL timed out.
+	ddlJobPollInterval = 5 * time.Second
+	// ddlJobSearchCount is the count of recent DDL jobs in downstream to search the DDL job of a timed out DDL.
+	ddlJobSearchCount = 100
+)
+
+// states of DDL jobs in TiDB, see `model.JobState`.
+const (
+	ddlJobStateSynced       = "synced"
+	ddlJobStateCancelled    = "cancelled"
+	ddlJobStateRollbackDone = "rollback done"
+)
+
+// ddlJobTracker records the progress of DDLs which are still running in downstream after `ddl-timeout`.
+type ddlJobTracker struct {
+	sync.RWMutex
+	running map[string]*pb.DDLJobProgress // DDL -> progress
+}
+
+func newDDLJobTracker() *ddlJobTracker {
+	return &ddlJobTracker{running: make(map[string]*pb.DDLJobProgress)}
+}
+
+func (t *ddlJobTracker) update(progress *pb.DDLJobProgress) {
+	t.Lock()
+	defer t.Unlock()
+	t.running[progress.Ddl] = progress
+}
+
+func (t *ddlJobTracker) finish(ddl string) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.running, ddl)
+}
+
+// Running returns the progress of all running DDLs, ordered by their start time.
+func (t *ddlJobTracker) Running() []*pb.DDLJobProgress {
+	if t == nil {
+		return nil
+	}
+	t.RLock()
+	defer t.RUnlock()
+	if len(t.running) == 0 {
+		return nil
+	}
+	res := make([]*pb.DDLJobProgress, 0, len(t.running))
+	for _, p := range t.running {
+		res = append(res, p)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].StartTime != res[j].StartTime {
+			return res[i].StartTime < res[j].StartTime
+		}
+		return res[i].Ddl < res[j].Ddl
+	})
+	return res
+}
+
+// executeDDLs executes DDLs in downstream. if `ddl-timeout` is set and a DDL isn't finished in time,
+// the connection is released and the DDL job is tracked asynchronously until it's done.
+// it returns the count of executed DDLs like `ExecuteSQLWithIgnore`. the DDLs are written to the file for a dry-run
+// subtask instead.
+func (s *Syncer) executeDDLs(tctx *tcontext.Context, db *dbconn.DBConn, ddls []string) (int, error) {
+	if s.dryRun != nil {
+		if err := s.dryRun.writeDDLs(ddls); err != nil {
+			return 0, err
+		}
+		return len(ddls), nil
+	}
+	if s.cfg.DDLTimeout <= 0 {
+		return db.ExecuteSQLWithIgnore(tctx, ignoreDDLError, ddls)
+	}
+
+	timeout := time.Duration(s.cfg.DDLTimeout) * time.Second
+	for i, ddl := range ddls {
+		startTime := time.Now()
+		tctx2, cancel := tctx.WithTimeout(timeout)
+		_, err := db.ExecuteSQLWithIgnore(tctx2, ignoreDDLError, []string{ddl})
+		timedOut := tctx2.Ctx.Err() == context.DeadlineExceeded && tctx.Ctx.Err() == nil
+		cancel()
+		if err == nil {
+			continue
+		}
+		if !timedOut {
+			return i, err
+		}
+
+		tctx.L().Warn("execution of DDL timed out, track the DDL job in downstream", zap.String("DDL", ddl), zap.Duration("timeout", timeout), log.ShortError(err))
+		// the connection may be broken by the timeout.
+		if err = db.ResetConn(tctx); err != nil {
+			return i, err
+		}
+		if err = s.waitDDLJob(tctx, db, ddl, startTime); err != nil {
+			return i, err
+		}
+	}
+	return len(ddls), nil
+}
+
+// waitDDLJob polls the DDL job of ddl in downstream until it's synced.
+func (s *Syncer) waitDDLJob(tctx *tcontext.Context, db *dbconn.DBConn, ddl string, startTime time.Time) error {
+	schema, table, err := ddlTargetTable(ddl)
+	if err != nil {
+		return err
+	}
+	defer s.ddlJobTracker.finish(ddl)
+
+	var jobID int64
+	ticker := time.NewTicker(ddlJobPollInterval)
+	defer ticker.Stop()
+	for {
+		if jobID == 0 {
+			jobID, err = findDDLJob(tctx, db, ddl, schema, table)
+			if err != nil {
+				return err
+			}
+			if jobID == 0 {
+				return terror.ErrSyncerDDLJobNotFound.Generate(ddl)
+			}
+		}
+
+		progress, err := queryDDLJob(tctx, db, jobID)
+		if err != nil {
+			return err
+		}
+		progress.Ddl = ddl
+		progress.StartTime = startTime.Format(time.RFC3339)
+
+		switch progress.State {
+		case ddlJobStateSynced:
+			tctx.L().Info("DDL job in downstream finished", zap.String("DDL", ddl), zap.Int64("job ID", jobID), zap.Duration("cost time", time.Since(startTime)))
+			return nil
+		case ddlJobStateCancelled, ddlJobStateRollbackDone:
+			return terror.ErrSyncerDDLJobFailed.Generate(jobID, ddl, progress.State)
+		}
+		s.ddlJobTracker.update(progress)
+
+		select {
+		case <-tctx.Ctx.Done():
+			return tctx.Ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ddlTargetTable returns the table of ddl, which is routed and has schema name.
+func ddlTargetTable(ddl string) (string, string, error) {
+	// ddls are came from *Syncer.routeDDL, which can be parsed by the default parser.
+	stmt, err := parser.New().ParseOneStmt(ddl, "", "")
+	if err != nil {
+		return "", "", terror.ErrSyncerParseDDL.Delegate(err, ddl)
+	}
+	tables, err := parserpkg.FetchDDLTables("", stmt, utils.LCTableNamesSensitive)
+	if err != nil {
+		return "", "", err
+	}
+	if len(tables) == 0 {
+		return "", "", terror.ErrSyncerDDLJobNotFound.Generate(ddl)
+	}
+	return tables[0].Schema, tables[0].Name, nil
+}
+
+// queryDDLJobs returns the recent DDL jobs in downstream as `ADMIN SHOW DDL JOBS` shows, the columns vary in different
+// versions of TiDB, so it returns column name -> value for each job.
+func queryDDLJobs(tctx *tcontext.Context, db *dbconn.DBConn, query string) ([]map[string]string, error) {
+	rows, err := db.QuerySQL(tctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	}
+	var jobs []map[string]string
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+		}
+		job := make(map[string]string, len(columns))
+		for i, column := range columns {
+			job[strings.ToUpper(column)] = values[i].String
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, terror.DBErrorAdapt(rows.Err(), terror.ErrDBDriverError)
+}
+
+// findDDLJob finds the ID of the latest DDL job of ddl in downstream, returns 0 if not found.
+func findDDLJob(tctx *tcontext.Context, db *dbconn.DBConn, ddl, schema, table string) (int64, error) {
+	jobs, err := queryDDLJobs(tctx, db, fmt.Sprintf("ADMIN SHOW DDL JOBS %d", ddlJobSearchCount))
+	if err != nil {
+		return 0, err
+	}
+
+	var candidates []int64
+	for _, job := range jobs {
+		if job["DB_NAME"] != schema || job["TABLE_NAME"] != table {
+			continue
+		}
+		id, err2 := strconv.ParseInt(job["JOB_ID"], 10, 64)
+		if err2 != nil {
+			continue
+		}
+		candidates = append(candidates, id)
+	}
+	// check the latest job first.
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] > candidates[j] })
+
+	for _, id := range candidates {
+		rows, err := db.QuerySQL(tctx, fmt.Sprintf("ADMIN SHOW DDL JOB QUERIES %d", id))
+		if err != nil {
+			return 0, err
+		}
+		var query string
+		matched := false
+		for rows.Next() {
+			if err = rows.Scan(&query); err != nil {
+				rows.Close()
+				return 0, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+			}
+			if sameDDL(query, ddl) {
+				matched = true
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return 0, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+		}
+		if matched {
+			return id, nil
+		}
+	}
+	return 0, nil
+}
+
+// queryDDLJob returns the progress of the DDL job in downstream.
+func queryDDLJob(tctx *tcontext.Context, db *dbconn.DBConn, jobID int64) (*pb.DDLJobProgress, error) {
+	jobs, err := queryDDLJobs(tctx, db, fmt.Sprintf("ADMIN SHOW DDL JOBS %d WHERE JOB_ID = %d", ddlJobSearchCount, jobID))
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, terror.ErrSyncerDDLJobNotFound.Generate(fmt.Sprintf("job %d", jobID))
+	}
+	job := jobs[0]
+	rowCount, _ := strconv.ParseInt(job["ROW_COUNT"], 10, 64)
+	return &pb.DDLJobProgress{
+		JobID:       jobID,
+		JobType:     job["JOB_TYPE"],
+		SchemaState: job["SCHEMA_STATE"],
+		State:       job["STATE"],
+		RowCount:    rowCount,
+	}, nil
+}
+
+func sameDDL(a, b string) bool {
+	trim := func(s string) string {
+		return strings.TrimRight(strings.TrimSpace(s), ";")
+	}
+	return trim(a) == trim(b)
+}
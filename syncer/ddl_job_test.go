@@ -0,0 +1,146 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/retry"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+var _ = Suite(&testDDLJobSuite{})
+
+type testDDLJobSuite struct{}
+
+func (t *testDDLJobSuite) TestDDLJobTracker(c *C) {
+	var nilTracker *ddlJobTracker
+	c.Assert(nilTracker.Running(), IsNil)
+
+	tracker := newDDLJobTracker()
+	c.Assert(tracker.Running(), IsNil)
+	p1 := &pb.DDLJobProgress{Ddl: "ALTER TABLE `db`.`tb1` ADD INDEX `idx`(`c`)", StartTime: "2021-11-01T10:00:00Z", State: "running"}
+	p2 := &pb.DDLJobProgress{Ddl: "ALTER TABLE `db`.`tb2` ADD INDEX `idx`(`c`)", StartTime: "2021-11-01T09:00:00Z", State: "queueing"}
+	tracker.update(p1)
+	tracker.update(p2)
+	c.Assert(tracker.Running(), DeepEquals, []*pb.DDLJobProgress{p2, p1})
+	tracker.finish(p2.Ddl)
+	c.Assert(tracker.Running(), DeepEquals, []*pb.DDLJobProgress{p1})
+}
+
+func (t *testDDLJobSuite) TestDDLTargetTable(c *C) {
+	schema, table, err := ddlTargetTable("ALTER TABLE `db`.`tb` ADD INDEX `idx`(`c`)")
+	c.Assert(err, IsNil)
+	c.Assert(schema, Equals, "db")
+	c.Assert(table, Equals, "tb")
+
+	schema, table, err = ddlTargetTable("CREATE DATABASE `db`")
+	c.Assert(err, IsNil)
+	c.Assert(schema, Equals, "db")
+	c.Assert(table, Equals, "")
+
+	_, _, err = ddlTargetTable("invalid ddl")
+	c.Assert(terror.ErrSyncerParseDDL.Equal(err), IsTrue)
+}
+
+func (t *testDDLJobSuite) TestExecuteDDLsWithTimeout(c *C) {
+	defer func(interval time.Duration) {
+		ddlJobPollInterval = interval
+	}(ddlJobPollInterval)
+	ddlJobPollInterval = 10 * time.Millisecond
+
+	ddl := "ALTER TABLE `db`.`tb` ADD INDEX `idx`(`c`)"
+	jobColumns := []string{"JOB_ID", "DB_NAME", "TABLE_NAME", "JOB_TYPE", "SCHEMA_STATE", "SCHEMA_ID", "TABLE_ID", "ROW_COUNT", "START_TIME", "END_TIME", "STATE"}
+
+	newSyncer := func() (*Syncer, *dbconn.DBConn, sqlmock.Sqlmock) {
+		db, mock, err := sqlmock.New()
+		c.Assert(err, IsNil)
+		dbConn, err := db.Conn(context.Background())
+		c.Assert(err, IsNil)
+		baseConn := conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})
+		cfg := &config.SubTaskConfig{}
+		cfg.DDLTimeout = 1
+		syncer := &Syncer{cfg: cfg, ddlJobTracker: newDDLJobTracker()}
+		// the connection is closed after the execution timed out, so reset it with a new one.
+		ddlConn := &dbconn.DBConn{Cfg: cfg, BaseConn: baseConn, ResetBaseConnFn: func(*tcontext.Context, *conn.BaseConn) (*conn.BaseConn, error) {
+			dbConn2, err2 := db.Conn(context.Background())
+			c.Assert(err2, IsNil)
+			return conn.NewBaseConn(dbConn2, &retry.FiniteRetryStrategy{}), nil
+		}}
+		return syncer, ddlConn, mock
+	}
+	expectTimeout := func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec("ALTER TABLE").WillDelayFor(3 * time.Second).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	expectFindJob := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery("ADMIN SHOW DDL JOBS 100").WillReturnRows(sqlmock.NewRows(jobColumns).
+			AddRow(12, "db", "tb", "add index", "write reorganization", 1, 2, 100, nil, nil, "running").
+			AddRow(11, "db", "tb", "add index", "public", 1, 2, 10, nil, nil, "synced").
+			AddRow(10, "db", "tb2", "add index", "public", 1, 3, 10, nil, nil, "synced"))
+		mock.ExpectQuery("ADMIN SHOW DDL JOB QUERIES 12").WillReturnRows(sqlmock.NewRows([]string{"QUERY"}).AddRow(ddl + ";"))
+	}
+	expectJobState := func(mock sqlmock.Sqlmock, rowCount int, state string) {
+		mock.ExpectQuery("ADMIN SHOW DDL JOBS 100 WHERE JOB_ID = 12").WillReturnRows(sqlmock.NewRows(jobColumns).
+			AddRow(12, "db", "tb", "add index", "write reorganization", 1, 2, rowCount, nil, nil, state))
+	}
+	tctx := tcontext.Background()
+
+	// the DDL job is tracked until it's synced
+	syncer, ddlConn, mock := newSyncer()
+	expectTimeout(mock)
+	expectFindJob(mock)
+	expectJobState(mock, 100, "running")
+	expectJobState(mock, 200, "synced")
+	affected, err := syncer.executeDDLs(tctx, ddlConn, []string{ddl})
+	c.Assert(err, IsNil)
+	c.Assert(affected, Equals, 1)
+	c.Assert(syncer.ddlJobTracker.Running(), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the DDL job is cancelled
+	syncer, ddlConn, mock = newSyncer()
+	expectTimeout(mock)
+	expectFindJob(mock)
+	expectJobState(mock, 100, "rollback done")
+	affected, err = syncer.executeDDLs(tctx, ddlConn, []string{ddl})
+	c.Assert(terror.ErrSyncerDDLJobFailed.Equal(err), IsTrue)
+	c.Assert(affected, Equals, 0)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the DDL job is not found
+	syncer, ddlConn, mock = newSyncer()
+	expectTimeout(mock)
+	mock.ExpectQuery("ADMIN SHOW DDL JOBS 100").WillReturnRows(sqlmock.NewRows(jobColumns))
+	_, err = syncer.executeDDLs(tctx, ddlConn, []string{ddl})
+	c.Assert(terror.ErrSyncerDDLJobNotFound.Equal(err), IsTrue)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// other errors are returned directly
+	syncer, ddlConn, mock = newSyncer()
+	mock.ExpectBegin()
+	mock.ExpectExec("ALTER TABLE").WillReturnError(terror.ErrDBUnExpect.Generate("mock error"))
+	mock.ExpectRollback()
+	_, err = syncer.executeDDLs(tctx, ddlConn, []string{ddl})
+	c.Assert(err, ErrorMatches, ".*mock error.*")
+}
@@ -0,0 +1,137 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/log"
+)
+
+var (
+	// ddlWindowCheckInterval is the interval to check whether the DDL window starts, and whether the tables waited by
+	// a blocked DDL are released.
+	ddlWindowCheckInterval = 30 * time.Second
+	// timeNow is used to mock the time in tests.
+	timeNow = time.Now
+)
+
+// inDDLWindow returns whether the DDLs can be executed now, always true if `ddl-window` is not set.
+func (s *Syncer) inDDLWindow() bool {
+	return s.ddlWindow == nil || s.ddlWindow.Contains(timeNow())
+}
+
+// isBufferableDDLs returns whether the DDLs of qec can be held by the `buffer` policy, every DDL should change exactly
+// one table, so the following DMLs of the table can be buffered after it.
+func isBufferableDDLs(qec *queryEventContext) bool {
+	for _, info := range qec.trackInfos {
+		if len(info.targetTables) != 1 || info.targetTables[0].Name == "" {
+			return false
+		}
+	}
+	return len(qec.trackInfos) > 0
+}
+
+// holdDDLs holds the DDLs of qec until the DDL window by the `buffer` policy, the following DMLs of the tables are
+// buffered after them. it returns false if the DDLs should be executed now.
+// the DDLs are held even in the window if a table of them is still held, to keep the order.
+func (s *Syncer) holdDDLs(qec *queryEventContext) (bool, error) {
+	if s.ddlWindow == nil || s.cfg.DDLWindowPolicy != config.DDLWindowBuffer || !isBufferableDDLs(qec) {
+		return false, nil
+	}
+	if s.inDDLWindow() && !s.hasDDLWindowTables(qec) {
+		return false, nil
+	}
+
+	for i, ddl := range qec.needHandleDDLs {
+		table := qec.trackInfos[i].targetTables[0]
+		if err := s.quarantine.holdDDL(table, ddl, *qec.startLocation); err != nil {
+			return true, err
+		}
+	}
+	qec.tctx.L().Info("hold DDLs until the DDL window", zap.String("event", "query"),
+		zap.Strings("DDLs", qec.needHandleDDLs), zap.String("window", s.cfg.DDLWindow))
+	return true, s.recordSkipSQLsLocation(qec.eventContext)
+}
+
+// hasDDLWindowTables returns whether any table of the DDLs is held by the DDL window.
+func (s *Syncer) hasDDLWindowTables(qec *queryEventContext) bool {
+	for _, info := range qec.trackInfos {
+		for _, table := range info.targetTables {
+			if s.quarantine.isHeldByDDLWindow(table) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// waitDDLWindow blocks replicating until the DDLs of qec can be executed. the `block` policy waits for the DDL window,
+// the `buffer` policy waits for the DDL window and the release of the held tables if the DDLs can't be buffered.
+func (s *Syncer) waitDDLWindow(qec *queryEventContext) error {
+	if s.ddlWindow == nil || len(qec.needHandleDDLs) == 0 {
+		return nil
+	}
+	if s.cfg.DDLWindowPolicy == config.DDLWindowBuffer && isBufferableDDLs(qec) {
+		return nil
+	}
+
+	logged := false
+	ticker := time.NewTicker(ddlWindowCheckInterval)
+	defer ticker.Stop()
+	for {
+		if s.inDDLWindow() && !s.hasDDLWindowTables(qec) {
+			return nil
+		}
+		if !logged {
+			qec.tctx.L().Info("wait for the DDL window to execute DDLs", zap.String("event", "query"),
+				zap.Strings("DDLs", qec.needHandleDDLs), zap.Time("window start", s.ddlWindow.NextStart(timeNow())))
+			logged = true
+		}
+		select {
+		case <-qec.tctx.Ctx.Done():
+			return qec.tctx.Ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ddlWindowLoop releases the tables held by the DDL window in the window, the held DDLs and the buffered DMLs of them
+// are executed in order.
+func (s *Syncer) ddlWindowLoop(ctx context.Context) {
+	ticker := time.NewTicker(ddlWindowCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !s.inDDLWindow() {
+			continue
+		}
+		for _, table := range s.quarantine.ddlWindowTables() {
+			if err := s.releaseQuarantinedTable(ctx, table); err != nil {
+				// retry in the next round, the following tables are not released to keep the order of the DDLs.
+				s.tctx.L().Error("fail to release the table held by DDL window", zap.Stringer("table", table), log.ShortError(err))
+				break
+			}
+			s.tctx.L().Info("release the table held by DDL window", zap.Stringer("table", table))
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"time"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	tcontext "github.com/pingcap/dm/pkg/context"
+)
+
+var _ = Suite(&testDDLWindowSuite{})
+
+type testDDLWindowSuite struct{}
+
+func (t *testDDLWindowSuite) TestHoldDDLs(c *C) {
+	defer func(f func() time.Time) { timeNow = f }(timeNow)
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.Local)
+	timeNow = func() time.Time { return now }
+
+	window, err := config.ParseDDLWindow("01:00-05:00")
+	c.Assert(err, IsNil)
+	cfg := &config.SubTaskConfig{LoaderConfig: config.LoaderConfig{Dir: c.MkDir()}}
+	cfg.DDLWindowPolicy = config.DDLWindowBuffer
+	var jobs []*job
+	s := &Syncer{
+		cfg:        cfg,
+		quarantine: newTableQuarantine(cfg),
+		ddlWindow:  &window,
+		addJobFunc: func(j *job) error {
+			jobs = append(jobs, j)
+			return nil
+		},
+	}
+
+	table := &filter.Table{Schema: "db", Name: "tb"}
+	location := binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil)
+	tctx := tcontext.Background()
+	newQEC := func(ddl string, tables ...*filter.Table) *queryEventContext {
+		return &queryEventContext{
+			eventContext:   &eventContext{tctx: tctx, startLocation: &location, lastLocation: &location},
+			needHandleDDLs: []string{ddl},
+			trackInfos:     []*ddlInfo{{routedDDL: ddl, targetTables: tables}},
+		}
+	}
+
+	// out of the window, the DDL is held and the event is skipped.
+	held, err := s.holdDDLs(newQEC("ALTER TABLE `db`.`tb` ADD INDEX idx(name)", table))
+	c.Assert(err, IsNil)
+	c.Assert(held, IsTrue)
+	c.Assert(jobs, HasLen, 1)
+	c.Assert(jobs[0].tp, Equals, skip)
+	c.Assert(s.quarantine.isHeldByDDLWindow(table), IsTrue)
+
+	// the DDLs can't be buffered are blocked.
+	dbDDL := newQEC("CREATE DATABASE db2", &filter.Table{Schema: "db2"})
+	held, err = s.holdDDLs(dbDDL)
+	c.Assert(err, IsNil)
+	c.Assert(held, IsFalse)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	dbDDL.tctx = tctx.WithContext(ctx)
+	c.Assert(s.waitDDLWindow(dbDDL), Equals, context.DeadlineExceeded)
+	cancel()
+
+	// in the window, the DDLs of the held table are still held to keep the order, the others are executed.
+	now = time.Date(2021, 10, 2, 2, 0, 0, 0, time.Local)
+	held, err = s.holdDDLs(newQEC("ALTER TABLE `db`.`tb` DROP INDEX idx", table))
+	c.Assert(err, IsNil)
+	c.Assert(held, IsTrue)
+	held, err = s.holdDDLs(newQEC("ALTER TABLE `db`.`tb2` ADD INDEX idx(name)", &filter.Table{Schema: "db", Name: "tb2"}))
+	c.Assert(err, IsNil)
+	c.Assert(held, IsFalse)
+	c.Assert(s.waitDDLWindow(dbDDL), IsNil)
+	// the DDLs can't be buffered wait for the release of the held tables.
+	rename := newQEC("RENAME TABLE `db`.`tb` TO `db`.`tb3`", table, &filter.Table{Schema: "db", Name: "tb3"})
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	rename.tctx = tctx.WithContext(ctx)
+	c.Assert(s.waitDDLWindow(rename), Equals, context.DeadlineExceeded)
+	cancel()
+	status := s.quarantine.status()
+	c.Assert(status, HasLen, 1)
+	c.Assert(status[0].Buffered, Equals, 2)
+
+	// the `block` policy doesn't hold the DDLs.
+	s.cfg.DDLWindowPolicy = config.DDLWindowBlock
+	now = time.Date(2021, 10, 2, 12, 0, 0, 0, time.Local)
+	held, err = s.holdDDLs(newQEC("ALTER TABLE `db`.`tb2` DROP INDEX idx", &filter.Table{Schema: "db", Name: "tb2"}))
+	c.Assert(err, IsNil)
+	c.Assert(held, IsFalse)
+}
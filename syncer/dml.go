@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/pingcap/tidb-tools/pkg/filter"
 	"github.com/pingcap/tidb/expression"
@@ -25,6 +26,9 @@ import (
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/types"
 	"go.uber.org/zap"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 
 	"github.com/pingcap/dm/pkg/log"
 	"github.com/pingcap/dm/pkg/terror"
@@ -37,8 +41,11 @@ type genDMLParam struct {
 	safeMode        bool                // only used in update
 	data            [][]interface{}     // pruned data
 	originalData    [][]interface{}     // all data
+	filterData      [][]interface{}     // all data before column transformation, nil if not transformed
 	columns         []*model.ColumnInfo // pruned columns
 	sourceTableInfo *model.TableInfo    // all table info
+	minimalRowImage bool                // only used with `minimal-row-image`
+	notLogged       []map[int]struct{}  // offsets of the columns not logged in each row, nil if all rows are full
 }
 
 func extractValueFromData(data []interface{}, columns []*model.ColumnInfo) []interface{} {
@@ -69,9 +76,13 @@ RowLoop:
 		if len(columns) != len(ti.Columns) {
 			originalValue = extractValueFromData(originalDataSeq[dataIdx], ti.Columns)
 		}
+		filterValue := originalValue
+		if param.filterData != nil && len(filterExprs) > 0 {
+			filterValue = extractValueFromData(param.filterData[dataIdx], ti.Columns)
+		}
 
 		for _, expr := range filterExprs {
-			skip, err := SkipDMLByExpression(originalValue, expr, ti.Columns)
+			skip, err := SkipDMLByExpression(filterValue, expr, ti.Columns)
 			if err != nil {
 				return nil, err
 			}
@@ -81,7 +92,8 @@ RowLoop:
 			}
 		}
 
-		dmls = append(dmls, newDML(insert, param.safeMode, param.targetTableID, param.sourceTable, nil, value, nil, originalValue, columns, ti))
+		dml := newDML(insert, param.safeMode, param.targetTableID, param.sourceTable, nil, value, nil, originalValue, columns, ti)
+		dmls = append(dmls, dml.withRowImage(param, dataIdx))
 	}
 
 	return dmls, nil
@@ -126,15 +138,20 @@ RowLoop:
 			oriOldValues = extractValueFromData(oriOldData, ti.Columns)
 			oriChangedValues = extractValueFromData(oriChangedData, ti.Columns)
 		}
+		filterOldValues, filterChangedValues := oriOldValues, oriChangedValues
+		if param.filterData != nil && len(oldValueFilters) > 0 {
+			filterOldValues = extractValueFromData(param.filterData[i], ti.Columns)
+			filterChangedValues = extractValueFromData(param.filterData[i+1], ti.Columns)
+		}
 
 		for j := range oldValueFilters {
 			// AND logic
 			oldExpr, newExpr := oldValueFilters[j], newValueFilters[j]
-			skip1, err := SkipDMLByExpression(oriOldValues, oldExpr, ti.Columns)
+			skip1, err := SkipDMLByExpression(filterOldValues, oldExpr, ti.Columns)
 			if err != nil {
 				return nil, err
 			}
-			skip2, err := SkipDMLByExpression(oriChangedValues, newExpr, ti.Columns)
+			skip2, err := SkipDMLByExpression(filterChangedValues, newExpr, ti.Columns)
 			if err != nil {
 				return nil, err
 			}
@@ -145,7 +162,8 @@ RowLoop:
 			}
 		}
 
-		dmls = append(dmls, newDML(update, param.safeMode, param.targetTableID, param.sourceTable, oldValues, changedValues, oriOldValues, oriChangedValues, columns, ti))
+		dml := newDML(update, param.safeMode, param.targetTableID, param.sourceTable, oldValues, changedValues, oriOldValues, oriChangedValues, columns, ti)
+		dmls = append(dmls, dml.withRowImage(param, i+1))
 	}
 
 	return dmls, nil
@@ -159,15 +177,19 @@ func (s *Syncer) genAndFilterDeleteDMLs(param *genDMLParam, filterExprs []expres
 	)
 
 RowLoop:
-	for _, data := range dataSeq {
+	for dataIdx, data := range dataSeq {
 		if len(data) != len(ti.Columns) {
 			return nil, terror.ErrSyncerUnitDMLColumnNotMatch.Generate(len(ti.Columns), len(data))
 		}
 
 		value := extractValueFromData(data, ti.Columns)
+		filterValue := value
+		if param.filterData != nil && len(filterExprs) > 0 {
+			filterValue = extractValueFromData(param.filterData[dataIdx], ti.Columns)
+		}
 
 		for _, expr := range filterExprs {
-			skip, err := SkipDMLByExpression(value, expr, ti.Columns)
+			skip, err := SkipDMLByExpression(filterValue, expr, ti.Columns)
 			if err != nil {
 				return nil, err
 			}
@@ -176,7 +198,8 @@ RowLoop:
 				continue RowLoop
 			}
 		}
-		dmls = append(dmls, newDML(del, false, param.targetTableID, param.sourceTable, nil, value, nil, value, ti.Columns, ti))
+		dml := newDML(del, false, param.targetTableID, param.sourceTable, nil, value, nil, value, ti.Columns, ti)
+		dmls = append(dmls, dml.withRowImage(param, dataIdx))
 	}
 
 	return dmls, nil
@@ -356,28 +379,33 @@ func (s *Syncer) mappingDML(table *filter.Table, ti *model.TableInfo, data [][]i
 // directly in DML, we must remove generated column from DML, including column
 // list and data list including generated columns.
 func pruneGeneratedColumnDML(ti *model.TableInfo, data [][]interface{}) ([]*model.ColumnInfo, [][]interface{}, error) {
-	// search for generated columns. if none found, return everything as-is.
-	firstGeneratedColumnIndex := -1
+	return pruneColumnDML(ti, data, isGeneratedColumn)
+}
+
+// pruneColumnDML filters columns list and data removing the columns matched by pruner.
+func pruneColumnDML(ti *model.TableInfo, data [][]interface{}, pruner columnPruner) ([]*model.ColumnInfo, [][]interface{}, error) {
+	// search for pruned columns. if none found, return everything as-is.
+	firstPrunedColumnIndex := -1
 	for i, c := range ti.Columns {
-		if c.IsGenerated() {
-			firstGeneratedColumnIndex = i
+		if pruner(c) {
+			firstPrunedColumnIndex = i
 			break
 		}
 	}
-	if firstGeneratedColumnIndex < 0 {
+	if firstPrunedColumnIndex < 0 {
 		return ti.Columns, data, nil
 	}
 
-	// remove generated columns from the list of columns
+	// remove pruned columns from the list of columns
 	cols := make([]*model.ColumnInfo, 0, len(ti.Columns))
-	cols = append(cols, ti.Columns[:firstGeneratedColumnIndex]...)
-	for _, c := range ti.Columns[(firstGeneratedColumnIndex + 1):] {
-		if !c.IsGenerated() {
+	cols = append(cols, ti.Columns[:firstPrunedColumnIndex]...)
+	for _, c := range ti.Columns[(firstPrunedColumnIndex + 1):] {
+		if !pruner(c) {
 			cols = append(cols, c)
 		}
 	}
 
-	// remove generated columns from the list of data.
+	// remove pruned columns from the list of data.
 	rows := make([][]interface{}, 0, len(data))
 	for _, row := range data {
 		if len(row) != len(ti.Columns) {
@@ -385,7 +413,7 @@ func pruneGeneratedColumnDML(ti *model.TableInfo, data [][]interface{}) ([]*mode
 		}
 		value := make([]interface{}, 0, len(cols))
 		for i := range row {
-			if !ti.Columns[i].IsGenerated() {
+			if !pruner(ti.Columns[i]) {
 				value = append(value, row[i])
 			}
 		}
@@ -394,18 +422,6 @@ func pruneGeneratedColumnDML(ti *model.TableInfo, data [][]interface{}) ([]*mode
 	return cols, rows, nil
 }
 
-// checkLogColumns returns error when not all rows in skipped is empty, which means the binlog doesn't contain all
-// columns.
-// TODO: don't return error when all skipped columns is non-PK.
-func checkLogColumns(skipped [][]int) error {
-	for _, row := range skipped {
-		if len(row) > 0 {
-			return terror.ErrBinlogNotLogColumn
-		}
-	}
-	return nil
-}
-
 // DML stores param for DML.
 type DML struct {
 	targetTableID   string
@@ -418,7 +434,10 @@ type DML struct {
 	originOldValues []interface{} // only for update SQL
 	originValues    []interface{} // use to gen key and `WHERE`
 	safeMode        bool
-	key             string // use to detect causality
+	key             string   // use to detect causality
+	partitions      []string // the downstream partitions written to, empty if not specified
+	minimalRowImage bool
+	notLogged       map[int]struct{} // offsets of the columns not logged in the (after) image, only for minimal row image
 }
 
 // newDML creates DML.
@@ -437,6 +456,35 @@ func newDML(op opType, safeMode bool, targetTableID string, sourceTable *filter.
 	}
 }
 
+// withRowImage sets the row image info of the idx-th row of param for `minimal-row-image`.
+func (dml *DML) withRowImage(param *genDMLParam, idx int) *DML {
+	if !param.minimalRowImage {
+		return dml
+	}
+	dml.minimalRowImage = true
+	if param.notLogged != nil {
+		dml.notLogged = param.notLogged[idx]
+	}
+	return dml
+}
+
+// loggedColumnsAndValues returns the columns and values of the DML which are logged in binlog.
+func (dml *DML) loggedColumnsAndValues() ([]*model.ColumnInfo, []interface{}) {
+	if len(dml.notLogged) == 0 {
+		return dml.columns, dml.values
+	}
+	columns := make([]*model.ColumnInfo, 0, len(dml.columns))
+	values := make([]interface{}, 0, len(dml.values))
+	for i, col := range dml.columns {
+		if _, ok := dml.notLogged[col.Offset]; ok {
+			continue
+		}
+		columns = append(columns, col)
+		values = append(values, dml.values[i])
+	}
+	return columns, values
+}
+
 // String returns the DML's string.
 func (dml *DML) String() string {
 	return fmt.Sprintf("[safemode: %t, targetTableID: %s, op: %s, columns: %v, oldValues: %v, values: %v]", dml.safeMode, dml.targetTableID, dml.op.String(), dml.columnNames(), dml.originOldValues, dml.originValues)
@@ -455,6 +503,11 @@ func (dml *DML) identifyKeys() []string {
 	if dml.originValues != nil {
 		keys = append(keys, genMultipleKeys(dml.sourceTableInfo, dml.originValues, dml.targetTableID)...)
 	}
+	// the unique keys other than the primary key may be not logged in the minimal row images, the conflicts on them
+	// can't be detected, so the rows of such tables are executed in order.
+	if dml.minimalRowImage && hasUniqueKeyBesidesPrimary(dml.sourceTableInfo) {
+		keys = append(keys, dml.targetTableID)
+	}
 	return keys
 }
 
@@ -514,6 +567,72 @@ func genKeyList(table string, columns []*model.ColumnInfo, dataSeq []interface{}
 	return buf.String()
 }
 
+// uniqueKeyValues converts the values of the columns in a unique index to the forms compared by the index, so the rows
+// conflicting on the index in downstream always get the same key. for string columns, only the prefix of the length of
+// the index column is compared, the trailing spaces are ignored under the PAD SPACE collations, which are all the
+// collations except the binary and NO PAD ones, and the case and the accents are ignored under the `_ci` collations
+// except the accent-sensitive ones. folding more than the collation does only makes the rows executed in order.
+func uniqueKeyValues(index *model.IndexInfo, cols []*model.ColumnInfo, vals []interface{}) []interface{} {
+	var normalized []interface{}
+	for i, col := range cols {
+		if !types.IsTypeBlob(col.Tp) && !types.IsTypeChar(col.Tp) {
+			continue
+		}
+		var str string
+		switch v := vals[i].(type) {
+		case string:
+			str = v
+		case []byte:
+			str = string(v)
+		default:
+			continue
+		}
+
+		binary := col.Charset == "binary" || col.Collate == "binary"
+		if length := index.Columns[i].Length; length != types.UnspecifiedLength {
+			if binary {
+				if len(str) > length {
+					str = str[:length]
+				}
+			} else if runes := []rune(str); len(runes) > length {
+				str = string(runes[:length])
+			}
+		}
+		if !binary {
+			collation := strings.ToLower(col.Collate)
+			// the collations of MySQL 8.0 and the `_nopad_` ones of MariaDB are NO PAD.
+			if !strings.Contains(collation, "_0900_") && !strings.Contains(collation, "_nopad_") {
+				str = strings.TrimRight(str, " ")
+			}
+			if strings.HasSuffix(collation, "_ci") {
+				str = strings.ToLower(str)
+				if !strings.HasSuffix(collation, "_as_ci") {
+					str = foldAccents(str)
+				}
+			}
+		}
+
+		if normalized == nil {
+			normalized = make([]interface{}, len(vals))
+			copy(normalized, vals)
+		}
+		normalized[i] = str
+	}
+	if normalized == nil {
+		return vals
+	}
+	return normalized
+}
+
+// foldAccents removes the accents of the characters, like "é" to "e".
+func foldAccents(str string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), str)
+	if err != nil {
+		return str
+	}
+	return folded
+}
+
 // genMultipleKeys gens keys with UNIQUE NOT NULL value.
 // if not UNIQUE NOT NULL value, use table name instead.
 func genMultipleKeys(ti *model.TableInfo, value []interface{}, table string) []string {
@@ -532,7 +651,7 @@ func genMultipleKeys(ti *model.TableInfo, value []interface{}, table string) []s
 			continue
 		}
 		cols, vals := getColumnData(ti.Columns, indexCols, value)
-		key := genKeyList(table, cols, vals)
+		key := genKeyList(table, cols, uniqueKeyValues(indexCols, cols, vals))
 		if len(key) > 0 { // ignore `null` value.
 			multipleKeys = append(multipleKeys, key)
 		} else {
@@ -550,6 +669,22 @@ func genMultipleKeys(ti *model.TableInfo, value []interface{}, table string) []s
 	return multipleKeys
 }
 
+// writeTableName writes the target table with the partition selection if any.
+func (dml *DML) writeTableName(buf *strings.Builder) {
+	buf.WriteString(dml.targetTableID)
+	if len(dml.partitions) == 0 {
+		return
+	}
+	buf.WriteString(" PARTITION (")
+	for i, p := range dml.partitions {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString("`" + strings.ReplaceAll(p, "`", "``") + "`")
+	}
+	buf.WriteByte(')')
+}
+
 // genWhere generates where condition.
 func (dml *DML) genWhere(buf *strings.Builder) []interface{} {
 	whereColumns, whereValues := dml.whereColumnsAndValues()
@@ -583,8 +718,9 @@ func (dml *DML) genSQL() (sql []string, arg [][]interface{}) {
 }
 
 // genUpdateSQL generates a `UPDATE` SQL with `WHERE`.
+// the not logged columns of minimal row image are not changed, so the row is updated in place even in safe mode.
 func (dml *DML) genUpdateSQL() ([]string, [][]interface{}) {
-	if dml.safeMode {
+	if dml.safeMode && len(dml.notLogged) == 0 {
 		sqls, args := dml.genDeleteSQL()
 		insertSQLs, insertArgs := dml.genInsertSQL()
 		sqls = append(sqls, insertSQLs...)
@@ -594,11 +730,12 @@ func (dml *DML) genUpdateSQL() ([]string, [][]interface{}) {
 	var buf strings.Builder
 	buf.Grow(2048)
 	buf.WriteString("UPDATE ")
-	buf.WriteString(dml.targetTableID)
+	dml.writeTableName(&buf)
 	buf.WriteString(" SET ")
 
-	for i, column := range dml.columns {
-		if i == len(dml.columns)-1 {
+	columns, values := dml.loggedColumnsAndValues()
+	for i, column := range columns {
+		if i == len(columns)-1 {
 			fmt.Fprintf(&buf, "`%s` = ?", strings.ReplaceAll(column.Name.O, "`", "``"))
 		} else {
 			fmt.Fprintf(&buf, "`%s` = ?, ", strings.ReplaceAll(column.Name.O, "`", "``"))
@@ -609,7 +746,7 @@ func (dml *DML) genUpdateSQL() ([]string, [][]interface{}) {
 	whereArgs := dml.genWhere(&buf)
 	buf.WriteString(" LIMIT 1")
 
-	args := dml.values
+	args := values
 	args = append(args, whereArgs...)
 	return []string{buf.String()}, [][]interface{}{args}
 }
@@ -619,7 +756,7 @@ func (dml *DML) genDeleteSQL() ([]string, [][]interface{}) {
 	var buf strings.Builder
 	buf.Grow(1024)
 	buf.WriteString("DELETE FROM ")
-	buf.WriteString(dml.targetTableID)
+	dml.writeTableName(&buf)
 	buf.WriteString(" WHERE ")
 	whereArgs := dml.genWhere(&buf)
 	buf.WriteString(" LIMIT 1")
@@ -633,10 +770,11 @@ func (dml *DML) genInsertSQL() ([]string, [][]interface{}) {
 	var buf strings.Builder
 	buf.Grow(256)
 	buf.WriteString("INSERT INTO ")
-	buf.WriteString(dml.targetTableID)
+	dml.writeTableName(&buf)
 	buf.WriteString(" (")
-	for i, column := range dml.columns {
-		if i != len(dml.columns)-1 {
+	columns, values := dml.loggedColumnsAndValues()
+	for i, column := range columns {
+		if i != len(columns)-1 {
 			buf.WriteString("`" + strings.ReplaceAll(column.Name.O, "`", "``") + "`,")
 		} else {
 			buf.WriteString("`" + strings.ReplaceAll(column.Name.O, "`", "``") + "`)")
@@ -645,8 +783,8 @@ func (dml *DML) genInsertSQL() ([]string, [][]interface{}) {
 	buf.WriteString(" VALUES (")
 
 	// placeholders
-	for i := range dml.columns {
-		if i != len(dml.columns)-1 {
+	for i := range columns {
+		if i != len(columns)-1 {
 			buf.WriteString("?,")
 		} else {
 			buf.WriteString("?)")
@@ -654,13 +792,13 @@ func (dml *DML) genInsertSQL() ([]string, [][]interface{}) {
 	}
 	if dml.safeMode {
 		buf.WriteString(" ON DUPLICATE KEY UPDATE ")
-		for i, column := range dml.columns {
+		for i, column := range columns {
 			col := strings.ReplaceAll(column.Name.O, "`", "``")
 			buf.WriteString("`" + col + "`=VALUES(`" + col + "`)")
-			if i != len(dml.columns)-1 {
+			if i != len(columns)-1 {
 				buf.WriteByte(',')
 			}
 		}
 	}
-	return []string{buf.String()}, [][]interface{}{dml.values}
+	return []string{buf.String()}, [][]interface{}{values}
 }
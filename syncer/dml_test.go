@@ -210,6 +210,30 @@ func (s *testSyncerSuite) TestGenMultipleKeys(c *C) {
 			values: []interface{}{17, nil},
 			keys:   []string{"17.a.table"},
 		},
+		{
+			// unique key with prefix length
+			schema: `create table t9(a int primary key, b varchar(16), c blob, unique key(b(3)), unique key(c(2)))`,
+			values: []interface{}{18, "abcdef", []byte("xyz")},
+			keys:   []string{"18.a.table", "abc.b.table", "xy.c.table"},
+		},
+		{
+			// unique key with prefix length of multi-byte characters
+			schema: `create table t10(a int primary key, b varchar(16) charset utf8mb4, unique key(b(2)))`,
+			values: []interface{}{19, "数据迁移"},
+			keys:   []string{"19.a.table", "数据.b.table"},
+		},
+		{
+			// unique key with case-insensitive and PAD SPACE collations
+			schema: `create table t11(a int primary key, b varchar(16) collate utf8mb4_general_ci unique, c varchar(16) collate utf8mb4_bin unique)`,
+			values: []interface{}{20, "ABc  ", "ABc  "},
+			keys:   []string{"20.a.table", "abc.b.table", "ABc.c.table"},
+		},
+		{
+			// unique key with accent-insensitive collation, the binary string is kept
+			schema: `create table t12(a int primary key, b varchar(16) collate utf8mb4_unicode_ci unique, c varbinary(16) unique)`,
+			values: []interface{}{21, "Café ", []byte("Café ")},
+			keys:   []string{"21.a.table", "cafe.b.table", "Café .c.table"},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -28,14 +28,23 @@ import (
 	"github.com/pingcap/dm/pkg/utils"
 	"github.com/pingcap/dm/syncer/dbconn"
 	"github.com/pingcap/dm/syncer/metrics"
+	"github.com/pingcap/dm/syncer/sink"
 )
 
+// maxExactlyOnceTxnJobs is the max number of row changes buffered for a transaction in `exactly-once` mode.
+var maxExactlyOnceTxnJobs = 100000
+
 // DMLWorker is used to sync dml.
 type DMLWorker struct {
 	batch       int
 	workerCount int
+	queueCount  int // workerCount, plus the dedicated queue for the slow digests if enabled
 	chanSize    int
 	toDBConns   []*dbconn.DBConn
+	sink        *sink.Sink // replaces toDBConns when not nil
+	slowDigest  *slowDigestDetector
+	exactlyOnce *exactlyOnceRecorder
+	dryRun      *dryRunWriter // replaces toDBConns when not nil
 	tctx        *tcontext.Context
 	wg          sync.WaitGroup // counts conflict/flush jobs in all DML job channels.
 	logger      log.Logger
@@ -62,6 +71,7 @@ func dmlWorkerWrap(inCh chan *job, syncer *Syncer) chan *job {
 	dmlWorker := &DMLWorker{
 		batch:        syncer.cfg.Batch,
 		workerCount:  syncer.cfg.WorkerCount,
+		queueCount:   syncer.dmlQueueCount(),
 		chanSize:     syncer.cfg.QueueSize,
 		task:         syncer.cfg.Name,
 		source:       syncer.cfg.SourceID,
@@ -73,6 +83,10 @@ func dmlWorkerWrap(inCh chan *job, syncer *Syncer) chan *job {
 		addCountFunc: syncer.addCount,
 		tctx:         syncer.tctx,
 		toDBConns:    syncer.toDBConns,
+		sink:         syncer.sink,
+		slowDigest:   syncer.slowDigest,
+		exactlyOnce:  syncer.exactlyOnce,
+		dryRun:       syncer.dryRun,
 		inCh:         inCh,
 		flushCh:      make(chan *job),
 	}
@@ -91,45 +105,60 @@ func (w *DMLWorker) close() {
 
 // run distribute jobs by queueBucket.
 func (w *DMLWorker) run() {
-	jobChs := make([]chan *job, w.workerCount)
+	jobChs := make([]chan *job, w.queueCount)
 
-	for i := 0; i < w.workerCount; i++ {
+	for i := 0; i < w.queueCount; i++ {
 		jobChs[i] = make(chan *job, w.chanSize)
 		go w.executeJobs(i, jobChs[i])
 	}
 
 	defer func() {
-		for i := 0; i < w.workerCount; i++ {
+		for i := 0; i < w.queueCount; i++ {
 			close(jobChs[i])
 		}
 	}()
 
-	queueBucketMapping := make([]string, w.workerCount)
-	for i := 0; i < w.workerCount; i++ {
+	queueBucketMapping := make([]string, w.queueCount)
+	for i := 0; i < w.queueCount; i++ {
 		queueBucketMapping[i] = queueBucketName(i)
 	}
 
+	var (
+		isolatedTables  map[string]struct{}
+		isolatedVersion int64
+	)
 	for j := range w.inCh {
 		metrics.QueueSizeGauge.WithLabelValues(w.task, "dml_worker_input", w.source).Set(float64(len(w.inCh)))
 		if j.tp == flush || j.tp == conflict {
 			if j.tp == conflict {
 				w.addCountFunc(false, adminQueueName, j.tp, 1, j.targetTable)
 			}
-			w.wg.Add(w.workerCount)
-			// flush for every DML queue
-			for i, jobCh := range jobChs {
-				startTime := time.Now()
-				jobCh <- j
-				metrics.AddJobDurationHistogram.WithLabelValues(j.tp.String(), w.task, queueBucketMapping[i], w.source).Observe(time.Since(startTime).Seconds())
-			}
-			w.wg.Wait()
+			w.waitQueues(jobChs, j, queueBucketMapping)
 			if j.tp == conflict {
 				w.addCountFunc(true, adminQueueName, j.tp, 1, j.targetTable)
 			} else {
 				w.flushCh <- j
 			}
+		} else if j.tp == asyncFlush {
+			// don't wait the DML queues, the checkpoint flush worker waits for `flushWg` instead.
+			for i, jobCh := range jobChs {
+				startTime := time.Now()
+				jobCh <- j
+				metrics.AddJobDurationHistogram.WithLabelValues(j.tp.String(), w.task, queueBucketMapping[i], w.source).Observe(time.Since(startTime).Seconds())
+			}
+		} else if j.tp == xid {
+			// only sent in `exactly-once` mode, which has only one DML queue.
+			jobChs[0] <- j
 		} else {
+			if version := w.slowDigest.getVersion(); version != isolatedVersion {
+				// wait all queues before moving tables across queues, to keep the order of the changes of a row.
+				w.waitQueues(jobChs, newConflictJob(), queueBucketMapping)
+				isolatedTables, isolatedVersion = w.slowDigest.isolatedTables()
+			}
 			queueBucket := int(utils.GenHashKey(j.dml.key)) % w.workerCount
+			if _, ok := isolatedTables[j.dml.targetTableID]; ok {
+				queueBucket = w.workerCount
+			}
 			w.addCountFunc(false, queueBucketMapping[queueBucket], j.tp, 1, j.targetTable)
 			startTime := time.Now()
 			w.logger.Debug("queue for key", zap.Int("queue", queueBucket), zap.String("key", j.dml.key))
@@ -139,24 +168,72 @@ func (w *DMLWorker) run() {
 	}
 }
 
+// waitQueues sends the flush or conflict job to all DML queues and waits until the jobs before it are executed.
+func (w *DMLWorker) waitQueues(jobChs []chan *job, j *job, queueBucketMapping []string) {
+	w.wg.Add(len(jobChs))
+	for i, jobCh := range jobChs {
+		startTime := time.Now()
+		jobCh <- j
+		metrics.AddJobDurationHistogram.WithLabelValues(j.tp.String(), w.task, queueBucketMapping[i], w.source).Observe(time.Since(startTime).Seconds())
+	}
+	w.wg.Wait()
+}
+
 // executeJobs execute jobs in same queueBucket
 // All the jobs received should be executed consecutively.
 func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 	jobs := make([]*job, 0, w.batch)
 	workerJobIdx := dmlWorkerJobIdx(queueID)
 	queueBucket := queueBucketName(queueID)
+	var (
+		// the xid job of the last transaction in jobs, only in `exactly-once` mode.
+		xidJob *job
+		// the number of the buffered row changes of the current transaction, only in `exactly-once` mode.
+		txnJobs int
+		// the row changes of the current transaction are dropped after it's too large.
+		dropTxn bool
+	)
 	for j := range jobCh {
 		metrics.QueueSizeGauge.WithLabelValues(w.task, queueBucket, w.source).Set(float64(len(jobCh)))
 
-		if j.tp != flush && j.tp != conflict {
+		switch j.tp {
+		case flush, asyncFlush, conflict:
+		case xid:
+			// the batch only ends with a whole transaction in `exactly-once` mode.
+			txnJobs = 0
+			if dropTxn {
+				dropTxn = false
+				continue
+			}
+			xidJob = j
+			if len(jobs) < w.batch && len(jobCh) > 0 {
+				continue
+			}
+		default:
+			if dropTxn {
+				continue
+			}
 			if len(jobs) == 0 {
 				// set job TS when received first job of this batch.
 				w.lagFunc(j, workerJobIdx)
 			}
 			jobs = append(jobs, j)
-			if len(jobs) < w.batch && len(jobCh) > 0 {
+			if w.exactlyOnce == nil {
+				if len(jobs) < w.batch && len(jobCh) > 0 {
+					continue
+				}
+				break
+			}
+			if txnJobs++; txnJobs <= maxExactlyOnceTxnJobs {
 				continue
 			}
+			// execute the whole transactions before the large one, and stop the task.
+			w.executeBatchJobs(queueID, jobs[:len(jobs)-txnJobs], xidJob)
+			w.fatalFunc(j, terror.ErrSyncerExactlyOnceTxnTooLarge.Generate(maxExactlyOnceTxnJobs))
+			jobs = jobs[0:0]
+			xidJob = nil
+			dropTxn = true
+			continue
 		}
 
 		failpoint.Inject("syncDMLBatchNotFull", func() {
@@ -165,12 +242,17 @@ func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 			}
 		})
 
-		w.executeBatchJobs(queueID, jobs)
-		if j.tp == conflict || j.tp == flush {
+		w.executeBatchJobs(queueID, jobs, xidJob)
+		switch j.tp {
+		case conflict, flush:
 			w.wg.Done()
+		case asyncFlush:
+			j.flushWg.Done()
 		}
 
 		jobs = jobs[0:0]
+		xidJob = nil
+		txnJobs = 0
 		if len(jobCh) == 0 {
 			failpoint.Inject("noJobInQueueLog", func() {
 				w.logger.Debug("no job in queue, update lag to zero", zap.Int(
@@ -181,8 +263,9 @@ func (w *DMLWorker) executeJobs(queueID int, jobCh chan *job) {
 	}
 }
 
-// executeBatchJobs execute jobs with batch size.
-func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
+// executeBatchJobs execute jobs with batch size. xidJob is the end of the last transaction in jobs in `exactly-once`
+// mode, its location is recorded in the same downstream transaction.
+func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job, xidJob *job) {
 	var (
 		affect int
 		db     = w.toDBConns[queueID]
@@ -193,6 +276,10 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 		if err == nil {
 			w.successFunc(queueID, jobs)
 		} else {
+			if affect >= len(jobs) {
+				// the statement recording the applied location fails.
+				affect = len(jobs) - 1
+			}
 			w.fatalFunc(jobs[affect], err)
 		}
 	}()
@@ -214,6 +301,15 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 		}
 	})
 
+	if w.sink != nil {
+		// the messages are sent in order and acknowledged before the jobs succeed, the jobs fail if the sink doesn't
+		// acknowledge them in time.
+		ctx, cancel := w.tctx.WithTimeout(maxDMLExecutionDuration)
+		defer cancel()
+		err = emitJobsToSink(ctx, w.sink, jobs)
+		return
+	}
+
 	queries := make([]string, 0, len(jobs))
 	args := make([][]interface{}, 0, len(jobs))
 	for _, j := range jobs {
@@ -221,6 +317,15 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 		queries = append(queries, query...)
 		args = append(args, arg...)
 	}
+	if xidJob != nil {
+		query, arg := w.exactlyOnce.recordSQL(xidJob.location)
+		queries = append(queries, query)
+		args = append(args, arg)
+	}
+	if w.dryRun != nil {
+		err = w.dryRun.writeDMLs(jobs[len(jobs)-1].currentLocation, queries, args)
+		return
+	}
 	failpoint.Inject("WaitUserCancel", func(v failpoint.Value) {
 		t := v.(int)
 		time.Sleep(time.Duration(t) * time.Second)
@@ -228,7 +333,14 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job) {
 	// use background context to execute sqls as much as possible
 	ctx, cancel := w.tctx.WithTimeout(maxDMLExecutionDuration)
 	defer cancel()
+	startTime := time.Now()
 	affect, err = db.ExecuteSQL(ctx, queries, args...)
+	if err == nil {
+		w.slowDigest.observe(jobs, time.Since(startTime))
+		if xidJob != nil {
+			w.exactlyOnce.setApplied(xidJob.location)
+		}
+	}
 	failpoint.Inject("SafeModeExit", func(val failpoint.Value) {
 		if intVal, ok := val.(int); ok && intVal == 4 && len(jobs) > 0 {
 			w.logger.Warn("fail to exec DML", zap.String("failpoint", "SafeModeExit"))
@@ -0,0 +1,170 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// dryRunFile returns the file to write the SQL statements of a dry-run subtask, it's relative to the working directory
+// of DM-worker like the dumped files.
+func dryRunFile(cfg *config.SubTaskConfig) string {
+	return filepath.Join("dry_run."+cfg.Name, cfg.SourceID+".sql")
+}
+
+// dryRunWriter writes the SQL statements which would be executed in downstream to a file for `start-task --dry-run`.
+// the file is truncated when the subtask starts, because the checkpoints of a dry-run subtask are not persisted and
+// the binlog is replayed from the beginning.
+type dryRunWriter struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+}
+
+func newDryRunWriter(cfg *config.SubTaskConfig) (*dryRunWriter, error) {
+	path := dryRunFile(cfg)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, terror.ErrSyncerDryRunWrite.Delegate(err, path)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return nil, terror.ErrSyncerDryRunWrite.Delegate(err, path)
+	}
+	return &dryRunWriter{path: path, f: f}, nil
+}
+
+// writeDMLs writes the DMLs executed in one downstream transaction, location is the end of the last row change.
+func (w *dryRunWriter) writeDMLs(location binlog.Location, queries []string, args [][]interface{}) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "-- %s, binlog location %s\nBEGIN;\n", time.Now().Format(time.RFC3339), location)
+	for i, query := range queries {
+		var arg []interface{}
+		if i < len(args) {
+			arg = args[i]
+		}
+		b.WriteString(interpolateSQL(query, arg))
+		b.WriteString(";\n")
+	}
+	b.WriteString("COMMIT;\n")
+	return w.write(b.String())
+}
+
+// writeDDLs writes the DDLs, they're executed one by one.
+func (w *dryRunWriter) writeDDLs(ddls []string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "-- %s, DDL\n", time.Now().Format(time.RFC3339))
+	for _, ddl := range ddls {
+		b.WriteString(ddl)
+		b.WriteString(";\n")
+	}
+	return w.write(b.String())
+}
+
+func (w *dryRunWriter) write(s string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.f.WriteString(s); err != nil {
+		return terror.ErrSyncerDryRunWrite.Delegate(err, w.path)
+	}
+	return nil
+}
+
+func (w *dryRunWriter) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.f.Close()
+}
+
+// interpolateSQL replaces the placeholders in the query with the literals of the arguments, the question marks
+// in the quoted identifiers and strings are kept.
+func interpolateSQL(query string, args []interface{}) string {
+	if len(args) == 0 {
+		return query
+	}
+	var (
+		b     strings.Builder
+		quote rune
+		n     int
+	)
+	for _, r := range query {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '`' || r == '\'' || r == '"':
+			quote = r
+		case r == '?' && n < len(args):
+			b.WriteString(sqlLiteral(args[n]))
+			n++
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// sqlLiteral formats the value as a MySQL literal.
+func sqlLiteral(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quoteSQLString(x)
+	case []byte:
+		if !utf8.Valid(x) {
+			return fmt.Sprintf("X'%X'", x)
+		}
+		return quoteSQLString(string(x))
+	case bool:
+		if x {
+			return "1"
+		}
+		return "0"
+	case float32:
+		return strconv.FormatFloat(float64(x), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64)
+	case time.Time:
+		return quoteSQLString(x.Format("2006-01-02 15:04:05.999999"))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", x)
+	default:
+		return quoteSQLString(fmt.Sprintf("%v", x))
+	}
+}
+
+var sqlStringEscaper = strings.NewReplacer(
+	"\\", "\\\\",
+	"'", "\\'",
+	"\x00", "\\0",
+	"\n", "\\n",
+	"\r", "\\r",
+	"\x1a", "\\Z",
+)
+
+func quoteSQLString(s string) string {
+	return "'" + sqlStringEscaper.Replace(s) + "'"
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestInterpolateSQL(c *C) {
+	c.Assert(interpolateSQL("INSERT INTO `db`.`t?` (`a`,`b`,`c`,`d`) VALUES (?,?,?,?)", []interface{}{int64(1), "it's\n", nil, []byte{0xff, 0x01}}),
+		Equals, "INSERT INTO `db`.`t?` (`a`,`b`,`c`,`d`) VALUES (1,'it\\'s\\n',NULL,X'FF01')")
+	c.Assert(interpolateSQL("UPDATE `db`.`t` SET `a` = ? WHERE `b` = ? LIMIT 1", []interface{}{1.5, []byte("x")}),
+		Equals, "UPDATE `db`.`t` SET `a` = 1.5 WHERE `b` = 'x' LIMIT 1")
+	c.Assert(interpolateSQL("DELETE FROM `db`.`t` WHERE `a` = ?", nil), Equals, "DELETE FROM `db`.`t` WHERE `a` = ?")
+	c.Assert(dryRunFile(&config.SubTaskConfig{Name: "test", SourceID: "mysql-replica-01"}), Equals, filepath.Join("dry_run.test", "mysql-replica-01.sql"))
+}
+
+func (s *testSyncerSuite) TestDryRunWriter(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, "create table tb(a int primary key, b varchar(10))")
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "test", Name: "tb"}
+
+	path := filepath.Join(c.MkDir(), "dry-run.sql")
+	f, err := os.Create(path)
+	c.Assert(err, IsNil)
+	writer := &dryRunWriter{path: path, f: f}
+
+	var executed []*job
+	worker := &DMLWorker{
+		batch:       10,
+		toDBConns:   []*dbconn.DBConn{nil},
+		dryRun:      writer,
+		tctx:        tcontext.Background(),
+		logger:      log.L(),
+		successFunc: func(_ int, jobs []*job) { executed = append(executed, jobs...) },
+		fatalFunc:   func(_ *job, err error) { c.Fatal(err) },
+	}
+	location := binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 100}}
+	dml := newDML(insert, false, "`test`.`tb`", table, nil, []interface{}{1, "a'b"}, nil, []interface{}{1, "a'b"}, ti.Columns, ti)
+	worker.executeBatchJobs(0, []*job{{tp: insert, targetTable: table, dml: dml, currentLocation: location}}, nil)
+	c.Assert(executed, HasLen, 1)
+
+	syncer := &Syncer{dryRun: writer}
+	affected, err := syncer.executeDDLs(tcontext.Background(), nil, []string{"ALTER TABLE `test`.`tb` ADD COLUMN `c` INT"})
+	c.Assert(err, IsNil)
+	c.Assert(affected, Equals, 1)
+	c.Assert(writer.close(), IsNil)
+
+	content, err := os.ReadFile(path)
+	c.Assert(err, IsNil)
+	c.Assert(string(content), Matches, "(?s)-- .*, binlog location position: \\(mysql-bin.000001, 100\\).*\n"+
+		"BEGIN;\nINSERT INTO `test`.`tb` \\(`a`,`b`\\) VALUES \\(1,'a\\\\'b'\\);\nCOMMIT;\n"+
+		"-- .*, DDL\nALTER TABLE `test`.`tb` ADD COLUMN `c` INT;\n")
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/pingcap/dm/dm/pb"
 	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/gtid"
 	"github.com/pingcap/dm/pkg/log"
 	"github.com/pingcap/dm/pkg/terror"
 )
@@ -35,6 +36,7 @@ type Operator struct {
 	uuid   string // add a UUID, make it more friendly to be traced in log
 	op     pb.ErrorOp
 	events []*replication.BinlogEvent // startLocation -> events
+	origin *replication.BinlogEvent   // the matched event, executed after the injected events for inject operation
 }
 
 // newOperator creates a new operator with a random UUID.
@@ -56,18 +58,34 @@ func (o *Operator) String() string {
 	return fmt.Sprintf("uuid: %s, op: %s, events: %s", o.uuid, o.op, strings.Join(events, "\n"))
 }
 
+// replaceEvents returns the events to replace the matched event.
+func (o *Operator) replaceEvents() []*replication.BinlogEvent {
+	if o.op == pb.ErrorOp_Inject && o.origin != nil {
+		return append(o.events[:len(o.events):len(o.events)], o.origin)
+	}
+	return o.events
+}
+
+// gtidOperator is an operator for the event of a specified GTID, it's moved to the operators by position once matched.
+type gtidOperator struct {
+	gtid gtid.Set
+	*Operator
+}
+
 // Holder holds error operator.
 type Holder struct {
-	mu        sync.Mutex
-	operators map[string]*Operator
-	logger    log.Logger
+	mu            sync.Mutex
+	operators     map[string]*Operator
+	gtidOperators map[string]*gtidOperator // GTID -> operator
+	logger        log.Logger
 }
 
 // NewHolder creates a new Holder.
 func NewHolder(pLogger *log.Logger) *Holder {
 	return &Holder{
-		operators: make(map[string]*Operator),
-		logger:    pLogger.WithFields(zap.String("component", "error operator holder")),
+		operators:     make(map[string]*Operator),
+		gtidOperators: make(map[string]*gtidOperator),
+		logger:        pLogger.WithFields(zap.String("component", "error operator holder")),
 	}
 }
 
@@ -94,6 +112,48 @@ func (h *Holder) Set(pos string, op pb.ErrorOp, events []*replication.BinlogEven
 	return nil
 }
 
+// SetByGTID sets an Operator for the event of the GTID.
+func (h *Holder) SetByGTID(gset gtid.Set, op pb.ErrorOp, events []*replication.BinlogEvent) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := gset.String()
+	if op == pb.ErrorOp_Revert {
+		if _, ok := h.gtidOperators[key]; !ok {
+			return terror.ErrSyncerOperatorNotExist.Generate(key)
+		}
+		delete(h.gtidOperators, key)
+		return nil
+	}
+
+	oper := newOperator(op, events)
+	pre, ok := h.gtidOperators[key]
+	if ok {
+		h.logger.Warn("overwrite operator", zap.String("gtid", key), zap.Stringer("old operator", pre.Operator))
+	}
+	h.gtidOperators[key] = &gtidOperator{gtid: gset, Operator: oper}
+	h.logger.Info("set a new operator", zap.String("gtid", key), zap.Stringer("new operator", oper))
+	return nil
+}
+
+// matchGTID moves the operator of the GTID of the event between startLocation and endLocation to the operators by
+// position, so the operator can be got by the position of the event later.
+func (h *Holder) matchGTID(startLocation, endLocation binlog.Location) {
+	startGTID, endGTID := startLocation.GetGTID(), endLocation.GetGTID()
+	if startGTID == nil || endGTID == nil {
+		return
+	}
+	for key, oper := range h.gtidOperators {
+		if endGTID.Contain(oper.gtid) && !startGTID.Contain(oper.gtid) {
+			pos := startLocation.Position.String()
+			h.logger.Info("match an operator by gtid", zap.String("gtid", key), zap.String("position", pos))
+			h.operators[pos] = oper.Operator
+			delete(h.gtidOperators, key)
+			return
+		}
+	}
+}
+
 // GetEvent return a replace binlog event
 // for example:
 //			startLocation		endLocation
@@ -113,11 +173,12 @@ func (h *Holder) GetEvent(startLocation binlog.Location) (*replication.BinlogEve
 		return nil, terror.ErrSyncerReplaceEventNotExist.Generate(startLocation)
 	}
 
-	if len(operator.events) <= startLocation.Suffix {
-		return nil, terror.ErrSyncerReplaceEvent.Generatef("replace events out of range, index: %d, total: %d", startLocation.Suffix, len(operator.events))
+	events := operator.replaceEvents()
+	if len(events) <= startLocation.Suffix {
+		return nil, terror.ErrSyncerReplaceEvent.Generatef("replace events out of range, index: %d, total: %d", startLocation.Suffix, len(events))
 	}
 
-	e := operator.events[startLocation.Suffix]
+	e := events[startLocation.Suffix]
 	buf := new(bytes.Buffer)
 	e.Dump(buf)
 	h.logger.Info("get replace event", zap.Stringer("event", buf))
@@ -126,26 +187,32 @@ func (h *Holder) GetEvent(startLocation binlog.Location) (*replication.BinlogEve
 }
 
 // MatchAndApply tries to match operation for event by location and apply it on replace events.
-func (h *Holder) MatchAndApply(startLocation, endLocation binlog.Location, realEventHeaderTS uint32) (bool, pb.ErrorOp) {
+func (h *Holder) MatchAndApply(startLocation, endLocation binlog.Location, realEvent *replication.BinlogEvent) (bool, pb.ErrorOp) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	h.matchGTID(startLocation, endLocation)
 	key := startLocation.Position.String()
 	operator, ok := h.operators[key]
 	if !ok {
 		return false, pb.ErrorOp_InvalidErrorOp
 	}
 
-	if operator.op == pb.ErrorOp_Replace {
-		if len(operator.events) == 0 {
+	if operator.op == pb.ErrorOp_Replace || operator.op == pb.ErrorOp_Inject {
+		if operator.op == pb.ErrorOp_Inject {
+			// execute the matched event after the injected events.
+			operator.origin = realEvent
+		}
+		events := operator.replaceEvents()
+		if len(events) == 0 {
 			// this should not happen
 			return false, pb.ErrorOp_InvalidErrorOp
 		}
 
 		// set LogPos as start position
-		for _, ev := range operator.events {
+		for _, ev := range events {
 			ev.Header.LogPos = startLocation.Position.Pos
-			ev.Header.Timestamp = realEventHeaderTS
+			ev.Header.Timestamp = realEvent.Header.Timestamp
 			if e, ok := ev.Event.(*replication.QueryEvent); ok {
 				if startLocation.GetGTID() != nil {
 					e.GSet = startLocation.GetGTID().Origin()
@@ -154,7 +221,7 @@ func (h *Holder) MatchAndApply(startLocation, endLocation binlog.Location, realE
 		}
 
 		// set the last replace event as end position
-		e := operator.events[len(operator.events)-1]
+		e := events[len(events)-1]
 		e.Header.EventSize = endLocation.Position.Pos - startLocation.Position.Pos
 		e.Header.LogPos = endLocation.Position.Pos
 		if e, ok := e.Event.(*replication.QueryEvent); ok {
@@ -184,5 +251,13 @@ func (h *Holder) RemoveOutdated(flushLocation binlog.Location) error {
 			delete(h.operators, pos)
 		}
 	}
+	if flushGTID := flushLocation.GetGTID(); flushGTID != nil {
+		for key, oper := range h.gtidOperators {
+			if flushGTID.Contain(oper.gtid) {
+				h.logger.Info("remove a outdated operator", zap.String("gtid", key), zap.Stringer("flush location", flushLocation), zap.Stringer("operator", oper.Operator))
+				delete(h.gtidOperators, key)
+			}
+		}
+	}
 	return nil
 }
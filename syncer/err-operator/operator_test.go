@@ -22,6 +22,7 @@ import (
 
 	"github.com/pingcap/dm/dm/pb"
 	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/gtid"
 	"github.com/pingcap/dm/pkg/log"
 	"github.com/pingcap/dm/pkg/terror"
 )
@@ -87,14 +88,14 @@ func (o *testOperatorSuite) TestOperator(c *C) {
 	// skip event
 	err = h.Set(startLocation.Position.String(), pb.ErrorOp_Skip, nil)
 	c.Assert(err, IsNil)
-	apply, op := h.MatchAndApply(startLocation, endLocation, event1.Header.Timestamp)
+	apply, op := h.MatchAndApply(startLocation, endLocation, event1)
 	c.Assert(apply, IsTrue)
 	c.Assert(op, Equals, pb.ErrorOp_Skip)
 
 	// overwrite operator
 	err = h.Set(startLocation.Position.String(), pb.ErrorOp_Replace, []*replication.BinlogEvent{event1, event2})
 	c.Assert(err, IsNil)
-	apply, op = h.MatchAndApply(startLocation, endLocation, event2.Header.Timestamp)
+	apply, op = h.MatchAndApply(startLocation, endLocation, event2)
 	c.Assert(apply, IsTrue)
 	c.Assert(op, Equals, pb.ErrorOp_Replace)
 
@@ -127,7 +128,7 @@ func (o *testOperatorSuite) TestOperator(c *C) {
 	// revert exist operator
 	err = h.Set(startLocation.Position.String(), pb.ErrorOp_Revert, nil)
 	c.Assert(err, IsNil)
-	apply, op = h.MatchAndApply(startLocation, endLocation, event1.Header.Timestamp)
+	apply, op = h.MatchAndApply(startLocation, endLocation, event1)
 	c.Assert(apply, IsFalse)
 	c.Assert(op, Equals, pb.ErrorOp_InvalidErrorOp)
 
@@ -140,17 +141,76 @@ func (o *testOperatorSuite) TestOperator(c *C) {
 	// test removeOutdated
 	flushLocation := startLocation
 	c.Assert(h.RemoveOutdated(flushLocation), IsNil)
-	apply, op = h.MatchAndApply(startLocation, endLocation, event1.Header.Timestamp)
+	apply, op = h.MatchAndApply(startLocation, endLocation, event1)
 	c.Assert(apply, IsTrue)
 	c.Assert(op, Equals, pb.ErrorOp_Replace)
 
 	flushLocation = endLocation
 	c.Assert(h.RemoveOutdated(flushLocation), IsNil)
-	apply, op = h.MatchAndApply(startLocation, endLocation, event1.Header.Timestamp)
+	apply, op = h.MatchAndApply(startLocation, endLocation, event1)
 	c.Assert(apply, IsFalse)
 	c.Assert(op, Equals, pb.ErrorOp_InvalidErrorOp)
 
-	apply, op = h.MatchAndApply(endLocation, nextLocation, event1.Header.Timestamp)
+	apply, op = h.MatchAndApply(endLocation, nextLocation, event1)
 	c.Assert(apply, IsTrue)
 	c.Assert(op, Equals, pb.ErrorOp_Replace)
 }
+
+func (o *testOperatorSuite) TestOperatorGTIDAndInject(c *C) {
+	logger := log.L()
+	h := NewHolder(&logger)
+
+	parseGTID := func(str string) gtid.Set {
+		gset, err := gtid.ParserGTID("mysql", str)
+		c.Assert(err, IsNil)
+		return gset
+	}
+	uuid := "3ccc475b-2343-11e7-be21-6c0b84d59f30"
+	startLocation := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 233}, parseGTID(uuid+":1-4"))
+	endLocation := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 250}, parseGTID(uuid+":1-5"))
+
+	injected := &replication.BinlogEvent{
+		Header: &replication.EventHeader{EventType: replication.QUERY_EVENT},
+		Event:  &replication.QueryEvent{Schema: []byte("db"), Query: []byte("alter table tb add column a int")},
+	}
+	origin := &replication.BinlogEvent{
+		Header: &replication.EventHeader{EventType: replication.QUERY_EVENT, Timestamp: uint32(1623313992), LogPos: 250, EventSize: 17},
+		Event:  &replication.QueryEvent{Schema: []byte("db"), Query: []byte("alter table tb add column b int")},
+	}
+
+	// revert not exist operator
+	err := h.SetByGTID(parseGTID(uuid+":5"), pb.ErrorOp_Revert, nil)
+	c.Assert(terror.ErrSyncerOperatorNotExist.Equal(err), IsTrue)
+
+	// the operator of other GTID is not matched
+	c.Assert(h.SetByGTID(parseGTID(uuid+":6"), pb.ErrorOp_Skip, nil), IsNil)
+	apply, op := h.MatchAndApply(startLocation, endLocation, origin)
+	c.Assert(apply, IsFalse)
+	c.Assert(op, Equals, pb.ErrorOp_InvalidErrorOp)
+
+	// inject by GTID, the original event is executed after the injected events
+	c.Assert(h.SetByGTID(parseGTID(uuid+":5"), pb.ErrorOp_Inject, []*replication.BinlogEvent{injected}), IsNil)
+	apply, op = h.MatchAndApply(startLocation, endLocation, origin)
+	c.Assert(apply, IsTrue)
+	c.Assert(op, Equals, pb.ErrorOp_Inject)
+	startLocation.Suffix = 0
+	e, err := h.GetEvent(startLocation)
+	c.Assert(err, IsNil)
+	c.Assert(e, Equals, injected)
+	c.Assert(e.Header.LogPos, Equals, startLocation.Position.Pos)
+	c.Assert(e.Header.Timestamp, Equals, origin.Header.Timestamp)
+	startLocation.Suffix = 1
+	e, err = h.GetEvent(startLocation)
+	c.Assert(err, IsNil)
+	c.Assert(e, Equals, origin)
+	c.Assert(e.Header.LogPos, Equals, endLocation.Position.Pos)
+	startLocation.Suffix = 2
+	_, err = h.GetEvent(startLocation)
+	c.Assert(terror.ErrSyncerReplaceEvent.Equal(err), IsTrue)
+
+	// the matched operator is kept by position, and the outdated GTID operators are removed
+	c.Assert(h.gtidOperators, HasLen, 1)
+	c.Assert(h.RemoveOutdated(binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 4}, parseGTID(uuid+":1-6"))), IsNil)
+	c.Assert(h.gtidOperators, HasLen, 0)
+	c.Assert(h.operators, HasLen, 1)
+}
@@ -0,0 +1,182 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/google/uuid"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/cputil"
+	"github.com/pingcap/dm/pkg/gtid"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+// exactlyOnceRecorder records the location of the last upstream transaction applied in `exactly-once` mode into a
+// table in the downstream meta schema. the location is written in the same downstream transaction as the row changes,
+// so the upstream transactions before the recorded location are known to be applied and skipped when the task is
+// resumed from a checkpoint before it.
+type exactlyOnceRecorder struct {
+	cfg       *config.SubTaskConfig
+	tableName string // qualified table name: `dm_meta`.`task_dm_applied_gtid`
+
+	db     *conn.BaseDB
+	dbConn *dbconn.DBConn
+	logCtx *tcontext.Context
+
+	mu      sync.RWMutex
+	applied *binlog.Location // nil if no transaction is applied
+}
+
+// newExactlyOnceRecorder creates a new exactlyOnceRecorder.
+func newExactlyOnceRecorder(tctx *tcontext.Context, cfg *config.SubTaskConfig) *exactlyOnceRecorder {
+	return &exactlyOnceRecorder{
+		cfg:       cfg,
+		tableName: dbutil.TableName(cfg.MetaSchema, cputil.SyncerAppliedGTID(cfg.Name)),
+		logCtx:    tcontext.Background().WithLogger(tctx.L().WithFields(zap.String("component", "exactly once recorder"))),
+	}
+}
+
+// init creates the connection and the applied table.
+func (r *exactlyOnceRecorder) init(tctx *tcontext.Context) error {
+	recorderDB := r.cfg.To
+	recorderDB.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxCheckPointTimeout)
+	db, dbConns, err := dbconn.CreateConns(tctx, r.cfg, recorderDB, 1)
+	if err != nil {
+		return err
+	}
+	r.db = db
+	r.dbConn = dbConns[0]
+
+	sqls := []string{
+		fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(r.cfg.MetaSchema)),
+		`CREATE TABLE IF NOT EXISTS ` + r.tableName + ` (
+			source_id VARCHAR(32) NOT NULL PRIMARY KEY,
+			binlog_name VARCHAR(128),
+			binlog_pos INT UNSIGNED,
+			binlog_gtid TEXT,
+			update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+		)`,
+	}
+	_, err = r.dbConn.ExecuteSQL(tctx, sqls)
+	r.logCtx.L().Info("create applied gtid table", zap.Strings("statements", sqls))
+	return terror.WithScope(err, terror.ScopeDownstream)
+}
+
+// load loads the location of the last applied transaction. it's loaded every time the syncer runs, because the
+// downstream transaction may be committed even if executing it returns an error.
+func (r *exactlyOnceRecorder) load(tctx *tcontext.Context) error {
+	query := `SELECT binlog_name, binlog_pos, binlog_gtid FROM ` + r.tableName + ` WHERE source_id = ?`
+	rows, err := r.dbConn.QuerySQL(tctx, query, r.cfg.SourceID)
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	var applied *binlog.Location
+	for rows.Next() {
+		var (
+			binlogName    string
+			binlogPos     uint32
+			binlogGTIDSet sql.NullString
+		)
+		if err = rows.Scan(&binlogName, &binlogPos, &binlogGTIDSet); err != nil {
+			return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+		}
+		gset, err2 := gtid.ParserGTID(r.cfg.Flavor, binlogGTIDSet.String) // default to "".
+		if err2 != nil {
+			return err2
+		}
+		location := binlog.InitLocation(mysql.Position{Name: binlogName, Pos: binlogPos}, gset)
+		applied = &location
+	}
+	if err = rows.Err(); err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+
+	r.mu.Lock()
+	r.applied = applied
+	r.mu.Unlock()
+	if applied != nil {
+		r.logCtx.L().Info("load the location of the last applied transaction", zap.Stringer("location", applied))
+	}
+	return nil
+}
+
+// isApplied returns whether the transaction has been applied. in GTID mode, txnGTID is the GTID of the transaction
+// read from its GTID event, the transaction is applied if it's contained by the recorded GTID set. the GTID set of the
+// events is not used because it's only updated at XID and query events, the row changes of a transaction may carry
+// the GTID set of the previous one. if txnGTID is nil or not in GTID mode, location must be the end of the transaction
+// or the location of an event in it, which is not greater than the recorded location if it's applied.
+func (r *exactlyOnceRecorder) isApplied(location binlog.Location, txnGTID gtid.Set) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.applied == nil {
+		return false
+	}
+	if r.cfg.EnableGTID && txnGTID != nil {
+		applied := r.applied.GetGTID()
+		return applied != nil && applied.Contain(txnGTID)
+	}
+	return binlog.CompareLocation(location, *r.applied, r.cfg.EnableGTID) <= 0
+}
+
+// transactionGTID returns the GTID of the transaction started by the GTID event, it returns nil for other events.
+func transactionGTID(flavor string, e replication.Event) (gtid.Set, error) {
+	var gtidStr string
+	switch ev := e.(type) {
+	case *replication.GTIDEvent:
+		u, err := uuid.FromBytes(ev.SID)
+		if err != nil {
+			return nil, terror.ErrParseGTID.Delegate(err, fmt.Sprintf("%x:%d", ev.SID, ev.GNO))
+		}
+		gtidStr = fmt.Sprintf("%s:%d", u.String(), ev.GNO)
+	case *replication.MariadbGTIDEvent:
+		gtidStr = fmt.Sprintf("%d-%d-%d", ev.GTID.DomainID, ev.GTID.ServerID, ev.GTID.SequenceNumber)
+	default:
+		return nil, nil
+	}
+	return gtid.ParserGTID(flavor, gtidStr)
+}
+
+// recordSQL returns the statement to record the location of the last transaction in a downstream transaction.
+func (r *exactlyOnceRecorder) recordSQL(location binlog.Location) (string, []interface{}) {
+	query := `INSERT INTO ` + r.tableName + ` (source_id, binlog_name, binlog_pos, binlog_gtid) VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE binlog_name = VALUES(binlog_name), binlog_pos = VALUES(binlog_pos), binlog_gtid = VALUES(binlog_gtid)`
+	return query, []interface{}{r.cfg.SourceID, location.Position.Name, location.Position.Pos, location.GTIDSetStr()}
+}
+
+// setApplied updates the location of the last applied transaction after the downstream transaction is committed.
+func (r *exactlyOnceRecorder) setApplied(location binlog.Location) {
+	location = location.Clone()
+	r.mu.Lock()
+	r.applied = &location
+	r.mu.Unlock()
+}
+
+// close closes the connection.
+func (r *exactlyOnceRecorder) close() {
+	dbconn.CloseBaseDB(r.logCtx, r.db)
+}
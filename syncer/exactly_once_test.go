@@ -0,0 +1,232 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"regexp"
+	"sync"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	gouuid "github.com/google/uuid"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/gtid"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/retry"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestExactlyOnceRecorder(c *C) {
+	tctx := tcontext.Background()
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "mysql-replica-01", MetaSchema: "dm_meta", Flavor: mysql.MySQLFlavor}
+	recorder := newExactlyOnceRecorder(tctx, cfg)
+	c.Assert(recorder.tableName, Equals, "`dm_meta`.`test_dm_applied_gtid`")
+
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(tctx.Context())
+	c.Assert(err, IsNil)
+	recorder.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+
+	var (
+		query = regexp.QuoteMeta("SELECT binlog_name, binlog_pos, binlog_gtid FROM `dm_meta`.`test_dm_applied_gtid` WHERE source_id = ?")
+		loc   = func(pos uint32) binlog.Location {
+			return binlog.Location{Position: mysql.Position{Name: "mysql-bin.000002", Pos: pos}}
+		}
+	)
+	// nothing is applied.
+	dbMock.ExpectQuery(query).WithArgs("mysql-replica-01").WillReturnRows(sqlmock.NewRows([]string{"binlog_name", "binlog_pos", "binlog_gtid"}))
+	c.Assert(recorder.load(tctx), IsNil)
+	c.Assert(recorder.isApplied(loc(4), nil), IsFalse)
+
+	dbMock.ExpectQuery(query).WithArgs("mysql-replica-01").WillReturnRows(
+		sqlmock.NewRows([]string{"binlog_name", "binlog_pos", "binlog_gtid"}).AddRow("mysql-bin.000002", 1000, nil))
+	c.Assert(recorder.load(tctx), IsNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	// the events of the applied transaction are before its end.
+	c.Assert(recorder.isApplied(loc(900), nil), IsTrue)
+	c.Assert(recorder.isApplied(binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 2000}}, nil), IsTrue)
+	c.Assert(recorder.isApplied(loc(1100), nil), IsFalse)
+
+	recorder.setApplied(loc(1200))
+	c.Assert(recorder.isApplied(loc(1100), nil), IsTrue)
+	query2, args := recorder.recordSQL(loc(1300))
+	c.Assert(query2, Matches, "(?s)INSERT INTO `dm_meta`.`test_dm_applied_gtid` .*ON DUPLICATE KEY UPDATE .*")
+	c.Assert(args, DeepEquals, []interface{}{"mysql-replica-01", "mysql-bin.000002", uint32(1300), ""})
+}
+
+func (s *testSyncerSuite) TestExactlyOnceRecorderGTID(c *C) {
+	tctx := tcontext.Background()
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "mysql-replica-01", MetaSchema: "dm_meta", Flavor: mysql.MySQLFlavor}
+	cfg.EnableGTID = true
+	recorder := newExactlyOnceRecorder(tctx, cfg)
+
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(tctx.Context())
+	c.Assert(err, IsNil)
+	recorder.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+
+	var (
+		query = regexp.QuoteMeta("SELECT binlog_name, binlog_pos, binlog_gtid FROM `dm_meta`.`test_dm_applied_gtid` WHERE source_id = ?")
+		uuid  = "3ccc475b-2343-11e7-be21-6c0b84d59f30"
+		loc   = func(pos uint32, gtidStr string) binlog.Location {
+			gset, err2 := gtid.ParserGTID(mysql.MySQLFlavor, gtidStr)
+			c.Assert(err2, IsNil)
+			return binlog.InitLocation(mysql.Position{Name: "mysql-bin.000002", Pos: pos}, gset)
+		}
+		txnGTID = func(gno int64) gtid.Set {
+			sid, err2 := gouuid.Parse(uuid)
+			c.Assert(err2, IsNil)
+			set, err2 := transactionGTID(mysql.MySQLFlavor, &replication.GTIDEvent{SID: sid[:], GNO: gno})
+			c.Assert(err2, IsNil)
+			return set
+		}
+	)
+	c.Assert(txnGTID(6).String(), Equals, uuid+":6")
+	set, err := transactionGTID(mysql.MySQLFlavor, &replication.XIDEvent{})
+	c.Assert(err, IsNil)
+	c.Assert(set, IsNil)
+
+	dbMock.ExpectQuery(query).WithArgs("mysql-replica-01").WillReturnRows(
+		sqlmock.NewRows([]string{"binlog_name", "binlog_pos", "binlog_gtid"}).AddRow("mysql-bin.000002", 1000, uuid+":1-5"))
+	c.Assert(recorder.load(tctx), IsNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+
+	// the row changes of the next transaction may carry the same GTID set as the applied transaction, they are not
+	// skipped because the GTID of the transaction is not applied.
+	c.Assert(recorder.isApplied(loc(1100, uuid+":1-5"), txnGTID(6)), IsFalse)
+	c.Assert(recorder.isApplied(loc(900, uuid+":1-4"), txnGTID(5)), IsTrue)
+	c.Assert(recorder.isApplied(loc(500, uuid+":1-2"), txnGTID(3)), IsTrue)
+	// the location of the xid job contains the GTID of the transaction.
+	c.Assert(recorder.isApplied(loc(1000, uuid+":1-5"), nil), IsTrue)
+	c.Assert(recorder.isApplied(loc(1200, uuid+":1-6"), nil), IsFalse)
+
+	recorder.setApplied(loc(1200, uuid+":1-6"))
+	c.Assert(recorder.isApplied(loc(1100, uuid+":1-5"), txnGTID(6)), IsTrue)
+	c.Assert(recorder.isApplied(loc(1300, uuid+":1-6"), txnGTID(7)), IsFalse)
+}
+
+func (s *testSyncerSuite) TestExactlyOnceDMLWorker(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, "create table tb(a int primary key, b int)")
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "test", Name: "tb"}
+
+	tctx := tcontext.Background()
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "mysql-replica-01", MetaSchema: "dm_meta"}
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(tctx.Context())
+	c.Assert(err, IsNil)
+
+	var (
+		executed [][]*job
+		worker   = &DMLWorker{
+			batch:       1,
+			toDBConns:   []*dbconn.DBConn{{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}},
+			exactlyOnce: newExactlyOnceRecorder(tctx, cfg),
+			tctx:        tctx,
+			logger:      log.L(),
+			successFunc: func(_ int, jobs []*job) {
+				if len(jobs) > 0 {
+					executed = append(executed, append([]*job(nil), jobs...))
+				}
+			},
+			fatalFunc: func(_ *job, err error) { c.Fatal(err) },
+			lagFunc:   func(*job, int) {},
+		}
+		location = binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 100}}
+		newJob   = func(i int) *job {
+			dml := newDML(insert, false, "`test`.`tb`", table, nil, []interface{}{i, i}, nil, []interface{}{i, i}, ti.Columns, ti)
+			return &job{tp: insert, targetTable: table, dml: dml}
+		}
+		flushWg = &sync.WaitGroup{}
+		jobCh   = make(chan *job, 10)
+	)
+	flushWg.Add(1)
+	// the first transaction is applied with its location though the batch size is 1, the second transaction is
+	// partially applied when flushing.
+	jobCh <- newJob(1)
+	jobCh <- newJob(2)
+	jobCh <- newXIDJob(location, location, location)
+	jobCh <- newJob(3)
+	jobCh <- &job{tp: asyncFlush, flushWg: flushWg}
+	close(jobCh)
+
+	insertSQL := regexp.QuoteMeta("INSERT INTO `test`.`tb` (`a`,`b`) VALUES (?,?)")
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec(insertSQL).WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec(insertSQL).WithArgs(2, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO `dm_meta`.`test_dm_applied_gtid`")).
+		WithArgs("mysql-replica-01", "mysql-bin.000001", 100, "").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec(insertSQL).WithArgs(3, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+
+	worker.executeJobs(0, jobCh)
+	flushWg.Wait()
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	c.Assert(executed, HasLen, 2)
+	c.Assert(executed[0], HasLen, 2)
+	c.Assert(executed[1], HasLen, 1)
+	c.Assert(worker.exactlyOnce.isApplied(location, nil), IsTrue)
+
+	// the task is stopped when a transaction is too large, the transactions before it are applied.
+	defer func(n int) {
+		maxExactlyOnceTxnJobs = n
+	}(maxExactlyOnceTxnJobs)
+	maxExactlyOnceTxnJobs = 2
+	var fatalErr error
+	worker.batch = 10
+	worker.fatalFunc = func(_ *job, err error) { fatalErr = err }
+	executed = nil
+	location2 := binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 200}}
+	location3 := binlog.Location{Position: mysql.Position{Name: "mysql-bin.000001", Pos: 300}}
+	jobCh = make(chan *job, 10)
+	jobCh <- newJob(4)
+	jobCh <- newXIDJob(location2, location2, location2)
+	jobCh <- newJob(5)
+	jobCh <- newJob(6)
+	jobCh <- newJob(7)
+	jobCh <- newJob(8)
+	jobCh <- newXIDJob(location3, location3, location3)
+	close(jobCh)
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec(insertSQL).WithArgs(4, 4).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO `dm_meta`.`test_dm_applied_gtid`")).
+		WithArgs("mysql-replica-01", "mysql-bin.000001", 200, "").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+
+	worker.executeJobs(0, jobCh)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	c.Assert(terror.ErrSyncerExactlyOnceTxnTooLarge.Equal(fatalErr), IsTrue)
+	c.Assert(executed, HasLen, 1)
+	c.Assert(executed[0], HasLen, 1)
+	c.Assert(worker.exactlyOnce.isApplied(location2, nil), IsTrue)
+	c.Assert(worker.exactlyOnce.isApplied(location3, nil), IsFalse)
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// columnPruner returns whether a column should be removed from the DMLs.
+type columnPruner func(col *model.ColumnInfo) bool
+
+func isGeneratedColumn(col *model.ColumnInfo) bool {
+	return col.IsGenerated()
+}
+
+// GeneratedColumnGroup compares the generated columns of the tracked table structures with the downstream tables,
+// and decides which columns are not replicated. the values of a generated column can't be specified in DMLs, so the
+// columns which are generated columns in either upstream or downstream are pruned.
+// the columns with expression default values are replicated as normal columns, because the binlog contains the
+// evaluated values.
+type GeneratedColumnGroup struct {
+	sync.Mutex
+	policy  string
+	pruners map[string]columnPruner // source tableID -> pruner
+}
+
+// NewGeneratedColumnGroup creates a GeneratedColumnGroup. policy is one of config.GeneratedColumnMismatchError,
+// config.GeneratedColumnMismatchSkip, and empty which means the downstream tables are not compared.
+func NewGeneratedColumnGroup(policy string) *GeneratedColumnGroup {
+	return &GeneratedColumnGroup{
+		policy:  policy,
+		pruners: make(map[string]columnPruner),
+	}
+}
+
+// ResetPruners deletes the pruners generated before. This should be called after table structure changed.
+func (g *GeneratedColumnGroup) ResetPruners(table *filter.Table) {
+	if g == nil {
+		return
+	}
+	g.Lock()
+	defer g.Unlock()
+	delete(g.pruners, utils.GenTableID(table))
+}
+
+// getColumnPruner returns the pruner of the source table, the downstream table is fetched at the first time.
+func (s *Syncer) getColumnPruner(tctx *tcontext.Context, sourceTable, targetTable *filter.Table, ti *model.TableInfo) (columnPruner, error) {
+	g := s.generatedColumns
+	// the row changes are not written into the downstream tables when sink is enabled.
+	if g == nil || g.policy == "" || s.sink != nil {
+		return isGeneratedColumn, nil
+	}
+	tableID := utils.GenTableID(sourceTable)
+	g.Lock()
+	pruner, ok := g.pruners[tableID]
+	g.Unlock()
+	if ok {
+		return pruner, nil
+	}
+
+	p, err := utils.GetParserForConn(tctx.Ctx, s.ddlDBConn.BaseConn.DBConn)
+	if err != nil {
+		return nil, terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
+	}
+	stmt, err := fetchCreateTableStmt(tctx, s.ddlDBConn, p, targetTable)
+	if err != nil {
+		if !utils.IsMySQLError(err, tmysql.ErrNoSuchTable) {
+			return nil, terror.WithScope(err, terror.ScopeDownstream)
+		}
+		// the downstream table may be created by a DDL later, the pruner is reset then.
+		tctx.L().Warn("table not exists in downstream, skip comparing generated columns",
+			zap.Stringer("source", sourceTable), zap.Stringer("target", targetTable))
+		g.setPruner(tableID, isGeneratedColumn)
+		return isGeneratedColumn, nil
+	}
+
+	downstreamGenerated, mismatched := compareGeneratedColumns(ti, stmt)
+	if len(mismatched) > 0 {
+		if g.policy == config.GeneratedColumnMismatchError {
+			return nil, terror.ErrSyncerGeneratedColumnMismatch.Generate(targetTable, sourceTable, strings.Join(mismatched, ", "))
+		}
+		tctx.L().Warn("generated columns are different from downstream, these columns will not be replicated",
+			zap.Stringer("source", sourceTable), zap.Stringer("target", targetTable), zap.Strings("columns", mismatched))
+	}
+	pruner = func(col *model.ColumnInfo) bool {
+		if col.IsGenerated() {
+			return true
+		}
+		_, ok := downstreamGenerated[col.Name.L]
+		return ok
+	}
+	g.setPruner(tableID, pruner)
+	return pruner, nil
+}
+
+func (g *GeneratedColumnGroup) setPruner(tableID string, pruner columnPruner) {
+	g.Lock()
+	defer g.Unlock()
+	g.pruners[tableID] = pruner
+}
+
+// compareGeneratedColumns returns the generated columns of the downstream table, and the columns which are generated
+// columns in only one of the upstream and downstream tables. the columns not exist in downstream are ignored.
+func compareGeneratedColumns(ti *model.TableInfo, stmt *ast.CreateTableStmt) (map[string]struct{}, []string) {
+	var (
+		downstreamCols      = make(map[string]bool, len(stmt.Cols))
+		downstreamGenerated = make(map[string]struct{})
+		mismatched          []string
+	)
+	for _, col := range stmt.Cols {
+		generated := false
+		for _, opt := range col.Options {
+			if opt.Tp == ast.ColumnOptionGenerated {
+				generated = true
+				break
+			}
+		}
+		downstreamCols[col.Name.Name.L] = generated
+		if generated {
+			downstreamGenerated[col.Name.Name.L] = struct{}{}
+		}
+	}
+	for _, col := range ti.Columns {
+		generated, ok := downstreamCols[col.Name.L]
+		if ok && generated != col.IsGenerated() {
+			mismatched = append(mismatched, col.Name.O)
+		}
+	}
+	return downstreamGenerated, mismatched
+}
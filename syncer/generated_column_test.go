@@ -0,0 +1,108 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/retry"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestGeneratedColumnPruner(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, `create table t(
+		id int primary key,
+		a int,
+		b int as (a + 1),
+		c int,
+		d int as (a + 2))`)
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "test", Name: "t"}
+	// `b` is generated in both, `c` is generated only in downstream, `d` is a normal column in downstream,
+	// `e` only exists in downstream.
+	downstreamSQL := "CREATE TABLE `t` (`id` int NOT NULL, `a` int, `b` int GENERATED ALWAYS AS (`a` + 1) VIRTUAL, " +
+		"`c` int GENERATED ALWAYS AS (`a` * 2) STORED, `d` int, `e` int AS (`a` - 1), PRIMARY KEY (`id`))"
+	rows := [][]interface{}{{1, 10, 11, 20, 12}}
+
+	newSyncer := func(policy string) (*Syncer, sqlmock.Sqlmock) {
+		db, mock, err2 := sqlmock.New()
+		c.Assert(err2, IsNil)
+		dbConn, err2 := db.Conn(context.Background())
+		c.Assert(err2, IsNil)
+		syncer := &Syncer{
+			cfg:              &config.SubTaskConfig{},
+			ddlDBConn:        &dbconn.DBConn{Cfg: s.cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})},
+			generatedColumns: NewGeneratedColumnGroup(policy),
+		}
+		return syncer, mock
+	}
+	expectShowCreateTable := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+			sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""))
+		mock.ExpectQuery("SHOW CREATE TABLE `test`.`t`").WillReturnRows(
+			sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("t", downstreamSQL))
+	}
+	tctx := tcontext.Background()
+
+	// not compared with downstream, only the upstream generated columns are pruned.
+	syncer, mock := newSyncer("")
+	pruner, err := syncer.getColumnPruner(tctx, table, table, ti)
+	c.Assert(err, IsNil)
+	cols, prunedRows, err := pruneColumnDML(ti, rows, pruner)
+	c.Assert(err, IsNil)
+	c.Assert(cols, HasLen, 3)
+	c.Assert(prunedRows, DeepEquals, [][]interface{}{{1, 10, 20}})
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// error on mismatch
+	syncer, mock = newSyncer(config.GeneratedColumnMismatchError)
+	expectShowCreateTable(mock)
+	_, err = syncer.getColumnPruner(tctx, table, table, ti)
+	c.Assert(terror.ErrSyncerGeneratedColumnMismatch.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*: c, d.*")
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// skip the mismatched columns, the pruner is cached until reset.
+	syncer, mock = newSyncer(config.GeneratedColumnMismatchSkip)
+	expectShowCreateTable(mock)
+	pruner, err = syncer.getColumnPruner(tctx, table, table, ti)
+	c.Assert(err, IsNil)
+	cols, prunedRows, err = pruneColumnDML(ti, rows, pruner)
+	c.Assert(err, IsNil)
+	c.Assert(cols, HasLen, 2)
+	c.Assert(cols[0].Name.O, Equals, "id")
+	c.Assert(cols[1].Name.O, Equals, "a")
+	c.Assert(prunedRows, DeepEquals, [][]interface{}{{1, 10}})
+	_, err = syncer.getColumnPruner(tctx, table, table, ti)
+	c.Assert(err, IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	syncer.generatedColumns.ResetPruners(table)
+	expectShowCreateTable(mock)
+	_, err = syncer.getColumnPruner(tctx, table, table, ti)
+	c.Assert(err, IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
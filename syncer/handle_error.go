@@ -16,70 +16,165 @@ package syncer
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pingcap/tidb/parser"
 
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
 
 	"github.com/pingcap/dm/dm/pb"
 	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/binlog/event"
+	"github.com/pingcap/dm/pkg/gtid"
 	parserpkg "github.com/pingcap/dm/pkg/parser"
 	"github.com/pingcap/dm/pkg/terror"
 )
 
-// HandleError handle error for syncer.
-func (s *Syncer) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) error {
+// HandleError handle error for syncer, the returned message describes the error event when previewing.
+func (s *Syncer) HandleError(ctx context.Context, req *pb.HandleWorkerErrorRequest) (string, error) {
 	pos := req.BinlogPos
 
-	if len(pos) == 0 {
-		startLocation, isQueryEvent := s.getErrLocation()
-		if startLocation == nil {
-			return fmt.Errorf("source '%s' has no error", s.cfg.SourceID)
+	var gset gtid.Set
+	if len(req.BinlogGTID) != 0 {
+		if len(pos) != 0 {
+			return "", fmt.Errorf("binlog-pos and binlog-gtid can't be specified at the same time")
 		}
-		if !isQueryEvent {
-			return fmt.Errorf("only support to handle ddl error currently, see https://docs.pingcap.com/tidb-data-migration/stable/error-handling for other errors")
+		var err error
+		gset, err = gtid.ParserGTID(s.cfg.Flavor, req.BinlogGTID)
+		if err != nil {
+			return "", err
 		}
-		pos = startLocation.Position.String()
-	} else {
+	} else if len(pos) != 0 {
 		startLocation, err := binlog.VerifyBinlogPos(pos)
 		if err != nil {
-			return err
+			return "", err
 		}
 		pos = startLocation.String()
 	}
 
+	if req.Op == pb.ErrorOp_Preview {
+		return s.previewErrorEvent(pos, req.BinlogGTID, gset)
+	}
+
+	if gset == nil && len(pos) == 0 {
+		startLocation, isQueryEvent := s.getErrLocation()
+		if startLocation == nil {
+			return "", fmt.Errorf("source '%s' has no error", s.cfg.SourceID)
+		}
+		if !isQueryEvent {
+			return "", fmt.Errorf("only support to handle ddl error currently, see https://docs.pingcap.com/tidb-data-migration/stable/error-handling for other errors")
+		}
+		pos = startLocation.Position.String()
+	}
+
 	events := make([]*replication.BinlogEvent, 0)
 	var err error
-	if req.Op == pb.ErrorOp_Replace {
+	if req.Op == pb.ErrorOp_Replace || req.Op == pb.ErrorOp_Inject {
 		events, err = s.genEvents(ctx, req.Sqls)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 
 	// remove outdated operators when add operator
 	err = s.errOperatorHolder.RemoveOutdated(s.checkpoint.FlushedGlobalPoint())
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	err = s.errOperatorHolder.Set(pos, req.Op, events)
+	if gset != nil {
+		err = s.errOperatorHolder.SetByGTID(gset, req.Op, events)
+	} else {
+		err = s.errOperatorHolder.Set(pos, req.Op, events)
+	}
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return "", nil
+}
+
+// previewErrorEvent describes the event which the handle-error operation would be applied to, the event of pos or gset,
+// or the error event if neither is specified. only the error event can be described because it's the one met.
+func (s *Syncer) previewErrorEvent(pos, gtidStr string, gset gtid.Set) (string, error) {
+	target := pos
+	if gset != nil {
+		target = "GTID " + gtidStr
+	}
+	startLocation, endLocation, isQueryEvent, event := s.getErrEvent()
+	if startLocation == nil {
+		if len(target) == 0 {
+			return "", fmt.Errorf("source '%s' has no error", s.cfg.SourceID)
+		}
+		return fmt.Sprintf("source '%s' has no error, the operation will be applied to the event at %s when it's met", s.cfg.SourceID, target), nil
+	}
+
+	matched := true
+	if gset != nil {
+		startGTID, endGTID := startLocation.GetGTID(), endLocation.GetGTID()
+		matched = startGTID != nil && endGTID != nil && endGTID.Contain(gset) && !startGTID.Contain(gset)
+	} else if len(pos) != 0 {
+		matched = startLocation.Position.String() == pos
+	}
+	if !matched {
+		return fmt.Sprintf("the event at %s is not the error event at startLocation [%s], the operation will be applied to it when it's met, the error event is: %s",
+			target, startLocation, event), nil
+	}
+
+	msg := fmt.Sprintf("error event at startLocation [%s], endLocation [%s]: %s", startLocation, endLocation, event)
+	if !isQueryEvent && len(target) == 0 {
+		msg += ", only support to handle ddl error currently unless binlog-pos or binlog-gtid is specified"
+	}
+	return msg, nil
+}
+
+// describeEvent returns the summary of the binlog event, the SQL of a query event or the table and row count of a rows event.
+func describeEvent(e *replication.BinlogEvent) string {
+	switch ev := e.Event.(type) {
+	case *replication.QueryEvent:
+		return fmt.Sprintf("%s in schema `%s`: %s", e.Header.EventType, ev.Schema, strings.TrimSpace(string(ev.Query)))
+	case *replication.RowsEvent:
+		rows := len(ev.Rows)
+		switch e.Header.EventType {
+		case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+			// the before and after images of a row are in pairs.
+			rows /= 2
+		}
+		return fmt.Sprintf("%s on `%s`.`%s` with %d rows", e.Header.EventType, ev.Table.Schema, ev.Table.Table, rows)
+	}
+	return e.Header.EventType.String()
+}
+
+// describeJob returns the summary of the event of the job, the DDL or the changed row of a DML.
+func describeJob(j *job) string {
+	switch {
+	case j.tp == ddl:
+		sql := j.originSQL
+		if len(sql) == 0 {
+			sql = strings.Join(j.ddls, "; ")
+		}
+		return "DDL: " + sql
+	case j.dml != nil:
+		return fmt.Sprintf("%s on %s, columns: %v, old values: %v, values: %v",
+			j.dml.op, j.dml.sourceTable, j.dml.columnNames(), j.dml.originOldValues, j.dml.originValues)
+	}
+	return j.tp.String()
 }
 
 func (s *Syncer) genEvents(ctx context.Context, sqls []string) ([]*replication.BinlogEvent, error) {
 	events := make([]*replication.BinlogEvent, 0)
 
-	parser2, err := s.fromDB.GetParser(ctx)
+	// the generated events carry the sql_mode, so the SQLs are parsed in the same way when they are replicated.
+	sqlMode, err := s.fromDB.GetSQLMode(ctx)
 	if err != nil {
-		s.tctx.L().Error("failed to get SQL mode specified parser from upstream, using default SQL mode instead")
-		parser2 = parser.New()
+		s.tctx.L().Error("failed to get SQL mode from upstream, using default SQL mode instead")
+		sqlMode = mysql.ModeNone
 	}
+	parser2 := parser.New()
+	parser2.SetSQLMode(sqlMode)
+	statusVars := event.GenSQLModeStatusVars(sqlMode)
 
 	for _, sql := range sqls {
 		node, err := parser2.ParseOneStmt(sql, "", "")
@@ -98,7 +193,7 @@ func (s *Syncer) genEvents(ctx context.Context, sqls []string) ([]*replication.B
 			if len(schema) == 0 {
 				return nil, terror.ErrSyncerUnitInjectDDLWithoutSchema.Generate(sql)
 			}
-			events = append(events, genQueryEvent([]byte(schema), []byte(sql)))
+			events = append(events, genQueryEvent([]byte(schema), []byte(sql), statusVars))
 		default:
 			// TODO: support DML
 			return nil, terror.ErrSyncerReplaceEvent.New("only support replace with DDL currently")
@@ -108,13 +203,14 @@ func (s *Syncer) genEvents(ctx context.Context, sqls []string) ([]*replication.B
 }
 
 // genQueryEvent generate QueryEvent with empty EventSize and LogPos.
-func genQueryEvent(schema, query []byte) *replication.BinlogEvent {
+func genQueryEvent(schema, query, statusVars []byte) *replication.BinlogEvent {
 	header := &replication.EventHeader{
 		EventType: replication.QUERY_EVENT,
 	}
 	queryEvent := &replication.QueryEvent{
-		Schema: schema,
-		Query:  query,
+		Schema:     schema,
+		Query:      query,
+		StatusVars: statusVars,
 	}
 	e := &replication.BinlogEvent{
 		Header: header,
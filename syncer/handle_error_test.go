@@ -17,9 +17,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
 	. "github.com/pingcap/check"
 
 	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/binlog/event"
 	"github.com/pingcap/dm/pkg/conn"
 	"github.com/pingcap/dm/syncer/dbconn"
 )
@@ -61,6 +66,18 @@ func (s *testSyncerSuite) TestHandleError(c *C) {
 				req:    pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Skip, Task: task, BinlogPos: "mysql-bin.000001:2345", Sqls: []string{}},
 				errMsg: "",
 			},
+			{
+				req:    pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Skip, Task: task, BinlogPos: "mysql-bin.000001:2345", BinlogGTID: "3ccc475b-2343-11e7-be21-6c0b84d59f30:14"},
+				errMsg: ".*binlog-pos and binlog-gtid can't be specified at the same time.*",
+			},
+			{
+				req:    pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Skip, Task: task, BinlogGTID: "wrong_gtid"},
+				errMsg: ".*invalid GTID format.*",
+			},
+			{
+				req:    pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Inject, Task: task, BinlogGTID: "3ccc475b-2343-11e7-be21-6c0b84d59f30:14", Sqls: []string{"alter table db.tb add column a int;"}},
+				errMsg: "",
+			},
 		}
 	)
 	mockDB := conn.InitMockDB(c)
@@ -69,7 +86,7 @@ func (s *testSyncerSuite) TestHandleError(c *C) {
 	c.Assert(err, IsNil)
 
 	for _, cs := range cases {
-		err := syncer.HandleError(ctx, &cs.req)
+		_, err := syncer.HandleError(ctx, &cs.req)
 		if len(cs.errMsg) == 0 {
 			c.Assert(err, IsNil)
 		} else {
@@ -79,3 +96,79 @@ func (s *testSyncerSuite) TestHandleError(c *C) {
 	}
 	c.Assert(mockDB.ExpectationsWereMet(), IsNil)
 }
+
+func (s *testSyncerSuite) TestPreviewErrorEvent(c *C) {
+	var (
+		syncer = NewSyncer(s.cfg, nil)
+		ctx    = context.Background()
+		req    = pb.HandleWorkerErrorRequest{Op: pb.ErrorOp_Preview, Task: "test"}
+	)
+
+	// no error event.
+	_, err := syncer.HandleError(ctx, &req)
+	c.Assert(err, ErrorMatches, fmt.Sprintf("source '%s' has no error", syncer.cfg.SourceID))
+	req.BinlogPos = "mysql-bin.000001:2345"
+	msg, err := syncer.HandleError(ctx, &req)
+	c.Assert(err, IsNil)
+	c.Assert(msg, Matches, ".*has no error, the operation will be applied to the event at .*mysql-bin.000001, 2345.* when it's met")
+
+	startLocation := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 2345}, nil)
+	endLocation := binlog.InitLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 2400}, nil)
+	syncer.setErrLocation(&startLocation, &endLocation, true, "DDL: alter table db.tb add column a int")
+
+	// the error event is previewed.
+	for _, pos := range []string{"", "mysql-bin.000001:2345"} {
+		req.BinlogPos = pos
+		msg, err = syncer.HandleError(ctx, &req)
+		c.Assert(err, IsNil)
+		c.Assert(msg, Matches, "error event at startLocation .*, endLocation .*: DDL: alter table db.tb add column a int")
+	}
+
+	req.BinlogPos = "mysql-bin.000001:4"
+	msg, err = syncer.HandleError(ctx, &req)
+	c.Assert(err, IsNil)
+	c.Assert(msg, Matches, "the event at .* is not the error event at startLocation .*DDL: alter table db.tb add column a int")
+
+	// a DML error event is previewed with a note.
+	syncer.setErrLocation(nil, nil, false, "")
+	syncer.setErrLocation(&startLocation, &endLocation, false, "insert on `db`.`tb`, columns: [a], old values: [], values: [1]")
+	req.BinlogPos = ""
+	msg, err = syncer.HandleError(ctx, &req)
+	c.Assert(err, IsNil)
+	c.Assert(msg, Matches, ".*values: \\[1\\], only support to handle ddl error currently .*")
+}
+
+func (s *testSyncerSuite) TestGenEventsWithSQLMode(c *C) {
+	var (
+		syncer = NewSyncer(s.cfg, nil)
+		ctx    = context.Background()
+		sqls   = []string{`alter table "db"."tb" add column "c" int`}
+	)
+	mockDB := conn.InitMockDB(c)
+	var err error
+	syncer.fromDB, err = dbconn.NewUpStreamConn(s.cfg.From)
+	c.Assert(err, IsNil)
+
+	// the SQLs are parsed with the sql_mode of upstream.
+	mockDB.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", "ANSI_QUOTES"))
+	events, err := syncer.genEvents(ctx, sqls)
+	c.Assert(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Assert(mockDB.ExpectationsWereMet(), IsNil)
+
+	// and the generated events carry it.
+	ev, ok := events[0].Event.(*replication.QueryEvent)
+	c.Assert(ok, IsTrue)
+	c.Assert(string(ev.Schema), Equals, "db")
+	p, err := event.GetParserForStatusVars(ev.StatusVars)
+	c.Assert(err, IsNil)
+	_, err = p.ParseOneStmt(string(ev.Query), "", "")
+	c.Assert(err, IsNil)
+
+	mockDB.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""))
+	_, err = syncer.genEvents(ctx, sqls)
+	c.Assert(err, ErrorMatches, ".*sql alter table .*")
+	c.Assert(mockDB.ExpectationsWereMet(), IsNil)
+}
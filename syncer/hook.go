@@ -0,0 +1,132 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/sink"
+)
+
+// hookDDLs passes the DDLs to the hook plugin before they're executed in the downstream, and returns the DDLs to
+// execute instead.
+func (s *Syncer) hookDDLs(tctx *tcontext.Context, ddls []string) ([]string, error) {
+	if s.hook == nil {
+		return ddls, nil
+	}
+	res := make([]string, 0, len(ddls))
+	for _, ddl := range ddls {
+		newDDLs, err := s.hook.OnDDL(tctx.Ctx, ddl)
+		if err != nil {
+			return nil, terror.ErrSyncerHookExecute.Delegate(err, "DDL "+ddl)
+		}
+		if len(newDDLs) != 1 || newDDLs[0] != ddl {
+			tctx.L().Info("DDL is changed by hook plugin", zap.String("DDL", ddl), zap.Strings("new DDLs", newDDLs))
+		}
+		res = append(res, newDDLs...)
+	}
+	return res, nil
+}
+
+// hookDMLs passes the row changes of the DMLs to the hook plugin before they're replicated, and returns the DMLs of
+// the row changes returned by the hook plugin.
+func (s *Syncer) hookDMLs(tctx *tcontext.Context, targetTable *filter.Table, ts uint32, dmls []*DML) ([]*DML, error) {
+	if s.hook == nil {
+		return dmls, nil
+	}
+	res := make([]*DML, 0, len(dmls))
+	for _, dml := range dmls {
+		changes, err := s.hook.OnRowChange(tctx.Ctx, dml.rowChange(targetTable, ts))
+		if err != nil {
+			return nil, terror.ErrSyncerHookExecute.Delegate(err, "row change of table "+targetTable.String())
+		}
+		for _, change := range changes {
+			newDML, err2 := dmlFromRowChange(dml, targetTable, change)
+			if err2 != nil {
+				return nil, err2
+			}
+			res = append(res, newDML)
+		}
+	}
+	return res, nil
+}
+
+// dmlFromRowChange builds the DML of a row change returned by the hook plugin, dml is the DML which the row change is
+// passed to the hook plugin from.
+func dmlFromRowChange(dml *DML, targetTable *filter.Table, change *sink.RowChange) (*DML, error) {
+	invalid := func(format string, args ...interface{}) error {
+		return terror.ErrSyncerHookInvalidRowChange.Generate(targetTable, fmt.Sprintf(format, args...))
+	}
+	if change.Schema != targetTable.Schema || change.Table != targetTable.Name {
+		return nil, invalid("table is changed to `%s`.`%s`", change.Schema, change.Table)
+	}
+	if len(change.Columns) != len(dml.columns) {
+		return nil, invalid("%d columns are returned, but there are %d columns", len(change.Columns), len(dml.columns))
+	}
+	for i, col := range dml.columns {
+		if !strings.EqualFold(change.Columns[i], col.Name.O) {
+			return nil, invalid("column %s is changed to %s", col.Name.O, change.Columns[i])
+		}
+	}
+	if len(change.Values) != len(dml.columns) {
+		return nil, invalid("%d values are returned for %d columns", len(change.Values), len(dml.columns))
+	}
+
+	var (
+		op                         opType
+		oldValues, originOldValues []interface{}
+		originValues               = overlayColumnValues(dml.originValues, dml.columns, change.Values)
+	)
+	switch change.Type {
+	case sink.RowChangeInsert:
+		op = insert
+	case sink.RowChangeDelete:
+		op = del
+	case sink.RowChangeUpdate:
+		op = update
+		if len(change.PreValues) != len(dml.columns) {
+			return nil, invalid("%d previous values are returned for %d columns", len(change.PreValues), len(dml.columns))
+		}
+		oldValues = change.PreValues
+		base := dml.originOldValues
+		if base == nil {
+			base = dml.originValues
+		}
+		originOldValues = overlayColumnValues(base, dml.columns, oldValues)
+	default:
+		return nil, invalid("type %s is not supported", change.Type)
+	}
+	return newDML(op, dml.safeMode, dml.targetTableID, dml.sourceTable, oldValues, change.Values, originOldValues, originValues,
+		dml.columns, dml.sourceTableInfo), nil
+}
+
+// overlayColumnValues returns a copy of the origin values of all columns in the source table, with the values of
+// columns replaced.
+func overlayColumnValues(originValues []interface{}, columns []*model.ColumnInfo, values []interface{}) []interface{} {
+	res := make([]interface{}, len(originValues))
+	copy(res, originValues)
+	for i, col := range columns {
+		if col.Offset < len(res) {
+			res[col.Offset] = values[i]
+		}
+	}
+	return res
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hook
+
+import (
+	"context"
+	"plugin"
+
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/sink"
+)
+
+// NewHookSymbol is the name of the function exported by the hook plugin to create the Hook, its type should be
+// `func() (hook.Hook, error)`.
+const NewHookSymbol = "NewHook"
+
+// RowChange is a row change of a target table passed to the hook.
+type RowChange = sink.RowChange
+
+// Hook intercepts the DDLs and row changes replicated by the syncer to transform them. it's loaded from a Go plugin
+// built with the same Go version and dependencies as DM-worker, like
+//
+//	go build -buildmode=plugin -o hook.so
+//
+// the methods are called sequentially by the syncer of a subtask, but the hook may be shared by several subtasks.
+type Hook interface {
+	// OnDDL is called before a routed DDL is executed in the downstream, it returns the DDLs to execute instead, or
+	// nothing to skip it. the DDLs are still tracked by the schema tracker as they're in the upstream.
+	OnDDL(ctx context.Context, ddl string) ([]string, error)
+	// OnRowChange is called for every row change of the target table before it's replicated, it returns the row
+	// changes to replicate instead, or nothing to skip it. the returned row changes should be of the same target table
+	// and have the same columns, only the type and the values can be changed.
+	OnRowChange(ctx context.Context, change *RowChange) ([]*RowChange, error)
+}
+
+// Load loads the Hook from the Go plugin of path.
+func Load(path string) (Hook, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, terror.ErrSyncerHookLoad.Delegate(err, path)
+	}
+	sym, err := p.Lookup(NewHookSymbol)
+	if err != nil {
+		return nil, terror.ErrSyncerHookLoad.Delegate(err, path)
+	}
+	newHook, ok := sym.(func() (Hook, error))
+	if !ok {
+		return nil, terror.ErrSyncerHookLoad.Generatef("%s of plugin %s is %T, not func() (hook.Hook, error)", NewHookSymbol, path, sym)
+	}
+	h, err := newHook()
+	if err != nil {
+		return nil, terror.ErrSyncerHookLoad.Delegate(err, path)
+	}
+	return h, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/hook"
+	"github.com/pingcap/dm/syncer/sink"
+)
+
+// mockHook masks the `content` column, splits an UPDATE into DELETE and INSERT, skips the rows with id 0, and
+// skips `DROP TABLE`.
+type mockHook struct{}
+
+func (mockHook) OnDDL(_ context.Context, ddl string) ([]string, error) {
+	if strings.HasPrefix(ddl, "DROP TABLE") {
+		return nil, nil
+	}
+	if strings.HasPrefix(ddl, "INVALID") {
+		return nil, errors.New("invalid DDL")
+	}
+	return []string{ddl, "ANALYZE TABLE `db`.`tb`"}, nil
+}
+
+func (mockHook) OnRowChange(_ context.Context, change *hook.RowChange) ([]*hook.RowChange, error) {
+	if change.Values[0] == int32(0) {
+		return nil, nil
+	}
+	change.Values[2] = "***"
+	if change.Type != sink.RowChangeUpdate {
+		return []*hook.RowChange{change}, nil
+	}
+	deleted := *change
+	deleted.Type = sink.RowChangeDelete
+	deleted.Values = change.PreValues
+	deleted.PreValues = nil
+	inserted := *change
+	inserted.Type = sink.RowChangeInsert
+	inserted.PreValues = nil
+	return []*hook.RowChange{&deleted, &inserted}, nil
+}
+
+func (s *testSyncerSuite) TestHook(c *C) {
+	var (
+		syncer      = &Syncer{hook: mockHook{}}
+		tctx        = tcontext.Background()
+		columns     = newOversizedRowTestColumns()
+		ti          = &model.TableInfo{Columns: columns, PKIsHandle: true}
+		sourceTable = &filter.Table{Schema: "db", Name: "tb"}
+		targetTable = &filter.Table{Schema: "target_db", Name: "target_tb"}
+	)
+
+	for i, col := range columns {
+		col.Offset = i
+	}
+
+	ddls, err := syncer.hookDDLs(tctx, []string{"ALTER TABLE `db`.`tb` ADD COLUMN c INT", "DROP TABLE `db`.`tb`"})
+	c.Assert(err, IsNil)
+	c.Assert(ddls, DeepEquals, []string{"ALTER TABLE `db`.`tb` ADD COLUMN c INT", "ANALYZE TABLE `db`.`tb`"})
+	_, err = syncer.hookDDLs(tctx, []string{"INVALID"})
+	c.Assert(terror.ErrSyncerHookExecute.Equal(err), IsTrue)
+
+	dmls := []*DML{
+		newDML(insert, false, targetTable.String(), sourceTable, nil, []interface{}{int32(0), "a", "b"}, nil, []interface{}{int32(0), "a", "b"}, columns, ti),
+		newDML(update, false, targetTable.String(), sourceTable, []interface{}{int32(1), "a", "b"}, []interface{}{int32(1), "a", "c"},
+			[]interface{}{int32(1), "a", "b"}, []interface{}{int32(1), "a", "c"}, columns, ti),
+	}
+	dmls, err = syncer.hookDMLs(tctx, targetTable, 0, dmls)
+	c.Assert(err, IsNil)
+	c.Assert(dmls, HasLen, 2)
+	c.Assert(dmls[0].op, Equals, del)
+	c.Assert(dmls[0].values, DeepEquals, []interface{}{int32(1), "a", "b"})
+	c.Assert(dmls[0].originValues, DeepEquals, []interface{}{int32(1), "a", "b"})
+	c.Assert(dmls[1].op, Equals, insert)
+	c.Assert(dmls[1].values, DeepEquals, []interface{}{int32(1), "a", "***"})
+	c.Assert(dmls[1].originValues, DeepEquals, []interface{}{int32(1), "a", "***"})
+	c.Assert(dmls[1].oldValues, IsNil)
+
+	// the invalid row changes returned by the hook plugin.
+	dml := newDML(insert, false, targetTable.String(), sourceTable, nil, []interface{}{int32(1), "a", "b"}, nil, []interface{}{int32(1), "a", "b"}, columns, ti)
+	for _, change := range []*sink.RowChange{
+		{Schema: "target_db", Table: "other_tb", Type: sink.RowChangeInsert, Columns: []string{"id", "name", "content"}, Values: []interface{}{1, "a", "b"}},
+		{Schema: "target_db", Table: "target_tb", Type: sink.RowChangeInsert, Columns: []string{"id", "name"}, Values: []interface{}{1, "a"}},
+		{Schema: "target_db", Table: "target_tb", Type: sink.RowChangeInsert, Columns: []string{"id", "name", "other"}, Values: []interface{}{1, "a", "b"}},
+		{Schema: "target_db", Table: "target_tb", Type: sink.RowChangeInsert, Columns: []string{"id", "name", "content"}, Values: []interface{}{1, "a"}},
+		{Schema: "target_db", Table: "target_tb", Type: sink.RowChangeUpdate, Columns: []string{"id", "name", "content"}, Values: []interface{}{1, "a", "b"}},
+		{Schema: "target_db", Table: "target_tb", Type: "REPLACE", Columns: []string{"id", "name", "content"}, Values: []interface{}{1, "a", "b"}},
+	} {
+		_, err = dmlFromRowChange(dml, targetTable, change)
+		c.Assert(terror.ErrSyncerHookInvalidRowChange.Equal(err), IsTrue)
+	}
+	newDML, err := dmlFromRowChange(dml, targetTable, &sink.RowChange{
+		Schema: "target_db", Table: "target_tb", Type: sink.RowChangeUpdate, Columns: []string{"ID", "name", "content"},
+		PreValues: []interface{}{int32(1), "a", "b"}, Values: []interface{}{int32(2), "a", "b"},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(newDML.op, Equals, update)
+	c.Assert(newDML.originOldValues, DeepEquals, []interface{}{int32(1), "a", "b"})
+	c.Assert(newDML.originValues, DeepEquals, []interface{}{int32(2), "a", "b"})
+}
@@ -15,6 +15,7 @@ package syncer
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-mysql-org/go-mysql/replication"
@@ -36,6 +37,7 @@ const (
 	skip // used by Syncer.recordSkipSQLsLocation to record global location, but not execute SQL
 	rotate
 	conflict
+	asyncFlush
 )
 
 func (t opType) String() string {
@@ -58,6 +60,8 @@ func (t opType) String() string {
 		return "rotate"
 	case conflict:
 		return "conflict"
+	case asyncFlush:
+		return "async flush"
 	}
 
 	return ""
@@ -80,6 +84,9 @@ type job struct {
 
 	eventHeader *replication.EventHeader
 	jobAddTime  time.Time // job commit time
+
+	flushWg *sync.WaitGroup // for asyncFlush job, done after the DML jobs before it in every DML queue are executed
+	memSize int64           // size acquired from the memory quota, released after the job is executed
 }
 
 func (j *job) String() string {
@@ -170,6 +177,19 @@ func newFlushJob() *job {
 	}
 }
 
+// newAsyncFlushJob creates an asyncFlush job, workerCount is the number of DML queues.
+func newAsyncFlushJob(workerCount int) *job {
+	j := &job{
+		tp:          asyncFlush,
+		targetTable: &filter.Table{},
+		jobAddTime:  time.Now(),
+		flushWg:     &sync.WaitGroup{},
+	}
+	// add before sending the job, so waiting on flushWg never returns too early.
+	j.flushWg.Add(workerCount)
+	return j
+}
+
 func newConflictJob() *job {
 	return &job{
 		tp:          conflict,
@@ -0,0 +1,379 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"strings"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog/event"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// executeLoadQueryPostHeaderLen is the length of the post header of EXECUTE_LOAD_QUERY event, which is the post header
+// of QUERY_EVENT followed by file_id, start_pos, end_pos and dup_handling_flags.
+const executeLoadQueryPostHeaderLen = 13 + 13
+
+// a LOAD DATA statement logged in statement format is split into the following events:
+//   - BEGIN_LOAD_QUERY_EVENT: the first block of the loaded file.
+//   - APPEND_BLOCK_EVENT: the following blocks of the loaded file.
+//   - EXECUTE_LOAD_QUERY_EVENT: the LOAD DATA statement.
+//   - DELETE_FILE_EVENT: the LOAD DATA statement failed in upstream.
+
+// appendLoadDataBlock appends a block of the file loaded by LOAD DATA, `begin` means it's the first block.
+func (s *Syncer) appendLoadDataBlock(fileID uint32, block []byte, begin bool) {
+	if s.loadDataFiles == nil {
+		s.loadDataFiles = make(map[uint32][]byte)
+	}
+	if begin {
+		s.loadDataFiles[fileID] = append([]byte(nil), block...)
+		return
+	}
+	if data, ok := s.loadDataFiles[fileID]; ok {
+		s.loadDataFiles[fileID] = append(data, block...)
+	}
+}
+
+// handleLoadDataFileEvent handles APPEND_BLOCK_EVENT and DELETE_FILE_EVENT, which are not decoded by go-mysql.
+func (s *Syncer) handleLoadDataFileEvent(e *replication.BinlogEvent, ev *replication.GenericEvent) {
+	if len(ev.Data) < 4 {
+		return
+	}
+	fileID := binary.LittleEndian.Uint32(ev.Data)
+	if e.Header.EventType == replication.APPEND_BLOCK_EVENT {
+		s.appendLoadDataBlock(fileID, ev.Data[4:], false)
+	} else {
+		delete(s.loadDataFiles, fileID)
+	}
+}
+
+// handleExecuteLoadQueryEvent handles the LOAD DATA statement according to `load-data-policy`, returns the statement.
+func (s *Syncer) handleExecuteLoadQueryEvent(ev *replication.ExecuteLoadQueryEvent, rawData []byte, ec eventContext) (string, error) {
+	data, ok := s.loadDataFiles[ev.FileID]
+	delete(s.loadDataFiles, ev.FileID)
+
+	schema, statusVars, query, err := parseExecuteLoadQuery(ev, rawData)
+	if err != nil {
+		return "", terror.ErrSyncerLoadDataNotSupported.Generate(err.Error())
+	}
+
+	switch s.cfg.LoadDataPolicy {
+	case config.LoadDataError:
+		return query, terror.ErrSyncerLoadDataNotSupported.Generate("`load-data-policy` is `error`")
+	case config.LoadDataReconstruct:
+		if !ok {
+			return query, terror.ErrSyncerLoadDataNotSupported.Generate(fmt.Sprintf("the content of file %d is not found in binlog", ev.FileID))
+		}
+		return query, s.reconstructLoadData(ec, schema, statusVars, query, data)
+	default:
+		ec.tctx.L().Warn("skip LOAD DATA statement, set `load-data-policy: reconstruct` to replicate the loaded rows",
+			zap.String("schema", schema),
+			zap.String("statement", query),
+			log.WrapStringerField("location", ec.currentLocation))
+		return query, nil
+	}
+}
+
+// reconstructLoadData parses the loaded file as the LOAD DATA statement does, and replicates the rows as a rows event.
+func (s *Syncer) reconstructLoadData(ec eventContext, schema string, statusVars []byte, query string, data []byte) error {
+	p, err := event.GetParserForStatusVars(statusVars)
+	if err != nil {
+		ec.tctx.L().Warn("found error when get sql_mode from binlog status_vars", zap.Error(err))
+	}
+	stmt, err := p.ParseOneStmt(query, "", "")
+	if err != nil {
+		return terror.ErrSyncerLoadDataNotSupported.Generate(err.Error())
+	}
+	load, ok := stmt.(*ast.LoadDataStmt)
+	if !ok {
+		return terror.ErrSyncerLoadDataNotSupported.Generate("not a LOAD DATA statement")
+	}
+
+	sourceTable := &filter.Table{Schema: load.Table.Schema.O, Name: load.Table.Name.O}
+	if sourceTable.Schema == "" {
+		sourceTable.Schema = schema
+	}
+	header := *ec.header
+	header.EventType = replication.WRITE_ROWS_EVENTv2
+	ec.header = &header
+	// rows conflict with the existing rows replace them for `LOAD DATA ... REPLACE`.
+	ec.safeMode = ec.safeMode || load.OnDuplicate == ast.OnDuplicateKeyHandlingReplace
+
+	var rows [][]interface{}
+	// the rows of skipped tables are not needed, handleRowsEvent skips the event in the same way as row format.
+	needSkip, err := s.skipRowsEvent(sourceTable, header.EventType)
+	if err != nil {
+		return err
+	}
+	if !needSkip {
+		tableInfo, err2 := s.getTableInfo(ec.tctx, sourceTable, s.route(sourceTable))
+		if err2 != nil {
+			return terror.WithScope(err2, terror.ScopeDownstream)
+		}
+		lines, err2 := newLoadDataParser(load).parse(data)
+		if err2 != nil {
+			return terror.ErrSyncerLoadDataNotSupported.Generate(err2.Error())
+		}
+		ts := time.Unix(int64(header.Timestamp), 0).In(s.timezone)
+		rows, err2 = genLoadDataRows(tableInfo, load, lines, ts)
+		if err2 != nil {
+			return terror.ErrSyncerLoadDataNotSupported.Generate(err2.Error())
+		}
+	}
+
+	rowsEvent := &replication.RowsEvent{
+		Table:          &replication.TableMapEvent{Schema: []byte(sourceTable.Schema), Table: []byte(sourceTable.Name)},
+		Rows:           rows,
+		SkippedColumns: make([][]int, len(rows)),
+	}
+	return s.handleRowsEvent(rowsEvent, ec)
+}
+
+// parseExecuteLoadQuery extracts the schema, status variables and statement of EXECUTE_LOAD_QUERY event from its raw
+// data, which are not decoded by go-mysql.
+func parseExecuteLoadQuery(ev *replication.ExecuteLoadQueryEvent, rawData []byte) (schema string, statusVars []byte, query string, err error) {
+	// the raw data may end with CRC32 checksum.
+	if n := len(rawData); n >= replication.EventHeaderSize+4 &&
+		crc32.ChecksumIEEE(rawData[:n-4]) == binary.LittleEndian.Uint32(rawData[n-4:]) {
+		rawData = rawData[:n-4]
+	}
+	if len(rawData) < replication.EventHeaderSize {
+		return "", nil, "", fmt.Errorf("invalid EXECUTE_LOAD_QUERY event of %d bytes", len(rawData))
+	}
+	body := rawData[replication.EventHeaderSize:]
+	pos := executeLoadQueryPostHeaderLen + int(ev.StatusVars)
+	end := pos + int(ev.SchemaLength) + 1 // schema is terminated by 0x00
+	if len(body) < end {
+		return "", nil, "", fmt.Errorf("invalid EXECUTE_LOAD_QUERY event of %d bytes", len(rawData))
+	}
+	return string(body[pos : end-1]), body[executeLoadQueryPostHeaderLen:pos], string(body[end:]), nil
+}
+
+// loadDataParser splits the content of a file loaded by LOAD DATA into lines and fields in the same way as MySQL.
+type loadDataParser struct {
+	fieldTerm []byte
+	enclosed  byte
+	escaped   byte
+	lineStart []byte
+	lineTerm  []byte
+}
+
+func newLoadDataParser(load *ast.LoadDataStmt) *loadDataParser {
+	p := &loadDataParser{
+		fieldTerm: []byte("\t"),
+		escaped:   '\\',
+		lineTerm:  []byte("\n"),
+	}
+	if load.FieldsInfo != nil {
+		p.fieldTerm = []byte(load.FieldsInfo.Terminated)
+		p.enclosed = load.FieldsInfo.Enclosed
+		p.escaped = load.FieldsInfo.Escaped
+	}
+	if load.LinesInfo != nil {
+		p.lineStart = []byte(load.LinesInfo.Starting)
+		p.lineTerm = []byte(load.LinesInfo.Terminated)
+	}
+	return p
+}
+
+// parse returns the fields of each line, a NULL field is nil and others are string.
+func (p *loadDataParser) parse(data []byte) ([][]interface{}, error) {
+	if len(p.fieldTerm) == 0 || len(p.lineTerm) == 0 {
+		return nil, fmt.Errorf("fixed-row format is not supported")
+	}
+
+	var lines [][]interface{}
+	pos := 0
+	for pos < len(data) {
+		if len(p.lineStart) > 0 {
+			// the content before the prefix is skipped, and the line without the prefix is skipped.
+			idx := bytes.Index(data[pos:], p.lineStart)
+			if idx < 0 {
+				break
+			}
+			pos += idx + len(p.lineStart)
+		}
+		var (
+			line      []interface{}
+			field     interface{}
+			endOfLine bool
+		)
+		for !endOfLine {
+			field, pos, endOfLine = p.readField(data, pos)
+			line = append(line, field)
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// readField reads a field starting at `pos`, returns the field, the position after the terminator and whether it's the
+// last field of the line.
+func (p *loadDataParser) readField(data []byte, pos int) (interface{}, int, bool) {
+	var buf []byte
+	quoted := p.enclosed != 0 && pos < len(data) && data[pos] == p.enclosed
+	i := pos
+	if quoted {
+		i++
+	}
+	for i < len(data) {
+		c := data[i]
+		if p.escaped != 0 && c == p.escaped && i+1 < len(data) {
+			buf = append(buf, unescapeLoadData(data[i+1]))
+			i += 2
+			continue
+		}
+		if quoted {
+			if c == p.enclosed {
+				// a doubled enclosed character is a literal one, and the closing one must be followed by a terminator.
+				if i+1 < len(data) && data[i+1] == p.enclosed {
+					buf = append(buf, c)
+					i += 2
+					continue
+				}
+				if next, endOfLine, ok := p.terminator(data, i+1); ok {
+					return string(buf), next, endOfLine
+				}
+			}
+		} else if next, endOfLine, ok := p.terminator(data, i); ok {
+			return p.unquotedField(data[pos:i], buf), next, endOfLine
+		}
+		buf = append(buf, c)
+		i++
+	}
+	if quoted {
+		// the enclosed character is not closed, the field is read as is.
+		return p.unquotedField(data[pos:], append([]byte{p.enclosed}, buf...)), len(data), true
+	}
+	return p.unquotedField(data[pos:], buf), len(data), true
+}
+
+// terminator checks whether there is a line or field terminator at `pos`, the end of data terminates the line.
+func (p *loadDataParser) terminator(data []byte, pos int) (next int, endOfLine bool, ok bool) {
+	switch {
+	case pos >= len(data):
+		return len(data), true, true
+	case bytes.HasPrefix(data[pos:], p.lineTerm):
+		return pos + len(p.lineTerm), true, true
+	case bytes.HasPrefix(data[pos:], p.fieldTerm):
+		return pos + len(p.fieldTerm), false, true
+	}
+	return pos, false, false
+}
+
+// unquotedField returns nil for `\N`, and also for `NULL` if the fields are enclosed.
+func (p *loadDataParser) unquotedField(raw, unescaped []byte) interface{} {
+	if p.escaped != 0 && len(raw) == 2 && raw[0] == p.escaped && raw[1] == 'N' {
+		return nil
+	}
+	if p.enclosed != 0 && string(raw) == "NULL" {
+		return nil
+	}
+	return string(unescaped)
+}
+
+func unescapeLoadData(c byte) byte {
+	switch c {
+	case '0':
+		return 0
+	case 'b':
+		return '\b'
+	case 'n':
+		return '\n'
+	case 'r':
+		return '\r'
+	case 't':
+		return '\t'
+	case 'Z':
+		return 0x1a
+	}
+	return c
+}
+
+// genLoadDataRows generates the rows of the table from the lines of the loaded file, the columns not loaded are filled
+// with the default values. `ts` is the time when the statement is executed in upstream.
+func genLoadDataRows(ti *model.TableInfo, load *ast.LoadDataStmt, lines [][]interface{}, ts time.Time) ([][]interface{}, error) {
+	if len(load.ColumnAssignments) > 0 {
+		return nil, fmt.Errorf("SET clause is not supported")
+	}
+	var loaded []int
+	if len(load.ColumnsAndUserVars) == 0 {
+		for i, col := range ti.Columns {
+			if !col.IsGenerated() && !col.Hidden {
+				loaded = append(loaded, i)
+			}
+		}
+	}
+	for _, item := range load.ColumnsAndUserVars {
+		if item.UserVar != nil {
+			return nil, fmt.Errorf("user variable @%s is not supported", item.UserVar.Name)
+		}
+		col := model.FindColumnInfo(ti.Columns, item.ColumnName.Name.L)
+		if col == nil {
+			return nil, fmt.Errorf("column %s not found in table %s", item.ColumnName.Name.O, ti.Name.O)
+		}
+		if col.IsGenerated() {
+			return nil, fmt.Errorf("generated column %s can't be loaded", col.Name.O)
+		}
+		loaded = append(loaded, col.Offset)
+	}
+
+	if uint64(len(lines)) <= load.IgnoreLines {
+		return nil, nil
+	}
+	lines = lines[load.IgnoreLines:]
+	rows := make([][]interface{}, 0, len(lines))
+	for _, line := range lines {
+		row := make([]interface{}, len(ti.Columns))
+		filled := make([]bool, len(ti.Columns))
+		// the extra fields are ignored, and the missing fields are filled with default values.
+		for i := 0; i < len(loaded) && i < len(line); i++ {
+			row[loaded[i]] = line[i]
+			filled[loaded[i]] = true
+		}
+		for i, col := range ti.Columns {
+			if filled[i] || col.IsGenerated() {
+				continue
+			}
+			if mysql.HasAutoIncrementFlag(col.Flag) {
+				// the value generated in upstream is unknown.
+				return nil, fmt.Errorf("auto increment column %s is not loaded", col.Name.O)
+			}
+			row[i] = loadDataDefaultValue(col, ts)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func loadDataDefaultValue(col *model.ColumnInfo, ts time.Time) interface{} {
+	value := col.GetDefaultValue()
+	if str, ok := value.(string); ok && strings.HasPrefix(strings.ToLower(str), ast.CurrentTimestamp) {
+		return ts.Format("2006-01-02 15:04:05")
+	}
+	return value
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/util/mock"
+)
+
+func (s *testSyncerSuite) TestParseExecuteLoadQuery(c *C) {
+	query := "LOAD DATA LOCAL INFILE '/tmp/SQL_LOAD_MB-1-0' INTO TABLE `tb` FIELDS TERMINATED BY ','"
+	statusVars := []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0}
+	raw := make([]byte, replication.EventHeaderSize+executeLoadQueryPostHeaderLen)
+	raw = append(raw, statusVars...)
+	raw = append(raw, "db\x00"...)
+	raw = append(raw, query...)
+	ev := &replication.ExecuteLoadQueryEvent{SchemaLength: 2, StatusVars: uint16(len(statusVars))}
+
+	schema, vars, q, err := parseExecuteLoadQuery(ev, raw)
+	c.Assert(err, IsNil)
+	c.Assert(schema, Equals, "db")
+	c.Assert(vars, DeepEquals, statusVars)
+	c.Assert(q, Equals, query)
+
+	// with checksum.
+	raw = append(raw, make([]byte, 4)...)
+	binary.LittleEndian.PutUint32(raw[len(raw)-4:], crc32.ChecksumIEEE(raw[:len(raw)-4]))
+	_, _, q, err = parseExecuteLoadQuery(ev, raw)
+	c.Assert(err, IsNil)
+	c.Assert(q, Equals, query)
+
+	ev.SchemaLength = 200
+	_, _, _, err = parseExecuteLoadQuery(ev, raw)
+	c.Assert(err, ErrorMatches, "invalid EXECUTE_LOAD_QUERY event.*")
+}
+
+func (s *testSyncerSuite) TestLoadDataParser(c *C) {
+	cases := []struct {
+		query string
+		data  string
+		lines [][]interface{}
+	}{
+		{
+			"LOAD DATA INFILE 'f' INTO TABLE tb",
+			"1\ta\n2\t\\N\n3\tx\\ty\\\\\n4",
+			[][]interface{}{{"1", "a"}, {"2", nil}, {"3", "x\ty\\"}, {"4"}},
+		},
+		{
+			"LOAD DATA INFILE 'f' INTO TABLE tb FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"' LINES TERMINATED BY '\\r\\n'",
+			"1,\"a,\"\"b\"\"\",NULL,\"NULL\"\r\n2,,\"x\"y\"\r\n",
+			[][]interface{}{{"1", "a,\"b\"", nil, "NULL"}, {"2", "", "x\"y"}},
+		},
+		{
+			"LOAD DATA INFILE 'f' INTO TABLE tb FIELDS TERMINATED BY '||' ESCAPED BY '' LINES STARTING BY 'xxx'",
+			"xxx1||\\N\nskipped\nabcxxx2||b\n",
+			[][]interface{}{{"1", "\\N"}, {"2", "b"}},
+		},
+		{
+			"LOAD DATA INFILE 'f' INTO TABLE tb FIELDS ENCLOSED BY '\"'",
+			"\"unclosed\ta",
+			[][]interface{}{{"\"unclosed\ta"}},
+		},
+	}
+	p := parser.New()
+	for _, cs := range cases {
+		stmt, err := p.ParseOneStmt(cs.query, "", "")
+		c.Assert(err, IsNil)
+		lines, err := newLoadDataParser(stmt.(*ast.LoadDataStmt)).parse([]byte(cs.data))
+		c.Assert(err, IsNil)
+		c.Assert(lines, DeepEquals, cs.lines, Commentf("query: %s", cs.query))
+	}
+
+	stmt, err := p.ParseOneStmt("LOAD DATA INFILE 'f' INTO TABLE tb FIELDS TERMINATED BY ''", "", "")
+	c.Assert(err, IsNil)
+	_, err = newLoadDataParser(stmt.(*ast.LoadDataStmt)).parse([]byte("abc"))
+	c.Assert(err, ErrorMatches, "fixed-row format is not supported")
+}
+
+func (s *testSyncerSuite) TestGenLoadDataRows(c *C) {
+	p := parser.New()
+	ti, err := createTableInfo(p, mock.NewContext(), 1, `create table tb(id int primary key, a varchar(10) default 'x',
+		b int as (id + 1), c timestamp default current_timestamp, d int)`)
+	c.Assert(err, IsNil)
+	ts := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	lines := [][]interface{}{{"header"}, {"1", "a", "2021-01-01 00:00:00", "4"}, {"2", nil}, {"3", "c", "2021-01-01 00:00:00", "4", "extra"}}
+
+	cases := []struct {
+		query string
+		rows  [][]interface{}
+		err   string
+	}{
+		{
+			"LOAD DATA INFILE 'f' INTO TABLE tb IGNORE 1 LINES",
+			[][]interface{}{
+				{"1", "a", nil, "2021-01-01 00:00:00", "4"},
+				{"2", nil, nil, "2021-10-01 12:00:00", nil},
+				{"3", "c", nil, "2021-01-01 00:00:00", "4"},
+			},
+			"",
+		},
+		{
+			"LOAD DATA INFILE 'f' INTO TABLE tb IGNORE 2 LINES (d, id)",
+			[][]interface{}{{nil, "x", nil, "2021-10-01 12:00:00", "2"}, {"c", "x", nil, "2021-10-01 12:00:00", "3"}},
+			"",
+		},
+		{"LOAD DATA INFILE 'f' INTO TABLE tb IGNORE 10 LINES", nil, ""},
+		{"LOAD DATA INFILE 'f' INTO TABLE tb (id, @a)", nil, "user variable @a is not supported"},
+		{"LOAD DATA INFILE 'f' INTO TABLE tb (id) SET a = 'y'", nil, "SET clause is not supported"},
+		{"LOAD DATA INFILE 'f' INTO TABLE tb (id, e)", nil, "column e not found in table tb"},
+		{"LOAD DATA INFILE 'f' INTO TABLE tb (id, b)", nil, "generated column b can't be loaded"},
+	}
+	for _, cs := range cases {
+		stmt, err2 := p.ParseOneStmt(cs.query, "", "")
+		c.Assert(err2, IsNil)
+		rows, err2 := genLoadDataRows(ti, stmt.(*ast.LoadDataStmt), lines, ts)
+		if cs.err != "" {
+			c.Assert(err2, ErrorMatches, cs.err)
+			continue
+		}
+		c.Assert(err2, IsNil)
+		c.Assert(rows, DeepEquals, cs.rows, Commentf("query: %s", cs.query))
+	}
+
+	ti, err = createTableInfo(p, mock.NewContext(), 2, "create table tb(id int primary key auto_increment, a int)")
+	c.Assert(err, IsNil)
+	stmt, err := p.ParseOneStmt("LOAD DATA INFILE 'f' INTO TABLE tb (a)", "", "")
+	c.Assert(err, IsNil)
+	_, err = genLoadDataRows(ti, stmt.(*ast.LoadDataStmt), lines, ts)
+	c.Assert(err, ErrorMatches, "auto increment column id is not loaded")
+}
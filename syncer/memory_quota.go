@@ -0,0 +1,65 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// memoryQuota limits the total size of the row changes buffered in the syncer of a subtask, the syncer stops reading
+// binlog until enough buffered row changes are executed, so a heavy subtask can't exhaust the memory of the worker.
+// all methods of a nil memoryQuota do nothing.
+type memoryQuota struct {
+	quota int64
+	sem   *semaphore.Weighted
+}
+
+// newMemoryQuota creates a memoryQuota, nil is returned if quota is not positive.
+func newMemoryQuota(quota int64) *memoryQuota {
+	if quota <= 0 {
+		return nil
+	}
+	return &memoryQuota{
+		quota: quota,
+		sem:   semaphore.NewWeighted(quota),
+	}
+}
+
+// acquire blocks until the size of the DML job is acquired or ctx is done.
+// the size larger than the quota is capped to the quota, so the job can still be executed when nothing else buffered.
+func (q *memoryQuota) acquire(ctx context.Context, j *job) error {
+	if q == nil || j.dml == nil {
+		return nil
+	}
+	size := int64(rowSize(j.dml.values) + rowSize(j.dml.oldValues))
+	if size > q.quota {
+		size = q.quota
+	}
+	if err := q.sem.Acquire(ctx, size); err != nil {
+		return err
+	}
+	j.memSize = size
+	return nil
+}
+
+// release releases the size acquired by the job after it's executed.
+func (q *memoryQuota) release(j *job) {
+	if q == nil || j.memSize == 0 {
+		return
+	}
+	q.sem.Release(j.memSize)
+	j.memSize = 0
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/pingcap/check"
+)
+
+func (s *testSyncerSuite) TestMemoryQuota(c *C) {
+	newJob := func(size int) *job {
+		return &job{tp: insert, dml: &DML{values: []interface{}{strings.Repeat("a", size)}}}
+	}
+	ctx := context.Background()
+
+	// nil quota means no limit.
+	var q *memoryQuota
+	c.Assert(newMemoryQuota(0), IsNil)
+	j := newJob(100)
+	c.Assert(q.acquire(ctx, j), IsNil)
+	c.Assert(j.memSize, Equals, int64(0))
+	q.release(j)
+
+	q = newMemoryQuota(100)
+	j1, j2, j3 := newJob(60), newJob(30), newJob(20)
+	c.Assert(q.acquire(ctx, j1), IsNil)
+	c.Assert(j1.memSize, Equals, int64(60))
+	c.Assert(q.acquire(ctx, j2), IsNil)
+
+	// blocked until enough quota released.
+	ctx2, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	c.Assert(q.acquire(ctx2, j3), Equals, context.DeadlineExceeded)
+	cancel()
+	c.Assert(j3.memSize, Equals, int64(0))
+	acquired := make(chan error)
+	go func() {
+		acquired <- q.acquire(ctx, j3)
+	}()
+	select {
+	case <-acquired:
+		c.Fatal("acquired before released")
+	case <-time.After(50 * time.Millisecond):
+	}
+	q.release(j1)
+	c.Assert(j1.memSize, Equals, int64(0))
+	c.Assert(<-acquired, IsNil)
+
+	// the size larger than the quota is capped.
+	q.release(j2)
+	q.release(j3)
+	j4 := newJob(1000)
+	c.Assert(q.acquire(ctx, j4), IsNil)
+	c.Assert(j4.memSize, Equals, int64(100))
+	q.release(j4)
+}
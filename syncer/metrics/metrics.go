@@ -68,6 +68,23 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(0.000005, 2, 25),
 		}, []string{"task", "source_id"})
 
+	CompactDurationHistogram = metricsproxy.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "compact_duration",
+			Help:      "bucketed histogram of compact time (s) for single DML statement",
+			Buckets:   prometheus.ExponentialBuckets(0.000005, 2, 25),
+		}, []string{"task", "source_id"})
+
+	CompactedJobsCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "compacted_jobs_total",
+			Help:      "total number of DML jobs compacted into later jobs of the same row",
+		}, []string{"task", "source_id"})
+
 	AddJobDurationHistogram = metricsproxy.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "dm",
@@ -264,6 +281,30 @@ var (
 			Help:      "checkpoint flushed time interval in seconds",
 			Buckets:   prometheus.LinearBuckets(1, 50, 21), // linear from 1 to 1001, i think this is enough
 		}, []string{"worker", "task", "source_id"})
+
+	OversizedRowCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "oversized_row_total",
+			Help:      "total number of rows exceed max-row-size",
+		}, []string{"task", "source_id", "policy"})
+
+	FilledRowCounter = metricsproxy.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "filled_row_total",
+			Help:      "total number of rows whose columns not logged in binlog are filled",
+		}, []string{"task", "source_id", "via"})
+
+	SchemaDriftTableGauge = metricsproxy.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "dm",
+			Subsystem: "syncer",
+			Name:      "schema_drift_tables",
+			Help:      "number of downstream tables differ from the tracked table structures",
+		}, []string{"task", "source_id"})
 )
 
 // RegisterMetrics registers metrics.
@@ -273,6 +314,8 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(BinlogEventCost)
 	registry.MustRegister(BinlogEventRowHistogram)
 	registry.MustRegister(ConflictDetectDurationHistogram)
+	registry.MustRegister(CompactDurationHistogram)
+	registry.MustRegister(CompactedJobsCounter)
 	registry.MustRegister(AddJobDurationHistogram)
 	registry.MustRegister(DispatchBinlogDurationHistogram)
 	registry.MustRegister(SkipBinlogDurationHistogram)
@@ -296,6 +339,9 @@ func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(FinishedTransactionTotal)
 	registry.MustRegister(ReplicationTransactionBatch)
 	registry.MustRegister(FlushCheckPointsTimeInterval)
+	registry.MustRegister(OversizedRowCounter)
+	registry.MustRegister(FilledRowCounter)
+	registry.MustRegister(SchemaDriftTableGauge)
 }
 
 // RemoveLabelValuesWithTaskInMetrics cleans metrics.
@@ -305,6 +351,8 @@ func RemoveLabelValuesWithTaskInMetrics(task string) {
 	BinlogEventCost.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	BinlogEventRowHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	ConflictDetectDurationHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	CompactDurationHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	CompactedJobsCounter.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	AddJobDurationHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	DispatchBinlogDurationHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	SkipBinlogDurationHistogram.DeleteAllAboutLabels(prometheus.Labels{"task": task})
@@ -328,4 +376,7 @@ func RemoveLabelValuesWithTaskInMetrics(task string) {
 	FinishedTransactionTotal.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	ReplicationTransactionBatch.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 	FlushCheckPointsTimeInterval.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	OversizedRowCounter.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	FilledRowCounter.DeleteAllAboutLabels(prometheus.Labels{"task": task})
+	SchemaDriftTableGauge.DeleteAllAboutLabels(prometheus.Labels{"task": task})
 }
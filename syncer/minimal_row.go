@@ -0,0 +1,456 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/metrics"
+)
+
+// fillLookupBatch is the max number of rows looked up from the upstream in one query.
+const fillLookupBatch = 64
+
+// the ways to fill the missing columns, used as the label of metrics.
+const (
+	filledByBeforeImage = "before_image"
+	filledByLookup      = "lookup"
+	filledByDefault     = "not_found"
+)
+
+// checkLogColumns returns error when not all rows in skipped is empty, which means the binlog doesn't contain all
+// columns.
+func checkLogColumns(skipped [][]int) error {
+	for _, row := range skipped {
+		if len(row) > 0 {
+			return terror.ErrBinlogNotLogColumn
+		}
+	}
+	return nil
+}
+
+// missingColumnFiller fills the columns not logged in the row images when the upstream uses `binlog_row_image`
+// MINIMAL or NOBLOB, it's enabled by `fill-missing-columns`.
+//   - the before image of UPDATE and DELETE contains the primary key at least, which is enough to identify the row.
+//   - the missing columns of the after image of UPDATE are not changed, they're filled from the before image if logged.
+//   - the other missing columns are filled by looking up the rows by primary key from the upstream in a consistent
+//     snapshot, in batches and rate-limited by `fill-missing-columns-rate-limit`.
+// NOTE: the looked up values are the latest ones instead of the ones at the binlog location, the following row changes
+// of the same rows will override them so the downstream is eventually consistent. the old values of the missing
+// columns in the before image of UPDATE are unknown, they're filled with the new values.
+type missingColumnFiller struct {
+	cfg     *config.SubTaskConfig
+	limiter *rate.Limiter // nil means no limit
+	batch   int
+}
+
+// newMissingColumnFiller creates a new missingColumnFiller.
+func newMissingColumnFiller(cfg *config.SubTaskConfig) *missingColumnFiller {
+	f := &missingColumnFiller{
+		cfg:   cfg,
+		batch: fillLookupBatch,
+	}
+	if limit := cfg.FillMissingColumnsRateLimit; limit > 0 {
+		f.limiter = rate.NewLimiter(rate.Limit(limit), limit)
+		if limit < f.batch {
+			f.batch = limit
+		}
+	}
+	return f
+}
+
+// primaryKeyColumns returns the columns of the primary key, nil if the table has no primary key.
+func primaryKeyColumns(ti *model.TableInfo) []*model.ColumnInfo {
+	if ti.PKIsHandle {
+		if pk := ti.GetPkColInfo(); pk != nil {
+			return []*model.ColumnInfo{pk}
+		}
+	}
+	for _, idx := range ti.Indices {
+		if idx.Primary {
+			cols := make([]*model.ColumnInfo, 0, len(idx.Columns))
+			for _, col := range idx.Columns {
+				cols = append(cols, ti.Columns[col.Offset])
+			}
+			return cols
+		}
+	}
+	return nil
+}
+
+// hasUniqueKeyBesidesPrimary returns whether the table has a unique key other than the primary key.
+func hasUniqueKeyBesidesPrimary(ti *model.TableInfo) bool {
+	for _, idx := range ti.Indices {
+		if idx.Unique && !idx.Primary {
+			return true
+		}
+	}
+	return false
+}
+
+// primaryKeyLogged returns whether all columns of the primary key are logged.
+func primaryKeyLogged(pkCols []*model.ColumnInfo, missing map[int]struct{}) bool {
+	for _, col := range pkCols {
+		if _, ok := missing[col.Offset]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupKey returns the string of the primary key values to match the looked up rows, false if any of them is missing.
+func lookupKey(pkCols []*model.ColumnInfo, row []interface{}, missing map[int]struct{}) (string, bool) {
+	vals := make([]string, 0, len(pkCols))
+	for _, col := range pkCols {
+		if _, ok := missing[col.Offset]; ok {
+			return "", false
+		}
+		vals = append(vals, columnValue(row[col.Offset], &col.FieldType))
+	}
+	return strings.Join(vals, ","), true
+}
+
+// lookupRow is a row whose missing columns are filled by looking up from the upstream.
+type lookupRow struct {
+	row     []interface{}
+	missing []int
+	key     string
+	// the before image of UPDATE, its missing columns are filled with the new values after the row is filled.
+	before        []interface{}
+	beforeMissing []int
+}
+
+func (l *lookupRow) fillBefore() {
+	for _, idx := range l.beforeMissing {
+		l.before[idx] = l.row[idx]
+	}
+}
+
+// fill fills the missing columns of the rows in place, it returns terror.ErrBinlogNotLogColumn if the rows can't be
+// filled, like the primary key is not logged.
+func (f *missingColumnFiller) fill(
+	tctx *tcontext.Context,
+	db *conn.BaseDB,
+	sourceTable *filter.Table,
+	ti *model.TableInfo,
+	eventType replication.EventType,
+	rows [][]interface{},
+	skipped [][]int,
+) error {
+	if checkLogColumns(skipped) == nil {
+		return nil
+	}
+	if len(rows) != len(skipped) {
+		return terror.ErrBinlogNotLogColumn
+	}
+	for _, row := range rows {
+		if len(row) != len(ti.Columns) {
+			return terror.ErrSyncerUnitDMLColumnNotMatch.Generate(len(ti.Columns), len(row))
+		}
+	}
+	pkCols := primaryKeyColumns(ti)
+	if len(pkCols) == 0 {
+		return terror.ErrBinlogNotLogColumn
+	}
+
+	lookups := make([]*lookupRow, 0, len(rows))
+	switch eventType {
+	case replication.DELETE_ROWS_EVENTv0, replication.DELETE_ROWS_EVENTv1, replication.DELETE_ROWS_EVENTv2:
+		// the primary key is enough to delete the row.
+		for i := range rows {
+			if _, ok := lookupKey(pkCols, rows[i], toSet(skipped[i])); !ok {
+				return terror.ErrBinlogNotLogColumn
+			}
+		}
+		return nil
+	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+		filled := 0
+		for i := 0; i+1 < len(rows); i += 2 {
+			before, after := rows[i], rows[i+1]
+			beforeMissing := toSet(skipped[i])
+			if _, ok := lookupKey(pkCols, before, beforeMissing); !ok {
+				return terror.ErrBinlogNotLogColumn
+			}
+			var afterMissing []int
+			for _, idx := range skipped[i+1] {
+				// the columns not logged in the after image are not changed.
+				if _, ok := beforeMissing[idx]; !ok {
+					after[idx] = before[idx]
+					continue
+				}
+				afterMissing = append(afterMissing, idx)
+			}
+			l := &lookupRow{row: after, missing: afterMissing, before: before, beforeMissing: skipped[i]}
+			if len(afterMissing) == 0 {
+				if len(skipped[i]) > 0 || len(skipped[i+1]) > 0 {
+					filled++
+				}
+				l.fillBefore()
+				continue
+			}
+			// the primary key may be changed by the UPDATE, it's in the after image now.
+			key, ok := lookupKey(pkCols, after, toSet(afterMissing))
+			if !ok {
+				return terror.ErrBinlogNotLogColumn
+			}
+			l.key = key
+			lookups = append(lookups, l)
+		}
+		metrics.FilledRowCounter.WithLabelValues(f.cfg.Name, f.cfg.SourceID, filledByBeforeImage).Add(float64(filled))
+	default:
+		for i := range rows {
+			if len(skipped[i]) == 0 {
+				continue
+			}
+			key, ok := lookupKey(pkCols, rows[i], toSet(skipped[i]))
+			if !ok {
+				return terror.ErrBinlogNotLogColumn
+			}
+			lookups = append(lookups, &lookupRow{row: rows[i], missing: skipped[i], key: key})
+		}
+	}
+	if len(lookups) == 0 {
+		return nil
+	}
+	return f.lookup(tctx, db, sourceTable, ti, pkCols, lookups)
+}
+
+// prepareMinimalRows prepares the rows to build the DMLs from the logged columns for `minimal-row-image`, it returns
+// the offsets of the columns not logged in each row, nil if all rows are full.
+//   - the before image of UPDATE and DELETE should contain the primary key to find the row in downstream.
+//   - the columns not logged in the after image of UPDATE are not changed, they're filled from the before image if
+//     logged, the others are not set.
+//   - the columns not logged in INSERT are not inserted, they get the default values in downstream.
+func prepareMinimalRows(ti *model.TableInfo, eventType replication.EventType, rows [][]interface{}, skipped [][]int) ([]map[int]struct{}, error) {
+	if checkLogColumns(skipped) == nil {
+		return nil, nil
+	}
+	if len(rows) != len(skipped) {
+		return nil, terror.ErrBinlogNotLogColumn
+	}
+	for _, row := range rows {
+		if len(row) != len(ti.Columns) {
+			return nil, terror.ErrSyncerUnitDMLColumnNotMatch.Generate(len(ti.Columns), len(row))
+		}
+	}
+	pkCols := primaryKeyColumns(ti)
+	if len(pkCols) == 0 {
+		return nil, terror.ErrBinlogNotLogColumn
+	}
+
+	notLogged := make([]map[int]struct{}, len(rows))
+	switch eventType {
+	case replication.DELETE_ROWS_EVENTv0, replication.DELETE_ROWS_EVENTv1, replication.DELETE_ROWS_EVENTv2:
+		for i := range rows {
+			if !primaryKeyLogged(pkCols, toSet(skipped[i])) {
+				return nil, terror.ErrBinlogNotLogColumn
+			}
+		}
+	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+		for i := 0; i+1 < len(rows); i += 2 {
+			before, after := rows[i], rows[i+1]
+			beforeMissing := toSet(skipped[i])
+			if !primaryKeyLogged(pkCols, beforeMissing) {
+				return nil, terror.ErrBinlogNotLogColumn
+			}
+			var afterMissing []int
+			for _, idx := range skipped[i+1] {
+				if _, ok := beforeMissing[idx]; !ok {
+					after[idx] = before[idx]
+					continue
+				}
+				afterMissing = append(afterMissing, idx)
+			}
+			if len(afterMissing) > 0 {
+				notLogged[i+1] = toSet(afterMissing)
+			}
+		}
+	default:
+		for i := range rows {
+			if len(skipped[i]) > 0 {
+				notLogged[i] = toSet(skipped[i])
+			}
+		}
+	}
+	return notLogged, nil
+}
+
+// lookup looks up the rows by primary key from the upstream in a consistent snapshot, and fills the missing columns.
+// the missing columns of the rows not found are filled with the default values, the later row changes of them
+// should be replicated too.
+func (f *missingColumnFiller) lookup(
+	tctx *tcontext.Context,
+	db *conn.BaseDB,
+	sourceTable *filter.Table,
+	ti *model.TableInfo,
+	pkCols []*model.ColumnInfo,
+	lookups []*lookupRow,
+) error {
+	tx, err := db.DB.BeginTx(tctx.Ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	found := make(map[string][]interface{}, len(lookups))
+	for begin := 0; begin < len(lookups); begin += f.batch {
+		end := begin + f.batch
+		if end > len(lookups) {
+			end = len(lookups)
+		}
+		if f.limiter != nil {
+			if err = f.limiter.WaitN(tctx.Ctx, end-begin); err != nil {
+				return err
+			}
+		}
+		query, args := genLookupSQL(sourceTable, ti, pkCols, lookups[begin:end])
+		if err = f.queryRows(tctx, tx, query, args, ti, pkCols, found); err != nil {
+			return err
+		}
+	}
+
+	notFound := 0
+	for _, l := range lookups {
+		values, ok := found[l.key]
+		if !ok {
+			notFound++
+		}
+		for _, idx := range l.missing {
+			l.row[idx] = lookupValue(values, ok, ti.Columns[idx])
+		}
+		l.fillBefore()
+	}
+	if notFound > 0 {
+		tctx.L().Warn("rows not found in upstream when filling missing columns, fill them with default values",
+			zap.Stringer("table", sourceTable), zap.Int("count", notFound))
+	}
+	metrics.FilledRowCounter.WithLabelValues(f.cfg.Name, f.cfg.SourceID, filledByLookup).Add(float64(len(lookups) - notFound))
+	metrics.FilledRowCounter.WithLabelValues(f.cfg.Name, f.cfg.SourceID, filledByDefault).Add(float64(notFound))
+	return nil
+}
+
+func (f *missingColumnFiller) queryRows(
+	tctx *tcontext.Context,
+	tx *sql.Tx,
+	query string,
+	args []interface{},
+	ti *model.TableInfo,
+	pkCols []*model.ColumnInfo,
+	found map[string][]interface{},
+) error {
+	rows, err := tx.QueryContext(tctx.Ctx, query, args...)
+	if err != nil {
+		return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	defer rows.Close()
+
+	raw := make([]sql.RawBytes, len(ti.Columns))
+	dest := make([]interface{}, len(ti.Columns))
+	for i := range raw {
+		dest[i] = &raw[i]
+	}
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+		}
+		values := make([]interface{}, len(ti.Columns))
+		for i, col := range ti.Columns {
+			if values[i], err = convertLookupValue(raw[i], col); err != nil {
+				return terror.ErrDBUnExpect.Delegate(err, "convert the value of column %s", col.Name.O)
+			}
+		}
+		key, _ := lookupKey(pkCols, values, nil)
+		found[key] = values
+	}
+	return terror.WithScope(terror.DBErrorAdapt(rows.Err(), terror.ErrDBDriverError), terror.ScopeUpstream)
+}
+
+// genLookupSQL generates the query to look up the rows by primary key, like
+// SELECT `a`,`b`,`c` FROM `db`.`tb` WHERE (`a`) IN ((?),(?)).
+func genLookupSQL(sourceTable *filter.Table, ti *model.TableInfo, pkCols []*model.ColumnInfo, lookups []*lookupRow) (string, []interface{}) {
+	columns := make([]string, 0, len(ti.Columns))
+	for _, col := range ti.Columns {
+		columns = append(columns, dbutil.ColumnName(col.Name.O))
+	}
+	pkNames := make([]string, 0, len(pkCols))
+	for _, col := range pkCols {
+		pkNames = append(pkNames, dbutil.ColumnName(col.Name.O))
+	}
+	holder := "(" + strings.TrimSuffix(strings.Repeat("?,", len(pkCols)), ",") + ")"
+	holders := make([]string, 0, len(lookups))
+	args := make([]interface{}, 0, len(lookups)*len(pkCols))
+	for _, l := range lookups {
+		holders = append(holders, holder)
+		for _, col := range pkCols {
+			args = append(args, castUnsigned(l.row[col.Offset], &col.FieldType))
+		}
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE (%s) IN (%s)",
+		strings.Join(columns, ","), dbutil.TableName(sourceTable.Schema, sourceTable.Name),
+		strings.Join(pkNames, ","), strings.Join(holders, ","))
+	return query, args
+}
+
+// lookupValue returns the looked up value of the column, or the default value if the row is not found.
+func lookupValue(values []interface{}, found bool, col *model.ColumnInfo) interface{} {
+	if found {
+		return values[col.Offset]
+	}
+	return col.GetDefaultValue()
+}
+
+// convertLookupValue converts the value read from the upstream to the type decoded from binlog.
+func convertLookupValue(raw sql.RawBytes, col *model.ColumnInfo) (interface{}, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	switch col.Tp {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeYear:
+		if mysql.HasUnsignedFlag(col.Flag) {
+			return strconv.ParseUint(string(raw), 10, 64)
+		}
+		return strconv.ParseInt(string(raw), 10, 64)
+	case mysql.TypeFloat, mysql.TypeDouble:
+		return strconv.ParseFloat(string(raw), 64)
+	}
+	if col.Charset == "binary" || col.Collate == "binary" || col.Tp == mysql.TypeBit {
+		return append([]byte(nil), raw...), nil
+	}
+	return string(raw), nil
+}
+
+func toSet(idxs []int) map[int]struct{} {
+	set := make(map[int]struct{}, len(idxs))
+	for _, idx := range idxs {
+		set[idx] = struct{}{}
+	}
+	return set
+}
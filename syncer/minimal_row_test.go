@@ -0,0 +1,155 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+func (s *testSyncerSuite) TestFillMissingColumns(c *C) {
+	tctx := tcontext.Background()
+	sourceTable := &filter.Table{Schema: "db", Name: "tb"}
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 1,
+		"create table tb(id int primary key, a varchar(10), b int not null default 3, c blob)")
+	c.Assert(err, IsNil)
+	noPK, err := createTableInfo(parser.New(), mock.NewContext(), 2, "create table tb(id int, a varchar(10))")
+	c.Assert(err, IsNil)
+
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	baseDB := conn.NewBaseDB(db, func() {})
+	f := newMissingColumnFiller(&config.SubTaskConfig{Name: "test", SourceID: "mysql-replica-01"})
+	c.Assert(f.limiter, IsNil)
+	c.Assert(f.batch, Equals, fillLookupBatch)
+	querySQL := regexp.QuoteMeta("SELECT `id`,`a`,`b`,`c` FROM `db`.`tb` WHERE (`id`) IN ")
+
+	// all columns are logged.
+	rows := [][]interface{}{{int32(1), "a", int32(2), []byte("c")}}
+	c.Assert(f.fill(tctx, baseDB, sourceTable, ti, replication.WRITE_ROWS_EVENTv2, rows, [][]int{{}}), IsNil)
+
+	// the primary key is enough for DELETE.
+	rows = [][]interface{}{{int32(1), nil, nil, nil}}
+	c.Assert(f.fill(tctx, baseDB, sourceTable, ti, replication.DELETE_ROWS_EVENTv2, rows, [][]int{{1, 2, 3}}), IsNil)
+	c.Assert(f.fill(tctx, baseDB, sourceTable, ti, replication.DELETE_ROWS_EVENTv2, rows, [][]int{{0, 1}}),
+		ErrorMatches, ".*"+terror.ErrBinlogNotLogColumn.Message()+".*")
+	// no primary key to look up.
+	rows = [][]interface{}{{nil, "a"}}
+	c.Assert(f.fill(tctx, baseDB, sourceTable, noPK, replication.WRITE_ROWS_EVENTv2, rows, [][]int{{0}}),
+		ErrorMatches, ".*"+terror.ErrBinlogNotLogColumn.Message()+".*")
+
+	// UPDATE: the first row is looked up, the second row is filled by the before image.
+	rows = [][]interface{}{
+		{int32(1), nil, nil, nil}, {nil, "x", nil, nil},
+		{int32(2), "p", int32(5), []byte("z")}, {nil, "y", nil, nil},
+	}
+	skipped := [][]int{{1, 2, 3}, {0, 2, 3}, {}, {0, 2, 3}}
+	dbMock.ExpectBegin()
+	dbMock.ExpectQuery(querySQL + regexp.QuoteMeta("((?))")).WithArgs(int32(1)).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "a", "b", "c"}).AddRow("1", "x", "7", []byte("blob")))
+	dbMock.ExpectRollback()
+	c.Assert(f.fill(tctx, baseDB, sourceTable, ti, replication.UPDATE_ROWS_EVENTv2, rows, skipped), IsNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	c.Assert(rows, DeepEquals, [][]interface{}{
+		{int32(1), "x", int64(7), []byte("blob")}, {int32(1), "x", int64(7), []byte("blob")},
+		{int32(2), "p", int32(5), []byte("z")}, {int32(2), "y", int32(5), []byte("z")},
+	})
+
+	// INSERT: looked up one by one with the rate limit, the row not found is filled with the default values.
+	f = newMissingColumnFiller(&config.SubTaskConfig{
+		Name: "test", SourceID: "mysql-replica-01",
+		SyncerConfig: config.SyncerConfig{FillMissingColumnsRateLimit: 1},
+	})
+	c.Assert(f.limiter, NotNil)
+	c.Assert(f.batch, Equals, 1)
+	rows = [][]interface{}{{int32(1), "a", nil, nil}, {int32(2), "b", nil, nil}}
+	dbMock.ExpectBegin()
+	dbMock.ExpectQuery(querySQL + regexp.QuoteMeta("((?))")).WithArgs(int32(1)).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "a", "b", "c"}).AddRow("1", "a", "3", nil))
+	dbMock.ExpectQuery(querySQL + regexp.QuoteMeta("((?))")).WithArgs(int32(2)).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "a", "b", "c"}))
+	dbMock.ExpectRollback()
+	c.Assert(f.fill(tctx, baseDB, sourceTable, ti, replication.WRITE_ROWS_EVENTv2, rows, [][]int{{2, 3}, {2, 3}}), IsNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	c.Assert(rows, DeepEquals, [][]interface{}{{int32(1), "a", int64(3), nil}, {int32(2), "b", "3", nil}})
+}
+
+func (s *testSyncerSuite) TestMinimalRowImage(c *C) {
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 1,
+		"create table tb(id int primary key, a varchar(10), b int not null default 3, c blob)")
+	c.Assert(err, IsNil)
+	noPK, err := createTableInfo(parser.New(), mock.NewContext(), 2, "create table tb(id int, a varchar(10))")
+	c.Assert(err, IsNil)
+	withUK, err := createTableInfo(parser.New(), mock.NewContext(), 3, "create table tb(id int primary key, a int unique)")
+	c.Assert(err, IsNil)
+	errMsg := ".*" + terror.ErrBinlogNotLogColumn.Message() + ".*"
+
+	// all columns are logged.
+	rows := [][]interface{}{{int32(1), "a", int32(2), []byte("c")}}
+	notLogged, err := prepareMinimalRows(ti, replication.WRITE_ROWS_EVENTv2, rows, [][]int{{}})
+	c.Assert(err, IsNil)
+	c.Assert(notLogged, IsNil)
+
+	// the primary key should be logged in the before image.
+	rows = [][]interface{}{{int32(1), nil, nil, nil}}
+	notLogged, err = prepareMinimalRows(ti, replication.DELETE_ROWS_EVENTv2, rows, [][]int{{1, 2, 3}})
+	c.Assert(err, IsNil)
+	c.Assert(notLogged, DeepEquals, []map[int]struct{}{nil})
+	_, err = prepareMinimalRows(ti, replication.DELETE_ROWS_EVENTv2, rows, [][]int{{0, 1}})
+	c.Assert(err, ErrorMatches, errMsg)
+	_, err = prepareMinimalRows(noPK, replication.WRITE_ROWS_EVENTv2, [][]interface{}{{int32(1), nil}}, [][]int{{1}})
+	c.Assert(err, ErrorMatches, errMsg)
+
+	// UPDATE: the primary key is filled from the before image, the other columns are not set.
+	rows = [][]interface{}{{int32(1), nil, nil, nil}, {nil, "x", nil, nil}}
+	notLogged, err = prepareMinimalRows(ti, replication.UPDATE_ROWS_EVENTv2, rows, [][]int{{1, 2, 3}, {0, 2, 3}})
+	c.Assert(err, IsNil)
+	c.Assert(rows[1], DeepEquals, []interface{}{int32(1), "x", nil, nil})
+	c.Assert(notLogged, DeepEquals, []map[int]struct{}{nil, {2: {}, 3: {}}})
+
+	param := &genDMLParam{minimalRowImage: true, notLogged: notLogged}
+	dml := newDML(update, true, "`db`.`tb`", &filter.Table{Schema: "db", Name: "tb"}, rows[0], rows[1], rows[0], rows[1], ti.Columns, ti)
+	dml = dml.withRowImage(param, 1)
+	sqls, args := dml.genSQL()
+	c.Assert(sqls, DeepEquals, []string{"UPDATE `db`.`tb` SET `id` = ?, `a` = ? WHERE `id` = ? LIMIT 1"})
+	c.Assert(args, DeepEquals, [][]interface{}{{int32(1), "x", int32(1)}})
+
+	// INSERT: the columns not logged are not inserted.
+	rows = [][]interface{}{{int32(2), "y", nil, nil}}
+	notLogged, err = prepareMinimalRows(ti, replication.WRITE_ROWS_EVENTv2, rows, [][]int{{2, 3}})
+	c.Assert(err, IsNil)
+	param.notLogged = notLogged
+	dml = newDML(insert, true, "`db`.`tb`", &filter.Table{Schema: "db", Name: "tb"}, nil, rows[0], nil, rows[0], ti.Columns, ti)
+	dml = dml.withRowImage(param, 0)
+	sqls, args = dml.genSQL()
+	c.Assert(sqls, DeepEquals, []string{"INSERT INTO `db`.`tb` (`id`,`a`) VALUES (?,?) ON DUPLICATE KEY UPDATE `id`=VALUES(`id`),`a`=VALUES(`a`)"})
+	c.Assert(args, DeepEquals, [][]interface{}{{int32(2), "y"}})
+	c.Assert(dml.identifyKeys(), DeepEquals, []string{"2.id.`db`.`tb`"})
+
+	// the rows of the tables with the other unique keys are executed in order.
+	dml = newDML(del, false, "`db`.`tb`", &filter.Table{Schema: "db", Name: "tb"}, nil, []interface{}{int32(1), nil}, nil, []interface{}{int32(1), nil}, withUK.Columns, withUK)
+	dml = dml.withRowImage(param, 0)
+	c.Assert(dml.identifyKeys(), DeepEquals, []string{"1.id.`db`.`tb`", "`db`.`tb`"})
+}
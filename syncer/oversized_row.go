@@ -0,0 +1,280 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/cputil"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+	"github.com/pingcap/dm/syncer/metrics"
+)
+
+// oversizedRowMarker is appended to the column values truncated by `oversized-row-policy: truncate`.
+const oversizedRowMarker = "...[truncated by DM]"
+
+// valueSize estimates the size in bytes of a column value.
+func valueSize(value interface{}) int {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case string:
+		return len(v)
+	case []byte:
+		return len(v)
+	default:
+		return 8
+	}
+}
+
+// rowSize estimates the size in bytes of a row.
+func rowSize(values []interface{}) int {
+	size := 0
+	for _, v := range values {
+		size += valueSize(v)
+	}
+	return size
+}
+
+// truncatableColumn returns whether the column value could be truncated.
+// columns in any index are never truncated because they may be used to identify the row.
+func truncatableColumn(col *model.ColumnInfo) bool {
+	if mysql.HasPriKeyFlag(col.Flag) || mysql.HasUniKeyFlag(col.Flag) || mysql.HasMultipleKeyFlag(col.Flag) {
+		return false
+	}
+	return types.IsTypeBlob(col.Tp) || types.IsTypeChar(col.Tp)
+}
+
+// truncateRow truncates the largest string or blob columns of a row until its size is not larger than maxSize.
+// it returns the truncated row (a copy of values) and whether the truncated row fits maxSize.
+func truncateRow(values []interface{}, columns []*model.ColumnInfo, maxSize int) ([]interface{}, bool) {
+	truncated := make([]interface{}, len(values))
+	copy(truncated, values)
+
+	for size := rowSize(truncated); size > maxSize; size = rowSize(truncated) {
+		idx, length := -1, 0
+		for i, v := range truncated {
+			if i >= len(columns) || !truncatableColumn(columns[i]) {
+				continue
+			}
+			if l := valueSize(v); l > length {
+				idx, length = i, l
+			}
+		}
+		if idx < 0 || length <= len(oversizedRowMarker) {
+			return truncated, false
+		}
+
+		keep := length - (size - maxSize) - len(oversizedRowMarker)
+		if keep < 0 {
+			keep = 0
+		}
+		switch v := truncated[idx].(type) {
+		case string:
+			// don't break a multi-byte character.
+			for keep > 0 && !utf8.RuneStart(v[keep]) {
+				keep--
+			}
+			truncated[idx] = v[:keep] + oversizedRowMarker
+		case []byte:
+			b := make([]byte, 0, keep+len(oversizedRowMarker))
+			b = append(b, v[:keep]...)
+			truncated[idx] = append(b, oversizedRowMarker...)
+		}
+	}
+	return truncated, true
+}
+
+// handleOversizedDMLs checks the size of DMLs against `max-row-size`, and handles the oversized ones
+// according to `oversized-row-policy`. It returns the DMLs which should still be replicated to downstream.
+func (s *Syncer) handleOversizedDMLs(ec *eventContext, dmls []*DML) ([]*DML, error) {
+	if s.cfg.MaxRowSize <= 0 {
+		return dmls, nil
+	}
+
+	kept := dmls[:0]
+	for _, dml := range dmls {
+		// DELETE only uses the identify columns of the row.
+		if dml.op == del {
+			kept = append(kept, dml)
+			continue
+		}
+		size := rowSize(dml.values)
+		if size <= s.cfg.MaxRowSize {
+			kept = append(kept, dml)
+			continue
+		}
+
+		policy := s.cfg.OversizedRowPolicy
+		if policy == "" {
+			policy = config.OversizedRowFail
+		}
+		metrics.OversizedRowCounter.WithLabelValues(s.cfg.Name, s.cfg.SourceID, policy).Inc()
+
+		switch policy {
+		case config.OversizedRowTruncate:
+			// the later UPDATE and DELETE of the row are matched by all the column values if the table has no key,
+			// which never match the truncated row in downstream.
+			if findFitIndex(dml.sourceTableInfo) == nil {
+				return nil, terror.ErrSyncerTruncateRowWithoutKey.Generate(dml.targetTableID)
+			}
+			values, ok := truncateRow(dml.values, dml.columns, s.cfg.MaxRowSize)
+			if !ok {
+				return nil, terror.ErrSyncerOversizedRow.Generate(dml.targetTableID, size, s.cfg.MaxRowSize)
+			}
+			ec.tctx.L().Warn("truncate oversized row",
+				zap.String("table", dml.targetTableID),
+				zap.Int("row size", size),
+				zap.Int("max row size", s.cfg.MaxRowSize),
+				zap.Stringer("location", ec.currentLocation))
+			dml.values = values
+			kept = append(kept, dml)
+		case config.OversizedRowSideTable:
+			if err := s.oversizedRowRecorder.record(ec.tctx, dml, size, *ec.currentLocation); err != nil {
+				return nil, err
+			}
+			ec.tctx.L().Warn("route oversized row to side table",
+				zap.String("table", dml.targetTableID),
+				zap.String("side table", s.oversizedRowRecorder.tableName),
+				zap.Int("row size", size),
+				zap.Int("max row size", s.cfg.MaxRowSize),
+				zap.Stringer("location", ec.currentLocation))
+		default:
+			return nil, terror.ErrSyncerOversizedRow.Generate(dml.targetTableID, size, s.cfg.MaxRowSize)
+		}
+	}
+	return kept, nil
+}
+
+// oversizedRowRecorder records the rows exceed `max-row-size` into a side table in the downstream meta schema,
+// so users could fix them manually later.
+type oversizedRowRecorder struct {
+	cfg       *config.SubTaskConfig
+	tableName string // qualified table name: `dm_meta`.`task_syncer_oversized_row`
+
+	db     *conn.BaseDB
+	dbConn *dbconn.DBConn
+	logCtx *tcontext.Context
+}
+
+// newOversizedRowRecorder creates a new oversizedRowRecorder.
+func newOversizedRowRecorder(tctx *tcontext.Context, cfg *config.SubTaskConfig) *oversizedRowRecorder {
+	return &oversizedRowRecorder{
+		cfg:       cfg,
+		tableName: dbutil.TableName(cfg.MetaSchema, cputil.SyncerOversizedRow(cfg.Name)),
+		logCtx:    tcontext.Background().WithLogger(tctx.L().WithFields(zap.String("component", "oversized row recorder"))),
+	}
+}
+
+// init creates the connection and the side table.
+func (r *oversizedRowRecorder) init(tctx *tcontext.Context) error {
+	recorderDB := r.cfg.To
+	recorderDB.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxCheckPointTimeout)
+	db, dbConns, err := dbconn.CreateConns(tctx, r.cfg, recorderDB, 1)
+	if err != nil {
+		return err
+	}
+	r.db = db
+	r.dbConn = dbConns[0]
+
+	sqls := []string{
+		fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(r.cfg.MetaSchema)),
+		`CREATE TABLE IF NOT EXISTS ` + r.tableName + ` (
+			id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			source_id VARCHAR(32) NOT NULL,
+			target_table VARCHAR(300) NOT NULL,
+			op VARCHAR(16) NOT NULL,
+			binlog_name VARCHAR(128),
+			binlog_pos INT UNSIGNED,
+			binlog_gtid TEXT,
+			row_size BIGINT NOT NULL,
+			row_key JSON NOT NULL,
+			old_row JSON,
+			new_row JSON,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+	}
+	_, err = r.dbConn.ExecuteSQL(tctx, sqls)
+	r.logCtx.L().Info("create oversized row table", zap.Strings("statements", sqls))
+	return terror.WithScope(err, terror.ScopeDownstream)
+}
+
+// record writes the identify columns, the row values and the location of an oversized row into the side table.
+// old_row is the row before UPDATE, new_row is the row after INSERT or UPDATE.
+func (r *oversizedRowRecorder) record(tctx *tcontext.Context, dml *DML, size int, location binlog.Location) error {
+	columns, values := dml.whereColumnsAndValues()
+	key := make(map[string]interface{}, len(columns))
+	for i, col := range columns {
+		if b, ok := values[i].([]byte); ok {
+			key[col] = string(b)
+		} else {
+			key[col] = values[i]
+		}
+	}
+	keyJSON, err := json.Marshal(key)
+	if err != nil {
+		return terror.ErrSyncerOversizedRow.Delegate(err, dml.targetTableID, size, r.cfg.MaxRowSize)
+	}
+	var oldRow, newRow interface{} // NULL by default.
+	if dml.op == update {
+		if oldRow, err = rowJSON(dml.columns, dml.oldValues); err != nil {
+			return terror.ErrSyncerOversizedRow.Delegate(err, dml.targetTableID, size, r.cfg.MaxRowSize)
+		}
+	}
+	if newRow, err = rowJSON(dml.columns, dml.values); err != nil {
+		return terror.ErrSyncerOversizedRow.Delegate(err, dml.targetTableID, size, r.cfg.MaxRowSize)
+	}
+
+	query := `INSERT INTO ` + r.tableName + ` (source_id, target_table, op, binlog_name, binlog_pos, binlog_gtid, row_size, row_key, old_row, new_row) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	args := []interface{}{r.cfg.SourceID, dml.targetTableID, dml.op.String(), location.Position.Name, location.Position.Pos, location.GTIDSetStr(), size, string(keyJSON), oldRow, newRow}
+	_, err = r.dbConn.ExecuteSQL(tctx, []string{query}, args)
+	return terror.WithScope(err, terror.ScopeDownstream)
+}
+
+// rowJSON encodes the values of a row into a JSON object of column name -> value. the values of binary columns are
+// encoded in base64, so they could be restored without loss.
+func rowJSON(columns []*model.ColumnInfo, values []interface{}) (string, error) {
+	row := make(map[string]interface{}, len(values))
+	for i, v := range values {
+		if i >= len(columns) {
+			break
+		}
+		col := columns[i]
+		if b, ok := v.([]byte); ok && col.Charset != charset.CharsetBin {
+			v = string(b)
+		}
+		row[col.Name.O] = v
+	}
+	data, err := json.Marshal(row)
+	return string(data), err
+}
+
+// close closes the connection.
+func (r *oversizedRowRecorder) close() {
+	dbconn.CloseBaseDB(r.logCtx, r.db)
+}
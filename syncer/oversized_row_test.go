@@ -0,0 +1,161 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/retry"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+func newOversizedRowTestColumns() []*model.ColumnInfo {
+	id := &model.ColumnInfo{Name: model.NewCIStr("id"), FieldType: *types.NewFieldType(mysql.TypeLong)}
+	id.Flag |= mysql.PriKeyFlag
+	name := &model.ColumnInfo{Name: model.NewCIStr("name"), FieldType: *types.NewFieldType(mysql.TypeVarchar)}
+	content := &model.ColumnInfo{Name: model.NewCIStr("content"), FieldType: *types.NewFieldType(mysql.TypeBlob)}
+	return []*model.ColumnInfo{id, name, content}
+}
+
+func (s *testSyncerSuite) TestTruncateRow(c *C) {
+	columns := newOversizedRowTestColumns()
+
+	values := []interface{}{int32(1), "name", strings.Repeat("中", 100)}
+	c.Assert(rowSize(values), Equals, 8+4+300)
+
+	// fits, nothing changed.
+	truncated, ok := truncateRow(values, columns, 1000)
+	c.Assert(ok, IsTrue)
+	c.Assert(truncated, DeepEquals, values)
+
+	// the largest column is truncated without breaking a character.
+	truncated, ok = truncateRow(values, columns, 100)
+	c.Assert(ok, IsTrue)
+	c.Assert(rowSize(truncated), LessEqual, 100)
+	content := truncated[2].(string)
+	c.Assert(strings.HasSuffix(content, oversizedRowMarker), IsTrue)
+	c.Assert(strings.Trim(strings.TrimSuffix(content, oversizedRowMarker), "中"), Equals, "")
+	// the original row is not modified.
+	c.Assert(values[2], Equals, strings.Repeat("中", 100))
+
+	// blob values.
+	values = []interface{}{int32(1), "name", []byte(strings.Repeat("a", 200))}
+	truncated, ok = truncateRow(values, columns, 100)
+	c.Assert(ok, IsTrue)
+	c.Assert(rowSize(truncated), Equals, 100)
+	c.Assert(string(truncated[2].([]byte)), Equals, strings.Repeat("a", 100-8-4-len(oversizedRowMarker))+oversizedRowMarker)
+
+	// primary key column is never truncated.
+	columns[1].Flag |= mysql.PriKeyFlag
+	values = []interface{}{int32(1), strings.Repeat("a", 200), nil}
+	_, ok = truncateRow(values, columns, 100)
+	c.Assert(ok, IsFalse)
+}
+
+func (s *testSyncerSuite) TestHandleOversizedDMLs(c *C) {
+	columns := newOversizedRowTestColumns()
+	ti := &model.TableInfo{Columns: columns}
+	location := binlog.NewLocation("")
+	ec := &eventContext{tctx: tcontext.Background(), currentLocation: &location}
+	newDMLs := func() []*DML {
+		small := []interface{}{int32(1), "name", "content"}
+		large := []interface{}{int32(2), "name", strings.Repeat("a", 200)}
+		return []*DML{
+			newDML(insert, false, "`db`.`tb`", nil, nil, small, nil, small, columns, ti),
+			newDML(insert, false, "`db`.`tb`", nil, nil, large, nil, large, columns, ti),
+			newDML(del, false, "`db`.`tb`", nil, nil, large, nil, large, columns, ti),
+		}
+	}
+
+	syncer := &Syncer{cfg: &config.SubTaskConfig{Name: "test"}}
+	// no limit.
+	dmls, err := syncer.handleOversizedDMLs(ec, newDMLs())
+	c.Assert(err, IsNil)
+	c.Assert(dmls, HasLen, 3)
+
+	// fail by default.
+	syncer.cfg.MaxRowSize = 100
+	_, err = syncer.handleOversizedDMLs(ec, newDMLs())
+	c.Assert(terror.ErrSyncerOversizedRow.Equal(err), IsTrue)
+
+	// truncate.
+	syncer.cfg.OversizedRowPolicy = config.OversizedRowTruncate
+	dmls, err = syncer.handleOversizedDMLs(ec, newDMLs())
+	c.Assert(err, IsNil)
+	c.Assert(dmls, HasLen, 3)
+	c.Assert(rowSize(dmls[1].values), LessEqual, 100)
+	c.Assert(dmls[1].originValues[2], Equals, strings.Repeat("a", 200))
+	c.Assert(dmls[2].values[2], Equals, strings.Repeat("a", 200))
+
+	// the row of the table without key can't be truncated.
+	columns[0].Flag &^= mysql.PriKeyFlag
+	_, err = syncer.handleOversizedDMLs(ec, newDMLs())
+	c.Assert(terror.ErrSyncerTruncateRowWithoutKey.Equal(err), IsTrue)
+}
+
+func (s *testSyncerSuite) TestOversizedRowRecorder(c *C) {
+	columns := newOversizedRowTestColumns()
+	columns[2].Charset = charset.CharsetBin
+	ti := &model.TableInfo{Columns: columns}
+
+	tctx := tcontext.Background()
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "mysql-replica-01", MetaSchema: "dm_meta"}
+	cfg.MaxRowSize = 10
+	recorder := newOversizedRowRecorder(tctx, cfg)
+	c.Assert(recorder.tableName, Equals, "`dm_meta`.`test_syncer_oversized_row`")
+
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(tctx.Context())
+	c.Assert(err, IsNil)
+	recorder.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+
+	var (
+		location = binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 100}, nil)
+		query    = regexp.QuoteMeta("INSERT INTO `dm_meta`.`test_syncer_oversized_row` (source_id, target_table, op, binlog_name, binlog_pos, binlog_gtid, row_size, row_key, old_row, new_row)")
+		oldRow   = []interface{}{int32(1), []byte("old"), []byte{0xff, 0x00}}
+		newRow   = []interface{}{int32(2), []byte("new"), []byte("content")}
+	)
+	// the values of the binary columns are encoded in base64.
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec(query).WithArgs("mysql-replica-01", "`db`.`tb`", "insert", "mysql-bin.000001", 100, "", 20,
+		`{"id":2}`, nil, `{"content":"Y29udGVudA==","id":2,"name":"new"}`).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+	dml := newDML(insert, false, "`db`.`tb`", nil, nil, newRow, nil, newRow, columns, ti)
+	c.Assert(recorder.record(tctx, dml, 20, location), IsNil)
+
+	// both the old and new rows are recorded for UPDATE.
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec(query).WithArgs("mysql-replica-01", "`db`.`tb`", "update", "mysql-bin.000001", 100, "", 20,
+		`{"id":1}`, `{"content":"/wA=","id":1,"name":"old"}`, `{"content":"Y29udGVudA==","id":2,"name":"new"}`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+	dml = newDML(update, false, "`db`.`tb`", nil, oldRow, newRow, oldRow, newRow, columns, ti)
+	c.Assert(recorder.record(tctx, dml, 20, location), IsNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+}
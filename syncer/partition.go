@@ -0,0 +1,365 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/opcode"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// partitionLocator locates the partition of a row for a downstream table partitioned by RANGE or LIST on a single
+// integer column, the partition expression is not evaluated, so only the column itself is supported.
+type partitionLocator struct {
+	table  string // target table ID, used in errors
+	tp     model.PartitionType
+	column string // lower case name of the partition column
+	names  []string
+
+	// RANGE, the exclusive upper bound of every partition, nil for MAXVALUE.
+	lessThan []*int64
+	// LIST, the values of every partition.
+	inValues   []map[int64]struct{}
+	nullIdx    int // LIST, the partition containing NULL, -1 if not exists
+	defaultIdx int // LIST, the DEFAULT partition, -1 if not exists
+}
+
+func newPartitionLocator(table string, stmt *ast.CreateTableStmt) (*partitionLocator, error) {
+	opts := stmt.Partition
+	if opts == nil {
+		return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "table is not partitioned")
+	}
+	l := &partitionLocator{table: table, tp: opts.Tp, nullIdx: -1, defaultIdx: -1}
+	if opts.Tp != model.PartitionTypeRange && opts.Tp != model.PartitionTypeList {
+		return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "partition type "+opts.Tp.String()+" not supported")
+	}
+	switch {
+	case len(opts.ColumnNames) == 1:
+		l.column = opts.ColumnNames[0].Name.L
+	case len(opts.ColumnNames) == 0 && opts.Expr != nil:
+		col, ok := opts.Expr.(*ast.ColumnNameExpr)
+		if !ok {
+			return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "partition expression is not a column")
+		}
+		l.column = col.Name.Name.L
+	default:
+		return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "partitioned by multiple columns")
+	}
+
+	for i, def := range opts.Definitions {
+		l.names = append(l.names, def.Name.O)
+		switch clause := def.Clause.(type) {
+		case *ast.PartitionDefinitionClauseLessThan:
+			if len(clause.Exprs) != 1 {
+				return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "partition "+def.Name.O+" has multiple bounds")
+			}
+			if _, ok := clause.Exprs[0].(*ast.MaxValueExpr); ok {
+				l.lessThan = append(l.lessThan, nil)
+				continue
+			}
+			bound, isNull, err := partitionBoundValue(clause.Exprs[0])
+			if err != nil || isNull {
+				return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "invalid bound of partition "+def.Name.O)
+			}
+			l.lessThan = append(l.lessThan, &bound)
+		case *ast.PartitionDefinitionClauseIn:
+			values := make(map[int64]struct{}, len(clause.Values))
+			if len(clause.Values) == 0 {
+				l.defaultIdx = i
+			}
+			for _, exprs := range clause.Values {
+				if len(exprs) != 1 {
+					return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "partition "+def.Name.O+" has multiple columns values")
+				}
+				if _, ok := exprs[0].(*ast.DefaultExpr); ok {
+					l.defaultIdx = i
+					continue
+				}
+				v, isNull, err := partitionBoundValue(exprs[0])
+				if err != nil {
+					return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "invalid values of partition "+def.Name.O)
+				}
+				if isNull {
+					l.nullIdx = i
+					continue
+				}
+				values[v] = struct{}{}
+			}
+			l.inValues = append(l.inValues, values)
+		default:
+			return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "invalid definition of partition "+def.Name.O)
+		}
+	}
+	if len(l.names) == 0 {
+		return nil, terror.ErrSyncerPartitionNotSupport.Generate(table, "no partition definitions")
+	}
+	return l, nil
+}
+
+// partitionBoundValue returns the integer value of an expression in partition definitions.
+func partitionBoundValue(expr ast.ExprNode) (int64, bool, error) {
+	neg := false
+	if unary, ok := expr.(*ast.UnaryOperationExpr); ok && unary.Op == opcode.Minus {
+		neg = true
+		expr = unary.V
+	}
+	valueExpr, ok := expr.(ast.ValueExpr)
+	if !ok {
+		return 0, false, fmt.Errorf("%T is not a value", expr)
+	}
+	if valueExpr.GetValue() == nil {
+		return 0, true, nil
+	}
+	v, overflow, err := partitionColumnValue(valueExpr.GetValue())
+	if err != nil {
+		return 0, false, err
+	}
+	if overflow {
+		return 0, false, fmt.Errorf("value %v out of range", valueExpr.GetValue())
+	}
+	if neg {
+		v = -v
+	}
+	return v, false, nil
+}
+
+// partitionColumnValue converts an integer to int64, overflow is true for an unsigned integer larger than
+// math.MaxInt64, which is larger than all the supported bounds.
+func partitionColumnValue(value interface{}) (v int64, overflow bool, err error) {
+	switch x := value.(type) {
+	case int:
+		return int64(x), false, nil
+	case int8:
+		return int64(x), false, nil
+	case int16:
+		return int64(x), false, nil
+	case int32:
+		return int64(x), false, nil
+	case int64:
+		return x, false, nil
+	case uint:
+		return partitionColumnValue(uint64(x))
+	case uint8:
+		return int64(x), false, nil
+	case uint16:
+		return int64(x), false, nil
+	case uint32:
+		return int64(x), false, nil
+	case uint64:
+		if x > math.MaxInt64 {
+			return 0, true, nil
+		}
+		return int64(x), false, nil
+	}
+	return 0, false, fmt.Errorf("value %v of type %T is not an integer", value, value)
+}
+
+// locate returns the partition name of the row.
+func (l *partitionLocator) locate(columns []*model.ColumnInfo, values []interface{}) (string, error) {
+	idx := -1
+	for i, col := range columns {
+		if col.Name.L == l.column {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 || idx >= len(values) {
+		return "", terror.ErrSyncerPartitionNotSupport.Generate(l.table, "partition column "+l.column+" is not replicated")
+	}
+
+	value := values[idx]
+	if value == nil {
+		// NULL is less than any values in RANGE partitioning.
+		if l.tp == model.PartitionTypeRange {
+			return l.names[0], nil
+		}
+		if l.nullIdx >= 0 {
+			return l.names[l.nullIdx], nil
+		}
+		if l.defaultIdx >= 0 {
+			return l.names[l.defaultIdx], nil
+		}
+		return "", terror.ErrSyncerPartitionNotFound.Generate(l.table, "NULL", l.column)
+	}
+
+	v, overflow, err := partitionColumnValue(value)
+	if err != nil {
+		return "", terror.ErrSyncerPartitionNotSupport.Generate(l.table, err.Error())
+	}
+	if l.tp == model.PartitionTypeRange {
+		for i, bound := range l.lessThan {
+			if bound == nil || (!overflow && v < *bound) {
+				return l.names[i], nil
+			}
+		}
+	} else {
+		if !overflow {
+			for i, values := range l.inValues {
+				if _, ok := values[v]; ok {
+					return l.names[i], nil
+				}
+			}
+		}
+		if l.defaultIdx >= 0 {
+			return l.names[l.defaultIdx], nil
+		}
+	}
+	return "", terror.ErrSyncerPartitionNotFound.Generate(l.table, value, l.column)
+}
+
+// locateDML returns the partitions of the rows changed by the DML, for UPDATE the row may be moved to another partition.
+func (l *partitionLocator) locateDML(dml *DML) ([]string, error) {
+	p, err := l.locate(dml.columns, dml.values)
+	if err != nil {
+		return nil, err
+	}
+	if dml.op != update {
+		return []string{p}, nil
+	}
+	oldP, err := l.locate(dml.columns, dml.oldValues)
+	if err != nil {
+		return nil, err
+	}
+	if oldP == p {
+		return []string{p}, nil
+	}
+	return []string{oldP, p}, nil
+}
+
+// PartitionGroup locates the partitions of the row changes for the downstream tables in partition rules.
+type PartitionGroup struct {
+	sync.Mutex
+	modes    map[string]string            // lower case target table ID -> mode
+	locators map[string]*partitionLocator // lower case target table ID -> locator, nil if the table not exists
+}
+
+// NewPartitionGroup creates a PartitionGroup, nil is returned if there are no partition rules.
+func NewPartitionGroup(rules []*config.PartitionRule) *PartitionGroup {
+	if len(rules) == 0 {
+		return nil
+	}
+	g := &PartitionGroup{
+		modes:    make(map[string]string, len(rules)),
+		locators: make(map[string]*partitionLocator),
+	}
+	for _, rule := range rules {
+		g.modes[partitionTableKey(&filter.Table{Schema: rule.TargetSchema, Name: rule.TargetTable})] = rule.Mode
+	}
+	return g
+}
+
+func partitionTableKey(table *filter.Table) string {
+	return strings.ToLower(utils.GenTableID(table))
+}
+
+// ResetLocator deletes the locator of the target table. This should be called after table structure changed.
+func (g *PartitionGroup) ResetLocator(table *filter.Table) {
+	if g == nil {
+		return
+	}
+	g.Lock()
+	defer g.Unlock()
+	delete(g.locators, partitionTableKey(table))
+}
+
+// getPartitionLocator returns the locator of the target table, the downstream table is fetched at the first time or
+// when refresh is true.
+func (s *Syncer) getPartitionLocator(tctx *tcontext.Context, targetTable *filter.Table, refresh bool) (*partitionLocator, error) {
+	g := s.partitions
+	key := partitionTableKey(targetTable)
+	if !refresh {
+		g.Lock()
+		locator, ok := g.locators[key]
+		g.Unlock()
+		if ok {
+			return locator, nil
+		}
+	}
+
+	p, err := utils.GetParserForConn(tctx.Ctx, s.ddlDBConn.BaseConn.DBConn)
+	if err != nil {
+		return nil, terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, targetTable)
+	}
+	var locator *partitionLocator
+	stmt, err := fetchCreateTableStmt(tctx, s.ddlDBConn, p, targetTable)
+	if err != nil {
+		if !utils.IsMySQLError(err, tmysql.ErrNoSuchTable) {
+			return nil, terror.WithScope(err, terror.ScopeDownstream)
+		}
+		// the downstream table may be created by a DDL later, the locator is reset then.
+		tctx.L().Warn("table not exists in downstream, skip locating partitions", zap.Stringer("target", targetTable))
+	} else {
+		locator, err = newPartitionLocator(utils.GenTableID(targetTable), stmt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	g.Lock()
+	g.locators[key] = locator
+	g.Unlock()
+	return locator, nil
+}
+
+// locatePartitions locates the partitions of the DMLs if the target table is in partition rules, and sets them to
+// the DMLs in `hint` mode.
+func (s *Syncer) locatePartitions(tctx *tcontext.Context, targetTable *filter.Table, dmls []*DML) error {
+	g := s.partitions
+	// the row changes are not written into the downstream tables when sink is enabled.
+	if g == nil || s.sink != nil || len(dmls) == 0 {
+		return nil
+	}
+	mode, ok := g.modes[partitionTableKey(targetTable)]
+	if !ok {
+		return nil
+	}
+	locator, err := s.getPartitionLocator(tctx, targetTable, false)
+	if err != nil || locator == nil {
+		return err
+	}
+
+	refreshed := false
+	for i := 0; i < len(dmls); i++ {
+		partitions, err2 := locator.locateDML(dmls[i])
+		if err2 != nil {
+			// the partitions may be added to the downstream table after the locator is created, so refresh it once.
+			if refreshed || !terror.ErrSyncerPartitionNotFound.Equal(err2) {
+				return err2
+			}
+			refreshed = true
+			locator, err = s.getPartitionLocator(tctx, targetTable, true)
+			if err != nil || locator == nil {
+				return err
+			}
+			i--
+			continue
+		}
+		if mode == config.PartitionModeHint {
+			dmls[i].partitions = partitions
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+	parserpkg "github.com/pingcap/dm/pkg/parser"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// isPartitionOperation returns whether the ALTER TABLE spec only operates the partitioning of the table.
+func isPartitionOperation(spec *ast.AlterTableSpec) bool {
+	switch spec.Tp {
+	case ast.AlterTablePartition, ast.AlterTableRemovePartitioning,
+		ast.AlterTableAddPartitions, ast.AlterTableCoalescePartitions, ast.AlterTableReorganizePartition,
+		ast.AlterTableDropPartition, ast.AlterTableTruncatePartition, ast.AlterTableExchangePartition,
+		ast.AlterTableRebuildPartition, ast.AlterTableOptimizePartition, ast.AlterTableCheckPartitions,
+		ast.AlterTableRepairPartition, ast.AlterTableImportPartitionTablespace, ast.AlterTableDiscardPartitionTablespace,
+		ast.AlterTableAlterPartition, ast.AlterTablePartitionAttributes, ast.AlterTablePartitionOptions:
+		return true
+	}
+	return false
+}
+
+// rewritePartitionDDL rewrites the routed DDL by `partition-ddl-policy`, the partitioning of CREATE TABLE is removed,
+// and the partition operations of ALTER TABLE are dropped or converted. returns true if the DDL is dropped.
+func (s *Syncer) rewritePartitionDDL(tctx *tcontext.Context, info *ddlInfo) (bool, error) {
+	switch stmt := info.originStmt.(type) {
+	case *ast.CreateTableStmt:
+		if stmt.Partition == nil {
+			return false, nil
+		}
+		// originStmt is already renamed to the target tables, and it's also used to generate the broadcast DDLs.
+		stmt.Partition = nil
+		routedDDL, err := parserpkg.RenameDDLTable(stmt, info.targetTables)
+		if err != nil {
+			return false, err
+		}
+		tctx.L().Info("remove the partitioning of DDL", zap.String("statement", info.originDDL), zap.String("routed statement", routedDDL))
+		info.routedDDL = routedDDL
+		return false, nil
+	case *ast.AlterTableStmt:
+		// the DDL is already split, so there is only one spec.
+		if len(stmt.Specs) != 1 || !isPartitionOperation(stmt.Specs[0]) {
+			return false, nil
+		}
+		spec := stmt.Specs[0]
+		if s.cfg.PartitionDDLPolicy == config.PartitionDDLConvert {
+			switch spec.Tp {
+			case ast.AlterTableDropPartition, ast.AlterTableTruncatePartition:
+				return s.convertPartitionDDL(tctx, info, spec)
+			case ast.AlterTableExchangePartition:
+				return false, terror.ErrSyncerPartitionDDLNotSupport.Generate(info.originDDL, "the rows are exchanged with another table")
+			}
+		}
+		tctx.L().Warn("skip the partition operation of DDL",
+			zap.String("statement", info.originDDL),
+			zap.String("policy", s.cfg.PartitionDDLPolicy))
+		return true, nil
+	}
+	return false, nil
+}
+
+// convertPartitionDDL converts DROP/TRUNCATE PARTITION to DELETE the rows in the partitions, the partitions are
+// located by the definitions in the schema tracker, which is not tracked the DDL yet.
+func (s *Syncer) convertPartitionDDL(tctx *tcontext.Context, info *ddlInfo, spec *ast.AlterTableSpec) (bool, error) {
+	sourceTable, targetTable := info.sourceTables[0], info.targetTables[0]
+	if spec.OnAllPartitions {
+		info.routedDDL = deleteRowsSQL(targetTable, "")
+		info.deleteRows = true
+		tctx.L().Info("convert the partition operation of DDL", zap.String("statement", info.originDDL), zap.String("routed statement", info.routedDDL))
+		return false, nil
+	}
+
+	if _, err := s.getTableInfo(tctx, sourceTable, targetTable); err != nil {
+		return false, err
+	}
+	createTable, err := s.schemaTracker.GetCreateTable(tctx.Ctx, sourceTable)
+	if err != nil {
+		return false, terror.ErrSchemaTrackerCannotGetTable.Delegate(err, sourceTable)
+	}
+	sqlMode, _ := s.schemaTracker.GetSystemVar("sql_mode")
+	p, err := utils.GetParserFromSQLModeStr(sqlMode)
+	if err != nil {
+		return false, err
+	}
+	stmt, err := p.ParseOneStmt(createTable, "", "")
+	if err != nil {
+		return false, terror.ErrSyncerPartitionDDLNotSupport.Delegate(err, info.originDDL, "fail to parse the tracked table")
+	}
+	locator, err := newPartitionLocator(utils.GenTableID(sourceTable), stmt.(*ast.CreateTableStmt))
+	if err != nil {
+		return false, terror.ErrSyncerPartitionDDLNotSupport.Delegate(err, info.originDDL, "fail to locate the partitions")
+	}
+	conds, err := locator.rowsConditions(spec.PartitionNames, spec.IfExists)
+	if err != nil {
+		return false, terror.ErrSyncerPartitionDDLNotSupport.Delegate(err, info.originDDL, "fail to locate the partitions")
+	}
+	if len(conds) == 0 {
+		tctx.L().Warn("skip the partition operation of DDL, all the partitions not exist", zap.String("statement", info.originDDL))
+		return true, nil
+	}
+
+	var where string
+	for _, cond := range conds {
+		// the partition contains all the rows.
+		if cond == "" {
+			where = ""
+			break
+		}
+		if where != "" {
+			where += " OR "
+		}
+		where += "(" + cond + ")"
+	}
+	info.routedDDL = deleteRowsSQL(targetTable, where)
+	info.deleteRows = true
+	info.deleteWhere = where
+	tctx.L().Info("convert the partition operation of DDL",
+		zap.String("statement", info.originDDL),
+		zap.String("routed statement", info.routedDDL),
+		zap.Stringer("source", sourceTable))
+	return false, nil
+}
+
+// deleteRowsSQL returns the DELETE statement converted from DROP/TRUNCATE PARTITION, empty `where` means all the rows.
+func deleteRowsSQL(table *filter.Table, where string) string {
+	if where == "" {
+		return fmt.Sprintf("DELETE FROM %s", dbutil.TableName(table.Schema, table.Name))
+	}
+	return fmt.Sprintf("DELETE FROM %s WHERE %s", dbutil.TableName(table.Schema, table.Name), where)
+}
+
+// trackPartitionDDL tracks the dropped partition operation in advance, it doesn't change the columns so the DMLs
+// before it are not affected. the partition definitions are kept for converting the following DDLs, but some
+// operations are not supported by the schema tracker, the errors are ignored because the DDL is dropped anyway.
+func (s *Syncer) trackPartitionDDL(qec *queryEventContext, info *ddlInfo) {
+	if err := s.trackDDL(qec.ddlSchema, info, qec.eventContext); err != nil {
+		qec.tctx.L().Warn("fail to track the skipped partition operation",
+			zap.String("statement", info.originDDL),
+			log.ShortError(err))
+	}
+}
+
+// rowsConditions returns the conditions of the rows in every partition, empty condition means all the rows.
+// the partitions not exist are ignored if `ifExists` is true.
+func (l *partitionLocator) rowsConditions(names []model.CIStr, ifExists bool) ([]string, error) {
+	conds := make([]string, 0, len(names))
+	for _, name := range names {
+		idx := -1
+		for i, n := range l.names {
+			if strings.EqualFold(n, name.O) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			if ifExists {
+				continue
+			}
+			return nil, terror.ErrSyncerPartitionNotSupport.Generate(l.table, "partition "+name.O+" not exists")
+		}
+		conds = append(conds, l.rowsCondition(idx))
+	}
+	return conds, nil
+}
+
+// rowsCondition returns the condition of the rows in the i-th partition.
+func (l *partitionLocator) rowsCondition(i int) string {
+	col := dbutil.ColumnName(l.column)
+	if l.tp == model.PartitionTypeRange {
+		var conds []string
+		if i > 0 {
+			conds = append(conds, fmt.Sprintf("%s >= %d", col, *l.lessThan[i-1]))
+		}
+		if l.lessThan[i] != nil {
+			conds = append(conds, fmt.Sprintf("%s < %d", col, *l.lessThan[i]))
+		}
+		cond := strings.Join(conds, " AND ")
+		// NULL is less than any values in RANGE partitioning.
+		if i == 0 && cond != "" {
+			cond = fmt.Sprintf("%s OR %s IS NULL", cond, col)
+		}
+		return cond
+	}
+
+	var (
+		values  []int64
+		hasNull = l.nullIdx == i
+	)
+	if l.defaultIdx == i {
+		// the DEFAULT partition contains the rows not in the other partitions.
+		for j, inValues := range l.inValues {
+			if j == i {
+				continue
+			}
+			for v := range inValues {
+				values = append(values, v)
+			}
+		}
+		hasNull = hasNull || l.nullIdx < 0
+	} else {
+		for v := range l.inValues[i] {
+			values = append(values, v)
+		}
+	}
+	sort.Slice(values, func(a, b int) bool { return values[a] < values[b] })
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		strs = append(strs, fmt.Sprintf("%d", v))
+	}
+
+	var cond string
+	switch {
+	case l.defaultIdx == i && len(values) == 0:
+		if hasNull {
+			return ""
+		}
+		return fmt.Sprintf("%s IS NOT NULL", col)
+	case l.defaultIdx == i:
+		cond = fmt.Sprintf("%s NOT IN (%s)", col, strings.Join(strs, ","))
+	case len(values) > 0:
+		cond = fmt.Sprintf("%s IN (%s)", col, strings.Join(strs, ","))
+	}
+	if hasNull {
+		if cond == "" {
+			return fmt.Sprintf("%s IS NULL", col)
+		}
+		cond = fmt.Sprintf("%s OR %s IS NULL", cond, col)
+	}
+	return cond
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/schema"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+func (s *testSyncerSuite) TestPartitionRowsConditions(c *C) {
+	p := parser.New()
+	newLocator := func(sql string) *partitionLocator {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		c.Assert(err, IsNil)
+		l, err := newPartitionLocator("`test`.`t`", stmt.(*ast.CreateTableStmt))
+		c.Assert(err, IsNil)
+		return l
+	}
+	names := func(names ...string) []model.CIStr {
+		res := make([]model.CIStr, 0, len(names))
+		for _, name := range names {
+			res = append(res, model.NewCIStr(name))
+		}
+		return res
+	}
+
+	l := newLocator("create table t(id int, a int) partition by range (a) " +
+		"(partition p0 values less than (-10), partition p1 values less than (100), partition p2 values less than maxvalue)")
+	conds, err := l.rowsConditions(names("P0", "p1", "p2"), false)
+	c.Assert(err, IsNil)
+	c.Assert(conds, DeepEquals, []string{"`a` < -10 OR `a` IS NULL", "`a` >= -10 AND `a` < 100", "`a` >= 100"})
+	_, err = l.rowsConditions(names("p3"), false)
+	c.Assert(terror.ErrSyncerPartitionNotSupport.Equal(err), IsTrue)
+	conds, err = l.rowsConditions(names("p3"), true)
+	c.Assert(err, IsNil)
+	c.Assert(conds, HasLen, 0)
+
+	l = newLocator("create table t(id int, a int) partition by range (a) (partition p0 values less than maxvalue)")
+	conds, err = l.rowsConditions(names("p0"), false)
+	c.Assert(err, IsNil)
+	c.Assert(conds, DeepEquals, []string{""})
+
+	l = newLocator("create table t(id int, a int) partition by list (a) " +
+		"(partition p0 values in (3, 1), partition p1 values in (2, null), partition p2 values in (4))")
+	conds, err = l.rowsConditions(names("p0", "p1", "p2"), false)
+	c.Assert(err, IsNil)
+	c.Assert(conds, DeepEquals, []string{"`a` IN (1,3)", "`a` IN (2) OR `a` IS NULL", "`a` IN (4)"})
+
+	// the DEFAULT partition contains the rows not in the other partitions.
+	l = &partitionLocator{
+		tp:         model.PartitionTypeList,
+		column:     "a",
+		names:      []string{"p0", "p1"},
+		inValues:   []map[int64]struct{}{{1: {}, 2: {}}, {}},
+		nullIdx:    -1,
+		defaultIdx: 1,
+	}
+	conds, err = l.rowsConditions(names("p1"), false)
+	c.Assert(err, IsNil)
+	c.Assert(conds, DeepEquals, []string{"`a` NOT IN (1,2) OR `a` IS NULL"})
+	l.nullIdx = 0
+	conds, err = l.rowsConditions(names("p1"), false)
+	c.Assert(err, IsNil)
+	c.Assert(conds, DeepEquals, []string{"`a` NOT IN (1,2)"})
+}
+
+func (s *testSyncerSuite) TestRewritePartitionDDL(c *C) {
+	ctx := context.Background()
+	tctx := tcontext.Background()
+	p := parser.New()
+	sourceTable := &filter.Table{Schema: "test", Name: "t"}
+	targetTable := &filter.Table{Schema: "test", Name: "t2"}
+	newDDLInfo := func(sql string) *ddlInfo {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		c.Assert(err, IsNil)
+		return &ddlInfo{
+			originDDL:    sql,
+			routedDDL:    sql,
+			originStmt:   stmt,
+			sourceTables: []*filter.Table{sourceTable},
+			targetTables: []*filter.Table{targetTable},
+		}
+	}
+
+	tracker, err := schema.NewTracker(ctx, "unit-test", defaultTestSessionCfg, nil)
+	c.Assert(err, IsNil)
+	defer tracker.Close()
+	createTable := "CREATE TABLE `test`.`t` (`id` INT PRIMARY KEY,`a` INT) PARTITION BY RANGE (`id`) " +
+		"(PARTITION `p0` VALUES LESS THAN (10),PARTITION `p1` VALUES LESS THAN (20))"
+	c.Assert(tracker.CreateSchemaIfNotExists("test"), IsNil)
+	c.Assert(tracker.Exec(ctx, "test", createTable), IsNil)
+
+	syncer := &Syncer{
+		cfg:           &config.SubTaskConfig{SyncerConfig: config.SyncerConfig{PartitionDDLPolicy: config.PartitionDDLDrop}},
+		schemaTracker: tracker,
+	}
+
+	// the partitioning is removed from CREATE TABLE.
+	info := newDDLInfo(createTable)
+	dropped, err := syncer.rewritePartitionDDL(tctx, info)
+	c.Assert(err, IsNil)
+	c.Assert(dropped, IsFalse)
+	c.Assert(info.routedDDL, Equals, "CREATE TABLE `test`.`t2` (`id` INT PRIMARY KEY,`a` INT)")
+
+	info = newDDLInfo("ALTER TABLE `test`.`t` ADD COLUMN `b` INT")
+	dropped, err = syncer.rewritePartitionDDL(tctx, info)
+	c.Assert(err, IsNil)
+	c.Assert(dropped, IsFalse)
+	c.Assert(info.routedDDL, Equals, "ALTER TABLE `test`.`t` ADD COLUMN `b` INT")
+
+	for _, sql := range []string{
+		"ALTER TABLE `test`.`t` ADD PARTITION (PARTITION `p2` VALUES LESS THAN (30))",
+		"ALTER TABLE `test`.`t` DROP PARTITION `p1`",
+		"ALTER TABLE `test`.`t` REMOVE PARTITIONING",
+	} {
+		dropped, err = syncer.rewritePartitionDDL(tctx, newDDLInfo(sql))
+		c.Assert(err, IsNil)
+		c.Assert(dropped, IsTrue, Commentf("%s", sql))
+	}
+
+	// DROP/TRUNCATE PARTITION are converted to DELETE.
+	syncer.cfg.PartitionDDLPolicy = config.PartitionDDLConvert
+	dropped, err = syncer.rewritePartitionDDL(tctx, newDDLInfo("ALTER TABLE `test`.`t` COALESCE PARTITION 1"))
+	c.Assert(err, IsNil)
+	c.Assert(dropped, IsTrue)
+
+	info = newDDLInfo("ALTER TABLE `test`.`t` DROP PARTITION `p0`,`p1`")
+	dropped, err = syncer.rewritePartitionDDL(tctx, info)
+	c.Assert(err, IsNil)
+	c.Assert(dropped, IsFalse)
+	c.Assert(info.routedDDL, Equals, "DELETE FROM `test`.`t2` WHERE (`id` < 10 OR `id` IS NULL) OR (`id` >= 10 AND `id` < 20)")
+	c.Assert(info.deleteRows, IsTrue)
+
+	info = newDDLInfo("ALTER TABLE `test`.`t` TRUNCATE PARTITION ALL")
+	dropped, err = syncer.rewritePartitionDDL(tctx, info)
+	c.Assert(err, IsNil)
+	c.Assert(dropped, IsFalse)
+	c.Assert(info.routedDDL, Equals, "DELETE FROM `test`.`t2`")
+
+	dropped, err = syncer.rewritePartitionDDL(tctx, newDDLInfo("ALTER TABLE `test`.`t` DROP PARTITION IF EXISTS `p5`"))
+	c.Assert(err, IsNil)
+	c.Assert(dropped, IsTrue)
+
+	_, err = syncer.rewritePartitionDDL(tctx, newDDLInfo("ALTER TABLE `test`.`t` EXCHANGE PARTITION `p0` WITH TABLE `test`.`t3`"))
+	c.Assert(terror.ErrSyncerPartitionDDLNotSupport.Equal(err), IsTrue)
+}
@@ -0,0 +1,145 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/retry"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestPartitionLocator(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, "create table t(id int primary key, a int unsigned)")
+	c.Assert(err, IsNil)
+	newLocator := func(sql string) (*partitionLocator, error) {
+		stmt, err2 := p.ParseOneStmt(sql, "", "")
+		c.Assert(err2, IsNil)
+		return newPartitionLocator("`test`.`t`", stmt.(*ast.CreateTableStmt))
+	}
+
+	l, err := newLocator("create table t(id int, a int) partition by range (a) " +
+		"(partition p0 values less than (-10), partition p1 values less than (100), partition p2 values less than maxvalue)")
+	c.Assert(err, IsNil)
+	cases := []struct {
+		value     interface{}
+		partition string
+	}{
+		{nil, "p0"},
+		{int64(-11), "p0"},
+		{int64(-10), "p1"},
+		{uint32(99), "p1"},
+		{int32(100), "p2"},
+		{uint64(1) << 63, "p2"},
+	}
+	for _, cs := range cases {
+		partition, err2 := l.locate(ti.Columns, []interface{}{1, cs.value})
+		c.Assert(err2, IsNil)
+		c.Assert(partition, Equals, cs.partition)
+	}
+
+	l, err = newLocator("create table t(id int, a int) partition by list columns (a) " +
+		"(partition p0 values in (1, 3), partition p1 values in (2, null))")
+	c.Assert(err, IsNil)
+	partition, err := l.locate(ti.Columns, []interface{}{1, int64(3)})
+	c.Assert(err, IsNil)
+	c.Assert(partition, Equals, "p0")
+	partition, err = l.locate(ti.Columns, []interface{}{1, nil})
+	c.Assert(err, IsNil)
+	c.Assert(partition, Equals, "p1")
+	_, err = l.locate(ti.Columns, []interface{}{1, int64(4)})
+	c.Assert(terror.ErrSyncerPartitionNotFound.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*table `test`.`t` has no partition for value 4 of column a.*")
+	_, err = l.locate(ti.Columns, []interface{}{1, "4"})
+	c.Assert(terror.ErrSyncerPartitionNotSupport.Equal(err), IsTrue)
+	_, err = l.locate(ti.Columns[:1], []interface{}{1})
+	c.Assert(terror.ErrSyncerPartitionNotSupport.Equal(err), IsTrue)
+
+	unsupported := []string{
+		"create table t(id int, a int)",
+		"create table t(id int, a int) partition by hash (a) partitions 4",
+		"create table t(id int, a int) partition by range (a + 1) (partition p0 values less than (10))",
+		"create table t(id int, a int) partition by range columns (id, a) (partition p0 values less than (10, 10))",
+	}
+	for _, sql := range unsupported {
+		_, err = newLocator(sql)
+		c.Assert(terror.ErrSyncerPartitionNotSupport.Equal(err), IsTrue)
+	}
+}
+
+func (s *testSyncerSuite) TestLocatePartitions(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, "create table t(id int primary key, a int)")
+	c.Assert(err, IsNil)
+	table := &filter.Table{Schema: "test", Name: "t"}
+	tctx := tcontext.Background()
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+	syncer := &Syncer{
+		cfg:        &config.SubTaskConfig{},
+		ddlDBConn:  &dbconn.DBConn{Cfg: s.cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})},
+		partitions: NewPartitionGroup([]*config.PartitionRule{{TargetSchema: "Test", TargetTable: "T", Mode: config.PartitionModeHint}}),
+	}
+	expectShowCreateTable := func(partitions string) {
+		mock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+			sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""))
+		mock.ExpectQuery("SHOW CREATE TABLE `test`.`t`").WillReturnRows(
+			sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("t",
+				"CREATE TABLE `t` (`id` int NOT NULL, `a` int, PRIMARY KEY (`id`, `a`)) PARTITION BY RANGE (`a`) ("+partitions+")"))
+	}
+
+	insertDML := newDML(insert, false, "`test`.`t`", table, nil, []interface{}{1, 1}, nil, []interface{}{1, 1}, ti.Columns, ti)
+	updateDML := newDML(update, false, "`test`.`t`", table, []interface{}{1, 1}, []interface{}{1, 11}, []interface{}{1, 1}, []interface{}{1, 11}, ti.Columns, ti)
+	expectShowCreateTable("PARTITION `p0` VALUES LESS THAN (10), PARTITION `p1` VALUES LESS THAN (20)")
+	c.Assert(syncer.locatePartitions(tctx, table, []*DML{insertDML, updateDML}), IsNil)
+	c.Assert(insertDML.partitions, DeepEquals, []string{"p0"})
+	c.Assert(updateDML.partitions, DeepEquals, []string{"p0", "p1"})
+	sqls, _ := updateDML.genSQL()
+	c.Assert(sqls, DeepEquals, []string{"UPDATE `test`.`t` PARTITION (`p0`,`p1`) SET `id` = ?, `a` = ? WHERE `id` = ? LIMIT 1"})
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the downstream table is fetched again when no partition is found, a partition may be added.
+	insertDML = newDML(insert, false, "`test`.`t`", table, nil, []interface{}{1, 21}, nil, []interface{}{1, 21}, ti.Columns, ti)
+	expectShowCreateTable("PARTITION `p0` VALUES LESS THAN (10), PARTITION `p1` VALUES LESS THAN (20), PARTITION `p2` VALUES LESS THAN (30)")
+	c.Assert(syncer.locatePartitions(tctx, table, []*DML{insertDML}), IsNil)
+	c.Assert(insertDML.partitions, DeepEquals, []string{"p2"})
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	insertDML = newDML(insert, false, "`test`.`t`", table, nil, []interface{}{1, 31}, nil, []interface{}{1, 31}, ti.Columns, ti)
+	expectShowCreateTable("PARTITION `p0` VALUES LESS THAN (10)")
+	err = syncer.locatePartitions(tctx, table, []*DML{insertDML})
+	c.Assert(terror.ErrSyncerPartitionNotFound.Equal(err), IsTrue)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+
+	// the tables not in partition rules are skipped.
+	c.Assert(syncer.locatePartitions(tctx, &filter.Table{Schema: "test", Name: "t2"}, []*DML{insertDML}), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
@@ -0,0 +1,518 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+var (
+	// quarantineChunkSize is the number of statements in a chunk, a chunk is buffered in memory or spilled to disk as a whole.
+	quarantineChunkSize = 1024
+	// quarantineMemoryChunks is the max number of chunks buffered in memory for a quarantined table, the following
+	// chunks are spilled to disk.
+	quarantineMemoryChunks = 16
+)
+
+// quarantinedStatement is a statement to write the quarantined table, the fields are exported for gob encoding.
+type quarantinedStatement struct {
+	SQL  string
+	Args []interface{}
+	DDL  bool
+}
+
+type quarantineChunk struct {
+	stmts []quarantinedStatement
+	path  string // the file which the statements are spilled to, empty if they are in memory
+	count int
+}
+
+type quarantinedTable struct {
+	table *filter.Table
+	since time.Time
+	// location to replicate the buffered events again from, the global checkpoint is held back to it.
+	location  *binlog.Location
+	chunks    []*quarantineChunk
+	buffered  int
+	spilled   int
+	releasing bool
+	// held by the `ddl-window`, it's released automatically in the window.
+	ddlWindow bool
+	// the statements are generated in safe mode, so they can be replayed on the rows repaired.
+	safeMode bool
+}
+
+// QuarantinedTableStatus is the status of a quarantined table.
+type QuarantinedTableStatus struct {
+	Table     string        `json:"table"`
+	Since     string        `json:"since"`
+	Buffered  int           `json:"buffered"` // number of the buffered statements, including the spilled ones
+	Spilled   int           `json:"spilled"`
+	Releasing bool          `json:"releasing"`
+	Location  string        `json:"location,omitempty"`
+	DDLWindow bool          `json:"ddl-window,omitempty"` // held by the DDL window
+	Repair    *RepairStatus `json:"repair,omitempty"`
+}
+
+// tableQuarantine suspends writing to some downstream tables. the DMLs of a quarantined table are buffered and
+// written after it's released, the rest tables are replicated as usual.
+// the buffered statements are not persistent, the global checkpoint is held back to the location before them, so they
+// are replicated again from the checkpoint when the task is restarted.
+type tableQuarantine struct {
+	sync.Mutex
+
+	dir           string
+	caseSensitive bool
+	enableGTID    bool
+	seq           int
+	tables        map[string]*quarantinedTable // target table ID -> quarantined table
+	repairs       map[string]*tableRepair      // target table ID -> the running or finished repair
+}
+
+func newTableQuarantine(cfg *config.SubTaskConfig) *tableQuarantine {
+	return &tableQuarantine{
+		dir:           filepath.Join(cfg.Dir, "quarantine"),
+		caseSensitive: cfg.CaseSensitive,
+		enableGTID:    cfg.EnableGTID,
+		tables:        make(map[string]*quarantinedTable),
+		repairs:       make(map[string]*tableRepair),
+	}
+}
+
+func (q *tableQuarantine) key(table *filter.Table) string {
+	id := utils.GenTableID(table)
+	if !q.caseSensitive {
+		id = strings.ToLower(id)
+	}
+	return id
+}
+
+// add quarantines table, it's a no-op if table is already quarantined.
+func (q *tableQuarantine) add(table *filter.Table) {
+	q.Lock()
+	defer q.Unlock()
+	k := q.key(table)
+	if _, ok := q.tables[k]; ok {
+		return
+	}
+	q.tables[k] = &quarantinedTable{table: table, since: time.Now()}
+}
+
+func (q *tableQuarantine) isQuarantined(table *filter.Table) bool {
+	q.Lock()
+	defer q.Unlock()
+	_, ok := q.tables[q.key(table)]
+	return ok
+}
+
+// isHeldByDDLWindow returns whether table is held by the DDL window.
+func (q *tableQuarantine) isHeldByDDLWindow(table *filter.Table) bool {
+	q.Lock()
+	defer q.Unlock()
+	t, ok := q.tables[q.key(table)]
+	return ok && t.ddlWindow
+}
+
+// holdDDL buffers ddl of table until the DDL window, table is quarantined if it's not, and location is the location to
+// replicate the DDL again from if nothing of the table is buffered before.
+func (q *tableQuarantine) holdDDL(table *filter.Table, ddl string, location binlog.Location) error {
+	q.Lock()
+	defer q.Unlock()
+	k := q.key(table)
+	t, ok := q.tables[k]
+	if !ok {
+		t = &quarantinedTable{table: table, since: time.Now(), ddlWindow: true}
+		q.tables[k] = t
+	}
+	if t.location == nil {
+		t.location = &location
+	}
+	return q.appendStatement(t, quarantinedStatement{SQL: ddl, DDL: true})
+}
+
+// ddlWindowTables returns the tables held by the DDL window, the earlier held ones come first.
+func (q *tableQuarantine) ddlWindowTables() []*filter.Table {
+	q.Lock()
+	defer q.Unlock()
+	held := make([]*quarantinedTable, 0, len(q.tables))
+	for _, t := range q.tables {
+		if t.ddlWindow {
+			held = append(held, t)
+		}
+	}
+	sort.Slice(held, func(i, j int) bool { return held[i].since.Before(held[j].since) })
+	tables := make([]*filter.Table, 0, len(held))
+	for _, t := range held {
+		tables = append(tables, t.table)
+	}
+	return tables
+}
+
+// buffer buffers the statements of dmls if table is quarantined, getLocation is called to get the location to replicate
+// them again from if nothing of the table is buffered before.
+func (q *tableQuarantine) buffer(table *filter.Table, dmls []*DML, getLocation func() binlog.Location) (bool, error) {
+	q.Lock()
+	defer q.Unlock()
+	t, ok := q.tables[q.key(table)]
+	if !ok {
+		return false, nil
+	}
+	if t.location == nil {
+		location := getLocation()
+		t.location = &location
+	}
+	for _, dml := range dmls {
+		if t.safeMode {
+			dml.safeMode = true
+		}
+		queries, args := dml.genSQL()
+		for i := range queries {
+			if err := q.appendStatement(t, quarantinedStatement{SQL: queries[i], Args: args[i]}); err != nil {
+				return true, err
+			}
+		}
+	}
+	return true, nil
+}
+
+func (q *tableQuarantine) appendStatement(t *quarantinedTable, stmt quarantinedStatement) error {
+	var last *quarantineChunk
+	if len(t.chunks) > 0 {
+		last = t.chunks[len(t.chunks)-1]
+	}
+	if last == nil || last.path != "" || last.count >= quarantineChunkSize {
+		last = &quarantineChunk{stmts: make([]quarantinedStatement, 0, quarantineChunkSize)}
+		t.chunks = append(t.chunks, last)
+	}
+	last.stmts = append(last.stmts, stmt)
+	last.count++
+	t.buffered++
+	if last.count < quarantineChunkSize {
+		return nil
+	}
+
+	inMemory := 0
+	for _, c := range t.chunks {
+		if c.path == "" {
+			inMemory++
+		}
+	}
+	if inMemory <= quarantineMemoryChunks {
+		return nil
+	}
+	// spill the newest chunk, the oldest ones are written first when releasing.
+	if err := q.spill(last); err != nil {
+		return terror.ErrSyncerQuarantineSpill.Delegate(err, t.table)
+	}
+	t.spilled += last.count
+	return nil
+}
+
+func (q *tableQuarantine) spill(c *quarantineChunk) error {
+	if err := os.MkdirAll(q.dir, 0o755); err != nil {
+		return err
+	}
+	q.seq++
+	path := filepath.Join(q.dir, fmt.Sprintf("chunk-%d.gob", q.seq))
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = gob.NewEncoder(f).Encode(c.stmts); err != nil {
+		os.Remove(path)
+		return err
+	}
+	c.stmts = nil
+	c.path = path
+	return nil
+}
+
+func loadQuarantineChunk(c *quarantineChunk) error {
+	f, err := os.Open(c.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var stmts []quarantinedStatement
+	if err = gob.NewDecoder(f).Decode(&stmts); err != nil {
+		return err
+	}
+	os.Remove(c.path)
+	c.stmts = stmts
+	c.path = ""
+	return nil
+}
+
+// peek returns at most n statements from the oldest buffered chunk, the chunk is loaded if it's spilled.
+// a DDL is always returned alone, so the DDLs and DMLs are executed separately.
+func (q *tableQuarantine) peek(t *quarantinedTable, n int) (*quarantineChunk, []quarantinedStatement, error) {
+	q.Lock()
+	defer q.Unlock()
+	for len(t.chunks) > 0 && t.chunks[0].count == 0 {
+		t.chunks = t.chunks[1:]
+	}
+	if len(t.chunks) == 0 {
+		return nil, nil, nil
+	}
+	c := t.chunks[0]
+	if c.path != "" {
+		count := c.count
+		if err := loadQuarantineChunk(c); err != nil {
+			return nil, nil, terror.ErrSyncerQuarantineSpill.Delegate(err, t.table)
+		}
+		t.spilled -= count
+	}
+	if n > len(c.stmts) {
+		n = len(c.stmts)
+	}
+	for i, stmt := range c.stmts[:n] {
+		if stmt.DDL {
+			if i == 0 {
+				n = 1
+			} else {
+				n = i
+			}
+			break
+		}
+	}
+	return c, append([]quarantinedStatement(nil), c.stmts[:n]...), nil
+}
+
+// pop removes n statements of chunk c peeked before.
+func (q *tableQuarantine) pop(t *quarantinedTable, c *quarantineChunk, n int) {
+	q.Lock()
+	defer q.Unlock()
+	if len(t.chunks) == 0 || t.chunks[0] != c {
+		// discarded
+		return
+	}
+	c.stmts = c.stmts[n:]
+	c.count -= n
+	t.buffered -= n
+}
+
+// release writes the buffered statements of table by exec in batches of batchSize, then removes the table from the
+// quarantine. the events of the table are still buffered while releasing, so they are written in order.
+func (q *tableQuarantine) release(table *filter.Table, batchSize int, exec func([]quarantinedStatement) error) error {
+	q.Lock()
+	t, ok := q.tables[q.key(table)]
+	if !ok {
+		q.Unlock()
+		return terror.ErrSyncerTableNotQuarantined.Generate(table)
+	}
+	if t.releasing {
+		q.Unlock()
+		return terror.ErrSyncerQuarantineNotSupport.Generate(fmt.Sprintf("while table %s is being released", table))
+	}
+	t.releasing = true
+	q.Unlock()
+
+	if batchSize <= 0 {
+		batchSize = quarantineChunkSize
+	}
+	for {
+		c, stmts, err := q.peek(t, batchSize)
+		if err == nil && len(stmts) == 0 {
+			q.Lock()
+			// check again, the new statements may be buffered after peek.
+			if t.buffered == 0 {
+				delete(q.tables, q.key(table))
+				q.Unlock()
+				return nil
+			}
+			q.Unlock()
+			continue
+		}
+		if err == nil {
+			err = exec(stmts)
+		}
+		if err != nil {
+			q.Lock()
+			t.releasing = false
+			q.Unlock()
+			return err
+		}
+		q.pop(t, c, len(stmts))
+	}
+}
+
+// adjustGlobalLocation holds the global checkpoint back to the location before the buffered events.
+func (q *tableQuarantine) adjustGlobalLocation(globalLocation binlog.Location) binlog.Location {
+	q.Lock()
+	defer q.Unlock()
+	for _, t := range q.tables {
+		if t.location != nil && binlog.CompareLocation(*t.location, globalLocation, q.enableGTID) < 0 {
+			globalLocation = *t.location
+		}
+	}
+	return globalLocation
+}
+
+// discard drops the buffered statements but keeps the tables quarantined, they will be replicated again from the
+// checkpoint and buffered again. the tables held by the DDL window are removed, they are held again when the DDLs are
+// replicated again. the running repairs are canceled and the tables are removed, they can be repaired again.
+func (q *tableQuarantine) discard() {
+	q.Lock()
+	defer q.Unlock()
+	for _, r := range q.repairs {
+		if r.cancel != nil {
+			r.cancel()
+			r.cancel = nil
+			r.status.Phase = repairPhaseFailed
+			r.status.Error = "canceled"
+		}
+	}
+	for k, t := range q.tables {
+		for _, c := range t.chunks {
+			if c.path != "" {
+				os.Remove(c.path)
+			}
+		}
+		if t.ddlWindow || t.safeMode {
+			delete(q.tables, k)
+			continue
+		}
+		t.chunks = nil
+		t.buffered = 0
+		t.spilled = 0
+		t.location = nil
+	}
+}
+
+func (q *tableQuarantine) status() []QuarantinedTableStatus {
+	q.Lock()
+	defer q.Unlock()
+	res := make([]QuarantinedTableStatus, 0, len(q.tables))
+	for k, t := range q.tables {
+		st := QuarantinedTableStatus{
+			Table:     t.table.String(),
+			Since:     t.since.Format(time.RFC3339),
+			Buffered:  t.buffered,
+			Spilled:   t.spilled,
+			Releasing: t.releasing,
+			DDLWindow: t.ddlWindow,
+		}
+		if t.location != nil {
+			st.Location = t.location.String()
+		}
+		if r, ok := q.repairs[k]; ok {
+			repair := r.status
+			st.Repair = &repair
+		}
+		res = append(res, st)
+	}
+	// the finished repairs are shown after the tables are released.
+	for k, r := range q.repairs {
+		if _, ok := q.tables[k]; ok {
+			continue
+		}
+		repair := r.status
+		res = append(res, QuarantinedTableStatus{Table: r.table.String(), Since: r.since.Format(time.RFC3339), Repair: &repair})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Table < res[j].Table })
+	return res
+}
+
+// QuarantineTable adds, releases, repairs or shows the quarantined downstream tables, the status of quarantined tables is
+// returned in JSON format for `ShowQuarantine`.
+func (s *Syncer) QuarantineTable(ctx context.Context, req *pb.QuarantineWorkerTableRequest) (string, error) {
+	if req.Op == pb.QuarantineOp_ShowQuarantine {
+		data, err := json.Marshal(s.quarantine.status())
+		return string(data), err
+	}
+
+	table := &filter.Table{Schema: req.Database, Name: req.Table}
+	switch req.Op {
+	case pb.QuarantineOp_AddQuarantine:
+		if s.cfg.Sink != nil {
+			return "", terror.ErrSyncerQuarantineNotSupport.Generate("for the task whose downstream is a sink")
+		}
+		s.quarantine.add(table)
+		s.tctx.L().Info("quarantine table", zap.Stringer("table", table))
+	case pb.QuarantineOp_RepairQuarantine:
+		if err := s.StartRepairTable(table); err != nil {
+			return "", err
+		}
+	case pb.QuarantineOp_ReleaseQuarantine:
+		if err := s.releaseQuarantinedTable(ctx, table); err != nil {
+			return "", err
+		}
+		s.tctx.L().Info("release quarantined table", zap.Stringer("table", table))
+	default:
+		return "", terror.ErrSyncerQuarantineNotSupport.Generate(fmt.Sprintf("for operation %s", req.Op))
+	}
+	return "", nil
+}
+
+// releaseQuarantinedTable writes the buffered statements of table through a dedicated connection.
+func (s *Syncer) releaseQuarantinedTable(ctx context.Context, table *filter.Table) error {
+	if !s.quarantine.isQuarantined(table) {
+		return terror.ErrSyncerTableNotQuarantined.Generate(table)
+	}
+	dbCfg := s.cfg.To
+	dbCfg.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxDMLConnectionTimeout)
+	db, dbConns, err := dbconn.CreateConns(s.tctx, s.cfg, dbCfg, 1)
+	if err != nil {
+		return err
+	}
+	defer dbconn.CloseBaseDB(s.tctx, db)
+
+	tctx := s.tctx.WithContext(ctx)
+	return s.quarantine.release(table, s.cfg.Batch, func(stmts []quarantinedStatement) error {
+		if len(stmts) == 1 && stmts[0].DDL {
+			ddls, err2 := s.hookDDLs(tctx, []string{stmts[0].SQL})
+			if err2 != nil {
+				return err2
+			}
+			if _, err2 = s.executeDDLs(tctx, dbConns[0], ddls); err2 != nil {
+				tctx.L().Error("fail to execute the held DDL of table", zap.Stringer("table", table), zap.String("DDL", stmts[0].SQL), log.ShortError(err2))
+				return terror.WithScope(err2, terror.ScopeDownstream)
+			}
+			tctx.L().Info("execute the held DDL of table", zap.Stringer("table", table), zap.String("DDL", stmts[0].SQL))
+			return nil
+		}
+		queries := make([]string, 0, len(stmts))
+		args := make([][]interface{}, 0, len(stmts))
+		for _, stmt := range stmts {
+			queries = append(queries, stmt.SQL)
+			args = append(args, stmt.Args)
+		}
+		if _, err2 := dbConns[0].ExecuteSQL(tctx, queries, args...); err2 != nil {
+			tctx.L().Error("fail to write the buffered statements of quarantined table", zap.Stringer("table", table), log.ShortError(err2))
+			return terror.WithScope(err2, terror.ScopeDownstream)
+		}
+		return nil
+	})
+}
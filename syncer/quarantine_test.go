@@ -0,0 +1,217 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"errors"
+	"os"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+var _ = Suite(&testQuarantineSuite{})
+
+type testQuarantineSuite struct {
+	ti *model.TableInfo
+}
+
+func (t *testQuarantineSuite) SetUpSuite(c *C) {
+	var err error
+	t.ti, err = createTableInfo(parser.New(), mock.NewContext(), 1, "create table tb(id int primary key, name varchar(10))")
+	c.Assert(err, IsNil)
+}
+
+func (t *testQuarantineSuite) genDMLs(table *filter.Table, ids ...int64) []*DML {
+	dmls := make([]*DML, 0, len(ids))
+	for _, id := range ids {
+		values := []interface{}{id, nil}
+		dmls = append(dmls, newDML(insert, false, "`db`.`tb`", table, nil, values, nil, values, t.ti.Columns, t.ti))
+	}
+	return dmls
+}
+
+func (t *testQuarantineSuite) newQuarantine(c *C) *tableQuarantine {
+	return newTableQuarantine(&config.SubTaskConfig{LoaderConfig: config.LoaderConfig{Dir: c.MkDir()}})
+}
+
+func (t *testQuarantineSuite) TestBufferAndRelease(c *C) {
+	defer func(size, chunks int) {
+		quarantineChunkSize, quarantineMemoryChunks = size, chunks
+	}(quarantineChunkSize, quarantineMemoryChunks)
+	quarantineChunkSize, quarantineMemoryChunks = 2, 1
+
+	q := t.newQuarantine(c)
+	table := &filter.Table{Schema: "db", Name: "tb"}
+	location := binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil)
+	getLocation := func() binlog.Location { return location }
+
+	buffered, err := q.buffer(table, t.genDMLs(table, 0), getLocation)
+	c.Assert(err, IsNil)
+	c.Assert(buffered, IsFalse)
+	c.Assert(terror.ErrSyncerTableNotQuarantined.Equal(q.release(table, 10, nil)), IsTrue)
+
+	q.add(&filter.Table{Schema: "DB", Name: "TB"})
+	c.Assert(q.isQuarantined(table), IsTrue)
+	buffered, err = q.buffer(table, t.genDMLs(table, 1, 2, 3, 4, 5, 6, 7), getLocation)
+	c.Assert(err, IsNil)
+	c.Assert(buffered, IsTrue)
+
+	// chunks: [1 2] [3 4](spilled) [5 6](spilled) [7]
+	status := q.status()
+	c.Assert(status, HasLen, 1)
+	c.Assert(status[0].Buffered, Equals, 7)
+	c.Assert(status[0].Spilled, Equals, 4)
+	c.Assert(status[0].Location, Equals, location.String())
+	files, err := os.ReadDir(q.dir)
+	c.Assert(err, IsNil)
+	c.Assert(files, HasLen, 2)
+
+	// the global checkpoint is held back.
+	later := binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000002", Pos: 4}, nil)
+	c.Assert(q.adjustGlobalLocation(later), DeepEquals, location)
+
+	var ids []interface{}
+	execCount := 0
+	err = q.release(table, 3, func(stmts []quarantinedStatement) error {
+		execCount++
+		if execCount == 1 {
+			// the events are still buffered while releasing.
+			_, err2 := q.buffer(table, t.genDMLs(table, 8), getLocation)
+			c.Assert(err2, IsNil)
+		}
+		for _, stmt := range stmts {
+			c.Assert(stmt.SQL, Equals, "INSERT INTO `db`.`tb` (`id`,`name`) VALUES (?,?)")
+			c.Assert(stmt.Args[1], IsNil)
+			ids = append(ids, stmt.Args[0])
+		}
+		return nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(ids, DeepEquals, []interface{}{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), int64(7), int64(8)})
+	c.Assert(q.isQuarantined(table), IsFalse)
+	c.Assert(q.status(), HasLen, 0)
+	c.Assert(q.adjustGlobalLocation(later), DeepEquals, later)
+	files, err = os.ReadDir(q.dir)
+	c.Assert(err, IsNil)
+	c.Assert(files, HasLen, 0)
+}
+
+func (t *testQuarantineSuite) TestReleaseFailAndDiscard(c *C) {
+	defer func(size, chunks int) {
+		quarantineChunkSize, quarantineMemoryChunks = size, chunks
+	}(quarantineChunkSize, quarantineMemoryChunks)
+	quarantineChunkSize, quarantineMemoryChunks = 2, 1
+
+	q := t.newQuarantine(c)
+	table := &filter.Table{Schema: "db", Name: "tb"}
+	location := binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil)
+	getLocation := func() binlog.Location { return location }
+
+	q.add(table)
+	_, err := q.buffer(table, t.genDMLs(table, 1, 2, 3, 4, 5), getLocation)
+	c.Assert(err, IsNil)
+
+	// the statements written before the failure are not written again.
+	execCount := 0
+	err = q.release(table, 1, func(stmts []quarantinedStatement) error {
+		execCount++
+		if execCount == 2 {
+			return errors.New("mock error")
+		}
+		return nil
+	})
+	c.Assert(err, ErrorMatches, "mock error")
+	status := q.status()
+	c.Assert(status, HasLen, 1)
+	c.Assert(status[0].Buffered, Equals, 4)
+	c.Assert(status[0].Releasing, IsFalse)
+
+	q.discard()
+	c.Assert(q.isQuarantined(table), IsTrue)
+	status = q.status()
+	c.Assert(status[0].Buffered, Equals, 0)
+	c.Assert(status[0].Spilled, Equals, 0)
+	c.Assert(status[0].Location, Equals, "")
+	files, err := os.ReadDir(q.dir)
+	c.Assert(err, IsNil)
+	c.Assert(files, HasLen, 0)
+	later := binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000002", Pos: 4}, nil)
+	c.Assert(q.adjustGlobalLocation(later), DeepEquals, later)
+}
+
+func (t *testQuarantineSuite) TestHoldDDL(c *C) {
+	q := t.newQuarantine(c)
+	table := &filter.Table{Schema: "db", Name: "tb"}
+	table2 := &filter.Table{Schema: "db", Name: "tb2"}
+	location := binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil)
+	later := binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000002", Pos: 4}, nil)
+	getLocation := func() binlog.Location { return later }
+
+	// a table quarantined by users is not released by the DDL window.
+	q.add(table2)
+	c.Assert(q.isHeldByDDLWindow(table2), IsFalse)
+
+	c.Assert(q.holdDDL(table, "ALTER TABLE `db`.`tb` ADD INDEX idx(name)", location), IsNil)
+	c.Assert(q.isHeldByDDLWindow(table), IsTrue)
+	_, err := q.buffer(table, t.genDMLs(table, 1, 2), getLocation)
+	c.Assert(err, IsNil)
+	c.Assert(q.holdDDL(table, "ALTER TABLE `db`.`tb` DROP INDEX idx", later), IsNil)
+	_, err = q.buffer(table, t.genDMLs(table, 3), getLocation)
+	c.Assert(err, IsNil)
+	c.Assert(q.ddlWindowTables(), DeepEquals, []*filter.Table{table})
+	c.Assert(q.adjustGlobalLocation(later), DeepEquals, location)
+	status := q.status()
+	c.Assert(status, HasLen, 2)
+	c.Assert(status[0].Table, Equals, table2.String())
+	c.Assert(status[0].DDLWindow, IsFalse)
+	c.Assert(status[1].DDLWindow, IsTrue)
+	c.Assert(status[1].Buffered, Equals, 5)
+
+	// the DDLs are executed alone and in order.
+	var batches [][]string
+	err = q.release(table, 10, func(stmts []quarantinedStatement) error {
+		batch := make([]string, 0, len(stmts))
+		for _, stmt := range stmts {
+			c.Assert(stmt.DDL, Equals, len(stmts) == 1 && stmt.Args == nil)
+			batch = append(batch, stmt.SQL)
+		}
+		batches = append(batches, batch)
+		return nil
+	})
+	c.Assert(err, IsNil)
+	insert := "INSERT INTO `db`.`tb` (`id`,`name`) VALUES (?,?)"
+	c.Assert(batches, DeepEquals, [][]string{
+		{"ALTER TABLE `db`.`tb` ADD INDEX idx(name)"},
+		{insert, insert},
+		{"ALTER TABLE `db`.`tb` DROP INDEX idx"},
+		{insert},
+	})
+	c.Assert(q.isQuarantined(table), IsFalse)
+
+	// the tables held by the DDL window are removed when discarding, the users' ones are kept.
+	c.Assert(q.holdDDL(table, "ALTER TABLE `db`.`tb` ADD INDEX idx(name)", location), IsNil)
+	q.discard()
+	c.Assert(q.isQuarantined(table), IsFalse)
+	c.Assert(q.isQuarantined(table2), IsTrue)
+	c.Assert(q.ddlWindowTables(), HasLen, 0)
+}
@@ -91,7 +91,7 @@ func (s *Syncer) setInitActiveRelayLog(ctx context.Context) error {
 		s.tctx.Logger.Warn("empty position, may because only specify GTID and hasn't saved according binlog position")
 		return nil
 	}
-	err = s.readerHub.UpdateActiveRelayLog(s.cfg.Name, activeUUID, pos.Name)
+	err = s.readerHub.UpdateActiveRelayLog(s.activeRelayLogKey(), activeUUID, pos.Name)
 	s.recordedActiveRelayLog = true
 	s.tctx.L().Info("current earliest active relay log", log.WrapStringerField("active relay log", s.readerHub.EarliestActiveRelayLog()))
 	return err
@@ -121,7 +121,7 @@ func (s *Syncer) updateActiveRelayLog(pos mysql.Position) error {
 		return err
 	}
 
-	err = s.readerHub.UpdateActiveRelayLog(s.cfg.Name, activeUUID, pos.Name)
+	err = s.readerHub.UpdateActiveRelayLog(s.activeRelayLogKey(), activeUUID, pos.Name)
 	s.tctx.L().Info("current earliest active relay log", log.WrapStringerField("active relay log", s.readerHub.EarliestActiveRelayLog()))
 	return err
 }
@@ -131,6 +131,6 @@ func (s *Syncer) removeActiveRelayLog() {
 		return
 	}
 
-	s.readerHub.RemoveActiveRelayLog(s.cfg.Name)
+	s.readerHub.RemoveActiveRelayLog(s.activeRelayLogKey())
 	s.tctx.L().Info("current earliest active relay log", log.WrapStringerField("active relay log", s.readerHub.EarliestActiveRelayLog()))
 }
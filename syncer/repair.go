@@ -0,0 +1,372 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+var (
+	// repairChunkSize is the number of rows read from the upstream and written to the staging table at once.
+	repairChunkSize = 1000
+	// repairWaitInterval is the interval to check whether the row changes dispatched before repairing are written.
+	repairWaitInterval = time.Second
+)
+
+// phases of repairing a table.
+const (
+	repairPhaseCopying   = "copying"
+	repairPhaseSwapping  = "swapping"
+	repairPhaseReplaying = "replaying"
+	repairPhaseDone      = "done"
+	repairPhaseFailed    = "failed"
+)
+
+// RepairStatus is the status of repairing a table.
+type RepairStatus struct {
+	Phase string `json:"phase"`
+	Rows  int64  `json:"rows"` // number of the rows copied into the staging table
+	Error string `json:"error,omitempty"`
+}
+
+// tableRepair is the progress of repairing a table, protected by the lock of tableQuarantine.
+type tableRepair struct {
+	table  *filter.Table
+	since  time.Time
+	status RepairStatus
+	cancel context.CancelFunc
+}
+
+// repairTableNames returns the names of the staging table and the table swapped out.
+func repairTableNames(table *filter.Table) (*filter.Table, *filter.Table) {
+	return &filter.Table{Schema: table.Schema, Name: fmt.Sprintf("_%s_dm_repair", table.Name)},
+		&filter.Table{Schema: table.Schema, Name: fmt.Sprintf("_%s_dm_old", table.Name)}
+}
+
+// addRepair quarantines table to repair it, the statements of its row changes are buffered in safe mode, so they can
+// be replayed on the rows copied from the upstream.
+func (q *tableQuarantine) addRepair(table *filter.Table, cancel context.CancelFunc) error {
+	q.Lock()
+	defer q.Unlock()
+	k := q.key(table)
+	if _, ok := q.tables[k]; ok {
+		return terror.ErrSyncerRepairNotSupport.Generate(fmt.Sprintf("for the quarantined table %s, please release it first", table))
+	}
+	if r, ok := q.repairs[k]; ok && r.cancel != nil {
+		return terror.ErrSyncerRepairNotSupport.Generate(fmt.Sprintf("while table %s is being repaired", table))
+	}
+	now := time.Now()
+	q.tables[k] = &quarantinedTable{table: table, since: now, safeMode: true}
+	q.repairs[k] = &tableRepair{table: table, since: now, status: RepairStatus{Phase: repairPhaseCopying}, cancel: cancel}
+	return nil
+}
+
+// updateRepair updates the progress of repairing table.
+func (q *tableQuarantine) updateRepair(table *filter.Table, phase string, rows int64, err error) {
+	q.Lock()
+	defer q.Unlock()
+	r, ok := q.repairs[q.key(table)]
+	if !ok {
+		return
+	}
+	if phase != "" {
+		r.status.Phase = phase
+	}
+	r.status.Rows += rows
+	if err != nil {
+		r.status.Error = err.Error()
+	}
+	if phase == repairPhaseDone || phase == repairPhaseFailed {
+		r.cancel = nil
+	}
+}
+
+// StartRepairTable repairs a downstream table diverged from the upstream without stopping the task.
+// the table is quarantined, and its data is rebuilt into a staging table by reading the upstream tables routed to it
+// in chunks in background. then the staging table is swapped with the table atomically by `RENAME TABLE`, and the row
+// changes buffered while rebuilding are replayed in safe mode.
+// NOTE: the rows are copied as they are in the upstream, the column mappings, expression filters and the hook plugin
+// are not applied, and the generated columns are generated by the downstream.
+func (s *Syncer) StartRepairTable(table *filter.Table) error {
+	if s.cfg.Sink != nil {
+		return terror.ErrSyncerRepairNotSupport.Generate("for the task whose downstream is a sink")
+	}
+	if s.cfg.ShardMode != "" {
+		return terror.ErrSyncerRepairNotSupport.Generate("for the task in shard mode, the rows of the other sources would be lost")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := s.quarantine.addRepair(table, cancel); err != nil {
+		cancel()
+		return err
+	}
+	s.tctx.L().Info("start to repair table", zap.Stringer("table", table))
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer cancel()
+		if err := s.repairTable(s.tctx.WithContext(ctx), table); err != nil {
+			s.tctx.L().Error("fail to repair table", zap.Stringer("table", table), log.ShortError(err))
+			s.quarantine.updateRepair(table, repairPhaseFailed, 0, err)
+			return
+		}
+		s.tctx.L().Info("table repaired", zap.Stringer("table", table))
+		s.quarantine.updateRepair(table, repairPhaseDone, 0, nil)
+	}()
+	return nil
+}
+
+// repairTable rebuilds table into a staging table, swaps them and replays the buffered row changes. if it fails
+// before swapping, the buffered row changes are written to the table as if it's released.
+func (s *Syncer) repairTable(tctx *tcontext.Context, table *filter.Table) (err error) {
+	staging, old := repairTableNames(table)
+	var dbConn *dbconn.DBConn
+	swapped := false
+	defer func() {
+		if err == nil || swapped || tctx.Ctx.Err() != nil {
+			// the buffered row changes are replicated again from the checkpoint if the task is paused.
+			return
+		}
+		if dbConn != nil {
+			if _, err2 := s.executeDDLs(tctx, dbConn, []string{"DROP TABLE IF EXISTS " + staging.String()}); err2 != nil {
+				tctx.L().Warn("fail to drop the staging table", zap.Stringer("table", staging), log.ShortError(err2))
+			}
+		}
+		if err2 := s.releaseQuarantinedTable(tctx.Ctx, table); err2 != nil {
+			tctx.L().Error("fail to write the buffered row changes to the table after repairing failed", zap.Stringer("table", table), log.ShortError(err2))
+		}
+	}()
+
+	dbCfg := s.cfg.To
+	dbCfg.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxDMLConnectionTimeout)
+	db, dbConns, err := dbconn.CreateConns(s.tctx, s.cfg, dbCfg, 1)
+	if err != nil {
+		return terror.ErrSyncerRepairTable.Delegate(err, table, repairPhaseCopying)
+	}
+	defer dbconn.CloseBaseDB(s.tctx, db)
+	dbConn = dbConns[0]
+
+	ddls := []string{
+		"DROP TABLE IF EXISTS " + staging.String(),
+		fmt.Sprintf("CREATE TABLE %s LIKE %s", staging, table),
+	}
+	if _, err = s.executeDDLs(tctx, dbConn, ddls); err != nil {
+		return terror.ErrSyncerRepairTable.Delegate(err, table, repairPhaseCopying)
+	}
+	if err = s.copyRepairRows(tctx, dbConn, table, staging); err != nil {
+		return terror.ErrSyncerRepairTable.Delegate(err, table, repairPhaseCopying)
+	}
+
+	s.quarantine.updateRepair(table, repairPhaseSwapping, 0, nil)
+	if err = s.waitCheckpointFlushed(tctx, time.Now()); err != nil {
+		return terror.ErrSyncerRepairTable.Delegate(err, table, repairPhaseSwapping)
+	}
+	ddls = []string{
+		"DROP TABLE IF EXISTS " + old.String(),
+		fmt.Sprintf("RENAME TABLE %s TO %s, %s TO %s", table, old, staging, table),
+	}
+	if _, err = s.executeDDLs(tctx, dbConn, ddls); err != nil {
+		return terror.ErrSyncerRepairTable.Delegate(err, table, repairPhaseSwapping)
+	}
+	swapped = true
+	tctx.L().Info("the staging table is swapped with the repairing table", zap.Stringer("table", table))
+	if _, err2 := s.executeDDLs(tctx, dbConn, []string{"DROP TABLE IF EXISTS " + old.String()}); err2 != nil {
+		tctx.L().Warn("fail to drop the table swapped out", zap.Stringer("table", old), log.ShortError(err2))
+	}
+
+	s.quarantine.updateRepair(table, repairPhaseReplaying, 0, nil)
+	if err = s.releaseQuarantinedTable(tctx.Ctx, table); err != nil {
+		return terror.ErrSyncerRepairTable.Delegate(err, table, repairPhaseReplaying)
+	}
+	return nil
+}
+
+// waitCheckpointFlushed waits until the checkpoint is flushed after since. the row changes of the repairing table
+// dispatched before it's quarantined are written when the checkpoint is flushed, so they don't overwrite the rows
+// copied into the staging table after swapping.
+func (s *Syncer) waitCheckpointFlushed(tctx *tcontext.Context, since time.Time) error {
+	ticker := time.NewTicker(repairWaitInterval)
+	defer ticker.Stop()
+	for {
+		s.lastCheckpointFlushedTime.Lock()
+		flushed := s.lastCheckpointFlushedTime.t.After(since)
+		s.lastCheckpointFlushedTime.Unlock()
+		if flushed {
+			return nil
+		}
+		select {
+		case <-tctx.Ctx.Done():
+			return tctx.Ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// copyRepairRows copies the rows of the upstream tables routed to table into the staging table.
+func (s *Syncer) copyRepairRows(tctx *tcontext.Context, conn *dbconn.DBConn, table, staging *filter.Table) error {
+	mapper, err := utils.FetchTargetDoTables(tctx.Ctx, s.fromDB.BaseDB.DB, s.baList, s.tableRouter)
+	if err != nil {
+		return err
+	}
+	var sourceTables []*filter.Table
+	for targetID, tables := range mapper {
+		if targetID == table.String() || (!s.cfg.CaseSensitive && strings.EqualFold(targetID, table.String())) {
+			sourceTables = append(sourceTables, tables...)
+		}
+	}
+	if len(sourceTables) == 0 {
+		return terror.ErrSyncerRepairNotSupport.Generate(fmt.Sprintf("for table %s which no upstream table is routed to", table))
+	}
+
+	for _, sourceTable := range sourceTables {
+		if err = s.copyRepairTable(tctx, conn, table, sourceTable, staging); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyRepairTable copies the rows of sourceTable into the staging table in chunks ordered by the primary key.
+func (s *Syncer) copyRepairTable(tctx *tcontext.Context, conn *dbconn.DBConn, table, sourceTable, staging *filter.Table) error {
+	ti, err := dbutil.GetTableInfo(tctx.Ctx, s.fromDB.BaseDB.DB, sourceTable.Schema, sourceTable.Name)
+	if err != nil {
+		return err
+	}
+	pkCols := primaryKeyColumns(ti)
+	if len(pkCols) == 0 {
+		return terror.ErrSyncerRepairNotSupport.Generate(fmt.Sprintf("for the upstream table %s without primary key", sourceTable))
+	}
+	columns := make([]*model.ColumnInfo, 0, len(ti.Columns))
+	for _, col := range ti.Columns {
+		if !col.IsGenerated() {
+			columns = append(columns, col)
+		}
+	}
+	pkOffsets := make([]int, 0, len(pkCols))
+	for _, pk := range pkCols {
+		for i, col := range columns {
+			if col.Name.L == pk.Name.L {
+				pkOffsets = append(pkOffsets, i)
+			}
+		}
+	}
+	if len(pkOffsets) != len(pkCols) {
+		return terror.ErrSyncerRepairNotSupport.Generate(fmt.Sprintf("for the upstream table %s whose primary key has generated columns", sourceTable))
+	}
+
+	var lastPK []interface{}
+	for {
+		rows, err2 := s.readRepairChunk(tctx, sourceTable, columns, pkCols, lastPK)
+		if err2 != nil {
+			return err2
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		query, args := genRepairInsertSQL(staging, columns, rows)
+		if _, err2 = conn.ExecuteSQL(tctx, []string{query}, args); err2 != nil {
+			return err2
+		}
+		s.quarantine.updateRepair(table, "", int64(len(rows)), nil)
+		if len(rows) < repairChunkSize {
+			return nil
+		}
+		last := rows[len(rows)-1]
+		lastPK = make([]interface{}, 0, len(pkOffsets))
+		for _, offset := range pkOffsets {
+			lastPK = append(lastPK, last[offset])
+		}
+	}
+}
+
+// readRepairChunk reads at most repairChunkSize rows of sourceTable whose primary key is greater than lastPK.
+func (s *Syncer) readRepairChunk(tctx *tcontext.Context, sourceTable *filter.Table, columns, pkCols []*model.ColumnInfo, lastPK []interface{}) ([][]interface{}, error) {
+	colNames := make([]string, 0, len(columns))
+	for _, col := range columns {
+		colNames = append(colNames, dbutil.ColumnName(col.Name.O))
+	}
+	pkNames := make([]string, 0, len(pkCols))
+	for _, col := range pkCols {
+		pkNames = append(pkNames, dbutil.ColumnName(col.Name.O))
+	}
+	var where string
+	if lastPK != nil {
+		where = fmt.Sprintf(" WHERE (%s) > (%s)", strings.Join(pkNames, ","), strings.TrimSuffix(strings.Repeat("?,", len(lastPK)), ","))
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s%s ORDER BY %s LIMIT %d",
+		strings.Join(colNames, ","), sourceTable, where, strings.Join(pkNames, ","), repairChunkSize)
+
+	rows, err := s.fromDB.BaseDB.DB.QueryContext(tctx.Ctx, query, lastPK...)
+	if err != nil {
+		return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBQueryFailed, query), terror.ScopeUpstream)
+	}
+	defer rows.Close()
+	var res [][]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+		}
+		res = append(res, values)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeUpstream)
+	}
+	return res, nil
+}
+
+// genRepairInsertSQL generates the statement to write rows into the staging table, the existing rows are replaced.
+func genRepairInsertSQL(staging *filter.Table, columns []*model.ColumnInfo, rows [][]interface{}) (string, []interface{}) {
+	colNames := make([]string, 0, len(columns))
+	updates := make([]string, 0, len(columns))
+	for _, col := range columns {
+		name := dbutil.ColumnName(col.Name.O)
+		colNames = append(colNames, name)
+		updates = append(updates, fmt.Sprintf("%s=VALUES(%s)", name, name))
+	}
+	placeholder := "(" + strings.TrimSuffix(strings.Repeat("?,", len(columns)), ",") + ")"
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "INSERT INTO %s (%s) VALUES ", staging, strings.Join(colNames, ","))
+	args := make([]interface{}, 0, len(rows)*len(columns))
+	for i, row := range rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(placeholder)
+		args = append(args, row...)
+	}
+	fmt.Fprintf(&buf, " ON DUPLICATE KEY UPDATE %s", strings.Join(updates, ","))
+	return buf.String(), args
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/retry"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+func (t *testQuarantineSuite) TestRepairQuarantine(c *C) {
+	var (
+		q        = t.newQuarantine(c)
+		tb       = &filter.Table{Schema: "db", Name: "tb"}
+		canceled bool
+	)
+	q.add(tb)
+	c.Assert(terror.ErrSyncerRepairNotSupport.Equal(q.addRepair(tb, func() {})), IsTrue)
+	c.Assert(q.release(tb, 0, func([]quarantinedStatement) error { return nil }), IsNil)
+
+	c.Assert(q.addRepair(tb, func() { canceled = true }), IsNil)
+	c.Assert(q.isQuarantined(tb), IsTrue)
+	// the row changes are buffered in safe mode.
+	quarantined, err := q.buffer(tb, t.genDMLs(tb, 1), func() binlog.Location { return binlog.NewLocation("") })
+	c.Assert(err, IsNil)
+	c.Assert(quarantined, IsTrue)
+	c.Assert(q.tables[q.key(tb)].chunks[0].stmts[0].SQL, Matches, "INSERT INTO .* ON DUPLICATE KEY UPDATE .*")
+
+	q.updateRepair(tb, "", 10, nil)
+	q.updateRepair(tb, repairPhaseSwapping, 5, nil)
+	status := q.status()
+	c.Assert(status, HasLen, 1)
+	c.Assert(status[0].Buffered, Equals, 1)
+	c.Assert(*status[0].Repair, DeepEquals, RepairStatus{Phase: repairPhaseSwapping, Rows: 15})
+
+	// the running repair is canceled and the table is removed when the task is paused.
+	q.discard()
+	c.Assert(canceled, IsTrue)
+	c.Assert(q.isQuarantined(tb), IsFalse)
+	status = q.status()
+	c.Assert(status, HasLen, 1)
+	c.Assert(status[0].Buffered, Equals, 0)
+	c.Assert(*status[0].Repair, DeepEquals, RepairStatus{Phase: repairPhaseFailed, Rows: 15, Error: "canceled"})
+
+	// repair it again.
+	c.Assert(q.addRepair(tb, func() {}), IsNil)
+	c.Assert(terror.ErrSyncerRepairNotSupport.Equal(q.addRepair(tb, func() {})), IsTrue)
+	c.Assert(q.status()[0].Repair.Phase, Equals, repairPhaseCopying)
+}
+
+func (t *testQuarantineSuite) TestCopyRepairTable(c *C) {
+	defer func(size int) {
+		repairChunkSize = size
+	}(repairChunkSize)
+	repairChunkSize = 2
+
+	var (
+		tctx        = tcontext.Background()
+		tb          = &filter.Table{Schema: "db", Name: "tb"}
+		sourceTable = &filter.Table{Schema: "db_1", Name: "tb_1"}
+		staging, _  = repairTableNames(tb)
+		s           = &Syncer{quarantine: t.newQuarantine(c)}
+	)
+	c.Assert(staging.String(), Equals, "`db`.`_tb_dm_repair`")
+	c.Assert(s.quarantine.addRepair(tb, func() {}), IsNil)
+
+	upDB, upMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	s.fromDB = &dbconn.UpStreamConn{BaseDB: conn.NewBaseDB(upDB, func() {})}
+	downDB, downMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	downConn, err := downDB.Conn(context.Background())
+	c.Assert(err, IsNil)
+	dbConn := &dbconn.DBConn{Cfg: &config.SubTaskConfig{Name: "test"}, BaseConn: conn.NewBaseConn(downConn, &retry.FiniteRetryStrategy{})}
+
+	upMock.ExpectQuery("SHOW CREATE TABLE `db_1`.`tb_1`").WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).
+			AddRow("tb_1", "create table tb_1(a int, id int, name varchar(10), v int as (id + 1), primary key(id, a))"))
+	upMock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""))
+	upMock.ExpectQuery(regexp.QuoteMeta("SELECT `a`,`id`,`name` FROM `db_1`.`tb_1` ORDER BY `id`,`a` LIMIT 2")).WillReturnRows(
+		sqlmock.NewRows([]string{"a", "id", "name"}).AddRow(1, 1, "a").AddRow(1, 2, nil))
+	downMock.ExpectBegin()
+	downMock.ExpectExec(regexp.QuoteMeta("INSERT INTO `db`.`_tb_dm_repair` (`a`,`id`,`name`) VALUES (?,?,?),(?,?,?) "+
+		"ON DUPLICATE KEY UPDATE `a`=VALUES(`a`),`id`=VALUES(`id`),`name`=VALUES(`name`)")).
+		WithArgs(1, 1, "a", 1, 2, nil).WillReturnResult(sqlmock.NewResult(0, 2))
+	downMock.ExpectCommit()
+	upMock.ExpectQuery(regexp.QuoteMeta("SELECT `a`,`id`,`name` FROM `db_1`.`tb_1` WHERE (`id`,`a`) > (?,?) ORDER BY `id`,`a` LIMIT 2")).
+		WithArgs(2, 1).WillReturnRows(sqlmock.NewRows([]string{"a", "id", "name"}).AddRow(2, 1, "c"))
+	downMock.ExpectBegin()
+	downMock.ExpectExec(regexp.QuoteMeta("INSERT INTO `db`.`_tb_dm_repair` (`a`,`id`,`name`) VALUES (?,?,?) ")).
+		WithArgs(2, 1, "c").WillReturnResult(sqlmock.NewResult(0, 1))
+	downMock.ExpectCommit()
+
+	c.Assert(s.copyRepairTable(tctx, dbConn, tb, sourceTable, staging), IsNil)
+	c.Assert(upMock.ExpectationsWereMet(), IsNil)
+	c.Assert(downMock.ExpectationsWereMet(), IsNil)
+	c.Assert(s.quarantine.status()[0].Repair.Rows, Equals, int64(3))
+
+	// no primary key.
+	upMock.ExpectQuery("SHOW CREATE TABLE `db_1`.`tb_1`").WillReturnRows(
+		sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("tb_1", "create table tb_1(id int, name varchar(10))"))
+	upMock.ExpectQuery("SHOW VARIABLES LIKE 'sql_mode'").WillReturnRows(
+		sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("sql_mode", ""))
+	err = s.copyRepairTable(tctx, dbConn, tb, sourceTable, staging)
+	c.Assert(terror.ErrSyncerRepairNotSupport.Equal(err), IsTrue)
+	c.Assert(upMock.ExpectationsWereMet(), IsNil)
+}
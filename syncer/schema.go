@@ -85,6 +85,9 @@ func (s *Syncer) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaR
 		}
 
 		s.exprFilterGroup.ResetExprs(sourceTable)
+		s.columnTransforms.ResetTransforms(sourceTable)
+		s.charsetConverter.ResetColumns(sourceTable)
+		s.generatedColumns.ResetPruners(sourceTable)
 
 		if !req.Flush && !req.Sync {
 			break
@@ -122,7 +125,12 @@ func (s *Syncer) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaR
 	case pb.SchemaOp_RemoveSchema:
 		// we only drop the schema in the schema-tracker now,
 		// so if we drop the schema and continue to replicate any DDL/DML, it will try to get schema from downstream again.
-		return "", s.schemaTracker.DropTable(sourceTable)
+		if err = s.schemaTracker.DropTable(sourceTable); err != nil {
+			return "", err
+		}
+		if s.schemaSnapshot != nil {
+			return "", s.schemaSnapshot.remove(tcontext.NewContext(ctx, log.L()), sourceTable)
+		}
 	}
 	return "", nil
 }
@@ -0,0 +1,226 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/syncer/dbconn"
+	"github.com/pingcap/dm/syncer/metrics"
+	onlineddl "github.com/pingcap/dm/syncer/online-ddl-tools"
+)
+
+// schemaDrift describes the differences between a tracked table structure and its downstream table.
+type schemaDrift struct {
+	// columns replicated from upstream but not exist in the downstream table
+	missingColumns []string
+	// NOT NULL columns without default value which only exist in the downstream table
+	extraColumns []string
+	// columns whose types are different, like "c: int vs varchar"
+	typeMismatches []string
+}
+
+func (d *schemaDrift) empty() bool {
+	return len(d.missingColumns) == 0 && len(d.extraColumns) == 0 && len(d.typeMismatches) == 0
+}
+
+func (d *schemaDrift) String() string {
+	parts := make([]string, 0, 3)
+	if len(d.missingColumns) > 0 {
+		parts = append(parts, "columns not exist in downstream: "+strings.Join(d.missingColumns, ", "))
+	}
+	if len(d.extraColumns) > 0 {
+		parts = append(parts, "NOT NULL columns without default value only exist in downstream: "+strings.Join(d.extraColumns, ", "))
+	}
+	if len(d.typeMismatches) > 0 {
+		parts = append(parts, "columns with different types (tracked vs downstream): "+strings.Join(d.typeMismatches, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// compareTableStructure compares the tracked table structure with the CREATE TABLE statement of the downstream table.
+// only the differences which may cause errors when applying DMLs are reported.
+func compareTableStructure(ti *model.TableInfo, stmt *ast.CreateTableStmt) *schemaDrift {
+	drift := &schemaDrift{}
+	downstreamCols := make(map[string]*ast.ColumnDef, len(stmt.Cols))
+	for _, col := range stmt.Cols {
+		downstreamCols[col.Name.Name.L] = col
+	}
+
+	trackedCols := make(map[string]struct{}, len(ti.Columns))
+	for _, col := range ti.Columns {
+		trackedCols[col.Name.L] = struct{}{}
+		// generated columns are not replicated.
+		if col.IsGenerated() {
+			continue
+		}
+		downCol, ok := downstreamCols[col.Name.L]
+		if !ok {
+			drift.missingColumns = append(drift.missingColumns, col.Name.O)
+			continue
+		}
+		if downCol.Tp != nil && downCol.Tp.Tp != col.Tp {
+			drift.typeMismatches = append(drift.typeMismatches,
+				fmt.Sprintf("%s: %s vs %s", col.Name.O, types.TypeStr(col.Tp), types.TypeStr(downCol.Tp.Tp)))
+		}
+	}
+
+	for _, col := range stmt.Cols {
+		if _, ok := trackedCols[col.Name.Name.L]; ok {
+			continue
+		}
+		if columnDefRequiresValue(col) {
+			drift.extraColumns = append(drift.extraColumns, col.Name.Name.O)
+		}
+	}
+	return drift
+}
+
+// columnDefRequiresValue returns whether a value must be specified for the column when inserting a row.
+func columnDefRequiresValue(col *ast.ColumnDef) bool {
+	notNull := false
+	for _, opt := range col.Options {
+		switch opt.Tp {
+		case ast.ColumnOptionNotNull, ast.ColumnOptionPrimaryKey:
+			notNull = true
+		case ast.ColumnOptionNull:
+			notNull = false
+		case ast.ColumnOptionDefaultValue, ast.ColumnOptionAutoIncrement, ast.ColumnOptionGenerated, ast.ColumnOptionAutoRandom:
+			return false
+		}
+	}
+	// TIMESTAMP columns get the current timestamp as the implicit default value.
+	if col.Tp != nil && col.Tp.Tp == mysql.TypeTimestamp {
+		return false
+	}
+	return notNull
+}
+
+// schemaDriftLoop compares the tracked table structures with the downstream tables every `schema-drift-check-interval`.
+func (s *Syncer) schemaDriftLoop(ctx context.Context) {
+	interval := time.Duration(s.cfg.SchemaDriftCheckInterval) * time.Second
+	logger := s.tctx.L().WithFields(zap.String("component", "schema drift checker"))
+
+	dbCfg := s.cfg.To
+	dbCfg.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxDDLConnectionTimeout)
+	db, dbConns, err := dbconn.CreateConns(s.tctx, s.cfg, dbCfg, 1)
+	if err != nil {
+		logger.Warn("fail to create connection, schema drift check is disabled", log.ShortError(err))
+		return
+	}
+	defer dbconn.CloseBaseDB(s.tctx, db)
+
+	// a drift is only reported when it's found in two consecutive checks, because the tracked table structure
+	// is changed a little earlier than the DDL is executed in downstream.
+	var lastDrifts map[string]string
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		tctx := s.tctx.WithContext(ctx).WithLogger(logger)
+		drifts := s.checkSchemaDrift(tctx, dbConns[0])
+		confirmed := 0
+		for table, drift := range drifts {
+			if lastDrifts[table] != drift {
+				continue
+			}
+			confirmed++
+			logger.Warn("schema drift detected", zap.Error(terror.ErrSyncerSchemaDrift.Generate(table, drift)))
+		}
+		metrics.SchemaDriftTableGauge.WithLabelValues(s.cfg.Name, s.cfg.SourceID).Set(float64(confirmed))
+		lastDrifts = drifts
+	}
+}
+
+// checkSchemaDrift compares all tables in the schema tracker with the downstream tables, and returns the drifts
+// keyed by "downstream table of source table".
+func (s *Syncer) checkSchemaDrift(tctx *tcontext.Context, dbConn *dbconn.DBConn) map[string]string {
+	drifts := make(map[string]string)
+	p, err := utils.GetParserForConn(tctx.Ctx, dbConn.BaseConn.DBConn)
+	if err != nil {
+		tctx.L().Warn("fail to get parser for downstream", log.ShortError(err))
+		p = parser.New()
+	}
+
+	for _, schema := range s.schemaTracker.AllSchemas() {
+		for _, ti := range schema.Tables {
+			sourceTable := &filter.Table{Schema: schema.Name.O, Name: ti.Name.O}
+			if s.onlineDDL != nil && s.onlineDDL.TableType(sourceTable.Name) != onlineddl.RealTable {
+				continue
+			}
+			targetTable := s.route(sourceTable)
+			stmt, err := fetchCreateTableStmt(tctx, dbConn, p, targetTable)
+			if err != nil {
+				if tctx.Ctx.Err() != nil {
+					return drifts
+				}
+				tctx.L().Warn("fail to fetch downstream table", zap.Stringer("table", targetTable), log.ShortError(err))
+				continue
+			}
+			if drift := compareTableStructure(ti, stmt); !drift.empty() {
+				drifts[fmt.Sprintf("%s of %s", targetTable, sourceTable)] = drift.String()
+			}
+		}
+	}
+	return drifts
+}
+
+// fetchCreateTableStmt fetches and parses the CREATE TABLE statement of a downstream table.
+func fetchCreateTableStmt(tctx *tcontext.Context, dbConn *dbconn.DBConn, p *parser.Parser, table *filter.Table) (*ast.CreateTableStmt, error) {
+	rows, err := dbConn.QuerySQL(tctx, "SHOW CREATE TABLE "+table.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+		}
+		return nil, terror.ErrDBUnExpect.Generate(fmt.Sprintf("no result of SHOW CREATE TABLE %s", table))
+	}
+	var tableName, createSQL string
+	if err = rows.Scan(&tableName, &createSQL); err != nil {
+		return nil, terror.DBErrorAdapt(err, terror.ErrDBDriverError)
+	}
+	stmt, err := p.ParseOneStmt(createSQL, "", "")
+	if err != nil {
+		return nil, terror.ErrSchemaTrackerInvalidCreateTableStmt.Delegate(err, createSQL)
+	}
+	createStmt, ok := stmt.(*ast.CreateTableStmt)
+	if !ok {
+		return nil, terror.ErrSchemaTrackerInvalidCreateTableStmt.Generate(createSQL)
+	}
+	return createStmt, nil
+}
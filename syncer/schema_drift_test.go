@@ -0,0 +1,53 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/util/mock"
+)
+
+func (s *testSyncerSuite) TestCompareTableStructure(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	ti, err := createTableInfo(p, se, 1, `create table t(
+		id int primary key,
+		name varchar(20),
+		age int,
+		full_name varchar(40) as (concat(name, '_')) virtual)`)
+	c.Assert(err, IsNil)
+	parseCreate := func(sql string) *ast.CreateTableStmt {
+		stmt, err2 := p.ParseOneStmt(sql, "", "")
+		c.Assert(err2, IsNil)
+		return stmt.(*ast.CreateTableStmt)
+	}
+
+	// same structure, generated columns and extra nullable columns are ignored.
+	drift := compareTableStructure(ti, parseCreate(`create table t(
+		id int(11) not null, name varchar(20), age int, remark text, created timestamp not null, primary key(id))`))
+	c.Assert(drift.empty(), IsTrue)
+
+	// manual DDLs in downstream.
+	drift = compareTableStructure(ti, parseCreate(`create table t(
+		id bigint not null, name varchar(20), extra int not null, extra2 int not null default 0, primary key(id))`))
+	c.Assert(drift.empty(), IsFalse)
+	c.Assert(drift.missingColumns, DeepEquals, []string{"age"})
+	c.Assert(drift.extraColumns, DeepEquals, []string{"extra"})
+	c.Assert(drift.typeMismatches, DeepEquals, []string{"id: int vs bigint"})
+	c.Assert(drift.String(), Equals, "columns not exist in downstream: age; "+
+		"NOT NULL columns without default value only exist in downstream: extra; "+
+		"columns with different types (tracked vs downstream): id: int vs bigint")
+}
@@ -0,0 +1,282 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/dbutil"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/cputil"
+	"github.com/pingcap/dm/pkg/schema"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+// schemaSnapshotRecorder persists the tables of the schema tracker into a table in the downstream meta schema, so the
+// schema tracker is restored from it when the syncer starts, rather than fetching the tables from the downstream one
+// by one. the snapshot of the schema tracker is written in the same downstream transaction as the checkpoint, and only
+// the tables changed since the last flush are written.
+type schemaSnapshotRecorder struct {
+	cfg       *config.SubTaskConfig
+	tableName string // qualified table name: `dm_meta`.`task_syncer_schema_snapshot`
+
+	db     *conn.BaseDB
+	dbConn *dbconn.DBConn
+	logCtx *tcontext.Context
+
+	mu sync.Mutex
+	// the tables written to the downstream, source-schema -> source-table -> table info.
+	flushed map[string]map[string]snapshotTable
+}
+
+// snapshotTable is a table in the snapshot of the schema tracker.
+type snapshotTable struct {
+	ti      *model.TableInfo
+	tiBytes []byte
+}
+
+// schemaSnapshot is the snapshot of the schema tracker taken with the snapshot of the checkpoint.
+type schemaSnapshot struct {
+	tables map[string]map[string]snapshotTable
+	// the tables changed or dropped since the last flush.
+	changed []snapshotRow
+	dropped []*filter.Table
+}
+
+type snapshotRow struct {
+	schema  string
+	table   string
+	tiBytes []byte
+}
+
+// newSchemaSnapshotRecorder creates a new schemaSnapshotRecorder.
+func newSchemaSnapshotRecorder(tctx *tcontext.Context, cfg *config.SubTaskConfig) *schemaSnapshotRecorder {
+	return &schemaSnapshotRecorder{
+		cfg:       cfg,
+		tableName: dbutil.TableName(cfg.MetaSchema, cputil.SyncerSchemaSnapshot(cfg.Name)),
+		logCtx:    tcontext.Background().WithLogger(tctx.L().WithFields(zap.String("component", "schema snapshot recorder"))),
+		flushed:   make(map[string]map[string]snapshotTable),
+	}
+}
+
+// init creates the connection and the snapshot table.
+func (r *schemaSnapshotRecorder) init(tctx *tcontext.Context) error {
+	recorderDB := r.cfg.To
+	recorderDB.RawDBCfg = config.DefaultRawDBConfig().SetReadTimeout(maxCheckPointTimeout)
+	db, dbConns, err := dbconn.CreateConns(tctx, r.cfg, recorderDB, 1)
+	if err != nil {
+		return err
+	}
+	r.db = db
+	r.dbConn = dbConns[0]
+
+	sqls := []string{
+		fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", dbutil.ColumnName(r.cfg.MetaSchema)),
+		`CREATE TABLE IF NOT EXISTS ` + r.tableName + ` (
+			id VARCHAR(32) NOT NULL,
+			cp_schema VARCHAR(128) NOT NULL,
+			cp_table VARCHAR(128) NOT NULL,
+			table_info JSON NOT NULL,
+			update_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+			UNIQUE KEY uk_id_schema_table (id, cp_schema, cp_table)
+		)`,
+	}
+	_, err = r.dbConn.ExecuteSQL(tctx, sqls)
+	r.logCtx.L().Info("create schema snapshot table", zap.Strings("statements", sqls))
+	return terror.WithScope(err, terror.ScopeDownstream)
+}
+
+// load loads the snapshot of the schema tracker, the loaded tables are regarded as flushed.
+func (r *schemaSnapshotRecorder) load(tctx *tcontext.Context) (map[string]map[string]*model.TableInfo, error) {
+	query := `SELECT cp_schema, cp_table, table_info FROM ` + r.tableName + ` WHERE id = ?`
+	rows, err := r.dbConn.QuerySQL(tctx, query, r.cfg.SourceID)
+	if err != nil {
+		return nil, terror.WithScope(err, terror.ScopeDownstream)
+	}
+	defer rows.Close()
+
+	var (
+		tables  = make(map[string]map[string]*model.TableInfo)
+		flushed = make(map[string]map[string]snapshotTable)
+		count   int
+	)
+	for rows.Next() {
+		var (
+			cpSchema, cpTable string
+			tiBytes           []byte
+			ti                *model.TableInfo
+		)
+		if err = rows.Scan(&cpSchema, &cpTable, &tiBytes); err != nil {
+			return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+		}
+		if err = json.Unmarshal(tiBytes, &ti); err != nil {
+			return nil, terror.ErrSchemaTrackerInvalidJSON.Delegate(err, cpSchema, cpTable)
+		}
+		if _, ok := tables[cpSchema]; !ok {
+			tables[cpSchema] = make(map[string]*model.TableInfo)
+			flushed[cpSchema] = make(map[string]snapshotTable)
+		}
+		tables[cpSchema][cpTable] = ti
+		flushed[cpSchema][cpTable] = snapshotTable{tiBytes: tiBytes}
+		count++
+	}
+	if err = rows.Err(); err != nil {
+		return nil, terror.WithScope(terror.DBErrorAdapt(err, terror.ErrDBDriverError), terror.ScopeDownstream)
+	}
+
+	r.mu.Lock()
+	r.flushed = flushed
+	r.mu.Unlock()
+	r.logCtx.L().Info("load the snapshot of schema tracker", zap.Int("tables", count))
+	return tables, nil
+}
+
+// snapshot takes a snapshot of the tables of the schema tracker, and finds the tables changed or dropped since the
+// last flush. views and sequences are not recorded.
+func (r *schemaSnapshotRecorder) snapshot(tracker *schema.Tracker) (*schemaSnapshot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshot := &schemaSnapshot{tables: make(map[string]map[string]snapshotTable)}
+	for schemaName, mSchema := range tracker.AllTableInfos() {
+		for tableName, ti := range mSchema {
+			if ti.IsView() || ti.IsSequence() {
+				continue
+			}
+			flushedTable, ok := r.flushed[schemaName][tableName]
+			table := snapshotTable{ti: ti, tiBytes: flushedTable.tiBytes}
+			// the table info is replaced when the table is altered, so it's not serialized again if it's the same one.
+			if !ok || flushedTable.ti != ti {
+				tiBytes, err := json.Marshal(ti)
+				if err != nil {
+					return nil, terror.ErrSchemaTrackerCannotSerialize.Delegate(err, schemaName, tableName)
+				}
+				if !ok || !bytes.Equal(tiBytes, flushedTable.tiBytes) {
+					snapshot.changed = append(snapshot.changed, snapshotRow{schema: schemaName, table: tableName, tiBytes: tiBytes})
+				}
+				table.tiBytes = tiBytes
+			}
+			if _, ok = snapshot.tables[schemaName]; !ok {
+				snapshot.tables[schemaName] = make(map[string]snapshotTable)
+			}
+			snapshot.tables[schemaName][tableName] = table
+		}
+	}
+	for schemaName, mSchema := range r.flushed {
+		for tableName := range mSchema {
+			if _, ok := snapshot.tables[schemaName][tableName]; !ok {
+				snapshot.dropped = append(snapshot.dropped, &filter.Table{Schema: schemaName, Name: tableName})
+			}
+		}
+	}
+	return snapshot, nil
+}
+
+// flushSQLs returns the SQLs to write the snapshot, which are executed with the SQLs of the checkpoint.
+func (r *schemaSnapshotRecorder) flushSQLs(snapshot *schemaSnapshot) ([]string, [][]interface{}) {
+	var (
+		sqls = make([]string, 0, len(snapshot.changed)/checkpointFlushBatchSize+len(snapshot.dropped)+1)
+		args = make([][]interface{}, 0, cap(sqls))
+	)
+	for rows := snapshot.changed; len(rows) > 0; {
+		n := len(rows)
+		if n > checkpointFlushBatchSize {
+			n = checkpointFlushBatchSize
+		}
+		var buf strings.Builder
+		buf.WriteString(`INSERT INTO ` + r.tableName + ` (id, cp_schema, cp_table, table_info) VALUES `)
+		arg := make([]interface{}, 0, n*4)
+		for i, row := range rows[:n] {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString("(?, ?, ?, ?)")
+			arg = append(arg, r.cfg.SourceID, row.schema, row.table, string(row.tiBytes))
+		}
+		buf.WriteString(` ON DUPLICATE KEY UPDATE table_info = VALUES(table_info)`)
+		sqls = append(sqls, buf.String())
+		args = append(args, arg)
+		rows = rows[n:]
+	}
+	for _, table := range snapshot.dropped {
+		sqls = append(sqls, `DELETE FROM `+r.tableName+` WHERE id = ? AND cp_schema = ? AND cp_table = ?`)
+		args = append(args, []interface{}{r.cfg.SourceID, table.Schema, table.Name})
+	}
+	return sqls, args
+}
+
+// setFlushed records the tables of the snapshot as flushed after the SQLs of the snapshot are executed.
+func (r *schemaSnapshotRecorder) setFlushed(snapshot *schemaSnapshot) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.flushed = snapshot.tables
+}
+
+// remove removes the table from the snapshot, it's not restored even if the syncer restarts before the next flush.
+func (r *schemaSnapshotRecorder) remove(tctx *tcontext.Context, table *filter.Table) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, err := r.dbConn.ExecuteSQL(tctx, []string{`DELETE FROM ` + r.tableName + ` WHERE id = ? AND cp_schema = ? AND cp_table = ?`},
+		[]interface{}{r.cfg.SourceID, table.Schema, table.Name})
+	if err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	delete(r.flushed[table.Schema], table.Name)
+	return nil
+}
+
+// close closes the connection.
+func (r *schemaSnapshotRecorder) close() {
+	dbconn.CloseBaseDB(r.logCtx, r.db)
+}
+
+// restoreSchemaTracker restores the tables of the schema tracker from the snapshot. the flushed table info in the
+// checkpoint is preferred, because it may be set by `operate-schema set --flush` after the snapshot is flushed.
+func (s *Syncer) restoreSchemaTracker(tctx *tcontext.Context) error {
+	tables, err := s.schemaSnapshot.load(tctx)
+	if err != nil {
+		return err
+	}
+	startTime := time.Now()
+	count := 0
+	for schemaName, mSchema := range tables {
+		if err = s.schemaTracker.CreateSchemaIfNotExists(schemaName); err != nil {
+			return terror.ErrSchemaTrackerCannotCreateSchema.Delegate(err, schemaName)
+		}
+		for tableName, ti := range mSchema {
+			table := &filter.Table{Schema: schemaName, Name: tableName}
+			if flushedTI := s.checkpoint.GetFlushedTableInfo(table); flushedTI != nil {
+				ti = flushedTI
+			}
+			if err = s.schemaTracker.CreateTableIfNotExists(table, ti); err != nil {
+				return terror.ErrSchemaTrackerCannotCreateTable.Delegate(err, table)
+			}
+			count++
+		}
+	}
+	tctx.L().Info("restore schema tracker from the snapshot", zap.Int("tables", count), zap.Duration("cost time", time.Since(startTime)))
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/retry"
+	"github.com/pingcap/dm/pkg/schema"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+func (s *testSyncerSuite) TestSchemaSnapshotRecorder(c *C) {
+	tctx := tcontext.Background()
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "mysql-replica-01", MetaSchema: "dm_meta"}
+	recorder := newSchemaSnapshotRecorder(tctx, cfg)
+	c.Assert(recorder.tableName, Equals, "`dm_meta`.`test_syncer_schema_snapshot`")
+
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(tctx.Context())
+	c.Assert(err, IsNil)
+	recorder.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+
+	tracker, err := schema.NewTracker(context.Background(), cfg.Name, defaultTestSessionCfg, nil)
+	c.Assert(err, IsNil)
+	defer tracker.Close()
+	c.Assert(tracker.CreateSchemaIfNotExists("db"), IsNil)
+	c.Assert(tracker.Exec(tctx.Ctx, "db", "create table tb1 (a int primary key)"), IsNil)
+	c.Assert(tracker.Exec(tctx.Ctx, "db", "create table tb2 (a int primary key)"), IsNil)
+	c.Assert(tracker.Exec(tctx.Ctx, "db", "create view v as select * from tb1"), IsNil)
+
+	// all the tables are written at the first time, the view is not recorded.
+	snapshot, err := recorder.snapshot(tracker)
+	c.Assert(err, IsNil)
+	c.Assert(snapshot.changed, HasLen, 2)
+	c.Assert(snapshot.dropped, HasLen, 0)
+	sqls, args := recorder.flushSQLs(snapshot)
+	c.Assert(sqls, HasLen, 1)
+	c.Assert(sqls[0], Matches, "INSERT INTO `dm_meta`.`test_syncer_schema_snapshot` .* VALUES \\(\\?, \\?, \\?, \\?\\),\\(\\?, \\?, \\?, \\?\\) ON DUPLICATE KEY UPDATE .*")
+	c.Assert(args[0], HasLen, 8)
+	c.Assert(args[0][0], Equals, "mysql-replica-01")
+
+	// the table infos are not flushed, they are written again.
+	snapshot, err = recorder.snapshot(tracker)
+	c.Assert(err, IsNil)
+	c.Assert(snapshot.changed, HasLen, 2)
+	recorder.setFlushed(snapshot)
+	snapshot, err = recorder.snapshot(tracker)
+	c.Assert(err, IsNil)
+	c.Assert(snapshot.changed, HasLen, 0)
+	c.Assert(snapshot.dropped, HasLen, 0)
+	sqls, _ = recorder.flushSQLs(snapshot)
+	c.Assert(sqls, HasLen, 0)
+
+	// only the altered table is written, and the dropped table is deleted.
+	c.Assert(tracker.Exec(tctx.Ctx, "db", "alter table tb1 add column b int"), IsNil)
+	c.Assert(tracker.DropTable(&filter.Table{Schema: "db", Name: "tb2"}), IsNil)
+	snapshot, err = recorder.snapshot(tracker)
+	c.Assert(err, IsNil)
+	c.Assert(snapshot.changed, HasLen, 1)
+	c.Assert(snapshot.changed[0].table, Equals, "tb1")
+	c.Assert(snapshot.dropped, DeepEquals, []*filter.Table{{Schema: "db", Name: "tb2"}})
+	sqls, args = recorder.flushSQLs(snapshot)
+	c.Assert(sqls, HasLen, 2)
+	c.Assert(sqls[1], Equals, "DELETE FROM `dm_meta`.`test_syncer_schema_snapshot` WHERE id = ? AND cp_schema = ? AND cp_table = ?")
+	c.Assert(args[1], DeepEquals, []interface{}{"mysql-replica-01", "db", "tb2"})
+	recorder.setFlushed(snapshot)
+
+	// the loaded tables are regarded as flushed, the tables are not written again until they are changed.
+	ti, err := tracker.GetTableInfo(&filter.Table{Schema: "db", Name: "tb1"})
+	c.Assert(err, IsNil)
+	tiBytes, err := json.Marshal(ti)
+	c.Assert(err, IsNil)
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT cp_schema, cp_table, table_info FROM `dm_meta`.`test_syncer_schema_snapshot` WHERE id = ?")).
+		WithArgs("mysql-replica-01").WillReturnRows(sqlmock.NewRows([]string{"cp_schema", "cp_table", "table_info"}).
+		AddRow("db", "tb1", tiBytes).AddRow("db", "tb2", tiBytes))
+	tables, err := recorder.load(tctx)
+	c.Assert(err, IsNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	c.Assert(tables["db"], HasLen, 2)
+	c.Assert(tables["db"]["tb1"].Columns, HasLen, 2)
+	snapshot, err = recorder.snapshot(tracker)
+	c.Assert(err, IsNil)
+	c.Assert(snapshot.changed, HasLen, 0)
+	c.Assert(snapshot.dropped, HasLen, 1)
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec(regexp.QuoteMeta("DELETE FROM `dm_meta`.`test_syncer_schema_snapshot` WHERE id = ? AND cp_schema = ? AND cp_table = ?")).
+		WithArgs("mysql-replica-01", "db", "tb2").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+	c.Assert(recorder.remove(tctx, &filter.Table{Schema: "db", Name: "tb2"}), IsNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+	snapshot, err = recorder.snapshot(tracker)
+	c.Assert(err, IsNil)
+	c.Assert(snapshot.dropped, HasLen, 0)
+}
+
+func (s *testSyncerSuite) TestRestoreSchemaTracker(c *C) {
+	tctx := tcontext.Background()
+	cfg := &config.SubTaskConfig{Name: "test", SourceID: "mysql-replica-01", MetaSchema: "dm_meta"}
+	recorder := newSchemaSnapshotRecorder(tctx, cfg)
+	db, dbMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	dbConn, err := db.Conn(tctx.Context())
+	c.Assert(err, IsNil)
+	recorder.dbConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+
+	tracker, err := schema.NewTracker(context.Background(), cfg.Name, defaultTestSessionCfg, nil)
+	c.Assert(err, IsNil)
+	defer tracker.Close()
+	c.Assert(tracker.CreateSchemaIfNotExists("db"), IsNil)
+	c.Assert(tracker.Exec(tctx.Ctx, "db", "create table tb1 (a int primary key)"), IsNil)
+	c.Assert(tracker.Exec(tctx.Ctx, "db", "create table tb2 (a int primary key, b int)"), IsNil)
+	tb1 := &filter.Table{Schema: "db", Name: "tb1"}
+	tb2 := &filter.Table{Schema: "db", Name: "tb2"}
+	ti1, err := tracker.GetTableInfo(tb1)
+	c.Assert(err, IsNil)
+	ti2, err := tracker.GetTableInfo(tb2)
+	c.Assert(err, IsNil)
+	ti1Bytes, err := json.Marshal(ti1)
+	c.Assert(err, IsNil)
+	ti2Bytes, err := json.Marshal(ti2)
+	c.Assert(err, IsNil)
+	c.Assert(tracker.Reset(), IsNil)
+
+	// the table info flushed in the checkpoint is preferred.
+	cp := NewRemoteCheckPoint(tctx, cfg, cfg.SourceID)
+	cp.(*RemoteCheckPoint).points["db"] = map[string]*binlogPoint{"tb2": {flushedTI: ti1}}
+	syncer := &Syncer{cfg: cfg, schemaTracker: tracker, checkpoint: cp, schemaSnapshot: recorder}
+	dbMock.ExpectQuery(regexp.QuoteMeta("SELECT cp_schema, cp_table, table_info FROM `dm_meta`.`test_syncer_schema_snapshot` WHERE id = ?")).
+		WithArgs("mysql-replica-01").WillReturnRows(sqlmock.NewRows([]string{"cp_schema", "cp_table", "table_info"}).
+		AddRow("db", "tb1", ti1Bytes).AddRow("db", "tb2", ti2Bytes))
+	c.Assert(syncer.restoreSchemaTracker(tctx), IsNil)
+	c.Assert(dbMock.ExpectationsWereMet(), IsNil)
+
+	ti, err := tracker.GetTableInfo(tb1)
+	c.Assert(err, IsNil)
+	c.Assert(ti.Columns, HasLen, 1)
+	ti, err = tracker.GetTableInfo(tb2)
+	c.Assert(err, IsNil)
+	c.Assert(ti.Columns, HasLen, 1)
+}
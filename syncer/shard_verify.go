@@ -0,0 +1,274 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/unit"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+	onlineddl "github.com/pingcap/dm/syncer/online-ddl-tools"
+)
+
+// shardSampleMaxKeys is the max number of the sampled row keys kept by shardRowChecker.
+const shardSampleMaxKeys = 100000
+
+// shardConflict describes the incompatible schemas of the shard tables merged into a downstream table.
+type shardConflict struct {
+	tables []string // the offending shard tables
+	desc   string
+}
+
+// compareShardSchemas compares the schemas of the shard tables merged into the same downstream table with the first
+// one, the same columns should have the same types and the primary keys should be the same. the tables should be
+// sorted. it returns nil if they're compatible.
+func compareShardSchemas(tables []*filter.Table, tis []*model.TableInfo) *shardConflict {
+	if len(tables) < 2 {
+		return nil
+	}
+	var (
+		base      = tis[0]
+		baseCols  = make(map[string]*model.ColumnInfo, len(base.Columns))
+		basePK    = primaryKeyNames(base)
+		offending = make(map[string]struct{})
+		descs     []string
+	)
+	for _, col := range base.Columns {
+		baseCols[col.Name.L] = col
+	}
+	for i := 1; i < len(tables); i++ {
+		for _, col := range tis[i].Columns {
+			baseCol, ok := baseCols[col.Name.L]
+			if !ok || (baseCol.Tp == col.Tp && mysql.HasUnsignedFlag(baseCol.Flag) == mysql.HasUnsignedFlag(col.Flag)) {
+				continue
+			}
+			offending[tables[i].String()] = struct{}{}
+			descs = append(descs, fmt.Sprintf("column %s: %s of %s vs %s of %s",
+				col.Name.O, baseCol.GetTypeDesc(), tables[0], col.GetTypeDesc(), tables[i]))
+		}
+		if pk := primaryKeyNames(tis[i]); pk != basePK {
+			offending[tables[i].String()] = struct{}{}
+			descs = append(descs, fmt.Sprintf("primary key (%s) of %s vs (%s) of %s", basePK, tables[0], pk, tables[i]))
+		}
+	}
+	if len(descs) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(offending)+1)
+	names = append(names, tables[0].String())
+	for name := range offending {
+		names = append(names, name)
+	}
+	sort.Strings(names[1:])
+	return &shardConflict{tables: names, desc: strings.Join(descs, "; ")}
+}
+
+// primaryKeyNames returns the column names of the primary key joined by comma.
+func primaryKeyNames(ti *model.TableInfo) string {
+	cols := primaryKeyColumns(ti)
+	names := make([]string, 0, len(cols))
+	for _, col := range cols {
+		names = append(names, col.Name.L)
+	}
+	return strings.Join(names, ",")
+}
+
+// checkShardSchemas compares the tracked schemas of the shard tables merged into the same downstream table, and
+// returns the conflicts keyed by the downstream table. the tables in unresolved sharding groups are skipped because
+// their schemas are changed one by one by the sharding DDL.
+func (s *Syncer) checkShardSchemas() map[string]*shardConflict {
+	var unresolved map[string]bool
+	if s.cfg.ShardMode == config.ShardPessimistic {
+		unresolved, _ = s.sgk.UnresolvedTables()
+	}
+
+	type shard struct {
+		table *filter.Table
+		ti    *model.TableInfo
+	}
+	groups := make(map[string][]shard)
+	for _, schema := range s.schemaTracker.AllSchemas() {
+		for _, ti := range schema.Tables {
+			sourceTable := &filter.Table{Schema: schema.Name.O, Name: ti.Name.O}
+			if s.onlineDDL != nil && s.onlineDDL.TableType(sourceTable.Name) != onlineddl.RealTable {
+				continue
+			}
+			targetID := utils.GenTableID(s.route(sourceTable))
+			if unresolved[targetID] {
+				continue
+			}
+			groups[targetID] = append(groups[targetID], shard{table: sourceTable, ti: ti})
+		}
+	}
+
+	conflicts := make(map[string]*shardConflict)
+	for targetID, shards := range groups {
+		sort.Slice(shards, func(i, j int) bool {
+			return shards[i].table.String() < shards[j].table.String()
+		})
+		tables := make([]*filter.Table, 0, len(shards))
+		tis := make([]*model.TableInfo, 0, len(shards))
+		for _, sh := range shards {
+			tables = append(tables, sh.table)
+			tis = append(tis, sh.ti)
+		}
+		if conflict := compareShardSchemas(tables, tis); conflict != nil {
+			conflicts[targetID] = conflict
+		}
+	}
+	return conflicts
+}
+
+// shardVerifyLoop compares the schemas of the shard tables every `shard-verify-interval`, the task is paused with
+// ErrShardConflictSchema if a conflict is found in two consecutive checks, because the schemas are changed one by one
+// when a DDL is replicated to all shard tables.
+func (s *Syncer) shardVerifyLoop(ctx context.Context) {
+	interval := time.Duration(s.cfg.ShardVerifyInterval) * time.Second
+	var lastConflicts map[string]*shardConflict
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		conflicts := s.checkShardSchemas()
+		for targetID, conflict := range conflicts {
+			if last, ok := lastConflicts[targetID]; !ok || last.desc != conflict.desc {
+				continue
+			}
+			err := terror.ErrShardConflictSchema.Generate(shardTablesString(conflict.tables, s.cfg.SourceID), targetID, conflict.desc)
+			s.tctx.L().Error("shard conflict detected", zap.Error(err))
+			s.runFatalChan <- unit.NewProcessError(err)
+			return
+		}
+		lastConflicts = conflicts
+	}
+}
+
+// shardTablesString returns the string of the shard tables with the source ID.
+func shardTablesString(tables []string, sourceID string) string {
+	return fmt.Sprintf("%s of source %s", strings.Join(tables, ", "), sourceID)
+}
+
+// sampledRow is a row sampled by shardRowChecker.
+type sampledRow struct {
+	source   string
+	checksum uint32
+}
+
+// shardRowChecker checks whether different shard tables write different rows with the same primary key into the
+// downstream table. the rows are sampled by the hash of the primary key, so the rows with the same primary key from
+// all shard tables are always sampled or not.
+// NOTE: only the shard tables of the same source are checked, the sampled rows are kept in memory.
+type shardRowChecker struct {
+	sampleRate int // percent
+	maxKeys    int
+	rows       map[string]sampledRow // the key of the primary key in downstream -> sampled row
+}
+
+// newShardRowChecker creates a new shardRowChecker.
+func newShardRowChecker(sampleRate int) *shardRowChecker {
+	return &shardRowChecker{
+		sampleRate: sampleRate,
+		maxKeys:    shardSampleMaxKeys,
+		rows:       make(map[string]sampledRow),
+	}
+}
+
+// primaryKey returns the key of the primary key of values in the downstream table, false if the row is not sampled.
+func (c *shardRowChecker) primaryKey(dml *DML, values []interface{}) (string, bool) {
+	pkCols := primaryKeyColumns(dml.sourceTableInfo)
+	if len(pkCols) == 0 || values == nil {
+		return "", false
+	}
+	vals := make([]interface{}, 0, len(pkCols))
+	for _, col := range pkCols {
+		vals = append(vals, values[col.Offset])
+	}
+	key := genKeyList(dml.targetTableID, pkCols, vals)
+	if key == "" || crc32.ChecksumIEEE([]byte(key))%100 >= uint32(c.sampleRate) {
+		return "", false
+	}
+	return key, true
+}
+
+// rowChecksum returns the checksum of the replicated values of the row.
+func rowChecksum(dml *DML) uint32 {
+	columns, values := dml.loggedColumnsAndValues()
+	var buf strings.Builder
+	for i, col := range columns {
+		buf.WriteString(col.Name.L)
+		buf.WriteByte('=')
+		buf.WriteString(columnValue(values[i], &col.FieldType))
+		buf.WriteByte(',')
+	}
+	return crc32.ChecksumIEEE([]byte(buf.String()))
+}
+
+// check checks the sampled rows of the DMLs, it returns ErrShardConflictRow if a row conflicts with the row of another
+// shard table. the same row written by different shard tables is not a conflict.
+func (c *shardRowChecker) check(dmls []*DML, sourceID string) error {
+	for _, dml := range dmls {
+		source := dml.sourceTable.String()
+		if dml.op == update || dml.op == del {
+			oldValues := dml.originOldValues
+			if dml.op == del {
+				oldValues = dml.originValues
+			}
+			if key, ok := c.primaryKey(dml, oldValues); ok {
+				if row, ok2 := c.rows[key]; ok2 && row.source == source {
+					delete(c.rows, key)
+				}
+			}
+			if dml.op == del {
+				continue
+			}
+		}
+
+		key, ok := c.primaryKey(dml, dml.originValues)
+		if !ok {
+			continue
+		}
+		checksum := rowChecksum(dml)
+		if row, ok2 := c.rows[key]; ok2 && row.source != source && row.checksum != checksum {
+			tables := []string{row.source, source}
+			sort.Strings(tables)
+			return terror.ErrShardConflictRow.Generate(shardTablesString(tables, sourceID), dml.targetTableID, key)
+		}
+		if _, ok2 := c.rows[key]; !ok2 && len(c.rows) >= c.maxKeys {
+			// evict an arbitrary row.
+			for k := range c.rows {
+				delete(c.rows, k)
+				break
+			}
+		}
+		c.rows[key] = sampledRow{source: source, checksum: checksum}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+func (s *testSyncerSuite) TestCompareShardSchemas(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	createTables := func(sqls ...string) []*model.TableInfo {
+		tis := make([]*model.TableInfo, 0, len(sqls))
+		for i, sql := range sqls {
+			ti, err := createTableInfo(p, se, int64(i+1), sql)
+			c.Assert(err, IsNil)
+			tis = append(tis, ti)
+		}
+		return tis
+	}
+	tables := []*filter.Table{{Schema: "db", Name: "tb1"}, {Schema: "db", Name: "tb2"}, {Schema: "db", Name: "tb3"}}
+
+	// a single table.
+	tis := createTables("create table tb1(id int primary key, a int)")
+	c.Assert(compareShardSchemas(tables[:1], tis), IsNil)
+
+	// the different columns are allowed.
+	tis = createTables(
+		"create table tb1(id int primary key, a int)",
+		"create table tb2(id int primary key, a int, b varchar(10))",
+		"create table tb3(id int primary key)")
+	c.Assert(compareShardSchemas(tables, tis), IsNil)
+
+	tis = createTables(
+		"create table tb1(id int primary key, a int)",
+		"create table tb2(id int primary key, a varchar(10))",
+		"create table tb3(id int, a int, b int, primary key(id, b))")
+	conflict := compareShardSchemas(tables, tis)
+	c.Assert(conflict, NotNil)
+	c.Assert(conflict.tables, DeepEquals, []string{"`db`.`tb1`", "`db`.`tb2`", "`db`.`tb3`"})
+	c.Assert(conflict.desc, Equals, "column a: int(11) of `db`.`tb1` vs varchar(10) of `db`.`tb2`; "+
+		"primary key (id) of `db`.`tb1` vs (id,b) of `db`.`tb3`")
+}
+
+func (s *testSyncerSuite) TestShardRowChecker(c *C) {
+	ti, err := createTableInfo(parser.New(), mock.NewContext(), 1, "create table tb(id int primary key, a varchar(10))")
+	c.Assert(err, IsNil)
+	noPK, err := createTableInfo(parser.New(), mock.NewContext(), 2, "create table tb(id int, a varchar(10))")
+	c.Assert(err, IsNil)
+	tb1 := &filter.Table{Schema: "db", Name: "tb1"}
+	tb2 := &filter.Table{Schema: "db", Name: "tb2"}
+	target := "`db`.`tb`"
+	insertDML := func(table *filter.Table, ti *model.TableInfo, values []interface{}) *DML {
+		return newDML(insert, false, target, table, nil, values, nil, values, ti.Columns, ti)
+	}
+
+	// all rows are sampled.
+	checker := newShardRowChecker(100)
+	c.Assert(checker.check([]*DML{
+		insertDML(tb1, ti, []interface{}{int32(1), "a"}),
+		insertDML(tb2, ti, []interface{}{int32(2), "b"}),
+		// the same row is not a conflict.
+		insertDML(tb2, ti, []interface{}{int32(1), "a"}),
+		// the tables without primary key are not checked.
+		insertDML(tb1, noPK, []interface{}{int32(3), "c"}),
+		insertDML(tb2, noPK, []interface{}{int32(3), "d"}),
+	}, "mysql-replica-01"), IsNil)
+	c.Assert(checker.rows, HasLen, 2)
+
+	// the row deleted by the owner is forgotten.
+	c.Assert(checker.check([]*DML{
+		newDML(del, false, target, tb1, nil, []interface{}{int32(1), "a"}, nil, []interface{}{int32(1), "a"}, ti.Columns, ti),
+		insertDML(tb2, ti, []interface{}{int32(1), "x"}),
+	}, "mysql-replica-01"), IsNil)
+
+	// UPDATE to the primary key of another shard table.
+	oldValues, newValues := []interface{}{int32(5), "e"}, []interface{}{int32(2), "e"}
+	err = checker.check([]*DML{
+		newDML(update, false, target, tb1, oldValues, newValues, oldValues, newValues, ti.Columns, ti),
+	}, "mysql-replica-01")
+	c.Assert(terror.ErrShardConflictRow.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*shard tables `db`.`tb1`, `db`.`tb2` of source mysql-replica-01 merged into `db`.`tb` "+
+		"write different rows with the same primary key 2.id.`db`.`tb`.*")
+
+	// no row is sampled.
+	checker = newShardRowChecker(0)
+	c.Assert(checker.check([]*DML{
+		insertDML(tb1, ti, []interface{}{int32(1), "a"}),
+		insertDML(tb2, ti, []interface{}{int32(1), "b"}),
+	}, "mysql-replica-01"), IsNil)
+	c.Assert(checker.rows, HasLen, 0)
+
+	// the rows are evicted when exceeding the max keys.
+	checker = newShardRowChecker(100)
+	checker.maxKeys = 1
+	c.Assert(checker.check([]*DML{
+		insertDML(tb1, ti, []interface{}{int32(1), "a"}),
+		insertDML(tb1, ti, []interface{}{int32(2), "b"}),
+	}, "mysql-replica-01"), IsNil)
+	c.Assert(checker.rows, HasLen, 1)
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"go.uber.org/zap"
+
+	tcontext "github.com/pingcap/dm/pkg/context"
+	parserpkg "github.com/pingcap/dm/pkg/parser"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/syncer/sink"
+)
+
+// rowChange converts the DML into a row change of the target table.
+func (dml *DML) rowChange(targetTable *filter.Table, ts uint32) *sink.RowChange {
+	row := &sink.RowChange{
+		Schema:      targetTable.Schema,
+		Table:       targetTable.Name,
+		Columns:     dml.columnNames(),
+		ColumnTypes: make([]string, 0, len(dml.columns)),
+		Values:      dml.values,
+		Key:         dml.key,
+		Timestamp:   ts,
+	}
+	for _, col := range dml.columns {
+		row.ColumnTypes = append(row.ColumnTypes, col.GetTypeDesc())
+	}
+	if row.Key == "" {
+		row.Key = dml.targetTableID
+	}
+	if idx := findFitIndex(dml.sourceTableInfo); idx != nil {
+		row.PKColumns = make([]string, 0, len(idx.Columns))
+		for _, col := range idx.Columns {
+			row.PKColumns = append(row.PKColumns, col.Name.O)
+		}
+	}
+
+	switch dml.op {
+	case insert:
+		row.Type = sink.RowChangeInsert
+	case update:
+		row.Type = sink.RowChangeUpdate
+		row.PreValues = dml.oldValues
+	case del:
+		row.Type = sink.RowChangeDelete
+	}
+	return row
+}
+
+// emitJobsToSink emits the row changes of DML jobs to the sink.
+func emitJobsToSink(tctx *tcontext.Context, s *sink.Sink, jobs []*job) error {
+	rows := make([]*sink.RowChange, 0, len(jobs))
+	for _, j := range jobs {
+		var ts uint32
+		if j.eventHeader != nil {
+			ts = j.eventHeader.Timestamp
+		}
+		rows = append(rows, j.dml.rowChange(j.targetTable, ts))
+	}
+	return s.EmitRowChanges(tctx.Ctx, rows)
+}
+
+// emitDDLToSink emits the DDLs of a DDL job to the sink, they are not executed in the target database.
+func (s *Syncer) emitDDLToSink(tctx *tcontext.Context, ddlJob *job) error {
+	var ts uint32
+	if ddlJob.eventHeader != nil {
+		ts = ddlJob.eventHeader.Timestamp
+	}
+	// DDLs are already routed and restored with the qualified target table names.
+	p := parser.New()
+	for _, ddl := range ddlJob.ddls {
+		stmt, err := p.ParseOneStmt(ddl, "", "")
+		if err != nil {
+			return terror.ErrSyncerParseDDL.Delegate(err, ddl)
+		}
+		tables, err := parserpkg.FetchDDLTables("", stmt, utils.LCTableNamesSensitive)
+		if err != nil {
+			return err
+		}
+		if len(tables) == 0 || tables[0].Name == "" {
+			tctx.L().Debug("skip to emit DDL without table to sink", zap.String("DDL", ddl))
+			continue
+		}
+		err = s.sink.EmitDDL(tctx.Ctx, &sink.DDLEvent{
+			Schema:    tables[0].Schema,
+			Table:     tables[0].Name,
+			Query:     ddl,
+			Timestamp: ts,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+const (
+	// the magic byte of the Confluent wire format, followed by the 4-byte schema ID and the avro binary data.
+	avroMagicByte = 0
+
+	avroOpField = "_dm_op"
+	avroTSField = "_dm_ts"
+
+	schemaRegistryTimeout = 10 * time.Second
+)
+
+// avro types of the columns.
+const (
+	avroTypeLong   = "long"
+	avroTypeDouble = "double"
+	avroTypeBytes  = "bytes"
+	avroTypeString = "string"
+)
+
+// schemaRegistry registers the avro schemas and returns their IDs.
+type schemaRegistry interface {
+	register(subject, schema string) (int32, error)
+}
+
+// httpSchemaRegistry is a client of the Confluent schema registry.
+type httpSchemaRegistry struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPSchemaRegistry(registryURL string) *httpSchemaRegistry {
+	return &httpSchemaRegistry{
+		url:    strings.TrimSuffix(registryURL, "/"),
+		client: &http.Client{Timeout: schemaRegistryTimeout},
+	}
+}
+
+// register registers the schema under the subject, the ID of the existing schema is returned if it's registered.
+func (r *httpSchemaRegistry) register(subject, schema string) (int32, error) {
+	body, err := json.Marshal(map[string]string{"schema": schema})
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	resp, err := r.client.Post(r.url+"/subjects/"+url.PathEscape(subject)+"/versions",
+		"application/vnd.schemaregistry.v1+json", bytes.NewReader(body))
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.Errorf("register avro schema of subject %s: %s %s", subject, resp.Status, data)
+	}
+	var result struct {
+		ID int32 `json:"id"`
+	}
+	if err = json.Unmarshal(data, &result); err != nil {
+		return 0, errors.Annotatef(err, "register avro schema of subject %s: %s", subject, data)
+	}
+	return result.ID, nil
+}
+
+// avroEncoder encodes row changes into the Confluent wire format, the value schema of a table is registered under the
+// subject `<topic>-value`. the message contains all the columns of the row after INSERT/UPDATE or before DELETE, and
+// `_dm_op`/`_dm_ts` for the type and the timestamp of the change.
+type avroEncoder struct {
+	topic    string
+	registry schemaRegistry
+
+	mu sync.Mutex
+	// subject -> the latest registered schema and its ID, the schema is registered again after it's changed by DDL.
+	schemas map[string]avroSchemaID
+}
+
+type avroSchemaID struct {
+	schema string
+	id     int32
+}
+
+func newAvroEncoder(topic string, registry schemaRegistry) *avroEncoder {
+	return &avroEncoder{
+		topic:    topic,
+		registry: registry,
+		schemas:  make(map[string]avroSchemaID),
+	}
+}
+
+type avroField struct {
+	Name    string      `json:"name"`
+	Type    interface{} `json:"type"`
+	Default interface{} `json:"default"`
+}
+
+type avroRecord struct {
+	Type      string      `json:"type"`
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace"`
+	Fields    []avroField `json:"fields"`
+}
+
+func (e *avroEncoder) EncodeRowChange(row *RowChange) ([]byte, error) {
+	types := make([]string, len(row.Columns))
+	record := avroRecord{
+		Type:      "record",
+		Name:      avroName(row.Table),
+		Namespace: avroName(row.Schema),
+		Fields:    make([]avroField, 0, len(row.Columns)+2),
+	}
+	for i, col := range row.Columns {
+		var desc string
+		if i < len(row.ColumnTypes) {
+			desc = row.ColumnTypes[i]
+		}
+		types[i] = avroColumnType(desc)
+		// the columns are nullable, and the default value of a union is the one of its first type.
+		record.Fields = append(record.Fields, avroField{Name: avroName(col), Type: []string{"null", types[i]}})
+	}
+	record.Fields = append(record.Fields,
+		avroField{Name: avroOpField, Type: avroTypeString, Default: ""},
+		avroField{Name: avroTSField, Type: avroTypeLong, Default: 0})
+	schema, err := json.Marshal(record)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	id, err := e.schemaID(topicName(e.topic, row.Schema, row.Table)+"-value", string(schema))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(avroMagicByte)
+	var idBuf [4]byte
+	binary.BigEndian.PutUint32(idBuf[:], uint32(id))
+	buf.Write(idBuf[:])
+	for i, col := range row.Columns {
+		var value interface{}
+		if i < len(row.Values) {
+			value = row.Values[i]
+		}
+		if err = writeAvroValue(&buf, types[i], value); err != nil {
+			return nil, errors.Annotatef(err, "encode column %s of %s.%s", col, row.Schema, row.Table)
+		}
+	}
+	writeAvroString(&buf, strings.ToLower(string(row.Type)))
+	writeAvroLong(&buf, int64(row.Timestamp))
+	return buf.Bytes(), nil
+}
+
+// EncodeDDL ignores DDLs, the schema changes are carried by the schema IDs of the messages.
+func (e *avroEncoder) EncodeDDL(*DDLEvent) ([]byte, error) {
+	return nil, nil
+}
+
+// schemaID returns the ID of the schema, it's registered if it's different from the last one of the subject.
+func (e *avroEncoder) schemaID(subject, schema string) (int32, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if s, ok := e.schemas[subject]; ok && s.schema == schema {
+		return s.id, nil
+	}
+	id, err := e.registry.register(subject, schema)
+	if err != nil {
+		return 0, err
+	}
+	e.schemas[subject] = avroSchemaID{schema: schema, id: id}
+	return id, nil
+}
+
+// avroColumnType returns the avro type of the column by its type description, like `bigint(20) unsigned`.
+func avroColumnType(desc string) string {
+	desc = strings.ToLower(desc)
+	base := desc
+	if i := strings.IndexAny(base, "( "); i >= 0 {
+		base = base[:i]
+	}
+	switch base {
+	case "tinyint", "smallint", "mediumint", "int", "integer", "year", "bit":
+		return avroTypeLong
+	case "bigint":
+		// unsigned bigint may overflow long.
+		if strings.Contains(desc, "unsigned") {
+			return avroTypeString
+		}
+		return avroTypeLong
+	case "float", "double", "real":
+		return avroTypeDouble
+	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob":
+		return avroTypeBytes
+	default:
+		return avroTypeString
+	}
+}
+
+// avroName replaces the characters not allowed in an avro name by `_`.
+func avroName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// writeAvroValue writes a value of the union ["null", tp].
+func writeAvroValue(buf *bytes.Buffer, tp string, value interface{}) error {
+	if value == nil {
+		writeAvroLong(buf, 0)
+		return nil
+	}
+	writeAvroLong(buf, 1)
+	switch tp {
+	case avroTypeLong:
+		v, err := avroLong(value)
+		if err != nil {
+			return err
+		}
+		writeAvroLong(buf, v)
+	case avroTypeDouble:
+		v, err := avroDouble(value)
+		if err != nil {
+			return err
+		}
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+		buf.Write(b[:])
+	case avroTypeBytes:
+		if b, ok := value.([]byte); ok {
+			writeAvroBytes(buf, b)
+		} else {
+			writeAvroString(buf, valueString(value))
+		}
+	default:
+		writeAvroString(buf, valueString(value))
+	}
+	return nil
+}
+
+func avroLong(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, errors.Errorf("%d overflows avro long", v)
+		}
+		return int64(v), nil
+	default:
+		v2, err := strconv.ParseInt(valueString(value), 10, 64)
+		return v2, errors.Trace(err)
+	}
+}
+
+func avroDouble(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	default:
+		v2, err := strconv.ParseFloat(valueString(value), 64)
+		return v2, errors.Trace(err)
+	}
+}
+
+// writeAvroLong writes the long in zig-zag variable-length encoding.
+func writeAvroLong(buf *bytes.Buffer, v int64) {
+	var b [binary.MaxVarintLen64]byte
+	buf.Write(b[:binary.PutVarint(b[:], v)])
+}
+
+func writeAvroBytes(buf *bytes.Buffer, b []byte) {
+	writeAvroLong(buf, int64(len(b)))
+	buf.Write(b)
+}
+
+func writeAvroString(buf *bytes.Buffer, s string) {
+	writeAvroLong(buf, int64(len(s)))
+	buf.WriteString(s)
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+)
+
+type mockSchemaRegistry struct {
+	schemas []string
+}
+
+func (r *mockSchemaRegistry) register(_, schema string) (int32, error) {
+	for i, s := range r.schemas {
+		if s == schema {
+			return int32(i + 1), nil
+		}
+	}
+	r.schemas = append(r.schemas, schema)
+	return int32(len(r.schemas)), nil
+}
+
+// avroReader reads the avro binary data for test.
+type avroReader struct {
+	*bytes.Reader
+}
+
+func (r avroReader) long(c *C) int64 {
+	v, err := binary.ReadVarint(r)
+	c.Assert(err, IsNil)
+	return v
+}
+
+func (r avroReader) bytes(c *C) []byte {
+	b := make([]byte, r.long(c))
+	_, err := io.ReadFull(r, b)
+	c.Assert(err, IsNil)
+	return b
+}
+
+func (t *testSinkSuite) TestAvroEncoder(c *C) {
+	registry := &mockSchemaRegistry{}
+	encoder := newAvroEncoder(config.DefaultSinkTopic, registry)
+
+	row := newTestRowChange(RowChangeUpdate)
+	row.Columns = append(row.Columns, "price", "big")
+	row.ColumnTypes = []string{"int(11)", "varchar(20)", "blob", "double", "bigint(20) unsigned"}
+	row.Values = append(row.Values, 1.5, uint64(math.MaxUint64))
+	data, err := encoder.EncodeRowChange(row)
+	c.Assert(err, IsNil)
+	c.Assert(registry.schemas, HasLen, 1)
+
+	var schema avroRecord
+	c.Assert(json.Unmarshal([]byte(registry.schemas[0]), &schema), IsNil)
+	c.Assert(schema.Name, Equals, "tb")
+	c.Assert(schema.Namespace, Equals, "db")
+	c.Assert(schema.Fields, HasLen, 7)
+	c.Assert(schema.Fields[0].Type, DeepEquals, []interface{}{"null", "long"})
+	c.Assert(schema.Fields[2].Type, DeepEquals, []interface{}{"null", "bytes"})
+	c.Assert(schema.Fields[3].Type, DeepEquals, []interface{}{"null", "double"})
+	c.Assert(schema.Fields[4].Type, DeepEquals, []interface{}{"null", "string"})
+	c.Assert(schema.Fields[5].Name, Equals, avroOpField)
+
+	c.Assert(data[0], Equals, byte(avroMagicByte))
+	c.Assert(binary.BigEndian.Uint32(data[1:5]), Equals, uint32(1))
+	r := avroReader{bytes.NewReader(data[5:])}
+	c.Assert(r.long(c), Equals, int64(1))
+	c.Assert(r.long(c), Equals, int64(1))
+	c.Assert(r.long(c), Equals, int64(1))
+	c.Assert(string(r.bytes(c)), Equals, "b")
+	c.Assert(r.long(c), Equals, int64(1))
+	c.Assert(string(r.bytes(c)), Equals, "c")
+	c.Assert(r.long(c), Equals, int64(1))
+	var b [8]byte
+	_, err = io.ReadFull(r, b[:])
+	c.Assert(err, IsNil)
+	c.Assert(math.Float64frombits(binary.LittleEndian.Uint64(b[:])), Equals, 1.5)
+	c.Assert(r.long(c), Equals, int64(1))
+	c.Assert(string(r.bytes(c)), Equals, "18446744073709551615")
+	c.Assert(string(r.bytes(c)), Equals, "update")
+	c.Assert(r.long(c), Equals, int64(1639633094))
+	c.Assert(r.Len(), Equals, 0)
+
+	// the schema is registered once.
+	row.Values[1] = nil
+	data, err = encoder.EncodeRowChange(row)
+	c.Assert(err, IsNil)
+	c.Assert(registry.schemas, HasLen, 1)
+	r = avroReader{bytes.NewReader(data[5:])}
+	r.long(c)
+	r.long(c)
+	c.Assert(r.long(c), Equals, int64(0)) // NULL
+
+	// the changed schema is registered again.
+	row.Columns[4] = "2nd-big"
+	data, err = encoder.EncodeRowChange(row)
+	c.Assert(err, IsNil)
+	c.Assert(registry.schemas, HasLen, 2)
+	c.Assert(binary.BigEndian.Uint32(data[1:5]), Equals, uint32(2))
+	c.Assert(json.Unmarshal([]byte(registry.schemas[1]), &schema), IsNil)
+	c.Assert(schema.Fields[4].Name, Equals, "_2nd_big")
+
+	row.Values[0] = "not a number"
+	_, err = encoder.EncodeRowChange(row)
+	c.Assert(err, ErrorMatches, ".*encode column id of db.tb.*")
+
+	data, err = encoder.EncodeDDL(&DDLEvent{Schema: "db", Table: "tb", Query: "ALTER TABLE `db`.`tb` ADD COLUMN `c` INT"})
+	c.Assert(err, IsNil)
+	c.Assert(data, IsNil)
+}
+
+func (t *testSinkSuite) TestHTTPSchemaRegistry(c *C) {
+	var subject, schema string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Schema string `json:"schema"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		subject, schema = r.URL.Path, req.Schema
+		if req.Schema == "invalid" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"error_code":42201,"message":"Invalid schema"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":12}`))
+	}))
+	defer server.Close()
+
+	encoder, err := NewEncoder(&config.SinkConfig{Protocol: config.SinkProtocolAvro, SchemaRegistry: server.URL + "/"})
+	c.Assert(err, IsNil)
+	registry := encoder.(*avroEncoder).registry
+	id, err := registry.register("db_tb-value", `"string"`)
+	c.Assert(err, IsNil)
+	c.Assert(id, Equals, int32(12))
+	c.Assert(subject, Equals, "/subjects/db_tb-value/versions")
+	c.Assert(schema, Equals, `"string"`)
+
+	_, err = registry.register("db_tb-value", "invalid")
+	c.Assert(err, ErrorMatches, ".*422 Unprocessable Entity.*Invalid schema.*")
+}
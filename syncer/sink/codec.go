@@ -0,0 +1,175 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// Encoder encodes row changes and DDLs into message values.
+type Encoder interface {
+	EncodeRowChange(row *RowChange) ([]byte, error)
+	// EncodeDDL returns nil if the protocol doesn't support DDL messages.
+	EncodeDDL(ddl *DDLEvent) ([]byte, error)
+}
+
+// NewEncoder creates an Encoder for the protocol of the sink.
+func NewEncoder(cfg *config.SinkConfig) (Encoder, error) {
+	switch cfg.Protocol {
+	case config.SinkProtocolCanalJSON:
+		return canalJSONEncoder{}, nil
+	case config.SinkProtocolMaxwell:
+		return maxwellEncoder{}, nil
+	case config.SinkProtocolAvro:
+		return newAvroEncoder(cfg.Topic, newHTTPSchemaRegistry(cfg.SchemaRegistry)), nil
+	default:
+		return nil, terror.ErrConfigSinkProtocolNotSupport.Generate(cfg.Protocol)
+	}
+}
+
+// canalJSONMessage is the flat message of canal-json.
+type canalJSONMessage struct {
+	ID        int64                    `json:"id"`
+	Schema    string                   `json:"database"`
+	Table     string                   `json:"table"`
+	PKNames   []string                 `json:"pkNames"`
+	IsDDL     bool                     `json:"isDdl"`
+	Type      string                   `json:"type"`
+	ES        int64                    `json:"es"` // binlog event time in milliseconds
+	TS        int64                    `json:"ts"` // encode time in milliseconds
+	Query     string                   `json:"sql"`
+	MySQLType map[string]string        `json:"mysqlType"`
+	Data      []map[string]interface{} `json:"data"`
+	Old       []map[string]interface{} `json:"old"`
+}
+
+type canalJSONEncoder struct{}
+
+func (canalJSONEncoder) EncodeRowChange(row *RowChange) ([]byte, error) {
+	msg := &canalJSONMessage{
+		Schema:    row.Schema,
+		Table:     row.Table,
+		PKNames:   row.PKColumns,
+		Type:      string(row.Type),
+		ES:        int64(row.Timestamp) * 1000,
+		TS:        time.Now().UnixNano() / int64(time.Millisecond),
+		MySQLType: make(map[string]string, len(row.Columns)),
+	}
+	for i, col := range row.Columns {
+		if i < len(row.ColumnTypes) {
+			msg.MySQLType[col] = row.ColumnTypes[i]
+		}
+	}
+	msg.Data = []map[string]interface{}{canalJSONColumns(row.Columns, row.Values)}
+	if row.Type == RowChangeUpdate {
+		msg.Old = []map[string]interface{}{canalJSONColumns(row.Columns, row.PreValues)}
+	}
+	return json.Marshal(msg)
+}
+
+func (canalJSONEncoder) EncodeDDL(ddl *DDLEvent) ([]byte, error) {
+	return json.Marshal(&canalJSONMessage{
+		Schema: ddl.Schema,
+		Table:  ddl.Table,
+		IsDDL:  true,
+		Type:   "QUERY",
+		ES:     int64(ddl.Timestamp) * 1000,
+		TS:     time.Now().UnixNano() / int64(time.Millisecond),
+		Query:  ddl.Query,
+	})
+}
+
+// canalJSONColumns converts the values to strings, NULL is kept as nil.
+func canalJSONColumns(columns []string, values []interface{}) map[string]interface{} {
+	data := make(map[string]interface{}, len(columns))
+	for i, col := range columns {
+		if i >= len(values) || values[i] == nil {
+			data[col] = nil
+			continue
+		}
+		data[col] = valueString(values[i])
+	}
+	return data
+}
+
+// maxwellMessage is the message of maxwell.
+type maxwellMessage struct {
+	Schema string                 `json:"database"`
+	Table  string                 `json:"table"`
+	Type   string                 `json:"type"`
+	TS     int64                  `json:"ts"` // binlog event time in seconds
+	Data   map[string]interface{} `json:"data"`
+	Old    map[string]interface{} `json:"old,omitempty"`
+}
+
+type maxwellEncoder struct{}
+
+func (maxwellEncoder) EncodeRowChange(row *RowChange) ([]byte, error) {
+	msg := &maxwellMessage{
+		Schema: row.Schema,
+		Table:  row.Table,
+		Type:   strings.ToLower(string(row.Type)),
+		TS:     int64(row.Timestamp),
+		Data:   make(map[string]interface{}, len(row.Columns)),
+	}
+	for i, col := range row.Columns {
+		if i < len(row.Values) {
+			msg.Data[col] = maxwellValue(row.Values[i])
+		}
+	}
+	// maxwell only contains the changed columns in `old`.
+	if row.Type == RowChangeUpdate {
+		msg.Old = make(map[string]interface{})
+		for i, col := range row.Columns {
+			if i >= len(row.Values) || i >= len(row.PreValues) {
+				continue
+			}
+			if valueString(row.PreValues[i]) != valueString(row.Values[i]) || (row.PreValues[i] == nil) != (row.Values[i] == nil) {
+				msg.Old[col] = maxwellValue(row.PreValues[i])
+			}
+		}
+	}
+	return json.Marshal(msg)
+}
+
+// EncodeDDL ignores DDLs, the schema changes of maxwell are not supported.
+func (maxwellEncoder) EncodeDDL(*DDLEvent) ([]byte, error) {
+	return nil, nil
+}
+
+func maxwellValue(value interface{}) interface{} {
+	if b, ok := value.([]byte); ok {
+		return string(b)
+	}
+	return value
+}
+
+func valueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
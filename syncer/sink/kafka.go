@@ -0,0 +1,109 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+const defaultKafkaVersion = "2.4.0"
+
+// kafkaProducer sends messages to Kafka synchronously.
+type kafkaProducer struct {
+	producer sarama.SyncProducer
+}
+
+// NewKafkaProducer creates a Producer for Kafka.
+func NewKafkaProducer(cfg *config.SinkConfig) (Producer, error) {
+	saramaCfg, err := newSaramaConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducer(cfg.Brokers, saramaCfg)
+	if err != nil {
+		return nil, terror.ErrSyncerSinkEmit.Delegate(err, 0)
+	}
+	return &kafkaProducer{producer: producer}, nil
+}
+
+func newSaramaConfig(cfg *config.SinkConfig) (*sarama.Config, error) {
+	saramaCfg := sarama.NewConfig()
+	saramaCfg.ClientID = "dm"
+
+	version := cfg.KafkaVersion
+	if version == "" {
+		version = defaultKafkaVersion
+	}
+	var err error
+	saramaCfg.Version, err = sarama.ParseKafkaVersion(version)
+	if err != nil {
+		return nil, terror.ErrConfigInvalidSink.Delegate(err, "invalid `kafka-version` "+version)
+	}
+
+	// messages of the same key must be in order, so wait for all replicas and don't retry concurrently.
+	saramaCfg.Producer.RequiredAcks = sarama.WaitForAll
+	saramaCfg.Producer.Return.Successes = true
+	saramaCfg.Producer.Partitioner = sarama.NewHashPartitioner
+	saramaCfg.Producer.MaxMessageBytes = cfg.MaxMessageBytes
+	saramaCfg.Net.MaxOpenRequests = 1
+
+	switch cfg.Compression {
+	case "", "none":
+		saramaCfg.Producer.Compression = sarama.CompressionNone
+	case "gzip":
+		saramaCfg.Producer.Compression = sarama.CompressionGZIP
+	case "snappy":
+		saramaCfg.Producer.Compression = sarama.CompressionSnappy
+	case "lz4":
+		saramaCfg.Producer.Compression = sarama.CompressionLZ4
+	case "zstd":
+		saramaCfg.Producer.Compression = sarama.CompressionZSTD
+	default:
+		return nil, terror.ErrConfigInvalidSink.Generate("invalid `compression` " + cfg.Compression)
+	}
+
+	if err = saramaCfg.Validate(); err != nil {
+		return nil, terror.ErrConfigInvalidSink.Delegate(err, "invalid Kafka producer config")
+	}
+	return saramaCfg, nil
+}
+
+// SendMessages implements Producer.SendMessages.
+func (p *kafkaProducer) SendMessages(ctx context.Context, msgs []*Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	pms := make([]*sarama.ProducerMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		pm := &sarama.ProducerMessage{
+			Topic: msg.Topic,
+			Value: sarama.ByteEncoder(msg.Value),
+		}
+		if len(msg.Key) > 0 {
+			pm.Key = sarama.ByteEncoder(msg.Key)
+		}
+		pms = append(pms, pm)
+	}
+	return p.producer.SendMessages(pms)
+}
+
+// Close implements Producer.Close.
+func (p *kafkaProducer) Close() error {
+	return p.producer.Close()
+}
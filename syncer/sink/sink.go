@@ -0,0 +1,163 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+// RowChangeType is the type of a row change.
+type RowChangeType string
+
+// row change types.
+const (
+	RowChangeInsert RowChangeType = "INSERT"
+	RowChangeUpdate RowChangeType = "UPDATE"
+	RowChangeDelete RowChangeType = "DELETE"
+)
+
+// RowChange is a row change of a target table.
+type RowChange struct {
+	Schema      string
+	Table       string
+	Type        RowChangeType
+	Columns     []string
+	ColumnTypes []string
+	PKColumns   []string
+	// PreValues is only set for UPDATE.
+	PreValues []interface{}
+	// Values is the row before DELETE, or the row after INSERT/UPDATE.
+	Values []interface{}
+	// Key is used to choose the partition, changes of the same row always have the same key.
+	Key string
+	// Timestamp is the timestamp (in seconds) of the binlog event.
+	Timestamp uint32
+}
+
+// DDLEvent is a DDL of a target table.
+type DDLEvent struct {
+	Schema    string
+	Table     string
+	Query     string
+	Timestamp uint32
+}
+
+// Message is a message sent to the message queue.
+type Message struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+// Producer sends messages to the message queue.
+type Producer interface {
+	// SendMessages sends messages in order, it returns after all messages are acknowledged.
+	SendMessages(ctx context.Context, msgs []*Message) error
+	Close() error
+}
+
+// Sink encodes row changes and DDLs into messages and sends them to the message queue.
+type Sink struct {
+	topic    string
+	encoder  Encoder
+	producer Producer
+}
+
+// NewSink creates a new Sink.
+func NewSink(cfg *config.SinkConfig) (*Sink, error) {
+	encoder, err := NewEncoder(cfg)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := NewKafkaProducer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newSink(cfg.Topic, encoder, producer), nil
+}
+
+func newSink(topic string, encoder Encoder, producer Producer) *Sink {
+	return &Sink{
+		topic:    topic,
+		encoder:  encoder,
+		producer: producer,
+	}
+}
+
+// EmitRowChanges emits row changes to the message queue.
+func (s *Sink) EmitRowChanges(ctx context.Context, rows []*RowChange) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	msgs := make([]*Message, 0, len(rows))
+	for _, row := range rows {
+		value, err := s.encoder.EncodeRowChange(row)
+		if err != nil {
+			return terror.ErrSyncerSinkEmit.Delegate(err, len(rows))
+		}
+		msgs = append(msgs, &Message{
+			Topic: topicName(s.topic, row.Schema, row.Table),
+			Key:   []byte(row.Key),
+			Value: value,
+		})
+	}
+	return s.send(ctx, msgs)
+}
+
+// EmitDDL emits a DDL to the message queue. DDLs without a table or not supported by the protocol are ignored.
+func (s *Sink) EmitDDL(ctx context.Context, ddl *DDLEvent) error {
+	if ddl.Table == "" {
+		return nil
+	}
+	value, err := s.encoder.EncodeDDL(ddl)
+	if err != nil {
+		return terror.ErrSyncerSinkEmit.Delegate(err, 1)
+	}
+	if value == nil {
+		return nil
+	}
+	return s.send(ctx, []*Message{{
+		Topic: topicName(s.topic, ddl.Schema, ddl.Table),
+		Value: value,
+	}})
+}
+
+func (s *Sink) send(ctx context.Context, msgs []*Message) error {
+	if err := s.producer.SendMessages(ctx, msgs); err != nil {
+		return terror.ErrSyncerSinkEmit.Delegate(err, len(msgs))
+	}
+	return nil
+}
+
+// Close closes the producer.
+func (s *Sink) Close() error {
+	return s.producer.Close()
+}
+
+// topicName generates the topic name for the table, characters not allowed in a Kafka topic are replaced by `_`.
+func topicName(pattern, schema, table string) string {
+	name := strings.NewReplacer("{schema}", schema, "{table}", table).Replace(pattern)
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/terror"
+)
+
+func TestSuite(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testSinkSuite{})
+
+type testSinkSuite struct{}
+
+type mockProducer struct {
+	msgs   []*Message
+	err    error
+	closed bool
+}
+
+func (p *mockProducer) SendMessages(_ context.Context, msgs []*Message) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.msgs = append(p.msgs, msgs...)
+	return nil
+}
+
+func (p *mockProducer) Close() error {
+	p.closed = true
+	return nil
+}
+
+func newTestRowChange(tp RowChangeType) *RowChange {
+	row := &RowChange{
+		Schema:      "db",
+		Table:       "tb",
+		Type:        tp,
+		Columns:     []string{"id", "name", "content"},
+		ColumnTypes: []string{"int", "varchar", "blob"},
+		PKColumns:   []string{"id"},
+		Values:      []interface{}{int32(1), "b", []byte("c")},
+		Key:         "1.id.`db`.`tb`",
+		Timestamp:   1639633094,
+	}
+	if tp == RowChangeUpdate {
+		row.PreValues = []interface{}{int32(1), "a", nil}
+	}
+	return row
+}
+
+func (t *testSinkSuite) TestTopicName(c *C) {
+	c.Assert(topicName(config.DefaultSinkTopic, "db", "tb"), Equals, "db_tb")
+	c.Assert(topicName("dm.{schema}", "db", "tb"), Equals, "dm.db")
+	c.Assert(topicName("{schema}-{table}", "数据库", "t$1"), Equals, "___-t_1")
+}
+
+func (t *testSinkSuite) TestCanalJSONEncoder(c *C) {
+	encoder, err := NewEncoder(&config.SinkConfig{Protocol: config.SinkProtocolCanalJSON})
+	c.Assert(err, IsNil)
+
+	data, err := encoder.EncodeRowChange(newTestRowChange(RowChangeUpdate))
+	c.Assert(err, IsNil)
+	var msg canalJSONMessage
+	c.Assert(json.Unmarshal(data, &msg), IsNil)
+	c.Assert(msg.Schema, Equals, "db")
+	c.Assert(msg.Table, Equals, "tb")
+	c.Assert(msg.Type, Equals, "UPDATE")
+	c.Assert(msg.IsDDL, IsFalse)
+	c.Assert(msg.ES, Equals, int64(1639633094000))
+	c.Assert(msg.PKNames, DeepEquals, []string{"id"})
+	c.Assert(msg.MySQLType, DeepEquals, map[string]string{"id": "int", "name": "varchar", "content": "blob"})
+	c.Assert(msg.Data, DeepEquals, []map[string]interface{}{{"id": "1", "name": "b", "content": "c"}})
+	c.Assert(msg.Old, DeepEquals, []map[string]interface{}{{"id": "1", "name": "a", "content": nil}})
+
+	data, err = encoder.EncodeRowChange(newTestRowChange(RowChangeDelete))
+	c.Assert(err, IsNil)
+	msg = canalJSONMessage{}
+	c.Assert(json.Unmarshal(data, &msg), IsNil)
+	c.Assert(msg.Type, Equals, "DELETE")
+	c.Assert(msg.Old, IsNil)
+
+	data, err = encoder.EncodeDDL(&DDLEvent{Schema: "db", Table: "tb", Query: "ALTER TABLE `db`.`tb` ADD COLUMN `c` INT"})
+	c.Assert(err, IsNil)
+	msg = canalJSONMessage{}
+	c.Assert(json.Unmarshal(data, &msg), IsNil)
+	c.Assert(msg.IsDDL, IsTrue)
+	c.Assert(msg.Query, Equals, "ALTER TABLE `db`.`tb` ADD COLUMN `c` INT")
+}
+
+func (t *testSinkSuite) TestMaxwellEncoder(c *C) {
+	encoder, err := NewEncoder(&config.SinkConfig{Protocol: config.SinkProtocolMaxwell})
+	c.Assert(err, IsNil)
+
+	data, err := encoder.EncodeRowChange(newTestRowChange(RowChangeUpdate))
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"database":"db","table":"tb","type":"update","ts":1639633094,"data":{"content":"c","id":1,"name":"b"},"old":{"content":null,"name":"a"}}`)
+
+	data, err = encoder.EncodeRowChange(newTestRowChange(RowChangeInsert))
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"database":"db","table":"tb","type":"insert","ts":1639633094,"data":{"content":"c","id":1,"name":"b"}}`)
+
+	data, err = encoder.EncodeDDL(&DDLEvent{Schema: "db", Table: "tb", Query: "ALTER TABLE `db`.`tb` ADD COLUMN `c` INT"})
+	c.Assert(err, IsNil)
+	c.Assert(data, IsNil)
+
+	_, err = NewEncoder(&config.SinkConfig{Protocol: "protobuf"})
+	c.Assert(terror.ErrConfigSinkProtocolNotSupport.Equal(err), IsTrue)
+}
+
+func (t *testSinkSuite) TestSink(c *C) {
+	var (
+		ctx      = context.Background()
+		producer = &mockProducer{}
+		s        = newSink(config.DefaultSinkTopic, maxwellEncoder{}, producer)
+	)
+
+	c.Assert(s.EmitRowChanges(ctx, nil), IsNil)
+	c.Assert(producer.msgs, HasLen, 0)
+
+	row := newTestRowChange(RowChangeInsert)
+	c.Assert(s.EmitRowChanges(ctx, []*RowChange{row, newTestRowChange(RowChangeDelete)}), IsNil)
+	c.Assert(producer.msgs, HasLen, 2)
+	c.Assert(producer.msgs[0].Topic, Equals, "db_tb")
+	c.Assert(string(producer.msgs[0].Key), Equals, row.Key)
+
+	// DDL is not supported by maxwell.
+	c.Assert(s.EmitDDL(ctx, &DDLEvent{Schema: "db", Table: "tb", Query: "ALTER TABLE `db`.`tb` ADD COLUMN `c` INT"}), IsNil)
+	c.Assert(producer.msgs, HasLen, 2)
+
+	s.encoder = canalJSONEncoder{}
+	// DDL without table is ignored.
+	c.Assert(s.EmitDDL(ctx, &DDLEvent{Schema: "db", Query: "CREATE DATABASE `db`"}), IsNil)
+	c.Assert(producer.msgs, HasLen, 2)
+	c.Assert(s.EmitDDL(ctx, &DDLEvent{Schema: "db", Table: "tb", Query: "ALTER TABLE `db`.`tb` ADD COLUMN `c` INT"}), IsNil)
+	c.Assert(producer.msgs, HasLen, 3)
+	c.Assert(producer.msgs[2].Key, IsNil)
+
+	producer.err = errors.New("mock send error")
+	err := s.EmitRowChanges(ctx, []*RowChange{row})
+	c.Assert(terror.ErrSyncerSinkEmit.Equal(err), IsTrue)
+
+	c.Assert(s.Close(), IsNil)
+	c.Assert(producer.closed, IsTrue)
+}
+
+func (t *testSinkSuite) TestNewSaramaConfig(c *C) {
+	cfg := &config.SinkConfig{Brokers: []string{"127.0.0.1:9092"}, MaxMessageBytes: 1024, Compression: "gzip"}
+	saramaCfg, err := newSaramaConfig(cfg)
+	c.Assert(err, IsNil)
+	c.Assert(saramaCfg.Producer.MaxMessageBytes, Equals, 1024)
+	c.Assert(saramaCfg.Version.String(), Equals, defaultKafkaVersion)
+
+	cfg.Compression = "unknown"
+	_, err = newSaramaConfig(cfg)
+	c.Assert(terror.ErrConfigInvalidSink.Equal(err), IsTrue)
+
+	cfg.Compression = ""
+	cfg.KafkaVersion = "invalid"
+	_, err = newSaramaConfig(cfg)
+	c.Assert(terror.ErrConfigInvalidSink.Equal(err), IsTrue)
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser/model"
+
+	"github.com/pingcap/dm/syncer/sink"
+)
+
+func (s *testSyncerSuite) TestDMLRowChange(c *C) {
+	columns := newOversizedRowTestColumns()
+	ti := &model.TableInfo{Columns: columns, PKIsHandle: true}
+	targetTable := &filter.Table{Schema: "target_db", Name: "target_tb"}
+
+	oldValues := []interface{}{int32(1), "a", "b"}
+	values := []interface{}{int32(1), "a", "c"}
+	dml := newDML(update, false, targetTable.String(), &filter.Table{Schema: "db", Name: "tb"}, oldValues, values, oldValues, values, columns, ti)
+	row := dml.rowChange(targetTable, 1639633094)
+	c.Assert(row.Schema, Equals, "target_db")
+	c.Assert(row.Table, Equals, "target_tb")
+	c.Assert(row.Type, Equals, sink.RowChangeUpdate)
+	c.Assert(row.Columns, DeepEquals, []string{"id", "name", "content"})
+	c.Assert(row.ColumnTypes, HasLen, 3)
+	c.Assert(row.PKColumns, DeepEquals, []string{"id"})
+	c.Assert(row.PreValues, DeepEquals, oldValues)
+	c.Assert(row.Values, DeepEquals, values)
+	// no causality key, use the table as key.
+	c.Assert(row.Key, Equals, targetTable.String())
+	c.Assert(row.Timestamp, Equals, uint32(1639633094))
+
+	dml = newDML(del, false, targetTable.String(), &filter.Table{Schema: "db", Name: "tb"}, nil, values, nil, values, columns, ti)
+	dml.key = "1.id.`target_db`.`target_tb`"
+	row = dml.rowChange(targetTable, 0)
+	c.Assert(row.Type, Equals, sink.RowChangeDelete)
+	c.Assert(row.PreValues, IsNil)
+	c.Assert(row.Key, Equals, dml.key)
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/log"
+)
+
+const (
+	// the min number of executed statements of a digest before judging whether it's slow.
+	slowDigestMinSamples = 10
+	// the weight of the latest execution time in the moving average of a digest.
+	slowDigestWeight = 0.2
+)
+
+// slowDigestDetector tracks the average downstream execution time of the statements per digest, and isolates the
+// tables of the slow digests (e.g. UPDATE on an unindexed column) into a dedicated DML queue, so they don't block the
+// replication of other tables in the same queues.
+// the statements of the same type on the same table share a digest. a table is isolated when any of its digests is
+// slower than the threshold, and is released after all its digests are faster than half of the threshold.
+// all methods of a nil slowDigestDetector do nothing.
+type slowDigestDetector struct {
+	threshold time.Duration
+	logger    log.Logger
+
+	mu       sync.Mutex
+	stats    map[string]*digestStat // digest -> stat
+	isolated map[string]struct{}    // target table ID -> struct{}
+	version  int64                  // increased whenever the isolated tables change
+}
+
+type digestStat struct {
+	table   string
+	avg     time.Duration
+	samples int
+}
+
+// newSlowDigestDetector creates a slowDigestDetector, nil is returned if threshold is not positive.
+func newSlowDigestDetector(threshold time.Duration, logger log.Logger) *slowDigestDetector {
+	if threshold <= 0 {
+		return nil
+	}
+	return &slowDigestDetector{
+		threshold: threshold,
+		logger:    logger,
+		stats:     make(map[string]*digestStat),
+		isolated:  make(map[string]struct{}),
+	}
+}
+
+func jobDigest(j *job) string {
+	return j.tp.String() + ":" + j.dml.targetTableID
+}
+
+// observe records the execution time of a batch of DML jobs, the time is shared equally by the jobs.
+func (d *slowDigestDetector) observe(jobs []*job, cost time.Duration) {
+	if d == nil || len(jobs) == 0 {
+		return
+	}
+	perJob := cost / time.Duration(len(jobs))
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	tables := make(map[string]struct{})
+	for _, j := range jobs {
+		if j.dml == nil {
+			continue
+		}
+		digest := jobDigest(j)
+		st, ok := d.stats[digest]
+		if !ok {
+			st = &digestStat{table: j.dml.targetTableID, avg: perJob}
+			d.stats[digest] = st
+		} else {
+			st.avg = time.Duration(float64(st.avg)*(1-slowDigestWeight) + float64(perJob)*slowDigestWeight)
+		}
+		st.samples++
+		tables[st.table] = struct{}{}
+	}
+	for table := range tables {
+		d.evaluate(table)
+	}
+}
+
+// evaluate isolates or releases the table according to the stats of its digests.
+func (d *slowDigestDetector) evaluate(table string) {
+	_, isolated := d.isolated[table]
+	slow, recovered := "", true
+	for digest, st := range d.stats {
+		if st.table != table {
+			continue
+		}
+		if st.samples >= slowDigestMinSamples && st.avg > d.threshold {
+			slow = digest
+		}
+		if st.avg >= d.threshold/2 {
+			recovered = false
+		}
+	}
+
+	switch {
+	case !isolated && slow != "":
+		d.isolated[table] = struct{}{}
+		d.version++
+		d.logger.Warn("isolate the table of the slow digest into the dedicated DML queue", zap.String("table", table),
+			zap.String("digest", slow), zap.Duration("average execution time", d.stats[slow].avg), zap.Duration("threshold", d.threshold))
+	case isolated && recovered:
+		delete(d.isolated, table)
+		d.version++
+		d.logger.Info("release the table from the dedicated DML queue", zap.String("table", table))
+	}
+}
+
+// isolatedTables returns the isolated tables and the version of them.
+func (d *slowDigestDetector) isolatedTables() (map[string]struct{}, int64) {
+	if d == nil {
+		return nil, 0
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	tables := make(map[string]struct{}, len(d.isolated))
+	for table := range d.isolated {
+		tables[table] = struct{}{}
+	}
+	return tables, d.version
+}
+
+// getVersion returns the version of the isolated tables.
+func (d *slowDigestDetector) getVersion() int64 {
+	if d == nil {
+		return 0
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.version
+}
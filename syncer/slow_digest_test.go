@@ -0,0 +1,72 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/pkg/log"
+)
+
+func (s *testSyncerSuite) TestSlowDigestDetector(c *C) {
+	// nil detector disables the detection.
+	var d *slowDigestDetector
+	c.Assert(newSlowDigestDetector(0, log.L()), IsNil)
+	d.observe([]*job{{tp: update, dml: &DML{targetTableID: "`db`.`tb1`"}}}, time.Hour)
+	tables, version := d.isolatedTables()
+	c.Assert(tables, HasLen, 0)
+	c.Assert(version, Equals, int64(0))
+	c.Assert(d.getVersion(), Equals, int64(0))
+
+	threshold := 100 * time.Millisecond
+	d = newSlowDigestDetector(threshold, log.L())
+	slowJob := &job{tp: update, dml: &DML{targetTableID: "`db`.`tb1`"}}
+	fastJob := &job{tp: insert, dml: &DML{targetTableID: "`db`.`tb2`"}}
+
+	// not isolated before enough samples.
+	for i := 0; i < slowDigestMinSamples-1; i++ {
+		d.observe([]*job{slowJob}, time.Second)
+		d.observe([]*job{fastJob}, time.Millisecond)
+	}
+	c.Assert(d.getVersion(), Equals, int64(0))
+	d.observe([]*job{slowJob}, time.Second)
+	d.observe([]*job{fastJob}, time.Millisecond)
+	tables, version = d.isolatedTables()
+	c.Assert(version, Equals, int64(1))
+	c.Assert(tables, DeepEquals, map[string]struct{}{"`db`.`tb1`": {}})
+
+	// keep isolated until the average time is below half of the threshold.
+	d.observe([]*job{slowJob}, threshold*3/4)
+	c.Assert(d.getVersion(), Equals, int64(1))
+	for i := 0; i < 20; i++ {
+		d.observe([]*job{slowJob}, time.Millisecond)
+	}
+	tables, version = d.isolatedTables()
+	c.Assert(version, Equals, int64(2))
+	c.Assert(tables, HasLen, 0)
+
+	// the time of a batch is shared by the jobs.
+	d = newSlowDigestDetector(threshold, log.L())
+	jobs := make([]*job, 0, 20)
+	for i := 0; i < 10; i++ {
+		jobs = append(jobs, slowJob, fastJob)
+	}
+	d.observe(jobs, time.Second)
+	c.Assert(d.getVersion(), Equals, int64(0))
+	d.observe(jobs, 10*time.Second)
+	tables, _ = d.isolatedTables()
+	c.Assert(tables, HasLen, 2)
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"math"
+	"sort"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/binlog/reader"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// getPosByStartTime searches the binlog files for the position of `start-time`. it finds the last binlog file created
+// before `start-time` by binary search on the timestamps of their first events, then scans the file for the end
+// position of the last transaction written before `start-time`.
+// the GTID sets of the position are not fetched here, they are filled by `adjustGlobalPointGTID` if GTID is enabled.
+func (s *Syncer) getPosByStartTime(tctx *tcontext.Context) (mysql.Position, error) {
+	startTime, err := config.ParseStartTime(s.cfg.StartTime)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	ts := uint32(startTime.Unix())
+
+	files, err := binlog.GetBinaryLogs(tctx.Context(), s.fromDB.BaseDB.DB)
+	if err != nil {
+		return mysql.Position{}, terror.WithScope(err, terror.ScopeUpstream)
+	}
+	names := files.Names()
+	if len(names) == 0 {
+		return mysql.Position{}, terror.ErrSyncerStartTime.Generate(s.cfg.StartTime, "no binlog file in upstream")
+	}
+
+	// the streamer starts from the beginning of the file, and reads binlog events by position.
+	startStreamer := func(name string) (*StreamerController, error) {
+		streamerController := NewStreamerController(s.syncCfg, false, s.fromDB, s.binlogType, s.cfg.RelayDir, s.timezone)
+		if err2 := streamerController.Start(tctx, binlog.Location{Position: mysql.Position{Name: name, Pos: 0}}); err2 != nil {
+			return nil, err2
+		}
+		return streamerController, nil
+	}
+
+	var searchErr error
+	idx := sort.Search(len(names), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		streamerController, err2 := startStreamer(names[i])
+		if err2 != nil {
+			searchErr = err2
+			return true
+		}
+		defer streamerController.Close(tctx)
+		fileTS, err2 := reader.GetFirstEventTimestamp(tctx.Context(), streamerController.streamer)
+		if err2 != nil {
+			searchErr = err2
+			return true
+		}
+		return fileTS > ts
+	})
+	if searchErr != nil {
+		return mysql.Position{}, terror.ErrSyncerStartTime.Delegate(searchErr, s.cfg.StartTime, "fail to read binlog file")
+	}
+	if idx == 0 {
+		// all binlog files are created after start-time
+		tctx.L().Warn("the first binlog file is created after start-time, start from the beginning of it",
+			zap.String("start time", s.cfg.StartTime), zap.String("binlog file", names[0]))
+		return mysql.Position{Name: names[0], Pos: binlog.MinPosition.Pos}, nil
+	}
+
+	name := names[idx-1]
+	endPos := mysql.Position{Name: name, Pos: math.MaxUint32}
+	if idx == len(names) {
+		// the latest binlog file is still being written, read it until the current position.
+		endPos, _, err = utils.GetMasterStatus(tctx.Context(), s.fromDB.BaseDB.DB, s.cfg.Flavor)
+		if err != nil {
+			return mysql.Position{}, terror.WithScope(err, terror.ScopeUpstream)
+		}
+	}
+
+	streamerController, err := startStreamer(name)
+	if err != nil {
+		return mysql.Position{}, terror.ErrSyncerStartTime.Delegate(err, s.cfg.StartTime, "fail to read binlog file")
+	}
+	defer streamerController.Close(tctx)
+	pos, found, err := reader.GetTxnPosForTimestamp(tctx.Context(), streamerController.streamer, ts, endPos)
+	if err != nil {
+		return mysql.Position{}, terror.ErrSyncerStartTime.Delegate(err, s.cfg.StartTime, "fail to read binlog file")
+	}
+	if !found {
+		if idx == len(names) {
+			return mysql.Position{}, terror.ErrSyncerStartTime.Generate(s.cfg.StartTime, "binlog file rotated while searching, please retry")
+		}
+		// all events in the file are written before start-time, and the next file is created after start-time.
+		pos = mysql.Position{Name: names[idx], Pos: binlog.MinPosition.Pos}
+	}
+	tctx.L().Info("found binlog position for start-time", zap.String("start time", s.cfg.StartTime), zap.Stringer("position", pos))
+	return pos, nil
+}
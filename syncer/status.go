@@ -36,11 +36,17 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 		RecentTps:           s.tps.Load(),
 		SyncerBinlog:        syncerLocation.Position.String(),
 		SecondsBehindMaster: s.secondsBehindMaster.Load(),
+		RunningDDLs:         s.ddlJobTracker.Running(),
+		SyncWindowStart:     s.syncWindowStart.Load(),
 	}
 
 	if syncerLocation.GetGTID() != nil {
 		st.SyncerBinlogGtid = syncerLocation.GetGTID().String()
 	}
+	if lastEventTime := s.idleDetector.LastEventTime(); !lastEventTime.IsZero() {
+		st.LastEventTime = lastEventTime.Format(time.RFC3339)
+		st.IdleDuration = int64(s.idleDetector.IdleDuration(time.Now()).Seconds())
+	}
 
 	if sourceStatus != nil {
 		st.MasterBinlog = sourceStatus.Location.Position.String()
@@ -74,6 +80,7 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 	if pendingShardInfo != nil {
 		st.BlockingDDLs = pendingShardInfo.DDLs
 	}
+	st.ProgressDetail = s.progressDetail(st, sourceStatus, time.Now())
 
 	failpoint.Inject("BlockSyncStatus", func(val failpoint.Value) {
 		interval, err := time.ParseDuration(val.(string))
@@ -88,6 +95,47 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 	return st
 }
 
+// progressDetail returns the progress in bytes of binlog, the total is unknown if the status of source is not given.
+func (s *Syncer) progressDetail(st *pb.SyncStatus, sourceStatus *binlog.SourceStatus, now time.Time) *pb.Progress {
+	progress := &pb.Progress{
+		Finished:                  s.binlogSizeCount.Load(),
+		Unit:                      "bytes",
+		EstimatedRemainingSeconds: -1,
+	}
+	if start := s.start.Load(); !start.IsZero() {
+		if elapsed := int64(now.Sub(start).Seconds()); elapsed > 0 {
+			progress.Rate = progress.Finished / elapsed
+		}
+	}
+	if sourceStatus != nil {
+		s.currentLocationMu.RLock()
+		currentLocation := s.currentLocationMu.currentLocation
+		s.currentLocationMu.RUnlock()
+		remaining := sourceStatus.Binlogs.After(currentLocation.Position)
+		progress.Total = progress.Finished + remaining
+		switch {
+		case st.Synced || remaining == 0:
+			progress.EstimatedRemainingSeconds = 0
+		case progress.Rate > 0:
+			progress.EstimatedRemainingSeconds = remaining / progress.Rate
+		}
+	}
+
+	switch {
+	case len(st.BlockingDDLs) > 0:
+		progress.Phase = "waiting for sharding DDL"
+	case len(st.RunningDDLs) > 0:
+		progress.Phase = "waiting for DDL in downstream"
+	case st.SyncWindowStart != "":
+		progress.Phase = "waiting for sync window"
+	case st.Synced:
+		progress.Phase = "synced"
+	default:
+		progress.Phase = "replicating"
+	}
+	return progress
+}
+
 func (s *Syncer) printStatus(sourceStatus *binlog.SourceStatus) {
 	if sourceStatus == nil {
 		// often happened when source status is not interested, such as in an unit test
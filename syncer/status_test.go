@@ -15,6 +15,7 @@ package syncer
 
 import (
 	"sync"
+	"time"
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	. "github.com/pingcap/check"
@@ -77,3 +78,31 @@ func (*mockCheckpoint) FlushedGlobalPoint() binlog.Location {
 		},
 	}
 }
+
+func (t *statusSuite) TestProgressDetail(c *C) {
+	s := &Syncer{}
+	now := time.Now()
+	s.start.Store(now.Add(-10 * time.Second))
+	s.binlogSizeCount.Store(1000)
+
+	// the total is unknown without the status of source.
+	progress := s.progressDetail(&pb.SyncStatus{}, nil, now)
+	c.Assert(progress, DeepEquals, &pb.Progress{
+		Finished:                  1000,
+		Unit:                      "bytes",
+		Rate:                      100,
+		EstimatedRemainingSeconds: -1,
+		Phase:                     "replicating",
+	})
+
+	sourceStatus := &binlog.SourceStatus{Binlogs: binlog.FileSizes(nil)}
+	progress = s.progressDetail(&pb.SyncStatus{Synced: true}, sourceStatus, now)
+	c.Assert(progress.Total, Equals, int64(1000))
+	c.Assert(progress.EstimatedRemainingSeconds, Equals, int64(0))
+	c.Assert(progress.Phase, Equals, "synced")
+
+	progress = s.progressDetail(&pb.SyncStatus{BlockingDDLs: []string{"ALTER TABLE t ADD COLUMN c INT"}}, sourceStatus, now)
+	c.Assert(progress.Phase, Equals, "waiting for sharding DDL")
+	progress = s.progressDetail(&pb.SyncStatus{RunningDDLs: []*pb.DDLJobProgress{{JobID: 1}}}, sourceStatus, now)
+	c.Assert(progress.Phase, Equals, "waiting for DDL in downstream")
+}
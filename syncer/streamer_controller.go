@@ -121,6 +121,9 @@ type StreamerController struct {
 
 	// whether the server id is updated
 	serverIDUpdated bool
+
+	// netRateLimiter throttles reading binlog events from the upstream directly, nil means no limit.
+	netRateLimiter *common.NetRateLimiter
 }
 
 // NewStreamerController creates a new streamer controller.
@@ -272,10 +275,15 @@ func (c *StreamerController) GetEvent(tctx *tcontext.Context) (event *replicatio
 
 	c.RLock()
 	streamer := c.streamer
+	remote := c.currentBinlogType == RemoteBinlog
 	c.RUnlock()
 
 	event, err = streamer.GetEvent(ctx)
 	cancel()
+	if err == nil && remote {
+		// the relay log is read locally, only throttle the binlog dump connection.
+		err = c.netRateLimiter.WaitEvent(tctx.Context(), event)
+	}
 	failpoint.Inject("GetEventError", func() {
 		err = errors.New("go-mysql returned an error")
 	})
@@ -0,0 +1,82 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"go.uber.org/zap"
+
+	tcontext "github.com/pingcap/dm/pkg/context"
+)
+
+// syncWindowCheckInterval is the interval to check whether the sync window starts.
+var syncWindowCheckInterval = 30 * time.Second
+
+// inSyncWindow returns whether the DMLs can be applied now, always true if `sync-window` is not set.
+func (s *Syncer) inSyncWindow() bool {
+	return s.syncWindow == nil || s.syncWindow.Contains(timeNow())
+}
+
+// isSyncWindowBoundary returns whether the event begins a transaction or is a DDL, the sync window is checked before
+// it. the COMMIT of a non-transactional transaction is in the transaction.
+func isSyncWindowBoundary(e *replication.BinlogEvent) bool {
+	switch ev := e.Event.(type) {
+	case *replication.GTIDEvent, *replication.MariadbGTIDEvent:
+		return true
+	case *replication.QueryEvent:
+		return !strings.EqualFold(strings.TrimSpace(string(ev.Query)), "COMMIT")
+	default:
+		return false
+	}
+}
+
+// waitSyncWindow blocks replicating until the sync window starts. it's called before a transaction or a DDL is
+// dispatched, so the transaction being replicated when the window ends is finished first, and nothing is applied out
+// of the window. the jobs before are flushed, so the checkpoint is saved. the connection of the remote binlog idles
+// while waiting, so it's reopened from the checkpoint after the window starts, and true is returned to read the events
+// again from the checkpoint.
+func (s *Syncer) waitSyncWindow(tctx *tcontext.Context) (bool, error) {
+	if s.inSyncWindow() {
+		return false, nil
+	}
+	if err := s.flushJobs(); err != nil {
+		return false, err
+	}
+
+	windowStart := s.syncWindow.NextStart(timeNow())
+	tctx.L().Info("pause replicating until the sync window", zap.String("window", s.cfg.SyncWindow),
+		zap.Time("window start", windowStart))
+	s.syncWindowStart.Store(windowStart.Format(time.RFC3339))
+	defer s.syncWindowStart.Store("")
+	ticker := time.NewTicker(syncWindowCheckInterval)
+	defer ticker.Stop()
+	for !s.inSyncWindow() {
+		select {
+		case <-tctx.Ctx.Done():
+			return false, tctx.Ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	tctx.L().Info("resume replicating in the sync window", zap.String("window", s.cfg.SyncWindow))
+
+	if s.streamerController != nil && s.streamerController.GetBinlogType() == RemoteBinlog {
+		location := s.checkpoint.GlobalPoint()
+		tctx.L().Info("reopen the binlog connection idled out of the sync window", zap.Stringer("location", location))
+		return true, s.streamerController.ResetReplicationSyncer(tctx, location)
+	}
+	return false, nil
+}
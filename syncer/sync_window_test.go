@@ -0,0 +1,202 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/retry"
+	"github.com/pingcap/dm/pkg/schema"
+	"github.com/pingcap/dm/pkg/utils"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+var _ = Suite(&testSyncWindowSuite{})
+
+type testSyncWindowSuite struct{}
+
+func (t *testSyncWindowSuite) TestWaitSyncWindow(c *C) {
+	defer func(f func() time.Time) { timeNow = f }(timeNow)
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.Local)
+	timeNow = func() time.Time { return now }
+
+	var jobs []*job
+	s := &Syncer{
+		cfg:  &config.SubTaskConfig{},
+		tctx: tcontext.Background(),
+		addJobFunc: func(j *job) error {
+			jobs = append(jobs, j)
+			return nil
+		},
+	}
+
+	// no window.
+	reset, err := s.waitSyncWindow(tcontext.Background())
+	c.Assert(err, IsNil)
+	c.Assert(reset, IsFalse)
+	c.Assert(jobs, HasLen, 0)
+
+	window, err := config.ParseSyncWindow("22:00-06:00")
+	c.Assert(err, IsNil)
+	s.syncWindow = &window
+	s.cfg.SyncWindow = "22:00-06:00"
+
+	// out of the window, the jobs are flushed and replicating is blocked, the start of the window is shown in status.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		_, err2 := s.waitSyncWindow(tcontext.Background().WithContext(ctx))
+		errCh <- err2
+	}()
+	c.Assert(utils.WaitSomething(100, 10*time.Millisecond, func() bool {
+		return s.syncWindowStart.Load() != ""
+	}), IsTrue)
+	c.Assert(s.syncWindowStart.Load(), Equals, time.Date(2021, 10, 1, 22, 0, 0, 0, time.Local).Format(time.RFC3339))
+	c.Assert(s.progressDetail(&pb.SyncStatus{SyncWindowStart: s.syncWindowStart.Load()}, nil, now).Phase, Equals, "waiting for sync window")
+	cancel()
+	c.Assert(<-errCh, Equals, context.Canceled)
+	c.Assert(s.syncWindowStart.Load(), Equals, "")
+	c.Assert(jobs, HasLen, 1)
+	c.Assert(jobs[0].tp, Equals, flush)
+
+	// in the window.
+	now = time.Date(2021, 10, 2, 1, 0, 0, 0, time.Local)
+	reset, err = s.waitSyncWindow(tcontext.Background())
+	c.Assert(err, IsNil)
+	c.Assert(reset, IsFalse)
+	c.Assert(jobs, HasLen, 1)
+}
+
+func (s *testSyncerSuite) TestSyncWindowBeforeTxn(c *C) {
+	defer func(f func() time.Time, interval time.Duration) {
+		timeNow = f
+		syncWindowCheckInterval = interval
+	}(timeNow, syncWindowCheckInterval)
+	var nowMu sync.Mutex
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.Local)
+	timeNow = func() time.Time {
+		nowMu.Lock()
+		defer nowMu.Unlock()
+		return now
+	}
+	syncWindowCheckInterval = 10 * time.Millisecond
+
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	s.mockGetServerUnixTS(mock)
+	dbConn, err := db.Conn(context.Background())
+	c.Assert(err, IsNil)
+	checkPointDB, checkPointMock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	checkPointDBConn, err := checkPointDB.Conn(context.Background())
+	c.Assert(err, IsNil)
+
+	testJobs.Lock()
+	testJobs.jobs = testJobs.jobs[:0]
+	testJobs.Unlock()
+
+	cfg, err := s.cfg.Clone()
+	c.Assert(err, IsNil)
+	cfg.BAList = &filter.Rules{DoDBs: []string{"test_window"}}
+	cfg.ColumnMappingRules = nil
+	cfg.RouteRules = nil
+	cfg.SyncWindow = "22:00-06:00"
+	cfg.WorkerCount = 2
+	syncer := NewSyncer(cfg, nil)
+	window, err := config.ParseSyncWindow(cfg.SyncWindow)
+	c.Assert(err, IsNil)
+	syncer.syncWindow = &window
+	syncer.fromDB = &dbconn.UpStreamConn{BaseDB: conn.NewBaseDB(db, func() {})}
+	syncer.toDBConns = []*dbconn.DBConn{
+		{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})},
+		{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})},
+	}
+	syncer.ddlDBConn = &dbconn.DBConn{Cfg: cfg, BaseConn: conn.NewBaseConn(dbConn, &retry.FiniteRetryStrategy{})}
+	syncer.schemaTracker, err = schema.NewTracker(context.Background(), cfg.Name, defaultTestSessionCfg, syncer.ddlDBConn.BaseConn)
+	c.Assert(err, IsNil)
+	syncer.exprFilterGroup = NewExprFilterGroup(nil)
+	c.Assert(syncer.genRouter(), IsNil)
+	syncer.setupMockCheckpoint(c, checkPointDBConn, checkPointMock)
+	syncer.reset()
+	s.mockGetServerUnixTS(mock)
+
+	events := mockBinlogEvents{
+		mockBinlogEvent{typ: DBCreate, args: []interface{}{"test_window"}},
+		mockBinlogEvent{typ: TableCreate, args: []interface{}{"test_window", "create table test_window.t(id int primary key)"}},
+		mockBinlogEvent{typ: Write, args: []interface{}{uint64(8), "test_window", "t", []byte{mysql.MYSQL_TYPE_LONG}, [][]interface{}{{int32(1)}}}},
+	}
+	mockStreamerProducer := &MockStreamProducer{s.generateEvents(events, c)}
+	mockStreamer, err := mockStreamerProducer.generateStreamer(binlog.NewLocation(""))
+	c.Assert(err, IsNil)
+	syncer.streamerController = &StreamerController{
+		streamerProducer: mockStreamerProducer,
+		streamer:         mockStreamer,
+	}
+	syncer.addJobFunc = syncer.addJobToMemory
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resultCh := make(chan pb.ProcessResult, 1)
+	go syncer.Process(ctx, resultCh)
+
+	// out of the window, the DDL and the transaction are not dispatched.
+	c.Assert(utils.WaitSomething(100, 10*time.Millisecond, func() bool {
+		return syncer.syncWindowStart.Load() != ""
+	}), IsTrue)
+	time.Sleep(100 * time.Millisecond)
+	testJobs.RLock()
+	checkJobs(c, testJobs.jobs, []*expectJob{{flush, nil, nil}})
+	testJobs.RUnlock()
+
+	// in the window.
+	nowMu.Lock()
+	now = time.Date(2021, 10, 1, 23, 0, 0, 0, time.Local)
+	nowMu.Unlock()
+	expectJobs := []*expectJob{
+		{flush, nil, nil},
+		{flush, nil, nil},
+		{ddl, []string{"CREATE DATABASE IF NOT EXISTS `test_window`"}, nil},
+		{flush, nil, nil},
+		{ddl, []string{"CREATE TABLE IF NOT EXISTS `test_window`.`t` (`id` INT PRIMARY KEY)"}, nil},
+		{insert, []string{"INSERT INTO `test_window`.`t` (`id`) VALUES (?)"}, [][]interface{}{{int32(1)}}},
+		// the jobs are flushed when no more events.
+		{flush, nil, nil},
+	}
+	c.Assert(utils.WaitSomething(100, 10*time.Millisecond, func() bool {
+		testJobs.RLock()
+		defer testJobs.RUnlock()
+		return len(testJobs.jobs) >= len(expectJobs)
+	}), IsTrue)
+	c.Assert(syncer.syncWindowStart.Load(), Equals, "")
+	testJobs.Lock()
+	checkJobs(c, testJobs.jobs, expectJobs)
+	testJobs.jobs = testJobs.jobs[:0]
+	testJobs.Unlock()
+
+	cancel()
+	syncer.Close()
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/format"
 	"github.com/pingcap/tidb/parser/model"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
 	"go.etcd.io/etcd/clientv3"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -55,6 +56,7 @@ import (
 	"github.com/pingcap/dm/pkg/conn"
 	tcontext "github.com/pingcap/dm/pkg/context"
 	fr "github.com/pingcap/dm/pkg/func-rollback"
+	"github.com/pingcap/dm/pkg/gtid"
 	"github.com/pingcap/dm/pkg/ha"
 	"github.com/pingcap/dm/pkg/log"
 	parserpkg "github.com/pingcap/dm/pkg/parser"
@@ -65,10 +67,12 @@ import (
 	"github.com/pingcap/dm/pkg/utils"
 	"github.com/pingcap/dm/syncer/dbconn"
 	operator "github.com/pingcap/dm/syncer/err-operator"
+	"github.com/pingcap/dm/syncer/hook"
 	"github.com/pingcap/dm/syncer/metrics"
 	onlineddl "github.com/pingcap/dm/syncer/online-ddl-tools"
 	sm "github.com/pingcap/dm/syncer/safe-mode"
 	"github.com/pingcap/dm/syncer/shardddl"
+	"github.com/pingcap/dm/syncer/sink"
 )
 
 var (
@@ -121,6 +125,8 @@ type Syncer struct {
 
 	cfg     *config.SubTaskConfig
 	syncCfg replication.BinlogSyncerConfig
+	// go-mysql connects to the upstream through it if the tunnel is configured
+	tunnel *conn.Tunnel
 
 	sgk       *ShardingGroupKeeper // keeper to keep all sharding (sub) group in this syncer
 	pessimist *shardddl.Pessimist  // shard DDL pessimist
@@ -130,6 +136,10 @@ type Syncer struct {
 	binlogType         BinlogType
 	streamerController *StreamerController
 	enableRelay        bool
+	// idleDetector detects whether no binlog event received for too long.
+	idleDetector *common.IdleDetector
+	// upstreamUUID is the server UUID of the upstream reading binlog from, used to detect the upstream switch.
+	upstreamUUID string
 
 	wg    sync.WaitGroup // counts goroutines
 	jobWg sync.WaitGroup // counts ddl/flush job in-flight in s.dmlJobCh and s.ddlJobCh
@@ -140,8 +150,12 @@ type Syncer struct {
 
 	toDB      *conn.BaseDB
 	toDBConns []*dbconn.DBConn
+	// the DMLs are executed by the shared connections of DM-worker if not nil, toDB is nil then
+	toDBPool  *dbconn.SharedPoolClient
 	ddlDB     *conn.BaseDB
 	ddlDBConn *dbconn.DBConn
+	// shared by toDBConns and ddlDBConn, nil if `error-budget` is not set
+	errorBudget *dbconn.ErrorBudget
 
 	dmlJobCh            chan *job
 	ddlJobCh            chan *job
@@ -150,12 +164,33 @@ type Syncer struct {
 	waitXIDJob          atomic.Int64
 	isTransactionEnd    bool
 	waitTransactionLock sync.Mutex
-
-	tableRouter     *router.Table
-	binlogFilter    *bf.BinlogEvent
-	columnMapping   *cm.Mapping
-	baList          *filter.Filter
-	exprFilterGroup *ExprFilterGroup
+	// limits the size of the row changes buffered in the job chans, nil if `memory-quota` is not set
+	memoryQuota *memoryQuota
+	// isolates the tables of the slow statements into a dedicated DML queue, nil if `slow-digest-threshold` is not set
+	slowDigest *slowDigestDetector
+	// records the DDLs still running in downstream after `ddl-timeout`
+	ddlJobTracker *ddlJobTracker
+	// buffers the DMLs of the downstream tables quarantined by users
+	quarantine *tableQuarantine
+	// the maintenance window to execute the replicated DDLs in, nil if `ddl-window` is not set
+	ddlWindow *config.TimeWindow
+	// the window to apply the DMLs in, nil if `sync-window` is not set
+	syncWindow *config.TimeWindow
+	// the start time of the sync window when replicating is paused out of it, empty otherwise
+	syncWindowStart atomic.String
+
+	tableRouter      *router.Table
+	broadcastRouters []*router.Table
+	binlogFilter     *bf.BinlogEvent
+	columnMapping    *cm.Mapping
+	baList           *filter.Filter
+	exprFilterGroup  *ExprFilterGroup
+	columnTransforms *ColumnTransformGroup
+	charsetConverter *CharsetConvertGroup
+	generatedColumns *GeneratedColumnGroup
+	partitions       *PartitionGroup
+	// the structures of the dropped tables, used to check the table created again by `on-table-recreate`.
+	droppedTables map[string]*model.TableInfo
 
 	closed atomic.Bool
 
@@ -186,6 +221,43 @@ type Syncer struct {
 	checkpoint CheckPoint
 	onlineDDL  onlineddl.OnlinePlugin
 
+	// records rows exceed `max-row-size` when `oversized-row-policy` is `side-table`
+	oversizedRowRecorder *oversizedRowRecorder
+	// fills the columns not logged in binlog by looking up from the upstream when `fill-missing-columns` is set
+	missingColumnFiller *missingColumnFiller
+	// checks the sampled rows written by different shard tables when `shard-conflict-sample-rate` is set
+	shardRowChecker *shardRowChecker
+	// the content of the files loaded by LOAD DATA statements in statement format, keyed by file ID
+	loadDataFiles map[uint32][]byte
+
+	// counts the row changes applied to downstream and writes them into an audit table when `apply-summary-interval` is set
+	applySummary         *applySummary
+	applySummaryRecorder *applySummaryRecorder
+
+	// records the location of the last applied transaction in the same downstream transaction when `exactly-once` is set
+	exactlyOnce *exactlyOnceRecorder
+	// persists the tables of the schema tracker with the checkpoint, the schema tracker is restored from it in Init
+	schemaSnapshot *schemaSnapshotRecorder
+
+	// emits row changes to a message queue instead of the target database when `sink` is set
+	sink *sink.Sink
+	hook hook.Hook
+
+	// writes the SQL statements to a file instead of the target database for `start-task --dry-run`
+	dryRun *dryRunWriter
+
+	// additional downstream databases configured by `targets`, every replica target is replicated by its own syncer,
+	// the syncers keep running after this syncer fails, until the subtask is paused, updated or stopped.
+	replicaTargets       []*replicaTarget
+	replicaTargetsMu     sync.Mutex
+	replicaTargetsCancel context.CancelFunc
+	replicaTargetsWg     sync.WaitGroup
+	// the address of the replica target if the syncer replicates a replica target of another syncer
+	replicaTargetAddr string
+
+	// flushes checkpoints in background when `async-checkpoint-flush` is enabled
+	checkpointFlushWorker *checkpointFlushWorker
+
 	// record process error rather than log.Fatal
 	runFatalChan chan *pb.ProcessError
 	// record whether error occurred when execute SQLs
@@ -194,6 +266,9 @@ type Syncer struct {
 	readerHub              *streamer.ReaderHub
 	recordedActiveRelayLog bool
 
+	// shared by subtasks of the same DM-worker
+	upstreamCache *schema.UpstreamCache
+
 	errOperatorHolder *operator.Holder
 
 	isReplacingErr bool // true if we are in replace events by handle-error
@@ -208,6 +283,7 @@ type Syncer struct {
 		startLocation *binlog.Location
 		endLocation   *binlog.Location
 		isQueryEvent  bool
+		event         string // summary of the error event, shown when previewing the handle-error operations
 	}
 
 	addJobFunc func(*job) error
@@ -218,7 +294,10 @@ type Syncer struct {
 	tsOffset                  atomic.Int64    // time offset between upstream and syncer, DM's timestamp - MySQL's timestamp
 	secondsBehindMaster       atomic.Int64    // current task delay second behind upstream
 	workerJobTSArray          []*atomic.Int64 // worker's sync job TS array, note that idx=0 is skip idx and idx=1 is ddl idx,sql worker job idx=(queue id + 2)
-	lastCheckpointFlushedTime time.Time
+	lastCheckpointFlushedTime struct {
+		sync.Mutex
+		t time.Time
+	}
 }
 
 // NewSyncer creates a new Syncer.
@@ -247,26 +326,47 @@ func NewSyncer(cfg *config.SubTaskConfig, etcdClient *clientv3.Client) *Syncer {
 	syncer.checkpoint = NewRemoteCheckPoint(syncer.tctx, cfg, syncer.checkpointID())
 
 	syncer.binlogType = toBinlogType(cfg.UseRelay)
+	syncer.idleDetector = common.NewIdleDetector(common.IdleThreshold)
 	syncer.errOperatorHolder = operator.NewHolder(&logger)
 	syncer.readerHub = streamer.GetReaderHub()
+	syncer.upstreamCache = schema.GetUpstreamCache()
 
 	if cfg.ShardMode == config.ShardPessimistic {
 		// only need to sync DDL in sharding mode
 		syncer.sgk = NewShardingGroupKeeper(syncer.tctx, cfg)
 	}
 	syncer.recordedActiveRelayLog = false
-	syncer.workerJobTSArray = make([]*atomic.Int64, cfg.WorkerCount+workerJobTSArrayInitSize)
+	syncer.slowDigest = newSlowDigestDetector(time.Duration(cfg.SlowDigestThreshold)*time.Millisecond,
+		syncer.tctx.Logger.WithFields(zap.String("component", "slow_digest_detector")))
+	syncer.ddlJobTracker = newDDLJobTracker()
+	syncer.quarantine = newTableQuarantine(cfg)
+	syncer.workerJobTSArray = make([]*atomic.Int64, syncer.dmlQueueCount()+workerJobTSArrayInitSize)
 	for i := range syncer.workerJobTSArray {
 		syncer.workerJobTSArray[i] = atomic.NewInt64(0)
 	}
-	syncer.lastCheckpointFlushedTime = time.Time{}
 	return syncer
 }
 
+// dmlQueueCount returns the number of DML queues, an extra queue is used to isolate the tables of the slow digests.
+func (s *Syncer) dmlQueueCount() int {
+	if s.slowDigest != nil {
+		return s.cfg.WorkerCount + 1
+	}
+	return s.cfg.WorkerCount
+}
+
 func (s *Syncer) newJobChans() {
 	s.closeJobChans()
 	s.dmlJobCh = make(chan *job, s.cfg.QueueSize)
 	s.ddlJobCh = make(chan *job, s.cfg.QueueSize)
+	if s.cfg.AsyncCheckpointFlush {
+		s.checkpointFlushWorker = newCheckpointFlushWorker(
+			2*time.Duration(s.cfg.CheckpointFlushInterval)*time.Second,
+			s.flushCheckPointTask,
+			func(err error) { s.runFatalChan <- unit.NewProcessError(err) },
+			s.tctx.Logger.WithFields(zap.String("component", "checkpoint_flush_worker")),
+		)
+	}
 	s.jobsClosed.Store(false)
 }
 
@@ -278,6 +378,9 @@ func (s *Syncer) closeJobChans() {
 	}
 	close(s.dmlJobCh)
 	close(s.ddlJobCh)
+	if s.checkpointFlushWorker != nil {
+		s.checkpointFlushWorker.close()
+	}
 	s.jobsClosed.Store(true)
 }
 
@@ -316,6 +419,13 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 
 	s.streamerController = NewStreamerController(s.syncCfg, s.cfg.EnableGTID, s.fromDB, s.binlogType, s.cfg.RelayDir, s.timezone)
+	s.streamerController.netRateLimiter = common.NewNetRateLimiter(s.cfg.NetRateLimit)
+	if s.replicaTargetAddr != "" && !s.cfg.UseRelay {
+		// the syncer of the target database pulls the binlog from the upstream with the same server ID.
+		if err = s.streamerController.updateServerID(tctx); err != nil {
+			return err
+		}
+	}
 
 	s.baList, err = filter.New(s.cfg.CaseSensitive, s.cfg.BAList)
 	if err != nil {
@@ -327,7 +437,31 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 		return terror.ErrSyncerUnitGenBinlogEventFilter.Delegate(err)
 	}
 
+	if s.cfg.DDLWindow != "" {
+		ddlWindow, err2 := config.ParseDDLWindow(s.cfg.DDLWindow)
+		if err2 != nil {
+			return err2
+		}
+		s.ddlWindow = &ddlWindow
+	}
+	if s.cfg.SyncWindow != "" {
+		syncWindow, err2 := config.ParseSyncWindow(s.cfg.SyncWindow)
+		if err2 != nil {
+			return err2
+		}
+		s.syncWindow = &syncWindow
+		if !s.cfg.UseRelay {
+			s.tctx.L().Warn("relay is not enabled, the upstream binlog should be kept until the sync window",
+				zap.String("window", s.cfg.SyncWindow))
+		}
+	}
+
 	s.exprFilterGroup = NewExprFilterGroup(s.cfg.ExprFilter)
+	s.columnTransforms = NewColumnTransformGroup(s.cfg.ColumnTransformations)
+	s.charsetConverter = NewCharsetConvertGroup(s.cfg.CharsetConvert, s.getUpstreamCreateTableSQL)
+	s.generatedColumns = NewGeneratedColumnGroup(s.cfg.GeneratedColumnMismatch)
+	s.partitions = NewPartitionGroup(s.cfg.PartitionRules)
+	s.droppedTables = make(map[string]*model.TableInfo)
 
 	if len(s.cfg.ColumnMappingRules) > 0 {
 		s.columnMapping, err = cm.NewMapping(s.cfg.CaseSensitive, s.cfg.ColumnMappingRules)
@@ -383,10 +517,71 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	rollbackHolder.Add(fr.FuncRollback{Name: "close-checkpoint", Fn: s.checkpoint.Close})
 
+	if s.cfg.MaxRowSize > 0 && s.cfg.OversizedRowPolicy == config.OversizedRowSideTable {
+		s.oversizedRowRecorder = newOversizedRowRecorder(s.tctx, s.cfg)
+		if err = s.oversizedRowRecorder.init(tctx); err != nil {
+			return err
+		}
+		rollbackHolder.Add(fr.FuncRollback{Name: "close-oversized-row-recorder", Fn: s.closeOversizedRowRecorder})
+	}
+
+	if s.cfg.FillMissingColumns {
+		s.missingColumnFiller = newMissingColumnFiller(s.cfg)
+	}
+
+	if s.cfg.ShardConflictSampleRate > 0 {
+		s.shardRowChecker = newShardRowChecker(s.cfg.ShardConflictSampleRate)
+	}
+
+	if s.cfg.ApplySummaryInterval > 0 {
+		s.applySummary = newApplySummary()
+		s.applySummaryRecorder = newApplySummaryRecorder(s.tctx, s.cfg)
+		if err = s.applySummaryRecorder.init(tctx); err != nil {
+			return err
+		}
+		rollbackHolder.Add(fr.FuncRollback{Name: "close-apply-summary-recorder", Fn: s.closeApplySummaryRecorder})
+	}
+
+	if s.cfg.ExactlyOnce {
+		s.exactlyOnce = newExactlyOnceRecorder(s.tctx, s.cfg)
+		if err = s.exactlyOnce.init(tctx); err != nil {
+			return err
+		}
+		rollbackHolder.Add(fr.FuncRollback{Name: "close-exactly-once-recorder", Fn: s.closeExactlyOnceRecorder})
+	}
+
+	if s.cfg.Sink != nil {
+		s.sink, err = sink.NewSink(s.cfg.Sink)
+		if err != nil {
+			return err
+		}
+		rollbackHolder.Add(fr.FuncRollback{Name: "close-sink", Fn: s.closeSink})
+	}
+
+	if s.cfg.DryRun {
+		s.dryRun, err = newDryRunWriter(s.cfg)
+		if err != nil {
+			return err
+		}
+		rollbackHolder.Add(fr.FuncRollback{Name: "close-dry-run-writer", Fn: s.closeDryRunWriter})
+		s.tctx.L().Info("dry run, the SQL statements are written to the file instead of downstream", zap.String("file", dryRunFile(s.cfg)))
+	}
+
+	if s.cfg.HookPlugin != "" {
+		s.hook, err = hook.Load(s.cfg.HookPlugin)
+		if err != nil {
+			return err
+		}
+		s.tctx.L().Info("hook plugin loaded", zap.String("path", s.cfg.HookPlugin))
+	}
+
 	err = s.checkpoint.Load(tctx)
 	if err != nil {
 		return err
 	}
+	if s.replicaTargets, err = newReplicaTargets(s.tctx, s.cfg); err != nil {
+		return err
+	}
 	if s.SourceTableNamesFlavor == utils.LCTableNamesSensitive {
 		if err = s.checkpoint.CheckAndUpdate(ctx, schemaMap, tableMap); err != nil {
 			return err
@@ -399,6 +594,19 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 		}
 	}
 
+	// the global checkpoint may be before the DDLs tracked in pessimistic shard mode, the schema tracker can't be
+	// restored to the state at the global checkpoint, and the checkpoint table isn't created in dry run.
+	if s.cfg.ShardMode != config.ShardPessimistic && !s.cfg.DryRun {
+		s.schemaSnapshot = newSchemaSnapshotRecorder(s.tctx, s.cfg)
+		if err = s.schemaSnapshot.init(tctx); err != nil {
+			return err
+		}
+		rollbackHolder.Add(fr.FuncRollback{Name: "close-schema-snapshot-recorder", Fn: s.closeSchemaSnapshotRecorder})
+		if err = s.restoreSchemaTracker(tctx); err != nil {
+			return err
+		}
+	}
+
 	// when Init syncer, set active relay log info
 	err = s.setInitActiveRelayLog(ctx)
 	if err != nil {
@@ -523,12 +731,15 @@ func (s *Syncer) reset() {
 	}
 	// create new job chans
 	s.newJobChans()
+	// the quota acquired by the jobs dropped before is abandoned together.
+	s.memoryQuota = newMemoryQuota(s.cfg.MemoryQuota)
 
 	s.execError.Store(nil)
-	s.setErrLocation(nil, nil, false)
+	s.setErrLocation(nil, nil, false, "")
 	s.isReplacingErr = false
 	s.waitXIDJob.Store(int64(noWait))
 	s.isTransactionEnd = true
+	s.loadDataFiles = nil
 
 	switch s.cfg.ShardMode {
 	case config.ShardPessimistic:
@@ -594,7 +805,9 @@ func (s *Syncer) Process(ctx context.Context, pr chan pb.ProcessResult) {
 	s.done = make(chan struct{})
 	s.Unlock()
 
-	runFatalChan := make(chan *pb.ProcessError, s.cfg.WorkerCount+1)
+	s.startReplicaTargets()
+
+	runFatalChan := make(chan *pb.ProcessError, s.dmlQueueCount()+1)
 	s.runFatalChan = runFatalChan
 	var (
 		errs   = make([]*pb.ProcessError, 0, 2)
@@ -652,6 +865,10 @@ func (s *Syncer) Process(ctx context.Context, pr chan pb.ProcessResult) {
 		isCanceled = true
 	default:
 	}
+	if isCanceled || len(errs) == 0 {
+		// the replica targets are paused together, but they keep replicating if this syncer fails.
+		s.stopReplicaTargets()
+	}
 
 	// try to rollback checkpoints, if they already flushed, no effect
 	prePos := s.checkpoint.GlobalPoint()
@@ -780,6 +997,31 @@ func (s *Syncer) trackTableInfoFromDownstream(tctx *tcontext.Context, sourceTabl
 	return nil
 }
 
+// trackTableInfoFromUpstream tracks the table info with the CREATE TABLE statement of upstream,
+// the statement is cached and shared by subtasks replicating from the same source.
+func (s *Syncer) trackTableInfoFromUpstream(tctx *tcontext.Context, sourceTable *filter.Table) (*model.TableInfo, error) {
+	createSQL, err := s.upstreamCache.GetCreateTableSQL(tctx.Ctx, s.cfg.SourceID, s.fromDB.BaseDB.DB, sourceTable)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.schemaTracker.CreateSchemaIfNotExists(sourceTable.Schema); err != nil {
+		return nil, terror.ErrSchemaTrackerCannotCreateSchema.Delegate(err, sourceTable.Schema)
+	}
+	if err = s.schemaTracker.Exec(tctx.Ctx, sourceTable.Schema, createSQL); err != nil {
+		return nil, terror.ErrSchemaTrackerCannotCreateTable.Delegate(err, sourceTable)
+	}
+	ti, err := s.schemaTracker.GetTableInfo(sourceTable)
+	if err != nil {
+		return nil, terror.ErrSchemaTrackerCannotGetTable.Delegate(err, sourceTable)
+	}
+	return ti, nil
+}
+
+// getUpstreamCreateTableSQL returns the cached CREATE TABLE statement of the upstream table.
+func (s *Syncer) getUpstreamCreateTableSQL(ctx context.Context, sourceTable *filter.Table) (string, error) {
+	return s.upstreamCache.GetCreateTableSQL(ctx, s.cfg.SourceID, s.fromDB.BaseDB.DB, sourceTable)
+}
+
 func (s *Syncer) addCount(isFinished bool, queueBucket string, tp opType, n int64, targetTable *filter.Table) {
 	m := metrics.AddedJobsTotal
 	if isFinished {
@@ -921,7 +1163,13 @@ func (s *Syncer) addJob(job *job) error {
 		s.waitXIDJob.CAS(int64(waiting), int64(waitComplete))
 		s.saveGlobalPoint(job.location)
 		s.isTransactionEnd = true
-		return nil
+		if s.exactlyOnce == nil {
+			return nil
+		}
+		// the DML worker applies the transaction together with its location when receiving the xid job.
+		if !s.exactlyOnce.isApplied(job.location, nil) {
+			s.dmlJobCh <- job
+		}
 	case skip:
 		s.updateReplicationJobTS(job, skipJobIdx)
 	case flush:
@@ -950,6 +1198,10 @@ func (s *Syncer) addJob(job *job) error {
 
 	// nolint:ifshort
 	needFlush := s.checkFlush()
+	if s.exactlyOnce != nil && !s.isTransactionEnd {
+		// flush the checkpoints after the transactions are applied, rather than in the middle of a transaction.
+		needFlush = false
+	}
 	failpoint.Inject("flushFirstJob", func() {
 		if waitJobsDone {
 			s.tctx.L().Info("trigger flushFirstJob")
@@ -957,7 +1209,13 @@ func (s *Syncer) addJob(job *job) error {
 			needFlush = true
 		}
 	})
-	if needFlush {
+	// fall back to synchronous flush if the asynchronous flush falls behind too much.
+	var asyncFlushWg *sync.WaitGroup
+	if needFlush && job.tp != ddl && s.checkpointFlushWorker != nil && !s.checkpointFlushWorker.stale() {
+		asyncFlushJob := newAsyncFlushJob(s.dmlQueueCount())
+		asyncFlushWg = asyncFlushJob.flushWg
+		s.dmlJobCh <- asyncFlushJob
+	} else if needFlush {
 		s.jobWg.Add(1)
 		s.dmlJobCh <- newFlushJob()
 		s.jobWg.Wait()
@@ -1005,6 +1263,11 @@ func (s *Syncer) addJob(job *job) error {
 		}
 	}
 
+	if asyncFlushWg != nil {
+		s.asyncFlushCheckPoints(asyncFlushWg)
+		return nil
+	}
+
 	if needFlush || job.tp == ddl {
 		// interrupted after save checkpoint and before flush checkpoint.
 		failpoint.Inject("FlushCheckpointStage", func(val failpoint.Value) {
@@ -1025,6 +1288,7 @@ func (s *Syncer) saveGlobalPoint(globalLocation binlog.Location) {
 		// so it is not need to adjust global checkpoint now, and after re-direct supported this should be updated.
 		globalLocation = s.sgk.AdjustGlobalLocation(globalLocation)
 	}
+	globalLocation = s.quarantine.adjustGlobalLocation(globalLocation)
 	s.checkpoint.SaveGlobalPoint(globalLocation)
 }
 
@@ -1050,6 +1314,44 @@ func (s *Syncer) resetShardingGroup(table *filter.Table) {
 //
 // we may need to refactor the concurrency model to make the work-flow more clearer later.
 func (s *Syncer) flushCheckPoints() error {
+	if s.checkpointFlushWorker != nil {
+		// wait for the asynchronous flushes, checkpoints should not be flushed concurrently.
+		s.checkpointFlushWorker.wait()
+	}
+	return s.flushCheckPointTask(s.newCheckpointFlushTask(nil))
+}
+
+// asyncFlushCheckPoints flushes a snapshot of checkpoints in background after flushWg of the asyncFlush job is done.
+func (s *Syncer) asyncFlushCheckPoints(flushWg *sync.WaitGroup) {
+	s.checkpointFlushWorker.add(s.newCheckpointFlushTask(flushWg))
+}
+
+func (s *Syncer) newCheckpointFlushTask(flushWg *sync.WaitGroup) *checkpointFlushTask {
+	task := &checkpointFlushTask{flushWg: flushWg}
+	if s.cfg.ShardMode == config.ShardPessimistic {
+		// flush all checkpoints except tables which are unresolved for sharding DDL for the pessimistic mode.
+		// NOTE: for the optimistic mode, because we don't handle conflicts automatically (or no re-direct supported),
+		// so we can simply flush checkpoint for all tables now, and after re-direct supported this should be updated.
+		var exceptTableIDs map[string]bool
+		exceptTableIDs, task.exceptTables = s.sgk.UnresolvedTables()
+		s.tctx.L().Info("flush checkpoints except for these tables", zap.Reflect("tables", task.exceptTables))
+
+		task.shardMetaSQLs, task.shardMetaArgs = s.sgk.PrepareFlushSQLs(exceptTableIDs)
+		s.tctx.L().Info("prepare flush sqls", zap.Strings("shard meta sqls", task.shardMetaSQLs), zap.Reflect("shard meta arguments", task.shardMetaArgs))
+	}
+	task.snapshot = s.checkpoint.Snapshot()
+	if s.schemaSnapshot != nil {
+		var err error
+		// the schema tracker is restored from the last flushed snapshot if this one fails.
+		if task.schemaSnapshot, err = s.schemaSnapshot.snapshot(s.schemaTracker); err != nil {
+			s.tctx.L().Warn("fail to take a snapshot of schema tracker", log.ShortError(err))
+		}
+	}
+	return task
+}
+
+// flushCheckPointTask flushes the snapshot of checkpoints in the task, it's called by the checkpoint flush worker too.
+func (s *Syncer) flushCheckPointTask(task *checkpointFlushTask) error {
 	err := s.execError.Load()
 	// TODO: for now, if any error occurred (including user canceled), checkpoint won't be updated. But if we have put
 	// optimistic shard info, DM-master may resolved the optimistic lock and let other worker execute DDL. So after this
@@ -1062,41 +1364,35 @@ func (s *Syncer) flushCheckPoints() error {
 		return nil
 	}
 
-	var (
-		exceptTableIDs map[string]bool
-		exceptTables   []*filter.Table
-		shardMetaSQLs  []string
-		shardMetaArgs  [][]interface{}
-	)
-	if s.cfg.ShardMode == config.ShardPessimistic {
-		// flush all checkpoints except tables which are unresolved for sharding DDL for the pessimistic mode.
-		// NOTE: for the optimistic mode, because we don't handle conflicts automatically (or no re-direct supported),
-		// so we can simply flush checkpoint for all tables now, and after re-direct supported this should be updated.
-		exceptTableIDs, exceptTables = s.sgk.UnresolvedTables()
-		s.tctx.L().Info("flush checkpoints except for these tables", zap.Reflect("tables", exceptTables))
-
-		shardMetaSQLs, shardMetaArgs = s.sgk.PrepareFlushSQLs(exceptTableIDs)
-		s.tctx.L().Info("prepare flush sqls", zap.Strings("shard meta sqls", shardMetaSQLs), zap.Reflect("shard meta arguments", shardMetaArgs))
+	extraSQLs, extraArgs := task.shardMetaSQLs, task.shardMetaArgs
+	if task.schemaSnapshot != nil {
+		snapshotSQLs, snapshotArgs := s.schemaSnapshot.flushSQLs(task.schemaSnapshot)
+		extraSQLs = append(append(make([]string, 0, len(extraSQLs)+len(snapshotSQLs)), extraSQLs...), snapshotSQLs...)
+		extraArgs = append(append(make([][]interface{}, 0, len(extraArgs)+len(snapshotArgs)), extraArgs...), snapshotArgs...)
 	}
-
-	err = s.checkpoint.FlushPointsExcept(s.tctx, exceptTables, shardMetaSQLs, shardMetaArgs)
+	err = s.checkpoint.FlushSnapshotPointsExcept(s.tctx, task.snapshot, task.exceptTables, extraSQLs, extraArgs)
 	if err != nil {
 		return terror.Annotatef(err, "flush checkpoint %s", s.checkpoint)
 	}
-	s.tctx.L().Info("flushed checkpoint", zap.Stringer("checkpoint", s.checkpoint))
+	if task.schemaSnapshot != nil {
+		s.schemaSnapshot.setFlushed(task.schemaSnapshot)
+	}
+	s.tctx.L().Info("flushed checkpoint", zap.Stringer("checkpoint", s.checkpoint), zap.Bool("async", task.flushWg != nil))
 
 	// update current active relay log after checkpoint flushed
-	err = s.updateActiveRelayLog(s.checkpoint.GlobalPoint().Position)
+	err = s.updateActiveRelayLog(task.snapshot.GlobalPoint().Position)
 	if err != nil {
 		return err
 	}
 
 	now := time.Now()
-	if !s.lastCheckpointFlushedTime.IsZero() {
-		duration := now.Sub(s.lastCheckpointFlushedTime).Seconds()
+	s.lastCheckpointFlushedTime.Lock()
+	if !s.lastCheckpointFlushedTime.t.IsZero() {
+		duration := now.Sub(s.lastCheckpointFlushedTime.t).Seconds()
 		metrics.FlushCheckPointsTimeInterval.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID).Observe(duration)
 	}
-	s.lastCheckpointFlushedTime = now
+	s.lastCheckpointFlushedTime.t = now
+	s.lastCheckpointFlushedTime.Unlock()
 
 	s.tctx.L().Info("after last flushing checkpoint, DM has ignored row changes by expression filter",
 		zap.Int64("number of filtered insert", s.filteredInsert.Load()),
@@ -1153,10 +1449,18 @@ func (s *Syncer) syncDDL(tctx *tcontext.Context, queueBucket string, db *dbconn.
 
 		if !ignore {
 			var affected int
-			affected, err = db.ExecuteSQLWithIgnore(tctx, ignoreDDLError, ddlJob.ddls)
-			if err != nil {
-				err = s.handleSpecialDDLError(tctx, err, ddlJob.ddls, affected, db)
-				err = terror.WithScope(err, terror.ScopeDownstream)
+			ddlJob.ddls, err = s.hookDDLs(tctx, ddlJob.ddls)
+			switch {
+			case err != nil:
+			case s.sink != nil:
+				// the DDLs are emitted to the sink instead of executed in the target database as the row changes.
+				err = s.emitDDLToSink(tctx, ddlJob)
+			default:
+				affected, err = s.executeDDLs(tctx, db, ddlJob.ddls)
+				if err != nil {
+					err = s.handleSpecialDDLError(tctx, err, ddlJob.ddls, affected, db)
+					err = terror.WithScope(err, terror.ScopeDownstream)
+				}
 			}
 		}
 		failpoint.Label("bypass")
@@ -1175,7 +1479,7 @@ func (s *Syncer) syncDDL(tctx *tcontext.Context, queueBucket string, db *dbconn.
 		if err != nil {
 			s.execError.Store(err)
 			if !utils.IsContextCanceledError(err) {
-				err = s.handleEventError(err, ddlJob.startLocation, ddlJob.currentLocation, true, ddlJob.originSQL)
+				err = s.handleEventError(err, ddlJob.startLocation, ddlJob.currentLocation, true, ddlJob.originSQL, describeJob(ddlJob))
 				s.runFatalChan <- unit.NewProcessError(err)
 			}
 			s.jobWg.Done()
@@ -1215,7 +1519,7 @@ func (s *Syncer) syncDDL(tctx *tcontext.Context, queueBucket string, db *dbconn.
 				s.execError.Store(err)
 			}
 			if !utils.IsContextCanceledError(err) {
-				err = s.handleEventError(err, ddlJob.startLocation, ddlJob.currentLocation, true, ddlJob.originSQL)
+				err = s.handleEventError(err, ddlJob.startLocation, ddlJob.currentLocation, true, ddlJob.originSQL, describeJob(ddlJob))
 				s.runFatalChan <- unit.NewProcessError(err)
 			}
 			s.jobWg.Done()
@@ -1247,6 +1551,10 @@ func (s *Syncer) successFunc(queueID int, jobs []*job) {
 
 	for _, sqlJob := range jobs {
 		s.addCount(true, queueBucket, sqlJob.tp, 1, sqlJob.targetTable)
+		if s.applySummary != nil {
+			s.applySummary.add(sqlJob.tp, sqlJob.targetTable)
+		}
+		s.memoryQuota.release(sqlJob)
 	}
 	s.updateReplicationJobTS(nil, dmlWorkerJobIdx(queueID))
 	metrics.ReplicationTransactionBatch.WithLabelValues(s.cfg.WorkerName, s.cfg.Name, s.cfg.SourceID, queueBucket).Observe(float64(len(jobs)))
@@ -1255,7 +1563,7 @@ func (s *Syncer) successFunc(queueID int, jobs []*job) {
 func (s *Syncer) fatalFunc(job *job, err error) {
 	s.execError.Store(err)
 	if !utils.IsContextCanceledError(err) {
-		err = s.handleEventError(err, job.startLocation, job.currentLocation, false, "")
+		err = s.handleEventError(err, job.startLocation, job.currentLocation, false, "", describeJob(job))
 		s.runFatalChan <- unit.NewProcessError(err)
 	}
 }
@@ -1264,9 +1572,15 @@ func (s *Syncer) fatalFunc(job *job, err error) {
 func (s *Syncer) syncDML() {
 	defer s.wg.Done()
 
-	// TODO: add compactor
-	causalityCh := causalityWrap(s.dmlJobCh, s)
-	flushCh := dmlWorkerWrap(causalityCh, s)
+	dmlJobCh := s.dmlJobCh
+	if s.cfg.Compact {
+		dmlJobCh = compactorWrap(dmlJobCh, s)
+	}
+	// the transactions are applied one by one in `exactly-once` mode, so there is no conflict between the DML queues.
+	if s.exactlyOnce == nil {
+		dmlJobCh = causalityWrap(dmlJobCh, s)
+	}
+	flushCh := dmlWorkerWrap(dmlJobCh, s)
 
 	for range flushCh {
 		s.jobWg.Done()
@@ -1284,6 +1598,8 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			close(s.done)
 		}
 	}()
+	// the buffered events of the quarantined tables are replicated again from the checkpoint in the next run.
+	defer s.quarantine.discard()
 
 	go func() {
 		<-ctx.Done()
@@ -1323,6 +1639,20 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		if err != nil {
 			return err
 		}
+		// `start-task --start-time` has higher priority than meta
+		if s.cfg.StartTime != "" {
+			var pos mysql.Position
+			pos, err = s.getPosByStartTime(tctx)
+			if err != nil {
+				return err
+			}
+			s.checkpoint.SaveGlobalPoint(binlog.InitLocation(pos, nil))
+		}
+	}
+	if s.exactlyOnce != nil {
+		if err = s.exactlyOnce.load(tctx); err != nil {
+			return err
+		}
 	}
 
 	var (
@@ -1334,6 +1664,9 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	if fresh && s.cfg.StartTime != "" {
+		flushCheckpoint = true
+	}
 	if s.cfg.Mode == config.ModeAll && fresh {
 		delLoadTask = true
 		flushCheckpoint = true
@@ -1417,13 +1750,56 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			return terror.Annotate(err, "fail to restart streamer controller")
 		}
 	}
+	if s.upstreamUUID == "" {
+		s.recordUpstreamUUID(tctx.Ctx)
+	}
 
 	s.wg.Add(1)
 	go s.syncDML()
 
+	if s.checkpointFlushWorker != nil {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.checkpointFlushWorker.run()
+		}()
+	}
+
 	s.wg.Add(1)
 	go s.syncDDL(tctx, adminQueueName, s.ddlDBConn, s.ddlJobCh)
 
+	if s.cfg.SchemaDriftCheckInterval > 0 {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.schemaDriftLoop(runCtx)
+		}()
+	}
+
+	if s.applySummaryRecorder != nil {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.applySummaryLoop(runCtx)
+		}()
+	}
+
+	if s.ddlWindow != nil && s.cfg.DDLWindowPolicy == config.DDLWindowBuffer {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.ddlWindowLoop(runCtx)
+		}()
+	}
+
+	if s.cfg.ShardVerifyInterval > 0 {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.shardVerifyLoop(runCtx)
+		}()
+	}
+
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
@@ -1555,6 +1931,9 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 
 	// eventIndex is the rows event index in this transaction, it's used to avoiding read duplicate event in gtid mode
 	eventIndex := 0
+	// txnGTID is the GTID of the current transaction in GTID mode, it's used to skip the applied transaction in
+	// `exactly-once` mode.
+	var txnGTID gtid.Set
 	// the relay log file may be truncated(not end with an RotateEvent), in this situation, we may read some rows events
 	// and then read from the gtid again, so we force enter safe-mode for one more transaction to avoid failure due to
 	// conflict
@@ -1613,6 +1992,22 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			return nil
 		case err == context.DeadlineExceeded:
 			tctx.L().Info("deadline exceeded when fetching binlog event")
+			// only reconnect the remote binlog connection, the local relay log has its own idle detection.
+			if s.streamerController.GetBinlogType() == RemoteBinlog && s.idleDetector.Check(time.Now()) {
+				tctx.L().Warn("no binlog event received for too long, the connection is suspect, will reconnect",
+					zap.Duration("idle duration", s.idleDetector.IdleDuration(time.Now())),
+					zap.Stringer("location", s.checkpoint.GlobalPoint()))
+				if err = s.checkUpstreamSwitch(tctx, s.checkpoint.GlobalPoint()); err != nil {
+					return err
+				}
+				err = s.streamerController.ResetReplicationSyncer(tctx, s.checkpoint.GlobalPoint())
+				if err != nil {
+					return err
+				}
+				if err = maybeSkipNRowsEvent(eventIndex); err != nil {
+					return err
+				}
+			}
 			continue
 		case isDuplicateServerIDError(err):
 			// if the server id is already used, need to use a new server id
@@ -1640,6 +2035,9 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 
 			if s.streamerController.CanRetry(err) {
 				// GlobalPoint is the last finished GTID
+				if err = s.checkUpstreamSwitch(tctx, s.checkpoint.GlobalPoint()); err != nil {
+					return err
+				}
 				err = s.streamerController.ResetReplicationSyncer(tctx, s.checkpoint.GlobalPoint())
 				if err != nil {
 					return err
@@ -1664,6 +2062,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 
 			return terror.ErrSyncerGetEvent.Generate(err)
 		}
+		s.idleDetector.Mark(time.Now())
 
 		failpoint.Inject("IgnoreSomeTypeEvent", func(val failpoint.Value) {
 			if e.Header.EventType.String() == val.(string) {
@@ -1692,6 +2091,21 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 
 		tctx.L().Debug("receive binlog event", zap.Reflect("header", e.Header))
 
+		// check the sync window before a transaction or a DDL is dispatched, so nothing is applied out of the window.
+		if isSyncWindowBoundary(e) && shardingReSync == nil && !s.isReplacingErr {
+			reset, err2 := s.waitSyncWindow(tctx)
+			switch {
+			case err2 == context.Canceled:
+				tctx.L().Info("binlog replication main routine quit(context canceled) when waiting for the sync window!", zap.Stringer("last location", lastLocation))
+				return nil
+			case err2 != nil:
+				return err2
+			case reset:
+				// the event will be read again from the checkpoint.
+				continue
+			}
+		}
+
 		// TODO: support all event
 		// we calculate startLocation and endLocation(currentLocation) for Query event here
 		// set startLocation empty for other events to avoid misuse
@@ -1725,9 +2139,9 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			}
 
 			if !s.isReplacingErr {
-				apply, op := s.errOperatorHolder.MatchAndApply(startLocation, currentLocation, e.Header.Timestamp)
+				apply, op := s.errOperatorHolder.MatchAndApply(startLocation, currentLocation, e)
 				if apply {
-					if op == pb.ErrorOp_Replace {
+					if op == pb.ErrorOp_Replace || op == pb.ErrorOp_Inject {
 						s.isReplacingErr = true
 						// revert currentLocation to startLocation
 						currentLocation = startLocation
@@ -1790,12 +2204,17 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			tryReSync:           tryReSync,
 			startTime:           startTime,
 			shardingReSyncCh:    &shardingReSyncCh,
+			txnGTID:             txnGTID,
 		}
 
 		var originSQL string // show origin sql when error, only ddl now
 		var err2 error
 
 		switch ev := e.Event.(type) {
+		case *replication.GTIDEvent, *replication.MariadbGTIDEvent:
+			if s.exactlyOnce != nil && s.cfg.EnableGTID {
+				txnGTID, err2 = transactionGTID(s.cfg.Flavor, ev)
+			}
 		case *replication.RotateEvent:
 			err2 = s.handleRotateEvent(ev, ec)
 		case *replication.RowsEvent:
@@ -1843,17 +2262,25 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 
 			job := newXIDJob(currentLocation, startLocation, currentLocation)
 			err2 = s.addJobFunc(job)
+		case *replication.BeginLoadQueryEvent:
+			s.appendLoadDataBlock(ev.FileID, ev.BlockData, true)
+		case *replication.ExecuteLoadQueryEvent:
+			eventIndex++
+			originSQL, err2 = s.handleExecuteLoadQueryEvent(ev, e.RawData, ec)
 		case *replication.GenericEvent:
-			if e.Header.EventType == replication.HEARTBEAT_EVENT {
+			switch e.Header.EventType {
+			case replication.HEARTBEAT_EVENT:
 				// flush checkpoint even if there are no real binlog events
 				if s.checkpoint.CheckGlobalPoint() {
 					tctx.L().Info("meet heartbeat event and then flush jobs")
 					err2 = s.flushJobs()
 				}
+			case replication.APPEND_BLOCK_EVENT, replication.DELETE_FILE_EVENT:
+				s.handleLoadDataFileEvent(e, ev)
 			}
 		}
 		if err2 != nil {
-			if err := s.handleEventError(err2, startLocation, currentLocation, e.Header.EventType == replication.QUERY_EVENT, originSQL); err != nil {
+			if err := s.handleEventError(err2, startLocation, currentLocation, e.Header.EventType == replication.QUERY_EVENT, originSQL, describeEvent(e)); err != nil {
 				return err
 			}
 		}
@@ -1878,6 +2305,8 @@ type eventContext struct {
 	tryReSync        bool
 	startTime        time.Time
 	shardingReSyncCh *chan *ShardingReSync
+	// txnGTID is the GTID of the transaction which the event belongs to, it's only set in `exactly-once` GTID mode.
+	txnGTID gtid.Set
 }
 
 // TODO: Further split into smaller functions and group common arguments into a context struct.
@@ -2016,18 +2445,44 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 
 	// TODO(csuzhangxc): check performance of `getTable` from schema tracker.
 	tableInfo, err := s.getTableInfo(ec.tctx, sourceTable, targetTable)
+	if err != nil && utils.IsMySQLError(err, tmysql.ErrNoSuchTable) {
+		ec.tctx.L().Warn("table not exists in downstream, track it with the table structure of upstream",
+			zap.Stringer("source", sourceTable), zap.Stringer("target", targetTable))
+		tableInfo, err = s.trackTableInfoFromUpstream(ec.tctx, sourceTable)
+	}
 	if err != nil {
 		return terror.WithScope(err, terror.ScopeDownstream)
 	}
+	var notLogged []map[int]struct{}
+	switch {
+	case s.missingColumnFiller != nil:
+		err = s.missingColumnFiller.fill(ec.tctx, s.fromDB.BaseDB, sourceTable, tableInfo, ec.header.EventType, ev.Rows, ev.SkippedColumns)
+	case s.cfg.MinimalRowImage:
+		notLogged, err = prepareMinimalRows(tableInfo, ec.header.EventType, ev.Rows, ev.SkippedColumns)
+	default:
+		err = checkLogColumns(ev.SkippedColumns)
+	}
+	if err != nil {
+		return err
+	}
 	rows, err := s.mappingDML(sourceTable, tableInfo, ev.Rows)
 	if err != nil {
 		return err
 	}
-	if err2 := checkLogColumns(ev.SkippedColumns); err2 != nil {
-		return err2
+	rows, err = s.charsetConverter.TranscodeRows(ec.tctx, sourceTable, tableInfo, rows)
+	if err != nil {
+		return err
+	}
+	transformedRows, transformed, err := s.columnTransforms.TransformRows(sourceTable, tableInfo, rows)
+	if err != nil {
+		return err
 	}
 
-	prunedColumns, prunedRows, err := pruneGeneratedColumnDML(tableInfo, rows)
+	pruner, err := s.getColumnPruner(ec.tctx, sourceTable, targetTable, tableInfo)
+	if err != nil {
+		return err
+	}
+	prunedColumns, prunedRows, err := pruneColumnDML(tableInfo, transformedRows, pruner)
 	if err != nil {
 		return err
 	}
@@ -2040,10 +2495,15 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 	param := &genDMLParam{
 		targetTableID:   utils.GenTableID(targetTable),
 		data:            prunedRows,
-		originalData:    rows,
+		originalData:    transformedRows,
 		columns:         prunedColumns,
 		sourceTableInfo: tableInfo,
 		sourceTable:     sourceTable,
+		minimalRowImage: s.cfg.MinimalRowImage,
+		notLogged:       notLogged,
+	}
+	if transformed {
+		param.filterData = rows
 	}
 
 	switch ec.header.EventType {
@@ -2093,11 +2553,34 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 		return nil
 	}
 
+	dmls, err = s.hookDMLs(ec.tctx, targetTable, ec.header.Timestamp, dmls)
+	if err != nil {
+		return err
+	}
+	dmls, err = s.handleOversizedDMLs(&ec, dmls)
+	if err != nil {
+		return err
+	}
+	if s.shardRowChecker != nil {
+		if err = s.shardRowChecker.check(dmls, s.cfg.SourceID); err != nil {
+			return err
+		}
+	}
+	if err = s.locatePartitions(ec.tctx, targetTable, dmls); err != nil {
+		return err
+	}
+
 	startTime := time.Now()
-	for i := range dmls {
-		job := newDMLJob(jobType, sourceTable, targetTable, dmls[i], &ec)
-		err = s.addJobFunc(job)
-		if err != nil {
+	if err = s.dispatchDMLs(&ec, jobType, sourceTable, targetTable, dmls); err != nil {
+		return err
+	}
+
+	for _, broadcastTable := range s.broadcastTargets(sourceTable, targetTable) {
+		broadcastDMLs := cloneDMLsForTarget(dmls, broadcastTable)
+		if err = s.locatePartitions(ec.tctx, broadcastTable, broadcastDMLs); err != nil {
+			return err
+		}
+		if err = s.dispatchDMLs(&ec, jobType, sourceTable, broadcastTable, broadcastDMLs); err != nil {
 			return err
 		}
 	}
@@ -2105,6 +2588,31 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) err
 	return nil
 }
 
+// dispatchDMLs adds the jobs of dmls to targetTable, or buffers them if targetTable is quarantined.
+func (s *Syncer) dispatchDMLs(ec *eventContext, jobType opType, sourceTable, targetTable *filter.Table, dmls []*DML) error {
+	if s.exactlyOnce != nil && s.exactlyOnce.isApplied(*ec.lastLocation, ec.txnGTID) {
+		ec.tctx.L().Debug("skip the row changes of the applied transaction",
+			zap.Stringer("target table", targetTable),
+			log.WrapStringerField("location", ec.lastLocation))
+		return nil
+	}
+	quarantined, err := s.quarantine.buffer(targetTable, dmls, s.checkpoint.GlobalPoint)
+	if err != nil || quarantined {
+		return err
+	}
+	for i := range dmls {
+		// the type of the row change may be changed by the hook plugin.
+		job := newDMLJob(dmls[i].op, sourceTable, targetTable, dmls[i], ec)
+		if err = s.memoryQuota.acquire(ec.tctx.Ctx, job); err != nil {
+			return err
+		}
+		if err = s.addJobFunc(job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type queryEventContext struct {
 	*eventContext
 
@@ -2115,6 +2623,7 @@ type queryEventContext struct {
 	splitDDLs      []string // after split before online ddl
 	needRouteDDLs  []string // after onlineDDL apply if onlineDDL != nil and track, before route
 	needHandleDDLs []string // after route
+	broadcastDDLs  []string // DDLs of the broadcast target tables
 
 	shardingDDLInfo *ddlInfo
 	trackInfos      []*ddlInfo
@@ -2292,6 +2801,21 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 			continue
 		}
 
+		if s.cfg.PartitionDDLPolicy == config.PartitionDDLDrop || s.cfg.PartitionDDLPolicy == config.PartitionDDLConvert {
+			dropped, err2 := s.rewritePartitionDDL(qec.tctx, ddlInfo)
+			if err2 != nil {
+				return err2
+			}
+			if dropped {
+				s.trackPartitionDDL(qec, ddlInfo)
+				continue
+			}
+		}
+
+		if err = s.charsetConverter.ConvertDDL(qec.tctx, ddlInfo); err != nil {
+			return err
+		}
+
 		// pre-filter of sharding
 		if s.cfg.ShardMode == config.ShardPessimistic {
 			switch ddlInfo.originStmt.(type) {
@@ -2333,6 +2857,18 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 			}
 		}
 
+		// the DDL is executed synchronously and the following DMLs depend on it, so it can't be buffered like the DMLs.
+		// except for the tables held by the DDL window, the DDL is held after the buffered DMLs of them.
+		for _, table := range ddlInfo.targetTables {
+			if s.quarantine.isQuarantined(table) && !s.quarantine.isHeldByDDLWindow(table) {
+				return terror.ErrSyncerQuarantinedTableDDL.Generate(ddlInfo.routedDDL, table)
+			}
+		}
+		broadcastDDLs, err2 := s.genBroadcastDDLs(ddlInfo)
+		if err2 != nil {
+			return err2
+		}
+		qec.broadcastDDLs = append(qec.broadcastDDLs, broadcastDDLs...)
 		qec.needHandleDDLs = append(qec.needHandleDDLs, ddlInfo.routedDDL)
 		qec.trackInfos = append(qec.trackInfos, ddlInfo)
 		// TODO: current table checkpoints will be deleted in track ddls, but created and updated in flush checkpoints,
@@ -2361,6 +2897,12 @@ func (s *Syncer) handleQueryEvent(ev *replication.QueryEvent, ec eventContext, o
 	if err = s.flushJobs(); err != nil {
 		return err
 	}
+	if err = s.waitDDLWindow(qec); err != nil {
+		return err
+	}
+	if err = s.handleBroadcastDDLs(qec); err != nil {
+		return err
+	}
 
 	switch s.cfg.ShardMode {
 	case "":
@@ -2399,11 +2941,16 @@ func (s *Syncer) handleQueryEventNoSharding(qec *queryEventContext) error {
 		}
 	})
 
-	job := newDDLJob(qec)
-	err := s.addJobFunc(job)
+	held, err := s.holdDDLs(qec)
 	if err != nil {
 		return err
 	}
+	if !held {
+		job := newDDLJob(qec)
+		if err = s.addJobFunc(job); err != nil {
+			return err
+		}
+	}
 
 	// when add ddl job, will execute ddl and then flush checkpoint.
 	// if execute ddl failed, the execError will be set to that error.
@@ -2686,6 +3233,7 @@ func (s *Syncer) trackDDL(usedSchema string, trackInfo *ddlInfo, ec *eventContex
 		tryFetchDownstreamTable = true
 	case *ast.DropTableStmt:
 		shouldExecDDLOnSchemaTracker = true
+		s.recordDroppedTable(ec.tctx, srcTable, targetTables[0])
 		if err := s.checkpoint.DeleteTablePoint(ec.tctx, srcTable); err != nil {
 			return err
 		}
@@ -2738,7 +3286,13 @@ func (s *Syncer) trackDDL(usedSchema string, trackInfo *ddlInfo, ec *eventContex
 		}
 	}
 
-	if tryFetchDownstreamTable {
+	// the table dropped before is created again in upstream.
+	var droppedTi *model.TableInfo
+	if _, ok := trackInfo.originStmt.(*ast.CreateTableStmt); ok {
+		droppedTi = s.popDroppedTable(srcTables[0])
+	}
+	// the downstream table of a recreated table may still have the old structure, so it's not fetched.
+	if tryFetchDownstreamTable && droppedTi == nil {
 		// ignore table not exists error, just try to fetch table from downstream.
 		_, _ = s.getTableInfo(ec.tctx, srcTables[0], targetTables[0])
 	}
@@ -2753,8 +3307,23 @@ func (s *Syncer) trackDDL(usedSchema string, trackInfo *ddlInfo, ec *eventContex
 			return terror.ErrSchemaTrackerCannotExecDDL.Delegate(err, trackInfo.originDDL)
 		}
 		s.exprFilterGroup.ResetExprs(srcTable)
+		s.columnTransforms.ResetTransforms(srcTable)
+		s.generatedColumns.ResetPruners(srcTable)
 	}
 
+	// the cached upstream table structures are outdated after the DDL.
+	for _, tbl := range srcTables {
+		s.upstreamCache.Invalidate(s.cfg.SourceID, tbl, *ec.currentLocation, s.cfg.EnableGTID)
+		s.charsetConverter.ResetColumns(tbl)
+	}
+	// the partitions of the downstream tables may be changed by the DDL.
+	for _, tbl := range targetTables {
+		s.partitions.ResetLocator(tbl)
+	}
+
+	if droppedTi != nil {
+		return s.checkRecreatedTable(ec.tctx, srcTables[0], targetTables[0], droppedTi)
+	}
 	return nil
 }
 
@@ -2766,6 +3335,11 @@ func (s *Syncer) genRouter() error {
 			return terror.ErrSyncerUnitGenTableRouter.Delegate(err)
 		}
 	}
+	broadcastRouters, err := genBroadcastRouters(s.cfg.CaseSensitive, s.cfg.BroadcastRouteRules)
+	if err != nil {
+		return err
+	}
+	s.broadcastRouters = broadcastRouters
 	return nil
 }
 
@@ -2846,11 +3420,11 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	conn, err := s.fromDB.BaseDB.GetBaseConn(ctx)
+	baseConn, err := s.fromDB.BaseDB.GetBaseConn(ctx)
 	if err != nil {
 		return err
 	}
-	lcFlavor, err := utils.FetchLowerCaseTableNamesSetting(ctx, conn.DBConn)
+	lcFlavor, err := utils.FetchLowerCaseTableNamesSetting(ctx, baseConn.DBConn)
 	if err != nil {
 		return err
 	}
@@ -2879,13 +3453,22 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 		}
 		s.cfg.To.Session["sql_mode"] = sqlModes
 	}
+	if err = conn.AddSessionLabels(ctx, &s.cfg.To, s.cfg.Name, s.cfg.SourceID); err != nil {
+		s.tctx.L().Warn("cannot label the downstream sessions with the task and source", log.ShortError(err))
+	}
 
 	dbCfg = s.cfg.To
 	dbCfg.RawDBCfg = config.DefaultRawDBConfig().
 		SetReadTimeout(maxDMLConnectionTimeout).
-		SetMaxIdleConns(s.cfg.WorkerCount)
+		SetMaxIdleConns(s.dmlQueueCount())
 
-	s.toDB, s.toDBConns, err = dbconn.CreateConns(s.tctx, s.cfg, dbCfg, s.cfg.WorkerCount)
+	if s.cfg.DownstreamPoolSize > 0 {
+		// the settings should be the same for all the subtasks to share the connections.
+		dbCfg.RawDBCfg.SetMaxIdleConns(s.cfg.DownstreamPoolSize)
+		s.toDBPool, s.toDBConns, err = dbconn.CreateSharedConns(s.tctx, s.cfg, dbCfg, s.dmlQueueCount(), s.cfg.DownstreamPoolSize)
+	} else {
+		s.toDB, s.toDBConns, err = dbconn.CreateConns(s.tctx, s.cfg, dbCfg, s.dmlQueueCount())
+	}
 	if err != nil {
 		dbconn.CloseUpstreamConn(s.tctx, s.fromDB) // release resources acquired before return with error
 		return err
@@ -2898,21 +3481,39 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 	s.ddlDB, ddlDBConns, err = dbconn.CreateConns(s.tctx, s.cfg, dbCfg, 1)
 	if err != nil {
 		dbconn.CloseUpstreamConn(s.tctx, s.fromDB)
-		dbconn.CloseBaseDB(s.tctx, s.toDB)
+		s.closeToDB()
 		return err
 	}
 	s.ddlDBConn = ddlDBConns[0]
+
+	s.errorBudget = dbconn.NewErrorBudget(s.cfg.ErrorBudget, time.Duration(s.cfg.ErrorBudgetWindow)*time.Second)
+	for _, dbConn := range s.toDBConns {
+		dbConn.ErrorBudget = s.errorBudget
+	}
+	s.ddlDBConn.ErrorBudget = s.errorBudget
 	printServerVersion(s.tctx, s.fromDB.BaseDB, "upstream")
-	printServerVersion(s.tctx, s.toDB, "downstream")
+	printServerVersion(s.tctx, s.ddlDB, "downstream")
 
 	return nil
 }
 
+// closeToDB closes the DB of the DMLs, or leaves the shared pool.
+func (s *Syncer) closeToDB() {
+	if s.toDBPool != nil {
+		s.toDBPool.Leave(s.tctx)
+		s.toDBPool = nil
+		return
+	}
+	dbconn.CloseBaseDB(s.tctx, s.toDB)
+}
+
 // closeBaseDB closes all opened DBs, rollback for createConns.
 func (s *Syncer) closeDBs() {
 	dbconn.CloseUpstreamConn(s.tctx, s.fromDB)
-	dbconn.CloseBaseDB(s.tctx, s.toDB)
+	s.closeToDB()
 	dbconn.CloseBaseDB(s.tctx, s.ddlDB)
+	s.tunnel.Close()
+	s.tunnel = nil
 }
 
 // record skip ddl/dml sqls' position
@@ -2982,11 +3583,21 @@ func (s *Syncer) Close() {
 	}
 
 	s.closeOnlineDDL()
+	s.closeOversizedRowRecorder()
+	s.closeApplySummaryRecorder()
+	s.closeExactlyOnceRecorder()
+	s.closeSchemaSnapshotRecorder()
+	s.closeSink()
+	s.closeDryRunWriter()
+	s.stopReplicaTargets()
 
 	// when closing syncer by `stop-task`, remove active relay log from hub
 	s.removeActiveRelayLog()
 
-	metrics.RemoveLabelValuesWithTaskInMetrics(s.cfg.Name)
+	// the syncer of a replica target is closed before it's restarted, the metrics of the task are kept.
+	if s.replicaTargetAddr == "" {
+		metrics.RemoveLabelValuesWithTaskInMetrics(s.cfg.Name)
+	}
 
 	s.closed.Store(true)
 }
@@ -3015,6 +3626,52 @@ func (s *Syncer) closeOnlineDDL() {
 	}
 }
 
+func (s *Syncer) closeOversizedRowRecorder() {
+	if s.oversizedRowRecorder != nil {
+		s.oversizedRowRecorder.close()
+		s.oversizedRowRecorder = nil
+	}
+}
+
+func (s *Syncer) closeApplySummaryRecorder() {
+	if s.applySummaryRecorder != nil {
+		s.applySummaryRecorder.close()
+		s.applySummaryRecorder = nil
+	}
+}
+
+func (s *Syncer) closeDryRunWriter() {
+	if s.dryRun != nil {
+		if err := s.dryRun.close(); err != nil {
+			s.tctx.L().Error("fail to close dry run writer", log.ShortError(err))
+		}
+		s.dryRun = nil
+	}
+}
+
+func (s *Syncer) closeExactlyOnceRecorder() {
+	if s.exactlyOnce != nil {
+		s.exactlyOnce.close()
+		s.exactlyOnce = nil
+	}
+}
+
+func (s *Syncer) closeSchemaSnapshotRecorder() {
+	if s.schemaSnapshot != nil {
+		s.schemaSnapshot.close()
+		s.schemaSnapshot = nil
+	}
+}
+
+func (s *Syncer) closeSink() {
+	if s.sink != nil {
+		if err := s.sink.Close(); err != nil {
+			s.tctx.L().Error("fail to close sink", log.ShortError(err))
+		}
+		s.sink = nil
+	}
+}
+
 // Pause implements Unit.Pause.
 func (s *Syncer) Pause() {
 	if s.isClosed() {
@@ -3047,6 +3704,14 @@ func (s *Syncer) Resume(ctx context.Context, pr chan pb.ProcessResult) {
 	s.Process(ctx, pr)
 }
 
+// ResetErrorBudget refills the error budget of the downstream, it should be called before Resume.
+func (s *Syncer) ResetErrorBudget() {
+	if s.errorBudget != nil {
+		s.tctx.L().Info("reset the error budget of the downstream")
+		s.errorBudget.Reset()
+	}
+}
+
 // Update implements Unit.Update
 // now, only support to update config for routes, filters, column-mappings, block-allow-list
 // now no config diff implemented, so simply re-init use new config.
@@ -3097,6 +3762,10 @@ func (s *Syncer) Update(cfg *config.SubTaskConfig) error {
 	if err != nil {
 		return terror.ErrSyncerUnitGenTableRouter.Delegate(err)
 	}
+	broadcastRouters, err := genBroadcastRouters(cfg.CaseSensitive, cfg.BroadcastRouteRules)
+	if err != nil {
+		return err
+	}
 
 	// update binlog filter
 	oldBinlogFilter = s.binlogFilter
@@ -3137,10 +3806,27 @@ func (s *Syncer) Update(cfg *config.SubTaskConfig) error {
 	s.cfg.FilterRules = cfg.FilterRules
 	s.cfg.ColumnMappingRules = cfg.ColumnMappingRules
 
+	// update broadcast-route-rules
+	s.broadcastRouters = broadcastRouters
+	s.cfg.BroadcastRouteRules = cfg.BroadcastRouteRules
+
+	// update column-transformations
+	s.columnTransforms = NewColumnTransformGroup(cfg.ColumnTransformations)
+	s.cfg.ColumnTransformations = cfg.ColumnTransformations
+
+	// update charset-convert
+	s.charsetConverter = NewCharsetConvertGroup(cfg.CharsetConvert, s.getUpstreamCreateTableSQL)
+	s.cfg.CharsetConvert = cfg.CharsetConvert
+
+	// update partition-rules
+	s.partitions = NewPartitionGroup(cfg.PartitionRules)
+	s.cfg.PartitionRules = cfg.PartitionRules
+
 	// update timezone
 	s.setTimezone()
 
-	return nil
+	err = s.resetReplicaTargets()
+	return err
 }
 
 // assume that reset master before switching to new master, and only the new master would write
@@ -3184,12 +3870,19 @@ func (s *Syncer) UpdateFromConfig(cfg *config.SubTaskConfig) error {
 	if s.streamerController != nil {
 		s.streamerController.UpdateSyncCfg(s.syncCfg, s.fromDB)
 	}
-	return nil
+	return s.resetReplicaTargets()
 }
 
 func (s *Syncer) setTimezone() {
-	s.tctx.L().Info("use timezone", log.WrapStringerField("location", time.UTC))
-	s.timezone = time.UTC
+	// the timestamps in binlog are formatted in the session time zone of the target database.
+	loc, err := utils.ParseTimeZone(s.cfg.To.TimeZone())
+	if err != nil {
+		// should not happen, the time zone is verified when adjusting the subtask config.
+		s.tctx.L().Warn("invalid session time zone, use UTC instead", log.ShortError(err))
+		loc = time.UTC
+	}
+	s.tctx.L().Info("use timezone", log.WrapStringerField("location", loc))
+	s.timezone = loc
 }
 
 func (s *Syncer) setSyncCfg() error {
@@ -3209,11 +3902,18 @@ func (s *Syncer) setSyncCfg() error {
 		}
 	}
 
+	from, tunnel, err := conn.ApplyTunnel(s.cfg.From)
+	if err != nil {
+		return err
+	}
+	s.tunnel.Close()
+	s.tunnel = tunnel
+
 	syncCfg := replication.BinlogSyncerConfig{
 		ServerID:                s.cfg.ServerID,
 		Flavor:                  s.cfg.Flavor,
-		Host:                    s.cfg.From.Host,
-		Port:                    uint16(s.cfg.From.Port),
+		Host:                    from.Host,
+		Port:                    uint16(from.Port),
 		User:                    s.cfg.From.User,
 		Password:                s.cfg.From.Password,
 		TimestampStringLocation: s.timezone,
@@ -3227,20 +3927,27 @@ func (s *Syncer) setSyncCfg() error {
 	return nil
 }
 
+// FlushedLocation returns the flushed global checkpoint location, which is the position replicated by syncer.
+func (s *Syncer) FlushedLocation() binlog.Location {
+	return s.checkpoint.FlushedGlobalPoint()
+}
+
 // ShardDDLOperation returns the current pending to handle shard DDL lock operation.
 func (s *Syncer) ShardDDLOperation() *pessimism.Operation {
 	return s.pessimist.PendingOperation()
 }
 
-func (s *Syncer) setErrLocation(startLocation, endLocation *binlog.Location, isQueryEventEvent bool) {
+func (s *Syncer) setErrLocation(startLocation, endLocation *binlog.Location, isQueryEventEvent bool, event string) {
 	s.errLocation.Lock()
 	defer s.errLocation.Unlock()
 
 	s.errLocation.isQueryEvent = isQueryEventEvent
 	if s.errLocation.startLocation == nil || startLocation == nil {
 		s.errLocation.startLocation = startLocation
+		s.errLocation.event = event
 	} else if binlog.CompareLocation(*startLocation, *s.errLocation.startLocation, s.cfg.EnableGTID) < 0 {
 		s.errLocation.startLocation = startLocation
+		s.errLocation.event = event
 	}
 
 	if s.errLocation.endLocation == nil || endLocation == nil {
@@ -3256,12 +3963,20 @@ func (s *Syncer) getErrLocation() (*binlog.Location, bool) {
 	return s.errLocation.startLocation, s.errLocation.isQueryEvent
 }
 
-func (s *Syncer) handleEventError(err error, startLocation, endLocation binlog.Location, isQueryEvent bool, originSQL string) error {
+// getErrEvent returns the locations, whether it's a query event and the summary of the error event.
+func (s *Syncer) getErrEvent() (*binlog.Location, *binlog.Location, bool, string) {
+	s.errLocation.Lock()
+	defer s.errLocation.Unlock()
+	return s.errLocation.startLocation, s.errLocation.endLocation, s.errLocation.isQueryEvent, s.errLocation.event
+}
+
+// handleEventError records the location and the summary of the error event, and annotates the error with the location.
+func (s *Syncer) handleEventError(err error, startLocation, endLocation binlog.Location, isQueryEvent bool, originSQL, event string) error {
 	if err == nil {
 		return nil
 	}
 
-	s.setErrLocation(&startLocation, &endLocation, isQueryEvent)
+	s.setErrLocation(&startLocation, &endLocation, isQueryEvent, event)
 	if len(originSQL) > 0 {
 		return terror.Annotatef(err, "startLocation: [%s], endLocation: [%s], origin SQL: [%s]", startLocation, endLocation, originSQL)
 	}
@@ -1171,7 +1171,8 @@ func (s *testSyncerSuite) TestTrackDDL(c *C) {
 		testDB   = "test_db"
 		testTbl  = "test_tbl"
 		testTbl2 = "test_tbl2"
-		ec       = &eventContext{tctx: tcontext.Background()}
+		curLoc   = binlog.NewLocation("")
+		ec       = &eventContext{tctx: tcontext.Background(), currentLocation: &curLoc}
 		qec      = &queryEventContext{
 			eventContext: ec,
 			ddlSchema:    testDB,
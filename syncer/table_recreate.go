@@ -0,0 +1,194 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	tmysql "github.com/pingcap/tidb/parser/mysql"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	parserpkg "github.com/pingcap/dm/pkg/parser"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// maxDroppedTables is the max number of dropped tables whose structures are kept to check the recreated tables.
+const maxDroppedTables = 1024
+
+// recordDroppedTable records the structure of the table before it's dropped if `on-table-recreate` is set.
+func (s *Syncer) recordDroppedTable(tctx *tcontext.Context, sourceTable, targetTable *filter.Table) {
+	if s.cfg.OnTableRecreate == "" {
+		return
+	}
+	ti, err := s.getTableInfo(tctx, sourceTable, targetTable)
+	if err != nil {
+		// the table is unknown, it's dropped in downstream too.
+		return
+	}
+	if len(s.droppedTables) >= maxDroppedTables {
+		for tableID := range s.droppedTables {
+			delete(s.droppedTables, tableID)
+			break
+		}
+	}
+	s.droppedTables[utils.GenTableID(sourceTable)] = ti
+}
+
+// popDroppedTable returns and forgets the structure of the table before it's dropped, nil if it's not dropped.
+func (s *Syncer) popDroppedTable(sourceTable *filter.Table) *model.TableInfo {
+	tableID := utils.GenTableID(sourceTable)
+	ti, ok := s.droppedTables[tableID]
+	if !ok {
+		return nil
+	}
+	delete(s.droppedTables, tableID)
+	return ti
+}
+
+// checkRecreatedTable checks the table created again after dropped. if its structure is different from before, the
+// rules of the table are validated against the new structure, and the downstream table which still has the old
+// structure (because the DROP TABLE is filtered, for example) is reported or rebuilt according to `on-table-recreate`.
+func (s *Syncer) checkRecreatedTable(tctx *tcontext.Context, sourceTable, targetTable *filter.Table, oldTi *model.TableInfo) error {
+	ti, err := s.getTableInfo(tctx, sourceTable, targetTable)
+	if err != nil {
+		return err
+	}
+	if tableStructure(oldTi) == tableStructure(ti) {
+		return nil
+	}
+	tctx.L().Warn("table is recreated with a different structure", zap.Stringer("table", sourceTable),
+		zap.String("old structure", tableStructure(oldTi)), zap.String("new structure", tableStructure(ti)))
+
+	if problems := s.validateTableRules(sourceTable, ti); len(problems) > 0 {
+		return terror.ErrSyncerTableRecreated.Generate(sourceTable, strings.Join(problems, "; "))
+	}
+
+	p, err := utils.GetParserForConn(tctx.Ctx, s.ddlDBConn.BaseConn.DBConn)
+	if err != nil {
+		return terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
+	}
+	stmt, err := fetchCreateTableStmt(tctx, s.ddlDBConn, p, targetTable)
+	if err != nil {
+		if utils.IsMySQLError(err, tmysql.ErrNoSuchTable) {
+			// the DROP TABLE is replicated, the downstream table will be created by the CREATE TABLE.
+			return nil
+		}
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	drift := compareTableStructure(ti, stmt)
+	if drift.empty() {
+		return nil
+	}
+	if s.cfg.OnTableRecreate != config.TableRecreateRedump {
+		return terror.ErrSyncerTableRecreated.Generate(sourceTable, fmt.Sprintf("downstream table %s has the old structure, %s", targetTable, drift))
+	}
+	return s.rebuildDownstreamTable(tctx, p, sourceTable, targetTable)
+}
+
+// validateTableRules validates the rules depending on the table structure, and returns the problems found.
+func (s *Syncer) validateTableRules(sourceTable *filter.Table, ti *model.TableInfo) []string {
+	var problems []string
+	_, err := s.exprFilterGroup.GetInsertExprs(sourceTable, ti)
+	if err == nil {
+		_, _, err = s.exprFilterGroup.GetUpdateExprs(sourceTable, ti)
+	}
+	if err == nil {
+		_, err = s.exprFilterGroup.GetDeleteExprs(sourceTable, ti)
+	}
+	if err != nil {
+		// the expressions may be partially built.
+		s.exprFilterGroup.ResetExprs(sourceTable)
+		problems = append(problems, "invalid expression filter: "+err.Error())
+	}
+	if _, err = s.columnTransforms.getTransforms(sourceTable, ti); err != nil {
+		problems = append(problems, "invalid column transformation: "+err.Error())
+	}
+	return problems
+}
+
+// rebuildDownstreamTable drops the downstream table having the old structure, and creates it with the new structure.
+// the recreated table is empty when it's created in upstream, so its rows are all replicated from binlog later, like
+// the table is dumped again.
+func (s *Syncer) rebuildDownstreamTable(tctx *tcontext.Context, p *parser.Parser, sourceTable, targetTable *filter.Table) error {
+	createSQL, err := s.schemaTracker.GetCreateTable(tctx.Ctx, sourceTable)
+	if err != nil {
+		return terror.ErrSchemaTrackerCannotGetTable.Delegate(err, sourceTable)
+	}
+	stmt, err := parserpkg.Parse(p, createSQL, "", "")
+	if err != nil || len(stmt) != 1 {
+		return terror.ErrSchemaTrackerInvalidCreateTableStmt.Delegate(err, createSQL)
+	}
+	createStmt, ok := stmt[0].(*ast.CreateTableStmt)
+	if !ok {
+		return terror.ErrSchemaTrackerInvalidCreateTableStmt.Generate(createSQL)
+	}
+	createStmt.IfNotExists = true
+	targetCreateSQL, err := parserpkg.RenameDDLTable(createStmt, []*filter.Table{targetTable})
+	if err != nil {
+		return err
+	}
+
+	queries := []string{"DROP TABLE IF EXISTS " + targetTable.String(), targetCreateSQL}
+	tctx.L().Warn("rebuild downstream table for the recreated table", zap.Stringer("source", sourceTable),
+		zap.Stringer("target", targetTable), zap.Strings("statements", queries))
+	if _, err = s.ddlDBConn.ExecuteSQL(tctx, queries); err != nil {
+		return terror.WithScope(err, terror.ScopeDownstream)
+	}
+	return nil
+}
+
+// tableStructure describes the columns and indices of the table, used to compare the structures.
+func tableStructure(ti *model.TableInfo) string {
+	var b strings.Builder
+	for _, col := range ti.Columns {
+		b.WriteString(col.Name.L)
+		b.WriteByte(' ')
+		b.WriteString(col.FieldType.String())
+		if col.IsGenerated() {
+			b.WriteString(" AS (" + col.GeneratedExprString + ")")
+		}
+		b.WriteString(", ")
+	}
+	if ti.PKIsHandle {
+		if pk := ti.GetPkColInfo(); pk != nil {
+			b.WriteString("PRIMARY KEY (" + pk.Name.L + "), ")
+		}
+	}
+	for _, idx := range ti.Indices {
+		switch {
+		case idx.Primary:
+			b.WriteString("PRIMARY KEY (")
+		case idx.Unique:
+			b.WriteString("UNIQUE KEY " + idx.Name.L + " (")
+		default:
+			continue
+		}
+		for i, col := range idx.Columns {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(col.Name.L)
+		}
+		b.WriteString("), ")
+	}
+	return strings.TrimSuffix(b.String(), ", ")
+}
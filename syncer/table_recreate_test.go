@@ -0,0 +1,61 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb-tools/pkg/filter"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/mock"
+
+	"github.com/pingcap/dm/dm/config"
+)
+
+func (s *testSyncerSuite) TestTableStructure(c *C) {
+	p := parser.New()
+	se := mock.NewContext()
+	structure := func(sql string) string {
+		ti, err := createTableInfo(p, se, 1, sql)
+		c.Assert(err, IsNil)
+		return tableStructure(ti)
+	}
+
+	old := structure("create table t(id int primary key, name varchar(20), key idx_name(name))")
+	c.Assert(old, Equals, "id int(11), name varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin, PRIMARY KEY (id)")
+	// non-unique indices and letter cases are ignored.
+	c.Assert(structure("create table t(ID int primary key, Name varchar(20))"), Equals, old)
+
+	c.Assert(structure("create table t(id int primary key, name varchar(40))"), Not(Equals), old)
+	c.Assert(structure("create table t(id int primary key, name varchar(20), age int)"), Not(Equals), old)
+	c.Assert(structure("create table t(id int, age int, unique key uk(id, age))"), Equals,
+		"id int(11), age int(11), UNIQUE KEY uk (id,age)")
+	c.Assert(structure("create table t(id int, age int, next_age int as (age + 1))"), Matches,
+		"id int\\(11\\), age int\\(11\\), next_age int\\(11\\) AS \\(.*age.*\\+ 1\\)")
+}
+
+func (s *testSyncerSuite) TestDroppedTables(c *C) {
+	syncer := &Syncer{cfg: &config.SubTaskConfig{}, droppedTables: make(map[string]*model.TableInfo)}
+	table := &filter.Table{Schema: "db", Name: "tb"}
+
+	// not recorded if the option is not set.
+	syncer.recordDroppedTable(nil, table, table)
+	c.Assert(syncer.droppedTables, HasLen, 0)
+	c.Assert(syncer.popDroppedTable(table), IsNil)
+
+	ti := &model.TableInfo{Name: model.NewCIStr("tb")}
+	syncer.droppedTables["`db`.`tb`"] = ti
+	c.Assert(syncer.popDroppedTable(table), Equals, ti)
+	c.Assert(syncer.popDroppedTable(table), IsNil)
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/dm/unit"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/log"
+)
+
+var (
+	// replicaTargetRetryInterval is the interval to restart the syncer of a replica target after it fails, it's
+	// doubled after every failure up to maxReplicaTargetRetryInterval.
+	replicaTargetRetryInterval    = 10 * time.Second
+	maxReplicaTargetRetryInterval = 5 * time.Minute
+)
+
+// replicaTarget is an additional downstream database configured by `targets`. every replica target is replicated
+// by its own syncer, which reads the binlog independently and keeps its checkpoint in the meta schema of the replica
+// target, so a slow or failing replica target doesn't stall or fail the target database and the other replica
+// targets. the syncer of a failed replica target is restarted from its own checkpoint after a while.
+type replicaTarget struct {
+	cfg    *config.SubTaskConfig // the config of the syncer, whose `target-database` is the replica target
+	addr   string
+	logger log.Logger
+
+	newUnit func(cfg *config.SubTaskConfig, addr string) unit.Unit
+}
+
+// newReplicaTargets creates replica targets for `targets` of the subtask. the source ID of the syncer of a replica
+// target is `<source-id>@<host>:<port>`, which identifies its checkpoint and metrics.
+func newReplicaTargets(tctx *tcontext.Context, cfg *config.SubTaskConfig) ([]*replicaTarget, error) {
+	targets := make([]*replicaTarget, 0, len(cfg.Targets))
+	for _, dbCfg := range cfg.Targets {
+		addr := fmt.Sprintf("%s:%d", dbCfg.Host, dbCfg.Port)
+		targetCfg, err := cfg.Clone()
+		if err != nil {
+			return nil, err
+		}
+		targetCfg.SourceID = cfg.SourceID + "@" + addr
+		targetCfg.To = dbCfg
+		targetCfg.Targets = nil
+		// use the same sql_mode as the target database.
+		if sqlMode, ok := cfg.To.Session["sql_mode"]; ok {
+			session := make(map[string]string, len(dbCfg.Session)+1)
+			hasSQLMode := false
+			for k, v := range dbCfg.Session {
+				session[k] = v
+				if strings.ToLower(k) == "sql_mode" {
+					hasSQLMode = true
+				}
+			}
+			if !hasSQLMode {
+				session["sql_mode"] = sqlMode
+			}
+			targetCfg.To.Session = session
+		}
+		targets = append(targets, &replicaTarget{
+			cfg:     targetCfg,
+			addr:    addr,
+			logger:  tctx.L().WithFields(zap.String("component", "replica target"), zap.String("target", addr)),
+			newUnit: newReplicaTargetSyncer,
+		})
+	}
+	return targets, nil
+}
+
+// newReplicaTargetSyncer creates the syncer of a replica target.
+func newReplicaTargetSyncer(cfg *config.SubTaskConfig, addr string) unit.Unit {
+	s := NewSyncer(cfg, nil)
+	s.replicaTargetAddr = addr
+	s.tctx = s.tctx.WithLogger(s.tctx.L().WithFields(zap.String("target", addr)))
+	return s
+}
+
+// run runs the syncer of the replica target until ctx is done, the syncer is restarted after it fails.
+func (t *replicaTarget) run(ctx context.Context) {
+	interval := replicaTargetRetryInterval
+	for {
+		startTime := time.Now()
+		err := t.runOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(startTime) > maxReplicaTargetRetryInterval {
+			// the syncer has been running for a while, it's a new failure.
+			interval = replicaTargetRetryInterval
+		}
+		t.logger.Error("replica target fails, restart it from its checkpoint later", zap.Duration("interval", interval), log.ShortError(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+		if interval *= 2; interval > maxReplicaTargetRetryInterval {
+			interval = maxReplicaTargetRetryInterval
+		}
+	}
+}
+
+// runOnce initializes and runs a new syncer of the replica target until it fails or ctx is done.
+func (t *replicaTarget) runOnce(ctx context.Context) error {
+	u := t.newUnit(t.cfg, t.addr)
+	if err := u.Init(ctx); err != nil {
+		return err
+	}
+	defer u.Close()
+
+	t.logger.Info("start to replicate the replica target")
+	pr := make(chan pb.ProcessResult, 1)
+	u.Process(ctx, pr)
+	result := <-pr
+	if len(result.Errors) > 0 {
+		return errors.New(unit.JoinProcessErrors(result.Errors))
+	}
+	return errors.New("syncer exits without error")
+}
+
+// startReplicaTargets starts the syncers of the replica targets if they are not running.
+func (s *Syncer) startReplicaTargets() {
+	s.replicaTargetsMu.Lock()
+	defer s.replicaTargetsMu.Unlock()
+	if s.replicaTargetsCancel != nil || len(s.replicaTargets) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.replicaTargetsCancel = cancel
+	for _, target := range s.replicaTargets {
+		s.replicaTargetsWg.Add(1)
+		go func(target *replicaTarget) {
+			defer s.replicaTargetsWg.Done()
+			target.run(ctx)
+		}(target)
+	}
+}
+
+// stopReplicaTargets stops the syncers of the replica targets and waits for them to exit.
+func (s *Syncer) stopReplicaTargets() {
+	s.replicaTargetsMu.Lock()
+	defer s.replicaTargetsMu.Unlock()
+	if s.replicaTargetsCancel == nil {
+		return
+	}
+	s.replicaTargetsCancel()
+	s.replicaTargetsWg.Wait()
+	s.replicaTargetsCancel = nil
+}
+
+// resetReplicaTargets recreates the replica targets by the config, their syncers are restarted if they are running.
+func (s *Syncer) resetReplicaTargets() error {
+	targets, err := newReplicaTargets(s.tctx, s.cfg)
+	if err != nil {
+		return err
+	}
+	s.replicaTargetsMu.Lock()
+	running := s.replicaTargetsCancel != nil
+	s.replicaTargetsMu.Unlock()
+
+	s.stopReplicaTargets()
+	s.replicaTargetsMu.Lock()
+	s.replicaTargets = targets
+	s.replicaTargetsMu.Unlock()
+	if running {
+		s.startReplicaTargets()
+	}
+	return nil
+}
+
+// activeRelayLogKey returns the key of the active relay log of the syncer in the reader hub, the syncers of the
+// replica targets read the relay log with the syncer of the target database.
+func (s *Syncer) activeRelayLogKey() string {
+	if s.replicaTargetAddr != "" {
+		return s.cfg.Name + "@" + s.replicaTargetAddr
+	}
+	return s.cfg.Name
+}
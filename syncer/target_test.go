@@ -0,0 +1,137 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/dm/pb"
+	"github.com/pingcap/dm/dm/unit"
+	"github.com/pingcap/dm/pkg/binlog"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// mockTargetUnit is the syncer of a replica target for test, the errors of Init and Process are set by the test.
+type mockTargetUnit struct {
+	initErr    error
+	processErr error
+	closed     bool
+}
+
+func (u *mockTargetUnit) Init(context.Context) error { return u.initErr }
+
+func (u *mockTargetUnit) Process(ctx context.Context, pr chan pb.ProcessResult) {
+	if u.processErr != nil {
+		pr <- pb.ProcessResult{Errors: []*pb.ProcessError{unit.NewProcessError(u.processErr)}}
+		return
+	}
+	<-ctx.Done()
+	pr <- pb.ProcessResult{IsCanceled: true}
+}
+
+func (u *mockTargetUnit) Close()                                        { u.closed = true }
+func (u *mockTargetUnit) Pause()                                        {}
+func (u *mockTargetUnit) Resume(context.Context, chan pb.ProcessResult) {}
+func (u *mockTargetUnit) Update(*config.SubTaskConfig) error            { return nil }
+func (u *mockTargetUnit) Status(*binlog.SourceStatus) interface{}       { return nil }
+func (u *mockTargetUnit) Type() pb.UnitType                             { return pb.UnitType_Sync }
+func (u *mockTargetUnit) IsFreshTask(context.Context) (bool, error)     { return false, nil }
+
+func (s *testSyncerSuite) TestReplicaTargetConfig(c *C) {
+	cfg := &config.SubTaskConfig{
+		Name:       "test",
+		SourceID:   "mysql-replica-01",
+		MetaSchema: "dm_meta",
+		Flavor:     mysql.MySQLFlavor,
+		To:         config.DBConfig{Host: "127.0.0.1", Port: 4000, Session: map[string]string{"sql_mode": "ANSI_QUOTES"}},
+		Targets: []config.DBConfig{
+			{Host: "127.0.0.1", Port: 4001},
+			{Host: "127.0.0.1", Port: 4002, Session: map[string]string{"SQL_MODE": ""}},
+		},
+	}
+	targets, err := newReplicaTargets(tcontext.Background(), cfg)
+	c.Assert(err, IsNil)
+	c.Assert(targets, HasLen, 2)
+	c.Assert(targets[0].addr, Equals, "127.0.0.1:4001")
+	c.Assert(targets[1].addr, Equals, "127.0.0.1:4002")
+
+	// the syncer of a replica target replicates it as the target database, with its own checkpoint.
+	targetCfg := targets[0].cfg
+	c.Assert(targetCfg.Name, Equals, cfg.Name)
+	c.Assert(targetCfg.SourceID, Equals, "mysql-replica-01@127.0.0.1:4001")
+	c.Assert(targetCfg.To.Port, Equals, 4001)
+	c.Assert(targetCfg.To.Session, DeepEquals, map[string]string{"sql_mode": "ANSI_QUOTES"})
+	c.Assert(targetCfg.Targets, HasLen, 0)
+	c.Assert(targets[1].cfg.To.Session, DeepEquals, map[string]string{"SQL_MODE": ""})
+	c.Assert(cfg.SourceID, Equals, "mysql-replica-01")
+	c.Assert(cfg.Targets, HasLen, 2)
+
+	syncer := newReplicaTargetSyncer(targetCfg, targets[0].addr).(*Syncer)
+	c.Assert(syncer.activeRelayLogKey(), Equals, "test@127.0.0.1:4001")
+	c.Assert(NewSyncer(cfg, nil).activeRelayLogKey(), Equals, "test")
+}
+
+func (s *testSyncerSuite) TestReplicaTargetRestart(c *C) {
+	oldInterval, oldMaxInterval := replicaTargetRetryInterval, maxReplicaTargetRetryInterval
+	replicaTargetRetryInterval, maxReplicaTargetRetryInterval = time.Millisecond, 10*time.Millisecond
+	defer func() {
+		replicaTargetRetryInterval, maxReplicaTargetRetryInterval = oldInterval, oldMaxInterval
+	}()
+
+	// the first syncer fails to init, the second one fails to process, and the third one keeps running.
+	var (
+		mu    sync.Mutex
+		units = []*mockTargetUnit{
+			{initErr: errors.New("init failed")},
+			{processErr: errors.New("process failed")},
+			{},
+		}
+		created int
+	)
+	target := &replicaTarget{
+		cfg:    &config.SubTaskConfig{Name: "test"},
+		addr:   "127.0.0.1:4001",
+		logger: tcontext.Background().L(),
+		newUnit: func(*config.SubTaskConfig, string) unit.Unit {
+			mu.Lock()
+			defer mu.Unlock()
+			u := units[created]
+			created++
+			return u
+		},
+	}
+	syncer := &Syncer{replicaTargets: []*replicaTarget{target}}
+	syncer.startReplicaTargets()
+	syncer.startReplicaTargets() // no-op if they are running
+	c.Assert(utils.WaitSomething(100, 10*time.Millisecond, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return created == len(units)
+	}), IsTrue)
+
+	syncer.stopReplicaTargets()
+	syncer.stopReplicaTargets()
+	c.Assert(created, Equals, len(units))
+	c.Assert(units[0].closed, IsFalse)
+	c.Assert(units[1].closed, IsTrue)
+	c.Assert(units[2].closed, IsTrue)
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/pingcap/dm/pkg/binlog"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/gtid"
+	"github.com/pingcap/dm/pkg/log"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/pkg/utils"
+)
+
+// when reading binlog from upstream directly, the upstream may be switched to a new primary (with a different server
+// UUID) by a failover behind a VIP or proxy. the binlog position of the old primary is meaningless for the new one,
+// but the GTID sets are comparable, so the syncer continues from the migrated GTID sets if GTID is enabled. the
+// relay unit handles the switch itself, so it's only checked for remote binlog.
+
+// recordUpstreamUUID records the server UUID of the upstream the syncer starts to read binlog from.
+func (s *Syncer) recordUpstreamUUID(ctx context.Context) {
+	if s.streamerController.GetBinlogType() != RemoteBinlog {
+		return
+	}
+	ctx2, cancel := context.WithTimeout(ctx, utils.DefaultDBTimeout)
+	defer cancel()
+	uuid, err := s.fromDB.GetServerUUID(ctx2, s.cfg.Flavor)
+	if err != nil {
+		// the switch can't be detected before the next successful record, but the syncer can still work.
+		s.tctx.L().Warn("fail to get server UUID of upstream", log.ShortError(err))
+		return
+	}
+	s.upstreamUUID = uuid
+}
+
+// checkUpstreamSwitch checks whether the upstream is switched to a new primary before reconnecting to it, and verifies
+// the binlog can be read from location of the new primary.
+func (s *Syncer) checkUpstreamSwitch(tctx *tcontext.Context, location binlog.Location) error {
+	if s.streamerController.GetBinlogType() != RemoteBinlog {
+		return nil
+	}
+	if s.upstreamUUID == "" {
+		s.recordUpstreamUUID(tctx.Ctx)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(tctx.Ctx, utils.DefaultDBTimeout)
+	defer cancel()
+	uuid, err := s.fromDB.GetServerUUID(ctx, s.cfg.Flavor)
+	if err != nil {
+		// maybe the new primary is not ready yet, the reconnecting will fail and retry.
+		tctx.L().Warn("fail to get server UUID of upstream", log.ShortError(err))
+		return nil
+	}
+	if uuid == s.upstreamUUID {
+		return nil
+	}
+
+	oldUUID := s.upstreamUUID
+	tctx.L().Warn("upstream is switched to a new primary", zap.String("old server UUID", oldUUID),
+		zap.String("new server UUID", uuid), zap.Stringer("location", location))
+	var executed gtid.Set
+	if s.cfg.EnableGTID {
+		_, executed, err = s.fromDB.GetMasterStatus(ctx, s.cfg.Flavor)
+		if err != nil {
+			return terror.WithScope(terror.Annotate(err, "get master status of the new primary"), terror.ScopeUpstream)
+		}
+	}
+	// the task is paused if the switch can't be handled automatically, and the user should update the checkpoint
+	// before resuming it, so the new primary is recorded anyway.
+	s.upstreamUUID = uuid
+	if !s.cfg.EnableGTID || location.GetGTID() == nil || location.GetGTID().String() == "" {
+		return terror.ErrSyncerUpstreamSwitchWithoutGTID.Generate(oldUUID, uuid)
+	}
+	pending, err := checkGTIDsAfterUpstreamSwitch(uuid, location.GetGTID(), executed)
+	if err != nil {
+		return err
+	}
+	tctx.L().Info("continue to migrate from the new primary of upstream", zap.String("server UUID", uuid),
+		zap.Stringer("migrated GTID sets", location.GetGTID()), zap.Stringer("pending GTID sets", pending))
+	return nil
+}
+
+// checkGTIDsAfterUpstreamSwitch verifies all migrated transactions are executed in the new primary, otherwise they are
+// errant transactions that the new primary never has, and returns the transactions to be migrated from the new primary.
+// the GTID sets to continue from are still the migrated ones, they are merged with the new primary's by replicating.
+func checkGTIDsAfterUpstreamSwitch(uuid string, migrated, executed gtid.Set) (gtid.Set, error) {
+	if executed == nil {
+		// no GTID is executed in the new primary.
+		executed = migrated.Minus(migrated)
+	}
+	if errant := migrated.Minus(executed); errant.String() != "" {
+		return nil, terror.ErrSyncerUpstreamSwitchErrantGTID.Generate(errant, uuid)
+	}
+	return executed.Minus(migrated), nil
+}
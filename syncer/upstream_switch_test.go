@@ -0,0 +1,119 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	. "github.com/pingcap/check"
+
+	"github.com/pingcap/dm/dm/config"
+	"github.com/pingcap/dm/pkg/binlog"
+	"github.com/pingcap/dm/pkg/conn"
+	tcontext "github.com/pingcap/dm/pkg/context"
+	"github.com/pingcap/dm/pkg/gtid"
+	"github.com/pingcap/dm/pkg/terror"
+	"github.com/pingcap/dm/syncer/dbconn"
+)
+
+var _ = Suite(&testUpstreamSwitchSuite{})
+
+type testUpstreamSwitchSuite struct{}
+
+const (
+	oldPrimaryUUID = "00c04543-f584-11e9-a765-0242ac120002"
+	newPrimaryUUID = "03fc0263-28c7-11e7-a653-6c0b84d59f30"
+)
+
+func (t *testUpstreamSwitchSuite) parseGTID(c *C, s string) gtid.Set {
+	gs, err := gtid.ParserGTID(gmysql.MySQLFlavor, s)
+	c.Assert(err, IsNil)
+	return gs
+}
+
+func (t *testUpstreamSwitchSuite) TestCheckGTIDsAfterUpstreamSwitch(c *C) {
+	migrated := t.parseGTID(c, oldPrimaryUUID+":1-100")
+
+	// the new primary has executed all migrated transactions
+	pending, err := checkGTIDsAfterUpstreamSwitch(newPrimaryUUID, migrated, t.parseGTID(c, oldPrimaryUUID+":1-120,"+newPrimaryUUID+":1-5"))
+	c.Assert(err, IsNil)
+	c.Assert(pending.Equal(t.parseGTID(c, oldPrimaryUUID+":101-120,"+newPrimaryUUID+":1-5")), IsTrue)
+
+	// the new primary lost some migrated transactions
+	_, err = checkGTIDsAfterUpstreamSwitch(newPrimaryUUID, migrated, t.parseGTID(c, oldPrimaryUUID+":1-90,"+newPrimaryUUID+":1-5"))
+	c.Assert(terror.ErrSyncerUpstreamSwitchErrantGTID.Equal(err), IsTrue)
+	c.Assert(err, ErrorMatches, ".*transactions "+oldPrimaryUUID+":91-100 have been migrated.*")
+
+	// the new primary has no GTID
+	_, err = checkGTIDsAfterUpstreamSwitch(newPrimaryUUID, migrated, nil)
+	c.Assert(terror.ErrSyncerUpstreamSwitchErrantGTID.Equal(err), IsTrue)
+}
+
+func (t *testUpstreamSwitchSuite) TestCheckUpstreamSwitch(c *C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	s := &Syncer{
+		tctx:               tcontext.Background(),
+		cfg:                &config.SubTaskConfig{Flavor: gmysql.MySQLFlavor},
+		fromDB:             &dbconn.UpStreamConn{BaseDB: conn.NewBaseDB(db, func() {})},
+		streamerController: NewStreamerController(replication.BinlogSyncerConfig{}, true, nil, RemoteBinlog, "", nil),
+	}
+	s.cfg.EnableGTID = true
+	location := binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000001", Pos: 4}, t.parseGTID(c, oldPrimaryUUID+":1-100"))
+	expectUUID := func(uuid string) {
+		mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'server_uuid'").WillReturnRows(
+			sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("server_uuid", uuid))
+	}
+	expectMasterStatus := func(gs string) {
+		mock.ExpectQuery("SHOW MASTER STATUS").WillReturnRows(
+			sqlmock.NewRows([]string{"File", "Position", "Binlog_Do_DB", "Binlog_Ignore_DB", "Executed_Gtid_Set"}).
+				AddRow("mysql-bin.000003", 1234, nil, nil, gs))
+	}
+
+	// record the upstream at first
+	expectUUID(oldPrimaryUUID)
+	c.Assert(s.checkUpstreamSwitch(s.tctx, location), IsNil)
+	c.Assert(s.upstreamUUID, Equals, oldPrimaryUUID)
+	// not switched
+	expectUUID(oldPrimaryUUID)
+	c.Assert(s.checkUpstreamSwitch(s.tctx, location), IsNil)
+	c.Assert(s.upstreamUUID, Equals, oldPrimaryUUID)
+
+	// switched to a new primary having executed the migrated transactions
+	expectUUID(newPrimaryUUID)
+	expectMasterStatus(oldPrimaryUUID + ":1-110," + newPrimaryUUID + ":1-3")
+	c.Assert(s.checkUpstreamSwitch(s.tctx, location), IsNil)
+	c.Assert(s.upstreamUUID, Equals, newPrimaryUUID)
+
+	// switched back to the old primary which lost some transactions migrated from the new primary
+	location = binlog.InitLocation(gmysql.Position{Name: "mysql-bin.000003", Pos: 4}, t.parseGTID(c, oldPrimaryUUID+":1-110,"+newPrimaryUUID+":1-3"))
+	expectUUID(oldPrimaryUUID)
+	expectMasterStatus(oldPrimaryUUID + ":1-110")
+	err = s.checkUpstreamSwitch(s.tctx, location)
+	c.Assert(terror.ErrSyncerUpstreamSwitchErrantGTID.Equal(err), IsTrue)
+	c.Assert(s.upstreamUUID, Equals, oldPrimaryUUID)
+
+	// GTID is not enabled
+	s.cfg.EnableGTID = false
+	expectUUID(newPrimaryUUID)
+	err = s.checkUpstreamSwitch(s.tctx, location)
+	c.Assert(terror.ErrSyncerUpstreamSwitchWithoutGTID.Equal(err), IsTrue)
+	c.Assert(s.upstreamUUID, Equals, newPrimaryUUID)
+
+	// not checked for relay log
+	s.streamerController = NewStreamerController(replication.BinlogSyncerConfig{}, true, nil, LocalBinlog, "", nil)
+	c.Assert(s.checkUpstreamSwitch(s.tctx, location), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
@@ -17,8 +17,7 @@ function update_task_worker_not_found() {
 	not_found_source_id=$2
 	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
 		"update-task $task_conf -s $not_found_source_id " \
-		"\"result\": true" 1 \
-		"\"result\": false" 1 \
+		"\"result\": false" 2 \
 		"\"source\": \"$not_found_source_id\"" 1 \
 		"\"msg\": \"source not found in task's config\"" 1
 }
@@ -27,8 +26,7 @@ function update_task_not_paused() {
 	task_conf=$1
 	run_dm_ctl $WORK_DIR "127.0.0.1:$MASTER_PORT" \
 		"update-task $task_conf" \
-		"\"result\": true" 1 \
-		"\"result\": false" 2 \
+		"\"result\": false" 3 \
 		"can only update task on Paused stage, but current stage is Running" 2
 }
 
@@ -49,9 +49,9 @@ function usage_and_arg_test() {
 	#    update_relay_wrong_config_file
 	#    update_relay_should_specify_one_dm_worker $MYSQL1_CONF
 
-	# echo "update_task_wrong_arg"
-	# update_task_wrong_arg
-	# update_task_wrong_config_file
+	echo "update_task_wrong_arg"
+	update_task_wrong_arg
+	update_task_wrong_config_file
 
 	#    echo "update_master_config_wrong_arg"
 	#    update_master_config_wrong_arg
@@ -290,7 +290,7 @@ function run() {
 		"\"source\": \"$SOURCE_ID1\"" 1 \
 		"\"source\": \"$SOURCE_ID2\"" 1 \
 		"\"stage\": \"Running\"" 4
-	# update_task_not_paused $TASK_CONF
+	update_task_not_paused $TASK_CONF
 
 	# stop relay because get_config_to_file will stop source
 	stop_relay_fail
@@ -320,10 +320,10 @@ function run() {
 	dmctl_wrong_addrs
 	dmctl_no_addr
 
-	# echo "update_task_worker_not_found"
-	# update_task_worker_not_found $TASK_CONF 127.0.0.1:9999
-	# update_task_success_single_worker $TASK_CONF $SOURCE_ID1
-	# update_task_success $TASK_CONF
+	echo "update_task_worker_not_found"
+	update_task_worker_not_found $TASK_CONF 127.0.0.1:9999
+	update_task_success_single_worker $TASK_CONF $SOURCE_ID1
+	update_task_success $TASK_CONF
 
 	start_relay_success
 
@@ -199,7 +199,7 @@ function run() {
 	# check configs
 	sed '/password/d' /tmp/configs/tasks/test.yaml | diff $cur/configs/tasks/test.yaml - || exit 1
 	sed '/password/d' /tmp/configs/sources/mysql-replica-01.yaml | diff -I '^case-sensitive*' $cur/configs/sources/mysql-replica-01.yaml - || exit 1
-	diff <(jq --sort-keys . /tmp/configs/relay_workers.json) <(jq --sort-keys . $cur/configs/relay_workers.json) || exit 1
+	diff /tmp/configs/relay_workers.yaml $cur/configs/relay_workers.yaml || exit 1
 
 	# destroy cluster
 	cleanup_process $*
@@ -222,8 +222,8 @@ function run() {
 		"config import -p /tmp/configs" \
 		"creating sources" 1 \
 		"creating tasks" 1 \
-		"The original relay workers have been exported to" 1 \
-		"Currently DM doesn't support recover relay workers.*transfer-source.*start-relay" 1
+		"enabling relay for sources" 1 \
+		"import configs from directory .* succeed" 1
 
 	run_dm_ctl_with_retry $WORK_DIR "127.0.0.1:$MASTER_PORT" \
 		"operate-source show" \